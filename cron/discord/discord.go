@@ -104,6 +104,24 @@ func (d *DiscordHealthTracker) SendDiscordNotificationIfNeeded(
 		isRunpodServerlessActive,
 		runpodServerlessErr,
 	)
+	if err := d.postWebhook(webhookBody); err != nil {
+		return err
+	}
+
+	// Update last notification times
+	d.lastNotificationTime = time.Now()
+	if status == shared.HEALTHY {
+		d.lastHealthyNotificationTime = d.lastNotificationTime
+	} else {
+		d.lastUnhealthyNotificationTime = d.lastNotificationTime
+	}
+	end := time.Now().UnixMilli()
+	log.Infof("Sent Discord notification in %dms", end-start)
+
+	return nil
+}
+
+func (d *DiscordHealthTracker) postWebhook(webhookBody models.DiscordWebhookBody) error {
 	reqBody, err := json.Marshal(webhookBody)
 	if err != nil {
 		log.Error("Error marshalling webhook body", "err", err)
@@ -123,18 +141,81 @@ func (d *DiscordHealthTracker) SendDiscordNotificationIfNeeded(
 		return err
 	}
 	defer res.Body.Close()
+	return nil
+}
 
-	// Update last notification times
-	d.lastNotificationTime = time.Now()
-	if status == shared.HEALTHY {
-		d.lastHealthyNotificationTime = d.lastNotificationTime
-	} else {
-		d.lastUnhealthyNotificationTime = d.lastNotificationTime
+func CircuitStateString(state shared.CircuitState) string {
+	switch state {
+	case shared.CircuitClosed:
+		return "🟢 Closed"
+	case shared.CircuitOpen:
+		return "🔴 Open"
+	case shared.CircuitHalfOpen:
+		return "🟡 Half-open"
 	}
-	end := time.Now().UnixMilli()
-	log.Infof("Sent Discord notification in %dms", end-start)
+	return "⚪️ Unknown"
+}
 
-	return nil
+// Sends a discord notification when a backend's circuit changed state since the last notification
+// The last notified state is kept next to the circuit in redis, so restarts and other instances don't notify again
+// A circuit that was never notified about only notifies if it isn't closed
+func (d *DiscordHealthTracker) SendCircuitNotificationIfChanged(breaker *shared.CircuitBreaker, status *shared.CircuitStatus) error {
+	last, changed, err := breaker.SetNotifiedState(status.Backend, status.State)
+	if err != nil {
+		return err
+	}
+	if !changed || (last == "" && status.State == shared.CircuitClosed) {
+		return nil
+	}
+
+	log.Info("Sending Discord circuit notification...", "backend", status.Backend, "state", status.State)
+	return d.postWebhook(getCircuitWebhookBody(status, last))
+}
+
+func getCircuitWebhookBody(status *shared.CircuitStatus, from shared.CircuitState) models.DiscordWebhookBody {
+	var content *string
+	discordUserIds := utils.GetEnv().GetDiscordUserIdsToNotify()
+	if status.State == shared.CircuitOpen && len(discordUserIds) > 0 {
+		mentionStr := ""
+		for _, userId := range discordUserIds {
+			mentionStr += fmt.Sprintf("<@%s> ", userId)
+		}
+		content = &mentionStr
+	}
+
+	fromStr := "⚪️ Unknown"
+	if from != "" {
+		fromStr = CircuitStateString(from)
+	}
+	body := models.DiscordWebhookBody{
+		Content: content,
+		Embeds: []models.DiscordWebhookEmbed{
+			{
+				Color: 11437547,
+				Fields: []models.DiscordWebhookField{
+					{
+						Name:  "Backend",
+						Value: fmt.Sprintf("```%s```", status.Backend),
+					},
+					{
+						Name:  "Circuit",
+						Value: fmt.Sprintf("```%s -> %s```", fromStr, CircuitStateString(status.State)),
+					},
+				},
+				Footer: models.DiscordWebhookEmbedFooter{
+					Text: time.Now().Format(time.RFC1123),
+				},
+			},
+		},
+		Attachments: []models.DiscordWebhookAttachment{},
+	}
+	if status.State == shared.CircuitHalfOpen {
+		body.Embeds[0].Fields = append(body.Embeds[0].Fields, models.DiscordWebhookField{
+			Name:  "Probes Succeeded",
+			Value: fmt.Sprintf("```%d of %d sent```", status.Successes, status.Probes),
+		})
+	}
+	return body
 }
 
 func getDiscordWebhookBody(
@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jarcoal/httpmock"
 	"github.com/stablecog/sc-go/cron/models"
+	"github.com/stablecog/sc-go/database"
 	"github.com/stablecog/sc-go/database/ent"
 	"github.com/stablecog/sc-go/database/ent/generation"
 	"github.com/stablecog/sc-go/shared"
@@ -126,3 +127,63 @@ func TestSendDiscordNotificationIfNeeded(t *testing.T) {
 	err = MockDiscordHealthTracker.SendDiscordNotificationIfNeeded(shared.HEALTHY, generations, time.Now(), time.Now(), false, nil)
 	assert.Nil(t, err)
 }
+
+func TestSendCircuitNotificationIfChanged(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var sent []models.DiscordWebhookBody
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var request models.DiscordWebhookBody
+			err := json.NewDecoder(req.Body).Decode(&request)
+			assert.Nil(t, err)
+			sent = append(sent, request)
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"status": "ok",
+			})
+		},
+	)
+
+	origMockRedis := utils.GetEnv().MockRedis
+	utils.GetEnv().MockRedis = true
+	defer func() { utils.GetEnv().MockRedis = origMockRedis }()
+	redis, err := database.NewRedis(context.Background())
+	assert.Nil(t, err)
+	breaker := shared.NewCircuitBreaker(context.Background(), redis.Client, shared.CircuitBreakerConfig{})
+
+	tracker := NewDiscordHealthTracker(context.Background())
+
+	// Closed on the first check isn't news
+	err = tracker.SendCircuitNotificationIfChanged(breaker, &shared.CircuitStatus{Backend: shared.BackendScWorker, State: shared.CircuitClosed})
+	assert.Nil(t, err)
+	assert.Len(t, sent, 0)
+
+	err = tracker.SendCircuitNotificationIfChanged(breaker, &shared.CircuitStatus{Backend: shared.BackendScWorker, State: shared.CircuitOpen})
+	assert.Nil(t, err)
+	assert.Len(t, sent, 1)
+	assert.Equal(t, "```sc-worker```", sent[0].Embeds[0].Fields[0].Value)
+	assert.Equal(t, "```🟢 Closed -> 🔴 Open```", sent[0].Embeds[0].Fields[1].Value)
+
+	// Same state again
+	err = tracker.SendCircuitNotificationIfChanged(breaker, &shared.CircuitStatus{Backend: shared.BackendScWorker, State: shared.CircuitOpen})
+	assert.Nil(t, err)
+	assert.Len(t, sent, 1)
+
+	err = tracker.SendCircuitNotificationIfChanged(breaker, &shared.CircuitStatus{Backend: shared.BackendScWorker, State: shared.CircuitHalfOpen, Probes: 2, Successes: 1})
+	assert.Nil(t, err)
+	assert.Len(t, sent, 2)
+	assert.Equal(t, "```🔴 Open -> 🟡 Half-open```", sent[1].Embeds[0].Fields[1].Value)
+	assert.Equal(t, "```1 of 2 sent```", sent[1].Embeds[0].Fields[2].Value)
+
+	// Restarted, or another instance, the state was already notified
+	err = NewDiscordHealthTracker(context.Background()).SendCircuitNotificationIfChanged(breaker, &shared.CircuitStatus{Backend: shared.BackendScWorker, State: shared.CircuitHalfOpen})
+	assert.Nil(t, err)
+	assert.Len(t, sent, 2)
+
+	// Open on the first check is
+	err = tracker.SendCircuitNotificationIfChanged(breaker, &shared.CircuitStatus{Backend: shared.BackendRunpodServerless, State: shared.CircuitOpen})
+	assert.Nil(t, err)
+	assert.Len(t, sent, 3)
+	assert.Equal(t, "```⚪️ Unknown -> 🔴 Open```", sent[2].Embeds[0].Fields[1].Value)
+}
@@ -0,0 +1,27 @@
+package jobs
+
+import (
+	"time"
+
+	"github.com/stablecog/sc-go/shared"
+)
+
+// Deactivate rotated tokens whose grace period is over and prune old usage entries
+func (j *JobRunner) MaintainApiTokens(log Logger) error {
+	deactivated, err := j.Repo.DeactivateRotatedTokens()
+	if err != nil {
+		log.Errorf("Error deactivating rotated api tokens %v", err)
+		return err
+	}
+	log.Count("tokens_deactivated", deactivated)
+
+	pruned, err := j.Repo.PruneApiTokenUsage(time.Now().Add(-shared.API_TOKEN_USAGE_RETENTION))
+	if err != nil {
+		log.Errorf("Error pruning api token usage %v", err)
+		return err
+	}
+	log.Count("usage_pruned", pruned)
+
+	log.Infof("Deactivated %d rotated api tokens, pruned %d usage entries", deactivated, pruned)
+	return nil
+}
@@ -1,6 +1,7 @@
 package jobs
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"os/signal"
@@ -12,7 +13,53 @@ import (
 	"github.com/stablecog/sc-go/shared"
 )
 
+// Lease held by the cron instance running auto upscale
+const autoUpscaleLeaseName = "cron_job:AUTO_UPSCALE"
+
+// Runs auto upscale on one cron instance at a time, the others wait to take over its lease
 func (j *JobRunner) StartAutoUpscaleJob(log Logger) {
+	ctx, stop := signal.NotifyContext(j.Ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	for ctx.Err() == nil {
+		// Acquired with the runner's context so it can still be released when shutting down
+		lease, err := shared.AcquireLease(j.Ctx, j.Redis.Client, autoUpscaleLeaseName, shared.CRON_JOB_LEASE_TTL)
+		if err != nil {
+			log.Errorf("Error claiming auto upscale lease %v", err)
+		}
+		if lease == nil {
+			select {
+			case <-ctx.Done():
+			case <-time.After(shared.AUTO_UPSCALE_RETRY_DURATION):
+			}
+			continue
+		}
+		j.runAutoUpscale(ctx, lease, log)
+	}
+	log.Infof("Shutting down auto upscale job...")
+}
+
+// Auto upscale while holding lease, stops if it's lost
+func (j *JobRunner) runAutoUpscale(ctx context.Context, lease *shared.Lease, log Logger) {
+	defer func() {
+		if err := lease.Release(); err != nil {
+			log.Errorf("Error releasing auto upscale lease %v", err)
+		}
+	}()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	lost := lease.KeepAlive(ctx)
+	go func() {
+		select {
+		case <-lost:
+			log.Warnf("Lost the auto upscale lease")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	j.withCtx(ctx).autoUpscale(ctx, log)
+}
+
+func (j *JobRunner) autoUpscale(ctx context.Context, log Logger) {
 	log.Infof("Starting auto upscale job...")
 	// Create a SyncMap to track requests
 	sMap := shared.NewSyncMap[chan requests.CogWebhookMessage]()
@@ -40,12 +87,9 @@ func (j *JobRunner) StartAutoUpscaleJob(log Logger) {
 		}
 	}()
 
-	signalChannel := make(chan os.Signal, 2)
-	signal.Notify(signalChannel, os.Interrupt, syscall.SIGTERM)
 	for {
 		select {
-		case <-signalChannel:
-			log.Infof("Shutting down free credit job...")
+		case <-ctx.Done():
 			return
 		default:
 			// Get unscaled outputs
@@ -62,6 +106,9 @@ func (j *JobRunner) StartAutoUpscaleJob(log Logger) {
 				continue
 			}
 			for _, output := range unscaledOutputs {
+				if ctx.Err() != nil {
+					return
+				}
 				// Check if refresh is needed
 				if time.Now().Sub(refreshedAt) > 5*time.Minute {
 					// Refresh
@@ -0,0 +1,150 @@
+package jobs
+
+import (
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/shared"
+)
+
+// Settle credits held for jobs that should be done by now
+// Jobs still queued or started have timed out, they're failed and their credits released.
+// Holds left over from finished jobs are captured or released based on how the job ended.
+func (j *JobRunner) ReconcileCreditHolds(log Logger) error {
+	log.Infof("Starting credit hold reconciliation job...")
+	released := 0
+	failedGens := 0
+	failedUpscales := 0
+	failedVoiceovers := 0
+
+	gens, err := j.Repo.GetGenerationsQueuedOrStarted()
+	if err != nil {
+		log.Errorf("Error getting queued/started generations %v", err)
+		return err
+	}
+	for _, gen := range gens {
+		var jobReleased bool
+		if err := j.Repo.WithTx(func(tx *ent.Tx) error {
+			db := tx.Client()
+			if err := j.Repo.SetGenerationFailed(gen.ID.String(), shared.TIMEOUT_ERROR, 0, db); err != nil {
+				return err
+			}
+			var err error
+			jobReleased, err = j.Repo.ReleaseCreditHold(gen.ID, gen.UserID, gen.NumOutputs, shared.TIMEOUT_ERROR, db)
+			return err
+		}); err != nil {
+			log.Errorf("Error timing out generation %s %s %v", gen.UserID.String(), gen.ID.String(), err)
+			continue
+		}
+		if jobReleased {
+			released += int(gen.NumOutputs)
+		}
+		failedGens++
+	}
+
+	upscales, err := j.Repo.GetUpscalesQueuedOrStarted()
+	if err != nil {
+		log.Errorf("Error getting queued/started upscales %v", err)
+		return err
+	}
+	for _, us := range upscales {
+		var jobReleased bool
+		if err := j.Repo.WithTx(func(tx *ent.Tx) error {
+			db := tx.Client()
+			if err := j.Repo.SetUpscaleFailed(us.ID.String(), shared.TIMEOUT_ERROR, db); err != nil {
+				return err
+			}
+			// Upscales from before holds are always 1 credit
+			var err error
+			jobReleased, err = j.Repo.ReleaseCreditHold(us.ID, us.UserID, 1, shared.TIMEOUT_ERROR, db)
+			return err
+		}); err != nil {
+			log.Errorf("Error timing out upscale %s %s %v", us.UserID.String(), us.ID.String(), err)
+			continue
+		}
+		if jobReleased {
+			released += 1
+		}
+		failedUpscales++
+	}
+
+	voiceovers, err := j.Repo.GetVoiceoversQueuedOrStarted()
+	if err != nil {
+		log.Errorf("Error getting queued/started voiceovers %v", err)
+		return err
+	}
+	for _, vo := range voiceovers {
+		var jobReleased bool
+		if err := j.Repo.WithTx(func(tx *ent.Tx) error {
+			db := tx.Client()
+			if err := j.Repo.SetVoiceoverFailed(vo.ID.String(), shared.TIMEOUT_ERROR, db); err != nil {
+				return err
+			}
+			var err error
+			jobReleased, err = j.Repo.ReleaseCreditHold(vo.ID, vo.UserID, vo.Cost, shared.TIMEOUT_ERROR, db)
+			return err
+		}); err != nil {
+			log.Errorf("Error timing out voiceover %s %s %v", vo.UserID.String(), vo.ID.String(), err)
+			continue
+		}
+		if jobReleased {
+			released += int(vo.Cost)
+		}
+		failedVoiceovers++
+	}
+
+	holds, err := j.Repo.GetExpiredCreditHolds()
+	if err != nil {
+		log.Errorf("Error getting expired credit holds %v", err)
+		return err
+	}
+	captured := 0
+	for _, hold := range holds {
+		status, failureReason, err := j.Repo.GetCreditHoldJobStatus(hold)
+		if err != nil && !ent.IsNotFound(err) {
+			log.Errorf("Error getting job of credit hold %s %v", hold.ID.String(), err)
+			continue
+		}
+
+		capture := false
+		reason := "job_not_found"
+		switch {
+		case ent.IsNotFound(err):
+		case status == string(generation.StatusSucceeded):
+			capture = true
+		case status == string(generation.StatusFailed):
+			// NSFW outputs are charged
+			capture = failureReason != nil && *failureReason == shared.NSFW_ERROR
+			reason = "failed"
+			if failureReason != nil {
+				reason = *failureReason
+			}
+		default:
+			// Still running, it's failed on a later run once it's stale
+			continue
+		}
+
+		if capture {
+			_, err = j.Repo.CaptureCreditHold(*hold.JobID, nil)
+		} else {
+			_, err = j.Repo.ReleaseHold(hold, reason, nil)
+		}
+		if err != nil {
+			log.Errorf("Error settling credit hold %s %v", hold.ID.String(), err)
+			continue
+		}
+		if capture {
+			captured += int(hold.Amount)
+		} else {
+			released += int(hold.Amount)
+		}
+	}
+
+	log.Count("generations_timed_out", failedGens)
+	log.Count("upscales_timed_out", failedUpscales)
+	log.Count("voiceovers_timed_out", failedVoiceovers)
+	log.Count("credits_released", released)
+	log.Count("credits_captured", captured)
+	log.Infof("Timed out %d generations, %d upscales, %d voiceovers. Released %d credits, captured %d credits of %d expired holds", failedGens, failedUpscales, failedVoiceovers, released, captured, len(holds))
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package jobs
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/upscale"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var MockJobRunner *JobRunner
+
+func TestMain(m *testing.M) {
+	os.Exit(testMainWrapper(m))
+}
+
+func testMainWrapper(m *testing.M) int {
+	ctx := context.Background()
+	dbconn, err := database.GetSqlDbConn(true)
+	if err != nil {
+		log.Fatal("Failed to connect to database", "err", err)
+		os.Exit(1)
+	}
+	entClient, err := database.NewEntClient(dbconn)
+	if err != nil {
+		log.Fatal("Failed to create ent client", "err", err)
+		os.Exit(1)
+	}
+	defer entClient.Close()
+
+	origMockRedis := utils.GetEnv().MockRedis
+	utils.GetEnv().MockRedis = true
+	defer func() {
+		utils.GetEnv().MockRedis = origMockRedis
+	}()
+	redis, err := database.NewRedis(ctx)
+	if err != nil {
+		log.Fatal("Error connecting to redis", "err", err)
+		os.Exit(1)
+	}
+
+	if err := entClient.Schema.Create(ctx); err != nil {
+		log.Fatal("Failed to run migrations", "err", err)
+		os.Exit(1)
+	}
+
+	repo := &repository.Repository{
+		DB:       entClient,
+		ConnInfo: dbconn,
+		Redis:    redis,
+		Ctx:      ctx,
+	}
+	if err = repo.CreateMockData(ctx); err != nil {
+		log.Fatal("Failed to create mock data", "err", err)
+		os.Exit(1)
+	}
+
+	MockJobRunner = &JobRunner{
+		Repo:  repo,
+		Redis: redis,
+		Ctx:   ctx,
+	}
+
+	return m.Run()
+}
+
+func TestReconcileCreditHoldsSkipsRequeuedJobs(t *testing.T) {
+	repo := MockJobRunner.Repo
+	userID := uuid.MustParse(repository.MOCK_ADMIN_UUID)
+
+	// A failed upscale from an hour ago, replayed from the dead letter queue
+	mock, err := repo.CreateMockUpscaleForDeletion(repo.Ctx)
+	assert.Nil(t, err)
+	u, err := repo.DB.Upscale.Create().
+		SetWidth(512).
+		SetHeight(512).
+		SetScale(4).
+		SetStatus(upscale.StatusFailed).
+		SetUserID(userID).
+		SetDeviceInfoID(mock.DeviceInfoID).
+		SetModelID(mock.ModelID).
+		SetCreatedAt(time.Now().Add(-1 * time.Hour)).
+		Save(repo.Ctx)
+	assert.Nil(t, err)
+	_, err = repo.RequeueFailedJob(shared.UPSCALE, u.ID, nil)
+	assert.Nil(t, err)
+	hold, err := repo.HoldCredits(userID, 1, credithold.ProcessTypeUpscale, nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, hold)
+	assert.Nil(t, repo.AttachCreditHold(hold.ID, u.ID, nil))
+
+	// Just requeued, it's left for a worker to pick up
+	assert.Nil(t, MockJobRunner.ReconcileCreditHolds(NewJobLogger("test")))
+	u, err = repo.GetUpscale(u.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, upscale.StatusQueued, u.Status)
+	hold, err = repo.DB.CreditHold.Get(repo.Ctx, hold.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, credithold.StatusHeld, hold.Status)
+
+	// Stale once it's been requeued for longer than the timeout
+	repo.DB.Upscale.UpdateOneID(u.ID).SetRequeuedAt(time.Now().Add(-10 * time.Minute)).ExecX(repo.Ctx)
+	assert.Nil(t, MockJobRunner.ReconcileCreditHolds(NewJobLogger("test")))
+	u, err = repo.GetUpscale(u.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, upscale.StatusFailed, u.Status)
+	assert.Equal(t, shared.TIMEOUT_ERROR, *u.FailureReason)
+	hold, err = repo.DB.CreditHold.Get(repo.Ctx, hold.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, credithold.StatusReleased, hold.Status)
+
+	repo.DB.Upscale.DeleteOneID(u.ID).ExecX(repo.Ctx)
+	repo.DB.Upscale.DeleteOneID(mock.ID).ExecX(repo.Ctx)
+}
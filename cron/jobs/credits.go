@@ -14,6 +14,7 @@ func (j *JobRunner) AddFreeCreditsToEligibleUsers(log Logger) error {
 		log.Errorf("Error replenishing free credits to eligible users %v", err)
 		return err
 	}
+	log.Count("users", count)
 
 	if count == 0 {
 		log.Infof("No users eligible for free credits")
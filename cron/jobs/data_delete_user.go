@@ -59,6 +59,10 @@ func (j *JobRunner) DeleteUserData(log Logger, dryRun bool) error {
 	grandTotalPrompts := 0
 	grandTotalNegativePrompts := 0
 	for _, u := range users {
+		// Lease lost, leave the rest to whoever has it now
+		if err := j.Ctx.Err(); err != nil {
+			return err
+		}
 		log.Infof("Deleting user data %s", u.ID)
 		outputs, err := j.Repo.GetUserGenerationOutputs(u.ID)
 		if err != nil {
@@ -382,6 +386,12 @@ func (j *JobRunner) DeleteUserData(log Logger, dryRun bool) error {
 
 	j.SendUserCleanNotification(log, true, len(usersBanned), len(usersNotBanned), "")
 
+	if !dryRun {
+		log.Count("banned_users_deleted", len(usersBanned))
+		log.Count("users_deleted", len(usersNotBanned))
+		log.Count("outputs_deleted", grandTotalOutputs)
+	}
+
 	log.Infof("Total outputs %d", grandTotalOutputs)
 	log.Infof("Total generations %d", grandTotalGenerations)
 	log.Infof("Total prompts %d", grandTotalPrompts)
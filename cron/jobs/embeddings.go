@@ -33,6 +33,10 @@ func (j *JobRunner) HandleOutputsWithNoEmbedding(log Logger) error {
 	log.Infof("Getting embeddings for outputs...")
 
 	for _, output := range outputs {
+		// Lease lost, leave the rest to whoever has it now
+		if err := j.Ctx.Err(); err != nil {
+			return err
+		}
 		tOutput := time.Now()
 		embeddingRes, err := j.CLIP.GetEmbeddings([]clip.EmbeddingReqObject{
 			{
@@ -148,6 +152,7 @@ func (j *JobRunner) HandleOutputsWithNoEmbedding(log Logger) error {
 			log.Errorf("Error starting transaction in HandleOutputsWithNoEmbedding: %s | Error: %v", output.ID.String(), err)
 			continue
 		}
+		log.Count("outputs_embedded", 1)
 	}
 
 	e := time.Since(s)
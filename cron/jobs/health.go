@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/shared"
 	"github.com/stablecog/sc-go/utils"
 )
@@ -52,14 +53,35 @@ func (j *JobRunner) CheckSCWorkerHealth(log Logger) error {
 		lastSuccessfulGenerationTime = successfulGenerations[0].CreatedAt
 	}
 
+	// An open circuit is half-open once it has been open long enough
+	breaker := shared.NewCircuitBreaker(j.Redis.Ctx, j.Redis.Client, utils.GetEnv().GetCircuitBreakerConfig())
+	primaryCircuit, err := breaker.Status(shared.PRIMARY_BACKEND)
+	if err != nil {
+		log.Errorf("Couldn't get %s circuit %v", shared.PRIMARY_BACKEND, err)
+		return err
+	}
+
 	// Last successful generation is too old, do a test generation
+	// While half-open the router sends it to sc-worker as a probe, the circuit closes once enough of them succeed
 	var durationMinutes float64 = 3
-	if time.Now().Sub(lastSuccessfulGenerationTime).Minutes() > durationMinutes {
-		log.Infof(fmt.Sprintf("%d minutes since last successful generation.", int(durationMinutes)))
+	if time.Now().Sub(lastSuccessfulGenerationTime).Minutes() > durationMinutes || primaryCircuit.State == shared.CircuitHalfOpen {
+		log.Infof(fmt.Sprintf("%d minutes since last successful generation or %s circuit is half-open.", int(durationMinutes), shared.PRIMARY_BACKEND))
 		err := CreateTestGeneration(log, apiKey)
 		if err != nil {
 			log.Infof("SC Worker test generation failed -> Assuming unhealthy")
 			workerHealthStatus = shared.UNHEALTHY
+			// Fail over every model on runpod until probes succeed
+			if primaryCircuit.State == shared.CircuitClosed {
+				_, err := breaker.Trip(shared.PRIMARY_BACKEND)
+				if err != nil {
+					log.Errorf("🏃‍♂️‍➡️📦 🔴 Couldn't open %s circuit: %v", shared.PRIMARY_BACKEND, err)
+				} else {
+					log.Infof("🏃‍♂️‍➡️📦 🟢 Opened %s circuit", shared.PRIMARY_BACKEND)
+				}
+			}
+		}
+		if primaryCircuit.State == shared.CircuitHalfOpen {
+			j.recordProbe(log, breaker, primaryCircuit, err == nil)
 		}
 	}
 
@@ -80,21 +102,27 @@ func (j *JobRunner) CheckSCWorkerHealth(log Logger) error {
 		log.Errorf("🏃‍♂️‍➡️📦 🔴 Couldn't check if Runpod serverless is active: %v", runpodServerlessErr)
 	}
 
-	if isRunpodServerlessActive {
-		log.Infof("🏃‍♂️‍➡️📦 🟢 Runpod serverless is active")
-	}
-
-	if workerHealthStatus != shared.HEALTHY && !isRunpodServerlessActive {
-		err := j.Repo.EnableRunpodServerless()
+	for _, backend := range shared.BACKENDS {
+		circuit, err := breaker.Status(backend)
 		if err != nil {
-			log.Errorf("🏃‍♂️‍➡️📦 🔴 Couldn't activate Runpod serverless: %v", err)
-			runpodServerlessErr = err
-		} else {
-			log.Infof("🏃‍♂️‍➡️📦 🟢 Activated Runpod serverless")
+			log.Errorf("Couldn't get %s circuit %v", backend, err)
+			continue
+		}
+		if backend == shared.PRIMARY_BACKEND && circuit.State != shared.CircuitClosed {
 			isRunpodServerlessActive = true
 		}
+		if err := j.Discord.SendCircuitNotificationIfChanged(breaker, circuit); err != nil {
+			log.Errorf("Couldn't send %s circuit notification %v", backend, err)
+		}
+	}
+
+	if isRunpodServerlessActive {
+		log.Infof("🏃‍♂️‍➡️📦 🟢 Runpod serverless is active")
 	}
 
+	// Failover is per model, jobs move off a backend as its jobs fail and come back when probes succeed
+	j.logBackendHealth(log)
+
 	return j.Discord.SendDiscordNotificationIfNeeded(
 		workerHealthStatus,
 		generations,
@@ -105,6 +133,60 @@ func (j *JobRunner) CheckSCWorkerHealth(log Logger) error {
 	)
 }
 
+// Record the test generation as a probe of the half-open primary backend
+// Skipped if its result already reached the circuit, i.e. it ran on the primary backend as a probe
+func (j *JobRunner) recordProbe(log Logger, breaker *shared.CircuitBreaker, before *shared.CircuitStatus, success bool) {
+	after, err := breaker.Status(shared.PRIMARY_BACKEND)
+	if err != nil {
+		log.Errorf("Couldn't get %s circuit %v", shared.PRIMARY_BACKEND, err)
+		return
+	}
+	if after.State != shared.CircuitHalfOpen || after.Successes != before.Successes {
+		return
+	}
+	status, err := breaker.Record(shared.PRIMARY_BACKEND, success)
+	if err != nil {
+		log.Errorf("🏃‍♂️‍➡️📦 🔴 Couldn't record %s probe: %v", shared.PRIMARY_BACKEND, err)
+		return
+	}
+	log.Infof("🏃‍♂️‍➡️📦 Recorded %s probe, circuit is %s", shared.PRIMARY_BACKEND, status.State)
+}
+
+// Log the backends models are being routed away from
+func (j *JobRunner) logBackendHealth(log Logger) {
+	tracker := shared.NewBackendHealthTracker(j.Redis.Ctx, j.Redis.Client)
+	modelIDs := make(map[uuid.UUID]string)
+	generationModels, err := j.Repo.GetAllGenerationModels()
+	if err != nil {
+		log.Errorf("Couldn't get generation models %v", err)
+		return
+	}
+	for _, model := range generationModels {
+		modelIDs[model.ID] = model.NameInWorker
+	}
+	upscaleModels, err := j.Repo.GetAllUpscaleModels()
+	if err != nil {
+		log.Errorf("Couldn't get upscale models %v", err)
+		return
+	}
+	for _, model := range upscaleModels {
+		modelIDs[model.ID] = model.NameInWorker
+	}
+
+	for id, name := range modelIDs {
+		for _, backend := range shared.BACKENDS {
+			health, err := tracker.Get(id, backend)
+			if err != nil {
+				log.Errorf("Couldn't get %s health for %s %v", backend, name, err)
+				continue
+			}
+			if !health.Healthy() {
+				log.Infof("🔴 %s is unhealthy for %s, score %.2f over %d jobs", backend, name, health.Score, health.Samples)
+			}
+		}
+	}
+}
+
 type RequestBody struct {
 	Prompt     string `json:"prompt"`
 	Width      int    `json:"width"`
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hibiken/asynq"
@@ -36,14 +37,19 @@ type JobRunner struct {
 }
 
 // Just wrap logger so we can include the job name without repeating it
+// Jobs also report what they did through it, kept in the run history
 type Logger interface {
 	Infof(s string, args ...any)
 	Errorf(s string, args ...any)
 	Warnf(s string, args ...any)
+	// Add n to a count of the current run, e.g. users credited
+	Count(name string, n int)
 }
 
 type JobLogger struct {
 	JobName string
+	mu      sync.Mutex
+	counts  map[string]int
 }
 
 func (j *JobLogger) Infof(s string, args ...any) {
@@ -58,6 +64,25 @@ func (j *JobLogger) Warnf(s string, args ...any) {
 	log.Warn(fmt.Sprintf("%s -- %v", j.JobName, fmt.Sprintf(s, args...)))
 }
 
+func (j *JobLogger) Count(name string, n int) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.counts == nil {
+		j.counts = make(map[string]int)
+	}
+	j.counts[name] += n
+}
+
+func (j *JobLogger) Counts() map[string]int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	counts := make(map[string]int, len(j.counts))
+	for name, n := range j.counts {
+		counts[name] = n
+	}
+	return counts
+}
+
 func NewJobLogger(jobName string) *JobLogger {
 	return &JobLogger{JobName: jobName}
 }
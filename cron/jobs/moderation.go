@@ -0,0 +1,21 @@
+package jobs
+
+import (
+	"time"
+
+	"github.com/stablecog/sc-go/shared"
+)
+
+// Prune moderation verdicts past their retention
+func (j *JobRunner) PruneModerationVerdicts(log Logger) error {
+	now := time.Now()
+	pruned, err := j.Repo.PruneModerationVerdicts(now.Add(-shared.MODERATION_ALLOWED_VERDICT_RETENTION), now.Add(-shared.MODERATION_VERDICT_RETENTION))
+	if err != nil {
+		log.Errorf("Error pruning moderation verdicts %v", err)
+		return err
+	}
+	log.Count("verdicts_pruned", pruned)
+
+	log.Infof("Pruned %d moderation verdicts", pruned)
+	return nil
+}
@@ -22,6 +22,15 @@ func (j *JobRunner) PruneOldQueueItems(log Logger) error {
 		log.Errorf("Couldn't delete old queue items from mq_log %v", err)
 		return err
 	}
+	log.Count("mq_log_deleted", deletedPg)
+
+	// Cancelled messages have been skipped by then
+	deletedCancelled, err := j.Repo.PruneCancelledQueueLog(time.Now().Add(-PRUNE_OLDER_THAN))
+	if err != nil {
+		log.Errorf("Couldn't delete cancelled queue items from mq_log %v", err)
+		return err
+	}
+	log.Count("mq_log_cancelled_deleted", deletedCancelled)
 
 	generations, upscales, err := j.Redis.GetPendingGenerationAndUpscaleIDs(PRUNE_OLDER_THAN)
 	if err != nil {
@@ -50,6 +59,7 @@ func (j *JobRunner) PruneOldQueueItems(log Logger) error {
 		log.Errorf("Couldn't delete old queue items %v", err)
 		return err
 	}
+	log.Count("queue_items_deleted", int(deleted))
 
 	log.Infof("Deleted %d old queue items", deleted)
 	log.Infof("Deleted %d old queue items from mq_log", deletedPg)
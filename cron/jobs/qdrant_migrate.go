@@ -0,0 +1,353 @@
+package jobs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/qdrant"
+	"github.com/stablecog/sc-go/server/clip"
+	"github.com/stablecog/sc-go/utils"
+)
+
+const (
+	QDRANT_MIGRATION_STAGE_BACKFILL = "backfill"
+	QDRANT_MIGRATION_STAGE_SWITCHED = "switched"
+	QDRANT_MIGRATION_STAGE_DONE     = "done"
+)
+
+type QdrantMigrationOptions struct {
+	// New collection to load
+	Collection string
+	// Alias the app uses as QDRANT_COLLECTION_NAME, switched to the new collection when it's loaded
+	Alias string
+	// Size of the image vectors, for a new CLIP model
+	VectorSize uint64
+	BatchSize  int
+	// Embed the images again through CLIP instead of copying vectors from the current collection
+	Reembed bool
+	// CLIP API to embed with, defaults to CLIP_API_URL
+	ClipUrl string
+	// The app reads the alias, catch up once more and finish
+	// Until then it may still write to the old collection, so every run catches up again
+	Finish bool
+}
+
+// Loads a new qdrant collection from postgres and switches the alias over to it when the counts match
+// Progress is kept in redis, running it again with the same collection resumes where it stopped
+// Outputs created or changed while loading are loaded again after the switch, and on every run after it until Finish
+// The old collection is kept for rollback
+func (j *JobRunner) MigrateQdrantCollection(log Logger, opts QdrantMigrationOptions) error {
+	s := time.Now()
+	if opts.Collection == "" || opts.Alias == "" || opts.Collection == opts.Alias {
+		return fmt.Errorf("collection and alias must be set and differ")
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+
+	state, err := j.Redis.GetQdrantMigrationState(opts.Collection)
+	if err != nil {
+		log.Errorf("Error getting migration state: %v", err)
+		return err
+	}
+	if state == nil {
+		state = &database.QdrantMigrationState{
+			Collection: opts.Collection,
+			Alias:      opts.Alias,
+			Stage:      QDRANT_MIGRATION_STAGE_BACKFILL,
+			StartedAt:  time.Now(),
+		}
+	} else if state.Alias != opts.Alias {
+		return fmt.Errorf("collection %s is being migrated to alias %s", opts.Collection, state.Alias)
+	}
+	if state.Stage == QDRANT_MIGRATION_STAGE_DONE {
+		log.Infof("Migration to %s already done", opts.Collection)
+		return nil
+	}
+	log.Infof("Migrating to %s, stage %s, %d loaded so far", opts.Collection, state.Stage, state.Upserted)
+
+	// The alias can't replace a collection with the same name
+	collections, err := j.Qdrant.GetCollections(false)
+	if err != nil {
+		return err
+	}
+	targetExists := false
+	for _, collection := range collections.Collections {
+		if collection.Name == opts.Alias {
+			return fmt.Errorf("%s is a collection, not an alias, pick another alias and point QDRANT_COLLECTION_NAME at it after the switch", opts.Alias)
+		}
+		if collection.Name == opts.Collection {
+			targetExists = true
+		}
+	}
+	if state.Source == "" {
+		aliases, err := j.Qdrant.GetAliases(false)
+		if err != nil {
+			return err
+		}
+		state.Source = aliases[opts.Alias]
+		if state.Source == "" {
+			// First migration, copy from what the app uses today
+			state.Source = j.Qdrant.CollectionName
+		}
+	}
+	if !opts.Reembed && state.Source == opts.Collection {
+		return fmt.Errorf("alias %s already points at %s, nothing to copy vectors from", opts.Alias, opts.Collection)
+	}
+
+	target := j.Qdrant.WithCollection(opts.Collection)
+	if !targetExists {
+		log.Infof("Creating collection %s with vector size %d", opts.Collection, opts.VectorSize)
+		if err := target.CreateCollection(opts.VectorSize, false); err != nil {
+			return err
+		}
+	}
+	if err := target.CreateAllIndexes(); err != nil {
+		log.Errorf("Error creating indexes: %v", err)
+		return err
+	}
+
+	clipSvc := j.CLIP
+	if opts.ClipUrl != "" {
+		clipSvc = clipSvc.WithURL(opts.ClipUrl)
+	}
+	source := j.Qdrant.WithCollection(state.Source)
+
+	if state.Stage == QDRANT_MIGRATION_STAGE_BACKFILL {
+		if err := j.loadQdrantCollection(log, state, target, source, clipSvc, opts, nil); err != nil {
+			return err
+		}
+
+		// Verify before anyone searches it
+		expected, err := j.Repo.CountOutputsForQdrantBackfill()
+		if err != nil {
+			return err
+		}
+		count, err := target.CountExact(false)
+		if err != nil {
+			return err
+		}
+		log.Infof("%s has %d points, %d outputs in postgres, %d skipped", opts.Collection, count, expected, state.Skipped)
+		if int(count)+state.Skipped < expected {
+			return fmt.Errorf("count mismatch, %d points and %d skipped for %d outputs, run again to load the rest", count, state.Skipped, expected)
+		}
+
+		if err := j.Qdrant.SwitchAlias(opts.Alias, opts.Collection, false); err != nil {
+			log.Errorf("Error switching alias: %v", err)
+			return err
+		}
+		log.Infof("Switched alias %s from %s to %s", opts.Alias, state.Source, opts.Collection)
+		state.Stage = QDRANT_MIGRATION_STAGE_SWITCHED
+		state.CursorCreatedAt = nil
+		state.CursorID = nil
+		if err := j.Redis.SetQdrantMigrationState(state); err != nil {
+			return err
+		}
+	}
+
+	// Catch up on what changed since the last catch-up, or while loading
+	if state.Stage == QDRANT_MIGRATION_STAGE_SWITCHED {
+		if state.CatchUpStartedAt == nil {
+			now := time.Now()
+			state.CatchUpStartedAt = &now
+			if err := j.Redis.SetQdrantMigrationState(state); err != nil {
+				return err
+			}
+		}
+		since := state.StartedAt
+		if state.CaughtUpSince != nil {
+			since = *state.CaughtUpSince
+		}
+		if err := j.loadQdrantCollection(log, state, target, source, clipSvc, opts, &since); err != nil {
+			return err
+		}
+		state.CaughtUpSince = state.CatchUpStartedAt
+		state.CatchUpStartedAt = nil
+		state.CursorCreatedAt = nil
+		state.CursorID = nil
+		if opts.Finish {
+			state.Stage = QDRANT_MIGRATION_STAGE_DONE
+		}
+		if err := j.Redis.SetQdrantMigrationState(state); err != nil {
+			return err
+		}
+		if !opts.Finish {
+			log.Infof("Caught up on %s, run again with -qdrant-finish once the app reads alias %s", opts.Collection, opts.Alias)
+			return nil
+		}
+	}
+
+	log.Infof("✅ Migrated to %s | %d loaded | %d skipped | %s", opts.Collection, state.Upserted, state.Skipped, time.Since(s))
+	return nil
+}
+
+// Loads outputs after the state cursor in batches, saving the cursor after each one
+// When catching up since updatedSince, vectors already in the target are kept and only missing ones are copied
+func (j *JobRunner) loadQdrantCollection(log Logger, state *database.QdrantMigrationState, target *qdrant.QdrantClient, source *qdrant.QdrantClient, clipSvc *clip.ClipService, opts QdrantMigrationOptions, updatedSince *time.Time) error {
+	for {
+		outputs, err := j.Repo.GetOutputsForQdrantBackfill(state.CursorCreatedAt, state.CursorID, updatedSince, opts.BatchSize)
+		if err != nil {
+			log.Errorf("Error getting outputs: %v", err)
+			return err
+		}
+		if len(outputs) == 0 {
+			return nil
+		}
+
+		embeddings := make(map[uuid.UUID][]float32, len(outputs))
+		missing := outputs
+		if updatedSince != nil {
+			// Points already in the target are newer than the source's, outputs created after the switch are only there
+			embeddings, err = getQdrantVectors(target, outputs)
+			if err != nil {
+				log.Errorf("Error getting vectors from %s: %v", target.CollectionName, err)
+				return err
+			}
+			missing = nil
+			for _, output := range outputs {
+				if _, ok := embeddings[output.ID]; !ok {
+					missing = append(missing, output)
+				}
+			}
+		}
+		if len(missing) > 0 {
+			var loaded map[uuid.UUID][]float32
+			if opts.Reembed {
+				loaded = j.embedOutputs(log, clipSvc, missing)
+			} else {
+				loaded, err = getQdrantVectors(source, missing)
+				if err != nil {
+					log.Errorf("Error getting vectors from %s: %v", source.CollectionName, err)
+					return err
+				}
+			}
+			for id, embedding := range loaded {
+				embeddings[id] = embedding
+			}
+		}
+
+		var payloads []map[string]interface{}
+		for _, output := range outputs {
+			embedding, ok := embeddings[output.ID]
+			if !ok || output.Edges.Generations == nil || output.Edges.Generations.Edges.Prompt == nil {
+				log.Warnf("Skipping %s, no embedding or generation", output.ID)
+				state.Skipped++
+				continue
+			}
+			payload := qdrantPayloadForOutput(output)
+			payload["id"] = output.ID.String()
+			payload["embedding"] = embedding
+			payloads = append(payloads, payload)
+		}
+		if len(payloads) > 0 {
+			if err := target.BatchUpsert(payloads, false); err != nil {
+				log.Errorf("Error upserting batch: %v", err)
+				return err
+			}
+		}
+
+		last := outputs[len(outputs)-1]
+		state.CursorCreatedAt = &last.CreatedAt
+		state.CursorID = &last.ID
+		state.Upserted += len(payloads)
+		if err := j.Redis.SetQdrantMigrationState(state); err != nil {
+			log.Errorf("Error saving migration state: %v", err)
+			return err
+		}
+		log.Infof("Loaded %d, cursor %s", state.Upserted, last.CreatedAt.Format(time.RFC3339Nano))
+	}
+}
+
+// Vectors of the outputs in the collection, missing ones are left out
+func getQdrantVectors(source *qdrant.QdrantClient, outputs []*ent.GenerationOutput) (map[uuid.UUID][]float32, error) {
+	ids := make([]uuid.UUID, len(outputs))
+	for i, output := range outputs {
+		ids[i] = output.ID
+	}
+	res, err := source.GetPoints(ids, false)
+	if err != nil {
+		return nil, err
+	}
+	embeddings := make(map[uuid.UUID][]float32, len(res.Result))
+	for _, point := range res.Result {
+		if len(point.Vector.Image) > 0 {
+			embeddings[point.ID] = point.Vector.Image
+		}
+	}
+	return embeddings, nil
+}
+
+// Embeds the output images, one at a time if the batch fails so one bad image doesn't hold up the rest
+func (j *JobRunner) embedOutputs(log Logger, clipSvc *clip.ClipService, outputs []*ent.GenerationOutput) map[uuid.UUID][]float32 {
+	embeddings := make(map[uuid.UUID][]float32, len(outputs))
+	req := make([]clip.EmbeddingReqObject, len(outputs))
+	for i, output := range outputs {
+		req[i] = clip.EmbeddingReqObject{Image: utils.GetEnv().GetURLFromImagePath(output.ImagePath)}
+	}
+	res, err := clipSvc.GetEmbeddings(req)
+	if err == nil {
+		for i, r := range res {
+			embeddings[outputs[i].ID] = r.Embedding
+		}
+		return embeddings
+	}
+
+	log.Warnf("Error embedding batch, retrying one by one: %v", err)
+	for i, output := range outputs {
+		res, err := clipSvc.GetEmbeddings(req[i : i+1])
+		if err != nil {
+			log.Warnf("Error embedding %s: %v", output.ID, err)
+			continue
+		}
+		embeddings[output.ID] = res[0].Embedding
+	}
+	return embeddings
+}
+
+// Qdrant payload of an output with its generation, prompt and negative prompt loaded
+func qdrantPayloadForOutput(output *ent.GenerationOutput) map[string]interface{} {
+	generation := output.Edges.Generations
+	promptObj := generation.Edges.Prompt
+	payload := map[string]interface{}{
+		"image_path":               output.ImagePath,
+		"gallery_status":           output.GalleryStatus,
+		"is_favorited":             output.IsFavorited,
+		"created_at":               output.CreatedAt.Unix(),
+		"updated_at":               output.UpdatedAt.Unix(),
+		"is_public":                output.IsPublic,
+		"aesthetic_rating_score":   output.AestheticRatingScore,
+		"aesthetic_artifact_score": output.AestheticArtifactScore,
+		"nsfw_score":               output.NsfwScore,
+		"was_auto_submitted":       generation.WasAutoSubmitted,
+		"guidance_scale":           generation.GuidanceScale,
+		"inference_steps":          generation.InferenceSteps,
+		"prompt_strength":          generation.PromptStrength,
+		"height":                   generation.Height,
+		"width":                    generation.Width,
+		"model":                    generation.ModelID.String(),
+		"scheduler":                generation.SchedulerID.String(),
+		"user_id":                  generation.UserID.String(),
+		"generation_id":            generation.ID.String(),
+		"prompt_id":                promptObj.ID.String(),
+		"prompt":                   promptObj.Text,
+	}
+	if output.DeletedAt != nil {
+		payload["deleted_at"] = output.DeletedAt.Unix()
+	}
+	if output.UpscaledImagePath != nil {
+		payload["upscaled_image_path"] = *output.UpscaledImagePath
+	}
+	if generation.InitImageURL != nil {
+		payload["init_image_url"] = *generation.InitImageURL
+	}
+	if generation.Edges.NegativePrompt != nil && generation.Edges.NegativePrompt.Text != "" {
+		payload["negative_prompt"] = generation.Edges.NegativePrompt.Text
+	}
+	if promptObj.TranslatedText != nil {
+		payload["translated_prompt"] = *promptObj.TranslatedText
+	}
+	return payload
+}
@@ -0,0 +1,359 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/generationoutput"
+	"github.com/stablecog/sc-go/database/qdrant"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeQdrantPoint struct {
+	Payload map[string]interface{}
+	Image   []float32
+}
+
+// Collections, aliases and points of a qdrant served from memory
+type fakeQdrant struct {
+	mu          sync.Mutex
+	collections map[string]map[string]fakeQdrantPoint
+	aliases     map[string]string
+	// Points upserted through the API, by collection and ID
+	upserts map[string]map[string]int
+}
+
+func newFakeQdrant(t *testing.T) (*fakeQdrant, *qdrant.QdrantClient) {
+	f := &fakeQdrant{
+		collections: make(map[string]map[string]fakeQdrantPoint),
+		aliases:     make(map[string]string),
+		upserts:     make(map[string]map[string]int),
+	}
+	server := httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(server.Close)
+	c, doer, err := qdrant.NewClientWithResponses(server.URL)
+	assert.Nil(t, err)
+	return f, &qdrant.QdrantClient{Client: c, Doer: doer, Ctx: context.Background(), CollectionName: "stablecog"}
+}
+
+func (f *fakeQdrant) point(collection string, id uuid.UUID) (fakeQdrantPoint, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	p, ok := f.collections[collection][id.String()]
+	return p, ok
+}
+
+func (f *fakeQdrant) setPoint(collection string, id uuid.UUID, image []float32) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.collections[collection] == nil {
+		f.collections[collection] = make(map[string]fakeQdrantPoint)
+	}
+	f.collections[collection][id.String()] = fakeQdrantPoint{Payload: map[string]interface{}{}, Image: image}
+}
+
+func (f *fakeQdrant) upserted(collection string, id uuid.UUID) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.upserts[collection][id.String()]
+}
+
+func (f *fakeQdrant) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	ok := func(result string) {
+		fmt.Fprintf(w, `{"result":%s,"status":"ok","time":0}`, result)
+	}
+	updated := `{"operation_id":0,"status":"completed"}`
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/collections":
+		var names []string
+		for name := range f.collections {
+			names = append(names, fmt.Sprintf(`{"name":%q}`, name))
+		}
+		ok(`{"collections":[` + strings.Join(names, ",") + `]}`)
+	case r.Method == http.MethodGet && r.URL.Path == "/aliases":
+		var aliases []string
+		for alias, collection := range f.aliases {
+			aliases = append(aliases, fmt.Sprintf(`{"alias_name":%q,"collection_name":%q}`, alias, collection))
+		}
+		ok(`{"aliases":[` + strings.Join(aliases, ",") + `]}`)
+	case r.Method == http.MethodPost && r.URL.Path == "/collections/aliases":
+		var body struct {
+			Actions []struct {
+				CreateAlias *struct {
+					AliasName      string `json:"alias_name"`
+					CollectionName string `json:"collection_name"`
+				} `json:"create_alias"`
+				DeleteAlias *struct {
+					AliasName string `json:"alias_name"`
+				} `json:"delete_alias"`
+			} `json:"actions"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		for _, action := range body.Actions {
+			if action.DeleteAlias != nil {
+				delete(f.aliases, action.DeleteAlias.AliasName)
+			}
+			if action.CreateAlias != nil {
+				f.aliases[action.CreateAlias.AliasName] = action.CreateAlias.CollectionName
+			}
+		}
+		ok("true")
+	case len(parts) == 2 && r.Method == http.MethodPut:
+		f.collections[parts[1]] = make(map[string]fakeQdrantPoint)
+		ok("true")
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		ok(`{"payload_schema":{}}`)
+	case len(parts) == 3 && parts[2] == "index":
+		ok(updated)
+	case len(parts) == 3 && parts[2] == "points" && r.Method == http.MethodPut:
+		var body struct {
+			Points []struct {
+				ID      string                 `json:"id"`
+				Payload map[string]interface{} `json:"payload"`
+				Vector  struct {
+					Image []float32 `json:"image"`
+				} `json:"vector"`
+			} `json:"points"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		collection := f.resolve(parts[1])
+		if f.upserts[collection] == nil {
+			f.upserts[collection] = make(map[string]int)
+		}
+		for _, p := range body.Points {
+			f.collections[collection][p.ID] = fakeQdrantPoint{Payload: p.Payload, Image: p.Vector.Image}
+			f.upserts[collection][p.ID]++
+		}
+		ok(updated)
+	case len(parts) == 3 && parts[2] == "points" && r.Method == http.MethodPost:
+		var body struct {
+			Ids []string `json:"ids"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		collection := f.collections[f.resolve(parts[1])]
+		var records []qdrant.GetPointResult
+		for _, id := range body.Ids {
+			if p, found := collection[id]; found {
+				records = append(records, qdrant.GetPointResult{ID: uuid.MustParse(id), Payload: p.Payload, Vector: qdrant.GetPointVector{Image: p.Image}})
+			}
+		}
+		b, _ := json.Marshal(records)
+		if records == nil {
+			b = []byte("[]")
+		}
+		ok(string(b))
+	case len(parts) == 4 && parts[3] == "count":
+		ok(fmt.Sprintf(`{"count":%d}`, len(f.collections[f.resolve(parts[1])])))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeQdrant) resolve(name string) string {
+	if collection, ok := f.aliases[name]; ok {
+		return collection
+	}
+	return name
+}
+
+// Outputs of a new generation that should be in qdrant, removed from the backfill when the test ends
+func createQdrantBackfillOutputs(t *testing.T) []*ent.GenerationOutput {
+	repo := MockJobRunner.Repo
+	gen, err := repo.CreateMockGenerationForDeletion(repo.Ctx)
+	assert.Nil(t, err)
+	repo.DB.GenerationOutput.Update().Where(generationoutput.GenerationIDEQ(gen.ID)).SetHasEmbeddings(true).ExecX(repo.Ctx)
+	t.Cleanup(func() {
+		repo.DB.GenerationOutput.Update().Where(generationoutput.GenerationIDEQ(gen.ID)).SetHasEmbeddings(false).ExecX(repo.Ctx)
+	})
+	outputs, err := repo.DB.GenerationOutput.Query().
+		Where(generationoutput.GenerationIDEQ(gen.ID)).
+		Order(ent.Asc(generationoutput.FieldCreatedAt), ent.Asc(generationoutput.FieldID)).
+		All(repo.Ctx)
+	assert.Nil(t, err)
+	assert.Len(t, outputs, 3)
+	return outputs
+}
+
+// Runner whose current collection is stablecog_v1 behind the stablecog alias, with every output that should be in qdrant in it
+func newQdrantMigrationRunner(t *testing.T) (*JobRunner, *fakeQdrant, []*ent.GenerationOutput) {
+	f, q := newFakeQdrant(t)
+	f.collections["stablecog_v1"] = make(map[string]fakeQdrantPoint)
+	f.aliases["stablecog"] = "stablecog_v1"
+	all, err := MockJobRunner.Repo.GetOutputsForQdrantBackfill(nil, nil, nil, 10000)
+	assert.Nil(t, err)
+	for _, output := range all {
+		f.setPoint("stablecog_v1", output.ID, []float32{1})
+	}
+	return &JobRunner{
+		Repo:   MockJobRunner.Repo,
+		Redis:  MockJobRunner.Redis,
+		Ctx:    MockJobRunner.Ctx,
+		Qdrant: q,
+	}, f, all
+}
+
+func TestMigrateQdrantCollectionResumesFromCursor(t *testing.T) {
+	createQdrantBackfillOutputs(t)
+	runner, f, all := newQdrantMigrationRunner(t)
+
+	// Stopped after loading all but the last output
+	f.collections["stablecog_v2"] = make(map[string]fakeQdrantPoint)
+	for _, output := range all[:len(all)-1] {
+		f.setPoint("stablecog_v2", output.ID, []float32{1})
+	}
+	cursor := all[len(all)-2]
+	assert.Nil(t, runner.Redis.SetQdrantMigrationState(&database.QdrantMigrationState{
+		Collection:      "stablecog_v2",
+		Alias:           "stablecog",
+		Source:          "stablecog_v1",
+		Stage:           QDRANT_MIGRATION_STAGE_BACKFILL,
+		StartedAt:       time.Now(),
+		CursorCreatedAt: &cursor.CreatedAt,
+		CursorID:        &cursor.ID,
+		Upserted:        len(all) - 1,
+	}))
+
+	assert.Nil(t, runner.MigrateQdrantCollection(NewJobLogger("test"), QdrantMigrationOptions{Collection: "stablecog_v2", Alias: "stablecog"}))
+	for _, output := range all[:len(all)-1] {
+		assert.Equal(t, 0, f.upserted("stablecog_v2", output.ID))
+	}
+	assert.Equal(t, 1, f.upserted("stablecog_v2", all[len(all)-1].ID))
+	assert.Equal(t, "stablecog_v2", f.aliases["stablecog"])
+
+	// Not done until the app is known to read the alias
+	state, err := runner.Redis.GetQdrantMigrationState("stablecog_v2")
+	assert.Nil(t, err)
+	assert.Equal(t, QDRANT_MIGRATION_STAGE_SWITCHED, state.Stage)
+	assert.Equal(t, len(all), state.Upserted)
+	assert.NotNil(t, state.CaughtUpSince)
+	assert.Nil(t, state.CatchUpStartedAt)
+}
+
+func TestMigrateQdrantCollectionCountMismatch(t *testing.T) {
+	createQdrantBackfillOutputs(t)
+	runner, f, all := newQdrantMigrationRunner(t)
+
+	// Everything was loaded, but the new collection lost its points
+	last := all[len(all)-1]
+	assert.Nil(t, runner.Redis.SetQdrantMigrationState(&database.QdrantMigrationState{
+		Collection:      "stablecog_mismatch",
+		Alias:           "stablecog",
+		Source:          "stablecog_v1",
+		Stage:           QDRANT_MIGRATION_STAGE_BACKFILL,
+		StartedAt:       time.Now(),
+		CursorCreatedAt: &last.CreatedAt,
+		CursorID:        &last.ID,
+		Upserted:        len(all),
+	}))
+
+	err := runner.MigrateQdrantCollection(NewJobLogger("test"), QdrantMigrationOptions{Collection: "stablecog_mismatch", Alias: "stablecog"})
+	assert.ErrorContains(t, err, "count mismatch")
+	assert.Equal(t, "stablecog_v1", f.aliases["stablecog"])
+
+	state, err := runner.Redis.GetQdrantMigrationState("stablecog_mismatch")
+	assert.Nil(t, err)
+	assert.Equal(t, QDRANT_MIGRATION_STAGE_BACKFILL, state.Stage)
+}
+
+func TestMigrateQdrantCollectionCatchUp(t *testing.T) {
+	repo := MockJobRunner.Repo
+	startedAt := time.Now()
+	outputs := createQdrantBackfillOutputs(t)
+	runner, f, _ := newQdrantMigrationRunner(t)
+	changed, created, copied := outputs[0], outputs[1], outputs[2]
+
+	// Switched, the app wrote a new output and a change to the new collection since
+	f.collections["stablecog_catchup"] = make(map[string]fakeQdrantPoint)
+	f.aliases["stablecog"] = "stablecog_catchup"
+	f.setPoint("stablecog_catchup", changed.ID, []float32{2})
+	f.setPoint("stablecog_catchup", created.ID, []float32{2})
+	delete(f.collections["stablecog_v1"], created.ID.String())
+	repo.DB.GenerationOutput.UpdateOneID(changed.ID).SetIsFavorited(true).ExecX(repo.Ctx)
+	assert.Nil(t, runner.Redis.SetQdrantMigrationState(&database.QdrantMigrationState{
+		Collection: "stablecog_catchup",
+		Alias:      "stablecog",
+		Source:     "stablecog_v1",
+		Stage:      QDRANT_MIGRATION_STAGE_SWITCHED,
+		StartedAt:  startedAt,
+	}))
+
+	assert.Nil(t, runner.MigrateQdrantCollection(NewJobLogger("test"), QdrantMigrationOptions{Collection: "stablecog_catchup", Alias: "stablecog", Finish: true}))
+	state, err := runner.Redis.GetQdrantMigrationState("stablecog_catchup")
+	assert.Nil(t, err)
+	assert.Equal(t, QDRANT_MIGRATION_STAGE_DONE, state.Stage)
+	assert.Equal(t, 0, state.Skipped)
+
+	// Newer vectors are kept, payloads are taken from postgres
+	p, ok := f.point("stablecog_catchup", changed.ID)
+	assert.True(t, ok)
+	assert.Equal(t, []float32{2}, p.Image)
+	assert.Equal(t, true, p.Payload["is_favorited"])
+	p, ok = f.point("stablecog_catchup", created.ID)
+	assert.True(t, ok)
+	assert.Equal(t, []float32{2}, p.Image)
+	assert.Equal(t, 1, f.upserted("stablecog_catchup", created.ID))
+	// Missing from the new collection, copied from the old one
+	p, ok = f.point("stablecog_catchup", copied.ID)
+	assert.True(t, ok)
+	assert.Equal(t, []float32{1}, p.Image)
+}
+
+func TestMigrateQdrantCollectionCatchUpUntilFinished(t *testing.T) {
+	repo := MockJobRunner.Repo
+	outputs := createQdrantBackfillOutputs(t)
+	runner, f, _ := newQdrantMigrationRunner(t)
+	caughtUp := time.Now()
+	assert.Nil(t, runner.Redis.SetQdrantMigrationState(&database.QdrantMigrationState{
+		Collection:    "stablecog_repeat",
+		Alias:         "stablecog",
+		Source:        "stablecog_v1",
+		Stage:         QDRANT_MIGRATION_STAGE_SWITCHED,
+		StartedAt:     caughtUp.Add(-time.Hour),
+		CaughtUpSince: &caughtUp,
+	}))
+	f.collections["stablecog_repeat"] = make(map[string]fakeQdrantPoint)
+	f.aliases["stablecog"] = "stablecog_repeat"
+
+	// Nothing changed since the last catch-up
+	opts := QdrantMigrationOptions{Collection: "stablecog_repeat", Alias: "stablecog"}
+	assert.Nil(t, runner.MigrateQdrantCollection(NewJobLogger("test"), opts))
+	for _, output := range outputs {
+		assert.Equal(t, 0, f.upserted("stablecog_repeat", output.ID))
+	}
+
+	// The app hasn't been redeployed, it embedded an output into the old collection
+	time.Sleep(10 * time.Millisecond)
+	f.setPoint("stablecog_v1", outputs[0].ID, []float32{3})
+	repo.DB.GenerationOutput.UpdateOneID(outputs[0].ID).SetIsFavorited(true).ExecX(repo.Ctx)
+	assert.Nil(t, runner.MigrateQdrantCollection(NewJobLogger("test"), opts))
+	p, ok := f.point("stablecog_repeat", outputs[0].ID)
+	assert.True(t, ok)
+	assert.Equal(t, []float32{3}, p.Image)
+	state, err := runner.Redis.GetQdrantMigrationState("stablecog_repeat")
+	assert.Nil(t, err)
+	assert.Equal(t, QDRANT_MIGRATION_STAGE_SWITCHED, state.Stage)
+	assert.True(t, state.CaughtUpSince.After(caughtUp))
+
+	// Redeployed with the alias
+	opts.Finish = true
+	assert.Nil(t, runner.MigrateQdrantCollection(NewJobLogger("test"), opts))
+	state, err = runner.Redis.GetQdrantMigrationState("stablecog_repeat")
+	assert.Nil(t, err)
+	assert.Equal(t, QDRANT_MIGRATION_STAGE_DONE, state.Stage)
+	assert.Equal(t, 1, f.upserted("stablecog_repeat", outputs[0].ID))
+}
@@ -0,0 +1,179 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// A job cron runs every interval, or when an admin triggers it
+type JobDefinition struct {
+	Name     shared.CronJobName
+	Interval time.Duration
+	// Only runs when triggered if false
+	Scheduled bool
+	// Called on a runner whose Ctx, Repo and Redis are cancelled if the job's lease is lost
+	Run func(j *JobRunner, log Logger) error
+}
+
+func (j *JobRunner) JobDefinitions() []JobDefinition {
+	return []JobDefinition{
+		{Name: shared.CronJobStats, Interval: 60 * time.Second, Scheduled: true, Run: (*JobRunner).GetAndSetStats},
+		{Name: shared.CronJobEmbeddings, Interval: 15 * time.Second, Scheduled: true, Run: (*JobRunner).HandleOutputsWithNoEmbedding},
+		{Name: shared.CronJobHealth, Interval: 60 * time.Second, Scheduled: utils.GetEnv().DiscordWebhookUrl != "", Run: (*JobRunner).CheckSCWorkerHealth},
+		{Name: shared.CronJobFreeCredits, Interval: 60 * time.Second, Scheduled: true, Run: (*JobRunner).AddFreeCreditsToEligibleUsers},
+		// Sync stripe
+		{Name: shared.CronJobStripeSync, Interval: 10 * time.Minute, Scheduled: true, Run: (*JobRunner).SyncStripe},
+		// Clean up old redis queue items
+		{Name: shared.CronJobQueueCleanup, Interval: 10 * time.Minute, Scheduled: true, Run: (*JobRunner).PruneOldQueueItems},
+		// Settle credits held for timed out or finished jobs
+		{Name: shared.CronJobCreditHolds, Interval: 10 * time.Minute, Scheduled: true, Run: (*JobRunner).ReconcileCreditHolds},
+		// Expire rotated api tokens and prune their usage
+		{Name: shared.CronJobApiTokens, Interval: 10 * time.Minute, Scheduled: true, Run: (*JobRunner).MaintainApiTokens},
+		// Prune moderation verdicts past their retention
+		{Name: shared.CronJobModeration, Interval: 60 * time.Minute, Scheduled: true, Run: (*JobRunner).PruneModerationVerdicts},
+		// Auto delete users
+		{Name: shared.CronJobDeleteUserData, Interval: 60 * time.Minute, Scheduled: true, Run: func(j *JobRunner, log Logger) error {
+			return j.DeleteUserData(log, false)
+		}},
+	}
+}
+
+func (j *JobRunner) GetJobDefinition(name shared.CronJobName) (JobDefinition, bool) {
+	for _, def := range j.JobDefinitions() {
+		if def.Name == name {
+			return def, true
+		}
+	}
+	return JobDefinition{}, false
+}
+
+func cronInstanceName() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "unknown"
+	}
+	return hostname
+}
+
+// Run a job on the schedule, for gocron
+// Every cron instance schedules every job, the first to claim an interval runs it
+func (j *JobRunner) RunScheduledJob(def JobDefinition) {
+	// Not released, it expires a little before the next run is due
+	slot, err := shared.AcquireLease(j.Ctx, j.Redis.Client, fmt.Sprintf("cron_schedule:%s", def.Name), def.Interval*9/10)
+	if err != nil {
+		log.Error("Error claiming cron job schedule", "job", def.Name, "err", err)
+		return
+	}
+	if slot == nil {
+		return
+	}
+	if _, err := j.RunJob(def, cronjobrun.TriggerSchedule, nil); err != nil {
+		log.Error("Error running cron job", "job", def.Name, "err", err)
+	}
+}
+
+// Copy of the runner whose queries stop when ctx is cancelled, e.g. when its lease is lost
+func (j *JobRunner) withCtx(ctx context.Context) *JobRunner {
+	runner := *j
+	runner.Ctx = ctx
+	runner.Repo = j.Repo.WithCtx(ctx)
+	runner.Redis = j.Redis.WithCtx(ctx)
+	return &runner
+}
+
+// Run a job while holding its lease, recording the run
+// Returns a skipped run if another instance is running the job, nil for skipped scheduled runs
+func (j *JobRunner) RunJob(def JobDefinition, trigger cronjobrun.Trigger, triggeredBy *uuid.UUID) (*ent.CronJobRun, error) {
+	instance := cronInstanceName()
+	lease, err := shared.AcquireLease(j.Ctx, j.Redis.Client, fmt.Sprintf("cron_job:%s", def.Name), shared.CRON_JOB_LEASE_TTL)
+	if err != nil {
+		return nil, err
+	}
+	if lease == nil {
+		if trigger == cronjobrun.TriggerSchedule {
+			return nil, nil
+		}
+		log.Info("Cron job already running, skipping", "job", def.Name)
+		return j.Repo.CreateCronJobRun(def.Name, trigger, cronjobrun.StatusSkipped, instance, triggeredBy)
+	}
+	defer func() {
+		if err := lease.Release(); err != nil {
+			log.Error("Error releasing cron job lease", "job", def.Name, "err", err)
+		}
+	}()
+	ctx, cancel := context.WithCancel(j.Ctx)
+	defer cancel()
+	lost := lease.KeepAlive(ctx)
+	// Another instance may start the job once the lease is lost, stop this run
+	go func() {
+		select {
+		case <-lost:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// History is kept on a best effort basis, the job runs regardless
+	if _, err := j.Repo.AbandonCronJobRuns(def.Name); err != nil {
+		log.Error("Error failing abandoned cron job runs", "job", def.Name, "err", err)
+	}
+	run, err := j.Repo.CreateCronJobRun(def.Name, trigger, cronjobrun.StatusRunning, instance, triggeredBy)
+	if err != nil {
+		log.Error("Error recording cron job run", "job", def.Name, "err", err)
+	}
+
+	logger := NewJobLogger(string(def.Name))
+	jobErr := def.Run(j.withCtx(ctx), logger)
+	select {
+	case <-lost:
+		logger.Warnf("Lost the lease while running")
+		if jobErr == nil {
+			jobErr = fmt.Errorf("lost the lease while running")
+		}
+	default:
+	}
+
+	if run == nil {
+		return nil, jobErr
+	}
+	run, err = j.Repo.FinishCronJobRun(run.ID, logger.Counts(), jobErr)
+	if err != nil {
+		log.Error("Error recording cron job run", "job", def.Name, "err", err)
+	}
+	return run, jobErr
+}
+
+// Run jobs admins trigger until ctx is done
+func (j *JobRunner) ConsumeJobTriggers(ctx context.Context) {
+	for ctx.Err() == nil {
+		trigger, err := j.Redis.WaitCronJobTrigger(5 * time.Second)
+		if err != nil {
+			log.Error("Error waiting for cron job trigger", "err", err)
+			time.Sleep(5 * time.Second)
+			continue
+		}
+		if trigger == nil {
+			continue
+		}
+		def, ok := j.GetJobDefinition(trigger.Job)
+		if !ok {
+			log.Warn("Triggered unknown cron job", "job", trigger.Job)
+			continue
+		}
+		log.Info("Running triggered cron job", "job", def.Name, "triggered_by", trigger.TriggeredBy)
+		go func() {
+			if _, err := j.RunJob(def, cronjobrun.TriggerManual, &trigger.TriggeredBy); err != nil {
+				log.Error("Error running triggered cron job", "job", def.Name, "err", err)
+			}
+		}()
+	}
+}
@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunJobBindsRepoToLease(t *testing.T) {
+	var runner *JobRunner
+	def := JobDefinition{
+		Name: shared.CronJobStats,
+		Run: func(j *JobRunner, log Logger) error {
+			runner = j
+			assert.Nil(t, j.Repo.Ctx.Err())
+			return nil
+		},
+	}
+	run, err := MockJobRunner.RunJob(def, cronjobrun.TriggerManual, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, cronjobrun.StatusSucceeded, run.Status)
+
+	// Queries of the run stop with it, the runner's own repository is untouched
+	assert.NotSame(t, MockJobRunner.Repo, runner.Repo)
+	assert.Equal(t, runner.Ctx, runner.Repo.Ctx)
+	assert.Equal(t, runner.Ctx, runner.Repo.Redis.Ctx)
+	assert.Equal(t, runner.Ctx, runner.Redis.Ctx)
+	assert.NotNil(t, runner.Repo.Ctx.Err())
+	assert.Nil(t, MockJobRunner.Repo.Ctx.Err())
+	assert.Nil(t, MockJobRunner.Redis.Ctx.Err())
+}
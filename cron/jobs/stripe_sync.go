@@ -38,6 +38,10 @@ func (j *JobRunner) SyncStripe(log Logger) error {
 		}
 	}
 
+	for _, customers := range productCustomerMap {
+		log.Count("customers", len(customers))
+	}
+
 	err := j.Repo.SyncStripeProductIDs(productCustomerMap)
 	if err != nil {
 		log.Errorf("Error syncing stripe product ids: %v", err)
@@ -19,12 +19,14 @@ import (
 	"github.com/stablecog/sc-go/cron/discord"
 	"github.com/stablecog/sc-go/cron/jobs"
 	"github.com/stablecog/sc-go/database"
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
 	"github.com/stablecog/sc-go/database/qdrant"
 	"github.com/stablecog/sc-go/database/repository"
 	"github.com/stablecog/sc-go/log"
 	"github.com/stablecog/sc-go/server/analytics"
 	"github.com/stablecog/sc-go/server/clip"
 	"github.com/stablecog/sc-go/server/translator"
+	"github.com/stablecog/sc-go/shared"
 	"github.com/stablecog/sc-go/shared/queue"
 	"github.com/stablecog/sc-go/utils"
 	stripe "github.com/stripe/stripe-go/v74/client"
@@ -51,8 +53,15 @@ func main() {
 	deleteData := flag.Bool("delete-banned-data", false, "Delete banned user data")
 	disableAutoUpscale := flag.Bool("disable-auto-upscale", false, "Disable auto upscaling")
 	dryRun := flag.Bool("dry-run", false, "Dry run (don't actually do anything)")
-	refund := flag.Bool("refund", false, "Refund expired credits")
+	refund := flag.Bool("refund", false, "Reconcile expired credit holds")
 	allJobs := flag.Bool("all", false, "Run all jobs in a blocking process")
+	qdrantMigrate := flag.String("qdrant-migrate", "", "Load this new qdrant collection and switch the alias to it, resumes if interrupted")
+	qdrantAlias := flag.String("qdrant-alias", "", "Alias to switch for -qdrant-migrate (default QDRANT_COLLECTION_NAME)")
+	qdrantVectorSize := flag.Uint64("qdrant-vector-size", qdrant.IMAGE_VECTOR_SIZE, "Image vector size of the new collection")
+	qdrantBatchSize := flag.Int("qdrant-batch-size", 100, "Outputs per batch for -qdrant-migrate")
+	qdrantReembed := flag.Bool("qdrant-reembed", false, "Embed images through CLIP again instead of copying vectors")
+	qdrantClipUrl := flag.String("qdrant-clip-url", "", "CLIP API for -qdrant-reembed (default CLIP_API_URL)")
+	qdrantFinish := flag.Bool("qdrant-finish", false, "Catch up once more and finish -qdrant-migrate, once the app reads the alias")
 	flag.Parse()
 
 	if *showHelp {
@@ -141,12 +150,12 @@ func main() {
 
 	safetyChecker := translator.NewTranslatorSafetyChecker(ctx, utils.GetEnv().OpenAIApiKey, false, redis)
 
-	// Setup rabbitmq client
-	rabbitmqClient, err := queue.NewRabbitMQClient(ctx, utils.GetEnv().RabbitMQAMQPUrl)
+	// Setup message queue client
+	mqClient, err := queue.NewMQClient(ctx, redis.Client)
 	if err != nil {
-		log.Fatalf("Error connecting to rabbitmq: %v", err)
+		log.Fatalf("Error connecting to message queue: %v", err)
 	}
-	defer rabbitmqClient.Close()
+	defer mqClient.Close()
 
 	// Setup asynq client
 	options := redis.Client.Options()
@@ -169,44 +178,66 @@ func main() {
 		S3:           s3Client,
 		S3Img2Img:    s3Img2ImgClient,
 		Qdrant:       qdrantClient,
-		MQClient:     rabbitmqClient,
+		MQClient:     mqClient,
 		CLIP:         clip.NewClipService(redis, safetyChecker),
 		AsynqClient:  asynqClient,
 		SupabaseAuth: database.NewSupabaseAuth(),
 		HTTP:         &http.Client{},
 	}
 
-	if *healthCheck {
-		err := jobRunner.CheckSCWorkerHealth(jobs.NewJobLogger("HEALTH"))
+	// One-off runs take the job's lease and are recorded like triggered runs
+	runOnce := func(def jobs.JobDefinition) {
+		run, err := jobRunner.RunJob(def, cronjobrun.TriggerManual, nil)
 		if err != nil {
-			log.Fatal("Error running health check", "err", err)
+			log.Fatal("Error running cron job", "job", def.Name, "err", err)
+			os.Exit(1)
+		}
+		if run != nil && run.Status == cronjobrun.StatusSkipped {
+			log.Fatal("Cron job is already running", "job", def.Name)
 			os.Exit(1)
 		}
 		os.Exit(0)
 	}
 
+	if *healthCheck {
+		def, _ := jobRunner.GetJobDefinition(shared.CronJobHealth)
+		runOnce(def)
+	}
+
 	if *stats {
-		err := jobRunner.GetAndSetStats(jobs.NewJobLogger("STATS"))
-		if err != nil {
-			log.Fatal("Error running stats job", "err", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
+		def, _ := jobRunner.GetJobDefinition(shared.CronJobStats)
+		runOnce(def)
 	}
 
 	if *deleteData {
-		err := jobRunner.DeleteUserData(jobs.NewJobLogger("DELETE_DATA"), *dryRun)
-		if err != nil {
-			log.Fatal("Error running delete data job", "err", err)
-			os.Exit(1)
+		def, _ := jobRunner.GetJobDefinition(shared.CronJobDeleteUserData)
+		def.Run = func(j *jobs.JobRunner, log jobs.Logger) error {
+			return j.DeleteUserData(log, *dryRun)
 		}
-		os.Exit(0)
+		runOnce(def)
 	}
 
 	if *refund {
-		err := jobRunner.RefundOldGenerationCredits(jobs.NewJobLogger("REFUND"))
+		def, _ := jobRunner.GetJobDefinition(shared.CronJobCreditHolds)
+		runOnce(def)
+	}
+
+	if *qdrantMigrate != "" {
+		alias := *qdrantAlias
+		if alias == "" {
+			alias = utils.GetEnv().QdrantCollectionName
+		}
+		err := jobRunner.MigrateQdrantCollection(jobs.NewJobLogger("QDRANT_MIGRATE"), jobs.QdrantMigrationOptions{
+			Collection: *qdrantMigrate,
+			Alias:      alias,
+			VectorSize: *qdrantVectorSize,
+			BatchSize:  *qdrantBatchSize,
+			Reembed:    *qdrantReembed,
+			ClipUrl:    *qdrantClipUrl,
+			Finish:     *qdrantFinish,
+		})
 		if err != nil {
-			log.Fatal("Error running refund job", "err", err)
+			log.Fatal("Error running qdrant migration", "err", err)
 			os.Exit(1)
 		}
 		os.Exit(0)
@@ -222,17 +253,14 @@ func main() {
 		}
 		log.Info("🏡 Starting all jobs...")
 		s := gocron.NewScheduler(time.UTC)
-		s.Every(60).Seconds().Do(jobRunner.GetAndSetStats, jobs.NewJobLogger("STATS"))
-		s.Every(15).Seconds().SingletonMode().Do(jobRunner.HandleOutputsWithNoEmbedding, jobs.NewJobLogger("EMBEDDINGS"))
-		if utils.GetEnv().DiscordWebhookUrl != "" {
-			s.Every(60).Seconds().Do(jobRunner.CheckSCWorkerHealth, jobs.NewJobLogger("HEALTH"))
+		// Leased per job, so any number of cron instances can run these
+		for _, def := range jobRunner.JobDefinitions() {
+			if !def.Scheduled {
+				continue
+			}
+			s.Every(def.Interval).SingletonMode().Do(jobRunner.RunScheduledJob, def)
 		}
-		s.Every(60).Seconds().Do(jobRunner.AddFreeCreditsToEligibleUsers, jobs.NewJobLogger("FREE_CREDITS"))
-		// Sync stripe
-		s.Every(10).Minutes().Do(jobRunner.SyncStripe, jobs.NewJobLogger("STRIPE_SYNC"))
-		// Clean up old redis queue items
-		s.Every(10).Minutes().Do(jobRunner.PruneOldQueueItems, jobs.NewJobLogger("RDQUEUE_CLEANUP"))
-		// cache update
+		// cache update, local to this instance
 		s.Every(5).Minutes().StartAt(time.Now().Add(5 * time.Minute)).Do(func() {
 			log.Info("📦 Updating cache...")
 			err = repo.UpdateCache()
@@ -240,10 +268,8 @@ func main() {
 				log.Error("Error updating cache", "err", err)
 			}
 		})
-		// Auto refund
-		s.Every(10).Minutes().Do(jobRunner.RefundOldGenerationCredits, jobs.NewJobLogger("AUTO_REFUND"))
-		// Auto delete users
-		s.Every(60).Minutes().Do(jobRunner.DeleteUserData, jobs.NewJobLogger("AUTO_DELETE_DATA"), false)
+		// Jobs triggered by admins
+		go jobRunner.ConsumeJobTriggers(ctx)
 		// Auto upscale
 		if !*disableAutoUpscale {
 			go jobRunner.StartAutoUpscaleJob(jobs.NewJobLogger("AUTO_UPSCALE"))
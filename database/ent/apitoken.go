@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -32,6 +33,18 @@ type ApiToken struct {
 	Uses int `json:"uses,omitempty"`
 	// CreditsSpent holds the value of the "credits_spent" field.
 	CreditsSpent int `json:"credits_spent,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// MonthlyCreditCap holds the value of the "monthly_credit_cap" field.
+	MonthlyCreditCap *int `json:"monthly_credit_cap,omitempty"`
+	// MonthlyCreditsSpent holds the value of the "monthly_credits_spent" field.
+	MonthlyCreditsSpent int `json:"monthly_credits_spent,omitempty"`
+	// MonthlyCreditsPeriod holds the value of the "monthly_credits_period" field.
+	MonthlyCreditsPeriod *time.Time `json:"monthly_credits_period,omitempty"`
+	// RotatedFromID holds the value of the "rotated_from_id" field.
+	RotatedFromID *uuid.UUID `json:"rotated_from_id,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID uuid.UUID `json:"user_id,omitempty"`
 	// AuthClientID holds the value of the "auth_client_id" field.
@@ -119,15 +132,17 @@ func (*ApiToken) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case apitoken.FieldAuthClientID:
+		case apitoken.FieldRotatedFromID, apitoken.FieldAuthClientID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case apitoken.FieldScopes:
+			values[i] = new([]byte)
 		case apitoken.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case apitoken.FieldUses, apitoken.FieldCreditsSpent:
+		case apitoken.FieldUses, apitoken.FieldCreditsSpent, apitoken.FieldMonthlyCreditCap, apitoken.FieldMonthlyCreditsSpent:
 			values[i] = new(sql.NullInt64)
 		case apitoken.FieldHashedToken, apitoken.FieldName, apitoken.FieldShortString:
 			values[i] = new(sql.NullString)
-		case apitoken.FieldLastUsedAt, apitoken.FieldCreatedAt, apitoken.FieldUpdatedAt:
+		case apitoken.FieldExpiresAt, apitoken.FieldMonthlyCreditsPeriod, apitoken.FieldLastUsedAt, apitoken.FieldCreatedAt, apitoken.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case apitoken.FieldID, apitoken.FieldUserID:
 			values[i] = new(uuid.UUID)
@@ -188,6 +203,48 @@ func (at *ApiToken) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				at.CreditsSpent = int(value.Int64)
 			}
+		case apitoken.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &at.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case apitoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				at.ExpiresAt = new(time.Time)
+				*at.ExpiresAt = value.Time
+			}
+		case apitoken.FieldMonthlyCreditCap:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_credit_cap", values[i])
+			} else if value.Valid {
+				at.MonthlyCreditCap = new(int)
+				*at.MonthlyCreditCap = int(value.Int64)
+			}
+		case apitoken.FieldMonthlyCreditsSpent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_credits_spent", values[i])
+			} else if value.Valid {
+				at.MonthlyCreditsSpent = int(value.Int64)
+			}
+		case apitoken.FieldMonthlyCreditsPeriod:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_credits_period", values[i])
+			} else if value.Valid {
+				at.MonthlyCreditsPeriod = new(time.Time)
+				*at.MonthlyCreditsPeriod = value.Time
+			}
+		case apitoken.FieldRotatedFromID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field rotated_from_id", values[i])
+			} else if value.Valid {
+				at.RotatedFromID = new(uuid.UUID)
+				*at.RotatedFromID = *value.S.(*uuid.UUID)
+			}
 		case apitoken.FieldUserID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field user_id", values[i])
@@ -299,6 +356,32 @@ func (at *ApiToken) String() string {
 	builder.WriteString("credits_spent=")
 	builder.WriteString(fmt.Sprintf("%v", at.CreditsSpent))
 	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", at.Scopes))
+	builder.WriteString(", ")
+	if v := at.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := at.MonthlyCreditCap; v != nil {
+		builder.WriteString("monthly_credit_cap=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("monthly_credits_spent=")
+	builder.WriteString(fmt.Sprintf("%v", at.MonthlyCreditsSpent))
+	builder.WriteString(", ")
+	if v := at.MonthlyCreditsPeriod; v != nil {
+		builder.WriteString("monthly_credits_period=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := at.RotatedFromID; v != nil {
+		builder.WriteString("rotated_from_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", at.UserID))
 	builder.WriteString(", ")
@@ -27,6 +27,18 @@ const (
 	FieldUses = "uses"
 	// FieldCreditsSpent holds the string denoting the credits_spent field in the database.
 	FieldCreditsSpent = "credits_spent"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldMonthlyCreditCap holds the string denoting the monthly_credit_cap field in the database.
+	FieldMonthlyCreditCap = "monthly_credit_cap"
+	// FieldMonthlyCreditsSpent holds the string denoting the monthly_credits_spent field in the database.
+	FieldMonthlyCreditsSpent = "monthly_credits_spent"
+	// FieldMonthlyCreditsPeriod holds the string denoting the monthly_credits_period field in the database.
+	FieldMonthlyCreditsPeriod = "monthly_credits_period"
+	// FieldRotatedFromID holds the string denoting the rotated_from_id field in the database.
+	FieldRotatedFromID = "rotated_from_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
 	// FieldAuthClientID holds the string denoting the auth_client_id field in the database.
@@ -95,6 +107,12 @@ var Columns = []string{
 	FieldIsActive,
 	FieldUses,
 	FieldCreditsSpent,
+	FieldScopes,
+	FieldExpiresAt,
+	FieldMonthlyCreditCap,
+	FieldMonthlyCreditsSpent,
+	FieldMonthlyCreditsPeriod,
+	FieldRotatedFromID,
 	FieldUserID,
 	FieldAuthClientID,
 	FieldLastUsedAt,
@@ -119,6 +137,8 @@ var (
 	DefaultUses int
 	// DefaultCreditsSpent holds the default value on creation for the "credits_spent" field.
 	DefaultCreditsSpent int
+	// DefaultMonthlyCreditsSpent holds the default value on creation for the "monthly_credits_spent" field.
+	DefaultMonthlyCreditsSpent int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -167,6 +187,31 @@ func ByCreditsSpent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreditsSpent, opts...).ToFunc()
 }
 
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByMonthlyCreditCap orders the results by the monthly_credit_cap field.
+func ByMonthlyCreditCap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyCreditCap, opts...).ToFunc()
+}
+
+// ByMonthlyCreditsSpent orders the results by the monthly_credits_spent field.
+func ByMonthlyCreditsSpent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyCreditsSpent, opts...).ToFunc()
+}
+
+// ByMonthlyCreditsPeriod orders the results by the monthly_credits_period field.
+func ByMonthlyCreditsPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyCreditsPeriod, opts...).ToFunc()
+}
+
+// ByRotatedFromID orders the results by the rotated_from_id field.
+func ByRotatedFromID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRotatedFromID, opts...).ToFunc()
+}
+
 // ByUserID orders the results by the user_id field.
 func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
@@ -86,6 +86,31 @@ func CreditsSpent(v int) predicate.ApiToken {
 	return predicate.ApiToken(sql.FieldEQ(FieldCreditsSpent, v))
 }
 
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// MonthlyCreditCap applies equality check predicate on the "monthly_credit_cap" field. It's identical to MonthlyCreditCapEQ.
+func MonthlyCreditCap(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldEQ(FieldMonthlyCreditCap, v))
+}
+
+// MonthlyCreditsSpent applies equality check predicate on the "monthly_credits_spent" field. It's identical to MonthlyCreditsSpentEQ.
+func MonthlyCreditsSpent(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldEQ(FieldMonthlyCreditsSpent, v))
+}
+
+// MonthlyCreditsPeriod applies equality check predicate on the "monthly_credits_period" field. It's identical to MonthlyCreditsPeriodEQ.
+func MonthlyCreditsPeriod(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldEQ(FieldMonthlyCreditsPeriod, v))
+}
+
+// RotatedFromID applies equality check predicate on the "rotated_from_id" field. It's identical to RotatedFromIDEQ.
+func RotatedFromID(v uuid.UUID) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldEQ(FieldRotatedFromID, v))
+}
+
 // UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
 func UserID(v uuid.UUID) predicate.ApiToken {
 	return predicate.ApiToken(sql.FieldEQ(FieldUserID, v))
@@ -396,6 +421,256 @@ func CreditsSpentLTE(v int) predicate.ApiToken {
 	return predicate.ApiToken(sql.FieldLTE(FieldCreditsSpent, v))
 }
 
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNotNull(FieldScopes))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// MonthlyCreditCapEQ applies the EQ predicate on the "monthly_credit_cap" field.
+func MonthlyCreditCapEQ(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldEQ(FieldMonthlyCreditCap, v))
+}
+
+// MonthlyCreditCapNEQ applies the NEQ predicate on the "monthly_credit_cap" field.
+func MonthlyCreditCapNEQ(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNEQ(FieldMonthlyCreditCap, v))
+}
+
+// MonthlyCreditCapIn applies the In predicate on the "monthly_credit_cap" field.
+func MonthlyCreditCapIn(vs ...int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldIn(FieldMonthlyCreditCap, vs...))
+}
+
+// MonthlyCreditCapNotIn applies the NotIn predicate on the "monthly_credit_cap" field.
+func MonthlyCreditCapNotIn(vs ...int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNotIn(FieldMonthlyCreditCap, vs...))
+}
+
+// MonthlyCreditCapGT applies the GT predicate on the "monthly_credit_cap" field.
+func MonthlyCreditCapGT(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldGT(FieldMonthlyCreditCap, v))
+}
+
+// MonthlyCreditCapGTE applies the GTE predicate on the "monthly_credit_cap" field.
+func MonthlyCreditCapGTE(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldGTE(FieldMonthlyCreditCap, v))
+}
+
+// MonthlyCreditCapLT applies the LT predicate on the "monthly_credit_cap" field.
+func MonthlyCreditCapLT(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldLT(FieldMonthlyCreditCap, v))
+}
+
+// MonthlyCreditCapLTE applies the LTE predicate on the "monthly_credit_cap" field.
+func MonthlyCreditCapLTE(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldLTE(FieldMonthlyCreditCap, v))
+}
+
+// MonthlyCreditCapIsNil applies the IsNil predicate on the "monthly_credit_cap" field.
+func MonthlyCreditCapIsNil() predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldIsNull(FieldMonthlyCreditCap))
+}
+
+// MonthlyCreditCapNotNil applies the NotNil predicate on the "monthly_credit_cap" field.
+func MonthlyCreditCapNotNil() predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNotNull(FieldMonthlyCreditCap))
+}
+
+// MonthlyCreditsSpentEQ applies the EQ predicate on the "monthly_credits_spent" field.
+func MonthlyCreditsSpentEQ(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldEQ(FieldMonthlyCreditsSpent, v))
+}
+
+// MonthlyCreditsSpentNEQ applies the NEQ predicate on the "monthly_credits_spent" field.
+func MonthlyCreditsSpentNEQ(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNEQ(FieldMonthlyCreditsSpent, v))
+}
+
+// MonthlyCreditsSpentIn applies the In predicate on the "monthly_credits_spent" field.
+func MonthlyCreditsSpentIn(vs ...int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldIn(FieldMonthlyCreditsSpent, vs...))
+}
+
+// MonthlyCreditsSpentNotIn applies the NotIn predicate on the "monthly_credits_spent" field.
+func MonthlyCreditsSpentNotIn(vs ...int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNotIn(FieldMonthlyCreditsSpent, vs...))
+}
+
+// MonthlyCreditsSpentGT applies the GT predicate on the "monthly_credits_spent" field.
+func MonthlyCreditsSpentGT(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldGT(FieldMonthlyCreditsSpent, v))
+}
+
+// MonthlyCreditsSpentGTE applies the GTE predicate on the "monthly_credits_spent" field.
+func MonthlyCreditsSpentGTE(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldGTE(FieldMonthlyCreditsSpent, v))
+}
+
+// MonthlyCreditsSpentLT applies the LT predicate on the "monthly_credits_spent" field.
+func MonthlyCreditsSpentLT(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldLT(FieldMonthlyCreditsSpent, v))
+}
+
+// MonthlyCreditsSpentLTE applies the LTE predicate on the "monthly_credits_spent" field.
+func MonthlyCreditsSpentLTE(v int) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldLTE(FieldMonthlyCreditsSpent, v))
+}
+
+// MonthlyCreditsPeriodEQ applies the EQ predicate on the "monthly_credits_period" field.
+func MonthlyCreditsPeriodEQ(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldEQ(FieldMonthlyCreditsPeriod, v))
+}
+
+// MonthlyCreditsPeriodNEQ applies the NEQ predicate on the "monthly_credits_period" field.
+func MonthlyCreditsPeriodNEQ(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNEQ(FieldMonthlyCreditsPeriod, v))
+}
+
+// MonthlyCreditsPeriodIn applies the In predicate on the "monthly_credits_period" field.
+func MonthlyCreditsPeriodIn(vs ...time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldIn(FieldMonthlyCreditsPeriod, vs...))
+}
+
+// MonthlyCreditsPeriodNotIn applies the NotIn predicate on the "monthly_credits_period" field.
+func MonthlyCreditsPeriodNotIn(vs ...time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNotIn(FieldMonthlyCreditsPeriod, vs...))
+}
+
+// MonthlyCreditsPeriodGT applies the GT predicate on the "monthly_credits_period" field.
+func MonthlyCreditsPeriodGT(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldGT(FieldMonthlyCreditsPeriod, v))
+}
+
+// MonthlyCreditsPeriodGTE applies the GTE predicate on the "monthly_credits_period" field.
+func MonthlyCreditsPeriodGTE(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldGTE(FieldMonthlyCreditsPeriod, v))
+}
+
+// MonthlyCreditsPeriodLT applies the LT predicate on the "monthly_credits_period" field.
+func MonthlyCreditsPeriodLT(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldLT(FieldMonthlyCreditsPeriod, v))
+}
+
+// MonthlyCreditsPeriodLTE applies the LTE predicate on the "monthly_credits_period" field.
+func MonthlyCreditsPeriodLTE(v time.Time) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldLTE(FieldMonthlyCreditsPeriod, v))
+}
+
+// MonthlyCreditsPeriodIsNil applies the IsNil predicate on the "monthly_credits_period" field.
+func MonthlyCreditsPeriodIsNil() predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldIsNull(FieldMonthlyCreditsPeriod))
+}
+
+// MonthlyCreditsPeriodNotNil applies the NotNil predicate on the "monthly_credits_period" field.
+func MonthlyCreditsPeriodNotNil() predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNotNull(FieldMonthlyCreditsPeriod))
+}
+
+// RotatedFromIDEQ applies the EQ predicate on the "rotated_from_id" field.
+func RotatedFromIDEQ(v uuid.UUID) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldEQ(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDNEQ applies the NEQ predicate on the "rotated_from_id" field.
+func RotatedFromIDNEQ(v uuid.UUID) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNEQ(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDIn applies the In predicate on the "rotated_from_id" field.
+func RotatedFromIDIn(vs ...uuid.UUID) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldIn(FieldRotatedFromID, vs...))
+}
+
+// RotatedFromIDNotIn applies the NotIn predicate on the "rotated_from_id" field.
+func RotatedFromIDNotIn(vs ...uuid.UUID) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNotIn(FieldRotatedFromID, vs...))
+}
+
+// RotatedFromIDGT applies the GT predicate on the "rotated_from_id" field.
+func RotatedFromIDGT(v uuid.UUID) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldGT(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDGTE applies the GTE predicate on the "rotated_from_id" field.
+func RotatedFromIDGTE(v uuid.UUID) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldGTE(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDLT applies the LT predicate on the "rotated_from_id" field.
+func RotatedFromIDLT(v uuid.UUID) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldLT(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDLTE applies the LTE predicate on the "rotated_from_id" field.
+func RotatedFromIDLTE(v uuid.UUID) predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldLTE(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDIsNil applies the IsNil predicate on the "rotated_from_id" field.
+func RotatedFromIDIsNil() predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldIsNull(FieldRotatedFromID))
+}
+
+// RotatedFromIDNotNil applies the NotNil predicate on the "rotated_from_id" field.
+func RotatedFromIDNotNil() predicate.ApiToken {
+	return predicate.ApiToken(sql.FieldNotNull(FieldRotatedFromID))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v uuid.UUID) predicate.ApiToken {
 	return predicate.ApiToken(sql.FieldEQ(FieldUserID, v))
@@ -89,6 +89,82 @@ func (atc *ApiTokenCreate) SetNillableCreditsSpent(i *int) *ApiTokenCreate {
 	return atc
 }
 
+// SetScopes sets the "scopes" field.
+func (atc *ApiTokenCreate) SetScopes(s []string) *ApiTokenCreate {
+	atc.mutation.SetScopes(s)
+	return atc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (atc *ApiTokenCreate) SetExpiresAt(t time.Time) *ApiTokenCreate {
+	atc.mutation.SetExpiresAt(t)
+	return atc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (atc *ApiTokenCreate) SetNillableExpiresAt(t *time.Time) *ApiTokenCreate {
+	if t != nil {
+		atc.SetExpiresAt(*t)
+	}
+	return atc
+}
+
+// SetMonthlyCreditCap sets the "monthly_credit_cap" field.
+func (atc *ApiTokenCreate) SetMonthlyCreditCap(i int) *ApiTokenCreate {
+	atc.mutation.SetMonthlyCreditCap(i)
+	return atc
+}
+
+// SetNillableMonthlyCreditCap sets the "monthly_credit_cap" field if the given value is not nil.
+func (atc *ApiTokenCreate) SetNillableMonthlyCreditCap(i *int) *ApiTokenCreate {
+	if i != nil {
+		atc.SetMonthlyCreditCap(*i)
+	}
+	return atc
+}
+
+// SetMonthlyCreditsSpent sets the "monthly_credits_spent" field.
+func (atc *ApiTokenCreate) SetMonthlyCreditsSpent(i int) *ApiTokenCreate {
+	atc.mutation.SetMonthlyCreditsSpent(i)
+	return atc
+}
+
+// SetNillableMonthlyCreditsSpent sets the "monthly_credits_spent" field if the given value is not nil.
+func (atc *ApiTokenCreate) SetNillableMonthlyCreditsSpent(i *int) *ApiTokenCreate {
+	if i != nil {
+		atc.SetMonthlyCreditsSpent(*i)
+	}
+	return atc
+}
+
+// SetMonthlyCreditsPeriod sets the "monthly_credits_period" field.
+func (atc *ApiTokenCreate) SetMonthlyCreditsPeriod(t time.Time) *ApiTokenCreate {
+	atc.mutation.SetMonthlyCreditsPeriod(t)
+	return atc
+}
+
+// SetNillableMonthlyCreditsPeriod sets the "monthly_credits_period" field if the given value is not nil.
+func (atc *ApiTokenCreate) SetNillableMonthlyCreditsPeriod(t *time.Time) *ApiTokenCreate {
+	if t != nil {
+		atc.SetMonthlyCreditsPeriod(*t)
+	}
+	return atc
+}
+
+// SetRotatedFromID sets the "rotated_from_id" field.
+func (atc *ApiTokenCreate) SetRotatedFromID(u uuid.UUID) *ApiTokenCreate {
+	atc.mutation.SetRotatedFromID(u)
+	return atc
+}
+
+// SetNillableRotatedFromID sets the "rotated_from_id" field if the given value is not nil.
+func (atc *ApiTokenCreate) SetNillableRotatedFromID(u *uuid.UUID) *ApiTokenCreate {
+	if u != nil {
+		atc.SetRotatedFromID(*u)
+	}
+	return atc
+}
+
 // SetUserID sets the "user_id" field.
 func (atc *ApiTokenCreate) SetUserID(u uuid.UUID) *ApiTokenCreate {
 	atc.mutation.SetUserID(u)
@@ -281,6 +357,10 @@ func (atc *ApiTokenCreate) defaults() {
 		v := apitoken.DefaultCreditsSpent
 		atc.mutation.SetCreditsSpent(v)
 	}
+	if _, ok := atc.mutation.MonthlyCreditsSpent(); !ok {
+		v := apitoken.DefaultMonthlyCreditsSpent
+		atc.mutation.SetMonthlyCreditsSpent(v)
+	}
 	if _, ok := atc.mutation.CreatedAt(); !ok {
 		v := apitoken.DefaultCreatedAt()
 		atc.mutation.SetCreatedAt(v)
@@ -315,6 +395,9 @@ func (atc *ApiTokenCreate) check() error {
 	if _, ok := atc.mutation.CreditsSpent(); !ok {
 		return &ValidationError{Name: "credits_spent", err: errors.New(`ent: missing required field "ApiToken.credits_spent"`)}
 	}
+	if _, ok := atc.mutation.MonthlyCreditsSpent(); !ok {
+		return &ValidationError{Name: "monthly_credits_spent", err: errors.New(`ent: missing required field "ApiToken.monthly_credits_spent"`)}
+	}
 	if _, ok := atc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ApiToken.user_id"`)}
 	}
@@ -387,6 +470,30 @@ func (atc *ApiTokenCreate) createSpec() (*ApiToken, *sqlgraph.CreateSpec) {
 		_spec.SetField(apitoken.FieldCreditsSpent, field.TypeInt, value)
 		_node.CreditsSpent = value
 	}
+	if value, ok := atc.mutation.Scopes(); ok {
+		_spec.SetField(apitoken.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := atc.mutation.ExpiresAt(); ok {
+		_spec.SetField(apitoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := atc.mutation.MonthlyCreditCap(); ok {
+		_spec.SetField(apitoken.FieldMonthlyCreditCap, field.TypeInt, value)
+		_node.MonthlyCreditCap = &value
+	}
+	if value, ok := atc.mutation.MonthlyCreditsSpent(); ok {
+		_spec.SetField(apitoken.FieldMonthlyCreditsSpent, field.TypeInt, value)
+		_node.MonthlyCreditsSpent = value
+	}
+	if value, ok := atc.mutation.MonthlyCreditsPeriod(); ok {
+		_spec.SetField(apitoken.FieldMonthlyCreditsPeriod, field.TypeTime, value)
+		_node.MonthlyCreditsPeriod = &value
+	}
+	if value, ok := atc.mutation.RotatedFromID(); ok {
+		_spec.SetField(apitoken.FieldRotatedFromID, field.TypeUUID, value)
+		_node.RotatedFromID = &value
+	}
 	if value, ok := atc.mutation.LastUsedAt(); ok {
 		_spec.SetField(apitoken.FieldLastUsedAt, field.TypeTime, value)
 		_node.LastUsedAt = &value
@@ -617,6 +724,120 @@ func (u *ApiTokenUpsert) AddCreditsSpent(v int) *ApiTokenUpsert {
 	return u
 }
 
+// SetScopes sets the "scopes" field.
+func (u *ApiTokenUpsert) SetScopes(v []string) *ApiTokenUpsert {
+	u.Set(apitoken.FieldScopes, v)
+	return u
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *ApiTokenUpsert) UpdateScopes() *ApiTokenUpsert {
+	u.SetExcluded(apitoken.FieldScopes)
+	return u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *ApiTokenUpsert) ClearScopes() *ApiTokenUpsert {
+	u.SetNull(apitoken.FieldScopes)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ApiTokenUpsert) SetExpiresAt(v time.Time) *ApiTokenUpsert {
+	u.Set(apitoken.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ApiTokenUpsert) UpdateExpiresAt() *ApiTokenUpsert {
+	u.SetExcluded(apitoken.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ApiTokenUpsert) ClearExpiresAt() *ApiTokenUpsert {
+	u.SetNull(apitoken.FieldExpiresAt)
+	return u
+}
+
+// SetMonthlyCreditCap sets the "monthly_credit_cap" field.
+func (u *ApiTokenUpsert) SetMonthlyCreditCap(v int) *ApiTokenUpsert {
+	u.Set(apitoken.FieldMonthlyCreditCap, v)
+	return u
+}
+
+// UpdateMonthlyCreditCap sets the "monthly_credit_cap" field to the value that was provided on create.
+func (u *ApiTokenUpsert) UpdateMonthlyCreditCap() *ApiTokenUpsert {
+	u.SetExcluded(apitoken.FieldMonthlyCreditCap)
+	return u
+}
+
+// AddMonthlyCreditCap adds v to the "monthly_credit_cap" field.
+func (u *ApiTokenUpsert) AddMonthlyCreditCap(v int) *ApiTokenUpsert {
+	u.Add(apitoken.FieldMonthlyCreditCap, v)
+	return u
+}
+
+// ClearMonthlyCreditCap clears the value of the "monthly_credit_cap" field.
+func (u *ApiTokenUpsert) ClearMonthlyCreditCap() *ApiTokenUpsert {
+	u.SetNull(apitoken.FieldMonthlyCreditCap)
+	return u
+}
+
+// SetMonthlyCreditsSpent sets the "monthly_credits_spent" field.
+func (u *ApiTokenUpsert) SetMonthlyCreditsSpent(v int) *ApiTokenUpsert {
+	u.Set(apitoken.FieldMonthlyCreditsSpent, v)
+	return u
+}
+
+// UpdateMonthlyCreditsSpent sets the "monthly_credits_spent" field to the value that was provided on create.
+func (u *ApiTokenUpsert) UpdateMonthlyCreditsSpent() *ApiTokenUpsert {
+	u.SetExcluded(apitoken.FieldMonthlyCreditsSpent)
+	return u
+}
+
+// AddMonthlyCreditsSpent adds v to the "monthly_credits_spent" field.
+func (u *ApiTokenUpsert) AddMonthlyCreditsSpent(v int) *ApiTokenUpsert {
+	u.Add(apitoken.FieldMonthlyCreditsSpent, v)
+	return u
+}
+
+// SetMonthlyCreditsPeriod sets the "monthly_credits_period" field.
+func (u *ApiTokenUpsert) SetMonthlyCreditsPeriod(v time.Time) *ApiTokenUpsert {
+	u.Set(apitoken.FieldMonthlyCreditsPeriod, v)
+	return u
+}
+
+// UpdateMonthlyCreditsPeriod sets the "monthly_credits_period" field to the value that was provided on create.
+func (u *ApiTokenUpsert) UpdateMonthlyCreditsPeriod() *ApiTokenUpsert {
+	u.SetExcluded(apitoken.FieldMonthlyCreditsPeriod)
+	return u
+}
+
+// ClearMonthlyCreditsPeriod clears the value of the "monthly_credits_period" field.
+func (u *ApiTokenUpsert) ClearMonthlyCreditsPeriod() *ApiTokenUpsert {
+	u.SetNull(apitoken.FieldMonthlyCreditsPeriod)
+	return u
+}
+
+// SetRotatedFromID sets the "rotated_from_id" field.
+func (u *ApiTokenUpsert) SetRotatedFromID(v uuid.UUID) *ApiTokenUpsert {
+	u.Set(apitoken.FieldRotatedFromID, v)
+	return u
+}
+
+// UpdateRotatedFromID sets the "rotated_from_id" field to the value that was provided on create.
+func (u *ApiTokenUpsert) UpdateRotatedFromID() *ApiTokenUpsert {
+	u.SetExcluded(apitoken.FieldRotatedFromID)
+	return u
+}
+
+// ClearRotatedFromID clears the value of the "rotated_from_id" field.
+func (u *ApiTokenUpsert) ClearRotatedFromID() *ApiTokenUpsert {
+	u.SetNull(apitoken.FieldRotatedFromID)
+	return u
+}
+
 // SetUserID sets the "user_id" field.
 func (u *ApiTokenUpsert) SetUserID(v uuid.UUID) *ApiTokenUpsert {
 	u.Set(apitoken.FieldUserID, v)
@@ -826,6 +1047,139 @@ func (u *ApiTokenUpsertOne) UpdateCreditsSpent() *ApiTokenUpsertOne {
 	})
 }
 
+// SetScopes sets the "scopes" field.
+func (u *ApiTokenUpsertOne) SetScopes(v []string) *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *ApiTokenUpsertOne) UpdateScopes() *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *ApiTokenUpsertOne) ClearScopes() *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ApiTokenUpsertOne) SetExpiresAt(v time.Time) *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ApiTokenUpsertOne) UpdateExpiresAt() *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ApiTokenUpsertOne) ClearExpiresAt() *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMonthlyCreditCap sets the "monthly_credit_cap" field.
+func (u *ApiTokenUpsertOne) SetMonthlyCreditCap(v int) *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.SetMonthlyCreditCap(v)
+	})
+}
+
+// AddMonthlyCreditCap adds v to the "monthly_credit_cap" field.
+func (u *ApiTokenUpsertOne) AddMonthlyCreditCap(v int) *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.AddMonthlyCreditCap(v)
+	})
+}
+
+// UpdateMonthlyCreditCap sets the "monthly_credit_cap" field to the value that was provided on create.
+func (u *ApiTokenUpsertOne) UpdateMonthlyCreditCap() *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.UpdateMonthlyCreditCap()
+	})
+}
+
+// ClearMonthlyCreditCap clears the value of the "monthly_credit_cap" field.
+func (u *ApiTokenUpsertOne) ClearMonthlyCreditCap() *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.ClearMonthlyCreditCap()
+	})
+}
+
+// SetMonthlyCreditsSpent sets the "monthly_credits_spent" field.
+func (u *ApiTokenUpsertOne) SetMonthlyCreditsSpent(v int) *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.SetMonthlyCreditsSpent(v)
+	})
+}
+
+// AddMonthlyCreditsSpent adds v to the "monthly_credits_spent" field.
+func (u *ApiTokenUpsertOne) AddMonthlyCreditsSpent(v int) *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.AddMonthlyCreditsSpent(v)
+	})
+}
+
+// UpdateMonthlyCreditsSpent sets the "monthly_credits_spent" field to the value that was provided on create.
+func (u *ApiTokenUpsertOne) UpdateMonthlyCreditsSpent() *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.UpdateMonthlyCreditsSpent()
+	})
+}
+
+// SetMonthlyCreditsPeriod sets the "monthly_credits_period" field.
+func (u *ApiTokenUpsertOne) SetMonthlyCreditsPeriod(v time.Time) *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.SetMonthlyCreditsPeriod(v)
+	})
+}
+
+// UpdateMonthlyCreditsPeriod sets the "monthly_credits_period" field to the value that was provided on create.
+func (u *ApiTokenUpsertOne) UpdateMonthlyCreditsPeriod() *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.UpdateMonthlyCreditsPeriod()
+	})
+}
+
+// ClearMonthlyCreditsPeriod clears the value of the "monthly_credits_period" field.
+func (u *ApiTokenUpsertOne) ClearMonthlyCreditsPeriod() *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.ClearMonthlyCreditsPeriod()
+	})
+}
+
+// SetRotatedFromID sets the "rotated_from_id" field.
+func (u *ApiTokenUpsertOne) SetRotatedFromID(v uuid.UUID) *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.SetRotatedFromID(v)
+	})
+}
+
+// UpdateRotatedFromID sets the "rotated_from_id" field to the value that was provided on create.
+func (u *ApiTokenUpsertOne) UpdateRotatedFromID() *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.UpdateRotatedFromID()
+	})
+}
+
+// ClearRotatedFromID clears the value of the "rotated_from_id" field.
+func (u *ApiTokenUpsertOne) ClearRotatedFromID() *ApiTokenUpsertOne {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.ClearRotatedFromID()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *ApiTokenUpsertOne) SetUserID(v uuid.UUID) *ApiTokenUpsertOne {
 	return u.Update(func(s *ApiTokenUpsert) {
@@ -1212,6 +1566,139 @@ func (u *ApiTokenUpsertBulk) UpdateCreditsSpent() *ApiTokenUpsertBulk {
 	})
 }
 
+// SetScopes sets the "scopes" field.
+func (u *ApiTokenUpsertBulk) SetScopes(v []string) *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *ApiTokenUpsertBulk) UpdateScopes() *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *ApiTokenUpsertBulk) ClearScopes() *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ApiTokenUpsertBulk) SetExpiresAt(v time.Time) *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ApiTokenUpsertBulk) UpdateExpiresAt() *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ApiTokenUpsertBulk) ClearExpiresAt() *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMonthlyCreditCap sets the "monthly_credit_cap" field.
+func (u *ApiTokenUpsertBulk) SetMonthlyCreditCap(v int) *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.SetMonthlyCreditCap(v)
+	})
+}
+
+// AddMonthlyCreditCap adds v to the "monthly_credit_cap" field.
+func (u *ApiTokenUpsertBulk) AddMonthlyCreditCap(v int) *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.AddMonthlyCreditCap(v)
+	})
+}
+
+// UpdateMonthlyCreditCap sets the "monthly_credit_cap" field to the value that was provided on create.
+func (u *ApiTokenUpsertBulk) UpdateMonthlyCreditCap() *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.UpdateMonthlyCreditCap()
+	})
+}
+
+// ClearMonthlyCreditCap clears the value of the "monthly_credit_cap" field.
+func (u *ApiTokenUpsertBulk) ClearMonthlyCreditCap() *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.ClearMonthlyCreditCap()
+	})
+}
+
+// SetMonthlyCreditsSpent sets the "monthly_credits_spent" field.
+func (u *ApiTokenUpsertBulk) SetMonthlyCreditsSpent(v int) *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.SetMonthlyCreditsSpent(v)
+	})
+}
+
+// AddMonthlyCreditsSpent adds v to the "monthly_credits_spent" field.
+func (u *ApiTokenUpsertBulk) AddMonthlyCreditsSpent(v int) *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.AddMonthlyCreditsSpent(v)
+	})
+}
+
+// UpdateMonthlyCreditsSpent sets the "monthly_credits_spent" field to the value that was provided on create.
+func (u *ApiTokenUpsertBulk) UpdateMonthlyCreditsSpent() *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.UpdateMonthlyCreditsSpent()
+	})
+}
+
+// SetMonthlyCreditsPeriod sets the "monthly_credits_period" field.
+func (u *ApiTokenUpsertBulk) SetMonthlyCreditsPeriod(v time.Time) *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.SetMonthlyCreditsPeriod(v)
+	})
+}
+
+// UpdateMonthlyCreditsPeriod sets the "monthly_credits_period" field to the value that was provided on create.
+func (u *ApiTokenUpsertBulk) UpdateMonthlyCreditsPeriod() *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.UpdateMonthlyCreditsPeriod()
+	})
+}
+
+// ClearMonthlyCreditsPeriod clears the value of the "monthly_credits_period" field.
+func (u *ApiTokenUpsertBulk) ClearMonthlyCreditsPeriod() *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.ClearMonthlyCreditsPeriod()
+	})
+}
+
+// SetRotatedFromID sets the "rotated_from_id" field.
+func (u *ApiTokenUpsertBulk) SetRotatedFromID(v uuid.UUID) *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.SetRotatedFromID(v)
+	})
+}
+
+// UpdateRotatedFromID sets the "rotated_from_id" field to the value that was provided on create.
+func (u *ApiTokenUpsertBulk) UpdateRotatedFromID() *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.UpdateRotatedFromID()
+	})
+}
+
+// ClearRotatedFromID clears the value of the "rotated_from_id" field.
+func (u *ApiTokenUpsertBulk) ClearRotatedFromID() *ApiTokenUpsertBulk {
+	return u.Update(func(s *ApiTokenUpsert) {
+		s.ClearRotatedFromID()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *ApiTokenUpsertBulk) SetUserID(v uuid.UUID) *ApiTokenUpsertBulk {
 	return u.Update(func(s *ApiTokenUpsert) {
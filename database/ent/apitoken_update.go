@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database/ent/apitoken"
@@ -133,6 +134,132 @@ func (atu *ApiTokenUpdate) AddCreditsSpent(i int) *ApiTokenUpdate {
 	return atu
 }
 
+// SetScopes sets the "scopes" field.
+func (atu *ApiTokenUpdate) SetScopes(s []string) *ApiTokenUpdate {
+	atu.mutation.SetScopes(s)
+	return atu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (atu *ApiTokenUpdate) AppendScopes(s []string) *ApiTokenUpdate {
+	atu.mutation.AppendScopes(s)
+	return atu
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (atu *ApiTokenUpdate) ClearScopes() *ApiTokenUpdate {
+	atu.mutation.ClearScopes()
+	return atu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (atu *ApiTokenUpdate) SetExpiresAt(t time.Time) *ApiTokenUpdate {
+	atu.mutation.SetExpiresAt(t)
+	return atu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (atu *ApiTokenUpdate) SetNillableExpiresAt(t *time.Time) *ApiTokenUpdate {
+	if t != nil {
+		atu.SetExpiresAt(*t)
+	}
+	return atu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (atu *ApiTokenUpdate) ClearExpiresAt() *ApiTokenUpdate {
+	atu.mutation.ClearExpiresAt()
+	return atu
+}
+
+// SetMonthlyCreditCap sets the "monthly_credit_cap" field.
+func (atu *ApiTokenUpdate) SetMonthlyCreditCap(i int) *ApiTokenUpdate {
+	atu.mutation.ResetMonthlyCreditCap()
+	atu.mutation.SetMonthlyCreditCap(i)
+	return atu
+}
+
+// SetNillableMonthlyCreditCap sets the "monthly_credit_cap" field if the given value is not nil.
+func (atu *ApiTokenUpdate) SetNillableMonthlyCreditCap(i *int) *ApiTokenUpdate {
+	if i != nil {
+		atu.SetMonthlyCreditCap(*i)
+	}
+	return atu
+}
+
+// AddMonthlyCreditCap adds i to the "monthly_credit_cap" field.
+func (atu *ApiTokenUpdate) AddMonthlyCreditCap(i int) *ApiTokenUpdate {
+	atu.mutation.AddMonthlyCreditCap(i)
+	return atu
+}
+
+// ClearMonthlyCreditCap clears the value of the "monthly_credit_cap" field.
+func (atu *ApiTokenUpdate) ClearMonthlyCreditCap() *ApiTokenUpdate {
+	atu.mutation.ClearMonthlyCreditCap()
+	return atu
+}
+
+// SetMonthlyCreditsSpent sets the "monthly_credits_spent" field.
+func (atu *ApiTokenUpdate) SetMonthlyCreditsSpent(i int) *ApiTokenUpdate {
+	atu.mutation.ResetMonthlyCreditsSpent()
+	atu.mutation.SetMonthlyCreditsSpent(i)
+	return atu
+}
+
+// SetNillableMonthlyCreditsSpent sets the "monthly_credits_spent" field if the given value is not nil.
+func (atu *ApiTokenUpdate) SetNillableMonthlyCreditsSpent(i *int) *ApiTokenUpdate {
+	if i != nil {
+		atu.SetMonthlyCreditsSpent(*i)
+	}
+	return atu
+}
+
+// AddMonthlyCreditsSpent adds i to the "monthly_credits_spent" field.
+func (atu *ApiTokenUpdate) AddMonthlyCreditsSpent(i int) *ApiTokenUpdate {
+	atu.mutation.AddMonthlyCreditsSpent(i)
+	return atu
+}
+
+// SetMonthlyCreditsPeriod sets the "monthly_credits_period" field.
+func (atu *ApiTokenUpdate) SetMonthlyCreditsPeriod(t time.Time) *ApiTokenUpdate {
+	atu.mutation.SetMonthlyCreditsPeriod(t)
+	return atu
+}
+
+// SetNillableMonthlyCreditsPeriod sets the "monthly_credits_period" field if the given value is not nil.
+func (atu *ApiTokenUpdate) SetNillableMonthlyCreditsPeriod(t *time.Time) *ApiTokenUpdate {
+	if t != nil {
+		atu.SetMonthlyCreditsPeriod(*t)
+	}
+	return atu
+}
+
+// ClearMonthlyCreditsPeriod clears the value of the "monthly_credits_period" field.
+func (atu *ApiTokenUpdate) ClearMonthlyCreditsPeriod() *ApiTokenUpdate {
+	atu.mutation.ClearMonthlyCreditsPeriod()
+	return atu
+}
+
+// SetRotatedFromID sets the "rotated_from_id" field.
+func (atu *ApiTokenUpdate) SetRotatedFromID(u uuid.UUID) *ApiTokenUpdate {
+	atu.mutation.SetRotatedFromID(u)
+	return atu
+}
+
+// SetNillableRotatedFromID sets the "rotated_from_id" field if the given value is not nil.
+func (atu *ApiTokenUpdate) SetNillableRotatedFromID(u *uuid.UUID) *ApiTokenUpdate {
+	if u != nil {
+		atu.SetRotatedFromID(*u)
+	}
+	return atu
+}
+
+// ClearRotatedFromID clears the value of the "rotated_from_id" field.
+func (atu *ApiTokenUpdate) ClearRotatedFromID() *ApiTokenUpdate {
+	atu.mutation.ClearRotatedFromID()
+	return atu
+}
+
 // SetUserID sets the "user_id" field.
 func (atu *ApiTokenUpdate) SetUserID(u uuid.UUID) *ApiTokenUpdate {
 	atu.mutation.SetUserID(u)
@@ -428,6 +555,50 @@ func (atu *ApiTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := atu.mutation.AddedCreditsSpent(); ok {
 		_spec.AddField(apitoken.FieldCreditsSpent, field.TypeInt, value)
 	}
+	if value, ok := atu.mutation.Scopes(); ok {
+		_spec.SetField(apitoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := atu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apitoken.FieldScopes, value)
+		})
+	}
+	if atu.mutation.ScopesCleared() {
+		_spec.ClearField(apitoken.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := atu.mutation.ExpiresAt(); ok {
+		_spec.SetField(apitoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if atu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apitoken.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := atu.mutation.MonthlyCreditCap(); ok {
+		_spec.SetField(apitoken.FieldMonthlyCreditCap, field.TypeInt, value)
+	}
+	if value, ok := atu.mutation.AddedMonthlyCreditCap(); ok {
+		_spec.AddField(apitoken.FieldMonthlyCreditCap, field.TypeInt, value)
+	}
+	if atu.mutation.MonthlyCreditCapCleared() {
+		_spec.ClearField(apitoken.FieldMonthlyCreditCap, field.TypeInt)
+	}
+	if value, ok := atu.mutation.MonthlyCreditsSpent(); ok {
+		_spec.SetField(apitoken.FieldMonthlyCreditsSpent, field.TypeInt, value)
+	}
+	if value, ok := atu.mutation.AddedMonthlyCreditsSpent(); ok {
+		_spec.AddField(apitoken.FieldMonthlyCreditsSpent, field.TypeInt, value)
+	}
+	if value, ok := atu.mutation.MonthlyCreditsPeriod(); ok {
+		_spec.SetField(apitoken.FieldMonthlyCreditsPeriod, field.TypeTime, value)
+	}
+	if atu.mutation.MonthlyCreditsPeriodCleared() {
+		_spec.ClearField(apitoken.FieldMonthlyCreditsPeriod, field.TypeTime)
+	}
+	if value, ok := atu.mutation.RotatedFromID(); ok {
+		_spec.SetField(apitoken.FieldRotatedFromID, field.TypeUUID, value)
+	}
+	if atu.mutation.RotatedFromIDCleared() {
+		_spec.ClearField(apitoken.FieldRotatedFromID, field.TypeUUID)
+	}
 	if value, ok := atu.mutation.LastUsedAt(); ok {
 		_spec.SetField(apitoken.FieldLastUsedAt, field.TypeTime, value)
 	}
@@ -750,6 +921,132 @@ func (atuo *ApiTokenUpdateOne) AddCreditsSpent(i int) *ApiTokenUpdateOne {
 	return atuo
 }
 
+// SetScopes sets the "scopes" field.
+func (atuo *ApiTokenUpdateOne) SetScopes(s []string) *ApiTokenUpdateOne {
+	atuo.mutation.SetScopes(s)
+	return atuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (atuo *ApiTokenUpdateOne) AppendScopes(s []string) *ApiTokenUpdateOne {
+	atuo.mutation.AppendScopes(s)
+	return atuo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (atuo *ApiTokenUpdateOne) ClearScopes() *ApiTokenUpdateOne {
+	atuo.mutation.ClearScopes()
+	return atuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (atuo *ApiTokenUpdateOne) SetExpiresAt(t time.Time) *ApiTokenUpdateOne {
+	atuo.mutation.SetExpiresAt(t)
+	return atuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (atuo *ApiTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *ApiTokenUpdateOne {
+	if t != nil {
+		atuo.SetExpiresAt(*t)
+	}
+	return atuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (atuo *ApiTokenUpdateOne) ClearExpiresAt() *ApiTokenUpdateOne {
+	atuo.mutation.ClearExpiresAt()
+	return atuo
+}
+
+// SetMonthlyCreditCap sets the "monthly_credit_cap" field.
+func (atuo *ApiTokenUpdateOne) SetMonthlyCreditCap(i int) *ApiTokenUpdateOne {
+	atuo.mutation.ResetMonthlyCreditCap()
+	atuo.mutation.SetMonthlyCreditCap(i)
+	return atuo
+}
+
+// SetNillableMonthlyCreditCap sets the "monthly_credit_cap" field if the given value is not nil.
+func (atuo *ApiTokenUpdateOne) SetNillableMonthlyCreditCap(i *int) *ApiTokenUpdateOne {
+	if i != nil {
+		atuo.SetMonthlyCreditCap(*i)
+	}
+	return atuo
+}
+
+// AddMonthlyCreditCap adds i to the "monthly_credit_cap" field.
+func (atuo *ApiTokenUpdateOne) AddMonthlyCreditCap(i int) *ApiTokenUpdateOne {
+	atuo.mutation.AddMonthlyCreditCap(i)
+	return atuo
+}
+
+// ClearMonthlyCreditCap clears the value of the "monthly_credit_cap" field.
+func (atuo *ApiTokenUpdateOne) ClearMonthlyCreditCap() *ApiTokenUpdateOne {
+	atuo.mutation.ClearMonthlyCreditCap()
+	return atuo
+}
+
+// SetMonthlyCreditsSpent sets the "monthly_credits_spent" field.
+func (atuo *ApiTokenUpdateOne) SetMonthlyCreditsSpent(i int) *ApiTokenUpdateOne {
+	atuo.mutation.ResetMonthlyCreditsSpent()
+	atuo.mutation.SetMonthlyCreditsSpent(i)
+	return atuo
+}
+
+// SetNillableMonthlyCreditsSpent sets the "monthly_credits_spent" field if the given value is not nil.
+func (atuo *ApiTokenUpdateOne) SetNillableMonthlyCreditsSpent(i *int) *ApiTokenUpdateOne {
+	if i != nil {
+		atuo.SetMonthlyCreditsSpent(*i)
+	}
+	return atuo
+}
+
+// AddMonthlyCreditsSpent adds i to the "monthly_credits_spent" field.
+func (atuo *ApiTokenUpdateOne) AddMonthlyCreditsSpent(i int) *ApiTokenUpdateOne {
+	atuo.mutation.AddMonthlyCreditsSpent(i)
+	return atuo
+}
+
+// SetMonthlyCreditsPeriod sets the "monthly_credits_period" field.
+func (atuo *ApiTokenUpdateOne) SetMonthlyCreditsPeriod(t time.Time) *ApiTokenUpdateOne {
+	atuo.mutation.SetMonthlyCreditsPeriod(t)
+	return atuo
+}
+
+// SetNillableMonthlyCreditsPeriod sets the "monthly_credits_period" field if the given value is not nil.
+func (atuo *ApiTokenUpdateOne) SetNillableMonthlyCreditsPeriod(t *time.Time) *ApiTokenUpdateOne {
+	if t != nil {
+		atuo.SetMonthlyCreditsPeriod(*t)
+	}
+	return atuo
+}
+
+// ClearMonthlyCreditsPeriod clears the value of the "monthly_credits_period" field.
+func (atuo *ApiTokenUpdateOne) ClearMonthlyCreditsPeriod() *ApiTokenUpdateOne {
+	atuo.mutation.ClearMonthlyCreditsPeriod()
+	return atuo
+}
+
+// SetRotatedFromID sets the "rotated_from_id" field.
+func (atuo *ApiTokenUpdateOne) SetRotatedFromID(u uuid.UUID) *ApiTokenUpdateOne {
+	atuo.mutation.SetRotatedFromID(u)
+	return atuo
+}
+
+// SetNillableRotatedFromID sets the "rotated_from_id" field if the given value is not nil.
+func (atuo *ApiTokenUpdateOne) SetNillableRotatedFromID(u *uuid.UUID) *ApiTokenUpdateOne {
+	if u != nil {
+		atuo.SetRotatedFromID(*u)
+	}
+	return atuo
+}
+
+// ClearRotatedFromID clears the value of the "rotated_from_id" field.
+func (atuo *ApiTokenUpdateOne) ClearRotatedFromID() *ApiTokenUpdateOne {
+	atuo.mutation.ClearRotatedFromID()
+	return atuo
+}
+
 // SetUserID sets the "user_id" field.
 func (atuo *ApiTokenUpdateOne) SetUserID(u uuid.UUID) *ApiTokenUpdateOne {
 	atuo.mutation.SetUserID(u)
@@ -1075,6 +1372,50 @@ func (atuo *ApiTokenUpdateOne) sqlSave(ctx context.Context) (_node *ApiToken, er
 	if value, ok := atuo.mutation.AddedCreditsSpent(); ok {
 		_spec.AddField(apitoken.FieldCreditsSpent, field.TypeInt, value)
 	}
+	if value, ok := atuo.mutation.Scopes(); ok {
+		_spec.SetField(apitoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := atuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apitoken.FieldScopes, value)
+		})
+	}
+	if atuo.mutation.ScopesCleared() {
+		_spec.ClearField(apitoken.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := atuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(apitoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if atuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apitoken.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := atuo.mutation.MonthlyCreditCap(); ok {
+		_spec.SetField(apitoken.FieldMonthlyCreditCap, field.TypeInt, value)
+	}
+	if value, ok := atuo.mutation.AddedMonthlyCreditCap(); ok {
+		_spec.AddField(apitoken.FieldMonthlyCreditCap, field.TypeInt, value)
+	}
+	if atuo.mutation.MonthlyCreditCapCleared() {
+		_spec.ClearField(apitoken.FieldMonthlyCreditCap, field.TypeInt)
+	}
+	if value, ok := atuo.mutation.MonthlyCreditsSpent(); ok {
+		_spec.SetField(apitoken.FieldMonthlyCreditsSpent, field.TypeInt, value)
+	}
+	if value, ok := atuo.mutation.AddedMonthlyCreditsSpent(); ok {
+		_spec.AddField(apitoken.FieldMonthlyCreditsSpent, field.TypeInt, value)
+	}
+	if value, ok := atuo.mutation.MonthlyCreditsPeriod(); ok {
+		_spec.SetField(apitoken.FieldMonthlyCreditsPeriod, field.TypeTime, value)
+	}
+	if atuo.mutation.MonthlyCreditsPeriodCleared() {
+		_spec.ClearField(apitoken.FieldMonthlyCreditsPeriod, field.TypeTime)
+	}
+	if value, ok := atuo.mutation.RotatedFromID(); ok {
+		_spec.SetField(apitoken.FieldRotatedFromID, field.TypeUUID, value)
+	}
+	if atuo.mutation.RotatedFromIDCleared() {
+		_spec.ClearField(apitoken.FieldRotatedFromID, field.TypeUUID)
+	}
 	if value, ok := atuo.mutation.LastUsedAt(); ok {
 		_spec.SetField(apitoken.FieldLastUsedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/apitokenusage"
+)
+
+// ApiTokenUsage is the model entity for the ApiTokenUsage schema.
+type ApiTokenUsage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// APITokenID holds the value of the "api_token_id" field.
+	APITokenID uuid.UUID `json:"api_token_id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// Endpoint holds the value of the "endpoint" field.
+	Endpoint string `json:"endpoint,omitempty"`
+	// CreditsSpent holds the value of the "credits_spent" field.
+	CreditsSpent int `json:"credits_spent,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ApiTokenUsage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case apitokenusage.FieldCreditsSpent:
+			values[i] = new(sql.NullInt64)
+		case apitokenusage.FieldIP, apitokenusage.FieldEndpoint:
+			values[i] = new(sql.NullString)
+		case apitokenusage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case apitokenusage.FieldID, apitokenusage.FieldAPITokenID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ApiTokenUsage fields.
+func (atu *ApiTokenUsage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case apitokenusage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				atu.ID = *value
+			}
+		case apitokenusage.FieldAPITokenID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field api_token_id", values[i])
+			} else if value != nil {
+				atu.APITokenID = *value
+			}
+		case apitokenusage.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				atu.IP = value.String
+			}
+		case apitokenusage.FieldEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint", values[i])
+			} else if value.Valid {
+				atu.Endpoint = value.String
+			}
+		case apitokenusage.FieldCreditsSpent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credits_spent", values[i])
+			} else if value.Valid {
+				atu.CreditsSpent = int(value.Int64)
+			}
+		case apitokenusage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				atu.CreatedAt = value.Time
+			}
+		default:
+			atu.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ApiTokenUsage.
+// This includes values selected through modifiers, order, etc.
+func (atu *ApiTokenUsage) Value(name string) (ent.Value, error) {
+	return atu.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ApiTokenUsage.
+// Note that you need to call ApiTokenUsage.Unwrap() before calling this method if this ApiTokenUsage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (atu *ApiTokenUsage) Update() *ApiTokenUsageUpdateOne {
+	return NewApiTokenUsageClient(atu.config).UpdateOne(atu)
+}
+
+// Unwrap unwraps the ApiTokenUsage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (atu *ApiTokenUsage) Unwrap() *ApiTokenUsage {
+	_tx, ok := atu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ApiTokenUsage is not a transactional entity")
+	}
+	atu.config.driver = _tx.drv
+	return atu
+}
+
+// String implements the fmt.Stringer.
+func (atu *ApiTokenUsage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ApiTokenUsage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", atu.ID))
+	builder.WriteString("api_token_id=")
+	builder.WriteString(fmt.Sprintf("%v", atu.APITokenID))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(atu.IP)
+	builder.WriteString(", ")
+	builder.WriteString("endpoint=")
+	builder.WriteString(atu.Endpoint)
+	builder.WriteString(", ")
+	builder.WriteString("credits_spent=")
+	builder.WriteString(fmt.Sprintf("%v", atu.CreditsSpent))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(atu.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ApiTokenUsages is a parsable slice of ApiTokenUsage.
+type ApiTokenUsages []*ApiTokenUsage
@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apitokenusage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldLTE(FieldID, id))
+}
+
+// APITokenID applies equality check predicate on the "api_token_id" field. It's identical to APITokenIDEQ.
+func APITokenID(v uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEQ(FieldAPITokenID, v))
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEQ(FieldIP, v))
+}
+
+// Endpoint applies equality check predicate on the "endpoint" field. It's identical to EndpointEQ.
+func Endpoint(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// CreditsSpent applies equality check predicate on the "credits_spent" field. It's identical to CreditsSpentEQ.
+func CreditsSpent(v int) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEQ(FieldCreditsSpent, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// APITokenIDEQ applies the EQ predicate on the "api_token_id" field.
+func APITokenIDEQ(v uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEQ(FieldAPITokenID, v))
+}
+
+// APITokenIDNEQ applies the NEQ predicate on the "api_token_id" field.
+func APITokenIDNEQ(v uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldNEQ(FieldAPITokenID, v))
+}
+
+// APITokenIDIn applies the In predicate on the "api_token_id" field.
+func APITokenIDIn(vs ...uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldIn(FieldAPITokenID, vs...))
+}
+
+// APITokenIDNotIn applies the NotIn predicate on the "api_token_id" field.
+func APITokenIDNotIn(vs ...uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldNotIn(FieldAPITokenID, vs...))
+}
+
+// APITokenIDGT applies the GT predicate on the "api_token_id" field.
+func APITokenIDGT(v uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldGT(FieldAPITokenID, v))
+}
+
+// APITokenIDGTE applies the GTE predicate on the "api_token_id" field.
+func APITokenIDGTE(v uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldGTE(FieldAPITokenID, v))
+}
+
+// APITokenIDLT applies the LT predicate on the "api_token_id" field.
+func APITokenIDLT(v uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldLT(FieldAPITokenID, v))
+}
+
+// APITokenIDLTE applies the LTE predicate on the "api_token_id" field.
+func APITokenIDLTE(v uuid.UUID) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldLTE(FieldAPITokenID, v))
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEQ(FieldIP, v))
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldNEQ(FieldIP, v))
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldIn(FieldIP, vs...))
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldNotIn(FieldIP, vs...))
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldGT(FieldIP, v))
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldGTE(FieldIP, v))
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldLT(FieldIP, v))
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldLTE(FieldIP, v))
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldContains(FieldIP, v))
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldHasPrefix(FieldIP, v))
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldHasSuffix(FieldIP, v))
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEqualFold(FieldIP, v))
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldContainsFold(FieldIP, v))
+}
+
+// EndpointEQ applies the EQ predicate on the "endpoint" field.
+func EndpointEQ(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// EndpointNEQ applies the NEQ predicate on the "endpoint" field.
+func EndpointNEQ(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldNEQ(FieldEndpoint, v))
+}
+
+// EndpointIn applies the In predicate on the "endpoint" field.
+func EndpointIn(vs ...string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldIn(FieldEndpoint, vs...))
+}
+
+// EndpointNotIn applies the NotIn predicate on the "endpoint" field.
+func EndpointNotIn(vs ...string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldNotIn(FieldEndpoint, vs...))
+}
+
+// EndpointGT applies the GT predicate on the "endpoint" field.
+func EndpointGT(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldGT(FieldEndpoint, v))
+}
+
+// EndpointGTE applies the GTE predicate on the "endpoint" field.
+func EndpointGTE(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldGTE(FieldEndpoint, v))
+}
+
+// EndpointLT applies the LT predicate on the "endpoint" field.
+func EndpointLT(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldLT(FieldEndpoint, v))
+}
+
+// EndpointLTE applies the LTE predicate on the "endpoint" field.
+func EndpointLTE(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldLTE(FieldEndpoint, v))
+}
+
+// EndpointContains applies the Contains predicate on the "endpoint" field.
+func EndpointContains(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldContains(FieldEndpoint, v))
+}
+
+// EndpointHasPrefix applies the HasPrefix predicate on the "endpoint" field.
+func EndpointHasPrefix(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldHasPrefix(FieldEndpoint, v))
+}
+
+// EndpointHasSuffix applies the HasSuffix predicate on the "endpoint" field.
+func EndpointHasSuffix(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldHasSuffix(FieldEndpoint, v))
+}
+
+// EndpointEqualFold applies the EqualFold predicate on the "endpoint" field.
+func EndpointEqualFold(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEqualFold(FieldEndpoint, v))
+}
+
+// EndpointContainsFold applies the ContainsFold predicate on the "endpoint" field.
+func EndpointContainsFold(v string) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldContainsFold(FieldEndpoint, v))
+}
+
+// CreditsSpentEQ applies the EQ predicate on the "credits_spent" field.
+func CreditsSpentEQ(v int) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEQ(FieldCreditsSpent, v))
+}
+
+// CreditsSpentNEQ applies the NEQ predicate on the "credits_spent" field.
+func CreditsSpentNEQ(v int) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldNEQ(FieldCreditsSpent, v))
+}
+
+// CreditsSpentIn applies the In predicate on the "credits_spent" field.
+func CreditsSpentIn(vs ...int) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldIn(FieldCreditsSpent, vs...))
+}
+
+// CreditsSpentNotIn applies the NotIn predicate on the "credits_spent" field.
+func CreditsSpentNotIn(vs ...int) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldNotIn(FieldCreditsSpent, vs...))
+}
+
+// CreditsSpentGT applies the GT predicate on the "credits_spent" field.
+func CreditsSpentGT(v int) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldGT(FieldCreditsSpent, v))
+}
+
+// CreditsSpentGTE applies the GTE predicate on the "credits_spent" field.
+func CreditsSpentGTE(v int) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldGTE(FieldCreditsSpent, v))
+}
+
+// CreditsSpentLT applies the LT predicate on the "credits_spent" field.
+func CreditsSpentLT(v int) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldLT(FieldCreditsSpent, v))
+}
+
+// CreditsSpentLTE applies the LTE predicate on the "credits_spent" field.
+func CreditsSpentLTE(v int) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldLTE(FieldCreditsSpent, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ApiTokenUsage) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ApiTokenUsage) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ApiTokenUsage) predicate.ApiTokenUsage {
+	return predicate.ApiTokenUsage(sql.NotPredicates(p))
+}
@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/apitokenusage"
+)
+
+// ApiTokenUsageCreate is the builder for creating a ApiTokenUsage entity.
+type ApiTokenUsageCreate struct {
+	config
+	mutation *ApiTokenUsageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (atuc *ApiTokenUsageCreate) SetAPITokenID(u uuid.UUID) *ApiTokenUsageCreate {
+	atuc.mutation.SetAPITokenID(u)
+	return atuc
+}
+
+// SetIP sets the "ip" field.
+func (atuc *ApiTokenUsageCreate) SetIP(s string) *ApiTokenUsageCreate {
+	atuc.mutation.SetIP(s)
+	return atuc
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (atuc *ApiTokenUsageCreate) SetEndpoint(s string) *ApiTokenUsageCreate {
+	atuc.mutation.SetEndpoint(s)
+	return atuc
+}
+
+// SetCreditsSpent sets the "credits_spent" field.
+func (atuc *ApiTokenUsageCreate) SetCreditsSpent(i int) *ApiTokenUsageCreate {
+	atuc.mutation.SetCreditsSpent(i)
+	return atuc
+}
+
+// SetNillableCreditsSpent sets the "credits_spent" field if the given value is not nil.
+func (atuc *ApiTokenUsageCreate) SetNillableCreditsSpent(i *int) *ApiTokenUsageCreate {
+	if i != nil {
+		atuc.SetCreditsSpent(*i)
+	}
+	return atuc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (atuc *ApiTokenUsageCreate) SetCreatedAt(t time.Time) *ApiTokenUsageCreate {
+	atuc.mutation.SetCreatedAt(t)
+	return atuc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atuc *ApiTokenUsageCreate) SetNillableCreatedAt(t *time.Time) *ApiTokenUsageCreate {
+	if t != nil {
+		atuc.SetCreatedAt(*t)
+	}
+	return atuc
+}
+
+// SetID sets the "id" field.
+func (atuc *ApiTokenUsageCreate) SetID(u uuid.UUID) *ApiTokenUsageCreate {
+	atuc.mutation.SetID(u)
+	return atuc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (atuc *ApiTokenUsageCreate) SetNillableID(u *uuid.UUID) *ApiTokenUsageCreate {
+	if u != nil {
+		atuc.SetID(*u)
+	}
+	return atuc
+}
+
+// Mutation returns the ApiTokenUsageMutation object of the builder.
+func (atuc *ApiTokenUsageCreate) Mutation() *ApiTokenUsageMutation {
+	return atuc.mutation
+}
+
+// Save creates the ApiTokenUsage in the database.
+func (atuc *ApiTokenUsageCreate) Save(ctx context.Context) (*ApiTokenUsage, error) {
+	atuc.defaults()
+	return withHooks(ctx, atuc.sqlSave, atuc.mutation, atuc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atuc *ApiTokenUsageCreate) SaveX(ctx context.Context) *ApiTokenUsage {
+	v, err := atuc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atuc *ApiTokenUsageCreate) Exec(ctx context.Context) error {
+	_, err := atuc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuc *ApiTokenUsageCreate) ExecX(ctx context.Context) {
+	if err := atuc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atuc *ApiTokenUsageCreate) defaults() {
+	if _, ok := atuc.mutation.CreditsSpent(); !ok {
+		v := apitokenusage.DefaultCreditsSpent
+		atuc.mutation.SetCreditsSpent(v)
+	}
+	if _, ok := atuc.mutation.CreatedAt(); !ok {
+		v := apitokenusage.DefaultCreatedAt()
+		atuc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := atuc.mutation.ID(); !ok {
+		v := apitokenusage.DefaultID()
+		atuc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atuc *ApiTokenUsageCreate) check() error {
+	if _, ok := atuc.mutation.APITokenID(); !ok {
+		return &ValidationError{Name: "api_token_id", err: errors.New(`ent: missing required field "ApiTokenUsage.api_token_id"`)}
+	}
+	if _, ok := atuc.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "ApiTokenUsage.ip"`)}
+	}
+	if _, ok := atuc.mutation.Endpoint(); !ok {
+		return &ValidationError{Name: "endpoint", err: errors.New(`ent: missing required field "ApiTokenUsage.endpoint"`)}
+	}
+	if _, ok := atuc.mutation.CreditsSpent(); !ok {
+		return &ValidationError{Name: "credits_spent", err: errors.New(`ent: missing required field "ApiTokenUsage.credits_spent"`)}
+	}
+	if _, ok := atuc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ApiTokenUsage.created_at"`)}
+	}
+	return nil
+}
+
+func (atuc *ApiTokenUsageCreate) sqlSave(ctx context.Context) (*ApiTokenUsage, error) {
+	if err := atuc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := atuc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, atuc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	atuc.mutation.id = &_node.ID
+	atuc.mutation.done = true
+	return _node, nil
+}
+
+func (atuc *ApiTokenUsageCreate) createSpec() (*ApiTokenUsage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ApiTokenUsage{config: atuc.config}
+		_spec = sqlgraph.NewCreateSpec(apitokenusage.Table, sqlgraph.NewFieldSpec(apitokenusage.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = atuc.conflict
+	if id, ok := atuc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := atuc.mutation.APITokenID(); ok {
+		_spec.SetField(apitokenusage.FieldAPITokenID, field.TypeUUID, value)
+		_node.APITokenID = value
+	}
+	if value, ok := atuc.mutation.IP(); ok {
+		_spec.SetField(apitokenusage.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := atuc.mutation.Endpoint(); ok {
+		_spec.SetField(apitokenusage.FieldEndpoint, field.TypeString, value)
+		_node.Endpoint = value
+	}
+	if value, ok := atuc.mutation.CreditsSpent(); ok {
+		_spec.SetField(apitokenusage.FieldCreditsSpent, field.TypeInt, value)
+		_node.CreditsSpent = value
+	}
+	if value, ok := atuc.mutation.CreatedAt(); ok {
+		_spec.SetField(apitokenusage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ApiTokenUsage.Create().
+//		SetAPITokenID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ApiTokenUsageUpsert) {
+//			SetAPITokenID(v+v).
+//		}).
+//		Exec(ctx)
+func (atuc *ApiTokenUsageCreate) OnConflict(opts ...sql.ConflictOption) *ApiTokenUsageUpsertOne {
+	atuc.conflict = opts
+	return &ApiTokenUsageUpsertOne{
+		create: atuc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ApiTokenUsage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (atuc *ApiTokenUsageCreate) OnConflictColumns(columns ...string) *ApiTokenUsageUpsertOne {
+	atuc.conflict = append(atuc.conflict, sql.ConflictColumns(columns...))
+	return &ApiTokenUsageUpsertOne{
+		create: atuc,
+	}
+}
+
+type (
+	// ApiTokenUsageUpsertOne is the builder for "upsert"-ing
+	//  one ApiTokenUsage node.
+	ApiTokenUsageUpsertOne struct {
+		create *ApiTokenUsageCreate
+	}
+
+	// ApiTokenUsageUpsert is the "OnConflict" setter.
+	ApiTokenUsageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreditsSpent sets the "credits_spent" field.
+func (u *ApiTokenUsageUpsert) SetCreditsSpent(v int) *ApiTokenUsageUpsert {
+	u.Set(apitokenusage.FieldCreditsSpent, v)
+	return u
+}
+
+// UpdateCreditsSpent sets the "credits_spent" field to the value that was provided on create.
+func (u *ApiTokenUsageUpsert) UpdateCreditsSpent() *ApiTokenUsageUpsert {
+	u.SetExcluded(apitokenusage.FieldCreditsSpent)
+	return u
+}
+
+// AddCreditsSpent adds v to the "credits_spent" field.
+func (u *ApiTokenUsageUpsert) AddCreditsSpent(v int) *ApiTokenUsageUpsert {
+	u.Add(apitokenusage.FieldCreditsSpent, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ApiTokenUsage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apitokenusage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ApiTokenUsageUpsertOne) UpdateNewValues() *ApiTokenUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(apitokenusage.FieldID)
+		}
+		if _, exists := u.create.mutation.APITokenID(); exists {
+			s.SetIgnore(apitokenusage.FieldAPITokenID)
+		}
+		if _, exists := u.create.mutation.IP(); exists {
+			s.SetIgnore(apitokenusage.FieldIP)
+		}
+		if _, exists := u.create.mutation.Endpoint(); exists {
+			s.SetIgnore(apitokenusage.FieldEndpoint)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(apitokenusage.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ApiTokenUsage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ApiTokenUsageUpsertOne) Ignore() *ApiTokenUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ApiTokenUsageUpsertOne) DoNothing() *ApiTokenUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ApiTokenUsageCreate.OnConflict
+// documentation for more info.
+func (u *ApiTokenUsageUpsertOne) Update(set func(*ApiTokenUsageUpsert)) *ApiTokenUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ApiTokenUsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreditsSpent sets the "credits_spent" field.
+func (u *ApiTokenUsageUpsertOne) SetCreditsSpent(v int) *ApiTokenUsageUpsertOne {
+	return u.Update(func(s *ApiTokenUsageUpsert) {
+		s.SetCreditsSpent(v)
+	})
+}
+
+// AddCreditsSpent adds v to the "credits_spent" field.
+func (u *ApiTokenUsageUpsertOne) AddCreditsSpent(v int) *ApiTokenUsageUpsertOne {
+	return u.Update(func(s *ApiTokenUsageUpsert) {
+		s.AddCreditsSpent(v)
+	})
+}
+
+// UpdateCreditsSpent sets the "credits_spent" field to the value that was provided on create.
+func (u *ApiTokenUsageUpsertOne) UpdateCreditsSpent() *ApiTokenUsageUpsertOne {
+	return u.Update(func(s *ApiTokenUsageUpsert) {
+		s.UpdateCreditsSpent()
+	})
+}
+
+// Exec executes the query.
+func (u *ApiTokenUsageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ApiTokenUsageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ApiTokenUsageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ApiTokenUsageUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ApiTokenUsageUpsertOne.ID is not supported by MySQL driver. Use ApiTokenUsageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ApiTokenUsageUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ApiTokenUsageCreateBulk is the builder for creating many ApiTokenUsage entities in bulk.
+type ApiTokenUsageCreateBulk struct {
+	config
+	err      error
+	builders []*ApiTokenUsageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ApiTokenUsage entities in the database.
+func (atucb *ApiTokenUsageCreateBulk) Save(ctx context.Context) ([]*ApiTokenUsage, error) {
+	if atucb.err != nil {
+		return nil, atucb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(atucb.builders))
+	nodes := make([]*ApiTokenUsage, len(atucb.builders))
+	mutators := make([]Mutator, len(atucb.builders))
+	for i := range atucb.builders {
+		func(i int, root context.Context) {
+			builder := atucb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ApiTokenUsageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, atucb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = atucb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, atucb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, atucb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atucb *ApiTokenUsageCreateBulk) SaveX(ctx context.Context) []*ApiTokenUsage {
+	v, err := atucb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atucb *ApiTokenUsageCreateBulk) Exec(ctx context.Context) error {
+	_, err := atucb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atucb *ApiTokenUsageCreateBulk) ExecX(ctx context.Context) {
+	if err := atucb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ApiTokenUsage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ApiTokenUsageUpsert) {
+//			SetAPITokenID(v+v).
+//		}).
+//		Exec(ctx)
+func (atucb *ApiTokenUsageCreateBulk) OnConflict(opts ...sql.ConflictOption) *ApiTokenUsageUpsertBulk {
+	atucb.conflict = opts
+	return &ApiTokenUsageUpsertBulk{
+		create: atucb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ApiTokenUsage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (atucb *ApiTokenUsageCreateBulk) OnConflictColumns(columns ...string) *ApiTokenUsageUpsertBulk {
+	atucb.conflict = append(atucb.conflict, sql.ConflictColumns(columns...))
+	return &ApiTokenUsageUpsertBulk{
+		create: atucb,
+	}
+}
+
+// ApiTokenUsageUpsertBulk is the builder for "upsert"-ing
+// a bulk of ApiTokenUsage nodes.
+type ApiTokenUsageUpsertBulk struct {
+	create *ApiTokenUsageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ApiTokenUsage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apitokenusage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ApiTokenUsageUpsertBulk) UpdateNewValues() *ApiTokenUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(apitokenusage.FieldID)
+			}
+			if _, exists := b.mutation.APITokenID(); exists {
+				s.SetIgnore(apitokenusage.FieldAPITokenID)
+			}
+			if _, exists := b.mutation.IP(); exists {
+				s.SetIgnore(apitokenusage.FieldIP)
+			}
+			if _, exists := b.mutation.Endpoint(); exists {
+				s.SetIgnore(apitokenusage.FieldEndpoint)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(apitokenusage.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ApiTokenUsage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ApiTokenUsageUpsertBulk) Ignore() *ApiTokenUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ApiTokenUsageUpsertBulk) DoNothing() *ApiTokenUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ApiTokenUsageCreateBulk.OnConflict
+// documentation for more info.
+func (u *ApiTokenUsageUpsertBulk) Update(set func(*ApiTokenUsageUpsert)) *ApiTokenUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ApiTokenUsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreditsSpent sets the "credits_spent" field.
+func (u *ApiTokenUsageUpsertBulk) SetCreditsSpent(v int) *ApiTokenUsageUpsertBulk {
+	return u.Update(func(s *ApiTokenUsageUpsert) {
+		s.SetCreditsSpent(v)
+	})
+}
+
+// AddCreditsSpent adds v to the "credits_spent" field.
+func (u *ApiTokenUsageUpsertBulk) AddCreditsSpent(v int) *ApiTokenUsageUpsertBulk {
+	return u.Update(func(s *ApiTokenUsageUpsert) {
+		s.AddCreditsSpent(v)
+	})
+}
+
+// UpdateCreditsSpent sets the "credits_spent" field to the value that was provided on create.
+func (u *ApiTokenUsageUpsertBulk) UpdateCreditsSpent() *ApiTokenUsageUpsertBulk {
+	return u.Update(func(s *ApiTokenUsageUpsert) {
+		s.UpdateCreditsSpent()
+	})
+}
+
+// Exec executes the query.
+func (u *ApiTokenUsageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ApiTokenUsageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ApiTokenUsageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ApiTokenUsageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stablecog/sc-go/database/ent/apitokenusage"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// ApiTokenUsageDelete is the builder for deleting a ApiTokenUsage entity.
+type ApiTokenUsageDelete struct {
+	config
+	hooks    []Hook
+	mutation *ApiTokenUsageMutation
+}
+
+// Where appends a list predicates to the ApiTokenUsageDelete builder.
+func (atud *ApiTokenUsageDelete) Where(ps ...predicate.ApiTokenUsage) *ApiTokenUsageDelete {
+	atud.mutation.Where(ps...)
+	return atud
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (atud *ApiTokenUsageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, atud.sqlExec, atud.mutation, atud.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atud *ApiTokenUsageDelete) ExecX(ctx context.Context) int {
+	n, err := atud.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (atud *ApiTokenUsageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(apitokenusage.Table, sqlgraph.NewFieldSpec(apitokenusage.FieldID, field.TypeUUID))
+	if ps := atud.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, atud.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	atud.mutation.done = true
+	return affected, err
+}
+
+// ApiTokenUsageDeleteOne is the builder for deleting a single ApiTokenUsage entity.
+type ApiTokenUsageDeleteOne struct {
+	atud *ApiTokenUsageDelete
+}
+
+// Where appends a list predicates to the ApiTokenUsageDelete builder.
+func (atudo *ApiTokenUsageDeleteOne) Where(ps ...predicate.ApiTokenUsage) *ApiTokenUsageDeleteOne {
+	atudo.atud.mutation.Where(ps...)
+	return atudo
+}
+
+// Exec executes the deletion query.
+func (atudo *ApiTokenUsageDeleteOne) Exec(ctx context.Context) error {
+	n, err := atudo.atud.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{apitokenusage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atudo *ApiTokenUsageDeleteOne) ExecX(ctx context.Context) {
+	if err := atudo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/apitokenusage"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// ApiTokenUsageQuery is the builder for querying ApiTokenUsage entities.
+type ApiTokenUsageQuery struct {
+	config
+	ctx        *QueryContext
+	order      []apitokenusage.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ApiTokenUsage
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ApiTokenUsageQuery builder.
+func (atuq *ApiTokenUsageQuery) Where(ps ...predicate.ApiTokenUsage) *ApiTokenUsageQuery {
+	atuq.predicates = append(atuq.predicates, ps...)
+	return atuq
+}
+
+// Limit the number of records to be returned by this query.
+func (atuq *ApiTokenUsageQuery) Limit(limit int) *ApiTokenUsageQuery {
+	atuq.ctx.Limit = &limit
+	return atuq
+}
+
+// Offset to start from.
+func (atuq *ApiTokenUsageQuery) Offset(offset int) *ApiTokenUsageQuery {
+	atuq.ctx.Offset = &offset
+	return atuq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (atuq *ApiTokenUsageQuery) Unique(unique bool) *ApiTokenUsageQuery {
+	atuq.ctx.Unique = &unique
+	return atuq
+}
+
+// Order specifies how the records should be ordered.
+func (atuq *ApiTokenUsageQuery) Order(o ...apitokenusage.OrderOption) *ApiTokenUsageQuery {
+	atuq.order = append(atuq.order, o...)
+	return atuq
+}
+
+// First returns the first ApiTokenUsage entity from the query.
+// Returns a *NotFoundError when no ApiTokenUsage was found.
+func (atuq *ApiTokenUsageQuery) First(ctx context.Context) (*ApiTokenUsage, error) {
+	nodes, err := atuq.Limit(1).All(setContextOp(ctx, atuq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{apitokenusage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (atuq *ApiTokenUsageQuery) FirstX(ctx context.Context) *ApiTokenUsage {
+	node, err := atuq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ApiTokenUsage ID from the query.
+// Returns a *NotFoundError when no ApiTokenUsage ID was found.
+func (atuq *ApiTokenUsageQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = atuq.Limit(1).IDs(setContextOp(ctx, atuq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{apitokenusage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (atuq *ApiTokenUsageQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := atuq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ApiTokenUsage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ApiTokenUsage entity is found.
+// Returns a *NotFoundError when no ApiTokenUsage entities are found.
+func (atuq *ApiTokenUsageQuery) Only(ctx context.Context) (*ApiTokenUsage, error) {
+	nodes, err := atuq.Limit(2).All(setContextOp(ctx, atuq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{apitokenusage.Label}
+	default:
+		return nil, &NotSingularError{apitokenusage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (atuq *ApiTokenUsageQuery) OnlyX(ctx context.Context) *ApiTokenUsage {
+	node, err := atuq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ApiTokenUsage ID in the query.
+// Returns a *NotSingularError when more than one ApiTokenUsage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (atuq *ApiTokenUsageQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = atuq.Limit(2).IDs(setContextOp(ctx, atuq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{apitokenusage.Label}
+	default:
+		err = &NotSingularError{apitokenusage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (atuq *ApiTokenUsageQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := atuq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ApiTokenUsages.
+func (atuq *ApiTokenUsageQuery) All(ctx context.Context) ([]*ApiTokenUsage, error) {
+	ctx = setContextOp(ctx, atuq.ctx, ent.OpQueryAll)
+	if err := atuq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ApiTokenUsage, *ApiTokenUsageQuery]()
+	return withInterceptors[[]*ApiTokenUsage](ctx, atuq, qr, atuq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (atuq *ApiTokenUsageQuery) AllX(ctx context.Context) []*ApiTokenUsage {
+	nodes, err := atuq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ApiTokenUsage IDs.
+func (atuq *ApiTokenUsageQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if atuq.ctx.Unique == nil && atuq.path != nil {
+		atuq.Unique(true)
+	}
+	ctx = setContextOp(ctx, atuq.ctx, ent.OpQueryIDs)
+	if err = atuq.Select(apitokenusage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (atuq *ApiTokenUsageQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := atuq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (atuq *ApiTokenUsageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, atuq.ctx, ent.OpQueryCount)
+	if err := atuq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, atuq, querierCount[*ApiTokenUsageQuery](), atuq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (atuq *ApiTokenUsageQuery) CountX(ctx context.Context) int {
+	count, err := atuq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (atuq *ApiTokenUsageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, atuq.ctx, ent.OpQueryExist)
+	switch _, err := atuq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (atuq *ApiTokenUsageQuery) ExistX(ctx context.Context) bool {
+	exist, err := atuq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ApiTokenUsageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (atuq *ApiTokenUsageQuery) Clone() *ApiTokenUsageQuery {
+	if atuq == nil {
+		return nil
+	}
+	return &ApiTokenUsageQuery{
+		config:     atuq.config,
+		ctx:        atuq.ctx.Clone(),
+		order:      append([]apitokenusage.OrderOption{}, atuq.order...),
+		inters:     append([]Interceptor{}, atuq.inters...),
+		predicates: append([]predicate.ApiTokenUsage{}, atuq.predicates...),
+		// clone intermediate query.
+		sql:  atuq.sql.Clone(),
+		path: atuq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		APITokenID uuid.UUID `json:"api_token_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ApiTokenUsage.Query().
+//		GroupBy(apitokenusage.FieldAPITokenID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (atuq *ApiTokenUsageQuery) GroupBy(field string, fields ...string) *ApiTokenUsageGroupBy {
+	atuq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ApiTokenUsageGroupBy{build: atuq}
+	grbuild.flds = &atuq.ctx.Fields
+	grbuild.label = apitokenusage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		APITokenID uuid.UUID `json:"api_token_id,omitempty"`
+//	}
+//
+//	client.ApiTokenUsage.Query().
+//		Select(apitokenusage.FieldAPITokenID).
+//		Scan(ctx, &v)
+func (atuq *ApiTokenUsageQuery) Select(fields ...string) *ApiTokenUsageSelect {
+	atuq.ctx.Fields = append(atuq.ctx.Fields, fields...)
+	sbuild := &ApiTokenUsageSelect{ApiTokenUsageQuery: atuq}
+	sbuild.label = apitokenusage.Label
+	sbuild.flds, sbuild.scan = &atuq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ApiTokenUsageSelect configured with the given aggregations.
+func (atuq *ApiTokenUsageQuery) Aggregate(fns ...AggregateFunc) *ApiTokenUsageSelect {
+	return atuq.Select().Aggregate(fns...)
+}
+
+func (atuq *ApiTokenUsageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range atuq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, atuq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range atuq.ctx.Fields {
+		if !apitokenusage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if atuq.path != nil {
+		prev, err := atuq.path(ctx)
+		if err != nil {
+			return err
+		}
+		atuq.sql = prev
+	}
+	return nil
+}
+
+func (atuq *ApiTokenUsageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ApiTokenUsage, error) {
+	var (
+		nodes = []*ApiTokenUsage{}
+		_spec = atuq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ApiTokenUsage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ApiTokenUsage{config: atuq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(atuq.modifiers) > 0 {
+		_spec.Modifiers = atuq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, atuq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (atuq *ApiTokenUsageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := atuq.querySpec()
+	if len(atuq.modifiers) > 0 {
+		_spec.Modifiers = atuq.modifiers
+	}
+	_spec.Node.Columns = atuq.ctx.Fields
+	if len(atuq.ctx.Fields) > 0 {
+		_spec.Unique = atuq.ctx.Unique != nil && *atuq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, atuq.driver, _spec)
+}
+
+func (atuq *ApiTokenUsageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(apitokenusage.Table, apitokenusage.Columns, sqlgraph.NewFieldSpec(apitokenusage.FieldID, field.TypeUUID))
+	_spec.From = atuq.sql
+	if unique := atuq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if atuq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := atuq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apitokenusage.FieldID)
+		for i := range fields {
+			if fields[i] != apitokenusage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := atuq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := atuq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := atuq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := atuq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (atuq *ApiTokenUsageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(atuq.driver.Dialect())
+	t1 := builder.Table(apitokenusage.Table)
+	columns := atuq.ctx.Fields
+	if len(columns) == 0 {
+		columns = apitokenusage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if atuq.sql != nil {
+		selector = atuq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if atuq.ctx.Unique != nil && *atuq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range atuq.modifiers {
+		m(selector)
+	}
+	for _, p := range atuq.predicates {
+		p(selector)
+	}
+	for _, p := range atuq.order {
+		p(selector)
+	}
+	if offset := atuq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := atuq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (atuq *ApiTokenUsageQuery) Modify(modifiers ...func(s *sql.Selector)) *ApiTokenUsageSelect {
+	atuq.modifiers = append(atuq.modifiers, modifiers...)
+	return atuq.Select()
+}
+
+// ApiTokenUsageGroupBy is the group-by builder for ApiTokenUsage entities.
+type ApiTokenUsageGroupBy struct {
+	selector
+	build *ApiTokenUsageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (atugb *ApiTokenUsageGroupBy) Aggregate(fns ...AggregateFunc) *ApiTokenUsageGroupBy {
+	atugb.fns = append(atugb.fns, fns...)
+	return atugb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (atugb *ApiTokenUsageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, atugb.build.ctx, ent.OpQueryGroupBy)
+	if err := atugb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ApiTokenUsageQuery, *ApiTokenUsageGroupBy](ctx, atugb.build, atugb, atugb.build.inters, v)
+}
+
+func (atugb *ApiTokenUsageGroupBy) sqlScan(ctx context.Context, root *ApiTokenUsageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(atugb.fns))
+	for _, fn := range atugb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*atugb.flds)+len(atugb.fns))
+		for _, f := range *atugb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*atugb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := atugb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ApiTokenUsageSelect is the builder for selecting fields of ApiTokenUsage entities.
+type ApiTokenUsageSelect struct {
+	*ApiTokenUsageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (atus *ApiTokenUsageSelect) Aggregate(fns ...AggregateFunc) *ApiTokenUsageSelect {
+	atus.fns = append(atus.fns, fns...)
+	return atus
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (atus *ApiTokenUsageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, atus.ctx, ent.OpQuerySelect)
+	if err := atus.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ApiTokenUsageQuery, *ApiTokenUsageSelect](ctx, atus.ApiTokenUsageQuery, atus, atus.inters, v)
+}
+
+func (atus *ApiTokenUsageSelect) sqlScan(ctx context.Context, root *ApiTokenUsageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(atus.fns))
+	for _, fn := range atus.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*atus.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := atus.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (atus *ApiTokenUsageSelect) Modify(modifiers ...func(s *sql.Selector)) *ApiTokenUsageSelect {
+	atus.modifiers = append(atus.modifiers, modifiers...)
+	return atus
+}
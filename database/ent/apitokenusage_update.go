@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stablecog/sc-go/database/ent/apitokenusage"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// ApiTokenUsageUpdate is the builder for updating ApiTokenUsage entities.
+type ApiTokenUsageUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ApiTokenUsageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ApiTokenUsageUpdate builder.
+func (atuu *ApiTokenUsageUpdate) Where(ps ...predicate.ApiTokenUsage) *ApiTokenUsageUpdate {
+	atuu.mutation.Where(ps...)
+	return atuu
+}
+
+// SetCreditsSpent sets the "credits_spent" field.
+func (atuu *ApiTokenUsageUpdate) SetCreditsSpent(i int) *ApiTokenUsageUpdate {
+	atuu.mutation.ResetCreditsSpent()
+	atuu.mutation.SetCreditsSpent(i)
+	return atuu
+}
+
+// SetNillableCreditsSpent sets the "credits_spent" field if the given value is not nil.
+func (atuu *ApiTokenUsageUpdate) SetNillableCreditsSpent(i *int) *ApiTokenUsageUpdate {
+	if i != nil {
+		atuu.SetCreditsSpent(*i)
+	}
+	return atuu
+}
+
+// AddCreditsSpent adds i to the "credits_spent" field.
+func (atuu *ApiTokenUsageUpdate) AddCreditsSpent(i int) *ApiTokenUsageUpdate {
+	atuu.mutation.AddCreditsSpent(i)
+	return atuu
+}
+
+// Mutation returns the ApiTokenUsageMutation object of the builder.
+func (atuu *ApiTokenUsageUpdate) Mutation() *ApiTokenUsageMutation {
+	return atuu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (atuu *ApiTokenUsageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, atuu.sqlSave, atuu.mutation, atuu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuu *ApiTokenUsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := atuu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (atuu *ApiTokenUsageUpdate) Exec(ctx context.Context) error {
+	_, err := atuu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuu *ApiTokenUsageUpdate) ExecX(ctx context.Context) {
+	if err := atuu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (atuu *ApiTokenUsageUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ApiTokenUsageUpdate {
+	atuu.modifiers = append(atuu.modifiers, modifiers...)
+	return atuu
+}
+
+func (atuu *ApiTokenUsageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(apitokenusage.Table, apitokenusage.Columns, sqlgraph.NewFieldSpec(apitokenusage.FieldID, field.TypeUUID))
+	if ps := atuu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atuu.mutation.CreditsSpent(); ok {
+		_spec.SetField(apitokenusage.FieldCreditsSpent, field.TypeInt, value)
+	}
+	if value, ok := atuu.mutation.AddedCreditsSpent(); ok {
+		_spec.AddField(apitokenusage.FieldCreditsSpent, field.TypeInt, value)
+	}
+	_spec.AddModifiers(atuu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, atuu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apitokenusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	atuu.mutation.done = true
+	return n, nil
+}
+
+// ApiTokenUsageUpdateOne is the builder for updating a single ApiTokenUsage entity.
+type ApiTokenUsageUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ApiTokenUsageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreditsSpent sets the "credits_spent" field.
+func (atuuo *ApiTokenUsageUpdateOne) SetCreditsSpent(i int) *ApiTokenUsageUpdateOne {
+	atuuo.mutation.ResetCreditsSpent()
+	atuuo.mutation.SetCreditsSpent(i)
+	return atuuo
+}
+
+// SetNillableCreditsSpent sets the "credits_spent" field if the given value is not nil.
+func (atuuo *ApiTokenUsageUpdateOne) SetNillableCreditsSpent(i *int) *ApiTokenUsageUpdateOne {
+	if i != nil {
+		atuuo.SetCreditsSpent(*i)
+	}
+	return atuuo
+}
+
+// AddCreditsSpent adds i to the "credits_spent" field.
+func (atuuo *ApiTokenUsageUpdateOne) AddCreditsSpent(i int) *ApiTokenUsageUpdateOne {
+	atuuo.mutation.AddCreditsSpent(i)
+	return atuuo
+}
+
+// Mutation returns the ApiTokenUsageMutation object of the builder.
+func (atuuo *ApiTokenUsageUpdateOne) Mutation() *ApiTokenUsageMutation {
+	return atuuo.mutation
+}
+
+// Where appends a list predicates to the ApiTokenUsageUpdate builder.
+func (atuuo *ApiTokenUsageUpdateOne) Where(ps ...predicate.ApiTokenUsage) *ApiTokenUsageUpdateOne {
+	atuuo.mutation.Where(ps...)
+	return atuuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (atuuo *ApiTokenUsageUpdateOne) Select(field string, fields ...string) *ApiTokenUsageUpdateOne {
+	atuuo.fields = append([]string{field}, fields...)
+	return atuuo
+}
+
+// Save executes the query and returns the updated ApiTokenUsage entity.
+func (atuuo *ApiTokenUsageUpdateOne) Save(ctx context.Context) (*ApiTokenUsage, error) {
+	return withHooks(ctx, atuuo.sqlSave, atuuo.mutation, atuuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuuo *ApiTokenUsageUpdateOne) SaveX(ctx context.Context) *ApiTokenUsage {
+	node, err := atuuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (atuuo *ApiTokenUsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := atuuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuuo *ApiTokenUsageUpdateOne) ExecX(ctx context.Context) {
+	if err := atuuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (atuuo *ApiTokenUsageUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ApiTokenUsageUpdateOne {
+	atuuo.modifiers = append(atuuo.modifiers, modifiers...)
+	return atuuo
+}
+
+func (atuuo *ApiTokenUsageUpdateOne) sqlSave(ctx context.Context) (_node *ApiTokenUsage, err error) {
+	_spec := sqlgraph.NewUpdateSpec(apitokenusage.Table, apitokenusage.Columns, sqlgraph.NewFieldSpec(apitokenusage.FieldID, field.TypeUUID))
+	id, ok := atuuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ApiTokenUsage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := atuuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apitokenusage.FieldID)
+		for _, f := range fields {
+			if !apitokenusage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apitokenusage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := atuuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atuuo.mutation.CreditsSpent(); ok {
+		_spec.SetField(apitokenusage.FieldCreditsSpent, field.TypeInt, value)
+	}
+	if value, ok := atuuo.mutation.AddedCreditsSpent(); ok {
+		_spec.AddField(apitokenusage.FieldCreditsSpent, field.TypeInt, value)
+	}
+	_spec.AddModifiers(atuuo.modifiers...)
+	_node = &ApiTokenUsage{config: atuuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, atuuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apitokenusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	atuuo.mutation.done = true
+	return _node, nil
+}
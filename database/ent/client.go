@@ -17,17 +17,25 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/stablecog/sc-go/database/ent/apitoken"
+	"github.com/stablecog/sc-go/database/ent/apitokenusage"
 	"github.com/stablecog/sc-go/database/ent/authclient"
 	"github.com/stablecog/sc-go/database/ent/bannedwords"
 	"github.com/stablecog/sc-go/database/ent/credit"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/credittransaction"
 	"github.com/stablecog/sc-go/database/ent/credittype"
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
+	"github.com/stablecog/sc-go/database/ent/deadletter"
 	"github.com/stablecog/sc-go/database/ent/deviceinfo"
 	"github.com/stablecog/sc-go/database/ent/disposableemail"
 	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
 	"github.com/stablecog/sc-go/database/ent/generationmodel"
 	"github.com/stablecog/sc-go/database/ent/generationoutput"
 	"github.com/stablecog/sc-go/database/ent/generationoutputlike"
+	"github.com/stablecog/sc-go/database/ent/generationpreset"
 	"github.com/stablecog/sc-go/database/ent/ipblacklist"
+	"github.com/stablecog/sc-go/database/ent/moderationverdict"
 	"github.com/stablecog/sc-go/database/ent/mqlog"
 	"github.com/stablecog/sc-go/database/ent/negativeprompt"
 	"github.com/stablecog/sc-go/database/ent/prompt"
@@ -55,28 +63,44 @@ type Client struct {
 	Schema *migrate.Schema
 	// ApiToken is the client for interacting with the ApiToken builders.
 	ApiToken *ApiTokenClient
+	// ApiTokenUsage is the client for interacting with the ApiTokenUsage builders.
+	ApiTokenUsage *ApiTokenUsageClient
 	// AuthClient is the client for interacting with the AuthClient builders.
 	AuthClient *AuthClientClient
 	// BannedWords is the client for interacting with the BannedWords builders.
 	BannedWords *BannedWordsClient
 	// Credit is the client for interacting with the Credit builders.
 	Credit *CreditClient
+	// CreditHold is the client for interacting with the CreditHold builders.
+	CreditHold *CreditHoldClient
+	// CreditTransaction is the client for interacting with the CreditTransaction builders.
+	CreditTransaction *CreditTransactionClient
 	// CreditType is the client for interacting with the CreditType builders.
 	CreditType *CreditTypeClient
+	// CronJobRun is the client for interacting with the CronJobRun builders.
+	CronJobRun *CronJobRunClient
+	// DeadLetter is the client for interacting with the DeadLetter builders.
+	DeadLetter *DeadLetterClient
 	// DeviceInfo is the client for interacting with the DeviceInfo builders.
 	DeviceInfo *DeviceInfoClient
 	// DisposableEmail is the client for interacting with the DisposableEmail builders.
 	DisposableEmail *DisposableEmailClient
 	// Generation is the client for interacting with the Generation builders.
 	Generation *GenerationClient
+	// GenerationBatch is the client for interacting with the GenerationBatch builders.
+	GenerationBatch *GenerationBatchClient
 	// GenerationModel is the client for interacting with the GenerationModel builders.
 	GenerationModel *GenerationModelClient
 	// GenerationOutput is the client for interacting with the GenerationOutput builders.
 	GenerationOutput *GenerationOutputClient
 	// GenerationOutputLike is the client for interacting with the GenerationOutputLike builders.
 	GenerationOutputLike *GenerationOutputLikeClient
+	// GenerationPreset is the client for interacting with the GenerationPreset builders.
+	GenerationPreset *GenerationPresetClient
 	// IPBlackList is the client for interacting with the IPBlackList builders.
 	IPBlackList *IPBlackListClient
+	// ModerationVerdict is the client for interacting with the ModerationVerdict builders.
+	ModerationVerdict *ModerationVerdictClient
 	// MqLog is the client for interacting with the MqLog builders.
 	MqLog *MqLogClient
 	// NegativePrompt is the client for interacting with the NegativePrompt builders.
@@ -121,17 +145,25 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.ApiToken = NewApiTokenClient(c.config)
+	c.ApiTokenUsage = NewApiTokenUsageClient(c.config)
 	c.AuthClient = NewAuthClientClient(c.config)
 	c.BannedWords = NewBannedWordsClient(c.config)
 	c.Credit = NewCreditClient(c.config)
+	c.CreditHold = NewCreditHoldClient(c.config)
+	c.CreditTransaction = NewCreditTransactionClient(c.config)
 	c.CreditType = NewCreditTypeClient(c.config)
+	c.CronJobRun = NewCronJobRunClient(c.config)
+	c.DeadLetter = NewDeadLetterClient(c.config)
 	c.DeviceInfo = NewDeviceInfoClient(c.config)
 	c.DisposableEmail = NewDisposableEmailClient(c.config)
 	c.Generation = NewGenerationClient(c.config)
+	c.GenerationBatch = NewGenerationBatchClient(c.config)
 	c.GenerationModel = NewGenerationModelClient(c.config)
 	c.GenerationOutput = NewGenerationOutputClient(c.config)
 	c.GenerationOutputLike = NewGenerationOutputLikeClient(c.config)
+	c.GenerationPreset = NewGenerationPresetClient(c.config)
 	c.IPBlackList = NewIPBlackListClient(c.config)
+	c.ModerationVerdict = NewModerationVerdictClient(c.config)
 	c.MqLog = NewMqLogClient(c.config)
 	c.NegativePrompt = NewNegativePromptClient(c.config)
 	c.Prompt = NewPromptClient(c.config)
@@ -241,17 +273,25 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                  ctx,
 		config:               cfg,
 		ApiToken:             NewApiTokenClient(cfg),
+		ApiTokenUsage:        NewApiTokenUsageClient(cfg),
 		AuthClient:           NewAuthClientClient(cfg),
 		BannedWords:          NewBannedWordsClient(cfg),
 		Credit:               NewCreditClient(cfg),
+		CreditHold:           NewCreditHoldClient(cfg),
+		CreditTransaction:    NewCreditTransactionClient(cfg),
 		CreditType:           NewCreditTypeClient(cfg),
+		CronJobRun:           NewCronJobRunClient(cfg),
+		DeadLetter:           NewDeadLetterClient(cfg),
 		DeviceInfo:           NewDeviceInfoClient(cfg),
 		DisposableEmail:      NewDisposableEmailClient(cfg),
 		Generation:           NewGenerationClient(cfg),
+		GenerationBatch:      NewGenerationBatchClient(cfg),
 		GenerationModel:      NewGenerationModelClient(cfg),
 		GenerationOutput:     NewGenerationOutputClient(cfg),
 		GenerationOutputLike: NewGenerationOutputLikeClient(cfg),
+		GenerationPreset:     NewGenerationPresetClient(cfg),
 		IPBlackList:          NewIPBlackListClient(cfg),
+		ModerationVerdict:    NewModerationVerdictClient(cfg),
 		MqLog:                NewMqLogClient(cfg),
 		NegativePrompt:       NewNegativePromptClient(cfg),
 		Prompt:               NewPromptClient(cfg),
@@ -288,17 +328,25 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                  ctx,
 		config:               cfg,
 		ApiToken:             NewApiTokenClient(cfg),
+		ApiTokenUsage:        NewApiTokenUsageClient(cfg),
 		AuthClient:           NewAuthClientClient(cfg),
 		BannedWords:          NewBannedWordsClient(cfg),
 		Credit:               NewCreditClient(cfg),
+		CreditHold:           NewCreditHoldClient(cfg),
+		CreditTransaction:    NewCreditTransactionClient(cfg),
 		CreditType:           NewCreditTypeClient(cfg),
+		CronJobRun:           NewCronJobRunClient(cfg),
+		DeadLetter:           NewDeadLetterClient(cfg),
 		DeviceInfo:           NewDeviceInfoClient(cfg),
 		DisposableEmail:      NewDisposableEmailClient(cfg),
 		Generation:           NewGenerationClient(cfg),
+		GenerationBatch:      NewGenerationBatchClient(cfg),
 		GenerationModel:      NewGenerationModelClient(cfg),
 		GenerationOutput:     NewGenerationOutputClient(cfg),
 		GenerationOutputLike: NewGenerationOutputLikeClient(cfg),
+		GenerationPreset:     NewGenerationPresetClient(cfg),
 		IPBlackList:          NewIPBlackListClient(cfg),
+		ModerationVerdict:    NewModerationVerdictClient(cfg),
 		MqLog:                NewMqLogClient(cfg),
 		NegativePrompt:       NewNegativePromptClient(cfg),
 		Prompt:               NewPromptClient(cfg),
@@ -344,12 +392,15 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ApiToken, c.AuthClient, c.BannedWords, c.Credit, c.CreditType, c.DeviceInfo,
-		c.DisposableEmail, c.Generation, c.GenerationModel, c.GenerationOutput,
-		c.GenerationOutputLike, c.IPBlackList, c.MqLog, c.NegativePrompt, c.Prompt,
-		c.Role, c.Scheduler, c.ThumbmarkIdBlackList, c.TipLog, c.Upscale,
-		c.UpscaleModel, c.UpscaleOutput, c.User, c.UsernameBlacklist, c.Voiceover,
-		c.VoiceoverModel, c.VoiceoverOutput, c.VoiceoverSpeaker,
+		c.ApiToken, c.ApiTokenUsage, c.AuthClient, c.BannedWords, c.Credit,
+		c.CreditHold, c.CreditTransaction, c.CreditType, c.CronJobRun, c.DeadLetter,
+		c.DeviceInfo, c.DisposableEmail, c.Generation, c.GenerationBatch,
+		c.GenerationModel, c.GenerationOutput, c.GenerationOutputLike,
+		c.GenerationPreset, c.IPBlackList, c.ModerationVerdict, c.MqLog,
+		c.NegativePrompt, c.Prompt, c.Role, c.Scheduler, c.ThumbmarkIdBlackList,
+		c.TipLog, c.Upscale, c.UpscaleModel, c.UpscaleOutput, c.User,
+		c.UsernameBlacklist, c.Voiceover, c.VoiceoverModel, c.VoiceoverOutput,
+		c.VoiceoverSpeaker,
 	} {
 		n.Use(hooks...)
 	}
@@ -359,12 +410,15 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ApiToken, c.AuthClient, c.BannedWords, c.Credit, c.CreditType, c.DeviceInfo,
-		c.DisposableEmail, c.Generation, c.GenerationModel, c.GenerationOutput,
-		c.GenerationOutputLike, c.IPBlackList, c.MqLog, c.NegativePrompt, c.Prompt,
-		c.Role, c.Scheduler, c.ThumbmarkIdBlackList, c.TipLog, c.Upscale,
-		c.UpscaleModel, c.UpscaleOutput, c.User, c.UsernameBlacklist, c.Voiceover,
-		c.VoiceoverModel, c.VoiceoverOutput, c.VoiceoverSpeaker,
+		c.ApiToken, c.ApiTokenUsage, c.AuthClient, c.BannedWords, c.Credit,
+		c.CreditHold, c.CreditTransaction, c.CreditType, c.CronJobRun, c.DeadLetter,
+		c.DeviceInfo, c.DisposableEmail, c.Generation, c.GenerationBatch,
+		c.GenerationModel, c.GenerationOutput, c.GenerationOutputLike,
+		c.GenerationPreset, c.IPBlackList, c.ModerationVerdict, c.MqLog,
+		c.NegativePrompt, c.Prompt, c.Role, c.Scheduler, c.ThumbmarkIdBlackList,
+		c.TipLog, c.Upscale, c.UpscaleModel, c.UpscaleOutput, c.User,
+		c.UsernameBlacklist, c.Voiceover, c.VoiceoverModel, c.VoiceoverOutput,
+		c.VoiceoverSpeaker,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -375,28 +429,44 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ApiTokenMutation:
 		return c.ApiToken.mutate(ctx, m)
+	case *ApiTokenUsageMutation:
+		return c.ApiTokenUsage.mutate(ctx, m)
 	case *AuthClientMutation:
 		return c.AuthClient.mutate(ctx, m)
 	case *BannedWordsMutation:
 		return c.BannedWords.mutate(ctx, m)
 	case *CreditMutation:
 		return c.Credit.mutate(ctx, m)
+	case *CreditHoldMutation:
+		return c.CreditHold.mutate(ctx, m)
+	case *CreditTransactionMutation:
+		return c.CreditTransaction.mutate(ctx, m)
 	case *CreditTypeMutation:
 		return c.CreditType.mutate(ctx, m)
+	case *CronJobRunMutation:
+		return c.CronJobRun.mutate(ctx, m)
+	case *DeadLetterMutation:
+		return c.DeadLetter.mutate(ctx, m)
 	case *DeviceInfoMutation:
 		return c.DeviceInfo.mutate(ctx, m)
 	case *DisposableEmailMutation:
 		return c.DisposableEmail.mutate(ctx, m)
 	case *GenerationMutation:
 		return c.Generation.mutate(ctx, m)
+	case *GenerationBatchMutation:
+		return c.GenerationBatch.mutate(ctx, m)
 	case *GenerationModelMutation:
 		return c.GenerationModel.mutate(ctx, m)
 	case *GenerationOutputMutation:
 		return c.GenerationOutput.mutate(ctx, m)
 	case *GenerationOutputLikeMutation:
 		return c.GenerationOutputLike.mutate(ctx, m)
+	case *GenerationPresetMutation:
+		return c.GenerationPreset.mutate(ctx, m)
 	case *IPBlackListMutation:
 		return c.IPBlackList.mutate(ctx, m)
+	case *ModerationVerdictMutation:
+		return c.ModerationVerdict.mutate(ctx, m)
 	case *MqLogMutation:
 		return c.MqLog.mutate(ctx, m)
 	case *NegativePromptMutation:
@@ -647,6 +717,139 @@ func (c *ApiTokenClient) mutate(ctx context.Context, m *ApiTokenMutation) (Value
 	}
 }
 
+// ApiTokenUsageClient is a client for the ApiTokenUsage schema.
+type ApiTokenUsageClient struct {
+	config
+}
+
+// NewApiTokenUsageClient returns a client for the ApiTokenUsage from the given config.
+func NewApiTokenUsageClient(c config) *ApiTokenUsageClient {
+	return &ApiTokenUsageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apitokenusage.Hooks(f(g(h())))`.
+func (c *ApiTokenUsageClient) Use(hooks ...Hook) {
+	c.hooks.ApiTokenUsage = append(c.hooks.ApiTokenUsage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apitokenusage.Intercept(f(g(h())))`.
+func (c *ApiTokenUsageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ApiTokenUsage = append(c.inters.ApiTokenUsage, interceptors...)
+}
+
+// Create returns a builder for creating a ApiTokenUsage entity.
+func (c *ApiTokenUsageClient) Create() *ApiTokenUsageCreate {
+	mutation := newApiTokenUsageMutation(c.config, OpCreate)
+	return &ApiTokenUsageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ApiTokenUsage entities.
+func (c *ApiTokenUsageClient) CreateBulk(builders ...*ApiTokenUsageCreate) *ApiTokenUsageCreateBulk {
+	return &ApiTokenUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ApiTokenUsageClient) MapCreateBulk(slice any, setFunc func(*ApiTokenUsageCreate, int)) *ApiTokenUsageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ApiTokenUsageCreateBulk{err: fmt.Errorf("calling to ApiTokenUsageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ApiTokenUsageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ApiTokenUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ApiTokenUsage.
+func (c *ApiTokenUsageClient) Update() *ApiTokenUsageUpdate {
+	mutation := newApiTokenUsageMutation(c.config, OpUpdate)
+	return &ApiTokenUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ApiTokenUsageClient) UpdateOne(atu *ApiTokenUsage) *ApiTokenUsageUpdateOne {
+	mutation := newApiTokenUsageMutation(c.config, OpUpdateOne, withApiTokenUsage(atu))
+	return &ApiTokenUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ApiTokenUsageClient) UpdateOneID(id uuid.UUID) *ApiTokenUsageUpdateOne {
+	mutation := newApiTokenUsageMutation(c.config, OpUpdateOne, withApiTokenUsageID(id))
+	return &ApiTokenUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ApiTokenUsage.
+func (c *ApiTokenUsageClient) Delete() *ApiTokenUsageDelete {
+	mutation := newApiTokenUsageMutation(c.config, OpDelete)
+	return &ApiTokenUsageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ApiTokenUsageClient) DeleteOne(atu *ApiTokenUsage) *ApiTokenUsageDeleteOne {
+	return c.DeleteOneID(atu.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ApiTokenUsageClient) DeleteOneID(id uuid.UUID) *ApiTokenUsageDeleteOne {
+	builder := c.Delete().Where(apitokenusage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ApiTokenUsageDeleteOne{builder}
+}
+
+// Query returns a query builder for ApiTokenUsage.
+func (c *ApiTokenUsageClient) Query() *ApiTokenUsageQuery {
+	return &ApiTokenUsageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeApiTokenUsage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ApiTokenUsage entity by its id.
+func (c *ApiTokenUsageClient) Get(ctx context.Context, id uuid.UUID) (*ApiTokenUsage, error) {
+	return c.Query().Where(apitokenusage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ApiTokenUsageClient) GetX(ctx context.Context, id uuid.UUID) *ApiTokenUsage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ApiTokenUsageClient) Hooks() []Hook {
+	return c.hooks.ApiTokenUsage
+}
+
+// Interceptors returns the client interceptors.
+func (c *ApiTokenUsageClient) Interceptors() []Interceptor {
+	return c.inters.ApiTokenUsage
+}
+
+func (c *ApiTokenUsageClient) mutate(ctx context.Context, m *ApiTokenUsageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ApiTokenUsageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ApiTokenUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ApiTokenUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ApiTokenUsageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ApiTokenUsage mutation op: %q", m.Op())
+	}
+}
+
 // AuthClientClient is a client for the AuthClient schema.
 type AuthClientClient struct {
 	config
@@ -1094,6 +1297,272 @@ func (c *CreditClient) mutate(ctx context.Context, m *CreditMutation) (Value, er
 	}
 }
 
+// CreditHoldClient is a client for the CreditHold schema.
+type CreditHoldClient struct {
+	config
+}
+
+// NewCreditHoldClient returns a client for the CreditHold from the given config.
+func NewCreditHoldClient(c config) *CreditHoldClient {
+	return &CreditHoldClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `credithold.Hooks(f(g(h())))`.
+func (c *CreditHoldClient) Use(hooks ...Hook) {
+	c.hooks.CreditHold = append(c.hooks.CreditHold, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `credithold.Intercept(f(g(h())))`.
+func (c *CreditHoldClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CreditHold = append(c.inters.CreditHold, interceptors...)
+}
+
+// Create returns a builder for creating a CreditHold entity.
+func (c *CreditHoldClient) Create() *CreditHoldCreate {
+	mutation := newCreditHoldMutation(c.config, OpCreate)
+	return &CreditHoldCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CreditHold entities.
+func (c *CreditHoldClient) CreateBulk(builders ...*CreditHoldCreate) *CreditHoldCreateBulk {
+	return &CreditHoldCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CreditHoldClient) MapCreateBulk(slice any, setFunc func(*CreditHoldCreate, int)) *CreditHoldCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CreditHoldCreateBulk{err: fmt.Errorf("calling to CreditHoldClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CreditHoldCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CreditHoldCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CreditHold.
+func (c *CreditHoldClient) Update() *CreditHoldUpdate {
+	mutation := newCreditHoldMutation(c.config, OpUpdate)
+	return &CreditHoldUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CreditHoldClient) UpdateOne(ch *CreditHold) *CreditHoldUpdateOne {
+	mutation := newCreditHoldMutation(c.config, OpUpdateOne, withCreditHold(ch))
+	return &CreditHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CreditHoldClient) UpdateOneID(id uuid.UUID) *CreditHoldUpdateOne {
+	mutation := newCreditHoldMutation(c.config, OpUpdateOne, withCreditHoldID(id))
+	return &CreditHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CreditHold.
+func (c *CreditHoldClient) Delete() *CreditHoldDelete {
+	mutation := newCreditHoldMutation(c.config, OpDelete)
+	return &CreditHoldDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CreditHoldClient) DeleteOne(ch *CreditHold) *CreditHoldDeleteOne {
+	return c.DeleteOneID(ch.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CreditHoldClient) DeleteOneID(id uuid.UUID) *CreditHoldDeleteOne {
+	builder := c.Delete().Where(credithold.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CreditHoldDeleteOne{builder}
+}
+
+// Query returns a query builder for CreditHold.
+func (c *CreditHoldClient) Query() *CreditHoldQuery {
+	return &CreditHoldQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCreditHold},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CreditHold entity by its id.
+func (c *CreditHoldClient) Get(ctx context.Context, id uuid.UUID) (*CreditHold, error) {
+	return c.Query().Where(credithold.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CreditHoldClient) GetX(ctx context.Context, id uuid.UUID) *CreditHold {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CreditHoldClient) Hooks() []Hook {
+	return c.hooks.CreditHold
+}
+
+// Interceptors returns the client interceptors.
+func (c *CreditHoldClient) Interceptors() []Interceptor {
+	return c.inters.CreditHold
+}
+
+func (c *CreditHoldClient) mutate(ctx context.Context, m *CreditHoldMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CreditHoldCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CreditHoldUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CreditHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CreditHoldDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CreditHold mutation op: %q", m.Op())
+	}
+}
+
+// CreditTransactionClient is a client for the CreditTransaction schema.
+type CreditTransactionClient struct {
+	config
+}
+
+// NewCreditTransactionClient returns a client for the CreditTransaction from the given config.
+func NewCreditTransactionClient(c config) *CreditTransactionClient {
+	return &CreditTransactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `credittransaction.Hooks(f(g(h())))`.
+func (c *CreditTransactionClient) Use(hooks ...Hook) {
+	c.hooks.CreditTransaction = append(c.hooks.CreditTransaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `credittransaction.Intercept(f(g(h())))`.
+func (c *CreditTransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CreditTransaction = append(c.inters.CreditTransaction, interceptors...)
+}
+
+// Create returns a builder for creating a CreditTransaction entity.
+func (c *CreditTransactionClient) Create() *CreditTransactionCreate {
+	mutation := newCreditTransactionMutation(c.config, OpCreate)
+	return &CreditTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CreditTransaction entities.
+func (c *CreditTransactionClient) CreateBulk(builders ...*CreditTransactionCreate) *CreditTransactionCreateBulk {
+	return &CreditTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CreditTransactionClient) MapCreateBulk(slice any, setFunc func(*CreditTransactionCreate, int)) *CreditTransactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CreditTransactionCreateBulk{err: fmt.Errorf("calling to CreditTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CreditTransactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CreditTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CreditTransaction.
+func (c *CreditTransactionClient) Update() *CreditTransactionUpdate {
+	mutation := newCreditTransactionMutation(c.config, OpUpdate)
+	return &CreditTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CreditTransactionClient) UpdateOne(ct *CreditTransaction) *CreditTransactionUpdateOne {
+	mutation := newCreditTransactionMutation(c.config, OpUpdateOne, withCreditTransaction(ct))
+	return &CreditTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CreditTransactionClient) UpdateOneID(id uuid.UUID) *CreditTransactionUpdateOne {
+	mutation := newCreditTransactionMutation(c.config, OpUpdateOne, withCreditTransactionID(id))
+	return &CreditTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CreditTransaction.
+func (c *CreditTransactionClient) Delete() *CreditTransactionDelete {
+	mutation := newCreditTransactionMutation(c.config, OpDelete)
+	return &CreditTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CreditTransactionClient) DeleteOne(ct *CreditTransaction) *CreditTransactionDeleteOne {
+	return c.DeleteOneID(ct.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CreditTransactionClient) DeleteOneID(id uuid.UUID) *CreditTransactionDeleteOne {
+	builder := c.Delete().Where(credittransaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CreditTransactionDeleteOne{builder}
+}
+
+// Query returns a query builder for CreditTransaction.
+func (c *CreditTransactionClient) Query() *CreditTransactionQuery {
+	return &CreditTransactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCreditTransaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CreditTransaction entity by its id.
+func (c *CreditTransactionClient) Get(ctx context.Context, id uuid.UUID) (*CreditTransaction, error) {
+	return c.Query().Where(credittransaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CreditTransactionClient) GetX(ctx context.Context, id uuid.UUID) *CreditTransaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CreditTransactionClient) Hooks() []Hook {
+	return c.hooks.CreditTransaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *CreditTransactionClient) Interceptors() []Interceptor {
+	return c.inters.CreditTransaction
+}
+
+func (c *CreditTransactionClient) mutate(ctx context.Context, m *CreditTransactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CreditTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CreditTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CreditTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CreditTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CreditTransaction mutation op: %q", m.Op())
+	}
+}
+
 // CreditTypeClient is a client for the CreditType schema.
 type CreditTypeClient struct {
 	config
@@ -1179,22 +1648,304 @@ func (c *CreditTypeClient) DeleteOneID(id uuid.UUID) *CreditTypeDeleteOne {
 	return &CreditTypeDeleteOne{builder}
 }
 
-// Query returns a query builder for CreditType.
-func (c *CreditTypeClient) Query() *CreditTypeQuery {
-	return &CreditTypeQuery{
+// Query returns a query builder for CreditType.
+func (c *CreditTypeClient) Query() *CreditTypeQuery {
+	return &CreditTypeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCreditType},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CreditType entity by its id.
+func (c *CreditTypeClient) Get(ctx context.Context, id uuid.UUID) (*CreditType, error) {
+	return c.Query().Where(credittype.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CreditTypeClient) GetX(ctx context.Context, id uuid.UUID) *CreditType {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCredits queries the credits edge of a CreditType.
+func (c *CreditTypeClient) QueryCredits(ct *CreditType) *CreditQuery {
+	query := (&CreditClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ct.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(credittype.Table, credittype.FieldID, id),
+			sqlgraph.To(credit.Table, credit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, credittype.CreditsTable, credittype.CreditsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ct.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CreditTypeClient) Hooks() []Hook {
+	return c.hooks.CreditType
+}
+
+// Interceptors returns the client interceptors.
+func (c *CreditTypeClient) Interceptors() []Interceptor {
+	return c.inters.CreditType
+}
+
+func (c *CreditTypeClient) mutate(ctx context.Context, m *CreditTypeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CreditTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CreditTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CreditTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CreditTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CreditType mutation op: %q", m.Op())
+	}
+}
+
+// CronJobRunClient is a client for the CronJobRun schema.
+type CronJobRunClient struct {
+	config
+}
+
+// NewCronJobRunClient returns a client for the CronJobRun from the given config.
+func NewCronJobRunClient(c config) *CronJobRunClient {
+	return &CronJobRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `cronjobrun.Hooks(f(g(h())))`.
+func (c *CronJobRunClient) Use(hooks ...Hook) {
+	c.hooks.CronJobRun = append(c.hooks.CronJobRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `cronjobrun.Intercept(f(g(h())))`.
+func (c *CronJobRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CronJobRun = append(c.inters.CronJobRun, interceptors...)
+}
+
+// Create returns a builder for creating a CronJobRun entity.
+func (c *CronJobRunClient) Create() *CronJobRunCreate {
+	mutation := newCronJobRunMutation(c.config, OpCreate)
+	return &CronJobRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CronJobRun entities.
+func (c *CronJobRunClient) CreateBulk(builders ...*CronJobRunCreate) *CronJobRunCreateBulk {
+	return &CronJobRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CronJobRunClient) MapCreateBulk(slice any, setFunc func(*CronJobRunCreate, int)) *CronJobRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CronJobRunCreateBulk{err: fmt.Errorf("calling to CronJobRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CronJobRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CronJobRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CronJobRun.
+func (c *CronJobRunClient) Update() *CronJobRunUpdate {
+	mutation := newCronJobRunMutation(c.config, OpUpdate)
+	return &CronJobRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CronJobRunClient) UpdateOne(cjr *CronJobRun) *CronJobRunUpdateOne {
+	mutation := newCronJobRunMutation(c.config, OpUpdateOne, withCronJobRun(cjr))
+	return &CronJobRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CronJobRunClient) UpdateOneID(id uuid.UUID) *CronJobRunUpdateOne {
+	mutation := newCronJobRunMutation(c.config, OpUpdateOne, withCronJobRunID(id))
+	return &CronJobRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CronJobRun.
+func (c *CronJobRunClient) Delete() *CronJobRunDelete {
+	mutation := newCronJobRunMutation(c.config, OpDelete)
+	return &CronJobRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CronJobRunClient) DeleteOne(cjr *CronJobRun) *CronJobRunDeleteOne {
+	return c.DeleteOneID(cjr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CronJobRunClient) DeleteOneID(id uuid.UUID) *CronJobRunDeleteOne {
+	builder := c.Delete().Where(cronjobrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CronJobRunDeleteOne{builder}
+}
+
+// Query returns a query builder for CronJobRun.
+func (c *CronJobRunClient) Query() *CronJobRunQuery {
+	return &CronJobRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCronJobRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CronJobRun entity by its id.
+func (c *CronJobRunClient) Get(ctx context.Context, id uuid.UUID) (*CronJobRun, error) {
+	return c.Query().Where(cronjobrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CronJobRunClient) GetX(ctx context.Context, id uuid.UUID) *CronJobRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CronJobRunClient) Hooks() []Hook {
+	return c.hooks.CronJobRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *CronJobRunClient) Interceptors() []Interceptor {
+	return c.inters.CronJobRun
+}
+
+func (c *CronJobRunClient) mutate(ctx context.Context, m *CronJobRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CronJobRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CronJobRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CronJobRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CronJobRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CronJobRun mutation op: %q", m.Op())
+	}
+}
+
+// DeadLetterClient is a client for the DeadLetter schema.
+type DeadLetterClient struct {
+	config
+}
+
+// NewDeadLetterClient returns a client for the DeadLetter from the given config.
+func NewDeadLetterClient(c config) *DeadLetterClient {
+	return &DeadLetterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deadletter.Hooks(f(g(h())))`.
+func (c *DeadLetterClient) Use(hooks ...Hook) {
+	c.hooks.DeadLetter = append(c.hooks.DeadLetter, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deadletter.Intercept(f(g(h())))`.
+func (c *DeadLetterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeadLetter = append(c.inters.DeadLetter, interceptors...)
+}
+
+// Create returns a builder for creating a DeadLetter entity.
+func (c *DeadLetterClient) Create() *DeadLetterCreate {
+	mutation := newDeadLetterMutation(c.config, OpCreate)
+	return &DeadLetterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeadLetter entities.
+func (c *DeadLetterClient) CreateBulk(builders ...*DeadLetterCreate) *DeadLetterCreateBulk {
+	return &DeadLetterCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeadLetterClient) MapCreateBulk(slice any, setFunc func(*DeadLetterCreate, int)) *DeadLetterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeadLetterCreateBulk{err: fmt.Errorf("calling to DeadLetterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeadLetterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeadLetterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeadLetter.
+func (c *DeadLetterClient) Update() *DeadLetterUpdate {
+	mutation := newDeadLetterMutation(c.config, OpUpdate)
+	return &DeadLetterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeadLetterClient) UpdateOne(dl *DeadLetter) *DeadLetterUpdateOne {
+	mutation := newDeadLetterMutation(c.config, OpUpdateOne, withDeadLetter(dl))
+	return &DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeadLetterClient) UpdateOneID(id uuid.UUID) *DeadLetterUpdateOne {
+	mutation := newDeadLetterMutation(c.config, OpUpdateOne, withDeadLetterID(id))
+	return &DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeadLetter.
+func (c *DeadLetterClient) Delete() *DeadLetterDelete {
+	mutation := newDeadLetterMutation(c.config, OpDelete)
+	return &DeadLetterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeadLetterClient) DeleteOne(dl *DeadLetter) *DeadLetterDeleteOne {
+	return c.DeleteOneID(dl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeadLetterClient) DeleteOneID(id uuid.UUID) *DeadLetterDeleteOne {
+	builder := c.Delete().Where(deadletter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeadLetterDeleteOne{builder}
+}
+
+// Query returns a query builder for DeadLetter.
+func (c *DeadLetterClient) Query() *DeadLetterQuery {
+	return &DeadLetterQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCreditType},
+		ctx:    &QueryContext{Type: TypeDeadLetter},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a CreditType entity by its id.
-func (c *CreditTypeClient) Get(ctx context.Context, id uuid.UUID) (*CreditType, error) {
-	return c.Query().Where(credittype.ID(id)).Only(ctx)
+// Get returns a DeadLetter entity by its id.
+func (c *DeadLetterClient) Get(ctx context.Context, id uuid.UUID) (*DeadLetter, error) {
+	return c.Query().Where(deadletter.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CreditTypeClient) GetX(ctx context.Context, id uuid.UUID) *CreditType {
+func (c *DeadLetterClient) GetX(ctx context.Context, id uuid.UUID) *DeadLetter {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1202,44 +1953,28 @@ func (c *CreditTypeClient) GetX(ctx context.Context, id uuid.UUID) *CreditType {
 	return obj
 }
 
-// QueryCredits queries the credits edge of a CreditType.
-func (c *CreditTypeClient) QueryCredits(ct *CreditType) *CreditQuery {
-	query := (&CreditClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ct.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(credittype.Table, credittype.FieldID, id),
-			sqlgraph.To(credit.Table, credit.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, credittype.CreditsTable, credittype.CreditsColumn),
-		)
-		fromV = sqlgraph.Neighbors(ct.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *CreditTypeClient) Hooks() []Hook {
-	return c.hooks.CreditType
+func (c *DeadLetterClient) Hooks() []Hook {
+	return c.hooks.DeadLetter
 }
 
 // Interceptors returns the client interceptors.
-func (c *CreditTypeClient) Interceptors() []Interceptor {
-	return c.inters.CreditType
+func (c *DeadLetterClient) Interceptors() []Interceptor {
+	return c.inters.DeadLetter
 }
 
-func (c *CreditTypeClient) mutate(ctx context.Context, m *CreditTypeMutation) (Value, error) {
+func (c *DeadLetterClient) mutate(ctx context.Context, m *DeadLetterMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CreditTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeadLetterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CreditTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeadLetterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CreditTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CreditTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DeadLetterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown CreditType mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DeadLetter mutation op: %q", m.Op())
 	}
 }
 
@@ -1818,6 +2553,139 @@ func (c *GenerationClient) mutate(ctx context.Context, m *GenerationMutation) (V
 	}
 }
 
+// GenerationBatchClient is a client for the GenerationBatch schema.
+type GenerationBatchClient struct {
+	config
+}
+
+// NewGenerationBatchClient returns a client for the GenerationBatch from the given config.
+func NewGenerationBatchClient(c config) *GenerationBatchClient {
+	return &GenerationBatchClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `generationbatch.Hooks(f(g(h())))`.
+func (c *GenerationBatchClient) Use(hooks ...Hook) {
+	c.hooks.GenerationBatch = append(c.hooks.GenerationBatch, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `generationbatch.Intercept(f(g(h())))`.
+func (c *GenerationBatchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GenerationBatch = append(c.inters.GenerationBatch, interceptors...)
+}
+
+// Create returns a builder for creating a GenerationBatch entity.
+func (c *GenerationBatchClient) Create() *GenerationBatchCreate {
+	mutation := newGenerationBatchMutation(c.config, OpCreate)
+	return &GenerationBatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GenerationBatch entities.
+func (c *GenerationBatchClient) CreateBulk(builders ...*GenerationBatchCreate) *GenerationBatchCreateBulk {
+	return &GenerationBatchCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GenerationBatchClient) MapCreateBulk(slice any, setFunc func(*GenerationBatchCreate, int)) *GenerationBatchCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GenerationBatchCreateBulk{err: fmt.Errorf("calling to GenerationBatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GenerationBatchCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GenerationBatchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GenerationBatch.
+func (c *GenerationBatchClient) Update() *GenerationBatchUpdate {
+	mutation := newGenerationBatchMutation(c.config, OpUpdate)
+	return &GenerationBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GenerationBatchClient) UpdateOne(gb *GenerationBatch) *GenerationBatchUpdateOne {
+	mutation := newGenerationBatchMutation(c.config, OpUpdateOne, withGenerationBatch(gb))
+	return &GenerationBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GenerationBatchClient) UpdateOneID(id uuid.UUID) *GenerationBatchUpdateOne {
+	mutation := newGenerationBatchMutation(c.config, OpUpdateOne, withGenerationBatchID(id))
+	return &GenerationBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GenerationBatch.
+func (c *GenerationBatchClient) Delete() *GenerationBatchDelete {
+	mutation := newGenerationBatchMutation(c.config, OpDelete)
+	return &GenerationBatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GenerationBatchClient) DeleteOne(gb *GenerationBatch) *GenerationBatchDeleteOne {
+	return c.DeleteOneID(gb.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GenerationBatchClient) DeleteOneID(id uuid.UUID) *GenerationBatchDeleteOne {
+	builder := c.Delete().Where(generationbatch.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GenerationBatchDeleteOne{builder}
+}
+
+// Query returns a query builder for GenerationBatch.
+func (c *GenerationBatchClient) Query() *GenerationBatchQuery {
+	return &GenerationBatchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGenerationBatch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GenerationBatch entity by its id.
+func (c *GenerationBatchClient) Get(ctx context.Context, id uuid.UUID) (*GenerationBatch, error) {
+	return c.Query().Where(generationbatch.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GenerationBatchClient) GetX(ctx context.Context, id uuid.UUID) *GenerationBatch {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GenerationBatchClient) Hooks() []Hook {
+	return c.hooks.GenerationBatch
+}
+
+// Interceptors returns the client interceptors.
+func (c *GenerationBatchClient) Interceptors() []Interceptor {
+	return c.inters.GenerationBatch
+}
+
+func (c *GenerationBatchClient) mutate(ctx context.Context, m *GenerationBatchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GenerationBatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GenerationBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GenerationBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GenerationBatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GenerationBatch mutation op: %q", m.Op())
+	}
+}
+
 // GenerationModelClient is a client for the GenerationModel schema.
 type GenerationModelClient struct {
 	config
@@ -2329,6 +3197,139 @@ func (c *GenerationOutputLikeClient) mutate(ctx context.Context, m *GenerationOu
 	}
 }
 
+// GenerationPresetClient is a client for the GenerationPreset schema.
+type GenerationPresetClient struct {
+	config
+}
+
+// NewGenerationPresetClient returns a client for the GenerationPreset from the given config.
+func NewGenerationPresetClient(c config) *GenerationPresetClient {
+	return &GenerationPresetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `generationpreset.Hooks(f(g(h())))`.
+func (c *GenerationPresetClient) Use(hooks ...Hook) {
+	c.hooks.GenerationPreset = append(c.hooks.GenerationPreset, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `generationpreset.Intercept(f(g(h())))`.
+func (c *GenerationPresetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GenerationPreset = append(c.inters.GenerationPreset, interceptors...)
+}
+
+// Create returns a builder for creating a GenerationPreset entity.
+func (c *GenerationPresetClient) Create() *GenerationPresetCreate {
+	mutation := newGenerationPresetMutation(c.config, OpCreate)
+	return &GenerationPresetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GenerationPreset entities.
+func (c *GenerationPresetClient) CreateBulk(builders ...*GenerationPresetCreate) *GenerationPresetCreateBulk {
+	return &GenerationPresetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GenerationPresetClient) MapCreateBulk(slice any, setFunc func(*GenerationPresetCreate, int)) *GenerationPresetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GenerationPresetCreateBulk{err: fmt.Errorf("calling to GenerationPresetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GenerationPresetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GenerationPresetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GenerationPreset.
+func (c *GenerationPresetClient) Update() *GenerationPresetUpdate {
+	mutation := newGenerationPresetMutation(c.config, OpUpdate)
+	return &GenerationPresetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GenerationPresetClient) UpdateOne(gp *GenerationPreset) *GenerationPresetUpdateOne {
+	mutation := newGenerationPresetMutation(c.config, OpUpdateOne, withGenerationPreset(gp))
+	return &GenerationPresetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GenerationPresetClient) UpdateOneID(id uuid.UUID) *GenerationPresetUpdateOne {
+	mutation := newGenerationPresetMutation(c.config, OpUpdateOne, withGenerationPresetID(id))
+	return &GenerationPresetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GenerationPreset.
+func (c *GenerationPresetClient) Delete() *GenerationPresetDelete {
+	mutation := newGenerationPresetMutation(c.config, OpDelete)
+	return &GenerationPresetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GenerationPresetClient) DeleteOne(gp *GenerationPreset) *GenerationPresetDeleteOne {
+	return c.DeleteOneID(gp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GenerationPresetClient) DeleteOneID(id uuid.UUID) *GenerationPresetDeleteOne {
+	builder := c.Delete().Where(generationpreset.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GenerationPresetDeleteOne{builder}
+}
+
+// Query returns a query builder for GenerationPreset.
+func (c *GenerationPresetClient) Query() *GenerationPresetQuery {
+	return &GenerationPresetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGenerationPreset},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GenerationPreset entity by its id.
+func (c *GenerationPresetClient) Get(ctx context.Context, id uuid.UUID) (*GenerationPreset, error) {
+	return c.Query().Where(generationpreset.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GenerationPresetClient) GetX(ctx context.Context, id uuid.UUID) *GenerationPreset {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GenerationPresetClient) Hooks() []Hook {
+	return c.hooks.GenerationPreset
+}
+
+// Interceptors returns the client interceptors.
+func (c *GenerationPresetClient) Interceptors() []Interceptor {
+	return c.inters.GenerationPreset
+}
+
+func (c *GenerationPresetClient) mutate(ctx context.Context, m *GenerationPresetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GenerationPresetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GenerationPresetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GenerationPresetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GenerationPresetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GenerationPreset mutation op: %q", m.Op())
+	}
+}
+
 // IPBlackListClient is a client for the IPBlackList schema.
 type IPBlackListClient struct {
 	config
@@ -2462,6 +3463,139 @@ func (c *IPBlackListClient) mutate(ctx context.Context, m *IPBlackListMutation)
 	}
 }
 
+// ModerationVerdictClient is a client for the ModerationVerdict schema.
+type ModerationVerdictClient struct {
+	config
+}
+
+// NewModerationVerdictClient returns a client for the ModerationVerdict from the given config.
+func NewModerationVerdictClient(c config) *ModerationVerdictClient {
+	return &ModerationVerdictClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `moderationverdict.Hooks(f(g(h())))`.
+func (c *ModerationVerdictClient) Use(hooks ...Hook) {
+	c.hooks.ModerationVerdict = append(c.hooks.ModerationVerdict, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `moderationverdict.Intercept(f(g(h())))`.
+func (c *ModerationVerdictClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModerationVerdict = append(c.inters.ModerationVerdict, interceptors...)
+}
+
+// Create returns a builder for creating a ModerationVerdict entity.
+func (c *ModerationVerdictClient) Create() *ModerationVerdictCreate {
+	mutation := newModerationVerdictMutation(c.config, OpCreate)
+	return &ModerationVerdictCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ModerationVerdict entities.
+func (c *ModerationVerdictClient) CreateBulk(builders ...*ModerationVerdictCreate) *ModerationVerdictCreateBulk {
+	return &ModerationVerdictCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModerationVerdictClient) MapCreateBulk(slice any, setFunc func(*ModerationVerdictCreate, int)) *ModerationVerdictCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModerationVerdictCreateBulk{err: fmt.Errorf("calling to ModerationVerdictClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModerationVerdictCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModerationVerdictCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ModerationVerdict.
+func (c *ModerationVerdictClient) Update() *ModerationVerdictUpdate {
+	mutation := newModerationVerdictMutation(c.config, OpUpdate)
+	return &ModerationVerdictUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModerationVerdictClient) UpdateOne(mv *ModerationVerdict) *ModerationVerdictUpdateOne {
+	mutation := newModerationVerdictMutation(c.config, OpUpdateOne, withModerationVerdict(mv))
+	return &ModerationVerdictUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModerationVerdictClient) UpdateOneID(id uuid.UUID) *ModerationVerdictUpdateOne {
+	mutation := newModerationVerdictMutation(c.config, OpUpdateOne, withModerationVerdictID(id))
+	return &ModerationVerdictUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModerationVerdict.
+func (c *ModerationVerdictClient) Delete() *ModerationVerdictDelete {
+	mutation := newModerationVerdictMutation(c.config, OpDelete)
+	return &ModerationVerdictDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModerationVerdictClient) DeleteOne(mv *ModerationVerdict) *ModerationVerdictDeleteOne {
+	return c.DeleteOneID(mv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModerationVerdictClient) DeleteOneID(id uuid.UUID) *ModerationVerdictDeleteOne {
+	builder := c.Delete().Where(moderationverdict.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModerationVerdictDeleteOne{builder}
+}
+
+// Query returns a query builder for ModerationVerdict.
+func (c *ModerationVerdictClient) Query() *ModerationVerdictQuery {
+	return &ModerationVerdictQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModerationVerdict},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ModerationVerdict entity by its id.
+func (c *ModerationVerdictClient) Get(ctx context.Context, id uuid.UUID) (*ModerationVerdict, error) {
+	return c.Query().Where(moderationverdict.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModerationVerdictClient) GetX(ctx context.Context, id uuid.UUID) *ModerationVerdict {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ModerationVerdictClient) Hooks() []Hook {
+	return c.hooks.ModerationVerdict
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModerationVerdictClient) Interceptors() []Interceptor {
+	return c.inters.ModerationVerdict
+}
+
+func (c *ModerationVerdictClient) mutate(ctx context.Context, m *ModerationVerdictMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModerationVerdictCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModerationVerdictUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModerationVerdictUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModerationVerdictDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ModerationVerdict mutation op: %q", m.Op())
+	}
+}
+
 // MqLogClient is a client for the MqLog schema.
 type MqLogClient struct {
 	config
@@ -5185,19 +6319,23 @@ func (c *VoiceoverSpeakerClient) mutate(ctx context.Context, m *VoiceoverSpeaker
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ApiToken, AuthClient, BannedWords, Credit, CreditType, DeviceInfo,
-		DisposableEmail, Generation, GenerationModel, GenerationOutput,
-		GenerationOutputLike, IPBlackList, MqLog, NegativePrompt, Prompt, Role,
-		Scheduler, ThumbmarkIdBlackList, TipLog, Upscale, UpscaleModel, UpscaleOutput,
-		User, UsernameBlacklist, Voiceover, VoiceoverModel, VoiceoverOutput,
+		ApiToken, ApiTokenUsage, AuthClient, BannedWords, Credit, CreditHold,
+		CreditTransaction, CreditType, CronJobRun, DeadLetter, DeviceInfo,
+		DisposableEmail, Generation, GenerationBatch, GenerationModel,
+		GenerationOutput, GenerationOutputLike, GenerationPreset, IPBlackList,
+		ModerationVerdict, MqLog, NegativePrompt, Prompt, Role, Scheduler,
+		ThumbmarkIdBlackList, TipLog, Upscale, UpscaleModel, UpscaleOutput, User,
+		UsernameBlacklist, Voiceover, VoiceoverModel, VoiceoverOutput,
 		VoiceoverSpeaker []ent.Hook
 	}
 	inters struct {
-		ApiToken, AuthClient, BannedWords, Credit, CreditType, DeviceInfo,
-		DisposableEmail, Generation, GenerationModel, GenerationOutput,
-		GenerationOutputLike, IPBlackList, MqLog, NegativePrompt, Prompt, Role,
-		Scheduler, ThumbmarkIdBlackList, TipLog, Upscale, UpscaleModel, UpscaleOutput,
-		User, UsernameBlacklist, Voiceover, VoiceoverModel, VoiceoverOutput,
+		ApiToken, ApiTokenUsage, AuthClient, BannedWords, Credit, CreditHold,
+		CreditTransaction, CreditType, CronJobRun, DeadLetter, DeviceInfo,
+		DisposableEmail, Generation, GenerationBatch, GenerationModel,
+		GenerationOutput, GenerationOutputLike, GenerationPreset, IPBlackList,
+		ModerationVerdict, MqLog, NegativePrompt, Prompt, Role, Scheduler,
+		ThumbmarkIdBlackList, TipLog, Upscale, UpscaleModel, UpscaleOutput, User,
+		UsernameBlacklist, Voiceover, VoiceoverModel, VoiceoverOutput,
 		VoiceoverSpeaker []ent.Interceptor
 	}
 )
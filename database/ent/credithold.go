@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+)
+
+// CreditHold is the model entity for the CreditHold schema.
+type CreditHold struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount int32 `json:"amount,omitempty"`
+	// Status holds the value of the "status" field.
+	Status credithold.Status `json:"status,omitempty"`
+	// ProcessType holds the value of the "process_type" field.
+	ProcessType credithold.ProcessType `json:"process_type,omitempty"`
+	// JobID holds the value of the "job_id" field.
+	JobID *uuid.UUID `json:"job_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	// APITokenID holds the value of the "api_token_id" field.
+	APITokenID *uuid.UUID `json:"api_token_id,omitempty"`
+	// APITokenPeriod holds the value of the "api_token_period" field.
+	APITokenPeriod *time.Time `json:"api_token_period,omitempty"`
+	// ReleaseReason holds the value of the "release_reason" field.
+	ReleaseReason *string `json:"release_reason,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CapturedAt holds the value of the "captured_at" field.
+	CapturedAt *time.Time `json:"captured_at,omitempty"`
+	// ReleasedAt holds the value of the "released_at" field.
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CreditHold) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case credithold.FieldJobID, credithold.FieldParentID, credithold.FieldAPITokenID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case credithold.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case credithold.FieldStatus, credithold.FieldProcessType, credithold.FieldReleaseReason:
+			values[i] = new(sql.NullString)
+		case credithold.FieldAPITokenPeriod, credithold.FieldExpiresAt, credithold.FieldCapturedAt, credithold.FieldReleasedAt, credithold.FieldCreatedAt, credithold.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case credithold.FieldID, credithold.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CreditHold fields.
+func (ch *CreditHold) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case credithold.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ch.ID = *value
+			}
+		case credithold.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ch.UserID = *value
+			}
+		case credithold.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				ch.Amount = int32(value.Int64)
+			}
+		case credithold.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ch.Status = credithold.Status(value.String)
+			}
+		case credithold.FieldProcessType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field process_type", values[i])
+			} else if value.Valid {
+				ch.ProcessType = credithold.ProcessType(value.String)
+			}
+		case credithold.FieldJobID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value.Valid {
+				ch.JobID = new(uuid.UUID)
+				*ch.JobID = *value.S.(*uuid.UUID)
+			}
+		case credithold.FieldParentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				ch.ParentID = new(uuid.UUID)
+				*ch.ParentID = *value.S.(*uuid.UUID)
+			}
+		case credithold.FieldAPITokenID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field api_token_id", values[i])
+			} else if value.Valid {
+				ch.APITokenID = new(uuid.UUID)
+				*ch.APITokenID = *value.S.(*uuid.UUID)
+			}
+		case credithold.FieldAPITokenPeriod:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_token_period", values[i])
+			} else if value.Valid {
+				ch.APITokenPeriod = new(time.Time)
+				*ch.APITokenPeriod = value.Time
+			}
+		case credithold.FieldReleaseReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field release_reason", values[i])
+			} else if value.Valid {
+				ch.ReleaseReason = new(string)
+				*ch.ReleaseReason = value.String
+			}
+		case credithold.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ch.ExpiresAt = value.Time
+			}
+		case credithold.FieldCapturedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field captured_at", values[i])
+			} else if value.Valid {
+				ch.CapturedAt = new(time.Time)
+				*ch.CapturedAt = value.Time
+			}
+		case credithold.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				ch.ReleasedAt = new(time.Time)
+				*ch.ReleasedAt = value.Time
+			}
+		case credithold.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ch.CreatedAt = value.Time
+			}
+		case credithold.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ch.UpdatedAt = value.Time
+			}
+		default:
+			ch.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CreditHold.
+// This includes values selected through modifiers, order, etc.
+func (ch *CreditHold) Value(name string) (ent.Value, error) {
+	return ch.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CreditHold.
+// Note that you need to call CreditHold.Unwrap() before calling this method if this CreditHold
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ch *CreditHold) Update() *CreditHoldUpdateOne {
+	return NewCreditHoldClient(ch.config).UpdateOne(ch)
+}
+
+// Unwrap unwraps the CreditHold entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ch *CreditHold) Unwrap() *CreditHold {
+	_tx, ok := ch.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CreditHold is not a transactional entity")
+	}
+	ch.config.driver = _tx.drv
+	return ch
+}
+
+// String implements the fmt.Stringer.
+func (ch *CreditHold) String() string {
+	var builder strings.Builder
+	builder.WriteString("CreditHold(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ch.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ch.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", ch.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ch.Status))
+	builder.WriteString(", ")
+	builder.WriteString("process_type=")
+	builder.WriteString(fmt.Sprintf("%v", ch.ProcessType))
+	builder.WriteString(", ")
+	if v := ch.JobID; v != nil {
+		builder.WriteString("job_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ch.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ch.APITokenID; v != nil {
+		builder.WriteString("api_token_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ch.APITokenPeriod; v != nil {
+		builder.WriteString("api_token_period=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ch.ReleaseReason; v != nil {
+		builder.WriteString("release_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ch.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ch.CapturedAt; v != nil {
+		builder.WriteString("captured_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ch.ReleasedAt; v != nil {
+		builder.WriteString("released_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ch.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ch.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CreditHolds is a parsable slice of CreditHold.
+type CreditHolds []*CreditHold
@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package credithold
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the credithold type in the database.
+	Label = "credit_hold"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldProcessType holds the string denoting the process_type field in the database.
+	FieldProcessType = "process_type"
+	// FieldJobID holds the string denoting the job_id field in the database.
+	FieldJobID = "job_id"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
+	// FieldAPITokenID holds the string denoting the api_token_id field in the database.
+	FieldAPITokenID = "api_token_id"
+	// FieldAPITokenPeriod holds the string denoting the api_token_period field in the database.
+	FieldAPITokenPeriod = "api_token_period"
+	// FieldReleaseReason holds the string denoting the release_reason field in the database.
+	FieldReleaseReason = "release_reason"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCapturedAt holds the string denoting the captured_at field in the database.
+	FieldCapturedAt = "captured_at"
+	// FieldReleasedAt holds the string denoting the released_at field in the database.
+	FieldReleasedAt = "released_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the credithold in the database.
+	Table = "credit_holds"
+)
+
+// Columns holds all SQL columns for credithold fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldAmount,
+	FieldStatus,
+	FieldProcessType,
+	FieldJobID,
+	FieldParentID,
+	FieldAPITokenID,
+	FieldAPITokenPeriod,
+	FieldReleaseReason,
+	FieldExpiresAt,
+	FieldCapturedAt,
+	FieldReleasedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// Status values.
+const (
+	StatusHeld     Status = "held"
+	StatusCaptured Status = "captured"
+	StatusReleased Status = "released"
+	StatusSplit    Status = "split"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusHeld, StatusCaptured, StatusReleased, StatusSplit:
+		return nil
+	default:
+		return fmt.Errorf("credithold: invalid enum value for status field: %q", s)
+	}
+}
+
+// ProcessType defines the type for the "process_type" enum field.
+type ProcessType string
+
+// ProcessType values.
+const (
+	ProcessTypeGenerate        ProcessType = "generate"
+	ProcessTypeUpscale         ProcessType = "upscale"
+	ProcessTypeVoiceover       ProcessType = "voiceover"
+	ProcessTypeGenerationBatch ProcessType = "generation_batch"
+)
+
+func (pt ProcessType) String() string {
+	return string(pt)
+}
+
+// ProcessTypeValidator is a validator for the "process_type" field enum values. It is called by the builders before save.
+func ProcessTypeValidator(pt ProcessType) error {
+	switch pt {
+	case ProcessTypeGenerate, ProcessTypeUpscale, ProcessTypeVoiceover, ProcessTypeGenerationBatch:
+		return nil
+	default:
+		return fmt.Errorf("credithold: invalid enum value for process_type field: %q", pt)
+	}
+}
+
+// OrderOption defines the ordering options for the CreditHold queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByProcessType orders the results by the process_type field.
+func ByProcessType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessType, opts...).ToFunc()
+}
+
+// ByJobID orders the results by the job_id field.
+func ByJobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobID, opts...).ToFunc()
+}
+
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
+
+// ByAPITokenID orders the results by the api_token_id field.
+func ByAPITokenID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPITokenID, opts...).ToFunc()
+}
+
+// ByAPITokenPeriod orders the results by the api_token_period field.
+func ByAPITokenPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPITokenPeriod, opts...).ToFunc()
+}
+
+// ByReleaseReason orders the results by the release_reason field.
+func ByReleaseReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleaseReason, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByCapturedAt orders the results by the captured_at field.
+func ByCapturedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCapturedAt, opts...).ToFunc()
+}
+
+// ByReleasedAt orders the results by the released_at field.
+func ByReleasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
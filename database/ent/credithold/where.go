@@ -0,0 +1,746 @@
+// Code generated by ent, DO NOT EDIT.
+
+package credithold
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldUserID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v int32) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldAmount, v))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldJobID, v))
+}
+
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldParentID, v))
+}
+
+// APITokenID applies equality check predicate on the "api_token_id" field. It's identical to APITokenIDEQ.
+func APITokenID(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldAPITokenID, v))
+}
+
+// APITokenPeriod applies equality check predicate on the "api_token_period" field. It's identical to APITokenPeriodEQ.
+func APITokenPeriod(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldAPITokenPeriod, v))
+}
+
+// ReleaseReason applies equality check predicate on the "release_reason" field. It's identical to ReleaseReasonEQ.
+func ReleaseReason(v string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldReleaseReason, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CapturedAt applies equality check predicate on the "captured_at" field. It's identical to CapturedAtEQ.
+func CapturedAt(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldCapturedAt, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLTE(FieldUserID, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v int32) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v int32) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...int32) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...int32) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v int32) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v int32) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v int32) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v int32) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLTE(FieldAmount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ProcessTypeEQ applies the EQ predicate on the "process_type" field.
+func ProcessTypeEQ(v ProcessType) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldProcessType, v))
+}
+
+// ProcessTypeNEQ applies the NEQ predicate on the "process_type" field.
+func ProcessTypeNEQ(v ProcessType) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldProcessType, v))
+}
+
+// ProcessTypeIn applies the In predicate on the "process_type" field.
+func ProcessTypeIn(vs ...ProcessType) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldProcessType, vs...))
+}
+
+// ProcessTypeNotIn applies the NotIn predicate on the "process_type" field.
+func ProcessTypeNotIn(vs ...ProcessType) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldProcessType, vs...))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// JobIDGT applies the GT predicate on the "job_id" field.
+func JobIDGT(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGT(FieldJobID, v))
+}
+
+// JobIDGTE applies the GTE predicate on the "job_id" field.
+func JobIDGTE(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGTE(FieldJobID, v))
+}
+
+// JobIDLT applies the LT predicate on the "job_id" field.
+func JobIDLT(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLT(FieldJobID, v))
+}
+
+// JobIDLTE applies the LTE predicate on the "job_id" field.
+func JobIDLTE(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLTE(FieldJobID, v))
+}
+
+// JobIDIsNil applies the IsNil predicate on the "job_id" field.
+func JobIDIsNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIsNull(FieldJobID))
+}
+
+// JobIDNotNil applies the NotNil predicate on the "job_id" field.
+func JobIDNotNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotNull(FieldJobID))
+}
+
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDGT applies the GT predicate on the "parent_id" field.
+func ParentIDGT(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGT(FieldParentID, v))
+}
+
+// ParentIDGTE applies the GTE predicate on the "parent_id" field.
+func ParentIDGTE(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGTE(FieldParentID, v))
+}
+
+// ParentIDLT applies the LT predicate on the "parent_id" field.
+func ParentIDLT(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLT(FieldParentID, v))
+}
+
+// ParentIDLTE applies the LTE predicate on the "parent_id" field.
+func ParentIDLTE(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLTE(FieldParentID, v))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotNull(FieldParentID))
+}
+
+// APITokenIDEQ applies the EQ predicate on the "api_token_id" field.
+func APITokenIDEQ(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldAPITokenID, v))
+}
+
+// APITokenIDNEQ applies the NEQ predicate on the "api_token_id" field.
+func APITokenIDNEQ(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldAPITokenID, v))
+}
+
+// APITokenIDIn applies the In predicate on the "api_token_id" field.
+func APITokenIDIn(vs ...uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldAPITokenID, vs...))
+}
+
+// APITokenIDNotIn applies the NotIn predicate on the "api_token_id" field.
+func APITokenIDNotIn(vs ...uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldAPITokenID, vs...))
+}
+
+// APITokenIDGT applies the GT predicate on the "api_token_id" field.
+func APITokenIDGT(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGT(FieldAPITokenID, v))
+}
+
+// APITokenIDGTE applies the GTE predicate on the "api_token_id" field.
+func APITokenIDGTE(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGTE(FieldAPITokenID, v))
+}
+
+// APITokenIDLT applies the LT predicate on the "api_token_id" field.
+func APITokenIDLT(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLT(FieldAPITokenID, v))
+}
+
+// APITokenIDLTE applies the LTE predicate on the "api_token_id" field.
+func APITokenIDLTE(v uuid.UUID) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLTE(FieldAPITokenID, v))
+}
+
+// APITokenIDIsNil applies the IsNil predicate on the "api_token_id" field.
+func APITokenIDIsNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIsNull(FieldAPITokenID))
+}
+
+// APITokenIDNotNil applies the NotNil predicate on the "api_token_id" field.
+func APITokenIDNotNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotNull(FieldAPITokenID))
+}
+
+// APITokenPeriodEQ applies the EQ predicate on the "api_token_period" field.
+func APITokenPeriodEQ(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldAPITokenPeriod, v))
+}
+
+// APITokenPeriodNEQ applies the NEQ predicate on the "api_token_period" field.
+func APITokenPeriodNEQ(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldAPITokenPeriod, v))
+}
+
+// APITokenPeriodIn applies the In predicate on the "api_token_period" field.
+func APITokenPeriodIn(vs ...time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldAPITokenPeriod, vs...))
+}
+
+// APITokenPeriodNotIn applies the NotIn predicate on the "api_token_period" field.
+func APITokenPeriodNotIn(vs ...time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldAPITokenPeriod, vs...))
+}
+
+// APITokenPeriodGT applies the GT predicate on the "api_token_period" field.
+func APITokenPeriodGT(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGT(FieldAPITokenPeriod, v))
+}
+
+// APITokenPeriodGTE applies the GTE predicate on the "api_token_period" field.
+func APITokenPeriodGTE(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGTE(FieldAPITokenPeriod, v))
+}
+
+// APITokenPeriodLT applies the LT predicate on the "api_token_period" field.
+func APITokenPeriodLT(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLT(FieldAPITokenPeriod, v))
+}
+
+// APITokenPeriodLTE applies the LTE predicate on the "api_token_period" field.
+func APITokenPeriodLTE(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLTE(FieldAPITokenPeriod, v))
+}
+
+// APITokenPeriodIsNil applies the IsNil predicate on the "api_token_period" field.
+func APITokenPeriodIsNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIsNull(FieldAPITokenPeriod))
+}
+
+// APITokenPeriodNotNil applies the NotNil predicate on the "api_token_period" field.
+func APITokenPeriodNotNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotNull(FieldAPITokenPeriod))
+}
+
+// ReleaseReasonEQ applies the EQ predicate on the "release_reason" field.
+func ReleaseReasonEQ(v string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldReleaseReason, v))
+}
+
+// ReleaseReasonNEQ applies the NEQ predicate on the "release_reason" field.
+func ReleaseReasonNEQ(v string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldReleaseReason, v))
+}
+
+// ReleaseReasonIn applies the In predicate on the "release_reason" field.
+func ReleaseReasonIn(vs ...string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldReleaseReason, vs...))
+}
+
+// ReleaseReasonNotIn applies the NotIn predicate on the "release_reason" field.
+func ReleaseReasonNotIn(vs ...string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldReleaseReason, vs...))
+}
+
+// ReleaseReasonGT applies the GT predicate on the "release_reason" field.
+func ReleaseReasonGT(v string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGT(FieldReleaseReason, v))
+}
+
+// ReleaseReasonGTE applies the GTE predicate on the "release_reason" field.
+func ReleaseReasonGTE(v string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGTE(FieldReleaseReason, v))
+}
+
+// ReleaseReasonLT applies the LT predicate on the "release_reason" field.
+func ReleaseReasonLT(v string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLT(FieldReleaseReason, v))
+}
+
+// ReleaseReasonLTE applies the LTE predicate on the "release_reason" field.
+func ReleaseReasonLTE(v string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLTE(FieldReleaseReason, v))
+}
+
+// ReleaseReasonContains applies the Contains predicate on the "release_reason" field.
+func ReleaseReasonContains(v string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldContains(FieldReleaseReason, v))
+}
+
+// ReleaseReasonHasPrefix applies the HasPrefix predicate on the "release_reason" field.
+func ReleaseReasonHasPrefix(v string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldHasPrefix(FieldReleaseReason, v))
+}
+
+// ReleaseReasonHasSuffix applies the HasSuffix predicate on the "release_reason" field.
+func ReleaseReasonHasSuffix(v string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldHasSuffix(FieldReleaseReason, v))
+}
+
+// ReleaseReasonIsNil applies the IsNil predicate on the "release_reason" field.
+func ReleaseReasonIsNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIsNull(FieldReleaseReason))
+}
+
+// ReleaseReasonNotNil applies the NotNil predicate on the "release_reason" field.
+func ReleaseReasonNotNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotNull(FieldReleaseReason))
+}
+
+// ReleaseReasonEqualFold applies the EqualFold predicate on the "release_reason" field.
+func ReleaseReasonEqualFold(v string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEqualFold(FieldReleaseReason, v))
+}
+
+// ReleaseReasonContainsFold applies the ContainsFold predicate on the "release_reason" field.
+func ReleaseReasonContainsFold(v string) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldContainsFold(FieldReleaseReason, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CapturedAtEQ applies the EQ predicate on the "captured_at" field.
+func CapturedAtEQ(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldCapturedAt, v))
+}
+
+// CapturedAtNEQ applies the NEQ predicate on the "captured_at" field.
+func CapturedAtNEQ(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldCapturedAt, v))
+}
+
+// CapturedAtIn applies the In predicate on the "captured_at" field.
+func CapturedAtIn(vs ...time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldCapturedAt, vs...))
+}
+
+// CapturedAtNotIn applies the NotIn predicate on the "captured_at" field.
+func CapturedAtNotIn(vs ...time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldCapturedAt, vs...))
+}
+
+// CapturedAtGT applies the GT predicate on the "captured_at" field.
+func CapturedAtGT(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGT(FieldCapturedAt, v))
+}
+
+// CapturedAtGTE applies the GTE predicate on the "captured_at" field.
+func CapturedAtGTE(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGTE(FieldCapturedAt, v))
+}
+
+// CapturedAtLT applies the LT predicate on the "captured_at" field.
+func CapturedAtLT(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLT(FieldCapturedAt, v))
+}
+
+// CapturedAtLTE applies the LTE predicate on the "captured_at" field.
+func CapturedAtLTE(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLTE(FieldCapturedAt, v))
+}
+
+// CapturedAtIsNil applies the IsNil predicate on the "captured_at" field.
+func CapturedAtIsNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIsNull(FieldCapturedAt))
+}
+
+// CapturedAtNotNil applies the NotNil predicate on the "captured_at" field.
+func CapturedAtNotNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotNull(FieldCapturedAt))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CreditHold {
+	return predicate.CreditHold(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CreditHold) predicate.CreditHold {
+	return predicate.CreditHold(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CreditHold) predicate.CreditHold {
+	return predicate.CreditHold(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CreditHold) predicate.CreditHold {
+	return predicate.CreditHold(sql.NotPredicates(p))
+}
@@ -0,0 +1,1393 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+)
+
+// CreditHoldCreate is the builder for creating a CreditHold entity.
+type CreditHoldCreate struct {
+	config
+	mutation *CreditHoldMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (chc *CreditHoldCreate) SetUserID(u uuid.UUID) *CreditHoldCreate {
+	chc.mutation.SetUserID(u)
+	return chc
+}
+
+// SetAmount sets the "amount" field.
+func (chc *CreditHoldCreate) SetAmount(i int32) *CreditHoldCreate {
+	chc.mutation.SetAmount(i)
+	return chc
+}
+
+// SetStatus sets the "status" field.
+func (chc *CreditHoldCreate) SetStatus(c credithold.Status) *CreditHoldCreate {
+	chc.mutation.SetStatus(c)
+	return chc
+}
+
+// SetProcessType sets the "process_type" field.
+func (chc *CreditHoldCreate) SetProcessType(ct credithold.ProcessType) *CreditHoldCreate {
+	chc.mutation.SetProcessType(ct)
+	return chc
+}
+
+// SetJobID sets the "job_id" field.
+func (chc *CreditHoldCreate) SetJobID(u uuid.UUID) *CreditHoldCreate {
+	chc.mutation.SetJobID(u)
+	return chc
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (chc *CreditHoldCreate) SetNillableJobID(u *uuid.UUID) *CreditHoldCreate {
+	if u != nil {
+		chc.SetJobID(*u)
+	}
+	return chc
+}
+
+// SetParentID sets the "parent_id" field.
+func (chc *CreditHoldCreate) SetParentID(u uuid.UUID) *CreditHoldCreate {
+	chc.mutation.SetParentID(u)
+	return chc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (chc *CreditHoldCreate) SetNillableParentID(u *uuid.UUID) *CreditHoldCreate {
+	if u != nil {
+		chc.SetParentID(*u)
+	}
+	return chc
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (chc *CreditHoldCreate) SetAPITokenID(u uuid.UUID) *CreditHoldCreate {
+	chc.mutation.SetAPITokenID(u)
+	return chc
+}
+
+// SetNillableAPITokenID sets the "api_token_id" field if the given value is not nil.
+func (chc *CreditHoldCreate) SetNillableAPITokenID(u *uuid.UUID) *CreditHoldCreate {
+	if u != nil {
+		chc.SetAPITokenID(*u)
+	}
+	return chc
+}
+
+// SetAPITokenPeriod sets the "api_token_period" field.
+func (chc *CreditHoldCreate) SetAPITokenPeriod(t time.Time) *CreditHoldCreate {
+	chc.mutation.SetAPITokenPeriod(t)
+	return chc
+}
+
+// SetNillableAPITokenPeriod sets the "api_token_period" field if the given value is not nil.
+func (chc *CreditHoldCreate) SetNillableAPITokenPeriod(t *time.Time) *CreditHoldCreate {
+	if t != nil {
+		chc.SetAPITokenPeriod(*t)
+	}
+	return chc
+}
+
+// SetReleaseReason sets the "release_reason" field.
+func (chc *CreditHoldCreate) SetReleaseReason(s string) *CreditHoldCreate {
+	chc.mutation.SetReleaseReason(s)
+	return chc
+}
+
+// SetNillableReleaseReason sets the "release_reason" field if the given value is not nil.
+func (chc *CreditHoldCreate) SetNillableReleaseReason(s *string) *CreditHoldCreate {
+	if s != nil {
+		chc.SetReleaseReason(*s)
+	}
+	return chc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (chc *CreditHoldCreate) SetExpiresAt(t time.Time) *CreditHoldCreate {
+	chc.mutation.SetExpiresAt(t)
+	return chc
+}
+
+// SetCapturedAt sets the "captured_at" field.
+func (chc *CreditHoldCreate) SetCapturedAt(t time.Time) *CreditHoldCreate {
+	chc.mutation.SetCapturedAt(t)
+	return chc
+}
+
+// SetNillableCapturedAt sets the "captured_at" field if the given value is not nil.
+func (chc *CreditHoldCreate) SetNillableCapturedAt(t *time.Time) *CreditHoldCreate {
+	if t != nil {
+		chc.SetCapturedAt(*t)
+	}
+	return chc
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (chc *CreditHoldCreate) SetReleasedAt(t time.Time) *CreditHoldCreate {
+	chc.mutation.SetReleasedAt(t)
+	return chc
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (chc *CreditHoldCreate) SetNillableReleasedAt(t *time.Time) *CreditHoldCreate {
+	if t != nil {
+		chc.SetReleasedAt(*t)
+	}
+	return chc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (chc *CreditHoldCreate) SetCreatedAt(t time.Time) *CreditHoldCreate {
+	chc.mutation.SetCreatedAt(t)
+	return chc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (chc *CreditHoldCreate) SetNillableCreatedAt(t *time.Time) *CreditHoldCreate {
+	if t != nil {
+		chc.SetCreatedAt(*t)
+	}
+	return chc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (chc *CreditHoldCreate) SetUpdatedAt(t time.Time) *CreditHoldCreate {
+	chc.mutation.SetUpdatedAt(t)
+	return chc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (chc *CreditHoldCreate) SetNillableUpdatedAt(t *time.Time) *CreditHoldCreate {
+	if t != nil {
+		chc.SetUpdatedAt(*t)
+	}
+	return chc
+}
+
+// SetID sets the "id" field.
+func (chc *CreditHoldCreate) SetID(u uuid.UUID) *CreditHoldCreate {
+	chc.mutation.SetID(u)
+	return chc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (chc *CreditHoldCreate) SetNillableID(u *uuid.UUID) *CreditHoldCreate {
+	if u != nil {
+		chc.SetID(*u)
+	}
+	return chc
+}
+
+// Mutation returns the CreditHoldMutation object of the builder.
+func (chc *CreditHoldCreate) Mutation() *CreditHoldMutation {
+	return chc.mutation
+}
+
+// Save creates the CreditHold in the database.
+func (chc *CreditHoldCreate) Save(ctx context.Context) (*CreditHold, error) {
+	chc.defaults()
+	return withHooks(ctx, chc.sqlSave, chc.mutation, chc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (chc *CreditHoldCreate) SaveX(ctx context.Context) *CreditHold {
+	v, err := chc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (chc *CreditHoldCreate) Exec(ctx context.Context) error {
+	_, err := chc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (chc *CreditHoldCreate) ExecX(ctx context.Context) {
+	if err := chc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (chc *CreditHoldCreate) defaults() {
+	if _, ok := chc.mutation.CreatedAt(); !ok {
+		v := credithold.DefaultCreatedAt()
+		chc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := chc.mutation.UpdatedAt(); !ok {
+		v := credithold.DefaultUpdatedAt()
+		chc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := chc.mutation.ID(); !ok {
+		v := credithold.DefaultID()
+		chc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (chc *CreditHoldCreate) check() error {
+	if _, ok := chc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "CreditHold.user_id"`)}
+	}
+	if _, ok := chc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "CreditHold.amount"`)}
+	}
+	if _, ok := chc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CreditHold.status"`)}
+	}
+	if v, ok := chc.mutation.Status(); ok {
+		if err := credithold.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CreditHold.status": %w`, err)}
+		}
+	}
+	if _, ok := chc.mutation.ProcessType(); !ok {
+		return &ValidationError{Name: "process_type", err: errors.New(`ent: missing required field "CreditHold.process_type"`)}
+	}
+	if v, ok := chc.mutation.ProcessType(); ok {
+		if err := credithold.ProcessTypeValidator(v); err != nil {
+			return &ValidationError{Name: "process_type", err: fmt.Errorf(`ent: validator failed for field "CreditHold.process_type": %w`, err)}
+		}
+	}
+	if _, ok := chc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "CreditHold.expires_at"`)}
+	}
+	if _, ok := chc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CreditHold.created_at"`)}
+	}
+	if _, ok := chc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CreditHold.updated_at"`)}
+	}
+	return nil
+}
+
+func (chc *CreditHoldCreate) sqlSave(ctx context.Context) (*CreditHold, error) {
+	if err := chc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := chc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, chc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	chc.mutation.id = &_node.ID
+	chc.mutation.done = true
+	return _node, nil
+}
+
+func (chc *CreditHoldCreate) createSpec() (*CreditHold, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CreditHold{config: chc.config}
+		_spec = sqlgraph.NewCreateSpec(credithold.Table, sqlgraph.NewFieldSpec(credithold.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = chc.conflict
+	if id, ok := chc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := chc.mutation.UserID(); ok {
+		_spec.SetField(credithold.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := chc.mutation.Amount(); ok {
+		_spec.SetField(credithold.FieldAmount, field.TypeInt32, value)
+		_node.Amount = value
+	}
+	if value, ok := chc.mutation.Status(); ok {
+		_spec.SetField(credithold.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := chc.mutation.ProcessType(); ok {
+		_spec.SetField(credithold.FieldProcessType, field.TypeEnum, value)
+		_node.ProcessType = value
+	}
+	if value, ok := chc.mutation.JobID(); ok {
+		_spec.SetField(credithold.FieldJobID, field.TypeUUID, value)
+		_node.JobID = &value
+	}
+	if value, ok := chc.mutation.ParentID(); ok {
+		_spec.SetField(credithold.FieldParentID, field.TypeUUID, value)
+		_node.ParentID = &value
+	}
+	if value, ok := chc.mutation.APITokenID(); ok {
+		_spec.SetField(credithold.FieldAPITokenID, field.TypeUUID, value)
+		_node.APITokenID = &value
+	}
+	if value, ok := chc.mutation.APITokenPeriod(); ok {
+		_spec.SetField(credithold.FieldAPITokenPeriod, field.TypeTime, value)
+		_node.APITokenPeriod = &value
+	}
+	if value, ok := chc.mutation.ReleaseReason(); ok {
+		_spec.SetField(credithold.FieldReleaseReason, field.TypeString, value)
+		_node.ReleaseReason = &value
+	}
+	if value, ok := chc.mutation.ExpiresAt(); ok {
+		_spec.SetField(credithold.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := chc.mutation.CapturedAt(); ok {
+		_spec.SetField(credithold.FieldCapturedAt, field.TypeTime, value)
+		_node.CapturedAt = &value
+	}
+	if value, ok := chc.mutation.ReleasedAt(); ok {
+		_spec.SetField(credithold.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	if value, ok := chc.mutation.CreatedAt(); ok {
+		_spec.SetField(credithold.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := chc.mutation.UpdatedAt(); ok {
+		_spec.SetField(credithold.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CreditHold.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CreditHoldUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (chc *CreditHoldCreate) OnConflict(opts ...sql.ConflictOption) *CreditHoldUpsertOne {
+	chc.conflict = opts
+	return &CreditHoldUpsertOne{
+		create: chc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CreditHold.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (chc *CreditHoldCreate) OnConflictColumns(columns ...string) *CreditHoldUpsertOne {
+	chc.conflict = append(chc.conflict, sql.ConflictColumns(columns...))
+	return &CreditHoldUpsertOne{
+		create: chc,
+	}
+}
+
+type (
+	// CreditHoldUpsertOne is the builder for "upsert"-ing
+	//  one CreditHold node.
+	CreditHoldUpsertOne struct {
+		create *CreditHoldCreate
+	}
+
+	// CreditHoldUpsert is the "OnConflict" setter.
+	CreditHoldUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *CreditHoldUpsert) SetUserID(v uuid.UUID) *CreditHoldUpsert {
+	u.Set(credithold.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CreditHoldUpsert) UpdateUserID() *CreditHoldUpsert {
+	u.SetExcluded(credithold.FieldUserID)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *CreditHoldUpsert) SetAmount(v int32) *CreditHoldUpsert {
+	u.Set(credithold.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *CreditHoldUpsert) UpdateAmount() *CreditHoldUpsert {
+	u.SetExcluded(credithold.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *CreditHoldUpsert) AddAmount(v int32) *CreditHoldUpsert {
+	u.Add(credithold.FieldAmount, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CreditHoldUpsert) SetStatus(v credithold.Status) *CreditHoldUpsert {
+	u.Set(credithold.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CreditHoldUpsert) UpdateStatus() *CreditHoldUpsert {
+	u.SetExcluded(credithold.FieldStatus)
+	return u
+}
+
+// SetProcessType sets the "process_type" field.
+func (u *CreditHoldUpsert) SetProcessType(v credithold.ProcessType) *CreditHoldUpsert {
+	u.Set(credithold.FieldProcessType, v)
+	return u
+}
+
+// UpdateProcessType sets the "process_type" field to the value that was provided on create.
+func (u *CreditHoldUpsert) UpdateProcessType() *CreditHoldUpsert {
+	u.SetExcluded(credithold.FieldProcessType)
+	return u
+}
+
+// SetJobID sets the "job_id" field.
+func (u *CreditHoldUpsert) SetJobID(v uuid.UUID) *CreditHoldUpsert {
+	u.Set(credithold.FieldJobID, v)
+	return u
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *CreditHoldUpsert) UpdateJobID() *CreditHoldUpsert {
+	u.SetExcluded(credithold.FieldJobID)
+	return u
+}
+
+// ClearJobID clears the value of the "job_id" field.
+func (u *CreditHoldUpsert) ClearJobID() *CreditHoldUpsert {
+	u.SetNull(credithold.FieldJobID)
+	return u
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *CreditHoldUpsert) SetParentID(v uuid.UUID) *CreditHoldUpsert {
+	u.Set(credithold.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CreditHoldUpsert) UpdateParentID() *CreditHoldUpsert {
+	u.SetExcluded(credithold.FieldParentID)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CreditHoldUpsert) ClearParentID() *CreditHoldUpsert {
+	u.SetNull(credithold.FieldParentID)
+	return u
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (u *CreditHoldUpsert) SetAPITokenID(v uuid.UUID) *CreditHoldUpsert {
+	u.Set(credithold.FieldAPITokenID, v)
+	return u
+}
+
+// UpdateAPITokenID sets the "api_token_id" field to the value that was provided on create.
+func (u *CreditHoldUpsert) UpdateAPITokenID() *CreditHoldUpsert {
+	u.SetExcluded(credithold.FieldAPITokenID)
+	return u
+}
+
+// ClearAPITokenID clears the value of the "api_token_id" field.
+func (u *CreditHoldUpsert) ClearAPITokenID() *CreditHoldUpsert {
+	u.SetNull(credithold.FieldAPITokenID)
+	return u
+}
+
+// SetAPITokenPeriod sets the "api_token_period" field.
+func (u *CreditHoldUpsert) SetAPITokenPeriod(v time.Time) *CreditHoldUpsert {
+	u.Set(credithold.FieldAPITokenPeriod, v)
+	return u
+}
+
+// UpdateAPITokenPeriod sets the "api_token_period" field to the value that was provided on create.
+func (u *CreditHoldUpsert) UpdateAPITokenPeriod() *CreditHoldUpsert {
+	u.SetExcluded(credithold.FieldAPITokenPeriod)
+	return u
+}
+
+// ClearAPITokenPeriod clears the value of the "api_token_period" field.
+func (u *CreditHoldUpsert) ClearAPITokenPeriod() *CreditHoldUpsert {
+	u.SetNull(credithold.FieldAPITokenPeriod)
+	return u
+}
+
+// SetReleaseReason sets the "release_reason" field.
+func (u *CreditHoldUpsert) SetReleaseReason(v string) *CreditHoldUpsert {
+	u.Set(credithold.FieldReleaseReason, v)
+	return u
+}
+
+// UpdateReleaseReason sets the "release_reason" field to the value that was provided on create.
+func (u *CreditHoldUpsert) UpdateReleaseReason() *CreditHoldUpsert {
+	u.SetExcluded(credithold.FieldReleaseReason)
+	return u
+}
+
+// ClearReleaseReason clears the value of the "release_reason" field.
+func (u *CreditHoldUpsert) ClearReleaseReason() *CreditHoldUpsert {
+	u.SetNull(credithold.FieldReleaseReason)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *CreditHoldUpsert) SetExpiresAt(v time.Time) *CreditHoldUpsert {
+	u.Set(credithold.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *CreditHoldUpsert) UpdateExpiresAt() *CreditHoldUpsert {
+	u.SetExcluded(credithold.FieldExpiresAt)
+	return u
+}
+
+// SetCapturedAt sets the "captured_at" field.
+func (u *CreditHoldUpsert) SetCapturedAt(v time.Time) *CreditHoldUpsert {
+	u.Set(credithold.FieldCapturedAt, v)
+	return u
+}
+
+// UpdateCapturedAt sets the "captured_at" field to the value that was provided on create.
+func (u *CreditHoldUpsert) UpdateCapturedAt() *CreditHoldUpsert {
+	u.SetExcluded(credithold.FieldCapturedAt)
+	return u
+}
+
+// ClearCapturedAt clears the value of the "captured_at" field.
+func (u *CreditHoldUpsert) ClearCapturedAt() *CreditHoldUpsert {
+	u.SetNull(credithold.FieldCapturedAt)
+	return u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (u *CreditHoldUpsert) SetReleasedAt(v time.Time) *CreditHoldUpsert {
+	u.Set(credithold.FieldReleasedAt, v)
+	return u
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *CreditHoldUpsert) UpdateReleasedAt() *CreditHoldUpsert {
+	u.SetExcluded(credithold.FieldReleasedAt)
+	return u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *CreditHoldUpsert) ClearReleasedAt() *CreditHoldUpsert {
+	u.SetNull(credithold.FieldReleasedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CreditHoldUpsert) SetUpdatedAt(v time.Time) *CreditHoldUpsert {
+	u.Set(credithold.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CreditHoldUpsert) UpdateUpdatedAt() *CreditHoldUpsert {
+	u.SetExcluded(credithold.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CreditHold.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(credithold.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CreditHoldUpsertOne) UpdateNewValues() *CreditHoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(credithold.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(credithold.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CreditHold.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CreditHoldUpsertOne) Ignore() *CreditHoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CreditHoldUpsertOne) DoNothing() *CreditHoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CreditHoldCreate.OnConflict
+// documentation for more info.
+func (u *CreditHoldUpsertOne) Update(set func(*CreditHoldUpsert)) *CreditHoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CreditHoldUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CreditHoldUpsertOne) SetUserID(v uuid.UUID) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CreditHoldUpsertOne) UpdateUserID() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *CreditHoldUpsertOne) SetAmount(v int32) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *CreditHoldUpsertOne) AddAmount(v int32) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *CreditHoldUpsertOne) UpdateAmount() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CreditHoldUpsertOne) SetStatus(v credithold.Status) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CreditHoldUpsertOne) UpdateStatus() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetProcessType sets the "process_type" field.
+func (u *CreditHoldUpsertOne) SetProcessType(v credithold.ProcessType) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetProcessType(v)
+	})
+}
+
+// UpdateProcessType sets the "process_type" field to the value that was provided on create.
+func (u *CreditHoldUpsertOne) UpdateProcessType() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateProcessType()
+	})
+}
+
+// SetJobID sets the "job_id" field.
+func (u *CreditHoldUpsertOne) SetJobID(v uuid.UUID) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetJobID(v)
+	})
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *CreditHoldUpsertOne) UpdateJobID() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateJobID()
+	})
+}
+
+// ClearJobID clears the value of the "job_id" field.
+func (u *CreditHoldUpsertOne) ClearJobID() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearJobID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *CreditHoldUpsertOne) SetParentID(v uuid.UUID) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CreditHoldUpsertOne) UpdateParentID() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CreditHoldUpsertOne) ClearParentID() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (u *CreditHoldUpsertOne) SetAPITokenID(v uuid.UUID) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetAPITokenID(v)
+	})
+}
+
+// UpdateAPITokenID sets the "api_token_id" field to the value that was provided on create.
+func (u *CreditHoldUpsertOne) UpdateAPITokenID() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateAPITokenID()
+	})
+}
+
+// ClearAPITokenID clears the value of the "api_token_id" field.
+func (u *CreditHoldUpsertOne) ClearAPITokenID() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearAPITokenID()
+	})
+}
+
+// SetAPITokenPeriod sets the "api_token_period" field.
+func (u *CreditHoldUpsertOne) SetAPITokenPeriod(v time.Time) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetAPITokenPeriod(v)
+	})
+}
+
+// UpdateAPITokenPeriod sets the "api_token_period" field to the value that was provided on create.
+func (u *CreditHoldUpsertOne) UpdateAPITokenPeriod() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateAPITokenPeriod()
+	})
+}
+
+// ClearAPITokenPeriod clears the value of the "api_token_period" field.
+func (u *CreditHoldUpsertOne) ClearAPITokenPeriod() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearAPITokenPeriod()
+	})
+}
+
+// SetReleaseReason sets the "release_reason" field.
+func (u *CreditHoldUpsertOne) SetReleaseReason(v string) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetReleaseReason(v)
+	})
+}
+
+// UpdateReleaseReason sets the "release_reason" field to the value that was provided on create.
+func (u *CreditHoldUpsertOne) UpdateReleaseReason() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateReleaseReason()
+	})
+}
+
+// ClearReleaseReason clears the value of the "release_reason" field.
+func (u *CreditHoldUpsertOne) ClearReleaseReason() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearReleaseReason()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *CreditHoldUpsertOne) SetExpiresAt(v time.Time) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *CreditHoldUpsertOne) UpdateExpiresAt() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCapturedAt sets the "captured_at" field.
+func (u *CreditHoldUpsertOne) SetCapturedAt(v time.Time) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetCapturedAt(v)
+	})
+}
+
+// UpdateCapturedAt sets the "captured_at" field to the value that was provided on create.
+func (u *CreditHoldUpsertOne) UpdateCapturedAt() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateCapturedAt()
+	})
+}
+
+// ClearCapturedAt clears the value of the "captured_at" field.
+func (u *CreditHoldUpsertOne) ClearCapturedAt() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearCapturedAt()
+	})
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (u *CreditHoldUpsertOne) SetReleasedAt(v time.Time) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetReleasedAt(v)
+	})
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *CreditHoldUpsertOne) UpdateReleasedAt() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateReleasedAt()
+	})
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *CreditHoldUpsertOne) ClearReleasedAt() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearReleasedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CreditHoldUpsertOne) SetUpdatedAt(v time.Time) *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CreditHoldUpsertOne) UpdateUpdatedAt() *CreditHoldUpsertOne {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CreditHoldUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CreditHoldCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CreditHoldUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CreditHoldUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CreditHoldUpsertOne.ID is not supported by MySQL driver. Use CreditHoldUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CreditHoldUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CreditHoldCreateBulk is the builder for creating many CreditHold entities in bulk.
+type CreditHoldCreateBulk struct {
+	config
+	err      error
+	builders []*CreditHoldCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CreditHold entities in the database.
+func (chcb *CreditHoldCreateBulk) Save(ctx context.Context) ([]*CreditHold, error) {
+	if chcb.err != nil {
+		return nil, chcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(chcb.builders))
+	nodes := make([]*CreditHold, len(chcb.builders))
+	mutators := make([]Mutator, len(chcb.builders))
+	for i := range chcb.builders {
+		func(i int, root context.Context) {
+			builder := chcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CreditHoldMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, chcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = chcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, chcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, chcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (chcb *CreditHoldCreateBulk) SaveX(ctx context.Context) []*CreditHold {
+	v, err := chcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (chcb *CreditHoldCreateBulk) Exec(ctx context.Context) error {
+	_, err := chcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (chcb *CreditHoldCreateBulk) ExecX(ctx context.Context) {
+	if err := chcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CreditHold.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CreditHoldUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (chcb *CreditHoldCreateBulk) OnConflict(opts ...sql.ConflictOption) *CreditHoldUpsertBulk {
+	chcb.conflict = opts
+	return &CreditHoldUpsertBulk{
+		create: chcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CreditHold.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (chcb *CreditHoldCreateBulk) OnConflictColumns(columns ...string) *CreditHoldUpsertBulk {
+	chcb.conflict = append(chcb.conflict, sql.ConflictColumns(columns...))
+	return &CreditHoldUpsertBulk{
+		create: chcb,
+	}
+}
+
+// CreditHoldUpsertBulk is the builder for "upsert"-ing
+// a bulk of CreditHold nodes.
+type CreditHoldUpsertBulk struct {
+	create *CreditHoldCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CreditHold.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(credithold.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CreditHoldUpsertBulk) UpdateNewValues() *CreditHoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(credithold.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(credithold.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CreditHold.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CreditHoldUpsertBulk) Ignore() *CreditHoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CreditHoldUpsertBulk) DoNothing() *CreditHoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CreditHoldCreateBulk.OnConflict
+// documentation for more info.
+func (u *CreditHoldUpsertBulk) Update(set func(*CreditHoldUpsert)) *CreditHoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CreditHoldUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CreditHoldUpsertBulk) SetUserID(v uuid.UUID) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CreditHoldUpsertBulk) UpdateUserID() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *CreditHoldUpsertBulk) SetAmount(v int32) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *CreditHoldUpsertBulk) AddAmount(v int32) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *CreditHoldUpsertBulk) UpdateAmount() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CreditHoldUpsertBulk) SetStatus(v credithold.Status) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CreditHoldUpsertBulk) UpdateStatus() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetProcessType sets the "process_type" field.
+func (u *CreditHoldUpsertBulk) SetProcessType(v credithold.ProcessType) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetProcessType(v)
+	})
+}
+
+// UpdateProcessType sets the "process_type" field to the value that was provided on create.
+func (u *CreditHoldUpsertBulk) UpdateProcessType() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateProcessType()
+	})
+}
+
+// SetJobID sets the "job_id" field.
+func (u *CreditHoldUpsertBulk) SetJobID(v uuid.UUID) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetJobID(v)
+	})
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *CreditHoldUpsertBulk) UpdateJobID() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateJobID()
+	})
+}
+
+// ClearJobID clears the value of the "job_id" field.
+func (u *CreditHoldUpsertBulk) ClearJobID() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearJobID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *CreditHoldUpsertBulk) SetParentID(v uuid.UUID) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CreditHoldUpsertBulk) UpdateParentID() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CreditHoldUpsertBulk) ClearParentID() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (u *CreditHoldUpsertBulk) SetAPITokenID(v uuid.UUID) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetAPITokenID(v)
+	})
+}
+
+// UpdateAPITokenID sets the "api_token_id" field to the value that was provided on create.
+func (u *CreditHoldUpsertBulk) UpdateAPITokenID() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateAPITokenID()
+	})
+}
+
+// ClearAPITokenID clears the value of the "api_token_id" field.
+func (u *CreditHoldUpsertBulk) ClearAPITokenID() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearAPITokenID()
+	})
+}
+
+// SetAPITokenPeriod sets the "api_token_period" field.
+func (u *CreditHoldUpsertBulk) SetAPITokenPeriod(v time.Time) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetAPITokenPeriod(v)
+	})
+}
+
+// UpdateAPITokenPeriod sets the "api_token_period" field to the value that was provided on create.
+func (u *CreditHoldUpsertBulk) UpdateAPITokenPeriod() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateAPITokenPeriod()
+	})
+}
+
+// ClearAPITokenPeriod clears the value of the "api_token_period" field.
+func (u *CreditHoldUpsertBulk) ClearAPITokenPeriod() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearAPITokenPeriod()
+	})
+}
+
+// SetReleaseReason sets the "release_reason" field.
+func (u *CreditHoldUpsertBulk) SetReleaseReason(v string) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetReleaseReason(v)
+	})
+}
+
+// UpdateReleaseReason sets the "release_reason" field to the value that was provided on create.
+func (u *CreditHoldUpsertBulk) UpdateReleaseReason() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateReleaseReason()
+	})
+}
+
+// ClearReleaseReason clears the value of the "release_reason" field.
+func (u *CreditHoldUpsertBulk) ClearReleaseReason() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearReleaseReason()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *CreditHoldUpsertBulk) SetExpiresAt(v time.Time) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *CreditHoldUpsertBulk) UpdateExpiresAt() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCapturedAt sets the "captured_at" field.
+func (u *CreditHoldUpsertBulk) SetCapturedAt(v time.Time) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetCapturedAt(v)
+	})
+}
+
+// UpdateCapturedAt sets the "captured_at" field to the value that was provided on create.
+func (u *CreditHoldUpsertBulk) UpdateCapturedAt() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateCapturedAt()
+	})
+}
+
+// ClearCapturedAt clears the value of the "captured_at" field.
+func (u *CreditHoldUpsertBulk) ClearCapturedAt() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearCapturedAt()
+	})
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (u *CreditHoldUpsertBulk) SetReleasedAt(v time.Time) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetReleasedAt(v)
+	})
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *CreditHoldUpsertBulk) UpdateReleasedAt() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateReleasedAt()
+	})
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *CreditHoldUpsertBulk) ClearReleasedAt() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.ClearReleasedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CreditHoldUpsertBulk) SetUpdatedAt(v time.Time) *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CreditHoldUpsertBulk) UpdateUpdatedAt() *CreditHoldUpsertBulk {
+	return u.Update(func(s *CreditHoldUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CreditHoldUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CreditHoldCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CreditHoldCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CreditHoldUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// CreditHoldDelete is the builder for deleting a CreditHold entity.
+type CreditHoldDelete struct {
+	config
+	hooks    []Hook
+	mutation *CreditHoldMutation
+}
+
+// Where appends a list predicates to the CreditHoldDelete builder.
+func (chd *CreditHoldDelete) Where(ps ...predicate.CreditHold) *CreditHoldDelete {
+	chd.mutation.Where(ps...)
+	return chd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (chd *CreditHoldDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, chd.sqlExec, chd.mutation, chd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (chd *CreditHoldDelete) ExecX(ctx context.Context) int {
+	n, err := chd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (chd *CreditHoldDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(credithold.Table, sqlgraph.NewFieldSpec(credithold.FieldID, field.TypeUUID))
+	if ps := chd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, chd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	chd.mutation.done = true
+	return affected, err
+}
+
+// CreditHoldDeleteOne is the builder for deleting a single CreditHold entity.
+type CreditHoldDeleteOne struct {
+	chd *CreditHoldDelete
+}
+
+// Where appends a list predicates to the CreditHoldDelete builder.
+func (chdo *CreditHoldDeleteOne) Where(ps ...predicate.CreditHold) *CreditHoldDeleteOne {
+	chdo.chd.mutation.Where(ps...)
+	return chdo
+}
+
+// Exec executes the deletion query.
+func (chdo *CreditHoldDeleteOne) Exec(ctx context.Context) error {
+	n, err := chdo.chd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{credithold.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (chdo *CreditHoldDeleteOne) ExecX(ctx context.Context) {
+	if err := chdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
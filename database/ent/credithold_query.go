@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// CreditHoldQuery is the builder for querying CreditHold entities.
+type CreditHoldQuery struct {
+	config
+	ctx        *QueryContext
+	order      []credithold.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CreditHold
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CreditHoldQuery builder.
+func (chq *CreditHoldQuery) Where(ps ...predicate.CreditHold) *CreditHoldQuery {
+	chq.predicates = append(chq.predicates, ps...)
+	return chq
+}
+
+// Limit the number of records to be returned by this query.
+func (chq *CreditHoldQuery) Limit(limit int) *CreditHoldQuery {
+	chq.ctx.Limit = &limit
+	return chq
+}
+
+// Offset to start from.
+func (chq *CreditHoldQuery) Offset(offset int) *CreditHoldQuery {
+	chq.ctx.Offset = &offset
+	return chq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (chq *CreditHoldQuery) Unique(unique bool) *CreditHoldQuery {
+	chq.ctx.Unique = &unique
+	return chq
+}
+
+// Order specifies how the records should be ordered.
+func (chq *CreditHoldQuery) Order(o ...credithold.OrderOption) *CreditHoldQuery {
+	chq.order = append(chq.order, o...)
+	return chq
+}
+
+// First returns the first CreditHold entity from the query.
+// Returns a *NotFoundError when no CreditHold was found.
+func (chq *CreditHoldQuery) First(ctx context.Context) (*CreditHold, error) {
+	nodes, err := chq.Limit(1).All(setContextOp(ctx, chq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{credithold.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (chq *CreditHoldQuery) FirstX(ctx context.Context) *CreditHold {
+	node, err := chq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CreditHold ID from the query.
+// Returns a *NotFoundError when no CreditHold ID was found.
+func (chq *CreditHoldQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = chq.Limit(1).IDs(setContextOp(ctx, chq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{credithold.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (chq *CreditHoldQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := chq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CreditHold entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CreditHold entity is found.
+// Returns a *NotFoundError when no CreditHold entities are found.
+func (chq *CreditHoldQuery) Only(ctx context.Context) (*CreditHold, error) {
+	nodes, err := chq.Limit(2).All(setContextOp(ctx, chq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{credithold.Label}
+	default:
+		return nil, &NotSingularError{credithold.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (chq *CreditHoldQuery) OnlyX(ctx context.Context) *CreditHold {
+	node, err := chq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CreditHold ID in the query.
+// Returns a *NotSingularError when more than one CreditHold ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (chq *CreditHoldQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = chq.Limit(2).IDs(setContextOp(ctx, chq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{credithold.Label}
+	default:
+		err = &NotSingularError{credithold.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (chq *CreditHoldQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := chq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CreditHolds.
+func (chq *CreditHoldQuery) All(ctx context.Context) ([]*CreditHold, error) {
+	ctx = setContextOp(ctx, chq.ctx, ent.OpQueryAll)
+	if err := chq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CreditHold, *CreditHoldQuery]()
+	return withInterceptors[[]*CreditHold](ctx, chq, qr, chq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (chq *CreditHoldQuery) AllX(ctx context.Context) []*CreditHold {
+	nodes, err := chq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CreditHold IDs.
+func (chq *CreditHoldQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if chq.ctx.Unique == nil && chq.path != nil {
+		chq.Unique(true)
+	}
+	ctx = setContextOp(ctx, chq.ctx, ent.OpQueryIDs)
+	if err = chq.Select(credithold.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (chq *CreditHoldQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := chq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (chq *CreditHoldQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, chq.ctx, ent.OpQueryCount)
+	if err := chq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, chq, querierCount[*CreditHoldQuery](), chq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (chq *CreditHoldQuery) CountX(ctx context.Context) int {
+	count, err := chq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (chq *CreditHoldQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, chq.ctx, ent.OpQueryExist)
+	switch _, err := chq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (chq *CreditHoldQuery) ExistX(ctx context.Context) bool {
+	exist, err := chq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CreditHoldQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (chq *CreditHoldQuery) Clone() *CreditHoldQuery {
+	if chq == nil {
+		return nil
+	}
+	return &CreditHoldQuery{
+		config:     chq.config,
+		ctx:        chq.ctx.Clone(),
+		order:      append([]credithold.OrderOption{}, chq.order...),
+		inters:     append([]Interceptor{}, chq.inters...),
+		predicates: append([]predicate.CreditHold{}, chq.predicates...),
+		// clone intermediate query.
+		sql:  chq.sql.Clone(),
+		path: chq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CreditHold.Query().
+//		GroupBy(credithold.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (chq *CreditHoldQuery) GroupBy(field string, fields ...string) *CreditHoldGroupBy {
+	chq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CreditHoldGroupBy{build: chq}
+	grbuild.flds = &chq.ctx.Fields
+	grbuild.label = credithold.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//	}
+//
+//	client.CreditHold.Query().
+//		Select(credithold.FieldUserID).
+//		Scan(ctx, &v)
+func (chq *CreditHoldQuery) Select(fields ...string) *CreditHoldSelect {
+	chq.ctx.Fields = append(chq.ctx.Fields, fields...)
+	sbuild := &CreditHoldSelect{CreditHoldQuery: chq}
+	sbuild.label = credithold.Label
+	sbuild.flds, sbuild.scan = &chq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CreditHoldSelect configured with the given aggregations.
+func (chq *CreditHoldQuery) Aggregate(fns ...AggregateFunc) *CreditHoldSelect {
+	return chq.Select().Aggregate(fns...)
+}
+
+func (chq *CreditHoldQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range chq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, chq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range chq.ctx.Fields {
+		if !credithold.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if chq.path != nil {
+		prev, err := chq.path(ctx)
+		if err != nil {
+			return err
+		}
+		chq.sql = prev
+	}
+	return nil
+}
+
+func (chq *CreditHoldQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CreditHold, error) {
+	var (
+		nodes = []*CreditHold{}
+		_spec = chq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CreditHold).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CreditHold{config: chq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(chq.modifiers) > 0 {
+		_spec.Modifiers = chq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, chq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (chq *CreditHoldQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := chq.querySpec()
+	if len(chq.modifiers) > 0 {
+		_spec.Modifiers = chq.modifiers
+	}
+	_spec.Node.Columns = chq.ctx.Fields
+	if len(chq.ctx.Fields) > 0 {
+		_spec.Unique = chq.ctx.Unique != nil && *chq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, chq.driver, _spec)
+}
+
+func (chq *CreditHoldQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(credithold.Table, credithold.Columns, sqlgraph.NewFieldSpec(credithold.FieldID, field.TypeUUID))
+	_spec.From = chq.sql
+	if unique := chq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if chq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := chq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, credithold.FieldID)
+		for i := range fields {
+			if fields[i] != credithold.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := chq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := chq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := chq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := chq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (chq *CreditHoldQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(chq.driver.Dialect())
+	t1 := builder.Table(credithold.Table)
+	columns := chq.ctx.Fields
+	if len(columns) == 0 {
+		columns = credithold.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if chq.sql != nil {
+		selector = chq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if chq.ctx.Unique != nil && *chq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range chq.modifiers {
+		m(selector)
+	}
+	for _, p := range chq.predicates {
+		p(selector)
+	}
+	for _, p := range chq.order {
+		p(selector)
+	}
+	if offset := chq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := chq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (chq *CreditHoldQuery) Modify(modifiers ...func(s *sql.Selector)) *CreditHoldSelect {
+	chq.modifiers = append(chq.modifiers, modifiers...)
+	return chq.Select()
+}
+
+// CreditHoldGroupBy is the group-by builder for CreditHold entities.
+type CreditHoldGroupBy struct {
+	selector
+	build *CreditHoldQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (chgb *CreditHoldGroupBy) Aggregate(fns ...AggregateFunc) *CreditHoldGroupBy {
+	chgb.fns = append(chgb.fns, fns...)
+	return chgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (chgb *CreditHoldGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, chgb.build.ctx, ent.OpQueryGroupBy)
+	if err := chgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CreditHoldQuery, *CreditHoldGroupBy](ctx, chgb.build, chgb, chgb.build.inters, v)
+}
+
+func (chgb *CreditHoldGroupBy) sqlScan(ctx context.Context, root *CreditHoldQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(chgb.fns))
+	for _, fn := range chgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*chgb.flds)+len(chgb.fns))
+		for _, f := range *chgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*chgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := chgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CreditHoldSelect is the builder for selecting fields of CreditHold entities.
+type CreditHoldSelect struct {
+	*CreditHoldQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (chs *CreditHoldSelect) Aggregate(fns ...AggregateFunc) *CreditHoldSelect {
+	chs.fns = append(chs.fns, fns...)
+	return chs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (chs *CreditHoldSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, chs.ctx, ent.OpQuerySelect)
+	if err := chs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CreditHoldQuery, *CreditHoldSelect](ctx, chs.CreditHoldQuery, chs, chs.inters, v)
+}
+
+func (chs *CreditHoldSelect) sqlScan(ctx context.Context, root *CreditHoldQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(chs.fns))
+	for _, fn := range chs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*chs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := chs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (chs *CreditHoldSelect) Modify(modifiers ...func(s *sql.Selector)) *CreditHoldSelect {
+	chs.modifiers = append(chs.modifiers, modifiers...)
+	return chs
+}
@@ -0,0 +1,819 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// CreditHoldUpdate is the builder for updating CreditHold entities.
+type CreditHoldUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CreditHoldMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CreditHoldUpdate builder.
+func (chu *CreditHoldUpdate) Where(ps ...predicate.CreditHold) *CreditHoldUpdate {
+	chu.mutation.Where(ps...)
+	return chu
+}
+
+// SetUserID sets the "user_id" field.
+func (chu *CreditHoldUpdate) SetUserID(u uuid.UUID) *CreditHoldUpdate {
+	chu.mutation.SetUserID(u)
+	return chu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (chu *CreditHoldUpdate) SetNillableUserID(u *uuid.UUID) *CreditHoldUpdate {
+	if u != nil {
+		chu.SetUserID(*u)
+	}
+	return chu
+}
+
+// SetAmount sets the "amount" field.
+func (chu *CreditHoldUpdate) SetAmount(i int32) *CreditHoldUpdate {
+	chu.mutation.ResetAmount()
+	chu.mutation.SetAmount(i)
+	return chu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (chu *CreditHoldUpdate) SetNillableAmount(i *int32) *CreditHoldUpdate {
+	if i != nil {
+		chu.SetAmount(*i)
+	}
+	return chu
+}
+
+// AddAmount adds i to the "amount" field.
+func (chu *CreditHoldUpdate) AddAmount(i int32) *CreditHoldUpdate {
+	chu.mutation.AddAmount(i)
+	return chu
+}
+
+// SetStatus sets the "status" field.
+func (chu *CreditHoldUpdate) SetStatus(c credithold.Status) *CreditHoldUpdate {
+	chu.mutation.SetStatus(c)
+	return chu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (chu *CreditHoldUpdate) SetNillableStatus(c *credithold.Status) *CreditHoldUpdate {
+	if c != nil {
+		chu.SetStatus(*c)
+	}
+	return chu
+}
+
+// SetProcessType sets the "process_type" field.
+func (chu *CreditHoldUpdate) SetProcessType(ct credithold.ProcessType) *CreditHoldUpdate {
+	chu.mutation.SetProcessType(ct)
+	return chu
+}
+
+// SetNillableProcessType sets the "process_type" field if the given value is not nil.
+func (chu *CreditHoldUpdate) SetNillableProcessType(ct *credithold.ProcessType) *CreditHoldUpdate {
+	if ct != nil {
+		chu.SetProcessType(*ct)
+	}
+	return chu
+}
+
+// SetJobID sets the "job_id" field.
+func (chu *CreditHoldUpdate) SetJobID(u uuid.UUID) *CreditHoldUpdate {
+	chu.mutation.SetJobID(u)
+	return chu
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (chu *CreditHoldUpdate) SetNillableJobID(u *uuid.UUID) *CreditHoldUpdate {
+	if u != nil {
+		chu.SetJobID(*u)
+	}
+	return chu
+}
+
+// ClearJobID clears the value of the "job_id" field.
+func (chu *CreditHoldUpdate) ClearJobID() *CreditHoldUpdate {
+	chu.mutation.ClearJobID()
+	return chu
+}
+
+// SetParentID sets the "parent_id" field.
+func (chu *CreditHoldUpdate) SetParentID(u uuid.UUID) *CreditHoldUpdate {
+	chu.mutation.SetParentID(u)
+	return chu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (chu *CreditHoldUpdate) SetNillableParentID(u *uuid.UUID) *CreditHoldUpdate {
+	if u != nil {
+		chu.SetParentID(*u)
+	}
+	return chu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (chu *CreditHoldUpdate) ClearParentID() *CreditHoldUpdate {
+	chu.mutation.ClearParentID()
+	return chu
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (chu *CreditHoldUpdate) SetAPITokenID(u uuid.UUID) *CreditHoldUpdate {
+	chu.mutation.SetAPITokenID(u)
+	return chu
+}
+
+// SetNillableAPITokenID sets the "api_token_id" field if the given value is not nil.
+func (chu *CreditHoldUpdate) SetNillableAPITokenID(u *uuid.UUID) *CreditHoldUpdate {
+	if u != nil {
+		chu.SetAPITokenID(*u)
+	}
+	return chu
+}
+
+// ClearAPITokenID clears the value of the "api_token_id" field.
+func (chu *CreditHoldUpdate) ClearAPITokenID() *CreditHoldUpdate {
+	chu.mutation.ClearAPITokenID()
+	return chu
+}
+
+// SetAPITokenPeriod sets the "api_token_period" field.
+func (chu *CreditHoldUpdate) SetAPITokenPeriod(t time.Time) *CreditHoldUpdate {
+	chu.mutation.SetAPITokenPeriod(t)
+	return chu
+}
+
+// SetNillableAPITokenPeriod sets the "api_token_period" field if the given value is not nil.
+func (chu *CreditHoldUpdate) SetNillableAPITokenPeriod(t *time.Time) *CreditHoldUpdate {
+	if t != nil {
+		chu.SetAPITokenPeriod(*t)
+	}
+	return chu
+}
+
+// ClearAPITokenPeriod clears the value of the "api_token_period" field.
+func (chu *CreditHoldUpdate) ClearAPITokenPeriod() *CreditHoldUpdate {
+	chu.mutation.ClearAPITokenPeriod()
+	return chu
+}
+
+// SetReleaseReason sets the "release_reason" field.
+func (chu *CreditHoldUpdate) SetReleaseReason(s string) *CreditHoldUpdate {
+	chu.mutation.SetReleaseReason(s)
+	return chu
+}
+
+// SetNillableReleaseReason sets the "release_reason" field if the given value is not nil.
+func (chu *CreditHoldUpdate) SetNillableReleaseReason(s *string) *CreditHoldUpdate {
+	if s != nil {
+		chu.SetReleaseReason(*s)
+	}
+	return chu
+}
+
+// ClearReleaseReason clears the value of the "release_reason" field.
+func (chu *CreditHoldUpdate) ClearReleaseReason() *CreditHoldUpdate {
+	chu.mutation.ClearReleaseReason()
+	return chu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (chu *CreditHoldUpdate) SetExpiresAt(t time.Time) *CreditHoldUpdate {
+	chu.mutation.SetExpiresAt(t)
+	return chu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (chu *CreditHoldUpdate) SetNillableExpiresAt(t *time.Time) *CreditHoldUpdate {
+	if t != nil {
+		chu.SetExpiresAt(*t)
+	}
+	return chu
+}
+
+// SetCapturedAt sets the "captured_at" field.
+func (chu *CreditHoldUpdate) SetCapturedAt(t time.Time) *CreditHoldUpdate {
+	chu.mutation.SetCapturedAt(t)
+	return chu
+}
+
+// SetNillableCapturedAt sets the "captured_at" field if the given value is not nil.
+func (chu *CreditHoldUpdate) SetNillableCapturedAt(t *time.Time) *CreditHoldUpdate {
+	if t != nil {
+		chu.SetCapturedAt(*t)
+	}
+	return chu
+}
+
+// ClearCapturedAt clears the value of the "captured_at" field.
+func (chu *CreditHoldUpdate) ClearCapturedAt() *CreditHoldUpdate {
+	chu.mutation.ClearCapturedAt()
+	return chu
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (chu *CreditHoldUpdate) SetReleasedAt(t time.Time) *CreditHoldUpdate {
+	chu.mutation.SetReleasedAt(t)
+	return chu
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (chu *CreditHoldUpdate) SetNillableReleasedAt(t *time.Time) *CreditHoldUpdate {
+	if t != nil {
+		chu.SetReleasedAt(*t)
+	}
+	return chu
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (chu *CreditHoldUpdate) ClearReleasedAt() *CreditHoldUpdate {
+	chu.mutation.ClearReleasedAt()
+	return chu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (chu *CreditHoldUpdate) SetUpdatedAt(t time.Time) *CreditHoldUpdate {
+	chu.mutation.SetUpdatedAt(t)
+	return chu
+}
+
+// Mutation returns the CreditHoldMutation object of the builder.
+func (chu *CreditHoldUpdate) Mutation() *CreditHoldMutation {
+	return chu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (chu *CreditHoldUpdate) Save(ctx context.Context) (int, error) {
+	chu.defaults()
+	return withHooks(ctx, chu.sqlSave, chu.mutation, chu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (chu *CreditHoldUpdate) SaveX(ctx context.Context) int {
+	affected, err := chu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (chu *CreditHoldUpdate) Exec(ctx context.Context) error {
+	_, err := chu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (chu *CreditHoldUpdate) ExecX(ctx context.Context) {
+	if err := chu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (chu *CreditHoldUpdate) defaults() {
+	if _, ok := chu.mutation.UpdatedAt(); !ok {
+		v := credithold.UpdateDefaultUpdatedAt()
+		chu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (chu *CreditHoldUpdate) check() error {
+	if v, ok := chu.mutation.Status(); ok {
+		if err := credithold.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CreditHold.status": %w`, err)}
+		}
+	}
+	if v, ok := chu.mutation.ProcessType(); ok {
+		if err := credithold.ProcessTypeValidator(v); err != nil {
+			return &ValidationError{Name: "process_type", err: fmt.Errorf(`ent: validator failed for field "CreditHold.process_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (chu *CreditHoldUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CreditHoldUpdate {
+	chu.modifiers = append(chu.modifiers, modifiers...)
+	return chu
+}
+
+func (chu *CreditHoldUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := chu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(credithold.Table, credithold.Columns, sqlgraph.NewFieldSpec(credithold.FieldID, field.TypeUUID))
+	if ps := chu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := chu.mutation.UserID(); ok {
+		_spec.SetField(credithold.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := chu.mutation.Amount(); ok {
+		_spec.SetField(credithold.FieldAmount, field.TypeInt32, value)
+	}
+	if value, ok := chu.mutation.AddedAmount(); ok {
+		_spec.AddField(credithold.FieldAmount, field.TypeInt32, value)
+	}
+	if value, ok := chu.mutation.Status(); ok {
+		_spec.SetField(credithold.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := chu.mutation.ProcessType(); ok {
+		_spec.SetField(credithold.FieldProcessType, field.TypeEnum, value)
+	}
+	if value, ok := chu.mutation.JobID(); ok {
+		_spec.SetField(credithold.FieldJobID, field.TypeUUID, value)
+	}
+	if chu.mutation.JobIDCleared() {
+		_spec.ClearField(credithold.FieldJobID, field.TypeUUID)
+	}
+	if value, ok := chu.mutation.ParentID(); ok {
+		_spec.SetField(credithold.FieldParentID, field.TypeUUID, value)
+	}
+	if chu.mutation.ParentIDCleared() {
+		_spec.ClearField(credithold.FieldParentID, field.TypeUUID)
+	}
+	if value, ok := chu.mutation.APITokenID(); ok {
+		_spec.SetField(credithold.FieldAPITokenID, field.TypeUUID, value)
+	}
+	if chu.mutation.APITokenIDCleared() {
+		_spec.ClearField(credithold.FieldAPITokenID, field.TypeUUID)
+	}
+	if value, ok := chu.mutation.APITokenPeriod(); ok {
+		_spec.SetField(credithold.FieldAPITokenPeriod, field.TypeTime, value)
+	}
+	if chu.mutation.APITokenPeriodCleared() {
+		_spec.ClearField(credithold.FieldAPITokenPeriod, field.TypeTime)
+	}
+	if value, ok := chu.mutation.ReleaseReason(); ok {
+		_spec.SetField(credithold.FieldReleaseReason, field.TypeString, value)
+	}
+	if chu.mutation.ReleaseReasonCleared() {
+		_spec.ClearField(credithold.FieldReleaseReason, field.TypeString)
+	}
+	if value, ok := chu.mutation.ExpiresAt(); ok {
+		_spec.SetField(credithold.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := chu.mutation.CapturedAt(); ok {
+		_spec.SetField(credithold.FieldCapturedAt, field.TypeTime, value)
+	}
+	if chu.mutation.CapturedAtCleared() {
+		_spec.ClearField(credithold.FieldCapturedAt, field.TypeTime)
+	}
+	if value, ok := chu.mutation.ReleasedAt(); ok {
+		_spec.SetField(credithold.FieldReleasedAt, field.TypeTime, value)
+	}
+	if chu.mutation.ReleasedAtCleared() {
+		_spec.ClearField(credithold.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := chu.mutation.UpdatedAt(); ok {
+		_spec.SetField(credithold.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(chu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, chu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credithold.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	chu.mutation.done = true
+	return n, nil
+}
+
+// CreditHoldUpdateOne is the builder for updating a single CreditHold entity.
+type CreditHoldUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CreditHoldMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (chuo *CreditHoldUpdateOne) SetUserID(u uuid.UUID) *CreditHoldUpdateOne {
+	chuo.mutation.SetUserID(u)
+	return chuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (chuo *CreditHoldUpdateOne) SetNillableUserID(u *uuid.UUID) *CreditHoldUpdateOne {
+	if u != nil {
+		chuo.SetUserID(*u)
+	}
+	return chuo
+}
+
+// SetAmount sets the "amount" field.
+func (chuo *CreditHoldUpdateOne) SetAmount(i int32) *CreditHoldUpdateOne {
+	chuo.mutation.ResetAmount()
+	chuo.mutation.SetAmount(i)
+	return chuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (chuo *CreditHoldUpdateOne) SetNillableAmount(i *int32) *CreditHoldUpdateOne {
+	if i != nil {
+		chuo.SetAmount(*i)
+	}
+	return chuo
+}
+
+// AddAmount adds i to the "amount" field.
+func (chuo *CreditHoldUpdateOne) AddAmount(i int32) *CreditHoldUpdateOne {
+	chuo.mutation.AddAmount(i)
+	return chuo
+}
+
+// SetStatus sets the "status" field.
+func (chuo *CreditHoldUpdateOne) SetStatus(c credithold.Status) *CreditHoldUpdateOne {
+	chuo.mutation.SetStatus(c)
+	return chuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (chuo *CreditHoldUpdateOne) SetNillableStatus(c *credithold.Status) *CreditHoldUpdateOne {
+	if c != nil {
+		chuo.SetStatus(*c)
+	}
+	return chuo
+}
+
+// SetProcessType sets the "process_type" field.
+func (chuo *CreditHoldUpdateOne) SetProcessType(ct credithold.ProcessType) *CreditHoldUpdateOne {
+	chuo.mutation.SetProcessType(ct)
+	return chuo
+}
+
+// SetNillableProcessType sets the "process_type" field if the given value is not nil.
+func (chuo *CreditHoldUpdateOne) SetNillableProcessType(ct *credithold.ProcessType) *CreditHoldUpdateOne {
+	if ct != nil {
+		chuo.SetProcessType(*ct)
+	}
+	return chuo
+}
+
+// SetJobID sets the "job_id" field.
+func (chuo *CreditHoldUpdateOne) SetJobID(u uuid.UUID) *CreditHoldUpdateOne {
+	chuo.mutation.SetJobID(u)
+	return chuo
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (chuo *CreditHoldUpdateOne) SetNillableJobID(u *uuid.UUID) *CreditHoldUpdateOne {
+	if u != nil {
+		chuo.SetJobID(*u)
+	}
+	return chuo
+}
+
+// ClearJobID clears the value of the "job_id" field.
+func (chuo *CreditHoldUpdateOne) ClearJobID() *CreditHoldUpdateOne {
+	chuo.mutation.ClearJobID()
+	return chuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (chuo *CreditHoldUpdateOne) SetParentID(u uuid.UUID) *CreditHoldUpdateOne {
+	chuo.mutation.SetParentID(u)
+	return chuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (chuo *CreditHoldUpdateOne) SetNillableParentID(u *uuid.UUID) *CreditHoldUpdateOne {
+	if u != nil {
+		chuo.SetParentID(*u)
+	}
+	return chuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (chuo *CreditHoldUpdateOne) ClearParentID() *CreditHoldUpdateOne {
+	chuo.mutation.ClearParentID()
+	return chuo
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (chuo *CreditHoldUpdateOne) SetAPITokenID(u uuid.UUID) *CreditHoldUpdateOne {
+	chuo.mutation.SetAPITokenID(u)
+	return chuo
+}
+
+// SetNillableAPITokenID sets the "api_token_id" field if the given value is not nil.
+func (chuo *CreditHoldUpdateOne) SetNillableAPITokenID(u *uuid.UUID) *CreditHoldUpdateOne {
+	if u != nil {
+		chuo.SetAPITokenID(*u)
+	}
+	return chuo
+}
+
+// ClearAPITokenID clears the value of the "api_token_id" field.
+func (chuo *CreditHoldUpdateOne) ClearAPITokenID() *CreditHoldUpdateOne {
+	chuo.mutation.ClearAPITokenID()
+	return chuo
+}
+
+// SetAPITokenPeriod sets the "api_token_period" field.
+func (chuo *CreditHoldUpdateOne) SetAPITokenPeriod(t time.Time) *CreditHoldUpdateOne {
+	chuo.mutation.SetAPITokenPeriod(t)
+	return chuo
+}
+
+// SetNillableAPITokenPeriod sets the "api_token_period" field if the given value is not nil.
+func (chuo *CreditHoldUpdateOne) SetNillableAPITokenPeriod(t *time.Time) *CreditHoldUpdateOne {
+	if t != nil {
+		chuo.SetAPITokenPeriod(*t)
+	}
+	return chuo
+}
+
+// ClearAPITokenPeriod clears the value of the "api_token_period" field.
+func (chuo *CreditHoldUpdateOne) ClearAPITokenPeriod() *CreditHoldUpdateOne {
+	chuo.mutation.ClearAPITokenPeriod()
+	return chuo
+}
+
+// SetReleaseReason sets the "release_reason" field.
+func (chuo *CreditHoldUpdateOne) SetReleaseReason(s string) *CreditHoldUpdateOne {
+	chuo.mutation.SetReleaseReason(s)
+	return chuo
+}
+
+// SetNillableReleaseReason sets the "release_reason" field if the given value is not nil.
+func (chuo *CreditHoldUpdateOne) SetNillableReleaseReason(s *string) *CreditHoldUpdateOne {
+	if s != nil {
+		chuo.SetReleaseReason(*s)
+	}
+	return chuo
+}
+
+// ClearReleaseReason clears the value of the "release_reason" field.
+func (chuo *CreditHoldUpdateOne) ClearReleaseReason() *CreditHoldUpdateOne {
+	chuo.mutation.ClearReleaseReason()
+	return chuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (chuo *CreditHoldUpdateOne) SetExpiresAt(t time.Time) *CreditHoldUpdateOne {
+	chuo.mutation.SetExpiresAt(t)
+	return chuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (chuo *CreditHoldUpdateOne) SetNillableExpiresAt(t *time.Time) *CreditHoldUpdateOne {
+	if t != nil {
+		chuo.SetExpiresAt(*t)
+	}
+	return chuo
+}
+
+// SetCapturedAt sets the "captured_at" field.
+func (chuo *CreditHoldUpdateOne) SetCapturedAt(t time.Time) *CreditHoldUpdateOne {
+	chuo.mutation.SetCapturedAt(t)
+	return chuo
+}
+
+// SetNillableCapturedAt sets the "captured_at" field if the given value is not nil.
+func (chuo *CreditHoldUpdateOne) SetNillableCapturedAt(t *time.Time) *CreditHoldUpdateOne {
+	if t != nil {
+		chuo.SetCapturedAt(*t)
+	}
+	return chuo
+}
+
+// ClearCapturedAt clears the value of the "captured_at" field.
+func (chuo *CreditHoldUpdateOne) ClearCapturedAt() *CreditHoldUpdateOne {
+	chuo.mutation.ClearCapturedAt()
+	return chuo
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (chuo *CreditHoldUpdateOne) SetReleasedAt(t time.Time) *CreditHoldUpdateOne {
+	chuo.mutation.SetReleasedAt(t)
+	return chuo
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (chuo *CreditHoldUpdateOne) SetNillableReleasedAt(t *time.Time) *CreditHoldUpdateOne {
+	if t != nil {
+		chuo.SetReleasedAt(*t)
+	}
+	return chuo
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (chuo *CreditHoldUpdateOne) ClearReleasedAt() *CreditHoldUpdateOne {
+	chuo.mutation.ClearReleasedAt()
+	return chuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (chuo *CreditHoldUpdateOne) SetUpdatedAt(t time.Time) *CreditHoldUpdateOne {
+	chuo.mutation.SetUpdatedAt(t)
+	return chuo
+}
+
+// Mutation returns the CreditHoldMutation object of the builder.
+func (chuo *CreditHoldUpdateOne) Mutation() *CreditHoldMutation {
+	return chuo.mutation
+}
+
+// Where appends a list predicates to the CreditHoldUpdate builder.
+func (chuo *CreditHoldUpdateOne) Where(ps ...predicate.CreditHold) *CreditHoldUpdateOne {
+	chuo.mutation.Where(ps...)
+	return chuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (chuo *CreditHoldUpdateOne) Select(field string, fields ...string) *CreditHoldUpdateOne {
+	chuo.fields = append([]string{field}, fields...)
+	return chuo
+}
+
+// Save executes the query and returns the updated CreditHold entity.
+func (chuo *CreditHoldUpdateOne) Save(ctx context.Context) (*CreditHold, error) {
+	chuo.defaults()
+	return withHooks(ctx, chuo.sqlSave, chuo.mutation, chuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (chuo *CreditHoldUpdateOne) SaveX(ctx context.Context) *CreditHold {
+	node, err := chuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (chuo *CreditHoldUpdateOne) Exec(ctx context.Context) error {
+	_, err := chuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (chuo *CreditHoldUpdateOne) ExecX(ctx context.Context) {
+	if err := chuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (chuo *CreditHoldUpdateOne) defaults() {
+	if _, ok := chuo.mutation.UpdatedAt(); !ok {
+		v := credithold.UpdateDefaultUpdatedAt()
+		chuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (chuo *CreditHoldUpdateOne) check() error {
+	if v, ok := chuo.mutation.Status(); ok {
+		if err := credithold.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CreditHold.status": %w`, err)}
+		}
+	}
+	if v, ok := chuo.mutation.ProcessType(); ok {
+		if err := credithold.ProcessTypeValidator(v); err != nil {
+			return &ValidationError{Name: "process_type", err: fmt.Errorf(`ent: validator failed for field "CreditHold.process_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (chuo *CreditHoldUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CreditHoldUpdateOne {
+	chuo.modifiers = append(chuo.modifiers, modifiers...)
+	return chuo
+}
+
+func (chuo *CreditHoldUpdateOne) sqlSave(ctx context.Context) (_node *CreditHold, err error) {
+	if err := chuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(credithold.Table, credithold.Columns, sqlgraph.NewFieldSpec(credithold.FieldID, field.TypeUUID))
+	id, ok := chuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CreditHold.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := chuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, credithold.FieldID)
+		for _, f := range fields {
+			if !credithold.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != credithold.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := chuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := chuo.mutation.UserID(); ok {
+		_spec.SetField(credithold.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := chuo.mutation.Amount(); ok {
+		_spec.SetField(credithold.FieldAmount, field.TypeInt32, value)
+	}
+	if value, ok := chuo.mutation.AddedAmount(); ok {
+		_spec.AddField(credithold.FieldAmount, field.TypeInt32, value)
+	}
+	if value, ok := chuo.mutation.Status(); ok {
+		_spec.SetField(credithold.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := chuo.mutation.ProcessType(); ok {
+		_spec.SetField(credithold.FieldProcessType, field.TypeEnum, value)
+	}
+	if value, ok := chuo.mutation.JobID(); ok {
+		_spec.SetField(credithold.FieldJobID, field.TypeUUID, value)
+	}
+	if chuo.mutation.JobIDCleared() {
+		_spec.ClearField(credithold.FieldJobID, field.TypeUUID)
+	}
+	if value, ok := chuo.mutation.ParentID(); ok {
+		_spec.SetField(credithold.FieldParentID, field.TypeUUID, value)
+	}
+	if chuo.mutation.ParentIDCleared() {
+		_spec.ClearField(credithold.FieldParentID, field.TypeUUID)
+	}
+	if value, ok := chuo.mutation.APITokenID(); ok {
+		_spec.SetField(credithold.FieldAPITokenID, field.TypeUUID, value)
+	}
+	if chuo.mutation.APITokenIDCleared() {
+		_spec.ClearField(credithold.FieldAPITokenID, field.TypeUUID)
+	}
+	if value, ok := chuo.mutation.APITokenPeriod(); ok {
+		_spec.SetField(credithold.FieldAPITokenPeriod, field.TypeTime, value)
+	}
+	if chuo.mutation.APITokenPeriodCleared() {
+		_spec.ClearField(credithold.FieldAPITokenPeriod, field.TypeTime)
+	}
+	if value, ok := chuo.mutation.ReleaseReason(); ok {
+		_spec.SetField(credithold.FieldReleaseReason, field.TypeString, value)
+	}
+	if chuo.mutation.ReleaseReasonCleared() {
+		_spec.ClearField(credithold.FieldReleaseReason, field.TypeString)
+	}
+	if value, ok := chuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(credithold.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := chuo.mutation.CapturedAt(); ok {
+		_spec.SetField(credithold.FieldCapturedAt, field.TypeTime, value)
+	}
+	if chuo.mutation.CapturedAtCleared() {
+		_spec.ClearField(credithold.FieldCapturedAt, field.TypeTime)
+	}
+	if value, ok := chuo.mutation.ReleasedAt(); ok {
+		_spec.SetField(credithold.FieldReleasedAt, field.TypeTime, value)
+	}
+	if chuo.mutation.ReleasedAtCleared() {
+		_spec.ClearField(credithold.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := chuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(credithold.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(chuo.modifiers...)
+	_node = &CreditHold{config: chuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, chuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credithold.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	chuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/credittransaction"
+)
+
+// CreditTransaction is the model entity for the CreditTransaction schema.
+type CreditTransaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// CreditID holds the value of the "credit_id" field.
+	CreditID uuid.UUID `json:"credit_id,omitempty"`
+	// CreditTypeID holds the value of the "credit_type_id" field.
+	CreditTypeID uuid.UUID `json:"credit_type_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type credittransaction.Type `json:"type,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount int32 `json:"amount,omitempty"`
+	// ReferenceID holds the value of the "reference_id" field.
+	ReferenceID *uuid.UUID `json:"reference_id,omitempty"`
+	// StripeLineItemID holds the value of the "stripe_line_item_id" field.
+	StripeLineItemID *string `json:"stripe_line_item_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CreditTransaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case credittransaction.FieldReferenceID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case credittransaction.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case credittransaction.FieldType, credittransaction.FieldStripeLineItemID:
+			values[i] = new(sql.NullString)
+		case credittransaction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case credittransaction.FieldID, credittransaction.FieldUserID, credittransaction.FieldCreditID, credittransaction.FieldCreditTypeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CreditTransaction fields.
+func (ct *CreditTransaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case credittransaction.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ct.ID = *value
+			}
+		case credittransaction.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ct.UserID = *value
+			}
+		case credittransaction.FieldCreditID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_id", values[i])
+			} else if value != nil {
+				ct.CreditID = *value
+			}
+		case credittransaction.FieldCreditTypeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_type_id", values[i])
+			} else if value != nil {
+				ct.CreditTypeID = *value
+			}
+		case credittransaction.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				ct.Type = credittransaction.Type(value.String)
+			}
+		case credittransaction.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				ct.Amount = int32(value.Int64)
+			}
+		case credittransaction.FieldReferenceID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_id", values[i])
+			} else if value.Valid {
+				ct.ReferenceID = new(uuid.UUID)
+				*ct.ReferenceID = *value.S.(*uuid.UUID)
+			}
+		case credittransaction.FieldStripeLineItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stripe_line_item_id", values[i])
+			} else if value.Valid {
+				ct.StripeLineItemID = new(string)
+				*ct.StripeLineItemID = value.String
+			}
+		case credittransaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ct.CreatedAt = value.Time
+			}
+		default:
+			ct.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CreditTransaction.
+// This includes values selected through modifiers, order, etc.
+func (ct *CreditTransaction) Value(name string) (ent.Value, error) {
+	return ct.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CreditTransaction.
+// Note that you need to call CreditTransaction.Unwrap() before calling this method if this CreditTransaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ct *CreditTransaction) Update() *CreditTransactionUpdateOne {
+	return NewCreditTransactionClient(ct.config).UpdateOne(ct)
+}
+
+// Unwrap unwraps the CreditTransaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ct *CreditTransaction) Unwrap() *CreditTransaction {
+	_tx, ok := ct.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CreditTransaction is not a transactional entity")
+	}
+	ct.config.driver = _tx.drv
+	return ct
+}
+
+// String implements the fmt.Stringer.
+func (ct *CreditTransaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("CreditTransaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ct.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ct.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("credit_id=")
+	builder.WriteString(fmt.Sprintf("%v", ct.CreditID))
+	builder.WriteString(", ")
+	builder.WriteString("credit_type_id=")
+	builder.WriteString(fmt.Sprintf("%v", ct.CreditTypeID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", ct.Type))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", ct.Amount))
+	builder.WriteString(", ")
+	if v := ct.ReferenceID; v != nil {
+		builder.WriteString("reference_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ct.StripeLineItemID; v != nil {
+		builder.WriteString("stripe_line_item_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ct.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CreditTransactions is a parsable slice of CreditTransaction.
+type CreditTransactions []*CreditTransaction
@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package credittransaction
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the credittransaction type in the database.
+	Label = "credit_transaction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldCreditID holds the string denoting the credit_id field in the database.
+	FieldCreditID = "credit_id"
+	// FieldCreditTypeID holds the string denoting the credit_type_id field in the database.
+	FieldCreditTypeID = "credit_type_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldReferenceID holds the string denoting the reference_id field in the database.
+	FieldReferenceID = "reference_id"
+	// FieldStripeLineItemID holds the string denoting the stripe_line_item_id field in the database.
+	FieldStripeLineItemID = "stripe_line_item_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the credittransaction in the database.
+	Table = "credit_transactions"
+)
+
+// Columns holds all SQL columns for credittransaction fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldCreditID,
+	FieldCreditTypeID,
+	FieldType,
+	FieldAmount,
+	FieldReferenceID,
+	FieldStripeLineItemID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeSubscription Type = "subscription"
+	TypePurchase     Type = "purchase"
+	TypeFree         Type = "free"
+	TypeReplenish    Type = "replenish"
+	TypeAdmin        Type = "admin"
+	TypeSpend        Type = "spend"
+	TypeHold         Type = "hold"
+	TypeRelease      Type = "release"
+	TypeRefund       Type = "refund"
+	TypeTipSent      Type = "tip_sent"
+	TypeTipReceived  Type = "tip_received"
+	TypeExpire       Type = "expire"
+	TypeRevoke       Type = "revoke"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeSubscription, TypePurchase, TypeFree, TypeReplenish, TypeAdmin, TypeSpend, TypeHold, TypeRelease, TypeRefund, TypeTipSent, TypeTipReceived, TypeExpire, TypeRevoke:
+		return nil
+	default:
+		return fmt.Errorf("credittransaction: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the CreditTransaction queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByCreditID orders the results by the credit_id field.
+func ByCreditID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditID, opts...).ToFunc()
+}
+
+// ByCreditTypeID orders the results by the credit_type_id field.
+func ByCreditTypeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditTypeID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByReferenceID orders the results by the reference_id field.
+func ByReferenceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferenceID, opts...).ToFunc()
+}
+
+// ByStripeLineItemID orders the results by the stripe_line_item_id field.
+func ByStripeLineItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStripeLineItemID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
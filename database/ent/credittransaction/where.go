@@ -0,0 +1,451 @@
+// Code generated by ent, DO NOT EDIT.
+
+package credittransaction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldUserID, v))
+}
+
+// CreditID applies equality check predicate on the "credit_id" field. It's identical to CreditIDEQ.
+func CreditID(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldCreditID, v))
+}
+
+// CreditTypeID applies equality check predicate on the "credit_type_id" field. It's identical to CreditTypeIDEQ.
+func CreditTypeID(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldCreditTypeID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v int32) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// ReferenceID applies equality check predicate on the "reference_id" field. It's identical to ReferenceIDEQ.
+func ReferenceID(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldReferenceID, v))
+}
+
+// StripeLineItemID applies equality check predicate on the "stripe_line_item_id" field. It's identical to StripeLineItemIDEQ.
+func StripeLineItemID(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldStripeLineItemID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLTE(FieldUserID, v))
+}
+
+// CreditIDEQ applies the EQ predicate on the "credit_id" field.
+func CreditIDEQ(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldCreditID, v))
+}
+
+// CreditIDNEQ applies the NEQ predicate on the "credit_id" field.
+func CreditIDNEQ(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNEQ(FieldCreditID, v))
+}
+
+// CreditIDIn applies the In predicate on the "credit_id" field.
+func CreditIDIn(vs ...uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldIn(FieldCreditID, vs...))
+}
+
+// CreditIDNotIn applies the NotIn predicate on the "credit_id" field.
+func CreditIDNotIn(vs ...uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNotIn(FieldCreditID, vs...))
+}
+
+// CreditIDGT applies the GT predicate on the "credit_id" field.
+func CreditIDGT(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGT(FieldCreditID, v))
+}
+
+// CreditIDGTE applies the GTE predicate on the "credit_id" field.
+func CreditIDGTE(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGTE(FieldCreditID, v))
+}
+
+// CreditIDLT applies the LT predicate on the "credit_id" field.
+func CreditIDLT(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLT(FieldCreditID, v))
+}
+
+// CreditIDLTE applies the LTE predicate on the "credit_id" field.
+func CreditIDLTE(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLTE(FieldCreditID, v))
+}
+
+// CreditTypeIDEQ applies the EQ predicate on the "credit_type_id" field.
+func CreditTypeIDEQ(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldCreditTypeID, v))
+}
+
+// CreditTypeIDNEQ applies the NEQ predicate on the "credit_type_id" field.
+func CreditTypeIDNEQ(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNEQ(FieldCreditTypeID, v))
+}
+
+// CreditTypeIDIn applies the In predicate on the "credit_type_id" field.
+func CreditTypeIDIn(vs ...uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldIn(FieldCreditTypeID, vs...))
+}
+
+// CreditTypeIDNotIn applies the NotIn predicate on the "credit_type_id" field.
+func CreditTypeIDNotIn(vs ...uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNotIn(FieldCreditTypeID, vs...))
+}
+
+// CreditTypeIDGT applies the GT predicate on the "credit_type_id" field.
+func CreditTypeIDGT(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGT(FieldCreditTypeID, v))
+}
+
+// CreditTypeIDGTE applies the GTE predicate on the "credit_type_id" field.
+func CreditTypeIDGTE(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGTE(FieldCreditTypeID, v))
+}
+
+// CreditTypeIDLT applies the LT predicate on the "credit_type_id" field.
+func CreditTypeIDLT(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLT(FieldCreditTypeID, v))
+}
+
+// CreditTypeIDLTE applies the LTE predicate on the "credit_type_id" field.
+func CreditTypeIDLTE(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLTE(FieldCreditTypeID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNotIn(FieldType, vs...))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v int32) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v int32) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...int32) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...int32) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v int32) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v int32) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v int32) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v int32) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLTE(FieldAmount, v))
+}
+
+// ReferenceIDEQ applies the EQ predicate on the "reference_id" field.
+func ReferenceIDEQ(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldReferenceID, v))
+}
+
+// ReferenceIDNEQ applies the NEQ predicate on the "reference_id" field.
+func ReferenceIDNEQ(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNEQ(FieldReferenceID, v))
+}
+
+// ReferenceIDIn applies the In predicate on the "reference_id" field.
+func ReferenceIDIn(vs ...uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldIn(FieldReferenceID, vs...))
+}
+
+// ReferenceIDNotIn applies the NotIn predicate on the "reference_id" field.
+func ReferenceIDNotIn(vs ...uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNotIn(FieldReferenceID, vs...))
+}
+
+// ReferenceIDGT applies the GT predicate on the "reference_id" field.
+func ReferenceIDGT(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGT(FieldReferenceID, v))
+}
+
+// ReferenceIDGTE applies the GTE predicate on the "reference_id" field.
+func ReferenceIDGTE(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGTE(FieldReferenceID, v))
+}
+
+// ReferenceIDLT applies the LT predicate on the "reference_id" field.
+func ReferenceIDLT(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLT(FieldReferenceID, v))
+}
+
+// ReferenceIDLTE applies the LTE predicate on the "reference_id" field.
+func ReferenceIDLTE(v uuid.UUID) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLTE(FieldReferenceID, v))
+}
+
+// ReferenceIDIsNil applies the IsNil predicate on the "reference_id" field.
+func ReferenceIDIsNil() predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldIsNull(FieldReferenceID))
+}
+
+// ReferenceIDNotNil applies the NotNil predicate on the "reference_id" field.
+func ReferenceIDNotNil() predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNotNull(FieldReferenceID))
+}
+
+// StripeLineItemIDEQ applies the EQ predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDEQ(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldStripeLineItemID, v))
+}
+
+// StripeLineItemIDNEQ applies the NEQ predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDNEQ(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNEQ(FieldStripeLineItemID, v))
+}
+
+// StripeLineItemIDIn applies the In predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDIn(vs ...string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldIn(FieldStripeLineItemID, vs...))
+}
+
+// StripeLineItemIDNotIn applies the NotIn predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDNotIn(vs ...string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNotIn(FieldStripeLineItemID, vs...))
+}
+
+// StripeLineItemIDGT applies the GT predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDGT(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGT(FieldStripeLineItemID, v))
+}
+
+// StripeLineItemIDGTE applies the GTE predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDGTE(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGTE(FieldStripeLineItemID, v))
+}
+
+// StripeLineItemIDLT applies the LT predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDLT(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLT(FieldStripeLineItemID, v))
+}
+
+// StripeLineItemIDLTE applies the LTE predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDLTE(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLTE(FieldStripeLineItemID, v))
+}
+
+// StripeLineItemIDContains applies the Contains predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDContains(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldContains(FieldStripeLineItemID, v))
+}
+
+// StripeLineItemIDHasPrefix applies the HasPrefix predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDHasPrefix(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldHasPrefix(FieldStripeLineItemID, v))
+}
+
+// StripeLineItemIDHasSuffix applies the HasSuffix predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDHasSuffix(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldHasSuffix(FieldStripeLineItemID, v))
+}
+
+// StripeLineItemIDIsNil applies the IsNil predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDIsNil() predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldIsNull(FieldStripeLineItemID))
+}
+
+// StripeLineItemIDNotNil applies the NotNil predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDNotNil() predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNotNull(FieldStripeLineItemID))
+}
+
+// StripeLineItemIDEqualFold applies the EqualFold predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDEqualFold(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEqualFold(FieldStripeLineItemID, v))
+}
+
+// StripeLineItemIDContainsFold applies the ContainsFold predicate on the "stripe_line_item_id" field.
+func StripeLineItemIDContainsFold(v string) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldContainsFold(FieldStripeLineItemID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CreditTransaction) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CreditTransaction) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CreditTransaction) predicate.CreditTransaction {
+	return predicate.CreditTransaction(sql.NotPredicates(p))
+}
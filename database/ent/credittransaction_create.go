@@ -0,0 +1,635 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/credittransaction"
+)
+
+// CreditTransactionCreate is the builder for creating a CreditTransaction entity.
+type CreditTransactionCreate struct {
+	config
+	mutation *CreditTransactionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (ctc *CreditTransactionCreate) SetUserID(u uuid.UUID) *CreditTransactionCreate {
+	ctc.mutation.SetUserID(u)
+	return ctc
+}
+
+// SetCreditID sets the "credit_id" field.
+func (ctc *CreditTransactionCreate) SetCreditID(u uuid.UUID) *CreditTransactionCreate {
+	ctc.mutation.SetCreditID(u)
+	return ctc
+}
+
+// SetCreditTypeID sets the "credit_type_id" field.
+func (ctc *CreditTransactionCreate) SetCreditTypeID(u uuid.UUID) *CreditTransactionCreate {
+	ctc.mutation.SetCreditTypeID(u)
+	return ctc
+}
+
+// SetType sets the "type" field.
+func (ctc *CreditTransactionCreate) SetType(c credittransaction.Type) *CreditTransactionCreate {
+	ctc.mutation.SetType(c)
+	return ctc
+}
+
+// SetAmount sets the "amount" field.
+func (ctc *CreditTransactionCreate) SetAmount(i int32) *CreditTransactionCreate {
+	ctc.mutation.SetAmount(i)
+	return ctc
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (ctc *CreditTransactionCreate) SetReferenceID(u uuid.UUID) *CreditTransactionCreate {
+	ctc.mutation.SetReferenceID(u)
+	return ctc
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (ctc *CreditTransactionCreate) SetNillableReferenceID(u *uuid.UUID) *CreditTransactionCreate {
+	if u != nil {
+		ctc.SetReferenceID(*u)
+	}
+	return ctc
+}
+
+// SetStripeLineItemID sets the "stripe_line_item_id" field.
+func (ctc *CreditTransactionCreate) SetStripeLineItemID(s string) *CreditTransactionCreate {
+	ctc.mutation.SetStripeLineItemID(s)
+	return ctc
+}
+
+// SetNillableStripeLineItemID sets the "stripe_line_item_id" field if the given value is not nil.
+func (ctc *CreditTransactionCreate) SetNillableStripeLineItemID(s *string) *CreditTransactionCreate {
+	if s != nil {
+		ctc.SetStripeLineItemID(*s)
+	}
+	return ctc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ctc *CreditTransactionCreate) SetCreatedAt(t time.Time) *CreditTransactionCreate {
+	ctc.mutation.SetCreatedAt(t)
+	return ctc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ctc *CreditTransactionCreate) SetNillableCreatedAt(t *time.Time) *CreditTransactionCreate {
+	if t != nil {
+		ctc.SetCreatedAt(*t)
+	}
+	return ctc
+}
+
+// SetID sets the "id" field.
+func (ctc *CreditTransactionCreate) SetID(u uuid.UUID) *CreditTransactionCreate {
+	ctc.mutation.SetID(u)
+	return ctc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ctc *CreditTransactionCreate) SetNillableID(u *uuid.UUID) *CreditTransactionCreate {
+	if u != nil {
+		ctc.SetID(*u)
+	}
+	return ctc
+}
+
+// Mutation returns the CreditTransactionMutation object of the builder.
+func (ctc *CreditTransactionCreate) Mutation() *CreditTransactionMutation {
+	return ctc.mutation
+}
+
+// Save creates the CreditTransaction in the database.
+func (ctc *CreditTransactionCreate) Save(ctx context.Context) (*CreditTransaction, error) {
+	ctc.defaults()
+	return withHooks(ctx, ctc.sqlSave, ctc.mutation, ctc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ctc *CreditTransactionCreate) SaveX(ctx context.Context) *CreditTransaction {
+	v, err := ctc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ctc *CreditTransactionCreate) Exec(ctx context.Context) error {
+	_, err := ctc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctc *CreditTransactionCreate) ExecX(ctx context.Context) {
+	if err := ctc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ctc *CreditTransactionCreate) defaults() {
+	if _, ok := ctc.mutation.CreatedAt(); !ok {
+		v := credittransaction.DefaultCreatedAt()
+		ctc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ctc.mutation.ID(); !ok {
+		v := credittransaction.DefaultID()
+		ctc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctc *CreditTransactionCreate) check() error {
+	if _, ok := ctc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "CreditTransaction.user_id"`)}
+	}
+	if _, ok := ctc.mutation.CreditID(); !ok {
+		return &ValidationError{Name: "credit_id", err: errors.New(`ent: missing required field "CreditTransaction.credit_id"`)}
+	}
+	if _, ok := ctc.mutation.CreditTypeID(); !ok {
+		return &ValidationError{Name: "credit_type_id", err: errors.New(`ent: missing required field "CreditTransaction.credit_type_id"`)}
+	}
+	if _, ok := ctc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "CreditTransaction.type"`)}
+	}
+	if v, ok := ctc.mutation.GetType(); ok {
+		if err := credittransaction.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "CreditTransaction.type": %w`, err)}
+		}
+	}
+	if _, ok := ctc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "CreditTransaction.amount"`)}
+	}
+	if _, ok := ctc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CreditTransaction.created_at"`)}
+	}
+	return nil
+}
+
+func (ctc *CreditTransactionCreate) sqlSave(ctx context.Context) (*CreditTransaction, error) {
+	if err := ctc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ctc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ctc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ctc.mutation.id = &_node.ID
+	ctc.mutation.done = true
+	return _node, nil
+}
+
+func (ctc *CreditTransactionCreate) createSpec() (*CreditTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CreditTransaction{config: ctc.config}
+		_spec = sqlgraph.NewCreateSpec(credittransaction.Table, sqlgraph.NewFieldSpec(credittransaction.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = ctc.conflict
+	if id, ok := ctc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ctc.mutation.UserID(); ok {
+		_spec.SetField(credittransaction.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := ctc.mutation.CreditID(); ok {
+		_spec.SetField(credittransaction.FieldCreditID, field.TypeUUID, value)
+		_node.CreditID = value
+	}
+	if value, ok := ctc.mutation.CreditTypeID(); ok {
+		_spec.SetField(credittransaction.FieldCreditTypeID, field.TypeUUID, value)
+		_node.CreditTypeID = value
+	}
+	if value, ok := ctc.mutation.GetType(); ok {
+		_spec.SetField(credittransaction.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := ctc.mutation.Amount(); ok {
+		_spec.SetField(credittransaction.FieldAmount, field.TypeInt32, value)
+		_node.Amount = value
+	}
+	if value, ok := ctc.mutation.ReferenceID(); ok {
+		_spec.SetField(credittransaction.FieldReferenceID, field.TypeUUID, value)
+		_node.ReferenceID = &value
+	}
+	if value, ok := ctc.mutation.StripeLineItemID(); ok {
+		_spec.SetField(credittransaction.FieldStripeLineItemID, field.TypeString, value)
+		_node.StripeLineItemID = &value
+	}
+	if value, ok := ctc.mutation.CreatedAt(); ok {
+		_spec.SetField(credittransaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CreditTransaction.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CreditTransactionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ctc *CreditTransactionCreate) OnConflict(opts ...sql.ConflictOption) *CreditTransactionUpsertOne {
+	ctc.conflict = opts
+	return &CreditTransactionUpsertOne{
+		create: ctc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CreditTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ctc *CreditTransactionCreate) OnConflictColumns(columns ...string) *CreditTransactionUpsertOne {
+	ctc.conflict = append(ctc.conflict, sql.ConflictColumns(columns...))
+	return &CreditTransactionUpsertOne{
+		create: ctc,
+	}
+}
+
+type (
+	// CreditTransactionUpsertOne is the builder for "upsert"-ing
+	//  one CreditTransaction node.
+	CreditTransactionUpsertOne struct {
+		create *CreditTransactionCreate
+	}
+
+	// CreditTransactionUpsert is the "OnConflict" setter.
+	CreditTransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CreditTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(credittransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CreditTransactionUpsertOne) UpdateNewValues() *CreditTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(credittransaction.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(credittransaction.FieldUserID)
+		}
+		if _, exists := u.create.mutation.CreditID(); exists {
+			s.SetIgnore(credittransaction.FieldCreditID)
+		}
+		if _, exists := u.create.mutation.CreditTypeID(); exists {
+			s.SetIgnore(credittransaction.FieldCreditTypeID)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(credittransaction.FieldType)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(credittransaction.FieldAmount)
+		}
+		if _, exists := u.create.mutation.ReferenceID(); exists {
+			s.SetIgnore(credittransaction.FieldReferenceID)
+		}
+		if _, exists := u.create.mutation.StripeLineItemID(); exists {
+			s.SetIgnore(credittransaction.FieldStripeLineItemID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(credittransaction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CreditTransaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CreditTransactionUpsertOne) Ignore() *CreditTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CreditTransactionUpsertOne) DoNothing() *CreditTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CreditTransactionCreate.OnConflict
+// documentation for more info.
+func (u *CreditTransactionUpsertOne) Update(set func(*CreditTransactionUpsert)) *CreditTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CreditTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *CreditTransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CreditTransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CreditTransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CreditTransactionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CreditTransactionUpsertOne.ID is not supported by MySQL driver. Use CreditTransactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CreditTransactionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CreditTransactionCreateBulk is the builder for creating many CreditTransaction entities in bulk.
+type CreditTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*CreditTransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CreditTransaction entities in the database.
+func (ctcb *CreditTransactionCreateBulk) Save(ctx context.Context) ([]*CreditTransaction, error) {
+	if ctcb.err != nil {
+		return nil, ctcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ctcb.builders))
+	nodes := make([]*CreditTransaction, len(ctcb.builders))
+	mutators := make([]Mutator, len(ctcb.builders))
+	for i := range ctcb.builders {
+		func(i int, root context.Context) {
+			builder := ctcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CreditTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ctcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ctcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ctcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ctcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctcb *CreditTransactionCreateBulk) SaveX(ctx context.Context) []*CreditTransaction {
+	v, err := ctcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ctcb *CreditTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := ctcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctcb *CreditTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := ctcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CreditTransaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CreditTransactionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ctcb *CreditTransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *CreditTransactionUpsertBulk {
+	ctcb.conflict = opts
+	return &CreditTransactionUpsertBulk{
+		create: ctcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CreditTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ctcb *CreditTransactionCreateBulk) OnConflictColumns(columns ...string) *CreditTransactionUpsertBulk {
+	ctcb.conflict = append(ctcb.conflict, sql.ConflictColumns(columns...))
+	return &CreditTransactionUpsertBulk{
+		create: ctcb,
+	}
+}
+
+// CreditTransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of CreditTransaction nodes.
+type CreditTransactionUpsertBulk struct {
+	create *CreditTransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CreditTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(credittransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CreditTransactionUpsertBulk) UpdateNewValues() *CreditTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(credittransaction.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(credittransaction.FieldUserID)
+			}
+			if _, exists := b.mutation.CreditID(); exists {
+				s.SetIgnore(credittransaction.FieldCreditID)
+			}
+			if _, exists := b.mutation.CreditTypeID(); exists {
+				s.SetIgnore(credittransaction.FieldCreditTypeID)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(credittransaction.FieldType)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(credittransaction.FieldAmount)
+			}
+			if _, exists := b.mutation.ReferenceID(); exists {
+				s.SetIgnore(credittransaction.FieldReferenceID)
+			}
+			if _, exists := b.mutation.StripeLineItemID(); exists {
+				s.SetIgnore(credittransaction.FieldStripeLineItemID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(credittransaction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CreditTransaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CreditTransactionUpsertBulk) Ignore() *CreditTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CreditTransactionUpsertBulk) DoNothing() *CreditTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CreditTransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *CreditTransactionUpsertBulk) Update(set func(*CreditTransactionUpsert)) *CreditTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CreditTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *CreditTransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CreditTransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CreditTransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CreditTransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
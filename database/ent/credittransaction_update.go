@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stablecog/sc-go/database/ent/credittransaction"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// CreditTransactionUpdate is the builder for updating CreditTransaction entities.
+type CreditTransactionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CreditTransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CreditTransactionUpdate builder.
+func (ctu *CreditTransactionUpdate) Where(ps ...predicate.CreditTransaction) *CreditTransactionUpdate {
+	ctu.mutation.Where(ps...)
+	return ctu
+}
+
+// Mutation returns the CreditTransactionMutation object of the builder.
+func (ctu *CreditTransactionUpdate) Mutation() *CreditTransactionMutation {
+	return ctu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ctu *CreditTransactionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ctu.sqlSave, ctu.mutation, ctu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctu *CreditTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ctu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ctu *CreditTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := ctu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctu *CreditTransactionUpdate) ExecX(ctx context.Context) {
+	if err := ctu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ctu *CreditTransactionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CreditTransactionUpdate {
+	ctu.modifiers = append(ctu.modifiers, modifiers...)
+	return ctu
+}
+
+func (ctu *CreditTransactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(credittransaction.Table, credittransaction.Columns, sqlgraph.NewFieldSpec(credittransaction.FieldID, field.TypeUUID))
+	if ps := ctu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ctu.mutation.ReferenceIDCleared() {
+		_spec.ClearField(credittransaction.FieldReferenceID, field.TypeUUID)
+	}
+	if ctu.mutation.StripeLineItemIDCleared() {
+		_spec.ClearField(credittransaction.FieldStripeLineItemID, field.TypeString)
+	}
+	_spec.AddModifiers(ctu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ctu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credittransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ctu.mutation.done = true
+	return n, nil
+}
+
+// CreditTransactionUpdateOne is the builder for updating a single CreditTransaction entity.
+type CreditTransactionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CreditTransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the CreditTransactionMutation object of the builder.
+func (ctuo *CreditTransactionUpdateOne) Mutation() *CreditTransactionMutation {
+	return ctuo.mutation
+}
+
+// Where appends a list predicates to the CreditTransactionUpdate builder.
+func (ctuo *CreditTransactionUpdateOne) Where(ps ...predicate.CreditTransaction) *CreditTransactionUpdateOne {
+	ctuo.mutation.Where(ps...)
+	return ctuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ctuo *CreditTransactionUpdateOne) Select(field string, fields ...string) *CreditTransactionUpdateOne {
+	ctuo.fields = append([]string{field}, fields...)
+	return ctuo
+}
+
+// Save executes the query and returns the updated CreditTransaction entity.
+func (ctuo *CreditTransactionUpdateOne) Save(ctx context.Context) (*CreditTransaction, error) {
+	return withHooks(ctx, ctuo.sqlSave, ctuo.mutation, ctuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctuo *CreditTransactionUpdateOne) SaveX(ctx context.Context) *CreditTransaction {
+	node, err := ctuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ctuo *CreditTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ctuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctuo *CreditTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := ctuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ctuo *CreditTransactionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CreditTransactionUpdateOne {
+	ctuo.modifiers = append(ctuo.modifiers, modifiers...)
+	return ctuo
+}
+
+func (ctuo *CreditTransactionUpdateOne) sqlSave(ctx context.Context) (_node *CreditTransaction, err error) {
+	_spec := sqlgraph.NewUpdateSpec(credittransaction.Table, credittransaction.Columns, sqlgraph.NewFieldSpec(credittransaction.FieldID, field.TypeUUID))
+	id, ok := ctuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CreditTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ctuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, credittransaction.FieldID)
+		for _, f := range fields {
+			if !credittransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != credittransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ctuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ctuo.mutation.ReferenceIDCleared() {
+		_spec.ClearField(credittransaction.FieldReferenceID, field.TypeUUID)
+	}
+	if ctuo.mutation.StripeLineItemIDCleared() {
+		_spec.ClearField(credittransaction.FieldStripeLineItemID, field.TypeString)
+	}
+	_spec.AddModifiers(ctuo.modifiers...)
+	_node = &CreditTransaction{config: ctuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ctuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credittransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ctuo.mutation.done = true
+	return _node, nil
+}
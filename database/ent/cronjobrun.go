@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
+)
+
+// CronJobRun is the model entity for the CronJobRun schema.
+type CronJobRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// JobName holds the value of the "job_name" field.
+	JobName string `json:"job_name,omitempty"`
+	// Trigger holds the value of the "trigger" field.
+	Trigger cronjobrun.Trigger `json:"trigger,omitempty"`
+	// Status holds the value of the "status" field.
+	Status cronjobrun.Status `json:"status,omitempty"`
+	// Instance holds the value of the "instance" field.
+	Instance string `json:"instance,omitempty"`
+	// TriggeredBy holds the value of the "triggered_by" field.
+	TriggeredBy *uuid.UUID `json:"triggered_by,omitempty"`
+	// Counts holds the value of the "counts" field.
+	Counts map[string]int `json:"counts,omitempty"`
+	// Error holds the value of the "error" field.
+	Error *string `json:"error,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CronJobRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cronjobrun.FieldTriggeredBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case cronjobrun.FieldCounts:
+			values[i] = new([]byte)
+		case cronjobrun.FieldJobName, cronjobrun.FieldTrigger, cronjobrun.FieldStatus, cronjobrun.FieldInstance, cronjobrun.FieldError:
+			values[i] = new(sql.NullString)
+		case cronjobrun.FieldStartedAt, cronjobrun.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		case cronjobrun.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CronJobRun fields.
+func (cjr *CronJobRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cronjobrun.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cjr.ID = *value
+			}
+		case cronjobrun.FieldJobName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_name", values[i])
+			} else if value.Valid {
+				cjr.JobName = value.String
+			}
+		case cronjobrun.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				cjr.Trigger = cronjobrun.Trigger(value.String)
+			}
+		case cronjobrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				cjr.Status = cronjobrun.Status(value.String)
+			}
+		case cronjobrun.FieldInstance:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instance", values[i])
+			} else if value.Valid {
+				cjr.Instance = value.String
+			}
+		case cronjobrun.FieldTriggeredBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field triggered_by", values[i])
+			} else if value.Valid {
+				cjr.TriggeredBy = new(uuid.UUID)
+				*cjr.TriggeredBy = *value.S.(*uuid.UUID)
+			}
+		case cronjobrun.FieldCounts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field counts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cjr.Counts); err != nil {
+					return fmt.Errorf("unmarshal field counts: %w", err)
+				}
+			}
+		case cronjobrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				cjr.Error = new(string)
+				*cjr.Error = value.String
+			}
+		case cronjobrun.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				cjr.StartedAt = value.Time
+			}
+		case cronjobrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				cjr.FinishedAt = new(time.Time)
+				*cjr.FinishedAt = value.Time
+			}
+		default:
+			cjr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CronJobRun.
+// This includes values selected through modifiers, order, etc.
+func (cjr *CronJobRun) Value(name string) (ent.Value, error) {
+	return cjr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CronJobRun.
+// Note that you need to call CronJobRun.Unwrap() before calling this method if this CronJobRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cjr *CronJobRun) Update() *CronJobRunUpdateOne {
+	return NewCronJobRunClient(cjr.config).UpdateOne(cjr)
+}
+
+// Unwrap unwraps the CronJobRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cjr *CronJobRun) Unwrap() *CronJobRun {
+	_tx, ok := cjr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CronJobRun is not a transactional entity")
+	}
+	cjr.config.driver = _tx.drv
+	return cjr
+}
+
+// String implements the fmt.Stringer.
+func (cjr *CronJobRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("CronJobRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cjr.ID))
+	builder.WriteString("job_name=")
+	builder.WriteString(cjr.JobName)
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(fmt.Sprintf("%v", cjr.Trigger))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", cjr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("instance=")
+	builder.WriteString(cjr.Instance)
+	builder.WriteString(", ")
+	if v := cjr.TriggeredBy; v != nil {
+		builder.WriteString("triggered_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("counts=")
+	builder.WriteString(fmt.Sprintf("%v", cjr.Counts))
+	builder.WriteString(", ")
+	if v := cjr.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(cjr.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := cjr.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CronJobRuns is a parsable slice of CronJobRun.
+type CronJobRuns []*CronJobRun
@@ -0,0 +1,496 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cronjobrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLTE(FieldID, id))
+}
+
+// JobName applies equality check predicate on the "job_name" field. It's identical to JobNameEQ.
+func JobName(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldJobName, v))
+}
+
+// Instance applies equality check predicate on the "instance" field. It's identical to InstanceEQ.
+func Instance(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldInstance, v))
+}
+
+// TriggeredBy applies equality check predicate on the "triggered_by" field. It's identical to TriggeredByEQ.
+func TriggeredBy(v uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldTriggeredBy, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldError, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// JobNameEQ applies the EQ predicate on the "job_name" field.
+func JobNameEQ(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldJobName, v))
+}
+
+// JobNameNEQ applies the NEQ predicate on the "job_name" field.
+func JobNameNEQ(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNEQ(FieldJobName, v))
+}
+
+// JobNameIn applies the In predicate on the "job_name" field.
+func JobNameIn(vs ...string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldIn(FieldJobName, vs...))
+}
+
+// JobNameNotIn applies the NotIn predicate on the "job_name" field.
+func JobNameNotIn(vs ...string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNotIn(FieldJobName, vs...))
+}
+
+// JobNameGT applies the GT predicate on the "job_name" field.
+func JobNameGT(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGT(FieldJobName, v))
+}
+
+// JobNameGTE applies the GTE predicate on the "job_name" field.
+func JobNameGTE(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGTE(FieldJobName, v))
+}
+
+// JobNameLT applies the LT predicate on the "job_name" field.
+func JobNameLT(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLT(FieldJobName, v))
+}
+
+// JobNameLTE applies the LTE predicate on the "job_name" field.
+func JobNameLTE(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLTE(FieldJobName, v))
+}
+
+// JobNameContains applies the Contains predicate on the "job_name" field.
+func JobNameContains(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldContains(FieldJobName, v))
+}
+
+// JobNameHasPrefix applies the HasPrefix predicate on the "job_name" field.
+func JobNameHasPrefix(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldHasPrefix(FieldJobName, v))
+}
+
+// JobNameHasSuffix applies the HasSuffix predicate on the "job_name" field.
+func JobNameHasSuffix(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldHasSuffix(FieldJobName, v))
+}
+
+// JobNameEqualFold applies the EqualFold predicate on the "job_name" field.
+func JobNameEqualFold(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEqualFold(FieldJobName, v))
+}
+
+// JobNameContainsFold applies the ContainsFold predicate on the "job_name" field.
+func JobNameContainsFold(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldContainsFold(FieldJobName, v))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v Trigger) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v Trigger) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...Trigger) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...Trigger) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// InstanceEQ applies the EQ predicate on the "instance" field.
+func InstanceEQ(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldInstance, v))
+}
+
+// InstanceNEQ applies the NEQ predicate on the "instance" field.
+func InstanceNEQ(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNEQ(FieldInstance, v))
+}
+
+// InstanceIn applies the In predicate on the "instance" field.
+func InstanceIn(vs ...string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldIn(FieldInstance, vs...))
+}
+
+// InstanceNotIn applies the NotIn predicate on the "instance" field.
+func InstanceNotIn(vs ...string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNotIn(FieldInstance, vs...))
+}
+
+// InstanceGT applies the GT predicate on the "instance" field.
+func InstanceGT(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGT(FieldInstance, v))
+}
+
+// InstanceGTE applies the GTE predicate on the "instance" field.
+func InstanceGTE(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGTE(FieldInstance, v))
+}
+
+// InstanceLT applies the LT predicate on the "instance" field.
+func InstanceLT(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLT(FieldInstance, v))
+}
+
+// InstanceLTE applies the LTE predicate on the "instance" field.
+func InstanceLTE(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLTE(FieldInstance, v))
+}
+
+// InstanceContains applies the Contains predicate on the "instance" field.
+func InstanceContains(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldContains(FieldInstance, v))
+}
+
+// InstanceHasPrefix applies the HasPrefix predicate on the "instance" field.
+func InstanceHasPrefix(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldHasPrefix(FieldInstance, v))
+}
+
+// InstanceHasSuffix applies the HasSuffix predicate on the "instance" field.
+func InstanceHasSuffix(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldHasSuffix(FieldInstance, v))
+}
+
+// InstanceEqualFold applies the EqualFold predicate on the "instance" field.
+func InstanceEqualFold(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEqualFold(FieldInstance, v))
+}
+
+// InstanceContainsFold applies the ContainsFold predicate on the "instance" field.
+func InstanceContainsFold(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldContainsFold(FieldInstance, v))
+}
+
+// TriggeredByEQ applies the EQ predicate on the "triggered_by" field.
+func TriggeredByEQ(v uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldTriggeredBy, v))
+}
+
+// TriggeredByNEQ applies the NEQ predicate on the "triggered_by" field.
+func TriggeredByNEQ(v uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNEQ(FieldTriggeredBy, v))
+}
+
+// TriggeredByIn applies the In predicate on the "triggered_by" field.
+func TriggeredByIn(vs ...uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldIn(FieldTriggeredBy, vs...))
+}
+
+// TriggeredByNotIn applies the NotIn predicate on the "triggered_by" field.
+func TriggeredByNotIn(vs ...uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNotIn(FieldTriggeredBy, vs...))
+}
+
+// TriggeredByGT applies the GT predicate on the "triggered_by" field.
+func TriggeredByGT(v uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGT(FieldTriggeredBy, v))
+}
+
+// TriggeredByGTE applies the GTE predicate on the "triggered_by" field.
+func TriggeredByGTE(v uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGTE(FieldTriggeredBy, v))
+}
+
+// TriggeredByLT applies the LT predicate on the "triggered_by" field.
+func TriggeredByLT(v uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLT(FieldTriggeredBy, v))
+}
+
+// TriggeredByLTE applies the LTE predicate on the "triggered_by" field.
+func TriggeredByLTE(v uuid.UUID) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLTE(FieldTriggeredBy, v))
+}
+
+// TriggeredByIsNil applies the IsNil predicate on the "triggered_by" field.
+func TriggeredByIsNil() predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldIsNull(FieldTriggeredBy))
+}
+
+// TriggeredByNotNil applies the NotNil predicate on the "triggered_by" field.
+func TriggeredByNotNil() predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNotNull(FieldTriggeredBy))
+}
+
+// CountsIsNil applies the IsNil predicate on the "counts" field.
+func CountsIsNil() predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldIsNull(FieldCounts))
+}
+
+// CountsNotNil applies the NotNil predicate on the "counts" field.
+func CountsNotNil() predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNotNull(FieldCounts))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldContainsFold(FieldError, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.CronJobRun {
+	return predicate.CronJobRun(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CronJobRun) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CronJobRun) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CronJobRun) predicate.CronJobRun {
+	return predicate.CronJobRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,1013 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
+)
+
+// CronJobRunCreate is the builder for creating a CronJobRun entity.
+type CronJobRunCreate struct {
+	config
+	mutation *CronJobRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetJobName sets the "job_name" field.
+func (cjrc *CronJobRunCreate) SetJobName(s string) *CronJobRunCreate {
+	cjrc.mutation.SetJobName(s)
+	return cjrc
+}
+
+// SetTrigger sets the "trigger" field.
+func (cjrc *CronJobRunCreate) SetTrigger(c cronjobrun.Trigger) *CronJobRunCreate {
+	cjrc.mutation.SetTrigger(c)
+	return cjrc
+}
+
+// SetStatus sets the "status" field.
+func (cjrc *CronJobRunCreate) SetStatus(c cronjobrun.Status) *CronJobRunCreate {
+	cjrc.mutation.SetStatus(c)
+	return cjrc
+}
+
+// SetInstance sets the "instance" field.
+func (cjrc *CronJobRunCreate) SetInstance(s string) *CronJobRunCreate {
+	cjrc.mutation.SetInstance(s)
+	return cjrc
+}
+
+// SetTriggeredBy sets the "triggered_by" field.
+func (cjrc *CronJobRunCreate) SetTriggeredBy(u uuid.UUID) *CronJobRunCreate {
+	cjrc.mutation.SetTriggeredBy(u)
+	return cjrc
+}
+
+// SetNillableTriggeredBy sets the "triggered_by" field if the given value is not nil.
+func (cjrc *CronJobRunCreate) SetNillableTriggeredBy(u *uuid.UUID) *CronJobRunCreate {
+	if u != nil {
+		cjrc.SetTriggeredBy(*u)
+	}
+	return cjrc
+}
+
+// SetCounts sets the "counts" field.
+func (cjrc *CronJobRunCreate) SetCounts(m map[string]int) *CronJobRunCreate {
+	cjrc.mutation.SetCounts(m)
+	return cjrc
+}
+
+// SetError sets the "error" field.
+func (cjrc *CronJobRunCreate) SetError(s string) *CronJobRunCreate {
+	cjrc.mutation.SetError(s)
+	return cjrc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (cjrc *CronJobRunCreate) SetNillableError(s *string) *CronJobRunCreate {
+	if s != nil {
+		cjrc.SetError(*s)
+	}
+	return cjrc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (cjrc *CronJobRunCreate) SetStartedAt(t time.Time) *CronJobRunCreate {
+	cjrc.mutation.SetStartedAt(t)
+	return cjrc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (cjrc *CronJobRunCreate) SetNillableStartedAt(t *time.Time) *CronJobRunCreate {
+	if t != nil {
+		cjrc.SetStartedAt(*t)
+	}
+	return cjrc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (cjrc *CronJobRunCreate) SetFinishedAt(t time.Time) *CronJobRunCreate {
+	cjrc.mutation.SetFinishedAt(t)
+	return cjrc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (cjrc *CronJobRunCreate) SetNillableFinishedAt(t *time.Time) *CronJobRunCreate {
+	if t != nil {
+		cjrc.SetFinishedAt(*t)
+	}
+	return cjrc
+}
+
+// SetID sets the "id" field.
+func (cjrc *CronJobRunCreate) SetID(u uuid.UUID) *CronJobRunCreate {
+	cjrc.mutation.SetID(u)
+	return cjrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cjrc *CronJobRunCreate) SetNillableID(u *uuid.UUID) *CronJobRunCreate {
+	if u != nil {
+		cjrc.SetID(*u)
+	}
+	return cjrc
+}
+
+// Mutation returns the CronJobRunMutation object of the builder.
+func (cjrc *CronJobRunCreate) Mutation() *CronJobRunMutation {
+	return cjrc.mutation
+}
+
+// Save creates the CronJobRun in the database.
+func (cjrc *CronJobRunCreate) Save(ctx context.Context) (*CronJobRun, error) {
+	cjrc.defaults()
+	return withHooks(ctx, cjrc.sqlSave, cjrc.mutation, cjrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cjrc *CronJobRunCreate) SaveX(ctx context.Context) *CronJobRun {
+	v, err := cjrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cjrc *CronJobRunCreate) Exec(ctx context.Context) error {
+	_, err := cjrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cjrc *CronJobRunCreate) ExecX(ctx context.Context) {
+	if err := cjrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cjrc *CronJobRunCreate) defaults() {
+	if _, ok := cjrc.mutation.StartedAt(); !ok {
+		v := cronjobrun.DefaultStartedAt()
+		cjrc.mutation.SetStartedAt(v)
+	}
+	if _, ok := cjrc.mutation.ID(); !ok {
+		v := cronjobrun.DefaultID()
+		cjrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cjrc *CronJobRunCreate) check() error {
+	if _, ok := cjrc.mutation.JobName(); !ok {
+		return &ValidationError{Name: "job_name", err: errors.New(`ent: missing required field "CronJobRun.job_name"`)}
+	}
+	if _, ok := cjrc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "CronJobRun.trigger"`)}
+	}
+	if v, ok := cjrc.mutation.Trigger(); ok {
+		if err := cronjobrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "CronJobRun.trigger": %w`, err)}
+		}
+	}
+	if _, ok := cjrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CronJobRun.status"`)}
+	}
+	if v, ok := cjrc.mutation.Status(); ok {
+		if err := cronjobrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CronJobRun.status": %w`, err)}
+		}
+	}
+	if _, ok := cjrc.mutation.Instance(); !ok {
+		return &ValidationError{Name: "instance", err: errors.New(`ent: missing required field "CronJobRun.instance"`)}
+	}
+	if _, ok := cjrc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "CronJobRun.started_at"`)}
+	}
+	return nil
+}
+
+func (cjrc *CronJobRunCreate) sqlSave(ctx context.Context) (*CronJobRun, error) {
+	if err := cjrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cjrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cjrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cjrc.mutation.id = &_node.ID
+	cjrc.mutation.done = true
+	return _node, nil
+}
+
+func (cjrc *CronJobRunCreate) createSpec() (*CronJobRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CronJobRun{config: cjrc.config}
+		_spec = sqlgraph.NewCreateSpec(cronjobrun.Table, sqlgraph.NewFieldSpec(cronjobrun.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = cjrc.conflict
+	if id, ok := cjrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cjrc.mutation.JobName(); ok {
+		_spec.SetField(cronjobrun.FieldJobName, field.TypeString, value)
+		_node.JobName = value
+	}
+	if value, ok := cjrc.mutation.Trigger(); ok {
+		_spec.SetField(cronjobrun.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = value
+	}
+	if value, ok := cjrc.mutation.Status(); ok {
+		_spec.SetField(cronjobrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := cjrc.mutation.Instance(); ok {
+		_spec.SetField(cronjobrun.FieldInstance, field.TypeString, value)
+		_node.Instance = value
+	}
+	if value, ok := cjrc.mutation.TriggeredBy(); ok {
+		_spec.SetField(cronjobrun.FieldTriggeredBy, field.TypeUUID, value)
+		_node.TriggeredBy = &value
+	}
+	if value, ok := cjrc.mutation.Counts(); ok {
+		_spec.SetField(cronjobrun.FieldCounts, field.TypeJSON, value)
+		_node.Counts = value
+	}
+	if value, ok := cjrc.mutation.Error(); ok {
+		_spec.SetField(cronjobrun.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := cjrc.mutation.StartedAt(); ok {
+		_spec.SetField(cronjobrun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := cjrc.mutation.FinishedAt(); ok {
+		_spec.SetField(cronjobrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CronJobRun.Create().
+//		SetJobName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CronJobRunUpsert) {
+//			SetJobName(v+v).
+//		}).
+//		Exec(ctx)
+func (cjrc *CronJobRunCreate) OnConflict(opts ...sql.ConflictOption) *CronJobRunUpsertOne {
+	cjrc.conflict = opts
+	return &CronJobRunUpsertOne{
+		create: cjrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CronJobRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cjrc *CronJobRunCreate) OnConflictColumns(columns ...string) *CronJobRunUpsertOne {
+	cjrc.conflict = append(cjrc.conflict, sql.ConflictColumns(columns...))
+	return &CronJobRunUpsertOne{
+		create: cjrc,
+	}
+}
+
+type (
+	// CronJobRunUpsertOne is the builder for "upsert"-ing
+	//  one CronJobRun node.
+	CronJobRunUpsertOne struct {
+		create *CronJobRunCreate
+	}
+
+	// CronJobRunUpsert is the "OnConflict" setter.
+	CronJobRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetJobName sets the "job_name" field.
+func (u *CronJobRunUpsert) SetJobName(v string) *CronJobRunUpsert {
+	u.Set(cronjobrun.FieldJobName, v)
+	return u
+}
+
+// UpdateJobName sets the "job_name" field to the value that was provided on create.
+func (u *CronJobRunUpsert) UpdateJobName() *CronJobRunUpsert {
+	u.SetExcluded(cronjobrun.FieldJobName)
+	return u
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *CronJobRunUpsert) SetTrigger(v cronjobrun.Trigger) *CronJobRunUpsert {
+	u.Set(cronjobrun.FieldTrigger, v)
+	return u
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *CronJobRunUpsert) UpdateTrigger() *CronJobRunUpsert {
+	u.SetExcluded(cronjobrun.FieldTrigger)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CronJobRunUpsert) SetStatus(v cronjobrun.Status) *CronJobRunUpsert {
+	u.Set(cronjobrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CronJobRunUpsert) UpdateStatus() *CronJobRunUpsert {
+	u.SetExcluded(cronjobrun.FieldStatus)
+	return u
+}
+
+// SetInstance sets the "instance" field.
+func (u *CronJobRunUpsert) SetInstance(v string) *CronJobRunUpsert {
+	u.Set(cronjobrun.FieldInstance, v)
+	return u
+}
+
+// UpdateInstance sets the "instance" field to the value that was provided on create.
+func (u *CronJobRunUpsert) UpdateInstance() *CronJobRunUpsert {
+	u.SetExcluded(cronjobrun.FieldInstance)
+	return u
+}
+
+// SetTriggeredBy sets the "triggered_by" field.
+func (u *CronJobRunUpsert) SetTriggeredBy(v uuid.UUID) *CronJobRunUpsert {
+	u.Set(cronjobrun.FieldTriggeredBy, v)
+	return u
+}
+
+// UpdateTriggeredBy sets the "triggered_by" field to the value that was provided on create.
+func (u *CronJobRunUpsert) UpdateTriggeredBy() *CronJobRunUpsert {
+	u.SetExcluded(cronjobrun.FieldTriggeredBy)
+	return u
+}
+
+// ClearTriggeredBy clears the value of the "triggered_by" field.
+func (u *CronJobRunUpsert) ClearTriggeredBy() *CronJobRunUpsert {
+	u.SetNull(cronjobrun.FieldTriggeredBy)
+	return u
+}
+
+// SetCounts sets the "counts" field.
+func (u *CronJobRunUpsert) SetCounts(v map[string]int) *CronJobRunUpsert {
+	u.Set(cronjobrun.FieldCounts, v)
+	return u
+}
+
+// UpdateCounts sets the "counts" field to the value that was provided on create.
+func (u *CronJobRunUpsert) UpdateCounts() *CronJobRunUpsert {
+	u.SetExcluded(cronjobrun.FieldCounts)
+	return u
+}
+
+// ClearCounts clears the value of the "counts" field.
+func (u *CronJobRunUpsert) ClearCounts() *CronJobRunUpsert {
+	u.SetNull(cronjobrun.FieldCounts)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *CronJobRunUpsert) SetError(v string) *CronJobRunUpsert {
+	u.Set(cronjobrun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *CronJobRunUpsert) UpdateError() *CronJobRunUpsert {
+	u.SetExcluded(cronjobrun.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *CronJobRunUpsert) ClearError() *CronJobRunUpsert {
+	u.SetNull(cronjobrun.FieldError)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *CronJobRunUpsert) SetFinishedAt(v time.Time) *CronJobRunUpsert {
+	u.Set(cronjobrun.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *CronJobRunUpsert) UpdateFinishedAt() *CronJobRunUpsert {
+	u.SetExcluded(cronjobrun.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *CronJobRunUpsert) ClearFinishedAt() *CronJobRunUpsert {
+	u.SetNull(cronjobrun.FieldFinishedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CronJobRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cronjobrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CronJobRunUpsertOne) UpdateNewValues() *CronJobRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(cronjobrun.FieldID)
+		}
+		if _, exists := u.create.mutation.StartedAt(); exists {
+			s.SetIgnore(cronjobrun.FieldStartedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CronJobRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CronJobRunUpsertOne) Ignore() *CronJobRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CronJobRunUpsertOne) DoNothing() *CronJobRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CronJobRunCreate.OnConflict
+// documentation for more info.
+func (u *CronJobRunUpsertOne) Update(set func(*CronJobRunUpsert)) *CronJobRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CronJobRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetJobName sets the "job_name" field.
+func (u *CronJobRunUpsertOne) SetJobName(v string) *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetJobName(v)
+	})
+}
+
+// UpdateJobName sets the "job_name" field to the value that was provided on create.
+func (u *CronJobRunUpsertOne) UpdateJobName() *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateJobName()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *CronJobRunUpsertOne) SetTrigger(v cronjobrun.Trigger) *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *CronJobRunUpsertOne) UpdateTrigger() *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CronJobRunUpsertOne) SetStatus(v cronjobrun.Status) *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CronJobRunUpsertOne) UpdateStatus() *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetInstance sets the "instance" field.
+func (u *CronJobRunUpsertOne) SetInstance(v string) *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetInstance(v)
+	})
+}
+
+// UpdateInstance sets the "instance" field to the value that was provided on create.
+func (u *CronJobRunUpsertOne) UpdateInstance() *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateInstance()
+	})
+}
+
+// SetTriggeredBy sets the "triggered_by" field.
+func (u *CronJobRunUpsertOne) SetTriggeredBy(v uuid.UUID) *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetTriggeredBy(v)
+	})
+}
+
+// UpdateTriggeredBy sets the "triggered_by" field to the value that was provided on create.
+func (u *CronJobRunUpsertOne) UpdateTriggeredBy() *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateTriggeredBy()
+	})
+}
+
+// ClearTriggeredBy clears the value of the "triggered_by" field.
+func (u *CronJobRunUpsertOne) ClearTriggeredBy() *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.ClearTriggeredBy()
+	})
+}
+
+// SetCounts sets the "counts" field.
+func (u *CronJobRunUpsertOne) SetCounts(v map[string]int) *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetCounts(v)
+	})
+}
+
+// UpdateCounts sets the "counts" field to the value that was provided on create.
+func (u *CronJobRunUpsertOne) UpdateCounts() *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateCounts()
+	})
+}
+
+// ClearCounts clears the value of the "counts" field.
+func (u *CronJobRunUpsertOne) ClearCounts() *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.ClearCounts()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *CronJobRunUpsertOne) SetError(v string) *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *CronJobRunUpsertOne) UpdateError() *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *CronJobRunUpsertOne) ClearError() *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *CronJobRunUpsertOne) SetFinishedAt(v time.Time) *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *CronJobRunUpsertOne) UpdateFinishedAt() *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *CronJobRunUpsertOne) ClearFinishedAt() *CronJobRunUpsertOne {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CronJobRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CronJobRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CronJobRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CronJobRunUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CronJobRunUpsertOne.ID is not supported by MySQL driver. Use CronJobRunUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CronJobRunUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CronJobRunCreateBulk is the builder for creating many CronJobRun entities in bulk.
+type CronJobRunCreateBulk struct {
+	config
+	err      error
+	builders []*CronJobRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CronJobRun entities in the database.
+func (cjrcb *CronJobRunCreateBulk) Save(ctx context.Context) ([]*CronJobRun, error) {
+	if cjrcb.err != nil {
+		return nil, cjrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cjrcb.builders))
+	nodes := make([]*CronJobRun, len(cjrcb.builders))
+	mutators := make([]Mutator, len(cjrcb.builders))
+	for i := range cjrcb.builders {
+		func(i int, root context.Context) {
+			builder := cjrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CronJobRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cjrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cjrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cjrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cjrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cjrcb *CronJobRunCreateBulk) SaveX(ctx context.Context) []*CronJobRun {
+	v, err := cjrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cjrcb *CronJobRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := cjrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cjrcb *CronJobRunCreateBulk) ExecX(ctx context.Context) {
+	if err := cjrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CronJobRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CronJobRunUpsert) {
+//			SetJobName(v+v).
+//		}).
+//		Exec(ctx)
+func (cjrcb *CronJobRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *CronJobRunUpsertBulk {
+	cjrcb.conflict = opts
+	return &CronJobRunUpsertBulk{
+		create: cjrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CronJobRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cjrcb *CronJobRunCreateBulk) OnConflictColumns(columns ...string) *CronJobRunUpsertBulk {
+	cjrcb.conflict = append(cjrcb.conflict, sql.ConflictColumns(columns...))
+	return &CronJobRunUpsertBulk{
+		create: cjrcb,
+	}
+}
+
+// CronJobRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of CronJobRun nodes.
+type CronJobRunUpsertBulk struct {
+	create *CronJobRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CronJobRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cronjobrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CronJobRunUpsertBulk) UpdateNewValues() *CronJobRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(cronjobrun.FieldID)
+			}
+			if _, exists := b.mutation.StartedAt(); exists {
+				s.SetIgnore(cronjobrun.FieldStartedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CronJobRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CronJobRunUpsertBulk) Ignore() *CronJobRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CronJobRunUpsertBulk) DoNothing() *CronJobRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CronJobRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *CronJobRunUpsertBulk) Update(set func(*CronJobRunUpsert)) *CronJobRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CronJobRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetJobName sets the "job_name" field.
+func (u *CronJobRunUpsertBulk) SetJobName(v string) *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetJobName(v)
+	})
+}
+
+// UpdateJobName sets the "job_name" field to the value that was provided on create.
+func (u *CronJobRunUpsertBulk) UpdateJobName() *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateJobName()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *CronJobRunUpsertBulk) SetTrigger(v cronjobrun.Trigger) *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *CronJobRunUpsertBulk) UpdateTrigger() *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CronJobRunUpsertBulk) SetStatus(v cronjobrun.Status) *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CronJobRunUpsertBulk) UpdateStatus() *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetInstance sets the "instance" field.
+func (u *CronJobRunUpsertBulk) SetInstance(v string) *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetInstance(v)
+	})
+}
+
+// UpdateInstance sets the "instance" field to the value that was provided on create.
+func (u *CronJobRunUpsertBulk) UpdateInstance() *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateInstance()
+	})
+}
+
+// SetTriggeredBy sets the "triggered_by" field.
+func (u *CronJobRunUpsertBulk) SetTriggeredBy(v uuid.UUID) *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetTriggeredBy(v)
+	})
+}
+
+// UpdateTriggeredBy sets the "triggered_by" field to the value that was provided on create.
+func (u *CronJobRunUpsertBulk) UpdateTriggeredBy() *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateTriggeredBy()
+	})
+}
+
+// ClearTriggeredBy clears the value of the "triggered_by" field.
+func (u *CronJobRunUpsertBulk) ClearTriggeredBy() *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.ClearTriggeredBy()
+	})
+}
+
+// SetCounts sets the "counts" field.
+func (u *CronJobRunUpsertBulk) SetCounts(v map[string]int) *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetCounts(v)
+	})
+}
+
+// UpdateCounts sets the "counts" field to the value that was provided on create.
+func (u *CronJobRunUpsertBulk) UpdateCounts() *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateCounts()
+	})
+}
+
+// ClearCounts clears the value of the "counts" field.
+func (u *CronJobRunUpsertBulk) ClearCounts() *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.ClearCounts()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *CronJobRunUpsertBulk) SetError(v string) *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *CronJobRunUpsertBulk) UpdateError() *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *CronJobRunUpsertBulk) ClearError() *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *CronJobRunUpsertBulk) SetFinishedAt(v time.Time) *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *CronJobRunUpsertBulk) UpdateFinishedAt() *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *CronJobRunUpsertBulk) ClearFinishedAt() *CronJobRunUpsertBulk {
+	return u.Update(func(s *CronJobRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CronJobRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CronJobRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CronJobRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CronJobRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
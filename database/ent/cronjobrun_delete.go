@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// CronJobRunDelete is the builder for deleting a CronJobRun entity.
+type CronJobRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *CronJobRunMutation
+}
+
+// Where appends a list predicates to the CronJobRunDelete builder.
+func (cjrd *CronJobRunDelete) Where(ps ...predicate.CronJobRun) *CronJobRunDelete {
+	cjrd.mutation.Where(ps...)
+	return cjrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cjrd *CronJobRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cjrd.sqlExec, cjrd.mutation, cjrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cjrd *CronJobRunDelete) ExecX(ctx context.Context) int {
+	n, err := cjrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cjrd *CronJobRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(cronjobrun.Table, sqlgraph.NewFieldSpec(cronjobrun.FieldID, field.TypeUUID))
+	if ps := cjrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cjrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cjrd.mutation.done = true
+	return affected, err
+}
+
+// CronJobRunDeleteOne is the builder for deleting a single CronJobRun entity.
+type CronJobRunDeleteOne struct {
+	cjrd *CronJobRunDelete
+}
+
+// Where appends a list predicates to the CronJobRunDelete builder.
+func (cjrdo *CronJobRunDeleteOne) Where(ps ...predicate.CronJobRun) *CronJobRunDeleteOne {
+	cjrdo.cjrd.mutation.Where(ps...)
+	return cjrdo
+}
+
+// Exec executes the deletion query.
+func (cjrdo *CronJobRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := cjrdo.cjrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{cronjobrun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cjrdo *CronJobRunDeleteOne) ExecX(ctx context.Context) {
+	if err := cjrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
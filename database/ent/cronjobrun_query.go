@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// CronJobRunQuery is the builder for querying CronJobRun entities.
+type CronJobRunQuery struct {
+	config
+	ctx        *QueryContext
+	order      []cronjobrun.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CronJobRun
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CronJobRunQuery builder.
+func (cjrq *CronJobRunQuery) Where(ps ...predicate.CronJobRun) *CronJobRunQuery {
+	cjrq.predicates = append(cjrq.predicates, ps...)
+	return cjrq
+}
+
+// Limit the number of records to be returned by this query.
+func (cjrq *CronJobRunQuery) Limit(limit int) *CronJobRunQuery {
+	cjrq.ctx.Limit = &limit
+	return cjrq
+}
+
+// Offset to start from.
+func (cjrq *CronJobRunQuery) Offset(offset int) *CronJobRunQuery {
+	cjrq.ctx.Offset = &offset
+	return cjrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cjrq *CronJobRunQuery) Unique(unique bool) *CronJobRunQuery {
+	cjrq.ctx.Unique = &unique
+	return cjrq
+}
+
+// Order specifies how the records should be ordered.
+func (cjrq *CronJobRunQuery) Order(o ...cronjobrun.OrderOption) *CronJobRunQuery {
+	cjrq.order = append(cjrq.order, o...)
+	return cjrq
+}
+
+// First returns the first CronJobRun entity from the query.
+// Returns a *NotFoundError when no CronJobRun was found.
+func (cjrq *CronJobRunQuery) First(ctx context.Context) (*CronJobRun, error) {
+	nodes, err := cjrq.Limit(1).All(setContextOp(ctx, cjrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{cronjobrun.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cjrq *CronJobRunQuery) FirstX(ctx context.Context) *CronJobRun {
+	node, err := cjrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CronJobRun ID from the query.
+// Returns a *NotFoundError when no CronJobRun ID was found.
+func (cjrq *CronJobRunQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cjrq.Limit(1).IDs(setContextOp(ctx, cjrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{cronjobrun.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cjrq *CronJobRunQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := cjrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CronJobRun entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CronJobRun entity is found.
+// Returns a *NotFoundError when no CronJobRun entities are found.
+func (cjrq *CronJobRunQuery) Only(ctx context.Context) (*CronJobRun, error) {
+	nodes, err := cjrq.Limit(2).All(setContextOp(ctx, cjrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{cronjobrun.Label}
+	default:
+		return nil, &NotSingularError{cronjobrun.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cjrq *CronJobRunQuery) OnlyX(ctx context.Context) *CronJobRun {
+	node, err := cjrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CronJobRun ID in the query.
+// Returns a *NotSingularError when more than one CronJobRun ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cjrq *CronJobRunQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cjrq.Limit(2).IDs(setContextOp(ctx, cjrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{cronjobrun.Label}
+	default:
+		err = &NotSingularError{cronjobrun.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cjrq *CronJobRunQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := cjrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CronJobRuns.
+func (cjrq *CronJobRunQuery) All(ctx context.Context) ([]*CronJobRun, error) {
+	ctx = setContextOp(ctx, cjrq.ctx, ent.OpQueryAll)
+	if err := cjrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CronJobRun, *CronJobRunQuery]()
+	return withInterceptors[[]*CronJobRun](ctx, cjrq, qr, cjrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cjrq *CronJobRunQuery) AllX(ctx context.Context) []*CronJobRun {
+	nodes, err := cjrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CronJobRun IDs.
+func (cjrq *CronJobRunQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if cjrq.ctx.Unique == nil && cjrq.path != nil {
+		cjrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cjrq.ctx, ent.OpQueryIDs)
+	if err = cjrq.Select(cronjobrun.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cjrq *CronJobRunQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := cjrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cjrq *CronJobRunQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cjrq.ctx, ent.OpQueryCount)
+	if err := cjrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cjrq, querierCount[*CronJobRunQuery](), cjrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cjrq *CronJobRunQuery) CountX(ctx context.Context) int {
+	count, err := cjrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cjrq *CronJobRunQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cjrq.ctx, ent.OpQueryExist)
+	switch _, err := cjrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cjrq *CronJobRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := cjrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CronJobRunQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cjrq *CronJobRunQuery) Clone() *CronJobRunQuery {
+	if cjrq == nil {
+		return nil
+	}
+	return &CronJobRunQuery{
+		config:     cjrq.config,
+		ctx:        cjrq.ctx.Clone(),
+		order:      append([]cronjobrun.OrderOption{}, cjrq.order...),
+		inters:     append([]Interceptor{}, cjrq.inters...),
+		predicates: append([]predicate.CronJobRun{}, cjrq.predicates...),
+		// clone intermediate query.
+		sql:  cjrq.sql.Clone(),
+		path: cjrq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		JobName string `json:"job_name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CronJobRun.Query().
+//		GroupBy(cronjobrun.FieldJobName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cjrq *CronJobRunQuery) GroupBy(field string, fields ...string) *CronJobRunGroupBy {
+	cjrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CronJobRunGroupBy{build: cjrq}
+	grbuild.flds = &cjrq.ctx.Fields
+	grbuild.label = cronjobrun.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		JobName string `json:"job_name,omitempty"`
+//	}
+//
+//	client.CronJobRun.Query().
+//		Select(cronjobrun.FieldJobName).
+//		Scan(ctx, &v)
+func (cjrq *CronJobRunQuery) Select(fields ...string) *CronJobRunSelect {
+	cjrq.ctx.Fields = append(cjrq.ctx.Fields, fields...)
+	sbuild := &CronJobRunSelect{CronJobRunQuery: cjrq}
+	sbuild.label = cronjobrun.Label
+	sbuild.flds, sbuild.scan = &cjrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CronJobRunSelect configured with the given aggregations.
+func (cjrq *CronJobRunQuery) Aggregate(fns ...AggregateFunc) *CronJobRunSelect {
+	return cjrq.Select().Aggregate(fns...)
+}
+
+func (cjrq *CronJobRunQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cjrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cjrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cjrq.ctx.Fields {
+		if !cronjobrun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cjrq.path != nil {
+		prev, err := cjrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cjrq.sql = prev
+	}
+	return nil
+}
+
+func (cjrq *CronJobRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CronJobRun, error) {
+	var (
+		nodes = []*CronJobRun{}
+		_spec = cjrq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CronJobRun).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CronJobRun{config: cjrq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(cjrq.modifiers) > 0 {
+		_spec.Modifiers = cjrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cjrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (cjrq *CronJobRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cjrq.querySpec()
+	if len(cjrq.modifiers) > 0 {
+		_spec.Modifiers = cjrq.modifiers
+	}
+	_spec.Node.Columns = cjrq.ctx.Fields
+	if len(cjrq.ctx.Fields) > 0 {
+		_spec.Unique = cjrq.ctx.Unique != nil && *cjrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cjrq.driver, _spec)
+}
+
+func (cjrq *CronJobRunQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(cronjobrun.Table, cronjobrun.Columns, sqlgraph.NewFieldSpec(cronjobrun.FieldID, field.TypeUUID))
+	_spec.From = cjrq.sql
+	if unique := cjrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cjrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cjrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cronjobrun.FieldID)
+		for i := range fields {
+			if fields[i] != cronjobrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cjrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cjrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cjrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cjrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cjrq *CronJobRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cjrq.driver.Dialect())
+	t1 := builder.Table(cronjobrun.Table)
+	columns := cjrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = cronjobrun.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cjrq.sql != nil {
+		selector = cjrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cjrq.ctx.Unique != nil && *cjrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range cjrq.modifiers {
+		m(selector)
+	}
+	for _, p := range cjrq.predicates {
+		p(selector)
+	}
+	for _, p := range cjrq.order {
+		p(selector)
+	}
+	if offset := cjrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cjrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cjrq *CronJobRunQuery) Modify(modifiers ...func(s *sql.Selector)) *CronJobRunSelect {
+	cjrq.modifiers = append(cjrq.modifiers, modifiers...)
+	return cjrq.Select()
+}
+
+// CronJobRunGroupBy is the group-by builder for CronJobRun entities.
+type CronJobRunGroupBy struct {
+	selector
+	build *CronJobRunQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cjrgb *CronJobRunGroupBy) Aggregate(fns ...AggregateFunc) *CronJobRunGroupBy {
+	cjrgb.fns = append(cjrgb.fns, fns...)
+	return cjrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cjrgb *CronJobRunGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cjrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cjrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CronJobRunQuery, *CronJobRunGroupBy](ctx, cjrgb.build, cjrgb, cjrgb.build.inters, v)
+}
+
+func (cjrgb *CronJobRunGroupBy) sqlScan(ctx context.Context, root *CronJobRunQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cjrgb.fns))
+	for _, fn := range cjrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cjrgb.flds)+len(cjrgb.fns))
+		for _, f := range *cjrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cjrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cjrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CronJobRunSelect is the builder for selecting fields of CronJobRun entities.
+type CronJobRunSelect struct {
+	*CronJobRunQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cjrs *CronJobRunSelect) Aggregate(fns ...AggregateFunc) *CronJobRunSelect {
+	cjrs.fns = append(cjrs.fns, fns...)
+	return cjrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cjrs *CronJobRunSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cjrs.ctx, ent.OpQuerySelect)
+	if err := cjrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CronJobRunQuery, *CronJobRunSelect](ctx, cjrs.CronJobRunQuery, cjrs, cjrs.inters, v)
+}
+
+func (cjrs *CronJobRunSelect) sqlScan(ctx context.Context, root *CronJobRunQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cjrs.fns))
+	for _, fn := range cjrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cjrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cjrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cjrs *CronJobRunSelect) Modify(modifiers ...func(s *sql.Selector)) *CronJobRunSelect {
+	cjrs.modifiers = append(cjrs.modifiers, modifiers...)
+	return cjrs
+}
@@ -0,0 +1,557 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// CronJobRunUpdate is the builder for updating CronJobRun entities.
+type CronJobRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CronJobRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CronJobRunUpdate builder.
+func (cjru *CronJobRunUpdate) Where(ps ...predicate.CronJobRun) *CronJobRunUpdate {
+	cjru.mutation.Where(ps...)
+	return cjru
+}
+
+// SetJobName sets the "job_name" field.
+func (cjru *CronJobRunUpdate) SetJobName(s string) *CronJobRunUpdate {
+	cjru.mutation.SetJobName(s)
+	return cjru
+}
+
+// SetNillableJobName sets the "job_name" field if the given value is not nil.
+func (cjru *CronJobRunUpdate) SetNillableJobName(s *string) *CronJobRunUpdate {
+	if s != nil {
+		cjru.SetJobName(*s)
+	}
+	return cjru
+}
+
+// SetTrigger sets the "trigger" field.
+func (cjru *CronJobRunUpdate) SetTrigger(c cronjobrun.Trigger) *CronJobRunUpdate {
+	cjru.mutation.SetTrigger(c)
+	return cjru
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (cjru *CronJobRunUpdate) SetNillableTrigger(c *cronjobrun.Trigger) *CronJobRunUpdate {
+	if c != nil {
+		cjru.SetTrigger(*c)
+	}
+	return cjru
+}
+
+// SetStatus sets the "status" field.
+func (cjru *CronJobRunUpdate) SetStatus(c cronjobrun.Status) *CronJobRunUpdate {
+	cjru.mutation.SetStatus(c)
+	return cjru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cjru *CronJobRunUpdate) SetNillableStatus(c *cronjobrun.Status) *CronJobRunUpdate {
+	if c != nil {
+		cjru.SetStatus(*c)
+	}
+	return cjru
+}
+
+// SetInstance sets the "instance" field.
+func (cjru *CronJobRunUpdate) SetInstance(s string) *CronJobRunUpdate {
+	cjru.mutation.SetInstance(s)
+	return cjru
+}
+
+// SetNillableInstance sets the "instance" field if the given value is not nil.
+func (cjru *CronJobRunUpdate) SetNillableInstance(s *string) *CronJobRunUpdate {
+	if s != nil {
+		cjru.SetInstance(*s)
+	}
+	return cjru
+}
+
+// SetTriggeredBy sets the "triggered_by" field.
+func (cjru *CronJobRunUpdate) SetTriggeredBy(u uuid.UUID) *CronJobRunUpdate {
+	cjru.mutation.SetTriggeredBy(u)
+	return cjru
+}
+
+// SetNillableTriggeredBy sets the "triggered_by" field if the given value is not nil.
+func (cjru *CronJobRunUpdate) SetNillableTriggeredBy(u *uuid.UUID) *CronJobRunUpdate {
+	if u != nil {
+		cjru.SetTriggeredBy(*u)
+	}
+	return cjru
+}
+
+// ClearTriggeredBy clears the value of the "triggered_by" field.
+func (cjru *CronJobRunUpdate) ClearTriggeredBy() *CronJobRunUpdate {
+	cjru.mutation.ClearTriggeredBy()
+	return cjru
+}
+
+// SetCounts sets the "counts" field.
+func (cjru *CronJobRunUpdate) SetCounts(m map[string]int) *CronJobRunUpdate {
+	cjru.mutation.SetCounts(m)
+	return cjru
+}
+
+// ClearCounts clears the value of the "counts" field.
+func (cjru *CronJobRunUpdate) ClearCounts() *CronJobRunUpdate {
+	cjru.mutation.ClearCounts()
+	return cjru
+}
+
+// SetError sets the "error" field.
+func (cjru *CronJobRunUpdate) SetError(s string) *CronJobRunUpdate {
+	cjru.mutation.SetError(s)
+	return cjru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (cjru *CronJobRunUpdate) SetNillableError(s *string) *CronJobRunUpdate {
+	if s != nil {
+		cjru.SetError(*s)
+	}
+	return cjru
+}
+
+// ClearError clears the value of the "error" field.
+func (cjru *CronJobRunUpdate) ClearError() *CronJobRunUpdate {
+	cjru.mutation.ClearError()
+	return cjru
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (cjru *CronJobRunUpdate) SetFinishedAt(t time.Time) *CronJobRunUpdate {
+	cjru.mutation.SetFinishedAt(t)
+	return cjru
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (cjru *CronJobRunUpdate) SetNillableFinishedAt(t *time.Time) *CronJobRunUpdate {
+	if t != nil {
+		cjru.SetFinishedAt(*t)
+	}
+	return cjru
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (cjru *CronJobRunUpdate) ClearFinishedAt() *CronJobRunUpdate {
+	cjru.mutation.ClearFinishedAt()
+	return cjru
+}
+
+// Mutation returns the CronJobRunMutation object of the builder.
+func (cjru *CronJobRunUpdate) Mutation() *CronJobRunMutation {
+	return cjru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cjru *CronJobRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cjru.sqlSave, cjru.mutation, cjru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cjru *CronJobRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := cjru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cjru *CronJobRunUpdate) Exec(ctx context.Context) error {
+	_, err := cjru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cjru *CronJobRunUpdate) ExecX(ctx context.Context) {
+	if err := cjru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cjru *CronJobRunUpdate) check() error {
+	if v, ok := cjru.mutation.Trigger(); ok {
+		if err := cronjobrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "CronJobRun.trigger": %w`, err)}
+		}
+	}
+	if v, ok := cjru.mutation.Status(); ok {
+		if err := cronjobrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CronJobRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cjru *CronJobRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CronJobRunUpdate {
+	cjru.modifiers = append(cjru.modifiers, modifiers...)
+	return cjru
+}
+
+func (cjru *CronJobRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cjru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cronjobrun.Table, cronjobrun.Columns, sqlgraph.NewFieldSpec(cronjobrun.FieldID, field.TypeUUID))
+	if ps := cjru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cjru.mutation.JobName(); ok {
+		_spec.SetField(cronjobrun.FieldJobName, field.TypeString, value)
+	}
+	if value, ok := cjru.mutation.Trigger(); ok {
+		_spec.SetField(cronjobrun.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := cjru.mutation.Status(); ok {
+		_spec.SetField(cronjobrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cjru.mutation.Instance(); ok {
+		_spec.SetField(cronjobrun.FieldInstance, field.TypeString, value)
+	}
+	if value, ok := cjru.mutation.TriggeredBy(); ok {
+		_spec.SetField(cronjobrun.FieldTriggeredBy, field.TypeUUID, value)
+	}
+	if cjru.mutation.TriggeredByCleared() {
+		_spec.ClearField(cronjobrun.FieldTriggeredBy, field.TypeUUID)
+	}
+	if value, ok := cjru.mutation.Counts(); ok {
+		_spec.SetField(cronjobrun.FieldCounts, field.TypeJSON, value)
+	}
+	if cjru.mutation.CountsCleared() {
+		_spec.ClearField(cronjobrun.FieldCounts, field.TypeJSON)
+	}
+	if value, ok := cjru.mutation.Error(); ok {
+		_spec.SetField(cronjobrun.FieldError, field.TypeString, value)
+	}
+	if cjru.mutation.ErrorCleared() {
+		_spec.ClearField(cronjobrun.FieldError, field.TypeString)
+	}
+	if value, ok := cjru.mutation.FinishedAt(); ok {
+		_spec.SetField(cronjobrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if cjru.mutation.FinishedAtCleared() {
+		_spec.ClearField(cronjobrun.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(cjru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cjru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cronjobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cjru.mutation.done = true
+	return n, nil
+}
+
+// CronJobRunUpdateOne is the builder for updating a single CronJobRun entity.
+type CronJobRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CronJobRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetJobName sets the "job_name" field.
+func (cjruo *CronJobRunUpdateOne) SetJobName(s string) *CronJobRunUpdateOne {
+	cjruo.mutation.SetJobName(s)
+	return cjruo
+}
+
+// SetNillableJobName sets the "job_name" field if the given value is not nil.
+func (cjruo *CronJobRunUpdateOne) SetNillableJobName(s *string) *CronJobRunUpdateOne {
+	if s != nil {
+		cjruo.SetJobName(*s)
+	}
+	return cjruo
+}
+
+// SetTrigger sets the "trigger" field.
+func (cjruo *CronJobRunUpdateOne) SetTrigger(c cronjobrun.Trigger) *CronJobRunUpdateOne {
+	cjruo.mutation.SetTrigger(c)
+	return cjruo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (cjruo *CronJobRunUpdateOne) SetNillableTrigger(c *cronjobrun.Trigger) *CronJobRunUpdateOne {
+	if c != nil {
+		cjruo.SetTrigger(*c)
+	}
+	return cjruo
+}
+
+// SetStatus sets the "status" field.
+func (cjruo *CronJobRunUpdateOne) SetStatus(c cronjobrun.Status) *CronJobRunUpdateOne {
+	cjruo.mutation.SetStatus(c)
+	return cjruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cjruo *CronJobRunUpdateOne) SetNillableStatus(c *cronjobrun.Status) *CronJobRunUpdateOne {
+	if c != nil {
+		cjruo.SetStatus(*c)
+	}
+	return cjruo
+}
+
+// SetInstance sets the "instance" field.
+func (cjruo *CronJobRunUpdateOne) SetInstance(s string) *CronJobRunUpdateOne {
+	cjruo.mutation.SetInstance(s)
+	return cjruo
+}
+
+// SetNillableInstance sets the "instance" field if the given value is not nil.
+func (cjruo *CronJobRunUpdateOne) SetNillableInstance(s *string) *CronJobRunUpdateOne {
+	if s != nil {
+		cjruo.SetInstance(*s)
+	}
+	return cjruo
+}
+
+// SetTriggeredBy sets the "triggered_by" field.
+func (cjruo *CronJobRunUpdateOne) SetTriggeredBy(u uuid.UUID) *CronJobRunUpdateOne {
+	cjruo.mutation.SetTriggeredBy(u)
+	return cjruo
+}
+
+// SetNillableTriggeredBy sets the "triggered_by" field if the given value is not nil.
+func (cjruo *CronJobRunUpdateOne) SetNillableTriggeredBy(u *uuid.UUID) *CronJobRunUpdateOne {
+	if u != nil {
+		cjruo.SetTriggeredBy(*u)
+	}
+	return cjruo
+}
+
+// ClearTriggeredBy clears the value of the "triggered_by" field.
+func (cjruo *CronJobRunUpdateOne) ClearTriggeredBy() *CronJobRunUpdateOne {
+	cjruo.mutation.ClearTriggeredBy()
+	return cjruo
+}
+
+// SetCounts sets the "counts" field.
+func (cjruo *CronJobRunUpdateOne) SetCounts(m map[string]int) *CronJobRunUpdateOne {
+	cjruo.mutation.SetCounts(m)
+	return cjruo
+}
+
+// ClearCounts clears the value of the "counts" field.
+func (cjruo *CronJobRunUpdateOne) ClearCounts() *CronJobRunUpdateOne {
+	cjruo.mutation.ClearCounts()
+	return cjruo
+}
+
+// SetError sets the "error" field.
+func (cjruo *CronJobRunUpdateOne) SetError(s string) *CronJobRunUpdateOne {
+	cjruo.mutation.SetError(s)
+	return cjruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (cjruo *CronJobRunUpdateOne) SetNillableError(s *string) *CronJobRunUpdateOne {
+	if s != nil {
+		cjruo.SetError(*s)
+	}
+	return cjruo
+}
+
+// ClearError clears the value of the "error" field.
+func (cjruo *CronJobRunUpdateOne) ClearError() *CronJobRunUpdateOne {
+	cjruo.mutation.ClearError()
+	return cjruo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (cjruo *CronJobRunUpdateOne) SetFinishedAt(t time.Time) *CronJobRunUpdateOne {
+	cjruo.mutation.SetFinishedAt(t)
+	return cjruo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (cjruo *CronJobRunUpdateOne) SetNillableFinishedAt(t *time.Time) *CronJobRunUpdateOne {
+	if t != nil {
+		cjruo.SetFinishedAt(*t)
+	}
+	return cjruo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (cjruo *CronJobRunUpdateOne) ClearFinishedAt() *CronJobRunUpdateOne {
+	cjruo.mutation.ClearFinishedAt()
+	return cjruo
+}
+
+// Mutation returns the CronJobRunMutation object of the builder.
+func (cjruo *CronJobRunUpdateOne) Mutation() *CronJobRunMutation {
+	return cjruo.mutation
+}
+
+// Where appends a list predicates to the CronJobRunUpdate builder.
+func (cjruo *CronJobRunUpdateOne) Where(ps ...predicate.CronJobRun) *CronJobRunUpdateOne {
+	cjruo.mutation.Where(ps...)
+	return cjruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cjruo *CronJobRunUpdateOne) Select(field string, fields ...string) *CronJobRunUpdateOne {
+	cjruo.fields = append([]string{field}, fields...)
+	return cjruo
+}
+
+// Save executes the query and returns the updated CronJobRun entity.
+func (cjruo *CronJobRunUpdateOne) Save(ctx context.Context) (*CronJobRun, error) {
+	return withHooks(ctx, cjruo.sqlSave, cjruo.mutation, cjruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cjruo *CronJobRunUpdateOne) SaveX(ctx context.Context) *CronJobRun {
+	node, err := cjruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cjruo *CronJobRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := cjruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cjruo *CronJobRunUpdateOne) ExecX(ctx context.Context) {
+	if err := cjruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cjruo *CronJobRunUpdateOne) check() error {
+	if v, ok := cjruo.mutation.Trigger(); ok {
+		if err := cronjobrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "CronJobRun.trigger": %w`, err)}
+		}
+	}
+	if v, ok := cjruo.mutation.Status(); ok {
+		if err := cronjobrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CronJobRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cjruo *CronJobRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CronJobRunUpdateOne {
+	cjruo.modifiers = append(cjruo.modifiers, modifiers...)
+	return cjruo
+}
+
+func (cjruo *CronJobRunUpdateOne) sqlSave(ctx context.Context) (_node *CronJobRun, err error) {
+	if err := cjruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cronjobrun.Table, cronjobrun.Columns, sqlgraph.NewFieldSpec(cronjobrun.FieldID, field.TypeUUID))
+	id, ok := cjruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CronJobRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cjruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cronjobrun.FieldID)
+		for _, f := range fields {
+			if !cronjobrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cronjobrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cjruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cjruo.mutation.JobName(); ok {
+		_spec.SetField(cronjobrun.FieldJobName, field.TypeString, value)
+	}
+	if value, ok := cjruo.mutation.Trigger(); ok {
+		_spec.SetField(cronjobrun.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := cjruo.mutation.Status(); ok {
+		_spec.SetField(cronjobrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cjruo.mutation.Instance(); ok {
+		_spec.SetField(cronjobrun.FieldInstance, field.TypeString, value)
+	}
+	if value, ok := cjruo.mutation.TriggeredBy(); ok {
+		_spec.SetField(cronjobrun.FieldTriggeredBy, field.TypeUUID, value)
+	}
+	if cjruo.mutation.TriggeredByCleared() {
+		_spec.ClearField(cronjobrun.FieldTriggeredBy, field.TypeUUID)
+	}
+	if value, ok := cjruo.mutation.Counts(); ok {
+		_spec.SetField(cronjobrun.FieldCounts, field.TypeJSON, value)
+	}
+	if cjruo.mutation.CountsCleared() {
+		_spec.ClearField(cronjobrun.FieldCounts, field.TypeJSON)
+	}
+	if value, ok := cjruo.mutation.Error(); ok {
+		_spec.SetField(cronjobrun.FieldError, field.TypeString, value)
+	}
+	if cjruo.mutation.ErrorCleared() {
+		_spec.ClearField(cronjobrun.FieldError, field.TypeString)
+	}
+	if value, ok := cjruo.mutation.FinishedAt(); ok {
+		_spec.SetField(cronjobrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if cjruo.mutation.FinishedAtCleared() {
+		_spec.ClearField(cronjobrun.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(cjruo.modifiers...)
+	_node = &CronJobRun{config: cjruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cjruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cronjobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cjruo.mutation.done = true
+	return _node, nil
+}
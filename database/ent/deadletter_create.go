@@ -0,0 +1,920 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/deadletter"
+)
+
+// DeadLetterCreate is the builder for creating a DeadLetter entity.
+type DeadLetterCreate struct {
+	config
+	mutation *DeadLetterMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetJobID sets the "job_id" field.
+func (dlc *DeadLetterCreate) SetJobID(u uuid.UUID) *DeadLetterCreate {
+	dlc.mutation.SetJobID(u)
+	return dlc
+}
+
+// SetProcessType sets the "process_type" field.
+func (dlc *DeadLetterCreate) SetProcessType(s string) *DeadLetterCreate {
+	dlc.mutation.SetProcessType(s)
+	return dlc
+}
+
+// SetUserID sets the "user_id" field.
+func (dlc *DeadLetterCreate) SetUserID(u uuid.UUID) *DeadLetterCreate {
+	dlc.mutation.SetUserID(u)
+	return dlc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableUserID(u *uuid.UUID) *DeadLetterCreate {
+	if u != nil {
+		dlc.SetUserID(*u)
+	}
+	return dlc
+}
+
+// SetPayload sets the "payload" field.
+func (dlc *DeadLetterCreate) SetPayload(s string) *DeadLetterCreate {
+	dlc.mutation.SetPayload(s)
+	return dlc
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (dlc *DeadLetterCreate) SetFailureReason(s string) *DeadLetterCreate {
+	dlc.mutation.SetFailureReason(s)
+	return dlc
+}
+
+// SetReplayedAt sets the "replayed_at" field.
+func (dlc *DeadLetterCreate) SetReplayedAt(t time.Time) *DeadLetterCreate {
+	dlc.mutation.SetReplayedAt(t)
+	return dlc
+}
+
+// SetNillableReplayedAt sets the "replayed_at" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableReplayedAt(t *time.Time) *DeadLetterCreate {
+	if t != nil {
+		dlc.SetReplayedAt(*t)
+	}
+	return dlc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dlc *DeadLetterCreate) SetCreatedAt(t time.Time) *DeadLetterCreate {
+	dlc.mutation.SetCreatedAt(t)
+	return dlc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableCreatedAt(t *time.Time) *DeadLetterCreate {
+	if t != nil {
+		dlc.SetCreatedAt(*t)
+	}
+	return dlc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dlc *DeadLetterCreate) SetUpdatedAt(t time.Time) *DeadLetterCreate {
+	dlc.mutation.SetUpdatedAt(t)
+	return dlc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableUpdatedAt(t *time.Time) *DeadLetterCreate {
+	if t != nil {
+		dlc.SetUpdatedAt(*t)
+	}
+	return dlc
+}
+
+// SetID sets the "id" field.
+func (dlc *DeadLetterCreate) SetID(u uuid.UUID) *DeadLetterCreate {
+	dlc.mutation.SetID(u)
+	return dlc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableID(u *uuid.UUID) *DeadLetterCreate {
+	if u != nil {
+		dlc.SetID(*u)
+	}
+	return dlc
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (dlc *DeadLetterCreate) Mutation() *DeadLetterMutation {
+	return dlc.mutation
+}
+
+// Save creates the DeadLetter in the database.
+func (dlc *DeadLetterCreate) Save(ctx context.Context) (*DeadLetter, error) {
+	dlc.defaults()
+	return withHooks(ctx, dlc.sqlSave, dlc.mutation, dlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dlc *DeadLetterCreate) SaveX(ctx context.Context) *DeadLetter {
+	v, err := dlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dlc *DeadLetterCreate) Exec(ctx context.Context) error {
+	_, err := dlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlc *DeadLetterCreate) ExecX(ctx context.Context) {
+	if err := dlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dlc *DeadLetterCreate) defaults() {
+	if _, ok := dlc.mutation.CreatedAt(); !ok {
+		v := deadletter.DefaultCreatedAt()
+		dlc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dlc.mutation.UpdatedAt(); !ok {
+		v := deadletter.DefaultUpdatedAt()
+		dlc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dlc.mutation.ID(); !ok {
+		v := deadletter.DefaultID()
+		dlc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dlc *DeadLetterCreate) check() error {
+	if _, ok := dlc.mutation.JobID(); !ok {
+		return &ValidationError{Name: "job_id", err: errors.New(`ent: missing required field "DeadLetter.job_id"`)}
+	}
+	if _, ok := dlc.mutation.ProcessType(); !ok {
+		return &ValidationError{Name: "process_type", err: errors.New(`ent: missing required field "DeadLetter.process_type"`)}
+	}
+	if _, ok := dlc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "DeadLetter.payload"`)}
+	}
+	if _, ok := dlc.mutation.FailureReason(); !ok {
+		return &ValidationError{Name: "failure_reason", err: errors.New(`ent: missing required field "DeadLetter.failure_reason"`)}
+	}
+	if _, ok := dlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeadLetter.created_at"`)}
+	}
+	if _, ok := dlc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DeadLetter.updated_at"`)}
+	}
+	return nil
+}
+
+func (dlc *DeadLetterCreate) sqlSave(ctx context.Context) (*DeadLetter, error) {
+	if err := dlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dlc.mutation.id = &_node.ID
+	dlc.mutation.done = true
+	return _node, nil
+}
+
+func (dlc *DeadLetterCreate) createSpec() (*DeadLetter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeadLetter{config: dlc.config}
+		_spec = sqlgraph.NewCreateSpec(deadletter.Table, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = dlc.conflict
+	if id, ok := dlc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dlc.mutation.JobID(); ok {
+		_spec.SetField(deadletter.FieldJobID, field.TypeUUID, value)
+		_node.JobID = value
+	}
+	if value, ok := dlc.mutation.ProcessType(); ok {
+		_spec.SetField(deadletter.FieldProcessType, field.TypeString, value)
+		_node.ProcessType = value
+	}
+	if value, ok := dlc.mutation.UserID(); ok {
+		_spec.SetField(deadletter.FieldUserID, field.TypeUUID, value)
+		_node.UserID = &value
+	}
+	if value, ok := dlc.mutation.Payload(); ok {
+		_spec.SetField(deadletter.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := dlc.mutation.FailureReason(); ok {
+		_spec.SetField(deadletter.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = value
+	}
+	if value, ok := dlc.mutation.ReplayedAt(); ok {
+		_spec.SetField(deadletter.FieldReplayedAt, field.TypeTime, value)
+		_node.ReplayedAt = &value
+	}
+	if value, ok := dlc.mutation.CreatedAt(); ok {
+		_spec.SetField(deadletter.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dlc.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletter.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeadLetter.Create().
+//		SetJobID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeadLetterUpsert) {
+//			SetJobID(v+v).
+//		}).
+//		Exec(ctx)
+func (dlc *DeadLetterCreate) OnConflict(opts ...sql.ConflictOption) *DeadLetterUpsertOne {
+	dlc.conflict = opts
+	return &DeadLetterUpsertOne{
+		create: dlc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dlc *DeadLetterCreate) OnConflictColumns(columns ...string) *DeadLetterUpsertOne {
+	dlc.conflict = append(dlc.conflict, sql.ConflictColumns(columns...))
+	return &DeadLetterUpsertOne{
+		create: dlc,
+	}
+}
+
+type (
+	// DeadLetterUpsertOne is the builder for "upsert"-ing
+	//  one DeadLetter node.
+	DeadLetterUpsertOne struct {
+		create *DeadLetterCreate
+	}
+
+	// DeadLetterUpsert is the "OnConflict" setter.
+	DeadLetterUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetJobID sets the "job_id" field.
+func (u *DeadLetterUpsert) SetJobID(v uuid.UUID) *DeadLetterUpsert {
+	u.Set(deadletter.FieldJobID, v)
+	return u
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateJobID() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldJobID)
+	return u
+}
+
+// SetProcessType sets the "process_type" field.
+func (u *DeadLetterUpsert) SetProcessType(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldProcessType, v)
+	return u
+}
+
+// UpdateProcessType sets the "process_type" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateProcessType() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldProcessType)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DeadLetterUpsert) SetUserID(v uuid.UUID) *DeadLetterUpsert {
+	u.Set(deadletter.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateUserID() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *DeadLetterUpsert) ClearUserID() *DeadLetterUpsert {
+	u.SetNull(deadletter.FieldUserID)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *DeadLetterUpsert) SetPayload(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdatePayload() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldPayload)
+	return u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *DeadLetterUpsert) SetFailureReason(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldFailureReason, v)
+	return u
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateFailureReason() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldFailureReason)
+	return u
+}
+
+// SetReplayedAt sets the "replayed_at" field.
+func (u *DeadLetterUpsert) SetReplayedAt(v time.Time) *DeadLetterUpsert {
+	u.Set(deadletter.FieldReplayedAt, v)
+	return u
+}
+
+// UpdateReplayedAt sets the "replayed_at" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateReplayedAt() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldReplayedAt)
+	return u
+}
+
+// ClearReplayedAt clears the value of the "replayed_at" field.
+func (u *DeadLetterUpsert) ClearReplayedAt() *DeadLetterUpsert {
+	u.SetNull(deadletter.FieldReplayedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeadLetterUpsert) SetUpdatedAt(v time.Time) *DeadLetterUpsert {
+	u.Set(deadletter.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateUpdatedAt() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deadletter.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeadLetterUpsertOne) UpdateNewValues() *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(deadletter.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(deadletter.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeadLetterUpsertOne) Ignore() *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeadLetterUpsertOne) DoNothing() *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeadLetterCreate.OnConflict
+// documentation for more info.
+func (u *DeadLetterUpsertOne) Update(set func(*DeadLetterUpsert)) *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeadLetterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetJobID sets the "job_id" field.
+func (u *DeadLetterUpsertOne) SetJobID(v uuid.UUID) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetJobID(v)
+	})
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateJobID() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateJobID()
+	})
+}
+
+// SetProcessType sets the "process_type" field.
+func (u *DeadLetterUpsertOne) SetProcessType(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetProcessType(v)
+	})
+}
+
+// UpdateProcessType sets the "process_type" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateProcessType() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateProcessType()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DeadLetterUpsertOne) SetUserID(v uuid.UUID) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateUserID() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *DeadLetterUpsertOne) ClearUserID() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *DeadLetterUpsertOne) SetPayload(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdatePayload() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *DeadLetterUpsertOne) SetFailureReason(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateFailureReason() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// SetReplayedAt sets the "replayed_at" field.
+func (u *DeadLetterUpsertOne) SetReplayedAt(v time.Time) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetReplayedAt(v)
+	})
+}
+
+// UpdateReplayedAt sets the "replayed_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateReplayedAt() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateReplayedAt()
+	})
+}
+
+// ClearReplayedAt clears the value of the "replayed_at" field.
+func (u *DeadLetterUpsertOne) ClearReplayedAt() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearReplayedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeadLetterUpsertOne) SetUpdatedAt(v time.Time) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateUpdatedAt() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeadLetterUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeadLetterCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeadLetterUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeadLetterUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DeadLetterUpsertOne.ID is not supported by MySQL driver. Use DeadLetterUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeadLetterUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeadLetterCreateBulk is the builder for creating many DeadLetter entities in bulk.
+type DeadLetterCreateBulk struct {
+	config
+	err      error
+	builders []*DeadLetterCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeadLetter entities in the database.
+func (dlcb *DeadLetterCreateBulk) Save(ctx context.Context) ([]*DeadLetter, error) {
+	if dlcb.err != nil {
+		return nil, dlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dlcb.builders))
+	nodes := make([]*DeadLetter, len(dlcb.builders))
+	mutators := make([]Mutator, len(dlcb.builders))
+	for i := range dlcb.builders {
+		func(i int, root context.Context) {
+			builder := dlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeadLetterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dlcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dlcb *DeadLetterCreateBulk) SaveX(ctx context.Context) []*DeadLetter {
+	v, err := dlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dlcb *DeadLetterCreateBulk) Exec(ctx context.Context) error {
+	_, err := dlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlcb *DeadLetterCreateBulk) ExecX(ctx context.Context) {
+	if err := dlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeadLetter.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeadLetterUpsert) {
+//			SetJobID(v+v).
+//		}).
+//		Exec(ctx)
+func (dlcb *DeadLetterCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeadLetterUpsertBulk {
+	dlcb.conflict = opts
+	return &DeadLetterUpsertBulk{
+		create: dlcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dlcb *DeadLetterCreateBulk) OnConflictColumns(columns ...string) *DeadLetterUpsertBulk {
+	dlcb.conflict = append(dlcb.conflict, sql.ConflictColumns(columns...))
+	return &DeadLetterUpsertBulk{
+		create: dlcb,
+	}
+}
+
+// DeadLetterUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeadLetter nodes.
+type DeadLetterUpsertBulk struct {
+	create *DeadLetterCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deadletter.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeadLetterUpsertBulk) UpdateNewValues() *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(deadletter.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(deadletter.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeadLetterUpsertBulk) Ignore() *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeadLetterUpsertBulk) DoNothing() *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeadLetterCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeadLetterUpsertBulk) Update(set func(*DeadLetterUpsert)) *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeadLetterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetJobID sets the "job_id" field.
+func (u *DeadLetterUpsertBulk) SetJobID(v uuid.UUID) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetJobID(v)
+	})
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateJobID() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateJobID()
+	})
+}
+
+// SetProcessType sets the "process_type" field.
+func (u *DeadLetterUpsertBulk) SetProcessType(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetProcessType(v)
+	})
+}
+
+// UpdateProcessType sets the "process_type" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateProcessType() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateProcessType()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DeadLetterUpsertBulk) SetUserID(v uuid.UUID) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateUserID() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *DeadLetterUpsertBulk) ClearUserID() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *DeadLetterUpsertBulk) SetPayload(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdatePayload() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *DeadLetterUpsertBulk) SetFailureReason(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateFailureReason() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// SetReplayedAt sets the "replayed_at" field.
+func (u *DeadLetterUpsertBulk) SetReplayedAt(v time.Time) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetReplayedAt(v)
+	})
+}
+
+// UpdateReplayedAt sets the "replayed_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateReplayedAt() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateReplayedAt()
+	})
+}
+
+// ClearReplayedAt clears the value of the "replayed_at" field.
+func (u *DeadLetterUpsertBulk) ClearReplayedAt() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearReplayedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeadLetterUpsertBulk) SetUpdatedAt(v time.Time) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateUpdatedAt() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeadLetterUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeadLetterCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeadLetterCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeadLetterUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
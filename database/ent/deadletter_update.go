@@ -0,0 +1,469 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/deadletter"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// DeadLetterUpdate is the builder for updating DeadLetter entities.
+type DeadLetterUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DeadLetterMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DeadLetterUpdate builder.
+func (dlu *DeadLetterUpdate) Where(ps ...predicate.DeadLetter) *DeadLetterUpdate {
+	dlu.mutation.Where(ps...)
+	return dlu
+}
+
+// SetJobID sets the "job_id" field.
+func (dlu *DeadLetterUpdate) SetJobID(u uuid.UUID) *DeadLetterUpdate {
+	dlu.mutation.SetJobID(u)
+	return dlu
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableJobID(u *uuid.UUID) *DeadLetterUpdate {
+	if u != nil {
+		dlu.SetJobID(*u)
+	}
+	return dlu
+}
+
+// SetProcessType sets the "process_type" field.
+func (dlu *DeadLetterUpdate) SetProcessType(s string) *DeadLetterUpdate {
+	dlu.mutation.SetProcessType(s)
+	return dlu
+}
+
+// SetNillableProcessType sets the "process_type" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableProcessType(s *string) *DeadLetterUpdate {
+	if s != nil {
+		dlu.SetProcessType(*s)
+	}
+	return dlu
+}
+
+// SetUserID sets the "user_id" field.
+func (dlu *DeadLetterUpdate) SetUserID(u uuid.UUID) *DeadLetterUpdate {
+	dlu.mutation.SetUserID(u)
+	return dlu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableUserID(u *uuid.UUID) *DeadLetterUpdate {
+	if u != nil {
+		dlu.SetUserID(*u)
+	}
+	return dlu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (dlu *DeadLetterUpdate) ClearUserID() *DeadLetterUpdate {
+	dlu.mutation.ClearUserID()
+	return dlu
+}
+
+// SetPayload sets the "payload" field.
+func (dlu *DeadLetterUpdate) SetPayload(s string) *DeadLetterUpdate {
+	dlu.mutation.SetPayload(s)
+	return dlu
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillablePayload(s *string) *DeadLetterUpdate {
+	if s != nil {
+		dlu.SetPayload(*s)
+	}
+	return dlu
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (dlu *DeadLetterUpdate) SetFailureReason(s string) *DeadLetterUpdate {
+	dlu.mutation.SetFailureReason(s)
+	return dlu
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableFailureReason(s *string) *DeadLetterUpdate {
+	if s != nil {
+		dlu.SetFailureReason(*s)
+	}
+	return dlu
+}
+
+// SetReplayedAt sets the "replayed_at" field.
+func (dlu *DeadLetterUpdate) SetReplayedAt(t time.Time) *DeadLetterUpdate {
+	dlu.mutation.SetReplayedAt(t)
+	return dlu
+}
+
+// SetNillableReplayedAt sets the "replayed_at" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableReplayedAt(t *time.Time) *DeadLetterUpdate {
+	if t != nil {
+		dlu.SetReplayedAt(*t)
+	}
+	return dlu
+}
+
+// ClearReplayedAt clears the value of the "replayed_at" field.
+func (dlu *DeadLetterUpdate) ClearReplayedAt() *DeadLetterUpdate {
+	dlu.mutation.ClearReplayedAt()
+	return dlu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dlu *DeadLetterUpdate) SetUpdatedAt(t time.Time) *DeadLetterUpdate {
+	dlu.mutation.SetUpdatedAt(t)
+	return dlu
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (dlu *DeadLetterUpdate) Mutation() *DeadLetterMutation {
+	return dlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dlu *DeadLetterUpdate) Save(ctx context.Context) (int, error) {
+	dlu.defaults()
+	return withHooks(ctx, dlu.sqlSave, dlu.mutation, dlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dlu *DeadLetterUpdate) SaveX(ctx context.Context) int {
+	affected, err := dlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dlu *DeadLetterUpdate) Exec(ctx context.Context) error {
+	_, err := dlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlu *DeadLetterUpdate) ExecX(ctx context.Context) {
+	if err := dlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dlu *DeadLetterUpdate) defaults() {
+	if _, ok := dlu.mutation.UpdatedAt(); !ok {
+		v := deadletter.UpdateDefaultUpdatedAt()
+		dlu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dlu *DeadLetterUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeadLetterUpdate {
+	dlu.modifiers = append(dlu.modifiers, modifiers...)
+	return dlu
+}
+
+func (dlu *DeadLetterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deadletter.Table, deadletter.Columns, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeUUID))
+	if ps := dlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dlu.mutation.JobID(); ok {
+		_spec.SetField(deadletter.FieldJobID, field.TypeUUID, value)
+	}
+	if value, ok := dlu.mutation.ProcessType(); ok {
+		_spec.SetField(deadletter.FieldProcessType, field.TypeString, value)
+	}
+	if value, ok := dlu.mutation.UserID(); ok {
+		_spec.SetField(deadletter.FieldUserID, field.TypeUUID, value)
+	}
+	if dlu.mutation.UserIDCleared() {
+		_spec.ClearField(deadletter.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := dlu.mutation.Payload(); ok {
+		_spec.SetField(deadletter.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := dlu.mutation.FailureReason(); ok {
+		_spec.SetField(deadletter.FieldFailureReason, field.TypeString, value)
+	}
+	if value, ok := dlu.mutation.ReplayedAt(); ok {
+		_spec.SetField(deadletter.FieldReplayedAt, field.TypeTime, value)
+	}
+	if dlu.mutation.ReplayedAtCleared() {
+		_spec.ClearField(deadletter.FieldReplayedAt, field.TypeTime)
+	}
+	if value, ok := dlu.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(dlu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, dlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dlu.mutation.done = true
+	return n, nil
+}
+
+// DeadLetterUpdateOne is the builder for updating a single DeadLetter entity.
+type DeadLetterUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DeadLetterMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetJobID sets the "job_id" field.
+func (dluo *DeadLetterUpdateOne) SetJobID(u uuid.UUID) *DeadLetterUpdateOne {
+	dluo.mutation.SetJobID(u)
+	return dluo
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableJobID(u *uuid.UUID) *DeadLetterUpdateOne {
+	if u != nil {
+		dluo.SetJobID(*u)
+	}
+	return dluo
+}
+
+// SetProcessType sets the "process_type" field.
+func (dluo *DeadLetterUpdateOne) SetProcessType(s string) *DeadLetterUpdateOne {
+	dluo.mutation.SetProcessType(s)
+	return dluo
+}
+
+// SetNillableProcessType sets the "process_type" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableProcessType(s *string) *DeadLetterUpdateOne {
+	if s != nil {
+		dluo.SetProcessType(*s)
+	}
+	return dluo
+}
+
+// SetUserID sets the "user_id" field.
+func (dluo *DeadLetterUpdateOne) SetUserID(u uuid.UUID) *DeadLetterUpdateOne {
+	dluo.mutation.SetUserID(u)
+	return dluo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableUserID(u *uuid.UUID) *DeadLetterUpdateOne {
+	if u != nil {
+		dluo.SetUserID(*u)
+	}
+	return dluo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (dluo *DeadLetterUpdateOne) ClearUserID() *DeadLetterUpdateOne {
+	dluo.mutation.ClearUserID()
+	return dluo
+}
+
+// SetPayload sets the "payload" field.
+func (dluo *DeadLetterUpdateOne) SetPayload(s string) *DeadLetterUpdateOne {
+	dluo.mutation.SetPayload(s)
+	return dluo
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillablePayload(s *string) *DeadLetterUpdateOne {
+	if s != nil {
+		dluo.SetPayload(*s)
+	}
+	return dluo
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (dluo *DeadLetterUpdateOne) SetFailureReason(s string) *DeadLetterUpdateOne {
+	dluo.mutation.SetFailureReason(s)
+	return dluo
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableFailureReason(s *string) *DeadLetterUpdateOne {
+	if s != nil {
+		dluo.SetFailureReason(*s)
+	}
+	return dluo
+}
+
+// SetReplayedAt sets the "replayed_at" field.
+func (dluo *DeadLetterUpdateOne) SetReplayedAt(t time.Time) *DeadLetterUpdateOne {
+	dluo.mutation.SetReplayedAt(t)
+	return dluo
+}
+
+// SetNillableReplayedAt sets the "replayed_at" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableReplayedAt(t *time.Time) *DeadLetterUpdateOne {
+	if t != nil {
+		dluo.SetReplayedAt(*t)
+	}
+	return dluo
+}
+
+// ClearReplayedAt clears the value of the "replayed_at" field.
+func (dluo *DeadLetterUpdateOne) ClearReplayedAt() *DeadLetterUpdateOne {
+	dluo.mutation.ClearReplayedAt()
+	return dluo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dluo *DeadLetterUpdateOne) SetUpdatedAt(t time.Time) *DeadLetterUpdateOne {
+	dluo.mutation.SetUpdatedAt(t)
+	return dluo
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (dluo *DeadLetterUpdateOne) Mutation() *DeadLetterMutation {
+	return dluo.mutation
+}
+
+// Where appends a list predicates to the DeadLetterUpdate builder.
+func (dluo *DeadLetterUpdateOne) Where(ps ...predicate.DeadLetter) *DeadLetterUpdateOne {
+	dluo.mutation.Where(ps...)
+	return dluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dluo *DeadLetterUpdateOne) Select(field string, fields ...string) *DeadLetterUpdateOne {
+	dluo.fields = append([]string{field}, fields...)
+	return dluo
+}
+
+// Save executes the query and returns the updated DeadLetter entity.
+func (dluo *DeadLetterUpdateOne) Save(ctx context.Context) (*DeadLetter, error) {
+	dluo.defaults()
+	return withHooks(ctx, dluo.sqlSave, dluo.mutation, dluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dluo *DeadLetterUpdateOne) SaveX(ctx context.Context) *DeadLetter {
+	node, err := dluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dluo *DeadLetterUpdateOne) Exec(ctx context.Context) error {
+	_, err := dluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dluo *DeadLetterUpdateOne) ExecX(ctx context.Context) {
+	if err := dluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dluo *DeadLetterUpdateOne) defaults() {
+	if _, ok := dluo.mutation.UpdatedAt(); !ok {
+		v := deadletter.UpdateDefaultUpdatedAt()
+		dluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dluo *DeadLetterUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeadLetterUpdateOne {
+	dluo.modifiers = append(dluo.modifiers, modifiers...)
+	return dluo
+}
+
+func (dluo *DeadLetterUpdateOne) sqlSave(ctx context.Context) (_node *DeadLetter, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deadletter.Table, deadletter.Columns, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeUUID))
+	id, ok := dluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeadLetter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deadletter.FieldID)
+		for _, f := range fields {
+			if !deadletter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deadletter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dluo.mutation.JobID(); ok {
+		_spec.SetField(deadletter.FieldJobID, field.TypeUUID, value)
+	}
+	if value, ok := dluo.mutation.ProcessType(); ok {
+		_spec.SetField(deadletter.FieldProcessType, field.TypeString, value)
+	}
+	if value, ok := dluo.mutation.UserID(); ok {
+		_spec.SetField(deadletter.FieldUserID, field.TypeUUID, value)
+	}
+	if dluo.mutation.UserIDCleared() {
+		_spec.ClearField(deadletter.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := dluo.mutation.Payload(); ok {
+		_spec.SetField(deadletter.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := dluo.mutation.FailureReason(); ok {
+		_spec.SetField(deadletter.FieldFailureReason, field.TypeString, value)
+	}
+	if value, ok := dluo.mutation.ReplayedAt(); ok {
+		_spec.SetField(deadletter.FieldReplayedAt, field.TypeTime, value)
+	}
+	if dluo.mutation.ReplayedAtCleared() {
+		_spec.ClearField(deadletter.FieldReplayedAt, field.TypeTime)
+	}
+	if value, ok := dluo.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(dluo.modifiers...)
+	_node = &DeadLetter{config: dluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dluo.mutation.done = true
+	return _node, nil
+}
@@ -13,17 +13,25 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/stablecog/sc-go/database/ent/apitoken"
+	"github.com/stablecog/sc-go/database/ent/apitokenusage"
 	"github.com/stablecog/sc-go/database/ent/authclient"
 	"github.com/stablecog/sc-go/database/ent/bannedwords"
 	"github.com/stablecog/sc-go/database/ent/credit"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/credittransaction"
 	"github.com/stablecog/sc-go/database/ent/credittype"
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
+	"github.com/stablecog/sc-go/database/ent/deadletter"
 	"github.com/stablecog/sc-go/database/ent/deviceinfo"
 	"github.com/stablecog/sc-go/database/ent/disposableemail"
 	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
 	"github.com/stablecog/sc-go/database/ent/generationmodel"
 	"github.com/stablecog/sc-go/database/ent/generationoutput"
 	"github.com/stablecog/sc-go/database/ent/generationoutputlike"
+	"github.com/stablecog/sc-go/database/ent/generationpreset"
 	"github.com/stablecog/sc-go/database/ent/ipblacklist"
+	"github.com/stablecog/sc-go/database/ent/moderationverdict"
 	"github.com/stablecog/sc-go/database/ent/mqlog"
 	"github.com/stablecog/sc-go/database/ent/negativeprompt"
 	"github.com/stablecog/sc-go/database/ent/prompt"
@@ -101,17 +109,25 @@ func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			apitoken.Table:             apitoken.ValidColumn,
+			apitokenusage.Table:        apitokenusage.ValidColumn,
 			authclient.Table:           authclient.ValidColumn,
 			bannedwords.Table:          bannedwords.ValidColumn,
 			credit.Table:               credit.ValidColumn,
+			credithold.Table:           credithold.ValidColumn,
+			credittransaction.Table:    credittransaction.ValidColumn,
 			credittype.Table:           credittype.ValidColumn,
+			cronjobrun.Table:           cronjobrun.ValidColumn,
+			deadletter.Table:           deadletter.ValidColumn,
 			deviceinfo.Table:           deviceinfo.ValidColumn,
 			disposableemail.Table:      disposableemail.ValidColumn,
 			generation.Table:           generation.ValidColumn,
+			generationbatch.Table:      generationbatch.ValidColumn,
 			generationmodel.Table:      generationmodel.ValidColumn,
 			generationoutput.Table:     generationoutput.ValidColumn,
 			generationoutputlike.Table: generationoutputlike.ValidColumn,
+			generationpreset.Table:     generationpreset.ValidColumn,
 			ipblacklist.Table:          ipblacklist.ValidColumn,
+			moderationverdict.Table:    moderationverdict.ValidColumn,
 			mqlog.Table:                mqlog.ValidColumn,
 			negativeprompt.Table:       negativeprompt.ValidColumn,
 			prompt.Table:               prompt.ValidColumn,
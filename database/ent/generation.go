@@ -74,6 +74,12 @@ type Generation struct {
 	DeviceInfoID uuid.UUID `json:"device_info_id,omitempty"`
 	// APITokenID holds the value of the "api_token_id" field.
 	APITokenID *uuid.UUID `json:"api_token_id,omitempty"`
+	// BatchID holds the value of the "batch_id" field.
+	BatchID *uuid.UUID `json:"batch_id,omitempty"`
+	// SourceOutputID holds the value of the "source_output_id" field.
+	SourceOutputID *uuid.UUID `json:"source_output_id,omitempty"`
+	// RequeuedAt holds the value of the "requeued_at" field.
+	RequeuedAt *time.Time `json:"requeued_at,omitempty"`
 	// StartedAt holds the value of the "started_at" field.
 	StartedAt *time.Time `json:"started_at,omitempty"`
 	// CompletedAt holds the value of the "completed_at" field.
@@ -202,7 +208,7 @@ func (*Generation) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case generation.FieldPromptID, generation.FieldNegativePromptID, generation.FieldAPITokenID:
+		case generation.FieldPromptID, generation.FieldNegativePromptID, generation.FieldAPITokenID, generation.FieldBatchID, generation.FieldSourceOutputID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case generation.FieldWasAutoSubmitted:
 			values[i] = new(sql.NullBool)
@@ -212,7 +218,7 @@ func (*Generation) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case generation.FieldStatus, generation.FieldFailureReason, generation.FieldCountryCode, generation.FieldInitImageURL, generation.FieldMaskImageURL, generation.FieldStripeProductID, generation.FieldSourceType:
 			values[i] = new(sql.NullString)
-		case generation.FieldStartedAt, generation.FieldCompletedAt, generation.FieldCreatedAt, generation.FieldUpdatedAt:
+		case generation.FieldRequeuedAt, generation.FieldStartedAt, generation.FieldCompletedAt, generation.FieldCreatedAt, generation.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case generation.FieldID, generation.FieldWebhookToken, generation.FieldModelID, generation.FieldSchedulerID, generation.FieldUserID, generation.FieldDeviceInfoID:
 			values[i] = new(uuid.UUID)
@@ -390,6 +396,27 @@ func (ge *Generation) assignValues(columns []string, values []any) error {
 				ge.APITokenID = new(uuid.UUID)
 				*ge.APITokenID = *value.S.(*uuid.UUID)
 			}
+		case generation.FieldBatchID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_id", values[i])
+			} else if value.Valid {
+				ge.BatchID = new(uuid.UUID)
+				*ge.BatchID = *value.S.(*uuid.UUID)
+			}
+		case generation.FieldSourceOutputID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field source_output_id", values[i])
+			} else if value.Valid {
+				ge.SourceOutputID = new(uuid.UUID)
+				*ge.SourceOutputID = *value.S.(*uuid.UUID)
+			}
+		case generation.FieldRequeuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field requeued_at", values[i])
+			} else if value.Valid {
+				ge.RequeuedAt = new(time.Time)
+				*ge.RequeuedAt = value.Time
+			}
 		case generation.FieldStartedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field started_at", values[i])
@@ -582,6 +609,21 @@ func (ge *Generation) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := ge.BatchID; v != nil {
+		builder.WriteString("batch_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ge.SourceOutputID; v != nil {
+		builder.WriteString("source_output_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ge.RequeuedAt; v != nil {
+		builder.WriteString("requeued_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := ge.StartedAt; v != nil {
 		builder.WriteString("started_at=")
 		builder.WriteString(v.Format(time.ANSIC))
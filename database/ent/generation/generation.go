@@ -65,6 +65,12 @@ const (
 	FieldDeviceInfoID = "device_info_id"
 	// FieldAPITokenID holds the string denoting the api_token_id field in the database.
 	FieldAPITokenID = "api_token_id"
+	// FieldBatchID holds the string denoting the batch_id field in the database.
+	FieldBatchID = "batch_id"
+	// FieldSourceOutputID holds the string denoting the source_output_id field in the database.
+	FieldSourceOutputID = "source_output_id"
+	// FieldRequeuedAt holds the string denoting the requeued_at field in the database.
+	FieldRequeuedAt = "requeued_at"
 	// FieldStartedAt holds the string denoting the started_at field in the database.
 	FieldStartedAt = "started_at"
 	// FieldCompletedAt holds the string denoting the completed_at field in the database.
@@ -176,6 +182,9 @@ var Columns = []string{
 	FieldUserID,
 	FieldDeviceInfoID,
 	FieldAPITokenID,
+	FieldBatchID,
+	FieldSourceOutputID,
+	FieldRequeuedAt,
 	FieldStartedAt,
 	FieldCompletedAt,
 	FieldCreatedAt,
@@ -374,6 +383,21 @@ func ByAPITokenID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAPITokenID, opts...).ToFunc()
 }
 
+// ByBatchID orders the results by the batch_id field.
+func ByBatchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchID, opts...).ToFunc()
+}
+
+// BySourceOutputID orders the results by the source_output_id field.
+func BySourceOutputID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceOutputID, opts...).ToFunc()
+}
+
+// ByRequeuedAt orders the results by the requeued_at field.
+func ByRequeuedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequeuedAt, opts...).ToFunc()
+}
+
 // ByStartedAt orders the results by the started_at field.
 func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
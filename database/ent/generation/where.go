@@ -167,6 +167,21 @@ func APITokenID(v uuid.UUID) predicate.Generation {
 	return predicate.Generation(sql.FieldEQ(FieldAPITokenID, v))
 }
 
+// BatchID applies equality check predicate on the "batch_id" field. It's identical to BatchIDEQ.
+func BatchID(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldEQ(FieldBatchID, v))
+}
+
+// SourceOutputID applies equality check predicate on the "source_output_id" field. It's identical to SourceOutputIDEQ.
+func SourceOutputID(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldEQ(FieldSourceOutputID, v))
+}
+
+// RequeuedAt applies equality check predicate on the "requeued_at" field. It's identical to RequeuedAtEQ.
+func RequeuedAt(v time.Time) predicate.Generation {
+	return predicate.Generation(sql.FieldEQ(FieldRequeuedAt, v))
+}
+
 // StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
 func StartedAt(v time.Time) predicate.Generation {
 	return predicate.Generation(sql.FieldEQ(FieldStartedAt, v))
@@ -1162,6 +1177,156 @@ func APITokenIDNotNil() predicate.Generation {
 	return predicate.Generation(sql.FieldNotNull(FieldAPITokenID))
 }
 
+// BatchIDEQ applies the EQ predicate on the "batch_id" field.
+func BatchIDEQ(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldEQ(FieldBatchID, v))
+}
+
+// BatchIDNEQ applies the NEQ predicate on the "batch_id" field.
+func BatchIDNEQ(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldNEQ(FieldBatchID, v))
+}
+
+// BatchIDIn applies the In predicate on the "batch_id" field.
+func BatchIDIn(vs ...uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldIn(FieldBatchID, vs...))
+}
+
+// BatchIDNotIn applies the NotIn predicate on the "batch_id" field.
+func BatchIDNotIn(vs ...uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldNotIn(FieldBatchID, vs...))
+}
+
+// BatchIDGT applies the GT predicate on the "batch_id" field.
+func BatchIDGT(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldGT(FieldBatchID, v))
+}
+
+// BatchIDGTE applies the GTE predicate on the "batch_id" field.
+func BatchIDGTE(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldGTE(FieldBatchID, v))
+}
+
+// BatchIDLT applies the LT predicate on the "batch_id" field.
+func BatchIDLT(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldLT(FieldBatchID, v))
+}
+
+// BatchIDLTE applies the LTE predicate on the "batch_id" field.
+func BatchIDLTE(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldLTE(FieldBatchID, v))
+}
+
+// BatchIDIsNil applies the IsNil predicate on the "batch_id" field.
+func BatchIDIsNil() predicate.Generation {
+	return predicate.Generation(sql.FieldIsNull(FieldBatchID))
+}
+
+// BatchIDNotNil applies the NotNil predicate on the "batch_id" field.
+func BatchIDNotNil() predicate.Generation {
+	return predicate.Generation(sql.FieldNotNull(FieldBatchID))
+}
+
+// SourceOutputIDEQ applies the EQ predicate on the "source_output_id" field.
+func SourceOutputIDEQ(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldEQ(FieldSourceOutputID, v))
+}
+
+// SourceOutputIDNEQ applies the NEQ predicate on the "source_output_id" field.
+func SourceOutputIDNEQ(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldNEQ(FieldSourceOutputID, v))
+}
+
+// SourceOutputIDIn applies the In predicate on the "source_output_id" field.
+func SourceOutputIDIn(vs ...uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldIn(FieldSourceOutputID, vs...))
+}
+
+// SourceOutputIDNotIn applies the NotIn predicate on the "source_output_id" field.
+func SourceOutputIDNotIn(vs ...uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldNotIn(FieldSourceOutputID, vs...))
+}
+
+// SourceOutputIDGT applies the GT predicate on the "source_output_id" field.
+func SourceOutputIDGT(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldGT(FieldSourceOutputID, v))
+}
+
+// SourceOutputIDGTE applies the GTE predicate on the "source_output_id" field.
+func SourceOutputIDGTE(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldGTE(FieldSourceOutputID, v))
+}
+
+// SourceOutputIDLT applies the LT predicate on the "source_output_id" field.
+func SourceOutputIDLT(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldLT(FieldSourceOutputID, v))
+}
+
+// SourceOutputIDLTE applies the LTE predicate on the "source_output_id" field.
+func SourceOutputIDLTE(v uuid.UUID) predicate.Generation {
+	return predicate.Generation(sql.FieldLTE(FieldSourceOutputID, v))
+}
+
+// SourceOutputIDIsNil applies the IsNil predicate on the "source_output_id" field.
+func SourceOutputIDIsNil() predicate.Generation {
+	return predicate.Generation(sql.FieldIsNull(FieldSourceOutputID))
+}
+
+// SourceOutputIDNotNil applies the NotNil predicate on the "source_output_id" field.
+func SourceOutputIDNotNil() predicate.Generation {
+	return predicate.Generation(sql.FieldNotNull(FieldSourceOutputID))
+}
+
+// RequeuedAtEQ applies the EQ predicate on the "requeued_at" field.
+func RequeuedAtEQ(v time.Time) predicate.Generation {
+	return predicate.Generation(sql.FieldEQ(FieldRequeuedAt, v))
+}
+
+// RequeuedAtNEQ applies the NEQ predicate on the "requeued_at" field.
+func RequeuedAtNEQ(v time.Time) predicate.Generation {
+	return predicate.Generation(sql.FieldNEQ(FieldRequeuedAt, v))
+}
+
+// RequeuedAtIn applies the In predicate on the "requeued_at" field.
+func RequeuedAtIn(vs ...time.Time) predicate.Generation {
+	return predicate.Generation(sql.FieldIn(FieldRequeuedAt, vs...))
+}
+
+// RequeuedAtNotIn applies the NotIn predicate on the "requeued_at" field.
+func RequeuedAtNotIn(vs ...time.Time) predicate.Generation {
+	return predicate.Generation(sql.FieldNotIn(FieldRequeuedAt, vs...))
+}
+
+// RequeuedAtGT applies the GT predicate on the "requeued_at" field.
+func RequeuedAtGT(v time.Time) predicate.Generation {
+	return predicate.Generation(sql.FieldGT(FieldRequeuedAt, v))
+}
+
+// RequeuedAtGTE applies the GTE predicate on the "requeued_at" field.
+func RequeuedAtGTE(v time.Time) predicate.Generation {
+	return predicate.Generation(sql.FieldGTE(FieldRequeuedAt, v))
+}
+
+// RequeuedAtLT applies the LT predicate on the "requeued_at" field.
+func RequeuedAtLT(v time.Time) predicate.Generation {
+	return predicate.Generation(sql.FieldLT(FieldRequeuedAt, v))
+}
+
+// RequeuedAtLTE applies the LTE predicate on the "requeued_at" field.
+func RequeuedAtLTE(v time.Time) predicate.Generation {
+	return predicate.Generation(sql.FieldLTE(FieldRequeuedAt, v))
+}
+
+// RequeuedAtIsNil applies the IsNil predicate on the "requeued_at" field.
+func RequeuedAtIsNil() predicate.Generation {
+	return predicate.Generation(sql.FieldIsNull(FieldRequeuedAt))
+}
+
+// RequeuedAtNotNil applies the NotNil predicate on the "requeued_at" field.
+func RequeuedAtNotNil() predicate.Generation {
+	return predicate.Generation(sql.FieldNotNull(FieldRequeuedAt))
+}
+
 // StartedAtEQ applies the EQ predicate on the "started_at" field.
 func StartedAtEQ(v time.Time) predicate.Generation {
 	return predicate.Generation(sql.FieldEQ(FieldStartedAt, v))
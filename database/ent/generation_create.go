@@ -281,6 +281,48 @@ func (gc *GenerationCreate) SetNillableAPITokenID(u *uuid.UUID) *GenerationCreat
 	return gc
 }
 
+// SetBatchID sets the "batch_id" field.
+func (gc *GenerationCreate) SetBatchID(u uuid.UUID) *GenerationCreate {
+	gc.mutation.SetBatchID(u)
+	return gc
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (gc *GenerationCreate) SetNillableBatchID(u *uuid.UUID) *GenerationCreate {
+	if u != nil {
+		gc.SetBatchID(*u)
+	}
+	return gc
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (gc *GenerationCreate) SetSourceOutputID(u uuid.UUID) *GenerationCreate {
+	gc.mutation.SetSourceOutputID(u)
+	return gc
+}
+
+// SetNillableSourceOutputID sets the "source_output_id" field if the given value is not nil.
+func (gc *GenerationCreate) SetNillableSourceOutputID(u *uuid.UUID) *GenerationCreate {
+	if u != nil {
+		gc.SetSourceOutputID(*u)
+	}
+	return gc
+}
+
+// SetRequeuedAt sets the "requeued_at" field.
+func (gc *GenerationCreate) SetRequeuedAt(t time.Time) *GenerationCreate {
+	gc.mutation.SetRequeuedAt(t)
+	return gc
+}
+
+// SetNillableRequeuedAt sets the "requeued_at" field if the given value is not nil.
+func (gc *GenerationCreate) SetNillableRequeuedAt(t *time.Time) *GenerationCreate {
+	if t != nil {
+		gc.SetRequeuedAt(*t)
+	}
+	return gc
+}
+
 // SetStartedAt sets the "started_at" field.
 func (gc *GenerationCreate) SetStartedAt(t time.Time) *GenerationCreate {
 	gc.mutation.SetStartedAt(t)
@@ -665,6 +707,18 @@ func (gc *GenerationCreate) createSpec() (*Generation, *sqlgraph.CreateSpec) {
 		_spec.SetField(generation.FieldWebhookToken, field.TypeUUID, value)
 		_node.WebhookToken = value
 	}
+	if value, ok := gc.mutation.BatchID(); ok {
+		_spec.SetField(generation.FieldBatchID, field.TypeUUID, value)
+		_node.BatchID = &value
+	}
+	if value, ok := gc.mutation.SourceOutputID(); ok {
+		_spec.SetField(generation.FieldSourceOutputID, field.TypeUUID, value)
+		_node.SourceOutputID = &value
+	}
+	if value, ok := gc.mutation.RequeuedAt(); ok {
+		_spec.SetField(generation.FieldRequeuedAt, field.TypeTime, value)
+		_node.RequeuedAt = &value
+	}
 	if value, ok := gc.mutation.StartedAt(); ok {
 		_spec.SetField(generation.FieldStartedAt, field.TypeTime, value)
 		_node.StartedAt = &value
@@ -1258,6 +1312,60 @@ func (u *GenerationUpsert) ClearAPITokenID() *GenerationUpsert {
 	return u
 }
 
+// SetBatchID sets the "batch_id" field.
+func (u *GenerationUpsert) SetBatchID(v uuid.UUID) *GenerationUpsert {
+	u.Set(generation.FieldBatchID, v)
+	return u
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *GenerationUpsert) UpdateBatchID() *GenerationUpsert {
+	u.SetExcluded(generation.FieldBatchID)
+	return u
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (u *GenerationUpsert) ClearBatchID() *GenerationUpsert {
+	u.SetNull(generation.FieldBatchID)
+	return u
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (u *GenerationUpsert) SetSourceOutputID(v uuid.UUID) *GenerationUpsert {
+	u.Set(generation.FieldSourceOutputID, v)
+	return u
+}
+
+// UpdateSourceOutputID sets the "source_output_id" field to the value that was provided on create.
+func (u *GenerationUpsert) UpdateSourceOutputID() *GenerationUpsert {
+	u.SetExcluded(generation.FieldSourceOutputID)
+	return u
+}
+
+// ClearSourceOutputID clears the value of the "source_output_id" field.
+func (u *GenerationUpsert) ClearSourceOutputID() *GenerationUpsert {
+	u.SetNull(generation.FieldSourceOutputID)
+	return u
+}
+
+// SetRequeuedAt sets the "requeued_at" field.
+func (u *GenerationUpsert) SetRequeuedAt(v time.Time) *GenerationUpsert {
+	u.Set(generation.FieldRequeuedAt, v)
+	return u
+}
+
+// UpdateRequeuedAt sets the "requeued_at" field to the value that was provided on create.
+func (u *GenerationUpsert) UpdateRequeuedAt() *GenerationUpsert {
+	u.SetExcluded(generation.FieldRequeuedAt)
+	return u
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (u *GenerationUpsert) ClearRequeuedAt() *GenerationUpsert {
+	u.SetNull(generation.FieldRequeuedAt)
+	return u
+}
+
 // SetStartedAt sets the "started_at" field.
 func (u *GenerationUpsert) SetStartedAt(v time.Time) *GenerationUpsert {
 	u.Set(generation.FieldStartedAt, v)
@@ -1812,6 +1920,69 @@ func (u *GenerationUpsertOne) ClearAPITokenID() *GenerationUpsertOne {
 	})
 }
 
+// SetBatchID sets the "batch_id" field.
+func (u *GenerationUpsertOne) SetBatchID(v uuid.UUID) *GenerationUpsertOne {
+	return u.Update(func(s *GenerationUpsert) {
+		s.SetBatchID(v)
+	})
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *GenerationUpsertOne) UpdateBatchID() *GenerationUpsertOne {
+	return u.Update(func(s *GenerationUpsert) {
+		s.UpdateBatchID()
+	})
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (u *GenerationUpsertOne) ClearBatchID() *GenerationUpsertOne {
+	return u.Update(func(s *GenerationUpsert) {
+		s.ClearBatchID()
+	})
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (u *GenerationUpsertOne) SetSourceOutputID(v uuid.UUID) *GenerationUpsertOne {
+	return u.Update(func(s *GenerationUpsert) {
+		s.SetSourceOutputID(v)
+	})
+}
+
+// UpdateSourceOutputID sets the "source_output_id" field to the value that was provided on create.
+func (u *GenerationUpsertOne) UpdateSourceOutputID() *GenerationUpsertOne {
+	return u.Update(func(s *GenerationUpsert) {
+		s.UpdateSourceOutputID()
+	})
+}
+
+// ClearSourceOutputID clears the value of the "source_output_id" field.
+func (u *GenerationUpsertOne) ClearSourceOutputID() *GenerationUpsertOne {
+	return u.Update(func(s *GenerationUpsert) {
+		s.ClearSourceOutputID()
+	})
+}
+
+// SetRequeuedAt sets the "requeued_at" field.
+func (u *GenerationUpsertOne) SetRequeuedAt(v time.Time) *GenerationUpsertOne {
+	return u.Update(func(s *GenerationUpsert) {
+		s.SetRequeuedAt(v)
+	})
+}
+
+// UpdateRequeuedAt sets the "requeued_at" field to the value that was provided on create.
+func (u *GenerationUpsertOne) UpdateRequeuedAt() *GenerationUpsertOne {
+	return u.Update(func(s *GenerationUpsert) {
+		s.UpdateRequeuedAt()
+	})
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (u *GenerationUpsertOne) ClearRequeuedAt() *GenerationUpsertOne {
+	return u.Update(func(s *GenerationUpsert) {
+		s.ClearRequeuedAt()
+	})
+}
+
 // SetStartedAt sets the "started_at" field.
 func (u *GenerationUpsertOne) SetStartedAt(v time.Time) *GenerationUpsertOne {
 	return u.Update(func(s *GenerationUpsert) {
@@ -2541,6 +2712,69 @@ func (u *GenerationUpsertBulk) ClearAPITokenID() *GenerationUpsertBulk {
 	})
 }
 
+// SetBatchID sets the "batch_id" field.
+func (u *GenerationUpsertBulk) SetBatchID(v uuid.UUID) *GenerationUpsertBulk {
+	return u.Update(func(s *GenerationUpsert) {
+		s.SetBatchID(v)
+	})
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *GenerationUpsertBulk) UpdateBatchID() *GenerationUpsertBulk {
+	return u.Update(func(s *GenerationUpsert) {
+		s.UpdateBatchID()
+	})
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (u *GenerationUpsertBulk) ClearBatchID() *GenerationUpsertBulk {
+	return u.Update(func(s *GenerationUpsert) {
+		s.ClearBatchID()
+	})
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (u *GenerationUpsertBulk) SetSourceOutputID(v uuid.UUID) *GenerationUpsertBulk {
+	return u.Update(func(s *GenerationUpsert) {
+		s.SetSourceOutputID(v)
+	})
+}
+
+// UpdateSourceOutputID sets the "source_output_id" field to the value that was provided on create.
+func (u *GenerationUpsertBulk) UpdateSourceOutputID() *GenerationUpsertBulk {
+	return u.Update(func(s *GenerationUpsert) {
+		s.UpdateSourceOutputID()
+	})
+}
+
+// ClearSourceOutputID clears the value of the "source_output_id" field.
+func (u *GenerationUpsertBulk) ClearSourceOutputID() *GenerationUpsertBulk {
+	return u.Update(func(s *GenerationUpsert) {
+		s.ClearSourceOutputID()
+	})
+}
+
+// SetRequeuedAt sets the "requeued_at" field.
+func (u *GenerationUpsertBulk) SetRequeuedAt(v time.Time) *GenerationUpsertBulk {
+	return u.Update(func(s *GenerationUpsert) {
+		s.SetRequeuedAt(v)
+	})
+}
+
+// UpdateRequeuedAt sets the "requeued_at" field to the value that was provided on create.
+func (u *GenerationUpsertBulk) UpdateRequeuedAt() *GenerationUpsertBulk {
+	return u.Update(func(s *GenerationUpsert) {
+		s.UpdateRequeuedAt()
+	})
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (u *GenerationUpsertBulk) ClearRequeuedAt() *GenerationUpsertBulk {
+	return u.Update(func(s *GenerationUpsert) {
+		s.ClearRequeuedAt()
+	})
+}
+
 // SetStartedAt sets the "started_at" field.
 func (u *GenerationUpsertBulk) SetStartedAt(v time.Time) *GenerationUpsertBulk {
 	return u.Update(func(s *GenerationUpsert) {
@@ -485,6 +485,66 @@ func (gu *GenerationUpdate) ClearAPITokenID() *GenerationUpdate {
 	return gu
 }
 
+// SetBatchID sets the "batch_id" field.
+func (gu *GenerationUpdate) SetBatchID(u uuid.UUID) *GenerationUpdate {
+	gu.mutation.SetBatchID(u)
+	return gu
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (gu *GenerationUpdate) SetNillableBatchID(u *uuid.UUID) *GenerationUpdate {
+	if u != nil {
+		gu.SetBatchID(*u)
+	}
+	return gu
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (gu *GenerationUpdate) ClearBatchID() *GenerationUpdate {
+	gu.mutation.ClearBatchID()
+	return gu
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (gu *GenerationUpdate) SetSourceOutputID(u uuid.UUID) *GenerationUpdate {
+	gu.mutation.SetSourceOutputID(u)
+	return gu
+}
+
+// SetNillableSourceOutputID sets the "source_output_id" field if the given value is not nil.
+func (gu *GenerationUpdate) SetNillableSourceOutputID(u *uuid.UUID) *GenerationUpdate {
+	if u != nil {
+		gu.SetSourceOutputID(*u)
+	}
+	return gu
+}
+
+// ClearSourceOutputID clears the value of the "source_output_id" field.
+func (gu *GenerationUpdate) ClearSourceOutputID() *GenerationUpdate {
+	gu.mutation.ClearSourceOutputID()
+	return gu
+}
+
+// SetRequeuedAt sets the "requeued_at" field.
+func (gu *GenerationUpdate) SetRequeuedAt(t time.Time) *GenerationUpdate {
+	gu.mutation.SetRequeuedAt(t)
+	return gu
+}
+
+// SetNillableRequeuedAt sets the "requeued_at" field if the given value is not nil.
+func (gu *GenerationUpdate) SetNillableRequeuedAt(t *time.Time) *GenerationUpdate {
+	if t != nil {
+		gu.SetRequeuedAt(*t)
+	}
+	return gu
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (gu *GenerationUpdate) ClearRequeuedAt() *GenerationUpdate {
+	gu.mutation.ClearRequeuedAt()
+	return gu
+}
+
 // SetStartedAt sets the "started_at" field.
 func (gu *GenerationUpdate) SetStartedAt(t time.Time) *GenerationUpdate {
 	gu.mutation.SetStartedAt(t)
@@ -843,6 +903,24 @@ func (gu *GenerationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := gu.mutation.WebhookToken(); ok {
 		_spec.SetField(generation.FieldWebhookToken, field.TypeUUID, value)
 	}
+	if value, ok := gu.mutation.BatchID(); ok {
+		_spec.SetField(generation.FieldBatchID, field.TypeUUID, value)
+	}
+	if gu.mutation.BatchIDCleared() {
+		_spec.ClearField(generation.FieldBatchID, field.TypeUUID)
+	}
+	if value, ok := gu.mutation.SourceOutputID(); ok {
+		_spec.SetField(generation.FieldSourceOutputID, field.TypeUUID, value)
+	}
+	if gu.mutation.SourceOutputIDCleared() {
+		_spec.ClearField(generation.FieldSourceOutputID, field.TypeUUID)
+	}
+	if value, ok := gu.mutation.RequeuedAt(); ok {
+		_spec.SetField(generation.FieldRequeuedAt, field.TypeTime, value)
+	}
+	if gu.mutation.RequeuedAtCleared() {
+		_spec.ClearField(generation.FieldRequeuedAt, field.TypeTime)
+	}
 	if value, ok := gu.mutation.StartedAt(); ok {
 		_spec.SetField(generation.FieldStartedAt, field.TypeTime, value)
 	}
@@ -1574,6 +1652,66 @@ func (guo *GenerationUpdateOne) ClearAPITokenID() *GenerationUpdateOne {
 	return guo
 }
 
+// SetBatchID sets the "batch_id" field.
+func (guo *GenerationUpdateOne) SetBatchID(u uuid.UUID) *GenerationUpdateOne {
+	guo.mutation.SetBatchID(u)
+	return guo
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (guo *GenerationUpdateOne) SetNillableBatchID(u *uuid.UUID) *GenerationUpdateOne {
+	if u != nil {
+		guo.SetBatchID(*u)
+	}
+	return guo
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (guo *GenerationUpdateOne) ClearBatchID() *GenerationUpdateOne {
+	guo.mutation.ClearBatchID()
+	return guo
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (guo *GenerationUpdateOne) SetSourceOutputID(u uuid.UUID) *GenerationUpdateOne {
+	guo.mutation.SetSourceOutputID(u)
+	return guo
+}
+
+// SetNillableSourceOutputID sets the "source_output_id" field if the given value is not nil.
+func (guo *GenerationUpdateOne) SetNillableSourceOutputID(u *uuid.UUID) *GenerationUpdateOne {
+	if u != nil {
+		guo.SetSourceOutputID(*u)
+	}
+	return guo
+}
+
+// ClearSourceOutputID clears the value of the "source_output_id" field.
+func (guo *GenerationUpdateOne) ClearSourceOutputID() *GenerationUpdateOne {
+	guo.mutation.ClearSourceOutputID()
+	return guo
+}
+
+// SetRequeuedAt sets the "requeued_at" field.
+func (guo *GenerationUpdateOne) SetRequeuedAt(t time.Time) *GenerationUpdateOne {
+	guo.mutation.SetRequeuedAt(t)
+	return guo
+}
+
+// SetNillableRequeuedAt sets the "requeued_at" field if the given value is not nil.
+func (guo *GenerationUpdateOne) SetNillableRequeuedAt(t *time.Time) *GenerationUpdateOne {
+	if t != nil {
+		guo.SetRequeuedAt(*t)
+	}
+	return guo
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (guo *GenerationUpdateOne) ClearRequeuedAt() *GenerationUpdateOne {
+	guo.mutation.ClearRequeuedAt()
+	return guo
+}
+
 // SetStartedAt sets the "started_at" field.
 func (guo *GenerationUpdateOne) SetStartedAt(t time.Time) *GenerationUpdateOne {
 	guo.mutation.SetStartedAt(t)
@@ -1962,6 +2100,24 @@ func (guo *GenerationUpdateOne) sqlSave(ctx context.Context) (_node *Generation,
 	if value, ok := guo.mutation.WebhookToken(); ok {
 		_spec.SetField(generation.FieldWebhookToken, field.TypeUUID, value)
 	}
+	if value, ok := guo.mutation.BatchID(); ok {
+		_spec.SetField(generation.FieldBatchID, field.TypeUUID, value)
+	}
+	if guo.mutation.BatchIDCleared() {
+		_spec.ClearField(generation.FieldBatchID, field.TypeUUID)
+	}
+	if value, ok := guo.mutation.SourceOutputID(); ok {
+		_spec.SetField(generation.FieldSourceOutputID, field.TypeUUID, value)
+	}
+	if guo.mutation.SourceOutputIDCleared() {
+		_spec.ClearField(generation.FieldSourceOutputID, field.TypeUUID)
+	}
+	if value, ok := guo.mutation.RequeuedAt(); ok {
+		_spec.SetField(generation.FieldRequeuedAt, field.TypeTime, value)
+	}
+	if guo.mutation.RequeuedAtCleared() {
+		_spec.ClearField(generation.FieldRequeuedAt, field.TypeTime)
+	}
 	if value, ok := guo.mutation.StartedAt(); ok {
 		_spec.SetField(generation.FieldStartedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
+	"github.com/stablecog/sc-go/database/enttypes"
+)
+
+// GenerationBatch is the model entity for the GenerationBatch schema.
+type GenerationBatch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// APITokenID holds the value of the "api_token_id" field.
+	APITokenID *uuid.UUID `json:"api_token_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status generationbatch.Status `json:"status,omitempty"`
+	// Items holds the value of the "items" field.
+	Items string `json:"items,omitempty"`
+	// NumItems holds the value of the "num_items" field.
+	NumItems int `json:"num_items,omitempty"`
+	// DispatchedItems holds the value of the "dispatched_items" field.
+	DispatchedItems int `json:"dispatched_items,omitempty"`
+	// RejectedItems holds the value of the "rejected_items" field.
+	RejectedItems int `json:"rejected_items,omitempty"`
+	// CreditsReserved holds the value of the "credits_reserved" field.
+	CreditsReserved int32 `json:"credits_reserved,omitempty"`
+	// CreditsRefunded holds the value of the "credits_refunded" field.
+	CreditsRefunded int32 `json:"credits_refunded,omitempty"`
+	// Free holds the value of the "free" field.
+	Free bool `json:"free,omitempty"`
+	// QueueTier holds the value of the "queue_tier" field.
+	QueueTier string `json:"queue_tier,omitempty"`
+	// SourceOutputID holds the value of the "source_output_id" field.
+	SourceOutputID *uuid.UUID `json:"source_output_id,omitempty"`
+	// VariationType holds the value of the "variation_type" field.
+	VariationType *generationbatch.VariationType `json:"variation_type,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin *enttypes.RequestOrigin `json:"origin,omitempty"`
+	// DispatchedAt holds the value of the "dispatched_at" field.
+	DispatchedAt *time.Time `json:"dispatched_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GenerationBatch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case generationbatch.FieldAPITokenID, generationbatch.FieldSourceOutputID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case generationbatch.FieldOrigin:
+			values[i] = new([]byte)
+		case generationbatch.FieldFree:
+			values[i] = new(sql.NullBool)
+		case generationbatch.FieldNumItems, generationbatch.FieldDispatchedItems, generationbatch.FieldRejectedItems, generationbatch.FieldCreditsReserved, generationbatch.FieldCreditsRefunded:
+			values[i] = new(sql.NullInt64)
+		case generationbatch.FieldStatus, generationbatch.FieldItems, generationbatch.FieldQueueTier, generationbatch.FieldVariationType:
+			values[i] = new(sql.NullString)
+		case generationbatch.FieldDispatchedAt, generationbatch.FieldCreatedAt, generationbatch.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case generationbatch.FieldID, generationbatch.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GenerationBatch fields.
+func (gb *GenerationBatch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case generationbatch.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				gb.ID = *value
+			}
+		case generationbatch.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				gb.UserID = *value
+			}
+		case generationbatch.FieldAPITokenID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field api_token_id", values[i])
+			} else if value.Valid {
+				gb.APITokenID = new(uuid.UUID)
+				*gb.APITokenID = *value.S.(*uuid.UUID)
+			}
+		case generationbatch.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				gb.Status = generationbatch.Status(value.String)
+			}
+		case generationbatch.FieldItems:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field items", values[i])
+			} else if value.Valid {
+				gb.Items = value.String
+			}
+		case generationbatch.FieldNumItems:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field num_items", values[i])
+			} else if value.Valid {
+				gb.NumItems = int(value.Int64)
+			}
+		case generationbatch.FieldDispatchedItems:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dispatched_items", values[i])
+			} else if value.Valid {
+				gb.DispatchedItems = int(value.Int64)
+			}
+		case generationbatch.FieldRejectedItems:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rejected_items", values[i])
+			} else if value.Valid {
+				gb.RejectedItems = int(value.Int64)
+			}
+		case generationbatch.FieldCreditsReserved:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credits_reserved", values[i])
+			} else if value.Valid {
+				gb.CreditsReserved = int32(value.Int64)
+			}
+		case generationbatch.FieldCreditsRefunded:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credits_refunded", values[i])
+			} else if value.Valid {
+				gb.CreditsRefunded = int32(value.Int64)
+			}
+		case generationbatch.FieldFree:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field free", values[i])
+			} else if value.Valid {
+				gb.Free = value.Bool
+			}
+		case generationbatch.FieldQueueTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field queue_tier", values[i])
+			} else if value.Valid {
+				gb.QueueTier = value.String
+			}
+		case generationbatch.FieldSourceOutputID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field source_output_id", values[i])
+			} else if value.Valid {
+				gb.SourceOutputID = new(uuid.UUID)
+				*gb.SourceOutputID = *value.S.(*uuid.UUID)
+			}
+		case generationbatch.FieldVariationType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variation_type", values[i])
+			} else if value.Valid {
+				gb.VariationType = new(generationbatch.VariationType)
+				*gb.VariationType = generationbatch.VariationType(value.String)
+			}
+		case generationbatch.FieldOrigin:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &gb.Origin); err != nil {
+					return fmt.Errorf("unmarshal field origin: %w", err)
+				}
+			}
+		case generationbatch.FieldDispatchedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field dispatched_at", values[i])
+			} else if value.Valid {
+				gb.DispatchedAt = new(time.Time)
+				*gb.DispatchedAt = value.Time
+			}
+		case generationbatch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				gb.CreatedAt = value.Time
+			}
+		case generationbatch.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				gb.UpdatedAt = value.Time
+			}
+		default:
+			gb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GenerationBatch.
+// This includes values selected through modifiers, order, etc.
+func (gb *GenerationBatch) Value(name string) (ent.Value, error) {
+	return gb.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GenerationBatch.
+// Note that you need to call GenerationBatch.Unwrap() before calling this method if this GenerationBatch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gb *GenerationBatch) Update() *GenerationBatchUpdateOne {
+	return NewGenerationBatchClient(gb.config).UpdateOne(gb)
+}
+
+// Unwrap unwraps the GenerationBatch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gb *GenerationBatch) Unwrap() *GenerationBatch {
+	_tx, ok := gb.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GenerationBatch is not a transactional entity")
+	}
+	gb.config.driver = _tx.drv
+	return gb
+}
+
+// String implements the fmt.Stringer.
+func (gb *GenerationBatch) String() string {
+	var builder strings.Builder
+	builder.WriteString("GenerationBatch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gb.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", gb.UserID))
+	builder.WriteString(", ")
+	if v := gb.APITokenID; v != nil {
+		builder.WriteString("api_token_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", gb.Status))
+	builder.WriteString(", ")
+	builder.WriteString("items=")
+	builder.WriteString(gb.Items)
+	builder.WriteString(", ")
+	builder.WriteString("num_items=")
+	builder.WriteString(fmt.Sprintf("%v", gb.NumItems))
+	builder.WriteString(", ")
+	builder.WriteString("dispatched_items=")
+	builder.WriteString(fmt.Sprintf("%v", gb.DispatchedItems))
+	builder.WriteString(", ")
+	builder.WriteString("rejected_items=")
+	builder.WriteString(fmt.Sprintf("%v", gb.RejectedItems))
+	builder.WriteString(", ")
+	builder.WriteString("credits_reserved=")
+	builder.WriteString(fmt.Sprintf("%v", gb.CreditsReserved))
+	builder.WriteString(", ")
+	builder.WriteString("credits_refunded=")
+	builder.WriteString(fmt.Sprintf("%v", gb.CreditsRefunded))
+	builder.WriteString(", ")
+	builder.WriteString("free=")
+	builder.WriteString(fmt.Sprintf("%v", gb.Free))
+	builder.WriteString(", ")
+	builder.WriteString("queue_tier=")
+	builder.WriteString(gb.QueueTier)
+	builder.WriteString(", ")
+	if v := gb.SourceOutputID; v != nil {
+		builder.WriteString("source_output_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gb.VariationType; v != nil {
+		builder.WriteString("variation_type=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(fmt.Sprintf("%v", gb.Origin))
+	builder.WriteString(", ")
+	if v := gb.DispatchedAt; v != nil {
+		builder.WriteString("dispatched_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(gb.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(gb.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GenerationBatches is a parsable slice of GenerationBatch.
+type GenerationBatches []*GenerationBatch
@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generationbatch
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the generationbatch type in the database.
+	Label = "generation_batch"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAPITokenID holds the string denoting the api_token_id field in the database.
+	FieldAPITokenID = "api_token_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldItems holds the string denoting the items field in the database.
+	FieldItems = "items"
+	// FieldNumItems holds the string denoting the num_items field in the database.
+	FieldNumItems = "num_items"
+	// FieldDispatchedItems holds the string denoting the dispatched_items field in the database.
+	FieldDispatchedItems = "dispatched_items"
+	// FieldRejectedItems holds the string denoting the rejected_items field in the database.
+	FieldRejectedItems = "rejected_items"
+	// FieldCreditsReserved holds the string denoting the credits_reserved field in the database.
+	FieldCreditsReserved = "credits_reserved"
+	// FieldCreditsRefunded holds the string denoting the credits_refunded field in the database.
+	FieldCreditsRefunded = "credits_refunded"
+	// FieldFree holds the string denoting the free field in the database.
+	FieldFree = "free"
+	// FieldQueueTier holds the string denoting the queue_tier field in the database.
+	FieldQueueTier = "queue_tier"
+	// FieldSourceOutputID holds the string denoting the source_output_id field in the database.
+	FieldSourceOutputID = "source_output_id"
+	// FieldVariationType holds the string denoting the variation_type field in the database.
+	FieldVariationType = "variation_type"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldDispatchedAt holds the string denoting the dispatched_at field in the database.
+	FieldDispatchedAt = "dispatched_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the generationbatch in the database.
+	Table = "generation_batches"
+)
+
+// Columns holds all SQL columns for generationbatch fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldAPITokenID,
+	FieldStatus,
+	FieldItems,
+	FieldNumItems,
+	FieldDispatchedItems,
+	FieldRejectedItems,
+	FieldCreditsReserved,
+	FieldCreditsRefunded,
+	FieldFree,
+	FieldQueueTier,
+	FieldSourceOutputID,
+	FieldVariationType,
+	FieldOrigin,
+	FieldDispatchedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDispatchedItems holds the default value on creation for the "dispatched_items" field.
+	DefaultDispatchedItems int
+	// DefaultRejectedItems holds the default value on creation for the "rejected_items" field.
+	DefaultRejectedItems int
+	// DefaultCreditsRefunded holds the default value on creation for the "credits_refunded" field.
+	DefaultCreditsRefunded int32
+	// DefaultFree holds the default value on creation for the "free" field.
+	DefaultFree bool
+	// DefaultQueueTier holds the default value on creation for the "queue_tier" field.
+	DefaultQueueTier string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// Status values.
+const (
+	StatusDispatching Status = "dispatching"
+	StatusDispatched  Status = "dispatched"
+	StatusFailed      Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusDispatching, StatusDispatched, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("generationbatch: invalid enum value for status field: %q", s)
+	}
+}
+
+// VariationType defines the type for the "variation_type" enum field.
+type VariationType string
+
+// VariationType values.
+const (
+	VariationTypeSeed          VariationType = "seed"
+	VariationTypeStrengthSweep VariationType = "strength_sweep"
+)
+
+func (vt VariationType) String() string {
+	return string(vt)
+}
+
+// VariationTypeValidator is a validator for the "variation_type" field enum values. It is called by the builders before save.
+func VariationTypeValidator(vt VariationType) error {
+	switch vt {
+	case VariationTypeSeed, VariationTypeStrengthSweep:
+		return nil
+	default:
+		return fmt.Errorf("generationbatch: invalid enum value for variation_type field: %q", vt)
+	}
+}
+
+// OrderOption defines the ordering options for the GenerationBatch queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAPITokenID orders the results by the api_token_id field.
+func ByAPITokenID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPITokenID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByItems orders the results by the items field.
+func ByItems(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItems, opts...).ToFunc()
+}
+
+// ByNumItems orders the results by the num_items field.
+func ByNumItems(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumItems, opts...).ToFunc()
+}
+
+// ByDispatchedItems orders the results by the dispatched_items field.
+func ByDispatchedItems(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDispatchedItems, opts...).ToFunc()
+}
+
+// ByRejectedItems orders the results by the rejected_items field.
+func ByRejectedItems(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectedItems, opts...).ToFunc()
+}
+
+// ByCreditsReserved orders the results by the credits_reserved field.
+func ByCreditsReserved(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditsReserved, opts...).ToFunc()
+}
+
+// ByCreditsRefunded orders the results by the credits_refunded field.
+func ByCreditsRefunded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditsRefunded, opts...).ToFunc()
+}
+
+// ByFree orders the results by the free field.
+func ByFree(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFree, opts...).ToFunc()
+}
+
+// ByQueueTier orders the results by the queue_tier field.
+func ByQueueTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQueueTier, opts...).ToFunc()
+}
+
+// BySourceOutputID orders the results by the source_output_id field.
+func BySourceOutputID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceOutputID, opts...).ToFunc()
+}
+
+// ByVariationType orders the results by the variation_type field.
+func ByVariationType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVariationType, opts...).ToFunc()
+}
+
+// ByDispatchedAt orders the results by the dispatched_at field.
+func ByDispatchedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDispatchedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,811 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generationbatch
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldUserID, v))
+}
+
+// APITokenID applies equality check predicate on the "api_token_id" field. It's identical to APITokenIDEQ.
+func APITokenID(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldAPITokenID, v))
+}
+
+// Items applies equality check predicate on the "items" field. It's identical to ItemsEQ.
+func Items(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldItems, v))
+}
+
+// NumItems applies equality check predicate on the "num_items" field. It's identical to NumItemsEQ.
+func NumItems(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldNumItems, v))
+}
+
+// DispatchedItems applies equality check predicate on the "dispatched_items" field. It's identical to DispatchedItemsEQ.
+func DispatchedItems(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldDispatchedItems, v))
+}
+
+// RejectedItems applies equality check predicate on the "rejected_items" field. It's identical to RejectedItemsEQ.
+func RejectedItems(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldRejectedItems, v))
+}
+
+// CreditsReserved applies equality check predicate on the "credits_reserved" field. It's identical to CreditsReservedEQ.
+func CreditsReserved(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldCreditsReserved, v))
+}
+
+// CreditsRefunded applies equality check predicate on the "credits_refunded" field. It's identical to CreditsRefundedEQ.
+func CreditsRefunded(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldCreditsRefunded, v))
+}
+
+// Free applies equality check predicate on the "free" field. It's identical to FreeEQ.
+func Free(v bool) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldFree, v))
+}
+
+// QueueTier applies equality check predicate on the "queue_tier" field. It's identical to QueueTierEQ.
+func QueueTier(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldQueueTier, v))
+}
+
+// SourceOutputID applies equality check predicate on the "source_output_id" field. It's identical to SourceOutputIDEQ.
+func SourceOutputID(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldSourceOutputID, v))
+}
+
+// DispatchedAt applies equality check predicate on the "dispatched_at" field. It's identical to DispatchedAtEQ.
+func DispatchedAt(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldDispatchedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldUserID, v))
+}
+
+// APITokenIDEQ applies the EQ predicate on the "api_token_id" field.
+func APITokenIDEQ(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldAPITokenID, v))
+}
+
+// APITokenIDNEQ applies the NEQ predicate on the "api_token_id" field.
+func APITokenIDNEQ(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldAPITokenID, v))
+}
+
+// APITokenIDIn applies the In predicate on the "api_token_id" field.
+func APITokenIDIn(vs ...uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldAPITokenID, vs...))
+}
+
+// APITokenIDNotIn applies the NotIn predicate on the "api_token_id" field.
+func APITokenIDNotIn(vs ...uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldAPITokenID, vs...))
+}
+
+// APITokenIDGT applies the GT predicate on the "api_token_id" field.
+func APITokenIDGT(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldAPITokenID, v))
+}
+
+// APITokenIDGTE applies the GTE predicate on the "api_token_id" field.
+func APITokenIDGTE(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldAPITokenID, v))
+}
+
+// APITokenIDLT applies the LT predicate on the "api_token_id" field.
+func APITokenIDLT(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldAPITokenID, v))
+}
+
+// APITokenIDLTE applies the LTE predicate on the "api_token_id" field.
+func APITokenIDLTE(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldAPITokenID, v))
+}
+
+// APITokenIDIsNil applies the IsNil predicate on the "api_token_id" field.
+func APITokenIDIsNil() predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIsNull(FieldAPITokenID))
+}
+
+// APITokenIDNotNil applies the NotNil predicate on the "api_token_id" field.
+func APITokenIDNotNil() predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotNull(FieldAPITokenID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ItemsEQ applies the EQ predicate on the "items" field.
+func ItemsEQ(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldItems, v))
+}
+
+// ItemsNEQ applies the NEQ predicate on the "items" field.
+func ItemsNEQ(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldItems, v))
+}
+
+// ItemsIn applies the In predicate on the "items" field.
+func ItemsIn(vs ...string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldItems, vs...))
+}
+
+// ItemsNotIn applies the NotIn predicate on the "items" field.
+func ItemsNotIn(vs ...string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldItems, vs...))
+}
+
+// ItemsGT applies the GT predicate on the "items" field.
+func ItemsGT(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldItems, v))
+}
+
+// ItemsGTE applies the GTE predicate on the "items" field.
+func ItemsGTE(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldItems, v))
+}
+
+// ItemsLT applies the LT predicate on the "items" field.
+func ItemsLT(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldItems, v))
+}
+
+// ItemsLTE applies the LTE predicate on the "items" field.
+func ItemsLTE(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldItems, v))
+}
+
+// ItemsContains applies the Contains predicate on the "items" field.
+func ItemsContains(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldContains(FieldItems, v))
+}
+
+// ItemsHasPrefix applies the HasPrefix predicate on the "items" field.
+func ItemsHasPrefix(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldHasPrefix(FieldItems, v))
+}
+
+// ItemsHasSuffix applies the HasSuffix predicate on the "items" field.
+func ItemsHasSuffix(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldHasSuffix(FieldItems, v))
+}
+
+// ItemsEqualFold applies the EqualFold predicate on the "items" field.
+func ItemsEqualFold(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEqualFold(FieldItems, v))
+}
+
+// ItemsContainsFold applies the ContainsFold predicate on the "items" field.
+func ItemsContainsFold(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldContainsFold(FieldItems, v))
+}
+
+// NumItemsEQ applies the EQ predicate on the "num_items" field.
+func NumItemsEQ(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldNumItems, v))
+}
+
+// NumItemsNEQ applies the NEQ predicate on the "num_items" field.
+func NumItemsNEQ(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldNumItems, v))
+}
+
+// NumItemsIn applies the In predicate on the "num_items" field.
+func NumItemsIn(vs ...int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldNumItems, vs...))
+}
+
+// NumItemsNotIn applies the NotIn predicate on the "num_items" field.
+func NumItemsNotIn(vs ...int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldNumItems, vs...))
+}
+
+// NumItemsGT applies the GT predicate on the "num_items" field.
+func NumItemsGT(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldNumItems, v))
+}
+
+// NumItemsGTE applies the GTE predicate on the "num_items" field.
+func NumItemsGTE(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldNumItems, v))
+}
+
+// NumItemsLT applies the LT predicate on the "num_items" field.
+func NumItemsLT(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldNumItems, v))
+}
+
+// NumItemsLTE applies the LTE predicate on the "num_items" field.
+func NumItemsLTE(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldNumItems, v))
+}
+
+// DispatchedItemsEQ applies the EQ predicate on the "dispatched_items" field.
+func DispatchedItemsEQ(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldDispatchedItems, v))
+}
+
+// DispatchedItemsNEQ applies the NEQ predicate on the "dispatched_items" field.
+func DispatchedItemsNEQ(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldDispatchedItems, v))
+}
+
+// DispatchedItemsIn applies the In predicate on the "dispatched_items" field.
+func DispatchedItemsIn(vs ...int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldDispatchedItems, vs...))
+}
+
+// DispatchedItemsNotIn applies the NotIn predicate on the "dispatched_items" field.
+func DispatchedItemsNotIn(vs ...int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldDispatchedItems, vs...))
+}
+
+// DispatchedItemsGT applies the GT predicate on the "dispatched_items" field.
+func DispatchedItemsGT(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldDispatchedItems, v))
+}
+
+// DispatchedItemsGTE applies the GTE predicate on the "dispatched_items" field.
+func DispatchedItemsGTE(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldDispatchedItems, v))
+}
+
+// DispatchedItemsLT applies the LT predicate on the "dispatched_items" field.
+func DispatchedItemsLT(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldDispatchedItems, v))
+}
+
+// DispatchedItemsLTE applies the LTE predicate on the "dispatched_items" field.
+func DispatchedItemsLTE(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldDispatchedItems, v))
+}
+
+// RejectedItemsEQ applies the EQ predicate on the "rejected_items" field.
+func RejectedItemsEQ(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldRejectedItems, v))
+}
+
+// RejectedItemsNEQ applies the NEQ predicate on the "rejected_items" field.
+func RejectedItemsNEQ(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldRejectedItems, v))
+}
+
+// RejectedItemsIn applies the In predicate on the "rejected_items" field.
+func RejectedItemsIn(vs ...int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldRejectedItems, vs...))
+}
+
+// RejectedItemsNotIn applies the NotIn predicate on the "rejected_items" field.
+func RejectedItemsNotIn(vs ...int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldRejectedItems, vs...))
+}
+
+// RejectedItemsGT applies the GT predicate on the "rejected_items" field.
+func RejectedItemsGT(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldRejectedItems, v))
+}
+
+// RejectedItemsGTE applies the GTE predicate on the "rejected_items" field.
+func RejectedItemsGTE(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldRejectedItems, v))
+}
+
+// RejectedItemsLT applies the LT predicate on the "rejected_items" field.
+func RejectedItemsLT(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldRejectedItems, v))
+}
+
+// RejectedItemsLTE applies the LTE predicate on the "rejected_items" field.
+func RejectedItemsLTE(v int) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldRejectedItems, v))
+}
+
+// CreditsReservedEQ applies the EQ predicate on the "credits_reserved" field.
+func CreditsReservedEQ(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldCreditsReserved, v))
+}
+
+// CreditsReservedNEQ applies the NEQ predicate on the "credits_reserved" field.
+func CreditsReservedNEQ(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldCreditsReserved, v))
+}
+
+// CreditsReservedIn applies the In predicate on the "credits_reserved" field.
+func CreditsReservedIn(vs ...int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldCreditsReserved, vs...))
+}
+
+// CreditsReservedNotIn applies the NotIn predicate on the "credits_reserved" field.
+func CreditsReservedNotIn(vs ...int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldCreditsReserved, vs...))
+}
+
+// CreditsReservedGT applies the GT predicate on the "credits_reserved" field.
+func CreditsReservedGT(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldCreditsReserved, v))
+}
+
+// CreditsReservedGTE applies the GTE predicate on the "credits_reserved" field.
+func CreditsReservedGTE(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldCreditsReserved, v))
+}
+
+// CreditsReservedLT applies the LT predicate on the "credits_reserved" field.
+func CreditsReservedLT(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldCreditsReserved, v))
+}
+
+// CreditsReservedLTE applies the LTE predicate on the "credits_reserved" field.
+func CreditsReservedLTE(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldCreditsReserved, v))
+}
+
+// CreditsRefundedEQ applies the EQ predicate on the "credits_refunded" field.
+func CreditsRefundedEQ(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldCreditsRefunded, v))
+}
+
+// CreditsRefundedNEQ applies the NEQ predicate on the "credits_refunded" field.
+func CreditsRefundedNEQ(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldCreditsRefunded, v))
+}
+
+// CreditsRefundedIn applies the In predicate on the "credits_refunded" field.
+func CreditsRefundedIn(vs ...int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldCreditsRefunded, vs...))
+}
+
+// CreditsRefundedNotIn applies the NotIn predicate on the "credits_refunded" field.
+func CreditsRefundedNotIn(vs ...int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldCreditsRefunded, vs...))
+}
+
+// CreditsRefundedGT applies the GT predicate on the "credits_refunded" field.
+func CreditsRefundedGT(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldCreditsRefunded, v))
+}
+
+// CreditsRefundedGTE applies the GTE predicate on the "credits_refunded" field.
+func CreditsRefundedGTE(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldCreditsRefunded, v))
+}
+
+// CreditsRefundedLT applies the LT predicate on the "credits_refunded" field.
+func CreditsRefundedLT(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldCreditsRefunded, v))
+}
+
+// CreditsRefundedLTE applies the LTE predicate on the "credits_refunded" field.
+func CreditsRefundedLTE(v int32) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldCreditsRefunded, v))
+}
+
+// FreeEQ applies the EQ predicate on the "free" field.
+func FreeEQ(v bool) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldFree, v))
+}
+
+// FreeNEQ applies the NEQ predicate on the "free" field.
+func FreeNEQ(v bool) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldFree, v))
+}
+
+// QueueTierEQ applies the EQ predicate on the "queue_tier" field.
+func QueueTierEQ(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldQueueTier, v))
+}
+
+// QueueTierNEQ applies the NEQ predicate on the "queue_tier" field.
+func QueueTierNEQ(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldQueueTier, v))
+}
+
+// QueueTierIn applies the In predicate on the "queue_tier" field.
+func QueueTierIn(vs ...string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldQueueTier, vs...))
+}
+
+// QueueTierNotIn applies the NotIn predicate on the "queue_tier" field.
+func QueueTierNotIn(vs ...string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldQueueTier, vs...))
+}
+
+// QueueTierGT applies the GT predicate on the "queue_tier" field.
+func QueueTierGT(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldQueueTier, v))
+}
+
+// QueueTierGTE applies the GTE predicate on the "queue_tier" field.
+func QueueTierGTE(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldQueueTier, v))
+}
+
+// QueueTierLT applies the LT predicate on the "queue_tier" field.
+func QueueTierLT(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldQueueTier, v))
+}
+
+// QueueTierLTE applies the LTE predicate on the "queue_tier" field.
+func QueueTierLTE(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldQueueTier, v))
+}
+
+// QueueTierContains applies the Contains predicate on the "queue_tier" field.
+func QueueTierContains(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldContains(FieldQueueTier, v))
+}
+
+// QueueTierHasPrefix applies the HasPrefix predicate on the "queue_tier" field.
+func QueueTierHasPrefix(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldHasPrefix(FieldQueueTier, v))
+}
+
+// QueueTierHasSuffix applies the HasSuffix predicate on the "queue_tier" field.
+func QueueTierHasSuffix(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldHasSuffix(FieldQueueTier, v))
+}
+
+// QueueTierEqualFold applies the EqualFold predicate on the "queue_tier" field.
+func QueueTierEqualFold(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEqualFold(FieldQueueTier, v))
+}
+
+// QueueTierContainsFold applies the ContainsFold predicate on the "queue_tier" field.
+func QueueTierContainsFold(v string) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldContainsFold(FieldQueueTier, v))
+}
+
+// SourceOutputIDEQ applies the EQ predicate on the "source_output_id" field.
+func SourceOutputIDEQ(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldSourceOutputID, v))
+}
+
+// SourceOutputIDNEQ applies the NEQ predicate on the "source_output_id" field.
+func SourceOutputIDNEQ(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldSourceOutputID, v))
+}
+
+// SourceOutputIDIn applies the In predicate on the "source_output_id" field.
+func SourceOutputIDIn(vs ...uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldSourceOutputID, vs...))
+}
+
+// SourceOutputIDNotIn applies the NotIn predicate on the "source_output_id" field.
+func SourceOutputIDNotIn(vs ...uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldSourceOutputID, vs...))
+}
+
+// SourceOutputIDGT applies the GT predicate on the "source_output_id" field.
+func SourceOutputIDGT(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldSourceOutputID, v))
+}
+
+// SourceOutputIDGTE applies the GTE predicate on the "source_output_id" field.
+func SourceOutputIDGTE(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldSourceOutputID, v))
+}
+
+// SourceOutputIDLT applies the LT predicate on the "source_output_id" field.
+func SourceOutputIDLT(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldSourceOutputID, v))
+}
+
+// SourceOutputIDLTE applies the LTE predicate on the "source_output_id" field.
+func SourceOutputIDLTE(v uuid.UUID) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldSourceOutputID, v))
+}
+
+// SourceOutputIDIsNil applies the IsNil predicate on the "source_output_id" field.
+func SourceOutputIDIsNil() predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIsNull(FieldSourceOutputID))
+}
+
+// SourceOutputIDNotNil applies the NotNil predicate on the "source_output_id" field.
+func SourceOutputIDNotNil() predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotNull(FieldSourceOutputID))
+}
+
+// VariationTypeEQ applies the EQ predicate on the "variation_type" field.
+func VariationTypeEQ(v VariationType) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldVariationType, v))
+}
+
+// VariationTypeNEQ applies the NEQ predicate on the "variation_type" field.
+func VariationTypeNEQ(v VariationType) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldVariationType, v))
+}
+
+// VariationTypeIn applies the In predicate on the "variation_type" field.
+func VariationTypeIn(vs ...VariationType) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldVariationType, vs...))
+}
+
+// VariationTypeNotIn applies the NotIn predicate on the "variation_type" field.
+func VariationTypeNotIn(vs ...VariationType) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldVariationType, vs...))
+}
+
+// VariationTypeIsNil applies the IsNil predicate on the "variation_type" field.
+func VariationTypeIsNil() predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIsNull(FieldVariationType))
+}
+
+// VariationTypeNotNil applies the NotNil predicate on the "variation_type" field.
+func VariationTypeNotNil() predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotNull(FieldVariationType))
+}
+
+// OriginIsNil applies the IsNil predicate on the "origin" field.
+func OriginIsNil() predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIsNull(FieldOrigin))
+}
+
+// OriginNotNil applies the NotNil predicate on the "origin" field.
+func OriginNotNil() predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotNull(FieldOrigin))
+}
+
+// DispatchedAtEQ applies the EQ predicate on the "dispatched_at" field.
+func DispatchedAtEQ(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldDispatchedAt, v))
+}
+
+// DispatchedAtNEQ applies the NEQ predicate on the "dispatched_at" field.
+func DispatchedAtNEQ(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldDispatchedAt, v))
+}
+
+// DispatchedAtIn applies the In predicate on the "dispatched_at" field.
+func DispatchedAtIn(vs ...time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldDispatchedAt, vs...))
+}
+
+// DispatchedAtNotIn applies the NotIn predicate on the "dispatched_at" field.
+func DispatchedAtNotIn(vs ...time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldDispatchedAt, vs...))
+}
+
+// DispatchedAtGT applies the GT predicate on the "dispatched_at" field.
+func DispatchedAtGT(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldDispatchedAt, v))
+}
+
+// DispatchedAtGTE applies the GTE predicate on the "dispatched_at" field.
+func DispatchedAtGTE(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldDispatchedAt, v))
+}
+
+// DispatchedAtLT applies the LT predicate on the "dispatched_at" field.
+func DispatchedAtLT(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldDispatchedAt, v))
+}
+
+// DispatchedAtLTE applies the LTE predicate on the "dispatched_at" field.
+func DispatchedAtLTE(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldDispatchedAt, v))
+}
+
+// DispatchedAtIsNil applies the IsNil predicate on the "dispatched_at" field.
+func DispatchedAtIsNil() predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIsNull(FieldDispatchedAt))
+}
+
+// DispatchedAtNotNil applies the NotNil predicate on the "dispatched_at" field.
+func DispatchedAtNotNil() predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotNull(FieldDispatchedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GenerationBatch) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GenerationBatch) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GenerationBatch) predicate.GenerationBatch {
+	return predicate.GenerationBatch(sql.NotPredicates(p))
+}
@@ -0,0 +1,1635 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
+	"github.com/stablecog/sc-go/database/enttypes"
+)
+
+// GenerationBatchCreate is the builder for creating a GenerationBatch entity.
+type GenerationBatchCreate struct {
+	config
+	mutation *GenerationBatchMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (gbc *GenerationBatchCreate) SetUserID(u uuid.UUID) *GenerationBatchCreate {
+	gbc.mutation.SetUserID(u)
+	return gbc
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (gbc *GenerationBatchCreate) SetAPITokenID(u uuid.UUID) *GenerationBatchCreate {
+	gbc.mutation.SetAPITokenID(u)
+	return gbc
+}
+
+// SetNillableAPITokenID sets the "api_token_id" field if the given value is not nil.
+func (gbc *GenerationBatchCreate) SetNillableAPITokenID(u *uuid.UUID) *GenerationBatchCreate {
+	if u != nil {
+		gbc.SetAPITokenID(*u)
+	}
+	return gbc
+}
+
+// SetStatus sets the "status" field.
+func (gbc *GenerationBatchCreate) SetStatus(ge generationbatch.Status) *GenerationBatchCreate {
+	gbc.mutation.SetStatus(ge)
+	return gbc
+}
+
+// SetItems sets the "items" field.
+func (gbc *GenerationBatchCreate) SetItems(s string) *GenerationBatchCreate {
+	gbc.mutation.SetItems(s)
+	return gbc
+}
+
+// SetNumItems sets the "num_items" field.
+func (gbc *GenerationBatchCreate) SetNumItems(i int) *GenerationBatchCreate {
+	gbc.mutation.SetNumItems(i)
+	return gbc
+}
+
+// SetDispatchedItems sets the "dispatched_items" field.
+func (gbc *GenerationBatchCreate) SetDispatchedItems(i int) *GenerationBatchCreate {
+	gbc.mutation.SetDispatchedItems(i)
+	return gbc
+}
+
+// SetNillableDispatchedItems sets the "dispatched_items" field if the given value is not nil.
+func (gbc *GenerationBatchCreate) SetNillableDispatchedItems(i *int) *GenerationBatchCreate {
+	if i != nil {
+		gbc.SetDispatchedItems(*i)
+	}
+	return gbc
+}
+
+// SetRejectedItems sets the "rejected_items" field.
+func (gbc *GenerationBatchCreate) SetRejectedItems(i int) *GenerationBatchCreate {
+	gbc.mutation.SetRejectedItems(i)
+	return gbc
+}
+
+// SetNillableRejectedItems sets the "rejected_items" field if the given value is not nil.
+func (gbc *GenerationBatchCreate) SetNillableRejectedItems(i *int) *GenerationBatchCreate {
+	if i != nil {
+		gbc.SetRejectedItems(*i)
+	}
+	return gbc
+}
+
+// SetCreditsReserved sets the "credits_reserved" field.
+func (gbc *GenerationBatchCreate) SetCreditsReserved(i int32) *GenerationBatchCreate {
+	gbc.mutation.SetCreditsReserved(i)
+	return gbc
+}
+
+// SetCreditsRefunded sets the "credits_refunded" field.
+func (gbc *GenerationBatchCreate) SetCreditsRefunded(i int32) *GenerationBatchCreate {
+	gbc.mutation.SetCreditsRefunded(i)
+	return gbc
+}
+
+// SetNillableCreditsRefunded sets the "credits_refunded" field if the given value is not nil.
+func (gbc *GenerationBatchCreate) SetNillableCreditsRefunded(i *int32) *GenerationBatchCreate {
+	if i != nil {
+		gbc.SetCreditsRefunded(*i)
+	}
+	return gbc
+}
+
+// SetFree sets the "free" field.
+func (gbc *GenerationBatchCreate) SetFree(b bool) *GenerationBatchCreate {
+	gbc.mutation.SetFree(b)
+	return gbc
+}
+
+// SetNillableFree sets the "free" field if the given value is not nil.
+func (gbc *GenerationBatchCreate) SetNillableFree(b *bool) *GenerationBatchCreate {
+	if b != nil {
+		gbc.SetFree(*b)
+	}
+	return gbc
+}
+
+// SetQueueTier sets the "queue_tier" field.
+func (gbc *GenerationBatchCreate) SetQueueTier(s string) *GenerationBatchCreate {
+	gbc.mutation.SetQueueTier(s)
+	return gbc
+}
+
+// SetNillableQueueTier sets the "queue_tier" field if the given value is not nil.
+func (gbc *GenerationBatchCreate) SetNillableQueueTier(s *string) *GenerationBatchCreate {
+	if s != nil {
+		gbc.SetQueueTier(*s)
+	}
+	return gbc
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (gbc *GenerationBatchCreate) SetSourceOutputID(u uuid.UUID) *GenerationBatchCreate {
+	gbc.mutation.SetSourceOutputID(u)
+	return gbc
+}
+
+// SetNillableSourceOutputID sets the "source_output_id" field if the given value is not nil.
+func (gbc *GenerationBatchCreate) SetNillableSourceOutputID(u *uuid.UUID) *GenerationBatchCreate {
+	if u != nil {
+		gbc.SetSourceOutputID(*u)
+	}
+	return gbc
+}
+
+// SetVariationType sets the "variation_type" field.
+func (gbc *GenerationBatchCreate) SetVariationType(gt generationbatch.VariationType) *GenerationBatchCreate {
+	gbc.mutation.SetVariationType(gt)
+	return gbc
+}
+
+// SetNillableVariationType sets the "variation_type" field if the given value is not nil.
+func (gbc *GenerationBatchCreate) SetNillableVariationType(gt *generationbatch.VariationType) *GenerationBatchCreate {
+	if gt != nil {
+		gbc.SetVariationType(*gt)
+	}
+	return gbc
+}
+
+// SetOrigin sets the "origin" field.
+func (gbc *GenerationBatchCreate) SetOrigin(eo *enttypes.RequestOrigin) *GenerationBatchCreate {
+	gbc.mutation.SetOrigin(eo)
+	return gbc
+}
+
+// SetDispatchedAt sets the "dispatched_at" field.
+func (gbc *GenerationBatchCreate) SetDispatchedAt(t time.Time) *GenerationBatchCreate {
+	gbc.mutation.SetDispatchedAt(t)
+	return gbc
+}
+
+// SetNillableDispatchedAt sets the "dispatched_at" field if the given value is not nil.
+func (gbc *GenerationBatchCreate) SetNillableDispatchedAt(t *time.Time) *GenerationBatchCreate {
+	if t != nil {
+		gbc.SetDispatchedAt(*t)
+	}
+	return gbc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gbc *GenerationBatchCreate) SetCreatedAt(t time.Time) *GenerationBatchCreate {
+	gbc.mutation.SetCreatedAt(t)
+	return gbc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gbc *GenerationBatchCreate) SetNillableCreatedAt(t *time.Time) *GenerationBatchCreate {
+	if t != nil {
+		gbc.SetCreatedAt(*t)
+	}
+	return gbc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gbc *GenerationBatchCreate) SetUpdatedAt(t time.Time) *GenerationBatchCreate {
+	gbc.mutation.SetUpdatedAt(t)
+	return gbc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (gbc *GenerationBatchCreate) SetNillableUpdatedAt(t *time.Time) *GenerationBatchCreate {
+	if t != nil {
+		gbc.SetUpdatedAt(*t)
+	}
+	return gbc
+}
+
+// SetID sets the "id" field.
+func (gbc *GenerationBatchCreate) SetID(u uuid.UUID) *GenerationBatchCreate {
+	gbc.mutation.SetID(u)
+	return gbc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (gbc *GenerationBatchCreate) SetNillableID(u *uuid.UUID) *GenerationBatchCreate {
+	if u != nil {
+		gbc.SetID(*u)
+	}
+	return gbc
+}
+
+// Mutation returns the GenerationBatchMutation object of the builder.
+func (gbc *GenerationBatchCreate) Mutation() *GenerationBatchMutation {
+	return gbc.mutation
+}
+
+// Save creates the GenerationBatch in the database.
+func (gbc *GenerationBatchCreate) Save(ctx context.Context) (*GenerationBatch, error) {
+	gbc.defaults()
+	return withHooks(ctx, gbc.sqlSave, gbc.mutation, gbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gbc *GenerationBatchCreate) SaveX(ctx context.Context) *GenerationBatch {
+	v, err := gbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gbc *GenerationBatchCreate) Exec(ctx context.Context) error {
+	_, err := gbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gbc *GenerationBatchCreate) ExecX(ctx context.Context) {
+	if err := gbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gbc *GenerationBatchCreate) defaults() {
+	if _, ok := gbc.mutation.DispatchedItems(); !ok {
+		v := generationbatch.DefaultDispatchedItems
+		gbc.mutation.SetDispatchedItems(v)
+	}
+	if _, ok := gbc.mutation.RejectedItems(); !ok {
+		v := generationbatch.DefaultRejectedItems
+		gbc.mutation.SetRejectedItems(v)
+	}
+	if _, ok := gbc.mutation.CreditsRefunded(); !ok {
+		v := generationbatch.DefaultCreditsRefunded
+		gbc.mutation.SetCreditsRefunded(v)
+	}
+	if _, ok := gbc.mutation.Free(); !ok {
+		v := generationbatch.DefaultFree
+		gbc.mutation.SetFree(v)
+	}
+	if _, ok := gbc.mutation.QueueTier(); !ok {
+		v := generationbatch.DefaultQueueTier
+		gbc.mutation.SetQueueTier(v)
+	}
+	if _, ok := gbc.mutation.CreatedAt(); !ok {
+		v := generationbatch.DefaultCreatedAt()
+		gbc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := gbc.mutation.UpdatedAt(); !ok {
+		v := generationbatch.DefaultUpdatedAt()
+		gbc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := gbc.mutation.ID(); !ok {
+		v := generationbatch.DefaultID()
+		gbc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gbc *GenerationBatchCreate) check() error {
+	if _, ok := gbc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "GenerationBatch.user_id"`)}
+	}
+	if _, ok := gbc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "GenerationBatch.status"`)}
+	}
+	if v, ok := gbc.mutation.Status(); ok {
+		if err := generationbatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GenerationBatch.status": %w`, err)}
+		}
+	}
+	if _, ok := gbc.mutation.Items(); !ok {
+		return &ValidationError{Name: "items", err: errors.New(`ent: missing required field "GenerationBatch.items"`)}
+	}
+	if _, ok := gbc.mutation.NumItems(); !ok {
+		return &ValidationError{Name: "num_items", err: errors.New(`ent: missing required field "GenerationBatch.num_items"`)}
+	}
+	if _, ok := gbc.mutation.DispatchedItems(); !ok {
+		return &ValidationError{Name: "dispatched_items", err: errors.New(`ent: missing required field "GenerationBatch.dispatched_items"`)}
+	}
+	if _, ok := gbc.mutation.RejectedItems(); !ok {
+		return &ValidationError{Name: "rejected_items", err: errors.New(`ent: missing required field "GenerationBatch.rejected_items"`)}
+	}
+	if _, ok := gbc.mutation.CreditsReserved(); !ok {
+		return &ValidationError{Name: "credits_reserved", err: errors.New(`ent: missing required field "GenerationBatch.credits_reserved"`)}
+	}
+	if _, ok := gbc.mutation.CreditsRefunded(); !ok {
+		return &ValidationError{Name: "credits_refunded", err: errors.New(`ent: missing required field "GenerationBatch.credits_refunded"`)}
+	}
+	if _, ok := gbc.mutation.Free(); !ok {
+		return &ValidationError{Name: "free", err: errors.New(`ent: missing required field "GenerationBatch.free"`)}
+	}
+	if _, ok := gbc.mutation.QueueTier(); !ok {
+		return &ValidationError{Name: "queue_tier", err: errors.New(`ent: missing required field "GenerationBatch.queue_tier"`)}
+	}
+	if v, ok := gbc.mutation.VariationType(); ok {
+		if err := generationbatch.VariationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "variation_type", err: fmt.Errorf(`ent: validator failed for field "GenerationBatch.variation_type": %w`, err)}
+		}
+	}
+	if _, ok := gbc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GenerationBatch.created_at"`)}
+	}
+	if _, ok := gbc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "GenerationBatch.updated_at"`)}
+	}
+	return nil
+}
+
+func (gbc *GenerationBatchCreate) sqlSave(ctx context.Context) (*GenerationBatch, error) {
+	if err := gbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	gbc.mutation.id = &_node.ID
+	gbc.mutation.done = true
+	return _node, nil
+}
+
+func (gbc *GenerationBatchCreate) createSpec() (*GenerationBatch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GenerationBatch{config: gbc.config}
+		_spec = sqlgraph.NewCreateSpec(generationbatch.Table, sqlgraph.NewFieldSpec(generationbatch.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = gbc.conflict
+	if id, ok := gbc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := gbc.mutation.UserID(); ok {
+		_spec.SetField(generationbatch.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := gbc.mutation.APITokenID(); ok {
+		_spec.SetField(generationbatch.FieldAPITokenID, field.TypeUUID, value)
+		_node.APITokenID = &value
+	}
+	if value, ok := gbc.mutation.Status(); ok {
+		_spec.SetField(generationbatch.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := gbc.mutation.Items(); ok {
+		_spec.SetField(generationbatch.FieldItems, field.TypeString, value)
+		_node.Items = value
+	}
+	if value, ok := gbc.mutation.NumItems(); ok {
+		_spec.SetField(generationbatch.FieldNumItems, field.TypeInt, value)
+		_node.NumItems = value
+	}
+	if value, ok := gbc.mutation.DispatchedItems(); ok {
+		_spec.SetField(generationbatch.FieldDispatchedItems, field.TypeInt, value)
+		_node.DispatchedItems = value
+	}
+	if value, ok := gbc.mutation.RejectedItems(); ok {
+		_spec.SetField(generationbatch.FieldRejectedItems, field.TypeInt, value)
+		_node.RejectedItems = value
+	}
+	if value, ok := gbc.mutation.CreditsReserved(); ok {
+		_spec.SetField(generationbatch.FieldCreditsReserved, field.TypeInt32, value)
+		_node.CreditsReserved = value
+	}
+	if value, ok := gbc.mutation.CreditsRefunded(); ok {
+		_spec.SetField(generationbatch.FieldCreditsRefunded, field.TypeInt32, value)
+		_node.CreditsRefunded = value
+	}
+	if value, ok := gbc.mutation.Free(); ok {
+		_spec.SetField(generationbatch.FieldFree, field.TypeBool, value)
+		_node.Free = value
+	}
+	if value, ok := gbc.mutation.QueueTier(); ok {
+		_spec.SetField(generationbatch.FieldQueueTier, field.TypeString, value)
+		_node.QueueTier = value
+	}
+	if value, ok := gbc.mutation.SourceOutputID(); ok {
+		_spec.SetField(generationbatch.FieldSourceOutputID, field.TypeUUID, value)
+		_node.SourceOutputID = &value
+	}
+	if value, ok := gbc.mutation.VariationType(); ok {
+		_spec.SetField(generationbatch.FieldVariationType, field.TypeEnum, value)
+		_node.VariationType = &value
+	}
+	if value, ok := gbc.mutation.Origin(); ok {
+		_spec.SetField(generationbatch.FieldOrigin, field.TypeJSON, value)
+		_node.Origin = value
+	}
+	if value, ok := gbc.mutation.DispatchedAt(); ok {
+		_spec.SetField(generationbatch.FieldDispatchedAt, field.TypeTime, value)
+		_node.DispatchedAt = &value
+	}
+	if value, ok := gbc.mutation.CreatedAt(); ok {
+		_spec.SetField(generationbatch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := gbc.mutation.UpdatedAt(); ok {
+		_spec.SetField(generationbatch.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GenerationBatch.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GenerationBatchUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (gbc *GenerationBatchCreate) OnConflict(opts ...sql.ConflictOption) *GenerationBatchUpsertOne {
+	gbc.conflict = opts
+	return &GenerationBatchUpsertOne{
+		create: gbc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GenerationBatch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gbc *GenerationBatchCreate) OnConflictColumns(columns ...string) *GenerationBatchUpsertOne {
+	gbc.conflict = append(gbc.conflict, sql.ConflictColumns(columns...))
+	return &GenerationBatchUpsertOne{
+		create: gbc,
+	}
+}
+
+type (
+	// GenerationBatchUpsertOne is the builder for "upsert"-ing
+	//  one GenerationBatch node.
+	GenerationBatchUpsertOne struct {
+		create *GenerationBatchCreate
+	}
+
+	// GenerationBatchUpsert is the "OnConflict" setter.
+	GenerationBatchUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *GenerationBatchUpsert) SetUserID(v uuid.UUID) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateUserID() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldUserID)
+	return u
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (u *GenerationBatchUpsert) SetAPITokenID(v uuid.UUID) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldAPITokenID, v)
+	return u
+}
+
+// UpdateAPITokenID sets the "api_token_id" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateAPITokenID() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldAPITokenID)
+	return u
+}
+
+// ClearAPITokenID clears the value of the "api_token_id" field.
+func (u *GenerationBatchUpsert) ClearAPITokenID() *GenerationBatchUpsert {
+	u.SetNull(generationbatch.FieldAPITokenID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *GenerationBatchUpsert) SetStatus(v generationbatch.Status) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateStatus() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldStatus)
+	return u
+}
+
+// SetItems sets the "items" field.
+func (u *GenerationBatchUpsert) SetItems(v string) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldItems, v)
+	return u
+}
+
+// UpdateItems sets the "items" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateItems() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldItems)
+	return u
+}
+
+// SetNumItems sets the "num_items" field.
+func (u *GenerationBatchUpsert) SetNumItems(v int) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldNumItems, v)
+	return u
+}
+
+// UpdateNumItems sets the "num_items" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateNumItems() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldNumItems)
+	return u
+}
+
+// AddNumItems adds v to the "num_items" field.
+func (u *GenerationBatchUpsert) AddNumItems(v int) *GenerationBatchUpsert {
+	u.Add(generationbatch.FieldNumItems, v)
+	return u
+}
+
+// SetDispatchedItems sets the "dispatched_items" field.
+func (u *GenerationBatchUpsert) SetDispatchedItems(v int) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldDispatchedItems, v)
+	return u
+}
+
+// UpdateDispatchedItems sets the "dispatched_items" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateDispatchedItems() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldDispatchedItems)
+	return u
+}
+
+// AddDispatchedItems adds v to the "dispatched_items" field.
+func (u *GenerationBatchUpsert) AddDispatchedItems(v int) *GenerationBatchUpsert {
+	u.Add(generationbatch.FieldDispatchedItems, v)
+	return u
+}
+
+// SetRejectedItems sets the "rejected_items" field.
+func (u *GenerationBatchUpsert) SetRejectedItems(v int) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldRejectedItems, v)
+	return u
+}
+
+// UpdateRejectedItems sets the "rejected_items" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateRejectedItems() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldRejectedItems)
+	return u
+}
+
+// AddRejectedItems adds v to the "rejected_items" field.
+func (u *GenerationBatchUpsert) AddRejectedItems(v int) *GenerationBatchUpsert {
+	u.Add(generationbatch.FieldRejectedItems, v)
+	return u
+}
+
+// SetCreditsReserved sets the "credits_reserved" field.
+func (u *GenerationBatchUpsert) SetCreditsReserved(v int32) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldCreditsReserved, v)
+	return u
+}
+
+// UpdateCreditsReserved sets the "credits_reserved" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateCreditsReserved() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldCreditsReserved)
+	return u
+}
+
+// AddCreditsReserved adds v to the "credits_reserved" field.
+func (u *GenerationBatchUpsert) AddCreditsReserved(v int32) *GenerationBatchUpsert {
+	u.Add(generationbatch.FieldCreditsReserved, v)
+	return u
+}
+
+// SetCreditsRefunded sets the "credits_refunded" field.
+func (u *GenerationBatchUpsert) SetCreditsRefunded(v int32) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldCreditsRefunded, v)
+	return u
+}
+
+// UpdateCreditsRefunded sets the "credits_refunded" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateCreditsRefunded() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldCreditsRefunded)
+	return u
+}
+
+// AddCreditsRefunded adds v to the "credits_refunded" field.
+func (u *GenerationBatchUpsert) AddCreditsRefunded(v int32) *GenerationBatchUpsert {
+	u.Add(generationbatch.FieldCreditsRefunded, v)
+	return u
+}
+
+// SetFree sets the "free" field.
+func (u *GenerationBatchUpsert) SetFree(v bool) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldFree, v)
+	return u
+}
+
+// UpdateFree sets the "free" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateFree() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldFree)
+	return u
+}
+
+// SetQueueTier sets the "queue_tier" field.
+func (u *GenerationBatchUpsert) SetQueueTier(v string) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldQueueTier, v)
+	return u
+}
+
+// UpdateQueueTier sets the "queue_tier" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateQueueTier() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldQueueTier)
+	return u
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (u *GenerationBatchUpsert) SetSourceOutputID(v uuid.UUID) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldSourceOutputID, v)
+	return u
+}
+
+// UpdateSourceOutputID sets the "source_output_id" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateSourceOutputID() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldSourceOutputID)
+	return u
+}
+
+// ClearSourceOutputID clears the value of the "source_output_id" field.
+func (u *GenerationBatchUpsert) ClearSourceOutputID() *GenerationBatchUpsert {
+	u.SetNull(generationbatch.FieldSourceOutputID)
+	return u
+}
+
+// SetVariationType sets the "variation_type" field.
+func (u *GenerationBatchUpsert) SetVariationType(v generationbatch.VariationType) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldVariationType, v)
+	return u
+}
+
+// UpdateVariationType sets the "variation_type" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateVariationType() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldVariationType)
+	return u
+}
+
+// ClearVariationType clears the value of the "variation_type" field.
+func (u *GenerationBatchUpsert) ClearVariationType() *GenerationBatchUpsert {
+	u.SetNull(generationbatch.FieldVariationType)
+	return u
+}
+
+// SetOrigin sets the "origin" field.
+func (u *GenerationBatchUpsert) SetOrigin(v *enttypes.RequestOrigin) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldOrigin, v)
+	return u
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateOrigin() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldOrigin)
+	return u
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (u *GenerationBatchUpsert) ClearOrigin() *GenerationBatchUpsert {
+	u.SetNull(generationbatch.FieldOrigin)
+	return u
+}
+
+// SetDispatchedAt sets the "dispatched_at" field.
+func (u *GenerationBatchUpsert) SetDispatchedAt(v time.Time) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldDispatchedAt, v)
+	return u
+}
+
+// UpdateDispatchedAt sets the "dispatched_at" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateDispatchedAt() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldDispatchedAt)
+	return u
+}
+
+// ClearDispatchedAt clears the value of the "dispatched_at" field.
+func (u *GenerationBatchUpsert) ClearDispatchedAt() *GenerationBatchUpsert {
+	u.SetNull(generationbatch.FieldDispatchedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GenerationBatchUpsert) SetUpdatedAt(v time.Time) *GenerationBatchUpsert {
+	u.Set(generationbatch.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GenerationBatchUpsert) UpdateUpdatedAt() *GenerationBatchUpsert {
+	u.SetExcluded(generationbatch.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GenerationBatch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(generationbatch.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GenerationBatchUpsertOne) UpdateNewValues() *GenerationBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(generationbatch.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(generationbatch.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GenerationBatch.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GenerationBatchUpsertOne) Ignore() *GenerationBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GenerationBatchUpsertOne) DoNothing() *GenerationBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GenerationBatchCreate.OnConflict
+// documentation for more info.
+func (u *GenerationBatchUpsertOne) Update(set func(*GenerationBatchUpsert)) *GenerationBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GenerationBatchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GenerationBatchUpsertOne) SetUserID(v uuid.UUID) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateUserID() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (u *GenerationBatchUpsertOne) SetAPITokenID(v uuid.UUID) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetAPITokenID(v)
+	})
+}
+
+// UpdateAPITokenID sets the "api_token_id" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateAPITokenID() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateAPITokenID()
+	})
+}
+
+// ClearAPITokenID clears the value of the "api_token_id" field.
+func (u *GenerationBatchUpsertOne) ClearAPITokenID() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.ClearAPITokenID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *GenerationBatchUpsertOne) SetStatus(v generationbatch.Status) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateStatus() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetItems sets the "items" field.
+func (u *GenerationBatchUpsertOne) SetItems(v string) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetItems(v)
+	})
+}
+
+// UpdateItems sets the "items" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateItems() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateItems()
+	})
+}
+
+// SetNumItems sets the "num_items" field.
+func (u *GenerationBatchUpsertOne) SetNumItems(v int) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetNumItems(v)
+	})
+}
+
+// AddNumItems adds v to the "num_items" field.
+func (u *GenerationBatchUpsertOne) AddNumItems(v int) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.AddNumItems(v)
+	})
+}
+
+// UpdateNumItems sets the "num_items" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateNumItems() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateNumItems()
+	})
+}
+
+// SetDispatchedItems sets the "dispatched_items" field.
+func (u *GenerationBatchUpsertOne) SetDispatchedItems(v int) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetDispatchedItems(v)
+	})
+}
+
+// AddDispatchedItems adds v to the "dispatched_items" field.
+func (u *GenerationBatchUpsertOne) AddDispatchedItems(v int) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.AddDispatchedItems(v)
+	})
+}
+
+// UpdateDispatchedItems sets the "dispatched_items" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateDispatchedItems() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateDispatchedItems()
+	})
+}
+
+// SetRejectedItems sets the "rejected_items" field.
+func (u *GenerationBatchUpsertOne) SetRejectedItems(v int) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetRejectedItems(v)
+	})
+}
+
+// AddRejectedItems adds v to the "rejected_items" field.
+func (u *GenerationBatchUpsertOne) AddRejectedItems(v int) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.AddRejectedItems(v)
+	})
+}
+
+// UpdateRejectedItems sets the "rejected_items" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateRejectedItems() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateRejectedItems()
+	})
+}
+
+// SetCreditsReserved sets the "credits_reserved" field.
+func (u *GenerationBatchUpsertOne) SetCreditsReserved(v int32) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetCreditsReserved(v)
+	})
+}
+
+// AddCreditsReserved adds v to the "credits_reserved" field.
+func (u *GenerationBatchUpsertOne) AddCreditsReserved(v int32) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.AddCreditsReserved(v)
+	})
+}
+
+// UpdateCreditsReserved sets the "credits_reserved" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateCreditsReserved() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateCreditsReserved()
+	})
+}
+
+// SetCreditsRefunded sets the "credits_refunded" field.
+func (u *GenerationBatchUpsertOne) SetCreditsRefunded(v int32) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetCreditsRefunded(v)
+	})
+}
+
+// AddCreditsRefunded adds v to the "credits_refunded" field.
+func (u *GenerationBatchUpsertOne) AddCreditsRefunded(v int32) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.AddCreditsRefunded(v)
+	})
+}
+
+// UpdateCreditsRefunded sets the "credits_refunded" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateCreditsRefunded() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateCreditsRefunded()
+	})
+}
+
+// SetFree sets the "free" field.
+func (u *GenerationBatchUpsertOne) SetFree(v bool) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetFree(v)
+	})
+}
+
+// UpdateFree sets the "free" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateFree() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateFree()
+	})
+}
+
+// SetQueueTier sets the "queue_tier" field.
+func (u *GenerationBatchUpsertOne) SetQueueTier(v string) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetQueueTier(v)
+	})
+}
+
+// UpdateQueueTier sets the "queue_tier" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateQueueTier() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateQueueTier()
+	})
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (u *GenerationBatchUpsertOne) SetSourceOutputID(v uuid.UUID) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetSourceOutputID(v)
+	})
+}
+
+// UpdateSourceOutputID sets the "source_output_id" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateSourceOutputID() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateSourceOutputID()
+	})
+}
+
+// ClearSourceOutputID clears the value of the "source_output_id" field.
+func (u *GenerationBatchUpsertOne) ClearSourceOutputID() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.ClearSourceOutputID()
+	})
+}
+
+// SetVariationType sets the "variation_type" field.
+func (u *GenerationBatchUpsertOne) SetVariationType(v generationbatch.VariationType) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetVariationType(v)
+	})
+}
+
+// UpdateVariationType sets the "variation_type" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateVariationType() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateVariationType()
+	})
+}
+
+// ClearVariationType clears the value of the "variation_type" field.
+func (u *GenerationBatchUpsertOne) ClearVariationType() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.ClearVariationType()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *GenerationBatchUpsertOne) SetOrigin(v *enttypes.RequestOrigin) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateOrigin() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (u *GenerationBatchUpsertOne) ClearOrigin() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.ClearOrigin()
+	})
+}
+
+// SetDispatchedAt sets the "dispatched_at" field.
+func (u *GenerationBatchUpsertOne) SetDispatchedAt(v time.Time) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetDispatchedAt(v)
+	})
+}
+
+// UpdateDispatchedAt sets the "dispatched_at" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateDispatchedAt() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateDispatchedAt()
+	})
+}
+
+// ClearDispatchedAt clears the value of the "dispatched_at" field.
+func (u *GenerationBatchUpsertOne) ClearDispatchedAt() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.ClearDispatchedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GenerationBatchUpsertOne) SetUpdatedAt(v time.Time) *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GenerationBatchUpsertOne) UpdateUpdatedAt() *GenerationBatchUpsertOne {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GenerationBatchUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GenerationBatchCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GenerationBatchUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GenerationBatchUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GenerationBatchUpsertOne.ID is not supported by MySQL driver. Use GenerationBatchUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GenerationBatchUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GenerationBatchCreateBulk is the builder for creating many GenerationBatch entities in bulk.
+type GenerationBatchCreateBulk struct {
+	config
+	err      error
+	builders []*GenerationBatchCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GenerationBatch entities in the database.
+func (gbcb *GenerationBatchCreateBulk) Save(ctx context.Context) ([]*GenerationBatch, error) {
+	if gbcb.err != nil {
+		return nil, gbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gbcb.builders))
+	nodes := make([]*GenerationBatch, len(gbcb.builders))
+	mutators := make([]Mutator, len(gbcb.builders))
+	for i := range gbcb.builders {
+		func(i int, root context.Context) {
+			builder := gbcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GenerationBatchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = gbcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gbcb *GenerationBatchCreateBulk) SaveX(ctx context.Context) []*GenerationBatch {
+	v, err := gbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gbcb *GenerationBatchCreateBulk) Exec(ctx context.Context) error {
+	_, err := gbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gbcb *GenerationBatchCreateBulk) ExecX(ctx context.Context) {
+	if err := gbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GenerationBatch.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GenerationBatchUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (gbcb *GenerationBatchCreateBulk) OnConflict(opts ...sql.ConflictOption) *GenerationBatchUpsertBulk {
+	gbcb.conflict = opts
+	return &GenerationBatchUpsertBulk{
+		create: gbcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GenerationBatch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gbcb *GenerationBatchCreateBulk) OnConflictColumns(columns ...string) *GenerationBatchUpsertBulk {
+	gbcb.conflict = append(gbcb.conflict, sql.ConflictColumns(columns...))
+	return &GenerationBatchUpsertBulk{
+		create: gbcb,
+	}
+}
+
+// GenerationBatchUpsertBulk is the builder for "upsert"-ing
+// a bulk of GenerationBatch nodes.
+type GenerationBatchUpsertBulk struct {
+	create *GenerationBatchCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GenerationBatch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(generationbatch.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GenerationBatchUpsertBulk) UpdateNewValues() *GenerationBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(generationbatch.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(generationbatch.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GenerationBatch.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GenerationBatchUpsertBulk) Ignore() *GenerationBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GenerationBatchUpsertBulk) DoNothing() *GenerationBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GenerationBatchCreateBulk.OnConflict
+// documentation for more info.
+func (u *GenerationBatchUpsertBulk) Update(set func(*GenerationBatchUpsert)) *GenerationBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GenerationBatchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GenerationBatchUpsertBulk) SetUserID(v uuid.UUID) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateUserID() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (u *GenerationBatchUpsertBulk) SetAPITokenID(v uuid.UUID) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetAPITokenID(v)
+	})
+}
+
+// UpdateAPITokenID sets the "api_token_id" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateAPITokenID() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateAPITokenID()
+	})
+}
+
+// ClearAPITokenID clears the value of the "api_token_id" field.
+func (u *GenerationBatchUpsertBulk) ClearAPITokenID() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.ClearAPITokenID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *GenerationBatchUpsertBulk) SetStatus(v generationbatch.Status) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateStatus() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetItems sets the "items" field.
+func (u *GenerationBatchUpsertBulk) SetItems(v string) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetItems(v)
+	})
+}
+
+// UpdateItems sets the "items" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateItems() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateItems()
+	})
+}
+
+// SetNumItems sets the "num_items" field.
+func (u *GenerationBatchUpsertBulk) SetNumItems(v int) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetNumItems(v)
+	})
+}
+
+// AddNumItems adds v to the "num_items" field.
+func (u *GenerationBatchUpsertBulk) AddNumItems(v int) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.AddNumItems(v)
+	})
+}
+
+// UpdateNumItems sets the "num_items" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateNumItems() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateNumItems()
+	})
+}
+
+// SetDispatchedItems sets the "dispatched_items" field.
+func (u *GenerationBatchUpsertBulk) SetDispatchedItems(v int) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetDispatchedItems(v)
+	})
+}
+
+// AddDispatchedItems adds v to the "dispatched_items" field.
+func (u *GenerationBatchUpsertBulk) AddDispatchedItems(v int) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.AddDispatchedItems(v)
+	})
+}
+
+// UpdateDispatchedItems sets the "dispatched_items" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateDispatchedItems() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateDispatchedItems()
+	})
+}
+
+// SetRejectedItems sets the "rejected_items" field.
+func (u *GenerationBatchUpsertBulk) SetRejectedItems(v int) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetRejectedItems(v)
+	})
+}
+
+// AddRejectedItems adds v to the "rejected_items" field.
+func (u *GenerationBatchUpsertBulk) AddRejectedItems(v int) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.AddRejectedItems(v)
+	})
+}
+
+// UpdateRejectedItems sets the "rejected_items" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateRejectedItems() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateRejectedItems()
+	})
+}
+
+// SetCreditsReserved sets the "credits_reserved" field.
+func (u *GenerationBatchUpsertBulk) SetCreditsReserved(v int32) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetCreditsReserved(v)
+	})
+}
+
+// AddCreditsReserved adds v to the "credits_reserved" field.
+func (u *GenerationBatchUpsertBulk) AddCreditsReserved(v int32) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.AddCreditsReserved(v)
+	})
+}
+
+// UpdateCreditsReserved sets the "credits_reserved" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateCreditsReserved() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateCreditsReserved()
+	})
+}
+
+// SetCreditsRefunded sets the "credits_refunded" field.
+func (u *GenerationBatchUpsertBulk) SetCreditsRefunded(v int32) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetCreditsRefunded(v)
+	})
+}
+
+// AddCreditsRefunded adds v to the "credits_refunded" field.
+func (u *GenerationBatchUpsertBulk) AddCreditsRefunded(v int32) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.AddCreditsRefunded(v)
+	})
+}
+
+// UpdateCreditsRefunded sets the "credits_refunded" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateCreditsRefunded() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateCreditsRefunded()
+	})
+}
+
+// SetFree sets the "free" field.
+func (u *GenerationBatchUpsertBulk) SetFree(v bool) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetFree(v)
+	})
+}
+
+// UpdateFree sets the "free" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateFree() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateFree()
+	})
+}
+
+// SetQueueTier sets the "queue_tier" field.
+func (u *GenerationBatchUpsertBulk) SetQueueTier(v string) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetQueueTier(v)
+	})
+}
+
+// UpdateQueueTier sets the "queue_tier" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateQueueTier() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateQueueTier()
+	})
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (u *GenerationBatchUpsertBulk) SetSourceOutputID(v uuid.UUID) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetSourceOutputID(v)
+	})
+}
+
+// UpdateSourceOutputID sets the "source_output_id" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateSourceOutputID() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateSourceOutputID()
+	})
+}
+
+// ClearSourceOutputID clears the value of the "source_output_id" field.
+func (u *GenerationBatchUpsertBulk) ClearSourceOutputID() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.ClearSourceOutputID()
+	})
+}
+
+// SetVariationType sets the "variation_type" field.
+func (u *GenerationBatchUpsertBulk) SetVariationType(v generationbatch.VariationType) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetVariationType(v)
+	})
+}
+
+// UpdateVariationType sets the "variation_type" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateVariationType() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateVariationType()
+	})
+}
+
+// ClearVariationType clears the value of the "variation_type" field.
+func (u *GenerationBatchUpsertBulk) ClearVariationType() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.ClearVariationType()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *GenerationBatchUpsertBulk) SetOrigin(v *enttypes.RequestOrigin) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateOrigin() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (u *GenerationBatchUpsertBulk) ClearOrigin() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.ClearOrigin()
+	})
+}
+
+// SetDispatchedAt sets the "dispatched_at" field.
+func (u *GenerationBatchUpsertBulk) SetDispatchedAt(v time.Time) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetDispatchedAt(v)
+	})
+}
+
+// UpdateDispatchedAt sets the "dispatched_at" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateDispatchedAt() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateDispatchedAt()
+	})
+}
+
+// ClearDispatchedAt clears the value of the "dispatched_at" field.
+func (u *GenerationBatchUpsertBulk) ClearDispatchedAt() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.ClearDispatchedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GenerationBatchUpsertBulk) SetUpdatedAt(v time.Time) *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GenerationBatchUpsertBulk) UpdateUpdatedAt() *GenerationBatchUpsertBulk {
+	return u.Update(func(s *GenerationBatchUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GenerationBatchUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GenerationBatchCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GenerationBatchCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GenerationBatchUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// GenerationBatchDelete is the builder for deleting a GenerationBatch entity.
+type GenerationBatchDelete struct {
+	config
+	hooks    []Hook
+	mutation *GenerationBatchMutation
+}
+
+// Where appends a list predicates to the GenerationBatchDelete builder.
+func (gbd *GenerationBatchDelete) Where(ps ...predicate.GenerationBatch) *GenerationBatchDelete {
+	gbd.mutation.Where(ps...)
+	return gbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gbd *GenerationBatchDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gbd.sqlExec, gbd.mutation, gbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gbd *GenerationBatchDelete) ExecX(ctx context.Context) int {
+	n, err := gbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gbd *GenerationBatchDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(generationbatch.Table, sqlgraph.NewFieldSpec(generationbatch.FieldID, field.TypeUUID))
+	if ps := gbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gbd.mutation.done = true
+	return affected, err
+}
+
+// GenerationBatchDeleteOne is the builder for deleting a single GenerationBatch entity.
+type GenerationBatchDeleteOne struct {
+	gbd *GenerationBatchDelete
+}
+
+// Where appends a list predicates to the GenerationBatchDelete builder.
+func (gbdo *GenerationBatchDeleteOne) Where(ps ...predicate.GenerationBatch) *GenerationBatchDeleteOne {
+	gbdo.gbd.mutation.Where(ps...)
+	return gbdo
+}
+
+// Exec executes the deletion query.
+func (gbdo *GenerationBatchDeleteOne) Exec(ctx context.Context) error {
+	n, err := gbdo.gbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{generationbatch.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gbdo *GenerationBatchDeleteOne) ExecX(ctx context.Context) {
+	if err := gbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
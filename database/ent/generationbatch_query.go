@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// GenerationBatchQuery is the builder for querying GenerationBatch entities.
+type GenerationBatchQuery struct {
+	config
+	ctx        *QueryContext
+	order      []generationbatch.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GenerationBatch
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GenerationBatchQuery builder.
+func (gbq *GenerationBatchQuery) Where(ps ...predicate.GenerationBatch) *GenerationBatchQuery {
+	gbq.predicates = append(gbq.predicates, ps...)
+	return gbq
+}
+
+// Limit the number of records to be returned by this query.
+func (gbq *GenerationBatchQuery) Limit(limit int) *GenerationBatchQuery {
+	gbq.ctx.Limit = &limit
+	return gbq
+}
+
+// Offset to start from.
+func (gbq *GenerationBatchQuery) Offset(offset int) *GenerationBatchQuery {
+	gbq.ctx.Offset = &offset
+	return gbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gbq *GenerationBatchQuery) Unique(unique bool) *GenerationBatchQuery {
+	gbq.ctx.Unique = &unique
+	return gbq
+}
+
+// Order specifies how the records should be ordered.
+func (gbq *GenerationBatchQuery) Order(o ...generationbatch.OrderOption) *GenerationBatchQuery {
+	gbq.order = append(gbq.order, o...)
+	return gbq
+}
+
+// First returns the first GenerationBatch entity from the query.
+// Returns a *NotFoundError when no GenerationBatch was found.
+func (gbq *GenerationBatchQuery) First(ctx context.Context) (*GenerationBatch, error) {
+	nodes, err := gbq.Limit(1).All(setContextOp(ctx, gbq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{generationbatch.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gbq *GenerationBatchQuery) FirstX(ctx context.Context) *GenerationBatch {
+	node, err := gbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GenerationBatch ID from the query.
+// Returns a *NotFoundError when no GenerationBatch ID was found.
+func (gbq *GenerationBatchQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = gbq.Limit(1).IDs(setContextOp(ctx, gbq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{generationbatch.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gbq *GenerationBatchQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := gbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GenerationBatch entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GenerationBatch entity is found.
+// Returns a *NotFoundError when no GenerationBatch entities are found.
+func (gbq *GenerationBatchQuery) Only(ctx context.Context) (*GenerationBatch, error) {
+	nodes, err := gbq.Limit(2).All(setContextOp(ctx, gbq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{generationbatch.Label}
+	default:
+		return nil, &NotSingularError{generationbatch.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gbq *GenerationBatchQuery) OnlyX(ctx context.Context) *GenerationBatch {
+	node, err := gbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GenerationBatch ID in the query.
+// Returns a *NotSingularError when more than one GenerationBatch ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gbq *GenerationBatchQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = gbq.Limit(2).IDs(setContextOp(ctx, gbq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{generationbatch.Label}
+	default:
+		err = &NotSingularError{generationbatch.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gbq *GenerationBatchQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := gbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GenerationBatches.
+func (gbq *GenerationBatchQuery) All(ctx context.Context) ([]*GenerationBatch, error) {
+	ctx = setContextOp(ctx, gbq.ctx, ent.OpQueryAll)
+	if err := gbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GenerationBatch, *GenerationBatchQuery]()
+	return withInterceptors[[]*GenerationBatch](ctx, gbq, qr, gbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gbq *GenerationBatchQuery) AllX(ctx context.Context) []*GenerationBatch {
+	nodes, err := gbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GenerationBatch IDs.
+func (gbq *GenerationBatchQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if gbq.ctx.Unique == nil && gbq.path != nil {
+		gbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gbq.ctx, ent.OpQueryIDs)
+	if err = gbq.Select(generationbatch.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gbq *GenerationBatchQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := gbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gbq *GenerationBatchQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gbq.ctx, ent.OpQueryCount)
+	if err := gbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gbq, querierCount[*GenerationBatchQuery](), gbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gbq *GenerationBatchQuery) CountX(ctx context.Context) int {
+	count, err := gbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gbq *GenerationBatchQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gbq.ctx, ent.OpQueryExist)
+	switch _, err := gbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gbq *GenerationBatchQuery) ExistX(ctx context.Context) bool {
+	exist, err := gbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GenerationBatchQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gbq *GenerationBatchQuery) Clone() *GenerationBatchQuery {
+	if gbq == nil {
+		return nil
+	}
+	return &GenerationBatchQuery{
+		config:     gbq.config,
+		ctx:        gbq.ctx.Clone(),
+		order:      append([]generationbatch.OrderOption{}, gbq.order...),
+		inters:     append([]Interceptor{}, gbq.inters...),
+		predicates: append([]predicate.GenerationBatch{}, gbq.predicates...),
+		// clone intermediate query.
+		sql:  gbq.sql.Clone(),
+		path: gbq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GenerationBatch.Query().
+//		GroupBy(generationbatch.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gbq *GenerationBatchQuery) GroupBy(field string, fields ...string) *GenerationBatchGroupBy {
+	gbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GenerationBatchGroupBy{build: gbq}
+	grbuild.flds = &gbq.ctx.Fields
+	grbuild.label = generationbatch.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//	}
+//
+//	client.GenerationBatch.Query().
+//		Select(generationbatch.FieldUserID).
+//		Scan(ctx, &v)
+func (gbq *GenerationBatchQuery) Select(fields ...string) *GenerationBatchSelect {
+	gbq.ctx.Fields = append(gbq.ctx.Fields, fields...)
+	sbuild := &GenerationBatchSelect{GenerationBatchQuery: gbq}
+	sbuild.label = generationbatch.Label
+	sbuild.flds, sbuild.scan = &gbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GenerationBatchSelect configured with the given aggregations.
+func (gbq *GenerationBatchQuery) Aggregate(fns ...AggregateFunc) *GenerationBatchSelect {
+	return gbq.Select().Aggregate(fns...)
+}
+
+func (gbq *GenerationBatchQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gbq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gbq.ctx.Fields {
+		if !generationbatch.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gbq.path != nil {
+		prev, err := gbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gbq.sql = prev
+	}
+	return nil
+}
+
+func (gbq *GenerationBatchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GenerationBatch, error) {
+	var (
+		nodes = []*GenerationBatch{}
+		_spec = gbq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GenerationBatch).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GenerationBatch{config: gbq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(gbq.modifiers) > 0 {
+		_spec.Modifiers = gbq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (gbq *GenerationBatchQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gbq.querySpec()
+	if len(gbq.modifiers) > 0 {
+		_spec.Modifiers = gbq.modifiers
+	}
+	_spec.Node.Columns = gbq.ctx.Fields
+	if len(gbq.ctx.Fields) > 0 {
+		_spec.Unique = gbq.ctx.Unique != nil && *gbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gbq.driver, _spec)
+}
+
+func (gbq *GenerationBatchQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(generationbatch.Table, generationbatch.Columns, sqlgraph.NewFieldSpec(generationbatch.FieldID, field.TypeUUID))
+	_spec.From = gbq.sql
+	if unique := gbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, generationbatch.FieldID)
+		for i := range fields {
+			if fields[i] != generationbatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := gbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gbq *GenerationBatchQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gbq.driver.Dialect())
+	t1 := builder.Table(generationbatch.Table)
+	columns := gbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = generationbatch.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gbq.sql != nil {
+		selector = gbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gbq.ctx.Unique != nil && *gbq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range gbq.modifiers {
+		m(selector)
+	}
+	for _, p := range gbq.predicates {
+		p(selector)
+	}
+	for _, p := range gbq.order {
+		p(selector)
+	}
+	if offset := gbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (gbq *GenerationBatchQuery) Modify(modifiers ...func(s *sql.Selector)) *GenerationBatchSelect {
+	gbq.modifiers = append(gbq.modifiers, modifiers...)
+	return gbq.Select()
+}
+
+// GenerationBatchGroupBy is the group-by builder for GenerationBatch entities.
+type GenerationBatchGroupBy struct {
+	selector
+	build *GenerationBatchQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gbgb *GenerationBatchGroupBy) Aggregate(fns ...AggregateFunc) *GenerationBatchGroupBy {
+	gbgb.fns = append(gbgb.fns, fns...)
+	return gbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gbgb *GenerationBatchGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gbgb.build.ctx, ent.OpQueryGroupBy)
+	if err := gbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GenerationBatchQuery, *GenerationBatchGroupBy](ctx, gbgb.build, gbgb, gbgb.build.inters, v)
+}
+
+func (gbgb *GenerationBatchGroupBy) sqlScan(ctx context.Context, root *GenerationBatchQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gbgb.fns))
+	for _, fn := range gbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gbgb.flds)+len(gbgb.fns))
+		for _, f := range *gbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GenerationBatchSelect is the builder for selecting fields of GenerationBatch entities.
+type GenerationBatchSelect struct {
+	*GenerationBatchQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gbs *GenerationBatchSelect) Aggregate(fns ...AggregateFunc) *GenerationBatchSelect {
+	gbs.fns = append(gbs.fns, fns...)
+	return gbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gbs *GenerationBatchSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gbs.ctx, ent.OpQuerySelect)
+	if err := gbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GenerationBatchQuery, *GenerationBatchSelect](ctx, gbs.GenerationBatchQuery, gbs, gbs.inters, v)
+}
+
+func (gbs *GenerationBatchSelect) sqlScan(ctx context.Context, root *GenerationBatchQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gbs.fns))
+	for _, fn := range gbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (gbs *GenerationBatchSelect) Modify(modifiers ...func(s *sql.Selector)) *GenerationBatchSelect {
+	gbs.modifiers = append(gbs.modifiers, modifiers...)
+	return gbs
+}
@@ -0,0 +1,950 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+	"github.com/stablecog/sc-go/database/enttypes"
+)
+
+// GenerationBatchUpdate is the builder for updating GenerationBatch entities.
+type GenerationBatchUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *GenerationBatchMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the GenerationBatchUpdate builder.
+func (gbu *GenerationBatchUpdate) Where(ps ...predicate.GenerationBatch) *GenerationBatchUpdate {
+	gbu.mutation.Where(ps...)
+	return gbu
+}
+
+// SetUserID sets the "user_id" field.
+func (gbu *GenerationBatchUpdate) SetUserID(u uuid.UUID) *GenerationBatchUpdate {
+	gbu.mutation.SetUserID(u)
+	return gbu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableUserID(u *uuid.UUID) *GenerationBatchUpdate {
+	if u != nil {
+		gbu.SetUserID(*u)
+	}
+	return gbu
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (gbu *GenerationBatchUpdate) SetAPITokenID(u uuid.UUID) *GenerationBatchUpdate {
+	gbu.mutation.SetAPITokenID(u)
+	return gbu
+}
+
+// SetNillableAPITokenID sets the "api_token_id" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableAPITokenID(u *uuid.UUID) *GenerationBatchUpdate {
+	if u != nil {
+		gbu.SetAPITokenID(*u)
+	}
+	return gbu
+}
+
+// ClearAPITokenID clears the value of the "api_token_id" field.
+func (gbu *GenerationBatchUpdate) ClearAPITokenID() *GenerationBatchUpdate {
+	gbu.mutation.ClearAPITokenID()
+	return gbu
+}
+
+// SetStatus sets the "status" field.
+func (gbu *GenerationBatchUpdate) SetStatus(ge generationbatch.Status) *GenerationBatchUpdate {
+	gbu.mutation.SetStatus(ge)
+	return gbu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableStatus(ge *generationbatch.Status) *GenerationBatchUpdate {
+	if ge != nil {
+		gbu.SetStatus(*ge)
+	}
+	return gbu
+}
+
+// SetItems sets the "items" field.
+func (gbu *GenerationBatchUpdate) SetItems(s string) *GenerationBatchUpdate {
+	gbu.mutation.SetItems(s)
+	return gbu
+}
+
+// SetNillableItems sets the "items" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableItems(s *string) *GenerationBatchUpdate {
+	if s != nil {
+		gbu.SetItems(*s)
+	}
+	return gbu
+}
+
+// SetNumItems sets the "num_items" field.
+func (gbu *GenerationBatchUpdate) SetNumItems(i int) *GenerationBatchUpdate {
+	gbu.mutation.ResetNumItems()
+	gbu.mutation.SetNumItems(i)
+	return gbu
+}
+
+// SetNillableNumItems sets the "num_items" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableNumItems(i *int) *GenerationBatchUpdate {
+	if i != nil {
+		gbu.SetNumItems(*i)
+	}
+	return gbu
+}
+
+// AddNumItems adds i to the "num_items" field.
+func (gbu *GenerationBatchUpdate) AddNumItems(i int) *GenerationBatchUpdate {
+	gbu.mutation.AddNumItems(i)
+	return gbu
+}
+
+// SetDispatchedItems sets the "dispatched_items" field.
+func (gbu *GenerationBatchUpdate) SetDispatchedItems(i int) *GenerationBatchUpdate {
+	gbu.mutation.ResetDispatchedItems()
+	gbu.mutation.SetDispatchedItems(i)
+	return gbu
+}
+
+// SetNillableDispatchedItems sets the "dispatched_items" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableDispatchedItems(i *int) *GenerationBatchUpdate {
+	if i != nil {
+		gbu.SetDispatchedItems(*i)
+	}
+	return gbu
+}
+
+// AddDispatchedItems adds i to the "dispatched_items" field.
+func (gbu *GenerationBatchUpdate) AddDispatchedItems(i int) *GenerationBatchUpdate {
+	gbu.mutation.AddDispatchedItems(i)
+	return gbu
+}
+
+// SetRejectedItems sets the "rejected_items" field.
+func (gbu *GenerationBatchUpdate) SetRejectedItems(i int) *GenerationBatchUpdate {
+	gbu.mutation.ResetRejectedItems()
+	gbu.mutation.SetRejectedItems(i)
+	return gbu
+}
+
+// SetNillableRejectedItems sets the "rejected_items" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableRejectedItems(i *int) *GenerationBatchUpdate {
+	if i != nil {
+		gbu.SetRejectedItems(*i)
+	}
+	return gbu
+}
+
+// AddRejectedItems adds i to the "rejected_items" field.
+func (gbu *GenerationBatchUpdate) AddRejectedItems(i int) *GenerationBatchUpdate {
+	gbu.mutation.AddRejectedItems(i)
+	return gbu
+}
+
+// SetCreditsReserved sets the "credits_reserved" field.
+func (gbu *GenerationBatchUpdate) SetCreditsReserved(i int32) *GenerationBatchUpdate {
+	gbu.mutation.ResetCreditsReserved()
+	gbu.mutation.SetCreditsReserved(i)
+	return gbu
+}
+
+// SetNillableCreditsReserved sets the "credits_reserved" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableCreditsReserved(i *int32) *GenerationBatchUpdate {
+	if i != nil {
+		gbu.SetCreditsReserved(*i)
+	}
+	return gbu
+}
+
+// AddCreditsReserved adds i to the "credits_reserved" field.
+func (gbu *GenerationBatchUpdate) AddCreditsReserved(i int32) *GenerationBatchUpdate {
+	gbu.mutation.AddCreditsReserved(i)
+	return gbu
+}
+
+// SetCreditsRefunded sets the "credits_refunded" field.
+func (gbu *GenerationBatchUpdate) SetCreditsRefunded(i int32) *GenerationBatchUpdate {
+	gbu.mutation.ResetCreditsRefunded()
+	gbu.mutation.SetCreditsRefunded(i)
+	return gbu
+}
+
+// SetNillableCreditsRefunded sets the "credits_refunded" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableCreditsRefunded(i *int32) *GenerationBatchUpdate {
+	if i != nil {
+		gbu.SetCreditsRefunded(*i)
+	}
+	return gbu
+}
+
+// AddCreditsRefunded adds i to the "credits_refunded" field.
+func (gbu *GenerationBatchUpdate) AddCreditsRefunded(i int32) *GenerationBatchUpdate {
+	gbu.mutation.AddCreditsRefunded(i)
+	return gbu
+}
+
+// SetFree sets the "free" field.
+func (gbu *GenerationBatchUpdate) SetFree(b bool) *GenerationBatchUpdate {
+	gbu.mutation.SetFree(b)
+	return gbu
+}
+
+// SetNillableFree sets the "free" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableFree(b *bool) *GenerationBatchUpdate {
+	if b != nil {
+		gbu.SetFree(*b)
+	}
+	return gbu
+}
+
+// SetQueueTier sets the "queue_tier" field.
+func (gbu *GenerationBatchUpdate) SetQueueTier(s string) *GenerationBatchUpdate {
+	gbu.mutation.SetQueueTier(s)
+	return gbu
+}
+
+// SetNillableQueueTier sets the "queue_tier" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableQueueTier(s *string) *GenerationBatchUpdate {
+	if s != nil {
+		gbu.SetQueueTier(*s)
+	}
+	return gbu
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (gbu *GenerationBatchUpdate) SetSourceOutputID(u uuid.UUID) *GenerationBatchUpdate {
+	gbu.mutation.SetSourceOutputID(u)
+	return gbu
+}
+
+// SetNillableSourceOutputID sets the "source_output_id" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableSourceOutputID(u *uuid.UUID) *GenerationBatchUpdate {
+	if u != nil {
+		gbu.SetSourceOutputID(*u)
+	}
+	return gbu
+}
+
+// ClearSourceOutputID clears the value of the "source_output_id" field.
+func (gbu *GenerationBatchUpdate) ClearSourceOutputID() *GenerationBatchUpdate {
+	gbu.mutation.ClearSourceOutputID()
+	return gbu
+}
+
+// SetVariationType sets the "variation_type" field.
+func (gbu *GenerationBatchUpdate) SetVariationType(gt generationbatch.VariationType) *GenerationBatchUpdate {
+	gbu.mutation.SetVariationType(gt)
+	return gbu
+}
+
+// SetNillableVariationType sets the "variation_type" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableVariationType(gt *generationbatch.VariationType) *GenerationBatchUpdate {
+	if gt != nil {
+		gbu.SetVariationType(*gt)
+	}
+	return gbu
+}
+
+// ClearVariationType clears the value of the "variation_type" field.
+func (gbu *GenerationBatchUpdate) ClearVariationType() *GenerationBatchUpdate {
+	gbu.mutation.ClearVariationType()
+	return gbu
+}
+
+// SetOrigin sets the "origin" field.
+func (gbu *GenerationBatchUpdate) SetOrigin(eo *enttypes.RequestOrigin) *GenerationBatchUpdate {
+	gbu.mutation.SetOrigin(eo)
+	return gbu
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (gbu *GenerationBatchUpdate) ClearOrigin() *GenerationBatchUpdate {
+	gbu.mutation.ClearOrigin()
+	return gbu
+}
+
+// SetDispatchedAt sets the "dispatched_at" field.
+func (gbu *GenerationBatchUpdate) SetDispatchedAt(t time.Time) *GenerationBatchUpdate {
+	gbu.mutation.SetDispatchedAt(t)
+	return gbu
+}
+
+// SetNillableDispatchedAt sets the "dispatched_at" field if the given value is not nil.
+func (gbu *GenerationBatchUpdate) SetNillableDispatchedAt(t *time.Time) *GenerationBatchUpdate {
+	if t != nil {
+		gbu.SetDispatchedAt(*t)
+	}
+	return gbu
+}
+
+// ClearDispatchedAt clears the value of the "dispatched_at" field.
+func (gbu *GenerationBatchUpdate) ClearDispatchedAt() *GenerationBatchUpdate {
+	gbu.mutation.ClearDispatchedAt()
+	return gbu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gbu *GenerationBatchUpdate) SetUpdatedAt(t time.Time) *GenerationBatchUpdate {
+	gbu.mutation.SetUpdatedAt(t)
+	return gbu
+}
+
+// Mutation returns the GenerationBatchMutation object of the builder.
+func (gbu *GenerationBatchUpdate) Mutation() *GenerationBatchMutation {
+	return gbu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gbu *GenerationBatchUpdate) Save(ctx context.Context) (int, error) {
+	gbu.defaults()
+	return withHooks(ctx, gbu.sqlSave, gbu.mutation, gbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gbu *GenerationBatchUpdate) SaveX(ctx context.Context) int {
+	affected, err := gbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gbu *GenerationBatchUpdate) Exec(ctx context.Context) error {
+	_, err := gbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gbu *GenerationBatchUpdate) ExecX(ctx context.Context) {
+	if err := gbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gbu *GenerationBatchUpdate) defaults() {
+	if _, ok := gbu.mutation.UpdatedAt(); !ok {
+		v := generationbatch.UpdateDefaultUpdatedAt()
+		gbu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gbu *GenerationBatchUpdate) check() error {
+	if v, ok := gbu.mutation.Status(); ok {
+		if err := generationbatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GenerationBatch.status": %w`, err)}
+		}
+	}
+	if v, ok := gbu.mutation.VariationType(); ok {
+		if err := generationbatch.VariationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "variation_type", err: fmt.Errorf(`ent: validator failed for field "GenerationBatch.variation_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (gbu *GenerationBatchUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GenerationBatchUpdate {
+	gbu.modifiers = append(gbu.modifiers, modifiers...)
+	return gbu
+}
+
+func (gbu *GenerationBatchUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gbu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(generationbatch.Table, generationbatch.Columns, sqlgraph.NewFieldSpec(generationbatch.FieldID, field.TypeUUID))
+	if ps := gbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gbu.mutation.UserID(); ok {
+		_spec.SetField(generationbatch.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := gbu.mutation.APITokenID(); ok {
+		_spec.SetField(generationbatch.FieldAPITokenID, field.TypeUUID, value)
+	}
+	if gbu.mutation.APITokenIDCleared() {
+		_spec.ClearField(generationbatch.FieldAPITokenID, field.TypeUUID)
+	}
+	if value, ok := gbu.mutation.Status(); ok {
+		_spec.SetField(generationbatch.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := gbu.mutation.Items(); ok {
+		_spec.SetField(generationbatch.FieldItems, field.TypeString, value)
+	}
+	if value, ok := gbu.mutation.NumItems(); ok {
+		_spec.SetField(generationbatch.FieldNumItems, field.TypeInt, value)
+	}
+	if value, ok := gbu.mutation.AddedNumItems(); ok {
+		_spec.AddField(generationbatch.FieldNumItems, field.TypeInt, value)
+	}
+	if value, ok := gbu.mutation.DispatchedItems(); ok {
+		_spec.SetField(generationbatch.FieldDispatchedItems, field.TypeInt, value)
+	}
+	if value, ok := gbu.mutation.AddedDispatchedItems(); ok {
+		_spec.AddField(generationbatch.FieldDispatchedItems, field.TypeInt, value)
+	}
+	if value, ok := gbu.mutation.RejectedItems(); ok {
+		_spec.SetField(generationbatch.FieldRejectedItems, field.TypeInt, value)
+	}
+	if value, ok := gbu.mutation.AddedRejectedItems(); ok {
+		_spec.AddField(generationbatch.FieldRejectedItems, field.TypeInt, value)
+	}
+	if value, ok := gbu.mutation.CreditsReserved(); ok {
+		_spec.SetField(generationbatch.FieldCreditsReserved, field.TypeInt32, value)
+	}
+	if value, ok := gbu.mutation.AddedCreditsReserved(); ok {
+		_spec.AddField(generationbatch.FieldCreditsReserved, field.TypeInt32, value)
+	}
+	if value, ok := gbu.mutation.CreditsRefunded(); ok {
+		_spec.SetField(generationbatch.FieldCreditsRefunded, field.TypeInt32, value)
+	}
+	if value, ok := gbu.mutation.AddedCreditsRefunded(); ok {
+		_spec.AddField(generationbatch.FieldCreditsRefunded, field.TypeInt32, value)
+	}
+	if value, ok := gbu.mutation.Free(); ok {
+		_spec.SetField(generationbatch.FieldFree, field.TypeBool, value)
+	}
+	if value, ok := gbu.mutation.QueueTier(); ok {
+		_spec.SetField(generationbatch.FieldQueueTier, field.TypeString, value)
+	}
+	if value, ok := gbu.mutation.SourceOutputID(); ok {
+		_spec.SetField(generationbatch.FieldSourceOutputID, field.TypeUUID, value)
+	}
+	if gbu.mutation.SourceOutputIDCleared() {
+		_spec.ClearField(generationbatch.FieldSourceOutputID, field.TypeUUID)
+	}
+	if value, ok := gbu.mutation.VariationType(); ok {
+		_spec.SetField(generationbatch.FieldVariationType, field.TypeEnum, value)
+	}
+	if gbu.mutation.VariationTypeCleared() {
+		_spec.ClearField(generationbatch.FieldVariationType, field.TypeEnum)
+	}
+	if value, ok := gbu.mutation.Origin(); ok {
+		_spec.SetField(generationbatch.FieldOrigin, field.TypeJSON, value)
+	}
+	if gbu.mutation.OriginCleared() {
+		_spec.ClearField(generationbatch.FieldOrigin, field.TypeJSON)
+	}
+	if value, ok := gbu.mutation.DispatchedAt(); ok {
+		_spec.SetField(generationbatch.FieldDispatchedAt, field.TypeTime, value)
+	}
+	if gbu.mutation.DispatchedAtCleared() {
+		_spec.ClearField(generationbatch.FieldDispatchedAt, field.TypeTime)
+	}
+	if value, ok := gbu.mutation.UpdatedAt(); ok {
+		_spec.SetField(generationbatch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(gbu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, gbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{generationbatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gbu.mutation.done = true
+	return n, nil
+}
+
+// GenerationBatchUpdateOne is the builder for updating a single GenerationBatch entity.
+type GenerationBatchUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *GenerationBatchMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (gbuo *GenerationBatchUpdateOne) SetUserID(u uuid.UUID) *GenerationBatchUpdateOne {
+	gbuo.mutation.SetUserID(u)
+	return gbuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableUserID(u *uuid.UUID) *GenerationBatchUpdateOne {
+	if u != nil {
+		gbuo.SetUserID(*u)
+	}
+	return gbuo
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (gbuo *GenerationBatchUpdateOne) SetAPITokenID(u uuid.UUID) *GenerationBatchUpdateOne {
+	gbuo.mutation.SetAPITokenID(u)
+	return gbuo
+}
+
+// SetNillableAPITokenID sets the "api_token_id" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableAPITokenID(u *uuid.UUID) *GenerationBatchUpdateOne {
+	if u != nil {
+		gbuo.SetAPITokenID(*u)
+	}
+	return gbuo
+}
+
+// ClearAPITokenID clears the value of the "api_token_id" field.
+func (gbuo *GenerationBatchUpdateOne) ClearAPITokenID() *GenerationBatchUpdateOne {
+	gbuo.mutation.ClearAPITokenID()
+	return gbuo
+}
+
+// SetStatus sets the "status" field.
+func (gbuo *GenerationBatchUpdateOne) SetStatus(ge generationbatch.Status) *GenerationBatchUpdateOne {
+	gbuo.mutation.SetStatus(ge)
+	return gbuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableStatus(ge *generationbatch.Status) *GenerationBatchUpdateOne {
+	if ge != nil {
+		gbuo.SetStatus(*ge)
+	}
+	return gbuo
+}
+
+// SetItems sets the "items" field.
+func (gbuo *GenerationBatchUpdateOne) SetItems(s string) *GenerationBatchUpdateOne {
+	gbuo.mutation.SetItems(s)
+	return gbuo
+}
+
+// SetNillableItems sets the "items" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableItems(s *string) *GenerationBatchUpdateOne {
+	if s != nil {
+		gbuo.SetItems(*s)
+	}
+	return gbuo
+}
+
+// SetNumItems sets the "num_items" field.
+func (gbuo *GenerationBatchUpdateOne) SetNumItems(i int) *GenerationBatchUpdateOne {
+	gbuo.mutation.ResetNumItems()
+	gbuo.mutation.SetNumItems(i)
+	return gbuo
+}
+
+// SetNillableNumItems sets the "num_items" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableNumItems(i *int) *GenerationBatchUpdateOne {
+	if i != nil {
+		gbuo.SetNumItems(*i)
+	}
+	return gbuo
+}
+
+// AddNumItems adds i to the "num_items" field.
+func (gbuo *GenerationBatchUpdateOne) AddNumItems(i int) *GenerationBatchUpdateOne {
+	gbuo.mutation.AddNumItems(i)
+	return gbuo
+}
+
+// SetDispatchedItems sets the "dispatched_items" field.
+func (gbuo *GenerationBatchUpdateOne) SetDispatchedItems(i int) *GenerationBatchUpdateOne {
+	gbuo.mutation.ResetDispatchedItems()
+	gbuo.mutation.SetDispatchedItems(i)
+	return gbuo
+}
+
+// SetNillableDispatchedItems sets the "dispatched_items" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableDispatchedItems(i *int) *GenerationBatchUpdateOne {
+	if i != nil {
+		gbuo.SetDispatchedItems(*i)
+	}
+	return gbuo
+}
+
+// AddDispatchedItems adds i to the "dispatched_items" field.
+func (gbuo *GenerationBatchUpdateOne) AddDispatchedItems(i int) *GenerationBatchUpdateOne {
+	gbuo.mutation.AddDispatchedItems(i)
+	return gbuo
+}
+
+// SetRejectedItems sets the "rejected_items" field.
+func (gbuo *GenerationBatchUpdateOne) SetRejectedItems(i int) *GenerationBatchUpdateOne {
+	gbuo.mutation.ResetRejectedItems()
+	gbuo.mutation.SetRejectedItems(i)
+	return gbuo
+}
+
+// SetNillableRejectedItems sets the "rejected_items" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableRejectedItems(i *int) *GenerationBatchUpdateOne {
+	if i != nil {
+		gbuo.SetRejectedItems(*i)
+	}
+	return gbuo
+}
+
+// AddRejectedItems adds i to the "rejected_items" field.
+func (gbuo *GenerationBatchUpdateOne) AddRejectedItems(i int) *GenerationBatchUpdateOne {
+	gbuo.mutation.AddRejectedItems(i)
+	return gbuo
+}
+
+// SetCreditsReserved sets the "credits_reserved" field.
+func (gbuo *GenerationBatchUpdateOne) SetCreditsReserved(i int32) *GenerationBatchUpdateOne {
+	gbuo.mutation.ResetCreditsReserved()
+	gbuo.mutation.SetCreditsReserved(i)
+	return gbuo
+}
+
+// SetNillableCreditsReserved sets the "credits_reserved" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableCreditsReserved(i *int32) *GenerationBatchUpdateOne {
+	if i != nil {
+		gbuo.SetCreditsReserved(*i)
+	}
+	return gbuo
+}
+
+// AddCreditsReserved adds i to the "credits_reserved" field.
+func (gbuo *GenerationBatchUpdateOne) AddCreditsReserved(i int32) *GenerationBatchUpdateOne {
+	gbuo.mutation.AddCreditsReserved(i)
+	return gbuo
+}
+
+// SetCreditsRefunded sets the "credits_refunded" field.
+func (gbuo *GenerationBatchUpdateOne) SetCreditsRefunded(i int32) *GenerationBatchUpdateOne {
+	gbuo.mutation.ResetCreditsRefunded()
+	gbuo.mutation.SetCreditsRefunded(i)
+	return gbuo
+}
+
+// SetNillableCreditsRefunded sets the "credits_refunded" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableCreditsRefunded(i *int32) *GenerationBatchUpdateOne {
+	if i != nil {
+		gbuo.SetCreditsRefunded(*i)
+	}
+	return gbuo
+}
+
+// AddCreditsRefunded adds i to the "credits_refunded" field.
+func (gbuo *GenerationBatchUpdateOne) AddCreditsRefunded(i int32) *GenerationBatchUpdateOne {
+	gbuo.mutation.AddCreditsRefunded(i)
+	return gbuo
+}
+
+// SetFree sets the "free" field.
+func (gbuo *GenerationBatchUpdateOne) SetFree(b bool) *GenerationBatchUpdateOne {
+	gbuo.mutation.SetFree(b)
+	return gbuo
+}
+
+// SetNillableFree sets the "free" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableFree(b *bool) *GenerationBatchUpdateOne {
+	if b != nil {
+		gbuo.SetFree(*b)
+	}
+	return gbuo
+}
+
+// SetQueueTier sets the "queue_tier" field.
+func (gbuo *GenerationBatchUpdateOne) SetQueueTier(s string) *GenerationBatchUpdateOne {
+	gbuo.mutation.SetQueueTier(s)
+	return gbuo
+}
+
+// SetNillableQueueTier sets the "queue_tier" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableQueueTier(s *string) *GenerationBatchUpdateOne {
+	if s != nil {
+		gbuo.SetQueueTier(*s)
+	}
+	return gbuo
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (gbuo *GenerationBatchUpdateOne) SetSourceOutputID(u uuid.UUID) *GenerationBatchUpdateOne {
+	gbuo.mutation.SetSourceOutputID(u)
+	return gbuo
+}
+
+// SetNillableSourceOutputID sets the "source_output_id" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableSourceOutputID(u *uuid.UUID) *GenerationBatchUpdateOne {
+	if u != nil {
+		gbuo.SetSourceOutputID(*u)
+	}
+	return gbuo
+}
+
+// ClearSourceOutputID clears the value of the "source_output_id" field.
+func (gbuo *GenerationBatchUpdateOne) ClearSourceOutputID() *GenerationBatchUpdateOne {
+	gbuo.mutation.ClearSourceOutputID()
+	return gbuo
+}
+
+// SetVariationType sets the "variation_type" field.
+func (gbuo *GenerationBatchUpdateOne) SetVariationType(gt generationbatch.VariationType) *GenerationBatchUpdateOne {
+	gbuo.mutation.SetVariationType(gt)
+	return gbuo
+}
+
+// SetNillableVariationType sets the "variation_type" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableVariationType(gt *generationbatch.VariationType) *GenerationBatchUpdateOne {
+	if gt != nil {
+		gbuo.SetVariationType(*gt)
+	}
+	return gbuo
+}
+
+// ClearVariationType clears the value of the "variation_type" field.
+func (gbuo *GenerationBatchUpdateOne) ClearVariationType() *GenerationBatchUpdateOne {
+	gbuo.mutation.ClearVariationType()
+	return gbuo
+}
+
+// SetOrigin sets the "origin" field.
+func (gbuo *GenerationBatchUpdateOne) SetOrigin(eo *enttypes.RequestOrigin) *GenerationBatchUpdateOne {
+	gbuo.mutation.SetOrigin(eo)
+	return gbuo
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (gbuo *GenerationBatchUpdateOne) ClearOrigin() *GenerationBatchUpdateOne {
+	gbuo.mutation.ClearOrigin()
+	return gbuo
+}
+
+// SetDispatchedAt sets the "dispatched_at" field.
+func (gbuo *GenerationBatchUpdateOne) SetDispatchedAt(t time.Time) *GenerationBatchUpdateOne {
+	gbuo.mutation.SetDispatchedAt(t)
+	return gbuo
+}
+
+// SetNillableDispatchedAt sets the "dispatched_at" field if the given value is not nil.
+func (gbuo *GenerationBatchUpdateOne) SetNillableDispatchedAt(t *time.Time) *GenerationBatchUpdateOne {
+	if t != nil {
+		gbuo.SetDispatchedAt(*t)
+	}
+	return gbuo
+}
+
+// ClearDispatchedAt clears the value of the "dispatched_at" field.
+func (gbuo *GenerationBatchUpdateOne) ClearDispatchedAt() *GenerationBatchUpdateOne {
+	gbuo.mutation.ClearDispatchedAt()
+	return gbuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gbuo *GenerationBatchUpdateOne) SetUpdatedAt(t time.Time) *GenerationBatchUpdateOne {
+	gbuo.mutation.SetUpdatedAt(t)
+	return gbuo
+}
+
+// Mutation returns the GenerationBatchMutation object of the builder.
+func (gbuo *GenerationBatchUpdateOne) Mutation() *GenerationBatchMutation {
+	return gbuo.mutation
+}
+
+// Where appends a list predicates to the GenerationBatchUpdate builder.
+func (gbuo *GenerationBatchUpdateOne) Where(ps ...predicate.GenerationBatch) *GenerationBatchUpdateOne {
+	gbuo.mutation.Where(ps...)
+	return gbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gbuo *GenerationBatchUpdateOne) Select(field string, fields ...string) *GenerationBatchUpdateOne {
+	gbuo.fields = append([]string{field}, fields...)
+	return gbuo
+}
+
+// Save executes the query and returns the updated GenerationBatch entity.
+func (gbuo *GenerationBatchUpdateOne) Save(ctx context.Context) (*GenerationBatch, error) {
+	gbuo.defaults()
+	return withHooks(ctx, gbuo.sqlSave, gbuo.mutation, gbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gbuo *GenerationBatchUpdateOne) SaveX(ctx context.Context) *GenerationBatch {
+	node, err := gbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gbuo *GenerationBatchUpdateOne) Exec(ctx context.Context) error {
+	_, err := gbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gbuo *GenerationBatchUpdateOne) ExecX(ctx context.Context) {
+	if err := gbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gbuo *GenerationBatchUpdateOne) defaults() {
+	if _, ok := gbuo.mutation.UpdatedAt(); !ok {
+		v := generationbatch.UpdateDefaultUpdatedAt()
+		gbuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gbuo *GenerationBatchUpdateOne) check() error {
+	if v, ok := gbuo.mutation.Status(); ok {
+		if err := generationbatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GenerationBatch.status": %w`, err)}
+		}
+	}
+	if v, ok := gbuo.mutation.VariationType(); ok {
+		if err := generationbatch.VariationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "variation_type", err: fmt.Errorf(`ent: validator failed for field "GenerationBatch.variation_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (gbuo *GenerationBatchUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GenerationBatchUpdateOne {
+	gbuo.modifiers = append(gbuo.modifiers, modifiers...)
+	return gbuo
+}
+
+func (gbuo *GenerationBatchUpdateOne) sqlSave(ctx context.Context) (_node *GenerationBatch, err error) {
+	if err := gbuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(generationbatch.Table, generationbatch.Columns, sqlgraph.NewFieldSpec(generationbatch.FieldID, field.TypeUUID))
+	id, ok := gbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GenerationBatch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, generationbatch.FieldID)
+		for _, f := range fields {
+			if !generationbatch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != generationbatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gbuo.mutation.UserID(); ok {
+		_spec.SetField(generationbatch.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := gbuo.mutation.APITokenID(); ok {
+		_spec.SetField(generationbatch.FieldAPITokenID, field.TypeUUID, value)
+	}
+	if gbuo.mutation.APITokenIDCleared() {
+		_spec.ClearField(generationbatch.FieldAPITokenID, field.TypeUUID)
+	}
+	if value, ok := gbuo.mutation.Status(); ok {
+		_spec.SetField(generationbatch.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := gbuo.mutation.Items(); ok {
+		_spec.SetField(generationbatch.FieldItems, field.TypeString, value)
+	}
+	if value, ok := gbuo.mutation.NumItems(); ok {
+		_spec.SetField(generationbatch.FieldNumItems, field.TypeInt, value)
+	}
+	if value, ok := gbuo.mutation.AddedNumItems(); ok {
+		_spec.AddField(generationbatch.FieldNumItems, field.TypeInt, value)
+	}
+	if value, ok := gbuo.mutation.DispatchedItems(); ok {
+		_spec.SetField(generationbatch.FieldDispatchedItems, field.TypeInt, value)
+	}
+	if value, ok := gbuo.mutation.AddedDispatchedItems(); ok {
+		_spec.AddField(generationbatch.FieldDispatchedItems, field.TypeInt, value)
+	}
+	if value, ok := gbuo.mutation.RejectedItems(); ok {
+		_spec.SetField(generationbatch.FieldRejectedItems, field.TypeInt, value)
+	}
+	if value, ok := gbuo.mutation.AddedRejectedItems(); ok {
+		_spec.AddField(generationbatch.FieldRejectedItems, field.TypeInt, value)
+	}
+	if value, ok := gbuo.mutation.CreditsReserved(); ok {
+		_spec.SetField(generationbatch.FieldCreditsReserved, field.TypeInt32, value)
+	}
+	if value, ok := gbuo.mutation.AddedCreditsReserved(); ok {
+		_spec.AddField(generationbatch.FieldCreditsReserved, field.TypeInt32, value)
+	}
+	if value, ok := gbuo.mutation.CreditsRefunded(); ok {
+		_spec.SetField(generationbatch.FieldCreditsRefunded, field.TypeInt32, value)
+	}
+	if value, ok := gbuo.mutation.AddedCreditsRefunded(); ok {
+		_spec.AddField(generationbatch.FieldCreditsRefunded, field.TypeInt32, value)
+	}
+	if value, ok := gbuo.mutation.Free(); ok {
+		_spec.SetField(generationbatch.FieldFree, field.TypeBool, value)
+	}
+	if value, ok := gbuo.mutation.QueueTier(); ok {
+		_spec.SetField(generationbatch.FieldQueueTier, field.TypeString, value)
+	}
+	if value, ok := gbuo.mutation.SourceOutputID(); ok {
+		_spec.SetField(generationbatch.FieldSourceOutputID, field.TypeUUID, value)
+	}
+	if gbuo.mutation.SourceOutputIDCleared() {
+		_spec.ClearField(generationbatch.FieldSourceOutputID, field.TypeUUID)
+	}
+	if value, ok := gbuo.mutation.VariationType(); ok {
+		_spec.SetField(generationbatch.FieldVariationType, field.TypeEnum, value)
+	}
+	if gbuo.mutation.VariationTypeCleared() {
+		_spec.ClearField(generationbatch.FieldVariationType, field.TypeEnum)
+	}
+	if value, ok := gbuo.mutation.Origin(); ok {
+		_spec.SetField(generationbatch.FieldOrigin, field.TypeJSON, value)
+	}
+	if gbuo.mutation.OriginCleared() {
+		_spec.ClearField(generationbatch.FieldOrigin, field.TypeJSON)
+	}
+	if value, ok := gbuo.mutation.DispatchedAt(); ok {
+		_spec.SetField(generationbatch.FieldDispatchedAt, field.TypeTime, value)
+	}
+	if gbuo.mutation.DispatchedAtCleared() {
+		_spec.ClearField(generationbatch.FieldDispatchedAt, field.TypeTime)
+	}
+	if value, ok := gbuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(generationbatch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(gbuo.modifiers...)
+	_node = &GenerationBatch{config: gbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{generationbatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gbuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/generationpreset"
+)
+
+// GenerationPreset is the model entity for the GenerationPreset schema.
+type GenerationPreset struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// PromptTemplate holds the value of the "prompt_template" field.
+	PromptTemplate *string `json:"prompt_template,omitempty"`
+	// NegativePrompt holds the value of the "negative_prompt" field.
+	NegativePrompt *string `json:"negative_prompt,omitempty"`
+	// ModelID holds the value of the "model_id" field.
+	ModelID *uuid.UUID `json:"model_id,omitempty"`
+	// SchedulerID holds the value of the "scheduler_id" field.
+	SchedulerID *uuid.UUID `json:"scheduler_id,omitempty"`
+	// Width holds the value of the "width" field.
+	Width *int32 `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height *int32 `json:"height,omitempty"`
+	// InferenceSteps holds the value of the "inference_steps" field.
+	InferenceSteps *int32 `json:"inference_steps,omitempty"`
+	// GuidanceScale holds the value of the "guidance_scale" field.
+	GuidanceScale *float32 `json:"guidance_scale,omitempty"`
+	// NumOutputs holds the value of the "num_outputs" field.
+	NumOutputs *int32 `json:"num_outputs,omitempty"`
+	// PromptStrength holds the value of the "prompt_strength" field.
+	PromptStrength *float32 `json:"prompt_strength,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GenerationPreset) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case generationpreset.FieldModelID, generationpreset.FieldSchedulerID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case generationpreset.FieldGuidanceScale, generationpreset.FieldPromptStrength:
+			values[i] = new(sql.NullFloat64)
+		case generationpreset.FieldWidth, generationpreset.FieldHeight, generationpreset.FieldInferenceSteps, generationpreset.FieldNumOutputs:
+			values[i] = new(sql.NullInt64)
+		case generationpreset.FieldName, generationpreset.FieldPromptTemplate, generationpreset.FieldNegativePrompt:
+			values[i] = new(sql.NullString)
+		case generationpreset.FieldCreatedAt, generationpreset.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case generationpreset.FieldID, generationpreset.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GenerationPreset fields.
+func (gp *GenerationPreset) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case generationpreset.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				gp.ID = *value
+			}
+		case generationpreset.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				gp.UserID = *value
+			}
+		case generationpreset.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				gp.Name = value.String
+			}
+		case generationpreset.FieldPromptTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_template", values[i])
+			} else if value.Valid {
+				gp.PromptTemplate = new(string)
+				*gp.PromptTemplate = value.String
+			}
+		case generationpreset.FieldNegativePrompt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field negative_prompt", values[i])
+			} else if value.Valid {
+				gp.NegativePrompt = new(string)
+				*gp.NegativePrompt = value.String
+			}
+		case generationpreset.FieldModelID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field model_id", values[i])
+			} else if value.Valid {
+				gp.ModelID = new(uuid.UUID)
+				*gp.ModelID = *value.S.(*uuid.UUID)
+			}
+		case generationpreset.FieldSchedulerID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduler_id", values[i])
+			} else if value.Valid {
+				gp.SchedulerID = new(uuid.UUID)
+				*gp.SchedulerID = *value.S.(*uuid.UUID)
+			}
+		case generationpreset.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				gp.Width = new(int32)
+				*gp.Width = int32(value.Int64)
+			}
+		case generationpreset.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				gp.Height = new(int32)
+				*gp.Height = int32(value.Int64)
+			}
+		case generationpreset.FieldInferenceSteps:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field inference_steps", values[i])
+			} else if value.Valid {
+				gp.InferenceSteps = new(int32)
+				*gp.InferenceSteps = int32(value.Int64)
+			}
+		case generationpreset.FieldGuidanceScale:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field guidance_scale", values[i])
+			} else if value.Valid {
+				gp.GuidanceScale = new(float32)
+				*gp.GuidanceScale = float32(value.Float64)
+			}
+		case generationpreset.FieldNumOutputs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field num_outputs", values[i])
+			} else if value.Valid {
+				gp.NumOutputs = new(int32)
+				*gp.NumOutputs = int32(value.Int64)
+			}
+		case generationpreset.FieldPromptStrength:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_strength", values[i])
+			} else if value.Valid {
+				gp.PromptStrength = new(float32)
+				*gp.PromptStrength = float32(value.Float64)
+			}
+		case generationpreset.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				gp.CreatedAt = value.Time
+			}
+		case generationpreset.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				gp.UpdatedAt = value.Time
+			}
+		default:
+			gp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GenerationPreset.
+// This includes values selected through modifiers, order, etc.
+func (gp *GenerationPreset) Value(name string) (ent.Value, error) {
+	return gp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GenerationPreset.
+// Note that you need to call GenerationPreset.Unwrap() before calling this method if this GenerationPreset
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gp *GenerationPreset) Update() *GenerationPresetUpdateOne {
+	return NewGenerationPresetClient(gp.config).UpdateOne(gp)
+}
+
+// Unwrap unwraps the GenerationPreset entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gp *GenerationPreset) Unwrap() *GenerationPreset {
+	_tx, ok := gp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GenerationPreset is not a transactional entity")
+	}
+	gp.config.driver = _tx.drv
+	return gp
+}
+
+// String implements the fmt.Stringer.
+func (gp *GenerationPreset) String() string {
+	var builder strings.Builder
+	builder.WriteString("GenerationPreset(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gp.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", gp.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(gp.Name)
+	builder.WriteString(", ")
+	if v := gp.PromptTemplate; v != nil {
+		builder.WriteString("prompt_template=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := gp.NegativePrompt; v != nil {
+		builder.WriteString("negative_prompt=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := gp.ModelID; v != nil {
+		builder.WriteString("model_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gp.SchedulerID; v != nil {
+		builder.WriteString("scheduler_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gp.Width; v != nil {
+		builder.WriteString("width=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gp.Height; v != nil {
+		builder.WriteString("height=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gp.InferenceSteps; v != nil {
+		builder.WriteString("inference_steps=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gp.GuidanceScale; v != nil {
+		builder.WriteString("guidance_scale=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gp.NumOutputs; v != nil {
+		builder.WriteString("num_outputs=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gp.PromptStrength; v != nil {
+		builder.WriteString("prompt_strength=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(gp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(gp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GenerationPresets is a parsable slice of GenerationPreset.
+type GenerationPresets []*GenerationPreset
@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generationpreset
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the generationpreset type in the database.
+	Label = "generation_preset"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPromptTemplate holds the string denoting the prompt_template field in the database.
+	FieldPromptTemplate = "prompt_template"
+	// FieldNegativePrompt holds the string denoting the negative_prompt field in the database.
+	FieldNegativePrompt = "negative_prompt"
+	// FieldModelID holds the string denoting the model_id field in the database.
+	FieldModelID = "model_id"
+	// FieldSchedulerID holds the string denoting the scheduler_id field in the database.
+	FieldSchedulerID = "scheduler_id"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldInferenceSteps holds the string denoting the inference_steps field in the database.
+	FieldInferenceSteps = "inference_steps"
+	// FieldGuidanceScale holds the string denoting the guidance_scale field in the database.
+	FieldGuidanceScale = "guidance_scale"
+	// FieldNumOutputs holds the string denoting the num_outputs field in the database.
+	FieldNumOutputs = "num_outputs"
+	// FieldPromptStrength holds the string denoting the prompt_strength field in the database.
+	FieldPromptStrength = "prompt_strength"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the generationpreset in the database.
+	Table = "generation_presets"
+)
+
+// Columns holds all SQL columns for generationpreset fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldName,
+	FieldPromptTemplate,
+	FieldNegativePrompt,
+	FieldModelID,
+	FieldSchedulerID,
+	FieldWidth,
+	FieldHeight,
+	FieldInferenceSteps,
+	FieldGuidanceScale,
+	FieldNumOutputs,
+	FieldPromptStrength,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the GenerationPreset queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPromptTemplate orders the results by the prompt_template field.
+func ByPromptTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptTemplate, opts...).ToFunc()
+}
+
+// ByNegativePrompt orders the results by the negative_prompt field.
+func ByNegativePrompt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNegativePrompt, opts...).ToFunc()
+}
+
+// ByModelID orders the results by the model_id field.
+func ByModelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModelID, opts...).ToFunc()
+}
+
+// BySchedulerID orders the results by the scheduler_id field.
+func BySchedulerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchedulerID, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByHeight orders the results by the height field.
+func ByHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeight, opts...).ToFunc()
+}
+
+// ByInferenceSteps orders the results by the inference_steps field.
+func ByInferenceSteps(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInferenceSteps, opts...).ToFunc()
+}
+
+// ByGuidanceScale orders the results by the guidance_scale field.
+func ByGuidanceScale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuidanceScale, opts...).ToFunc()
+}
+
+// ByNumOutputs orders the results by the num_outputs field.
+func ByNumOutputs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumOutputs, opts...).ToFunc()
+}
+
+// ByPromptStrength orders the results by the prompt_strength field.
+func ByPromptStrength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptStrength, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,876 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generationpreset
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldName, v))
+}
+
+// PromptTemplate applies equality check predicate on the "prompt_template" field. It's identical to PromptTemplateEQ.
+func PromptTemplate(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldPromptTemplate, v))
+}
+
+// NegativePrompt applies equality check predicate on the "negative_prompt" field. It's identical to NegativePromptEQ.
+func NegativePrompt(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldNegativePrompt, v))
+}
+
+// ModelID applies equality check predicate on the "model_id" field. It's identical to ModelIDEQ.
+func ModelID(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldModelID, v))
+}
+
+// SchedulerID applies equality check predicate on the "scheduler_id" field. It's identical to SchedulerIDEQ.
+func SchedulerID(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldSchedulerID, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldHeight, v))
+}
+
+// InferenceSteps applies equality check predicate on the "inference_steps" field. It's identical to InferenceStepsEQ.
+func InferenceSteps(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldInferenceSteps, v))
+}
+
+// GuidanceScale applies equality check predicate on the "guidance_scale" field. It's identical to GuidanceScaleEQ.
+func GuidanceScale(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldGuidanceScale, v))
+}
+
+// NumOutputs applies equality check predicate on the "num_outputs" field. It's identical to NumOutputsEQ.
+func NumOutputs(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldNumOutputs, v))
+}
+
+// PromptStrength applies equality check predicate on the "prompt_strength" field. It's identical to PromptStrengthEQ.
+func PromptStrength(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldPromptStrength, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldUserID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldContainsFold(FieldName, v))
+}
+
+// PromptTemplateEQ applies the EQ predicate on the "prompt_template" field.
+func PromptTemplateEQ(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldPromptTemplate, v))
+}
+
+// PromptTemplateNEQ applies the NEQ predicate on the "prompt_template" field.
+func PromptTemplateNEQ(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldPromptTemplate, v))
+}
+
+// PromptTemplateIn applies the In predicate on the "prompt_template" field.
+func PromptTemplateIn(vs ...string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldPromptTemplate, vs...))
+}
+
+// PromptTemplateNotIn applies the NotIn predicate on the "prompt_template" field.
+func PromptTemplateNotIn(vs ...string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldPromptTemplate, vs...))
+}
+
+// PromptTemplateGT applies the GT predicate on the "prompt_template" field.
+func PromptTemplateGT(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldPromptTemplate, v))
+}
+
+// PromptTemplateGTE applies the GTE predicate on the "prompt_template" field.
+func PromptTemplateGTE(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldPromptTemplate, v))
+}
+
+// PromptTemplateLT applies the LT predicate on the "prompt_template" field.
+func PromptTemplateLT(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldPromptTemplate, v))
+}
+
+// PromptTemplateLTE applies the LTE predicate on the "prompt_template" field.
+func PromptTemplateLTE(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldPromptTemplate, v))
+}
+
+// PromptTemplateContains applies the Contains predicate on the "prompt_template" field.
+func PromptTemplateContains(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldContains(FieldPromptTemplate, v))
+}
+
+// PromptTemplateHasPrefix applies the HasPrefix predicate on the "prompt_template" field.
+func PromptTemplateHasPrefix(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldHasPrefix(FieldPromptTemplate, v))
+}
+
+// PromptTemplateHasSuffix applies the HasSuffix predicate on the "prompt_template" field.
+func PromptTemplateHasSuffix(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldHasSuffix(FieldPromptTemplate, v))
+}
+
+// PromptTemplateIsNil applies the IsNil predicate on the "prompt_template" field.
+func PromptTemplateIsNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIsNull(FieldPromptTemplate))
+}
+
+// PromptTemplateNotNil applies the NotNil predicate on the "prompt_template" field.
+func PromptTemplateNotNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotNull(FieldPromptTemplate))
+}
+
+// PromptTemplateEqualFold applies the EqualFold predicate on the "prompt_template" field.
+func PromptTemplateEqualFold(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEqualFold(FieldPromptTemplate, v))
+}
+
+// PromptTemplateContainsFold applies the ContainsFold predicate on the "prompt_template" field.
+func PromptTemplateContainsFold(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldContainsFold(FieldPromptTemplate, v))
+}
+
+// NegativePromptEQ applies the EQ predicate on the "negative_prompt" field.
+func NegativePromptEQ(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldNegativePrompt, v))
+}
+
+// NegativePromptNEQ applies the NEQ predicate on the "negative_prompt" field.
+func NegativePromptNEQ(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldNegativePrompt, v))
+}
+
+// NegativePromptIn applies the In predicate on the "negative_prompt" field.
+func NegativePromptIn(vs ...string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldNegativePrompt, vs...))
+}
+
+// NegativePromptNotIn applies the NotIn predicate on the "negative_prompt" field.
+func NegativePromptNotIn(vs ...string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldNegativePrompt, vs...))
+}
+
+// NegativePromptGT applies the GT predicate on the "negative_prompt" field.
+func NegativePromptGT(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldNegativePrompt, v))
+}
+
+// NegativePromptGTE applies the GTE predicate on the "negative_prompt" field.
+func NegativePromptGTE(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldNegativePrompt, v))
+}
+
+// NegativePromptLT applies the LT predicate on the "negative_prompt" field.
+func NegativePromptLT(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldNegativePrompt, v))
+}
+
+// NegativePromptLTE applies the LTE predicate on the "negative_prompt" field.
+func NegativePromptLTE(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldNegativePrompt, v))
+}
+
+// NegativePromptContains applies the Contains predicate on the "negative_prompt" field.
+func NegativePromptContains(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldContains(FieldNegativePrompt, v))
+}
+
+// NegativePromptHasPrefix applies the HasPrefix predicate on the "negative_prompt" field.
+func NegativePromptHasPrefix(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldHasPrefix(FieldNegativePrompt, v))
+}
+
+// NegativePromptHasSuffix applies the HasSuffix predicate on the "negative_prompt" field.
+func NegativePromptHasSuffix(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldHasSuffix(FieldNegativePrompt, v))
+}
+
+// NegativePromptIsNil applies the IsNil predicate on the "negative_prompt" field.
+func NegativePromptIsNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIsNull(FieldNegativePrompt))
+}
+
+// NegativePromptNotNil applies the NotNil predicate on the "negative_prompt" field.
+func NegativePromptNotNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotNull(FieldNegativePrompt))
+}
+
+// NegativePromptEqualFold applies the EqualFold predicate on the "negative_prompt" field.
+func NegativePromptEqualFold(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEqualFold(FieldNegativePrompt, v))
+}
+
+// NegativePromptContainsFold applies the ContainsFold predicate on the "negative_prompt" field.
+func NegativePromptContainsFold(v string) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldContainsFold(FieldNegativePrompt, v))
+}
+
+// ModelIDEQ applies the EQ predicate on the "model_id" field.
+func ModelIDEQ(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldModelID, v))
+}
+
+// ModelIDNEQ applies the NEQ predicate on the "model_id" field.
+func ModelIDNEQ(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldModelID, v))
+}
+
+// ModelIDIn applies the In predicate on the "model_id" field.
+func ModelIDIn(vs ...uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldModelID, vs...))
+}
+
+// ModelIDNotIn applies the NotIn predicate on the "model_id" field.
+func ModelIDNotIn(vs ...uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldModelID, vs...))
+}
+
+// ModelIDGT applies the GT predicate on the "model_id" field.
+func ModelIDGT(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldModelID, v))
+}
+
+// ModelIDGTE applies the GTE predicate on the "model_id" field.
+func ModelIDGTE(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldModelID, v))
+}
+
+// ModelIDLT applies the LT predicate on the "model_id" field.
+func ModelIDLT(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldModelID, v))
+}
+
+// ModelIDLTE applies the LTE predicate on the "model_id" field.
+func ModelIDLTE(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldModelID, v))
+}
+
+// ModelIDIsNil applies the IsNil predicate on the "model_id" field.
+func ModelIDIsNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIsNull(FieldModelID))
+}
+
+// ModelIDNotNil applies the NotNil predicate on the "model_id" field.
+func ModelIDNotNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotNull(FieldModelID))
+}
+
+// SchedulerIDEQ applies the EQ predicate on the "scheduler_id" field.
+func SchedulerIDEQ(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldSchedulerID, v))
+}
+
+// SchedulerIDNEQ applies the NEQ predicate on the "scheduler_id" field.
+func SchedulerIDNEQ(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldSchedulerID, v))
+}
+
+// SchedulerIDIn applies the In predicate on the "scheduler_id" field.
+func SchedulerIDIn(vs ...uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldSchedulerID, vs...))
+}
+
+// SchedulerIDNotIn applies the NotIn predicate on the "scheduler_id" field.
+func SchedulerIDNotIn(vs ...uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldSchedulerID, vs...))
+}
+
+// SchedulerIDGT applies the GT predicate on the "scheduler_id" field.
+func SchedulerIDGT(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldSchedulerID, v))
+}
+
+// SchedulerIDGTE applies the GTE predicate on the "scheduler_id" field.
+func SchedulerIDGTE(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldSchedulerID, v))
+}
+
+// SchedulerIDLT applies the LT predicate on the "scheduler_id" field.
+func SchedulerIDLT(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldSchedulerID, v))
+}
+
+// SchedulerIDLTE applies the LTE predicate on the "scheduler_id" field.
+func SchedulerIDLTE(v uuid.UUID) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldSchedulerID, v))
+}
+
+// SchedulerIDIsNil applies the IsNil predicate on the "scheduler_id" field.
+func SchedulerIDIsNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIsNull(FieldSchedulerID))
+}
+
+// SchedulerIDNotNil applies the NotNil predicate on the "scheduler_id" field.
+func SchedulerIDNotNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotNull(FieldSchedulerID))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldWidth, v))
+}
+
+// WidthIsNil applies the IsNil predicate on the "width" field.
+func WidthIsNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIsNull(FieldWidth))
+}
+
+// WidthNotNil applies the NotNil predicate on the "width" field.
+func WidthNotNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotNull(FieldWidth))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldHeight, v))
+}
+
+// HeightIsNil applies the IsNil predicate on the "height" field.
+func HeightIsNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIsNull(FieldHeight))
+}
+
+// HeightNotNil applies the NotNil predicate on the "height" field.
+func HeightNotNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotNull(FieldHeight))
+}
+
+// InferenceStepsEQ applies the EQ predicate on the "inference_steps" field.
+func InferenceStepsEQ(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldInferenceSteps, v))
+}
+
+// InferenceStepsNEQ applies the NEQ predicate on the "inference_steps" field.
+func InferenceStepsNEQ(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldInferenceSteps, v))
+}
+
+// InferenceStepsIn applies the In predicate on the "inference_steps" field.
+func InferenceStepsIn(vs ...int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldInferenceSteps, vs...))
+}
+
+// InferenceStepsNotIn applies the NotIn predicate on the "inference_steps" field.
+func InferenceStepsNotIn(vs ...int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldInferenceSteps, vs...))
+}
+
+// InferenceStepsGT applies the GT predicate on the "inference_steps" field.
+func InferenceStepsGT(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldInferenceSteps, v))
+}
+
+// InferenceStepsGTE applies the GTE predicate on the "inference_steps" field.
+func InferenceStepsGTE(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldInferenceSteps, v))
+}
+
+// InferenceStepsLT applies the LT predicate on the "inference_steps" field.
+func InferenceStepsLT(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldInferenceSteps, v))
+}
+
+// InferenceStepsLTE applies the LTE predicate on the "inference_steps" field.
+func InferenceStepsLTE(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldInferenceSteps, v))
+}
+
+// InferenceStepsIsNil applies the IsNil predicate on the "inference_steps" field.
+func InferenceStepsIsNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIsNull(FieldInferenceSteps))
+}
+
+// InferenceStepsNotNil applies the NotNil predicate on the "inference_steps" field.
+func InferenceStepsNotNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotNull(FieldInferenceSteps))
+}
+
+// GuidanceScaleEQ applies the EQ predicate on the "guidance_scale" field.
+func GuidanceScaleEQ(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldGuidanceScale, v))
+}
+
+// GuidanceScaleNEQ applies the NEQ predicate on the "guidance_scale" field.
+func GuidanceScaleNEQ(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldGuidanceScale, v))
+}
+
+// GuidanceScaleIn applies the In predicate on the "guidance_scale" field.
+func GuidanceScaleIn(vs ...float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldGuidanceScale, vs...))
+}
+
+// GuidanceScaleNotIn applies the NotIn predicate on the "guidance_scale" field.
+func GuidanceScaleNotIn(vs ...float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldGuidanceScale, vs...))
+}
+
+// GuidanceScaleGT applies the GT predicate on the "guidance_scale" field.
+func GuidanceScaleGT(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldGuidanceScale, v))
+}
+
+// GuidanceScaleGTE applies the GTE predicate on the "guidance_scale" field.
+func GuidanceScaleGTE(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldGuidanceScale, v))
+}
+
+// GuidanceScaleLT applies the LT predicate on the "guidance_scale" field.
+func GuidanceScaleLT(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldGuidanceScale, v))
+}
+
+// GuidanceScaleLTE applies the LTE predicate on the "guidance_scale" field.
+func GuidanceScaleLTE(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldGuidanceScale, v))
+}
+
+// GuidanceScaleIsNil applies the IsNil predicate on the "guidance_scale" field.
+func GuidanceScaleIsNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIsNull(FieldGuidanceScale))
+}
+
+// GuidanceScaleNotNil applies the NotNil predicate on the "guidance_scale" field.
+func GuidanceScaleNotNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotNull(FieldGuidanceScale))
+}
+
+// NumOutputsEQ applies the EQ predicate on the "num_outputs" field.
+func NumOutputsEQ(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldNumOutputs, v))
+}
+
+// NumOutputsNEQ applies the NEQ predicate on the "num_outputs" field.
+func NumOutputsNEQ(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldNumOutputs, v))
+}
+
+// NumOutputsIn applies the In predicate on the "num_outputs" field.
+func NumOutputsIn(vs ...int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldNumOutputs, vs...))
+}
+
+// NumOutputsNotIn applies the NotIn predicate on the "num_outputs" field.
+func NumOutputsNotIn(vs ...int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldNumOutputs, vs...))
+}
+
+// NumOutputsGT applies the GT predicate on the "num_outputs" field.
+func NumOutputsGT(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldNumOutputs, v))
+}
+
+// NumOutputsGTE applies the GTE predicate on the "num_outputs" field.
+func NumOutputsGTE(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldNumOutputs, v))
+}
+
+// NumOutputsLT applies the LT predicate on the "num_outputs" field.
+func NumOutputsLT(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldNumOutputs, v))
+}
+
+// NumOutputsLTE applies the LTE predicate on the "num_outputs" field.
+func NumOutputsLTE(v int32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldNumOutputs, v))
+}
+
+// NumOutputsIsNil applies the IsNil predicate on the "num_outputs" field.
+func NumOutputsIsNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIsNull(FieldNumOutputs))
+}
+
+// NumOutputsNotNil applies the NotNil predicate on the "num_outputs" field.
+func NumOutputsNotNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotNull(FieldNumOutputs))
+}
+
+// PromptStrengthEQ applies the EQ predicate on the "prompt_strength" field.
+func PromptStrengthEQ(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldPromptStrength, v))
+}
+
+// PromptStrengthNEQ applies the NEQ predicate on the "prompt_strength" field.
+func PromptStrengthNEQ(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldPromptStrength, v))
+}
+
+// PromptStrengthIn applies the In predicate on the "prompt_strength" field.
+func PromptStrengthIn(vs ...float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldPromptStrength, vs...))
+}
+
+// PromptStrengthNotIn applies the NotIn predicate on the "prompt_strength" field.
+func PromptStrengthNotIn(vs ...float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldPromptStrength, vs...))
+}
+
+// PromptStrengthGT applies the GT predicate on the "prompt_strength" field.
+func PromptStrengthGT(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldPromptStrength, v))
+}
+
+// PromptStrengthGTE applies the GTE predicate on the "prompt_strength" field.
+func PromptStrengthGTE(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldPromptStrength, v))
+}
+
+// PromptStrengthLT applies the LT predicate on the "prompt_strength" field.
+func PromptStrengthLT(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldPromptStrength, v))
+}
+
+// PromptStrengthLTE applies the LTE predicate on the "prompt_strength" field.
+func PromptStrengthLTE(v float32) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldPromptStrength, v))
+}
+
+// PromptStrengthIsNil applies the IsNil predicate on the "prompt_strength" field.
+func PromptStrengthIsNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIsNull(FieldPromptStrength))
+}
+
+// PromptStrengthNotNil applies the NotNil predicate on the "prompt_strength" field.
+func PromptStrengthNotNil() predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotNull(FieldPromptStrength))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GenerationPreset) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GenerationPreset) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GenerationPreset) predicate.GenerationPreset {
+	return predicate.GenerationPreset(sql.NotPredicates(p))
+}
@@ -0,0 +1,1558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/generationpreset"
+)
+
+// GenerationPresetCreate is the builder for creating a GenerationPreset entity.
+type GenerationPresetCreate struct {
+	config
+	mutation *GenerationPresetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (gpc *GenerationPresetCreate) SetUserID(u uuid.UUID) *GenerationPresetCreate {
+	gpc.mutation.SetUserID(u)
+	return gpc
+}
+
+// SetName sets the "name" field.
+func (gpc *GenerationPresetCreate) SetName(s string) *GenerationPresetCreate {
+	gpc.mutation.SetName(s)
+	return gpc
+}
+
+// SetPromptTemplate sets the "prompt_template" field.
+func (gpc *GenerationPresetCreate) SetPromptTemplate(s string) *GenerationPresetCreate {
+	gpc.mutation.SetPromptTemplate(s)
+	return gpc
+}
+
+// SetNillablePromptTemplate sets the "prompt_template" field if the given value is not nil.
+func (gpc *GenerationPresetCreate) SetNillablePromptTemplate(s *string) *GenerationPresetCreate {
+	if s != nil {
+		gpc.SetPromptTemplate(*s)
+	}
+	return gpc
+}
+
+// SetNegativePrompt sets the "negative_prompt" field.
+func (gpc *GenerationPresetCreate) SetNegativePrompt(s string) *GenerationPresetCreate {
+	gpc.mutation.SetNegativePrompt(s)
+	return gpc
+}
+
+// SetNillableNegativePrompt sets the "negative_prompt" field if the given value is not nil.
+func (gpc *GenerationPresetCreate) SetNillableNegativePrompt(s *string) *GenerationPresetCreate {
+	if s != nil {
+		gpc.SetNegativePrompt(*s)
+	}
+	return gpc
+}
+
+// SetModelID sets the "model_id" field.
+func (gpc *GenerationPresetCreate) SetModelID(u uuid.UUID) *GenerationPresetCreate {
+	gpc.mutation.SetModelID(u)
+	return gpc
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (gpc *GenerationPresetCreate) SetNillableModelID(u *uuid.UUID) *GenerationPresetCreate {
+	if u != nil {
+		gpc.SetModelID(*u)
+	}
+	return gpc
+}
+
+// SetSchedulerID sets the "scheduler_id" field.
+func (gpc *GenerationPresetCreate) SetSchedulerID(u uuid.UUID) *GenerationPresetCreate {
+	gpc.mutation.SetSchedulerID(u)
+	return gpc
+}
+
+// SetNillableSchedulerID sets the "scheduler_id" field if the given value is not nil.
+func (gpc *GenerationPresetCreate) SetNillableSchedulerID(u *uuid.UUID) *GenerationPresetCreate {
+	if u != nil {
+		gpc.SetSchedulerID(*u)
+	}
+	return gpc
+}
+
+// SetWidth sets the "width" field.
+func (gpc *GenerationPresetCreate) SetWidth(i int32) *GenerationPresetCreate {
+	gpc.mutation.SetWidth(i)
+	return gpc
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (gpc *GenerationPresetCreate) SetNillableWidth(i *int32) *GenerationPresetCreate {
+	if i != nil {
+		gpc.SetWidth(*i)
+	}
+	return gpc
+}
+
+// SetHeight sets the "height" field.
+func (gpc *GenerationPresetCreate) SetHeight(i int32) *GenerationPresetCreate {
+	gpc.mutation.SetHeight(i)
+	return gpc
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (gpc *GenerationPresetCreate) SetNillableHeight(i *int32) *GenerationPresetCreate {
+	if i != nil {
+		gpc.SetHeight(*i)
+	}
+	return gpc
+}
+
+// SetInferenceSteps sets the "inference_steps" field.
+func (gpc *GenerationPresetCreate) SetInferenceSteps(i int32) *GenerationPresetCreate {
+	gpc.mutation.SetInferenceSteps(i)
+	return gpc
+}
+
+// SetNillableInferenceSteps sets the "inference_steps" field if the given value is not nil.
+func (gpc *GenerationPresetCreate) SetNillableInferenceSteps(i *int32) *GenerationPresetCreate {
+	if i != nil {
+		gpc.SetInferenceSteps(*i)
+	}
+	return gpc
+}
+
+// SetGuidanceScale sets the "guidance_scale" field.
+func (gpc *GenerationPresetCreate) SetGuidanceScale(f float32) *GenerationPresetCreate {
+	gpc.mutation.SetGuidanceScale(f)
+	return gpc
+}
+
+// SetNillableGuidanceScale sets the "guidance_scale" field if the given value is not nil.
+func (gpc *GenerationPresetCreate) SetNillableGuidanceScale(f *float32) *GenerationPresetCreate {
+	if f != nil {
+		gpc.SetGuidanceScale(*f)
+	}
+	return gpc
+}
+
+// SetNumOutputs sets the "num_outputs" field.
+func (gpc *GenerationPresetCreate) SetNumOutputs(i int32) *GenerationPresetCreate {
+	gpc.mutation.SetNumOutputs(i)
+	return gpc
+}
+
+// SetNillableNumOutputs sets the "num_outputs" field if the given value is not nil.
+func (gpc *GenerationPresetCreate) SetNillableNumOutputs(i *int32) *GenerationPresetCreate {
+	if i != nil {
+		gpc.SetNumOutputs(*i)
+	}
+	return gpc
+}
+
+// SetPromptStrength sets the "prompt_strength" field.
+func (gpc *GenerationPresetCreate) SetPromptStrength(f float32) *GenerationPresetCreate {
+	gpc.mutation.SetPromptStrength(f)
+	return gpc
+}
+
+// SetNillablePromptStrength sets the "prompt_strength" field if the given value is not nil.
+func (gpc *GenerationPresetCreate) SetNillablePromptStrength(f *float32) *GenerationPresetCreate {
+	if f != nil {
+		gpc.SetPromptStrength(*f)
+	}
+	return gpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gpc *GenerationPresetCreate) SetCreatedAt(t time.Time) *GenerationPresetCreate {
+	gpc.mutation.SetCreatedAt(t)
+	return gpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gpc *GenerationPresetCreate) SetNillableCreatedAt(t *time.Time) *GenerationPresetCreate {
+	if t != nil {
+		gpc.SetCreatedAt(*t)
+	}
+	return gpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gpc *GenerationPresetCreate) SetUpdatedAt(t time.Time) *GenerationPresetCreate {
+	gpc.mutation.SetUpdatedAt(t)
+	return gpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (gpc *GenerationPresetCreate) SetNillableUpdatedAt(t *time.Time) *GenerationPresetCreate {
+	if t != nil {
+		gpc.SetUpdatedAt(*t)
+	}
+	return gpc
+}
+
+// SetID sets the "id" field.
+func (gpc *GenerationPresetCreate) SetID(u uuid.UUID) *GenerationPresetCreate {
+	gpc.mutation.SetID(u)
+	return gpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (gpc *GenerationPresetCreate) SetNillableID(u *uuid.UUID) *GenerationPresetCreate {
+	if u != nil {
+		gpc.SetID(*u)
+	}
+	return gpc
+}
+
+// Mutation returns the GenerationPresetMutation object of the builder.
+func (gpc *GenerationPresetCreate) Mutation() *GenerationPresetMutation {
+	return gpc.mutation
+}
+
+// Save creates the GenerationPreset in the database.
+func (gpc *GenerationPresetCreate) Save(ctx context.Context) (*GenerationPreset, error) {
+	gpc.defaults()
+	return withHooks(ctx, gpc.sqlSave, gpc.mutation, gpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gpc *GenerationPresetCreate) SaveX(ctx context.Context) *GenerationPreset {
+	v, err := gpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gpc *GenerationPresetCreate) Exec(ctx context.Context) error {
+	_, err := gpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpc *GenerationPresetCreate) ExecX(ctx context.Context) {
+	if err := gpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gpc *GenerationPresetCreate) defaults() {
+	if _, ok := gpc.mutation.CreatedAt(); !ok {
+		v := generationpreset.DefaultCreatedAt()
+		gpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := gpc.mutation.UpdatedAt(); !ok {
+		v := generationpreset.DefaultUpdatedAt()
+		gpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := gpc.mutation.ID(); !ok {
+		v := generationpreset.DefaultID()
+		gpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gpc *GenerationPresetCreate) check() error {
+	if _, ok := gpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "GenerationPreset.user_id"`)}
+	}
+	if _, ok := gpc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "GenerationPreset.name"`)}
+	}
+	if _, ok := gpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GenerationPreset.created_at"`)}
+	}
+	if _, ok := gpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "GenerationPreset.updated_at"`)}
+	}
+	return nil
+}
+
+func (gpc *GenerationPresetCreate) sqlSave(ctx context.Context) (*GenerationPreset, error) {
+	if err := gpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	gpc.mutation.id = &_node.ID
+	gpc.mutation.done = true
+	return _node, nil
+}
+
+func (gpc *GenerationPresetCreate) createSpec() (*GenerationPreset, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GenerationPreset{config: gpc.config}
+		_spec = sqlgraph.NewCreateSpec(generationpreset.Table, sqlgraph.NewFieldSpec(generationpreset.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = gpc.conflict
+	if id, ok := gpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := gpc.mutation.UserID(); ok {
+		_spec.SetField(generationpreset.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := gpc.mutation.Name(); ok {
+		_spec.SetField(generationpreset.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := gpc.mutation.PromptTemplate(); ok {
+		_spec.SetField(generationpreset.FieldPromptTemplate, field.TypeString, value)
+		_node.PromptTemplate = &value
+	}
+	if value, ok := gpc.mutation.NegativePrompt(); ok {
+		_spec.SetField(generationpreset.FieldNegativePrompt, field.TypeString, value)
+		_node.NegativePrompt = &value
+	}
+	if value, ok := gpc.mutation.ModelID(); ok {
+		_spec.SetField(generationpreset.FieldModelID, field.TypeUUID, value)
+		_node.ModelID = &value
+	}
+	if value, ok := gpc.mutation.SchedulerID(); ok {
+		_spec.SetField(generationpreset.FieldSchedulerID, field.TypeUUID, value)
+		_node.SchedulerID = &value
+	}
+	if value, ok := gpc.mutation.Width(); ok {
+		_spec.SetField(generationpreset.FieldWidth, field.TypeInt32, value)
+		_node.Width = &value
+	}
+	if value, ok := gpc.mutation.Height(); ok {
+		_spec.SetField(generationpreset.FieldHeight, field.TypeInt32, value)
+		_node.Height = &value
+	}
+	if value, ok := gpc.mutation.InferenceSteps(); ok {
+		_spec.SetField(generationpreset.FieldInferenceSteps, field.TypeInt32, value)
+		_node.InferenceSteps = &value
+	}
+	if value, ok := gpc.mutation.GuidanceScale(); ok {
+		_spec.SetField(generationpreset.FieldGuidanceScale, field.TypeFloat32, value)
+		_node.GuidanceScale = &value
+	}
+	if value, ok := gpc.mutation.NumOutputs(); ok {
+		_spec.SetField(generationpreset.FieldNumOutputs, field.TypeInt32, value)
+		_node.NumOutputs = &value
+	}
+	if value, ok := gpc.mutation.PromptStrength(); ok {
+		_spec.SetField(generationpreset.FieldPromptStrength, field.TypeFloat32, value)
+		_node.PromptStrength = &value
+	}
+	if value, ok := gpc.mutation.CreatedAt(); ok {
+		_spec.SetField(generationpreset.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := gpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(generationpreset.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GenerationPreset.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GenerationPresetUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (gpc *GenerationPresetCreate) OnConflict(opts ...sql.ConflictOption) *GenerationPresetUpsertOne {
+	gpc.conflict = opts
+	return &GenerationPresetUpsertOne{
+		create: gpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GenerationPreset.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gpc *GenerationPresetCreate) OnConflictColumns(columns ...string) *GenerationPresetUpsertOne {
+	gpc.conflict = append(gpc.conflict, sql.ConflictColumns(columns...))
+	return &GenerationPresetUpsertOne{
+		create: gpc,
+	}
+}
+
+type (
+	// GenerationPresetUpsertOne is the builder for "upsert"-ing
+	//  one GenerationPreset node.
+	GenerationPresetUpsertOne struct {
+		create *GenerationPresetCreate
+	}
+
+	// GenerationPresetUpsert is the "OnConflict" setter.
+	GenerationPresetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *GenerationPresetUpsert) SetUserID(v uuid.UUID) *GenerationPresetUpsert {
+	u.Set(generationpreset.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GenerationPresetUpsert) UpdateUserID() *GenerationPresetUpsert {
+	u.SetExcluded(generationpreset.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *GenerationPresetUpsert) SetName(v string) *GenerationPresetUpsert {
+	u.Set(generationpreset.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GenerationPresetUpsert) UpdateName() *GenerationPresetUpsert {
+	u.SetExcluded(generationpreset.FieldName)
+	return u
+}
+
+// SetPromptTemplate sets the "prompt_template" field.
+func (u *GenerationPresetUpsert) SetPromptTemplate(v string) *GenerationPresetUpsert {
+	u.Set(generationpreset.FieldPromptTemplate, v)
+	return u
+}
+
+// UpdatePromptTemplate sets the "prompt_template" field to the value that was provided on create.
+func (u *GenerationPresetUpsert) UpdatePromptTemplate() *GenerationPresetUpsert {
+	u.SetExcluded(generationpreset.FieldPromptTemplate)
+	return u
+}
+
+// ClearPromptTemplate clears the value of the "prompt_template" field.
+func (u *GenerationPresetUpsert) ClearPromptTemplate() *GenerationPresetUpsert {
+	u.SetNull(generationpreset.FieldPromptTemplate)
+	return u
+}
+
+// SetNegativePrompt sets the "negative_prompt" field.
+func (u *GenerationPresetUpsert) SetNegativePrompt(v string) *GenerationPresetUpsert {
+	u.Set(generationpreset.FieldNegativePrompt, v)
+	return u
+}
+
+// UpdateNegativePrompt sets the "negative_prompt" field to the value that was provided on create.
+func (u *GenerationPresetUpsert) UpdateNegativePrompt() *GenerationPresetUpsert {
+	u.SetExcluded(generationpreset.FieldNegativePrompt)
+	return u
+}
+
+// ClearNegativePrompt clears the value of the "negative_prompt" field.
+func (u *GenerationPresetUpsert) ClearNegativePrompt() *GenerationPresetUpsert {
+	u.SetNull(generationpreset.FieldNegativePrompt)
+	return u
+}
+
+// SetModelID sets the "model_id" field.
+func (u *GenerationPresetUpsert) SetModelID(v uuid.UUID) *GenerationPresetUpsert {
+	u.Set(generationpreset.FieldModelID, v)
+	return u
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *GenerationPresetUpsert) UpdateModelID() *GenerationPresetUpsert {
+	u.SetExcluded(generationpreset.FieldModelID)
+	return u
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (u *GenerationPresetUpsert) ClearModelID() *GenerationPresetUpsert {
+	u.SetNull(generationpreset.FieldModelID)
+	return u
+}
+
+// SetSchedulerID sets the "scheduler_id" field.
+func (u *GenerationPresetUpsert) SetSchedulerID(v uuid.UUID) *GenerationPresetUpsert {
+	u.Set(generationpreset.FieldSchedulerID, v)
+	return u
+}
+
+// UpdateSchedulerID sets the "scheduler_id" field to the value that was provided on create.
+func (u *GenerationPresetUpsert) UpdateSchedulerID() *GenerationPresetUpsert {
+	u.SetExcluded(generationpreset.FieldSchedulerID)
+	return u
+}
+
+// ClearSchedulerID clears the value of the "scheduler_id" field.
+func (u *GenerationPresetUpsert) ClearSchedulerID() *GenerationPresetUpsert {
+	u.SetNull(generationpreset.FieldSchedulerID)
+	return u
+}
+
+// SetWidth sets the "width" field.
+func (u *GenerationPresetUpsert) SetWidth(v int32) *GenerationPresetUpsert {
+	u.Set(generationpreset.FieldWidth, v)
+	return u
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *GenerationPresetUpsert) UpdateWidth() *GenerationPresetUpsert {
+	u.SetExcluded(generationpreset.FieldWidth)
+	return u
+}
+
+// AddWidth adds v to the "width" field.
+func (u *GenerationPresetUpsert) AddWidth(v int32) *GenerationPresetUpsert {
+	u.Add(generationpreset.FieldWidth, v)
+	return u
+}
+
+// ClearWidth clears the value of the "width" field.
+func (u *GenerationPresetUpsert) ClearWidth() *GenerationPresetUpsert {
+	u.SetNull(generationpreset.FieldWidth)
+	return u
+}
+
+// SetHeight sets the "height" field.
+func (u *GenerationPresetUpsert) SetHeight(v int32) *GenerationPresetUpsert {
+	u.Set(generationpreset.FieldHeight, v)
+	return u
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *GenerationPresetUpsert) UpdateHeight() *GenerationPresetUpsert {
+	u.SetExcluded(generationpreset.FieldHeight)
+	return u
+}
+
+// AddHeight adds v to the "height" field.
+func (u *GenerationPresetUpsert) AddHeight(v int32) *GenerationPresetUpsert {
+	u.Add(generationpreset.FieldHeight, v)
+	return u
+}
+
+// ClearHeight clears the value of the "height" field.
+func (u *GenerationPresetUpsert) ClearHeight() *GenerationPresetUpsert {
+	u.SetNull(generationpreset.FieldHeight)
+	return u
+}
+
+// SetInferenceSteps sets the "inference_steps" field.
+func (u *GenerationPresetUpsert) SetInferenceSteps(v int32) *GenerationPresetUpsert {
+	u.Set(generationpreset.FieldInferenceSteps, v)
+	return u
+}
+
+// UpdateInferenceSteps sets the "inference_steps" field to the value that was provided on create.
+func (u *GenerationPresetUpsert) UpdateInferenceSteps() *GenerationPresetUpsert {
+	u.SetExcluded(generationpreset.FieldInferenceSteps)
+	return u
+}
+
+// AddInferenceSteps adds v to the "inference_steps" field.
+func (u *GenerationPresetUpsert) AddInferenceSteps(v int32) *GenerationPresetUpsert {
+	u.Add(generationpreset.FieldInferenceSteps, v)
+	return u
+}
+
+// ClearInferenceSteps clears the value of the "inference_steps" field.
+func (u *GenerationPresetUpsert) ClearInferenceSteps() *GenerationPresetUpsert {
+	u.SetNull(generationpreset.FieldInferenceSteps)
+	return u
+}
+
+// SetGuidanceScale sets the "guidance_scale" field.
+func (u *GenerationPresetUpsert) SetGuidanceScale(v float32) *GenerationPresetUpsert {
+	u.Set(generationpreset.FieldGuidanceScale, v)
+	return u
+}
+
+// UpdateGuidanceScale sets the "guidance_scale" field to the value that was provided on create.
+func (u *GenerationPresetUpsert) UpdateGuidanceScale() *GenerationPresetUpsert {
+	u.SetExcluded(generationpreset.FieldGuidanceScale)
+	return u
+}
+
+// AddGuidanceScale adds v to the "guidance_scale" field.
+func (u *GenerationPresetUpsert) AddGuidanceScale(v float32) *GenerationPresetUpsert {
+	u.Add(generationpreset.FieldGuidanceScale, v)
+	return u
+}
+
+// ClearGuidanceScale clears the value of the "guidance_scale" field.
+func (u *GenerationPresetUpsert) ClearGuidanceScale() *GenerationPresetUpsert {
+	u.SetNull(generationpreset.FieldGuidanceScale)
+	return u
+}
+
+// SetNumOutputs sets the "num_outputs" field.
+func (u *GenerationPresetUpsert) SetNumOutputs(v int32) *GenerationPresetUpsert {
+	u.Set(generationpreset.FieldNumOutputs, v)
+	return u
+}
+
+// UpdateNumOutputs sets the "num_outputs" field to the value that was provided on create.
+func (u *GenerationPresetUpsert) UpdateNumOutputs() *GenerationPresetUpsert {
+	u.SetExcluded(generationpreset.FieldNumOutputs)
+	return u
+}
+
+// AddNumOutputs adds v to the "num_outputs" field.
+func (u *GenerationPresetUpsert) AddNumOutputs(v int32) *GenerationPresetUpsert {
+	u.Add(generationpreset.FieldNumOutputs, v)
+	return u
+}
+
+// ClearNumOutputs clears the value of the "num_outputs" field.
+func (u *GenerationPresetUpsert) ClearNumOutputs() *GenerationPresetUpsert {
+	u.SetNull(generationpreset.FieldNumOutputs)
+	return u
+}
+
+// SetPromptStrength sets the "prompt_strength" field.
+func (u *GenerationPresetUpsert) SetPromptStrength(v float32) *GenerationPresetUpsert {
+	u.Set(generationpreset.FieldPromptStrength, v)
+	return u
+}
+
+// UpdatePromptStrength sets the "prompt_strength" field to the value that was provided on create.
+func (u *GenerationPresetUpsert) UpdatePromptStrength() *GenerationPresetUpsert {
+	u.SetExcluded(generationpreset.FieldPromptStrength)
+	return u
+}
+
+// AddPromptStrength adds v to the "prompt_strength" field.
+func (u *GenerationPresetUpsert) AddPromptStrength(v float32) *GenerationPresetUpsert {
+	u.Add(generationpreset.FieldPromptStrength, v)
+	return u
+}
+
+// ClearPromptStrength clears the value of the "prompt_strength" field.
+func (u *GenerationPresetUpsert) ClearPromptStrength() *GenerationPresetUpsert {
+	u.SetNull(generationpreset.FieldPromptStrength)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GenerationPresetUpsert) SetUpdatedAt(v time.Time) *GenerationPresetUpsert {
+	u.Set(generationpreset.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GenerationPresetUpsert) UpdateUpdatedAt() *GenerationPresetUpsert {
+	u.SetExcluded(generationpreset.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GenerationPreset.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(generationpreset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GenerationPresetUpsertOne) UpdateNewValues() *GenerationPresetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(generationpreset.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(generationpreset.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GenerationPreset.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GenerationPresetUpsertOne) Ignore() *GenerationPresetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GenerationPresetUpsertOne) DoNothing() *GenerationPresetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GenerationPresetCreate.OnConflict
+// documentation for more info.
+func (u *GenerationPresetUpsertOne) Update(set func(*GenerationPresetUpsert)) *GenerationPresetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GenerationPresetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GenerationPresetUpsertOne) SetUserID(v uuid.UUID) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GenerationPresetUpsertOne) UpdateUserID() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GenerationPresetUpsertOne) SetName(v string) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GenerationPresetUpsertOne) UpdateName() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPromptTemplate sets the "prompt_template" field.
+func (u *GenerationPresetUpsertOne) SetPromptTemplate(v string) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetPromptTemplate(v)
+	})
+}
+
+// UpdatePromptTemplate sets the "prompt_template" field to the value that was provided on create.
+func (u *GenerationPresetUpsertOne) UpdatePromptTemplate() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdatePromptTemplate()
+	})
+}
+
+// ClearPromptTemplate clears the value of the "prompt_template" field.
+func (u *GenerationPresetUpsertOne) ClearPromptTemplate() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearPromptTemplate()
+	})
+}
+
+// SetNegativePrompt sets the "negative_prompt" field.
+func (u *GenerationPresetUpsertOne) SetNegativePrompt(v string) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetNegativePrompt(v)
+	})
+}
+
+// UpdateNegativePrompt sets the "negative_prompt" field to the value that was provided on create.
+func (u *GenerationPresetUpsertOne) UpdateNegativePrompt() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateNegativePrompt()
+	})
+}
+
+// ClearNegativePrompt clears the value of the "negative_prompt" field.
+func (u *GenerationPresetUpsertOne) ClearNegativePrompt() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearNegativePrompt()
+	})
+}
+
+// SetModelID sets the "model_id" field.
+func (u *GenerationPresetUpsertOne) SetModelID(v uuid.UUID) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetModelID(v)
+	})
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *GenerationPresetUpsertOne) UpdateModelID() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateModelID()
+	})
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (u *GenerationPresetUpsertOne) ClearModelID() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearModelID()
+	})
+}
+
+// SetSchedulerID sets the "scheduler_id" field.
+func (u *GenerationPresetUpsertOne) SetSchedulerID(v uuid.UUID) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetSchedulerID(v)
+	})
+}
+
+// UpdateSchedulerID sets the "scheduler_id" field to the value that was provided on create.
+func (u *GenerationPresetUpsertOne) UpdateSchedulerID() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateSchedulerID()
+	})
+}
+
+// ClearSchedulerID clears the value of the "scheduler_id" field.
+func (u *GenerationPresetUpsertOne) ClearSchedulerID() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearSchedulerID()
+	})
+}
+
+// SetWidth sets the "width" field.
+func (u *GenerationPresetUpsertOne) SetWidth(v int32) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetWidth(v)
+	})
+}
+
+// AddWidth adds v to the "width" field.
+func (u *GenerationPresetUpsertOne) AddWidth(v int32) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.AddWidth(v)
+	})
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *GenerationPresetUpsertOne) UpdateWidth() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateWidth()
+	})
+}
+
+// ClearWidth clears the value of the "width" field.
+func (u *GenerationPresetUpsertOne) ClearWidth() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearWidth()
+	})
+}
+
+// SetHeight sets the "height" field.
+func (u *GenerationPresetUpsertOne) SetHeight(v int32) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetHeight(v)
+	})
+}
+
+// AddHeight adds v to the "height" field.
+func (u *GenerationPresetUpsertOne) AddHeight(v int32) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.AddHeight(v)
+	})
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *GenerationPresetUpsertOne) UpdateHeight() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateHeight()
+	})
+}
+
+// ClearHeight clears the value of the "height" field.
+func (u *GenerationPresetUpsertOne) ClearHeight() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearHeight()
+	})
+}
+
+// SetInferenceSteps sets the "inference_steps" field.
+func (u *GenerationPresetUpsertOne) SetInferenceSteps(v int32) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetInferenceSteps(v)
+	})
+}
+
+// AddInferenceSteps adds v to the "inference_steps" field.
+func (u *GenerationPresetUpsertOne) AddInferenceSteps(v int32) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.AddInferenceSteps(v)
+	})
+}
+
+// UpdateInferenceSteps sets the "inference_steps" field to the value that was provided on create.
+func (u *GenerationPresetUpsertOne) UpdateInferenceSteps() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateInferenceSteps()
+	})
+}
+
+// ClearInferenceSteps clears the value of the "inference_steps" field.
+func (u *GenerationPresetUpsertOne) ClearInferenceSteps() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearInferenceSteps()
+	})
+}
+
+// SetGuidanceScale sets the "guidance_scale" field.
+func (u *GenerationPresetUpsertOne) SetGuidanceScale(v float32) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetGuidanceScale(v)
+	})
+}
+
+// AddGuidanceScale adds v to the "guidance_scale" field.
+func (u *GenerationPresetUpsertOne) AddGuidanceScale(v float32) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.AddGuidanceScale(v)
+	})
+}
+
+// UpdateGuidanceScale sets the "guidance_scale" field to the value that was provided on create.
+func (u *GenerationPresetUpsertOne) UpdateGuidanceScale() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateGuidanceScale()
+	})
+}
+
+// ClearGuidanceScale clears the value of the "guidance_scale" field.
+func (u *GenerationPresetUpsertOne) ClearGuidanceScale() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearGuidanceScale()
+	})
+}
+
+// SetNumOutputs sets the "num_outputs" field.
+func (u *GenerationPresetUpsertOne) SetNumOutputs(v int32) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetNumOutputs(v)
+	})
+}
+
+// AddNumOutputs adds v to the "num_outputs" field.
+func (u *GenerationPresetUpsertOne) AddNumOutputs(v int32) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.AddNumOutputs(v)
+	})
+}
+
+// UpdateNumOutputs sets the "num_outputs" field to the value that was provided on create.
+func (u *GenerationPresetUpsertOne) UpdateNumOutputs() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateNumOutputs()
+	})
+}
+
+// ClearNumOutputs clears the value of the "num_outputs" field.
+func (u *GenerationPresetUpsertOne) ClearNumOutputs() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearNumOutputs()
+	})
+}
+
+// SetPromptStrength sets the "prompt_strength" field.
+func (u *GenerationPresetUpsertOne) SetPromptStrength(v float32) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetPromptStrength(v)
+	})
+}
+
+// AddPromptStrength adds v to the "prompt_strength" field.
+func (u *GenerationPresetUpsertOne) AddPromptStrength(v float32) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.AddPromptStrength(v)
+	})
+}
+
+// UpdatePromptStrength sets the "prompt_strength" field to the value that was provided on create.
+func (u *GenerationPresetUpsertOne) UpdatePromptStrength() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdatePromptStrength()
+	})
+}
+
+// ClearPromptStrength clears the value of the "prompt_strength" field.
+func (u *GenerationPresetUpsertOne) ClearPromptStrength() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearPromptStrength()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GenerationPresetUpsertOne) SetUpdatedAt(v time.Time) *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GenerationPresetUpsertOne) UpdateUpdatedAt() *GenerationPresetUpsertOne {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GenerationPresetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GenerationPresetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GenerationPresetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GenerationPresetUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GenerationPresetUpsertOne.ID is not supported by MySQL driver. Use GenerationPresetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GenerationPresetUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GenerationPresetCreateBulk is the builder for creating many GenerationPreset entities in bulk.
+type GenerationPresetCreateBulk struct {
+	config
+	err      error
+	builders []*GenerationPresetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GenerationPreset entities in the database.
+func (gpcb *GenerationPresetCreateBulk) Save(ctx context.Context) ([]*GenerationPreset, error) {
+	if gpcb.err != nil {
+		return nil, gpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gpcb.builders))
+	nodes := make([]*GenerationPreset, len(gpcb.builders))
+	mutators := make([]Mutator, len(gpcb.builders))
+	for i := range gpcb.builders {
+		func(i int, root context.Context) {
+			builder := gpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GenerationPresetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = gpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gpcb *GenerationPresetCreateBulk) SaveX(ctx context.Context) []*GenerationPreset {
+	v, err := gpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gpcb *GenerationPresetCreateBulk) Exec(ctx context.Context) error {
+	_, err := gpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpcb *GenerationPresetCreateBulk) ExecX(ctx context.Context) {
+	if err := gpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GenerationPreset.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GenerationPresetUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (gpcb *GenerationPresetCreateBulk) OnConflict(opts ...sql.ConflictOption) *GenerationPresetUpsertBulk {
+	gpcb.conflict = opts
+	return &GenerationPresetUpsertBulk{
+		create: gpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GenerationPreset.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gpcb *GenerationPresetCreateBulk) OnConflictColumns(columns ...string) *GenerationPresetUpsertBulk {
+	gpcb.conflict = append(gpcb.conflict, sql.ConflictColumns(columns...))
+	return &GenerationPresetUpsertBulk{
+		create: gpcb,
+	}
+}
+
+// GenerationPresetUpsertBulk is the builder for "upsert"-ing
+// a bulk of GenerationPreset nodes.
+type GenerationPresetUpsertBulk struct {
+	create *GenerationPresetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GenerationPreset.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(generationpreset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GenerationPresetUpsertBulk) UpdateNewValues() *GenerationPresetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(generationpreset.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(generationpreset.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GenerationPreset.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GenerationPresetUpsertBulk) Ignore() *GenerationPresetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GenerationPresetUpsertBulk) DoNothing() *GenerationPresetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GenerationPresetCreateBulk.OnConflict
+// documentation for more info.
+func (u *GenerationPresetUpsertBulk) Update(set func(*GenerationPresetUpsert)) *GenerationPresetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GenerationPresetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GenerationPresetUpsertBulk) SetUserID(v uuid.UUID) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GenerationPresetUpsertBulk) UpdateUserID() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GenerationPresetUpsertBulk) SetName(v string) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GenerationPresetUpsertBulk) UpdateName() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPromptTemplate sets the "prompt_template" field.
+func (u *GenerationPresetUpsertBulk) SetPromptTemplate(v string) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetPromptTemplate(v)
+	})
+}
+
+// UpdatePromptTemplate sets the "prompt_template" field to the value that was provided on create.
+func (u *GenerationPresetUpsertBulk) UpdatePromptTemplate() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdatePromptTemplate()
+	})
+}
+
+// ClearPromptTemplate clears the value of the "prompt_template" field.
+func (u *GenerationPresetUpsertBulk) ClearPromptTemplate() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearPromptTemplate()
+	})
+}
+
+// SetNegativePrompt sets the "negative_prompt" field.
+func (u *GenerationPresetUpsertBulk) SetNegativePrompt(v string) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetNegativePrompt(v)
+	})
+}
+
+// UpdateNegativePrompt sets the "negative_prompt" field to the value that was provided on create.
+func (u *GenerationPresetUpsertBulk) UpdateNegativePrompt() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateNegativePrompt()
+	})
+}
+
+// ClearNegativePrompt clears the value of the "negative_prompt" field.
+func (u *GenerationPresetUpsertBulk) ClearNegativePrompt() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearNegativePrompt()
+	})
+}
+
+// SetModelID sets the "model_id" field.
+func (u *GenerationPresetUpsertBulk) SetModelID(v uuid.UUID) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetModelID(v)
+	})
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *GenerationPresetUpsertBulk) UpdateModelID() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateModelID()
+	})
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (u *GenerationPresetUpsertBulk) ClearModelID() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearModelID()
+	})
+}
+
+// SetSchedulerID sets the "scheduler_id" field.
+func (u *GenerationPresetUpsertBulk) SetSchedulerID(v uuid.UUID) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetSchedulerID(v)
+	})
+}
+
+// UpdateSchedulerID sets the "scheduler_id" field to the value that was provided on create.
+func (u *GenerationPresetUpsertBulk) UpdateSchedulerID() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateSchedulerID()
+	})
+}
+
+// ClearSchedulerID clears the value of the "scheduler_id" field.
+func (u *GenerationPresetUpsertBulk) ClearSchedulerID() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearSchedulerID()
+	})
+}
+
+// SetWidth sets the "width" field.
+func (u *GenerationPresetUpsertBulk) SetWidth(v int32) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetWidth(v)
+	})
+}
+
+// AddWidth adds v to the "width" field.
+func (u *GenerationPresetUpsertBulk) AddWidth(v int32) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.AddWidth(v)
+	})
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *GenerationPresetUpsertBulk) UpdateWidth() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateWidth()
+	})
+}
+
+// ClearWidth clears the value of the "width" field.
+func (u *GenerationPresetUpsertBulk) ClearWidth() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearWidth()
+	})
+}
+
+// SetHeight sets the "height" field.
+func (u *GenerationPresetUpsertBulk) SetHeight(v int32) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetHeight(v)
+	})
+}
+
+// AddHeight adds v to the "height" field.
+func (u *GenerationPresetUpsertBulk) AddHeight(v int32) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.AddHeight(v)
+	})
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *GenerationPresetUpsertBulk) UpdateHeight() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateHeight()
+	})
+}
+
+// ClearHeight clears the value of the "height" field.
+func (u *GenerationPresetUpsertBulk) ClearHeight() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearHeight()
+	})
+}
+
+// SetInferenceSteps sets the "inference_steps" field.
+func (u *GenerationPresetUpsertBulk) SetInferenceSteps(v int32) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetInferenceSteps(v)
+	})
+}
+
+// AddInferenceSteps adds v to the "inference_steps" field.
+func (u *GenerationPresetUpsertBulk) AddInferenceSteps(v int32) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.AddInferenceSteps(v)
+	})
+}
+
+// UpdateInferenceSteps sets the "inference_steps" field to the value that was provided on create.
+func (u *GenerationPresetUpsertBulk) UpdateInferenceSteps() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateInferenceSteps()
+	})
+}
+
+// ClearInferenceSteps clears the value of the "inference_steps" field.
+func (u *GenerationPresetUpsertBulk) ClearInferenceSteps() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearInferenceSteps()
+	})
+}
+
+// SetGuidanceScale sets the "guidance_scale" field.
+func (u *GenerationPresetUpsertBulk) SetGuidanceScale(v float32) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetGuidanceScale(v)
+	})
+}
+
+// AddGuidanceScale adds v to the "guidance_scale" field.
+func (u *GenerationPresetUpsertBulk) AddGuidanceScale(v float32) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.AddGuidanceScale(v)
+	})
+}
+
+// UpdateGuidanceScale sets the "guidance_scale" field to the value that was provided on create.
+func (u *GenerationPresetUpsertBulk) UpdateGuidanceScale() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateGuidanceScale()
+	})
+}
+
+// ClearGuidanceScale clears the value of the "guidance_scale" field.
+func (u *GenerationPresetUpsertBulk) ClearGuidanceScale() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearGuidanceScale()
+	})
+}
+
+// SetNumOutputs sets the "num_outputs" field.
+func (u *GenerationPresetUpsertBulk) SetNumOutputs(v int32) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetNumOutputs(v)
+	})
+}
+
+// AddNumOutputs adds v to the "num_outputs" field.
+func (u *GenerationPresetUpsertBulk) AddNumOutputs(v int32) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.AddNumOutputs(v)
+	})
+}
+
+// UpdateNumOutputs sets the "num_outputs" field to the value that was provided on create.
+func (u *GenerationPresetUpsertBulk) UpdateNumOutputs() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateNumOutputs()
+	})
+}
+
+// ClearNumOutputs clears the value of the "num_outputs" field.
+func (u *GenerationPresetUpsertBulk) ClearNumOutputs() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearNumOutputs()
+	})
+}
+
+// SetPromptStrength sets the "prompt_strength" field.
+func (u *GenerationPresetUpsertBulk) SetPromptStrength(v float32) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetPromptStrength(v)
+	})
+}
+
+// AddPromptStrength adds v to the "prompt_strength" field.
+func (u *GenerationPresetUpsertBulk) AddPromptStrength(v float32) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.AddPromptStrength(v)
+	})
+}
+
+// UpdatePromptStrength sets the "prompt_strength" field to the value that was provided on create.
+func (u *GenerationPresetUpsertBulk) UpdatePromptStrength() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdatePromptStrength()
+	})
+}
+
+// ClearPromptStrength clears the value of the "prompt_strength" field.
+func (u *GenerationPresetUpsertBulk) ClearPromptStrength() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.ClearPromptStrength()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GenerationPresetUpsertBulk) SetUpdatedAt(v time.Time) *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GenerationPresetUpsertBulk) UpdateUpdatedAt() *GenerationPresetUpsertBulk {
+	return u.Update(func(s *GenerationPresetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GenerationPresetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GenerationPresetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GenerationPresetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GenerationPresetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
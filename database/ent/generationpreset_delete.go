@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stablecog/sc-go/database/ent/generationpreset"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// GenerationPresetDelete is the builder for deleting a GenerationPreset entity.
+type GenerationPresetDelete struct {
+	config
+	hooks    []Hook
+	mutation *GenerationPresetMutation
+}
+
+// Where appends a list predicates to the GenerationPresetDelete builder.
+func (gpd *GenerationPresetDelete) Where(ps ...predicate.GenerationPreset) *GenerationPresetDelete {
+	gpd.mutation.Where(ps...)
+	return gpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gpd *GenerationPresetDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gpd.sqlExec, gpd.mutation, gpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpd *GenerationPresetDelete) ExecX(ctx context.Context) int {
+	n, err := gpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gpd *GenerationPresetDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(generationpreset.Table, sqlgraph.NewFieldSpec(generationpreset.FieldID, field.TypeUUID))
+	if ps := gpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gpd.mutation.done = true
+	return affected, err
+}
+
+// GenerationPresetDeleteOne is the builder for deleting a single GenerationPreset entity.
+type GenerationPresetDeleteOne struct {
+	gpd *GenerationPresetDelete
+}
+
+// Where appends a list predicates to the GenerationPresetDelete builder.
+func (gpdo *GenerationPresetDeleteOne) Where(ps ...predicate.GenerationPreset) *GenerationPresetDeleteOne {
+	gpdo.gpd.mutation.Where(ps...)
+	return gpdo
+}
+
+// Exec executes the deletion query.
+func (gpdo *GenerationPresetDeleteOne) Exec(ctx context.Context) error {
+	n, err := gpdo.gpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{generationpreset.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpdo *GenerationPresetDeleteOne) ExecX(ctx context.Context) {
+	if err := gpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
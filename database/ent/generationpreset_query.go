@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/generationpreset"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// GenerationPresetQuery is the builder for querying GenerationPreset entities.
+type GenerationPresetQuery struct {
+	config
+	ctx        *QueryContext
+	order      []generationpreset.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GenerationPreset
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GenerationPresetQuery builder.
+func (gpq *GenerationPresetQuery) Where(ps ...predicate.GenerationPreset) *GenerationPresetQuery {
+	gpq.predicates = append(gpq.predicates, ps...)
+	return gpq
+}
+
+// Limit the number of records to be returned by this query.
+func (gpq *GenerationPresetQuery) Limit(limit int) *GenerationPresetQuery {
+	gpq.ctx.Limit = &limit
+	return gpq
+}
+
+// Offset to start from.
+func (gpq *GenerationPresetQuery) Offset(offset int) *GenerationPresetQuery {
+	gpq.ctx.Offset = &offset
+	return gpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gpq *GenerationPresetQuery) Unique(unique bool) *GenerationPresetQuery {
+	gpq.ctx.Unique = &unique
+	return gpq
+}
+
+// Order specifies how the records should be ordered.
+func (gpq *GenerationPresetQuery) Order(o ...generationpreset.OrderOption) *GenerationPresetQuery {
+	gpq.order = append(gpq.order, o...)
+	return gpq
+}
+
+// First returns the first GenerationPreset entity from the query.
+// Returns a *NotFoundError when no GenerationPreset was found.
+func (gpq *GenerationPresetQuery) First(ctx context.Context) (*GenerationPreset, error) {
+	nodes, err := gpq.Limit(1).All(setContextOp(ctx, gpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{generationpreset.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gpq *GenerationPresetQuery) FirstX(ctx context.Context) *GenerationPreset {
+	node, err := gpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GenerationPreset ID from the query.
+// Returns a *NotFoundError when no GenerationPreset ID was found.
+func (gpq *GenerationPresetQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = gpq.Limit(1).IDs(setContextOp(ctx, gpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{generationpreset.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gpq *GenerationPresetQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := gpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GenerationPreset entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GenerationPreset entity is found.
+// Returns a *NotFoundError when no GenerationPreset entities are found.
+func (gpq *GenerationPresetQuery) Only(ctx context.Context) (*GenerationPreset, error) {
+	nodes, err := gpq.Limit(2).All(setContextOp(ctx, gpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{generationpreset.Label}
+	default:
+		return nil, &NotSingularError{generationpreset.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gpq *GenerationPresetQuery) OnlyX(ctx context.Context) *GenerationPreset {
+	node, err := gpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GenerationPreset ID in the query.
+// Returns a *NotSingularError when more than one GenerationPreset ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gpq *GenerationPresetQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = gpq.Limit(2).IDs(setContextOp(ctx, gpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{generationpreset.Label}
+	default:
+		err = &NotSingularError{generationpreset.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gpq *GenerationPresetQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := gpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GenerationPresets.
+func (gpq *GenerationPresetQuery) All(ctx context.Context) ([]*GenerationPreset, error) {
+	ctx = setContextOp(ctx, gpq.ctx, ent.OpQueryAll)
+	if err := gpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GenerationPreset, *GenerationPresetQuery]()
+	return withInterceptors[[]*GenerationPreset](ctx, gpq, qr, gpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gpq *GenerationPresetQuery) AllX(ctx context.Context) []*GenerationPreset {
+	nodes, err := gpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GenerationPreset IDs.
+func (gpq *GenerationPresetQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if gpq.ctx.Unique == nil && gpq.path != nil {
+		gpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gpq.ctx, ent.OpQueryIDs)
+	if err = gpq.Select(generationpreset.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gpq *GenerationPresetQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := gpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gpq *GenerationPresetQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gpq.ctx, ent.OpQueryCount)
+	if err := gpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gpq, querierCount[*GenerationPresetQuery](), gpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gpq *GenerationPresetQuery) CountX(ctx context.Context) int {
+	count, err := gpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gpq *GenerationPresetQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gpq.ctx, ent.OpQueryExist)
+	switch _, err := gpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gpq *GenerationPresetQuery) ExistX(ctx context.Context) bool {
+	exist, err := gpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GenerationPresetQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gpq *GenerationPresetQuery) Clone() *GenerationPresetQuery {
+	if gpq == nil {
+		return nil
+	}
+	return &GenerationPresetQuery{
+		config:     gpq.config,
+		ctx:        gpq.ctx.Clone(),
+		order:      append([]generationpreset.OrderOption{}, gpq.order...),
+		inters:     append([]Interceptor{}, gpq.inters...),
+		predicates: append([]predicate.GenerationPreset{}, gpq.predicates...),
+		// clone intermediate query.
+		sql:  gpq.sql.Clone(),
+		path: gpq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GenerationPreset.Query().
+//		GroupBy(generationpreset.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gpq *GenerationPresetQuery) GroupBy(field string, fields ...string) *GenerationPresetGroupBy {
+	gpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GenerationPresetGroupBy{build: gpq}
+	grbuild.flds = &gpq.ctx.Fields
+	grbuild.label = generationpreset.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//	}
+//
+//	client.GenerationPreset.Query().
+//		Select(generationpreset.FieldUserID).
+//		Scan(ctx, &v)
+func (gpq *GenerationPresetQuery) Select(fields ...string) *GenerationPresetSelect {
+	gpq.ctx.Fields = append(gpq.ctx.Fields, fields...)
+	sbuild := &GenerationPresetSelect{GenerationPresetQuery: gpq}
+	sbuild.label = generationpreset.Label
+	sbuild.flds, sbuild.scan = &gpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GenerationPresetSelect configured with the given aggregations.
+func (gpq *GenerationPresetQuery) Aggregate(fns ...AggregateFunc) *GenerationPresetSelect {
+	return gpq.Select().Aggregate(fns...)
+}
+
+func (gpq *GenerationPresetQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gpq.ctx.Fields {
+		if !generationpreset.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gpq.path != nil {
+		prev, err := gpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gpq.sql = prev
+	}
+	return nil
+}
+
+func (gpq *GenerationPresetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GenerationPreset, error) {
+	var (
+		nodes = []*GenerationPreset{}
+		_spec = gpq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GenerationPreset).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GenerationPreset{config: gpq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(gpq.modifiers) > 0 {
+		_spec.Modifiers = gpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (gpq *GenerationPresetQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gpq.querySpec()
+	if len(gpq.modifiers) > 0 {
+		_spec.Modifiers = gpq.modifiers
+	}
+	_spec.Node.Columns = gpq.ctx.Fields
+	if len(gpq.ctx.Fields) > 0 {
+		_spec.Unique = gpq.ctx.Unique != nil && *gpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gpq.driver, _spec)
+}
+
+func (gpq *GenerationPresetQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(generationpreset.Table, generationpreset.Columns, sqlgraph.NewFieldSpec(generationpreset.FieldID, field.TypeUUID))
+	_spec.From = gpq.sql
+	if unique := gpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, generationpreset.FieldID)
+		for i := range fields {
+			if fields[i] != generationpreset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := gpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gpq *GenerationPresetQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gpq.driver.Dialect())
+	t1 := builder.Table(generationpreset.Table)
+	columns := gpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = generationpreset.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gpq.sql != nil {
+		selector = gpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gpq.ctx.Unique != nil && *gpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range gpq.modifiers {
+		m(selector)
+	}
+	for _, p := range gpq.predicates {
+		p(selector)
+	}
+	for _, p := range gpq.order {
+		p(selector)
+	}
+	if offset := gpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (gpq *GenerationPresetQuery) Modify(modifiers ...func(s *sql.Selector)) *GenerationPresetSelect {
+	gpq.modifiers = append(gpq.modifiers, modifiers...)
+	return gpq.Select()
+}
+
+// GenerationPresetGroupBy is the group-by builder for GenerationPreset entities.
+type GenerationPresetGroupBy struct {
+	selector
+	build *GenerationPresetQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gpgb *GenerationPresetGroupBy) Aggregate(fns ...AggregateFunc) *GenerationPresetGroupBy {
+	gpgb.fns = append(gpgb.fns, fns...)
+	return gpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gpgb *GenerationPresetGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := gpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GenerationPresetQuery, *GenerationPresetGroupBy](ctx, gpgb.build, gpgb, gpgb.build.inters, v)
+}
+
+func (gpgb *GenerationPresetGroupBy) sqlScan(ctx context.Context, root *GenerationPresetQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gpgb.fns))
+	for _, fn := range gpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gpgb.flds)+len(gpgb.fns))
+		for _, f := range *gpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GenerationPresetSelect is the builder for selecting fields of GenerationPreset entities.
+type GenerationPresetSelect struct {
+	*GenerationPresetQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gps *GenerationPresetSelect) Aggregate(fns ...AggregateFunc) *GenerationPresetSelect {
+	gps.fns = append(gps.fns, fns...)
+	return gps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gps *GenerationPresetSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gps.ctx, ent.OpQuerySelect)
+	if err := gps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GenerationPresetQuery, *GenerationPresetSelect](ctx, gps.GenerationPresetQuery, gps, gps.inters, v)
+}
+
+func (gps *GenerationPresetSelect) sqlScan(ctx context.Context, root *GenerationPresetQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gps.fns))
+	for _, fn := range gps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (gps *GenerationPresetSelect) Modify(modifiers ...func(s *sql.Selector)) *GenerationPresetSelect {
+	gps.modifiers = append(gps.modifiers, modifiers...)
+	return gps
+}
@@ -0,0 +1,937 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/generationpreset"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// GenerationPresetUpdate is the builder for updating GenerationPreset entities.
+type GenerationPresetUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *GenerationPresetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the GenerationPresetUpdate builder.
+func (gpu *GenerationPresetUpdate) Where(ps ...predicate.GenerationPreset) *GenerationPresetUpdate {
+	gpu.mutation.Where(ps...)
+	return gpu
+}
+
+// SetUserID sets the "user_id" field.
+func (gpu *GenerationPresetUpdate) SetUserID(u uuid.UUID) *GenerationPresetUpdate {
+	gpu.mutation.SetUserID(u)
+	return gpu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (gpu *GenerationPresetUpdate) SetNillableUserID(u *uuid.UUID) *GenerationPresetUpdate {
+	if u != nil {
+		gpu.SetUserID(*u)
+	}
+	return gpu
+}
+
+// SetName sets the "name" field.
+func (gpu *GenerationPresetUpdate) SetName(s string) *GenerationPresetUpdate {
+	gpu.mutation.SetName(s)
+	return gpu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (gpu *GenerationPresetUpdate) SetNillableName(s *string) *GenerationPresetUpdate {
+	if s != nil {
+		gpu.SetName(*s)
+	}
+	return gpu
+}
+
+// SetPromptTemplate sets the "prompt_template" field.
+func (gpu *GenerationPresetUpdate) SetPromptTemplate(s string) *GenerationPresetUpdate {
+	gpu.mutation.SetPromptTemplate(s)
+	return gpu
+}
+
+// SetNillablePromptTemplate sets the "prompt_template" field if the given value is not nil.
+func (gpu *GenerationPresetUpdate) SetNillablePromptTemplate(s *string) *GenerationPresetUpdate {
+	if s != nil {
+		gpu.SetPromptTemplate(*s)
+	}
+	return gpu
+}
+
+// ClearPromptTemplate clears the value of the "prompt_template" field.
+func (gpu *GenerationPresetUpdate) ClearPromptTemplate() *GenerationPresetUpdate {
+	gpu.mutation.ClearPromptTemplate()
+	return gpu
+}
+
+// SetNegativePrompt sets the "negative_prompt" field.
+func (gpu *GenerationPresetUpdate) SetNegativePrompt(s string) *GenerationPresetUpdate {
+	gpu.mutation.SetNegativePrompt(s)
+	return gpu
+}
+
+// SetNillableNegativePrompt sets the "negative_prompt" field if the given value is not nil.
+func (gpu *GenerationPresetUpdate) SetNillableNegativePrompt(s *string) *GenerationPresetUpdate {
+	if s != nil {
+		gpu.SetNegativePrompt(*s)
+	}
+	return gpu
+}
+
+// ClearNegativePrompt clears the value of the "negative_prompt" field.
+func (gpu *GenerationPresetUpdate) ClearNegativePrompt() *GenerationPresetUpdate {
+	gpu.mutation.ClearNegativePrompt()
+	return gpu
+}
+
+// SetModelID sets the "model_id" field.
+func (gpu *GenerationPresetUpdate) SetModelID(u uuid.UUID) *GenerationPresetUpdate {
+	gpu.mutation.SetModelID(u)
+	return gpu
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (gpu *GenerationPresetUpdate) SetNillableModelID(u *uuid.UUID) *GenerationPresetUpdate {
+	if u != nil {
+		gpu.SetModelID(*u)
+	}
+	return gpu
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (gpu *GenerationPresetUpdate) ClearModelID() *GenerationPresetUpdate {
+	gpu.mutation.ClearModelID()
+	return gpu
+}
+
+// SetSchedulerID sets the "scheduler_id" field.
+func (gpu *GenerationPresetUpdate) SetSchedulerID(u uuid.UUID) *GenerationPresetUpdate {
+	gpu.mutation.SetSchedulerID(u)
+	return gpu
+}
+
+// SetNillableSchedulerID sets the "scheduler_id" field if the given value is not nil.
+func (gpu *GenerationPresetUpdate) SetNillableSchedulerID(u *uuid.UUID) *GenerationPresetUpdate {
+	if u != nil {
+		gpu.SetSchedulerID(*u)
+	}
+	return gpu
+}
+
+// ClearSchedulerID clears the value of the "scheduler_id" field.
+func (gpu *GenerationPresetUpdate) ClearSchedulerID() *GenerationPresetUpdate {
+	gpu.mutation.ClearSchedulerID()
+	return gpu
+}
+
+// SetWidth sets the "width" field.
+func (gpu *GenerationPresetUpdate) SetWidth(i int32) *GenerationPresetUpdate {
+	gpu.mutation.ResetWidth()
+	gpu.mutation.SetWidth(i)
+	return gpu
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (gpu *GenerationPresetUpdate) SetNillableWidth(i *int32) *GenerationPresetUpdate {
+	if i != nil {
+		gpu.SetWidth(*i)
+	}
+	return gpu
+}
+
+// AddWidth adds i to the "width" field.
+func (gpu *GenerationPresetUpdate) AddWidth(i int32) *GenerationPresetUpdate {
+	gpu.mutation.AddWidth(i)
+	return gpu
+}
+
+// ClearWidth clears the value of the "width" field.
+func (gpu *GenerationPresetUpdate) ClearWidth() *GenerationPresetUpdate {
+	gpu.mutation.ClearWidth()
+	return gpu
+}
+
+// SetHeight sets the "height" field.
+func (gpu *GenerationPresetUpdate) SetHeight(i int32) *GenerationPresetUpdate {
+	gpu.mutation.ResetHeight()
+	gpu.mutation.SetHeight(i)
+	return gpu
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (gpu *GenerationPresetUpdate) SetNillableHeight(i *int32) *GenerationPresetUpdate {
+	if i != nil {
+		gpu.SetHeight(*i)
+	}
+	return gpu
+}
+
+// AddHeight adds i to the "height" field.
+func (gpu *GenerationPresetUpdate) AddHeight(i int32) *GenerationPresetUpdate {
+	gpu.mutation.AddHeight(i)
+	return gpu
+}
+
+// ClearHeight clears the value of the "height" field.
+func (gpu *GenerationPresetUpdate) ClearHeight() *GenerationPresetUpdate {
+	gpu.mutation.ClearHeight()
+	return gpu
+}
+
+// SetInferenceSteps sets the "inference_steps" field.
+func (gpu *GenerationPresetUpdate) SetInferenceSteps(i int32) *GenerationPresetUpdate {
+	gpu.mutation.ResetInferenceSteps()
+	gpu.mutation.SetInferenceSteps(i)
+	return gpu
+}
+
+// SetNillableInferenceSteps sets the "inference_steps" field if the given value is not nil.
+func (gpu *GenerationPresetUpdate) SetNillableInferenceSteps(i *int32) *GenerationPresetUpdate {
+	if i != nil {
+		gpu.SetInferenceSteps(*i)
+	}
+	return gpu
+}
+
+// AddInferenceSteps adds i to the "inference_steps" field.
+func (gpu *GenerationPresetUpdate) AddInferenceSteps(i int32) *GenerationPresetUpdate {
+	gpu.mutation.AddInferenceSteps(i)
+	return gpu
+}
+
+// ClearInferenceSteps clears the value of the "inference_steps" field.
+func (gpu *GenerationPresetUpdate) ClearInferenceSteps() *GenerationPresetUpdate {
+	gpu.mutation.ClearInferenceSteps()
+	return gpu
+}
+
+// SetGuidanceScale sets the "guidance_scale" field.
+func (gpu *GenerationPresetUpdate) SetGuidanceScale(f float32) *GenerationPresetUpdate {
+	gpu.mutation.ResetGuidanceScale()
+	gpu.mutation.SetGuidanceScale(f)
+	return gpu
+}
+
+// SetNillableGuidanceScale sets the "guidance_scale" field if the given value is not nil.
+func (gpu *GenerationPresetUpdate) SetNillableGuidanceScale(f *float32) *GenerationPresetUpdate {
+	if f != nil {
+		gpu.SetGuidanceScale(*f)
+	}
+	return gpu
+}
+
+// AddGuidanceScale adds f to the "guidance_scale" field.
+func (gpu *GenerationPresetUpdate) AddGuidanceScale(f float32) *GenerationPresetUpdate {
+	gpu.mutation.AddGuidanceScale(f)
+	return gpu
+}
+
+// ClearGuidanceScale clears the value of the "guidance_scale" field.
+func (gpu *GenerationPresetUpdate) ClearGuidanceScale() *GenerationPresetUpdate {
+	gpu.mutation.ClearGuidanceScale()
+	return gpu
+}
+
+// SetNumOutputs sets the "num_outputs" field.
+func (gpu *GenerationPresetUpdate) SetNumOutputs(i int32) *GenerationPresetUpdate {
+	gpu.mutation.ResetNumOutputs()
+	gpu.mutation.SetNumOutputs(i)
+	return gpu
+}
+
+// SetNillableNumOutputs sets the "num_outputs" field if the given value is not nil.
+func (gpu *GenerationPresetUpdate) SetNillableNumOutputs(i *int32) *GenerationPresetUpdate {
+	if i != nil {
+		gpu.SetNumOutputs(*i)
+	}
+	return gpu
+}
+
+// AddNumOutputs adds i to the "num_outputs" field.
+func (gpu *GenerationPresetUpdate) AddNumOutputs(i int32) *GenerationPresetUpdate {
+	gpu.mutation.AddNumOutputs(i)
+	return gpu
+}
+
+// ClearNumOutputs clears the value of the "num_outputs" field.
+func (gpu *GenerationPresetUpdate) ClearNumOutputs() *GenerationPresetUpdate {
+	gpu.mutation.ClearNumOutputs()
+	return gpu
+}
+
+// SetPromptStrength sets the "prompt_strength" field.
+func (gpu *GenerationPresetUpdate) SetPromptStrength(f float32) *GenerationPresetUpdate {
+	gpu.mutation.ResetPromptStrength()
+	gpu.mutation.SetPromptStrength(f)
+	return gpu
+}
+
+// SetNillablePromptStrength sets the "prompt_strength" field if the given value is not nil.
+func (gpu *GenerationPresetUpdate) SetNillablePromptStrength(f *float32) *GenerationPresetUpdate {
+	if f != nil {
+		gpu.SetPromptStrength(*f)
+	}
+	return gpu
+}
+
+// AddPromptStrength adds f to the "prompt_strength" field.
+func (gpu *GenerationPresetUpdate) AddPromptStrength(f float32) *GenerationPresetUpdate {
+	gpu.mutation.AddPromptStrength(f)
+	return gpu
+}
+
+// ClearPromptStrength clears the value of the "prompt_strength" field.
+func (gpu *GenerationPresetUpdate) ClearPromptStrength() *GenerationPresetUpdate {
+	gpu.mutation.ClearPromptStrength()
+	return gpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gpu *GenerationPresetUpdate) SetUpdatedAt(t time.Time) *GenerationPresetUpdate {
+	gpu.mutation.SetUpdatedAt(t)
+	return gpu
+}
+
+// Mutation returns the GenerationPresetMutation object of the builder.
+func (gpu *GenerationPresetUpdate) Mutation() *GenerationPresetMutation {
+	return gpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gpu *GenerationPresetUpdate) Save(ctx context.Context) (int, error) {
+	gpu.defaults()
+	return withHooks(ctx, gpu.sqlSave, gpu.mutation, gpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gpu *GenerationPresetUpdate) SaveX(ctx context.Context) int {
+	affected, err := gpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gpu *GenerationPresetUpdate) Exec(ctx context.Context) error {
+	_, err := gpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpu *GenerationPresetUpdate) ExecX(ctx context.Context) {
+	if err := gpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gpu *GenerationPresetUpdate) defaults() {
+	if _, ok := gpu.mutation.UpdatedAt(); !ok {
+		v := generationpreset.UpdateDefaultUpdatedAt()
+		gpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (gpu *GenerationPresetUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GenerationPresetUpdate {
+	gpu.modifiers = append(gpu.modifiers, modifiers...)
+	return gpu
+}
+
+func (gpu *GenerationPresetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(generationpreset.Table, generationpreset.Columns, sqlgraph.NewFieldSpec(generationpreset.FieldID, field.TypeUUID))
+	if ps := gpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gpu.mutation.UserID(); ok {
+		_spec.SetField(generationpreset.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := gpu.mutation.Name(); ok {
+		_spec.SetField(generationpreset.FieldName, field.TypeString, value)
+	}
+	if value, ok := gpu.mutation.PromptTemplate(); ok {
+		_spec.SetField(generationpreset.FieldPromptTemplate, field.TypeString, value)
+	}
+	if gpu.mutation.PromptTemplateCleared() {
+		_spec.ClearField(generationpreset.FieldPromptTemplate, field.TypeString)
+	}
+	if value, ok := gpu.mutation.NegativePrompt(); ok {
+		_spec.SetField(generationpreset.FieldNegativePrompt, field.TypeString, value)
+	}
+	if gpu.mutation.NegativePromptCleared() {
+		_spec.ClearField(generationpreset.FieldNegativePrompt, field.TypeString)
+	}
+	if value, ok := gpu.mutation.ModelID(); ok {
+		_spec.SetField(generationpreset.FieldModelID, field.TypeUUID, value)
+	}
+	if gpu.mutation.ModelIDCleared() {
+		_spec.ClearField(generationpreset.FieldModelID, field.TypeUUID)
+	}
+	if value, ok := gpu.mutation.SchedulerID(); ok {
+		_spec.SetField(generationpreset.FieldSchedulerID, field.TypeUUID, value)
+	}
+	if gpu.mutation.SchedulerIDCleared() {
+		_spec.ClearField(generationpreset.FieldSchedulerID, field.TypeUUID)
+	}
+	if value, ok := gpu.mutation.Width(); ok {
+		_spec.SetField(generationpreset.FieldWidth, field.TypeInt32, value)
+	}
+	if value, ok := gpu.mutation.AddedWidth(); ok {
+		_spec.AddField(generationpreset.FieldWidth, field.TypeInt32, value)
+	}
+	if gpu.mutation.WidthCleared() {
+		_spec.ClearField(generationpreset.FieldWidth, field.TypeInt32)
+	}
+	if value, ok := gpu.mutation.Height(); ok {
+		_spec.SetField(generationpreset.FieldHeight, field.TypeInt32, value)
+	}
+	if value, ok := gpu.mutation.AddedHeight(); ok {
+		_spec.AddField(generationpreset.FieldHeight, field.TypeInt32, value)
+	}
+	if gpu.mutation.HeightCleared() {
+		_spec.ClearField(generationpreset.FieldHeight, field.TypeInt32)
+	}
+	if value, ok := gpu.mutation.InferenceSteps(); ok {
+		_spec.SetField(generationpreset.FieldInferenceSteps, field.TypeInt32, value)
+	}
+	if value, ok := gpu.mutation.AddedInferenceSteps(); ok {
+		_spec.AddField(generationpreset.FieldInferenceSteps, field.TypeInt32, value)
+	}
+	if gpu.mutation.InferenceStepsCleared() {
+		_spec.ClearField(generationpreset.FieldInferenceSteps, field.TypeInt32)
+	}
+	if value, ok := gpu.mutation.GuidanceScale(); ok {
+		_spec.SetField(generationpreset.FieldGuidanceScale, field.TypeFloat32, value)
+	}
+	if value, ok := gpu.mutation.AddedGuidanceScale(); ok {
+		_spec.AddField(generationpreset.FieldGuidanceScale, field.TypeFloat32, value)
+	}
+	if gpu.mutation.GuidanceScaleCleared() {
+		_spec.ClearField(generationpreset.FieldGuidanceScale, field.TypeFloat32)
+	}
+	if value, ok := gpu.mutation.NumOutputs(); ok {
+		_spec.SetField(generationpreset.FieldNumOutputs, field.TypeInt32, value)
+	}
+	if value, ok := gpu.mutation.AddedNumOutputs(); ok {
+		_spec.AddField(generationpreset.FieldNumOutputs, field.TypeInt32, value)
+	}
+	if gpu.mutation.NumOutputsCleared() {
+		_spec.ClearField(generationpreset.FieldNumOutputs, field.TypeInt32)
+	}
+	if value, ok := gpu.mutation.PromptStrength(); ok {
+		_spec.SetField(generationpreset.FieldPromptStrength, field.TypeFloat32, value)
+	}
+	if value, ok := gpu.mutation.AddedPromptStrength(); ok {
+		_spec.AddField(generationpreset.FieldPromptStrength, field.TypeFloat32, value)
+	}
+	if gpu.mutation.PromptStrengthCleared() {
+		_spec.ClearField(generationpreset.FieldPromptStrength, field.TypeFloat32)
+	}
+	if value, ok := gpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(generationpreset.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(gpu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, gpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{generationpreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gpu.mutation.done = true
+	return n, nil
+}
+
+// GenerationPresetUpdateOne is the builder for updating a single GenerationPreset entity.
+type GenerationPresetUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *GenerationPresetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (gpuo *GenerationPresetUpdateOne) SetUserID(u uuid.UUID) *GenerationPresetUpdateOne {
+	gpuo.mutation.SetUserID(u)
+	return gpuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (gpuo *GenerationPresetUpdateOne) SetNillableUserID(u *uuid.UUID) *GenerationPresetUpdateOne {
+	if u != nil {
+		gpuo.SetUserID(*u)
+	}
+	return gpuo
+}
+
+// SetName sets the "name" field.
+func (gpuo *GenerationPresetUpdateOne) SetName(s string) *GenerationPresetUpdateOne {
+	gpuo.mutation.SetName(s)
+	return gpuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (gpuo *GenerationPresetUpdateOne) SetNillableName(s *string) *GenerationPresetUpdateOne {
+	if s != nil {
+		gpuo.SetName(*s)
+	}
+	return gpuo
+}
+
+// SetPromptTemplate sets the "prompt_template" field.
+func (gpuo *GenerationPresetUpdateOne) SetPromptTemplate(s string) *GenerationPresetUpdateOne {
+	gpuo.mutation.SetPromptTemplate(s)
+	return gpuo
+}
+
+// SetNillablePromptTemplate sets the "prompt_template" field if the given value is not nil.
+func (gpuo *GenerationPresetUpdateOne) SetNillablePromptTemplate(s *string) *GenerationPresetUpdateOne {
+	if s != nil {
+		gpuo.SetPromptTemplate(*s)
+	}
+	return gpuo
+}
+
+// ClearPromptTemplate clears the value of the "prompt_template" field.
+func (gpuo *GenerationPresetUpdateOne) ClearPromptTemplate() *GenerationPresetUpdateOne {
+	gpuo.mutation.ClearPromptTemplate()
+	return gpuo
+}
+
+// SetNegativePrompt sets the "negative_prompt" field.
+func (gpuo *GenerationPresetUpdateOne) SetNegativePrompt(s string) *GenerationPresetUpdateOne {
+	gpuo.mutation.SetNegativePrompt(s)
+	return gpuo
+}
+
+// SetNillableNegativePrompt sets the "negative_prompt" field if the given value is not nil.
+func (gpuo *GenerationPresetUpdateOne) SetNillableNegativePrompt(s *string) *GenerationPresetUpdateOne {
+	if s != nil {
+		gpuo.SetNegativePrompt(*s)
+	}
+	return gpuo
+}
+
+// ClearNegativePrompt clears the value of the "negative_prompt" field.
+func (gpuo *GenerationPresetUpdateOne) ClearNegativePrompt() *GenerationPresetUpdateOne {
+	gpuo.mutation.ClearNegativePrompt()
+	return gpuo
+}
+
+// SetModelID sets the "model_id" field.
+func (gpuo *GenerationPresetUpdateOne) SetModelID(u uuid.UUID) *GenerationPresetUpdateOne {
+	gpuo.mutation.SetModelID(u)
+	return gpuo
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (gpuo *GenerationPresetUpdateOne) SetNillableModelID(u *uuid.UUID) *GenerationPresetUpdateOne {
+	if u != nil {
+		gpuo.SetModelID(*u)
+	}
+	return gpuo
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (gpuo *GenerationPresetUpdateOne) ClearModelID() *GenerationPresetUpdateOne {
+	gpuo.mutation.ClearModelID()
+	return gpuo
+}
+
+// SetSchedulerID sets the "scheduler_id" field.
+func (gpuo *GenerationPresetUpdateOne) SetSchedulerID(u uuid.UUID) *GenerationPresetUpdateOne {
+	gpuo.mutation.SetSchedulerID(u)
+	return gpuo
+}
+
+// SetNillableSchedulerID sets the "scheduler_id" field if the given value is not nil.
+func (gpuo *GenerationPresetUpdateOne) SetNillableSchedulerID(u *uuid.UUID) *GenerationPresetUpdateOne {
+	if u != nil {
+		gpuo.SetSchedulerID(*u)
+	}
+	return gpuo
+}
+
+// ClearSchedulerID clears the value of the "scheduler_id" field.
+func (gpuo *GenerationPresetUpdateOne) ClearSchedulerID() *GenerationPresetUpdateOne {
+	gpuo.mutation.ClearSchedulerID()
+	return gpuo
+}
+
+// SetWidth sets the "width" field.
+func (gpuo *GenerationPresetUpdateOne) SetWidth(i int32) *GenerationPresetUpdateOne {
+	gpuo.mutation.ResetWidth()
+	gpuo.mutation.SetWidth(i)
+	return gpuo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (gpuo *GenerationPresetUpdateOne) SetNillableWidth(i *int32) *GenerationPresetUpdateOne {
+	if i != nil {
+		gpuo.SetWidth(*i)
+	}
+	return gpuo
+}
+
+// AddWidth adds i to the "width" field.
+func (gpuo *GenerationPresetUpdateOne) AddWidth(i int32) *GenerationPresetUpdateOne {
+	gpuo.mutation.AddWidth(i)
+	return gpuo
+}
+
+// ClearWidth clears the value of the "width" field.
+func (gpuo *GenerationPresetUpdateOne) ClearWidth() *GenerationPresetUpdateOne {
+	gpuo.mutation.ClearWidth()
+	return gpuo
+}
+
+// SetHeight sets the "height" field.
+func (gpuo *GenerationPresetUpdateOne) SetHeight(i int32) *GenerationPresetUpdateOne {
+	gpuo.mutation.ResetHeight()
+	gpuo.mutation.SetHeight(i)
+	return gpuo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (gpuo *GenerationPresetUpdateOne) SetNillableHeight(i *int32) *GenerationPresetUpdateOne {
+	if i != nil {
+		gpuo.SetHeight(*i)
+	}
+	return gpuo
+}
+
+// AddHeight adds i to the "height" field.
+func (gpuo *GenerationPresetUpdateOne) AddHeight(i int32) *GenerationPresetUpdateOne {
+	gpuo.mutation.AddHeight(i)
+	return gpuo
+}
+
+// ClearHeight clears the value of the "height" field.
+func (gpuo *GenerationPresetUpdateOne) ClearHeight() *GenerationPresetUpdateOne {
+	gpuo.mutation.ClearHeight()
+	return gpuo
+}
+
+// SetInferenceSteps sets the "inference_steps" field.
+func (gpuo *GenerationPresetUpdateOne) SetInferenceSteps(i int32) *GenerationPresetUpdateOne {
+	gpuo.mutation.ResetInferenceSteps()
+	gpuo.mutation.SetInferenceSteps(i)
+	return gpuo
+}
+
+// SetNillableInferenceSteps sets the "inference_steps" field if the given value is not nil.
+func (gpuo *GenerationPresetUpdateOne) SetNillableInferenceSteps(i *int32) *GenerationPresetUpdateOne {
+	if i != nil {
+		gpuo.SetInferenceSteps(*i)
+	}
+	return gpuo
+}
+
+// AddInferenceSteps adds i to the "inference_steps" field.
+func (gpuo *GenerationPresetUpdateOne) AddInferenceSteps(i int32) *GenerationPresetUpdateOne {
+	gpuo.mutation.AddInferenceSteps(i)
+	return gpuo
+}
+
+// ClearInferenceSteps clears the value of the "inference_steps" field.
+func (gpuo *GenerationPresetUpdateOne) ClearInferenceSteps() *GenerationPresetUpdateOne {
+	gpuo.mutation.ClearInferenceSteps()
+	return gpuo
+}
+
+// SetGuidanceScale sets the "guidance_scale" field.
+func (gpuo *GenerationPresetUpdateOne) SetGuidanceScale(f float32) *GenerationPresetUpdateOne {
+	gpuo.mutation.ResetGuidanceScale()
+	gpuo.mutation.SetGuidanceScale(f)
+	return gpuo
+}
+
+// SetNillableGuidanceScale sets the "guidance_scale" field if the given value is not nil.
+func (gpuo *GenerationPresetUpdateOne) SetNillableGuidanceScale(f *float32) *GenerationPresetUpdateOne {
+	if f != nil {
+		gpuo.SetGuidanceScale(*f)
+	}
+	return gpuo
+}
+
+// AddGuidanceScale adds f to the "guidance_scale" field.
+func (gpuo *GenerationPresetUpdateOne) AddGuidanceScale(f float32) *GenerationPresetUpdateOne {
+	gpuo.mutation.AddGuidanceScale(f)
+	return gpuo
+}
+
+// ClearGuidanceScale clears the value of the "guidance_scale" field.
+func (gpuo *GenerationPresetUpdateOne) ClearGuidanceScale() *GenerationPresetUpdateOne {
+	gpuo.mutation.ClearGuidanceScale()
+	return gpuo
+}
+
+// SetNumOutputs sets the "num_outputs" field.
+func (gpuo *GenerationPresetUpdateOne) SetNumOutputs(i int32) *GenerationPresetUpdateOne {
+	gpuo.mutation.ResetNumOutputs()
+	gpuo.mutation.SetNumOutputs(i)
+	return gpuo
+}
+
+// SetNillableNumOutputs sets the "num_outputs" field if the given value is not nil.
+func (gpuo *GenerationPresetUpdateOne) SetNillableNumOutputs(i *int32) *GenerationPresetUpdateOne {
+	if i != nil {
+		gpuo.SetNumOutputs(*i)
+	}
+	return gpuo
+}
+
+// AddNumOutputs adds i to the "num_outputs" field.
+func (gpuo *GenerationPresetUpdateOne) AddNumOutputs(i int32) *GenerationPresetUpdateOne {
+	gpuo.mutation.AddNumOutputs(i)
+	return gpuo
+}
+
+// ClearNumOutputs clears the value of the "num_outputs" field.
+func (gpuo *GenerationPresetUpdateOne) ClearNumOutputs() *GenerationPresetUpdateOne {
+	gpuo.mutation.ClearNumOutputs()
+	return gpuo
+}
+
+// SetPromptStrength sets the "prompt_strength" field.
+func (gpuo *GenerationPresetUpdateOne) SetPromptStrength(f float32) *GenerationPresetUpdateOne {
+	gpuo.mutation.ResetPromptStrength()
+	gpuo.mutation.SetPromptStrength(f)
+	return gpuo
+}
+
+// SetNillablePromptStrength sets the "prompt_strength" field if the given value is not nil.
+func (gpuo *GenerationPresetUpdateOne) SetNillablePromptStrength(f *float32) *GenerationPresetUpdateOne {
+	if f != nil {
+		gpuo.SetPromptStrength(*f)
+	}
+	return gpuo
+}
+
+// AddPromptStrength adds f to the "prompt_strength" field.
+func (gpuo *GenerationPresetUpdateOne) AddPromptStrength(f float32) *GenerationPresetUpdateOne {
+	gpuo.mutation.AddPromptStrength(f)
+	return gpuo
+}
+
+// ClearPromptStrength clears the value of the "prompt_strength" field.
+func (gpuo *GenerationPresetUpdateOne) ClearPromptStrength() *GenerationPresetUpdateOne {
+	gpuo.mutation.ClearPromptStrength()
+	return gpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gpuo *GenerationPresetUpdateOne) SetUpdatedAt(t time.Time) *GenerationPresetUpdateOne {
+	gpuo.mutation.SetUpdatedAt(t)
+	return gpuo
+}
+
+// Mutation returns the GenerationPresetMutation object of the builder.
+func (gpuo *GenerationPresetUpdateOne) Mutation() *GenerationPresetMutation {
+	return gpuo.mutation
+}
+
+// Where appends a list predicates to the GenerationPresetUpdate builder.
+func (gpuo *GenerationPresetUpdateOne) Where(ps ...predicate.GenerationPreset) *GenerationPresetUpdateOne {
+	gpuo.mutation.Where(ps...)
+	return gpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gpuo *GenerationPresetUpdateOne) Select(field string, fields ...string) *GenerationPresetUpdateOne {
+	gpuo.fields = append([]string{field}, fields...)
+	return gpuo
+}
+
+// Save executes the query and returns the updated GenerationPreset entity.
+func (gpuo *GenerationPresetUpdateOne) Save(ctx context.Context) (*GenerationPreset, error) {
+	gpuo.defaults()
+	return withHooks(ctx, gpuo.sqlSave, gpuo.mutation, gpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gpuo *GenerationPresetUpdateOne) SaveX(ctx context.Context) *GenerationPreset {
+	node, err := gpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gpuo *GenerationPresetUpdateOne) Exec(ctx context.Context) error {
+	_, err := gpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpuo *GenerationPresetUpdateOne) ExecX(ctx context.Context) {
+	if err := gpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gpuo *GenerationPresetUpdateOne) defaults() {
+	if _, ok := gpuo.mutation.UpdatedAt(); !ok {
+		v := generationpreset.UpdateDefaultUpdatedAt()
+		gpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (gpuo *GenerationPresetUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GenerationPresetUpdateOne {
+	gpuo.modifiers = append(gpuo.modifiers, modifiers...)
+	return gpuo
+}
+
+func (gpuo *GenerationPresetUpdateOne) sqlSave(ctx context.Context) (_node *GenerationPreset, err error) {
+	_spec := sqlgraph.NewUpdateSpec(generationpreset.Table, generationpreset.Columns, sqlgraph.NewFieldSpec(generationpreset.FieldID, field.TypeUUID))
+	id, ok := gpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GenerationPreset.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, generationpreset.FieldID)
+		for _, f := range fields {
+			if !generationpreset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != generationpreset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gpuo.mutation.UserID(); ok {
+		_spec.SetField(generationpreset.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := gpuo.mutation.Name(); ok {
+		_spec.SetField(generationpreset.FieldName, field.TypeString, value)
+	}
+	if value, ok := gpuo.mutation.PromptTemplate(); ok {
+		_spec.SetField(generationpreset.FieldPromptTemplate, field.TypeString, value)
+	}
+	if gpuo.mutation.PromptTemplateCleared() {
+		_spec.ClearField(generationpreset.FieldPromptTemplate, field.TypeString)
+	}
+	if value, ok := gpuo.mutation.NegativePrompt(); ok {
+		_spec.SetField(generationpreset.FieldNegativePrompt, field.TypeString, value)
+	}
+	if gpuo.mutation.NegativePromptCleared() {
+		_spec.ClearField(generationpreset.FieldNegativePrompt, field.TypeString)
+	}
+	if value, ok := gpuo.mutation.ModelID(); ok {
+		_spec.SetField(generationpreset.FieldModelID, field.TypeUUID, value)
+	}
+	if gpuo.mutation.ModelIDCleared() {
+		_spec.ClearField(generationpreset.FieldModelID, field.TypeUUID)
+	}
+	if value, ok := gpuo.mutation.SchedulerID(); ok {
+		_spec.SetField(generationpreset.FieldSchedulerID, field.TypeUUID, value)
+	}
+	if gpuo.mutation.SchedulerIDCleared() {
+		_spec.ClearField(generationpreset.FieldSchedulerID, field.TypeUUID)
+	}
+	if value, ok := gpuo.mutation.Width(); ok {
+		_spec.SetField(generationpreset.FieldWidth, field.TypeInt32, value)
+	}
+	if value, ok := gpuo.mutation.AddedWidth(); ok {
+		_spec.AddField(generationpreset.FieldWidth, field.TypeInt32, value)
+	}
+	if gpuo.mutation.WidthCleared() {
+		_spec.ClearField(generationpreset.FieldWidth, field.TypeInt32)
+	}
+	if value, ok := gpuo.mutation.Height(); ok {
+		_spec.SetField(generationpreset.FieldHeight, field.TypeInt32, value)
+	}
+	if value, ok := gpuo.mutation.AddedHeight(); ok {
+		_spec.AddField(generationpreset.FieldHeight, field.TypeInt32, value)
+	}
+	if gpuo.mutation.HeightCleared() {
+		_spec.ClearField(generationpreset.FieldHeight, field.TypeInt32)
+	}
+	if value, ok := gpuo.mutation.InferenceSteps(); ok {
+		_spec.SetField(generationpreset.FieldInferenceSteps, field.TypeInt32, value)
+	}
+	if value, ok := gpuo.mutation.AddedInferenceSteps(); ok {
+		_spec.AddField(generationpreset.FieldInferenceSteps, field.TypeInt32, value)
+	}
+	if gpuo.mutation.InferenceStepsCleared() {
+		_spec.ClearField(generationpreset.FieldInferenceSteps, field.TypeInt32)
+	}
+	if value, ok := gpuo.mutation.GuidanceScale(); ok {
+		_spec.SetField(generationpreset.FieldGuidanceScale, field.TypeFloat32, value)
+	}
+	if value, ok := gpuo.mutation.AddedGuidanceScale(); ok {
+		_spec.AddField(generationpreset.FieldGuidanceScale, field.TypeFloat32, value)
+	}
+	if gpuo.mutation.GuidanceScaleCleared() {
+		_spec.ClearField(generationpreset.FieldGuidanceScale, field.TypeFloat32)
+	}
+	if value, ok := gpuo.mutation.NumOutputs(); ok {
+		_spec.SetField(generationpreset.FieldNumOutputs, field.TypeInt32, value)
+	}
+	if value, ok := gpuo.mutation.AddedNumOutputs(); ok {
+		_spec.AddField(generationpreset.FieldNumOutputs, field.TypeInt32, value)
+	}
+	if gpuo.mutation.NumOutputsCleared() {
+		_spec.ClearField(generationpreset.FieldNumOutputs, field.TypeInt32)
+	}
+	if value, ok := gpuo.mutation.PromptStrength(); ok {
+		_spec.SetField(generationpreset.FieldPromptStrength, field.TypeFloat32, value)
+	}
+	if value, ok := gpuo.mutation.AddedPromptStrength(); ok {
+		_spec.AddField(generationpreset.FieldPromptStrength, field.TypeFloat32, value)
+	}
+	if gpuo.mutation.PromptStrengthCleared() {
+		_spec.ClearField(generationpreset.FieldPromptStrength, field.TypeFloat32)
+	}
+	if value, ok := gpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(generationpreset.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(gpuo.modifiers...)
+	_node = &GenerationPreset{config: gpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{generationpreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gpuo.mutation.done = true
+	return _node, nil
+}
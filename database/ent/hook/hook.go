@@ -21,6 +21,18 @@ func (f ApiTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ApiTokenMutation", m)
 }
 
+// The ApiTokenUsageFunc type is an adapter to allow the use of ordinary
+// function as ApiTokenUsage mutator.
+type ApiTokenUsageFunc func(context.Context, *ent.ApiTokenUsageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ApiTokenUsageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ApiTokenUsageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ApiTokenUsageMutation", m)
+}
+
 // The AuthClientFunc type is an adapter to allow the use of ordinary
 // function as AuthClient mutator.
 type AuthClientFunc func(context.Context, *ent.AuthClientMutation) (ent.Value, error)
@@ -57,6 +69,30 @@ func (f CreditFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CreditMutation", m)
 }
 
+// The CreditHoldFunc type is an adapter to allow the use of ordinary
+// function as CreditHold mutator.
+type CreditHoldFunc func(context.Context, *ent.CreditHoldMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CreditHoldFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CreditHoldMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CreditHoldMutation", m)
+}
+
+// The CreditTransactionFunc type is an adapter to allow the use of ordinary
+// function as CreditTransaction mutator.
+type CreditTransactionFunc func(context.Context, *ent.CreditTransactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CreditTransactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CreditTransactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CreditTransactionMutation", m)
+}
+
 // The CreditTypeFunc type is an adapter to allow the use of ordinary
 // function as CreditType mutator.
 type CreditTypeFunc func(context.Context, *ent.CreditTypeMutation) (ent.Value, error)
@@ -69,6 +105,30 @@ func (f CreditTypeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CreditTypeMutation", m)
 }
 
+// The CronJobRunFunc type is an adapter to allow the use of ordinary
+// function as CronJobRun mutator.
+type CronJobRunFunc func(context.Context, *ent.CronJobRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CronJobRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CronJobRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CronJobRunMutation", m)
+}
+
+// The DeadLetterFunc type is an adapter to allow the use of ordinary
+// function as DeadLetter mutator.
+type DeadLetterFunc func(context.Context, *ent.DeadLetterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeadLetterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeadLetterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeadLetterMutation", m)
+}
+
 // The DeviceInfoFunc type is an adapter to allow the use of ordinary
 // function as DeviceInfo mutator.
 type DeviceInfoFunc func(context.Context, *ent.DeviceInfoMutation) (ent.Value, error)
@@ -105,6 +165,18 @@ func (f GenerationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GenerationMutation", m)
 }
 
+// The GenerationBatchFunc type is an adapter to allow the use of ordinary
+// function as GenerationBatch mutator.
+type GenerationBatchFunc func(context.Context, *ent.GenerationBatchMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GenerationBatchFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GenerationBatchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GenerationBatchMutation", m)
+}
+
 // The GenerationModelFunc type is an adapter to allow the use of ordinary
 // function as GenerationModel mutator.
 type GenerationModelFunc func(context.Context, *ent.GenerationModelMutation) (ent.Value, error)
@@ -141,6 +213,18 @@ func (f GenerationOutputLikeFunc) Mutate(ctx context.Context, m ent.Mutation) (e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GenerationOutputLikeMutation", m)
 }
 
+// The GenerationPresetFunc type is an adapter to allow the use of ordinary
+// function as GenerationPreset mutator.
+type GenerationPresetFunc func(context.Context, *ent.GenerationPresetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GenerationPresetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GenerationPresetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GenerationPresetMutation", m)
+}
+
 // The IPBlackListFunc type is an adapter to allow the use of ordinary
 // function as IPBlackList mutator.
 type IPBlackListFunc func(context.Context, *ent.IPBlackListMutation) (ent.Value, error)
@@ -153,6 +237,18 @@ func (f IPBlackListFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IPBlackListMutation", m)
 }
 
+// The ModerationVerdictFunc type is an adapter to allow the use of ordinary
+// function as ModerationVerdict mutator.
+type ModerationVerdictFunc func(context.Context, *ent.ModerationVerdictMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModerationVerdictFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ModerationVerdictMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ModerationVerdictMutation", m)
+}
+
 // The MqLogFunc type is an adapter to allow the use of ordinary
 // function as MqLog mutator.
 type MqLogFunc func(context.Context, *ent.MqLogMutation) (ent.Value, error)
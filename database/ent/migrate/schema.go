@@ -18,6 +18,12 @@ var (
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "uses", Type: field.TypeInt, Default: 0},
 		{Name: "credits_spent", Type: field.TypeInt, Default: 0},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "monthly_credit_cap", Type: field.TypeInt, Nullable: true},
+		{Name: "monthly_credits_spent", Type: field.TypeInt, Default: 0},
+		{Name: "monthly_credits_period", Type: field.TypeTime, Nullable: true},
+		{Name: "rotated_from_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -32,18 +38,45 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "api_tokens_auth_clients_api_tokens",
-				Columns:    []*schema.Column{APITokensColumns[10]},
+				Columns:    []*schema.Column{APITokensColumns[16]},
 				RefColumns: []*schema.Column{AuthClientsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "api_tokens_users_api_tokens",
-				Columns:    []*schema.Column{APITokensColumns[11]},
+				Columns:    []*schema.Column{APITokensColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// APITokenUsagesColumns holds the columns for the "api_token_usages" table.
+	APITokenUsagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "api_token_id", Type: field.TypeUUID},
+		{Name: "ip", Type: field.TypeString, Size: 2147483647},
+		{Name: "endpoint", Type: field.TypeString, Size: 2147483647},
+		{Name: "credits_spent", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// APITokenUsagesTable holds the schema information for the "api_token_usages" table.
+	APITokenUsagesTable = &schema.Table{
+		Name:       "api_token_usages",
+		Columns:    APITokenUsagesColumns,
+		PrimaryKey: []*schema.Column{APITokenUsagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apitokenusage_api_token_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{APITokenUsagesColumns[1], APITokenUsagesColumns[5]},
+			},
+			{
+				Name:    "apitokenusage_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{APITokenUsagesColumns[5]},
+			},
+		},
+	}
 	// AuthClientsColumns holds the columns for the "auth_clients" table.
 	AuthClientsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -118,6 +151,77 @@ var (
 			},
 		},
 	}
+	// CreditHoldsColumns holds the columns for the "credit_holds" table.
+	CreditHoldsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "amount", Type: field.TypeInt32},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"held", "captured", "released", "split"}},
+		{Name: "process_type", Type: field.TypeEnum, Enums: []string{"generate", "upscale", "voiceover", "generation_batch"}},
+		{Name: "job_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "api_token_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "api_token_period", Type: field.TypeTime, Nullable: true},
+		{Name: "release_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "captured_at", Type: field.TypeTime, Nullable: true},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CreditHoldsTable holds the schema information for the "credit_holds" table.
+	CreditHoldsTable = &schema.Table{
+		Name:       "credit_holds",
+		Columns:    CreditHoldsColumns,
+		PrimaryKey: []*schema.Column{CreditHoldsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "credithold_job_id",
+				Unique:  false,
+				Columns: []*schema.Column{CreditHoldsColumns[5]},
+			},
+			{
+				Name:    "credithold_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{CreditHoldsColumns[1], CreditHoldsColumns[3]},
+			},
+			{
+				Name:    "credithold_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{CreditHoldsColumns[3], CreditHoldsColumns[10]},
+			},
+		},
+	}
+	// CreditTransactionsColumns holds the columns for the "credit_transactions" table.
+	CreditTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "credit_id", Type: field.TypeUUID},
+		{Name: "credit_type_id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"subscription", "purchase", "free", "replenish", "admin", "spend", "hold", "release", "refund", "tip_sent", "tip_received", "expire", "revoke"}},
+		{Name: "amount", Type: field.TypeInt32},
+		{Name: "reference_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "stripe_line_item_id", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CreditTransactionsTable holds the schema information for the "credit_transactions" table.
+	CreditTransactionsTable = &schema.Table{
+		Name:       "credit_transactions",
+		Columns:    CreditTransactionsColumns,
+		PrimaryKey: []*schema.Column{CreditTransactionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "credittransaction_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CreditTransactionsColumns[1], CreditTransactionsColumns[8]},
+			},
+			{
+				Name:    "credittransaction_reference_id",
+				Unique:  false,
+				Columns: []*schema.Column{CreditTransactionsColumns[6]},
+			},
+		},
+	}
 	// CreditTypesColumns holds the columns for the "credit_types" table.
 	CreditTypesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -136,6 +240,67 @@ var (
 		Columns:    CreditTypesColumns,
 		PrimaryKey: []*schema.Column{CreditTypesColumns[0]},
 	}
+	// CronJobRunsColumns holds the columns for the "cron_job_runs" table.
+	CronJobRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "job_name", Type: field.TypeString, Size: 2147483647},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"schedule", "manual"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "succeeded", "failed", "skipped"}},
+		{Name: "instance", Type: field.TypeString, Size: 2147483647},
+		{Name: "triggered_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "counts", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// CronJobRunsTable holds the schema information for the "cron_job_runs" table.
+	CronJobRunsTable = &schema.Table{
+		Name:       "cron_job_runs",
+		Columns:    CronJobRunsColumns,
+		PrimaryKey: []*schema.Column{CronJobRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cronjobrun_job_name_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{CronJobRunsColumns[1], CronJobRunsColumns[8]},
+			},
+			{
+				Name:    "cronjobrun_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{CronJobRunsColumns[8]},
+			},
+		},
+	}
+	// DeadLetterQueueColumns holds the columns for the "dead_letter_queue" table.
+	DeadLetterQueueColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "job_id", Type: field.TypeUUID},
+		{Name: "process_type", Type: field.TypeString, Size: 2147483647},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "failure_reason", Type: field.TypeString, Size: 2147483647},
+		{Name: "replayed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DeadLetterQueueTable holds the schema information for the "dead_letter_queue" table.
+	DeadLetterQueueTable = &schema.Table{
+		Name:       "dead_letter_queue",
+		Columns:    DeadLetterQueueColumns,
+		PrimaryKey: []*schema.Column{DeadLetterQueueColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deadletter_job_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeadLetterQueueColumns[1]},
+			},
+			{
+				Name:    "deadletter_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeadLetterQueueColumns[7]},
+			},
+		},
+	}
 	// DeviceInfoColumns holds the columns for the "device_info" table.
 	DeviceInfoColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -184,6 +349,9 @@ var (
 		{Name: "stripe_product_id", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "source_type", Type: field.TypeEnum, Enums: []string{"web-ui", "api", "discord", "internal"}, Default: "web-ui"},
 		{Name: "webhook_token", Type: field.TypeUUID},
+		{Name: "batch_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "source_output_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "requeued_at", Type: field.TypeTime, Nullable: true},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -204,43 +372,43 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "generations_api_tokens_generations",
-				Columns:    []*schema.Column{GenerationsColumns[22]},
+				Columns:    []*schema.Column{GenerationsColumns[25]},
 				RefColumns: []*schema.Column{APITokensColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "generations_device_info_generations",
-				Columns:    []*schema.Column{GenerationsColumns[23]},
+				Columns:    []*schema.Column{GenerationsColumns[26]},
 				RefColumns: []*schema.Column{DeviceInfoColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "generations_generation_models_generations",
-				Columns:    []*schema.Column{GenerationsColumns[24]},
+				Columns:    []*schema.Column{GenerationsColumns[27]},
 				RefColumns: []*schema.Column{GenerationModelsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "generations_negative_prompts_generations",
-				Columns:    []*schema.Column{GenerationsColumns[25]},
+				Columns:    []*schema.Column{GenerationsColumns[28]},
 				RefColumns: []*schema.Column{NegativePromptsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "generations_prompts_generations",
-				Columns:    []*schema.Column{GenerationsColumns[26]},
+				Columns:    []*schema.Column{GenerationsColumns[29]},
 				RefColumns: []*schema.Column{PromptsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "generations_schedulers_generations",
-				Columns:    []*schema.Column{GenerationsColumns[27]},
+				Columns:    []*schema.Column{GenerationsColumns[30]},
 				RefColumns: []*schema.Column{SchedulersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "generations_users_generations",
-				Columns:    []*schema.Column{GenerationsColumns[28]},
+				Columns:    []*schema.Column{GenerationsColumns[31]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -249,17 +417,17 @@ var (
 			{
 				Name:    "generation_user_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{GenerationsColumns[28], GenerationsColumns[20]},
+				Columns: []*schema.Column{GenerationsColumns[31], GenerationsColumns[23]},
 			},
 			{
 				Name:    "generation_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{GenerationsColumns[20]},
+				Columns: []*schema.Column{GenerationsColumns[23]},
 			},
 			{
 				Name:    "generation_updated_at",
 				Unique:  false,
-				Columns: []*schema.Column{GenerationsColumns[21]},
+				Columns: []*schema.Column{GenerationsColumns[24]},
 			},
 			{
 				Name:    "generation_status",
@@ -269,22 +437,76 @@ var (
 			{
 				Name:    "generation_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{GenerationsColumns[28]},
+				Columns: []*schema.Column{GenerationsColumns[31]},
 			},
 			{
 				Name:    "generation_negative_prompt_id",
 				Unique:  false,
-				Columns: []*schema.Column{GenerationsColumns[25]},
+				Columns: []*schema.Column{GenerationsColumns[28]},
 			},
 			{
 				Name:    "generation_status_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{GenerationsColumns[8], GenerationsColumns[28]},
+				Columns: []*schema.Column{GenerationsColumns[8], GenerationsColumns[31]},
 			},
 			{
 				Name:    "generation_prompt_id",
 				Unique:  false,
-				Columns: []*schema.Column{GenerationsColumns[26]},
+				Columns: []*schema.Column{GenerationsColumns[29]},
+			},
+			{
+				Name:    "generation_batch_id",
+				Unique:  false,
+				Columns: []*schema.Column{GenerationsColumns[18]},
+			},
+			{
+				Name:    "generation_source_output_id",
+				Unique:  false,
+				Columns: []*schema.Column{GenerationsColumns[19]},
+			},
+			{
+				Name:    "generation_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{GenerationsColumns[21]},
+			},
+		},
+	}
+	// GenerationBatchesColumns holds the columns for the "generation_batches" table.
+	GenerationBatchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "api_token_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"dispatching", "dispatched", "failed"}},
+		{Name: "items", Type: field.TypeString, Size: 2147483647},
+		{Name: "num_items", Type: field.TypeInt},
+		{Name: "dispatched_items", Type: field.TypeInt, Default: 0},
+		{Name: "rejected_items", Type: field.TypeInt, Default: 0},
+		{Name: "credits_reserved", Type: field.TypeInt32},
+		{Name: "credits_refunded", Type: field.TypeInt32, Default: 0},
+		{Name: "free", Type: field.TypeBool, Default: false},
+		{Name: "queue_tier", Type: field.TypeString, Size: 2147483647, Default: "free"},
+		{Name: "source_output_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "variation_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"seed", "strength_sweep"}},
+		{Name: "origin", Type: field.TypeJSON, Nullable: true},
+		{Name: "dispatched_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// GenerationBatchesTable holds the schema information for the "generation_batches" table.
+	GenerationBatchesTable = &schema.Table{
+		Name:       "generation_batches",
+		Columns:    GenerationBatchesColumns,
+		PrimaryKey: []*schema.Column{GenerationBatchesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "generationbatch_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{GenerationBatchesColumns[1], GenerationBatchesColumns[16]},
+			},
+			{
+				Name:    "generationbatch_status",
+				Unique:  false,
+				Columns: []*schema.Column{GenerationBatchesColumns[3]},
 			},
 		},
 	}
@@ -413,6 +635,37 @@ var (
 			},
 		},
 	}
+	// GenerationPresetsColumns holds the columns for the "generation_presets" table.
+	GenerationPresetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 2147483647},
+		{Name: "prompt_template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "negative_prompt", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "model_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "scheduler_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "width", Type: field.TypeInt32, Nullable: true},
+		{Name: "height", Type: field.TypeInt32, Nullable: true},
+		{Name: "inference_steps", Type: field.TypeInt32, Nullable: true},
+		{Name: "guidance_scale", Type: field.TypeFloat32, Nullable: true},
+		{Name: "num_outputs", Type: field.TypeInt32, Nullable: true},
+		{Name: "prompt_strength", Type: field.TypeFloat32, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// GenerationPresetsTable holds the schema information for the "generation_presets" table.
+	GenerationPresetsTable = &schema.Table{
+		Name:       "generation_presets",
+		Columns:    GenerationPresetsColumns,
+		PrimaryKey: []*schema.Column{GenerationPresetsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "generationpreset_user_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{GenerationPresetsColumns[1], GenerationPresetsColumns[2]},
+			},
+		},
+	}
 	// IPBlacklistColumns holds the columns for the "ip_blacklist" table.
 	IPBlacklistColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -426,12 +679,56 @@ var (
 		Columns:    IPBlacklistColumns,
 		PrimaryKey: []*schema.Column{IPBlacklistColumns[0]},
 	}
+	// ModerationVerdictsColumns holds the columns for the "moderation_verdicts" table.
+	ModerationVerdictsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "generation_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "source_type", Type: field.TypeEnum, Enums: []string{"web-ui", "api", "discord", "internal"}},
+		{Name: "tier", Type: field.TypeString, Size: 2147483647},
+		{Name: "policy", Type: field.TypeString, Size: 2147483647},
+		{Name: "prompt", Type: field.TypeString, Size: 2147483647},
+		{Name: "translated_prompt", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"allow", "flag", "block", "ban"}},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "findings", Type: field.TypeJSON, Nullable: true},
+		{Name: "errors", Type: field.TypeJSON, Nullable: true},
+		{Name: "review_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"upheld", "overturned"}},
+		{Name: "reviewed_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "review_note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ModerationVerdictsTable holds the schema information for the "moderation_verdicts" table.
+	ModerationVerdictsTable = &schema.Table{
+		Name:       "moderation_verdicts",
+		Columns:    ModerationVerdictsColumns,
+		PrimaryKey: []*schema.Column{ModerationVerdictsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "moderationverdict_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationVerdictsColumns[1]},
+			},
+			{
+				Name:    "moderationverdict_action_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationVerdictsColumns[8], ModerationVerdictsColumns[16]},
+			},
+			{
+				Name:    "moderationverdict_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationVerdictsColumns[16]},
+			},
+		},
+	}
 	// MqLogColumns holds the columns for the "mq_log" table.
 	MqLogColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "message_id", Type: field.TypeString, Unique: true, Size: 2147483647},
 		{Name: "priority", Type: field.TypeInt},
 		{Name: "is_processing", Type: field.TypeBool, Default: false},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -557,6 +854,7 @@ var (
 		{Name: "system_generated", Type: field.TypeBool, Default: false},
 		{Name: "source_type", Type: field.TypeEnum, Enums: []string{"web-ui", "api", "discord", "internal"}, Default: "web-ui"},
 		{Name: "webhook_token", Type: field.TypeUUID},
+		{Name: "requeued_at", Type: field.TypeTime, Nullable: true},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -574,29 +872,36 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "upscales_api_tokens_upscales",
-				Columns:    []*schema.Column{UpscalesColumns[15]},
+				Columns:    []*schema.Column{UpscalesColumns[16]},
 				RefColumns: []*schema.Column{APITokensColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "upscales_device_info_upscales",
-				Columns:    []*schema.Column{UpscalesColumns[16]},
+				Columns:    []*schema.Column{UpscalesColumns[17]},
 				RefColumns: []*schema.Column{DeviceInfoColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "upscales_upscale_models_upscales",
-				Columns:    []*schema.Column{UpscalesColumns[17]},
+				Columns:    []*schema.Column{UpscalesColumns[18]},
 				RefColumns: []*schema.Column{UpscaleModelsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "upscales_users_upscales",
-				Columns:    []*schema.Column{UpscalesColumns[18]},
+				Columns:    []*schema.Column{UpscalesColumns[19]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "upscale_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{UpscalesColumns[12]},
+			},
+		},
 	}
 	// UpscaleModelsColumns holds the columns for the "upscale_models" table.
 	UpscaleModelsColumns = []*schema.Column{
@@ -718,6 +1023,7 @@ var (
 		{Name: "remove_silence", Type: field.TypeBool, Default: true},
 		{Name: "cost", Type: field.TypeInt32},
 		{Name: "source_type", Type: field.TypeEnum, Enums: []string{"web-ui", "api", "discord", "internal"}, Default: "web-ui"},
+		{Name: "requeued_at", Type: field.TypeTime, Nullable: true},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -737,41 +1043,48 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "voiceovers_api_tokens_voiceovers",
-				Columns:    []*schema.Column{VoiceoversColumns[16]},
+				Columns:    []*schema.Column{VoiceoversColumns[17]},
 				RefColumns: []*schema.Column{APITokensColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "voiceovers_device_info_voiceovers",
-				Columns:    []*schema.Column{VoiceoversColumns[17]},
+				Columns:    []*schema.Column{VoiceoversColumns[18]},
 				RefColumns: []*schema.Column{DeviceInfoColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "voiceovers_prompts_voiceovers",
-				Columns:    []*schema.Column{VoiceoversColumns[18]},
+				Columns:    []*schema.Column{VoiceoversColumns[19]},
 				RefColumns: []*schema.Column{PromptsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "voiceovers_users_voiceovers",
-				Columns:    []*schema.Column{VoiceoversColumns[19]},
+				Columns:    []*schema.Column{VoiceoversColumns[20]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "voiceovers_voiceover_models_voiceovers",
-				Columns:    []*schema.Column{VoiceoversColumns[20]},
+				Columns:    []*schema.Column{VoiceoversColumns[21]},
 				RefColumns: []*schema.Column{VoiceoverModelsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "voiceovers_voiceover_speakers_voiceovers",
-				Columns:    []*schema.Column{VoiceoversColumns[21]},
+				Columns:    []*schema.Column{VoiceoversColumns[22]},
 				RefColumns: []*schema.Column{VoiceoverSpeakersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "voiceover_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{VoiceoversColumns[13]},
+			},
+		},
 	}
 	// VoiceoverModelsColumns holds the columns for the "voiceover_models" table.
 	VoiceoverModelsColumns = []*schema.Column{
@@ -911,17 +1224,25 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		APITokensTable,
+		APITokenUsagesTable,
 		AuthClientsTable,
 		BannedWordsTable,
 		CreditsTable,
+		CreditHoldsTable,
+		CreditTransactionsTable,
 		CreditTypesTable,
+		CronJobRunsTable,
+		DeadLetterQueueTable,
 		DeviceInfoTable,
 		DisposableEmailsTable,
 		GenerationsTable,
+		GenerationBatchesTable,
 		GenerationModelsTable,
 		GenerationOutputsTable,
 		GenerationOutputLikesTable,
+		GenerationPresetsTable,
 		IPBlacklistTable,
+		ModerationVerdictsTable,
 		MqLogTable,
 		NegativePromptsTable,
 		PromptsTable,
@@ -949,6 +1270,9 @@ func init() {
 	APITokensTable.Annotation = &entsql.Annotation{
 		Table: "api_tokens",
 	}
+	APITokenUsagesTable.Annotation = &entsql.Annotation{
+		Table: "api_token_usages",
+	}
 	AuthClientsTable.Annotation = &entsql.Annotation{
 		Table: "auth_clients",
 	}
@@ -960,9 +1284,21 @@ func init() {
 	CreditsTable.Annotation = &entsql.Annotation{
 		Table: "credits",
 	}
+	CreditHoldsTable.Annotation = &entsql.Annotation{
+		Table: "credit_holds",
+	}
+	CreditTransactionsTable.Annotation = &entsql.Annotation{
+		Table: "credit_transactions",
+	}
 	CreditTypesTable.Annotation = &entsql.Annotation{
 		Table: "credit_types",
 	}
+	CronJobRunsTable.Annotation = &entsql.Annotation{
+		Table: "cron_job_runs",
+	}
+	DeadLetterQueueTable.Annotation = &entsql.Annotation{
+		Table: "dead_letter_queue",
+	}
 	DeviceInfoTable.Annotation = &entsql.Annotation{
 		Table: "device_info",
 	}
@@ -979,6 +1315,9 @@ func init() {
 	GenerationsTable.Annotation = &entsql.Annotation{
 		Table: "generations",
 	}
+	GenerationBatchesTable.Annotation = &entsql.Annotation{
+		Table: "generation_batches",
+	}
 	GenerationModelsTable.Annotation = &entsql.Annotation{
 		Table: "generation_models",
 	}
@@ -991,9 +1330,15 @@ func init() {
 	GenerationOutputLikesTable.Annotation = &entsql.Annotation{
 		Table: "generation_output_likes",
 	}
+	GenerationPresetsTable.Annotation = &entsql.Annotation{
+		Table: "generation_presets",
+	}
 	IPBlacklistTable.Annotation = &entsql.Annotation{
 		Table: "ip_blacklist",
 	}
+	ModerationVerdictsTable.Annotation = &entsql.Annotation{
+		Table: "moderation_verdicts",
+	}
 	MqLogTable.Annotation = &entsql.Annotation{
 		Table: "mq_log",
 	}
@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/moderationverdict"
+	"github.com/stablecog/sc-go/database/enttypes"
+)
+
+// ModerationVerdict is the model entity for the ModerationVerdict schema.
+type ModerationVerdict struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// GenerationID holds the value of the "generation_id" field.
+	GenerationID *uuid.UUID `json:"generation_id,omitempty"`
+	// SourceType holds the value of the "source_type" field.
+	SourceType enttypes.SourceType `json:"source_type,omitempty"`
+	// Tier holds the value of the "tier" field.
+	Tier string `json:"tier,omitempty"`
+	// Policy holds the value of the "policy" field.
+	Policy string `json:"policy,omitempty"`
+	// Prompt holds the value of the "prompt" field.
+	Prompt string `json:"prompt,omitempty"`
+	// TranslatedPrompt holds the value of the "translated_prompt" field.
+	TranslatedPrompt *string `json:"translated_prompt,omitempty"`
+	// Action holds the value of the "action" field.
+	Action moderationverdict.Action `json:"action,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason *string `json:"reason,omitempty"`
+	// Findings holds the value of the "findings" field.
+	Findings []enttypes.ModerationFinding `json:"findings,omitempty"`
+	// Errors holds the value of the "errors" field.
+	Errors []string `json:"errors,omitempty"`
+	// ReviewStatus holds the value of the "review_status" field.
+	ReviewStatus *moderationverdict.ReviewStatus `json:"review_status,omitempty"`
+	// ReviewedBy holds the value of the "reviewed_by" field.
+	ReviewedBy *uuid.UUID `json:"reviewed_by,omitempty"`
+	// ReviewNote holds the value of the "review_note" field.
+	ReviewNote *string `json:"review_note,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModerationVerdict) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case moderationverdict.FieldGenerationID, moderationverdict.FieldReviewedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case moderationverdict.FieldFindings, moderationverdict.FieldErrors:
+			values[i] = new([]byte)
+		case moderationverdict.FieldSourceType, moderationverdict.FieldTier, moderationverdict.FieldPolicy, moderationverdict.FieldPrompt, moderationverdict.FieldTranslatedPrompt, moderationverdict.FieldAction, moderationverdict.FieldReason, moderationverdict.FieldReviewStatus, moderationverdict.FieldReviewNote:
+			values[i] = new(sql.NullString)
+		case moderationverdict.FieldReviewedAt, moderationverdict.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case moderationverdict.FieldID, moderationverdict.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModerationVerdict fields.
+func (mv *ModerationVerdict) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case moderationverdict.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mv.ID = *value
+			}
+		case moderationverdict.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				mv.UserID = *value
+			}
+		case moderationverdict.FieldGenerationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field generation_id", values[i])
+			} else if value.Valid {
+				mv.GenerationID = new(uuid.UUID)
+				*mv.GenerationID = *value.S.(*uuid.UUID)
+			}
+		case moderationverdict.FieldSourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_type", values[i])
+			} else if value.Valid {
+				mv.SourceType = enttypes.SourceType(value.String)
+			}
+		case moderationverdict.FieldTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tier", values[i])
+			} else if value.Valid {
+				mv.Tier = value.String
+			}
+		case moderationverdict.FieldPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy", values[i])
+			} else if value.Valid {
+				mv.Policy = value.String
+			}
+		case moderationverdict.FieldPrompt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt", values[i])
+			} else if value.Valid {
+				mv.Prompt = value.String
+			}
+		case moderationverdict.FieldTranslatedPrompt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field translated_prompt", values[i])
+			} else if value.Valid {
+				mv.TranslatedPrompt = new(string)
+				*mv.TranslatedPrompt = value.String
+			}
+		case moderationverdict.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				mv.Action = moderationverdict.Action(value.String)
+			}
+		case moderationverdict.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				mv.Reason = new(string)
+				*mv.Reason = value.String
+			}
+		case moderationverdict.FieldFindings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field findings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mv.Findings); err != nil {
+					return fmt.Errorf("unmarshal field findings: %w", err)
+				}
+			}
+		case moderationverdict.FieldErrors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field errors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mv.Errors); err != nil {
+					return fmt.Errorf("unmarshal field errors: %w", err)
+				}
+			}
+		case moderationverdict.FieldReviewStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_status", values[i])
+			} else if value.Valid {
+				mv.ReviewStatus = new(moderationverdict.ReviewStatus)
+				*mv.ReviewStatus = moderationverdict.ReviewStatus(value.String)
+			}
+		case moderationverdict.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				mv.ReviewedBy = new(uuid.UUID)
+				*mv.ReviewedBy = *value.S.(*uuid.UUID)
+			}
+		case moderationverdict.FieldReviewNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_note", values[i])
+			} else if value.Valid {
+				mv.ReviewNote = new(string)
+				*mv.ReviewNote = value.String
+			}
+		case moderationverdict.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				mv.ReviewedAt = new(time.Time)
+				*mv.ReviewedAt = value.Time
+			}
+		case moderationverdict.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mv.CreatedAt = value.Time
+			}
+		default:
+			mv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModerationVerdict.
+// This includes values selected through modifiers, order, etc.
+func (mv *ModerationVerdict) Value(name string) (ent.Value, error) {
+	return mv.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ModerationVerdict.
+// Note that you need to call ModerationVerdict.Unwrap() before calling this method if this ModerationVerdict
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mv *ModerationVerdict) Update() *ModerationVerdictUpdateOne {
+	return NewModerationVerdictClient(mv.config).UpdateOne(mv)
+}
+
+// Unwrap unwraps the ModerationVerdict entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mv *ModerationVerdict) Unwrap() *ModerationVerdict {
+	_tx, ok := mv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ModerationVerdict is not a transactional entity")
+	}
+	mv.config.driver = _tx.drv
+	return mv
+}
+
+// String implements the fmt.Stringer.
+func (mv *ModerationVerdict) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModerationVerdict(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mv.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", mv.UserID))
+	builder.WriteString(", ")
+	if v := mv.GenerationID; v != nil {
+		builder.WriteString("generation_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("source_type=")
+	builder.WriteString(fmt.Sprintf("%v", mv.SourceType))
+	builder.WriteString(", ")
+	builder.WriteString("tier=")
+	builder.WriteString(mv.Tier)
+	builder.WriteString(", ")
+	builder.WriteString("policy=")
+	builder.WriteString(mv.Policy)
+	builder.WriteString(", ")
+	builder.WriteString("prompt=")
+	builder.WriteString(mv.Prompt)
+	builder.WriteString(", ")
+	if v := mv.TranslatedPrompt; v != nil {
+		builder.WriteString("translated_prompt=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", mv.Action))
+	builder.WriteString(", ")
+	if v := mv.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("findings=")
+	builder.WriteString(fmt.Sprintf("%v", mv.Findings))
+	builder.WriteString(", ")
+	builder.WriteString("errors=")
+	builder.WriteString(fmt.Sprintf("%v", mv.Errors))
+	builder.WriteString(", ")
+	if v := mv.ReviewStatus; v != nil {
+		builder.WriteString("review_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := mv.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := mv.ReviewNote; v != nil {
+		builder.WriteString("review_note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := mv.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mv.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ModerationVerdicts is a parsable slice of ModerationVerdict.
+type ModerationVerdicts []*ModerationVerdict
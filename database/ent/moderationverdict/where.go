@@ -0,0 +1,872 @@
+// Code generated by ent, DO NOT EDIT.
+
+package moderationverdict
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+	"github.com/stablecog/sc-go/database/enttypes"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldUserID, v))
+}
+
+// GenerationID applies equality check predicate on the "generation_id" field. It's identical to GenerationIDEQ.
+func GenerationID(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldGenerationID, v))
+}
+
+// Tier applies equality check predicate on the "tier" field. It's identical to TierEQ.
+func Tier(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldTier, v))
+}
+
+// Prompt applies equality check predicate on the "prompt" field. It's identical to PromptEQ.
+func Prompt(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldPrompt, v))
+}
+
+// TranslatedPrompt applies equality check predicate on the "translated_prompt" field. It's identical to TranslatedPromptEQ.
+func TranslatedPrompt(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldTranslatedPrompt, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldReason, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewNote applies equality check predicate on the "review_note" field. It's identical to ReviewNoteEQ.
+func ReviewNote(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldReviewNote, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLTE(FieldUserID, v))
+}
+
+// GenerationIDEQ applies the EQ predicate on the "generation_id" field.
+func GenerationIDEQ(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldGenerationID, v))
+}
+
+// GenerationIDNEQ applies the NEQ predicate on the "generation_id" field.
+func GenerationIDNEQ(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldGenerationID, v))
+}
+
+// GenerationIDIn applies the In predicate on the "generation_id" field.
+func GenerationIDIn(vs ...uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldGenerationID, vs...))
+}
+
+// GenerationIDNotIn applies the NotIn predicate on the "generation_id" field.
+func GenerationIDNotIn(vs ...uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldGenerationID, vs...))
+}
+
+// GenerationIDGT applies the GT predicate on the "generation_id" field.
+func GenerationIDGT(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGT(FieldGenerationID, v))
+}
+
+// GenerationIDGTE applies the GTE predicate on the "generation_id" field.
+func GenerationIDGTE(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGTE(FieldGenerationID, v))
+}
+
+// GenerationIDLT applies the LT predicate on the "generation_id" field.
+func GenerationIDLT(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLT(FieldGenerationID, v))
+}
+
+// GenerationIDLTE applies the LTE predicate on the "generation_id" field.
+func GenerationIDLTE(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLTE(FieldGenerationID, v))
+}
+
+// GenerationIDIsNil applies the IsNil predicate on the "generation_id" field.
+func GenerationIDIsNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIsNull(FieldGenerationID))
+}
+
+// GenerationIDNotNil applies the NotNil predicate on the "generation_id" field.
+func GenerationIDNotNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotNull(FieldGenerationID))
+}
+
+// SourceTypeEQ applies the EQ predicate on the "source_type" field.
+func SourceTypeEQ(v enttypes.SourceType) predicate.ModerationVerdict {
+	vc := v
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldSourceType, vc))
+}
+
+// SourceTypeNEQ applies the NEQ predicate on the "source_type" field.
+func SourceTypeNEQ(v enttypes.SourceType) predicate.ModerationVerdict {
+	vc := v
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldSourceType, vc))
+}
+
+// SourceTypeIn applies the In predicate on the "source_type" field.
+func SourceTypeIn(vs ...enttypes.SourceType) predicate.ModerationVerdict {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ModerationVerdict(sql.FieldIn(FieldSourceType, v...))
+}
+
+// SourceTypeNotIn applies the NotIn predicate on the "source_type" field.
+func SourceTypeNotIn(vs ...enttypes.SourceType) predicate.ModerationVerdict {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldSourceType, v...))
+}
+
+// TierEQ applies the EQ predicate on the "tier" field.
+func TierEQ(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldTier, v))
+}
+
+// TierNEQ applies the NEQ predicate on the "tier" field.
+func TierNEQ(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldTier, v))
+}
+
+// TierIn applies the In predicate on the "tier" field.
+func TierIn(vs ...string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldTier, vs...))
+}
+
+// TierNotIn applies the NotIn predicate on the "tier" field.
+func TierNotIn(vs ...string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldTier, vs...))
+}
+
+// TierGT applies the GT predicate on the "tier" field.
+func TierGT(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGT(FieldTier, v))
+}
+
+// TierGTE applies the GTE predicate on the "tier" field.
+func TierGTE(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGTE(FieldTier, v))
+}
+
+// TierLT applies the LT predicate on the "tier" field.
+func TierLT(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLT(FieldTier, v))
+}
+
+// TierLTE applies the LTE predicate on the "tier" field.
+func TierLTE(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLTE(FieldTier, v))
+}
+
+// TierContains applies the Contains predicate on the "tier" field.
+func TierContains(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldContains(FieldTier, v))
+}
+
+// TierHasPrefix applies the HasPrefix predicate on the "tier" field.
+func TierHasPrefix(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldHasPrefix(FieldTier, v))
+}
+
+// TierHasSuffix applies the HasSuffix predicate on the "tier" field.
+func TierHasSuffix(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldHasSuffix(FieldTier, v))
+}
+
+// TierEqualFold applies the EqualFold predicate on the "tier" field.
+func TierEqualFold(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEqualFold(FieldTier, v))
+}
+
+// TierContainsFold applies the ContainsFold predicate on the "tier" field.
+func TierContainsFold(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldContainsFold(FieldTier, v))
+}
+
+// PolicyEQ applies the EQ predicate on the "policy" field.
+func PolicyEQ(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldPolicy, v))
+}
+
+// PolicyNEQ applies the NEQ predicate on the "policy" field.
+func PolicyNEQ(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldPolicy, v))
+}
+
+// PolicyIn applies the In predicate on the "policy" field.
+func PolicyIn(vs ...string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldPolicy, vs...))
+}
+
+// PolicyNotIn applies the NotIn predicate on the "policy" field.
+func PolicyNotIn(vs ...string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldPolicy, vs...))
+}
+
+// PolicyGT applies the GT predicate on the "policy" field.
+func PolicyGT(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGT(FieldPolicy, v))
+}
+
+// PolicyGTE applies the GTE predicate on the "policy" field.
+func PolicyGTE(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGTE(FieldPolicy, v))
+}
+
+// PolicyLT applies the LT predicate on the "policy" field.
+func PolicyLT(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLT(FieldPolicy, v))
+}
+
+// PolicyLTE applies the LTE predicate on the "policy" field.
+func PolicyLTE(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLTE(FieldPolicy, v))
+}
+
+// PolicyContains applies the Contains predicate on the "policy" field.
+func PolicyContains(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldContains(FieldPolicy, v))
+}
+
+// PolicyHasPrefix applies the HasPrefix predicate on the "policy" field.
+func PolicyHasPrefix(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldHasPrefix(FieldPolicy, v))
+}
+
+// PolicyHasSuffix applies the HasSuffix predicate on the "policy" field.
+func PolicyHasSuffix(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldHasSuffix(FieldPolicy, v))
+}
+
+// PolicyEqualFold applies the EqualFold predicate on the "policy" field.
+func PolicyEqualFold(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEqualFold(FieldPolicy, v))
+}
+
+// PolicyContainsFold applies the ContainsFold predicate on the "policy" field.
+func PolicyContainsFold(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldContainsFold(FieldPolicy, v))
+}
+
+// PromptEQ applies the EQ predicate on the "prompt" field.
+func PromptEQ(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldPrompt, v))
+}
+
+// PromptNEQ applies the NEQ predicate on the "prompt" field.
+func PromptNEQ(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldPrompt, v))
+}
+
+// PromptIn applies the In predicate on the "prompt" field.
+func PromptIn(vs ...string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldPrompt, vs...))
+}
+
+// PromptNotIn applies the NotIn predicate on the "prompt" field.
+func PromptNotIn(vs ...string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldPrompt, vs...))
+}
+
+// PromptGT applies the GT predicate on the "prompt" field.
+func PromptGT(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGT(FieldPrompt, v))
+}
+
+// PromptGTE applies the GTE predicate on the "prompt" field.
+func PromptGTE(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGTE(FieldPrompt, v))
+}
+
+// PromptLT applies the LT predicate on the "prompt" field.
+func PromptLT(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLT(FieldPrompt, v))
+}
+
+// PromptLTE applies the LTE predicate on the "prompt" field.
+func PromptLTE(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLTE(FieldPrompt, v))
+}
+
+// PromptContains applies the Contains predicate on the "prompt" field.
+func PromptContains(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldContains(FieldPrompt, v))
+}
+
+// PromptHasPrefix applies the HasPrefix predicate on the "prompt" field.
+func PromptHasPrefix(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldHasPrefix(FieldPrompt, v))
+}
+
+// PromptHasSuffix applies the HasSuffix predicate on the "prompt" field.
+func PromptHasSuffix(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldHasSuffix(FieldPrompt, v))
+}
+
+// PromptEqualFold applies the EqualFold predicate on the "prompt" field.
+func PromptEqualFold(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEqualFold(FieldPrompt, v))
+}
+
+// PromptContainsFold applies the ContainsFold predicate on the "prompt" field.
+func PromptContainsFold(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldContainsFold(FieldPrompt, v))
+}
+
+// TranslatedPromptEQ applies the EQ predicate on the "translated_prompt" field.
+func TranslatedPromptEQ(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldTranslatedPrompt, v))
+}
+
+// TranslatedPromptNEQ applies the NEQ predicate on the "translated_prompt" field.
+func TranslatedPromptNEQ(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldTranslatedPrompt, v))
+}
+
+// TranslatedPromptIn applies the In predicate on the "translated_prompt" field.
+func TranslatedPromptIn(vs ...string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldTranslatedPrompt, vs...))
+}
+
+// TranslatedPromptNotIn applies the NotIn predicate on the "translated_prompt" field.
+func TranslatedPromptNotIn(vs ...string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldTranslatedPrompt, vs...))
+}
+
+// TranslatedPromptGT applies the GT predicate on the "translated_prompt" field.
+func TranslatedPromptGT(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGT(FieldTranslatedPrompt, v))
+}
+
+// TranslatedPromptGTE applies the GTE predicate on the "translated_prompt" field.
+func TranslatedPromptGTE(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGTE(FieldTranslatedPrompt, v))
+}
+
+// TranslatedPromptLT applies the LT predicate on the "translated_prompt" field.
+func TranslatedPromptLT(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLT(FieldTranslatedPrompt, v))
+}
+
+// TranslatedPromptLTE applies the LTE predicate on the "translated_prompt" field.
+func TranslatedPromptLTE(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLTE(FieldTranslatedPrompt, v))
+}
+
+// TranslatedPromptContains applies the Contains predicate on the "translated_prompt" field.
+func TranslatedPromptContains(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldContains(FieldTranslatedPrompt, v))
+}
+
+// TranslatedPromptHasPrefix applies the HasPrefix predicate on the "translated_prompt" field.
+func TranslatedPromptHasPrefix(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldHasPrefix(FieldTranslatedPrompt, v))
+}
+
+// TranslatedPromptHasSuffix applies the HasSuffix predicate on the "translated_prompt" field.
+func TranslatedPromptHasSuffix(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldHasSuffix(FieldTranslatedPrompt, v))
+}
+
+// TranslatedPromptIsNil applies the IsNil predicate on the "translated_prompt" field.
+func TranslatedPromptIsNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIsNull(FieldTranslatedPrompt))
+}
+
+// TranslatedPromptNotNil applies the NotNil predicate on the "translated_prompt" field.
+func TranslatedPromptNotNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotNull(FieldTranslatedPrompt))
+}
+
+// TranslatedPromptEqualFold applies the EqualFold predicate on the "translated_prompt" field.
+func TranslatedPromptEqualFold(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEqualFold(FieldTranslatedPrompt, v))
+}
+
+// TranslatedPromptContainsFold applies the ContainsFold predicate on the "translated_prompt" field.
+func TranslatedPromptContainsFold(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldContainsFold(FieldTranslatedPrompt, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldContainsFold(FieldReason, v))
+}
+
+// FindingsIsNil applies the IsNil predicate on the "findings" field.
+func FindingsIsNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIsNull(FieldFindings))
+}
+
+// FindingsNotNil applies the NotNil predicate on the "findings" field.
+func FindingsNotNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotNull(FieldFindings))
+}
+
+// ErrorsIsNil applies the IsNil predicate on the "errors" field.
+func ErrorsIsNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIsNull(FieldErrors))
+}
+
+// ErrorsNotNil applies the NotNil predicate on the "errors" field.
+func ErrorsNotNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotNull(FieldErrors))
+}
+
+// ReviewStatusEQ applies the EQ predicate on the "review_status" field.
+func ReviewStatusEQ(v ReviewStatus) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldReviewStatus, v))
+}
+
+// ReviewStatusNEQ applies the NEQ predicate on the "review_status" field.
+func ReviewStatusNEQ(v ReviewStatus) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldReviewStatus, v))
+}
+
+// ReviewStatusIn applies the In predicate on the "review_status" field.
+func ReviewStatusIn(vs ...ReviewStatus) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldReviewStatus, vs...))
+}
+
+// ReviewStatusNotIn applies the NotIn predicate on the "review_status" field.
+func ReviewStatusNotIn(vs ...ReviewStatus) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldReviewStatus, vs...))
+}
+
+// ReviewStatusIsNil applies the IsNil predicate on the "review_status" field.
+func ReviewStatusIsNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIsNull(FieldReviewStatus))
+}
+
+// ReviewStatusNotNil applies the NotNil predicate on the "review_status" field.
+func ReviewStatusNotNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotNull(FieldReviewStatus))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v uuid.UUID) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewNoteEQ applies the EQ predicate on the "review_note" field.
+func ReviewNoteEQ(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteNEQ applies the NEQ predicate on the "review_note" field.
+func ReviewNoteNEQ(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteIn applies the In predicate on the "review_note" field.
+func ReviewNoteIn(vs ...string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteNotIn applies the NotIn predicate on the "review_note" field.
+func ReviewNoteNotIn(vs ...string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteGT applies the GT predicate on the "review_note" field.
+func ReviewNoteGT(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGT(FieldReviewNote, v))
+}
+
+// ReviewNoteGTE applies the GTE predicate on the "review_note" field.
+func ReviewNoteGTE(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGTE(FieldReviewNote, v))
+}
+
+// ReviewNoteLT applies the LT predicate on the "review_note" field.
+func ReviewNoteLT(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLT(FieldReviewNote, v))
+}
+
+// ReviewNoteLTE applies the LTE predicate on the "review_note" field.
+func ReviewNoteLTE(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLTE(FieldReviewNote, v))
+}
+
+// ReviewNoteContains applies the Contains predicate on the "review_note" field.
+func ReviewNoteContains(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldContains(FieldReviewNote, v))
+}
+
+// ReviewNoteHasPrefix applies the HasPrefix predicate on the "review_note" field.
+func ReviewNoteHasPrefix(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldHasPrefix(FieldReviewNote, v))
+}
+
+// ReviewNoteHasSuffix applies the HasSuffix predicate on the "review_note" field.
+func ReviewNoteHasSuffix(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldHasSuffix(FieldReviewNote, v))
+}
+
+// ReviewNoteIsNil applies the IsNil predicate on the "review_note" field.
+func ReviewNoteIsNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIsNull(FieldReviewNote))
+}
+
+// ReviewNoteNotNil applies the NotNil predicate on the "review_note" field.
+func ReviewNoteNotNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotNull(FieldReviewNote))
+}
+
+// ReviewNoteEqualFold applies the EqualFold predicate on the "review_note" field.
+func ReviewNoteEqualFold(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEqualFold(FieldReviewNote, v))
+}
+
+// ReviewNoteContainsFold applies the ContainsFold predicate on the "review_note" field.
+func ReviewNoteContainsFold(v string) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldContainsFold(FieldReviewNote, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ModerationVerdict) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ModerationVerdict) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ModerationVerdict) predicate.ModerationVerdict {
+	return predicate.ModerationVerdict(sql.NotPredicates(p))
+}
@@ -0,0 +1,1507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/moderationverdict"
+	"github.com/stablecog/sc-go/database/enttypes"
+)
+
+// ModerationVerdictCreate is the builder for creating a ModerationVerdict entity.
+type ModerationVerdictCreate struct {
+	config
+	mutation *ModerationVerdictMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (mvc *ModerationVerdictCreate) SetUserID(u uuid.UUID) *ModerationVerdictCreate {
+	mvc.mutation.SetUserID(u)
+	return mvc
+}
+
+// SetGenerationID sets the "generation_id" field.
+func (mvc *ModerationVerdictCreate) SetGenerationID(u uuid.UUID) *ModerationVerdictCreate {
+	mvc.mutation.SetGenerationID(u)
+	return mvc
+}
+
+// SetNillableGenerationID sets the "generation_id" field if the given value is not nil.
+func (mvc *ModerationVerdictCreate) SetNillableGenerationID(u *uuid.UUID) *ModerationVerdictCreate {
+	if u != nil {
+		mvc.SetGenerationID(*u)
+	}
+	return mvc
+}
+
+// SetSourceType sets the "source_type" field.
+func (mvc *ModerationVerdictCreate) SetSourceType(et enttypes.SourceType) *ModerationVerdictCreate {
+	mvc.mutation.SetSourceType(et)
+	return mvc
+}
+
+// SetTier sets the "tier" field.
+func (mvc *ModerationVerdictCreate) SetTier(s string) *ModerationVerdictCreate {
+	mvc.mutation.SetTier(s)
+	return mvc
+}
+
+// SetPolicy sets the "policy" field.
+func (mvc *ModerationVerdictCreate) SetPolicy(s string) *ModerationVerdictCreate {
+	mvc.mutation.SetPolicy(s)
+	return mvc
+}
+
+// SetPrompt sets the "prompt" field.
+func (mvc *ModerationVerdictCreate) SetPrompt(s string) *ModerationVerdictCreate {
+	mvc.mutation.SetPrompt(s)
+	return mvc
+}
+
+// SetTranslatedPrompt sets the "translated_prompt" field.
+func (mvc *ModerationVerdictCreate) SetTranslatedPrompt(s string) *ModerationVerdictCreate {
+	mvc.mutation.SetTranslatedPrompt(s)
+	return mvc
+}
+
+// SetNillableTranslatedPrompt sets the "translated_prompt" field if the given value is not nil.
+func (mvc *ModerationVerdictCreate) SetNillableTranslatedPrompt(s *string) *ModerationVerdictCreate {
+	if s != nil {
+		mvc.SetTranslatedPrompt(*s)
+	}
+	return mvc
+}
+
+// SetAction sets the "action" field.
+func (mvc *ModerationVerdictCreate) SetAction(m moderationverdict.Action) *ModerationVerdictCreate {
+	mvc.mutation.SetAction(m)
+	return mvc
+}
+
+// SetReason sets the "reason" field.
+func (mvc *ModerationVerdictCreate) SetReason(s string) *ModerationVerdictCreate {
+	mvc.mutation.SetReason(s)
+	return mvc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mvc *ModerationVerdictCreate) SetNillableReason(s *string) *ModerationVerdictCreate {
+	if s != nil {
+		mvc.SetReason(*s)
+	}
+	return mvc
+}
+
+// SetFindings sets the "findings" field.
+func (mvc *ModerationVerdictCreate) SetFindings(ef []enttypes.ModerationFinding) *ModerationVerdictCreate {
+	mvc.mutation.SetFindings(ef)
+	return mvc
+}
+
+// SetErrors sets the "errors" field.
+func (mvc *ModerationVerdictCreate) SetErrors(s []string) *ModerationVerdictCreate {
+	mvc.mutation.SetErrors(s)
+	return mvc
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (mvc *ModerationVerdictCreate) SetReviewStatus(ms moderationverdict.ReviewStatus) *ModerationVerdictCreate {
+	mvc.mutation.SetReviewStatus(ms)
+	return mvc
+}
+
+// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
+func (mvc *ModerationVerdictCreate) SetNillableReviewStatus(ms *moderationverdict.ReviewStatus) *ModerationVerdictCreate {
+	if ms != nil {
+		mvc.SetReviewStatus(*ms)
+	}
+	return mvc
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (mvc *ModerationVerdictCreate) SetReviewedBy(u uuid.UUID) *ModerationVerdictCreate {
+	mvc.mutation.SetReviewedBy(u)
+	return mvc
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (mvc *ModerationVerdictCreate) SetNillableReviewedBy(u *uuid.UUID) *ModerationVerdictCreate {
+	if u != nil {
+		mvc.SetReviewedBy(*u)
+	}
+	return mvc
+}
+
+// SetReviewNote sets the "review_note" field.
+func (mvc *ModerationVerdictCreate) SetReviewNote(s string) *ModerationVerdictCreate {
+	mvc.mutation.SetReviewNote(s)
+	return mvc
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (mvc *ModerationVerdictCreate) SetNillableReviewNote(s *string) *ModerationVerdictCreate {
+	if s != nil {
+		mvc.SetReviewNote(*s)
+	}
+	return mvc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (mvc *ModerationVerdictCreate) SetReviewedAt(t time.Time) *ModerationVerdictCreate {
+	mvc.mutation.SetReviewedAt(t)
+	return mvc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (mvc *ModerationVerdictCreate) SetNillableReviewedAt(t *time.Time) *ModerationVerdictCreate {
+	if t != nil {
+		mvc.SetReviewedAt(*t)
+	}
+	return mvc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mvc *ModerationVerdictCreate) SetCreatedAt(t time.Time) *ModerationVerdictCreate {
+	mvc.mutation.SetCreatedAt(t)
+	return mvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mvc *ModerationVerdictCreate) SetNillableCreatedAt(t *time.Time) *ModerationVerdictCreate {
+	if t != nil {
+		mvc.SetCreatedAt(*t)
+	}
+	return mvc
+}
+
+// SetID sets the "id" field.
+func (mvc *ModerationVerdictCreate) SetID(u uuid.UUID) *ModerationVerdictCreate {
+	mvc.mutation.SetID(u)
+	return mvc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mvc *ModerationVerdictCreate) SetNillableID(u *uuid.UUID) *ModerationVerdictCreate {
+	if u != nil {
+		mvc.SetID(*u)
+	}
+	return mvc
+}
+
+// Mutation returns the ModerationVerdictMutation object of the builder.
+func (mvc *ModerationVerdictCreate) Mutation() *ModerationVerdictMutation {
+	return mvc.mutation
+}
+
+// Save creates the ModerationVerdict in the database.
+func (mvc *ModerationVerdictCreate) Save(ctx context.Context) (*ModerationVerdict, error) {
+	mvc.defaults()
+	return withHooks(ctx, mvc.sqlSave, mvc.mutation, mvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mvc *ModerationVerdictCreate) SaveX(ctx context.Context) *ModerationVerdict {
+	v, err := mvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mvc *ModerationVerdictCreate) Exec(ctx context.Context) error {
+	_, err := mvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvc *ModerationVerdictCreate) ExecX(ctx context.Context) {
+	if err := mvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mvc *ModerationVerdictCreate) defaults() {
+	if _, ok := mvc.mutation.CreatedAt(); !ok {
+		v := moderationverdict.DefaultCreatedAt()
+		mvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mvc.mutation.ID(); !ok {
+		v := moderationverdict.DefaultID()
+		mvc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mvc *ModerationVerdictCreate) check() error {
+	if _, ok := mvc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ModerationVerdict.user_id"`)}
+	}
+	if _, ok := mvc.mutation.SourceType(); !ok {
+		return &ValidationError{Name: "source_type", err: errors.New(`ent: missing required field "ModerationVerdict.source_type"`)}
+	}
+	if v, ok := mvc.mutation.SourceType(); ok {
+		if err := moderationverdict.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "ModerationVerdict.source_type": %w`, err)}
+		}
+	}
+	if _, ok := mvc.mutation.Tier(); !ok {
+		return &ValidationError{Name: "tier", err: errors.New(`ent: missing required field "ModerationVerdict.tier"`)}
+	}
+	if _, ok := mvc.mutation.Policy(); !ok {
+		return &ValidationError{Name: "policy", err: errors.New(`ent: missing required field "ModerationVerdict.policy"`)}
+	}
+	if _, ok := mvc.mutation.Prompt(); !ok {
+		return &ValidationError{Name: "prompt", err: errors.New(`ent: missing required field "ModerationVerdict.prompt"`)}
+	}
+	if _, ok := mvc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ModerationVerdict.action"`)}
+	}
+	if v, ok := mvc.mutation.Action(); ok {
+		if err := moderationverdict.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ModerationVerdict.action": %w`, err)}
+		}
+	}
+	if v, ok := mvc.mutation.ReviewStatus(); ok {
+		if err := moderationverdict.ReviewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "review_status", err: fmt.Errorf(`ent: validator failed for field "ModerationVerdict.review_status": %w`, err)}
+		}
+	}
+	if _, ok := mvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ModerationVerdict.created_at"`)}
+	}
+	return nil
+}
+
+func (mvc *ModerationVerdictCreate) sqlSave(ctx context.Context) (*ModerationVerdict, error) {
+	if err := mvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mvc.mutation.id = &_node.ID
+	mvc.mutation.done = true
+	return _node, nil
+}
+
+func (mvc *ModerationVerdictCreate) createSpec() (*ModerationVerdict, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModerationVerdict{config: mvc.config}
+		_spec = sqlgraph.NewCreateSpec(moderationverdict.Table, sqlgraph.NewFieldSpec(moderationverdict.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mvc.conflict
+	if id, ok := mvc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mvc.mutation.UserID(); ok {
+		_spec.SetField(moderationverdict.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := mvc.mutation.GenerationID(); ok {
+		_spec.SetField(moderationverdict.FieldGenerationID, field.TypeUUID, value)
+		_node.GenerationID = &value
+	}
+	if value, ok := mvc.mutation.SourceType(); ok {
+		_spec.SetField(moderationverdict.FieldSourceType, field.TypeEnum, value)
+		_node.SourceType = value
+	}
+	if value, ok := mvc.mutation.Tier(); ok {
+		_spec.SetField(moderationverdict.FieldTier, field.TypeString, value)
+		_node.Tier = value
+	}
+	if value, ok := mvc.mutation.Policy(); ok {
+		_spec.SetField(moderationverdict.FieldPolicy, field.TypeString, value)
+		_node.Policy = value
+	}
+	if value, ok := mvc.mutation.Prompt(); ok {
+		_spec.SetField(moderationverdict.FieldPrompt, field.TypeString, value)
+		_node.Prompt = value
+	}
+	if value, ok := mvc.mutation.TranslatedPrompt(); ok {
+		_spec.SetField(moderationverdict.FieldTranslatedPrompt, field.TypeString, value)
+		_node.TranslatedPrompt = &value
+	}
+	if value, ok := mvc.mutation.Action(); ok {
+		_spec.SetField(moderationverdict.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := mvc.mutation.Reason(); ok {
+		_spec.SetField(moderationverdict.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := mvc.mutation.Findings(); ok {
+		_spec.SetField(moderationverdict.FieldFindings, field.TypeJSON, value)
+		_node.Findings = value
+	}
+	if value, ok := mvc.mutation.Errors(); ok {
+		_spec.SetField(moderationverdict.FieldErrors, field.TypeJSON, value)
+		_node.Errors = value
+	}
+	if value, ok := mvc.mutation.ReviewStatus(); ok {
+		_spec.SetField(moderationverdict.FieldReviewStatus, field.TypeEnum, value)
+		_node.ReviewStatus = &value
+	}
+	if value, ok := mvc.mutation.ReviewedBy(); ok {
+		_spec.SetField(moderationverdict.FieldReviewedBy, field.TypeUUID, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := mvc.mutation.ReviewNote(); ok {
+		_spec.SetField(moderationverdict.FieldReviewNote, field.TypeString, value)
+		_node.ReviewNote = &value
+	}
+	if value, ok := mvc.mutation.ReviewedAt(); ok {
+		_spec.SetField(moderationverdict.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := mvc.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationverdict.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationVerdict.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationVerdictUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (mvc *ModerationVerdictCreate) OnConflict(opts ...sql.ConflictOption) *ModerationVerdictUpsertOne {
+	mvc.conflict = opts
+	return &ModerationVerdictUpsertOne{
+		create: mvc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationVerdict.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mvc *ModerationVerdictCreate) OnConflictColumns(columns ...string) *ModerationVerdictUpsertOne {
+	mvc.conflict = append(mvc.conflict, sql.ConflictColumns(columns...))
+	return &ModerationVerdictUpsertOne{
+		create: mvc,
+	}
+}
+
+type (
+	// ModerationVerdictUpsertOne is the builder for "upsert"-ing
+	//  one ModerationVerdict node.
+	ModerationVerdictUpsertOne struct {
+		create *ModerationVerdictCreate
+	}
+
+	// ModerationVerdictUpsert is the "OnConflict" setter.
+	ModerationVerdictUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *ModerationVerdictUpsert) SetUserID(v uuid.UUID) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdateUserID() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldUserID)
+	return u
+}
+
+// SetGenerationID sets the "generation_id" field.
+func (u *ModerationVerdictUpsert) SetGenerationID(v uuid.UUID) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldGenerationID, v)
+	return u
+}
+
+// UpdateGenerationID sets the "generation_id" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdateGenerationID() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldGenerationID)
+	return u
+}
+
+// ClearGenerationID clears the value of the "generation_id" field.
+func (u *ModerationVerdictUpsert) ClearGenerationID() *ModerationVerdictUpsert {
+	u.SetNull(moderationverdict.FieldGenerationID)
+	return u
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *ModerationVerdictUpsert) SetSourceType(v enttypes.SourceType) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldSourceType, v)
+	return u
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdateSourceType() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldSourceType)
+	return u
+}
+
+// SetTier sets the "tier" field.
+func (u *ModerationVerdictUpsert) SetTier(v string) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldTier, v)
+	return u
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdateTier() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldTier)
+	return u
+}
+
+// SetPolicy sets the "policy" field.
+func (u *ModerationVerdictUpsert) SetPolicy(v string) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldPolicy, v)
+	return u
+}
+
+// UpdatePolicy sets the "policy" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdatePolicy() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldPolicy)
+	return u
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *ModerationVerdictUpsert) SetPrompt(v string) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldPrompt, v)
+	return u
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdatePrompt() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldPrompt)
+	return u
+}
+
+// SetTranslatedPrompt sets the "translated_prompt" field.
+func (u *ModerationVerdictUpsert) SetTranslatedPrompt(v string) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldTranslatedPrompt, v)
+	return u
+}
+
+// UpdateTranslatedPrompt sets the "translated_prompt" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdateTranslatedPrompt() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldTranslatedPrompt)
+	return u
+}
+
+// ClearTranslatedPrompt clears the value of the "translated_prompt" field.
+func (u *ModerationVerdictUpsert) ClearTranslatedPrompt() *ModerationVerdictUpsert {
+	u.SetNull(moderationverdict.FieldTranslatedPrompt)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *ModerationVerdictUpsert) SetAction(v moderationverdict.Action) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdateAction() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldAction)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ModerationVerdictUpsert) SetReason(v string) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdateReason() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ModerationVerdictUpsert) ClearReason() *ModerationVerdictUpsert {
+	u.SetNull(moderationverdict.FieldReason)
+	return u
+}
+
+// SetFindings sets the "findings" field.
+func (u *ModerationVerdictUpsert) SetFindings(v []enttypes.ModerationFinding) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldFindings, v)
+	return u
+}
+
+// UpdateFindings sets the "findings" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdateFindings() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldFindings)
+	return u
+}
+
+// ClearFindings clears the value of the "findings" field.
+func (u *ModerationVerdictUpsert) ClearFindings() *ModerationVerdictUpsert {
+	u.SetNull(moderationverdict.FieldFindings)
+	return u
+}
+
+// SetErrors sets the "errors" field.
+func (u *ModerationVerdictUpsert) SetErrors(v []string) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldErrors, v)
+	return u
+}
+
+// UpdateErrors sets the "errors" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdateErrors() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldErrors)
+	return u
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (u *ModerationVerdictUpsert) ClearErrors() *ModerationVerdictUpsert {
+	u.SetNull(moderationverdict.FieldErrors)
+	return u
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (u *ModerationVerdictUpsert) SetReviewStatus(v moderationverdict.ReviewStatus) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldReviewStatus, v)
+	return u
+}
+
+// UpdateReviewStatus sets the "review_status" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdateReviewStatus() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldReviewStatus)
+	return u
+}
+
+// ClearReviewStatus clears the value of the "review_status" field.
+func (u *ModerationVerdictUpsert) ClearReviewStatus() *ModerationVerdictUpsert {
+	u.SetNull(moderationverdict.FieldReviewStatus)
+	return u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *ModerationVerdictUpsert) SetReviewedBy(v uuid.UUID) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldReviewedBy, v)
+	return u
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdateReviewedBy() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldReviewedBy)
+	return u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *ModerationVerdictUpsert) ClearReviewedBy() *ModerationVerdictUpsert {
+	u.SetNull(moderationverdict.FieldReviewedBy)
+	return u
+}
+
+// SetReviewNote sets the "review_note" field.
+func (u *ModerationVerdictUpsert) SetReviewNote(v string) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldReviewNote, v)
+	return u
+}
+
+// UpdateReviewNote sets the "review_note" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdateReviewNote() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldReviewNote)
+	return u
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (u *ModerationVerdictUpsert) ClearReviewNote() *ModerationVerdictUpsert {
+	u.SetNull(moderationverdict.FieldReviewNote)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ModerationVerdictUpsert) SetReviewedAt(v time.Time) *ModerationVerdictUpsert {
+	u.Set(moderationverdict.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ModerationVerdictUpsert) UpdateReviewedAt() *ModerationVerdictUpsert {
+	u.SetExcluded(moderationverdict.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ModerationVerdictUpsert) ClearReviewedAt() *ModerationVerdictUpsert {
+	u.SetNull(moderationverdict.FieldReviewedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ModerationVerdict.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(moderationverdict.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ModerationVerdictUpsertOne) UpdateNewValues() *ModerationVerdictUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(moderationverdict.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(moderationverdict.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationVerdict.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ModerationVerdictUpsertOne) Ignore() *ModerationVerdictUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationVerdictUpsertOne) DoNothing() *ModerationVerdictUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationVerdictCreate.OnConflict
+// documentation for more info.
+func (u *ModerationVerdictUpsertOne) Update(set func(*ModerationVerdictUpsert)) *ModerationVerdictUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationVerdictUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ModerationVerdictUpsertOne) SetUserID(v uuid.UUID) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdateUserID() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetGenerationID sets the "generation_id" field.
+func (u *ModerationVerdictUpsertOne) SetGenerationID(v uuid.UUID) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetGenerationID(v)
+	})
+}
+
+// UpdateGenerationID sets the "generation_id" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdateGenerationID() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateGenerationID()
+	})
+}
+
+// ClearGenerationID clears the value of the "generation_id" field.
+func (u *ModerationVerdictUpsertOne) ClearGenerationID() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearGenerationID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *ModerationVerdictUpsertOne) SetSourceType(v enttypes.SourceType) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdateSourceType() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetTier sets the "tier" field.
+func (u *ModerationVerdictUpsertOne) SetTier(v string) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetTier(v)
+	})
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdateTier() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateTier()
+	})
+}
+
+// SetPolicy sets the "policy" field.
+func (u *ModerationVerdictUpsertOne) SetPolicy(v string) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetPolicy(v)
+	})
+}
+
+// UpdatePolicy sets the "policy" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdatePolicy() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdatePolicy()
+	})
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *ModerationVerdictUpsertOne) SetPrompt(v string) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetPrompt(v)
+	})
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdatePrompt() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdatePrompt()
+	})
+}
+
+// SetTranslatedPrompt sets the "translated_prompt" field.
+func (u *ModerationVerdictUpsertOne) SetTranslatedPrompt(v string) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetTranslatedPrompt(v)
+	})
+}
+
+// UpdateTranslatedPrompt sets the "translated_prompt" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdateTranslatedPrompt() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateTranslatedPrompt()
+	})
+}
+
+// ClearTranslatedPrompt clears the value of the "translated_prompt" field.
+func (u *ModerationVerdictUpsertOne) ClearTranslatedPrompt() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearTranslatedPrompt()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *ModerationVerdictUpsertOne) SetAction(v moderationverdict.Action) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdateAction() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ModerationVerdictUpsertOne) SetReason(v string) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdateReason() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ModerationVerdictUpsertOne) ClearReason() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetFindings sets the "findings" field.
+func (u *ModerationVerdictUpsertOne) SetFindings(v []enttypes.ModerationFinding) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetFindings(v)
+	})
+}
+
+// UpdateFindings sets the "findings" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdateFindings() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateFindings()
+	})
+}
+
+// ClearFindings clears the value of the "findings" field.
+func (u *ModerationVerdictUpsertOne) ClearFindings() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearFindings()
+	})
+}
+
+// SetErrors sets the "errors" field.
+func (u *ModerationVerdictUpsertOne) SetErrors(v []string) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetErrors(v)
+	})
+}
+
+// UpdateErrors sets the "errors" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdateErrors() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateErrors()
+	})
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (u *ModerationVerdictUpsertOne) ClearErrors() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearErrors()
+	})
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (u *ModerationVerdictUpsertOne) SetReviewStatus(v moderationverdict.ReviewStatus) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetReviewStatus(v)
+	})
+}
+
+// UpdateReviewStatus sets the "review_status" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdateReviewStatus() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateReviewStatus()
+	})
+}
+
+// ClearReviewStatus clears the value of the "review_status" field.
+func (u *ModerationVerdictUpsertOne) ClearReviewStatus() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearReviewStatus()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *ModerationVerdictUpsertOne) SetReviewedBy(v uuid.UUID) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdateReviewedBy() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *ModerationVerdictUpsertOne) ClearReviewedBy() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewNote sets the "review_note" field.
+func (u *ModerationVerdictUpsertOne) SetReviewNote(v string) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetReviewNote(v)
+	})
+}
+
+// UpdateReviewNote sets the "review_note" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdateReviewNote() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateReviewNote()
+	})
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (u *ModerationVerdictUpsertOne) ClearReviewNote() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearReviewNote()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ModerationVerdictUpsertOne) SetReviewedAt(v time.Time) *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertOne) UpdateReviewedAt() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ModerationVerdictUpsertOne) ClearReviewedAt() *ModerationVerdictUpsertOne {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ModerationVerdictUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationVerdictCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationVerdictUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ModerationVerdictUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ModerationVerdictUpsertOne.ID is not supported by MySQL driver. Use ModerationVerdictUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ModerationVerdictUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ModerationVerdictCreateBulk is the builder for creating many ModerationVerdict entities in bulk.
+type ModerationVerdictCreateBulk struct {
+	config
+	err      error
+	builders []*ModerationVerdictCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ModerationVerdict entities in the database.
+func (mvcb *ModerationVerdictCreateBulk) Save(ctx context.Context) ([]*ModerationVerdict, error) {
+	if mvcb.err != nil {
+		return nil, mvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mvcb.builders))
+	nodes := make([]*ModerationVerdict, len(mvcb.builders))
+	mutators := make([]Mutator, len(mvcb.builders))
+	for i := range mvcb.builders {
+		func(i int, root context.Context) {
+			builder := mvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModerationVerdictMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mvcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mvcb *ModerationVerdictCreateBulk) SaveX(ctx context.Context) []*ModerationVerdict {
+	v, err := mvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mvcb *ModerationVerdictCreateBulk) Exec(ctx context.Context) error {
+	_, err := mvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvcb *ModerationVerdictCreateBulk) ExecX(ctx context.Context) {
+	if err := mvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationVerdict.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationVerdictUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (mvcb *ModerationVerdictCreateBulk) OnConflict(opts ...sql.ConflictOption) *ModerationVerdictUpsertBulk {
+	mvcb.conflict = opts
+	return &ModerationVerdictUpsertBulk{
+		create: mvcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationVerdict.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mvcb *ModerationVerdictCreateBulk) OnConflictColumns(columns ...string) *ModerationVerdictUpsertBulk {
+	mvcb.conflict = append(mvcb.conflict, sql.ConflictColumns(columns...))
+	return &ModerationVerdictUpsertBulk{
+		create: mvcb,
+	}
+}
+
+// ModerationVerdictUpsertBulk is the builder for "upsert"-ing
+// a bulk of ModerationVerdict nodes.
+type ModerationVerdictUpsertBulk struct {
+	create *ModerationVerdictCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ModerationVerdict.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(moderationverdict.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ModerationVerdictUpsertBulk) UpdateNewValues() *ModerationVerdictUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(moderationverdict.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(moderationverdict.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationVerdict.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ModerationVerdictUpsertBulk) Ignore() *ModerationVerdictUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationVerdictUpsertBulk) DoNothing() *ModerationVerdictUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationVerdictCreateBulk.OnConflict
+// documentation for more info.
+func (u *ModerationVerdictUpsertBulk) Update(set func(*ModerationVerdictUpsert)) *ModerationVerdictUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationVerdictUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ModerationVerdictUpsertBulk) SetUserID(v uuid.UUID) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdateUserID() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetGenerationID sets the "generation_id" field.
+func (u *ModerationVerdictUpsertBulk) SetGenerationID(v uuid.UUID) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetGenerationID(v)
+	})
+}
+
+// UpdateGenerationID sets the "generation_id" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdateGenerationID() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateGenerationID()
+	})
+}
+
+// ClearGenerationID clears the value of the "generation_id" field.
+func (u *ModerationVerdictUpsertBulk) ClearGenerationID() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearGenerationID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *ModerationVerdictUpsertBulk) SetSourceType(v enttypes.SourceType) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdateSourceType() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetTier sets the "tier" field.
+func (u *ModerationVerdictUpsertBulk) SetTier(v string) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetTier(v)
+	})
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdateTier() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateTier()
+	})
+}
+
+// SetPolicy sets the "policy" field.
+func (u *ModerationVerdictUpsertBulk) SetPolicy(v string) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetPolicy(v)
+	})
+}
+
+// UpdatePolicy sets the "policy" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdatePolicy() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdatePolicy()
+	})
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *ModerationVerdictUpsertBulk) SetPrompt(v string) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetPrompt(v)
+	})
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdatePrompt() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdatePrompt()
+	})
+}
+
+// SetTranslatedPrompt sets the "translated_prompt" field.
+func (u *ModerationVerdictUpsertBulk) SetTranslatedPrompt(v string) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetTranslatedPrompt(v)
+	})
+}
+
+// UpdateTranslatedPrompt sets the "translated_prompt" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdateTranslatedPrompt() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateTranslatedPrompt()
+	})
+}
+
+// ClearTranslatedPrompt clears the value of the "translated_prompt" field.
+func (u *ModerationVerdictUpsertBulk) ClearTranslatedPrompt() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearTranslatedPrompt()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *ModerationVerdictUpsertBulk) SetAction(v moderationverdict.Action) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdateAction() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ModerationVerdictUpsertBulk) SetReason(v string) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdateReason() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ModerationVerdictUpsertBulk) ClearReason() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetFindings sets the "findings" field.
+func (u *ModerationVerdictUpsertBulk) SetFindings(v []enttypes.ModerationFinding) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetFindings(v)
+	})
+}
+
+// UpdateFindings sets the "findings" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdateFindings() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateFindings()
+	})
+}
+
+// ClearFindings clears the value of the "findings" field.
+func (u *ModerationVerdictUpsertBulk) ClearFindings() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearFindings()
+	})
+}
+
+// SetErrors sets the "errors" field.
+func (u *ModerationVerdictUpsertBulk) SetErrors(v []string) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetErrors(v)
+	})
+}
+
+// UpdateErrors sets the "errors" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdateErrors() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateErrors()
+	})
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (u *ModerationVerdictUpsertBulk) ClearErrors() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearErrors()
+	})
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (u *ModerationVerdictUpsertBulk) SetReviewStatus(v moderationverdict.ReviewStatus) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetReviewStatus(v)
+	})
+}
+
+// UpdateReviewStatus sets the "review_status" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdateReviewStatus() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateReviewStatus()
+	})
+}
+
+// ClearReviewStatus clears the value of the "review_status" field.
+func (u *ModerationVerdictUpsertBulk) ClearReviewStatus() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearReviewStatus()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *ModerationVerdictUpsertBulk) SetReviewedBy(v uuid.UUID) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdateReviewedBy() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *ModerationVerdictUpsertBulk) ClearReviewedBy() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewNote sets the "review_note" field.
+func (u *ModerationVerdictUpsertBulk) SetReviewNote(v string) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetReviewNote(v)
+	})
+}
+
+// UpdateReviewNote sets the "review_note" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdateReviewNote() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateReviewNote()
+	})
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (u *ModerationVerdictUpsertBulk) ClearReviewNote() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearReviewNote()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ModerationVerdictUpsertBulk) SetReviewedAt(v time.Time) *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ModerationVerdictUpsertBulk) UpdateReviewedAt() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ModerationVerdictUpsertBulk) ClearReviewedAt() *ModerationVerdictUpsertBulk {
+	return u.Update(func(s *ModerationVerdictUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ModerationVerdictUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ModerationVerdictCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationVerdictCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationVerdictUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
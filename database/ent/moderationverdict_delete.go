@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stablecog/sc-go/database/ent/moderationverdict"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+)
+
+// ModerationVerdictDelete is the builder for deleting a ModerationVerdict entity.
+type ModerationVerdictDelete struct {
+	config
+	hooks    []Hook
+	mutation *ModerationVerdictMutation
+}
+
+// Where appends a list predicates to the ModerationVerdictDelete builder.
+func (mvd *ModerationVerdictDelete) Where(ps ...predicate.ModerationVerdict) *ModerationVerdictDelete {
+	mvd.mutation.Where(ps...)
+	return mvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mvd *ModerationVerdictDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mvd.sqlExec, mvd.mutation, mvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvd *ModerationVerdictDelete) ExecX(ctx context.Context) int {
+	n, err := mvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mvd *ModerationVerdictDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(moderationverdict.Table, sqlgraph.NewFieldSpec(moderationverdict.FieldID, field.TypeUUID))
+	if ps := mvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mvd.mutation.done = true
+	return affected, err
+}
+
+// ModerationVerdictDeleteOne is the builder for deleting a single ModerationVerdict entity.
+type ModerationVerdictDeleteOne struct {
+	mvd *ModerationVerdictDelete
+}
+
+// Where appends a list predicates to the ModerationVerdictDelete builder.
+func (mvdo *ModerationVerdictDeleteOne) Where(ps ...predicate.ModerationVerdict) *ModerationVerdictDeleteOne {
+	mvdo.mvd.mutation.Where(ps...)
+	return mvdo
+}
+
+// Exec executes the deletion query.
+func (mvdo *ModerationVerdictDeleteOne) Exec(ctx context.Context) error {
+	n, err := mvdo.mvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{moderationverdict.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvdo *ModerationVerdictDeleteOne) ExecX(ctx context.Context) {
+	if err := mvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,925 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/moderationverdict"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+	"github.com/stablecog/sc-go/database/enttypes"
+)
+
+// ModerationVerdictUpdate is the builder for updating ModerationVerdict entities.
+type ModerationVerdictUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ModerationVerdictMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ModerationVerdictUpdate builder.
+func (mvu *ModerationVerdictUpdate) Where(ps ...predicate.ModerationVerdict) *ModerationVerdictUpdate {
+	mvu.mutation.Where(ps...)
+	return mvu
+}
+
+// SetUserID sets the "user_id" field.
+func (mvu *ModerationVerdictUpdate) SetUserID(u uuid.UUID) *ModerationVerdictUpdate {
+	mvu.mutation.SetUserID(u)
+	return mvu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mvu *ModerationVerdictUpdate) SetNillableUserID(u *uuid.UUID) *ModerationVerdictUpdate {
+	if u != nil {
+		mvu.SetUserID(*u)
+	}
+	return mvu
+}
+
+// SetGenerationID sets the "generation_id" field.
+func (mvu *ModerationVerdictUpdate) SetGenerationID(u uuid.UUID) *ModerationVerdictUpdate {
+	mvu.mutation.SetGenerationID(u)
+	return mvu
+}
+
+// SetNillableGenerationID sets the "generation_id" field if the given value is not nil.
+func (mvu *ModerationVerdictUpdate) SetNillableGenerationID(u *uuid.UUID) *ModerationVerdictUpdate {
+	if u != nil {
+		mvu.SetGenerationID(*u)
+	}
+	return mvu
+}
+
+// ClearGenerationID clears the value of the "generation_id" field.
+func (mvu *ModerationVerdictUpdate) ClearGenerationID() *ModerationVerdictUpdate {
+	mvu.mutation.ClearGenerationID()
+	return mvu
+}
+
+// SetSourceType sets the "source_type" field.
+func (mvu *ModerationVerdictUpdate) SetSourceType(et enttypes.SourceType) *ModerationVerdictUpdate {
+	mvu.mutation.SetSourceType(et)
+	return mvu
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (mvu *ModerationVerdictUpdate) SetNillableSourceType(et *enttypes.SourceType) *ModerationVerdictUpdate {
+	if et != nil {
+		mvu.SetSourceType(*et)
+	}
+	return mvu
+}
+
+// SetTier sets the "tier" field.
+func (mvu *ModerationVerdictUpdate) SetTier(s string) *ModerationVerdictUpdate {
+	mvu.mutation.SetTier(s)
+	return mvu
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (mvu *ModerationVerdictUpdate) SetNillableTier(s *string) *ModerationVerdictUpdate {
+	if s != nil {
+		mvu.SetTier(*s)
+	}
+	return mvu
+}
+
+// SetPolicy sets the "policy" field.
+func (mvu *ModerationVerdictUpdate) SetPolicy(s string) *ModerationVerdictUpdate {
+	mvu.mutation.SetPolicy(s)
+	return mvu
+}
+
+// SetNillablePolicy sets the "policy" field if the given value is not nil.
+func (mvu *ModerationVerdictUpdate) SetNillablePolicy(s *string) *ModerationVerdictUpdate {
+	if s != nil {
+		mvu.SetPolicy(*s)
+	}
+	return mvu
+}
+
+// SetPrompt sets the "prompt" field.
+func (mvu *ModerationVerdictUpdate) SetPrompt(s string) *ModerationVerdictUpdate {
+	mvu.mutation.SetPrompt(s)
+	return mvu
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (mvu *ModerationVerdictUpdate) SetNillablePrompt(s *string) *ModerationVerdictUpdate {
+	if s != nil {
+		mvu.SetPrompt(*s)
+	}
+	return mvu
+}
+
+// SetTranslatedPrompt sets the "translated_prompt" field.
+func (mvu *ModerationVerdictUpdate) SetTranslatedPrompt(s string) *ModerationVerdictUpdate {
+	mvu.mutation.SetTranslatedPrompt(s)
+	return mvu
+}
+
+// SetNillableTranslatedPrompt sets the "translated_prompt" field if the given value is not nil.
+func (mvu *ModerationVerdictUpdate) SetNillableTranslatedPrompt(s *string) *ModerationVerdictUpdate {
+	if s != nil {
+		mvu.SetTranslatedPrompt(*s)
+	}
+	return mvu
+}
+
+// ClearTranslatedPrompt clears the value of the "translated_prompt" field.
+func (mvu *ModerationVerdictUpdate) ClearTranslatedPrompt() *ModerationVerdictUpdate {
+	mvu.mutation.ClearTranslatedPrompt()
+	return mvu
+}
+
+// SetAction sets the "action" field.
+func (mvu *ModerationVerdictUpdate) SetAction(m moderationverdict.Action) *ModerationVerdictUpdate {
+	mvu.mutation.SetAction(m)
+	return mvu
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (mvu *ModerationVerdictUpdate) SetNillableAction(m *moderationverdict.Action) *ModerationVerdictUpdate {
+	if m != nil {
+		mvu.SetAction(*m)
+	}
+	return mvu
+}
+
+// SetReason sets the "reason" field.
+func (mvu *ModerationVerdictUpdate) SetReason(s string) *ModerationVerdictUpdate {
+	mvu.mutation.SetReason(s)
+	return mvu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mvu *ModerationVerdictUpdate) SetNillableReason(s *string) *ModerationVerdictUpdate {
+	if s != nil {
+		mvu.SetReason(*s)
+	}
+	return mvu
+}
+
+// ClearReason clears the value of the "reason" field.
+func (mvu *ModerationVerdictUpdate) ClearReason() *ModerationVerdictUpdate {
+	mvu.mutation.ClearReason()
+	return mvu
+}
+
+// SetFindings sets the "findings" field.
+func (mvu *ModerationVerdictUpdate) SetFindings(ef []enttypes.ModerationFinding) *ModerationVerdictUpdate {
+	mvu.mutation.SetFindings(ef)
+	return mvu
+}
+
+// AppendFindings appends ef to the "findings" field.
+func (mvu *ModerationVerdictUpdate) AppendFindings(ef []enttypes.ModerationFinding) *ModerationVerdictUpdate {
+	mvu.mutation.AppendFindings(ef)
+	return mvu
+}
+
+// ClearFindings clears the value of the "findings" field.
+func (mvu *ModerationVerdictUpdate) ClearFindings() *ModerationVerdictUpdate {
+	mvu.mutation.ClearFindings()
+	return mvu
+}
+
+// SetErrors sets the "errors" field.
+func (mvu *ModerationVerdictUpdate) SetErrors(s []string) *ModerationVerdictUpdate {
+	mvu.mutation.SetErrors(s)
+	return mvu
+}
+
+// AppendErrors appends s to the "errors" field.
+func (mvu *ModerationVerdictUpdate) AppendErrors(s []string) *ModerationVerdictUpdate {
+	mvu.mutation.AppendErrors(s)
+	return mvu
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (mvu *ModerationVerdictUpdate) ClearErrors() *ModerationVerdictUpdate {
+	mvu.mutation.ClearErrors()
+	return mvu
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (mvu *ModerationVerdictUpdate) SetReviewStatus(ms moderationverdict.ReviewStatus) *ModerationVerdictUpdate {
+	mvu.mutation.SetReviewStatus(ms)
+	return mvu
+}
+
+// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
+func (mvu *ModerationVerdictUpdate) SetNillableReviewStatus(ms *moderationverdict.ReviewStatus) *ModerationVerdictUpdate {
+	if ms != nil {
+		mvu.SetReviewStatus(*ms)
+	}
+	return mvu
+}
+
+// ClearReviewStatus clears the value of the "review_status" field.
+func (mvu *ModerationVerdictUpdate) ClearReviewStatus() *ModerationVerdictUpdate {
+	mvu.mutation.ClearReviewStatus()
+	return mvu
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (mvu *ModerationVerdictUpdate) SetReviewedBy(u uuid.UUID) *ModerationVerdictUpdate {
+	mvu.mutation.SetReviewedBy(u)
+	return mvu
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (mvu *ModerationVerdictUpdate) SetNillableReviewedBy(u *uuid.UUID) *ModerationVerdictUpdate {
+	if u != nil {
+		mvu.SetReviewedBy(*u)
+	}
+	return mvu
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (mvu *ModerationVerdictUpdate) ClearReviewedBy() *ModerationVerdictUpdate {
+	mvu.mutation.ClearReviewedBy()
+	return mvu
+}
+
+// SetReviewNote sets the "review_note" field.
+func (mvu *ModerationVerdictUpdate) SetReviewNote(s string) *ModerationVerdictUpdate {
+	mvu.mutation.SetReviewNote(s)
+	return mvu
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (mvu *ModerationVerdictUpdate) SetNillableReviewNote(s *string) *ModerationVerdictUpdate {
+	if s != nil {
+		mvu.SetReviewNote(*s)
+	}
+	return mvu
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (mvu *ModerationVerdictUpdate) ClearReviewNote() *ModerationVerdictUpdate {
+	mvu.mutation.ClearReviewNote()
+	return mvu
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (mvu *ModerationVerdictUpdate) SetReviewedAt(t time.Time) *ModerationVerdictUpdate {
+	mvu.mutation.SetReviewedAt(t)
+	return mvu
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (mvu *ModerationVerdictUpdate) SetNillableReviewedAt(t *time.Time) *ModerationVerdictUpdate {
+	if t != nil {
+		mvu.SetReviewedAt(*t)
+	}
+	return mvu
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (mvu *ModerationVerdictUpdate) ClearReviewedAt() *ModerationVerdictUpdate {
+	mvu.mutation.ClearReviewedAt()
+	return mvu
+}
+
+// Mutation returns the ModerationVerdictMutation object of the builder.
+func (mvu *ModerationVerdictUpdate) Mutation() *ModerationVerdictMutation {
+	return mvu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mvu *ModerationVerdictUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mvu.sqlSave, mvu.mutation, mvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mvu *ModerationVerdictUpdate) SaveX(ctx context.Context) int {
+	affected, err := mvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mvu *ModerationVerdictUpdate) Exec(ctx context.Context) error {
+	_, err := mvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvu *ModerationVerdictUpdate) ExecX(ctx context.Context) {
+	if err := mvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mvu *ModerationVerdictUpdate) check() error {
+	if v, ok := mvu.mutation.SourceType(); ok {
+		if err := moderationverdict.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "ModerationVerdict.source_type": %w`, err)}
+		}
+	}
+	if v, ok := mvu.mutation.Action(); ok {
+		if err := moderationverdict.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ModerationVerdict.action": %w`, err)}
+		}
+	}
+	if v, ok := mvu.mutation.ReviewStatus(); ok {
+		if err := moderationverdict.ReviewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "review_status", err: fmt.Errorf(`ent: validator failed for field "ModerationVerdict.review_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mvu *ModerationVerdictUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ModerationVerdictUpdate {
+	mvu.modifiers = append(mvu.modifiers, modifiers...)
+	return mvu
+}
+
+func (mvu *ModerationVerdictUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationverdict.Table, moderationverdict.Columns, sqlgraph.NewFieldSpec(moderationverdict.FieldID, field.TypeUUID))
+	if ps := mvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mvu.mutation.UserID(); ok {
+		_spec.SetField(moderationverdict.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := mvu.mutation.GenerationID(); ok {
+		_spec.SetField(moderationverdict.FieldGenerationID, field.TypeUUID, value)
+	}
+	if mvu.mutation.GenerationIDCleared() {
+		_spec.ClearField(moderationverdict.FieldGenerationID, field.TypeUUID)
+	}
+	if value, ok := mvu.mutation.SourceType(); ok {
+		_spec.SetField(moderationverdict.FieldSourceType, field.TypeEnum, value)
+	}
+	if value, ok := mvu.mutation.Tier(); ok {
+		_spec.SetField(moderationverdict.FieldTier, field.TypeString, value)
+	}
+	if value, ok := mvu.mutation.Policy(); ok {
+		_spec.SetField(moderationverdict.FieldPolicy, field.TypeString, value)
+	}
+	if value, ok := mvu.mutation.Prompt(); ok {
+		_spec.SetField(moderationverdict.FieldPrompt, field.TypeString, value)
+	}
+	if value, ok := mvu.mutation.TranslatedPrompt(); ok {
+		_spec.SetField(moderationverdict.FieldTranslatedPrompt, field.TypeString, value)
+	}
+	if mvu.mutation.TranslatedPromptCleared() {
+		_spec.ClearField(moderationverdict.FieldTranslatedPrompt, field.TypeString)
+	}
+	if value, ok := mvu.mutation.Action(); ok {
+		_spec.SetField(moderationverdict.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := mvu.mutation.Reason(); ok {
+		_spec.SetField(moderationverdict.FieldReason, field.TypeString, value)
+	}
+	if mvu.mutation.ReasonCleared() {
+		_spec.ClearField(moderationverdict.FieldReason, field.TypeString)
+	}
+	if value, ok := mvu.mutation.Findings(); ok {
+		_spec.SetField(moderationverdict.FieldFindings, field.TypeJSON, value)
+	}
+	if value, ok := mvu.mutation.AppendedFindings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, moderationverdict.FieldFindings, value)
+		})
+	}
+	if mvu.mutation.FindingsCleared() {
+		_spec.ClearField(moderationverdict.FieldFindings, field.TypeJSON)
+	}
+	if value, ok := mvu.mutation.Errors(); ok {
+		_spec.SetField(moderationverdict.FieldErrors, field.TypeJSON, value)
+	}
+	if value, ok := mvu.mutation.AppendedErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, moderationverdict.FieldErrors, value)
+		})
+	}
+	if mvu.mutation.ErrorsCleared() {
+		_spec.ClearField(moderationverdict.FieldErrors, field.TypeJSON)
+	}
+	if value, ok := mvu.mutation.ReviewStatus(); ok {
+		_spec.SetField(moderationverdict.FieldReviewStatus, field.TypeEnum, value)
+	}
+	if mvu.mutation.ReviewStatusCleared() {
+		_spec.ClearField(moderationverdict.FieldReviewStatus, field.TypeEnum)
+	}
+	if value, ok := mvu.mutation.ReviewedBy(); ok {
+		_spec.SetField(moderationverdict.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if mvu.mutation.ReviewedByCleared() {
+		_spec.ClearField(moderationverdict.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := mvu.mutation.ReviewNote(); ok {
+		_spec.SetField(moderationverdict.FieldReviewNote, field.TypeString, value)
+	}
+	if mvu.mutation.ReviewNoteCleared() {
+		_spec.ClearField(moderationverdict.FieldReviewNote, field.TypeString)
+	}
+	if value, ok := mvu.mutation.ReviewedAt(); ok {
+		_spec.SetField(moderationverdict.FieldReviewedAt, field.TypeTime, value)
+	}
+	if mvu.mutation.ReviewedAtCleared() {
+		_spec.ClearField(moderationverdict.FieldReviewedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(mvu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationverdict.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mvu.mutation.done = true
+	return n, nil
+}
+
+// ModerationVerdictUpdateOne is the builder for updating a single ModerationVerdict entity.
+type ModerationVerdictUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ModerationVerdictMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (mvuo *ModerationVerdictUpdateOne) SetUserID(u uuid.UUID) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetUserID(u)
+	return mvuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mvuo *ModerationVerdictUpdateOne) SetNillableUserID(u *uuid.UUID) *ModerationVerdictUpdateOne {
+	if u != nil {
+		mvuo.SetUserID(*u)
+	}
+	return mvuo
+}
+
+// SetGenerationID sets the "generation_id" field.
+func (mvuo *ModerationVerdictUpdateOne) SetGenerationID(u uuid.UUID) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetGenerationID(u)
+	return mvuo
+}
+
+// SetNillableGenerationID sets the "generation_id" field if the given value is not nil.
+func (mvuo *ModerationVerdictUpdateOne) SetNillableGenerationID(u *uuid.UUID) *ModerationVerdictUpdateOne {
+	if u != nil {
+		mvuo.SetGenerationID(*u)
+	}
+	return mvuo
+}
+
+// ClearGenerationID clears the value of the "generation_id" field.
+func (mvuo *ModerationVerdictUpdateOne) ClearGenerationID() *ModerationVerdictUpdateOne {
+	mvuo.mutation.ClearGenerationID()
+	return mvuo
+}
+
+// SetSourceType sets the "source_type" field.
+func (mvuo *ModerationVerdictUpdateOne) SetSourceType(et enttypes.SourceType) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetSourceType(et)
+	return mvuo
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (mvuo *ModerationVerdictUpdateOne) SetNillableSourceType(et *enttypes.SourceType) *ModerationVerdictUpdateOne {
+	if et != nil {
+		mvuo.SetSourceType(*et)
+	}
+	return mvuo
+}
+
+// SetTier sets the "tier" field.
+func (mvuo *ModerationVerdictUpdateOne) SetTier(s string) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetTier(s)
+	return mvuo
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (mvuo *ModerationVerdictUpdateOne) SetNillableTier(s *string) *ModerationVerdictUpdateOne {
+	if s != nil {
+		mvuo.SetTier(*s)
+	}
+	return mvuo
+}
+
+// SetPolicy sets the "policy" field.
+func (mvuo *ModerationVerdictUpdateOne) SetPolicy(s string) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetPolicy(s)
+	return mvuo
+}
+
+// SetNillablePolicy sets the "policy" field if the given value is not nil.
+func (mvuo *ModerationVerdictUpdateOne) SetNillablePolicy(s *string) *ModerationVerdictUpdateOne {
+	if s != nil {
+		mvuo.SetPolicy(*s)
+	}
+	return mvuo
+}
+
+// SetPrompt sets the "prompt" field.
+func (mvuo *ModerationVerdictUpdateOne) SetPrompt(s string) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetPrompt(s)
+	return mvuo
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (mvuo *ModerationVerdictUpdateOne) SetNillablePrompt(s *string) *ModerationVerdictUpdateOne {
+	if s != nil {
+		mvuo.SetPrompt(*s)
+	}
+	return mvuo
+}
+
+// SetTranslatedPrompt sets the "translated_prompt" field.
+func (mvuo *ModerationVerdictUpdateOne) SetTranslatedPrompt(s string) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetTranslatedPrompt(s)
+	return mvuo
+}
+
+// SetNillableTranslatedPrompt sets the "translated_prompt" field if the given value is not nil.
+func (mvuo *ModerationVerdictUpdateOne) SetNillableTranslatedPrompt(s *string) *ModerationVerdictUpdateOne {
+	if s != nil {
+		mvuo.SetTranslatedPrompt(*s)
+	}
+	return mvuo
+}
+
+// ClearTranslatedPrompt clears the value of the "translated_prompt" field.
+func (mvuo *ModerationVerdictUpdateOne) ClearTranslatedPrompt() *ModerationVerdictUpdateOne {
+	mvuo.mutation.ClearTranslatedPrompt()
+	return mvuo
+}
+
+// SetAction sets the "action" field.
+func (mvuo *ModerationVerdictUpdateOne) SetAction(m moderationverdict.Action) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetAction(m)
+	return mvuo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (mvuo *ModerationVerdictUpdateOne) SetNillableAction(m *moderationverdict.Action) *ModerationVerdictUpdateOne {
+	if m != nil {
+		mvuo.SetAction(*m)
+	}
+	return mvuo
+}
+
+// SetReason sets the "reason" field.
+func (mvuo *ModerationVerdictUpdateOne) SetReason(s string) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetReason(s)
+	return mvuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mvuo *ModerationVerdictUpdateOne) SetNillableReason(s *string) *ModerationVerdictUpdateOne {
+	if s != nil {
+		mvuo.SetReason(*s)
+	}
+	return mvuo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (mvuo *ModerationVerdictUpdateOne) ClearReason() *ModerationVerdictUpdateOne {
+	mvuo.mutation.ClearReason()
+	return mvuo
+}
+
+// SetFindings sets the "findings" field.
+func (mvuo *ModerationVerdictUpdateOne) SetFindings(ef []enttypes.ModerationFinding) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetFindings(ef)
+	return mvuo
+}
+
+// AppendFindings appends ef to the "findings" field.
+func (mvuo *ModerationVerdictUpdateOne) AppendFindings(ef []enttypes.ModerationFinding) *ModerationVerdictUpdateOne {
+	mvuo.mutation.AppendFindings(ef)
+	return mvuo
+}
+
+// ClearFindings clears the value of the "findings" field.
+func (mvuo *ModerationVerdictUpdateOne) ClearFindings() *ModerationVerdictUpdateOne {
+	mvuo.mutation.ClearFindings()
+	return mvuo
+}
+
+// SetErrors sets the "errors" field.
+func (mvuo *ModerationVerdictUpdateOne) SetErrors(s []string) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetErrors(s)
+	return mvuo
+}
+
+// AppendErrors appends s to the "errors" field.
+func (mvuo *ModerationVerdictUpdateOne) AppendErrors(s []string) *ModerationVerdictUpdateOne {
+	mvuo.mutation.AppendErrors(s)
+	return mvuo
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (mvuo *ModerationVerdictUpdateOne) ClearErrors() *ModerationVerdictUpdateOne {
+	mvuo.mutation.ClearErrors()
+	return mvuo
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (mvuo *ModerationVerdictUpdateOne) SetReviewStatus(ms moderationverdict.ReviewStatus) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetReviewStatus(ms)
+	return mvuo
+}
+
+// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
+func (mvuo *ModerationVerdictUpdateOne) SetNillableReviewStatus(ms *moderationverdict.ReviewStatus) *ModerationVerdictUpdateOne {
+	if ms != nil {
+		mvuo.SetReviewStatus(*ms)
+	}
+	return mvuo
+}
+
+// ClearReviewStatus clears the value of the "review_status" field.
+func (mvuo *ModerationVerdictUpdateOne) ClearReviewStatus() *ModerationVerdictUpdateOne {
+	mvuo.mutation.ClearReviewStatus()
+	return mvuo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (mvuo *ModerationVerdictUpdateOne) SetReviewedBy(u uuid.UUID) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetReviewedBy(u)
+	return mvuo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (mvuo *ModerationVerdictUpdateOne) SetNillableReviewedBy(u *uuid.UUID) *ModerationVerdictUpdateOne {
+	if u != nil {
+		mvuo.SetReviewedBy(*u)
+	}
+	return mvuo
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (mvuo *ModerationVerdictUpdateOne) ClearReviewedBy() *ModerationVerdictUpdateOne {
+	mvuo.mutation.ClearReviewedBy()
+	return mvuo
+}
+
+// SetReviewNote sets the "review_note" field.
+func (mvuo *ModerationVerdictUpdateOne) SetReviewNote(s string) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetReviewNote(s)
+	return mvuo
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (mvuo *ModerationVerdictUpdateOne) SetNillableReviewNote(s *string) *ModerationVerdictUpdateOne {
+	if s != nil {
+		mvuo.SetReviewNote(*s)
+	}
+	return mvuo
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (mvuo *ModerationVerdictUpdateOne) ClearReviewNote() *ModerationVerdictUpdateOne {
+	mvuo.mutation.ClearReviewNote()
+	return mvuo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (mvuo *ModerationVerdictUpdateOne) SetReviewedAt(t time.Time) *ModerationVerdictUpdateOne {
+	mvuo.mutation.SetReviewedAt(t)
+	return mvuo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (mvuo *ModerationVerdictUpdateOne) SetNillableReviewedAt(t *time.Time) *ModerationVerdictUpdateOne {
+	if t != nil {
+		mvuo.SetReviewedAt(*t)
+	}
+	return mvuo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (mvuo *ModerationVerdictUpdateOne) ClearReviewedAt() *ModerationVerdictUpdateOne {
+	mvuo.mutation.ClearReviewedAt()
+	return mvuo
+}
+
+// Mutation returns the ModerationVerdictMutation object of the builder.
+func (mvuo *ModerationVerdictUpdateOne) Mutation() *ModerationVerdictMutation {
+	return mvuo.mutation
+}
+
+// Where appends a list predicates to the ModerationVerdictUpdate builder.
+func (mvuo *ModerationVerdictUpdateOne) Where(ps ...predicate.ModerationVerdict) *ModerationVerdictUpdateOne {
+	mvuo.mutation.Where(ps...)
+	return mvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mvuo *ModerationVerdictUpdateOne) Select(field string, fields ...string) *ModerationVerdictUpdateOne {
+	mvuo.fields = append([]string{field}, fields...)
+	return mvuo
+}
+
+// Save executes the query and returns the updated ModerationVerdict entity.
+func (mvuo *ModerationVerdictUpdateOne) Save(ctx context.Context) (*ModerationVerdict, error) {
+	return withHooks(ctx, mvuo.sqlSave, mvuo.mutation, mvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mvuo *ModerationVerdictUpdateOne) SaveX(ctx context.Context) *ModerationVerdict {
+	node, err := mvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mvuo *ModerationVerdictUpdateOne) Exec(ctx context.Context) error {
+	_, err := mvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvuo *ModerationVerdictUpdateOne) ExecX(ctx context.Context) {
+	if err := mvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mvuo *ModerationVerdictUpdateOne) check() error {
+	if v, ok := mvuo.mutation.SourceType(); ok {
+		if err := moderationverdict.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "ModerationVerdict.source_type": %w`, err)}
+		}
+	}
+	if v, ok := mvuo.mutation.Action(); ok {
+		if err := moderationverdict.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ModerationVerdict.action": %w`, err)}
+		}
+	}
+	if v, ok := mvuo.mutation.ReviewStatus(); ok {
+		if err := moderationverdict.ReviewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "review_status", err: fmt.Errorf(`ent: validator failed for field "ModerationVerdict.review_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mvuo *ModerationVerdictUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ModerationVerdictUpdateOne {
+	mvuo.modifiers = append(mvuo.modifiers, modifiers...)
+	return mvuo
+}
+
+func (mvuo *ModerationVerdictUpdateOne) sqlSave(ctx context.Context) (_node *ModerationVerdict, err error) {
+	if err := mvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationverdict.Table, moderationverdict.Columns, sqlgraph.NewFieldSpec(moderationverdict.FieldID, field.TypeUUID))
+	id, ok := mvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ModerationVerdict.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderationverdict.FieldID)
+		for _, f := range fields {
+			if !moderationverdict.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != moderationverdict.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mvuo.mutation.UserID(); ok {
+		_spec.SetField(moderationverdict.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := mvuo.mutation.GenerationID(); ok {
+		_spec.SetField(moderationverdict.FieldGenerationID, field.TypeUUID, value)
+	}
+	if mvuo.mutation.GenerationIDCleared() {
+		_spec.ClearField(moderationverdict.FieldGenerationID, field.TypeUUID)
+	}
+	if value, ok := mvuo.mutation.SourceType(); ok {
+		_spec.SetField(moderationverdict.FieldSourceType, field.TypeEnum, value)
+	}
+	if value, ok := mvuo.mutation.Tier(); ok {
+		_spec.SetField(moderationverdict.FieldTier, field.TypeString, value)
+	}
+	if value, ok := mvuo.mutation.Policy(); ok {
+		_spec.SetField(moderationverdict.FieldPolicy, field.TypeString, value)
+	}
+	if value, ok := mvuo.mutation.Prompt(); ok {
+		_spec.SetField(moderationverdict.FieldPrompt, field.TypeString, value)
+	}
+	if value, ok := mvuo.mutation.TranslatedPrompt(); ok {
+		_spec.SetField(moderationverdict.FieldTranslatedPrompt, field.TypeString, value)
+	}
+	if mvuo.mutation.TranslatedPromptCleared() {
+		_spec.ClearField(moderationverdict.FieldTranslatedPrompt, field.TypeString)
+	}
+	if value, ok := mvuo.mutation.Action(); ok {
+		_spec.SetField(moderationverdict.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := mvuo.mutation.Reason(); ok {
+		_spec.SetField(moderationverdict.FieldReason, field.TypeString, value)
+	}
+	if mvuo.mutation.ReasonCleared() {
+		_spec.ClearField(moderationverdict.FieldReason, field.TypeString)
+	}
+	if value, ok := mvuo.mutation.Findings(); ok {
+		_spec.SetField(moderationverdict.FieldFindings, field.TypeJSON, value)
+	}
+	if value, ok := mvuo.mutation.AppendedFindings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, moderationverdict.FieldFindings, value)
+		})
+	}
+	if mvuo.mutation.FindingsCleared() {
+		_spec.ClearField(moderationverdict.FieldFindings, field.TypeJSON)
+	}
+	if value, ok := mvuo.mutation.Errors(); ok {
+		_spec.SetField(moderationverdict.FieldErrors, field.TypeJSON, value)
+	}
+	if value, ok := mvuo.mutation.AppendedErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, moderationverdict.FieldErrors, value)
+		})
+	}
+	if mvuo.mutation.ErrorsCleared() {
+		_spec.ClearField(moderationverdict.FieldErrors, field.TypeJSON)
+	}
+	if value, ok := mvuo.mutation.ReviewStatus(); ok {
+		_spec.SetField(moderationverdict.FieldReviewStatus, field.TypeEnum, value)
+	}
+	if mvuo.mutation.ReviewStatusCleared() {
+		_spec.ClearField(moderationverdict.FieldReviewStatus, field.TypeEnum)
+	}
+	if value, ok := mvuo.mutation.ReviewedBy(); ok {
+		_spec.SetField(moderationverdict.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if mvuo.mutation.ReviewedByCleared() {
+		_spec.ClearField(moderationverdict.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := mvuo.mutation.ReviewNote(); ok {
+		_spec.SetField(moderationverdict.FieldReviewNote, field.TypeString, value)
+	}
+	if mvuo.mutation.ReviewNoteCleared() {
+		_spec.ClearField(moderationverdict.FieldReviewNote, field.TypeString)
+	}
+	if value, ok := mvuo.mutation.ReviewedAt(); ok {
+		_spec.SetField(moderationverdict.FieldReviewedAt, field.TypeTime, value)
+	}
+	if mvuo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(moderationverdict.FieldReviewedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(mvuo.modifiers...)
+	_node = &ModerationVerdict{config: mvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationverdict.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mvuo.mutation.done = true
+	return _node, nil
+}
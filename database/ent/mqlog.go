@@ -24,6 +24,8 @@ type MqLog struct {
 	Priority int `json:"priority,omitempty"`
 	// IsProcessing holds the value of the "is_processing" field.
 	IsProcessing bool `json:"is_processing,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -42,7 +44,7 @@ func (*MqLog) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case mqlog.FieldMessageID:
 			values[i] = new(sql.NullString)
-		case mqlog.FieldCreatedAt, mqlog.FieldUpdatedAt:
+		case mqlog.FieldCancelledAt, mqlog.FieldCreatedAt, mqlog.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case mqlog.FieldID:
 			values[i] = new(uuid.UUID)
@@ -85,6 +87,13 @@ func (ml *MqLog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ml.IsProcessing = value.Bool
 			}
+		case mqlog.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				ml.CancelledAt = new(time.Time)
+				*ml.CancelledAt = value.Time
+			}
 		case mqlog.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -142,6 +151,11 @@ func (ml *MqLog) String() string {
 	builder.WriteString("is_processing=")
 	builder.WriteString(fmt.Sprintf("%v", ml.IsProcessing))
 	builder.WriteString(", ")
+	if v := ml.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(ml.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -20,6 +20,8 @@ const (
 	FieldPriority = "priority"
 	// FieldIsProcessing holds the string denoting the is_processing field in the database.
 	FieldIsProcessing = "is_processing"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -34,6 +36,7 @@ var Columns = []string{
 	FieldMessageID,
 	FieldPriority,
 	FieldIsProcessing,
+	FieldCancelledAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -84,6 +87,11 @@ func ByIsProcessing(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsProcessing, opts...).ToFunc()
 }
 
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -70,6 +70,11 @@ func IsProcessing(v bool) predicate.MqLog {
 	return predicate.MqLog(sql.FieldEQ(FieldIsProcessing, v))
 }
 
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.MqLog {
+	return predicate.MqLog(sql.FieldEQ(FieldCancelledAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.MqLog {
 	return predicate.MqLog(sql.FieldEQ(FieldCreatedAt, v))
@@ -195,6 +200,56 @@ func IsProcessingNEQ(v bool) predicate.MqLog {
 	return predicate.MqLog(sql.FieldNEQ(FieldIsProcessing, v))
 }
 
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.MqLog {
+	return predicate.MqLog(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.MqLog {
+	return predicate.MqLog(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.MqLog {
+	return predicate.MqLog(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.MqLog {
+	return predicate.MqLog(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.MqLog {
+	return predicate.MqLog(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.MqLog {
+	return predicate.MqLog(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.MqLog {
+	return predicate.MqLog(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.MqLog {
+	return predicate.MqLog(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.MqLog {
+	return predicate.MqLog(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.MqLog {
+	return predicate.MqLog(sql.FieldNotNull(FieldCancelledAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.MqLog {
 	return predicate.MqLog(sql.FieldEQ(FieldCreatedAt, v))
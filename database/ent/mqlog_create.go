@@ -50,6 +50,20 @@ func (mlc *MqLogCreate) SetNillableIsProcessing(b *bool) *MqLogCreate {
 	return mlc
 }
 
+// SetCancelledAt sets the "cancelled_at" field.
+func (mlc *MqLogCreate) SetCancelledAt(t time.Time) *MqLogCreate {
+	mlc.mutation.SetCancelledAt(t)
+	return mlc
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (mlc *MqLogCreate) SetNillableCancelledAt(t *time.Time) *MqLogCreate {
+	if t != nil {
+		mlc.SetCancelledAt(*t)
+	}
+	return mlc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (mlc *MqLogCreate) SetCreatedAt(t time.Time) *MqLogCreate {
 	mlc.mutation.SetCreatedAt(t)
@@ -210,6 +224,10 @@ func (mlc *MqLogCreate) createSpec() (*MqLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(mqlog.FieldIsProcessing, field.TypeBool, value)
 		_node.IsProcessing = value
 	}
+	if value, ok := mlc.mutation.CancelledAt(); ok {
+		_spec.SetField(mqlog.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
 	if value, ok := mlc.mutation.CreatedAt(); ok {
 		_spec.SetField(mqlog.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -312,6 +330,24 @@ func (u *MqLogUpsert) UpdateIsProcessing() *MqLogUpsert {
 	return u
 }
 
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *MqLogUpsert) SetCancelledAt(v time.Time) *MqLogUpsert {
+	u.Set(mqlog.FieldCancelledAt, v)
+	return u
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *MqLogUpsert) UpdateCancelledAt() *MqLogUpsert {
+	u.SetExcluded(mqlog.FieldCancelledAt)
+	return u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *MqLogUpsert) ClearCancelledAt() *MqLogUpsert {
+	u.SetNull(mqlog.FieldCancelledAt)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *MqLogUpsert) SetUpdatedAt(v time.Time) *MqLogUpsert {
 	u.Set(mqlog.FieldUpdatedAt, v)
@@ -424,6 +460,27 @@ func (u *MqLogUpsertOne) UpdateIsProcessing() *MqLogUpsertOne {
 	})
 }
 
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *MqLogUpsertOne) SetCancelledAt(v time.Time) *MqLogUpsertOne {
+	return u.Update(func(s *MqLogUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *MqLogUpsertOne) UpdateCancelledAt() *MqLogUpsertOne {
+	return u.Update(func(s *MqLogUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *MqLogUpsertOne) ClearCancelledAt() *MqLogUpsertOne {
+	return u.Update(func(s *MqLogUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *MqLogUpsertOne) SetUpdatedAt(v time.Time) *MqLogUpsertOne {
 	return u.Update(func(s *MqLogUpsert) {
@@ -705,6 +762,27 @@ func (u *MqLogUpsertBulk) UpdateIsProcessing() *MqLogUpsertBulk {
 	})
 }
 
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *MqLogUpsertBulk) SetCancelledAt(v time.Time) *MqLogUpsertBulk {
+	return u.Update(func(s *MqLogUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *MqLogUpsertBulk) UpdateCancelledAt() *MqLogUpsertBulk {
+	return u.Update(func(s *MqLogUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *MqLogUpsertBulk) ClearCancelledAt() *MqLogUpsertBulk {
+	return u.Update(func(s *MqLogUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *MqLogUpsertBulk) SetUpdatedAt(v time.Time) *MqLogUpsertBulk {
 	return u.Update(func(s *MqLogUpsert) {
@@ -78,6 +78,26 @@ func (mlu *MqLogUpdate) SetNillableIsProcessing(b *bool) *MqLogUpdate {
 	return mlu
 }
 
+// SetCancelledAt sets the "cancelled_at" field.
+func (mlu *MqLogUpdate) SetCancelledAt(t time.Time) *MqLogUpdate {
+	mlu.mutation.SetCancelledAt(t)
+	return mlu
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (mlu *MqLogUpdate) SetNillableCancelledAt(t *time.Time) *MqLogUpdate {
+	if t != nil {
+		mlu.SetCancelledAt(*t)
+	}
+	return mlu
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (mlu *MqLogUpdate) ClearCancelledAt() *MqLogUpdate {
+	mlu.mutation.ClearCancelledAt()
+	return mlu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (mlu *MqLogUpdate) SetUpdatedAt(t time.Time) *MqLogUpdate {
 	mlu.mutation.SetUpdatedAt(t)
@@ -152,6 +172,12 @@ func (mlu *MqLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mlu.mutation.IsProcessing(); ok {
 		_spec.SetField(mqlog.FieldIsProcessing, field.TypeBool, value)
 	}
+	if value, ok := mlu.mutation.CancelledAt(); ok {
+		_spec.SetField(mqlog.FieldCancelledAt, field.TypeTime, value)
+	}
+	if mlu.mutation.CancelledAtCleared() {
+		_spec.ClearField(mqlog.FieldCancelledAt, field.TypeTime)
+	}
 	if value, ok := mlu.mutation.UpdatedAt(); ok {
 		_spec.SetField(mqlog.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -226,6 +252,26 @@ func (mluo *MqLogUpdateOne) SetNillableIsProcessing(b *bool) *MqLogUpdateOne {
 	return mluo
 }
 
+// SetCancelledAt sets the "cancelled_at" field.
+func (mluo *MqLogUpdateOne) SetCancelledAt(t time.Time) *MqLogUpdateOne {
+	mluo.mutation.SetCancelledAt(t)
+	return mluo
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (mluo *MqLogUpdateOne) SetNillableCancelledAt(t *time.Time) *MqLogUpdateOne {
+	if t != nil {
+		mluo.SetCancelledAt(*t)
+	}
+	return mluo
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (mluo *MqLogUpdateOne) ClearCancelledAt() *MqLogUpdateOne {
+	mluo.mutation.ClearCancelledAt()
+	return mluo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (mluo *MqLogUpdateOne) SetUpdatedAt(t time.Time) *MqLogUpdateOne {
 	mluo.mutation.SetUpdatedAt(t)
@@ -330,6 +376,12 @@ func (mluo *MqLogUpdateOne) sqlSave(ctx context.Context) (_node *MqLog, err erro
 	if value, ok := mluo.mutation.IsProcessing(); ok {
 		_spec.SetField(mqlog.FieldIsProcessing, field.TypeBool, value)
 	}
+	if value, ok := mluo.mutation.CancelledAt(); ok {
+		_spec.SetField(mqlog.FieldCancelledAt, field.TypeTime, value)
+	}
+	if mluo.mutation.CancelledAtCleared() {
+		_spec.ClearField(mqlog.FieldCancelledAt, field.TypeTime)
+	}
 	if value, ok := mluo.mutation.UpdatedAt(); ok {
 		_spec.SetField(mqlog.FieldUpdatedAt, field.TypeTime, value)
 	}
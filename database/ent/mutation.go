@@ -13,17 +13,25 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database/ent/apitoken"
+	"github.com/stablecog/sc-go/database/ent/apitokenusage"
 	"github.com/stablecog/sc-go/database/ent/authclient"
 	"github.com/stablecog/sc-go/database/ent/bannedwords"
 	"github.com/stablecog/sc-go/database/ent/credit"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/credittransaction"
 	"github.com/stablecog/sc-go/database/ent/credittype"
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
+	"github.com/stablecog/sc-go/database/ent/deadletter"
 	"github.com/stablecog/sc-go/database/ent/deviceinfo"
 	"github.com/stablecog/sc-go/database/ent/disposableemail"
 	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
 	"github.com/stablecog/sc-go/database/ent/generationmodel"
 	"github.com/stablecog/sc-go/database/ent/generationoutput"
 	"github.com/stablecog/sc-go/database/ent/generationoutputlike"
+	"github.com/stablecog/sc-go/database/ent/generationpreset"
 	"github.com/stablecog/sc-go/database/ent/ipblacklist"
+	"github.com/stablecog/sc-go/database/ent/moderationverdict"
 	"github.com/stablecog/sc-go/database/ent/mqlog"
 	"github.com/stablecog/sc-go/database/ent/negativeprompt"
 	"github.com/stablecog/sc-go/database/ent/predicate"
@@ -54,17 +62,25 @@ const (
 
 	// Node types.
 	TypeApiToken             = "ApiToken"
+	TypeApiTokenUsage        = "ApiTokenUsage"
 	TypeAuthClient           = "AuthClient"
 	TypeBannedWords          = "BannedWords"
 	TypeCredit               = "Credit"
+	TypeCreditHold           = "CreditHold"
+	TypeCreditTransaction    = "CreditTransaction"
 	TypeCreditType           = "CreditType"
+	TypeCronJobRun           = "CronJobRun"
+	TypeDeadLetter           = "DeadLetter"
 	TypeDeviceInfo           = "DeviceInfo"
 	TypeDisposableEmail      = "DisposableEmail"
 	TypeGeneration           = "Generation"
+	TypeGenerationBatch      = "GenerationBatch"
 	TypeGenerationModel      = "GenerationModel"
 	TypeGenerationOutput     = "GenerationOutput"
 	TypeGenerationOutputLike = "GenerationOutputLike"
+	TypeGenerationPreset     = "GenerationPreset"
 	TypeIPBlackList          = "IPBlackList"
+	TypeModerationVerdict    = "ModerationVerdict"
 	TypeMqLog                = "MqLog"
 	TypeNegativePrompt       = "NegativePrompt"
 	TypePrompt               = "Prompt"
@@ -86,37 +102,46 @@ const (
 // ApiTokenMutation represents an operation that mutates the ApiToken nodes in the graph.
 type ApiTokenMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	hashed_token        *string
-	name                *string
-	short_string        *string
-	is_active           *bool
-	uses                *int
-	adduses             *int
-	credits_spent       *int
-	addcredits_spent    *int
-	last_used_at        *time.Time
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	user                *uuid.UUID
-	cleareduser         bool
-	generations         map[uuid.UUID]struct{}
-	removedgenerations  map[uuid.UUID]struct{}
-	clearedgenerations  bool
-	upscales            map[uuid.UUID]struct{}
-	removedupscales     map[uuid.UUID]struct{}
-	clearedupscales     bool
-	voiceovers          map[uuid.UUID]struct{}
-	removedvoiceovers   map[uuid.UUID]struct{}
-	clearedvoiceovers   bool
-	auth_clients        *uuid.UUID
-	clearedauth_clients bool
-	done                bool
-	oldValue            func(context.Context) (*ApiToken, error)
-	predicates          []predicate.ApiToken
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	hashed_token             *string
+	name                     *string
+	short_string             *string
+	is_active                *bool
+	uses                     *int
+	adduses                  *int
+	credits_spent            *int
+	addcredits_spent         *int
+	scopes                   *[]string
+	appendscopes             []string
+	expires_at               *time.Time
+	monthly_credit_cap       *int
+	addmonthly_credit_cap    *int
+	monthly_credits_spent    *int
+	addmonthly_credits_spent *int
+	monthly_credits_period   *time.Time
+	rotated_from_id          *uuid.UUID
+	last_used_at             *time.Time
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	user                     *uuid.UUID
+	cleareduser              bool
+	generations              map[uuid.UUID]struct{}
+	removedgenerations       map[uuid.UUID]struct{}
+	clearedgenerations       bool
+	upscales                 map[uuid.UUID]struct{}
+	removedupscales          map[uuid.UUID]struct{}
+	clearedupscales          bool
+	voiceovers               map[uuid.UUID]struct{}
+	removedvoiceovers        map[uuid.UUID]struct{}
+	clearedvoiceovers        bool
+	auth_clients             *uuid.UUID
+	clearedauth_clients      bool
+	done                     bool
+	oldValue                 func(context.Context) (*ApiToken, error)
+	predicates               []predicate.ApiToken
 }
 
 var _ ent.Mutation = (*ApiTokenMutation)(nil)
@@ -479,6 +504,344 @@ func (m *ApiTokenMutation) ResetCreditsSpent() {
 	m.addcredits_spent = nil
 }
 
+// SetScopes sets the "scopes" field.
+func (m *ApiTokenMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *ApiTokenMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the ApiToken entity.
+// If the ApiToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *ApiTokenMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *ApiTokenMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (m *ApiTokenMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[apitoken.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *ApiTokenMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *ApiTokenMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, apitoken.FieldScopes)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ApiTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ApiTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ApiToken entity.
+// If the ApiToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiTokenMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ApiTokenMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[apitoken.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ApiTokenMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ApiTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, apitoken.FieldExpiresAt)
+}
+
+// SetMonthlyCreditCap sets the "monthly_credit_cap" field.
+func (m *ApiTokenMutation) SetMonthlyCreditCap(i int) {
+	m.monthly_credit_cap = &i
+	m.addmonthly_credit_cap = nil
+}
+
+// MonthlyCreditCap returns the value of the "monthly_credit_cap" field in the mutation.
+func (m *ApiTokenMutation) MonthlyCreditCap() (r int, exists bool) {
+	v := m.monthly_credit_cap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonthlyCreditCap returns the old "monthly_credit_cap" field's value of the ApiToken entity.
+// If the ApiToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiTokenMutation) OldMonthlyCreditCap(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthlyCreditCap is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthlyCreditCap requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthlyCreditCap: %w", err)
+	}
+	return oldValue.MonthlyCreditCap, nil
+}
+
+// AddMonthlyCreditCap adds i to the "monthly_credit_cap" field.
+func (m *ApiTokenMutation) AddMonthlyCreditCap(i int) {
+	if m.addmonthly_credit_cap != nil {
+		*m.addmonthly_credit_cap += i
+	} else {
+		m.addmonthly_credit_cap = &i
+	}
+}
+
+// AddedMonthlyCreditCap returns the value that was added to the "monthly_credit_cap" field in this mutation.
+func (m *ApiTokenMutation) AddedMonthlyCreditCap() (r int, exists bool) {
+	v := m.addmonthly_credit_cap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMonthlyCreditCap clears the value of the "monthly_credit_cap" field.
+func (m *ApiTokenMutation) ClearMonthlyCreditCap() {
+	m.monthly_credit_cap = nil
+	m.addmonthly_credit_cap = nil
+	m.clearedFields[apitoken.FieldMonthlyCreditCap] = struct{}{}
+}
+
+// MonthlyCreditCapCleared returns if the "monthly_credit_cap" field was cleared in this mutation.
+func (m *ApiTokenMutation) MonthlyCreditCapCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldMonthlyCreditCap]
+	return ok
+}
+
+// ResetMonthlyCreditCap resets all changes to the "monthly_credit_cap" field.
+func (m *ApiTokenMutation) ResetMonthlyCreditCap() {
+	m.monthly_credit_cap = nil
+	m.addmonthly_credit_cap = nil
+	delete(m.clearedFields, apitoken.FieldMonthlyCreditCap)
+}
+
+// SetMonthlyCreditsSpent sets the "monthly_credits_spent" field.
+func (m *ApiTokenMutation) SetMonthlyCreditsSpent(i int) {
+	m.monthly_credits_spent = &i
+	m.addmonthly_credits_spent = nil
+}
+
+// MonthlyCreditsSpent returns the value of the "monthly_credits_spent" field in the mutation.
+func (m *ApiTokenMutation) MonthlyCreditsSpent() (r int, exists bool) {
+	v := m.monthly_credits_spent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonthlyCreditsSpent returns the old "monthly_credits_spent" field's value of the ApiToken entity.
+// If the ApiToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiTokenMutation) OldMonthlyCreditsSpent(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthlyCreditsSpent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthlyCreditsSpent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthlyCreditsSpent: %w", err)
+	}
+	return oldValue.MonthlyCreditsSpent, nil
+}
+
+// AddMonthlyCreditsSpent adds i to the "monthly_credits_spent" field.
+func (m *ApiTokenMutation) AddMonthlyCreditsSpent(i int) {
+	if m.addmonthly_credits_spent != nil {
+		*m.addmonthly_credits_spent += i
+	} else {
+		m.addmonthly_credits_spent = &i
+	}
+}
+
+// AddedMonthlyCreditsSpent returns the value that was added to the "monthly_credits_spent" field in this mutation.
+func (m *ApiTokenMutation) AddedMonthlyCreditsSpent() (r int, exists bool) {
+	v := m.addmonthly_credits_spent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMonthlyCreditsSpent resets all changes to the "monthly_credits_spent" field.
+func (m *ApiTokenMutation) ResetMonthlyCreditsSpent() {
+	m.monthly_credits_spent = nil
+	m.addmonthly_credits_spent = nil
+}
+
+// SetMonthlyCreditsPeriod sets the "monthly_credits_period" field.
+func (m *ApiTokenMutation) SetMonthlyCreditsPeriod(t time.Time) {
+	m.monthly_credits_period = &t
+}
+
+// MonthlyCreditsPeriod returns the value of the "monthly_credits_period" field in the mutation.
+func (m *ApiTokenMutation) MonthlyCreditsPeriod() (r time.Time, exists bool) {
+	v := m.monthly_credits_period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonthlyCreditsPeriod returns the old "monthly_credits_period" field's value of the ApiToken entity.
+// If the ApiToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiTokenMutation) OldMonthlyCreditsPeriod(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthlyCreditsPeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthlyCreditsPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthlyCreditsPeriod: %w", err)
+	}
+	return oldValue.MonthlyCreditsPeriod, nil
+}
+
+// ClearMonthlyCreditsPeriod clears the value of the "monthly_credits_period" field.
+func (m *ApiTokenMutation) ClearMonthlyCreditsPeriod() {
+	m.monthly_credits_period = nil
+	m.clearedFields[apitoken.FieldMonthlyCreditsPeriod] = struct{}{}
+}
+
+// MonthlyCreditsPeriodCleared returns if the "monthly_credits_period" field was cleared in this mutation.
+func (m *ApiTokenMutation) MonthlyCreditsPeriodCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldMonthlyCreditsPeriod]
+	return ok
+}
+
+// ResetMonthlyCreditsPeriod resets all changes to the "monthly_credits_period" field.
+func (m *ApiTokenMutation) ResetMonthlyCreditsPeriod() {
+	m.monthly_credits_period = nil
+	delete(m.clearedFields, apitoken.FieldMonthlyCreditsPeriod)
+}
+
+// SetRotatedFromID sets the "rotated_from_id" field.
+func (m *ApiTokenMutation) SetRotatedFromID(u uuid.UUID) {
+	m.rotated_from_id = &u
+}
+
+// RotatedFromID returns the value of the "rotated_from_id" field in the mutation.
+func (m *ApiTokenMutation) RotatedFromID() (r uuid.UUID, exists bool) {
+	v := m.rotated_from_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRotatedFromID returns the old "rotated_from_id" field's value of the ApiToken entity.
+// If the ApiToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiTokenMutation) OldRotatedFromID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRotatedFromID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRotatedFromID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRotatedFromID: %w", err)
+	}
+	return oldValue.RotatedFromID, nil
+}
+
+// ClearRotatedFromID clears the value of the "rotated_from_id" field.
+func (m *ApiTokenMutation) ClearRotatedFromID() {
+	m.rotated_from_id = nil
+	m.clearedFields[apitoken.FieldRotatedFromID] = struct{}{}
+}
+
+// RotatedFromIDCleared returns if the "rotated_from_id" field was cleared in this mutation.
+func (m *ApiTokenMutation) RotatedFromIDCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldRotatedFromID]
+	return ok
+}
+
+// ResetRotatedFromID resets all changes to the "rotated_from_id" field.
+func (m *ApiTokenMutation) ResetRotatedFromID() {
+	m.rotated_from_id = nil
+	delete(m.clearedFields, apitoken.FieldRotatedFromID)
+}
+
 // SetUserID sets the "user_id" field.
 func (m *ApiTokenMutation) SetUserID(u uuid.UUID) {
 	m.user = &u
@@ -948,7 +1311,7 @@ func (m *ApiTokenMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ApiTokenMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 17)
 	if m.hashed_token != nil {
 		fields = append(fields, apitoken.FieldHashedToken)
 	}
@@ -967,6 +1330,24 @@ func (m *ApiTokenMutation) Fields() []string {
 	if m.credits_spent != nil {
 		fields = append(fields, apitoken.FieldCreditsSpent)
 	}
+	if m.scopes != nil {
+		fields = append(fields, apitoken.FieldScopes)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, apitoken.FieldExpiresAt)
+	}
+	if m.monthly_credit_cap != nil {
+		fields = append(fields, apitoken.FieldMonthlyCreditCap)
+	}
+	if m.monthly_credits_spent != nil {
+		fields = append(fields, apitoken.FieldMonthlyCreditsSpent)
+	}
+	if m.monthly_credits_period != nil {
+		fields = append(fields, apitoken.FieldMonthlyCreditsPeriod)
+	}
+	if m.rotated_from_id != nil {
+		fields = append(fields, apitoken.FieldRotatedFromID)
+	}
 	if m.user != nil {
 		fields = append(fields, apitoken.FieldUserID)
 	}
@@ -1002,6 +1383,18 @@ func (m *ApiTokenMutation) Field(name string) (ent.Value, bool) {
 		return m.Uses()
 	case apitoken.FieldCreditsSpent:
 		return m.CreditsSpent()
+	case apitoken.FieldScopes:
+		return m.Scopes()
+	case apitoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case apitoken.FieldMonthlyCreditCap:
+		return m.MonthlyCreditCap()
+	case apitoken.FieldMonthlyCreditsSpent:
+		return m.MonthlyCreditsSpent()
+	case apitoken.FieldMonthlyCreditsPeriod:
+		return m.MonthlyCreditsPeriod()
+	case apitoken.FieldRotatedFromID:
+		return m.RotatedFromID()
 	case apitoken.FieldUserID:
 		return m.UserID()
 	case apitoken.FieldAuthClientID:
@@ -1033,6 +1426,18 @@ func (m *ApiTokenMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldUses(ctx)
 	case apitoken.FieldCreditsSpent:
 		return m.OldCreditsSpent(ctx)
+	case apitoken.FieldScopes:
+		return m.OldScopes(ctx)
+	case apitoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case apitoken.FieldMonthlyCreditCap:
+		return m.OldMonthlyCreditCap(ctx)
+	case apitoken.FieldMonthlyCreditsSpent:
+		return m.OldMonthlyCreditsSpent(ctx)
+	case apitoken.FieldMonthlyCreditsPeriod:
+		return m.OldMonthlyCreditsPeriod(ctx)
+	case apitoken.FieldRotatedFromID:
+		return m.OldRotatedFromID(ctx)
 	case apitoken.FieldUserID:
 		return m.OldUserID(ctx)
 	case apitoken.FieldAuthClientID:
@@ -1094,6 +1499,48 @@ func (m *ApiTokenMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCreditsSpent(v)
 		return nil
+	case apitoken.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case apitoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case apitoken.FieldMonthlyCreditCap:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyCreditCap(v)
+		return nil
+	case apitoken.FieldMonthlyCreditsSpent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyCreditsSpent(v)
+		return nil
+	case apitoken.FieldMonthlyCreditsPeriod:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyCreditsPeriod(v)
+		return nil
+	case apitoken.FieldRotatedFromID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRotatedFromID(v)
+		return nil
 	case apitoken.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -1143,6 +1590,12 @@ func (m *ApiTokenMutation) AddedFields() []string {
 	if m.addcredits_spent != nil {
 		fields = append(fields, apitoken.FieldCreditsSpent)
 	}
+	if m.addmonthly_credit_cap != nil {
+		fields = append(fields, apitoken.FieldMonthlyCreditCap)
+	}
+	if m.addmonthly_credits_spent != nil {
+		fields = append(fields, apitoken.FieldMonthlyCreditsSpent)
+	}
 	return fields
 }
 
@@ -1155,6 +1608,10 @@ func (m *ApiTokenMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedUses()
 	case apitoken.FieldCreditsSpent:
 		return m.AddedCreditsSpent()
+	case apitoken.FieldMonthlyCreditCap:
+		return m.AddedMonthlyCreditCap()
+	case apitoken.FieldMonthlyCreditsSpent:
+		return m.AddedMonthlyCreditsSpent()
 	}
 	return nil, false
 }
@@ -1178,14 +1635,43 @@ func (m *ApiTokenMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddCreditsSpent(v)
 		return nil
-	}
-	return fmt.Errorf("unknown ApiToken numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
+	case apitoken.FieldMonthlyCreditCap:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthlyCreditCap(v)
+		return nil
+	case apitoken.FieldMonthlyCreditsSpent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthlyCreditsSpent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ApiToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
 func (m *ApiTokenMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(apitoken.FieldScopes) {
+		fields = append(fields, apitoken.FieldScopes)
+	}
+	if m.FieldCleared(apitoken.FieldExpiresAt) {
+		fields = append(fields, apitoken.FieldExpiresAt)
+	}
+	if m.FieldCleared(apitoken.FieldMonthlyCreditCap) {
+		fields = append(fields, apitoken.FieldMonthlyCreditCap)
+	}
+	if m.FieldCleared(apitoken.FieldMonthlyCreditsPeriod) {
+		fields = append(fields, apitoken.FieldMonthlyCreditsPeriod)
+	}
+	if m.FieldCleared(apitoken.FieldRotatedFromID) {
+		fields = append(fields, apitoken.FieldRotatedFromID)
+	}
 	if m.FieldCleared(apitoken.FieldAuthClientID) {
 		fields = append(fields, apitoken.FieldAuthClientID)
 	}
@@ -1206,6 +1692,21 @@ func (m *ApiTokenMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *ApiTokenMutation) ClearField(name string) error {
 	switch name {
+	case apitoken.FieldScopes:
+		m.ClearScopes()
+		return nil
+	case apitoken.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case apitoken.FieldMonthlyCreditCap:
+		m.ClearMonthlyCreditCap()
+		return nil
+	case apitoken.FieldMonthlyCreditsPeriod:
+		m.ClearMonthlyCreditsPeriod()
+		return nil
+	case apitoken.FieldRotatedFromID:
+		m.ClearRotatedFromID()
+		return nil
 	case apitoken.FieldAuthClientID:
 		m.ClearAuthClientID()
 		return nil
@@ -1238,6 +1739,24 @@ func (m *ApiTokenMutation) ResetField(name string) error {
 	case apitoken.FieldCreditsSpent:
 		m.ResetCreditsSpent()
 		return nil
+	case apitoken.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case apitoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case apitoken.FieldMonthlyCreditCap:
+		m.ResetMonthlyCreditCap()
+		return nil
+	case apitoken.FieldMonthlyCreditsSpent:
+		m.ResetMonthlyCreditsSpent()
+		return nil
+	case apitoken.FieldMonthlyCreditsPeriod:
+		m.ResetMonthlyCreditsPeriod()
+		return nil
+	case apitoken.FieldRotatedFromID:
+		m.ResetRotatedFromID()
+		return nil
 	case apitoken.FieldUserID:
 		m.ResetUserID()
 		return nil
@@ -1429,35 +1948,35 @@ func (m *ApiTokenMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown ApiToken edge %s", name)
 }
 
-// AuthClientMutation represents an operation that mutates the AuthClient nodes in the graph.
-type AuthClientMutation struct {
+// ApiTokenUsageMutation represents an operation that mutates the ApiTokenUsage nodes in the graph.
+type ApiTokenUsageMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	name              *string
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	api_tokens        map[uuid.UUID]struct{}
-	removedapi_tokens map[uuid.UUID]struct{}
-	clearedapi_tokens bool
-	done              bool
-	oldValue          func(context.Context) (*AuthClient, error)
-	predicates        []predicate.AuthClient
-}
-
-var _ ent.Mutation = (*AuthClientMutation)(nil)
-
-// authclientOption allows management of the mutation configuration using functional options.
-type authclientOption func(*AuthClientMutation)
-
-// newAuthClientMutation creates new mutation for the AuthClient entity.
-func newAuthClientMutation(c config, op Op, opts ...authclientOption) *AuthClientMutation {
-	m := &AuthClientMutation{
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	api_token_id     *uuid.UUID
+	ip               *string
+	endpoint         *string
+	credits_spent    *int
+	addcredits_spent *int
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*ApiTokenUsage, error)
+	predicates       []predicate.ApiTokenUsage
+}
+
+var _ ent.Mutation = (*ApiTokenUsageMutation)(nil)
+
+// apitokenusageOption allows management of the mutation configuration using functional options.
+type apitokenusageOption func(*ApiTokenUsageMutation)
+
+// newApiTokenUsageMutation creates new mutation for the ApiTokenUsage entity.
+func newApiTokenUsageMutation(c config, op Op, opts ...apitokenusageOption) *ApiTokenUsageMutation {
+	m := &ApiTokenUsageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuthClient,
+		typ:           TypeApiTokenUsage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1466,20 +1985,20 @@ func newAuthClientMutation(c config, op Op, opts ...authclientOption) *AuthClien
 	return m
 }
 
-// withAuthClientID sets the ID field of the mutation.
-func withAuthClientID(id uuid.UUID) authclientOption {
-	return func(m *AuthClientMutation) {
+// withApiTokenUsageID sets the ID field of the mutation.
+func withApiTokenUsageID(id uuid.UUID) apitokenusageOption {
+	return func(m *ApiTokenUsageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AuthClient
+			value *ApiTokenUsage
 		)
-		m.oldValue = func(ctx context.Context) (*AuthClient, error) {
+		m.oldValue = func(ctx context.Context) (*ApiTokenUsage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AuthClient.Get(ctx, id)
+					value, err = m.Client().ApiTokenUsage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1488,10 +2007,10 @@ func withAuthClientID(id uuid.UUID) authclientOption {
 	}
 }
 
-// withAuthClient sets the old AuthClient of the mutation.
-func withAuthClient(node *AuthClient) authclientOption {
-	return func(m *AuthClientMutation) {
-		m.oldValue = func(context.Context) (*AuthClient, error) {
+// withApiTokenUsage sets the old ApiTokenUsage of the mutation.
+func withApiTokenUsage(node *ApiTokenUsage) apitokenusageOption {
+	return func(m *ApiTokenUsageMutation) {
+		m.oldValue = func(context.Context) (*ApiTokenUsage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1500,7 +2019,7 @@ func withAuthClient(node *AuthClient) authclientOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuthClientMutation) Client() *Client {
+func (m ApiTokenUsageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1508,7 +2027,7 @@ func (m AuthClientMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuthClientMutation) Tx() (*Tx, error) {
+func (m ApiTokenUsageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1518,14 +2037,14 @@ func (m AuthClientMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of AuthClient entities.
-func (m *AuthClientMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ApiTokenUsage entities.
+func (m *ApiTokenUsageMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuthClientMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ApiTokenUsageMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1536,7 +2055,7 @@ func (m *AuthClientMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuthClientMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ApiTokenUsageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1545,183 +2064,221 @@ func (m *AuthClientMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuthClient.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ApiTokenUsage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *AuthClientMutation) SetName(s string) {
-	m.name = &s
+// SetAPITokenID sets the "api_token_id" field.
+func (m *ApiTokenUsageMutation) SetAPITokenID(u uuid.UUID) {
+	m.api_token_id = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *AuthClientMutation) Name() (r string, exists bool) {
-	v := m.name
+// APITokenID returns the value of the "api_token_id" field in the mutation.
+func (m *ApiTokenUsageMutation) APITokenID() (r uuid.UUID, exists bool) {
+	v := m.api_token_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the AuthClient entity.
-// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// OldAPITokenID returns the old "api_token_id" field's value of the ApiTokenUsage entity.
+// If the ApiTokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthClientMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ApiTokenUsageMutation) OldAPITokenID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPITokenID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldAPITokenID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPITokenID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.APITokenID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *AuthClientMutation) ResetName() {
-	m.name = nil
+// ResetAPITokenID resets all changes to the "api_token_id" field.
+func (m *ApiTokenUsageMutation) ResetAPITokenID() {
+	m.api_token_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *AuthClientMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetIP sets the "ip" field.
+func (m *ApiTokenUsageMutation) SetIP(s string) {
+	m.ip = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *AuthClientMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// IP returns the value of the "ip" field in the mutation.
+func (m *ApiTokenUsageMutation) IP() (r string, exists bool) {
+	v := m.ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the AuthClient entity.
-// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// OldIP returns the old "ip" field's value of the ApiTokenUsage entity.
+// If the ApiTokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthClientMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ApiTokenUsageMutation) OldIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.IP, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *AuthClientMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetIP resets all changes to the "ip" field.
+func (m *ApiTokenUsageMutation) ResetIP() {
+	m.ip = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *AuthClientMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetEndpoint sets the "endpoint" field.
+func (m *ApiTokenUsageMutation) SetEndpoint(s string) {
+	m.endpoint = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *AuthClientMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *ApiTokenUsageMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the AuthClient entity.
-// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// OldEndpoint returns the old "endpoint" field's value of the ApiTokenUsage entity.
+// If the ApiTokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthClientMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ApiTokenUsageMutation) OldEndpoint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Endpoint, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *AuthClientMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *ApiTokenUsageMutation) ResetEndpoint() {
+	m.endpoint = nil
 }
 
-// AddAPITokenIDs adds the "api_tokens" edge to the ApiToken entity by ids.
-func (m *AuthClientMutation) AddAPITokenIDs(ids ...uuid.UUID) {
-	if m.api_tokens == nil {
-		m.api_tokens = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.api_tokens[ids[i]] = struct{}{}
-	}
+// SetCreditsSpent sets the "credits_spent" field.
+func (m *ApiTokenUsageMutation) SetCreditsSpent(i int) {
+	m.credits_spent = &i
+	m.addcredits_spent = nil
 }
 
-// ClearAPITokens clears the "api_tokens" edge to the ApiToken entity.
-func (m *AuthClientMutation) ClearAPITokens() {
-	m.clearedapi_tokens = true
+// CreditsSpent returns the value of the "credits_spent" field in the mutation.
+func (m *ApiTokenUsageMutation) CreditsSpent() (r int, exists bool) {
+	v := m.credits_spent
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// APITokensCleared reports if the "api_tokens" edge to the ApiToken entity was cleared.
-func (m *AuthClientMutation) APITokensCleared() bool {
-	return m.clearedapi_tokens
+// OldCreditsSpent returns the old "credits_spent" field's value of the ApiTokenUsage entity.
+// If the ApiTokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiTokenUsageMutation) OldCreditsSpent(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreditsSpent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreditsSpent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreditsSpent: %w", err)
+	}
+	return oldValue.CreditsSpent, nil
 }
 
-// RemoveAPITokenIDs removes the "api_tokens" edge to the ApiToken entity by IDs.
-func (m *AuthClientMutation) RemoveAPITokenIDs(ids ...uuid.UUID) {
-	if m.removedapi_tokens == nil {
-		m.removedapi_tokens = make(map[uuid.UUID]struct{})
+// AddCreditsSpent adds i to the "credits_spent" field.
+func (m *ApiTokenUsageMutation) AddCreditsSpent(i int) {
+	if m.addcredits_spent != nil {
+		*m.addcredits_spent += i
+	} else {
+		m.addcredits_spent = &i
 	}
-	for i := range ids {
-		delete(m.api_tokens, ids[i])
-		m.removedapi_tokens[ids[i]] = struct{}{}
+}
+
+// AddedCreditsSpent returns the value that was added to the "credits_spent" field in this mutation.
+func (m *ApiTokenUsageMutation) AddedCreditsSpent() (r int, exists bool) {
+	v := m.addcredits_spent
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAPITokens returns the removed IDs of the "api_tokens" edge to the ApiToken entity.
-func (m *AuthClientMutation) RemovedAPITokensIDs() (ids []uuid.UUID) {
-	for id := range m.removedapi_tokens {
-		ids = append(ids, id)
+// ResetCreditsSpent resets all changes to the "credits_spent" field.
+func (m *ApiTokenUsageMutation) ResetCreditsSpent() {
+	m.credits_spent = nil
+	m.addcredits_spent = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ApiTokenUsageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ApiTokenUsageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// APITokensIDs returns the "api_tokens" edge IDs in the mutation.
-func (m *AuthClientMutation) APITokensIDs() (ids []uuid.UUID) {
-	for id := range m.api_tokens {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the ApiTokenUsage entity.
+// If the ApiTokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiTokenUsageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetAPITokens resets all changes to the "api_tokens" edge.
-func (m *AuthClientMutation) ResetAPITokens() {
-	m.api_tokens = nil
-	m.clearedapi_tokens = false
-	m.removedapi_tokens = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ApiTokenUsageMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the AuthClientMutation builder.
-func (m *AuthClientMutation) Where(ps ...predicate.AuthClient) {
+// Where appends a list predicates to the ApiTokenUsageMutation builder.
+func (m *ApiTokenUsageMutation) Where(ps ...predicate.ApiTokenUsage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AuthClientMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ApiTokenUsageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuthClientMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuthClient, len(ps))
+func (m *ApiTokenUsageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ApiTokenUsage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1729,33 +2286,39 @@ func (m *AuthClientMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AuthClientMutation) Op() Op {
+func (m *ApiTokenUsageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AuthClientMutation) SetOp(op Op) {
+func (m *ApiTokenUsageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (AuthClient).
-func (m *AuthClientMutation) Type() string {
+// Type returns the node type of this mutation (ApiTokenUsage).
+func (m *ApiTokenUsageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AuthClientMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.name != nil {
-		fields = append(fields, authclient.FieldName)
+func (m *ApiTokenUsageMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.api_token_id != nil {
+		fields = append(fields, apitokenusage.FieldAPITokenID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, authclient.FieldCreatedAt)
+	if m.ip != nil {
+		fields = append(fields, apitokenusage.FieldIP)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, authclient.FieldUpdatedAt)
+	if m.endpoint != nil {
+		fields = append(fields, apitokenusage.FieldEndpoint)
+	}
+	if m.credits_spent != nil {
+		fields = append(fields, apitokenusage.FieldCreditsSpent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, apitokenusage.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1763,14 +2326,18 @@ func (m *AuthClientMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuthClientMutation) Field(name string) (ent.Value, bool) {
+func (m *ApiTokenUsageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case authclient.FieldName:
-		return m.Name()
-	case authclient.FieldCreatedAt:
+	case apitokenusage.FieldAPITokenID:
+		return m.APITokenID()
+	case apitokenusage.FieldIP:
+		return m.IP()
+	case apitokenusage.FieldEndpoint:
+		return m.Endpoint()
+	case apitokenusage.FieldCreditsSpent:
+		return m.CreditsSpent()
+	case apitokenusage.FieldCreatedAt:
 		return m.CreatedAt()
-	case authclient.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1778,219 +2345,222 @@ func (m *AuthClientMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuthClientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ApiTokenUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case authclient.FieldName:
-		return m.OldName(ctx)
-	case authclient.FieldCreatedAt:
+	case apitokenusage.FieldAPITokenID:
+		return m.OldAPITokenID(ctx)
+	case apitokenusage.FieldIP:
+		return m.OldIP(ctx)
+	case apitokenusage.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case apitokenusage.FieldCreditsSpent:
+		return m.OldCreditsSpent(ctx)
+	case apitokenusage.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case authclient.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown AuthClient field %s", name)
+	return nil, fmt.Errorf("unknown ApiTokenUsage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthClientMutation) SetField(name string, value ent.Value) error {
+func (m *ApiTokenUsageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case authclient.FieldName:
+	case apitokenusage.FieldAPITokenID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPITokenID(v)
+		return nil
+	case apitokenusage.FieldIP:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetIP(v)
 		return nil
-	case authclient.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case apitokenusage.FieldEndpoint:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetEndpoint(v)
 		return nil
-	case authclient.FieldUpdatedAt:
+	case apitokenusage.FieldCreditsSpent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreditsSpent(v)
+		return nil
+	case apitokenusage.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AuthClient field %s", name)
+	return fmt.Errorf("unknown ApiTokenUsage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuthClientMutation) AddedFields() []string {
-	return nil
+func (m *ApiTokenUsageMutation) AddedFields() []string {
+	var fields []string
+	if m.addcredits_spent != nil {
+		fields = append(fields, apitokenusage.FieldCreditsSpent)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuthClientMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ApiTokenUsageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case apitokenusage.FieldCreditsSpent:
+		return m.AddedCreditsSpent()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthClientMutation) AddField(name string, value ent.Value) error {
+func (m *ApiTokenUsageMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case apitokenusage.FieldCreditsSpent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreditsSpent(v)
+		return nil
 	}
-	return fmt.Errorf("unknown AuthClient numeric field %s", name)
+	return fmt.Errorf("unknown ApiTokenUsage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuthClientMutation) ClearedFields() []string {
+func (m *ApiTokenUsageMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuthClientMutation) FieldCleared(name string) bool {
+func (m *ApiTokenUsageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuthClientMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown AuthClient nullable field %s", name)
+func (m *ApiTokenUsageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ApiTokenUsage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuthClientMutation) ResetField(name string) error {
+func (m *ApiTokenUsageMutation) ResetField(name string) error {
 	switch name {
-	case authclient.FieldName:
-		m.ResetName()
+	case apitokenusage.FieldAPITokenID:
+		m.ResetAPITokenID()
 		return nil
-	case authclient.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case apitokenusage.FieldIP:
+		m.ResetIP()
 		return nil
-	case authclient.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case apitokenusage.FieldEndpoint:
+		m.ResetEndpoint()
+		return nil
+	case apitokenusage.FieldCreditsSpent:
+		m.ResetCreditsSpent()
+		return nil
+	case apitokenusage.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown AuthClient field %s", name)
+	return fmt.Errorf("unknown ApiTokenUsage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuthClientMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.api_tokens != nil {
-		edges = append(edges, authclient.EdgeAPITokens)
-	}
+func (m *ApiTokenUsageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuthClientMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case authclient.EdgeAPITokens:
-		ids := make([]ent.Value, 0, len(m.api_tokens))
-		for id := range m.api_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ApiTokenUsageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuthClientMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedapi_tokens != nil {
-		edges = append(edges, authclient.EdgeAPITokens)
-	}
+func (m *ApiTokenUsageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuthClientMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case authclient.EdgeAPITokens:
-		ids := make([]ent.Value, 0, len(m.removedapi_tokens))
-		for id := range m.removedapi_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ApiTokenUsageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuthClientMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedapi_tokens {
-		edges = append(edges, authclient.EdgeAPITokens)
-	}
+func (m *ApiTokenUsageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuthClientMutation) EdgeCleared(name string) bool {
-	switch name {
-	case authclient.EdgeAPITokens:
-		return m.clearedapi_tokens
-	}
+func (m *ApiTokenUsageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuthClientMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown AuthClient unique edge %s", name)
+func (m *ApiTokenUsageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ApiTokenUsage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuthClientMutation) ResetEdge(name string) error {
-	switch name {
-	case authclient.EdgeAPITokens:
-		m.ResetAPITokens()
-		return nil
-	}
-	return fmt.Errorf("unknown AuthClient edge %s", name)
+func (m *ApiTokenUsageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ApiTokenUsage edge %s", name)
 }
 
-// BannedWordsMutation represents an operation that mutates the BannedWords nodes in the graph.
-type BannedWordsMutation struct {
+// AuthClientMutation represents an operation that mutates the AuthClient nodes in the graph.
+type AuthClientMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	words         *[]string
-	appendwords   []string
-	reason        *string
-	split_match   *bool
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*BannedWords, error)
-	predicates    []predicate.BannedWords
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	name              *string
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	api_tokens        map[uuid.UUID]struct{}
+	removedapi_tokens map[uuid.UUID]struct{}
+	clearedapi_tokens bool
+	done              bool
+	oldValue          func(context.Context) (*AuthClient, error)
+	predicates        []predicate.AuthClient
 }
 
-var _ ent.Mutation = (*BannedWordsMutation)(nil)
+var _ ent.Mutation = (*AuthClientMutation)(nil)
 
-// bannedwordsOption allows management of the mutation configuration using functional options.
-type bannedwordsOption func(*BannedWordsMutation)
+// authclientOption allows management of the mutation configuration using functional options.
+type authclientOption func(*AuthClientMutation)
 
-// newBannedWordsMutation creates new mutation for the BannedWords entity.
-func newBannedWordsMutation(c config, op Op, opts ...bannedwordsOption) *BannedWordsMutation {
-	m := &BannedWordsMutation{
+// newAuthClientMutation creates new mutation for the AuthClient entity.
+func newAuthClientMutation(c config, op Op, opts ...authclientOption) *AuthClientMutation {
+	m := &AuthClientMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBannedWords,
+		typ:           TypeAuthClient,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1999,20 +2569,20 @@ func newBannedWordsMutation(c config, op Op, opts ...bannedwordsOption) *BannedW
 	return m
 }
 
-// withBannedWordsID sets the ID field of the mutation.
-func withBannedWordsID(id uuid.UUID) bannedwordsOption {
-	return func(m *BannedWordsMutation) {
+// withAuthClientID sets the ID field of the mutation.
+func withAuthClientID(id uuid.UUID) authclientOption {
+	return func(m *AuthClientMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *BannedWords
+			value *AuthClient
 		)
-		m.oldValue = func(ctx context.Context) (*BannedWords, error) {
+		m.oldValue = func(ctx context.Context) (*AuthClient, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().BannedWords.Get(ctx, id)
+					value, err = m.Client().AuthClient.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2021,10 +2591,10 @@ func withBannedWordsID(id uuid.UUID) bannedwordsOption {
 	}
 }
 
-// withBannedWords sets the old BannedWords of the mutation.
-func withBannedWords(node *BannedWords) bannedwordsOption {
-	return func(m *BannedWordsMutation) {
-		m.oldValue = func(context.Context) (*BannedWords, error) {
+// withAuthClient sets the old AuthClient of the mutation.
+func withAuthClient(node *AuthClient) authclientOption {
+	return func(m *AuthClientMutation) {
+		m.oldValue = func(context.Context) (*AuthClient, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2033,7 +2603,7 @@ func withBannedWords(node *BannedWords) bannedwordsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BannedWordsMutation) Client() *Client {
+func (m AuthClientMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2041,7 +2611,7 @@ func (m BannedWordsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BannedWordsMutation) Tx() (*Tx, error) {
+func (m AuthClientMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2051,14 +2621,14 @@ func (m BannedWordsMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of BannedWords entities.
-func (m *BannedWordsMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AuthClient entities.
+func (m *AuthClientMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BannedWordsMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AuthClientMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2069,7 +2639,7 @@ func (m *BannedWordsMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BannedWordsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AuthClientMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2078,142 +2648,55 @@ func (m *BannedWordsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().BannedWords.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuthClient.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetWords sets the "words" field.
-func (m *BannedWordsMutation) SetWords(s []string) {
-	m.words = &s
-	m.appendwords = nil
+// SetName sets the "name" field.
+func (m *AuthClientMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Words returns the value of the "words" field in the mutation.
-func (m *BannedWordsMutation) Words() (r []string, exists bool) {
-	v := m.words
+// Name returns the value of the "name" field in the mutation.
+func (m *AuthClientMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWords returns the old "words" field's value of the BannedWords entity.
-// If the BannedWords object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BannedWordsMutation) OldWords(ctx context.Context) (v []string, err error) {
+func (m *AuthClientMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWords is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWords requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWords: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Words, nil
+	return oldValue.Name, nil
 }
 
-// AppendWords adds s to the "words" field.
-func (m *BannedWordsMutation) AppendWords(s []string) {
-	m.appendwords = append(m.appendwords, s...)
+// ResetName resets all changes to the "name" field.
+func (m *AuthClientMutation) ResetName() {
+	m.name = nil
 }
 
-// AppendedWords returns the list of values that were appended to the "words" field in this mutation.
-func (m *BannedWordsMutation) AppendedWords() ([]string, bool) {
-	if len(m.appendwords) == 0 {
-		return nil, false
-	}
-	return m.appendwords, true
-}
-
-// ResetWords resets all changes to the "words" field.
-func (m *BannedWordsMutation) ResetWords() {
-	m.words = nil
-	m.appendwords = nil
-}
-
-// SetReason sets the "reason" field.
-func (m *BannedWordsMutation) SetReason(s string) {
-	m.reason = &s
-}
-
-// Reason returns the value of the "reason" field in the mutation.
-func (m *BannedWordsMutation) Reason() (r string, exists bool) {
-	v := m.reason
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldReason returns the old "reason" field's value of the BannedWords entity.
-// If the BannedWords object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BannedWordsMutation) OldReason(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReason is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReason requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReason: %w", err)
-	}
-	return oldValue.Reason, nil
-}
-
-// ResetReason resets all changes to the "reason" field.
-func (m *BannedWordsMutation) ResetReason() {
-	m.reason = nil
-}
-
-// SetSplitMatch sets the "split_match" field.
-func (m *BannedWordsMutation) SetSplitMatch(b bool) {
-	m.split_match = &b
-}
-
-// SplitMatch returns the value of the "split_match" field in the mutation.
-func (m *BannedWordsMutation) SplitMatch() (r bool, exists bool) {
-	v := m.split_match
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSplitMatch returns the old "split_match" field's value of the BannedWords entity.
-// If the BannedWords object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BannedWordsMutation) OldSplitMatch(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSplitMatch is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSplitMatch requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSplitMatch: %w", err)
-	}
-	return oldValue.SplitMatch, nil
-}
-
-// ResetSplitMatch resets all changes to the "split_match" field.
-func (m *BannedWordsMutation) ResetSplitMatch() {
-	m.split_match = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *BannedWordsMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *AuthClientMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *BannedWordsMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AuthClientMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2221,10 +2704,10 @@ func (m *BannedWordsMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the BannedWords entity.
-// If the BannedWords object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BannedWordsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthClientMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2239,17 +2722,17 @@ func (m *BannedWordsMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *BannedWordsMutation) ResetCreatedAt() {
+func (m *AuthClientMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *BannedWordsMutation) SetUpdatedAt(t time.Time) {
+func (m *AuthClientMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *BannedWordsMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *AuthClientMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2257,10 +2740,10 @@ func (m *BannedWordsMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the BannedWords entity.
-// If the BannedWords object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BannedWordsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthClientMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2275,19 +2758,73 @@ func (m *BannedWordsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *BannedWordsMutation) ResetUpdatedAt() {
+func (m *AuthClientMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// Where appends a list predicates to the BannedWordsMutation builder.
-func (m *BannedWordsMutation) Where(ps ...predicate.BannedWords) {
+// AddAPITokenIDs adds the "api_tokens" edge to the ApiToken entity by ids.
+func (m *AuthClientMutation) AddAPITokenIDs(ids ...uuid.UUID) {
+	if m.api_tokens == nil {
+		m.api_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.api_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAPITokens clears the "api_tokens" edge to the ApiToken entity.
+func (m *AuthClientMutation) ClearAPITokens() {
+	m.clearedapi_tokens = true
+}
+
+// APITokensCleared reports if the "api_tokens" edge to the ApiToken entity was cleared.
+func (m *AuthClientMutation) APITokensCleared() bool {
+	return m.clearedapi_tokens
+}
+
+// RemoveAPITokenIDs removes the "api_tokens" edge to the ApiToken entity by IDs.
+func (m *AuthClientMutation) RemoveAPITokenIDs(ids ...uuid.UUID) {
+	if m.removedapi_tokens == nil {
+		m.removedapi_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.api_tokens, ids[i])
+		m.removedapi_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAPITokens returns the removed IDs of the "api_tokens" edge to the ApiToken entity.
+func (m *AuthClientMutation) RemovedAPITokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedapi_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// APITokensIDs returns the "api_tokens" edge IDs in the mutation.
+func (m *AuthClientMutation) APITokensIDs() (ids []uuid.UUID) {
+	for id := range m.api_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAPITokens resets all changes to the "api_tokens" edge.
+func (m *AuthClientMutation) ResetAPITokens() {
+	m.api_tokens = nil
+	m.clearedapi_tokens = false
+	m.removedapi_tokens = nil
+}
+
+// Where appends a list predicates to the AuthClientMutation builder.
+func (m *AuthClientMutation) Where(ps ...predicate.AuthClient) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the BannedWordsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuthClientMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BannedWordsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.BannedWords, len(ps))
+func (m *AuthClientMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthClient, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2295,39 +2832,33 @@ func (m *BannedWordsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *BannedWordsMutation) Op() Op {
+func (m *AuthClientMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *BannedWordsMutation) SetOp(op Op) {
+func (m *AuthClientMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (BannedWords).
-func (m *BannedWordsMutation) Type() string {
+// Type returns the node type of this mutation (AuthClient).
+func (m *AuthClientMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BannedWordsMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.words != nil {
-		fields = append(fields, bannedwords.FieldWords)
-	}
-	if m.reason != nil {
-		fields = append(fields, bannedwords.FieldReason)
-	}
-	if m.split_match != nil {
-		fields = append(fields, bannedwords.FieldSplitMatch)
+func (m *AuthClientMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, authclient.FieldName)
 	}
 	if m.created_at != nil {
-		fields = append(fields, bannedwords.FieldCreatedAt)
+		fields = append(fields, authclient.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, bannedwords.FieldUpdatedAt)
+		fields = append(fields, authclient.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2335,17 +2866,13 @@ func (m *BannedWordsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BannedWordsMutation) Field(name string) (ent.Value, bool) {
+func (m *AuthClientMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case bannedwords.FieldWords:
-		return m.Words()
-	case bannedwords.FieldReason:
-		return m.Reason()
-	case bannedwords.FieldSplitMatch:
-		return m.SplitMatch()
-	case bannedwords.FieldCreatedAt:
+	case authclient.FieldName:
+		return m.Name()
+	case authclient.FieldCreatedAt:
 		return m.CreatedAt()
-	case bannedwords.FieldUpdatedAt:
+	case authclient.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2354,56 +2881,38 @@ func (m *BannedWordsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BannedWordsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuthClientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case bannedwords.FieldWords:
-		return m.OldWords(ctx)
-	case bannedwords.FieldReason:
-		return m.OldReason(ctx)
-	case bannedwords.FieldSplitMatch:
-		return m.OldSplitMatch(ctx)
-	case bannedwords.FieldCreatedAt:
+	case authclient.FieldName:
+		return m.OldName(ctx)
+	case authclient.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case bannedwords.FieldUpdatedAt:
+	case authclient.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown BannedWords field %s", name)
+	return nil, fmt.Errorf("unknown AuthClient field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BannedWordsMutation) SetField(name string, value ent.Value) error {
+func (m *AuthClientMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case bannedwords.FieldWords:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWords(v)
-		return nil
-	case bannedwords.FieldReason:
+	case authclient.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReason(v)
-		return nil
-	case bannedwords.FieldSplitMatch:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSplitMatch(v)
+		m.SetName(v)
 		return nil
-	case bannedwords.FieldCreatedAt:
+	case authclient.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case bannedwords.FieldUpdatedAt:
+	case authclient.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2411,158 +2920,180 @@ func (m *BannedWordsMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown BannedWords field %s", name)
+	return fmt.Errorf("unknown AuthClient field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BannedWordsMutation) AddedFields() []string {
+func (m *AuthClientMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BannedWordsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuthClientMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BannedWordsMutation) AddField(name string, value ent.Value) error {
+func (m *AuthClientMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown BannedWords numeric field %s", name)
+	return fmt.Errorf("unknown AuthClient numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BannedWordsMutation) ClearedFields() []string {
+func (m *AuthClientMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BannedWordsMutation) FieldCleared(name string) bool {
+func (m *AuthClientMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BannedWordsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown BannedWords nullable field %s", name)
+func (m *AuthClientMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AuthClient nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BannedWordsMutation) ResetField(name string) error {
+func (m *AuthClientMutation) ResetField(name string) error {
 	switch name {
-	case bannedwords.FieldWords:
-		m.ResetWords()
-		return nil
-	case bannedwords.FieldReason:
-		m.ResetReason()
-		return nil
-	case bannedwords.FieldSplitMatch:
-		m.ResetSplitMatch()
+	case authclient.FieldName:
+		m.ResetName()
 		return nil
-	case bannedwords.FieldCreatedAt:
+	case authclient.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case bannedwords.FieldUpdatedAt:
+	case authclient.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown BannedWords field %s", name)
+	return fmt.Errorf("unknown AuthClient field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BannedWordsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *AuthClientMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.api_tokens != nil {
+		edges = append(edges, authclient.EdgeAPITokens)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BannedWordsMutation) AddedIDs(name string) []ent.Value {
+func (m *AuthClientMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case authclient.EdgeAPITokens:
+		ids := make([]ent.Value, 0, len(m.api_tokens))
+		for id := range m.api_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BannedWordsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *AuthClientMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedapi_tokens != nil {
+		edges = append(edges, authclient.EdgeAPITokens)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BannedWordsMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuthClientMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case authclient.EdgeAPITokens:
+		ids := make([]ent.Value, 0, len(m.removedapi_tokens))
+		for id := range m.removedapi_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BannedWordsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *AuthClientMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedapi_tokens {
+		edges = append(edges, authclient.EdgeAPITokens)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BannedWordsMutation) EdgeCleared(name string) bool {
+func (m *AuthClientMutation) EdgeCleared(name string) bool {
+	switch name {
+	case authclient.EdgeAPITokens:
+		return m.clearedapi_tokens
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BannedWordsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown BannedWords unique edge %s", name)
+func (m *AuthClientMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AuthClient unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BannedWordsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown BannedWords edge %s", name)
+func (m *AuthClientMutation) ResetEdge(name string) error {
+	switch name {
+	case authclient.EdgeAPITokens:
+		m.ResetAPITokens()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthClient edge %s", name)
 }
 
-// CreditMutation represents an operation that mutates the Credit nodes in the graph.
-type CreditMutation struct {
+// BannedWordsMutation represents an operation that mutates the BannedWords nodes in the graph.
+type BannedWordsMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	remaining_amount    *int32
-	addremaining_amount *int32
-	starts_at           *time.Time
-	expires_at          *time.Time
-	period              *int
-	addperiod           *int
-	stripe_line_item_id *string
-	replenished_at      *time.Time
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	users               *uuid.UUID
-	clearedusers        bool
-	credit_type         *uuid.UUID
-	clearedcredit_type  bool
-	done                bool
-	oldValue            func(context.Context) (*Credit, error)
-	predicates          []predicate.Credit
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	words         *[]string
+	appendwords   []string
+	reason        *string
+	split_match   *bool
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*BannedWords, error)
+	predicates    []predicate.BannedWords
 }
 
-var _ ent.Mutation = (*CreditMutation)(nil)
+var _ ent.Mutation = (*BannedWordsMutation)(nil)
 
-// creditOption allows management of the mutation configuration using functional options.
-type creditOption func(*CreditMutation)
+// bannedwordsOption allows management of the mutation configuration using functional options.
+type bannedwordsOption func(*BannedWordsMutation)
 
-// newCreditMutation creates new mutation for the Credit entity.
-func newCreditMutation(c config, op Op, opts ...creditOption) *CreditMutation {
-	m := &CreditMutation{
+// newBannedWordsMutation creates new mutation for the BannedWords entity.
+func newBannedWordsMutation(c config, op Op, opts ...bannedwordsOption) *BannedWordsMutation {
+	m := &BannedWordsMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCredit,
+		typ:           TypeBannedWords,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2571,20 +3102,20 @@ func newCreditMutation(c config, op Op, opts ...creditOption) *CreditMutation {
 	return m
 }
 
-// withCreditID sets the ID field of the mutation.
-func withCreditID(id uuid.UUID) creditOption {
-	return func(m *CreditMutation) {
+// withBannedWordsID sets the ID field of the mutation.
+func withBannedWordsID(id uuid.UUID) bannedwordsOption {
+	return func(m *BannedWordsMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Credit
+			value *BannedWords
 		)
-		m.oldValue = func(ctx context.Context) (*Credit, error) {
+		m.oldValue = func(ctx context.Context) (*BannedWords, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Credit.Get(ctx, id)
+					value, err = m.Client().BannedWords.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2593,10 +3124,10 @@ func withCreditID(id uuid.UUID) creditOption {
 	}
 }
 
-// withCredit sets the old Credit of the mutation.
-func withCredit(node *Credit) creditOption {
-	return func(m *CreditMutation) {
-		m.oldValue = func(context.Context) (*Credit, error) {
+// withBannedWords sets the old BannedWords of the mutation.
+func withBannedWords(node *BannedWords) bannedwordsOption {
+	return func(m *BannedWordsMutation) {
+		m.oldValue = func(context.Context) (*BannedWords, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2605,7 +3136,7 @@ func withCredit(node *Credit) creditOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CreditMutation) Client() *Client {
+func (m BannedWordsMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2613,7 +3144,7 @@ func (m CreditMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CreditMutation) Tx() (*Tx, error) {
+func (m BannedWordsMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2623,14 +3154,14 @@ func (m CreditMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Credit entities.
-func (m *CreditMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of BannedWords entities.
+func (m *BannedWordsMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CreditMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BannedWordsMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2641,7 +3172,7 @@ func (m *CreditMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CreditMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *BannedWordsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2650,1429 +3181,1073 @@ func (m *CreditMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Credit.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BannedWords.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRemainingAmount sets the "remaining_amount" field.
-func (m *CreditMutation) SetRemainingAmount(i int32) {
-	m.remaining_amount = &i
-	m.addremaining_amount = nil
+// SetWords sets the "words" field.
+func (m *BannedWordsMutation) SetWords(s []string) {
+	m.words = &s
+	m.appendwords = nil
 }
 
-// RemainingAmount returns the value of the "remaining_amount" field in the mutation.
-func (m *CreditMutation) RemainingAmount() (r int32, exists bool) {
-	v := m.remaining_amount
+// Words returns the value of the "words" field in the mutation.
+func (m *BannedWordsMutation) Words() (r []string, exists bool) {
+	v := m.words
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRemainingAmount returns the old "remaining_amount" field's value of the Credit entity.
-// If the Credit object wasn't provided to the builder, the object is fetched from the database.
+// OldWords returns the old "words" field's value of the BannedWords entity.
+// If the BannedWords object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditMutation) OldRemainingAmount(ctx context.Context) (v int32, err error) {
+func (m *BannedWordsMutation) OldWords(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRemainingAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldWords is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRemainingAmount requires an ID field in the mutation")
+		return v, errors.New("OldWords requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRemainingAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldWords: %w", err)
 	}
-	return oldValue.RemainingAmount, nil
+	return oldValue.Words, nil
 }
 
-// AddRemainingAmount adds i to the "remaining_amount" field.
-func (m *CreditMutation) AddRemainingAmount(i int32) {
-	if m.addremaining_amount != nil {
-		*m.addremaining_amount += i
-	} else {
-		m.addremaining_amount = &i
-	}
+// AppendWords adds s to the "words" field.
+func (m *BannedWordsMutation) AppendWords(s []string) {
+	m.appendwords = append(m.appendwords, s...)
 }
 
-// AddedRemainingAmount returns the value that was added to the "remaining_amount" field in this mutation.
-func (m *CreditMutation) AddedRemainingAmount() (r int32, exists bool) {
-	v := m.addremaining_amount
-	if v == nil {
-		return
+// AppendedWords returns the list of values that were appended to the "words" field in this mutation.
+func (m *BannedWordsMutation) AppendedWords() ([]string, bool) {
+	if len(m.appendwords) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendwords, true
 }
 
-// ResetRemainingAmount resets all changes to the "remaining_amount" field.
-func (m *CreditMutation) ResetRemainingAmount() {
-	m.remaining_amount = nil
-	m.addremaining_amount = nil
+// ResetWords resets all changes to the "words" field.
+func (m *BannedWordsMutation) ResetWords() {
+	m.words = nil
+	m.appendwords = nil
 }
 
-// SetStartsAt sets the "starts_at" field.
-func (m *CreditMutation) SetStartsAt(t time.Time) {
-	m.starts_at = &t
+// SetReason sets the "reason" field.
+func (m *BannedWordsMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// StartsAt returns the value of the "starts_at" field in the mutation.
-func (m *CreditMutation) StartsAt() (r time.Time, exists bool) {
-	v := m.starts_at
+// Reason returns the value of the "reason" field in the mutation.
+func (m *BannedWordsMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartsAt returns the old "starts_at" field's value of the Credit entity.
-// If the Credit object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the BannedWords entity.
+// If the BannedWords object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+func (m *BannedWordsMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.StartsAt, nil
+	return oldValue.Reason, nil
 }
 
-// ResetStartsAt resets all changes to the "starts_at" field.
-func (m *CreditMutation) ResetStartsAt() {
-	m.starts_at = nil
+// ResetReason resets all changes to the "reason" field.
+func (m *BannedWordsMutation) ResetReason() {
+	m.reason = nil
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *CreditMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetSplitMatch sets the "split_match" field.
+func (m *BannedWordsMutation) SetSplitMatch(b bool) {
+	m.split_match = &b
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *CreditMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// SplitMatch returns the value of the "split_match" field in the mutation.
+func (m *BannedWordsMutation) SplitMatch() (r bool, exists bool) {
+	v := m.split_match
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the Credit entity.
-// If the Credit object wasn't provided to the builder, the object is fetched from the database.
+// OldSplitMatch returns the old "split_match" field's value of the BannedWords entity.
+// If the BannedWords object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *BannedWordsMutation) OldSplitMatch(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSplitMatch is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldSplitMatch requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSplitMatch: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.SplitMatch, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *CreditMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ResetSplitMatch resets all changes to the "split_match" field.
+func (m *BannedWordsMutation) ResetSplitMatch() {
+	m.split_match = nil
 }
 
-// SetPeriod sets the "period" field.
-func (m *CreditMutation) SetPeriod(i int) {
-	m.period = &i
-	m.addperiod = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *BannedWordsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Period returns the value of the "period" field in the mutation.
-func (m *CreditMutation) Period() (r int, exists bool) {
-	v := m.period
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BannedWordsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPeriod returns the old "period" field's value of the Credit entity.
-// If the Credit object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the BannedWords entity.
+// If the BannedWords object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditMutation) OldPeriod(ctx context.Context) (v int, err error) {
+func (m *BannedWordsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPeriod is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPeriod requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPeriod: %w", err)
-	}
-	return oldValue.Period, nil
-}
-
-// AddPeriod adds i to the "period" field.
-func (m *CreditMutation) AddPeriod(i int) {
-	if m.addperiod != nil {
-		*m.addperiod += i
-	} else {
-		m.addperiod = &i
-	}
-}
-
-// AddedPeriod returns the value that was added to the "period" field in this mutation.
-func (m *CreditMutation) AddedPeriod() (r int, exists bool) {
-	v := m.addperiod
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.CreatedAt, nil
 }
 
-// ResetPeriod resets all changes to the "period" field.
-func (m *CreditMutation) ResetPeriod() {
-	m.period = nil
-	m.addperiod = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BannedWordsMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetStripeLineItemID sets the "stripe_line_item_id" field.
-func (m *CreditMutation) SetStripeLineItemID(s string) {
-	m.stripe_line_item_id = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BannedWordsMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// StripeLineItemID returns the value of the "stripe_line_item_id" field in the mutation.
-func (m *CreditMutation) StripeLineItemID() (r string, exists bool) {
-	v := m.stripe_line_item_id
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BannedWordsMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStripeLineItemID returns the old "stripe_line_item_id" field's value of the Credit entity.
-// If the Credit object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the BannedWords entity.
+// If the BannedWords object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditMutation) OldStripeLineItemID(ctx context.Context) (v *string, err error) {
+func (m *BannedWordsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStripeLineItemID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStripeLineItemID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStripeLineItemID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.StripeLineItemID, nil
-}
-
-// ClearStripeLineItemID clears the value of the "stripe_line_item_id" field.
-func (m *CreditMutation) ClearStripeLineItemID() {
-	m.stripe_line_item_id = nil
-	m.clearedFields[credit.FieldStripeLineItemID] = struct{}{}
-}
-
-// StripeLineItemIDCleared returns if the "stripe_line_item_id" field was cleared in this mutation.
-func (m *CreditMutation) StripeLineItemIDCleared() bool {
-	_, ok := m.clearedFields[credit.FieldStripeLineItemID]
-	return ok
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetStripeLineItemID resets all changes to the "stripe_line_item_id" field.
-func (m *CreditMutation) ResetStripeLineItemID() {
-	m.stripe_line_item_id = nil
-	delete(m.clearedFields, credit.FieldStripeLineItemID)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BannedWordsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetReplenishedAt sets the "replenished_at" field.
-func (m *CreditMutation) SetReplenishedAt(t time.Time) {
-	m.replenished_at = &t
+// Where appends a list predicates to the BannedWordsMutation builder.
+func (m *BannedWordsMutation) Where(ps ...predicate.BannedWords) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ReplenishedAt returns the value of the "replenished_at" field in the mutation.
-func (m *CreditMutation) ReplenishedAt() (r time.Time, exists bool) {
-	v := m.replenished_at
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the BannedWordsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BannedWordsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BannedWords, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldReplenishedAt returns the old "replenished_at" field's value of the Credit entity.
-// If the Credit object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditMutation) OldReplenishedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReplenishedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReplenishedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReplenishedAt: %w", err)
-	}
-	return oldValue.ReplenishedAt, nil
+// Op returns the operation name.
+func (m *BannedWordsMutation) Op() Op {
+	return m.op
 }
 
-// ResetReplenishedAt resets all changes to the "replenished_at" field.
-func (m *CreditMutation) ResetReplenishedAt() {
-	m.replenished_at = nil
+// SetOp allows setting the mutation operation.
+func (m *BannedWordsMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetUserID sets the "user_id" field.
-func (m *CreditMutation) SetUserID(u uuid.UUID) {
-	m.users = &u
+// Type returns the node type of this mutation (BannedWords).
+func (m *BannedWordsMutation) Type() string {
+	return m.typ
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *CreditMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.users
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BannedWordsMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.words != nil {
+		fields = append(fields, bannedwords.FieldWords)
 	}
-	return *v, true
-}
-
-// OldUserID returns the old "user_id" field's value of the Credit entity.
-// If the Credit object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	if m.reason != nil {
+		fields = append(fields, bannedwords.FieldReason)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+	if m.split_match != nil {
+		fields = append(fields, bannedwords.FieldSplitMatch)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, bannedwords.FieldCreatedAt)
 	}
-	return oldValue.UserID, nil
-}
-
-// ResetUserID resets all changes to the "user_id" field.
-func (m *CreditMutation) ResetUserID() {
-	m.users = nil
-}
-
-// SetCreditTypeID sets the "credit_type_id" field.
-func (m *CreditMutation) SetCreditTypeID(u uuid.UUID) {
-	m.credit_type = &u
+	if m.updated_at != nil {
+		fields = append(fields, bannedwords.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// CreditTypeID returns the value of the "credit_type_id" field in the mutation.
-func (m *CreditMutation) CreditTypeID() (r uuid.UUID, exists bool) {
-	v := m.credit_type
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BannedWordsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bannedwords.FieldWords:
+		return m.Words()
+	case bannedwords.FieldReason:
+		return m.Reason()
+	case bannedwords.FieldSplitMatch:
+		return m.SplitMatch()
+	case bannedwords.FieldCreatedAt:
+		return m.CreatedAt()
+	case bannedwords.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldCreditTypeID returns the old "credit_type_id" field's value of the Credit entity.
-// If the Credit object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditMutation) OldCreditTypeID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreditTypeID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreditTypeID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreditTypeID: %w", err)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BannedWordsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bannedwords.FieldWords:
+		return m.OldWords(ctx)
+	case bannedwords.FieldReason:
+		return m.OldReason(ctx)
+	case bannedwords.FieldSplitMatch:
+		return m.OldSplitMatch(ctx)
+	case bannedwords.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case bannedwords.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return oldValue.CreditTypeID, nil
+	return nil, fmt.Errorf("unknown BannedWords field %s", name)
 }
 
-// ResetCreditTypeID resets all changes to the "credit_type_id" field.
-func (m *CreditMutation) ResetCreditTypeID() {
-	m.credit_type = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BannedWordsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bannedwords.FieldWords:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWords(v)
+		return nil
+	case bannedwords.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case bannedwords.FieldSplitMatch:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSplitMatch(v)
+		return nil
+	case bannedwords.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case bannedwords.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BannedWords field %s", name)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CreditMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BannedWordsMutation) AddedFields() []string {
+	return nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CreditMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BannedWordsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Credit entity.
-// If the Credit object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BannedWordsMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.CreatedAt, nil
+	return fmt.Errorf("unknown BannedWords numeric field %s", name)
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CreditMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BannedWordsMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CreditMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BannedWordsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CreditMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BannedWordsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BannedWords nullable field %s", name)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Credit entity.
-// If the Credit object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BannedWordsMutation) ResetField(name string) error {
+	switch name {
+	case bannedwords.FieldWords:
+		m.ResetWords()
+		return nil
+	case bannedwords.FieldReason:
+		m.ResetReason()
+		return nil
+	case bannedwords.FieldSplitMatch:
+		m.ResetSplitMatch()
+		return nil
+	case bannedwords.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case bannedwords.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CreditMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetUsersID sets the "users" edge to the User entity by id.
-func (m *CreditMutation) SetUsersID(id uuid.UUID) {
-	m.users = &id
+	return fmt.Errorf("unknown BannedWords field %s", name)
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *CreditMutation) ClearUsers() {
-	m.clearedusers = true
-	m.clearedFields[credit.FieldUserID] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BannedWordsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *CreditMutation) UsersCleared() bool {
-	return m.clearedusers
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BannedWordsMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// UsersID returns the "users" edge ID in the mutation.
-func (m *CreditMutation) UsersID() (id uuid.UUID, exists bool) {
-	if m.users != nil {
-		return *m.users, true
-	}
-	return
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BannedWordsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UsersID instead. It exists only for internal usage by the builders.
-func (m *CreditMutation) UsersIDs() (ids []uuid.UUID) {
-	if id := m.users; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BannedWordsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *CreditMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BannedWordsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearCreditType clears the "credit_type" edge to the CreditType entity.
-func (m *CreditMutation) ClearCreditType() {
-	m.clearedcredit_type = true
-	m.clearedFields[credit.FieldCreditTypeID] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BannedWordsMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// CreditTypeCleared reports if the "credit_type" edge to the CreditType entity was cleared.
-func (m *CreditMutation) CreditTypeCleared() bool {
-	return m.clearedcredit_type
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BannedWordsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BannedWords unique edge %s", name)
 }
 
-// CreditTypeIDs returns the "credit_type" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CreditTypeID instead. It exists only for internal usage by the builders.
-func (m *CreditMutation) CreditTypeIDs() (ids []uuid.UUID) {
-	if id := m.credit_type; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BannedWordsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BannedWords edge %s", name)
 }
 
-// ResetCreditType resets all changes to the "credit_type" edge.
-func (m *CreditMutation) ResetCreditType() {
-	m.credit_type = nil
-	m.clearedcredit_type = false
+// CreditMutation represents an operation that mutates the Credit nodes in the graph.
+type CreditMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	remaining_amount    *int32
+	addremaining_amount *int32
+	starts_at           *time.Time
+	expires_at          *time.Time
+	period              *int
+	addperiod           *int
+	stripe_line_item_id *string
+	replenished_at      *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	users               *uuid.UUID
+	clearedusers        bool
+	credit_type         *uuid.UUID
+	clearedcredit_type  bool
+	done                bool
+	oldValue            func(context.Context) (*Credit, error)
+	predicates          []predicate.Credit
 }
 
-// Where appends a list predicates to the CreditMutation builder.
-func (m *CreditMutation) Where(ps ...predicate.Credit) {
-	m.predicates = append(m.predicates, ps...)
-}
+var _ ent.Mutation = (*CreditMutation)(nil)
 
-// WhereP appends storage-level predicates to the CreditMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CreditMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Credit, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// creditOption allows management of the mutation configuration using functional options.
+type creditOption func(*CreditMutation)
+
+// newCreditMutation creates new mutation for the Credit entity.
+func newCreditMutation(c config, op Op, opts ...creditOption) *CreditMutation {
+	m := &CreditMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCredit,
+		clearedFields: make(map[string]struct{}),
 	}
-	m.Where(p...)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// Op returns the operation name.
-func (m *CreditMutation) Op() Op {
-	return m.op
+// withCreditID sets the ID field of the mutation.
+func withCreditID(id uuid.UUID) creditOption {
+	return func(m *CreditMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Credit
+		)
+		m.oldValue = func(ctx context.Context) (*Credit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Credit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CreditMutation) SetOp(op Op) {
-	m.op = op
+// withCredit sets the old Credit of the mutation.
+func withCredit(node *Credit) creditOption {
+	return func(m *CreditMutation) {
+		m.oldValue = func(context.Context) (*Credit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Type returns the node type of this mutation (Credit).
-func (m *CreditMutation) Type() string {
-	return m.typ
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CreditMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CreditMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.remaining_amount != nil {
-		fields = append(fields, credit.FieldRemainingAmount)
-	}
-	if m.starts_at != nil {
-		fields = append(fields, credit.FieldStartsAt)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CreditMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	if m.expires_at != nil {
-		fields = append(fields, credit.FieldExpiresAt)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Credit entities.
+func (m *CreditMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CreditMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	if m.period != nil {
-		fields = append(fields, credit.FieldPeriod)
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CreditMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Credit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	if m.stripe_line_item_id != nil {
-		fields = append(fields, credit.FieldStripeLineItemID)
+}
+
+// SetRemainingAmount sets the "remaining_amount" field.
+func (m *CreditMutation) SetRemainingAmount(i int32) {
+	m.remaining_amount = &i
+	m.addremaining_amount = nil
+}
+
+// RemainingAmount returns the value of the "remaining_amount" field in the mutation.
+func (m *CreditMutation) RemainingAmount() (r int32, exists bool) {
+	v := m.remaining_amount
+	if v == nil {
+		return
 	}
-	if m.replenished_at != nil {
-		fields = append(fields, credit.FieldReplenishedAt)
+	return *v, true
+}
+
+// OldRemainingAmount returns the old "remaining_amount" field's value of the Credit entity.
+// If the Credit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditMutation) OldRemainingAmount(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemainingAmount is only allowed on UpdateOne operations")
 	}
-	if m.users != nil {
-		fields = append(fields, credit.FieldUserID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemainingAmount requires an ID field in the mutation")
 	}
-	if m.credit_type != nil {
-		fields = append(fields, credit.FieldCreditTypeID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemainingAmount: %w", err)
 	}
-	if m.created_at != nil {
-		fields = append(fields, credit.FieldCreatedAt)
+	return oldValue.RemainingAmount, nil
+}
+
+// AddRemainingAmount adds i to the "remaining_amount" field.
+func (m *CreditMutation) AddRemainingAmount(i int32) {
+	if m.addremaining_amount != nil {
+		*m.addremaining_amount += i
+	} else {
+		m.addremaining_amount = &i
 	}
-	if m.updated_at != nil {
-		fields = append(fields, credit.FieldUpdatedAt)
+}
+
+// AddedRemainingAmount returns the value that was added to the "remaining_amount" field in this mutation.
+func (m *CreditMutation) AddedRemainingAmount() (r int32, exists bool) {
+	v := m.addremaining_amount
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CreditMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case credit.FieldRemainingAmount:
-		return m.RemainingAmount()
-	case credit.FieldStartsAt:
-		return m.StartsAt()
-	case credit.FieldExpiresAt:
-		return m.ExpiresAt()
-	case credit.FieldPeriod:
-		return m.Period()
-	case credit.FieldStripeLineItemID:
-		return m.StripeLineItemID()
-	case credit.FieldReplenishedAt:
-		return m.ReplenishedAt()
-	case credit.FieldUserID:
-		return m.UserID()
-	case credit.FieldCreditTypeID:
-		return m.CreditTypeID()
-	case credit.FieldCreatedAt:
-		return m.CreatedAt()
-	case credit.FieldUpdatedAt:
-		return m.UpdatedAt()
+// ResetRemainingAmount resets all changes to the "remaining_amount" field.
+func (m *CreditMutation) ResetRemainingAmount() {
+	m.remaining_amount = nil
+	m.addremaining_amount = nil
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *CreditMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *CreditMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *CreditMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case credit.FieldRemainingAmount:
-		return m.OldRemainingAmount(ctx)
-	case credit.FieldStartsAt:
-		return m.OldStartsAt(ctx)
-	case credit.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case credit.FieldPeriod:
-		return m.OldPeriod(ctx)
-	case credit.FieldStripeLineItemID:
-		return m.OldStripeLineItemID(ctx)
-	case credit.FieldReplenishedAt:
-		return m.OldReplenishedAt(ctx)
-	case credit.FieldUserID:
-		return m.OldUserID(ctx)
-	case credit.FieldCreditTypeID:
-		return m.OldCreditTypeID(ctx)
-	case credit.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case credit.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// OldStartsAt returns the old "starts_at" field's value of the Credit entity.
+// If the Credit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown Credit field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CreditMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case credit.FieldRemainingAmount:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRemainingAmount(v)
-		return nil
-	case credit.FieldStartsAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartsAt(v)
-		return nil
-	case credit.FieldExpiresAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiresAt(v)
-		return nil
-	case credit.FieldPeriod:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPeriod(v)
-		return nil
-	case credit.FieldStripeLineItemID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStripeLineItemID(v)
-		return nil
-	case credit.FieldReplenishedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReplenishedAt(v)
-		return nil
-	case credit.FieldUserID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case credit.FieldCreditTypeID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreditTypeID(v)
-		return nil
-	case credit.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case credit.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *CreditMutation) ResetStartsAt() {
+	m.starts_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *CreditMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *CreditMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Credit field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *CreditMutation) AddedFields() []string {
-	var fields []string
-	if m.addremaining_amount != nil {
-		fields = append(fields, credit.FieldRemainingAmount)
+// OldExpiresAt returns the old "expires_at" field's value of the Credit entity.
+// If the Credit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
-	if m.addperiod != nil {
-		fields = append(fields, credit.FieldPeriod)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *CreditMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case credit.FieldRemainingAmount:
-		return m.AddedRemainingAmount()
-	case credit.FieldPeriod:
-		return m.AddedPeriod()
-	}
-	return nil, false
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *CreditMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CreditMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case credit.FieldRemainingAmount:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRemainingAmount(v)
-		return nil
-	case credit.FieldPeriod:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPeriod(v)
-		return nil
+// SetPeriod sets the "period" field.
+func (m *CreditMutation) SetPeriod(i int) {
+	m.period = &i
+	m.addperiod = nil
+}
+
+// Period returns the value of the "period" field in the mutation.
+func (m *CreditMutation) Period() (r int, exists bool) {
+	v := m.period
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Credit numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *CreditMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(credit.FieldStripeLineItemID) {
-		fields = append(fields, credit.FieldStripeLineItemID)
+// OldPeriod returns the old "period" field's value of the Credit entity.
+// If the Credit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditMutation) OldPeriod(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriod is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriod: %w", err)
+	}
+	return oldValue.Period, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *CreditMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddPeriod adds i to the "period" field.
+func (m *CreditMutation) AddPeriod(i int) {
+	if m.addperiod != nil {
+		*m.addperiod += i
+	} else {
+		m.addperiod = &i
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *CreditMutation) ClearField(name string) error {
-	switch name {
-	case credit.FieldStripeLineItemID:
-		m.ClearStripeLineItemID()
-		return nil
+// AddedPeriod returns the value that was added to the "period" field in this mutation.
+func (m *CreditMutation) AddedPeriod() (r int, exists bool) {
+	v := m.addperiod
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Credit nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *CreditMutation) ResetField(name string) error {
-	switch name {
-	case credit.FieldRemainingAmount:
-		m.ResetRemainingAmount()
-		return nil
-	case credit.FieldStartsAt:
-		m.ResetStartsAt()
-		return nil
-	case credit.FieldExpiresAt:
-		m.ResetExpiresAt()
-		return nil
-	case credit.FieldPeriod:
-		m.ResetPeriod()
-		return nil
-	case credit.FieldStripeLineItemID:
-		m.ResetStripeLineItemID()
-		return nil
-	case credit.FieldReplenishedAt:
-		m.ResetReplenishedAt()
-		return nil
-	case credit.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case credit.FieldCreditTypeID:
-		m.ResetCreditTypeID()
-		return nil
-	case credit.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case credit.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Credit field %s", name)
+// ResetPeriod resets all changes to the "period" field.
+func (m *CreditMutation) ResetPeriod() {
+	m.period = nil
+	m.addperiod = nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CreditMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.users != nil {
-		edges = append(edges, credit.EdgeUsers)
-	}
-	if m.credit_type != nil {
-		edges = append(edges, credit.EdgeCreditType)
+// SetStripeLineItemID sets the "stripe_line_item_id" field.
+func (m *CreditMutation) SetStripeLineItemID(s string) {
+	m.stripe_line_item_id = &s
+}
+
+// StripeLineItemID returns the value of the "stripe_line_item_id" field in the mutation.
+func (m *CreditMutation) StripeLineItemID() (r string, exists bool) {
+	v := m.stripe_line_item_id
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *CreditMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case credit.EdgeUsers:
-		if id := m.users; id != nil {
-			return []ent.Value{*id}
-		}
-	case credit.EdgeCreditType:
-		if id := m.credit_type; id != nil {
-			return []ent.Value{*id}
-		}
+// OldStripeLineItemID returns the old "stripe_line_item_id" field's value of the Credit entity.
+// If the Credit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditMutation) OldStripeLineItemID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStripeLineItemID is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStripeLineItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStripeLineItemID: %w", err)
+	}
+	return oldValue.StripeLineItemID, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CreditMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// ClearStripeLineItemID clears the value of the "stripe_line_item_id" field.
+func (m *CreditMutation) ClearStripeLineItemID() {
+	m.stripe_line_item_id = nil
+	m.clearedFields[credit.FieldStripeLineItemID] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *CreditMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// StripeLineItemIDCleared returns if the "stripe_line_item_id" field was cleared in this mutation.
+func (m *CreditMutation) StripeLineItemIDCleared() bool {
+	_, ok := m.clearedFields[credit.FieldStripeLineItemID]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CreditMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedusers {
-		edges = append(edges, credit.EdgeUsers)
-	}
-	if m.clearedcredit_type {
-		edges = append(edges, credit.EdgeCreditType)
-	}
-	return edges
+// ResetStripeLineItemID resets all changes to the "stripe_line_item_id" field.
+func (m *CreditMutation) ResetStripeLineItemID() {
+	m.stripe_line_item_id = nil
+	delete(m.clearedFields, credit.FieldStripeLineItemID)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *CreditMutation) EdgeCleared(name string) bool {
-	switch name {
-	case credit.EdgeUsers:
-		return m.clearedusers
-	case credit.EdgeCreditType:
-		return m.clearedcredit_type
-	}
-	return false
+// SetReplenishedAt sets the "replenished_at" field.
+func (m *CreditMutation) SetReplenishedAt(t time.Time) {
+	m.replenished_at = &t
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *CreditMutation) ClearEdge(name string) error {
-	switch name {
-	case credit.EdgeUsers:
-		m.ClearUsers()
-		return nil
-	case credit.EdgeCreditType:
-		m.ClearCreditType()
-		return nil
+// ReplenishedAt returns the value of the "replenished_at" field in the mutation.
+func (m *CreditMutation) ReplenishedAt() (r time.Time, exists bool) {
+	v := m.replenished_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Credit unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *CreditMutation) ResetEdge(name string) error {
-	switch name {
-	case credit.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case credit.EdgeCreditType:
-		m.ResetCreditType()
-		return nil
+// OldReplenishedAt returns the old "replenished_at" field's value of the Credit entity.
+// If the Credit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditMutation) OldReplenishedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplenishedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Credit edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplenishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplenishedAt: %w", err)
+	}
+	return oldValue.ReplenishedAt, nil
 }
 
-// CreditTypeMutation represents an operation that mutates the CreditType nodes in the graph.
-type CreditTypeMutation struct {
-	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	name              *string
-	description       *string
-	amount            *int32
-	addamount         *int32
-	stripe_product_id *string
-	annual            *bool
-	_type             *credittype.Type
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	credits           map[uuid.UUID]struct{}
-	removedcredits    map[uuid.UUID]struct{}
-	clearedcredits    bool
-	done              bool
-	oldValue          func(context.Context) (*CreditType, error)
-	predicates        []predicate.CreditType
+// ResetReplenishedAt resets all changes to the "replenished_at" field.
+func (m *CreditMutation) ResetReplenishedAt() {
+	m.replenished_at = nil
 }
 
-var _ ent.Mutation = (*CreditTypeMutation)(nil)
+// SetUserID sets the "user_id" field.
+func (m *CreditMutation) SetUserID(u uuid.UUID) {
+	m.users = &u
+}
 
-// credittypeOption allows management of the mutation configuration using functional options.
-type credittypeOption func(*CreditTypeMutation)
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *CreditMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newCreditTypeMutation creates new mutation for the CreditType entity.
-func newCreditTypeMutation(c config, op Op, opts ...credittypeOption) *CreditTypeMutation {
-	m := &CreditTypeMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeCreditType,
-		clearedFields: make(map[string]struct{}),
+// OldUserID returns the old "user_id" field's value of the Credit entity.
+// If the Credit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// withCreditTypeID sets the ID field of the mutation.
-func withCreditTypeID(id uuid.UUID) credittypeOption {
-	return func(m *CreditTypeMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *CreditType
-		)
-		m.oldValue = func(ctx context.Context) (*CreditType, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().CreditType.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetUserID resets all changes to the "user_id" field.
+func (m *CreditMutation) ResetUserID() {
+	m.users = nil
 }
 
-// withCreditType sets the old CreditType of the mutation.
-func withCreditType(node *CreditType) credittypeOption {
-	return func(m *CreditTypeMutation) {
-		m.oldValue = func(context.Context) (*CreditType, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetCreditTypeID sets the "credit_type_id" field.
+func (m *CreditMutation) SetCreditTypeID(u uuid.UUID) {
+	m.credit_type = &u
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CreditTypeMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m CreditTypeMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of CreditType entities.
-func (m *CreditTypeMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *CreditTypeMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *CreditTypeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CreditType.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetName sets the "name" field.
-func (m *CreditTypeMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *CreditTypeMutation) Name() (r string, exists bool) {
-	v := m.name
+// CreditTypeID returns the value of the "credit_type_id" field in the mutation.
+func (m *CreditMutation) CreditTypeID() (r uuid.UUID, exists bool) {
+	v := m.credit_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the CreditType entity.
-// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
+// OldCreditTypeID returns the old "credit_type_id" field's value of the Credit entity.
+// If the Credit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditTypeMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CreditMutation) OldCreditTypeID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreditTypeID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCreditTypeID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreditTypeID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CreditTypeID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *CreditTypeMutation) ResetName() {
-	m.name = nil
+// ResetCreditTypeID resets all changes to the "credit_type_id" field.
+func (m *CreditMutation) ResetCreditTypeID() {
+	m.credit_type = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *CreditTypeMutation) SetDescription(s string) {
-	m.description = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *CreditMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *CreditTypeMutation) Description() (r string, exists bool) {
-	v := m.description
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CreditMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the CreditType entity.
-// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Credit entity.
+// If the Credit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditTypeMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *CreditMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *CreditTypeMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[credittype.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *CreditTypeMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[credittype.FieldDescription]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *CreditTypeMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, credittype.FieldDescription)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CreditMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetAmount sets the "amount" field.
-func (m *CreditTypeMutation) SetAmount(i int32) {
-	m.amount = &i
-	m.addamount = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CreditMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Amount returns the value of the "amount" field in the mutation.
-func (m *CreditTypeMutation) Amount() (r int32, exists bool) {
-	v := m.amount
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CreditMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAmount returns the old "amount" field's value of the CreditType entity.
-// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Credit entity.
+// If the Credit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditTypeMutation) OldAmount(ctx context.Context) (v int32, err error) {
+func (m *CreditMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmount requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Amount, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// AddAmount adds i to the "amount" field.
-func (m *CreditTypeMutation) AddAmount(i int32) {
-	if m.addamount != nil {
-		*m.addamount += i
-	} else {
-		m.addamount = &i
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CreditMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddedAmount returns the value that was added to the "amount" field in this mutation.
-func (m *CreditTypeMutation) AddedAmount() (r int32, exists bool) {
-	v := m.addamount
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetUsersID sets the "users" edge to the User entity by id.
+func (m *CreditMutation) SetUsersID(id uuid.UUID) {
+	m.users = &id
 }
 
-// ResetAmount resets all changes to the "amount" field.
-func (m *CreditTypeMutation) ResetAmount() {
-	m.amount = nil
-	m.addamount = nil
+// ClearUsers clears the "users" edge to the User entity.
+func (m *CreditMutation) ClearUsers() {
+	m.clearedusers = true
+	m.clearedFields[credit.FieldUserID] = struct{}{}
 }
 
-// SetStripeProductID sets the "stripe_product_id" field.
-func (m *CreditTypeMutation) SetStripeProductID(s string) {
-	m.stripe_product_id = &s
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *CreditMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// StripeProductID returns the value of the "stripe_product_id" field in the mutation.
-func (m *CreditTypeMutation) StripeProductID() (r string, exists bool) {
-	v := m.stripe_product_id
-	if v == nil {
-		return
+// UsersID returns the "users" edge ID in the mutation.
+func (m *CreditMutation) UsersID() (id uuid.UUID, exists bool) {
+	if m.users != nil {
+		return *m.users, true
 	}
-	return *v, true
+	return
 }
 
-// OldStripeProductID returns the old "stripe_product_id" field's value of the CreditType entity.
-// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditTypeMutation) OldStripeProductID(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStripeProductID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStripeProductID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStripeProductID: %w", err)
+// UsersIDs returns the "users" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UsersID instead. It exists only for internal usage by the builders.
+func (m *CreditMutation) UsersIDs() (ids []uuid.UUID) {
+	if id := m.users; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.StripeProductID, nil
+	return
 }
 
-// ClearStripeProductID clears the value of the "stripe_product_id" field.
-func (m *CreditTypeMutation) ClearStripeProductID() {
-	m.stripe_product_id = nil
-	m.clearedFields[credittype.FieldStripeProductID] = struct{}{}
+// ResetUsers resets all changes to the "users" edge.
+func (m *CreditMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
 }
 
-// StripeProductIDCleared returns if the "stripe_product_id" field was cleared in this mutation.
-func (m *CreditTypeMutation) StripeProductIDCleared() bool {
-	_, ok := m.clearedFields[credittype.FieldStripeProductID]
-	return ok
+// ClearCreditType clears the "credit_type" edge to the CreditType entity.
+func (m *CreditMutation) ClearCreditType() {
+	m.clearedcredit_type = true
+	m.clearedFields[credit.FieldCreditTypeID] = struct{}{}
 }
 
-// ResetStripeProductID resets all changes to the "stripe_product_id" field.
-func (m *CreditTypeMutation) ResetStripeProductID() {
-	m.stripe_product_id = nil
-	delete(m.clearedFields, credittype.FieldStripeProductID)
+// CreditTypeCleared reports if the "credit_type" edge to the CreditType entity was cleared.
+func (m *CreditMutation) CreditTypeCleared() bool {
+	return m.clearedcredit_type
 }
 
-// SetAnnual sets the "annual" field.
-func (m *CreditTypeMutation) SetAnnual(b bool) {
-	m.annual = &b
+// CreditTypeIDs returns the "credit_type" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreditTypeID instead. It exists only for internal usage by the builders.
+func (m *CreditMutation) CreditTypeIDs() (ids []uuid.UUID) {
+	if id := m.credit_type; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// Annual returns the value of the "annual" field in the mutation.
-func (m *CreditTypeMutation) Annual() (r bool, exists bool) {
-	v := m.annual
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAnnual returns the old "annual" field's value of the CreditType entity.
-// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditTypeMutation) OldAnnual(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnnual is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnnual requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnnual: %w", err)
-	}
-	return oldValue.Annual, nil
-}
-
-// ResetAnnual resets all changes to the "annual" field.
-func (m *CreditTypeMutation) ResetAnnual() {
-	m.annual = nil
-}
-
-// SetType sets the "type" field.
-func (m *CreditTypeMutation) SetType(c credittype.Type) {
-	m._type = &c
-}
-
-// GetType returns the value of the "type" field in the mutation.
-func (m *CreditTypeMutation) GetType() (r credittype.Type, exists bool) {
-	v := m._type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldType returns the old "type" field's value of the CreditType entity.
-// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditTypeMutation) OldType(ctx context.Context) (v credittype.Type, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *CreditTypeMutation) ResetType() {
-	m._type = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *CreditTypeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CreditTypeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the CreditType entity.
-// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditTypeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CreditTypeMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CreditTypeMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CreditTypeMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the CreditType entity.
-// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditTypeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CreditTypeMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// AddCreditIDs adds the "credits" edge to the Credit entity by ids.
-func (m *CreditTypeMutation) AddCreditIDs(ids ...uuid.UUID) {
-	if m.credits == nil {
-		m.credits = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.credits[ids[i]] = struct{}{}
-	}
-}
-
-// ClearCredits clears the "credits" edge to the Credit entity.
-func (m *CreditTypeMutation) ClearCredits() {
-	m.clearedcredits = true
-}
-
-// CreditsCleared reports if the "credits" edge to the Credit entity was cleared.
-func (m *CreditTypeMutation) CreditsCleared() bool {
-	return m.clearedcredits
-}
-
-// RemoveCreditIDs removes the "credits" edge to the Credit entity by IDs.
-func (m *CreditTypeMutation) RemoveCreditIDs(ids ...uuid.UUID) {
-	if m.removedcredits == nil {
-		m.removedcredits = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.credits, ids[i])
-		m.removedcredits[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedCredits returns the removed IDs of the "credits" edge to the Credit entity.
-func (m *CreditTypeMutation) RemovedCreditsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcredits {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// CreditsIDs returns the "credits" edge IDs in the mutation.
-func (m *CreditTypeMutation) CreditsIDs() (ids []uuid.UUID) {
-	for id := range m.credits {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetCredits resets all changes to the "credits" edge.
-func (m *CreditTypeMutation) ResetCredits() {
-	m.credits = nil
-	m.clearedcredits = false
-	m.removedcredits = nil
+// ResetCreditType resets all changes to the "credit_type" edge.
+func (m *CreditMutation) ResetCreditType() {
+	m.credit_type = nil
+	m.clearedcredit_type = false
 }
 
-// Where appends a list predicates to the CreditTypeMutation builder.
-func (m *CreditTypeMutation) Where(ps ...predicate.CreditType) {
+// Where appends a list predicates to the CreditMutation builder.
+func (m *CreditMutation) Where(ps ...predicate.Credit) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CreditTypeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CreditMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CreditTypeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CreditType, len(ps))
+func (m *CreditMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Credit, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4080,48 +4255,54 @@ func (m *CreditTypeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CreditTypeMutation) Op() Op {
+func (m *CreditMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CreditTypeMutation) SetOp(op Op) {
+func (m *CreditMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CreditType).
-func (m *CreditTypeMutation) Type() string {
+// Type returns the node type of this mutation (Credit).
+func (m *CreditMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CreditTypeMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.name != nil {
-		fields = append(fields, credittype.FieldName)
+func (m *CreditMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.remaining_amount != nil {
+		fields = append(fields, credit.FieldRemainingAmount)
 	}
-	if m.description != nil {
-		fields = append(fields, credittype.FieldDescription)
+	if m.starts_at != nil {
+		fields = append(fields, credit.FieldStartsAt)
 	}
-	if m.amount != nil {
-		fields = append(fields, credittype.FieldAmount)
+	if m.expires_at != nil {
+		fields = append(fields, credit.FieldExpiresAt)
 	}
-	if m.stripe_product_id != nil {
-		fields = append(fields, credittype.FieldStripeProductID)
+	if m.period != nil {
+		fields = append(fields, credit.FieldPeriod)
 	}
-	if m.annual != nil {
-		fields = append(fields, credittype.FieldAnnual)
+	if m.stripe_line_item_id != nil {
+		fields = append(fields, credit.FieldStripeLineItemID)
 	}
-	if m._type != nil {
-		fields = append(fields, credittype.FieldType)
+	if m.replenished_at != nil {
+		fields = append(fields, credit.FieldReplenishedAt)
+	}
+	if m.users != nil {
+		fields = append(fields, credit.FieldUserID)
+	}
+	if m.credit_type != nil {
+		fields = append(fields, credit.FieldCreditTypeID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, credittype.FieldCreatedAt)
+		fields = append(fields, credit.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, credittype.FieldUpdatedAt)
+		fields = append(fields, credit.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4129,23 +4310,27 @@ func (m *CreditTypeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CreditTypeMutation) Field(name string) (ent.Value, bool) {
+func (m *CreditMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case credittype.FieldName:
-		return m.Name()
-	case credittype.FieldDescription:
-		return m.Description()
-	case credittype.FieldAmount:
-		return m.Amount()
-	case credittype.FieldStripeProductID:
-		return m.StripeProductID()
-	case credittype.FieldAnnual:
-		return m.Annual()
-	case credittype.FieldType:
-		return m.GetType()
-	case credittype.FieldCreatedAt:
+	case credit.FieldRemainingAmount:
+		return m.RemainingAmount()
+	case credit.FieldStartsAt:
+		return m.StartsAt()
+	case credit.FieldExpiresAt:
+		return m.ExpiresAt()
+	case credit.FieldPeriod:
+		return m.Period()
+	case credit.FieldStripeLineItemID:
+		return m.StripeLineItemID()
+	case credit.FieldReplenishedAt:
+		return m.ReplenishedAt()
+	case credit.FieldUserID:
+		return m.UserID()
+	case credit.FieldCreditTypeID:
+		return m.CreditTypeID()
+	case credit.FieldCreatedAt:
 		return m.CreatedAt()
-	case credittype.FieldUpdatedAt:
+	case credit.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -4154,83 +4339,101 @@ func (m *CreditTypeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CreditTypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CreditMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case credittype.FieldName:
-		return m.OldName(ctx)
-	case credittype.FieldDescription:
-		return m.OldDescription(ctx)
-	case credittype.FieldAmount:
-		return m.OldAmount(ctx)
-	case credittype.FieldStripeProductID:
-		return m.OldStripeProductID(ctx)
-	case credittype.FieldAnnual:
-		return m.OldAnnual(ctx)
-	case credittype.FieldType:
-		return m.OldType(ctx)
-	case credittype.FieldCreatedAt:
+	case credit.FieldRemainingAmount:
+		return m.OldRemainingAmount(ctx)
+	case credit.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case credit.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case credit.FieldPeriod:
+		return m.OldPeriod(ctx)
+	case credit.FieldStripeLineItemID:
+		return m.OldStripeLineItemID(ctx)
+	case credit.FieldReplenishedAt:
+		return m.OldReplenishedAt(ctx)
+	case credit.FieldUserID:
+		return m.OldUserID(ctx)
+	case credit.FieldCreditTypeID:
+		return m.OldCreditTypeID(ctx)
+	case credit.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case credittype.FieldUpdatedAt:
+	case credit.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown CreditType field %s", name)
+	return nil, fmt.Errorf("unknown Credit field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CreditTypeMutation) SetField(name string, value ent.Value) error {
+func (m *CreditMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case credittype.FieldName:
-		v, ok := value.(string)
+	case credit.FieldRemainingAmount:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetRemainingAmount(v)
 		return nil
-	case credittype.FieldDescription:
-		v, ok := value.(string)
+	case credit.FieldStartsAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetStartsAt(v)
 		return nil
-	case credittype.FieldAmount:
-		v, ok := value.(int32)
+	case credit.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAmount(v)
+		m.SetExpiresAt(v)
 		return nil
-	case credittype.FieldStripeProductID:
+	case credit.FieldPeriod:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriod(v)
+		return nil
+	case credit.FieldStripeLineItemID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStripeProductID(v)
+		m.SetStripeLineItemID(v)
 		return nil
-	case credittype.FieldAnnual:
-		v, ok := value.(bool)
+	case credit.FieldReplenishedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAnnual(v)
+		m.SetReplenishedAt(v)
 		return nil
-	case credittype.FieldType:
-		v, ok := value.(credittype.Type)
+	case credit.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetUserID(v)
 		return nil
-	case credittype.FieldCreatedAt:
+	case credit.FieldCreditTypeID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreditTypeID(v)
+		return nil
+	case credit.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case credittype.FieldUpdatedAt:
+	case credit.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4238,15 +4441,18 @@ func (m *CreditTypeMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CreditType field %s", name)
+	return fmt.Errorf("unknown Credit field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CreditTypeMutation) AddedFields() []string {
+func (m *CreditMutation) AddedFields() []string {
 	var fields []string
-	if m.addamount != nil {
-		fields = append(fields, credittype.FieldAmount)
+	if m.addremaining_amount != nil {
+		fields = append(fields, credit.FieldRemainingAmount)
+	}
+	if m.addperiod != nil {
+		fields = append(fields, credit.FieldPeriod)
 	}
 	return fields
 }
@@ -4254,10 +4460,12 @@ func (m *CreditTypeMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CreditTypeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CreditMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case credittype.FieldAmount:
-		return m.AddedAmount()
+	case credit.FieldRemainingAmount:
+		return m.AddedRemainingAmount()
+	case credit.FieldPeriod:
+		return m.AddedPeriod()
 	}
 	return nil, false
 }
@@ -4265,206 +4473,222 @@ func (m *CreditTypeMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CreditTypeMutation) AddField(name string, value ent.Value) error {
+func (m *CreditMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case credittype.FieldAmount:
+	case credit.FieldRemainingAmount:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAmount(v)
+		m.AddRemainingAmount(v)
+		return nil
+	case credit.FieldPeriod:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPeriod(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CreditType numeric field %s", name)
+	return fmt.Errorf("unknown Credit numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CreditTypeMutation) ClearedFields() []string {
+func (m *CreditMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(credittype.FieldDescription) {
-		fields = append(fields, credittype.FieldDescription)
-	}
-	if m.FieldCleared(credittype.FieldStripeProductID) {
-		fields = append(fields, credittype.FieldStripeProductID)
+	if m.FieldCleared(credit.FieldStripeLineItemID) {
+		fields = append(fields, credit.FieldStripeLineItemID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CreditTypeMutation) FieldCleared(name string) bool {
+func (m *CreditMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CreditTypeMutation) ClearField(name string) error {
+func (m *CreditMutation) ClearField(name string) error {
 	switch name {
-	case credittype.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case credittype.FieldStripeProductID:
-		m.ClearStripeProductID()
+	case credit.FieldStripeLineItemID:
+		m.ClearStripeLineItemID()
 		return nil
 	}
-	return fmt.Errorf("unknown CreditType nullable field %s", name)
+	return fmt.Errorf("unknown Credit nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CreditTypeMutation) ResetField(name string) error {
+func (m *CreditMutation) ResetField(name string) error {
 	switch name {
-	case credittype.FieldName:
-		m.ResetName()
+	case credit.FieldRemainingAmount:
+		m.ResetRemainingAmount()
 		return nil
-	case credittype.FieldDescription:
-		m.ResetDescription()
+	case credit.FieldStartsAt:
+		m.ResetStartsAt()
 		return nil
-	case credittype.FieldAmount:
-		m.ResetAmount()
+	case credit.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case credittype.FieldStripeProductID:
-		m.ResetStripeProductID()
+	case credit.FieldPeriod:
+		m.ResetPeriod()
 		return nil
-	case credittype.FieldAnnual:
-		m.ResetAnnual()
+	case credit.FieldStripeLineItemID:
+		m.ResetStripeLineItemID()
 		return nil
-	case credittype.FieldType:
-		m.ResetType()
+	case credit.FieldReplenishedAt:
+		m.ResetReplenishedAt()
 		return nil
-	case credittype.FieldCreatedAt:
+	case credit.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case credit.FieldCreditTypeID:
+		m.ResetCreditTypeID()
+		return nil
+	case credit.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case credittype.FieldUpdatedAt:
+	case credit.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CreditType field %s", name)
+	return fmt.Errorf("unknown Credit field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CreditTypeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.credits != nil {
-		edges = append(edges, credittype.EdgeCredits)
+func (m *CreditMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.users != nil {
+		edges = append(edges, credit.EdgeUsers)
+	}
+	if m.credit_type != nil {
+		edges = append(edges, credit.EdgeCreditType)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CreditTypeMutation) AddedIDs(name string) []ent.Value {
+func (m *CreditMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case credittype.EdgeCredits:
-		ids := make([]ent.Value, 0, len(m.credits))
-		for id := range m.credits {
-			ids = append(ids, id)
+	case credit.EdgeUsers:
+		if id := m.users; id != nil {
+			return []ent.Value{*id}
+		}
+	case credit.EdgeCreditType:
+		if id := m.credit_type; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CreditTypeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedcredits != nil {
-		edges = append(edges, credittype.EdgeCredits)
-	}
+func (m *CreditMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CreditTypeMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case credittype.EdgeCredits:
-		ids := make([]ent.Value, 0, len(m.removedcredits))
-		for id := range m.removedcredits {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CreditMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CreditTypeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedcredits {
-		edges = append(edges, credittype.EdgeCredits)
+func (m *CreditMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedusers {
+		edges = append(edges, credit.EdgeUsers)
+	}
+	if m.clearedcredit_type {
+		edges = append(edges, credit.EdgeCreditType)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CreditTypeMutation) EdgeCleared(name string) bool {
+func (m *CreditMutation) EdgeCleared(name string) bool {
 	switch name {
-	case credittype.EdgeCredits:
-		return m.clearedcredits
+	case credit.EdgeUsers:
+		return m.clearedusers
+	case credit.EdgeCreditType:
+		return m.clearedcredit_type
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CreditTypeMutation) ClearEdge(name string) error {
+func (m *CreditMutation) ClearEdge(name string) error {
 	switch name {
+	case credit.EdgeUsers:
+		m.ClearUsers()
+		return nil
+	case credit.EdgeCreditType:
+		m.ClearCreditType()
+		return nil
 	}
-	return fmt.Errorf("unknown CreditType unique edge %s", name)
+	return fmt.Errorf("unknown Credit unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CreditTypeMutation) ResetEdge(name string) error {
+func (m *CreditMutation) ResetEdge(name string) error {
 	switch name {
-	case credittype.EdgeCredits:
-		m.ResetCredits()
+	case credit.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case credit.EdgeCreditType:
+		m.ResetCreditType()
 		return nil
 	}
-	return fmt.Errorf("unknown CreditType edge %s", name)
+	return fmt.Errorf("unknown Credit edge %s", name)
 }
 
-// DeviceInfoMutation represents an operation that mutates the DeviceInfo nodes in the graph.
-type DeviceInfoMutation struct {
+// CreditHoldMutation represents an operation that mutates the CreditHold nodes in the graph.
+type CreditHoldMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	_type              *string
-	os                 *string
-	browser            *string
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	generations        map[uuid.UUID]struct{}
-	removedgenerations map[uuid.UUID]struct{}
-	clearedgenerations bool
-	upscales           map[uuid.UUID]struct{}
-	removedupscales    map[uuid.UUID]struct{}
-	clearedupscales    bool
-	voiceovers         map[uuid.UUID]struct{}
-	removedvoiceovers  map[uuid.UUID]struct{}
-	clearedvoiceovers  bool
-	done               bool
-	oldValue           func(context.Context) (*DeviceInfo, error)
-	predicates         []predicate.DeviceInfo
-}
-
-var _ ent.Mutation = (*DeviceInfoMutation)(nil)
-
-// deviceinfoOption allows management of the mutation configuration using functional options.
-type deviceinfoOption func(*DeviceInfoMutation)
-
-// newDeviceInfoMutation creates new mutation for the DeviceInfo entity.
-func newDeviceInfoMutation(c config, op Op, opts ...deviceinfoOption) *DeviceInfoMutation {
-	m := &DeviceInfoMutation{
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	user_id          *uuid.UUID
+	amount           *int32
+	addamount        *int32
+	status           *credithold.Status
+	process_type     *credithold.ProcessType
+	job_id           *uuid.UUID
+	parent_id        *uuid.UUID
+	api_token_id     *uuid.UUID
+	api_token_period *time.Time
+	release_reason   *string
+	expires_at       *time.Time
+	captured_at      *time.Time
+	released_at      *time.Time
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*CreditHold, error)
+	predicates       []predicate.CreditHold
+}
+
+var _ ent.Mutation = (*CreditHoldMutation)(nil)
+
+// creditholdOption allows management of the mutation configuration using functional options.
+type creditholdOption func(*CreditHoldMutation)
+
+// newCreditHoldMutation creates new mutation for the CreditHold entity.
+func newCreditHoldMutation(c config, op Op, opts ...creditholdOption) *CreditHoldMutation {
+	m := &CreditHoldMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeviceInfo,
+		typ:           TypeCreditHold,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4473,20 +4697,20 @@ func newDeviceInfoMutation(c config, op Op, opts ...deviceinfoOption) *DeviceInf
 	return m
 }
 
-// withDeviceInfoID sets the ID field of the mutation.
-func withDeviceInfoID(id uuid.UUID) deviceinfoOption {
-	return func(m *DeviceInfoMutation) {
+// withCreditHoldID sets the ID field of the mutation.
+func withCreditHoldID(id uuid.UUID) creditholdOption {
+	return func(m *CreditHoldMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeviceInfo
+			value *CreditHold
 		)
-		m.oldValue = func(ctx context.Context) (*DeviceInfo, error) {
+		m.oldValue = func(ctx context.Context) (*CreditHold, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeviceInfo.Get(ctx, id)
+					value, err = m.Client().CreditHold.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4495,10 +4719,10 @@ func withDeviceInfoID(id uuid.UUID) deviceinfoOption {
 	}
 }
 
-// withDeviceInfo sets the old DeviceInfo of the mutation.
-func withDeviceInfo(node *DeviceInfo) deviceinfoOption {
-	return func(m *DeviceInfoMutation) {
-		m.oldValue = func(context.Context) (*DeviceInfo, error) {
+// withCreditHold sets the old CreditHold of the mutation.
+func withCreditHold(node *CreditHold) creditholdOption {
+	return func(m *CreditHoldMutation) {
+		m.oldValue = func(context.Context) (*CreditHold, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4507,7 +4731,7 @@ func withDeviceInfo(node *DeviceInfo) deviceinfoOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeviceInfoMutation) Client() *Client {
+func (m CreditHoldMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4515,7 +4739,7 @@ func (m DeviceInfoMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeviceInfoMutation) Tx() (*Tx, error) {
+func (m CreditHoldMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4525,14 +4749,14 @@ func (m DeviceInfoMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DeviceInfo entities.
-func (m *DeviceInfoMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of CreditHold entities.
+func (m *CreditHoldMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeviceInfoMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CreditHoldMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4543,7 +4767,7 @@ func (m *DeviceInfoMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeviceInfoMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CreditHoldMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4552,518 +4776,878 @@ func (m *DeviceInfoMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeviceInfo.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CreditHold.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *DeviceInfoMutation) SetType(s string) {
-	m._type = &s
+// SetUserID sets the "user_id" field.
+func (m *CreditHoldMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *DeviceInfoMutation) GetType() (r string, exists bool) {
-	v := m._type
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *CreditHoldMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the DeviceInfo entity.
-// If the DeviceInfo object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceInfoMutation) OldType(ctx context.Context) (v *string, err error) {
+func (m *CreditHoldMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Type, nil
-}
-
-// ClearType clears the value of the "type" field.
-func (m *DeviceInfoMutation) ClearType() {
-	m._type = nil
-	m.clearedFields[deviceinfo.FieldType] = struct{}{}
-}
-
-// TypeCleared returns if the "type" field was cleared in this mutation.
-func (m *DeviceInfoMutation) TypeCleared() bool {
-	_, ok := m.clearedFields[deviceinfo.FieldType]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *DeviceInfoMutation) ResetType() {
-	m._type = nil
-	delete(m.clearedFields, deviceinfo.FieldType)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *CreditHoldMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetOs sets the "os" field.
-func (m *DeviceInfoMutation) SetOs(s string) {
-	m.os = &s
+// SetAmount sets the "amount" field.
+func (m *CreditHoldMutation) SetAmount(i int32) {
+	m.amount = &i
+	m.addamount = nil
 }
 
-// Os returns the value of the "os" field in the mutation.
-func (m *DeviceInfoMutation) Os() (r string, exists bool) {
-	v := m.os
+// Amount returns the value of the "amount" field in the mutation.
+func (m *CreditHoldMutation) Amount() (r int32, exists bool) {
+	v := m.amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOs returns the old "os" field's value of the DeviceInfo entity.
-// If the DeviceInfo object wasn't provided to the builder, the object is fetched from the database.
+// OldAmount returns the old "amount" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceInfoMutation) OldOs(ctx context.Context) (v *string, err error) {
+func (m *CreditHoldMutation) OldAmount(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOs is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOs requires an ID field in the mutation")
+		return v, errors.New("OldAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOs: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
 	}
-	return oldValue.Os, nil
+	return oldValue.Amount, nil
 }
 
-// ClearOs clears the value of the "os" field.
-func (m *DeviceInfoMutation) ClearOs() {
-	m.os = nil
-	m.clearedFields[deviceinfo.FieldOs] = struct{}{}
+// AddAmount adds i to the "amount" field.
+func (m *CreditHoldMutation) AddAmount(i int32) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
 }
 
-// OsCleared returns if the "os" field was cleared in this mutation.
-func (m *DeviceInfoMutation) OsCleared() bool {
-	_, ok := m.clearedFields[deviceinfo.FieldOs]
-	return ok
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *CreditHoldMutation) AddedAmount() (r int32, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetOs resets all changes to the "os" field.
-func (m *DeviceInfoMutation) ResetOs() {
-	m.os = nil
-	delete(m.clearedFields, deviceinfo.FieldOs)
+// ResetAmount resets all changes to the "amount" field.
+func (m *CreditHoldMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
 }
 
-// SetBrowser sets the "browser" field.
-func (m *DeviceInfoMutation) SetBrowser(s string) {
-	m.browser = &s
+// SetStatus sets the "status" field.
+func (m *CreditHoldMutation) SetStatus(c credithold.Status) {
+	m.status = &c
 }
 
-// Browser returns the value of the "browser" field in the mutation.
-func (m *DeviceInfoMutation) Browser() (r string, exists bool) {
-	v := m.browser
+// Status returns the value of the "status" field in the mutation.
+func (m *CreditHoldMutation) Status() (r credithold.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBrowser returns the old "browser" field's value of the DeviceInfo entity.
-// If the DeviceInfo object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceInfoMutation) OldBrowser(ctx context.Context) (v *string, err error) {
+func (m *CreditHoldMutation) OldStatus(ctx context.Context) (v credithold.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBrowser is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBrowser requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBrowser: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Browser, nil
-}
-
-// ClearBrowser clears the value of the "browser" field.
-func (m *DeviceInfoMutation) ClearBrowser() {
-	m.browser = nil
-	m.clearedFields[deviceinfo.FieldBrowser] = struct{}{}
-}
-
-// BrowserCleared returns if the "browser" field was cleared in this mutation.
-func (m *DeviceInfoMutation) BrowserCleared() bool {
-	_, ok := m.clearedFields[deviceinfo.FieldBrowser]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetBrowser resets all changes to the "browser" field.
-func (m *DeviceInfoMutation) ResetBrowser() {
-	m.browser = nil
-	delete(m.clearedFields, deviceinfo.FieldBrowser)
+// ResetStatus resets all changes to the "status" field.
+func (m *CreditHoldMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DeviceInfoMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetProcessType sets the "process_type" field.
+func (m *CreditHoldMutation) SetProcessType(ct credithold.ProcessType) {
+	m.process_type = &ct
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DeviceInfoMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ProcessType returns the value of the "process_type" field in the mutation.
+func (m *CreditHoldMutation) ProcessType() (r credithold.ProcessType, exists bool) {
+	v := m.process_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DeviceInfo entity.
-// If the DeviceInfo object wasn't provided to the builder, the object is fetched from the database.
+// OldProcessType returns the old "process_type" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceInfoMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CreditHoldMutation) OldProcessType(ctx context.Context) (v credithold.ProcessType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldProcessType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldProcessType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldProcessType: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ProcessType, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DeviceInfoMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetProcessType resets all changes to the "process_type" field.
+func (m *CreditHoldMutation) ResetProcessType() {
+	m.process_type = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *DeviceInfoMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetJobID sets the "job_id" field.
+func (m *CreditHoldMutation) SetJobID(u uuid.UUID) {
+	m.job_id = &u
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DeviceInfoMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *CreditHoldMutation) JobID() (r uuid.UUID, exists bool) {
+	v := m.job_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the DeviceInfo entity.
-// If the DeviceInfo object wasn't provided to the builder, the object is fetched from the database.
+// OldJobID returns the old "job_id" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceInfoMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CreditHoldMutation) OldJobID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldJobID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.JobID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DeviceInfoMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearJobID clears the value of the "job_id" field.
+func (m *CreditHoldMutation) ClearJobID() {
+	m.job_id = nil
+	m.clearedFields[credithold.FieldJobID] = struct{}{}
 }
 
-// AddGenerationIDs adds the "generations" edge to the Generation entity by ids.
-func (m *DeviceInfoMutation) AddGenerationIDs(ids ...uuid.UUID) {
-	if m.generations == nil {
-		m.generations = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.generations[ids[i]] = struct{}{}
-	}
+// JobIDCleared returns if the "job_id" field was cleared in this mutation.
+func (m *CreditHoldMutation) JobIDCleared() bool {
+	_, ok := m.clearedFields[credithold.FieldJobID]
+	return ok
 }
 
-// ClearGenerations clears the "generations" edge to the Generation entity.
-func (m *DeviceInfoMutation) ClearGenerations() {
-	m.clearedgenerations = true
+// ResetJobID resets all changes to the "job_id" field.
+func (m *CreditHoldMutation) ResetJobID() {
+	m.job_id = nil
+	delete(m.clearedFields, credithold.FieldJobID)
 }
 
-// GenerationsCleared reports if the "generations" edge to the Generation entity was cleared.
-func (m *DeviceInfoMutation) GenerationsCleared() bool {
-	return m.clearedgenerations
+// SetParentID sets the "parent_id" field.
+func (m *CreditHoldMutation) SetParentID(u uuid.UUID) {
+	m.parent_id = &u
 }
 
-// RemoveGenerationIDs removes the "generations" edge to the Generation entity by IDs.
-func (m *DeviceInfoMutation) RemoveGenerationIDs(ids ...uuid.UUID) {
-	if m.removedgenerations == nil {
-		m.removedgenerations = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.generations, ids[i])
-		m.removedgenerations[ids[i]] = struct{}{}
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *CreditHoldMutation) ParentID() (r uuid.UUID, exists bool) {
+	v := m.parent_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedGenerations returns the removed IDs of the "generations" edge to the Generation entity.
-func (m *DeviceInfoMutation) RemovedGenerationsIDs() (ids []uuid.UUID) {
-	for id := range m.removedgenerations {
-		ids = append(ids, id)
+// OldParentID returns the old "parent_id" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditHoldMutation) OldParentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// GenerationsIDs returns the "generations" edge IDs in the mutation.
-func (m *DeviceInfoMutation) GenerationsIDs() (ids []uuid.UUID) {
-	for id := range m.generations {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
 }
 
-// ResetGenerations resets all changes to the "generations" edge.
-func (m *DeviceInfoMutation) ResetGenerations() {
-	m.generations = nil
-	m.clearedgenerations = false
-	m.removedgenerations = nil
+// ClearParentID clears the value of the "parent_id" field.
+func (m *CreditHoldMutation) ClearParentID() {
+	m.parent_id = nil
+	m.clearedFields[credithold.FieldParentID] = struct{}{}
 }
 
-// AddUpscaleIDs adds the "upscales" edge to the Upscale entity by ids.
-func (m *DeviceInfoMutation) AddUpscaleIDs(ids ...uuid.UUID) {
-	if m.upscales == nil {
-		m.upscales = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.upscales[ids[i]] = struct{}{}
-	}
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *CreditHoldMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[credithold.FieldParentID]
+	return ok
 }
 
-// ClearUpscales clears the "upscales" edge to the Upscale entity.
-func (m *DeviceInfoMutation) ClearUpscales() {
-	m.clearedupscales = true
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *CreditHoldMutation) ResetParentID() {
+	m.parent_id = nil
+	delete(m.clearedFields, credithold.FieldParentID)
 }
 
-// UpscalesCleared reports if the "upscales" edge to the Upscale entity was cleared.
-func (m *DeviceInfoMutation) UpscalesCleared() bool {
-	return m.clearedupscales
+// SetAPITokenID sets the "api_token_id" field.
+func (m *CreditHoldMutation) SetAPITokenID(u uuid.UUID) {
+	m.api_token_id = &u
 }
 
-// RemoveUpscaleIDs removes the "upscales" edge to the Upscale entity by IDs.
-func (m *DeviceInfoMutation) RemoveUpscaleIDs(ids ...uuid.UUID) {
-	if m.removedupscales == nil {
-		m.removedupscales = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.upscales, ids[i])
-		m.removedupscales[ids[i]] = struct{}{}
+// APITokenID returns the value of the "api_token_id" field in the mutation.
+func (m *CreditHoldMutation) APITokenID() (r uuid.UUID, exists bool) {
+	v := m.api_token_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedUpscales returns the removed IDs of the "upscales" edge to the Upscale entity.
-func (m *DeviceInfoMutation) RemovedUpscalesIDs() (ids []uuid.UUID) {
-	for id := range m.removedupscales {
-		ids = append(ids, id)
+// OldAPITokenID returns the old "api_token_id" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditHoldMutation) OldAPITokenID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPITokenID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPITokenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPITokenID: %w", err)
+	}
+	return oldValue.APITokenID, nil
 }
 
-// UpscalesIDs returns the "upscales" edge IDs in the mutation.
-func (m *DeviceInfoMutation) UpscalesIDs() (ids []uuid.UUID) {
-	for id := range m.upscales {
-		ids = append(ids, id)
-	}
-	return
+// ClearAPITokenID clears the value of the "api_token_id" field.
+func (m *CreditHoldMutation) ClearAPITokenID() {
+	m.api_token_id = nil
+	m.clearedFields[credithold.FieldAPITokenID] = struct{}{}
 }
 
-// ResetUpscales resets all changes to the "upscales" edge.
-func (m *DeviceInfoMutation) ResetUpscales() {
-	m.upscales = nil
-	m.clearedupscales = false
-	m.removedupscales = nil
+// APITokenIDCleared returns if the "api_token_id" field was cleared in this mutation.
+func (m *CreditHoldMutation) APITokenIDCleared() bool {
+	_, ok := m.clearedFields[credithold.FieldAPITokenID]
+	return ok
 }
 
-// AddVoiceoverIDs adds the "voiceovers" edge to the Voiceover entity by ids.
-func (m *DeviceInfoMutation) AddVoiceoverIDs(ids ...uuid.UUID) {
-	if m.voiceovers == nil {
-		m.voiceovers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.voiceovers[ids[i]] = struct{}{}
-	}
+// ResetAPITokenID resets all changes to the "api_token_id" field.
+func (m *CreditHoldMutation) ResetAPITokenID() {
+	m.api_token_id = nil
+	delete(m.clearedFields, credithold.FieldAPITokenID)
 }
 
-// ClearVoiceovers clears the "voiceovers" edge to the Voiceover entity.
-func (m *DeviceInfoMutation) ClearVoiceovers() {
-	m.clearedvoiceovers = true
+// SetAPITokenPeriod sets the "api_token_period" field.
+func (m *CreditHoldMutation) SetAPITokenPeriod(t time.Time) {
+	m.api_token_period = &t
 }
 
-// VoiceoversCleared reports if the "voiceovers" edge to the Voiceover entity was cleared.
-func (m *DeviceInfoMutation) VoiceoversCleared() bool {
-	return m.clearedvoiceovers
+// APITokenPeriod returns the value of the "api_token_period" field in the mutation.
+func (m *CreditHoldMutation) APITokenPeriod() (r time.Time, exists bool) {
+	v := m.api_token_period
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveVoiceoverIDs removes the "voiceovers" edge to the Voiceover entity by IDs.
-func (m *DeviceInfoMutation) RemoveVoiceoverIDs(ids ...uuid.UUID) {
-	if m.removedvoiceovers == nil {
-		m.removedvoiceovers = make(map[uuid.UUID]struct{})
+// OldAPITokenPeriod returns the old "api_token_period" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditHoldMutation) OldAPITokenPeriod(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPITokenPeriod is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.voiceovers, ids[i])
-		m.removedvoiceovers[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPITokenPeriod requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPITokenPeriod: %w", err)
+	}
+	return oldValue.APITokenPeriod, nil
 }
 
-// RemovedVoiceovers returns the removed IDs of the "voiceovers" edge to the Voiceover entity.
-func (m *DeviceInfoMutation) RemovedVoiceoversIDs() (ids []uuid.UUID) {
-	for id := range m.removedvoiceovers {
-		ids = append(ids, id)
-	}
-	return
+// ClearAPITokenPeriod clears the value of the "api_token_period" field.
+func (m *CreditHoldMutation) ClearAPITokenPeriod() {
+	m.api_token_period = nil
+	m.clearedFields[credithold.FieldAPITokenPeriod] = struct{}{}
 }
 
-// VoiceoversIDs returns the "voiceovers" edge IDs in the mutation.
-func (m *DeviceInfoMutation) VoiceoversIDs() (ids []uuid.UUID) {
-	for id := range m.voiceovers {
-		ids = append(ids, id)
-	}
-	return
+// APITokenPeriodCleared returns if the "api_token_period" field was cleared in this mutation.
+func (m *CreditHoldMutation) APITokenPeriodCleared() bool {
+	_, ok := m.clearedFields[credithold.FieldAPITokenPeriod]
+	return ok
 }
 
-// ResetVoiceovers resets all changes to the "voiceovers" edge.
-func (m *DeviceInfoMutation) ResetVoiceovers() {
-	m.voiceovers = nil
-	m.clearedvoiceovers = false
-	m.removedvoiceovers = nil
+// ResetAPITokenPeriod resets all changes to the "api_token_period" field.
+func (m *CreditHoldMutation) ResetAPITokenPeriod() {
+	m.api_token_period = nil
+	delete(m.clearedFields, credithold.FieldAPITokenPeriod)
 }
 
-// Where appends a list predicates to the DeviceInfoMutation builder.
-func (m *DeviceInfoMutation) Where(ps ...predicate.DeviceInfo) {
-	m.predicates = append(m.predicates, ps...)
+// SetReleaseReason sets the "release_reason" field.
+func (m *CreditHoldMutation) SetReleaseReason(s string) {
+	m.release_reason = &s
 }
 
-// WhereP appends storage-level predicates to the DeviceInfoMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeviceInfoMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeviceInfo, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ReleaseReason returns the value of the "release_reason" field in the mutation.
+func (m *CreditHoldMutation) ReleaseReason() (r string, exists bool) {
+	v := m.release_reason
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *DeviceInfoMutation) Op() Op {
-	return m.op
+// OldReleaseReason returns the old "release_reason" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditHoldMutation) OldReleaseReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleaseReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleaseReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleaseReason: %w", err)
+	}
+	return oldValue.ReleaseReason, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *DeviceInfoMutation) SetOp(op Op) {
-	m.op = op
+// ClearReleaseReason clears the value of the "release_reason" field.
+func (m *CreditHoldMutation) ClearReleaseReason() {
+	m.release_reason = nil
+	m.clearedFields[credithold.FieldReleaseReason] = struct{}{}
 }
 
-// Type returns the node type of this mutation (DeviceInfo).
-func (m *DeviceInfoMutation) Type() string {
-	return m.typ
+// ReleaseReasonCleared returns if the "release_reason" field was cleared in this mutation.
+func (m *CreditHoldMutation) ReleaseReasonCleared() bool {
+	_, ok := m.clearedFields[credithold.FieldReleaseReason]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *DeviceInfoMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m._type != nil {
-		fields = append(fields, deviceinfo.FieldType)
-	}
-	if m.os != nil {
-		fields = append(fields, deviceinfo.FieldOs)
+// ResetReleaseReason resets all changes to the "release_reason" field.
+func (m *CreditHoldMutation) ResetReleaseReason() {
+	m.release_reason = nil
+	delete(m.clearedFields, credithold.FieldReleaseReason)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *CreditHoldMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *CreditHoldMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
 	}
-	if m.browser != nil {
-		fields = append(fields, deviceinfo.FieldBrowser)
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditHoldMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, deviceinfo.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, deviceinfo.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return fields
+	return oldValue.ExpiresAt, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *DeviceInfoMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case deviceinfo.FieldType:
-		return m.GetType()
-	case deviceinfo.FieldOs:
-		return m.Os()
-	case deviceinfo.FieldBrowser:
-		return m.Browser()
-	case deviceinfo.FieldCreatedAt:
-		return m.CreatedAt()
-	case deviceinfo.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *CreditHoldMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *DeviceInfoMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case deviceinfo.FieldType:
-		return m.OldType(ctx)
-	case deviceinfo.FieldOs:
-		return m.OldOs(ctx)
-	case deviceinfo.FieldBrowser:
-		return m.OldBrowser(ctx)
-	case deviceinfo.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case deviceinfo.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// SetCapturedAt sets the "captured_at" field.
+func (m *CreditHoldMutation) SetCapturedAt(t time.Time) {
+	m.captured_at = &t
+}
+
+// CapturedAt returns the value of the "captured_at" field in the mutation.
+func (m *CreditHoldMutation) CapturedAt() (r time.Time, exists bool) {
+	v := m.captured_at
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown DeviceInfo field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
+// OldCapturedAt returns the old "captured_at" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditHoldMutation) OldCapturedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapturedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapturedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapturedAt: %w", err)
+	}
+	return oldValue.CapturedAt, nil
+}
+
+// ClearCapturedAt clears the value of the "captured_at" field.
+func (m *CreditHoldMutation) ClearCapturedAt() {
+	m.captured_at = nil
+	m.clearedFields[credithold.FieldCapturedAt] = struct{}{}
+}
+
+// CapturedAtCleared returns if the "captured_at" field was cleared in this mutation.
+func (m *CreditHoldMutation) CapturedAtCleared() bool {
+	_, ok := m.clearedFields[credithold.FieldCapturedAt]
+	return ok
+}
+
+// ResetCapturedAt resets all changes to the "captured_at" field.
+func (m *CreditHoldMutation) ResetCapturedAt() {
+	m.captured_at = nil
+	delete(m.clearedFields, credithold.FieldCapturedAt)
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (m *CreditHoldMutation) SetReleasedAt(t time.Time) {
+	m.released_at = &t
+}
+
+// ReleasedAt returns the value of the "released_at" field in the mutation.
+func (m *CreditHoldMutation) ReleasedAt() (r time.Time, exists bool) {
+	v := m.released_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReleasedAt returns the old "released_at" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditHoldMutation) OldReleasedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleasedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleasedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleasedAt: %w", err)
+	}
+	return oldValue.ReleasedAt, nil
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (m *CreditHoldMutation) ClearReleasedAt() {
+	m.released_at = nil
+	m.clearedFields[credithold.FieldReleasedAt] = struct{}{}
+}
+
+// ReleasedAtCleared returns if the "released_at" field was cleared in this mutation.
+func (m *CreditHoldMutation) ReleasedAtCleared() bool {
+	_, ok := m.clearedFields[credithold.FieldReleasedAt]
+	return ok
+}
+
+// ResetReleasedAt resets all changes to the "released_at" field.
+func (m *CreditHoldMutation) ResetReleasedAt() {
+	m.released_at = nil
+	delete(m.clearedFields, credithold.FieldReleasedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CreditHoldMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CreditHoldMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditHoldMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CreditHoldMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CreditHoldMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CreditHoldMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CreditHold entity.
+// If the CreditHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditHoldMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CreditHoldMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the CreditHoldMutation builder.
+func (m *CreditHoldMutation) Where(ps ...predicate.CreditHold) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CreditHoldMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CreditHoldMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CreditHold, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CreditHoldMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CreditHoldMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CreditHold).
+func (m *CreditHoldMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CreditHoldMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.user_id != nil {
+		fields = append(fields, credithold.FieldUserID)
+	}
+	if m.amount != nil {
+		fields = append(fields, credithold.FieldAmount)
+	}
+	if m.status != nil {
+		fields = append(fields, credithold.FieldStatus)
+	}
+	if m.process_type != nil {
+		fields = append(fields, credithold.FieldProcessType)
+	}
+	if m.job_id != nil {
+		fields = append(fields, credithold.FieldJobID)
+	}
+	if m.parent_id != nil {
+		fields = append(fields, credithold.FieldParentID)
+	}
+	if m.api_token_id != nil {
+		fields = append(fields, credithold.FieldAPITokenID)
+	}
+	if m.api_token_period != nil {
+		fields = append(fields, credithold.FieldAPITokenPeriod)
+	}
+	if m.release_reason != nil {
+		fields = append(fields, credithold.FieldReleaseReason)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, credithold.FieldExpiresAt)
+	}
+	if m.captured_at != nil {
+		fields = append(fields, credithold.FieldCapturedAt)
+	}
+	if m.released_at != nil {
+		fields = append(fields, credithold.FieldReleasedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, credithold.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, credithold.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CreditHoldMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case credithold.FieldUserID:
+		return m.UserID()
+	case credithold.FieldAmount:
+		return m.Amount()
+	case credithold.FieldStatus:
+		return m.Status()
+	case credithold.FieldProcessType:
+		return m.ProcessType()
+	case credithold.FieldJobID:
+		return m.JobID()
+	case credithold.FieldParentID:
+		return m.ParentID()
+	case credithold.FieldAPITokenID:
+		return m.APITokenID()
+	case credithold.FieldAPITokenPeriod:
+		return m.APITokenPeriod()
+	case credithold.FieldReleaseReason:
+		return m.ReleaseReason()
+	case credithold.FieldExpiresAt:
+		return m.ExpiresAt()
+	case credithold.FieldCapturedAt:
+		return m.CapturedAt()
+	case credithold.FieldReleasedAt:
+		return m.ReleasedAt()
+	case credithold.FieldCreatedAt:
+		return m.CreatedAt()
+	case credithold.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CreditHoldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case credithold.FieldUserID:
+		return m.OldUserID(ctx)
+	case credithold.FieldAmount:
+		return m.OldAmount(ctx)
+	case credithold.FieldStatus:
+		return m.OldStatus(ctx)
+	case credithold.FieldProcessType:
+		return m.OldProcessType(ctx)
+	case credithold.FieldJobID:
+		return m.OldJobID(ctx)
+	case credithold.FieldParentID:
+		return m.OldParentID(ctx)
+	case credithold.FieldAPITokenID:
+		return m.OldAPITokenID(ctx)
+	case credithold.FieldAPITokenPeriod:
+		return m.OldAPITokenPeriod(ctx)
+	case credithold.FieldReleaseReason:
+		return m.OldReleaseReason(ctx)
+	case credithold.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case credithold.FieldCapturedAt:
+		return m.OldCapturedAt(ctx)
+	case credithold.FieldReleasedAt:
+		return m.OldReleasedAt(ctx)
+	case credithold.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case credithold.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CreditHold field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceInfoMutation) SetField(name string, value ent.Value) error {
+func (m *CreditHoldMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case deviceinfo.FieldType:
-		v, ok := value.(string)
+	case credithold.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetUserID(v)
 		return nil
-	case deviceinfo.FieldOs:
-		v, ok := value.(string)
+	case credithold.FieldAmount:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOs(v)
+		m.SetAmount(v)
 		return nil
-	case deviceinfo.FieldBrowser:
+	case credithold.FieldStatus:
+		v, ok := value.(credithold.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case credithold.FieldProcessType:
+		v, ok := value.(credithold.ProcessType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessType(v)
+		return nil
+	case credithold.FieldJobID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case credithold.FieldParentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case credithold.FieldAPITokenID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPITokenID(v)
+		return nil
+	case credithold.FieldAPITokenPeriod:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPITokenPeriod(v)
+		return nil
+	case credithold.FieldReleaseReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBrowser(v)
+		m.SetReleaseReason(v)
 		return nil
-	case deviceinfo.FieldCreatedAt:
+	case credithold.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case credithold.FieldCapturedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapturedAt(v)
+		return nil
+	case credithold.FieldReleasedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleasedAt(v)
+		return nil
+	case credithold.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case deviceinfo.FieldUpdatedAt:
+	case credithold.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -5071,256 +5655,240 @@ func (m *DeviceInfoMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceInfo field %s", name)
+	return fmt.Errorf("unknown CreditHold field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeviceInfoMutation) AddedFields() []string {
-	return nil
+func (m *CreditHoldMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, credithold.FieldAmount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeviceInfoMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CreditHoldMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case credithold.FieldAmount:
+		return m.AddedAmount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceInfoMutation) AddField(name string, value ent.Value) error {
+func (m *CreditHoldMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	}
-	return fmt.Errorf("unknown DeviceInfo numeric field %s", name)
-}
-
+	case credithold.FieldAmount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CreditHold numeric field %s", name)
+}
+
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeviceInfoMutation) ClearedFields() []string {
+func (m *CreditHoldMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(deviceinfo.FieldType) {
-		fields = append(fields, deviceinfo.FieldType)
+	if m.FieldCleared(credithold.FieldJobID) {
+		fields = append(fields, credithold.FieldJobID)
 	}
-	if m.FieldCleared(deviceinfo.FieldOs) {
-		fields = append(fields, deviceinfo.FieldOs)
+	if m.FieldCleared(credithold.FieldParentID) {
+		fields = append(fields, credithold.FieldParentID)
 	}
-	if m.FieldCleared(deviceinfo.FieldBrowser) {
-		fields = append(fields, deviceinfo.FieldBrowser)
+	if m.FieldCleared(credithold.FieldAPITokenID) {
+		fields = append(fields, credithold.FieldAPITokenID)
+	}
+	if m.FieldCleared(credithold.FieldAPITokenPeriod) {
+		fields = append(fields, credithold.FieldAPITokenPeriod)
+	}
+	if m.FieldCleared(credithold.FieldReleaseReason) {
+		fields = append(fields, credithold.FieldReleaseReason)
+	}
+	if m.FieldCleared(credithold.FieldCapturedAt) {
+		fields = append(fields, credithold.FieldCapturedAt)
+	}
+	if m.FieldCleared(credithold.FieldReleasedAt) {
+		fields = append(fields, credithold.FieldReleasedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeviceInfoMutation) FieldCleared(name string) bool {
+func (m *CreditHoldMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeviceInfoMutation) ClearField(name string) error {
+func (m *CreditHoldMutation) ClearField(name string) error {
 	switch name {
-	case deviceinfo.FieldType:
-		m.ClearType()
+	case credithold.FieldJobID:
+		m.ClearJobID()
 		return nil
-	case deviceinfo.FieldOs:
-		m.ClearOs()
+	case credithold.FieldParentID:
+		m.ClearParentID()
 		return nil
-	case deviceinfo.FieldBrowser:
-		m.ClearBrowser()
+	case credithold.FieldAPITokenID:
+		m.ClearAPITokenID()
+		return nil
+	case credithold.FieldAPITokenPeriod:
+		m.ClearAPITokenPeriod()
+		return nil
+	case credithold.FieldReleaseReason:
+		m.ClearReleaseReason()
+		return nil
+	case credithold.FieldCapturedAt:
+		m.ClearCapturedAt()
+		return nil
+	case credithold.FieldReleasedAt:
+		m.ClearReleasedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceInfo nullable field %s", name)
+	return fmt.Errorf("unknown CreditHold nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeviceInfoMutation) ResetField(name string) error {
+func (m *CreditHoldMutation) ResetField(name string) error {
 	switch name {
-	case deviceinfo.FieldType:
-		m.ResetType()
+	case credithold.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case deviceinfo.FieldOs:
-		m.ResetOs()
+	case credithold.FieldAmount:
+		m.ResetAmount()
 		return nil
-	case deviceinfo.FieldBrowser:
-		m.ResetBrowser()
+	case credithold.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case deviceinfo.FieldCreatedAt:
+	case credithold.FieldProcessType:
+		m.ResetProcessType()
+		return nil
+	case credithold.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case credithold.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case credithold.FieldAPITokenID:
+		m.ResetAPITokenID()
+		return nil
+	case credithold.FieldAPITokenPeriod:
+		m.ResetAPITokenPeriod()
+		return nil
+	case credithold.FieldReleaseReason:
+		m.ResetReleaseReason()
+		return nil
+	case credithold.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case credithold.FieldCapturedAt:
+		m.ResetCapturedAt()
+		return nil
+	case credithold.FieldReleasedAt:
+		m.ResetReleasedAt()
+		return nil
+	case credithold.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case deviceinfo.FieldUpdatedAt:
+	case credithold.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceInfo field %s", name)
+	return fmt.Errorf("unknown CreditHold field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeviceInfoMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.generations != nil {
-		edges = append(edges, deviceinfo.EdgeGenerations)
-	}
-	if m.upscales != nil {
-		edges = append(edges, deviceinfo.EdgeUpscales)
-	}
-	if m.voiceovers != nil {
-		edges = append(edges, deviceinfo.EdgeVoiceovers)
-	}
+func (m *CreditHoldMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeviceInfoMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case deviceinfo.EdgeGenerations:
-		ids := make([]ent.Value, 0, len(m.generations))
-		for id := range m.generations {
-			ids = append(ids, id)
-		}
-		return ids
-	case deviceinfo.EdgeUpscales:
-		ids := make([]ent.Value, 0, len(m.upscales))
-		for id := range m.upscales {
-			ids = append(ids, id)
-		}
-		return ids
-	case deviceinfo.EdgeVoiceovers:
-		ids := make([]ent.Value, 0, len(m.voiceovers))
-		for id := range m.voiceovers {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CreditHoldMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeviceInfoMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedgenerations != nil {
-		edges = append(edges, deviceinfo.EdgeGenerations)
-	}
-	if m.removedupscales != nil {
-		edges = append(edges, deviceinfo.EdgeUpscales)
-	}
-	if m.removedvoiceovers != nil {
-		edges = append(edges, deviceinfo.EdgeVoiceovers)
-	}
+func (m *CreditHoldMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeviceInfoMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case deviceinfo.EdgeGenerations:
-		ids := make([]ent.Value, 0, len(m.removedgenerations))
-		for id := range m.removedgenerations {
-			ids = append(ids, id)
-		}
-		return ids
-	case deviceinfo.EdgeUpscales:
-		ids := make([]ent.Value, 0, len(m.removedupscales))
-		for id := range m.removedupscales {
-			ids = append(ids, id)
-		}
-		return ids
-	case deviceinfo.EdgeVoiceovers:
-		ids := make([]ent.Value, 0, len(m.removedvoiceovers))
-		for id := range m.removedvoiceovers {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CreditHoldMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeviceInfoMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedgenerations {
-		edges = append(edges, deviceinfo.EdgeGenerations)
-	}
-	if m.clearedupscales {
-		edges = append(edges, deviceinfo.EdgeUpscales)
-	}
-	if m.clearedvoiceovers {
-		edges = append(edges, deviceinfo.EdgeVoiceovers)
-	}
+func (m *CreditHoldMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeviceInfoMutation) EdgeCleared(name string) bool {
-	switch name {
-	case deviceinfo.EdgeGenerations:
-		return m.clearedgenerations
-	case deviceinfo.EdgeUpscales:
-		return m.clearedupscales
-	case deviceinfo.EdgeVoiceovers:
-		return m.clearedvoiceovers
-	}
+func (m *CreditHoldMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeviceInfoMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown DeviceInfo unique edge %s", name)
+func (m *CreditHoldMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CreditHold unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeviceInfoMutation) ResetEdge(name string) error {
-	switch name {
-	case deviceinfo.EdgeGenerations:
-		m.ResetGenerations()
-		return nil
-	case deviceinfo.EdgeUpscales:
-		m.ResetUpscales()
-		return nil
-	case deviceinfo.EdgeVoiceovers:
-		m.ResetVoiceovers()
-		return nil
-	}
-	return fmt.Errorf("unknown DeviceInfo edge %s", name)
+func (m *CreditHoldMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CreditHold edge %s", name)
 }
 
-// DisposableEmailMutation represents an operation that mutates the DisposableEmail nodes in the graph.
-type DisposableEmailMutation struct {
+// CreditTransactionMutation represents an operation that mutates the CreditTransaction nodes in the graph.
+type CreditTransactionMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	domain        *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*DisposableEmail, error)
-	predicates    []predicate.DisposableEmail
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	user_id             *uuid.UUID
+	credit_id           *uuid.UUID
+	credit_type_id      *uuid.UUID
+	_type               *credittransaction.Type
+	amount              *int32
+	addamount           *int32
+	reference_id        *uuid.UUID
+	stripe_line_item_id *string
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*CreditTransaction, error)
+	predicates          []predicate.CreditTransaction
 }
 
-var _ ent.Mutation = (*DisposableEmailMutation)(nil)
+var _ ent.Mutation = (*CreditTransactionMutation)(nil)
 
-// disposableemailOption allows management of the mutation configuration using functional options.
-type disposableemailOption func(*DisposableEmailMutation)
+// credittransactionOption allows management of the mutation configuration using functional options.
+type credittransactionOption func(*CreditTransactionMutation)
 
-// newDisposableEmailMutation creates new mutation for the DisposableEmail entity.
-func newDisposableEmailMutation(c config, op Op, opts ...disposableemailOption) *DisposableEmailMutation {
-	m := &DisposableEmailMutation{
+// newCreditTransactionMutation creates new mutation for the CreditTransaction entity.
+func newCreditTransactionMutation(c config, op Op, opts ...credittransactionOption) *CreditTransactionMutation {
+	m := &CreditTransactionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDisposableEmail,
+		typ:           TypeCreditTransaction,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5329,20 +5897,20 @@ func newDisposableEmailMutation(c config, op Op, opts ...disposableemailOption)
 	return m
 }
 
-// withDisposableEmailID sets the ID field of the mutation.
-func withDisposableEmailID(id uuid.UUID) disposableemailOption {
-	return func(m *DisposableEmailMutation) {
+// withCreditTransactionID sets the ID field of the mutation.
+func withCreditTransactionID(id uuid.UUID) credittransactionOption {
+	return func(m *CreditTransactionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DisposableEmail
+			value *CreditTransaction
 		)
-		m.oldValue = func(ctx context.Context) (*DisposableEmail, error) {
+		m.oldValue = func(ctx context.Context) (*CreditTransaction, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DisposableEmail.Get(ctx, id)
+					value, err = m.Client().CreditTransaction.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5351,10 +5919,10 @@ func withDisposableEmailID(id uuid.UUID) disposableemailOption {
 	}
 }
 
-// withDisposableEmail sets the old DisposableEmail of the mutation.
-func withDisposableEmail(node *DisposableEmail) disposableemailOption {
-	return func(m *DisposableEmailMutation) {
-		m.oldValue = func(context.Context) (*DisposableEmail, error) {
+// withCreditTransaction sets the old CreditTransaction of the mutation.
+func withCreditTransaction(node *CreditTransaction) credittransactionOption {
+	return func(m *CreditTransactionMutation) {
+		m.oldValue = func(context.Context) (*CreditTransaction, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5363,7 +5931,7 @@ func withDisposableEmail(node *DisposableEmail) disposableemailOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DisposableEmailMutation) Client() *Client {
+func (m CreditTransactionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5371,7 +5939,7 @@ func (m DisposableEmailMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DisposableEmailMutation) Tx() (*Tx, error) {
+func (m CreditTransactionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5381,14 +5949,14 @@ func (m DisposableEmailMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DisposableEmail entities.
-func (m *DisposableEmailMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of CreditTransaction entities.
+func (m *CreditTransactionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DisposableEmailMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CreditTransactionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5399,7 +5967,7 @@ func (m *DisposableEmailMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DisposableEmailMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CreditTransactionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5408,129 +5976,355 @@ func (m *DisposableEmailMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DisposableEmail.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CreditTransaction.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetDomain sets the "domain" field.
-func (m *DisposableEmailMutation) SetDomain(s string) {
-	m.domain = &s
+// SetUserID sets the "user_id" field.
+func (m *CreditTransactionMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// Domain returns the value of the "domain" field in the mutation.
-func (m *DisposableEmailMutation) Domain() (r string, exists bool) {
-	v := m.domain
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *CreditTransactionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDomain returns the old "domain" field's value of the DisposableEmail entity.
-// If the DisposableEmail object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the CreditTransaction entity.
+// If the CreditTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DisposableEmailMutation) OldDomain(ctx context.Context) (v string, err error) {
+func (m *CreditTransactionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDomain requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Domain, nil
+	return oldValue.UserID, nil
 }
 
-// ResetDomain resets all changes to the "domain" field.
-func (m *DisposableEmailMutation) ResetDomain() {
-	m.domain = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *CreditTransactionMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DisposableEmailMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetCreditID sets the "credit_id" field.
+func (m *CreditTransactionMutation) SetCreditID(u uuid.UUID) {
+	m.credit_id = &u
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DisposableEmailMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// CreditID returns the value of the "credit_id" field in the mutation.
+func (m *CreditTransactionMutation) CreditID() (r uuid.UUID, exists bool) {
+	v := m.credit_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DisposableEmail entity.
-// If the DisposableEmail object wasn't provided to the builder, the object is fetched from the database.
+// OldCreditID returns the old "credit_id" field's value of the CreditTransaction entity.
+// If the CreditTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DisposableEmailMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CreditTransactionMutation) OldCreditID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreditID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreditID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreditID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.CreditID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DisposableEmailMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetCreditID resets all changes to the "credit_id" field.
+func (m *CreditTransactionMutation) ResetCreditID() {
+	m.credit_id = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *DisposableEmailMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetCreditTypeID sets the "credit_type_id" field.
+func (m *CreditTransactionMutation) SetCreditTypeID(u uuid.UUID) {
+	m.credit_type_id = &u
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DisposableEmailMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// CreditTypeID returns the value of the "credit_type_id" field in the mutation.
+func (m *CreditTransactionMutation) CreditTypeID() (r uuid.UUID, exists bool) {
+	v := m.credit_type_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the DisposableEmail entity.
-// If the DisposableEmail object wasn't provided to the builder, the object is fetched from the database.
+// OldCreditTypeID returns the old "credit_type_id" field's value of the CreditTransaction entity.
+// If the CreditTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DisposableEmailMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CreditTransactionMutation) OldCreditTypeID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreditTypeID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreditTypeID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreditTypeID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CreditTypeID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DisposableEmailMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCreditTypeID resets all changes to the "credit_type_id" field.
+func (m *CreditTransactionMutation) ResetCreditTypeID() {
+	m.credit_type_id = nil
 }
 
-// Where appends a list predicates to the DisposableEmailMutation builder.
-func (m *DisposableEmailMutation) Where(ps ...predicate.DisposableEmail) {
+// SetType sets the "type" field.
+func (m *CreditTransactionMutation) SetType(c credittransaction.Type) {
+	m._type = &c
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *CreditTransactionMutation) GetType() (r credittransaction.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the CreditTransaction entity.
+// If the CreditTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditTransactionMutation) OldType(ctx context.Context) (v credittransaction.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *CreditTransactionMutation) ResetType() {
+	m._type = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *CreditTransactionMutation) SetAmount(i int32) {
+	m.amount = &i
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *CreditTransactionMutation) Amount() (r int32, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the CreditTransaction entity.
+// If the CreditTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditTransactionMutation) OldAmount(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds i to the "amount" field.
+func (m *CreditTransactionMutation) AddAmount(i int32) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *CreditTransactionMutation) AddedAmount() (r int32, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *CreditTransactionMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (m *CreditTransactionMutation) SetReferenceID(u uuid.UUID) {
+	m.reference_id = &u
+}
+
+// ReferenceID returns the value of the "reference_id" field in the mutation.
+func (m *CreditTransactionMutation) ReferenceID() (r uuid.UUID, exists bool) {
+	v := m.reference_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReferenceID returns the old "reference_id" field's value of the CreditTransaction entity.
+// If the CreditTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditTransactionMutation) OldReferenceID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReferenceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReferenceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReferenceID: %w", err)
+	}
+	return oldValue.ReferenceID, nil
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (m *CreditTransactionMutation) ClearReferenceID() {
+	m.reference_id = nil
+	m.clearedFields[credittransaction.FieldReferenceID] = struct{}{}
+}
+
+// ReferenceIDCleared returns if the "reference_id" field was cleared in this mutation.
+func (m *CreditTransactionMutation) ReferenceIDCleared() bool {
+	_, ok := m.clearedFields[credittransaction.FieldReferenceID]
+	return ok
+}
+
+// ResetReferenceID resets all changes to the "reference_id" field.
+func (m *CreditTransactionMutation) ResetReferenceID() {
+	m.reference_id = nil
+	delete(m.clearedFields, credittransaction.FieldReferenceID)
+}
+
+// SetStripeLineItemID sets the "stripe_line_item_id" field.
+func (m *CreditTransactionMutation) SetStripeLineItemID(s string) {
+	m.stripe_line_item_id = &s
+}
+
+// StripeLineItemID returns the value of the "stripe_line_item_id" field in the mutation.
+func (m *CreditTransactionMutation) StripeLineItemID() (r string, exists bool) {
+	v := m.stripe_line_item_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStripeLineItemID returns the old "stripe_line_item_id" field's value of the CreditTransaction entity.
+// If the CreditTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditTransactionMutation) OldStripeLineItemID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStripeLineItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStripeLineItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStripeLineItemID: %w", err)
+	}
+	return oldValue.StripeLineItemID, nil
+}
+
+// ClearStripeLineItemID clears the value of the "stripe_line_item_id" field.
+func (m *CreditTransactionMutation) ClearStripeLineItemID() {
+	m.stripe_line_item_id = nil
+	m.clearedFields[credittransaction.FieldStripeLineItemID] = struct{}{}
+}
+
+// StripeLineItemIDCleared returns if the "stripe_line_item_id" field was cleared in this mutation.
+func (m *CreditTransactionMutation) StripeLineItemIDCleared() bool {
+	_, ok := m.clearedFields[credittransaction.FieldStripeLineItemID]
+	return ok
+}
+
+// ResetStripeLineItemID resets all changes to the "stripe_line_item_id" field.
+func (m *CreditTransactionMutation) ResetStripeLineItemID() {
+	m.stripe_line_item_id = nil
+	delete(m.clearedFields, credittransaction.FieldStripeLineItemID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CreditTransactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CreditTransactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CreditTransaction entity.
+// If the CreditTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditTransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CreditTransactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the CreditTransactionMutation builder.
+func (m *CreditTransactionMutation) Where(ps ...predicate.CreditTransaction) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DisposableEmailMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CreditTransactionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DisposableEmailMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DisposableEmail, len(ps))
+func (m *CreditTransactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CreditTransaction, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5538,33 +6332,48 @@ func (m *DisposableEmailMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DisposableEmailMutation) Op() Op {
+func (m *CreditTransactionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DisposableEmailMutation) SetOp(op Op) {
+func (m *CreditTransactionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DisposableEmail).
-func (m *DisposableEmailMutation) Type() string {
+// Type returns the node type of this mutation (CreditTransaction).
+func (m *CreditTransactionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DisposableEmailMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.domain != nil {
-		fields = append(fields, disposableemail.FieldDomain)
+func (m *CreditTransactionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.user_id != nil {
+		fields = append(fields, credittransaction.FieldUserID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, disposableemail.FieldCreatedAt)
+	if m.credit_id != nil {
+		fields = append(fields, credittransaction.FieldCreditID)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, disposableemail.FieldUpdatedAt)
+	if m.credit_type_id != nil {
+		fields = append(fields, credittransaction.FieldCreditTypeID)
+	}
+	if m._type != nil {
+		fields = append(fields, credittransaction.FieldType)
+	}
+	if m.amount != nil {
+		fields = append(fields, credittransaction.FieldAmount)
+	}
+	if m.reference_id != nil {
+		fields = append(fields, credittransaction.FieldReferenceID)
+	}
+	if m.stripe_line_item_id != nil {
+		fields = append(fields, credittransaction.FieldStripeLineItemID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, credittransaction.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5572,14 +6381,24 @@ func (m *DisposableEmailMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DisposableEmailMutation) Field(name string) (ent.Value, bool) {
+func (m *CreditTransactionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case disposableemail.FieldDomain:
-		return m.Domain()
-	case disposableemail.FieldCreatedAt:
+	case credittransaction.FieldUserID:
+		return m.UserID()
+	case credittransaction.FieldCreditID:
+		return m.CreditID()
+	case credittransaction.FieldCreditTypeID:
+		return m.CreditTypeID()
+	case credittransaction.FieldType:
+		return m.GetType()
+	case credittransaction.FieldAmount:
+		return m.Amount()
+	case credittransaction.FieldReferenceID:
+		return m.ReferenceID()
+	case credittransaction.FieldStripeLineItemID:
+		return m.StripeLineItemID()
+	case credittransaction.FieldCreatedAt:
 		return m.CreatedAt()
-	case disposableemail.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5587,223 +6406,279 @@ func (m *DisposableEmailMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DisposableEmailMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CreditTransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case disposableemail.FieldDomain:
-		return m.OldDomain(ctx)
-	case disposableemail.FieldCreatedAt:
+	case credittransaction.FieldUserID:
+		return m.OldUserID(ctx)
+	case credittransaction.FieldCreditID:
+		return m.OldCreditID(ctx)
+	case credittransaction.FieldCreditTypeID:
+		return m.OldCreditTypeID(ctx)
+	case credittransaction.FieldType:
+		return m.OldType(ctx)
+	case credittransaction.FieldAmount:
+		return m.OldAmount(ctx)
+	case credittransaction.FieldReferenceID:
+		return m.OldReferenceID(ctx)
+	case credittransaction.FieldStripeLineItemID:
+		return m.OldStripeLineItemID(ctx)
+	case credittransaction.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case disposableemail.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DisposableEmail field %s", name)
+	return nil, fmt.Errorf("unknown CreditTransaction field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DisposableEmailMutation) SetField(name string, value ent.Value) error {
+func (m *CreditTransactionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case disposableemail.FieldDomain:
-		v, ok := value.(string)
+	case credittransaction.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDomain(v)
+		m.SetUserID(v)
 		return nil
-	case disposableemail.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case credittransaction.FieldCreditID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetCreditID(v)
 		return nil
-	case disposableemail.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case credittransaction.FieldCreditTypeID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreditTypeID(v)
 		return nil
-	}
-	return fmt.Errorf("unknown DisposableEmail field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *DisposableEmailMutation) AddedFields() []string {
-	return nil
-}
+	case credittransaction.FieldType:
+		v, ok := value.(credittransaction.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case credittransaction.FieldAmount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case credittransaction.FieldReferenceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReferenceID(v)
+		return nil
+	case credittransaction.FieldStripeLineItemID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStripeLineItemID(v)
+		return nil
+	case credittransaction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CreditTransaction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CreditTransactionMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, credittransaction.FieldAmount)
+	}
+	return fields
+}
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DisposableEmailMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CreditTransactionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case credittransaction.FieldAmount:
+		return m.AddedAmount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DisposableEmailMutation) AddField(name string, value ent.Value) error {
+func (m *CreditTransactionMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case credittransaction.FieldAmount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown DisposableEmail numeric field %s", name)
+	return fmt.Errorf("unknown CreditTransaction numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DisposableEmailMutation) ClearedFields() []string {
-	return nil
+func (m *CreditTransactionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(credittransaction.FieldReferenceID) {
+		fields = append(fields, credittransaction.FieldReferenceID)
+	}
+	if m.FieldCleared(credittransaction.FieldStripeLineItemID) {
+		fields = append(fields, credittransaction.FieldStripeLineItemID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DisposableEmailMutation) FieldCleared(name string) bool {
+func (m *CreditTransactionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DisposableEmailMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown DisposableEmail nullable field %s", name)
+func (m *CreditTransactionMutation) ClearField(name string) error {
+	switch name {
+	case credittransaction.FieldReferenceID:
+		m.ClearReferenceID()
+		return nil
+	case credittransaction.FieldStripeLineItemID:
+		m.ClearStripeLineItemID()
+		return nil
+	}
+	return fmt.Errorf("unknown CreditTransaction nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DisposableEmailMutation) ResetField(name string) error {
+func (m *CreditTransactionMutation) ResetField(name string) error {
 	switch name {
-	case disposableemail.FieldDomain:
-		m.ResetDomain()
+	case credittransaction.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case disposableemail.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case credittransaction.FieldCreditID:
+		m.ResetCreditID()
 		return nil
-	case disposableemail.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case credittransaction.FieldCreditTypeID:
+		m.ResetCreditTypeID()
+		return nil
+	case credittransaction.FieldType:
+		m.ResetType()
+		return nil
+	case credittransaction.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case credittransaction.FieldReferenceID:
+		m.ResetReferenceID()
+		return nil
+	case credittransaction.FieldStripeLineItemID:
+		m.ResetStripeLineItemID()
+		return nil
+	case credittransaction.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DisposableEmail field %s", name)
+	return fmt.Errorf("unknown CreditTransaction field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DisposableEmailMutation) AddedEdges() []string {
+func (m *CreditTransactionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DisposableEmailMutation) AddedIDs(name string) []ent.Value {
+func (m *CreditTransactionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DisposableEmailMutation) RemovedEdges() []string {
+func (m *CreditTransactionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DisposableEmailMutation) RemovedIDs(name string) []ent.Value {
+func (m *CreditTransactionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DisposableEmailMutation) ClearedEdges() []string {
+func (m *CreditTransactionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DisposableEmailMutation) EdgeCleared(name string) bool {
+func (m *CreditTransactionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DisposableEmailMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DisposableEmail unique edge %s", name)
+func (m *CreditTransactionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CreditTransaction unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DisposableEmailMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DisposableEmail edge %s", name)
+func (m *CreditTransactionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CreditTransaction edge %s", name)
 }
 
-// GenerationMutation represents an operation that mutates the Generation nodes in the graph.
-type GenerationMutation struct {
+// CreditTypeMutation represents an operation that mutates the CreditType nodes in the graph.
+type CreditTypeMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *uuid.UUID
-	width                     *int32
-	addwidth                  *int32
-	height                    *int32
-	addheight                 *int32
-	inference_steps           *int32
-	addinference_steps        *int32
-	guidance_scale            *float32
-	addguidance_scale         *float32
-	num_outputs               *int32
-	addnum_outputs            *int32
-	nsfw_count                *int32
-	addnsfw_count             *int32
-	seed                      *int
-	addseed                   *int
-	status                    *generation.Status
-	failure_reason            *string
-	country_code              *string
-	init_image_url            *string
-	mask_image_url            *string
-	prompt_strength           *float32
-	addprompt_strength        *float32
-	was_auto_submitted        *bool
-	stripe_product_id         *string
-	source_type               *enttypes.SourceType
-	webhook_token             *uuid.UUID
-	started_at                *time.Time
-	completed_at              *time.Time
-	created_at                *time.Time
-	updated_at                *time.Time
-	clearedFields             map[string]struct{}
-	device_info               *uuid.UUID
-	cleareddevice_info        bool
-	scheduler                 *uuid.UUID
-	clearedscheduler          bool
-	prompt                    *uuid.UUID
-	clearedprompt             bool
-	negative_prompt           *uuid.UUID
-	clearednegative_prompt    bool
-	generation_model          *uuid.UUID
-	clearedgeneration_model   bool
-	user                      *uuid.UUID
-	cleareduser               bool
-	api_tokens                *uuid.UUID
-	clearedapi_tokens         bool
-	generation_outputs        map[uuid.UUID]struct{}
-	removedgeneration_outputs map[uuid.UUID]struct{}
-	clearedgeneration_outputs bool
-	done                      bool
-	oldValue                  func(context.Context) (*Generation, error)
-	predicates                []predicate.Generation
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	name              *string
+	description       *string
+	amount            *int32
+	addamount         *int32
+	stripe_product_id *string
+	annual            *bool
+	_type             *credittype.Type
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	credits           map[uuid.UUID]struct{}
+	removedcredits    map[uuid.UUID]struct{}
+	clearedcredits    bool
+	done              bool
+	oldValue          func(context.Context) (*CreditType, error)
+	predicates        []predicate.CreditType
 }
 
-var _ ent.Mutation = (*GenerationMutation)(nil)
+var _ ent.Mutation = (*CreditTypeMutation)(nil)
 
-// generationOption allows management of the mutation configuration using functional options.
-type generationOption func(*GenerationMutation)
+// credittypeOption allows management of the mutation configuration using functional options.
+type credittypeOption func(*CreditTypeMutation)
 
-// newGenerationMutation creates new mutation for the Generation entity.
-func newGenerationMutation(c config, op Op, opts ...generationOption) *GenerationMutation {
-	m := &GenerationMutation{
+// newCreditTypeMutation creates new mutation for the CreditType entity.
+func newCreditTypeMutation(c config, op Op, opts ...credittypeOption) *CreditTypeMutation {
+	m := &CreditTypeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGeneration,
+		typ:           TypeCreditType,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5812,20 +6687,20 @@ func newGenerationMutation(c config, op Op, opts ...generationOption) *Generatio
 	return m
 }
 
-// withGenerationID sets the ID field of the mutation.
-func withGenerationID(id uuid.UUID) generationOption {
-	return func(m *GenerationMutation) {
+// withCreditTypeID sets the ID field of the mutation.
+func withCreditTypeID(id uuid.UUID) credittypeOption {
+	return func(m *CreditTypeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Generation
+			value *CreditType
 		)
-		m.oldValue = func(ctx context.Context) (*Generation, error) {
+		m.oldValue = func(ctx context.Context) (*CreditType, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Generation.Get(ctx, id)
+					value, err = m.Client().CreditType.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5834,10 +6709,10 @@ func withGenerationID(id uuid.UUID) generationOption {
 	}
 }
 
-// withGeneration sets the old Generation of the mutation.
-func withGeneration(node *Generation) generationOption {
-	return func(m *GenerationMutation) {
-		m.oldValue = func(context.Context) (*Generation, error) {
+// withCreditType sets the old CreditType of the mutation.
+func withCreditType(node *CreditType) credittypeOption {
+	return func(m *CreditTypeMutation) {
+		m.oldValue = func(context.Context) (*CreditType, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5846,7 +6721,7 @@ func withGeneration(node *Generation) generationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GenerationMutation) Client() *Client {
+func (m CreditTypeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5854,7 +6729,7 @@ func (m GenerationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GenerationMutation) Tx() (*Tx, error) {
+func (m CreditTypeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5864,14 +6739,14 @@ func (m GenerationMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Generation entities.
-func (m *GenerationMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of CreditType entities.
+func (m *CreditTypeMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GenerationMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CreditTypeMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5882,7 +6757,7 @@ func (m *GenerationMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GenerationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CreditTypeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5891,1602 +6766,2054 @@ func (m *GenerationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Generation.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CreditType.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetWidth sets the "width" field.
-func (m *GenerationMutation) SetWidth(i int32) {
-	m.width = &i
-	m.addwidth = nil
+// SetName sets the "name" field.
+func (m *CreditTypeMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Width returns the value of the "width" field in the mutation.
-func (m *GenerationMutation) Width() (r int32, exists bool) {
-	v := m.width
+// Name returns the value of the "name" field in the mutation.
+func (m *CreditTypeMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWidth returns the old "width" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the CreditType entity.
+// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldWidth(ctx context.Context) (v int32, err error) {
+func (m *CreditTypeMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWidth requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Width, nil
+	return oldValue.Name, nil
 }
 
-// AddWidth adds i to the "width" field.
-func (m *GenerationMutation) AddWidth(i int32) {
-	if m.addwidth != nil {
-		*m.addwidth += i
-	} else {
-		m.addwidth = &i
-	}
+// ResetName resets all changes to the "name" field.
+func (m *CreditTypeMutation) ResetName() {
+	m.name = nil
 }
 
-// AddedWidth returns the value that was added to the "width" field in this mutation.
-func (m *GenerationMutation) AddedWidth() (r int32, exists bool) {
-	v := m.addwidth
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetWidth resets all changes to the "width" field.
-func (m *GenerationMutation) ResetWidth() {
-	m.width = nil
-	m.addwidth = nil
-}
-
-// SetHeight sets the "height" field.
-func (m *GenerationMutation) SetHeight(i int32) {
-	m.height = &i
-	m.addheight = nil
+// SetDescription sets the "description" field.
+func (m *CreditTypeMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// Height returns the value of the "height" field in the mutation.
-func (m *GenerationMutation) Height() (r int32, exists bool) {
-	v := m.height
+// Description returns the value of the "description" field in the mutation.
+func (m *CreditTypeMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHeight returns the old "height" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the CreditType entity.
+// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldHeight(ctx context.Context) (v int32, err error) {
+func (m *CreditTypeMutation) OldDescription(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHeight requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.Height, nil
+	return oldValue.Description, nil
 }
 
-// AddHeight adds i to the "height" field.
-func (m *GenerationMutation) AddHeight(i int32) {
-	if m.addheight != nil {
-		*m.addheight += i
-	} else {
-		m.addheight = &i
-	}
+// ClearDescription clears the value of the "description" field.
+func (m *CreditTypeMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[credittype.FieldDescription] = struct{}{}
 }
 
-// AddedHeight returns the value that was added to the "height" field in this mutation.
-func (m *GenerationMutation) AddedHeight() (r int32, exists bool) {
-	v := m.addheight
-	if v == nil {
-		return
-	}
-	return *v, true
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *CreditTypeMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[credittype.FieldDescription]
+	return ok
 }
 
-// ResetHeight resets all changes to the "height" field.
-func (m *GenerationMutation) ResetHeight() {
-	m.height = nil
-	m.addheight = nil
+// ResetDescription resets all changes to the "description" field.
+func (m *CreditTypeMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, credittype.FieldDescription)
 }
 
-// SetInferenceSteps sets the "inference_steps" field.
-func (m *GenerationMutation) SetInferenceSteps(i int32) {
-	m.inference_steps = &i
-	m.addinference_steps = nil
+// SetAmount sets the "amount" field.
+func (m *CreditTypeMutation) SetAmount(i int32) {
+	m.amount = &i
+	m.addamount = nil
 }
 
-// InferenceSteps returns the value of the "inference_steps" field in the mutation.
-func (m *GenerationMutation) InferenceSteps() (r int32, exists bool) {
-	v := m.inference_steps
+// Amount returns the value of the "amount" field in the mutation.
+func (m *CreditTypeMutation) Amount() (r int32, exists bool) {
+	v := m.amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInferenceSteps returns the old "inference_steps" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldAmount returns the old "amount" field's value of the CreditType entity.
+// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldInferenceSteps(ctx context.Context) (v int32, err error) {
+func (m *CreditTypeMutation) OldAmount(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInferenceSteps is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInferenceSteps requires an ID field in the mutation")
+		return v, errors.New("OldAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInferenceSteps: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
 	}
-	return oldValue.InferenceSteps, nil
+	return oldValue.Amount, nil
 }
 
-// AddInferenceSteps adds i to the "inference_steps" field.
-func (m *GenerationMutation) AddInferenceSteps(i int32) {
-	if m.addinference_steps != nil {
-		*m.addinference_steps += i
+// AddAmount adds i to the "amount" field.
+func (m *CreditTypeMutation) AddAmount(i int32) {
+	if m.addamount != nil {
+		*m.addamount += i
 	} else {
-		m.addinference_steps = &i
+		m.addamount = &i
 	}
 }
 
-// AddedInferenceSteps returns the value that was added to the "inference_steps" field in this mutation.
-func (m *GenerationMutation) AddedInferenceSteps() (r int32, exists bool) {
-	v := m.addinference_steps
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *CreditTypeMutation) AddedAmount() (r int32, exists bool) {
+	v := m.addamount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetInferenceSteps resets all changes to the "inference_steps" field.
-func (m *GenerationMutation) ResetInferenceSteps() {
-	m.inference_steps = nil
-	m.addinference_steps = nil
+// ResetAmount resets all changes to the "amount" field.
+func (m *CreditTypeMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
 }
 
-// SetGuidanceScale sets the "guidance_scale" field.
-func (m *GenerationMutation) SetGuidanceScale(f float32) {
-	m.guidance_scale = &f
-	m.addguidance_scale = nil
+// SetStripeProductID sets the "stripe_product_id" field.
+func (m *CreditTypeMutation) SetStripeProductID(s string) {
+	m.stripe_product_id = &s
 }
 
-// GuidanceScale returns the value of the "guidance_scale" field in the mutation.
-func (m *GenerationMutation) GuidanceScale() (r float32, exists bool) {
-	v := m.guidance_scale
+// StripeProductID returns the value of the "stripe_product_id" field in the mutation.
+func (m *CreditTypeMutation) StripeProductID() (r string, exists bool) {
+	v := m.stripe_product_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGuidanceScale returns the old "guidance_scale" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldStripeProductID returns the old "stripe_product_id" field's value of the CreditType entity.
+// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldGuidanceScale(ctx context.Context) (v float32, err error) {
+func (m *CreditTypeMutation) OldStripeProductID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGuidanceScale is only allowed on UpdateOne operations")
+		return v, errors.New("OldStripeProductID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGuidanceScale requires an ID field in the mutation")
+		return v, errors.New("OldStripeProductID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGuidanceScale: %w", err)
+		return v, fmt.Errorf("querying old value for OldStripeProductID: %w", err)
 	}
-	return oldValue.GuidanceScale, nil
+	return oldValue.StripeProductID, nil
 }
 
-// AddGuidanceScale adds f to the "guidance_scale" field.
-func (m *GenerationMutation) AddGuidanceScale(f float32) {
-	if m.addguidance_scale != nil {
-		*m.addguidance_scale += f
-	} else {
-		m.addguidance_scale = &f
-	}
+// ClearStripeProductID clears the value of the "stripe_product_id" field.
+func (m *CreditTypeMutation) ClearStripeProductID() {
+	m.stripe_product_id = nil
+	m.clearedFields[credittype.FieldStripeProductID] = struct{}{}
 }
 
-// AddedGuidanceScale returns the value that was added to the "guidance_scale" field in this mutation.
-func (m *GenerationMutation) AddedGuidanceScale() (r float32, exists bool) {
-	v := m.addguidance_scale
-	if v == nil {
-		return
-	}
-	return *v, true
+// StripeProductIDCleared returns if the "stripe_product_id" field was cleared in this mutation.
+func (m *CreditTypeMutation) StripeProductIDCleared() bool {
+	_, ok := m.clearedFields[credittype.FieldStripeProductID]
+	return ok
 }
 
-// ResetGuidanceScale resets all changes to the "guidance_scale" field.
-func (m *GenerationMutation) ResetGuidanceScale() {
-	m.guidance_scale = nil
-	m.addguidance_scale = nil
+// ResetStripeProductID resets all changes to the "stripe_product_id" field.
+func (m *CreditTypeMutation) ResetStripeProductID() {
+	m.stripe_product_id = nil
+	delete(m.clearedFields, credittype.FieldStripeProductID)
 }
 
-// SetNumOutputs sets the "num_outputs" field.
-func (m *GenerationMutation) SetNumOutputs(i int32) {
-	m.num_outputs = &i
-	m.addnum_outputs = nil
+// SetAnnual sets the "annual" field.
+func (m *CreditTypeMutation) SetAnnual(b bool) {
+	m.annual = &b
 }
 
-// NumOutputs returns the value of the "num_outputs" field in the mutation.
-func (m *GenerationMutation) NumOutputs() (r int32, exists bool) {
-	v := m.num_outputs
+// Annual returns the value of the "annual" field in the mutation.
+func (m *CreditTypeMutation) Annual() (r bool, exists bool) {
+	v := m.annual
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNumOutputs returns the old "num_outputs" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldAnnual returns the old "annual" field's value of the CreditType entity.
+// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldNumOutputs(ctx context.Context) (v int32, err error) {
+func (m *CreditTypeMutation) OldAnnual(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNumOutputs is only allowed on UpdateOne operations")
+		return v, errors.New("OldAnnual is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNumOutputs requires an ID field in the mutation")
+		return v, errors.New("OldAnnual requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNumOutputs: %w", err)
-	}
-	return oldValue.NumOutputs, nil
-}
-
-// AddNumOutputs adds i to the "num_outputs" field.
-func (m *GenerationMutation) AddNumOutputs(i int32) {
-	if m.addnum_outputs != nil {
-		*m.addnum_outputs += i
-	} else {
-		m.addnum_outputs = &i
-	}
-}
-
-// AddedNumOutputs returns the value that was added to the "num_outputs" field in this mutation.
-func (m *GenerationMutation) AddedNumOutputs() (r int32, exists bool) {
-	v := m.addnum_outputs
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAnnual: %w", err)
 	}
-	return *v, true
+	return oldValue.Annual, nil
 }
 
-// ResetNumOutputs resets all changes to the "num_outputs" field.
-func (m *GenerationMutation) ResetNumOutputs() {
-	m.num_outputs = nil
-	m.addnum_outputs = nil
+// ResetAnnual resets all changes to the "annual" field.
+func (m *CreditTypeMutation) ResetAnnual() {
+	m.annual = nil
 }
 
-// SetNsfwCount sets the "nsfw_count" field.
-func (m *GenerationMutation) SetNsfwCount(i int32) {
-	m.nsfw_count = &i
-	m.addnsfw_count = nil
+// SetType sets the "type" field.
+func (m *CreditTypeMutation) SetType(c credittype.Type) {
+	m._type = &c
 }
 
-// NsfwCount returns the value of the "nsfw_count" field in the mutation.
-func (m *GenerationMutation) NsfwCount() (r int32, exists bool) {
-	v := m.nsfw_count
+// GetType returns the value of the "type" field in the mutation.
+func (m *CreditTypeMutation) GetType() (r credittype.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNsfwCount returns the old "nsfw_count" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the CreditType entity.
+// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldNsfwCount(ctx context.Context) (v int32, err error) {
+func (m *CreditTypeMutation) OldType(ctx context.Context) (v credittype.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNsfwCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNsfwCount requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNsfwCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.NsfwCount, nil
-}
-
-// AddNsfwCount adds i to the "nsfw_count" field.
-func (m *GenerationMutation) AddNsfwCount(i int32) {
-	if m.addnsfw_count != nil {
-		*m.addnsfw_count += i
-	} else {
-		m.addnsfw_count = &i
-	}
-}
-
-// AddedNsfwCount returns the value that was added to the "nsfw_count" field in this mutation.
-func (m *GenerationMutation) AddedNsfwCount() (r int32, exists bool) {
-	v := m.addnsfw_count
-	if v == nil {
-		return
-	}
-	return *v, true
+	return oldValue.Type, nil
 }
 
-// ResetNsfwCount resets all changes to the "nsfw_count" field.
-func (m *GenerationMutation) ResetNsfwCount() {
-	m.nsfw_count = nil
-	m.addnsfw_count = nil
+// ResetType resets all changes to the "type" field.
+func (m *CreditTypeMutation) ResetType() {
+	m._type = nil
 }
 
-// SetSeed sets the "seed" field.
-func (m *GenerationMutation) SetSeed(i int) {
-	m.seed = &i
-	m.addseed = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *CreditTypeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Seed returns the value of the "seed" field in the mutation.
-func (m *GenerationMutation) Seed() (r int, exists bool) {
-	v := m.seed
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CreditTypeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSeed returns the old "seed" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the CreditType entity.
+// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldSeed(ctx context.Context) (v int, err error) {
+func (m *CreditTypeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeed requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
-	}
-	return oldValue.Seed, nil
-}
-
-// AddSeed adds i to the "seed" field.
-func (m *GenerationMutation) AddSeed(i int) {
-	if m.addseed != nil {
-		*m.addseed += i
-	} else {
-		m.addseed = &i
-	}
-}
-
-// AddedSeed returns the value that was added to the "seed" field in this mutation.
-func (m *GenerationMutation) AddedSeed() (r int, exists bool) {
-	v := m.addseed
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSeed resets all changes to the "seed" field.
-func (m *GenerationMutation) ResetSeed() {
-	m.seed = nil
-	m.addseed = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CreditTypeMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *GenerationMutation) SetStatus(ge generation.Status) {
-	m.status = &ge
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CreditTypeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *GenerationMutation) Status() (r generation.Status, exists bool) {
-	v := m.status
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CreditTypeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the CreditType entity.
+// If the CreditType object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldStatus(ctx context.Context) (v generation.Status, err error) {
+func (m *CreditTypeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *GenerationMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetFailureReason sets the "failure_reason" field.
-func (m *GenerationMutation) SetFailureReason(s string) {
-	m.failure_reason = &s
+	return oldValue.UpdatedAt, nil
 }
 
-// FailureReason returns the value of the "failure_reason" field in the mutation.
-func (m *GenerationMutation) FailureReason() (r string, exists bool) {
-	v := m.failure_reason
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CreditTypeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// OldFailureReason returns the old "failure_reason" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldFailureReason(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFailureReason is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFailureReason requires an ID field in the mutation")
+// AddCreditIDs adds the "credits" edge to the Credit entity by ids.
+func (m *CreditTypeMutation) AddCreditIDs(ids ...uuid.UUID) {
+	if m.credits == nil {
+		m.credits = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFailureReason: %w", err)
+	for i := range ids {
+		m.credits[ids[i]] = struct{}{}
 	}
-	return oldValue.FailureReason, nil
 }
 
-// ClearFailureReason clears the value of the "failure_reason" field.
-func (m *GenerationMutation) ClearFailureReason() {
-	m.failure_reason = nil
-	m.clearedFields[generation.FieldFailureReason] = struct{}{}
+// ClearCredits clears the "credits" edge to the Credit entity.
+func (m *CreditTypeMutation) ClearCredits() {
+	m.clearedcredits = true
 }
 
-// FailureReasonCleared returns if the "failure_reason" field was cleared in this mutation.
-func (m *GenerationMutation) FailureReasonCleared() bool {
-	_, ok := m.clearedFields[generation.FieldFailureReason]
-	return ok
+// CreditsCleared reports if the "credits" edge to the Credit entity was cleared.
+func (m *CreditTypeMutation) CreditsCleared() bool {
+	return m.clearedcredits
 }
 
-// ResetFailureReason resets all changes to the "failure_reason" field.
-func (m *GenerationMutation) ResetFailureReason() {
-	m.failure_reason = nil
-	delete(m.clearedFields, generation.FieldFailureReason)
+// RemoveCreditIDs removes the "credits" edge to the Credit entity by IDs.
+func (m *CreditTypeMutation) RemoveCreditIDs(ids ...uuid.UUID) {
+	if m.removedcredits == nil {
+		m.removedcredits = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.credits, ids[i])
+		m.removedcredits[ids[i]] = struct{}{}
+	}
 }
 
-// SetCountryCode sets the "country_code" field.
-func (m *GenerationMutation) SetCountryCode(s string) {
-	m.country_code = &s
+// RemovedCredits returns the removed IDs of the "credits" edge to the Credit entity.
+func (m *CreditTypeMutation) RemovedCreditsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcredits {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// CountryCode returns the value of the "country_code" field in the mutation.
-func (m *GenerationMutation) CountryCode() (r string, exists bool) {
-	v := m.country_code
-	if v == nil {
-		return
+// CreditsIDs returns the "credits" edge IDs in the mutation.
+func (m *CreditTypeMutation) CreditsIDs() (ids []uuid.UUID) {
+	for id := range m.credits {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldCountryCode returns the old "country_code" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldCountryCode(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCountryCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCountryCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCountryCode: %w", err)
-	}
-	return oldValue.CountryCode, nil
+// ResetCredits resets all changes to the "credits" edge.
+func (m *CreditTypeMutation) ResetCredits() {
+	m.credits = nil
+	m.clearedcredits = false
+	m.removedcredits = nil
 }
 
-// ClearCountryCode clears the value of the "country_code" field.
-func (m *GenerationMutation) ClearCountryCode() {
-	m.country_code = nil
-	m.clearedFields[generation.FieldCountryCode] = struct{}{}
+// Where appends a list predicates to the CreditTypeMutation builder.
+func (m *CreditTypeMutation) Where(ps ...predicate.CreditType) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// CountryCodeCleared returns if the "country_code" field was cleared in this mutation.
-func (m *GenerationMutation) CountryCodeCleared() bool {
-	_, ok := m.clearedFields[generation.FieldCountryCode]
-	return ok
+// WhereP appends storage-level predicates to the CreditTypeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CreditTypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CreditType, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ResetCountryCode resets all changes to the "country_code" field.
-func (m *GenerationMutation) ResetCountryCode() {
-	m.country_code = nil
-	delete(m.clearedFields, generation.FieldCountryCode)
+// Op returns the operation name.
+func (m *CreditTypeMutation) Op() Op {
+	return m.op
 }
 
-// SetInitImageURL sets the "init_image_url" field.
-func (m *GenerationMutation) SetInitImageURL(s string) {
-	m.init_image_url = &s
+// SetOp allows setting the mutation operation.
+func (m *CreditTypeMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// InitImageURL returns the value of the "init_image_url" field in the mutation.
-func (m *GenerationMutation) InitImageURL() (r string, exists bool) {
-	v := m.init_image_url
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (CreditType).
+func (m *CreditTypeMutation) Type() string {
+	return m.typ
 }
 
-// OldInitImageURL returns the old "init_image_url" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldInitImageURL(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInitImageURL is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CreditTypeMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, credittype.FieldName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInitImageURL requires an ID field in the mutation")
+	if m.description != nil {
+		fields = append(fields, credittype.FieldDescription)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInitImageURL: %w", err)
+	if m.amount != nil {
+		fields = append(fields, credittype.FieldAmount)
 	}
-	return oldValue.InitImageURL, nil
+	if m.stripe_product_id != nil {
+		fields = append(fields, credittype.FieldStripeProductID)
+	}
+	if m.annual != nil {
+		fields = append(fields, credittype.FieldAnnual)
+	}
+	if m._type != nil {
+		fields = append(fields, credittype.FieldType)
+	}
+	if m.created_at != nil {
+		fields = append(fields, credittype.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, credittype.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ClearInitImageURL clears the value of the "init_image_url" field.
-func (m *GenerationMutation) ClearInitImageURL() {
-	m.init_image_url = nil
-	m.clearedFields[generation.FieldInitImageURL] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CreditTypeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case credittype.FieldName:
+		return m.Name()
+	case credittype.FieldDescription:
+		return m.Description()
+	case credittype.FieldAmount:
+		return m.Amount()
+	case credittype.FieldStripeProductID:
+		return m.StripeProductID()
+	case credittype.FieldAnnual:
+		return m.Annual()
+	case credittype.FieldType:
+		return m.GetType()
+	case credittype.FieldCreatedAt:
+		return m.CreatedAt()
+	case credittype.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// InitImageURLCleared returns if the "init_image_url" field was cleared in this mutation.
-func (m *GenerationMutation) InitImageURLCleared() bool {
-	_, ok := m.clearedFields[generation.FieldInitImageURL]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CreditTypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case credittype.FieldName:
+		return m.OldName(ctx)
+	case credittype.FieldDescription:
+		return m.OldDescription(ctx)
+	case credittype.FieldAmount:
+		return m.OldAmount(ctx)
+	case credittype.FieldStripeProductID:
+		return m.OldStripeProductID(ctx)
+	case credittype.FieldAnnual:
+		return m.OldAnnual(ctx)
+	case credittype.FieldType:
+		return m.OldType(ctx)
+	case credittype.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case credittype.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CreditType field %s", name)
 }
 
-// ResetInitImageURL resets all changes to the "init_image_url" field.
-func (m *GenerationMutation) ResetInitImageURL() {
-	m.init_image_url = nil
-	delete(m.clearedFields, generation.FieldInitImageURL)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CreditTypeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case credittype.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case credittype.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case credittype.FieldAmount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case credittype.FieldStripeProductID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStripeProductID(v)
+		return nil
+	case credittype.FieldAnnual:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnnual(v)
+		return nil
+	case credittype.FieldType:
+		v, ok := value.(credittype.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case credittype.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case credittype.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CreditType field %s", name)
 }
 
-// SetMaskImageURL sets the "mask_image_url" field.
-func (m *GenerationMutation) SetMaskImageURL(s string) {
-	m.mask_image_url = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CreditTypeMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, credittype.FieldAmount)
+	}
+	return fields
 }
 
-// MaskImageURL returns the value of the "mask_image_url" field in the mutation.
-func (m *GenerationMutation) MaskImageURL() (r string, exists bool) {
-	v := m.mask_image_url
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CreditTypeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case credittype.FieldAmount:
+		return m.AddedAmount()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldMaskImageURL returns the old "mask_image_url" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldMaskImageURL(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaskImageURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaskImageURL requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaskImageURL: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CreditTypeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case credittype.FieldAmount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
 	}
-	return oldValue.MaskImageURL, nil
+	return fmt.Errorf("unknown CreditType numeric field %s", name)
 }
 
-// ClearMaskImageURL clears the value of the "mask_image_url" field.
-func (m *GenerationMutation) ClearMaskImageURL() {
-	m.mask_image_url = nil
-	m.clearedFields[generation.FieldMaskImageURL] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CreditTypeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(credittype.FieldDescription) {
+		fields = append(fields, credittype.FieldDescription)
+	}
+	if m.FieldCleared(credittype.FieldStripeProductID) {
+		fields = append(fields, credittype.FieldStripeProductID)
+	}
+	return fields
 }
 
-// MaskImageURLCleared returns if the "mask_image_url" field was cleared in this mutation.
-func (m *GenerationMutation) MaskImageURLCleared() bool {
-	_, ok := m.clearedFields[generation.FieldMaskImageURL]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CreditTypeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetMaskImageURL resets all changes to the "mask_image_url" field.
-func (m *GenerationMutation) ResetMaskImageURL() {
-	m.mask_image_url = nil
-	delete(m.clearedFields, generation.FieldMaskImageURL)
-}
-
-// SetPromptStrength sets the "prompt_strength" field.
-func (m *GenerationMutation) SetPromptStrength(f float32) {
-	m.prompt_strength = &f
-	m.addprompt_strength = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CreditTypeMutation) ClearField(name string) error {
+	switch name {
+	case credittype.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case credittype.FieldStripeProductID:
+		m.ClearStripeProductID()
+		return nil
+	}
+	return fmt.Errorf("unknown CreditType nullable field %s", name)
 }
 
-// PromptStrength returns the value of the "prompt_strength" field in the mutation.
-func (m *GenerationMutation) PromptStrength() (r float32, exists bool) {
-	v := m.prompt_strength
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CreditTypeMutation) ResetField(name string) error {
+	switch name {
+	case credittype.FieldName:
+		m.ResetName()
+		return nil
+	case credittype.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case credittype.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case credittype.FieldStripeProductID:
+		m.ResetStripeProductID()
+		return nil
+	case credittype.FieldAnnual:
+		m.ResetAnnual()
+		return nil
+	case credittype.FieldType:
+		m.ResetType()
+		return nil
+	case credittype.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case credittype.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown CreditType field %s", name)
 }
 
-// OldPromptStrength returns the old "prompt_strength" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldPromptStrength(ctx context.Context) (v *float32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromptStrength is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CreditTypeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.credits != nil {
+		edges = append(edges, credittype.EdgeCredits)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromptStrength requires an ID field in the mutation")
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CreditTypeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case credittype.EdgeCredits:
+		ids := make([]ent.Value, 0, len(m.credits))
+		for id := range m.credits {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromptStrength: %w", err)
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CreditTypeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedcredits != nil {
+		edges = append(edges, credittype.EdgeCredits)
 	}
-	return oldValue.PromptStrength, nil
+	return edges
 }
 
-// AddPromptStrength adds f to the "prompt_strength" field.
-func (m *GenerationMutation) AddPromptStrength(f float32) {
-	if m.addprompt_strength != nil {
-		*m.addprompt_strength += f
-	} else {
-		m.addprompt_strength = &f
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CreditTypeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case credittype.EdgeCredits:
+		ids := make([]ent.Value, 0, len(m.removedcredits))
+		for id := range m.removedcredits {
+			ids = append(ids, id)
+		}
+		return ids
 	}
+	return nil
 }
 
-// AddedPromptStrength returns the value that was added to the "prompt_strength" field in this mutation.
-func (m *GenerationMutation) AddedPromptStrength() (r float32, exists bool) {
-	v := m.addprompt_strength
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CreditTypeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcredits {
+		edges = append(edges, credittype.EdgeCredits)
 	}
-	return *v, true
+	return edges
 }
 
-// ClearPromptStrength clears the value of the "prompt_strength" field.
-func (m *GenerationMutation) ClearPromptStrength() {
-	m.prompt_strength = nil
-	m.addprompt_strength = nil
-	m.clearedFields[generation.FieldPromptStrength] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CreditTypeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case credittype.EdgeCredits:
+		return m.clearedcredits
+	}
+	return false
 }
 
-// PromptStrengthCleared returns if the "prompt_strength" field was cleared in this mutation.
-func (m *GenerationMutation) PromptStrengthCleared() bool {
-	_, ok := m.clearedFields[generation.FieldPromptStrength]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CreditTypeMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CreditType unique edge %s", name)
 }
 
-// ResetPromptStrength resets all changes to the "prompt_strength" field.
-func (m *GenerationMutation) ResetPromptStrength() {
-	m.prompt_strength = nil
-	m.addprompt_strength = nil
-	delete(m.clearedFields, generation.FieldPromptStrength)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CreditTypeMutation) ResetEdge(name string) error {
+	switch name {
+	case credittype.EdgeCredits:
+		m.ResetCredits()
+		return nil
+	}
+	return fmt.Errorf("unknown CreditType edge %s", name)
 }
 
-// SetWasAutoSubmitted sets the "was_auto_submitted" field.
-func (m *GenerationMutation) SetWasAutoSubmitted(b bool) {
-	m.was_auto_submitted = &b
+// CronJobRunMutation represents an operation that mutates the CronJobRun nodes in the graph.
+type CronJobRunMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	job_name      *string
+	trigger       *cronjobrun.Trigger
+	status        *cronjobrun.Status
+	instance      *string
+	triggered_by  *uuid.UUID
+	counts        *map[string]int
+	error         *string
+	started_at    *time.Time
+	finished_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*CronJobRun, error)
+	predicates    []predicate.CronJobRun
 }
 
-// WasAutoSubmitted returns the value of the "was_auto_submitted" field in the mutation.
-func (m *GenerationMutation) WasAutoSubmitted() (r bool, exists bool) {
-	v := m.was_auto_submitted
+var _ ent.Mutation = (*CronJobRunMutation)(nil)
+
+// cronjobrunOption allows management of the mutation configuration using functional options.
+type cronjobrunOption func(*CronJobRunMutation)
+
+// newCronJobRunMutation creates new mutation for the CronJobRun entity.
+func newCronJobRunMutation(c config, op Op, opts ...cronjobrunOption) *CronJobRunMutation {
+	m := &CronJobRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCronJobRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCronJobRunID sets the ID field of the mutation.
+func withCronJobRunID(id uuid.UUID) cronjobrunOption {
+	return func(m *CronJobRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CronJobRun
+		)
+		m.oldValue = func(ctx context.Context) (*CronJobRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CronJobRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCronJobRun sets the old CronJobRun of the mutation.
+func withCronJobRun(node *CronJobRun) cronjobrunOption {
+	return func(m *CronJobRunMutation) {
+		m.oldValue = func(context.Context) (*CronJobRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CronJobRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CronJobRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CronJobRun entities.
+func (m *CronJobRunMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CronJobRunMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CronJobRunMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CronJobRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetJobName sets the "job_name" field.
+func (m *CronJobRunMutation) SetJobName(s string) {
+	m.job_name = &s
+}
+
+// JobName returns the value of the "job_name" field in the mutation.
+func (m *CronJobRunMutation) JobName() (r string, exists bool) {
+	v := m.job_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWasAutoSubmitted returns the old "was_auto_submitted" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldJobName returns the old "job_name" field's value of the CronJobRun entity.
+// If the CronJobRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldWasAutoSubmitted(ctx context.Context) (v bool, err error) {
+func (m *CronJobRunMutation) OldJobName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWasAutoSubmitted is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWasAutoSubmitted requires an ID field in the mutation")
+		return v, errors.New("OldJobName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWasAutoSubmitted: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobName: %w", err)
 	}
-	return oldValue.WasAutoSubmitted, nil
+	return oldValue.JobName, nil
 }
 
-// ResetWasAutoSubmitted resets all changes to the "was_auto_submitted" field.
-func (m *GenerationMutation) ResetWasAutoSubmitted() {
-	m.was_auto_submitted = nil
+// ResetJobName resets all changes to the "job_name" field.
+func (m *CronJobRunMutation) ResetJobName() {
+	m.job_name = nil
 }
 
-// SetStripeProductID sets the "stripe_product_id" field.
-func (m *GenerationMutation) SetStripeProductID(s string) {
-	m.stripe_product_id = &s
+// SetTrigger sets the "trigger" field.
+func (m *CronJobRunMutation) SetTrigger(c cronjobrun.Trigger) {
+	m.trigger = &c
 }
 
-// StripeProductID returns the value of the "stripe_product_id" field in the mutation.
-func (m *GenerationMutation) StripeProductID() (r string, exists bool) {
-	v := m.stripe_product_id
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *CronJobRunMutation) Trigger() (r cronjobrun.Trigger, exists bool) {
+	v := m.trigger
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStripeProductID returns the old "stripe_product_id" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldTrigger returns the old "trigger" field's value of the CronJobRun entity.
+// If the CronJobRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldStripeProductID(ctx context.Context) (v *string, err error) {
+func (m *CronJobRunMutation) OldTrigger(ctx context.Context) (v cronjobrun.Trigger, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStripeProductID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStripeProductID requires an ID field in the mutation")
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStripeProductID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
 	}
-	return oldValue.StripeProductID, nil
+	return oldValue.Trigger, nil
 }
 
-// ClearStripeProductID clears the value of the "stripe_product_id" field.
-func (m *GenerationMutation) ClearStripeProductID() {
-	m.stripe_product_id = nil
-	m.clearedFields[generation.FieldStripeProductID] = struct{}{}
-}
-
-// StripeProductIDCleared returns if the "stripe_product_id" field was cleared in this mutation.
-func (m *GenerationMutation) StripeProductIDCleared() bool {
-	_, ok := m.clearedFields[generation.FieldStripeProductID]
-	return ok
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *CronJobRunMutation) ResetTrigger() {
+	m.trigger = nil
 }
 
-// ResetStripeProductID resets all changes to the "stripe_product_id" field.
-func (m *GenerationMutation) ResetStripeProductID() {
-	m.stripe_product_id = nil
-	delete(m.clearedFields, generation.FieldStripeProductID)
-}
-
-// SetSourceType sets the "source_type" field.
-func (m *GenerationMutation) SetSourceType(et enttypes.SourceType) {
-	m.source_type = &et
+// SetStatus sets the "status" field.
+func (m *CronJobRunMutation) SetStatus(c cronjobrun.Status) {
+	m.status = &c
 }
 
-// SourceType returns the value of the "source_type" field in the mutation.
-func (m *GenerationMutation) SourceType() (r enttypes.SourceType, exists bool) {
-	v := m.source_type
+// Status returns the value of the "status" field in the mutation.
+func (m *CronJobRunMutation) Status() (r cronjobrun.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceType returns the old "source_type" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the CronJobRun entity.
+// If the CronJobRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldSourceType(ctx context.Context) (v enttypes.SourceType, err error) {
+func (m *CronJobRunMutation) OldStatus(ctx context.Context) (v cronjobrun.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceType is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceType requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceType: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.SourceType, nil
+	return oldValue.Status, nil
 }
 
-// ResetSourceType resets all changes to the "source_type" field.
-func (m *GenerationMutation) ResetSourceType() {
-	m.source_type = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *CronJobRunMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetWebhookToken sets the "webhook_token" field.
-func (m *GenerationMutation) SetWebhookToken(u uuid.UUID) {
-	m.webhook_token = &u
+// SetInstance sets the "instance" field.
+func (m *CronJobRunMutation) SetInstance(s string) {
+	m.instance = &s
 }
 
-// WebhookToken returns the value of the "webhook_token" field in the mutation.
-func (m *GenerationMutation) WebhookToken() (r uuid.UUID, exists bool) {
-	v := m.webhook_token
+// Instance returns the value of the "instance" field in the mutation.
+func (m *CronJobRunMutation) Instance() (r string, exists bool) {
+	v := m.instance
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWebhookToken returns the old "webhook_token" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldInstance returns the old "instance" field's value of the CronJobRun entity.
+// If the CronJobRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldWebhookToken(ctx context.Context) (v uuid.UUID, err error) {
+func (m *CronJobRunMutation) OldInstance(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWebhookToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldInstance is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWebhookToken requires an ID field in the mutation")
+		return v, errors.New("OldInstance requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWebhookToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldInstance: %w", err)
 	}
-	return oldValue.WebhookToken, nil
+	return oldValue.Instance, nil
 }
 
-// ResetWebhookToken resets all changes to the "webhook_token" field.
-func (m *GenerationMutation) ResetWebhookToken() {
-	m.webhook_token = nil
+// ResetInstance resets all changes to the "instance" field.
+func (m *CronJobRunMutation) ResetInstance() {
+	m.instance = nil
 }
 
-// SetPromptID sets the "prompt_id" field.
-func (m *GenerationMutation) SetPromptID(u uuid.UUID) {
-	m.prompt = &u
+// SetTriggeredBy sets the "triggered_by" field.
+func (m *CronJobRunMutation) SetTriggeredBy(u uuid.UUID) {
+	m.triggered_by = &u
 }
 
-// PromptID returns the value of the "prompt_id" field in the mutation.
-func (m *GenerationMutation) PromptID() (r uuid.UUID, exists bool) {
-	v := m.prompt
+// TriggeredBy returns the value of the "triggered_by" field in the mutation.
+func (m *CronJobRunMutation) TriggeredBy() (r uuid.UUID, exists bool) {
+	v := m.triggered_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPromptID returns the old "prompt_id" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldTriggeredBy returns the old "triggered_by" field's value of the CronJobRun entity.
+// If the CronJobRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldPromptID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *CronJobRunMutation) OldTriggeredBy(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromptID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTriggeredBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromptID requires an ID field in the mutation")
+		return v, errors.New("OldTriggeredBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromptID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTriggeredBy: %w", err)
 	}
-	return oldValue.PromptID, nil
+	return oldValue.TriggeredBy, nil
 }
 
-// ClearPromptID clears the value of the "prompt_id" field.
-func (m *GenerationMutation) ClearPromptID() {
-	m.prompt = nil
-	m.clearedFields[generation.FieldPromptID] = struct{}{}
+// ClearTriggeredBy clears the value of the "triggered_by" field.
+func (m *CronJobRunMutation) ClearTriggeredBy() {
+	m.triggered_by = nil
+	m.clearedFields[cronjobrun.FieldTriggeredBy] = struct{}{}
 }
 
-// PromptIDCleared returns if the "prompt_id" field was cleared in this mutation.
-func (m *GenerationMutation) PromptIDCleared() bool {
-	_, ok := m.clearedFields[generation.FieldPromptID]
+// TriggeredByCleared returns if the "triggered_by" field was cleared in this mutation.
+func (m *CronJobRunMutation) TriggeredByCleared() bool {
+	_, ok := m.clearedFields[cronjobrun.FieldTriggeredBy]
 	return ok
 }
 
-// ResetPromptID resets all changes to the "prompt_id" field.
-func (m *GenerationMutation) ResetPromptID() {
-	m.prompt = nil
-	delete(m.clearedFields, generation.FieldPromptID)
+// ResetTriggeredBy resets all changes to the "triggered_by" field.
+func (m *CronJobRunMutation) ResetTriggeredBy() {
+	m.triggered_by = nil
+	delete(m.clearedFields, cronjobrun.FieldTriggeredBy)
 }
 
-// SetNegativePromptID sets the "negative_prompt_id" field.
-func (m *GenerationMutation) SetNegativePromptID(u uuid.UUID) {
-	m.negative_prompt = &u
+// SetCounts sets the "counts" field.
+func (m *CronJobRunMutation) SetCounts(value map[string]int) {
+	m.counts = &value
 }
 
-// NegativePromptID returns the value of the "negative_prompt_id" field in the mutation.
-func (m *GenerationMutation) NegativePromptID() (r uuid.UUID, exists bool) {
-	v := m.negative_prompt
+// Counts returns the value of the "counts" field in the mutation.
+func (m *CronJobRunMutation) Counts() (r map[string]int, exists bool) {
+	v := m.counts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNegativePromptID returns the old "negative_prompt_id" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldCounts returns the old "counts" field's value of the CronJobRun entity.
+// If the CronJobRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldNegativePromptID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *CronJobRunMutation) OldCounts(ctx context.Context) (v map[string]int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNegativePromptID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCounts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNegativePromptID requires an ID field in the mutation")
+		return v, errors.New("OldCounts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNegativePromptID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCounts: %w", err)
 	}
-	return oldValue.NegativePromptID, nil
+	return oldValue.Counts, nil
 }
 
-// ClearNegativePromptID clears the value of the "negative_prompt_id" field.
-func (m *GenerationMutation) ClearNegativePromptID() {
-	m.negative_prompt = nil
-	m.clearedFields[generation.FieldNegativePromptID] = struct{}{}
+// ClearCounts clears the value of the "counts" field.
+func (m *CronJobRunMutation) ClearCounts() {
+	m.counts = nil
+	m.clearedFields[cronjobrun.FieldCounts] = struct{}{}
 }
 
-// NegativePromptIDCleared returns if the "negative_prompt_id" field was cleared in this mutation.
-func (m *GenerationMutation) NegativePromptIDCleared() bool {
-	_, ok := m.clearedFields[generation.FieldNegativePromptID]
+// CountsCleared returns if the "counts" field was cleared in this mutation.
+func (m *CronJobRunMutation) CountsCleared() bool {
+	_, ok := m.clearedFields[cronjobrun.FieldCounts]
 	return ok
 }
 
-// ResetNegativePromptID resets all changes to the "negative_prompt_id" field.
-func (m *GenerationMutation) ResetNegativePromptID() {
-	m.negative_prompt = nil
-	delete(m.clearedFields, generation.FieldNegativePromptID)
+// ResetCounts resets all changes to the "counts" field.
+func (m *CronJobRunMutation) ResetCounts() {
+	m.counts = nil
+	delete(m.clearedFields, cronjobrun.FieldCounts)
 }
 
-// SetModelID sets the "model_id" field.
-func (m *GenerationMutation) SetModelID(u uuid.UUID) {
-	m.generation_model = &u
+// SetError sets the "error" field.
+func (m *CronJobRunMutation) SetError(s string) {
+	m.error = &s
 }
 
-// ModelID returns the value of the "model_id" field in the mutation.
-func (m *GenerationMutation) ModelID() (r uuid.UUID, exists bool) {
-	v := m.generation_model
+// Error returns the value of the "error" field in the mutation.
+func (m *CronJobRunMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelID returns the old "model_id" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the CronJobRun entity.
+// If the CronJobRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldModelID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *CronJobRunMutation) OldError(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelID requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.ModelID, nil
+	return oldValue.Error, nil
 }
 
-// ResetModelID resets all changes to the "model_id" field.
-func (m *GenerationMutation) ResetModelID() {
-	m.generation_model = nil
+// ClearError clears the value of the "error" field.
+func (m *CronJobRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[cronjobrun.FieldError] = struct{}{}
 }
 
-// SetSchedulerID sets the "scheduler_id" field.
-func (m *GenerationMutation) SetSchedulerID(u uuid.UUID) {
-	m.scheduler = &u
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *CronJobRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[cronjobrun.FieldError]
+	return ok
 }
 
-// SchedulerID returns the value of the "scheduler_id" field in the mutation.
-func (m *GenerationMutation) SchedulerID() (r uuid.UUID, exists bool) {
-	v := m.scheduler
+// ResetError resets all changes to the "error" field.
+func (m *CronJobRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, cronjobrun.FieldError)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *CronJobRunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *CronJobRunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSchedulerID returns the old "scheduler_id" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the CronJobRun entity.
+// If the CronJobRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldSchedulerID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *CronJobRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSchedulerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSchedulerID requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSchedulerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.SchedulerID, nil
+	return oldValue.StartedAt, nil
 }
 
-// ResetSchedulerID resets all changes to the "scheduler_id" field.
-func (m *GenerationMutation) ResetSchedulerID() {
-	m.scheduler = nil
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *CronJobRunMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *GenerationMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// SetFinishedAt sets the "finished_at" field.
+func (m *CronJobRunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *GenerationMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *CronJobRunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldFinishedAt returns the old "finished_at" field's value of the CronJobRun entity.
+// If the CronJobRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *CronJobRunMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.FinishedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *GenerationMutation) ResetUserID() {
-	m.user = nil
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *CronJobRunMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[cronjobrun.FieldFinishedAt] = struct{}{}
 }
 
-// SetDeviceInfoID sets the "device_info_id" field.
-func (m *GenerationMutation) SetDeviceInfoID(u uuid.UUID) {
-	m.device_info = &u
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *CronJobRunMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[cronjobrun.FieldFinishedAt]
+	return ok
 }
 
-// DeviceInfoID returns the value of the "device_info_id" field in the mutation.
-func (m *GenerationMutation) DeviceInfoID() (r uuid.UUID, exists bool) {
-	v := m.device_info
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *CronJobRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, cronjobrun.FieldFinishedAt)
 }
 
-// OldDeviceInfoID returns the old "device_info_id" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldDeviceInfoID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceInfoID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceInfoID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceInfoID: %w", err)
+// Where appends a list predicates to the CronJobRunMutation builder.
+func (m *CronJobRunMutation) Where(ps ...predicate.CronJobRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CronJobRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CronJobRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CronJobRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.DeviceInfoID, nil
+	m.Where(p...)
 }
 
-// ResetDeviceInfoID resets all changes to the "device_info_id" field.
-func (m *GenerationMutation) ResetDeviceInfoID() {
-	m.device_info = nil
+// Op returns the operation name.
+func (m *CronJobRunMutation) Op() Op {
+	return m.op
 }
 
-// SetAPITokenID sets the "api_token_id" field.
-func (m *GenerationMutation) SetAPITokenID(u uuid.UUID) {
-	m.api_tokens = &u
+// SetOp allows setting the mutation operation.
+func (m *CronJobRunMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// APITokenID returns the value of the "api_token_id" field in the mutation.
-func (m *GenerationMutation) APITokenID() (r uuid.UUID, exists bool) {
-	v := m.api_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (CronJobRun).
+func (m *CronJobRunMutation) Type() string {
+	return m.typ
 }
 
-// OldAPITokenID returns the old "api_token_id" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldAPITokenID(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPITokenID is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CronJobRunMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.job_name != nil {
+		fields = append(fields, cronjobrun.FieldJobName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPITokenID requires an ID field in the mutation")
+	if m.trigger != nil {
+		fields = append(fields, cronjobrun.FieldTrigger)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPITokenID: %w", err)
+	if m.status != nil {
+		fields = append(fields, cronjobrun.FieldStatus)
 	}
-	return oldValue.APITokenID, nil
+	if m.instance != nil {
+		fields = append(fields, cronjobrun.FieldInstance)
+	}
+	if m.triggered_by != nil {
+		fields = append(fields, cronjobrun.FieldTriggeredBy)
+	}
+	if m.counts != nil {
+		fields = append(fields, cronjobrun.FieldCounts)
+	}
+	if m.error != nil {
+		fields = append(fields, cronjobrun.FieldError)
+	}
+	if m.started_at != nil {
+		fields = append(fields, cronjobrun.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, cronjobrun.FieldFinishedAt)
+	}
+	return fields
 }
 
-// ClearAPITokenID clears the value of the "api_token_id" field.
-func (m *GenerationMutation) ClearAPITokenID() {
-	m.api_tokens = nil
-	m.clearedFields[generation.FieldAPITokenID] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CronJobRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case cronjobrun.FieldJobName:
+		return m.JobName()
+	case cronjobrun.FieldTrigger:
+		return m.Trigger()
+	case cronjobrun.FieldStatus:
+		return m.Status()
+	case cronjobrun.FieldInstance:
+		return m.Instance()
+	case cronjobrun.FieldTriggeredBy:
+		return m.TriggeredBy()
+	case cronjobrun.FieldCounts:
+		return m.Counts()
+	case cronjobrun.FieldError:
+		return m.Error()
+	case cronjobrun.FieldStartedAt:
+		return m.StartedAt()
+	case cronjobrun.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
 }
 
-// APITokenIDCleared returns if the "api_token_id" field was cleared in this mutation.
-func (m *GenerationMutation) APITokenIDCleared() bool {
-	_, ok := m.clearedFields[generation.FieldAPITokenID]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CronJobRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case cronjobrun.FieldJobName:
+		return m.OldJobName(ctx)
+	case cronjobrun.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case cronjobrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case cronjobrun.FieldInstance:
+		return m.OldInstance(ctx)
+	case cronjobrun.FieldTriggeredBy:
+		return m.OldTriggeredBy(ctx)
+	case cronjobrun.FieldCounts:
+		return m.OldCounts(ctx)
+	case cronjobrun.FieldError:
+		return m.OldError(ctx)
+	case cronjobrun.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case cronjobrun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CronJobRun field %s", name)
 }
 
-// ResetAPITokenID resets all changes to the "api_token_id" field.
-func (m *GenerationMutation) ResetAPITokenID() {
-	m.api_tokens = nil
-	delete(m.clearedFields, generation.FieldAPITokenID)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CronJobRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case cronjobrun.FieldJobName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobName(v)
+		return nil
+	case cronjobrun.FieldTrigger:
+		v, ok := value.(cronjobrun.Trigger)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case cronjobrun.FieldStatus:
+		v, ok := value.(cronjobrun.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case cronjobrun.FieldInstance:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstance(v)
+		return nil
+	case cronjobrun.FieldTriggeredBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggeredBy(v)
+		return nil
+	case cronjobrun.FieldCounts:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCounts(v)
+		return nil
+	case cronjobrun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case cronjobrun.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case cronjobrun.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CronJobRun field %s", name)
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *GenerationMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CronJobRunMutation) AddedFields() []string {
+	return nil
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *GenerationMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CronJobRunMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CronJobRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown CronJobRun numeric field %s", name)
 }
 
-// OldStartedAt returns the old "started_at" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CronJobRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(cronjobrun.FieldTriggeredBy) {
+		fields = append(fields, cronjobrun.FieldTriggeredBy)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	if m.FieldCleared(cronjobrun.FieldCounts) {
+		fields = append(fields, cronjobrun.FieldCounts)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	if m.FieldCleared(cronjobrun.FieldError) {
+		fields = append(fields, cronjobrun.FieldError)
 	}
-	return oldValue.StartedAt, nil
+	if m.FieldCleared(cronjobrun.FieldFinishedAt) {
+		fields = append(fields, cronjobrun.FieldFinishedAt)
+	}
+	return fields
 }
 
-// ClearStartedAt clears the value of the "started_at" field.
-func (m *GenerationMutation) ClearStartedAt() {
-	m.started_at = nil
-	m.clearedFields[generation.FieldStartedAt] = struct{}{}
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CronJobRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
-func (m *GenerationMutation) StartedAtCleared() bool {
-	_, ok := m.clearedFields[generation.FieldStartedAt]
-	return ok
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CronJobRunMutation) ClearField(name string) error {
+	switch name {
+	case cronjobrun.FieldTriggeredBy:
+		m.ClearTriggeredBy()
+		return nil
+	case cronjobrun.FieldCounts:
+		m.ClearCounts()
+		return nil
+	case cronjobrun.FieldError:
+		m.ClearError()
+		return nil
+	case cronjobrun.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CronJobRun nullable field %s", name)
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *GenerationMutation) ResetStartedAt() {
-	m.started_at = nil
-	delete(m.clearedFields, generation.FieldStartedAt)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CronJobRunMutation) ResetField(name string) error {
+	switch name {
+	case cronjobrun.FieldJobName:
+		m.ResetJobName()
+		return nil
+	case cronjobrun.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case cronjobrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case cronjobrun.FieldInstance:
+		m.ResetInstance()
+		return nil
+	case cronjobrun.FieldTriggeredBy:
+		m.ResetTriggeredBy()
+		return nil
+	case cronjobrun.FieldCounts:
+		m.ResetCounts()
+		return nil
+	case cronjobrun.FieldError:
+		m.ResetError()
+		return nil
+	case cronjobrun.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case cronjobrun.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CronJobRun field %s", name)
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *GenerationMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CronJobRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *GenerationMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CronJobRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
-	}
-	return oldValue.CompletedAt, nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CronJobRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *GenerationMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[generation.FieldCompletedAt] = struct{}{}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CronJobRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *GenerationMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[generation.FieldCompletedAt]
-	return ok
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CronJobRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *GenerationMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, generation.FieldCompletedAt)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CronJobRunMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *GenerationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CronJobRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CronJobRun unique edge %s", name)
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GenerationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CronJobRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CronJobRun edge %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// DeadLetterMutation represents an operation that mutates the DeadLetter nodes in the graph.
+type DeadLetterMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	job_id         *uuid.UUID
+	process_type   *string
+	user_id        *uuid.UUID
+	payload        *string
+	failure_reason *string
+	replayed_at    *time.Time
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*DeadLetter, error)
+	predicates     []predicate.DeadLetter
+}
+
+var _ ent.Mutation = (*DeadLetterMutation)(nil)
+
+// deadletterOption allows management of the mutation configuration using functional options.
+type deadletterOption func(*DeadLetterMutation)
+
+// newDeadLetterMutation creates new mutation for the DeadLetter entity.
+func newDeadLetterMutation(c config, op Op, opts ...deadletterOption) *DeadLetterMutation {
+	m := &DeadLetterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeadLetter,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.CreatedAt, nil
+	return m
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GenerationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// withDeadLetterID sets the ID field of the mutation.
+func withDeadLetterID(id uuid.UUID) deadletterOption {
+	return func(m *DeadLetterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeadLetter
+		)
+		m.oldValue = func(ctx context.Context) (*DeadLetter, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeadLetter.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *GenerationMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// withDeadLetter sets the old DeadLetter of the mutation.
+func withDeadLetter(node *DeadLetter) deadletterOption {
+	return func(m *DeadLetterMutation) {
+		m.oldValue = func(context.Context) (*DeadLetter, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GenerationMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeadLetterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeadLetterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeadLetter entities.
+func (m *DeadLetterMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeadLetterMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeadLetterMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeadLetter.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetJobID sets the "job_id" field.
+func (m *DeadLetterMutation) SetJobID(u uuid.UUID) {
+	m.job_id = &u
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *DeadLetterMutation) JobID() (r uuid.UUID, exists bool) {
+	v := m.job_id
+	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Generation entity.
-// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// OldJobID returns the old "job_id" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeadLetterMutation) OldJobID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldJobID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.JobID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GenerationMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetJobID resets all changes to the "job_id" field.
+func (m *DeadLetterMutation) ResetJobID() {
+	m.job_id = nil
 }
 
-// ClearDeviceInfo clears the "device_info" edge to the DeviceInfo entity.
-func (m *GenerationMutation) ClearDeviceInfo() {
-	m.cleareddevice_info = true
-	m.clearedFields[generation.FieldDeviceInfoID] = struct{}{}
+// SetProcessType sets the "process_type" field.
+func (m *DeadLetterMutation) SetProcessType(s string) {
+	m.process_type = &s
 }
 
-// DeviceInfoCleared reports if the "device_info" edge to the DeviceInfo entity was cleared.
-func (m *GenerationMutation) DeviceInfoCleared() bool {
-	return m.cleareddevice_info
+// ProcessType returns the value of the "process_type" field in the mutation.
+func (m *DeadLetterMutation) ProcessType() (r string, exists bool) {
+	v := m.process_type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// DeviceInfoIDs returns the "device_info" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DeviceInfoID instead. It exists only for internal usage by the builders.
-func (m *GenerationMutation) DeviceInfoIDs() (ids []uuid.UUID) {
-	if id := m.device_info; id != nil {
-		ids = append(ids, *id)
+// OldProcessType returns the old "process_type" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldProcessType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessType is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessType: %w", err)
+	}
+	return oldValue.ProcessType, nil
 }
 
-// ResetDeviceInfo resets all changes to the "device_info" edge.
-func (m *GenerationMutation) ResetDeviceInfo() {
-	m.device_info = nil
-	m.cleareddevice_info = false
+// ResetProcessType resets all changes to the "process_type" field.
+func (m *DeadLetterMutation) ResetProcessType() {
+	m.process_type = nil
 }
 
-// ClearScheduler clears the "scheduler" edge to the Scheduler entity.
-func (m *GenerationMutation) ClearScheduler() {
-	m.clearedscheduler = true
-	m.clearedFields[generation.FieldSchedulerID] = struct{}{}
+// SetUserID sets the "user_id" field.
+func (m *DeadLetterMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// SchedulerCleared reports if the "scheduler" edge to the Scheduler entity was cleared.
-func (m *GenerationMutation) SchedulerCleared() bool {
-	return m.clearedscheduler
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *DeadLetterMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SchedulerIDs returns the "scheduler" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SchedulerID instead. It exists only for internal usage by the builders.
-func (m *GenerationMutation) SchedulerIDs() (ids []uuid.UUID) {
-	if id := m.scheduler; id != nil {
-		ids = append(ids, *id)
+// OldUserID returns the old "user_id" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldUserID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// ResetScheduler resets all changes to the "scheduler" edge.
-func (m *GenerationMutation) ResetScheduler() {
-	m.scheduler = nil
-	m.clearedscheduler = false
+// ClearUserID clears the value of the "user_id" field.
+func (m *DeadLetterMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[deadletter.FieldUserID] = struct{}{}
 }
 
-// ClearPrompt clears the "prompt" edge to the Prompt entity.
-func (m *GenerationMutation) ClearPrompt() {
-	m.clearedprompt = true
-	m.clearedFields[generation.FieldPromptID] = struct{}{}
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *DeadLetterMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[deadletter.FieldUserID]
+	return ok
 }
 
-// PromptCleared reports if the "prompt" edge to the Prompt entity was cleared.
-func (m *GenerationMutation) PromptCleared() bool {
-	return m.PromptIDCleared() || m.clearedprompt
+// ResetUserID resets all changes to the "user_id" field.
+func (m *DeadLetterMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, deadletter.FieldUserID)
 }
 
-// PromptIDs returns the "prompt" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PromptID instead. It exists only for internal usage by the builders.
-func (m *GenerationMutation) PromptIDs() (ids []uuid.UUID) {
-	if id := m.prompt; id != nil {
-		ids = append(ids, *id)
+// SetPayload sets the "payload" field.
+func (m *DeadLetterMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *DeadLetterMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetPrompt resets all changes to the "prompt" edge.
-func (m *GenerationMutation) ResetPrompt() {
-	m.prompt = nil
-	m.clearedprompt = false
+// OldPayload returns the old "payload" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
 }
 
-// ClearNegativePrompt clears the "negative_prompt" edge to the NegativePrompt entity.
-func (m *GenerationMutation) ClearNegativePrompt() {
-	m.clearednegative_prompt = true
-	m.clearedFields[generation.FieldNegativePromptID] = struct{}{}
+// ResetPayload resets all changes to the "payload" field.
+func (m *DeadLetterMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// NegativePromptCleared reports if the "negative_prompt" edge to the NegativePrompt entity was cleared.
-func (m *GenerationMutation) NegativePromptCleared() bool {
-	return m.NegativePromptIDCleared() || m.clearednegative_prompt
+// SetFailureReason sets the "failure_reason" field.
+func (m *DeadLetterMutation) SetFailureReason(s string) {
+	m.failure_reason = &s
 }
 
-// NegativePromptIDs returns the "negative_prompt" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// NegativePromptID instead. It exists only for internal usage by the builders.
-func (m *GenerationMutation) NegativePromptIDs() (ids []uuid.UUID) {
-	if id := m.negative_prompt; id != nil {
-		ids = append(ids, *id)
+// FailureReason returns the value of the "failure_reason" field in the mutation.
+func (m *DeadLetterMutation) FailureReason() (r string, exists bool) {
+	v := m.failure_reason
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetNegativePrompt resets all changes to the "negative_prompt" edge.
-func (m *GenerationMutation) ResetNegativePrompt() {
-	m.negative_prompt = nil
-	m.clearednegative_prompt = false
+// OldFailureReason returns the old "failure_reason" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldFailureReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailureReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailureReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailureReason: %w", err)
+	}
+	return oldValue.FailureReason, nil
 }
 
-// SetGenerationModelID sets the "generation_model" edge to the GenerationModel entity by id.
-func (m *GenerationMutation) SetGenerationModelID(id uuid.UUID) {
-	m.generation_model = &id
+// ResetFailureReason resets all changes to the "failure_reason" field.
+func (m *DeadLetterMutation) ResetFailureReason() {
+	m.failure_reason = nil
 }
 
-// ClearGenerationModel clears the "generation_model" edge to the GenerationModel entity.
-func (m *GenerationMutation) ClearGenerationModel() {
-	m.clearedgeneration_model = true
-	m.clearedFields[generation.FieldModelID] = struct{}{}
+// SetReplayedAt sets the "replayed_at" field.
+func (m *DeadLetterMutation) SetReplayedAt(t time.Time) {
+	m.replayed_at = &t
 }
 
-// GenerationModelCleared reports if the "generation_model" edge to the GenerationModel entity was cleared.
-func (m *GenerationMutation) GenerationModelCleared() bool {
-	return m.clearedgeneration_model
+// ReplayedAt returns the value of the "replayed_at" field in the mutation.
+func (m *DeadLetterMutation) ReplayedAt() (r time.Time, exists bool) {
+	v := m.replayed_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// GenerationModelID returns the "generation_model" edge ID in the mutation.
-func (m *GenerationMutation) GenerationModelID() (id uuid.UUID, exists bool) {
-	if m.generation_model != nil {
-		return *m.generation_model, true
+// OldReplayedAt returns the old "replayed_at" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldReplayedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplayedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplayedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplayedAt: %w", err)
+	}
+	return oldValue.ReplayedAt, nil
 }
 
-// GenerationModelIDs returns the "generation_model" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GenerationModelID instead. It exists only for internal usage by the builders.
-func (m *GenerationMutation) GenerationModelIDs() (ids []uuid.UUID) {
-	if id := m.generation_model; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearReplayedAt clears the value of the "replayed_at" field.
+func (m *DeadLetterMutation) ClearReplayedAt() {
+	m.replayed_at = nil
+	m.clearedFields[deadletter.FieldReplayedAt] = struct{}{}
 }
 
-// ResetGenerationModel resets all changes to the "generation_model" edge.
-func (m *GenerationMutation) ResetGenerationModel() {
-	m.generation_model = nil
-	m.clearedgeneration_model = false
+// ReplayedAtCleared returns if the "replayed_at" field was cleared in this mutation.
+func (m *DeadLetterMutation) ReplayedAtCleared() bool {
+	_, ok := m.clearedFields[deadletter.FieldReplayedAt]
+	return ok
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *GenerationMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[generation.FieldUserID] = struct{}{}
+// ResetReplayedAt resets all changes to the "replayed_at" field.
+func (m *DeadLetterMutation) ResetReplayedAt() {
+	m.replayed_at = nil
+	delete(m.clearedFields, deadletter.FieldReplayedAt)
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *GenerationMutation) UserCleared() bool {
-	return m.cleareduser
+// SetCreatedAt sets the "created_at" field.
+func (m *DeadLetterMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *GenerationMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DeadLetterMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *GenerationMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// SetAPITokensID sets the "api_tokens" edge to the ApiToken entity by id.
-func (m *GenerationMutation) SetAPITokensID(id uuid.UUID) {
-	m.api_tokens = &id
-}
-
-// ClearAPITokens clears the "api_tokens" edge to the ApiToken entity.
-func (m *GenerationMutation) ClearAPITokens() {
-	m.clearedapi_tokens = true
-	m.clearedFields[generation.FieldAPITokenID] = struct{}{}
-}
-
-// APITokensCleared reports if the "api_tokens" edge to the ApiToken entity was cleared.
-func (m *GenerationMutation) APITokensCleared() bool {
-	return m.APITokenIDCleared() || m.clearedapi_tokens
-}
-
-// APITokensID returns the "api_tokens" edge ID in the mutation.
-func (m *GenerationMutation) APITokensID() (id uuid.UUID, exists bool) {
-	if m.api_tokens != nil {
-		return *m.api_tokens, true
-	}
-	return
-}
-
-// APITokensIDs returns the "api_tokens" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// APITokensID instead. It exists only for internal usage by the builders.
-func (m *GenerationMutation) APITokensIDs() (ids []uuid.UUID) {
-	if id := m.api_tokens; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetAPITokens resets all changes to the "api_tokens" edge.
-func (m *GenerationMutation) ResetAPITokens() {
-	m.api_tokens = nil
-	m.clearedapi_tokens = false
-}
-
-// AddGenerationOutputIDs adds the "generation_outputs" edge to the GenerationOutput entity by ids.
-func (m *GenerationMutation) AddGenerationOutputIDs(ids ...uuid.UUID) {
-	if m.generation_outputs == nil {
-		m.generation_outputs = make(map[uuid.UUID]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.generation_outputs[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearGenerationOutputs clears the "generation_outputs" edge to the GenerationOutput entity.
-func (m *GenerationMutation) ClearGenerationOutputs() {
-	m.clearedgeneration_outputs = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DeadLetterMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// GenerationOutputsCleared reports if the "generation_outputs" edge to the GenerationOutput entity was cleared.
-func (m *GenerationMutation) GenerationOutputsCleared() bool {
-	return m.clearedgeneration_outputs
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DeadLetterMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemoveGenerationOutputIDs removes the "generation_outputs" edge to the GenerationOutput entity by IDs.
-func (m *GenerationMutation) RemoveGenerationOutputIDs(ids ...uuid.UUID) {
-	if m.removedgeneration_outputs == nil {
-		m.removedgeneration_outputs = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.generation_outputs, ids[i])
-		m.removedgeneration_outputs[ids[i]] = struct{}{}
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DeadLetterMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedGenerationOutputs returns the removed IDs of the "generation_outputs" edge to the GenerationOutput entity.
-func (m *GenerationMutation) RemovedGenerationOutputsIDs() (ids []uuid.UUID) {
-	for id := range m.removedgeneration_outputs {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// GenerationOutputsIDs returns the "generation_outputs" edge IDs in the mutation.
-func (m *GenerationMutation) GenerationOutputsIDs() (ids []uuid.UUID) {
-	for id := range m.generation_outputs {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetGenerationOutputs resets all changes to the "generation_outputs" edge.
-func (m *GenerationMutation) ResetGenerationOutputs() {
-	m.generation_outputs = nil
-	m.clearedgeneration_outputs = false
-	m.removedgeneration_outputs = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DeadLetterMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the GenerationMutation builder.
-func (m *GenerationMutation) Where(ps ...predicate.Generation) {
+// Where appends a list predicates to the DeadLetterMutation builder.
+func (m *DeadLetterMutation) Where(ps ...predicate.DeadLetter) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GenerationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DeadLetterMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GenerationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Generation, len(ps))
+func (m *DeadLetterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeadLetter, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7494,108 +8821,48 @@ func (m *GenerationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GenerationMutation) Op() Op {
+func (m *DeadLetterMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GenerationMutation) SetOp(op Op) {
+func (m *DeadLetterMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Generation).
-func (m *GenerationMutation) Type() string {
+// Type returns the node type of this mutation (DeadLetter).
+func (m *DeadLetterMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GenerationMutation) Fields() []string {
-	fields := make([]string, 0, 28)
-	if m.width != nil {
-		fields = append(fields, generation.FieldWidth)
-	}
-	if m.height != nil {
-		fields = append(fields, generation.FieldHeight)
-	}
-	if m.inference_steps != nil {
-		fields = append(fields, generation.FieldInferenceSteps)
-	}
-	if m.guidance_scale != nil {
-		fields = append(fields, generation.FieldGuidanceScale)
-	}
-	if m.num_outputs != nil {
-		fields = append(fields, generation.FieldNumOutputs)
+func (m *DeadLetterMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.job_id != nil {
+		fields = append(fields, deadletter.FieldJobID)
 	}
-	if m.nsfw_count != nil {
-		fields = append(fields, generation.FieldNsfwCount)
+	if m.process_type != nil {
+		fields = append(fields, deadletter.FieldProcessType)
 	}
-	if m.seed != nil {
-		fields = append(fields, generation.FieldSeed)
+	if m.user_id != nil {
+		fields = append(fields, deadletter.FieldUserID)
 	}
-	if m.status != nil {
-		fields = append(fields, generation.FieldStatus)
+	if m.payload != nil {
+		fields = append(fields, deadletter.FieldPayload)
 	}
 	if m.failure_reason != nil {
-		fields = append(fields, generation.FieldFailureReason)
-	}
-	if m.country_code != nil {
-		fields = append(fields, generation.FieldCountryCode)
-	}
-	if m.init_image_url != nil {
-		fields = append(fields, generation.FieldInitImageURL)
-	}
-	if m.mask_image_url != nil {
-		fields = append(fields, generation.FieldMaskImageURL)
-	}
-	if m.prompt_strength != nil {
-		fields = append(fields, generation.FieldPromptStrength)
-	}
-	if m.was_auto_submitted != nil {
-		fields = append(fields, generation.FieldWasAutoSubmitted)
-	}
-	if m.stripe_product_id != nil {
-		fields = append(fields, generation.FieldStripeProductID)
-	}
-	if m.source_type != nil {
-		fields = append(fields, generation.FieldSourceType)
-	}
-	if m.webhook_token != nil {
-		fields = append(fields, generation.FieldWebhookToken)
-	}
-	if m.prompt != nil {
-		fields = append(fields, generation.FieldPromptID)
-	}
-	if m.negative_prompt != nil {
-		fields = append(fields, generation.FieldNegativePromptID)
-	}
-	if m.generation_model != nil {
-		fields = append(fields, generation.FieldModelID)
+		fields = append(fields, deadletter.FieldFailureReason)
 	}
-	if m.scheduler != nil {
-		fields = append(fields, generation.FieldSchedulerID)
-	}
-	if m.user != nil {
-		fields = append(fields, generation.FieldUserID)
-	}
-	if m.device_info != nil {
-		fields = append(fields, generation.FieldDeviceInfoID)
-	}
-	if m.api_tokens != nil {
-		fields = append(fields, generation.FieldAPITokenID)
-	}
-	if m.started_at != nil {
-		fields = append(fields, generation.FieldStartedAt)
-	}
-	if m.completed_at != nil {
-		fields = append(fields, generation.FieldCompletedAt)
+	if m.replayed_at != nil {
+		fields = append(fields, deadletter.FieldReplayedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, generation.FieldCreatedAt)
+		fields = append(fields, deadletter.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, generation.FieldUpdatedAt)
+		fields = append(fields, deadletter.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -7603,63 +8870,23 @@ func (m *GenerationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GenerationMutation) Field(name string) (ent.Value, bool) {
+func (m *DeadLetterMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case generation.FieldWidth:
-		return m.Width()
-	case generation.FieldHeight:
-		return m.Height()
-	case generation.FieldInferenceSteps:
-		return m.InferenceSteps()
-	case generation.FieldGuidanceScale:
-		return m.GuidanceScale()
-	case generation.FieldNumOutputs:
-		return m.NumOutputs()
-	case generation.FieldNsfwCount:
-		return m.NsfwCount()
-	case generation.FieldSeed:
-		return m.Seed()
-	case generation.FieldStatus:
-		return m.Status()
-	case generation.FieldFailureReason:
-		return m.FailureReason()
-	case generation.FieldCountryCode:
-		return m.CountryCode()
-	case generation.FieldInitImageURL:
-		return m.InitImageURL()
-	case generation.FieldMaskImageURL:
-		return m.MaskImageURL()
-	case generation.FieldPromptStrength:
-		return m.PromptStrength()
-	case generation.FieldWasAutoSubmitted:
-		return m.WasAutoSubmitted()
-	case generation.FieldStripeProductID:
-		return m.StripeProductID()
-	case generation.FieldSourceType:
-		return m.SourceType()
-	case generation.FieldWebhookToken:
-		return m.WebhookToken()
-	case generation.FieldPromptID:
-		return m.PromptID()
-	case generation.FieldNegativePromptID:
-		return m.NegativePromptID()
-	case generation.FieldModelID:
-		return m.ModelID()
-	case generation.FieldSchedulerID:
-		return m.SchedulerID()
-	case generation.FieldUserID:
+	case deadletter.FieldJobID:
+		return m.JobID()
+	case deadletter.FieldProcessType:
+		return m.ProcessType()
+	case deadletter.FieldUserID:
 		return m.UserID()
-	case generation.FieldDeviceInfoID:
-		return m.DeviceInfoID()
-	case generation.FieldAPITokenID:
-		return m.APITokenID()
-	case generation.FieldStartedAt:
-		return m.StartedAt()
-	case generation.FieldCompletedAt:
-		return m.CompletedAt()
-	case generation.FieldCreatedAt:
+	case deadletter.FieldPayload:
+		return m.Payload()
+	case deadletter.FieldFailureReason:
+		return m.FailureReason()
+	case deadletter.FieldReplayedAt:
+		return m.ReplayedAt()
+	case deadletter.FieldCreatedAt:
 		return m.CreatedAt()
-	case generation.FieldUpdatedAt:
+	case deadletter.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -7668,263 +8895,83 @@ func (m *GenerationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GenerationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DeadLetterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case generation.FieldWidth:
-		return m.OldWidth(ctx)
-	case generation.FieldHeight:
-		return m.OldHeight(ctx)
-	case generation.FieldInferenceSteps:
-		return m.OldInferenceSteps(ctx)
-	case generation.FieldGuidanceScale:
-		return m.OldGuidanceScale(ctx)
-	case generation.FieldNumOutputs:
-		return m.OldNumOutputs(ctx)
-	case generation.FieldNsfwCount:
-		return m.OldNsfwCount(ctx)
-	case generation.FieldSeed:
-		return m.OldSeed(ctx)
-	case generation.FieldStatus:
-		return m.OldStatus(ctx)
-	case generation.FieldFailureReason:
-		return m.OldFailureReason(ctx)
-	case generation.FieldCountryCode:
-		return m.OldCountryCode(ctx)
-	case generation.FieldInitImageURL:
-		return m.OldInitImageURL(ctx)
-	case generation.FieldMaskImageURL:
-		return m.OldMaskImageURL(ctx)
-	case generation.FieldPromptStrength:
-		return m.OldPromptStrength(ctx)
-	case generation.FieldWasAutoSubmitted:
-		return m.OldWasAutoSubmitted(ctx)
-	case generation.FieldStripeProductID:
-		return m.OldStripeProductID(ctx)
-	case generation.FieldSourceType:
-		return m.OldSourceType(ctx)
-	case generation.FieldWebhookToken:
-		return m.OldWebhookToken(ctx)
-	case generation.FieldPromptID:
-		return m.OldPromptID(ctx)
-	case generation.FieldNegativePromptID:
-		return m.OldNegativePromptID(ctx)
-	case generation.FieldModelID:
-		return m.OldModelID(ctx)
-	case generation.FieldSchedulerID:
-		return m.OldSchedulerID(ctx)
-	case generation.FieldUserID:
+	case deadletter.FieldJobID:
+		return m.OldJobID(ctx)
+	case deadletter.FieldProcessType:
+		return m.OldProcessType(ctx)
+	case deadletter.FieldUserID:
 		return m.OldUserID(ctx)
-	case generation.FieldDeviceInfoID:
-		return m.OldDeviceInfoID(ctx)
-	case generation.FieldAPITokenID:
-		return m.OldAPITokenID(ctx)
-	case generation.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case generation.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case generation.FieldCreatedAt:
+	case deadletter.FieldPayload:
+		return m.OldPayload(ctx)
+	case deadletter.FieldFailureReason:
+		return m.OldFailureReason(ctx)
+	case deadletter.FieldReplayedAt:
+		return m.OldReplayedAt(ctx)
+	case deadletter.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case generation.FieldUpdatedAt:
+	case deadletter.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Generation field %s", name)
+	return nil, fmt.Errorf("unknown DeadLetter field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GenerationMutation) SetField(name string, value ent.Value) error {
+func (m *DeadLetterMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case generation.FieldWidth:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWidth(v)
-		return nil
-	case generation.FieldHeight:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHeight(v)
-		return nil
-	case generation.FieldInferenceSteps:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInferenceSteps(v)
-		return nil
-	case generation.FieldGuidanceScale:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGuidanceScale(v)
-		return nil
-	case generation.FieldNumOutputs:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNumOutputs(v)
-		return nil
-	case generation.FieldNsfwCount:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNsfwCount(v)
-		return nil
-	case generation.FieldSeed:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSeed(v)
-		return nil
-	case generation.FieldStatus:
-		v, ok := value.(generation.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case generation.FieldFailureReason:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFailureReason(v)
-		return nil
-	case generation.FieldCountryCode:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCountryCode(v)
-		return nil
-	case generation.FieldInitImageURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInitImageURL(v)
-		return nil
-	case generation.FieldMaskImageURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMaskImageURL(v)
-		return nil
-	case generation.FieldPromptStrength:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPromptStrength(v)
-		return nil
-	case generation.FieldWasAutoSubmitted:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWasAutoSubmitted(v)
-		return nil
-	case generation.FieldStripeProductID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStripeProductID(v)
-		return nil
-	case generation.FieldSourceType:
-		v, ok := value.(enttypes.SourceType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSourceType(v)
-		return nil
-	case generation.FieldWebhookToken:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWebhookToken(v)
-		return nil
-	case generation.FieldPromptID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPromptID(v)
-		return nil
-	case generation.FieldNegativePromptID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNegativePromptID(v)
-		return nil
-	case generation.FieldModelID:
+	case deadletter.FieldJobID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelID(v)
+		m.SetJobID(v)
 		return nil
-	case generation.FieldSchedulerID:
-		v, ok := value.(uuid.UUID)
+	case deadletter.FieldProcessType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSchedulerID(v)
+		m.SetProcessType(v)
 		return nil
-	case generation.FieldUserID:
+	case deadletter.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case generation.FieldDeviceInfoID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeviceInfoID(v)
-		return nil
-	case generation.FieldAPITokenID:
-		v, ok := value.(uuid.UUID)
+	case deadletter.FieldPayload:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAPITokenID(v)
+		m.SetPayload(v)
 		return nil
-	case generation.FieldStartedAt:
-		v, ok := value.(time.Time)
+	case deadletter.FieldFailureReason:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartedAt(v)
+		m.SetFailureReason(v)
 		return nil
-	case generation.FieldCompletedAt:
+	case deadletter.FieldReplayedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompletedAt(v)
+		m.SetReplayedAt(v)
 		return nil
-	case generation.FieldCreatedAt:
+	case deadletter.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case generation.FieldUpdatedAt:
+	case deadletter.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -7932,567 +8979,182 @@ func (m *GenerationMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Generation field %s", name)
+	return fmt.Errorf("unknown DeadLetter field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GenerationMutation) AddedFields() []string {
-	var fields []string
-	if m.addwidth != nil {
-		fields = append(fields, generation.FieldWidth)
-	}
-	if m.addheight != nil {
-		fields = append(fields, generation.FieldHeight)
-	}
-	if m.addinference_steps != nil {
-		fields = append(fields, generation.FieldInferenceSteps)
-	}
-	if m.addguidance_scale != nil {
-		fields = append(fields, generation.FieldGuidanceScale)
-	}
-	if m.addnum_outputs != nil {
-		fields = append(fields, generation.FieldNumOutputs)
-	}
-	if m.addnsfw_count != nil {
-		fields = append(fields, generation.FieldNsfwCount)
-	}
-	if m.addseed != nil {
-		fields = append(fields, generation.FieldSeed)
-	}
-	if m.addprompt_strength != nil {
-		fields = append(fields, generation.FieldPromptStrength)
-	}
-	return fields
+func (m *DeadLetterMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GenerationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DeadLetterMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeadLetterMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case generation.FieldWidth:
-		return m.AddedWidth()
-	case generation.FieldHeight:
-		return m.AddedHeight()
-	case generation.FieldInferenceSteps:
-		return m.AddedInferenceSteps()
-	case generation.FieldGuidanceScale:
-		return m.AddedGuidanceScale()
-	case generation.FieldNumOutputs:
-		return m.AddedNumOutputs()
-	case generation.FieldNsfwCount:
-		return m.AddedNsfwCount()
-	case generation.FieldSeed:
-		return m.AddedSeed()
-	case generation.FieldPromptStrength:
-		return m.AddedPromptStrength()
-	}
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GenerationMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case generation.FieldWidth:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddWidth(v)
-		return nil
-	case generation.FieldHeight:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddHeight(v)
-		return nil
-	case generation.FieldInferenceSteps:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddInferenceSteps(v)
-		return nil
-	case generation.FieldGuidanceScale:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddGuidanceScale(v)
-		return nil
-	case generation.FieldNumOutputs:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddNumOutputs(v)
-		return nil
-	case generation.FieldNsfwCount:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddNsfwCount(v)
-		return nil
-	case generation.FieldSeed:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSeed(v)
-		return nil
-	case generation.FieldPromptStrength:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPromptStrength(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Generation numeric field %s", name)
+	return fmt.Errorf("unknown DeadLetter numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GenerationMutation) ClearedFields() []string {
+func (m *DeadLetterMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(generation.FieldFailureReason) {
-		fields = append(fields, generation.FieldFailureReason)
-	}
-	if m.FieldCleared(generation.FieldCountryCode) {
-		fields = append(fields, generation.FieldCountryCode)
-	}
-	if m.FieldCleared(generation.FieldInitImageURL) {
-		fields = append(fields, generation.FieldInitImageURL)
-	}
-	if m.FieldCleared(generation.FieldMaskImageURL) {
-		fields = append(fields, generation.FieldMaskImageURL)
-	}
-	if m.FieldCleared(generation.FieldPromptStrength) {
-		fields = append(fields, generation.FieldPromptStrength)
-	}
-	if m.FieldCleared(generation.FieldStripeProductID) {
-		fields = append(fields, generation.FieldStripeProductID)
-	}
-	if m.FieldCleared(generation.FieldPromptID) {
-		fields = append(fields, generation.FieldPromptID)
-	}
-	if m.FieldCleared(generation.FieldNegativePromptID) {
-		fields = append(fields, generation.FieldNegativePromptID)
-	}
-	if m.FieldCleared(generation.FieldAPITokenID) {
-		fields = append(fields, generation.FieldAPITokenID)
+	if m.FieldCleared(deadletter.FieldUserID) {
+		fields = append(fields, deadletter.FieldUserID)
 	}
-	if m.FieldCleared(generation.FieldStartedAt) {
-		fields = append(fields, generation.FieldStartedAt)
-	}
-	if m.FieldCleared(generation.FieldCompletedAt) {
-		fields = append(fields, generation.FieldCompletedAt)
+	if m.FieldCleared(deadletter.FieldReplayedAt) {
+		fields = append(fields, deadletter.FieldReplayedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GenerationMutation) FieldCleared(name string) bool {
+func (m *DeadLetterMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GenerationMutation) ClearField(name string) error {
+func (m *DeadLetterMutation) ClearField(name string) error {
 	switch name {
-	case generation.FieldFailureReason:
-		m.ClearFailureReason()
-		return nil
-	case generation.FieldCountryCode:
-		m.ClearCountryCode()
-		return nil
-	case generation.FieldInitImageURL:
-		m.ClearInitImageURL()
-		return nil
-	case generation.FieldMaskImageURL:
-		m.ClearMaskImageURL()
-		return nil
-	case generation.FieldPromptStrength:
-		m.ClearPromptStrength()
-		return nil
-	case generation.FieldStripeProductID:
-		m.ClearStripeProductID()
-		return nil
-	case generation.FieldPromptID:
-		m.ClearPromptID()
-		return nil
-	case generation.FieldNegativePromptID:
-		m.ClearNegativePromptID()
-		return nil
-	case generation.FieldAPITokenID:
-		m.ClearAPITokenID()
-		return nil
-	case generation.FieldStartedAt:
-		m.ClearStartedAt()
+	case deadletter.FieldUserID:
+		m.ClearUserID()
 		return nil
-	case generation.FieldCompletedAt:
-		m.ClearCompletedAt()
+	case deadletter.FieldReplayedAt:
+		m.ClearReplayedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Generation nullable field %s", name)
+	return fmt.Errorf("unknown DeadLetter nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GenerationMutation) ResetField(name string) error {
+func (m *DeadLetterMutation) ResetField(name string) error {
 	switch name {
-	case generation.FieldWidth:
-		m.ResetWidth()
-		return nil
-	case generation.FieldHeight:
-		m.ResetHeight()
-		return nil
-	case generation.FieldInferenceSteps:
-		m.ResetInferenceSteps()
-		return nil
-	case generation.FieldGuidanceScale:
-		m.ResetGuidanceScale()
-		return nil
-	case generation.FieldNumOutputs:
-		m.ResetNumOutputs()
-		return nil
-	case generation.FieldNsfwCount:
-		m.ResetNsfwCount()
-		return nil
-	case generation.FieldSeed:
-		m.ResetSeed()
-		return nil
-	case generation.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case generation.FieldFailureReason:
-		m.ResetFailureReason()
-		return nil
-	case generation.FieldCountryCode:
-		m.ResetCountryCode()
-		return nil
-	case generation.FieldInitImageURL:
-		m.ResetInitImageURL()
-		return nil
-	case generation.FieldMaskImageURL:
-		m.ResetMaskImageURL()
-		return nil
-	case generation.FieldPromptStrength:
-		m.ResetPromptStrength()
-		return nil
-	case generation.FieldWasAutoSubmitted:
-		m.ResetWasAutoSubmitted()
-		return nil
-	case generation.FieldStripeProductID:
-		m.ResetStripeProductID()
-		return nil
-	case generation.FieldSourceType:
-		m.ResetSourceType()
-		return nil
-	case generation.FieldWebhookToken:
-		m.ResetWebhookToken()
-		return nil
-	case generation.FieldPromptID:
-		m.ResetPromptID()
-		return nil
-	case generation.FieldNegativePromptID:
-		m.ResetNegativePromptID()
+	case deadletter.FieldJobID:
+		m.ResetJobID()
 		return nil
-	case generation.FieldModelID:
-		m.ResetModelID()
-		return nil
-	case generation.FieldSchedulerID:
-		m.ResetSchedulerID()
+	case deadletter.FieldProcessType:
+		m.ResetProcessType()
 		return nil
-	case generation.FieldUserID:
+	case deadletter.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case generation.FieldDeviceInfoID:
-		m.ResetDeviceInfoID()
-		return nil
-	case generation.FieldAPITokenID:
-		m.ResetAPITokenID()
+	case deadletter.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case generation.FieldStartedAt:
-		m.ResetStartedAt()
+	case deadletter.FieldFailureReason:
+		m.ResetFailureReason()
 		return nil
-	case generation.FieldCompletedAt:
-		m.ResetCompletedAt()
+	case deadletter.FieldReplayedAt:
+		m.ResetReplayedAt()
 		return nil
-	case generation.FieldCreatedAt:
+	case deadletter.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case generation.FieldUpdatedAt:
+	case deadletter.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Generation field %s", name)
+	return fmt.Errorf("unknown DeadLetter field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GenerationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.device_info != nil {
-		edges = append(edges, generation.EdgeDeviceInfo)
-	}
-	if m.scheduler != nil {
-		edges = append(edges, generation.EdgeScheduler)
-	}
-	if m.prompt != nil {
-		edges = append(edges, generation.EdgePrompt)
-	}
-	if m.negative_prompt != nil {
-		edges = append(edges, generation.EdgeNegativePrompt)
-	}
-	if m.generation_model != nil {
-		edges = append(edges, generation.EdgeGenerationModel)
-	}
-	if m.user != nil {
-		edges = append(edges, generation.EdgeUser)
-	}
-	if m.api_tokens != nil {
-		edges = append(edges, generation.EdgeAPITokens)
-	}
-	if m.generation_outputs != nil {
-		edges = append(edges, generation.EdgeGenerationOutputs)
-	}
+func (m *DeadLetterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GenerationMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case generation.EdgeDeviceInfo:
-		if id := m.device_info; id != nil {
-			return []ent.Value{*id}
-		}
-	case generation.EdgeScheduler:
-		if id := m.scheduler; id != nil {
-			return []ent.Value{*id}
-		}
-	case generation.EdgePrompt:
-		if id := m.prompt; id != nil {
-			return []ent.Value{*id}
-		}
-	case generation.EdgeNegativePrompt:
-		if id := m.negative_prompt; id != nil {
-			return []ent.Value{*id}
-		}
-	case generation.EdgeGenerationModel:
-		if id := m.generation_model; id != nil {
-			return []ent.Value{*id}
-		}
-	case generation.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case generation.EdgeAPITokens:
-		if id := m.api_tokens; id != nil {
-			return []ent.Value{*id}
-		}
-	case generation.EdgeGenerationOutputs:
-		ids := make([]ent.Value, 0, len(m.generation_outputs))
-		for id := range m.generation_outputs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *DeadLetterMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GenerationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.removedgeneration_outputs != nil {
-		edges = append(edges, generation.EdgeGenerationOutputs)
-	}
+func (m *DeadLetterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GenerationMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case generation.EdgeGenerationOutputs:
-		ids := make([]ent.Value, 0, len(m.removedgeneration_outputs))
-		for id := range m.removedgeneration_outputs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *DeadLetterMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GenerationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.cleareddevice_info {
-		edges = append(edges, generation.EdgeDeviceInfo)
-	}
-	if m.clearedscheduler {
-		edges = append(edges, generation.EdgeScheduler)
-	}
-	if m.clearedprompt {
-		edges = append(edges, generation.EdgePrompt)
-	}
-	if m.clearednegative_prompt {
-		edges = append(edges, generation.EdgeNegativePrompt)
-	}
-	if m.clearedgeneration_model {
-		edges = append(edges, generation.EdgeGenerationModel)
-	}
-	if m.cleareduser {
-		edges = append(edges, generation.EdgeUser)
-	}
-	if m.clearedapi_tokens {
-		edges = append(edges, generation.EdgeAPITokens)
-	}
-	if m.clearedgeneration_outputs {
-		edges = append(edges, generation.EdgeGenerationOutputs)
-	}
+func (m *DeadLetterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GenerationMutation) EdgeCleared(name string) bool {
-	switch name {
-	case generation.EdgeDeviceInfo:
-		return m.cleareddevice_info
-	case generation.EdgeScheduler:
-		return m.clearedscheduler
-	case generation.EdgePrompt:
-		return m.clearedprompt
-	case generation.EdgeNegativePrompt:
-		return m.clearednegative_prompt
-	case generation.EdgeGenerationModel:
-		return m.clearedgeneration_model
-	case generation.EdgeUser:
-		return m.cleareduser
-	case generation.EdgeAPITokens:
-		return m.clearedapi_tokens
-	case generation.EdgeGenerationOutputs:
-		return m.clearedgeneration_outputs
-	}
+func (m *DeadLetterMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GenerationMutation) ClearEdge(name string) error {
-	switch name {
-	case generation.EdgeDeviceInfo:
-		m.ClearDeviceInfo()
-		return nil
-	case generation.EdgeScheduler:
-		m.ClearScheduler()
-		return nil
-	case generation.EdgePrompt:
-		m.ClearPrompt()
-		return nil
-	case generation.EdgeNegativePrompt:
-		m.ClearNegativePrompt()
-		return nil
-	case generation.EdgeGenerationModel:
-		m.ClearGenerationModel()
-		return nil
-	case generation.EdgeUser:
-		m.ClearUser()
-		return nil
-	case generation.EdgeAPITokens:
-		m.ClearAPITokens()
-		return nil
-	}
-	return fmt.Errorf("unknown Generation unique edge %s", name)
+func (m *DeadLetterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeadLetter unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GenerationMutation) ResetEdge(name string) error {
-	switch name {
-	case generation.EdgeDeviceInfo:
-		m.ResetDeviceInfo()
-		return nil
-	case generation.EdgeScheduler:
-		m.ResetScheduler()
-		return nil
-	case generation.EdgePrompt:
-		m.ResetPrompt()
-		return nil
-	case generation.EdgeNegativePrompt:
-		m.ResetNegativePrompt()
-		return nil
-	case generation.EdgeGenerationModel:
-		m.ResetGenerationModel()
-		return nil
-	case generation.EdgeUser:
-		m.ResetUser()
-		return nil
-	case generation.EdgeAPITokens:
-		m.ResetAPITokens()
-		return nil
-	case generation.EdgeGenerationOutputs:
-		m.ResetGenerationOutputs()
-		return nil
-	}
-	return fmt.Errorf("unknown Generation edge %s", name)
+func (m *DeadLetterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeadLetter edge %s", name)
 }
 
-// GenerationModelMutation represents an operation that mutates the GenerationModel nodes in the graph.
-type GenerationModelMutation struct {
+// DeviceInfoMutation represents an operation that mutates the DeviceInfo nodes in the graph.
+type DeviceInfoMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *uuid.UUID
-	name_in_worker             *string
-	short_name                 *string
-	is_active                  *bool
-	is_default                 *bool
-	is_hidden                  *bool
-	runpod_endpoint            *string
-	runpod_active              *bool
-	display_weight             *int32
-	adddisplay_weight          *int32
-	default_scheduler_id       *uuid.UUID
-	default_width              *int32
-	adddefault_width           *int32
-	default_height             *int32
-	adddefault_height          *int32
-	default_inference_steps    *int32
-	adddefault_inference_steps *int32
-	created_at                 *time.Time
-	updated_at                 *time.Time
-	clearedFields              map[string]struct{}
-	generations                map[uuid.UUID]struct{}
-	removedgenerations         map[uuid.UUID]struct{}
-	clearedgenerations         bool
-	schedulers                 map[uuid.UUID]struct{}
-	removedschedulers          map[uuid.UUID]struct{}
-	clearedschedulers          bool
-	done                       bool
-	oldValue                   func(context.Context) (*GenerationModel, error)
-	predicates                 []predicate.GenerationModel
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	_type              *string
+	os                 *string
+	browser            *string
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	generations        map[uuid.UUID]struct{}
+	removedgenerations map[uuid.UUID]struct{}
+	clearedgenerations bool
+	upscales           map[uuid.UUID]struct{}
+	removedupscales    map[uuid.UUID]struct{}
+	clearedupscales    bool
+	voiceovers         map[uuid.UUID]struct{}
+	removedvoiceovers  map[uuid.UUID]struct{}
+	clearedvoiceovers  bool
+	done               bool
+	oldValue           func(context.Context) (*DeviceInfo, error)
+	predicates         []predicate.DeviceInfo
 }
 
-var _ ent.Mutation = (*GenerationModelMutation)(nil)
+var _ ent.Mutation = (*DeviceInfoMutation)(nil)
 
-// generationmodelOption allows management of the mutation configuration using functional options.
-type generationmodelOption func(*GenerationModelMutation)
+// deviceinfoOption allows management of the mutation configuration using functional options.
+type deviceinfoOption func(*DeviceInfoMutation)
 
-// newGenerationModelMutation creates new mutation for the GenerationModel entity.
-func newGenerationModelMutation(c config, op Op, opts ...generationmodelOption) *GenerationModelMutation {
-	m := &GenerationModelMutation{
+// newDeviceInfoMutation creates new mutation for the DeviceInfo entity.
+func newDeviceInfoMutation(c config, op Op, opts ...deviceinfoOption) *DeviceInfoMutation {
+	m := &DeviceInfoMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGenerationModel,
+		typ:           TypeDeviceInfo,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8501,20 +9163,20 @@ func newGenerationModelMutation(c config, op Op, opts ...generationmodelOption)
 	return m
 }
 
-// withGenerationModelID sets the ID field of the mutation.
-func withGenerationModelID(id uuid.UUID) generationmodelOption {
-	return func(m *GenerationModelMutation) {
+// withDeviceInfoID sets the ID field of the mutation.
+func withDeviceInfoID(id uuid.UUID) deviceinfoOption {
+	return func(m *DeviceInfoMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *GenerationModel
+			value *DeviceInfo
 		)
-		m.oldValue = func(ctx context.Context) (*GenerationModel, error) {
+		m.oldValue = func(ctx context.Context) (*DeviceInfo, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().GenerationModel.Get(ctx, id)
+					value, err = m.Client().DeviceInfo.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8523,10 +9185,10 @@ func withGenerationModelID(id uuid.UUID) generationmodelOption {
 	}
 }
 
-// withGenerationModel sets the old GenerationModel of the mutation.
-func withGenerationModel(node *GenerationModel) generationmodelOption {
-	return func(m *GenerationModelMutation) {
-		m.oldValue = func(context.Context) (*GenerationModel, error) {
+// withDeviceInfo sets the old DeviceInfo of the mutation.
+func withDeviceInfo(node *DeviceInfo) deviceinfoOption {
+	return func(m *DeviceInfoMutation) {
+		m.oldValue = func(context.Context) (*DeviceInfo, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8535,7 +9197,7 @@ func withGenerationModel(node *GenerationModel) generationmodelOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GenerationModelMutation) Client() *Client {
+func (m DeviceInfoMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8543,7 +9205,7 @@ func (m GenerationModelMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GenerationModelMutation) Tx() (*Tx, error) {
+func (m DeviceInfoMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8553,14 +9215,14 @@ func (m GenerationModelMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of GenerationModel entities.
-func (m *GenerationModelMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of DeviceInfo entities.
+func (m *DeviceInfoMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GenerationModelMutation) ID() (id uuid.UUID, exists bool) {
+func (m *DeviceInfoMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8571,7 +9233,7 @@ func (m *GenerationModelMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GenerationModelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *DeviceInfoMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8580,2054 +9242,10712 @@ func (m *GenerationModelMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GenerationModel.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DeviceInfo.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetNameInWorker sets the "name_in_worker" field.
-func (m *GenerationModelMutation) SetNameInWorker(s string) {
-	m.name_in_worker = &s
+// SetType sets the "type" field.
+func (m *DeviceInfoMutation) SetType(s string) {
+	m._type = &s
 }
 
-// NameInWorker returns the value of the "name_in_worker" field in the mutation.
-func (m *GenerationModelMutation) NameInWorker() (r string, exists bool) {
-	v := m.name_in_worker
+// GetType returns the value of the "type" field in the mutation.
+func (m *DeviceInfoMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNameInWorker returns the old "name_in_worker" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldNameInWorker(ctx context.Context) (v string, err error) {
+// OldType returns the old "type" field's value of the DeviceInfo entity.
+// If the DeviceInfo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceInfoMutation) OldType(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNameInWorker is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNameInWorker requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNameInWorker: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.NameInWorker, nil
+	return oldValue.Type, nil
 }
 
-// ResetNameInWorker resets all changes to the "name_in_worker" field.
-func (m *GenerationModelMutation) ResetNameInWorker() {
-	m.name_in_worker = nil
+// ClearType clears the value of the "type" field.
+func (m *DeviceInfoMutation) ClearType() {
+	m._type = nil
+	m.clearedFields[deviceinfo.FieldType] = struct{}{}
 }
 
-// SetShortName sets the "short_name" field.
-func (m *GenerationModelMutation) SetShortName(s string) {
-	m.short_name = &s
+// TypeCleared returns if the "type" field was cleared in this mutation.
+func (m *DeviceInfoMutation) TypeCleared() bool {
+	_, ok := m.clearedFields[deviceinfo.FieldType]
+	return ok
 }
 
-// ShortName returns the value of the "short_name" field in the mutation.
-func (m *GenerationModelMutation) ShortName() (r string, exists bool) {
-	v := m.short_name
+// ResetType resets all changes to the "type" field.
+func (m *DeviceInfoMutation) ResetType() {
+	m._type = nil
+	delete(m.clearedFields, deviceinfo.FieldType)
+}
+
+// SetOs sets the "os" field.
+func (m *DeviceInfoMutation) SetOs(s string) {
+	m.os = &s
+}
+
+// Os returns the value of the "os" field in the mutation.
+func (m *DeviceInfoMutation) Os() (r string, exists bool) {
+	v := m.os
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldShortName returns the old "short_name" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// OldOs returns the old "os" field's value of the DeviceInfo entity.
+// If the DeviceInfo object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldShortName(ctx context.Context) (v string, err error) {
+func (m *DeviceInfoMutation) OldOs(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldShortName is only allowed on UpdateOne operations")
+		return v, errors.New("OldOs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldShortName requires an ID field in the mutation")
+		return v, errors.New("OldOs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldShortName: %w", err)
+		return v, fmt.Errorf("querying old value for OldOs: %w", err)
+	}
+	return oldValue.Os, nil
+}
+
+// ClearOs clears the value of the "os" field.
+func (m *DeviceInfoMutation) ClearOs() {
+	m.os = nil
+	m.clearedFields[deviceinfo.FieldOs] = struct{}{}
+}
+
+// OsCleared returns if the "os" field was cleared in this mutation.
+func (m *DeviceInfoMutation) OsCleared() bool {
+	_, ok := m.clearedFields[deviceinfo.FieldOs]
+	return ok
+}
+
+// ResetOs resets all changes to the "os" field.
+func (m *DeviceInfoMutation) ResetOs() {
+	m.os = nil
+	delete(m.clearedFields, deviceinfo.FieldOs)
+}
+
+// SetBrowser sets the "browser" field.
+func (m *DeviceInfoMutation) SetBrowser(s string) {
+	m.browser = &s
+}
+
+// Browser returns the value of the "browser" field in the mutation.
+func (m *DeviceInfoMutation) Browser() (r string, exists bool) {
+	v := m.browser
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBrowser returns the old "browser" field's value of the DeviceInfo entity.
+// If the DeviceInfo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceInfoMutation) OldBrowser(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBrowser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBrowser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBrowser: %w", err)
+	}
+	return oldValue.Browser, nil
+}
+
+// ClearBrowser clears the value of the "browser" field.
+func (m *DeviceInfoMutation) ClearBrowser() {
+	m.browser = nil
+	m.clearedFields[deviceinfo.FieldBrowser] = struct{}{}
+}
+
+// BrowserCleared returns if the "browser" field was cleared in this mutation.
+func (m *DeviceInfoMutation) BrowserCleared() bool {
+	_, ok := m.clearedFields[deviceinfo.FieldBrowser]
+	return ok
+}
+
+// ResetBrowser resets all changes to the "browser" field.
+func (m *DeviceInfoMutation) ResetBrowser() {
+	m.browser = nil
+	delete(m.clearedFields, deviceinfo.FieldBrowser)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DeviceInfoMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DeviceInfoMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DeviceInfo entity.
+// If the DeviceInfo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceInfoMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DeviceInfoMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DeviceInfoMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DeviceInfoMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DeviceInfo entity.
+// If the DeviceInfo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceInfoMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DeviceInfoMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddGenerationIDs adds the "generations" edge to the Generation entity by ids.
+func (m *DeviceInfoMutation) AddGenerationIDs(ids ...uuid.UUID) {
+	if m.generations == nil {
+		m.generations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.generations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGenerations clears the "generations" edge to the Generation entity.
+func (m *DeviceInfoMutation) ClearGenerations() {
+	m.clearedgenerations = true
+}
+
+// GenerationsCleared reports if the "generations" edge to the Generation entity was cleared.
+func (m *DeviceInfoMutation) GenerationsCleared() bool {
+	return m.clearedgenerations
+}
+
+// RemoveGenerationIDs removes the "generations" edge to the Generation entity by IDs.
+func (m *DeviceInfoMutation) RemoveGenerationIDs(ids ...uuid.UUID) {
+	if m.removedgenerations == nil {
+		m.removedgenerations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.generations, ids[i])
+		m.removedgenerations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGenerations returns the removed IDs of the "generations" edge to the Generation entity.
+func (m *DeviceInfoMutation) RemovedGenerationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgenerations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GenerationsIDs returns the "generations" edge IDs in the mutation.
+func (m *DeviceInfoMutation) GenerationsIDs() (ids []uuid.UUID) {
+	for id := range m.generations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGenerations resets all changes to the "generations" edge.
+func (m *DeviceInfoMutation) ResetGenerations() {
+	m.generations = nil
+	m.clearedgenerations = false
+	m.removedgenerations = nil
+}
+
+// AddUpscaleIDs adds the "upscales" edge to the Upscale entity by ids.
+func (m *DeviceInfoMutation) AddUpscaleIDs(ids ...uuid.UUID) {
+	if m.upscales == nil {
+		m.upscales = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.upscales[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUpscales clears the "upscales" edge to the Upscale entity.
+func (m *DeviceInfoMutation) ClearUpscales() {
+	m.clearedupscales = true
+}
+
+// UpscalesCleared reports if the "upscales" edge to the Upscale entity was cleared.
+func (m *DeviceInfoMutation) UpscalesCleared() bool {
+	return m.clearedupscales
+}
+
+// RemoveUpscaleIDs removes the "upscales" edge to the Upscale entity by IDs.
+func (m *DeviceInfoMutation) RemoveUpscaleIDs(ids ...uuid.UUID) {
+	if m.removedupscales == nil {
+		m.removedupscales = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.upscales, ids[i])
+		m.removedupscales[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUpscales returns the removed IDs of the "upscales" edge to the Upscale entity.
+func (m *DeviceInfoMutation) RemovedUpscalesIDs() (ids []uuid.UUID) {
+	for id := range m.removedupscales {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UpscalesIDs returns the "upscales" edge IDs in the mutation.
+func (m *DeviceInfoMutation) UpscalesIDs() (ids []uuid.UUID) {
+	for id := range m.upscales {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUpscales resets all changes to the "upscales" edge.
+func (m *DeviceInfoMutation) ResetUpscales() {
+	m.upscales = nil
+	m.clearedupscales = false
+	m.removedupscales = nil
+}
+
+// AddVoiceoverIDs adds the "voiceovers" edge to the Voiceover entity by ids.
+func (m *DeviceInfoMutation) AddVoiceoverIDs(ids ...uuid.UUID) {
+	if m.voiceovers == nil {
+		m.voiceovers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.voiceovers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVoiceovers clears the "voiceovers" edge to the Voiceover entity.
+func (m *DeviceInfoMutation) ClearVoiceovers() {
+	m.clearedvoiceovers = true
+}
+
+// VoiceoversCleared reports if the "voiceovers" edge to the Voiceover entity was cleared.
+func (m *DeviceInfoMutation) VoiceoversCleared() bool {
+	return m.clearedvoiceovers
+}
+
+// RemoveVoiceoverIDs removes the "voiceovers" edge to the Voiceover entity by IDs.
+func (m *DeviceInfoMutation) RemoveVoiceoverIDs(ids ...uuid.UUID) {
+	if m.removedvoiceovers == nil {
+		m.removedvoiceovers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.voiceovers, ids[i])
+		m.removedvoiceovers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVoiceovers returns the removed IDs of the "voiceovers" edge to the Voiceover entity.
+func (m *DeviceInfoMutation) RemovedVoiceoversIDs() (ids []uuid.UUID) {
+	for id := range m.removedvoiceovers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VoiceoversIDs returns the "voiceovers" edge IDs in the mutation.
+func (m *DeviceInfoMutation) VoiceoversIDs() (ids []uuid.UUID) {
+	for id := range m.voiceovers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVoiceovers resets all changes to the "voiceovers" edge.
+func (m *DeviceInfoMutation) ResetVoiceovers() {
+	m.voiceovers = nil
+	m.clearedvoiceovers = false
+	m.removedvoiceovers = nil
+}
+
+// Where appends a list predicates to the DeviceInfoMutation builder.
+func (m *DeviceInfoMutation) Where(ps ...predicate.DeviceInfo) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeviceInfoMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeviceInfoMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeviceInfo, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeviceInfoMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeviceInfoMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeviceInfo).
+func (m *DeviceInfoMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeviceInfoMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m._type != nil {
+		fields = append(fields, deviceinfo.FieldType)
+	}
+	if m.os != nil {
+		fields = append(fields, deviceinfo.FieldOs)
+	}
+	if m.browser != nil {
+		fields = append(fields, deviceinfo.FieldBrowser)
+	}
+	if m.created_at != nil {
+		fields = append(fields, deviceinfo.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, deviceinfo.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeviceInfoMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deviceinfo.FieldType:
+		return m.GetType()
+	case deviceinfo.FieldOs:
+		return m.Os()
+	case deviceinfo.FieldBrowser:
+		return m.Browser()
+	case deviceinfo.FieldCreatedAt:
+		return m.CreatedAt()
+	case deviceinfo.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeviceInfoMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deviceinfo.FieldType:
+		return m.OldType(ctx)
+	case deviceinfo.FieldOs:
+		return m.OldOs(ctx)
+	case deviceinfo.FieldBrowser:
+		return m.OldBrowser(ctx)
+	case deviceinfo.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case deviceinfo.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeviceInfo field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceInfoMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deviceinfo.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case deviceinfo.FieldOs:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOs(v)
+		return nil
+	case deviceinfo.FieldBrowser:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBrowser(v)
+		return nil
+	case deviceinfo.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case deviceinfo.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceInfo field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeviceInfoMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeviceInfoMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceInfoMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DeviceInfo numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeviceInfoMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deviceinfo.FieldType) {
+		fields = append(fields, deviceinfo.FieldType)
+	}
+	if m.FieldCleared(deviceinfo.FieldOs) {
+		fields = append(fields, deviceinfo.FieldOs)
+	}
+	if m.FieldCleared(deviceinfo.FieldBrowser) {
+		fields = append(fields, deviceinfo.FieldBrowser)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeviceInfoMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeviceInfoMutation) ClearField(name string) error {
+	switch name {
+	case deviceinfo.FieldType:
+		m.ClearType()
+		return nil
+	case deviceinfo.FieldOs:
+		m.ClearOs()
+		return nil
+	case deviceinfo.FieldBrowser:
+		m.ClearBrowser()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceInfo nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeviceInfoMutation) ResetField(name string) error {
+	switch name {
+	case deviceinfo.FieldType:
+		m.ResetType()
+		return nil
+	case deviceinfo.FieldOs:
+		m.ResetOs()
+		return nil
+	case deviceinfo.FieldBrowser:
+		m.ResetBrowser()
+		return nil
+	case deviceinfo.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case deviceinfo.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceInfo field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeviceInfoMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.generations != nil {
+		edges = append(edges, deviceinfo.EdgeGenerations)
+	}
+	if m.upscales != nil {
+		edges = append(edges, deviceinfo.EdgeUpscales)
+	}
+	if m.voiceovers != nil {
+		edges = append(edges, deviceinfo.EdgeVoiceovers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeviceInfoMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deviceinfo.EdgeGenerations:
+		ids := make([]ent.Value, 0, len(m.generations))
+		for id := range m.generations {
+			ids = append(ids, id)
+		}
+		return ids
+	case deviceinfo.EdgeUpscales:
+		ids := make([]ent.Value, 0, len(m.upscales))
+		for id := range m.upscales {
+			ids = append(ids, id)
+		}
+		return ids
+	case deviceinfo.EdgeVoiceovers:
+		ids := make([]ent.Value, 0, len(m.voiceovers))
+		for id := range m.voiceovers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeviceInfoMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedgenerations != nil {
+		edges = append(edges, deviceinfo.EdgeGenerations)
+	}
+	if m.removedupscales != nil {
+		edges = append(edges, deviceinfo.EdgeUpscales)
+	}
+	if m.removedvoiceovers != nil {
+		edges = append(edges, deviceinfo.EdgeVoiceovers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeviceInfoMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case deviceinfo.EdgeGenerations:
+		ids := make([]ent.Value, 0, len(m.removedgenerations))
+		for id := range m.removedgenerations {
+			ids = append(ids, id)
+		}
+		return ids
+	case deviceinfo.EdgeUpscales:
+		ids := make([]ent.Value, 0, len(m.removedupscales))
+		for id := range m.removedupscales {
+			ids = append(ids, id)
+		}
+		return ids
+	case deviceinfo.EdgeVoiceovers:
+		ids := make([]ent.Value, 0, len(m.removedvoiceovers))
+		for id := range m.removedvoiceovers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeviceInfoMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedgenerations {
+		edges = append(edges, deviceinfo.EdgeGenerations)
+	}
+	if m.clearedupscales {
+		edges = append(edges, deviceinfo.EdgeUpscales)
+	}
+	if m.clearedvoiceovers {
+		edges = append(edges, deviceinfo.EdgeVoiceovers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeviceInfoMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deviceinfo.EdgeGenerations:
+		return m.clearedgenerations
+	case deviceinfo.EdgeUpscales:
+		return m.clearedupscales
+	case deviceinfo.EdgeVoiceovers:
+		return m.clearedvoiceovers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeviceInfoMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DeviceInfo unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeviceInfoMutation) ResetEdge(name string) error {
+	switch name {
+	case deviceinfo.EdgeGenerations:
+		m.ResetGenerations()
+		return nil
+	case deviceinfo.EdgeUpscales:
+		m.ResetUpscales()
+		return nil
+	case deviceinfo.EdgeVoiceovers:
+		m.ResetVoiceovers()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceInfo edge %s", name)
+}
+
+// DisposableEmailMutation represents an operation that mutates the DisposableEmail nodes in the graph.
+type DisposableEmailMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	domain        *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DisposableEmail, error)
+	predicates    []predicate.DisposableEmail
+}
+
+var _ ent.Mutation = (*DisposableEmailMutation)(nil)
+
+// disposableemailOption allows management of the mutation configuration using functional options.
+type disposableemailOption func(*DisposableEmailMutation)
+
+// newDisposableEmailMutation creates new mutation for the DisposableEmail entity.
+func newDisposableEmailMutation(c config, op Op, opts ...disposableemailOption) *DisposableEmailMutation {
+	m := &DisposableEmailMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDisposableEmail,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDisposableEmailID sets the ID field of the mutation.
+func withDisposableEmailID(id uuid.UUID) disposableemailOption {
+	return func(m *DisposableEmailMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DisposableEmail
+		)
+		m.oldValue = func(ctx context.Context) (*DisposableEmail, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DisposableEmail.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDisposableEmail sets the old DisposableEmail of the mutation.
+func withDisposableEmail(node *DisposableEmail) disposableemailOption {
+	return func(m *DisposableEmailMutation) {
+		m.oldValue = func(context.Context) (*DisposableEmail, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DisposableEmailMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DisposableEmailMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DisposableEmail entities.
+func (m *DisposableEmailMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DisposableEmailMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DisposableEmailMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DisposableEmail.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDomain sets the "domain" field.
+func (m *DisposableEmailMutation) SetDomain(s string) {
+	m.domain = &s
+}
+
+// Domain returns the value of the "domain" field in the mutation.
+func (m *DisposableEmailMutation) Domain() (r string, exists bool) {
+	v := m.domain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomain returns the old "domain" field's value of the DisposableEmail entity.
+// If the DisposableEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DisposableEmailMutation) OldDomain(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+	}
+	return oldValue.Domain, nil
+}
+
+// ResetDomain resets all changes to the "domain" field.
+func (m *DisposableEmailMutation) ResetDomain() {
+	m.domain = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DisposableEmailMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DisposableEmailMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DisposableEmail entity.
+// If the DisposableEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DisposableEmailMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DisposableEmailMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DisposableEmailMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DisposableEmailMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DisposableEmail entity.
+// If the DisposableEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DisposableEmailMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DisposableEmailMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the DisposableEmailMutation builder.
+func (m *DisposableEmailMutation) Where(ps ...predicate.DisposableEmail) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DisposableEmailMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DisposableEmailMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DisposableEmail, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DisposableEmailMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DisposableEmailMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DisposableEmail).
+func (m *DisposableEmailMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DisposableEmailMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.domain != nil {
+		fields = append(fields, disposableemail.FieldDomain)
+	}
+	if m.created_at != nil {
+		fields = append(fields, disposableemail.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, disposableemail.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DisposableEmailMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case disposableemail.FieldDomain:
+		return m.Domain()
+	case disposableemail.FieldCreatedAt:
+		return m.CreatedAt()
+	case disposableemail.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DisposableEmailMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case disposableemail.FieldDomain:
+		return m.OldDomain(ctx)
+	case disposableemail.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case disposableemail.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DisposableEmail field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DisposableEmailMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case disposableemail.FieldDomain:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomain(v)
+		return nil
+	case disposableemail.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case disposableemail.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DisposableEmail field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DisposableEmailMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DisposableEmailMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DisposableEmailMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DisposableEmail numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DisposableEmailMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DisposableEmailMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DisposableEmailMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DisposableEmail nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DisposableEmailMutation) ResetField(name string) error {
+	switch name {
+	case disposableemail.FieldDomain:
+		m.ResetDomain()
+		return nil
+	case disposableemail.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case disposableemail.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DisposableEmail field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DisposableEmailMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DisposableEmailMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DisposableEmailMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DisposableEmailMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DisposableEmailMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DisposableEmailMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DisposableEmailMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DisposableEmail unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DisposableEmailMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DisposableEmail edge %s", name)
+}
+
+// GenerationMutation represents an operation that mutates the Generation nodes in the graph.
+type GenerationMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	width                     *int32
+	addwidth                  *int32
+	height                    *int32
+	addheight                 *int32
+	inference_steps           *int32
+	addinference_steps        *int32
+	guidance_scale            *float32
+	addguidance_scale         *float32
+	num_outputs               *int32
+	addnum_outputs            *int32
+	nsfw_count                *int32
+	addnsfw_count             *int32
+	seed                      *int
+	addseed                   *int
+	status                    *generation.Status
+	failure_reason            *string
+	country_code              *string
+	init_image_url            *string
+	mask_image_url            *string
+	prompt_strength           *float32
+	addprompt_strength        *float32
+	was_auto_submitted        *bool
+	stripe_product_id         *string
+	source_type               *enttypes.SourceType
+	webhook_token             *uuid.UUID
+	batch_id                  *uuid.UUID
+	source_output_id          *uuid.UUID
+	requeued_at               *time.Time
+	started_at                *time.Time
+	completed_at              *time.Time
+	created_at                *time.Time
+	updated_at                *time.Time
+	clearedFields             map[string]struct{}
+	device_info               *uuid.UUID
+	cleareddevice_info        bool
+	scheduler                 *uuid.UUID
+	clearedscheduler          bool
+	prompt                    *uuid.UUID
+	clearedprompt             bool
+	negative_prompt           *uuid.UUID
+	clearednegative_prompt    bool
+	generation_model          *uuid.UUID
+	clearedgeneration_model   bool
+	user                      *uuid.UUID
+	cleareduser               bool
+	api_tokens                *uuid.UUID
+	clearedapi_tokens         bool
+	generation_outputs        map[uuid.UUID]struct{}
+	removedgeneration_outputs map[uuid.UUID]struct{}
+	clearedgeneration_outputs bool
+	done                      bool
+	oldValue                  func(context.Context) (*Generation, error)
+	predicates                []predicate.Generation
+}
+
+var _ ent.Mutation = (*GenerationMutation)(nil)
+
+// generationOption allows management of the mutation configuration using functional options.
+type generationOption func(*GenerationMutation)
+
+// newGenerationMutation creates new mutation for the Generation entity.
+func newGenerationMutation(c config, op Op, opts ...generationOption) *GenerationMutation {
+	m := &GenerationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGeneration,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGenerationID sets the ID field of the mutation.
+func withGenerationID(id uuid.UUID) generationOption {
+	return func(m *GenerationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Generation
+		)
+		m.oldValue = func(ctx context.Context) (*Generation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Generation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGeneration sets the old Generation of the mutation.
+func withGeneration(node *Generation) generationOption {
+	return func(m *GenerationMutation) {
+		m.oldValue = func(context.Context) (*Generation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GenerationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GenerationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Generation entities.
+func (m *GenerationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GenerationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GenerationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Generation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWidth sets the "width" field.
+func (m *GenerationMutation) SetWidth(i int32) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *GenerationMutation) Width() (r int32, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldWidth(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds i to the "width" field.
+func (m *GenerationMutation) AddWidth(i int32) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *GenerationMutation) AddedWidth() (r int32, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *GenerationMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+}
+
+// SetHeight sets the "height" field.
+func (m *GenerationMutation) SetHeight(i int32) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *GenerationMutation) Height() (r int32, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldHeight(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// AddHeight adds i to the "height" field.
+func (m *GenerationMutation) AddHeight(i int32) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *GenerationMutation) AddedHeight() (r int32, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *GenerationMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+}
+
+// SetInferenceSteps sets the "inference_steps" field.
+func (m *GenerationMutation) SetInferenceSteps(i int32) {
+	m.inference_steps = &i
+	m.addinference_steps = nil
+}
+
+// InferenceSteps returns the value of the "inference_steps" field in the mutation.
+func (m *GenerationMutation) InferenceSteps() (r int32, exists bool) {
+	v := m.inference_steps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInferenceSteps returns the old "inference_steps" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldInferenceSteps(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInferenceSteps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInferenceSteps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInferenceSteps: %w", err)
+	}
+	return oldValue.InferenceSteps, nil
+}
+
+// AddInferenceSteps adds i to the "inference_steps" field.
+func (m *GenerationMutation) AddInferenceSteps(i int32) {
+	if m.addinference_steps != nil {
+		*m.addinference_steps += i
+	} else {
+		m.addinference_steps = &i
+	}
+}
+
+// AddedInferenceSteps returns the value that was added to the "inference_steps" field in this mutation.
+func (m *GenerationMutation) AddedInferenceSteps() (r int32, exists bool) {
+	v := m.addinference_steps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInferenceSteps resets all changes to the "inference_steps" field.
+func (m *GenerationMutation) ResetInferenceSteps() {
+	m.inference_steps = nil
+	m.addinference_steps = nil
+}
+
+// SetGuidanceScale sets the "guidance_scale" field.
+func (m *GenerationMutation) SetGuidanceScale(f float32) {
+	m.guidance_scale = &f
+	m.addguidance_scale = nil
+}
+
+// GuidanceScale returns the value of the "guidance_scale" field in the mutation.
+func (m *GenerationMutation) GuidanceScale() (r float32, exists bool) {
+	v := m.guidance_scale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuidanceScale returns the old "guidance_scale" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldGuidanceScale(ctx context.Context) (v float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuidanceScale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuidanceScale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuidanceScale: %w", err)
+	}
+	return oldValue.GuidanceScale, nil
+}
+
+// AddGuidanceScale adds f to the "guidance_scale" field.
+func (m *GenerationMutation) AddGuidanceScale(f float32) {
+	if m.addguidance_scale != nil {
+		*m.addguidance_scale += f
+	} else {
+		m.addguidance_scale = &f
+	}
+}
+
+// AddedGuidanceScale returns the value that was added to the "guidance_scale" field in this mutation.
+func (m *GenerationMutation) AddedGuidanceScale() (r float32, exists bool) {
+	v := m.addguidance_scale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGuidanceScale resets all changes to the "guidance_scale" field.
+func (m *GenerationMutation) ResetGuidanceScale() {
+	m.guidance_scale = nil
+	m.addguidance_scale = nil
+}
+
+// SetNumOutputs sets the "num_outputs" field.
+func (m *GenerationMutation) SetNumOutputs(i int32) {
+	m.num_outputs = &i
+	m.addnum_outputs = nil
+}
+
+// NumOutputs returns the value of the "num_outputs" field in the mutation.
+func (m *GenerationMutation) NumOutputs() (r int32, exists bool) {
+	v := m.num_outputs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumOutputs returns the old "num_outputs" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldNumOutputs(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumOutputs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumOutputs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumOutputs: %w", err)
+	}
+	return oldValue.NumOutputs, nil
+}
+
+// AddNumOutputs adds i to the "num_outputs" field.
+func (m *GenerationMutation) AddNumOutputs(i int32) {
+	if m.addnum_outputs != nil {
+		*m.addnum_outputs += i
+	} else {
+		m.addnum_outputs = &i
+	}
+}
+
+// AddedNumOutputs returns the value that was added to the "num_outputs" field in this mutation.
+func (m *GenerationMutation) AddedNumOutputs() (r int32, exists bool) {
+	v := m.addnum_outputs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNumOutputs resets all changes to the "num_outputs" field.
+func (m *GenerationMutation) ResetNumOutputs() {
+	m.num_outputs = nil
+	m.addnum_outputs = nil
+}
+
+// SetNsfwCount sets the "nsfw_count" field.
+func (m *GenerationMutation) SetNsfwCount(i int32) {
+	m.nsfw_count = &i
+	m.addnsfw_count = nil
+}
+
+// NsfwCount returns the value of the "nsfw_count" field in the mutation.
+func (m *GenerationMutation) NsfwCount() (r int32, exists bool) {
+	v := m.nsfw_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNsfwCount returns the old "nsfw_count" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldNsfwCount(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNsfwCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNsfwCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNsfwCount: %w", err)
+	}
+	return oldValue.NsfwCount, nil
+}
+
+// AddNsfwCount adds i to the "nsfw_count" field.
+func (m *GenerationMutation) AddNsfwCount(i int32) {
+	if m.addnsfw_count != nil {
+		*m.addnsfw_count += i
+	} else {
+		m.addnsfw_count = &i
+	}
+}
+
+// AddedNsfwCount returns the value that was added to the "nsfw_count" field in this mutation.
+func (m *GenerationMutation) AddedNsfwCount() (r int32, exists bool) {
+	v := m.addnsfw_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNsfwCount resets all changes to the "nsfw_count" field.
+func (m *GenerationMutation) ResetNsfwCount() {
+	m.nsfw_count = nil
+	m.addnsfw_count = nil
+}
+
+// SetSeed sets the "seed" field.
+func (m *GenerationMutation) SetSeed(i int) {
+	m.seed = &i
+	m.addseed = nil
+}
+
+// Seed returns the value of the "seed" field in the mutation.
+func (m *GenerationMutation) Seed() (r int, exists bool) {
+	v := m.seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeed returns the old "seed" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldSeed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+	}
+	return oldValue.Seed, nil
+}
+
+// AddSeed adds i to the "seed" field.
+func (m *GenerationMutation) AddSeed(i int) {
+	if m.addseed != nil {
+		*m.addseed += i
+	} else {
+		m.addseed = &i
+	}
+}
+
+// AddedSeed returns the value that was added to the "seed" field in this mutation.
+func (m *GenerationMutation) AddedSeed() (r int, exists bool) {
+	v := m.addseed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeed resets all changes to the "seed" field.
+func (m *GenerationMutation) ResetSeed() {
+	m.seed = nil
+	m.addseed = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *GenerationMutation) SetStatus(ge generation.Status) {
+	m.status = &ge
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *GenerationMutation) Status() (r generation.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldStatus(ctx context.Context) (v generation.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *GenerationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (m *GenerationMutation) SetFailureReason(s string) {
+	m.failure_reason = &s
+}
+
+// FailureReason returns the value of the "failure_reason" field in the mutation.
+func (m *GenerationMutation) FailureReason() (r string, exists bool) {
+	v := m.failure_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailureReason returns the old "failure_reason" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldFailureReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailureReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailureReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailureReason: %w", err)
+	}
+	return oldValue.FailureReason, nil
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (m *GenerationMutation) ClearFailureReason() {
+	m.failure_reason = nil
+	m.clearedFields[generation.FieldFailureReason] = struct{}{}
+}
+
+// FailureReasonCleared returns if the "failure_reason" field was cleared in this mutation.
+func (m *GenerationMutation) FailureReasonCleared() bool {
+	_, ok := m.clearedFields[generation.FieldFailureReason]
+	return ok
+}
+
+// ResetFailureReason resets all changes to the "failure_reason" field.
+func (m *GenerationMutation) ResetFailureReason() {
+	m.failure_reason = nil
+	delete(m.clearedFields, generation.FieldFailureReason)
+}
+
+// SetCountryCode sets the "country_code" field.
+func (m *GenerationMutation) SetCountryCode(s string) {
+	m.country_code = &s
+}
+
+// CountryCode returns the value of the "country_code" field in the mutation.
+func (m *GenerationMutation) CountryCode() (r string, exists bool) {
+	v := m.country_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountryCode returns the old "country_code" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldCountryCode(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountryCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountryCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountryCode: %w", err)
+	}
+	return oldValue.CountryCode, nil
+}
+
+// ClearCountryCode clears the value of the "country_code" field.
+func (m *GenerationMutation) ClearCountryCode() {
+	m.country_code = nil
+	m.clearedFields[generation.FieldCountryCode] = struct{}{}
+}
+
+// CountryCodeCleared returns if the "country_code" field was cleared in this mutation.
+func (m *GenerationMutation) CountryCodeCleared() bool {
+	_, ok := m.clearedFields[generation.FieldCountryCode]
+	return ok
+}
+
+// ResetCountryCode resets all changes to the "country_code" field.
+func (m *GenerationMutation) ResetCountryCode() {
+	m.country_code = nil
+	delete(m.clearedFields, generation.FieldCountryCode)
+}
+
+// SetInitImageURL sets the "init_image_url" field.
+func (m *GenerationMutation) SetInitImageURL(s string) {
+	m.init_image_url = &s
+}
+
+// InitImageURL returns the value of the "init_image_url" field in the mutation.
+func (m *GenerationMutation) InitImageURL() (r string, exists bool) {
+	v := m.init_image_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInitImageURL returns the old "init_image_url" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldInitImageURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInitImageURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInitImageURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInitImageURL: %w", err)
+	}
+	return oldValue.InitImageURL, nil
+}
+
+// ClearInitImageURL clears the value of the "init_image_url" field.
+func (m *GenerationMutation) ClearInitImageURL() {
+	m.init_image_url = nil
+	m.clearedFields[generation.FieldInitImageURL] = struct{}{}
+}
+
+// InitImageURLCleared returns if the "init_image_url" field was cleared in this mutation.
+func (m *GenerationMutation) InitImageURLCleared() bool {
+	_, ok := m.clearedFields[generation.FieldInitImageURL]
+	return ok
+}
+
+// ResetInitImageURL resets all changes to the "init_image_url" field.
+func (m *GenerationMutation) ResetInitImageURL() {
+	m.init_image_url = nil
+	delete(m.clearedFields, generation.FieldInitImageURL)
+}
+
+// SetMaskImageURL sets the "mask_image_url" field.
+func (m *GenerationMutation) SetMaskImageURL(s string) {
+	m.mask_image_url = &s
+}
+
+// MaskImageURL returns the value of the "mask_image_url" field in the mutation.
+func (m *GenerationMutation) MaskImageURL() (r string, exists bool) {
+	v := m.mask_image_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaskImageURL returns the old "mask_image_url" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldMaskImageURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaskImageURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaskImageURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaskImageURL: %w", err)
+	}
+	return oldValue.MaskImageURL, nil
+}
+
+// ClearMaskImageURL clears the value of the "mask_image_url" field.
+func (m *GenerationMutation) ClearMaskImageURL() {
+	m.mask_image_url = nil
+	m.clearedFields[generation.FieldMaskImageURL] = struct{}{}
+}
+
+// MaskImageURLCleared returns if the "mask_image_url" field was cleared in this mutation.
+func (m *GenerationMutation) MaskImageURLCleared() bool {
+	_, ok := m.clearedFields[generation.FieldMaskImageURL]
+	return ok
+}
+
+// ResetMaskImageURL resets all changes to the "mask_image_url" field.
+func (m *GenerationMutation) ResetMaskImageURL() {
+	m.mask_image_url = nil
+	delete(m.clearedFields, generation.FieldMaskImageURL)
+}
+
+// SetPromptStrength sets the "prompt_strength" field.
+func (m *GenerationMutation) SetPromptStrength(f float32) {
+	m.prompt_strength = &f
+	m.addprompt_strength = nil
+}
+
+// PromptStrength returns the value of the "prompt_strength" field in the mutation.
+func (m *GenerationMutation) PromptStrength() (r float32, exists bool) {
+	v := m.prompt_strength
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptStrength returns the old "prompt_strength" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldPromptStrength(ctx context.Context) (v *float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptStrength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptStrength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptStrength: %w", err)
+	}
+	return oldValue.PromptStrength, nil
+}
+
+// AddPromptStrength adds f to the "prompt_strength" field.
+func (m *GenerationMutation) AddPromptStrength(f float32) {
+	if m.addprompt_strength != nil {
+		*m.addprompt_strength += f
+	} else {
+		m.addprompt_strength = &f
+	}
+}
+
+// AddedPromptStrength returns the value that was added to the "prompt_strength" field in this mutation.
+func (m *GenerationMutation) AddedPromptStrength() (r float32, exists bool) {
+	v := m.addprompt_strength
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPromptStrength clears the value of the "prompt_strength" field.
+func (m *GenerationMutation) ClearPromptStrength() {
+	m.prompt_strength = nil
+	m.addprompt_strength = nil
+	m.clearedFields[generation.FieldPromptStrength] = struct{}{}
+}
+
+// PromptStrengthCleared returns if the "prompt_strength" field was cleared in this mutation.
+func (m *GenerationMutation) PromptStrengthCleared() bool {
+	_, ok := m.clearedFields[generation.FieldPromptStrength]
+	return ok
+}
+
+// ResetPromptStrength resets all changes to the "prompt_strength" field.
+func (m *GenerationMutation) ResetPromptStrength() {
+	m.prompt_strength = nil
+	m.addprompt_strength = nil
+	delete(m.clearedFields, generation.FieldPromptStrength)
+}
+
+// SetWasAutoSubmitted sets the "was_auto_submitted" field.
+func (m *GenerationMutation) SetWasAutoSubmitted(b bool) {
+	m.was_auto_submitted = &b
+}
+
+// WasAutoSubmitted returns the value of the "was_auto_submitted" field in the mutation.
+func (m *GenerationMutation) WasAutoSubmitted() (r bool, exists bool) {
+	v := m.was_auto_submitted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWasAutoSubmitted returns the old "was_auto_submitted" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldWasAutoSubmitted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWasAutoSubmitted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWasAutoSubmitted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWasAutoSubmitted: %w", err)
+	}
+	return oldValue.WasAutoSubmitted, nil
+}
+
+// ResetWasAutoSubmitted resets all changes to the "was_auto_submitted" field.
+func (m *GenerationMutation) ResetWasAutoSubmitted() {
+	m.was_auto_submitted = nil
+}
+
+// SetStripeProductID sets the "stripe_product_id" field.
+func (m *GenerationMutation) SetStripeProductID(s string) {
+	m.stripe_product_id = &s
+}
+
+// StripeProductID returns the value of the "stripe_product_id" field in the mutation.
+func (m *GenerationMutation) StripeProductID() (r string, exists bool) {
+	v := m.stripe_product_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStripeProductID returns the old "stripe_product_id" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldStripeProductID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStripeProductID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStripeProductID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStripeProductID: %w", err)
+	}
+	return oldValue.StripeProductID, nil
+}
+
+// ClearStripeProductID clears the value of the "stripe_product_id" field.
+func (m *GenerationMutation) ClearStripeProductID() {
+	m.stripe_product_id = nil
+	m.clearedFields[generation.FieldStripeProductID] = struct{}{}
+}
+
+// StripeProductIDCleared returns if the "stripe_product_id" field was cleared in this mutation.
+func (m *GenerationMutation) StripeProductIDCleared() bool {
+	_, ok := m.clearedFields[generation.FieldStripeProductID]
+	return ok
+}
+
+// ResetStripeProductID resets all changes to the "stripe_product_id" field.
+func (m *GenerationMutation) ResetStripeProductID() {
+	m.stripe_product_id = nil
+	delete(m.clearedFields, generation.FieldStripeProductID)
+}
+
+// SetSourceType sets the "source_type" field.
+func (m *GenerationMutation) SetSourceType(et enttypes.SourceType) {
+	m.source_type = &et
+}
+
+// SourceType returns the value of the "source_type" field in the mutation.
+func (m *GenerationMutation) SourceType() (r enttypes.SourceType, exists bool) {
+	v := m.source_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceType returns the old "source_type" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldSourceType(ctx context.Context) (v enttypes.SourceType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceType: %w", err)
+	}
+	return oldValue.SourceType, nil
+}
+
+// ResetSourceType resets all changes to the "source_type" field.
+func (m *GenerationMutation) ResetSourceType() {
+	m.source_type = nil
+}
+
+// SetWebhookToken sets the "webhook_token" field.
+func (m *GenerationMutation) SetWebhookToken(u uuid.UUID) {
+	m.webhook_token = &u
+}
+
+// WebhookToken returns the value of the "webhook_token" field in the mutation.
+func (m *GenerationMutation) WebhookToken() (r uuid.UUID, exists bool) {
+	v := m.webhook_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebhookToken returns the old "webhook_token" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldWebhookToken(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebhookToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebhookToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookToken: %w", err)
+	}
+	return oldValue.WebhookToken, nil
+}
+
+// ResetWebhookToken resets all changes to the "webhook_token" field.
+func (m *GenerationMutation) ResetWebhookToken() {
+	m.webhook_token = nil
+}
+
+// SetPromptID sets the "prompt_id" field.
+func (m *GenerationMutation) SetPromptID(u uuid.UUID) {
+	m.prompt = &u
+}
+
+// PromptID returns the value of the "prompt_id" field in the mutation.
+func (m *GenerationMutation) PromptID() (r uuid.UUID, exists bool) {
+	v := m.prompt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptID returns the old "prompt_id" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldPromptID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptID: %w", err)
+	}
+	return oldValue.PromptID, nil
+}
+
+// ClearPromptID clears the value of the "prompt_id" field.
+func (m *GenerationMutation) ClearPromptID() {
+	m.prompt = nil
+	m.clearedFields[generation.FieldPromptID] = struct{}{}
+}
+
+// PromptIDCleared returns if the "prompt_id" field was cleared in this mutation.
+func (m *GenerationMutation) PromptIDCleared() bool {
+	_, ok := m.clearedFields[generation.FieldPromptID]
+	return ok
+}
+
+// ResetPromptID resets all changes to the "prompt_id" field.
+func (m *GenerationMutation) ResetPromptID() {
+	m.prompt = nil
+	delete(m.clearedFields, generation.FieldPromptID)
+}
+
+// SetNegativePromptID sets the "negative_prompt_id" field.
+func (m *GenerationMutation) SetNegativePromptID(u uuid.UUID) {
+	m.negative_prompt = &u
+}
+
+// NegativePromptID returns the value of the "negative_prompt_id" field in the mutation.
+func (m *GenerationMutation) NegativePromptID() (r uuid.UUID, exists bool) {
+	v := m.negative_prompt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNegativePromptID returns the old "negative_prompt_id" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldNegativePromptID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNegativePromptID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNegativePromptID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNegativePromptID: %w", err)
+	}
+	return oldValue.NegativePromptID, nil
+}
+
+// ClearNegativePromptID clears the value of the "negative_prompt_id" field.
+func (m *GenerationMutation) ClearNegativePromptID() {
+	m.negative_prompt = nil
+	m.clearedFields[generation.FieldNegativePromptID] = struct{}{}
+}
+
+// NegativePromptIDCleared returns if the "negative_prompt_id" field was cleared in this mutation.
+func (m *GenerationMutation) NegativePromptIDCleared() bool {
+	_, ok := m.clearedFields[generation.FieldNegativePromptID]
+	return ok
+}
+
+// ResetNegativePromptID resets all changes to the "negative_prompt_id" field.
+func (m *GenerationMutation) ResetNegativePromptID() {
+	m.negative_prompt = nil
+	delete(m.clearedFields, generation.FieldNegativePromptID)
+}
+
+// SetModelID sets the "model_id" field.
+func (m *GenerationMutation) SetModelID(u uuid.UUID) {
+	m.generation_model = &u
+}
+
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *GenerationMutation) ModelID() (r uuid.UUID, exists bool) {
+	v := m.generation_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelID returns the old "model_id" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldModelID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+	}
+	return oldValue.ModelID, nil
+}
+
+// ResetModelID resets all changes to the "model_id" field.
+func (m *GenerationMutation) ResetModelID() {
+	m.generation_model = nil
+}
+
+// SetSchedulerID sets the "scheduler_id" field.
+func (m *GenerationMutation) SetSchedulerID(u uuid.UUID) {
+	m.scheduler = &u
+}
+
+// SchedulerID returns the value of the "scheduler_id" field in the mutation.
+func (m *GenerationMutation) SchedulerID() (r uuid.UUID, exists bool) {
+	v := m.scheduler
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchedulerID returns the old "scheduler_id" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldSchedulerID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchedulerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchedulerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchedulerID: %w", err)
+	}
+	return oldValue.SchedulerID, nil
+}
+
+// ResetSchedulerID resets all changes to the "scheduler_id" field.
+func (m *GenerationMutation) ResetSchedulerID() {
+	m.scheduler = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *GenerationMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *GenerationMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *GenerationMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetDeviceInfoID sets the "device_info_id" field.
+func (m *GenerationMutation) SetDeviceInfoID(u uuid.UUID) {
+	m.device_info = &u
+}
+
+// DeviceInfoID returns the value of the "device_info_id" field in the mutation.
+func (m *GenerationMutation) DeviceInfoID() (r uuid.UUID, exists bool) {
+	v := m.device_info
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceInfoID returns the old "device_info_id" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldDeviceInfoID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceInfoID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceInfoID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceInfoID: %w", err)
+	}
+	return oldValue.DeviceInfoID, nil
+}
+
+// ResetDeviceInfoID resets all changes to the "device_info_id" field.
+func (m *GenerationMutation) ResetDeviceInfoID() {
+	m.device_info = nil
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (m *GenerationMutation) SetAPITokenID(u uuid.UUID) {
+	m.api_tokens = &u
+}
+
+// APITokenID returns the value of the "api_token_id" field in the mutation.
+func (m *GenerationMutation) APITokenID() (r uuid.UUID, exists bool) {
+	v := m.api_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPITokenID returns the old "api_token_id" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldAPITokenID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPITokenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPITokenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPITokenID: %w", err)
+	}
+	return oldValue.APITokenID, nil
+}
+
+// ClearAPITokenID clears the value of the "api_token_id" field.
+func (m *GenerationMutation) ClearAPITokenID() {
+	m.api_tokens = nil
+	m.clearedFields[generation.FieldAPITokenID] = struct{}{}
+}
+
+// APITokenIDCleared returns if the "api_token_id" field was cleared in this mutation.
+func (m *GenerationMutation) APITokenIDCleared() bool {
+	_, ok := m.clearedFields[generation.FieldAPITokenID]
+	return ok
+}
+
+// ResetAPITokenID resets all changes to the "api_token_id" field.
+func (m *GenerationMutation) ResetAPITokenID() {
+	m.api_tokens = nil
+	delete(m.clearedFields, generation.FieldAPITokenID)
+}
+
+// SetBatchID sets the "batch_id" field.
+func (m *GenerationMutation) SetBatchID(u uuid.UUID) {
+	m.batch_id = &u
+}
+
+// BatchID returns the value of the "batch_id" field in the mutation.
+func (m *GenerationMutation) BatchID() (r uuid.UUID, exists bool) {
+	v := m.batch_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBatchID returns the old "batch_id" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldBatchID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBatchID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBatchID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBatchID: %w", err)
+	}
+	return oldValue.BatchID, nil
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (m *GenerationMutation) ClearBatchID() {
+	m.batch_id = nil
+	m.clearedFields[generation.FieldBatchID] = struct{}{}
+}
+
+// BatchIDCleared returns if the "batch_id" field was cleared in this mutation.
+func (m *GenerationMutation) BatchIDCleared() bool {
+	_, ok := m.clearedFields[generation.FieldBatchID]
+	return ok
+}
+
+// ResetBatchID resets all changes to the "batch_id" field.
+func (m *GenerationMutation) ResetBatchID() {
+	m.batch_id = nil
+	delete(m.clearedFields, generation.FieldBatchID)
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (m *GenerationMutation) SetSourceOutputID(u uuid.UUID) {
+	m.source_output_id = &u
+}
+
+// SourceOutputID returns the value of the "source_output_id" field in the mutation.
+func (m *GenerationMutation) SourceOutputID() (r uuid.UUID, exists bool) {
+	v := m.source_output_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceOutputID returns the old "source_output_id" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldSourceOutputID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceOutputID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceOutputID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceOutputID: %w", err)
+	}
+	return oldValue.SourceOutputID, nil
+}
+
+// ClearSourceOutputID clears the value of the "source_output_id" field.
+func (m *GenerationMutation) ClearSourceOutputID() {
+	m.source_output_id = nil
+	m.clearedFields[generation.FieldSourceOutputID] = struct{}{}
+}
+
+// SourceOutputIDCleared returns if the "source_output_id" field was cleared in this mutation.
+func (m *GenerationMutation) SourceOutputIDCleared() bool {
+	_, ok := m.clearedFields[generation.FieldSourceOutputID]
+	return ok
+}
+
+// ResetSourceOutputID resets all changes to the "source_output_id" field.
+func (m *GenerationMutation) ResetSourceOutputID() {
+	m.source_output_id = nil
+	delete(m.clearedFields, generation.FieldSourceOutputID)
+}
+
+// SetRequeuedAt sets the "requeued_at" field.
+func (m *GenerationMutation) SetRequeuedAt(t time.Time) {
+	m.requeued_at = &t
+}
+
+// RequeuedAt returns the value of the "requeued_at" field in the mutation.
+func (m *GenerationMutation) RequeuedAt() (r time.Time, exists bool) {
+	v := m.requeued_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequeuedAt returns the old "requeued_at" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldRequeuedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequeuedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequeuedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequeuedAt: %w", err)
+	}
+	return oldValue.RequeuedAt, nil
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (m *GenerationMutation) ClearRequeuedAt() {
+	m.requeued_at = nil
+	m.clearedFields[generation.FieldRequeuedAt] = struct{}{}
+}
+
+// RequeuedAtCleared returns if the "requeued_at" field was cleared in this mutation.
+func (m *GenerationMutation) RequeuedAtCleared() bool {
+	_, ok := m.clearedFields[generation.FieldRequeuedAt]
+	return ok
+}
+
+// ResetRequeuedAt resets all changes to the "requeued_at" field.
+func (m *GenerationMutation) ResetRequeuedAt() {
+	m.requeued_at = nil
+	delete(m.clearedFields, generation.FieldRequeuedAt)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *GenerationMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *GenerationMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *GenerationMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[generation.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *GenerationMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[generation.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *GenerationMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, generation.FieldStartedAt)
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *GenerationMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *GenerationMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *GenerationMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[generation.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *GenerationMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[generation.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *GenerationMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, generation.FieldCompletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GenerationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GenerationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GenerationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GenerationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GenerationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Generation entity.
+// If the Generation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GenerationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearDeviceInfo clears the "device_info" edge to the DeviceInfo entity.
+func (m *GenerationMutation) ClearDeviceInfo() {
+	m.cleareddevice_info = true
+	m.clearedFields[generation.FieldDeviceInfoID] = struct{}{}
+}
+
+// DeviceInfoCleared reports if the "device_info" edge to the DeviceInfo entity was cleared.
+func (m *GenerationMutation) DeviceInfoCleared() bool {
+	return m.cleareddevice_info
+}
+
+// DeviceInfoIDs returns the "device_info" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeviceInfoID instead. It exists only for internal usage by the builders.
+func (m *GenerationMutation) DeviceInfoIDs() (ids []uuid.UUID) {
+	if id := m.device_info; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDeviceInfo resets all changes to the "device_info" edge.
+func (m *GenerationMutation) ResetDeviceInfo() {
+	m.device_info = nil
+	m.cleareddevice_info = false
+}
+
+// ClearScheduler clears the "scheduler" edge to the Scheduler entity.
+func (m *GenerationMutation) ClearScheduler() {
+	m.clearedscheduler = true
+	m.clearedFields[generation.FieldSchedulerID] = struct{}{}
+}
+
+// SchedulerCleared reports if the "scheduler" edge to the Scheduler entity was cleared.
+func (m *GenerationMutation) SchedulerCleared() bool {
+	return m.clearedscheduler
+}
+
+// SchedulerIDs returns the "scheduler" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SchedulerID instead. It exists only for internal usage by the builders.
+func (m *GenerationMutation) SchedulerIDs() (ids []uuid.UUID) {
+	if id := m.scheduler; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetScheduler resets all changes to the "scheduler" edge.
+func (m *GenerationMutation) ResetScheduler() {
+	m.scheduler = nil
+	m.clearedscheduler = false
+}
+
+// ClearPrompt clears the "prompt" edge to the Prompt entity.
+func (m *GenerationMutation) ClearPrompt() {
+	m.clearedprompt = true
+	m.clearedFields[generation.FieldPromptID] = struct{}{}
+}
+
+// PromptCleared reports if the "prompt" edge to the Prompt entity was cleared.
+func (m *GenerationMutation) PromptCleared() bool {
+	return m.PromptIDCleared() || m.clearedprompt
+}
+
+// PromptIDs returns the "prompt" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PromptID instead. It exists only for internal usage by the builders.
+func (m *GenerationMutation) PromptIDs() (ids []uuid.UUID) {
+	if id := m.prompt; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPrompt resets all changes to the "prompt" edge.
+func (m *GenerationMutation) ResetPrompt() {
+	m.prompt = nil
+	m.clearedprompt = false
+}
+
+// ClearNegativePrompt clears the "negative_prompt" edge to the NegativePrompt entity.
+func (m *GenerationMutation) ClearNegativePrompt() {
+	m.clearednegative_prompt = true
+	m.clearedFields[generation.FieldNegativePromptID] = struct{}{}
+}
+
+// NegativePromptCleared reports if the "negative_prompt" edge to the NegativePrompt entity was cleared.
+func (m *GenerationMutation) NegativePromptCleared() bool {
+	return m.NegativePromptIDCleared() || m.clearednegative_prompt
+}
+
+// NegativePromptIDs returns the "negative_prompt" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NegativePromptID instead. It exists only for internal usage by the builders.
+func (m *GenerationMutation) NegativePromptIDs() (ids []uuid.UUID) {
+	if id := m.negative_prompt; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNegativePrompt resets all changes to the "negative_prompt" edge.
+func (m *GenerationMutation) ResetNegativePrompt() {
+	m.negative_prompt = nil
+	m.clearednegative_prompt = false
+}
+
+// SetGenerationModelID sets the "generation_model" edge to the GenerationModel entity by id.
+func (m *GenerationMutation) SetGenerationModelID(id uuid.UUID) {
+	m.generation_model = &id
+}
+
+// ClearGenerationModel clears the "generation_model" edge to the GenerationModel entity.
+func (m *GenerationMutation) ClearGenerationModel() {
+	m.clearedgeneration_model = true
+	m.clearedFields[generation.FieldModelID] = struct{}{}
+}
+
+// GenerationModelCleared reports if the "generation_model" edge to the GenerationModel entity was cleared.
+func (m *GenerationMutation) GenerationModelCleared() bool {
+	return m.clearedgeneration_model
+}
+
+// GenerationModelID returns the "generation_model" edge ID in the mutation.
+func (m *GenerationMutation) GenerationModelID() (id uuid.UUID, exists bool) {
+	if m.generation_model != nil {
+		return *m.generation_model, true
+	}
+	return
+}
+
+// GenerationModelIDs returns the "generation_model" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GenerationModelID instead. It exists only for internal usage by the builders.
+func (m *GenerationMutation) GenerationModelIDs() (ids []uuid.UUID) {
+	if id := m.generation_model; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGenerationModel resets all changes to the "generation_model" edge.
+func (m *GenerationMutation) ResetGenerationModel() {
+	m.generation_model = nil
+	m.clearedgeneration_model = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *GenerationMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[generation.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *GenerationMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *GenerationMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *GenerationMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetAPITokensID sets the "api_tokens" edge to the ApiToken entity by id.
+func (m *GenerationMutation) SetAPITokensID(id uuid.UUID) {
+	m.api_tokens = &id
+}
+
+// ClearAPITokens clears the "api_tokens" edge to the ApiToken entity.
+func (m *GenerationMutation) ClearAPITokens() {
+	m.clearedapi_tokens = true
+	m.clearedFields[generation.FieldAPITokenID] = struct{}{}
+}
+
+// APITokensCleared reports if the "api_tokens" edge to the ApiToken entity was cleared.
+func (m *GenerationMutation) APITokensCleared() bool {
+	return m.APITokenIDCleared() || m.clearedapi_tokens
+}
+
+// APITokensID returns the "api_tokens" edge ID in the mutation.
+func (m *GenerationMutation) APITokensID() (id uuid.UUID, exists bool) {
+	if m.api_tokens != nil {
+		return *m.api_tokens, true
+	}
+	return
+}
+
+// APITokensIDs returns the "api_tokens" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// APITokensID instead. It exists only for internal usage by the builders.
+func (m *GenerationMutation) APITokensIDs() (ids []uuid.UUID) {
+	if id := m.api_tokens; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAPITokens resets all changes to the "api_tokens" edge.
+func (m *GenerationMutation) ResetAPITokens() {
+	m.api_tokens = nil
+	m.clearedapi_tokens = false
+}
+
+// AddGenerationOutputIDs adds the "generation_outputs" edge to the GenerationOutput entity by ids.
+func (m *GenerationMutation) AddGenerationOutputIDs(ids ...uuid.UUID) {
+	if m.generation_outputs == nil {
+		m.generation_outputs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.generation_outputs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGenerationOutputs clears the "generation_outputs" edge to the GenerationOutput entity.
+func (m *GenerationMutation) ClearGenerationOutputs() {
+	m.clearedgeneration_outputs = true
+}
+
+// GenerationOutputsCleared reports if the "generation_outputs" edge to the GenerationOutput entity was cleared.
+func (m *GenerationMutation) GenerationOutputsCleared() bool {
+	return m.clearedgeneration_outputs
+}
+
+// RemoveGenerationOutputIDs removes the "generation_outputs" edge to the GenerationOutput entity by IDs.
+func (m *GenerationMutation) RemoveGenerationOutputIDs(ids ...uuid.UUID) {
+	if m.removedgeneration_outputs == nil {
+		m.removedgeneration_outputs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.generation_outputs, ids[i])
+		m.removedgeneration_outputs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGenerationOutputs returns the removed IDs of the "generation_outputs" edge to the GenerationOutput entity.
+func (m *GenerationMutation) RemovedGenerationOutputsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgeneration_outputs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GenerationOutputsIDs returns the "generation_outputs" edge IDs in the mutation.
+func (m *GenerationMutation) GenerationOutputsIDs() (ids []uuid.UUID) {
+	for id := range m.generation_outputs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGenerationOutputs resets all changes to the "generation_outputs" edge.
+func (m *GenerationMutation) ResetGenerationOutputs() {
+	m.generation_outputs = nil
+	m.clearedgeneration_outputs = false
+	m.removedgeneration_outputs = nil
+}
+
+// Where appends a list predicates to the GenerationMutation builder.
+func (m *GenerationMutation) Where(ps ...predicate.Generation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GenerationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GenerationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Generation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GenerationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GenerationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Generation).
+func (m *GenerationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GenerationMutation) Fields() []string {
+	fields := make([]string, 0, 31)
+	if m.width != nil {
+		fields = append(fields, generation.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, generation.FieldHeight)
+	}
+	if m.inference_steps != nil {
+		fields = append(fields, generation.FieldInferenceSteps)
+	}
+	if m.guidance_scale != nil {
+		fields = append(fields, generation.FieldGuidanceScale)
+	}
+	if m.num_outputs != nil {
+		fields = append(fields, generation.FieldNumOutputs)
+	}
+	if m.nsfw_count != nil {
+		fields = append(fields, generation.FieldNsfwCount)
+	}
+	if m.seed != nil {
+		fields = append(fields, generation.FieldSeed)
+	}
+	if m.status != nil {
+		fields = append(fields, generation.FieldStatus)
+	}
+	if m.failure_reason != nil {
+		fields = append(fields, generation.FieldFailureReason)
+	}
+	if m.country_code != nil {
+		fields = append(fields, generation.FieldCountryCode)
+	}
+	if m.init_image_url != nil {
+		fields = append(fields, generation.FieldInitImageURL)
+	}
+	if m.mask_image_url != nil {
+		fields = append(fields, generation.FieldMaskImageURL)
+	}
+	if m.prompt_strength != nil {
+		fields = append(fields, generation.FieldPromptStrength)
+	}
+	if m.was_auto_submitted != nil {
+		fields = append(fields, generation.FieldWasAutoSubmitted)
+	}
+	if m.stripe_product_id != nil {
+		fields = append(fields, generation.FieldStripeProductID)
+	}
+	if m.source_type != nil {
+		fields = append(fields, generation.FieldSourceType)
+	}
+	if m.webhook_token != nil {
+		fields = append(fields, generation.FieldWebhookToken)
+	}
+	if m.prompt != nil {
+		fields = append(fields, generation.FieldPromptID)
+	}
+	if m.negative_prompt != nil {
+		fields = append(fields, generation.FieldNegativePromptID)
+	}
+	if m.generation_model != nil {
+		fields = append(fields, generation.FieldModelID)
+	}
+	if m.scheduler != nil {
+		fields = append(fields, generation.FieldSchedulerID)
+	}
+	if m.user != nil {
+		fields = append(fields, generation.FieldUserID)
+	}
+	if m.device_info != nil {
+		fields = append(fields, generation.FieldDeviceInfoID)
+	}
+	if m.api_tokens != nil {
+		fields = append(fields, generation.FieldAPITokenID)
+	}
+	if m.batch_id != nil {
+		fields = append(fields, generation.FieldBatchID)
+	}
+	if m.source_output_id != nil {
+		fields = append(fields, generation.FieldSourceOutputID)
+	}
+	if m.requeued_at != nil {
+		fields = append(fields, generation.FieldRequeuedAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, generation.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, generation.FieldCompletedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, generation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, generation.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GenerationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case generation.FieldWidth:
+		return m.Width()
+	case generation.FieldHeight:
+		return m.Height()
+	case generation.FieldInferenceSteps:
+		return m.InferenceSteps()
+	case generation.FieldGuidanceScale:
+		return m.GuidanceScale()
+	case generation.FieldNumOutputs:
+		return m.NumOutputs()
+	case generation.FieldNsfwCount:
+		return m.NsfwCount()
+	case generation.FieldSeed:
+		return m.Seed()
+	case generation.FieldStatus:
+		return m.Status()
+	case generation.FieldFailureReason:
+		return m.FailureReason()
+	case generation.FieldCountryCode:
+		return m.CountryCode()
+	case generation.FieldInitImageURL:
+		return m.InitImageURL()
+	case generation.FieldMaskImageURL:
+		return m.MaskImageURL()
+	case generation.FieldPromptStrength:
+		return m.PromptStrength()
+	case generation.FieldWasAutoSubmitted:
+		return m.WasAutoSubmitted()
+	case generation.FieldStripeProductID:
+		return m.StripeProductID()
+	case generation.FieldSourceType:
+		return m.SourceType()
+	case generation.FieldWebhookToken:
+		return m.WebhookToken()
+	case generation.FieldPromptID:
+		return m.PromptID()
+	case generation.FieldNegativePromptID:
+		return m.NegativePromptID()
+	case generation.FieldModelID:
+		return m.ModelID()
+	case generation.FieldSchedulerID:
+		return m.SchedulerID()
+	case generation.FieldUserID:
+		return m.UserID()
+	case generation.FieldDeviceInfoID:
+		return m.DeviceInfoID()
+	case generation.FieldAPITokenID:
+		return m.APITokenID()
+	case generation.FieldBatchID:
+		return m.BatchID()
+	case generation.FieldSourceOutputID:
+		return m.SourceOutputID()
+	case generation.FieldRequeuedAt:
+		return m.RequeuedAt()
+	case generation.FieldStartedAt:
+		return m.StartedAt()
+	case generation.FieldCompletedAt:
+		return m.CompletedAt()
+	case generation.FieldCreatedAt:
+		return m.CreatedAt()
+	case generation.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GenerationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case generation.FieldWidth:
+		return m.OldWidth(ctx)
+	case generation.FieldHeight:
+		return m.OldHeight(ctx)
+	case generation.FieldInferenceSteps:
+		return m.OldInferenceSteps(ctx)
+	case generation.FieldGuidanceScale:
+		return m.OldGuidanceScale(ctx)
+	case generation.FieldNumOutputs:
+		return m.OldNumOutputs(ctx)
+	case generation.FieldNsfwCount:
+		return m.OldNsfwCount(ctx)
+	case generation.FieldSeed:
+		return m.OldSeed(ctx)
+	case generation.FieldStatus:
+		return m.OldStatus(ctx)
+	case generation.FieldFailureReason:
+		return m.OldFailureReason(ctx)
+	case generation.FieldCountryCode:
+		return m.OldCountryCode(ctx)
+	case generation.FieldInitImageURL:
+		return m.OldInitImageURL(ctx)
+	case generation.FieldMaskImageURL:
+		return m.OldMaskImageURL(ctx)
+	case generation.FieldPromptStrength:
+		return m.OldPromptStrength(ctx)
+	case generation.FieldWasAutoSubmitted:
+		return m.OldWasAutoSubmitted(ctx)
+	case generation.FieldStripeProductID:
+		return m.OldStripeProductID(ctx)
+	case generation.FieldSourceType:
+		return m.OldSourceType(ctx)
+	case generation.FieldWebhookToken:
+		return m.OldWebhookToken(ctx)
+	case generation.FieldPromptID:
+		return m.OldPromptID(ctx)
+	case generation.FieldNegativePromptID:
+		return m.OldNegativePromptID(ctx)
+	case generation.FieldModelID:
+		return m.OldModelID(ctx)
+	case generation.FieldSchedulerID:
+		return m.OldSchedulerID(ctx)
+	case generation.FieldUserID:
+		return m.OldUserID(ctx)
+	case generation.FieldDeviceInfoID:
+		return m.OldDeviceInfoID(ctx)
+	case generation.FieldAPITokenID:
+		return m.OldAPITokenID(ctx)
+	case generation.FieldBatchID:
+		return m.OldBatchID(ctx)
+	case generation.FieldSourceOutputID:
+		return m.OldSourceOutputID(ctx)
+	case generation.FieldRequeuedAt:
+		return m.OldRequeuedAt(ctx)
+	case generation.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case generation.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case generation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case generation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Generation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenerationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case generation.FieldWidth:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case generation.FieldHeight:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case generation.FieldInferenceSteps:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInferenceSteps(v)
+		return nil
+	case generation.FieldGuidanceScale:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuidanceScale(v)
+		return nil
+	case generation.FieldNumOutputs:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumOutputs(v)
+		return nil
+	case generation.FieldNsfwCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNsfwCount(v)
+		return nil
+	case generation.FieldSeed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeed(v)
+		return nil
+	case generation.FieldStatus:
+		v, ok := value.(generation.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case generation.FieldFailureReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailureReason(v)
+		return nil
+	case generation.FieldCountryCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountryCode(v)
+		return nil
+	case generation.FieldInitImageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInitImageURL(v)
+		return nil
+	case generation.FieldMaskImageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaskImageURL(v)
+		return nil
+	case generation.FieldPromptStrength:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptStrength(v)
+		return nil
+	case generation.FieldWasAutoSubmitted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWasAutoSubmitted(v)
+		return nil
+	case generation.FieldStripeProductID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStripeProductID(v)
+		return nil
+	case generation.FieldSourceType:
+		v, ok := value.(enttypes.SourceType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceType(v)
+		return nil
+	case generation.FieldWebhookToken:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebhookToken(v)
+		return nil
+	case generation.FieldPromptID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptID(v)
+		return nil
+	case generation.FieldNegativePromptID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNegativePromptID(v)
+		return nil
+	case generation.FieldModelID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelID(v)
+		return nil
+	case generation.FieldSchedulerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchedulerID(v)
+		return nil
+	case generation.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case generation.FieldDeviceInfoID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceInfoID(v)
+		return nil
+	case generation.FieldAPITokenID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPITokenID(v)
+		return nil
+	case generation.FieldBatchID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBatchID(v)
+		return nil
+	case generation.FieldSourceOutputID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceOutputID(v)
+		return nil
+	case generation.FieldRequeuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequeuedAt(v)
+		return nil
+	case generation.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case generation.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case generation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case generation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Generation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GenerationMutation) AddedFields() []string {
+	var fields []string
+	if m.addwidth != nil {
+		fields = append(fields, generation.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, generation.FieldHeight)
+	}
+	if m.addinference_steps != nil {
+		fields = append(fields, generation.FieldInferenceSteps)
+	}
+	if m.addguidance_scale != nil {
+		fields = append(fields, generation.FieldGuidanceScale)
+	}
+	if m.addnum_outputs != nil {
+		fields = append(fields, generation.FieldNumOutputs)
+	}
+	if m.addnsfw_count != nil {
+		fields = append(fields, generation.FieldNsfwCount)
+	}
+	if m.addseed != nil {
+		fields = append(fields, generation.FieldSeed)
+	}
+	if m.addprompt_strength != nil {
+		fields = append(fields, generation.FieldPromptStrength)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GenerationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case generation.FieldWidth:
+		return m.AddedWidth()
+	case generation.FieldHeight:
+		return m.AddedHeight()
+	case generation.FieldInferenceSteps:
+		return m.AddedInferenceSteps()
+	case generation.FieldGuidanceScale:
+		return m.AddedGuidanceScale()
+	case generation.FieldNumOutputs:
+		return m.AddedNumOutputs()
+	case generation.FieldNsfwCount:
+		return m.AddedNsfwCount()
+	case generation.FieldSeed:
+		return m.AddedSeed()
+	case generation.FieldPromptStrength:
+		return m.AddedPromptStrength()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenerationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case generation.FieldWidth:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case generation.FieldHeight:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case generation.FieldInferenceSteps:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInferenceSteps(v)
+		return nil
+	case generation.FieldGuidanceScale:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGuidanceScale(v)
+		return nil
+	case generation.FieldNumOutputs:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumOutputs(v)
+		return nil
+	case generation.FieldNsfwCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNsfwCount(v)
+		return nil
+	case generation.FieldSeed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeed(v)
+		return nil
+	case generation.FieldPromptStrength:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptStrength(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Generation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GenerationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(generation.FieldFailureReason) {
+		fields = append(fields, generation.FieldFailureReason)
+	}
+	if m.FieldCleared(generation.FieldCountryCode) {
+		fields = append(fields, generation.FieldCountryCode)
+	}
+	if m.FieldCleared(generation.FieldInitImageURL) {
+		fields = append(fields, generation.FieldInitImageURL)
+	}
+	if m.FieldCleared(generation.FieldMaskImageURL) {
+		fields = append(fields, generation.FieldMaskImageURL)
+	}
+	if m.FieldCleared(generation.FieldPromptStrength) {
+		fields = append(fields, generation.FieldPromptStrength)
+	}
+	if m.FieldCleared(generation.FieldStripeProductID) {
+		fields = append(fields, generation.FieldStripeProductID)
+	}
+	if m.FieldCleared(generation.FieldPromptID) {
+		fields = append(fields, generation.FieldPromptID)
+	}
+	if m.FieldCleared(generation.FieldNegativePromptID) {
+		fields = append(fields, generation.FieldNegativePromptID)
+	}
+	if m.FieldCleared(generation.FieldAPITokenID) {
+		fields = append(fields, generation.FieldAPITokenID)
+	}
+	if m.FieldCleared(generation.FieldBatchID) {
+		fields = append(fields, generation.FieldBatchID)
+	}
+	if m.FieldCleared(generation.FieldSourceOutputID) {
+		fields = append(fields, generation.FieldSourceOutputID)
+	}
+	if m.FieldCleared(generation.FieldRequeuedAt) {
+		fields = append(fields, generation.FieldRequeuedAt)
+	}
+	if m.FieldCleared(generation.FieldStartedAt) {
+		fields = append(fields, generation.FieldStartedAt)
+	}
+	if m.FieldCleared(generation.FieldCompletedAt) {
+		fields = append(fields, generation.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GenerationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GenerationMutation) ClearField(name string) error {
+	switch name {
+	case generation.FieldFailureReason:
+		m.ClearFailureReason()
+		return nil
+	case generation.FieldCountryCode:
+		m.ClearCountryCode()
+		return nil
+	case generation.FieldInitImageURL:
+		m.ClearInitImageURL()
+		return nil
+	case generation.FieldMaskImageURL:
+		m.ClearMaskImageURL()
+		return nil
+	case generation.FieldPromptStrength:
+		m.ClearPromptStrength()
+		return nil
+	case generation.FieldStripeProductID:
+		m.ClearStripeProductID()
+		return nil
+	case generation.FieldPromptID:
+		m.ClearPromptID()
+		return nil
+	case generation.FieldNegativePromptID:
+		m.ClearNegativePromptID()
+		return nil
+	case generation.FieldAPITokenID:
+		m.ClearAPITokenID()
+		return nil
+	case generation.FieldBatchID:
+		m.ClearBatchID()
+		return nil
+	case generation.FieldSourceOutputID:
+		m.ClearSourceOutputID()
+		return nil
+	case generation.FieldRequeuedAt:
+		m.ClearRequeuedAt()
+		return nil
+	case generation.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case generation.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Generation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GenerationMutation) ResetField(name string) error {
+	switch name {
+	case generation.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case generation.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case generation.FieldInferenceSteps:
+		m.ResetInferenceSteps()
+		return nil
+	case generation.FieldGuidanceScale:
+		m.ResetGuidanceScale()
+		return nil
+	case generation.FieldNumOutputs:
+		m.ResetNumOutputs()
+		return nil
+	case generation.FieldNsfwCount:
+		m.ResetNsfwCount()
+		return nil
+	case generation.FieldSeed:
+		m.ResetSeed()
+		return nil
+	case generation.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case generation.FieldFailureReason:
+		m.ResetFailureReason()
+		return nil
+	case generation.FieldCountryCode:
+		m.ResetCountryCode()
+		return nil
+	case generation.FieldInitImageURL:
+		m.ResetInitImageURL()
+		return nil
+	case generation.FieldMaskImageURL:
+		m.ResetMaskImageURL()
+		return nil
+	case generation.FieldPromptStrength:
+		m.ResetPromptStrength()
+		return nil
+	case generation.FieldWasAutoSubmitted:
+		m.ResetWasAutoSubmitted()
+		return nil
+	case generation.FieldStripeProductID:
+		m.ResetStripeProductID()
+		return nil
+	case generation.FieldSourceType:
+		m.ResetSourceType()
+		return nil
+	case generation.FieldWebhookToken:
+		m.ResetWebhookToken()
+		return nil
+	case generation.FieldPromptID:
+		m.ResetPromptID()
+		return nil
+	case generation.FieldNegativePromptID:
+		m.ResetNegativePromptID()
+		return nil
+	case generation.FieldModelID:
+		m.ResetModelID()
+		return nil
+	case generation.FieldSchedulerID:
+		m.ResetSchedulerID()
+		return nil
+	case generation.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case generation.FieldDeviceInfoID:
+		m.ResetDeviceInfoID()
+		return nil
+	case generation.FieldAPITokenID:
+		m.ResetAPITokenID()
+		return nil
+	case generation.FieldBatchID:
+		m.ResetBatchID()
+		return nil
+	case generation.FieldSourceOutputID:
+		m.ResetSourceOutputID()
+		return nil
+	case generation.FieldRequeuedAt:
+		m.ResetRequeuedAt()
+		return nil
+	case generation.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case generation.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case generation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case generation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Generation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GenerationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.device_info != nil {
+		edges = append(edges, generation.EdgeDeviceInfo)
+	}
+	if m.scheduler != nil {
+		edges = append(edges, generation.EdgeScheduler)
+	}
+	if m.prompt != nil {
+		edges = append(edges, generation.EdgePrompt)
+	}
+	if m.negative_prompt != nil {
+		edges = append(edges, generation.EdgeNegativePrompt)
+	}
+	if m.generation_model != nil {
+		edges = append(edges, generation.EdgeGenerationModel)
+	}
+	if m.user != nil {
+		edges = append(edges, generation.EdgeUser)
+	}
+	if m.api_tokens != nil {
+		edges = append(edges, generation.EdgeAPITokens)
+	}
+	if m.generation_outputs != nil {
+		edges = append(edges, generation.EdgeGenerationOutputs)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GenerationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case generation.EdgeDeviceInfo:
+		if id := m.device_info; id != nil {
+			return []ent.Value{*id}
+		}
+	case generation.EdgeScheduler:
+		if id := m.scheduler; id != nil {
+			return []ent.Value{*id}
+		}
+	case generation.EdgePrompt:
+		if id := m.prompt; id != nil {
+			return []ent.Value{*id}
+		}
+	case generation.EdgeNegativePrompt:
+		if id := m.negative_prompt; id != nil {
+			return []ent.Value{*id}
+		}
+	case generation.EdgeGenerationModel:
+		if id := m.generation_model; id != nil {
+			return []ent.Value{*id}
+		}
+	case generation.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case generation.EdgeAPITokens:
+		if id := m.api_tokens; id != nil {
+			return []ent.Value{*id}
+		}
+	case generation.EdgeGenerationOutputs:
+		ids := make([]ent.Value, 0, len(m.generation_outputs))
+		for id := range m.generation_outputs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GenerationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedgeneration_outputs != nil {
+		edges = append(edges, generation.EdgeGenerationOutputs)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GenerationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case generation.EdgeGenerationOutputs:
+		ids := make([]ent.Value, 0, len(m.removedgeneration_outputs))
+		for id := range m.removedgeneration_outputs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GenerationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.cleareddevice_info {
+		edges = append(edges, generation.EdgeDeviceInfo)
+	}
+	if m.clearedscheduler {
+		edges = append(edges, generation.EdgeScheduler)
+	}
+	if m.clearedprompt {
+		edges = append(edges, generation.EdgePrompt)
+	}
+	if m.clearednegative_prompt {
+		edges = append(edges, generation.EdgeNegativePrompt)
+	}
+	if m.clearedgeneration_model {
+		edges = append(edges, generation.EdgeGenerationModel)
+	}
+	if m.cleareduser {
+		edges = append(edges, generation.EdgeUser)
+	}
+	if m.clearedapi_tokens {
+		edges = append(edges, generation.EdgeAPITokens)
+	}
+	if m.clearedgeneration_outputs {
+		edges = append(edges, generation.EdgeGenerationOutputs)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GenerationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case generation.EdgeDeviceInfo:
+		return m.cleareddevice_info
+	case generation.EdgeScheduler:
+		return m.clearedscheduler
+	case generation.EdgePrompt:
+		return m.clearedprompt
+	case generation.EdgeNegativePrompt:
+		return m.clearednegative_prompt
+	case generation.EdgeGenerationModel:
+		return m.clearedgeneration_model
+	case generation.EdgeUser:
+		return m.cleareduser
+	case generation.EdgeAPITokens:
+		return m.clearedapi_tokens
+	case generation.EdgeGenerationOutputs:
+		return m.clearedgeneration_outputs
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GenerationMutation) ClearEdge(name string) error {
+	switch name {
+	case generation.EdgeDeviceInfo:
+		m.ClearDeviceInfo()
+		return nil
+	case generation.EdgeScheduler:
+		m.ClearScheduler()
+		return nil
+	case generation.EdgePrompt:
+		m.ClearPrompt()
+		return nil
+	case generation.EdgeNegativePrompt:
+		m.ClearNegativePrompt()
+		return nil
+	case generation.EdgeGenerationModel:
+		m.ClearGenerationModel()
+		return nil
+	case generation.EdgeUser:
+		m.ClearUser()
+		return nil
+	case generation.EdgeAPITokens:
+		m.ClearAPITokens()
+		return nil
+	}
+	return fmt.Errorf("unknown Generation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GenerationMutation) ResetEdge(name string) error {
+	switch name {
+	case generation.EdgeDeviceInfo:
+		m.ResetDeviceInfo()
+		return nil
+	case generation.EdgeScheduler:
+		m.ResetScheduler()
+		return nil
+	case generation.EdgePrompt:
+		m.ResetPrompt()
+		return nil
+	case generation.EdgeNegativePrompt:
+		m.ResetNegativePrompt()
+		return nil
+	case generation.EdgeGenerationModel:
+		m.ResetGenerationModel()
+		return nil
+	case generation.EdgeUser:
+		m.ResetUser()
+		return nil
+	case generation.EdgeAPITokens:
+		m.ResetAPITokens()
+		return nil
+	case generation.EdgeGenerationOutputs:
+		m.ResetGenerationOutputs()
+		return nil
+	}
+	return fmt.Errorf("unknown Generation edge %s", name)
+}
+
+// GenerationBatchMutation represents an operation that mutates the GenerationBatch nodes in the graph.
+type GenerationBatchMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	user_id             *uuid.UUID
+	api_token_id        *uuid.UUID
+	status              *generationbatch.Status
+	items               *string
+	num_items           *int
+	addnum_items        *int
+	dispatched_items    *int
+	adddispatched_items *int
+	rejected_items      *int
+	addrejected_items   *int
+	credits_reserved    *int32
+	addcredits_reserved *int32
+	credits_refunded    *int32
+	addcredits_refunded *int32
+	free                *bool
+	queue_tier          *string
+	source_output_id    *uuid.UUID
+	variation_type      *generationbatch.VariationType
+	origin              **enttypes.RequestOrigin
+	dispatched_at       *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*GenerationBatch, error)
+	predicates          []predicate.GenerationBatch
+}
+
+var _ ent.Mutation = (*GenerationBatchMutation)(nil)
+
+// generationbatchOption allows management of the mutation configuration using functional options.
+type generationbatchOption func(*GenerationBatchMutation)
+
+// newGenerationBatchMutation creates new mutation for the GenerationBatch entity.
+func newGenerationBatchMutation(c config, op Op, opts ...generationbatchOption) *GenerationBatchMutation {
+	m := &GenerationBatchMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGenerationBatch,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGenerationBatchID sets the ID field of the mutation.
+func withGenerationBatchID(id uuid.UUID) generationbatchOption {
+	return func(m *GenerationBatchMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GenerationBatch
+		)
+		m.oldValue = func(ctx context.Context) (*GenerationBatch, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GenerationBatch.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGenerationBatch sets the old GenerationBatch of the mutation.
+func withGenerationBatch(node *GenerationBatch) generationbatchOption {
+	return func(m *GenerationBatchMutation) {
+		m.oldValue = func(context.Context) (*GenerationBatch, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GenerationBatchMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GenerationBatchMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GenerationBatch entities.
+func (m *GenerationBatchMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GenerationBatchMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GenerationBatchMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GenerationBatch.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *GenerationBatchMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *GenerationBatchMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *GenerationBatchMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetAPITokenID sets the "api_token_id" field.
+func (m *GenerationBatchMutation) SetAPITokenID(u uuid.UUID) {
+	m.api_token_id = &u
+}
+
+// APITokenID returns the value of the "api_token_id" field in the mutation.
+func (m *GenerationBatchMutation) APITokenID() (r uuid.UUID, exists bool) {
+	v := m.api_token_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPITokenID returns the old "api_token_id" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldAPITokenID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPITokenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPITokenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPITokenID: %w", err)
+	}
+	return oldValue.APITokenID, nil
+}
+
+// ClearAPITokenID clears the value of the "api_token_id" field.
+func (m *GenerationBatchMutation) ClearAPITokenID() {
+	m.api_token_id = nil
+	m.clearedFields[generationbatch.FieldAPITokenID] = struct{}{}
+}
+
+// APITokenIDCleared returns if the "api_token_id" field was cleared in this mutation.
+func (m *GenerationBatchMutation) APITokenIDCleared() bool {
+	_, ok := m.clearedFields[generationbatch.FieldAPITokenID]
+	return ok
+}
+
+// ResetAPITokenID resets all changes to the "api_token_id" field.
+func (m *GenerationBatchMutation) ResetAPITokenID() {
+	m.api_token_id = nil
+	delete(m.clearedFields, generationbatch.FieldAPITokenID)
+}
+
+// SetStatus sets the "status" field.
+func (m *GenerationBatchMutation) SetStatus(ge generationbatch.Status) {
+	m.status = &ge
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *GenerationBatchMutation) Status() (r generationbatch.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldStatus(ctx context.Context) (v generationbatch.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *GenerationBatchMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetItems sets the "items" field.
+func (m *GenerationBatchMutation) SetItems(s string) {
+	m.items = &s
+}
+
+// Items returns the value of the "items" field in the mutation.
+func (m *GenerationBatchMutation) Items() (r string, exists bool) {
+	v := m.items
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItems returns the old "items" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldItems(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItems is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItems requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItems: %w", err)
+	}
+	return oldValue.Items, nil
+}
+
+// ResetItems resets all changes to the "items" field.
+func (m *GenerationBatchMutation) ResetItems() {
+	m.items = nil
+}
+
+// SetNumItems sets the "num_items" field.
+func (m *GenerationBatchMutation) SetNumItems(i int) {
+	m.num_items = &i
+	m.addnum_items = nil
+}
+
+// NumItems returns the value of the "num_items" field in the mutation.
+func (m *GenerationBatchMutation) NumItems() (r int, exists bool) {
+	v := m.num_items
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumItems returns the old "num_items" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldNumItems(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumItems is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumItems requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumItems: %w", err)
+	}
+	return oldValue.NumItems, nil
+}
+
+// AddNumItems adds i to the "num_items" field.
+func (m *GenerationBatchMutation) AddNumItems(i int) {
+	if m.addnum_items != nil {
+		*m.addnum_items += i
+	} else {
+		m.addnum_items = &i
+	}
+}
+
+// AddedNumItems returns the value that was added to the "num_items" field in this mutation.
+func (m *GenerationBatchMutation) AddedNumItems() (r int, exists bool) {
+	v := m.addnum_items
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNumItems resets all changes to the "num_items" field.
+func (m *GenerationBatchMutation) ResetNumItems() {
+	m.num_items = nil
+	m.addnum_items = nil
+}
+
+// SetDispatchedItems sets the "dispatched_items" field.
+func (m *GenerationBatchMutation) SetDispatchedItems(i int) {
+	m.dispatched_items = &i
+	m.adddispatched_items = nil
+}
+
+// DispatchedItems returns the value of the "dispatched_items" field in the mutation.
+func (m *GenerationBatchMutation) DispatchedItems() (r int, exists bool) {
+	v := m.dispatched_items
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDispatchedItems returns the old "dispatched_items" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldDispatchedItems(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDispatchedItems is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDispatchedItems requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDispatchedItems: %w", err)
+	}
+	return oldValue.DispatchedItems, nil
+}
+
+// AddDispatchedItems adds i to the "dispatched_items" field.
+func (m *GenerationBatchMutation) AddDispatchedItems(i int) {
+	if m.adddispatched_items != nil {
+		*m.adddispatched_items += i
+	} else {
+		m.adddispatched_items = &i
+	}
+}
+
+// AddedDispatchedItems returns the value that was added to the "dispatched_items" field in this mutation.
+func (m *GenerationBatchMutation) AddedDispatchedItems() (r int, exists bool) {
+	v := m.adddispatched_items
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDispatchedItems resets all changes to the "dispatched_items" field.
+func (m *GenerationBatchMutation) ResetDispatchedItems() {
+	m.dispatched_items = nil
+	m.adddispatched_items = nil
+}
+
+// SetRejectedItems sets the "rejected_items" field.
+func (m *GenerationBatchMutation) SetRejectedItems(i int) {
+	m.rejected_items = &i
+	m.addrejected_items = nil
+}
+
+// RejectedItems returns the value of the "rejected_items" field in the mutation.
+func (m *GenerationBatchMutation) RejectedItems() (r int, exists bool) {
+	v := m.rejected_items
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRejectedItems returns the old "rejected_items" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldRejectedItems(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRejectedItems is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRejectedItems requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRejectedItems: %w", err)
+	}
+	return oldValue.RejectedItems, nil
+}
+
+// AddRejectedItems adds i to the "rejected_items" field.
+func (m *GenerationBatchMutation) AddRejectedItems(i int) {
+	if m.addrejected_items != nil {
+		*m.addrejected_items += i
+	} else {
+		m.addrejected_items = &i
+	}
+}
+
+// AddedRejectedItems returns the value that was added to the "rejected_items" field in this mutation.
+func (m *GenerationBatchMutation) AddedRejectedItems() (r int, exists bool) {
+	v := m.addrejected_items
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRejectedItems resets all changes to the "rejected_items" field.
+func (m *GenerationBatchMutation) ResetRejectedItems() {
+	m.rejected_items = nil
+	m.addrejected_items = nil
+}
+
+// SetCreditsReserved sets the "credits_reserved" field.
+func (m *GenerationBatchMutation) SetCreditsReserved(i int32) {
+	m.credits_reserved = &i
+	m.addcredits_reserved = nil
+}
+
+// CreditsReserved returns the value of the "credits_reserved" field in the mutation.
+func (m *GenerationBatchMutation) CreditsReserved() (r int32, exists bool) {
+	v := m.credits_reserved
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreditsReserved returns the old "credits_reserved" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldCreditsReserved(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreditsReserved is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreditsReserved requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreditsReserved: %w", err)
+	}
+	return oldValue.CreditsReserved, nil
+}
+
+// AddCreditsReserved adds i to the "credits_reserved" field.
+func (m *GenerationBatchMutation) AddCreditsReserved(i int32) {
+	if m.addcredits_reserved != nil {
+		*m.addcredits_reserved += i
+	} else {
+		m.addcredits_reserved = &i
+	}
+}
+
+// AddedCreditsReserved returns the value that was added to the "credits_reserved" field in this mutation.
+func (m *GenerationBatchMutation) AddedCreditsReserved() (r int32, exists bool) {
+	v := m.addcredits_reserved
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreditsReserved resets all changes to the "credits_reserved" field.
+func (m *GenerationBatchMutation) ResetCreditsReserved() {
+	m.credits_reserved = nil
+	m.addcredits_reserved = nil
+}
+
+// SetCreditsRefunded sets the "credits_refunded" field.
+func (m *GenerationBatchMutation) SetCreditsRefunded(i int32) {
+	m.credits_refunded = &i
+	m.addcredits_refunded = nil
+}
+
+// CreditsRefunded returns the value of the "credits_refunded" field in the mutation.
+func (m *GenerationBatchMutation) CreditsRefunded() (r int32, exists bool) {
+	v := m.credits_refunded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreditsRefunded returns the old "credits_refunded" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldCreditsRefunded(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreditsRefunded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreditsRefunded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreditsRefunded: %w", err)
+	}
+	return oldValue.CreditsRefunded, nil
+}
+
+// AddCreditsRefunded adds i to the "credits_refunded" field.
+func (m *GenerationBatchMutation) AddCreditsRefunded(i int32) {
+	if m.addcredits_refunded != nil {
+		*m.addcredits_refunded += i
+	} else {
+		m.addcredits_refunded = &i
+	}
+}
+
+// AddedCreditsRefunded returns the value that was added to the "credits_refunded" field in this mutation.
+func (m *GenerationBatchMutation) AddedCreditsRefunded() (r int32, exists bool) {
+	v := m.addcredits_refunded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreditsRefunded resets all changes to the "credits_refunded" field.
+func (m *GenerationBatchMutation) ResetCreditsRefunded() {
+	m.credits_refunded = nil
+	m.addcredits_refunded = nil
+}
+
+// SetFree sets the "free" field.
+func (m *GenerationBatchMutation) SetFree(b bool) {
+	m.free = &b
+}
+
+// Free returns the value of the "free" field in the mutation.
+func (m *GenerationBatchMutation) Free() (r bool, exists bool) {
+	v := m.free
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFree returns the old "free" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldFree(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFree is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFree requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFree: %w", err)
+	}
+	return oldValue.Free, nil
+}
+
+// ResetFree resets all changes to the "free" field.
+func (m *GenerationBatchMutation) ResetFree() {
+	m.free = nil
+}
+
+// SetQueueTier sets the "queue_tier" field.
+func (m *GenerationBatchMutation) SetQueueTier(s string) {
+	m.queue_tier = &s
+}
+
+// QueueTier returns the value of the "queue_tier" field in the mutation.
+func (m *GenerationBatchMutation) QueueTier() (r string, exists bool) {
+	v := m.queue_tier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQueueTier returns the old "queue_tier" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldQueueTier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQueueTier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQueueTier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQueueTier: %w", err)
+	}
+	return oldValue.QueueTier, nil
+}
+
+// ResetQueueTier resets all changes to the "queue_tier" field.
+func (m *GenerationBatchMutation) ResetQueueTier() {
+	m.queue_tier = nil
+}
+
+// SetSourceOutputID sets the "source_output_id" field.
+func (m *GenerationBatchMutation) SetSourceOutputID(u uuid.UUID) {
+	m.source_output_id = &u
+}
+
+// SourceOutputID returns the value of the "source_output_id" field in the mutation.
+func (m *GenerationBatchMutation) SourceOutputID() (r uuid.UUID, exists bool) {
+	v := m.source_output_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceOutputID returns the old "source_output_id" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldSourceOutputID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceOutputID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceOutputID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceOutputID: %w", err)
+	}
+	return oldValue.SourceOutputID, nil
+}
+
+// ClearSourceOutputID clears the value of the "source_output_id" field.
+func (m *GenerationBatchMutation) ClearSourceOutputID() {
+	m.source_output_id = nil
+	m.clearedFields[generationbatch.FieldSourceOutputID] = struct{}{}
+}
+
+// SourceOutputIDCleared returns if the "source_output_id" field was cleared in this mutation.
+func (m *GenerationBatchMutation) SourceOutputIDCleared() bool {
+	_, ok := m.clearedFields[generationbatch.FieldSourceOutputID]
+	return ok
+}
+
+// ResetSourceOutputID resets all changes to the "source_output_id" field.
+func (m *GenerationBatchMutation) ResetSourceOutputID() {
+	m.source_output_id = nil
+	delete(m.clearedFields, generationbatch.FieldSourceOutputID)
+}
+
+// SetVariationType sets the "variation_type" field.
+func (m *GenerationBatchMutation) SetVariationType(gt generationbatch.VariationType) {
+	m.variation_type = &gt
+}
+
+// VariationType returns the value of the "variation_type" field in the mutation.
+func (m *GenerationBatchMutation) VariationType() (r generationbatch.VariationType, exists bool) {
+	v := m.variation_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariationType returns the old "variation_type" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldVariationType(ctx context.Context) (v *generationbatch.VariationType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariationType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariationType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariationType: %w", err)
+	}
+	return oldValue.VariationType, nil
+}
+
+// ClearVariationType clears the value of the "variation_type" field.
+func (m *GenerationBatchMutation) ClearVariationType() {
+	m.variation_type = nil
+	m.clearedFields[generationbatch.FieldVariationType] = struct{}{}
+}
+
+// VariationTypeCleared returns if the "variation_type" field was cleared in this mutation.
+func (m *GenerationBatchMutation) VariationTypeCleared() bool {
+	_, ok := m.clearedFields[generationbatch.FieldVariationType]
+	return ok
+}
+
+// ResetVariationType resets all changes to the "variation_type" field.
+func (m *GenerationBatchMutation) ResetVariationType() {
+	m.variation_type = nil
+	delete(m.clearedFields, generationbatch.FieldVariationType)
+}
+
+// SetOrigin sets the "origin" field.
+func (m *GenerationBatchMutation) SetOrigin(eo *enttypes.RequestOrigin) {
+	m.origin = &eo
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *GenerationBatchMutation) Origin() (r *enttypes.RequestOrigin, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldOrigin(ctx context.Context) (v *enttypes.RequestOrigin, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (m *GenerationBatchMutation) ClearOrigin() {
+	m.origin = nil
+	m.clearedFields[generationbatch.FieldOrigin] = struct{}{}
+}
+
+// OriginCleared returns if the "origin" field was cleared in this mutation.
+func (m *GenerationBatchMutation) OriginCleared() bool {
+	_, ok := m.clearedFields[generationbatch.FieldOrigin]
+	return ok
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *GenerationBatchMutation) ResetOrigin() {
+	m.origin = nil
+	delete(m.clearedFields, generationbatch.FieldOrigin)
+}
+
+// SetDispatchedAt sets the "dispatched_at" field.
+func (m *GenerationBatchMutation) SetDispatchedAt(t time.Time) {
+	m.dispatched_at = &t
+}
+
+// DispatchedAt returns the value of the "dispatched_at" field in the mutation.
+func (m *GenerationBatchMutation) DispatchedAt() (r time.Time, exists bool) {
+	v := m.dispatched_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDispatchedAt returns the old "dispatched_at" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldDispatchedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDispatchedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDispatchedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDispatchedAt: %w", err)
+	}
+	return oldValue.DispatchedAt, nil
+}
+
+// ClearDispatchedAt clears the value of the "dispatched_at" field.
+func (m *GenerationBatchMutation) ClearDispatchedAt() {
+	m.dispatched_at = nil
+	m.clearedFields[generationbatch.FieldDispatchedAt] = struct{}{}
+}
+
+// DispatchedAtCleared returns if the "dispatched_at" field was cleared in this mutation.
+func (m *GenerationBatchMutation) DispatchedAtCleared() bool {
+	_, ok := m.clearedFields[generationbatch.FieldDispatchedAt]
+	return ok
+}
+
+// ResetDispatchedAt resets all changes to the "dispatched_at" field.
+func (m *GenerationBatchMutation) ResetDispatchedAt() {
+	m.dispatched_at = nil
+	delete(m.clearedFields, generationbatch.FieldDispatchedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GenerationBatchMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GenerationBatchMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GenerationBatchMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GenerationBatchMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GenerationBatchMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the GenerationBatch entity.
+// If the GenerationBatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationBatchMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GenerationBatchMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the GenerationBatchMutation builder.
+func (m *GenerationBatchMutation) Where(ps ...predicate.GenerationBatch) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GenerationBatchMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GenerationBatchMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GenerationBatch, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GenerationBatchMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GenerationBatchMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GenerationBatch).
+func (m *GenerationBatchMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GenerationBatchMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.user_id != nil {
+		fields = append(fields, generationbatch.FieldUserID)
+	}
+	if m.api_token_id != nil {
+		fields = append(fields, generationbatch.FieldAPITokenID)
+	}
+	if m.status != nil {
+		fields = append(fields, generationbatch.FieldStatus)
+	}
+	if m.items != nil {
+		fields = append(fields, generationbatch.FieldItems)
+	}
+	if m.num_items != nil {
+		fields = append(fields, generationbatch.FieldNumItems)
+	}
+	if m.dispatched_items != nil {
+		fields = append(fields, generationbatch.FieldDispatchedItems)
+	}
+	if m.rejected_items != nil {
+		fields = append(fields, generationbatch.FieldRejectedItems)
+	}
+	if m.credits_reserved != nil {
+		fields = append(fields, generationbatch.FieldCreditsReserved)
+	}
+	if m.credits_refunded != nil {
+		fields = append(fields, generationbatch.FieldCreditsRefunded)
+	}
+	if m.free != nil {
+		fields = append(fields, generationbatch.FieldFree)
+	}
+	if m.queue_tier != nil {
+		fields = append(fields, generationbatch.FieldQueueTier)
+	}
+	if m.source_output_id != nil {
+		fields = append(fields, generationbatch.FieldSourceOutputID)
+	}
+	if m.variation_type != nil {
+		fields = append(fields, generationbatch.FieldVariationType)
+	}
+	if m.origin != nil {
+		fields = append(fields, generationbatch.FieldOrigin)
+	}
+	if m.dispatched_at != nil {
+		fields = append(fields, generationbatch.FieldDispatchedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, generationbatch.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, generationbatch.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GenerationBatchMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case generationbatch.FieldUserID:
+		return m.UserID()
+	case generationbatch.FieldAPITokenID:
+		return m.APITokenID()
+	case generationbatch.FieldStatus:
+		return m.Status()
+	case generationbatch.FieldItems:
+		return m.Items()
+	case generationbatch.FieldNumItems:
+		return m.NumItems()
+	case generationbatch.FieldDispatchedItems:
+		return m.DispatchedItems()
+	case generationbatch.FieldRejectedItems:
+		return m.RejectedItems()
+	case generationbatch.FieldCreditsReserved:
+		return m.CreditsReserved()
+	case generationbatch.FieldCreditsRefunded:
+		return m.CreditsRefunded()
+	case generationbatch.FieldFree:
+		return m.Free()
+	case generationbatch.FieldQueueTier:
+		return m.QueueTier()
+	case generationbatch.FieldSourceOutputID:
+		return m.SourceOutputID()
+	case generationbatch.FieldVariationType:
+		return m.VariationType()
+	case generationbatch.FieldOrigin:
+		return m.Origin()
+	case generationbatch.FieldDispatchedAt:
+		return m.DispatchedAt()
+	case generationbatch.FieldCreatedAt:
+		return m.CreatedAt()
+	case generationbatch.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GenerationBatchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case generationbatch.FieldUserID:
+		return m.OldUserID(ctx)
+	case generationbatch.FieldAPITokenID:
+		return m.OldAPITokenID(ctx)
+	case generationbatch.FieldStatus:
+		return m.OldStatus(ctx)
+	case generationbatch.FieldItems:
+		return m.OldItems(ctx)
+	case generationbatch.FieldNumItems:
+		return m.OldNumItems(ctx)
+	case generationbatch.FieldDispatchedItems:
+		return m.OldDispatchedItems(ctx)
+	case generationbatch.FieldRejectedItems:
+		return m.OldRejectedItems(ctx)
+	case generationbatch.FieldCreditsReserved:
+		return m.OldCreditsReserved(ctx)
+	case generationbatch.FieldCreditsRefunded:
+		return m.OldCreditsRefunded(ctx)
+	case generationbatch.FieldFree:
+		return m.OldFree(ctx)
+	case generationbatch.FieldQueueTier:
+		return m.OldQueueTier(ctx)
+	case generationbatch.FieldSourceOutputID:
+		return m.OldSourceOutputID(ctx)
+	case generationbatch.FieldVariationType:
+		return m.OldVariationType(ctx)
+	case generationbatch.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case generationbatch.FieldDispatchedAt:
+		return m.OldDispatchedAt(ctx)
+	case generationbatch.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case generationbatch.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GenerationBatch field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenerationBatchMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case generationbatch.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case generationbatch.FieldAPITokenID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPITokenID(v)
+		return nil
+	case generationbatch.FieldStatus:
+		v, ok := value.(generationbatch.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case generationbatch.FieldItems:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItems(v)
+		return nil
+	case generationbatch.FieldNumItems:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumItems(v)
+		return nil
+	case generationbatch.FieldDispatchedItems:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDispatchedItems(v)
+		return nil
+	case generationbatch.FieldRejectedItems:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRejectedItems(v)
+		return nil
+	case generationbatch.FieldCreditsReserved:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreditsReserved(v)
+		return nil
+	case generationbatch.FieldCreditsRefunded:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreditsRefunded(v)
+		return nil
+	case generationbatch.FieldFree:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFree(v)
+		return nil
+	case generationbatch.FieldQueueTier:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueueTier(v)
+		return nil
+	case generationbatch.FieldSourceOutputID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceOutputID(v)
+		return nil
+	case generationbatch.FieldVariationType:
+		v, ok := value.(generationbatch.VariationType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariationType(v)
+		return nil
+	case generationbatch.FieldOrigin:
+		v, ok := value.(*enttypes.RequestOrigin)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case generationbatch.FieldDispatchedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDispatchedAt(v)
+		return nil
+	case generationbatch.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case generationbatch.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationBatch field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GenerationBatchMutation) AddedFields() []string {
+	var fields []string
+	if m.addnum_items != nil {
+		fields = append(fields, generationbatch.FieldNumItems)
+	}
+	if m.adddispatched_items != nil {
+		fields = append(fields, generationbatch.FieldDispatchedItems)
+	}
+	if m.addrejected_items != nil {
+		fields = append(fields, generationbatch.FieldRejectedItems)
+	}
+	if m.addcredits_reserved != nil {
+		fields = append(fields, generationbatch.FieldCreditsReserved)
+	}
+	if m.addcredits_refunded != nil {
+		fields = append(fields, generationbatch.FieldCreditsRefunded)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GenerationBatchMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case generationbatch.FieldNumItems:
+		return m.AddedNumItems()
+	case generationbatch.FieldDispatchedItems:
+		return m.AddedDispatchedItems()
+	case generationbatch.FieldRejectedItems:
+		return m.AddedRejectedItems()
+	case generationbatch.FieldCreditsReserved:
+		return m.AddedCreditsReserved()
+	case generationbatch.FieldCreditsRefunded:
+		return m.AddedCreditsRefunded()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenerationBatchMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case generationbatch.FieldNumItems:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumItems(v)
+		return nil
+	case generationbatch.FieldDispatchedItems:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDispatchedItems(v)
+		return nil
+	case generationbatch.FieldRejectedItems:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRejectedItems(v)
+		return nil
+	case generationbatch.FieldCreditsReserved:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreditsReserved(v)
+		return nil
+	case generationbatch.FieldCreditsRefunded:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreditsRefunded(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationBatch numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GenerationBatchMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(generationbatch.FieldAPITokenID) {
+		fields = append(fields, generationbatch.FieldAPITokenID)
+	}
+	if m.FieldCleared(generationbatch.FieldSourceOutputID) {
+		fields = append(fields, generationbatch.FieldSourceOutputID)
+	}
+	if m.FieldCleared(generationbatch.FieldVariationType) {
+		fields = append(fields, generationbatch.FieldVariationType)
+	}
+	if m.FieldCleared(generationbatch.FieldOrigin) {
+		fields = append(fields, generationbatch.FieldOrigin)
+	}
+	if m.FieldCleared(generationbatch.FieldDispatchedAt) {
+		fields = append(fields, generationbatch.FieldDispatchedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GenerationBatchMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GenerationBatchMutation) ClearField(name string) error {
+	switch name {
+	case generationbatch.FieldAPITokenID:
+		m.ClearAPITokenID()
+		return nil
+	case generationbatch.FieldSourceOutputID:
+		m.ClearSourceOutputID()
+		return nil
+	case generationbatch.FieldVariationType:
+		m.ClearVariationType()
+		return nil
+	case generationbatch.FieldOrigin:
+		m.ClearOrigin()
+		return nil
+	case generationbatch.FieldDispatchedAt:
+		m.ClearDispatchedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationBatch nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GenerationBatchMutation) ResetField(name string) error {
+	switch name {
+	case generationbatch.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case generationbatch.FieldAPITokenID:
+		m.ResetAPITokenID()
+		return nil
+	case generationbatch.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case generationbatch.FieldItems:
+		m.ResetItems()
+		return nil
+	case generationbatch.FieldNumItems:
+		m.ResetNumItems()
+		return nil
+	case generationbatch.FieldDispatchedItems:
+		m.ResetDispatchedItems()
+		return nil
+	case generationbatch.FieldRejectedItems:
+		m.ResetRejectedItems()
+		return nil
+	case generationbatch.FieldCreditsReserved:
+		m.ResetCreditsReserved()
+		return nil
+	case generationbatch.FieldCreditsRefunded:
+		m.ResetCreditsRefunded()
+		return nil
+	case generationbatch.FieldFree:
+		m.ResetFree()
+		return nil
+	case generationbatch.FieldQueueTier:
+		m.ResetQueueTier()
+		return nil
+	case generationbatch.FieldSourceOutputID:
+		m.ResetSourceOutputID()
+		return nil
+	case generationbatch.FieldVariationType:
+		m.ResetVariationType()
+		return nil
+	case generationbatch.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case generationbatch.FieldDispatchedAt:
+		m.ResetDispatchedAt()
+		return nil
+	case generationbatch.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case generationbatch.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationBatch field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GenerationBatchMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GenerationBatchMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GenerationBatchMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GenerationBatchMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GenerationBatchMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GenerationBatchMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GenerationBatchMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GenerationBatch unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GenerationBatchMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GenerationBatch edge %s", name)
+}
+
+// GenerationModelMutation represents an operation that mutates the GenerationModel nodes in the graph.
+type GenerationModelMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	name_in_worker             *string
+	short_name                 *string
+	is_active                  *bool
+	is_default                 *bool
+	is_hidden                  *bool
+	runpod_endpoint            *string
+	runpod_active              *bool
+	display_weight             *int32
+	adddisplay_weight          *int32
+	default_scheduler_id       *uuid.UUID
+	default_width              *int32
+	adddefault_width           *int32
+	default_height             *int32
+	adddefault_height          *int32
+	default_inference_steps    *int32
+	adddefault_inference_steps *int32
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	clearedFields              map[string]struct{}
+	generations                map[uuid.UUID]struct{}
+	removedgenerations         map[uuid.UUID]struct{}
+	clearedgenerations         bool
+	schedulers                 map[uuid.UUID]struct{}
+	removedschedulers          map[uuid.UUID]struct{}
+	clearedschedulers          bool
+	done                       bool
+	oldValue                   func(context.Context) (*GenerationModel, error)
+	predicates                 []predicate.GenerationModel
+}
+
+var _ ent.Mutation = (*GenerationModelMutation)(nil)
+
+// generationmodelOption allows management of the mutation configuration using functional options.
+type generationmodelOption func(*GenerationModelMutation)
+
+// newGenerationModelMutation creates new mutation for the GenerationModel entity.
+func newGenerationModelMutation(c config, op Op, opts ...generationmodelOption) *GenerationModelMutation {
+	m := &GenerationModelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGenerationModel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGenerationModelID sets the ID field of the mutation.
+func withGenerationModelID(id uuid.UUID) generationmodelOption {
+	return func(m *GenerationModelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GenerationModel
+		)
+		m.oldValue = func(ctx context.Context) (*GenerationModel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GenerationModel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGenerationModel sets the old GenerationModel of the mutation.
+func withGenerationModel(node *GenerationModel) generationmodelOption {
+	return func(m *GenerationModelMutation) {
+		m.oldValue = func(context.Context) (*GenerationModel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GenerationModelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GenerationModelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GenerationModel entities.
+func (m *GenerationModelMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GenerationModelMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GenerationModelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GenerationModel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNameInWorker sets the "name_in_worker" field.
+func (m *GenerationModelMutation) SetNameInWorker(s string) {
+	m.name_in_worker = &s
+}
+
+// NameInWorker returns the value of the "name_in_worker" field in the mutation.
+func (m *GenerationModelMutation) NameInWorker() (r string, exists bool) {
+	v := m.name_in_worker
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNameInWorker returns the old "name_in_worker" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldNameInWorker(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNameInWorker is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNameInWorker requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNameInWorker: %w", err)
+	}
+	return oldValue.NameInWorker, nil
+}
+
+// ResetNameInWorker resets all changes to the "name_in_worker" field.
+func (m *GenerationModelMutation) ResetNameInWorker() {
+	m.name_in_worker = nil
+}
+
+// SetShortName sets the "short_name" field.
+func (m *GenerationModelMutation) SetShortName(s string) {
+	m.short_name = &s
+}
+
+// ShortName returns the value of the "short_name" field in the mutation.
+func (m *GenerationModelMutation) ShortName() (r string, exists bool) {
+	v := m.short_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShortName returns the old "short_name" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldShortName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShortName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShortName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShortName: %w", err)
+	}
+	return oldValue.ShortName, nil
+}
+
+// ResetShortName resets all changes to the "short_name" field.
+func (m *GenerationModelMutation) ResetShortName() {
+	m.short_name = nil
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *GenerationModelMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *GenerationModelMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *GenerationModelMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetIsDefault sets the "is_default" field.
+func (m *GenerationModelMutation) SetIsDefault(b bool) {
+	m.is_default = &b
+}
+
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *GenerationModelMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDefault returns the old "is_default" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
+}
+
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *GenerationModelMutation) ResetIsDefault() {
+	m.is_default = nil
+}
+
+// SetIsHidden sets the "is_hidden" field.
+func (m *GenerationModelMutation) SetIsHidden(b bool) {
+	m.is_hidden = &b
+}
+
+// IsHidden returns the value of the "is_hidden" field in the mutation.
+func (m *GenerationModelMutation) IsHidden() (r bool, exists bool) {
+	v := m.is_hidden
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsHidden returns the old "is_hidden" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldIsHidden(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsHidden is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsHidden requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsHidden: %w", err)
+	}
+	return oldValue.IsHidden, nil
+}
+
+// ResetIsHidden resets all changes to the "is_hidden" field.
+func (m *GenerationModelMutation) ResetIsHidden() {
+	m.is_hidden = nil
+}
+
+// SetRunpodEndpoint sets the "runpod_endpoint" field.
+func (m *GenerationModelMutation) SetRunpodEndpoint(s string) {
+	m.runpod_endpoint = &s
+}
+
+// RunpodEndpoint returns the value of the "runpod_endpoint" field in the mutation.
+func (m *GenerationModelMutation) RunpodEndpoint() (r string, exists bool) {
+	v := m.runpod_endpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunpodEndpoint returns the old "runpod_endpoint" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldRunpodEndpoint(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunpodEndpoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunpodEndpoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunpodEndpoint: %w", err)
+	}
+	return oldValue.RunpodEndpoint, nil
+}
+
+// ClearRunpodEndpoint clears the value of the "runpod_endpoint" field.
+func (m *GenerationModelMutation) ClearRunpodEndpoint() {
+	m.runpod_endpoint = nil
+	m.clearedFields[generationmodel.FieldRunpodEndpoint] = struct{}{}
+}
+
+// RunpodEndpointCleared returns if the "runpod_endpoint" field was cleared in this mutation.
+func (m *GenerationModelMutation) RunpodEndpointCleared() bool {
+	_, ok := m.clearedFields[generationmodel.FieldRunpodEndpoint]
+	return ok
+}
+
+// ResetRunpodEndpoint resets all changes to the "runpod_endpoint" field.
+func (m *GenerationModelMutation) ResetRunpodEndpoint() {
+	m.runpod_endpoint = nil
+	delete(m.clearedFields, generationmodel.FieldRunpodEndpoint)
+}
+
+// SetRunpodActive sets the "runpod_active" field.
+func (m *GenerationModelMutation) SetRunpodActive(b bool) {
+	m.runpod_active = &b
+}
+
+// RunpodActive returns the value of the "runpod_active" field in the mutation.
+func (m *GenerationModelMutation) RunpodActive() (r bool, exists bool) {
+	v := m.runpod_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunpodActive returns the old "runpod_active" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldRunpodActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunpodActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunpodActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunpodActive: %w", err)
+	}
+	return oldValue.RunpodActive, nil
+}
+
+// ResetRunpodActive resets all changes to the "runpod_active" field.
+func (m *GenerationModelMutation) ResetRunpodActive() {
+	m.runpod_active = nil
+}
+
+// SetDisplayWeight sets the "display_weight" field.
+func (m *GenerationModelMutation) SetDisplayWeight(i int32) {
+	m.display_weight = &i
+	m.adddisplay_weight = nil
+}
+
+// DisplayWeight returns the value of the "display_weight" field in the mutation.
+func (m *GenerationModelMutation) DisplayWeight() (r int32, exists bool) {
+	v := m.display_weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayWeight returns the old "display_weight" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldDisplayWeight(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayWeight: %w", err)
+	}
+	return oldValue.DisplayWeight, nil
+}
+
+// AddDisplayWeight adds i to the "display_weight" field.
+func (m *GenerationModelMutation) AddDisplayWeight(i int32) {
+	if m.adddisplay_weight != nil {
+		*m.adddisplay_weight += i
+	} else {
+		m.adddisplay_weight = &i
+	}
+}
+
+// AddedDisplayWeight returns the value that was added to the "display_weight" field in this mutation.
+func (m *GenerationModelMutation) AddedDisplayWeight() (r int32, exists bool) {
+	v := m.adddisplay_weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDisplayWeight resets all changes to the "display_weight" field.
+func (m *GenerationModelMutation) ResetDisplayWeight() {
+	m.display_weight = nil
+	m.adddisplay_weight = nil
+}
+
+// SetDefaultSchedulerID sets the "default_scheduler_id" field.
+func (m *GenerationModelMutation) SetDefaultSchedulerID(u uuid.UUID) {
+	m.default_scheduler_id = &u
+}
+
+// DefaultSchedulerID returns the value of the "default_scheduler_id" field in the mutation.
+func (m *GenerationModelMutation) DefaultSchedulerID() (r uuid.UUID, exists bool) {
+	v := m.default_scheduler_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultSchedulerID returns the old "default_scheduler_id" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldDefaultSchedulerID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultSchedulerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultSchedulerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultSchedulerID: %w", err)
+	}
+	return oldValue.DefaultSchedulerID, nil
+}
+
+// ClearDefaultSchedulerID clears the value of the "default_scheduler_id" field.
+func (m *GenerationModelMutation) ClearDefaultSchedulerID() {
+	m.default_scheduler_id = nil
+	m.clearedFields[generationmodel.FieldDefaultSchedulerID] = struct{}{}
+}
+
+// DefaultSchedulerIDCleared returns if the "default_scheduler_id" field was cleared in this mutation.
+func (m *GenerationModelMutation) DefaultSchedulerIDCleared() bool {
+	_, ok := m.clearedFields[generationmodel.FieldDefaultSchedulerID]
+	return ok
+}
+
+// ResetDefaultSchedulerID resets all changes to the "default_scheduler_id" field.
+func (m *GenerationModelMutation) ResetDefaultSchedulerID() {
+	m.default_scheduler_id = nil
+	delete(m.clearedFields, generationmodel.FieldDefaultSchedulerID)
+}
+
+// SetDefaultWidth sets the "default_width" field.
+func (m *GenerationModelMutation) SetDefaultWidth(i int32) {
+	m.default_width = &i
+	m.adddefault_width = nil
+}
+
+// DefaultWidth returns the value of the "default_width" field in the mutation.
+func (m *GenerationModelMutation) DefaultWidth() (r int32, exists bool) {
+	v := m.default_width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultWidth returns the old "default_width" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldDefaultWidth(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultWidth: %w", err)
+	}
+	return oldValue.DefaultWidth, nil
+}
+
+// AddDefaultWidth adds i to the "default_width" field.
+func (m *GenerationModelMutation) AddDefaultWidth(i int32) {
+	if m.adddefault_width != nil {
+		*m.adddefault_width += i
+	} else {
+		m.adddefault_width = &i
+	}
+}
+
+// AddedDefaultWidth returns the value that was added to the "default_width" field in this mutation.
+func (m *GenerationModelMutation) AddedDefaultWidth() (r int32, exists bool) {
+	v := m.adddefault_width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDefaultWidth resets all changes to the "default_width" field.
+func (m *GenerationModelMutation) ResetDefaultWidth() {
+	m.default_width = nil
+	m.adddefault_width = nil
+}
+
+// SetDefaultHeight sets the "default_height" field.
+func (m *GenerationModelMutation) SetDefaultHeight(i int32) {
+	m.default_height = &i
+	m.adddefault_height = nil
+}
+
+// DefaultHeight returns the value of the "default_height" field in the mutation.
+func (m *GenerationModelMutation) DefaultHeight() (r int32, exists bool) {
+	v := m.default_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultHeight returns the old "default_height" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldDefaultHeight(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultHeight: %w", err)
+	}
+	return oldValue.DefaultHeight, nil
+}
+
+// AddDefaultHeight adds i to the "default_height" field.
+func (m *GenerationModelMutation) AddDefaultHeight(i int32) {
+	if m.adddefault_height != nil {
+		*m.adddefault_height += i
+	} else {
+		m.adddefault_height = &i
+	}
+}
+
+// AddedDefaultHeight returns the value that was added to the "default_height" field in this mutation.
+func (m *GenerationModelMutation) AddedDefaultHeight() (r int32, exists bool) {
+	v := m.adddefault_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDefaultHeight resets all changes to the "default_height" field.
+func (m *GenerationModelMutation) ResetDefaultHeight() {
+	m.default_height = nil
+	m.adddefault_height = nil
+}
+
+// SetDefaultInferenceSteps sets the "default_inference_steps" field.
+func (m *GenerationModelMutation) SetDefaultInferenceSteps(i int32) {
+	m.default_inference_steps = &i
+	m.adddefault_inference_steps = nil
+}
+
+// DefaultInferenceSteps returns the value of the "default_inference_steps" field in the mutation.
+func (m *GenerationModelMutation) DefaultInferenceSteps() (r int32, exists bool) {
+	v := m.default_inference_steps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultInferenceSteps returns the old "default_inference_steps" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldDefaultInferenceSteps(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultInferenceSteps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultInferenceSteps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultInferenceSteps: %w", err)
+	}
+	return oldValue.DefaultInferenceSteps, nil
+}
+
+// AddDefaultInferenceSteps adds i to the "default_inference_steps" field.
+func (m *GenerationModelMutation) AddDefaultInferenceSteps(i int32) {
+	if m.adddefault_inference_steps != nil {
+		*m.adddefault_inference_steps += i
+	} else {
+		m.adddefault_inference_steps = &i
+	}
+}
+
+// AddedDefaultInferenceSteps returns the value that was added to the "default_inference_steps" field in this mutation.
+func (m *GenerationModelMutation) AddedDefaultInferenceSteps() (r int32, exists bool) {
+	v := m.adddefault_inference_steps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDefaultInferenceSteps resets all changes to the "default_inference_steps" field.
+func (m *GenerationModelMutation) ResetDefaultInferenceSteps() {
+	m.default_inference_steps = nil
+	m.adddefault_inference_steps = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GenerationModelMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GenerationModelMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GenerationModelMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GenerationModelMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GenerationModelMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the GenerationModel entity.
+// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationModelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GenerationModelMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddGenerationIDs adds the "generations" edge to the Generation entity by ids.
+func (m *GenerationModelMutation) AddGenerationIDs(ids ...uuid.UUID) {
+	if m.generations == nil {
+		m.generations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.generations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGenerations clears the "generations" edge to the Generation entity.
+func (m *GenerationModelMutation) ClearGenerations() {
+	m.clearedgenerations = true
+}
+
+// GenerationsCleared reports if the "generations" edge to the Generation entity was cleared.
+func (m *GenerationModelMutation) GenerationsCleared() bool {
+	return m.clearedgenerations
+}
+
+// RemoveGenerationIDs removes the "generations" edge to the Generation entity by IDs.
+func (m *GenerationModelMutation) RemoveGenerationIDs(ids ...uuid.UUID) {
+	if m.removedgenerations == nil {
+		m.removedgenerations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.generations, ids[i])
+		m.removedgenerations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGenerations returns the removed IDs of the "generations" edge to the Generation entity.
+func (m *GenerationModelMutation) RemovedGenerationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgenerations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GenerationsIDs returns the "generations" edge IDs in the mutation.
+func (m *GenerationModelMutation) GenerationsIDs() (ids []uuid.UUID) {
+	for id := range m.generations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGenerations resets all changes to the "generations" edge.
+func (m *GenerationModelMutation) ResetGenerations() {
+	m.generations = nil
+	m.clearedgenerations = false
+	m.removedgenerations = nil
+}
+
+// AddSchedulerIDs adds the "schedulers" edge to the Scheduler entity by ids.
+func (m *GenerationModelMutation) AddSchedulerIDs(ids ...uuid.UUID) {
+	if m.schedulers == nil {
+		m.schedulers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.schedulers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSchedulers clears the "schedulers" edge to the Scheduler entity.
+func (m *GenerationModelMutation) ClearSchedulers() {
+	m.clearedschedulers = true
+}
+
+// SchedulersCleared reports if the "schedulers" edge to the Scheduler entity was cleared.
+func (m *GenerationModelMutation) SchedulersCleared() bool {
+	return m.clearedschedulers
+}
+
+// RemoveSchedulerIDs removes the "schedulers" edge to the Scheduler entity by IDs.
+func (m *GenerationModelMutation) RemoveSchedulerIDs(ids ...uuid.UUID) {
+	if m.removedschedulers == nil {
+		m.removedschedulers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.schedulers, ids[i])
+		m.removedschedulers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSchedulers returns the removed IDs of the "schedulers" edge to the Scheduler entity.
+func (m *GenerationModelMutation) RemovedSchedulersIDs() (ids []uuid.UUID) {
+	for id := range m.removedschedulers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SchedulersIDs returns the "schedulers" edge IDs in the mutation.
+func (m *GenerationModelMutation) SchedulersIDs() (ids []uuid.UUID) {
+	for id := range m.schedulers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSchedulers resets all changes to the "schedulers" edge.
+func (m *GenerationModelMutation) ResetSchedulers() {
+	m.schedulers = nil
+	m.clearedschedulers = false
+	m.removedschedulers = nil
+}
+
+// Where appends a list predicates to the GenerationModelMutation builder.
+func (m *GenerationModelMutation) Where(ps ...predicate.GenerationModel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GenerationModelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GenerationModelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GenerationModel, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GenerationModelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GenerationModelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GenerationModel).
+func (m *GenerationModelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GenerationModelMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.name_in_worker != nil {
+		fields = append(fields, generationmodel.FieldNameInWorker)
+	}
+	if m.short_name != nil {
+		fields = append(fields, generationmodel.FieldShortName)
+	}
+	if m.is_active != nil {
+		fields = append(fields, generationmodel.FieldIsActive)
+	}
+	if m.is_default != nil {
+		fields = append(fields, generationmodel.FieldIsDefault)
+	}
+	if m.is_hidden != nil {
+		fields = append(fields, generationmodel.FieldIsHidden)
+	}
+	if m.runpod_endpoint != nil {
+		fields = append(fields, generationmodel.FieldRunpodEndpoint)
+	}
+	if m.runpod_active != nil {
+		fields = append(fields, generationmodel.FieldRunpodActive)
+	}
+	if m.display_weight != nil {
+		fields = append(fields, generationmodel.FieldDisplayWeight)
+	}
+	if m.default_scheduler_id != nil {
+		fields = append(fields, generationmodel.FieldDefaultSchedulerID)
+	}
+	if m.default_width != nil {
+		fields = append(fields, generationmodel.FieldDefaultWidth)
+	}
+	if m.default_height != nil {
+		fields = append(fields, generationmodel.FieldDefaultHeight)
+	}
+	if m.default_inference_steps != nil {
+		fields = append(fields, generationmodel.FieldDefaultInferenceSteps)
+	}
+	if m.created_at != nil {
+		fields = append(fields, generationmodel.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, generationmodel.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GenerationModelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case generationmodel.FieldNameInWorker:
+		return m.NameInWorker()
+	case generationmodel.FieldShortName:
+		return m.ShortName()
+	case generationmodel.FieldIsActive:
+		return m.IsActive()
+	case generationmodel.FieldIsDefault:
+		return m.IsDefault()
+	case generationmodel.FieldIsHidden:
+		return m.IsHidden()
+	case generationmodel.FieldRunpodEndpoint:
+		return m.RunpodEndpoint()
+	case generationmodel.FieldRunpodActive:
+		return m.RunpodActive()
+	case generationmodel.FieldDisplayWeight:
+		return m.DisplayWeight()
+	case generationmodel.FieldDefaultSchedulerID:
+		return m.DefaultSchedulerID()
+	case generationmodel.FieldDefaultWidth:
+		return m.DefaultWidth()
+	case generationmodel.FieldDefaultHeight:
+		return m.DefaultHeight()
+	case generationmodel.FieldDefaultInferenceSteps:
+		return m.DefaultInferenceSteps()
+	case generationmodel.FieldCreatedAt:
+		return m.CreatedAt()
+	case generationmodel.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GenerationModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case generationmodel.FieldNameInWorker:
+		return m.OldNameInWorker(ctx)
+	case generationmodel.FieldShortName:
+		return m.OldShortName(ctx)
+	case generationmodel.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case generationmodel.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	case generationmodel.FieldIsHidden:
+		return m.OldIsHidden(ctx)
+	case generationmodel.FieldRunpodEndpoint:
+		return m.OldRunpodEndpoint(ctx)
+	case generationmodel.FieldRunpodActive:
+		return m.OldRunpodActive(ctx)
+	case generationmodel.FieldDisplayWeight:
+		return m.OldDisplayWeight(ctx)
+	case generationmodel.FieldDefaultSchedulerID:
+		return m.OldDefaultSchedulerID(ctx)
+	case generationmodel.FieldDefaultWidth:
+		return m.OldDefaultWidth(ctx)
+	case generationmodel.FieldDefaultHeight:
+		return m.OldDefaultHeight(ctx)
+	case generationmodel.FieldDefaultInferenceSteps:
+		return m.OldDefaultInferenceSteps(ctx)
+	case generationmodel.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case generationmodel.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GenerationModel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenerationModelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case generationmodel.FieldNameInWorker:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNameInWorker(v)
+		return nil
+	case generationmodel.FieldShortName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShortName(v)
+		return nil
+	case generationmodel.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case generationmodel.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
+		return nil
+	case generationmodel.FieldIsHidden:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsHidden(v)
+		return nil
+	case generationmodel.FieldRunpodEndpoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunpodEndpoint(v)
+		return nil
+	case generationmodel.FieldRunpodActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunpodActive(v)
+		return nil
+	case generationmodel.FieldDisplayWeight:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayWeight(v)
+		return nil
+	case generationmodel.FieldDefaultSchedulerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultSchedulerID(v)
+		return nil
+	case generationmodel.FieldDefaultWidth:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultWidth(v)
+		return nil
+	case generationmodel.FieldDefaultHeight:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultHeight(v)
+		return nil
+	case generationmodel.FieldDefaultInferenceSteps:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultInferenceSteps(v)
+		return nil
+	case generationmodel.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case generationmodel.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationModel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GenerationModelMutation) AddedFields() []string {
+	var fields []string
+	if m.adddisplay_weight != nil {
+		fields = append(fields, generationmodel.FieldDisplayWeight)
+	}
+	if m.adddefault_width != nil {
+		fields = append(fields, generationmodel.FieldDefaultWidth)
+	}
+	if m.adddefault_height != nil {
+		fields = append(fields, generationmodel.FieldDefaultHeight)
+	}
+	if m.adddefault_inference_steps != nil {
+		fields = append(fields, generationmodel.FieldDefaultInferenceSteps)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GenerationModelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case generationmodel.FieldDisplayWeight:
+		return m.AddedDisplayWeight()
+	case generationmodel.FieldDefaultWidth:
+		return m.AddedDefaultWidth()
+	case generationmodel.FieldDefaultHeight:
+		return m.AddedDefaultHeight()
+	case generationmodel.FieldDefaultInferenceSteps:
+		return m.AddedDefaultInferenceSteps()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenerationModelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case generationmodel.FieldDisplayWeight:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDisplayWeight(v)
+		return nil
+	case generationmodel.FieldDefaultWidth:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDefaultWidth(v)
+		return nil
+	case generationmodel.FieldDefaultHeight:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDefaultHeight(v)
+		return nil
+	case generationmodel.FieldDefaultInferenceSteps:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDefaultInferenceSteps(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationModel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GenerationModelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(generationmodel.FieldRunpodEndpoint) {
+		fields = append(fields, generationmodel.FieldRunpodEndpoint)
+	}
+	if m.FieldCleared(generationmodel.FieldDefaultSchedulerID) {
+		fields = append(fields, generationmodel.FieldDefaultSchedulerID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GenerationModelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GenerationModelMutation) ClearField(name string) error {
+	switch name {
+	case generationmodel.FieldRunpodEndpoint:
+		m.ClearRunpodEndpoint()
+		return nil
+	case generationmodel.FieldDefaultSchedulerID:
+		m.ClearDefaultSchedulerID()
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationModel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GenerationModelMutation) ResetField(name string) error {
+	switch name {
+	case generationmodel.FieldNameInWorker:
+		m.ResetNameInWorker()
+		return nil
+	case generationmodel.FieldShortName:
+		m.ResetShortName()
+		return nil
+	case generationmodel.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case generationmodel.FieldIsDefault:
+		m.ResetIsDefault()
+		return nil
+	case generationmodel.FieldIsHidden:
+		m.ResetIsHidden()
+		return nil
+	case generationmodel.FieldRunpodEndpoint:
+		m.ResetRunpodEndpoint()
+		return nil
+	case generationmodel.FieldRunpodActive:
+		m.ResetRunpodActive()
+		return nil
+	case generationmodel.FieldDisplayWeight:
+		m.ResetDisplayWeight()
+		return nil
+	case generationmodel.FieldDefaultSchedulerID:
+		m.ResetDefaultSchedulerID()
+		return nil
+	case generationmodel.FieldDefaultWidth:
+		m.ResetDefaultWidth()
+		return nil
+	case generationmodel.FieldDefaultHeight:
+		m.ResetDefaultHeight()
+		return nil
+	case generationmodel.FieldDefaultInferenceSteps:
+		m.ResetDefaultInferenceSteps()
+		return nil
+	case generationmodel.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case generationmodel.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationModel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GenerationModelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.generations != nil {
+		edges = append(edges, generationmodel.EdgeGenerations)
+	}
+	if m.schedulers != nil {
+		edges = append(edges, generationmodel.EdgeSchedulers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GenerationModelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case generationmodel.EdgeGenerations:
+		ids := make([]ent.Value, 0, len(m.generations))
+		for id := range m.generations {
+			ids = append(ids, id)
+		}
+		return ids
+	case generationmodel.EdgeSchedulers:
+		ids := make([]ent.Value, 0, len(m.schedulers))
+		for id := range m.schedulers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GenerationModelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedgenerations != nil {
+		edges = append(edges, generationmodel.EdgeGenerations)
+	}
+	if m.removedschedulers != nil {
+		edges = append(edges, generationmodel.EdgeSchedulers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GenerationModelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case generationmodel.EdgeGenerations:
+		ids := make([]ent.Value, 0, len(m.removedgenerations))
+		for id := range m.removedgenerations {
+			ids = append(ids, id)
+		}
+		return ids
+	case generationmodel.EdgeSchedulers:
+		ids := make([]ent.Value, 0, len(m.removedschedulers))
+		for id := range m.removedschedulers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GenerationModelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgenerations {
+		edges = append(edges, generationmodel.EdgeGenerations)
+	}
+	if m.clearedschedulers {
+		edges = append(edges, generationmodel.EdgeSchedulers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GenerationModelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case generationmodel.EdgeGenerations:
+		return m.clearedgenerations
+	case generationmodel.EdgeSchedulers:
+		return m.clearedschedulers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GenerationModelMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GenerationModel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GenerationModelMutation) ResetEdge(name string) error {
+	switch name {
+	case generationmodel.EdgeGenerations:
+		m.ResetGenerations()
+		return nil
+	case generationmodel.EdgeSchedulers:
+		m.ResetSchedulers()
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationModel edge %s", name)
+}
+
+// GenerationOutputMutation represents an operation that mutates the GenerationOutput nodes in the graph.
+type GenerationOutputMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *uuid.UUID
+	image_path                     *string
+	upscaled_image_path            *string
+	gallery_status                 *generationoutput.GalleryStatus
+	is_favorited                   *bool
+	has_embeddings                 *bool
+	has_embeddings_new             *bool
+	is_migrated                    *bool
+	is_public                      *bool
+	aesthetic_rating_score         *float32
+	addaesthetic_rating_score      *float32
+	aesthetic_artifact_score       *float32
+	addaesthetic_artifact_score    *float32
+	checked_for_nsfw               *bool
+	nsfw_score                     *float32
+	addnsfw_score                  *float32
+	like_count                     *int
+	addlike_count                  *int
+	deleted_at                     *time.Time
+	created_at                     *time.Time
+	updated_at                     *time.Time
+	clearedFields                  map[string]struct{}
+	generations                    *uuid.UUID
+	clearedgenerations             bool
+	upscale_outputs                *uuid.UUID
+	clearedupscale_outputs         bool
+	generation_output_likes        map[uuid.UUID]struct{}
+	removedgeneration_output_likes map[uuid.UUID]struct{}
+	clearedgeneration_output_likes bool
+	done                           bool
+	oldValue                       func(context.Context) (*GenerationOutput, error)
+	predicates                     []predicate.GenerationOutput
+}
+
+var _ ent.Mutation = (*GenerationOutputMutation)(nil)
+
+// generationoutputOption allows management of the mutation configuration using functional options.
+type generationoutputOption func(*GenerationOutputMutation)
+
+// newGenerationOutputMutation creates new mutation for the GenerationOutput entity.
+func newGenerationOutputMutation(c config, op Op, opts ...generationoutputOption) *GenerationOutputMutation {
+	m := &GenerationOutputMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGenerationOutput,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGenerationOutputID sets the ID field of the mutation.
+func withGenerationOutputID(id uuid.UUID) generationoutputOption {
+	return func(m *GenerationOutputMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GenerationOutput
+		)
+		m.oldValue = func(ctx context.Context) (*GenerationOutput, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GenerationOutput.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGenerationOutput sets the old GenerationOutput of the mutation.
+func withGenerationOutput(node *GenerationOutput) generationoutputOption {
+	return func(m *GenerationOutputMutation) {
+		m.oldValue = func(context.Context) (*GenerationOutput, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GenerationOutputMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GenerationOutputMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GenerationOutput entities.
+func (m *GenerationOutputMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GenerationOutputMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GenerationOutputMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GenerationOutput.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetImagePath sets the "image_path" field.
+func (m *GenerationOutputMutation) SetImagePath(s string) {
+	m.image_path = &s
+}
+
+// ImagePath returns the value of the "image_path" field in the mutation.
+func (m *GenerationOutputMutation) ImagePath() (r string, exists bool) {
+	v := m.image_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImagePath returns the old "image_path" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldImagePath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImagePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImagePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImagePath: %w", err)
+	}
+	return oldValue.ImagePath, nil
+}
+
+// ResetImagePath resets all changes to the "image_path" field.
+func (m *GenerationOutputMutation) ResetImagePath() {
+	m.image_path = nil
+}
+
+// SetUpscaledImagePath sets the "upscaled_image_path" field.
+func (m *GenerationOutputMutation) SetUpscaledImagePath(s string) {
+	m.upscaled_image_path = &s
+}
+
+// UpscaledImagePath returns the value of the "upscaled_image_path" field in the mutation.
+func (m *GenerationOutputMutation) UpscaledImagePath() (r string, exists bool) {
+	v := m.upscaled_image_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpscaledImagePath returns the old "upscaled_image_path" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldUpscaledImagePath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpscaledImagePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpscaledImagePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpscaledImagePath: %w", err)
+	}
+	return oldValue.UpscaledImagePath, nil
+}
+
+// ClearUpscaledImagePath clears the value of the "upscaled_image_path" field.
+func (m *GenerationOutputMutation) ClearUpscaledImagePath() {
+	m.upscaled_image_path = nil
+	m.clearedFields[generationoutput.FieldUpscaledImagePath] = struct{}{}
+}
+
+// UpscaledImagePathCleared returns if the "upscaled_image_path" field was cleared in this mutation.
+func (m *GenerationOutputMutation) UpscaledImagePathCleared() bool {
+	_, ok := m.clearedFields[generationoutput.FieldUpscaledImagePath]
+	return ok
+}
+
+// ResetUpscaledImagePath resets all changes to the "upscaled_image_path" field.
+func (m *GenerationOutputMutation) ResetUpscaledImagePath() {
+	m.upscaled_image_path = nil
+	delete(m.clearedFields, generationoutput.FieldUpscaledImagePath)
+}
+
+// SetGalleryStatus sets the "gallery_status" field.
+func (m *GenerationOutputMutation) SetGalleryStatus(gs generationoutput.GalleryStatus) {
+	m.gallery_status = &gs
+}
+
+// GalleryStatus returns the value of the "gallery_status" field in the mutation.
+func (m *GenerationOutputMutation) GalleryStatus() (r generationoutput.GalleryStatus, exists bool) {
+	v := m.gallery_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGalleryStatus returns the old "gallery_status" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldGalleryStatus(ctx context.Context) (v generationoutput.GalleryStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGalleryStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGalleryStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGalleryStatus: %w", err)
+	}
+	return oldValue.GalleryStatus, nil
+}
+
+// ResetGalleryStatus resets all changes to the "gallery_status" field.
+func (m *GenerationOutputMutation) ResetGalleryStatus() {
+	m.gallery_status = nil
+}
+
+// SetIsFavorited sets the "is_favorited" field.
+func (m *GenerationOutputMutation) SetIsFavorited(b bool) {
+	m.is_favorited = &b
+}
+
+// IsFavorited returns the value of the "is_favorited" field in the mutation.
+func (m *GenerationOutputMutation) IsFavorited() (r bool, exists bool) {
+	v := m.is_favorited
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsFavorited returns the old "is_favorited" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldIsFavorited(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsFavorited is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsFavorited requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsFavorited: %w", err)
+	}
+	return oldValue.IsFavorited, nil
+}
+
+// ResetIsFavorited resets all changes to the "is_favorited" field.
+func (m *GenerationOutputMutation) ResetIsFavorited() {
+	m.is_favorited = nil
+}
+
+// SetHasEmbeddings sets the "has_embeddings" field.
+func (m *GenerationOutputMutation) SetHasEmbeddings(b bool) {
+	m.has_embeddings = &b
+}
+
+// HasEmbeddings returns the value of the "has_embeddings" field in the mutation.
+func (m *GenerationOutputMutation) HasEmbeddings() (r bool, exists bool) {
+	v := m.has_embeddings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasEmbeddings returns the old "has_embeddings" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldHasEmbeddings(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasEmbeddings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasEmbeddings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasEmbeddings: %w", err)
+	}
+	return oldValue.HasEmbeddings, nil
+}
+
+// ResetHasEmbeddings resets all changes to the "has_embeddings" field.
+func (m *GenerationOutputMutation) ResetHasEmbeddings() {
+	m.has_embeddings = nil
+}
+
+// SetHasEmbeddingsNew sets the "has_embeddings_new" field.
+func (m *GenerationOutputMutation) SetHasEmbeddingsNew(b bool) {
+	m.has_embeddings_new = &b
+}
+
+// HasEmbeddingsNew returns the value of the "has_embeddings_new" field in the mutation.
+func (m *GenerationOutputMutation) HasEmbeddingsNew() (r bool, exists bool) {
+	v := m.has_embeddings_new
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasEmbeddingsNew returns the old "has_embeddings_new" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldHasEmbeddingsNew(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasEmbeddingsNew is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasEmbeddingsNew requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasEmbeddingsNew: %w", err)
+	}
+	return oldValue.HasEmbeddingsNew, nil
+}
+
+// ResetHasEmbeddingsNew resets all changes to the "has_embeddings_new" field.
+func (m *GenerationOutputMutation) ResetHasEmbeddingsNew() {
+	m.has_embeddings_new = nil
+}
+
+// SetIsMigrated sets the "is_migrated" field.
+func (m *GenerationOutputMutation) SetIsMigrated(b bool) {
+	m.is_migrated = &b
+}
+
+// IsMigrated returns the value of the "is_migrated" field in the mutation.
+func (m *GenerationOutputMutation) IsMigrated() (r bool, exists bool) {
+	v := m.is_migrated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsMigrated returns the old "is_migrated" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldIsMigrated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsMigrated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsMigrated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsMigrated: %w", err)
+	}
+	return oldValue.IsMigrated, nil
+}
+
+// ResetIsMigrated resets all changes to the "is_migrated" field.
+func (m *GenerationOutputMutation) ResetIsMigrated() {
+	m.is_migrated = nil
+}
+
+// SetIsPublic sets the "is_public" field.
+func (m *GenerationOutputMutation) SetIsPublic(b bool) {
+	m.is_public = &b
+}
+
+// IsPublic returns the value of the "is_public" field in the mutation.
+func (m *GenerationOutputMutation) IsPublic() (r bool, exists bool) {
+	v := m.is_public
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPublic returns the old "is_public" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	}
+	return oldValue.IsPublic, nil
+}
+
+// ResetIsPublic resets all changes to the "is_public" field.
+func (m *GenerationOutputMutation) ResetIsPublic() {
+	m.is_public = nil
+}
+
+// SetAestheticRatingScore sets the "aesthetic_rating_score" field.
+func (m *GenerationOutputMutation) SetAestheticRatingScore(f float32) {
+	m.aesthetic_rating_score = &f
+	m.addaesthetic_rating_score = nil
+}
+
+// AestheticRatingScore returns the value of the "aesthetic_rating_score" field in the mutation.
+func (m *GenerationOutputMutation) AestheticRatingScore() (r float32, exists bool) {
+	v := m.aesthetic_rating_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAestheticRatingScore returns the old "aesthetic_rating_score" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldAestheticRatingScore(ctx context.Context) (v float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAestheticRatingScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAestheticRatingScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAestheticRatingScore: %w", err)
+	}
+	return oldValue.AestheticRatingScore, nil
+}
+
+// AddAestheticRatingScore adds f to the "aesthetic_rating_score" field.
+func (m *GenerationOutputMutation) AddAestheticRatingScore(f float32) {
+	if m.addaesthetic_rating_score != nil {
+		*m.addaesthetic_rating_score += f
+	} else {
+		m.addaesthetic_rating_score = &f
+	}
+}
+
+// AddedAestheticRatingScore returns the value that was added to the "aesthetic_rating_score" field in this mutation.
+func (m *GenerationOutputMutation) AddedAestheticRatingScore() (r float32, exists bool) {
+	v := m.addaesthetic_rating_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAestheticRatingScore resets all changes to the "aesthetic_rating_score" field.
+func (m *GenerationOutputMutation) ResetAestheticRatingScore() {
+	m.aesthetic_rating_score = nil
+	m.addaesthetic_rating_score = nil
+}
+
+// SetAestheticArtifactScore sets the "aesthetic_artifact_score" field.
+func (m *GenerationOutputMutation) SetAestheticArtifactScore(f float32) {
+	m.aesthetic_artifact_score = &f
+	m.addaesthetic_artifact_score = nil
+}
+
+// AestheticArtifactScore returns the value of the "aesthetic_artifact_score" field in the mutation.
+func (m *GenerationOutputMutation) AestheticArtifactScore() (r float32, exists bool) {
+	v := m.aesthetic_artifact_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAestheticArtifactScore returns the old "aesthetic_artifact_score" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldAestheticArtifactScore(ctx context.Context) (v float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAestheticArtifactScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAestheticArtifactScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAestheticArtifactScore: %w", err)
+	}
+	return oldValue.AestheticArtifactScore, nil
+}
+
+// AddAestheticArtifactScore adds f to the "aesthetic_artifact_score" field.
+func (m *GenerationOutputMutation) AddAestheticArtifactScore(f float32) {
+	if m.addaesthetic_artifact_score != nil {
+		*m.addaesthetic_artifact_score += f
+	} else {
+		m.addaesthetic_artifact_score = &f
+	}
+}
+
+// AddedAestheticArtifactScore returns the value that was added to the "aesthetic_artifact_score" field in this mutation.
+func (m *GenerationOutputMutation) AddedAestheticArtifactScore() (r float32, exists bool) {
+	v := m.addaesthetic_artifact_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAestheticArtifactScore resets all changes to the "aesthetic_artifact_score" field.
+func (m *GenerationOutputMutation) ResetAestheticArtifactScore() {
+	m.aesthetic_artifact_score = nil
+	m.addaesthetic_artifact_score = nil
+}
+
+// SetCheckedForNsfw sets the "checked_for_nsfw" field.
+func (m *GenerationOutputMutation) SetCheckedForNsfw(b bool) {
+	m.checked_for_nsfw = &b
+}
+
+// CheckedForNsfw returns the value of the "checked_for_nsfw" field in the mutation.
+func (m *GenerationOutputMutation) CheckedForNsfw() (r bool, exists bool) {
+	v := m.checked_for_nsfw
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckedForNsfw returns the old "checked_for_nsfw" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldCheckedForNsfw(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckedForNsfw is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckedForNsfw requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckedForNsfw: %w", err)
+	}
+	return oldValue.CheckedForNsfw, nil
+}
+
+// ResetCheckedForNsfw resets all changes to the "checked_for_nsfw" field.
+func (m *GenerationOutputMutation) ResetCheckedForNsfw() {
+	m.checked_for_nsfw = nil
+}
+
+// SetNsfwScore sets the "nsfw_score" field.
+func (m *GenerationOutputMutation) SetNsfwScore(f float32) {
+	m.nsfw_score = &f
+	m.addnsfw_score = nil
+}
+
+// NsfwScore returns the value of the "nsfw_score" field in the mutation.
+func (m *GenerationOutputMutation) NsfwScore() (r float32, exists bool) {
+	v := m.nsfw_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNsfwScore returns the old "nsfw_score" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldNsfwScore(ctx context.Context) (v float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNsfwScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNsfwScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNsfwScore: %w", err)
+	}
+	return oldValue.NsfwScore, nil
+}
+
+// AddNsfwScore adds f to the "nsfw_score" field.
+func (m *GenerationOutputMutation) AddNsfwScore(f float32) {
+	if m.addnsfw_score != nil {
+		*m.addnsfw_score += f
+	} else {
+		m.addnsfw_score = &f
+	}
+}
+
+// AddedNsfwScore returns the value that was added to the "nsfw_score" field in this mutation.
+func (m *GenerationOutputMutation) AddedNsfwScore() (r float32, exists bool) {
+	v := m.addnsfw_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNsfwScore resets all changes to the "nsfw_score" field.
+func (m *GenerationOutputMutation) ResetNsfwScore() {
+	m.nsfw_score = nil
+	m.addnsfw_score = nil
+}
+
+// SetLikeCount sets the "like_count" field.
+func (m *GenerationOutputMutation) SetLikeCount(i int) {
+	m.like_count = &i
+	m.addlike_count = nil
+}
+
+// LikeCount returns the value of the "like_count" field in the mutation.
+func (m *GenerationOutputMutation) LikeCount() (r int, exists bool) {
+	v := m.like_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLikeCount returns the old "like_count" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldLikeCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLikeCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLikeCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLikeCount: %w", err)
+	}
+	return oldValue.LikeCount, nil
+}
+
+// AddLikeCount adds i to the "like_count" field.
+func (m *GenerationOutputMutation) AddLikeCount(i int) {
+	if m.addlike_count != nil {
+		*m.addlike_count += i
+	} else {
+		m.addlike_count = &i
+	}
+}
+
+// AddedLikeCount returns the value that was added to the "like_count" field in this mutation.
+func (m *GenerationOutputMutation) AddedLikeCount() (r int, exists bool) {
+	v := m.addlike_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLikeCount resets all changes to the "like_count" field.
+func (m *GenerationOutputMutation) ResetLikeCount() {
+	m.like_count = nil
+	m.addlike_count = nil
+}
+
+// SetGenerationID sets the "generation_id" field.
+func (m *GenerationOutputMutation) SetGenerationID(u uuid.UUID) {
+	m.generations = &u
+}
+
+// GenerationID returns the value of the "generation_id" field in the mutation.
+func (m *GenerationOutputMutation) GenerationID() (r uuid.UUID, exists bool) {
+	v := m.generations
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenerationID returns the old "generation_id" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldGenerationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenerationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenerationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenerationID: %w", err)
+	}
+	return oldValue.GenerationID, nil
+}
+
+// ResetGenerationID resets all changes to the "generation_id" field.
+func (m *GenerationOutputMutation) ResetGenerationID() {
+	m.generations = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *GenerationOutputMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *GenerationOutputMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *GenerationOutputMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[generationoutput.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *GenerationOutputMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[generationoutput.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *GenerationOutputMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, generationoutput.FieldDeletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GenerationOutputMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GenerationOutputMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GenerationOutputMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GenerationOutputMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GenerationOutputMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the GenerationOutput entity.
+// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationOutputMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GenerationOutputMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetGenerationsID sets the "generations" edge to the Generation entity by id.
+func (m *GenerationOutputMutation) SetGenerationsID(id uuid.UUID) {
+	m.generations = &id
+}
+
+// ClearGenerations clears the "generations" edge to the Generation entity.
+func (m *GenerationOutputMutation) ClearGenerations() {
+	m.clearedgenerations = true
+	m.clearedFields[generationoutput.FieldGenerationID] = struct{}{}
+}
+
+// GenerationsCleared reports if the "generations" edge to the Generation entity was cleared.
+func (m *GenerationOutputMutation) GenerationsCleared() bool {
+	return m.clearedgenerations
+}
+
+// GenerationsID returns the "generations" edge ID in the mutation.
+func (m *GenerationOutputMutation) GenerationsID() (id uuid.UUID, exists bool) {
+	if m.generations != nil {
+		return *m.generations, true
+	}
+	return
+}
+
+// GenerationsIDs returns the "generations" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GenerationsID instead. It exists only for internal usage by the builders.
+func (m *GenerationOutputMutation) GenerationsIDs() (ids []uuid.UUID) {
+	if id := m.generations; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGenerations resets all changes to the "generations" edge.
+func (m *GenerationOutputMutation) ResetGenerations() {
+	m.generations = nil
+	m.clearedgenerations = false
+}
+
+// SetUpscaleOutputsID sets the "upscale_outputs" edge to the UpscaleOutput entity by id.
+func (m *GenerationOutputMutation) SetUpscaleOutputsID(id uuid.UUID) {
+	m.upscale_outputs = &id
+}
+
+// ClearUpscaleOutputs clears the "upscale_outputs" edge to the UpscaleOutput entity.
+func (m *GenerationOutputMutation) ClearUpscaleOutputs() {
+	m.clearedupscale_outputs = true
+}
+
+// UpscaleOutputsCleared reports if the "upscale_outputs" edge to the UpscaleOutput entity was cleared.
+func (m *GenerationOutputMutation) UpscaleOutputsCleared() bool {
+	return m.clearedupscale_outputs
+}
+
+// UpscaleOutputsID returns the "upscale_outputs" edge ID in the mutation.
+func (m *GenerationOutputMutation) UpscaleOutputsID() (id uuid.UUID, exists bool) {
+	if m.upscale_outputs != nil {
+		return *m.upscale_outputs, true
+	}
+	return
+}
+
+// UpscaleOutputsIDs returns the "upscale_outputs" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UpscaleOutputsID instead. It exists only for internal usage by the builders.
+func (m *GenerationOutputMutation) UpscaleOutputsIDs() (ids []uuid.UUID) {
+	if id := m.upscale_outputs; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUpscaleOutputs resets all changes to the "upscale_outputs" edge.
+func (m *GenerationOutputMutation) ResetUpscaleOutputs() {
+	m.upscale_outputs = nil
+	m.clearedupscale_outputs = false
+}
+
+// AddGenerationOutputLikeIDs adds the "generation_output_likes" edge to the GenerationOutputLike entity by ids.
+func (m *GenerationOutputMutation) AddGenerationOutputLikeIDs(ids ...uuid.UUID) {
+	if m.generation_output_likes == nil {
+		m.generation_output_likes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.generation_output_likes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGenerationOutputLikes clears the "generation_output_likes" edge to the GenerationOutputLike entity.
+func (m *GenerationOutputMutation) ClearGenerationOutputLikes() {
+	m.clearedgeneration_output_likes = true
+}
+
+// GenerationOutputLikesCleared reports if the "generation_output_likes" edge to the GenerationOutputLike entity was cleared.
+func (m *GenerationOutputMutation) GenerationOutputLikesCleared() bool {
+	return m.clearedgeneration_output_likes
+}
+
+// RemoveGenerationOutputLikeIDs removes the "generation_output_likes" edge to the GenerationOutputLike entity by IDs.
+func (m *GenerationOutputMutation) RemoveGenerationOutputLikeIDs(ids ...uuid.UUID) {
+	if m.removedgeneration_output_likes == nil {
+		m.removedgeneration_output_likes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.generation_output_likes, ids[i])
+		m.removedgeneration_output_likes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGenerationOutputLikes returns the removed IDs of the "generation_output_likes" edge to the GenerationOutputLike entity.
+func (m *GenerationOutputMutation) RemovedGenerationOutputLikesIDs() (ids []uuid.UUID) {
+	for id := range m.removedgeneration_output_likes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GenerationOutputLikesIDs returns the "generation_output_likes" edge IDs in the mutation.
+func (m *GenerationOutputMutation) GenerationOutputLikesIDs() (ids []uuid.UUID) {
+	for id := range m.generation_output_likes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGenerationOutputLikes resets all changes to the "generation_output_likes" edge.
+func (m *GenerationOutputMutation) ResetGenerationOutputLikes() {
+	m.generation_output_likes = nil
+	m.clearedgeneration_output_likes = false
+	m.removedgeneration_output_likes = nil
+}
+
+// Where appends a list predicates to the GenerationOutputMutation builder.
+func (m *GenerationOutputMutation) Where(ps ...predicate.GenerationOutput) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GenerationOutputMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GenerationOutputMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GenerationOutput, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GenerationOutputMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GenerationOutputMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GenerationOutput).
+func (m *GenerationOutputMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GenerationOutputMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.image_path != nil {
+		fields = append(fields, generationoutput.FieldImagePath)
+	}
+	if m.upscaled_image_path != nil {
+		fields = append(fields, generationoutput.FieldUpscaledImagePath)
+	}
+	if m.gallery_status != nil {
+		fields = append(fields, generationoutput.FieldGalleryStatus)
+	}
+	if m.is_favorited != nil {
+		fields = append(fields, generationoutput.FieldIsFavorited)
+	}
+	if m.has_embeddings != nil {
+		fields = append(fields, generationoutput.FieldHasEmbeddings)
+	}
+	if m.has_embeddings_new != nil {
+		fields = append(fields, generationoutput.FieldHasEmbeddingsNew)
+	}
+	if m.is_migrated != nil {
+		fields = append(fields, generationoutput.FieldIsMigrated)
+	}
+	if m.is_public != nil {
+		fields = append(fields, generationoutput.FieldIsPublic)
+	}
+	if m.aesthetic_rating_score != nil {
+		fields = append(fields, generationoutput.FieldAestheticRatingScore)
+	}
+	if m.aesthetic_artifact_score != nil {
+		fields = append(fields, generationoutput.FieldAestheticArtifactScore)
+	}
+	if m.checked_for_nsfw != nil {
+		fields = append(fields, generationoutput.FieldCheckedForNsfw)
+	}
+	if m.nsfw_score != nil {
+		fields = append(fields, generationoutput.FieldNsfwScore)
+	}
+	if m.like_count != nil {
+		fields = append(fields, generationoutput.FieldLikeCount)
+	}
+	if m.generations != nil {
+		fields = append(fields, generationoutput.FieldGenerationID)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, generationoutput.FieldDeletedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, generationoutput.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, generationoutput.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GenerationOutputMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case generationoutput.FieldImagePath:
+		return m.ImagePath()
+	case generationoutput.FieldUpscaledImagePath:
+		return m.UpscaledImagePath()
+	case generationoutput.FieldGalleryStatus:
+		return m.GalleryStatus()
+	case generationoutput.FieldIsFavorited:
+		return m.IsFavorited()
+	case generationoutput.FieldHasEmbeddings:
+		return m.HasEmbeddings()
+	case generationoutput.FieldHasEmbeddingsNew:
+		return m.HasEmbeddingsNew()
+	case generationoutput.FieldIsMigrated:
+		return m.IsMigrated()
+	case generationoutput.FieldIsPublic:
+		return m.IsPublic()
+	case generationoutput.FieldAestheticRatingScore:
+		return m.AestheticRatingScore()
+	case generationoutput.FieldAestheticArtifactScore:
+		return m.AestheticArtifactScore()
+	case generationoutput.FieldCheckedForNsfw:
+		return m.CheckedForNsfw()
+	case generationoutput.FieldNsfwScore:
+		return m.NsfwScore()
+	case generationoutput.FieldLikeCount:
+		return m.LikeCount()
+	case generationoutput.FieldGenerationID:
+		return m.GenerationID()
+	case generationoutput.FieldDeletedAt:
+		return m.DeletedAt()
+	case generationoutput.FieldCreatedAt:
+		return m.CreatedAt()
+	case generationoutput.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GenerationOutputMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case generationoutput.FieldImagePath:
+		return m.OldImagePath(ctx)
+	case generationoutput.FieldUpscaledImagePath:
+		return m.OldUpscaledImagePath(ctx)
+	case generationoutput.FieldGalleryStatus:
+		return m.OldGalleryStatus(ctx)
+	case generationoutput.FieldIsFavorited:
+		return m.OldIsFavorited(ctx)
+	case generationoutput.FieldHasEmbeddings:
+		return m.OldHasEmbeddings(ctx)
+	case generationoutput.FieldHasEmbeddingsNew:
+		return m.OldHasEmbeddingsNew(ctx)
+	case generationoutput.FieldIsMigrated:
+		return m.OldIsMigrated(ctx)
+	case generationoutput.FieldIsPublic:
+		return m.OldIsPublic(ctx)
+	case generationoutput.FieldAestheticRatingScore:
+		return m.OldAestheticRatingScore(ctx)
+	case generationoutput.FieldAestheticArtifactScore:
+		return m.OldAestheticArtifactScore(ctx)
+	case generationoutput.FieldCheckedForNsfw:
+		return m.OldCheckedForNsfw(ctx)
+	case generationoutput.FieldNsfwScore:
+		return m.OldNsfwScore(ctx)
+	case generationoutput.FieldLikeCount:
+		return m.OldLikeCount(ctx)
+	case generationoutput.FieldGenerationID:
+		return m.OldGenerationID(ctx)
+	case generationoutput.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case generationoutput.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case generationoutput.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GenerationOutput field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenerationOutputMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case generationoutput.FieldImagePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImagePath(v)
+		return nil
+	case generationoutput.FieldUpscaledImagePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpscaledImagePath(v)
+		return nil
+	case generationoutput.FieldGalleryStatus:
+		v, ok := value.(generationoutput.GalleryStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGalleryStatus(v)
+		return nil
+	case generationoutput.FieldIsFavorited:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsFavorited(v)
+		return nil
+	case generationoutput.FieldHasEmbeddings:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasEmbeddings(v)
+		return nil
+	case generationoutput.FieldHasEmbeddingsNew:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasEmbeddingsNew(v)
+		return nil
+	case generationoutput.FieldIsMigrated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsMigrated(v)
+		return nil
+	case generationoutput.FieldIsPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPublic(v)
+		return nil
+	case generationoutput.FieldAestheticRatingScore:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAestheticRatingScore(v)
+		return nil
+	case generationoutput.FieldAestheticArtifactScore:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAestheticArtifactScore(v)
+		return nil
+	case generationoutput.FieldCheckedForNsfw:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckedForNsfw(v)
+		return nil
+	case generationoutput.FieldNsfwScore:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNsfwScore(v)
+		return nil
+	case generationoutput.FieldLikeCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLikeCount(v)
+		return nil
+	case generationoutput.FieldGenerationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenerationID(v)
+		return nil
+	case generationoutput.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case generationoutput.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case generationoutput.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationOutput field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GenerationOutputMutation) AddedFields() []string {
+	var fields []string
+	if m.addaesthetic_rating_score != nil {
+		fields = append(fields, generationoutput.FieldAestheticRatingScore)
+	}
+	if m.addaesthetic_artifact_score != nil {
+		fields = append(fields, generationoutput.FieldAestheticArtifactScore)
+	}
+	if m.addnsfw_score != nil {
+		fields = append(fields, generationoutput.FieldNsfwScore)
+	}
+	if m.addlike_count != nil {
+		fields = append(fields, generationoutput.FieldLikeCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GenerationOutputMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case generationoutput.FieldAestheticRatingScore:
+		return m.AddedAestheticRatingScore()
+	case generationoutput.FieldAestheticArtifactScore:
+		return m.AddedAestheticArtifactScore()
+	case generationoutput.FieldNsfwScore:
+		return m.AddedNsfwScore()
+	case generationoutput.FieldLikeCount:
+		return m.AddedLikeCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenerationOutputMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case generationoutput.FieldAestheticRatingScore:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAestheticRatingScore(v)
+		return nil
+	case generationoutput.FieldAestheticArtifactScore:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAestheticArtifactScore(v)
+		return nil
+	case generationoutput.FieldNsfwScore:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNsfwScore(v)
+		return nil
+	case generationoutput.FieldLikeCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLikeCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationOutput numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GenerationOutputMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(generationoutput.FieldUpscaledImagePath) {
+		fields = append(fields, generationoutput.FieldUpscaledImagePath)
+	}
+	if m.FieldCleared(generationoutput.FieldDeletedAt) {
+		fields = append(fields, generationoutput.FieldDeletedAt)
 	}
-	return oldValue.ShortName, nil
+	return fields
 }
 
-// ResetShortName resets all changes to the "short_name" field.
-func (m *GenerationModelMutation) ResetShortName() {
-	m.short_name = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GenerationOutputMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *GenerationModelMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GenerationOutputMutation) ClearField(name string) error {
+	switch name {
+	case generationoutput.FieldUpscaledImagePath:
+		m.ClearUpscaledImagePath()
+		return nil
+	case generationoutput.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationOutput nullable field %s", name)
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *GenerationModelMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GenerationOutputMutation) ResetField(name string) error {
+	switch name {
+	case generationoutput.FieldImagePath:
+		m.ResetImagePath()
+		return nil
+	case generationoutput.FieldUpscaledImagePath:
+		m.ResetUpscaledImagePath()
+		return nil
+	case generationoutput.FieldGalleryStatus:
+		m.ResetGalleryStatus()
+		return nil
+	case generationoutput.FieldIsFavorited:
+		m.ResetIsFavorited()
+		return nil
+	case generationoutput.FieldHasEmbeddings:
+		m.ResetHasEmbeddings()
+		return nil
+	case generationoutput.FieldHasEmbeddingsNew:
+		m.ResetHasEmbeddingsNew()
+		return nil
+	case generationoutput.FieldIsMigrated:
+		m.ResetIsMigrated()
+		return nil
+	case generationoutput.FieldIsPublic:
+		m.ResetIsPublic()
+		return nil
+	case generationoutput.FieldAestheticRatingScore:
+		m.ResetAestheticRatingScore()
+		return nil
+	case generationoutput.FieldAestheticArtifactScore:
+		m.ResetAestheticArtifactScore()
+		return nil
+	case generationoutput.FieldCheckedForNsfw:
+		m.ResetCheckedForNsfw()
+		return nil
+	case generationoutput.FieldNsfwScore:
+		m.ResetNsfwScore()
+		return nil
+	case generationoutput.FieldLikeCount:
+		m.ResetLikeCount()
+		return nil
+	case generationoutput.FieldGenerationID:
+		m.ResetGenerationID()
+		return nil
+	case generationoutput.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case generationoutput.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case generationoutput.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown GenerationOutput field %s", name)
 }
 
-// OldIsActive returns the old "is_active" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldIsActive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GenerationOutputMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.generations != nil {
+		edges = append(edges, generationoutput.EdgeGenerations)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	if m.upscale_outputs != nil {
+		edges = append(edges, generationoutput.EdgeUpscaleOutputs)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	if m.generation_output_likes != nil {
+		edges = append(edges, generationoutput.EdgeGenerationOutputLikes)
 	}
-	return oldValue.IsActive, nil
+	return edges
 }
 
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *GenerationModelMutation) ResetIsActive() {
-	m.is_active = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GenerationOutputMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case generationoutput.EdgeGenerations:
+		if id := m.generations; id != nil {
+			return []ent.Value{*id}
+		}
+	case generationoutput.EdgeUpscaleOutputs:
+		if id := m.upscale_outputs; id != nil {
+			return []ent.Value{*id}
+		}
+	case generationoutput.EdgeGenerationOutputLikes:
+		ids := make([]ent.Value, 0, len(m.generation_output_likes))
+		for id := range m.generation_output_likes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// SetIsDefault sets the "is_default" field.
-func (m *GenerationModelMutation) SetIsDefault(b bool) {
-	m.is_default = &b
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GenerationOutputMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedgeneration_output_likes != nil {
+		edges = append(edges, generationoutput.EdgeGenerationOutputLikes)
+	}
+	return edges
 }
 
-// IsDefault returns the value of the "is_default" field in the mutation.
-func (m *GenerationModelMutation) IsDefault() (r bool, exists bool) {
-	v := m.is_default
-	if v == nil {
-		return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GenerationOutputMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case generationoutput.EdgeGenerationOutputLikes:
+		ids := make([]ent.Value, 0, len(m.removedgeneration_output_likes))
+		for id := range m.removedgeneration_output_likes {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldIsDefault returns the old "is_default" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GenerationOutputMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedgenerations {
+		edges = append(edges, generationoutput.EdgeGenerations)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	if m.clearedupscale_outputs {
+		edges = append(edges, generationoutput.EdgeUpscaleOutputs)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	if m.clearedgeneration_output_likes {
+		edges = append(edges, generationoutput.EdgeGenerationOutputLikes)
 	}
-	return oldValue.IsDefault, nil
+	return edges
 }
 
-// ResetIsDefault resets all changes to the "is_default" field.
-func (m *GenerationModelMutation) ResetIsDefault() {
-	m.is_default = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GenerationOutputMutation) EdgeCleared(name string) bool {
+	switch name {
+	case generationoutput.EdgeGenerations:
+		return m.clearedgenerations
+	case generationoutput.EdgeUpscaleOutputs:
+		return m.clearedupscale_outputs
+	case generationoutput.EdgeGenerationOutputLikes:
+		return m.clearedgeneration_output_likes
+	}
+	return false
 }
 
-// SetIsHidden sets the "is_hidden" field.
-func (m *GenerationModelMutation) SetIsHidden(b bool) {
-	m.is_hidden = &b
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GenerationOutputMutation) ClearEdge(name string) error {
+	switch name {
+	case generationoutput.EdgeGenerations:
+		m.ClearGenerations()
+		return nil
+	case generationoutput.EdgeUpscaleOutputs:
+		m.ClearUpscaleOutputs()
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationOutput unique edge %s", name)
 }
 
-// IsHidden returns the value of the "is_hidden" field in the mutation.
-func (m *GenerationModelMutation) IsHidden() (r bool, exists bool) {
-	v := m.is_hidden
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GenerationOutputMutation) ResetEdge(name string) error {
+	switch name {
+	case generationoutput.EdgeGenerations:
+		m.ResetGenerations()
+		return nil
+	case generationoutput.EdgeUpscaleOutputs:
+		m.ResetUpscaleOutputs()
+		return nil
+	case generationoutput.EdgeGenerationOutputLikes:
+		m.ResetGenerationOutputLikes()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown GenerationOutput edge %s", name)
 }
 
-// OldIsHidden returns the old "is_hidden" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldIsHidden(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsHidden is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsHidden requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsHidden: %w", err)
-	}
-	return oldValue.IsHidden, nil
+// GenerationOutputLikeMutation represents an operation that mutates the GenerationOutputLike nodes in the graph.
+type GenerationOutputLikeMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	created_at                *time.Time
+	clearedFields             map[string]struct{}
+	generation_outputs        *uuid.UUID
+	clearedgeneration_outputs bool
+	users                     *uuid.UUID
+	clearedusers              bool
+	done                      bool
+	oldValue                  func(context.Context) (*GenerationOutputLike, error)
+	predicates                []predicate.GenerationOutputLike
 }
 
-// ResetIsHidden resets all changes to the "is_hidden" field.
-func (m *GenerationModelMutation) ResetIsHidden() {
-	m.is_hidden = nil
-}
+var _ ent.Mutation = (*GenerationOutputLikeMutation)(nil)
 
-// SetRunpodEndpoint sets the "runpod_endpoint" field.
-func (m *GenerationModelMutation) SetRunpodEndpoint(s string) {
-	m.runpod_endpoint = &s
-}
+// generationoutputlikeOption allows management of the mutation configuration using functional options.
+type generationoutputlikeOption func(*GenerationOutputLikeMutation)
 
-// RunpodEndpoint returns the value of the "runpod_endpoint" field in the mutation.
-func (m *GenerationModelMutation) RunpodEndpoint() (r string, exists bool) {
-	v := m.runpod_endpoint
-	if v == nil {
-		return
+// newGenerationOutputLikeMutation creates new mutation for the GenerationOutputLike entity.
+func newGenerationOutputLikeMutation(c config, op Op, opts ...generationoutputlikeOption) *GenerationOutputLikeMutation {
+	m := &GenerationOutputLikeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGenerationOutputLike,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldRunpodEndpoint returns the old "runpod_endpoint" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldRunpodEndpoint(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRunpodEndpoint is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRunpodEndpoint requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRunpodEndpoint: %w", err)
+// withGenerationOutputLikeID sets the ID field of the mutation.
+func withGenerationOutputLikeID(id uuid.UUID) generationoutputlikeOption {
+	return func(m *GenerationOutputLikeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GenerationOutputLike
+		)
+		m.oldValue = func(ctx context.Context) (*GenerationOutputLike, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GenerationOutputLike.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.RunpodEndpoint, nil
 }
 
-// ClearRunpodEndpoint clears the value of the "runpod_endpoint" field.
-func (m *GenerationModelMutation) ClearRunpodEndpoint() {
-	m.runpod_endpoint = nil
-	m.clearedFields[generationmodel.FieldRunpodEndpoint] = struct{}{}
+// withGenerationOutputLike sets the old GenerationOutputLike of the mutation.
+func withGenerationOutputLike(node *GenerationOutputLike) generationoutputlikeOption {
+	return func(m *GenerationOutputLikeMutation) {
+		m.oldValue = func(context.Context) (*GenerationOutputLike, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// RunpodEndpointCleared returns if the "runpod_endpoint" field was cleared in this mutation.
-func (m *GenerationModelMutation) RunpodEndpointCleared() bool {
-	_, ok := m.clearedFields[generationmodel.FieldRunpodEndpoint]
-	return ok
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GenerationOutputLikeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetRunpodEndpoint resets all changes to the "runpod_endpoint" field.
-func (m *GenerationModelMutation) ResetRunpodEndpoint() {
-	m.runpod_endpoint = nil
-	delete(m.clearedFields, generationmodel.FieldRunpodEndpoint)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GenerationOutputLikeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetRunpodActive sets the "runpod_active" field.
-func (m *GenerationModelMutation) SetRunpodActive(b bool) {
-	m.runpod_active = &b
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GenerationOutputLike entities.
+func (m *GenerationOutputLikeMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// RunpodActive returns the value of the "runpod_active" field in the mutation.
-func (m *GenerationModelMutation) RunpodActive() (r bool, exists bool) {
-	v := m.runpod_active
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GenerationOutputLikeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldRunpodActive returns the old "runpod_active" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldRunpodActive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRunpodActive is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRunpodActive requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRunpodActive: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GenerationOutputLikeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GenerationOutputLike.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.RunpodActive, nil
-}
-
-// ResetRunpodActive resets all changes to the "runpod_active" field.
-func (m *GenerationModelMutation) ResetRunpodActive() {
-	m.runpod_active = nil
 }
 
-// SetDisplayWeight sets the "display_weight" field.
-func (m *GenerationModelMutation) SetDisplayWeight(i int32) {
-	m.display_weight = &i
-	m.adddisplay_weight = nil
+// SetOutputID sets the "output_id" field.
+func (m *GenerationOutputLikeMutation) SetOutputID(u uuid.UUID) {
+	m.generation_outputs = &u
 }
 
-// DisplayWeight returns the value of the "display_weight" field in the mutation.
-func (m *GenerationModelMutation) DisplayWeight() (r int32, exists bool) {
-	v := m.display_weight
+// OutputID returns the value of the "output_id" field in the mutation.
+func (m *GenerationOutputLikeMutation) OutputID() (r uuid.UUID, exists bool) {
+	v := m.generation_outputs
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayWeight returns the old "display_weight" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// OldOutputID returns the old "output_id" field's value of the GenerationOutputLike entity.
+// If the GenerationOutputLike object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldDisplayWeight(ctx context.Context) (v int32, err error) {
+func (m *GenerationOutputLikeMutation) OldOutputID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayWeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldOutputID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayWeight requires an ID field in the mutation")
+		return v, errors.New("OldOutputID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayWeight: %w", err)
-	}
-	return oldValue.DisplayWeight, nil
-}
-
-// AddDisplayWeight adds i to the "display_weight" field.
-func (m *GenerationModelMutation) AddDisplayWeight(i int32) {
-	if m.adddisplay_weight != nil {
-		*m.adddisplay_weight += i
-	} else {
-		m.adddisplay_weight = &i
-	}
-}
-
-// AddedDisplayWeight returns the value that was added to the "display_weight" field in this mutation.
-func (m *GenerationModelMutation) AddedDisplayWeight() (r int32, exists bool) {
-	v := m.adddisplay_weight
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldOutputID: %w", err)
 	}
-	return *v, true
+	return oldValue.OutputID, nil
 }
 
-// ResetDisplayWeight resets all changes to the "display_weight" field.
-func (m *GenerationModelMutation) ResetDisplayWeight() {
-	m.display_weight = nil
-	m.adddisplay_weight = nil
+// ResetOutputID resets all changes to the "output_id" field.
+func (m *GenerationOutputLikeMutation) ResetOutputID() {
+	m.generation_outputs = nil
 }
 
-// SetDefaultSchedulerID sets the "default_scheduler_id" field.
-func (m *GenerationModelMutation) SetDefaultSchedulerID(u uuid.UUID) {
-	m.default_scheduler_id = &u
+// SetLikedByUserID sets the "liked_by_user_id" field.
+func (m *GenerationOutputLikeMutation) SetLikedByUserID(u uuid.UUID) {
+	m.users = &u
 }
 
-// DefaultSchedulerID returns the value of the "default_scheduler_id" field in the mutation.
-func (m *GenerationModelMutation) DefaultSchedulerID() (r uuid.UUID, exists bool) {
-	v := m.default_scheduler_id
+// LikedByUserID returns the value of the "liked_by_user_id" field in the mutation.
+func (m *GenerationOutputLikeMutation) LikedByUserID() (r uuid.UUID, exists bool) {
+	v := m.users
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDefaultSchedulerID returns the old "default_scheduler_id" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// OldLikedByUserID returns the old "liked_by_user_id" field's value of the GenerationOutputLike entity.
+// If the GenerationOutputLike object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldDefaultSchedulerID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *GenerationOutputLikeMutation) OldLikedByUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDefaultSchedulerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLikedByUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDefaultSchedulerID requires an ID field in the mutation")
+		return v, errors.New("OldLikedByUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDefaultSchedulerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLikedByUserID: %w", err)
 	}
-	return oldValue.DefaultSchedulerID, nil
-}
-
-// ClearDefaultSchedulerID clears the value of the "default_scheduler_id" field.
-func (m *GenerationModelMutation) ClearDefaultSchedulerID() {
-	m.default_scheduler_id = nil
-	m.clearedFields[generationmodel.FieldDefaultSchedulerID] = struct{}{}
-}
-
-// DefaultSchedulerIDCleared returns if the "default_scheduler_id" field was cleared in this mutation.
-func (m *GenerationModelMutation) DefaultSchedulerIDCleared() bool {
-	_, ok := m.clearedFields[generationmodel.FieldDefaultSchedulerID]
-	return ok
+	return oldValue.LikedByUserID, nil
 }
 
-// ResetDefaultSchedulerID resets all changes to the "default_scheduler_id" field.
-func (m *GenerationModelMutation) ResetDefaultSchedulerID() {
-	m.default_scheduler_id = nil
-	delete(m.clearedFields, generationmodel.FieldDefaultSchedulerID)
+// ResetLikedByUserID resets all changes to the "liked_by_user_id" field.
+func (m *GenerationOutputLikeMutation) ResetLikedByUserID() {
+	m.users = nil
 }
 
-// SetDefaultWidth sets the "default_width" field.
-func (m *GenerationModelMutation) SetDefaultWidth(i int32) {
-	m.default_width = &i
-	m.adddefault_width = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *GenerationOutputLikeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// DefaultWidth returns the value of the "default_width" field in the mutation.
-func (m *GenerationModelMutation) DefaultWidth() (r int32, exists bool) {
-	v := m.default_width
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GenerationOutputLikeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDefaultWidth returns the old "default_width" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the GenerationOutputLike entity.
+// If the GenerationOutputLike object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldDefaultWidth(ctx context.Context) (v int32, err error) {
+func (m *GenerationOutputLikeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDefaultWidth is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDefaultWidth requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDefaultWidth: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.DefaultWidth, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddDefaultWidth adds i to the "default_width" field.
-func (m *GenerationModelMutation) AddDefaultWidth(i int32) {
-	if m.adddefault_width != nil {
-		*m.adddefault_width += i
-	} else {
-		m.adddefault_width = &i
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GenerationOutputLikeMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddedDefaultWidth returns the value that was added to the "default_width" field in this mutation.
-func (m *GenerationModelMutation) AddedDefaultWidth() (r int32, exists bool) {
-	v := m.adddefault_width
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetGenerationOutputsID sets the "generation_outputs" edge to the GenerationOutput entity by id.
+func (m *GenerationOutputLikeMutation) SetGenerationOutputsID(id uuid.UUID) {
+	m.generation_outputs = &id
 }
 
-// ResetDefaultWidth resets all changes to the "default_width" field.
-func (m *GenerationModelMutation) ResetDefaultWidth() {
-	m.default_width = nil
-	m.adddefault_width = nil
+// ClearGenerationOutputs clears the "generation_outputs" edge to the GenerationOutput entity.
+func (m *GenerationOutputLikeMutation) ClearGenerationOutputs() {
+	m.clearedgeneration_outputs = true
+	m.clearedFields[generationoutputlike.FieldOutputID] = struct{}{}
 }
 
-// SetDefaultHeight sets the "default_height" field.
-func (m *GenerationModelMutation) SetDefaultHeight(i int32) {
-	m.default_height = &i
-	m.adddefault_height = nil
+// GenerationOutputsCleared reports if the "generation_outputs" edge to the GenerationOutput entity was cleared.
+func (m *GenerationOutputLikeMutation) GenerationOutputsCleared() bool {
+	return m.clearedgeneration_outputs
 }
 
-// DefaultHeight returns the value of the "default_height" field in the mutation.
-func (m *GenerationModelMutation) DefaultHeight() (r int32, exists bool) {
-	v := m.default_height
-	if v == nil {
-		return
+// GenerationOutputsID returns the "generation_outputs" edge ID in the mutation.
+func (m *GenerationOutputLikeMutation) GenerationOutputsID() (id uuid.UUID, exists bool) {
+	if m.generation_outputs != nil {
+		return *m.generation_outputs, true
 	}
-	return *v, true
+	return
 }
 
-// OldDefaultHeight returns the old "default_height" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldDefaultHeight(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDefaultHeight is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDefaultHeight requires an ID field in the mutation")
+// GenerationOutputsIDs returns the "generation_outputs" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GenerationOutputsID instead. It exists only for internal usage by the builders.
+func (m *GenerationOutputLikeMutation) GenerationOutputsIDs() (ids []uuid.UUID) {
+	if id := m.generation_outputs; id != nil {
+		ids = append(ids, *id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDefaultHeight: %w", err)
+	return
+}
+
+// ResetGenerationOutputs resets all changes to the "generation_outputs" edge.
+func (m *GenerationOutputLikeMutation) ResetGenerationOutputs() {
+	m.generation_outputs = nil
+	m.clearedgeneration_outputs = false
+}
+
+// SetUsersID sets the "users" edge to the User entity by id.
+func (m *GenerationOutputLikeMutation) SetUsersID(id uuid.UUID) {
+	m.users = &id
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *GenerationOutputLikeMutation) ClearUsers() {
+	m.clearedusers = true
+	m.clearedFields[generationoutputlike.FieldLikedByUserID] = struct{}{}
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *GenerationOutputLikeMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// UsersID returns the "users" edge ID in the mutation.
+func (m *GenerationOutputLikeMutation) UsersID() (id uuid.UUID, exists bool) {
+	if m.users != nil {
+		return *m.users, true
 	}
-	return oldValue.DefaultHeight, nil
+	return
 }
 
-// AddDefaultHeight adds i to the "default_height" field.
-func (m *GenerationModelMutation) AddDefaultHeight(i int32) {
-	if m.adddefault_height != nil {
-		*m.adddefault_height += i
-	} else {
-		m.adddefault_height = &i
+// UsersIDs returns the "users" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UsersID instead. It exists only for internal usage by the builders.
+func (m *GenerationOutputLikeMutation) UsersIDs() (ids []uuid.UUID) {
+	if id := m.users; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// AddedDefaultHeight returns the value that was added to the "default_height" field in this mutation.
-func (m *GenerationModelMutation) AddedDefaultHeight() (r int32, exists bool) {
-	v := m.adddefault_height
-	if v == nil {
-		return
+// ResetUsers resets all changes to the "users" edge.
+func (m *GenerationOutputLikeMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+}
+
+// Where appends a list predicates to the GenerationOutputLikeMutation builder.
+func (m *GenerationOutputLikeMutation) Where(ps ...predicate.GenerationOutputLike) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GenerationOutputLikeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GenerationOutputLikeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GenerationOutputLike, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetDefaultHeight resets all changes to the "default_height" field.
-func (m *GenerationModelMutation) ResetDefaultHeight() {
-	m.default_height = nil
-	m.adddefault_height = nil
+// Op returns the operation name.
+func (m *GenerationOutputLikeMutation) Op() Op {
+	return m.op
 }
 
-// SetDefaultInferenceSteps sets the "default_inference_steps" field.
-func (m *GenerationModelMutation) SetDefaultInferenceSteps(i int32) {
-	m.default_inference_steps = &i
-	m.adddefault_inference_steps = nil
+// SetOp allows setting the mutation operation.
+func (m *GenerationOutputLikeMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// DefaultInferenceSteps returns the value of the "default_inference_steps" field in the mutation.
-func (m *GenerationModelMutation) DefaultInferenceSteps() (r int32, exists bool) {
-	v := m.default_inference_steps
-	if v == nil {
-		return
+// Type returns the node type of this mutation (GenerationOutputLike).
+func (m *GenerationOutputLikeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GenerationOutputLikeMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.generation_outputs != nil {
+		fields = append(fields, generationoutputlike.FieldOutputID)
 	}
-	return *v, true
+	if m.users != nil {
+		fields = append(fields, generationoutputlike.FieldLikedByUserID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, generationoutputlike.FieldCreatedAt)
+	}
+	return fields
 }
 
-// OldDefaultInferenceSteps returns the old "default_inference_steps" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldDefaultInferenceSteps(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDefaultInferenceSteps is only allowed on UpdateOne operations")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GenerationOutputLikeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case generationoutputlike.FieldOutputID:
+		return m.OutputID()
+	case generationoutputlike.FieldLikedByUserID:
+		return m.LikedByUserID()
+	case generationoutputlike.FieldCreatedAt:
+		return m.CreatedAt()
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDefaultInferenceSteps requires an ID field in the mutation")
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GenerationOutputLikeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case generationoutputlike.FieldOutputID:
+		return m.OldOutputID(ctx)
+	case generationoutputlike.FieldLikedByUserID:
+		return m.OldLikedByUserID(ctx)
+	case generationoutputlike.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDefaultInferenceSteps: %w", err)
+	return nil, fmt.Errorf("unknown GenerationOutputLike field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenerationOutputLikeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case generationoutputlike.FieldOutputID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputID(v)
+		return nil
+	case generationoutputlike.FieldLikedByUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLikedByUserID(v)
+		return nil
+	case generationoutputlike.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	return oldValue.DefaultInferenceSteps, nil
+	return fmt.Errorf("unknown GenerationOutputLike field %s", name)
 }
 
-// AddDefaultInferenceSteps adds i to the "default_inference_steps" field.
-func (m *GenerationModelMutation) AddDefaultInferenceSteps(i int32) {
-	if m.adddefault_inference_steps != nil {
-		*m.adddefault_inference_steps += i
-	} else {
-		m.adddefault_inference_steps = &i
-	}
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GenerationOutputLikeMutation) AddedFields() []string {
+	return nil
 }
 
-// AddedDefaultInferenceSteps returns the value that was added to the "default_inference_steps" field in this mutation.
-func (m *GenerationModelMutation) AddedDefaultInferenceSteps() (r int32, exists bool) {
-	v := m.adddefault_inference_steps
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GenerationOutputLikeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenerationOutputLikeMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown GenerationOutputLike numeric field %s", name)
 }
 
-// ResetDefaultInferenceSteps resets all changes to the "default_inference_steps" field.
-func (m *GenerationModelMutation) ResetDefaultInferenceSteps() {
-	m.default_inference_steps = nil
-	m.adddefault_inference_steps = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GenerationOutputLikeMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *GenerationModelMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GenerationOutputLikeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GenerationModelMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GenerationOutputLikeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GenerationOutputLike nullable field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GenerationOutputLikeMutation) ResetField(name string) error {
+	switch name {
+	case generationoutputlike.FieldOutputID:
+		m.ResetOutputID()
+		return nil
+	case generationoutputlike.FieldLikedByUserID:
+		m.ResetLikedByUserID()
+		return nil
+	case generationoutputlike.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	return fmt.Errorf("unknown GenerationOutputLike field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GenerationOutputLikeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.generation_outputs != nil {
+		edges = append(edges, generationoutputlike.EdgeGenerationOutputs)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.users != nil {
+		edges = append(edges, generationoutputlike.EdgeUsers)
 	}
-	return oldValue.CreatedAt, nil
+	return edges
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GenerationModelMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GenerationOutputLikeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case generationoutputlike.EdgeGenerationOutputs:
+		if id := m.generation_outputs; id != nil {
+			return []ent.Value{*id}
+		}
+	case generationoutputlike.EdgeUsers:
+		if id := m.users; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *GenerationModelMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GenerationOutputLikeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GenerationModelMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GenerationOutputLikeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the GenerationModel entity.
-// If the GenerationModel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationModelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GenerationOutputLikeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgeneration_outputs {
+		edges = append(edges, generationoutputlike.EdgeGenerationOutputs)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.clearedusers {
+		edges = append(edges, generationoutputlike.EdgeUsers)
 	}
-	return oldValue.UpdatedAt, nil
+	return edges
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GenerationModelMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GenerationOutputLikeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case generationoutputlike.EdgeGenerationOutputs:
+		return m.clearedgeneration_outputs
+	case generationoutputlike.EdgeUsers:
+		return m.clearedusers
+	}
+	return false
 }
 
-// AddGenerationIDs adds the "generations" edge to the Generation entity by ids.
-func (m *GenerationModelMutation) AddGenerationIDs(ids ...uuid.UUID) {
-	if m.generations == nil {
-		m.generations = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.generations[ids[i]] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GenerationOutputLikeMutation) ClearEdge(name string) error {
+	switch name {
+	case generationoutputlike.EdgeGenerationOutputs:
+		m.ClearGenerationOutputs()
+		return nil
+	case generationoutputlike.EdgeUsers:
+		m.ClearUsers()
+		return nil
 	}
+	return fmt.Errorf("unknown GenerationOutputLike unique edge %s", name)
 }
 
-// ClearGenerations clears the "generations" edge to the Generation entity.
-func (m *GenerationModelMutation) ClearGenerations() {
-	m.clearedgenerations = true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GenerationOutputLikeMutation) ResetEdge(name string) error {
+	switch name {
+	case generationoutputlike.EdgeGenerationOutputs:
+		m.ResetGenerationOutputs()
+		return nil
+	case generationoutputlike.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	}
+	return fmt.Errorf("unknown GenerationOutputLike edge %s", name)
 }
 
-// GenerationsCleared reports if the "generations" edge to the Generation entity was cleared.
-func (m *GenerationModelMutation) GenerationsCleared() bool {
-	return m.clearedgenerations
+// GenerationPresetMutation represents an operation that mutates the GenerationPreset nodes in the graph.
+type GenerationPresetMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	user_id            *uuid.UUID
+	name               *string
+	prompt_template    *string
+	negative_prompt    *string
+	model_id           *uuid.UUID
+	scheduler_id       *uuid.UUID
+	width              *int32
+	addwidth           *int32
+	height             *int32
+	addheight          *int32
+	inference_steps    *int32
+	addinference_steps *int32
+	guidance_scale     *float32
+	addguidance_scale  *float32
+	num_outputs        *int32
+	addnum_outputs     *int32
+	prompt_strength    *float32
+	addprompt_strength *float32
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*GenerationPreset, error)
+	predicates         []predicate.GenerationPreset
 }
 
-// RemoveGenerationIDs removes the "generations" edge to the Generation entity by IDs.
-func (m *GenerationModelMutation) RemoveGenerationIDs(ids ...uuid.UUID) {
-	if m.removedgenerations == nil {
-		m.removedgenerations = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.generations, ids[i])
-		m.removedgenerations[ids[i]] = struct{}{}
-	}
-}
+var _ ent.Mutation = (*GenerationPresetMutation)(nil)
 
-// RemovedGenerations returns the removed IDs of the "generations" edge to the Generation entity.
-func (m *GenerationModelMutation) RemovedGenerationsIDs() (ids []uuid.UUID) {
-	for id := range m.removedgenerations {
-		ids = append(ids, id)
-	}
-	return
-}
+// generationpresetOption allows management of the mutation configuration using functional options.
+type generationpresetOption func(*GenerationPresetMutation)
 
-// GenerationsIDs returns the "generations" edge IDs in the mutation.
-func (m *GenerationModelMutation) GenerationsIDs() (ids []uuid.UUID) {
-	for id := range m.generations {
-		ids = append(ids, id)
+// newGenerationPresetMutation creates new mutation for the GenerationPreset entity.
+func newGenerationPresetMutation(c config, op Op, opts ...generationpresetOption) *GenerationPresetMutation {
+	m := &GenerationPresetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGenerationPreset,
+		clearedFields: make(map[string]struct{}),
 	}
-	return
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ResetGenerations resets all changes to the "generations" edge.
-func (m *GenerationModelMutation) ResetGenerations() {
-	m.generations = nil
-	m.clearedgenerations = false
-	m.removedgenerations = nil
+// withGenerationPresetID sets the ID field of the mutation.
+func withGenerationPresetID(id uuid.UUID) generationpresetOption {
+	return func(m *GenerationPresetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GenerationPreset
+		)
+		m.oldValue = func(ctx context.Context) (*GenerationPreset, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GenerationPreset.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// AddSchedulerIDs adds the "schedulers" edge to the Scheduler entity by ids.
-func (m *GenerationModelMutation) AddSchedulerIDs(ids ...uuid.UUID) {
-	if m.schedulers == nil {
-		m.schedulers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.schedulers[ids[i]] = struct{}{}
+// withGenerationPreset sets the old GenerationPreset of the mutation.
+func withGenerationPreset(node *GenerationPreset) generationpresetOption {
+	return func(m *GenerationPresetMutation) {
+		m.oldValue = func(context.Context) (*GenerationPreset, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// ClearSchedulers clears the "schedulers" edge to the Scheduler entity.
-func (m *GenerationModelMutation) ClearSchedulers() {
-	m.clearedschedulers = true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GenerationPresetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SchedulersCleared reports if the "schedulers" edge to the Scheduler entity was cleared.
-func (m *GenerationModelMutation) SchedulersCleared() bool {
-	return m.clearedschedulers
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GenerationPresetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// RemoveSchedulerIDs removes the "schedulers" edge to the Scheduler entity by IDs.
-func (m *GenerationModelMutation) RemoveSchedulerIDs(ids ...uuid.UUID) {
-	if m.removedschedulers == nil {
-		m.removedschedulers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.schedulers, ids[i])
-		m.removedschedulers[ids[i]] = struct{}{}
-	}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GenerationPreset entities.
+func (m *GenerationPresetMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// RemovedSchedulers returns the removed IDs of the "schedulers" edge to the Scheduler entity.
-func (m *GenerationModelMutation) RemovedSchedulersIDs() (ids []uuid.UUID) {
-	for id := range m.removedschedulers {
-		ids = append(ids, id)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GenerationPresetMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return
+	return *m.id, true
 }
 
-// SchedulersIDs returns the "schedulers" edge IDs in the mutation.
-func (m *GenerationModelMutation) SchedulersIDs() (ids []uuid.UUID) {
-	for id := range m.schedulers {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GenerationPresetMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GenerationPreset.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// ResetSchedulers resets all changes to the "schedulers" edge.
-func (m *GenerationModelMutation) ResetSchedulers() {
-	m.schedulers = nil
-	m.clearedschedulers = false
-	m.removedschedulers = nil
+// SetUserID sets the "user_id" field.
+func (m *GenerationPresetMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// Where appends a list predicates to the GenerationModelMutation builder.
-func (m *GenerationModelMutation) Where(ps ...predicate.GenerationModel) {
-	m.predicates = append(m.predicates, ps...)
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *GenerationPresetMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the GenerationModelMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GenerationModelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GenerationModel, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldUserID returns the old "user_id" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationPresetMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// Op returns the operation name.
-func (m *GenerationModelMutation) Op() Op {
-	return m.op
+// ResetUserID resets all changes to the "user_id" field.
+func (m *GenerationPresetMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *GenerationModelMutation) SetOp(op Op) {
-	m.op = op
+// SetName sets the "name" field.
+func (m *GenerationPresetMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Type returns the node type of this mutation (GenerationModel).
-func (m *GenerationModelMutation) Type() string {
-	return m.typ
+// Name returns the value of the "name" field in the mutation.
+func (m *GenerationPresetMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *GenerationModelMutation) Fields() []string {
-	fields := make([]string, 0, 14)
-	if m.name_in_worker != nil {
-		fields = append(fields, generationmodel.FieldNameInWorker)
-	}
-	if m.short_name != nil {
-		fields = append(fields, generationmodel.FieldShortName)
-	}
-	if m.is_active != nil {
-		fields = append(fields, generationmodel.FieldIsActive)
-	}
-	if m.is_default != nil {
-		fields = append(fields, generationmodel.FieldIsDefault)
-	}
-	if m.is_hidden != nil {
-		fields = append(fields, generationmodel.FieldIsHidden)
-	}
-	if m.runpod_endpoint != nil {
-		fields = append(fields, generationmodel.FieldRunpodEndpoint)
-	}
-	if m.runpod_active != nil {
-		fields = append(fields, generationmodel.FieldRunpodActive)
-	}
-	if m.display_weight != nil {
-		fields = append(fields, generationmodel.FieldDisplayWeight)
-	}
-	if m.default_scheduler_id != nil {
-		fields = append(fields, generationmodel.FieldDefaultSchedulerID)
-	}
-	if m.default_width != nil {
-		fields = append(fields, generationmodel.FieldDefaultWidth)
-	}
-	if m.default_height != nil {
-		fields = append(fields, generationmodel.FieldDefaultHeight)
-	}
-	if m.default_inference_steps != nil {
-		fields = append(fields, generationmodel.FieldDefaultInferenceSteps)
+// OldName returns the old "name" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationPresetMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, generationmodel.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, generationmodel.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return fields
+	return oldValue.Name, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *GenerationModelMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case generationmodel.FieldNameInWorker:
-		return m.NameInWorker()
-	case generationmodel.FieldShortName:
-		return m.ShortName()
-	case generationmodel.FieldIsActive:
-		return m.IsActive()
-	case generationmodel.FieldIsDefault:
-		return m.IsDefault()
-	case generationmodel.FieldIsHidden:
-		return m.IsHidden()
-	case generationmodel.FieldRunpodEndpoint:
-		return m.RunpodEndpoint()
-	case generationmodel.FieldRunpodActive:
-		return m.RunpodActive()
-	case generationmodel.FieldDisplayWeight:
-		return m.DisplayWeight()
-	case generationmodel.FieldDefaultSchedulerID:
-		return m.DefaultSchedulerID()
-	case generationmodel.FieldDefaultWidth:
-		return m.DefaultWidth()
-	case generationmodel.FieldDefaultHeight:
-		return m.DefaultHeight()
-	case generationmodel.FieldDefaultInferenceSteps:
-		return m.DefaultInferenceSteps()
-	case generationmodel.FieldCreatedAt:
-		return m.CreatedAt()
-	case generationmodel.FieldUpdatedAt:
-		return m.UpdatedAt()
+// ResetName resets all changes to the "name" field.
+func (m *GenerationPresetMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPromptTemplate sets the "prompt_template" field.
+func (m *GenerationPresetMutation) SetPromptTemplate(s string) {
+	m.prompt_template = &s
+}
+
+// PromptTemplate returns the value of the "prompt_template" field in the mutation.
+func (m *GenerationPresetMutation) PromptTemplate() (r string, exists bool) {
+	v := m.prompt_template
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *GenerationModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case generationmodel.FieldNameInWorker:
-		return m.OldNameInWorker(ctx)
-	case generationmodel.FieldShortName:
-		return m.OldShortName(ctx)
-	case generationmodel.FieldIsActive:
-		return m.OldIsActive(ctx)
-	case generationmodel.FieldIsDefault:
-		return m.OldIsDefault(ctx)
-	case generationmodel.FieldIsHidden:
-		return m.OldIsHidden(ctx)
-	case generationmodel.FieldRunpodEndpoint:
-		return m.OldRunpodEndpoint(ctx)
-	case generationmodel.FieldRunpodActive:
-		return m.OldRunpodActive(ctx)
-	case generationmodel.FieldDisplayWeight:
-		return m.OldDisplayWeight(ctx)
-	case generationmodel.FieldDefaultSchedulerID:
-		return m.OldDefaultSchedulerID(ctx)
-	case generationmodel.FieldDefaultWidth:
-		return m.OldDefaultWidth(ctx)
-	case generationmodel.FieldDefaultHeight:
-		return m.OldDefaultHeight(ctx)
-	case generationmodel.FieldDefaultInferenceSteps:
-		return m.OldDefaultInferenceSteps(ctx)
-	case generationmodel.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case generationmodel.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// OldPromptTemplate returns the old "prompt_template" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationPresetMutation) OldPromptTemplate(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTemplate: %w", err)
 	}
-	return nil, fmt.Errorf("unknown GenerationModel field %s", name)
+	return oldValue.PromptTemplate, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GenerationModelMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case generationmodel.FieldNameInWorker:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNameInWorker(v)
-		return nil
-	case generationmodel.FieldShortName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetShortName(v)
-		return nil
-	case generationmodel.FieldIsActive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsActive(v)
-		return nil
-	case generationmodel.FieldIsDefault:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsDefault(v)
-		return nil
-	case generationmodel.FieldIsHidden:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsHidden(v)
-		return nil
-	case generationmodel.FieldRunpodEndpoint:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRunpodEndpoint(v)
-		return nil
-	case generationmodel.FieldRunpodActive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRunpodActive(v)
-		return nil
-	case generationmodel.FieldDisplayWeight:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDisplayWeight(v)
-		return nil
-	case generationmodel.FieldDefaultSchedulerID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDefaultSchedulerID(v)
-		return nil
-	case generationmodel.FieldDefaultWidth:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDefaultWidth(v)
-		return nil
-	case generationmodel.FieldDefaultHeight:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDefaultHeight(v)
-		return nil
-	case generationmodel.FieldDefaultInferenceSteps:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDefaultInferenceSteps(v)
-		return nil
-	case generationmodel.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case generationmodel.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// ClearPromptTemplate clears the value of the "prompt_template" field.
+func (m *GenerationPresetMutation) ClearPromptTemplate() {
+	m.prompt_template = nil
+	m.clearedFields[generationpreset.FieldPromptTemplate] = struct{}{}
+}
+
+// PromptTemplateCleared returns if the "prompt_template" field was cleared in this mutation.
+func (m *GenerationPresetMutation) PromptTemplateCleared() bool {
+	_, ok := m.clearedFields[generationpreset.FieldPromptTemplate]
+	return ok
+}
+
+// ResetPromptTemplate resets all changes to the "prompt_template" field.
+func (m *GenerationPresetMutation) ResetPromptTemplate() {
+	m.prompt_template = nil
+	delete(m.clearedFields, generationpreset.FieldPromptTemplate)
+}
+
+// SetNegativePrompt sets the "negative_prompt" field.
+func (m *GenerationPresetMutation) SetNegativePrompt(s string) {
+	m.negative_prompt = &s
+}
+
+// NegativePrompt returns the value of the "negative_prompt" field in the mutation.
+func (m *GenerationPresetMutation) NegativePrompt() (r string, exists bool) {
+	v := m.negative_prompt
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown GenerationModel field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *GenerationModelMutation) AddedFields() []string {
-	var fields []string
-	if m.adddisplay_weight != nil {
-		fields = append(fields, generationmodel.FieldDisplayWeight)
+// OldNegativePrompt returns the old "negative_prompt" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationPresetMutation) OldNegativePrompt(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNegativePrompt is only allowed on UpdateOne operations")
 	}
-	if m.adddefault_width != nil {
-		fields = append(fields, generationmodel.FieldDefaultWidth)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNegativePrompt requires an ID field in the mutation")
 	}
-	if m.adddefault_height != nil {
-		fields = append(fields, generationmodel.FieldDefaultHeight)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNegativePrompt: %w", err)
 	}
-	if m.adddefault_inference_steps != nil {
-		fields = append(fields, generationmodel.FieldDefaultInferenceSteps)
+	return oldValue.NegativePrompt, nil
+}
+
+// ClearNegativePrompt clears the value of the "negative_prompt" field.
+func (m *GenerationPresetMutation) ClearNegativePrompt() {
+	m.negative_prompt = nil
+	m.clearedFields[generationpreset.FieldNegativePrompt] = struct{}{}
+}
+
+// NegativePromptCleared returns if the "negative_prompt" field was cleared in this mutation.
+func (m *GenerationPresetMutation) NegativePromptCleared() bool {
+	_, ok := m.clearedFields[generationpreset.FieldNegativePrompt]
+	return ok
+}
+
+// ResetNegativePrompt resets all changes to the "negative_prompt" field.
+func (m *GenerationPresetMutation) ResetNegativePrompt() {
+	m.negative_prompt = nil
+	delete(m.clearedFields, generationpreset.FieldNegativePrompt)
+}
+
+// SetModelID sets the "model_id" field.
+func (m *GenerationPresetMutation) SetModelID(u uuid.UUID) {
+	m.model_id = &u
+}
+
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *GenerationPresetMutation) ModelID() (r uuid.UUID, exists bool) {
+	v := m.model_id
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *GenerationModelMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case generationmodel.FieldDisplayWeight:
-		return m.AddedDisplayWeight()
-	case generationmodel.FieldDefaultWidth:
-		return m.AddedDefaultWidth()
-	case generationmodel.FieldDefaultHeight:
-		return m.AddedDefaultHeight()
-	case generationmodel.FieldDefaultInferenceSteps:
-		return m.AddedDefaultInferenceSteps()
+// OldModelID returns the old "model_id" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationPresetMutation) OldModelID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+	}
+	return oldValue.ModelID, nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GenerationModelMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case generationmodel.FieldDisplayWeight:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDisplayWeight(v)
-		return nil
-	case generationmodel.FieldDefaultWidth:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDefaultWidth(v)
-		return nil
-	case generationmodel.FieldDefaultHeight:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDefaultHeight(v)
-		return nil
-	case generationmodel.FieldDefaultInferenceSteps:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDefaultInferenceSteps(v)
-		return nil
+// ClearModelID clears the value of the "model_id" field.
+func (m *GenerationPresetMutation) ClearModelID() {
+	m.model_id = nil
+	m.clearedFields[generationpreset.FieldModelID] = struct{}{}
+}
+
+// ModelIDCleared returns if the "model_id" field was cleared in this mutation.
+func (m *GenerationPresetMutation) ModelIDCleared() bool {
+	_, ok := m.clearedFields[generationpreset.FieldModelID]
+	return ok
+}
+
+// ResetModelID resets all changes to the "model_id" field.
+func (m *GenerationPresetMutation) ResetModelID() {
+	m.model_id = nil
+	delete(m.clearedFields, generationpreset.FieldModelID)
+}
+
+// SetSchedulerID sets the "scheduler_id" field.
+func (m *GenerationPresetMutation) SetSchedulerID(u uuid.UUID) {
+	m.scheduler_id = &u
+}
+
+// SchedulerID returns the value of the "scheduler_id" field in the mutation.
+func (m *GenerationPresetMutation) SchedulerID() (r uuid.UUID, exists bool) {
+	v := m.scheduler_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown GenerationModel numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *GenerationModelMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(generationmodel.FieldRunpodEndpoint) {
-		fields = append(fields, generationmodel.FieldRunpodEndpoint)
+// OldSchedulerID returns the old "scheduler_id" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationPresetMutation) OldSchedulerID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchedulerID is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(generationmodel.FieldDefaultSchedulerID) {
-		fields = append(fields, generationmodel.FieldDefaultSchedulerID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchedulerID requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchedulerID: %w", err)
+	}
+	return oldValue.SchedulerID, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *GenerationModelMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearSchedulerID clears the value of the "scheduler_id" field.
+func (m *GenerationPresetMutation) ClearSchedulerID() {
+	m.scheduler_id = nil
+	m.clearedFields[generationpreset.FieldSchedulerID] = struct{}{}
+}
+
+// SchedulerIDCleared returns if the "scheduler_id" field was cleared in this mutation.
+func (m *GenerationPresetMutation) SchedulerIDCleared() bool {
+	_, ok := m.clearedFields[generationpreset.FieldSchedulerID]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *GenerationModelMutation) ClearField(name string) error {
-	switch name {
-	case generationmodel.FieldRunpodEndpoint:
-		m.ClearRunpodEndpoint()
-		return nil
-	case generationmodel.FieldDefaultSchedulerID:
-		m.ClearDefaultSchedulerID()
-		return nil
-	}
-	return fmt.Errorf("unknown GenerationModel nullable field %s", name)
+// ResetSchedulerID resets all changes to the "scheduler_id" field.
+func (m *GenerationPresetMutation) ResetSchedulerID() {
+	m.scheduler_id = nil
+	delete(m.clearedFields, generationpreset.FieldSchedulerID)
+}
+
+// SetWidth sets the "width" field.
+func (m *GenerationPresetMutation) SetWidth(i int32) {
+	m.width = &i
+	m.addwidth = nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *GenerationModelMutation) ResetField(name string) error {
-	switch name {
-	case generationmodel.FieldNameInWorker:
-		m.ResetNameInWorker()
-		return nil
-	case generationmodel.FieldShortName:
-		m.ResetShortName()
-		return nil
-	case generationmodel.FieldIsActive:
-		m.ResetIsActive()
-		return nil
-	case generationmodel.FieldIsDefault:
-		m.ResetIsDefault()
-		return nil
-	case generationmodel.FieldIsHidden:
-		m.ResetIsHidden()
-		return nil
-	case generationmodel.FieldRunpodEndpoint:
-		m.ResetRunpodEndpoint()
-		return nil
-	case generationmodel.FieldRunpodActive:
-		m.ResetRunpodActive()
-		return nil
-	case generationmodel.FieldDisplayWeight:
-		m.ResetDisplayWeight()
-		return nil
-	case generationmodel.FieldDefaultSchedulerID:
-		m.ResetDefaultSchedulerID()
-		return nil
-	case generationmodel.FieldDefaultWidth:
-		m.ResetDefaultWidth()
-		return nil
-	case generationmodel.FieldDefaultHeight:
-		m.ResetDefaultHeight()
-		return nil
-	case generationmodel.FieldDefaultInferenceSteps:
-		m.ResetDefaultInferenceSteps()
-		return nil
-	case generationmodel.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case generationmodel.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// Width returns the value of the "width" field in the mutation.
+func (m *GenerationPresetMutation) Width() (r int32, exists bool) {
+	v := m.width
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown GenerationModel field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GenerationModelMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.generations != nil {
-		edges = append(edges, generationmodel.EdgeGenerations)
+// OldWidth returns the old "width" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationPresetMutation) OldWidth(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
 	}
-	if m.schedulers != nil {
-		edges = append(edges, generationmodel.EdgeSchedulers)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *GenerationModelMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case generationmodel.EdgeGenerations:
-		ids := make([]ent.Value, 0, len(m.generations))
-		for id := range m.generations {
-			ids = append(ids, id)
-		}
-		return ids
-	case generationmodel.EdgeSchedulers:
-		ids := make([]ent.Value, 0, len(m.schedulers))
-		for id := range m.schedulers {
-			ids = append(ids, id)
-		}
-		return ids
+// AddWidth adds i to the "width" field.
+func (m *GenerationPresetMutation) AddWidth(i int32) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GenerationModelMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedgenerations != nil {
-		edges = append(edges, generationmodel.EdgeGenerations)
-	}
-	if m.removedschedulers != nil {
-		edges = append(edges, generationmodel.EdgeSchedulers)
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *GenerationPresetMutation) AddedWidth() (r int32, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *GenerationModelMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case generationmodel.EdgeGenerations:
-		ids := make([]ent.Value, 0, len(m.removedgenerations))
-		for id := range m.removedgenerations {
-			ids = append(ids, id)
-		}
-		return ids
-	case generationmodel.EdgeSchedulers:
-		ids := make([]ent.Value, 0, len(m.removedschedulers))
-		for id := range m.removedschedulers {
-			ids = append(ids, id)
-		}
-		return ids
+// ClearWidth clears the value of the "width" field.
+func (m *GenerationPresetMutation) ClearWidth() {
+	m.width = nil
+	m.addwidth = nil
+	m.clearedFields[generationpreset.FieldWidth] = struct{}{}
+}
+
+// WidthCleared returns if the "width" field was cleared in this mutation.
+func (m *GenerationPresetMutation) WidthCleared() bool {
+	_, ok := m.clearedFields[generationpreset.FieldWidth]
+	return ok
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *GenerationPresetMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+	delete(m.clearedFields, generationpreset.FieldWidth)
+}
+
+// SetHeight sets the "height" field.
+func (m *GenerationPresetMutation) SetHeight(i int32) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *GenerationPresetMutation) Height() (r int32, exists bool) {
+	v := m.height
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GenerationModelMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedgenerations {
-		edges = append(edges, generationmodel.EdgeGenerations)
+// OldHeight returns the old "height" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationPresetMutation) OldHeight(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
 	}
-	if m.clearedschedulers {
-		edges = append(edges, generationmodel.EdgeSchedulers)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *GenerationModelMutation) EdgeCleared(name string) bool {
-	switch name {
-	case generationmodel.EdgeGenerations:
-		return m.clearedgenerations
-	case generationmodel.EdgeSchedulers:
-		return m.clearedschedulers
+// AddHeight adds i to the "height" field.
+func (m *GenerationPresetMutation) AddHeight(i int32) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
 	}
-	return false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *GenerationModelMutation) ClearEdge(name string) error {
-	switch name {
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *GenerationPresetMutation) AddedHeight() (r int32, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown GenerationModel unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *GenerationModelMutation) ResetEdge(name string) error {
-	switch name {
-	case generationmodel.EdgeGenerations:
-		m.ResetGenerations()
-		return nil
-	case generationmodel.EdgeSchedulers:
-		m.ResetSchedulers()
-		return nil
-	}
-	return fmt.Errorf("unknown GenerationModel edge %s", name)
+// ClearHeight clears the value of the "height" field.
+func (m *GenerationPresetMutation) ClearHeight() {
+	m.height = nil
+	m.addheight = nil
+	m.clearedFields[generationpreset.FieldHeight] = struct{}{}
 }
 
-// GenerationOutputMutation represents an operation that mutates the GenerationOutput nodes in the graph.
-type GenerationOutputMutation struct {
-	config
-	op                             Op
-	typ                            string
-	id                             *uuid.UUID
-	image_path                     *string
-	upscaled_image_path            *string
-	gallery_status                 *generationoutput.GalleryStatus
-	is_favorited                   *bool
-	has_embeddings                 *bool
-	has_embeddings_new             *bool
-	is_migrated                    *bool
-	is_public                      *bool
-	aesthetic_rating_score         *float32
-	addaesthetic_rating_score      *float32
-	aesthetic_artifact_score       *float32
-	addaesthetic_artifact_score    *float32
-	checked_for_nsfw               *bool
-	nsfw_score                     *float32
-	addnsfw_score                  *float32
-	like_count                     *int
-	addlike_count                  *int
-	deleted_at                     *time.Time
-	created_at                     *time.Time
-	updated_at                     *time.Time
-	clearedFields                  map[string]struct{}
-	generations                    *uuid.UUID
-	clearedgenerations             bool
-	upscale_outputs                *uuid.UUID
-	clearedupscale_outputs         bool
-	generation_output_likes        map[uuid.UUID]struct{}
-	removedgeneration_output_likes map[uuid.UUID]struct{}
-	clearedgeneration_output_likes bool
-	done                           bool
-	oldValue                       func(context.Context) (*GenerationOutput, error)
-	predicates                     []predicate.GenerationOutput
+// HeightCleared returns if the "height" field was cleared in this mutation.
+func (m *GenerationPresetMutation) HeightCleared() bool {
+	_, ok := m.clearedFields[generationpreset.FieldHeight]
+	return ok
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *GenerationPresetMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+	delete(m.clearedFields, generationpreset.FieldHeight)
 }
 
-var _ ent.Mutation = (*GenerationOutputMutation)(nil)
+// SetInferenceSteps sets the "inference_steps" field.
+func (m *GenerationPresetMutation) SetInferenceSteps(i int32) {
+	m.inference_steps = &i
+	m.addinference_steps = nil
+}
 
-// generationoutputOption allows management of the mutation configuration using functional options.
-type generationoutputOption func(*GenerationOutputMutation)
+// InferenceSteps returns the value of the "inference_steps" field in the mutation.
+func (m *GenerationPresetMutation) InferenceSteps() (r int32, exists bool) {
+	v := m.inference_steps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newGenerationOutputMutation creates new mutation for the GenerationOutput entity.
-func newGenerationOutputMutation(c config, op Op, opts ...generationoutputOption) *GenerationOutputMutation {
-	m := &GenerationOutputMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeGenerationOutput,
-		clearedFields: make(map[string]struct{}),
+// OldInferenceSteps returns the old "inference_steps" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationPresetMutation) OldInferenceSteps(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInferenceSteps is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInferenceSteps requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInferenceSteps: %w", err)
+	}
+	return oldValue.InferenceSteps, nil
 }
 
-// withGenerationOutputID sets the ID field of the mutation.
-func withGenerationOutputID(id uuid.UUID) generationoutputOption {
-	return func(m *GenerationOutputMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *GenerationOutput
-		)
-		m.oldValue = func(ctx context.Context) (*GenerationOutput, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().GenerationOutput.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddInferenceSteps adds i to the "inference_steps" field.
+func (m *GenerationPresetMutation) AddInferenceSteps(i int32) {
+	if m.addinference_steps != nil {
+		*m.addinference_steps += i
+	} else {
+		m.addinference_steps = &i
 	}
 }
 
-// withGenerationOutput sets the old GenerationOutput of the mutation.
-func withGenerationOutput(node *GenerationOutput) generationoutputOption {
-	return func(m *GenerationOutputMutation) {
-		m.oldValue = func(context.Context) (*GenerationOutput, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddedInferenceSteps returns the value that was added to the "inference_steps" field in this mutation.
+func (m *GenerationPresetMutation) AddedInferenceSteps() (r int32, exists bool) {
+	v := m.addinference_steps
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GenerationOutputMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearInferenceSteps clears the value of the "inference_steps" field.
+func (m *GenerationPresetMutation) ClearInferenceSteps() {
+	m.inference_steps = nil
+	m.addinference_steps = nil
+	m.clearedFields[generationpreset.FieldInferenceSteps] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m GenerationOutputMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// InferenceStepsCleared returns if the "inference_steps" field was cleared in this mutation.
+func (m *GenerationPresetMutation) InferenceStepsCleared() bool {
+	_, ok := m.clearedFields[generationpreset.FieldInferenceSteps]
+	return ok
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of GenerationOutput entities.
-func (m *GenerationOutputMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ResetInferenceSteps resets all changes to the "inference_steps" field.
+func (m *GenerationPresetMutation) ResetInferenceSteps() {
+	m.inference_steps = nil
+	m.addinference_steps = nil
+	delete(m.clearedFields, generationpreset.FieldInferenceSteps)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *GenerationOutputMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// SetGuidanceScale sets the "guidance_scale" field.
+func (m *GenerationPresetMutation) SetGuidanceScale(f float32) {
+	m.guidance_scale = &f
+	m.addguidance_scale = nil
+}
+
+// GuidanceScale returns the value of the "guidance_scale" field in the mutation.
+func (m *GenerationPresetMutation) GuidanceScale() (r float32, exists bool) {
+	v := m.guidance_scale
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *GenerationOutputMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GenerationOutput.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldGuidanceScale returns the old "guidance_scale" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenerationPresetMutation) OldGuidanceScale(ctx context.Context) (v *float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuidanceScale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuidanceScale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuidanceScale: %w", err)
 	}
+	return oldValue.GuidanceScale, nil
 }
 
-// SetImagePath sets the "image_path" field.
-func (m *GenerationOutputMutation) SetImagePath(s string) {
-	m.image_path = &s
+// AddGuidanceScale adds f to the "guidance_scale" field.
+func (m *GenerationPresetMutation) AddGuidanceScale(f float32) {
+	if m.addguidance_scale != nil {
+		*m.addguidance_scale += f
+	} else {
+		m.addguidance_scale = &f
+	}
 }
 
-// ImagePath returns the value of the "image_path" field in the mutation.
-func (m *GenerationOutputMutation) ImagePath() (r string, exists bool) {
-	v := m.image_path
+// AddedGuidanceScale returns the value that was added to the "guidance_scale" field in this mutation.
+func (m *GenerationPresetMutation) AddedGuidanceScale() (r float32, exists bool) {
+	v := m.addguidance_scale
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImagePath returns the old "image_path" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// ClearGuidanceScale clears the value of the "guidance_scale" field.
+func (m *GenerationPresetMutation) ClearGuidanceScale() {
+	m.guidance_scale = nil
+	m.addguidance_scale = nil
+	m.clearedFields[generationpreset.FieldGuidanceScale] = struct{}{}
+}
+
+// GuidanceScaleCleared returns if the "guidance_scale" field was cleared in this mutation.
+func (m *GenerationPresetMutation) GuidanceScaleCleared() bool {
+	_, ok := m.clearedFields[generationpreset.FieldGuidanceScale]
+	return ok
+}
+
+// ResetGuidanceScale resets all changes to the "guidance_scale" field.
+func (m *GenerationPresetMutation) ResetGuidanceScale() {
+	m.guidance_scale = nil
+	m.addguidance_scale = nil
+	delete(m.clearedFields, generationpreset.FieldGuidanceScale)
+}
+
+// SetNumOutputs sets the "num_outputs" field.
+func (m *GenerationPresetMutation) SetNumOutputs(i int32) {
+	m.num_outputs = &i
+	m.addnum_outputs = nil
+}
+
+// NumOutputs returns the value of the "num_outputs" field in the mutation.
+func (m *GenerationPresetMutation) NumOutputs() (r int32, exists bool) {
+	v := m.num_outputs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumOutputs returns the old "num_outputs" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldImagePath(ctx context.Context) (v string, err error) {
+func (m *GenerationPresetMutation) OldNumOutputs(ctx context.Context) (v *int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImagePath is only allowed on UpdateOne operations")
+		return v, errors.New("OldNumOutputs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImagePath requires an ID field in the mutation")
+		return v, errors.New("OldNumOutputs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImagePath: %w", err)
+		return v, fmt.Errorf("querying old value for OldNumOutputs: %w", err)
 	}
-	return oldValue.ImagePath, nil
+	return oldValue.NumOutputs, nil
 }
 
-// ResetImagePath resets all changes to the "image_path" field.
-func (m *GenerationOutputMutation) ResetImagePath() {
-	m.image_path = nil
+// AddNumOutputs adds i to the "num_outputs" field.
+func (m *GenerationPresetMutation) AddNumOutputs(i int32) {
+	if m.addnum_outputs != nil {
+		*m.addnum_outputs += i
+	} else {
+		m.addnum_outputs = &i
+	}
 }
 
-// SetUpscaledImagePath sets the "upscaled_image_path" field.
-func (m *GenerationOutputMutation) SetUpscaledImagePath(s string) {
-	m.upscaled_image_path = &s
+// AddedNumOutputs returns the value that was added to the "num_outputs" field in this mutation.
+func (m *GenerationPresetMutation) AddedNumOutputs() (r int32, exists bool) {
+	v := m.addnum_outputs
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpscaledImagePath returns the value of the "upscaled_image_path" field in the mutation.
-func (m *GenerationOutputMutation) UpscaledImagePath() (r string, exists bool) {
-	v := m.upscaled_image_path
+// ClearNumOutputs clears the value of the "num_outputs" field.
+func (m *GenerationPresetMutation) ClearNumOutputs() {
+	m.num_outputs = nil
+	m.addnum_outputs = nil
+	m.clearedFields[generationpreset.FieldNumOutputs] = struct{}{}
+}
+
+// NumOutputsCleared returns if the "num_outputs" field was cleared in this mutation.
+func (m *GenerationPresetMutation) NumOutputsCleared() bool {
+	_, ok := m.clearedFields[generationpreset.FieldNumOutputs]
+	return ok
+}
+
+// ResetNumOutputs resets all changes to the "num_outputs" field.
+func (m *GenerationPresetMutation) ResetNumOutputs() {
+	m.num_outputs = nil
+	m.addnum_outputs = nil
+	delete(m.clearedFields, generationpreset.FieldNumOutputs)
+}
+
+// SetPromptStrength sets the "prompt_strength" field.
+func (m *GenerationPresetMutation) SetPromptStrength(f float32) {
+	m.prompt_strength = &f
+	m.addprompt_strength = nil
+}
+
+// PromptStrength returns the value of the "prompt_strength" field in the mutation.
+func (m *GenerationPresetMutation) PromptStrength() (r float32, exists bool) {
+	v := m.prompt_strength
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpscaledImagePath returns the old "upscaled_image_path" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldPromptStrength returns the old "prompt_strength" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldUpscaledImagePath(ctx context.Context) (v *string, err error) {
+func (m *GenerationPresetMutation) OldPromptStrength(ctx context.Context) (v *float32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpscaledImagePath is only allowed on UpdateOne operations")
+		return v, errors.New("OldPromptStrength is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpscaledImagePath requires an ID field in the mutation")
+		return v, errors.New("OldPromptStrength requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpscaledImagePath: %w", err)
+		return v, fmt.Errorf("querying old value for OldPromptStrength: %w", err)
 	}
-	return oldValue.UpscaledImagePath, nil
+	return oldValue.PromptStrength, nil
+}
+
+// AddPromptStrength adds f to the "prompt_strength" field.
+func (m *GenerationPresetMutation) AddPromptStrength(f float32) {
+	if m.addprompt_strength != nil {
+		*m.addprompt_strength += f
+	} else {
+		m.addprompt_strength = &f
+	}
+}
+
+// AddedPromptStrength returns the value that was added to the "prompt_strength" field in this mutation.
+func (m *GenerationPresetMutation) AddedPromptStrength() (r float32, exists bool) {
+	v := m.addprompt_strength
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearUpscaledImagePath clears the value of the "upscaled_image_path" field.
-func (m *GenerationOutputMutation) ClearUpscaledImagePath() {
-	m.upscaled_image_path = nil
-	m.clearedFields[generationoutput.FieldUpscaledImagePath] = struct{}{}
+// ClearPromptStrength clears the value of the "prompt_strength" field.
+func (m *GenerationPresetMutation) ClearPromptStrength() {
+	m.prompt_strength = nil
+	m.addprompt_strength = nil
+	m.clearedFields[generationpreset.FieldPromptStrength] = struct{}{}
 }
 
-// UpscaledImagePathCleared returns if the "upscaled_image_path" field was cleared in this mutation.
-func (m *GenerationOutputMutation) UpscaledImagePathCleared() bool {
-	_, ok := m.clearedFields[generationoutput.FieldUpscaledImagePath]
+// PromptStrengthCleared returns if the "prompt_strength" field was cleared in this mutation.
+func (m *GenerationPresetMutation) PromptStrengthCleared() bool {
+	_, ok := m.clearedFields[generationpreset.FieldPromptStrength]
 	return ok
 }
 
-// ResetUpscaledImagePath resets all changes to the "upscaled_image_path" field.
-func (m *GenerationOutputMutation) ResetUpscaledImagePath() {
-	m.upscaled_image_path = nil
-	delete(m.clearedFields, generationoutput.FieldUpscaledImagePath)
+// ResetPromptStrength resets all changes to the "prompt_strength" field.
+func (m *GenerationPresetMutation) ResetPromptStrength() {
+	m.prompt_strength = nil
+	m.addprompt_strength = nil
+	delete(m.clearedFields, generationpreset.FieldPromptStrength)
 }
 
-// SetGalleryStatus sets the "gallery_status" field.
-func (m *GenerationOutputMutation) SetGalleryStatus(gs generationoutput.GalleryStatus) {
-	m.gallery_status = &gs
+// SetCreatedAt sets the "created_at" field.
+func (m *GenerationPresetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// GalleryStatus returns the value of the "gallery_status" field in the mutation.
-func (m *GenerationOutputMutation) GalleryStatus() (r generationoutput.GalleryStatus, exists bool) {
-	v := m.gallery_status
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GenerationPresetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGalleryStatus returns the old "gallery_status" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldGalleryStatus(ctx context.Context) (v generationoutput.GalleryStatus, err error) {
+func (m *GenerationPresetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGalleryStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGalleryStatus requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGalleryStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.GalleryStatus, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetGalleryStatus resets all changes to the "gallery_status" field.
-func (m *GenerationOutputMutation) ResetGalleryStatus() {
-	m.gallery_status = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GenerationPresetMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetIsFavorited sets the "is_favorited" field.
-func (m *GenerationOutputMutation) SetIsFavorited(b bool) {
-	m.is_favorited = &b
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GenerationPresetMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// IsFavorited returns the value of the "is_favorited" field in the mutation.
-func (m *GenerationOutputMutation) IsFavorited() (r bool, exists bool) {
-	v := m.is_favorited
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GenerationPresetMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsFavorited returns the old "is_favorited" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the GenerationPreset entity.
+// If the GenerationPreset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldIsFavorited(ctx context.Context) (v bool, err error) {
+func (m *GenerationPresetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsFavorited is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsFavorited requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsFavorited: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.IsFavorited, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetIsFavorited resets all changes to the "is_favorited" field.
-func (m *GenerationOutputMutation) ResetIsFavorited() {
-	m.is_favorited = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GenerationPresetMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetHasEmbeddings sets the "has_embeddings" field.
-func (m *GenerationOutputMutation) SetHasEmbeddings(b bool) {
-	m.has_embeddings = &b
+// Where appends a list predicates to the GenerationPresetMutation builder.
+func (m *GenerationPresetMutation) Where(ps ...predicate.GenerationPreset) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// HasEmbeddings returns the value of the "has_embeddings" field in the mutation.
-func (m *GenerationOutputMutation) HasEmbeddings() (r bool, exists bool) {
-	v := m.has_embeddings
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the GenerationPresetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GenerationPresetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GenerationPreset, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldHasEmbeddings returns the old "has_embeddings" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldHasEmbeddings(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHasEmbeddings is only allowed on UpdateOne operations")
+// Op returns the operation name.
+func (m *GenerationPresetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GenerationPresetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GenerationPreset).
+func (m *GenerationPresetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GenerationPresetMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.user_id != nil {
+		fields = append(fields, generationpreset.FieldUserID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHasEmbeddings requires an ID field in the mutation")
+	if m.name != nil {
+		fields = append(fields, generationpreset.FieldName)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHasEmbeddings: %w", err)
+	if m.prompt_template != nil {
+		fields = append(fields, generationpreset.FieldPromptTemplate)
 	}
-	return oldValue.HasEmbeddings, nil
+	if m.negative_prompt != nil {
+		fields = append(fields, generationpreset.FieldNegativePrompt)
+	}
+	if m.model_id != nil {
+		fields = append(fields, generationpreset.FieldModelID)
+	}
+	if m.scheduler_id != nil {
+		fields = append(fields, generationpreset.FieldSchedulerID)
+	}
+	if m.width != nil {
+		fields = append(fields, generationpreset.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, generationpreset.FieldHeight)
+	}
+	if m.inference_steps != nil {
+		fields = append(fields, generationpreset.FieldInferenceSteps)
+	}
+	if m.guidance_scale != nil {
+		fields = append(fields, generationpreset.FieldGuidanceScale)
+	}
+	if m.num_outputs != nil {
+		fields = append(fields, generationpreset.FieldNumOutputs)
+	}
+	if m.prompt_strength != nil {
+		fields = append(fields, generationpreset.FieldPromptStrength)
+	}
+	if m.created_at != nil {
+		fields = append(fields, generationpreset.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, generationpreset.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ResetHasEmbeddings resets all changes to the "has_embeddings" field.
-func (m *GenerationOutputMutation) ResetHasEmbeddings() {
-	m.has_embeddings = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GenerationPresetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case generationpreset.FieldUserID:
+		return m.UserID()
+	case generationpreset.FieldName:
+		return m.Name()
+	case generationpreset.FieldPromptTemplate:
+		return m.PromptTemplate()
+	case generationpreset.FieldNegativePrompt:
+		return m.NegativePrompt()
+	case generationpreset.FieldModelID:
+		return m.ModelID()
+	case generationpreset.FieldSchedulerID:
+		return m.SchedulerID()
+	case generationpreset.FieldWidth:
+		return m.Width()
+	case generationpreset.FieldHeight:
+		return m.Height()
+	case generationpreset.FieldInferenceSteps:
+		return m.InferenceSteps()
+	case generationpreset.FieldGuidanceScale:
+		return m.GuidanceScale()
+	case generationpreset.FieldNumOutputs:
+		return m.NumOutputs()
+	case generationpreset.FieldPromptStrength:
+		return m.PromptStrength()
+	case generationpreset.FieldCreatedAt:
+		return m.CreatedAt()
+	case generationpreset.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// SetHasEmbeddingsNew sets the "has_embeddings_new" field.
-func (m *GenerationOutputMutation) SetHasEmbeddingsNew(b bool) {
-	m.has_embeddings_new = &b
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GenerationPresetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case generationpreset.FieldUserID:
+		return m.OldUserID(ctx)
+	case generationpreset.FieldName:
+		return m.OldName(ctx)
+	case generationpreset.FieldPromptTemplate:
+		return m.OldPromptTemplate(ctx)
+	case generationpreset.FieldNegativePrompt:
+		return m.OldNegativePrompt(ctx)
+	case generationpreset.FieldModelID:
+		return m.OldModelID(ctx)
+	case generationpreset.FieldSchedulerID:
+		return m.OldSchedulerID(ctx)
+	case generationpreset.FieldWidth:
+		return m.OldWidth(ctx)
+	case generationpreset.FieldHeight:
+		return m.OldHeight(ctx)
+	case generationpreset.FieldInferenceSteps:
+		return m.OldInferenceSteps(ctx)
+	case generationpreset.FieldGuidanceScale:
+		return m.OldGuidanceScale(ctx)
+	case generationpreset.FieldNumOutputs:
+		return m.OldNumOutputs(ctx)
+	case generationpreset.FieldPromptStrength:
+		return m.OldPromptStrength(ctx)
+	case generationpreset.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case generationpreset.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GenerationPreset field %s", name)
 }
 
-// HasEmbeddingsNew returns the value of the "has_embeddings_new" field in the mutation.
-func (m *GenerationOutputMutation) HasEmbeddingsNew() (r bool, exists bool) {
-	v := m.has_embeddings_new
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenerationPresetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case generationpreset.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case generationpreset.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case generationpreset.FieldPromptTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTemplate(v)
+		return nil
+	case generationpreset.FieldNegativePrompt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNegativePrompt(v)
+		return nil
+	case generationpreset.FieldModelID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelID(v)
+		return nil
+	case generationpreset.FieldSchedulerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchedulerID(v)
+		return nil
+	case generationpreset.FieldWidth:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case generationpreset.FieldHeight:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case generationpreset.FieldInferenceSteps:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInferenceSteps(v)
+		return nil
+	case generationpreset.FieldGuidanceScale:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuidanceScale(v)
+		return nil
+	case generationpreset.FieldNumOutputs:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumOutputs(v)
+		return nil
+	case generationpreset.FieldPromptStrength:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptStrength(v)
+		return nil
+	case generationpreset.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case generationpreset.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown GenerationPreset field %s", name)
 }
 
-// OldHasEmbeddingsNew returns the old "has_embeddings_new" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldHasEmbeddingsNew(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHasEmbeddingsNew is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHasEmbeddingsNew requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHasEmbeddingsNew: %w", err)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GenerationPresetMutation) AddedFields() []string {
+	var fields []string
+	if m.addwidth != nil {
+		fields = append(fields, generationpreset.FieldWidth)
 	}
-	return oldValue.HasEmbeddingsNew, nil
-}
-
-// ResetHasEmbeddingsNew resets all changes to the "has_embeddings_new" field.
-func (m *GenerationOutputMutation) ResetHasEmbeddingsNew() {
-	m.has_embeddings_new = nil
-}
-
-// SetIsMigrated sets the "is_migrated" field.
-func (m *GenerationOutputMutation) SetIsMigrated(b bool) {
-	m.is_migrated = &b
-}
-
-// IsMigrated returns the value of the "is_migrated" field in the mutation.
-func (m *GenerationOutputMutation) IsMigrated() (r bool, exists bool) {
-	v := m.is_migrated
-	if v == nil {
-		return
+	if m.addheight != nil {
+		fields = append(fields, generationpreset.FieldHeight)
 	}
-	return *v, true
-}
-
-// OldIsMigrated returns the old "is_migrated" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldIsMigrated(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsMigrated is only allowed on UpdateOne operations")
+	if m.addinference_steps != nil {
+		fields = append(fields, generationpreset.FieldInferenceSteps)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsMigrated requires an ID field in the mutation")
+	if m.addguidance_scale != nil {
+		fields = append(fields, generationpreset.FieldGuidanceScale)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsMigrated: %w", err)
+	if m.addnum_outputs != nil {
+		fields = append(fields, generationpreset.FieldNumOutputs)
 	}
-	return oldValue.IsMigrated, nil
-}
-
-// ResetIsMigrated resets all changes to the "is_migrated" field.
-func (m *GenerationOutputMutation) ResetIsMigrated() {
-	m.is_migrated = nil
-}
-
-// SetIsPublic sets the "is_public" field.
-func (m *GenerationOutputMutation) SetIsPublic(b bool) {
-	m.is_public = &b
-}
-
-// IsPublic returns the value of the "is_public" field in the mutation.
-func (m *GenerationOutputMutation) IsPublic() (r bool, exists bool) {
-	v := m.is_public
-	if v == nil {
-		return
+	if m.addprompt_strength != nil {
+		fields = append(fields, generationpreset.FieldPromptStrength)
 	}
-	return *v, true
+	return fields
 }
 
-// OldIsPublic returns the old "is_public" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPublic requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GenerationPresetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case generationpreset.FieldWidth:
+		return m.AddedWidth()
+	case generationpreset.FieldHeight:
+		return m.AddedHeight()
+	case generationpreset.FieldInferenceSteps:
+		return m.AddedInferenceSteps()
+	case generationpreset.FieldGuidanceScale:
+		return m.AddedGuidanceScale()
+	case generationpreset.FieldNumOutputs:
+		return m.AddedNumOutputs()
+	case generationpreset.FieldPromptStrength:
+		return m.AddedPromptStrength()
 	}
-	return oldValue.IsPublic, nil
-}
-
-// ResetIsPublic resets all changes to the "is_public" field.
-func (m *GenerationOutputMutation) ResetIsPublic() {
-	m.is_public = nil
-}
-
-// SetAestheticRatingScore sets the "aesthetic_rating_score" field.
-func (m *GenerationOutputMutation) SetAestheticRatingScore(f float32) {
-	m.aesthetic_rating_score = &f
-	m.addaesthetic_rating_score = nil
+	return nil, false
 }
 
-// AestheticRatingScore returns the value of the "aesthetic_rating_score" field in the mutation.
-func (m *GenerationOutputMutation) AestheticRatingScore() (r float32, exists bool) {
-	v := m.aesthetic_rating_score
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenerationPresetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case generationpreset.FieldWidth:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case generationpreset.FieldHeight:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case generationpreset.FieldInferenceSteps:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInferenceSteps(v)
+		return nil
+	case generationpreset.FieldGuidanceScale:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGuidanceScale(v)
+		return nil
+	case generationpreset.FieldNumOutputs:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumOutputs(v)
+		return nil
+	case generationpreset.FieldPromptStrength:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptStrength(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown GenerationPreset numeric field %s", name)
 }
 
-// OldAestheticRatingScore returns the old "aesthetic_rating_score" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldAestheticRatingScore(ctx context.Context) (v float32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAestheticRatingScore is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAestheticRatingScore requires an ID field in the mutation")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GenerationPresetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(generationpreset.FieldPromptTemplate) {
+		fields = append(fields, generationpreset.FieldPromptTemplate)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAestheticRatingScore: %w", err)
+	if m.FieldCleared(generationpreset.FieldNegativePrompt) {
+		fields = append(fields, generationpreset.FieldNegativePrompt)
 	}
-	return oldValue.AestheticRatingScore, nil
-}
-
-// AddAestheticRatingScore adds f to the "aesthetic_rating_score" field.
-func (m *GenerationOutputMutation) AddAestheticRatingScore(f float32) {
-	if m.addaesthetic_rating_score != nil {
-		*m.addaesthetic_rating_score += f
-	} else {
-		m.addaesthetic_rating_score = &f
+	if m.FieldCleared(generationpreset.FieldModelID) {
+		fields = append(fields, generationpreset.FieldModelID)
 	}
-}
-
-// AddedAestheticRatingScore returns the value that was added to the "aesthetic_rating_score" field in this mutation.
-func (m *GenerationOutputMutation) AddedAestheticRatingScore() (r float32, exists bool) {
-	v := m.addaesthetic_rating_score
-	if v == nil {
-		return
+	if m.FieldCleared(generationpreset.FieldSchedulerID) {
+		fields = append(fields, generationpreset.FieldSchedulerID)
 	}
-	return *v, true
-}
-
-// ResetAestheticRatingScore resets all changes to the "aesthetic_rating_score" field.
-func (m *GenerationOutputMutation) ResetAestheticRatingScore() {
-	m.aesthetic_rating_score = nil
-	m.addaesthetic_rating_score = nil
-}
-
-// SetAestheticArtifactScore sets the "aesthetic_artifact_score" field.
-func (m *GenerationOutputMutation) SetAestheticArtifactScore(f float32) {
-	m.aesthetic_artifact_score = &f
-	m.addaesthetic_artifact_score = nil
-}
-
-// AestheticArtifactScore returns the value of the "aesthetic_artifact_score" field in the mutation.
-func (m *GenerationOutputMutation) AestheticArtifactScore() (r float32, exists bool) {
-	v := m.aesthetic_artifact_score
-	if v == nil {
-		return
+	if m.FieldCleared(generationpreset.FieldWidth) {
+		fields = append(fields, generationpreset.FieldWidth)
 	}
-	return *v, true
-}
-
-// OldAestheticArtifactScore returns the old "aesthetic_artifact_score" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldAestheticArtifactScore(ctx context.Context) (v float32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAestheticArtifactScore is only allowed on UpdateOne operations")
+	if m.FieldCleared(generationpreset.FieldHeight) {
+		fields = append(fields, generationpreset.FieldHeight)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAestheticArtifactScore requires an ID field in the mutation")
+	if m.FieldCleared(generationpreset.FieldInferenceSteps) {
+		fields = append(fields, generationpreset.FieldInferenceSteps)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAestheticArtifactScore: %w", err)
+	if m.FieldCleared(generationpreset.FieldGuidanceScale) {
+		fields = append(fields, generationpreset.FieldGuidanceScale)
 	}
-	return oldValue.AestheticArtifactScore, nil
-}
-
-// AddAestheticArtifactScore adds f to the "aesthetic_artifact_score" field.
-func (m *GenerationOutputMutation) AddAestheticArtifactScore(f float32) {
-	if m.addaesthetic_artifact_score != nil {
-		*m.addaesthetic_artifact_score += f
-	} else {
-		m.addaesthetic_artifact_score = &f
+	if m.FieldCleared(generationpreset.FieldNumOutputs) {
+		fields = append(fields, generationpreset.FieldNumOutputs)
 	}
-}
-
-// AddedAestheticArtifactScore returns the value that was added to the "aesthetic_artifact_score" field in this mutation.
-func (m *GenerationOutputMutation) AddedAestheticArtifactScore() (r float32, exists bool) {
-	v := m.addaesthetic_artifact_score
-	if v == nil {
-		return
+	if m.FieldCleared(generationpreset.FieldPromptStrength) {
+		fields = append(fields, generationpreset.FieldPromptStrength)
 	}
-	return *v, true
-}
-
-// ResetAestheticArtifactScore resets all changes to the "aesthetic_artifact_score" field.
-func (m *GenerationOutputMutation) ResetAestheticArtifactScore() {
-	m.aesthetic_artifact_score = nil
-	m.addaesthetic_artifact_score = nil
+	return fields
 }
 
-// SetCheckedForNsfw sets the "checked_for_nsfw" field.
-func (m *GenerationOutputMutation) SetCheckedForNsfw(b bool) {
-	m.checked_for_nsfw = &b
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GenerationPresetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// CheckedForNsfw returns the value of the "checked_for_nsfw" field in the mutation.
-func (m *GenerationOutputMutation) CheckedForNsfw() (r bool, exists bool) {
-	v := m.checked_for_nsfw
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GenerationPresetMutation) ClearField(name string) error {
+	switch name {
+	case generationpreset.FieldPromptTemplate:
+		m.ClearPromptTemplate()
+		return nil
+	case generationpreset.FieldNegativePrompt:
+		m.ClearNegativePrompt()
+		return nil
+	case generationpreset.FieldModelID:
+		m.ClearModelID()
+		return nil
+	case generationpreset.FieldSchedulerID:
+		m.ClearSchedulerID()
+		return nil
+	case generationpreset.FieldWidth:
+		m.ClearWidth()
+		return nil
+	case generationpreset.FieldHeight:
+		m.ClearHeight()
+		return nil
+	case generationpreset.FieldInferenceSteps:
+		m.ClearInferenceSteps()
+		return nil
+	case generationpreset.FieldGuidanceScale:
+		m.ClearGuidanceScale()
+		return nil
+	case generationpreset.FieldNumOutputs:
+		m.ClearNumOutputs()
+		return nil
+	case generationpreset.FieldPromptStrength:
+		m.ClearPromptStrength()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown GenerationPreset nullable field %s", name)
 }
 
-// OldCheckedForNsfw returns the old "checked_for_nsfw" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldCheckedForNsfw(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCheckedForNsfw is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCheckedForNsfw requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCheckedForNsfw: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GenerationPresetMutation) ResetField(name string) error {
+	switch name {
+	case generationpreset.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case generationpreset.FieldName:
+		m.ResetName()
+		return nil
+	case generationpreset.FieldPromptTemplate:
+		m.ResetPromptTemplate()
+		return nil
+	case generationpreset.FieldNegativePrompt:
+		m.ResetNegativePrompt()
+		return nil
+	case generationpreset.FieldModelID:
+		m.ResetModelID()
+		return nil
+	case generationpreset.FieldSchedulerID:
+		m.ResetSchedulerID()
+		return nil
+	case generationpreset.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case generationpreset.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case generationpreset.FieldInferenceSteps:
+		m.ResetInferenceSteps()
+		return nil
+	case generationpreset.FieldGuidanceScale:
+		m.ResetGuidanceScale()
+		return nil
+	case generationpreset.FieldNumOutputs:
+		m.ResetNumOutputs()
+		return nil
+	case generationpreset.FieldPromptStrength:
+		m.ResetPromptStrength()
+		return nil
+	case generationpreset.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case generationpreset.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.CheckedForNsfw, nil
+	return fmt.Errorf("unknown GenerationPreset field %s", name)
 }
 
-// ResetCheckedForNsfw resets all changes to the "checked_for_nsfw" field.
-func (m *GenerationOutputMutation) ResetCheckedForNsfw() {
-	m.checked_for_nsfw = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GenerationPresetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetNsfwScore sets the "nsfw_score" field.
-func (m *GenerationOutputMutation) SetNsfwScore(f float32) {
-	m.nsfw_score = &f
-	m.addnsfw_score = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GenerationPresetMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// NsfwScore returns the value of the "nsfw_score" field in the mutation.
-func (m *GenerationOutputMutation) NsfwScore() (r float32, exists bool) {
-	v := m.nsfw_score
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GenerationPresetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldNsfwScore returns the old "nsfw_score" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldNsfwScore(ctx context.Context) (v float32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNsfwScore is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNsfwScore requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNsfwScore: %w", err)
-	}
-	return oldValue.NsfwScore, nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GenerationPresetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// AddNsfwScore adds f to the "nsfw_score" field.
-func (m *GenerationOutputMutation) AddNsfwScore(f float32) {
-	if m.addnsfw_score != nil {
-		*m.addnsfw_score += f
-	} else {
-		m.addnsfw_score = &f
-	}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GenerationPresetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AddedNsfwScore returns the value that was added to the "nsfw_score" field in this mutation.
-func (m *GenerationOutputMutation) AddedNsfwScore() (r float32, exists bool) {
-	v := m.addnsfw_score
-	if v == nil {
-		return
-	}
-	return *v, true
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GenerationPresetMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ResetNsfwScore resets all changes to the "nsfw_score" field.
-func (m *GenerationOutputMutation) ResetNsfwScore() {
-	m.nsfw_score = nil
-	m.addnsfw_score = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GenerationPresetMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GenerationPreset unique edge %s", name)
 }
 
-// SetLikeCount sets the "like_count" field.
-func (m *GenerationOutputMutation) SetLikeCount(i int) {
-	m.like_count = &i
-	m.addlike_count = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GenerationPresetMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GenerationPreset edge %s", name)
 }
 
-// LikeCount returns the value of the "like_count" field in the mutation.
-func (m *GenerationOutputMutation) LikeCount() (r int, exists bool) {
-	v := m.like_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// IPBlackListMutation represents an operation that mutates the IPBlackList nodes in the graph.
+type IPBlackListMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	ip            *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IPBlackList, error)
+	predicates    []predicate.IPBlackList
 }
 
-// OldLikeCount returns the old "like_count" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldLikeCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLikeCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLikeCount requires an ID field in the mutation")
+var _ ent.Mutation = (*IPBlackListMutation)(nil)
+
+// ipblacklistOption allows management of the mutation configuration using functional options.
+type ipblacklistOption func(*IPBlackListMutation)
+
+// newIPBlackListMutation creates new mutation for the IPBlackList entity.
+func newIPBlackListMutation(c config, op Op, opts ...ipblacklistOption) *IPBlackListMutation {
+	m := &IPBlackListMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIPBlackList,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLikeCount: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.LikeCount, nil
+	return m
 }
 
-// AddLikeCount adds i to the "like_count" field.
-func (m *GenerationOutputMutation) AddLikeCount(i int) {
-	if m.addlike_count != nil {
-		*m.addlike_count += i
-	} else {
-		m.addlike_count = &i
+// withIPBlackListID sets the ID field of the mutation.
+func withIPBlackListID(id uuid.UUID) ipblacklistOption {
+	return func(m *IPBlackListMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IPBlackList
+		)
+		m.oldValue = func(ctx context.Context) (*IPBlackList, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IPBlackList.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// AddedLikeCount returns the value that was added to the "like_count" field in this mutation.
-func (m *GenerationOutputMutation) AddedLikeCount() (r int, exists bool) {
-	v := m.addlike_count
-	if v == nil {
-		return
+// withIPBlackList sets the old IPBlackList of the mutation.
+func withIPBlackList(node *IPBlackList) ipblacklistOption {
+	return func(m *IPBlackListMutation) {
+		m.oldValue = func(context.Context) (*IPBlackList, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// ResetLikeCount resets all changes to the "like_count" field.
-func (m *GenerationOutputMutation) ResetLikeCount() {
-	m.like_count = nil
-	m.addlike_count = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IPBlackListMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetGenerationID sets the "generation_id" field.
-func (m *GenerationOutputMutation) SetGenerationID(u uuid.UUID) {
-	m.generations = &u
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IPBlackListMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IPBlackList entities.
+func (m *IPBlackListMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// GenerationID returns the value of the "generation_id" field in the mutation.
-func (m *GenerationOutputMutation) GenerationID() (r uuid.UUID, exists bool) {
-	v := m.generations
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IPBlackListMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldGenerationID returns the old "generation_id" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldGenerationID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGenerationID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGenerationID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGenerationID: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IPBlackListMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IPBlackList.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.GenerationID, nil
-}
-
-// ResetGenerationID resets all changes to the "generation_id" field.
-func (m *GenerationOutputMutation) ResetGenerationID() {
-	m.generations = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *GenerationOutputMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetIP sets the "ip" field.
+func (m *IPBlackListMutation) SetIP(s string) {
+	m.ip = &s
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *GenerationOutputMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// IP returns the value of the "ip" field in the mutation.
+func (m *IPBlackListMutation) IP() (r string, exists bool) {
+	v := m.ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldIP returns the old "ip" field's value of the IPBlackList entity.
+// If the IPBlackList object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *IPBlackListMutation) OldIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *GenerationOutputMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[generationoutput.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *GenerationOutputMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[generationoutput.FieldDeletedAt]
-	return ok
+	return oldValue.IP, nil
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *GenerationOutputMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, generationoutput.FieldDeletedAt)
+// ResetIP resets all changes to the "ip" field.
+func (m *IPBlackListMutation) ResetIP() {
+	m.ip = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *GenerationOutputMutation) SetCreatedAt(t time.Time) {
+func (m *IPBlackListMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GenerationOutputMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *IPBlackListMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -10635,10 +19955,10 @@ func (m *GenerationOutputMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the IPBlackList entity.
+// If the IPBlackList object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *IPBlackListMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -10653,17 +19973,17 @@ func (m *GenerationOutputMutation) OldCreatedAt(ctx context.Context) (v time.Tim
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GenerationOutputMutation) ResetCreatedAt() {
+func (m *IPBlackListMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *GenerationOutputMutation) SetUpdatedAt(t time.Time) {
+func (m *IPBlackListMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GenerationOutputMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *IPBlackListMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -10671,10 +19991,10 @@ func (m *GenerationOutputMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the GenerationOutput entity.
-// If the GenerationOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the IPBlackList entity.
+// If the IPBlackList object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *IPBlackListMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -10689,152 +20009,19 @@ func (m *GenerationOutputMutation) OldUpdatedAt(ctx context.Context) (v time.Tim
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GenerationOutputMutation) ResetUpdatedAt() {
+func (m *IPBlackListMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetGenerationsID sets the "generations" edge to the Generation entity by id.
-func (m *GenerationOutputMutation) SetGenerationsID(id uuid.UUID) {
-	m.generations = &id
-}
-
-// ClearGenerations clears the "generations" edge to the Generation entity.
-func (m *GenerationOutputMutation) ClearGenerations() {
-	m.clearedgenerations = true
-	m.clearedFields[generationoutput.FieldGenerationID] = struct{}{}
-}
-
-// GenerationsCleared reports if the "generations" edge to the Generation entity was cleared.
-func (m *GenerationOutputMutation) GenerationsCleared() bool {
-	return m.clearedgenerations
-}
-
-// GenerationsID returns the "generations" edge ID in the mutation.
-func (m *GenerationOutputMutation) GenerationsID() (id uuid.UUID, exists bool) {
-	if m.generations != nil {
-		return *m.generations, true
-	}
-	return
-}
-
-// GenerationsIDs returns the "generations" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GenerationsID instead. It exists only for internal usage by the builders.
-func (m *GenerationOutputMutation) GenerationsIDs() (ids []uuid.UUID) {
-	if id := m.generations; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetGenerations resets all changes to the "generations" edge.
-func (m *GenerationOutputMutation) ResetGenerations() {
-	m.generations = nil
-	m.clearedgenerations = false
-}
-
-// SetUpscaleOutputsID sets the "upscale_outputs" edge to the UpscaleOutput entity by id.
-func (m *GenerationOutputMutation) SetUpscaleOutputsID(id uuid.UUID) {
-	m.upscale_outputs = &id
-}
-
-// ClearUpscaleOutputs clears the "upscale_outputs" edge to the UpscaleOutput entity.
-func (m *GenerationOutputMutation) ClearUpscaleOutputs() {
-	m.clearedupscale_outputs = true
-}
-
-// UpscaleOutputsCleared reports if the "upscale_outputs" edge to the UpscaleOutput entity was cleared.
-func (m *GenerationOutputMutation) UpscaleOutputsCleared() bool {
-	return m.clearedupscale_outputs
-}
-
-// UpscaleOutputsID returns the "upscale_outputs" edge ID in the mutation.
-func (m *GenerationOutputMutation) UpscaleOutputsID() (id uuid.UUID, exists bool) {
-	if m.upscale_outputs != nil {
-		return *m.upscale_outputs, true
-	}
-	return
-}
-
-// UpscaleOutputsIDs returns the "upscale_outputs" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UpscaleOutputsID instead. It exists only for internal usage by the builders.
-func (m *GenerationOutputMutation) UpscaleOutputsIDs() (ids []uuid.UUID) {
-	if id := m.upscale_outputs; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetUpscaleOutputs resets all changes to the "upscale_outputs" edge.
-func (m *GenerationOutputMutation) ResetUpscaleOutputs() {
-	m.upscale_outputs = nil
-	m.clearedupscale_outputs = false
-}
-
-// AddGenerationOutputLikeIDs adds the "generation_output_likes" edge to the GenerationOutputLike entity by ids.
-func (m *GenerationOutputMutation) AddGenerationOutputLikeIDs(ids ...uuid.UUID) {
-	if m.generation_output_likes == nil {
-		m.generation_output_likes = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.generation_output_likes[ids[i]] = struct{}{}
-	}
-}
-
-// ClearGenerationOutputLikes clears the "generation_output_likes" edge to the GenerationOutputLike entity.
-func (m *GenerationOutputMutation) ClearGenerationOutputLikes() {
-	m.clearedgeneration_output_likes = true
-}
-
-// GenerationOutputLikesCleared reports if the "generation_output_likes" edge to the GenerationOutputLike entity was cleared.
-func (m *GenerationOutputMutation) GenerationOutputLikesCleared() bool {
-	return m.clearedgeneration_output_likes
-}
-
-// RemoveGenerationOutputLikeIDs removes the "generation_output_likes" edge to the GenerationOutputLike entity by IDs.
-func (m *GenerationOutputMutation) RemoveGenerationOutputLikeIDs(ids ...uuid.UUID) {
-	if m.removedgeneration_output_likes == nil {
-		m.removedgeneration_output_likes = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.generation_output_likes, ids[i])
-		m.removedgeneration_output_likes[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedGenerationOutputLikes returns the removed IDs of the "generation_output_likes" edge to the GenerationOutputLike entity.
-func (m *GenerationOutputMutation) RemovedGenerationOutputLikesIDs() (ids []uuid.UUID) {
-	for id := range m.removedgeneration_output_likes {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// GenerationOutputLikesIDs returns the "generation_output_likes" edge IDs in the mutation.
-func (m *GenerationOutputMutation) GenerationOutputLikesIDs() (ids []uuid.UUID) {
-	for id := range m.generation_output_likes {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetGenerationOutputLikes resets all changes to the "generation_output_likes" edge.
-func (m *GenerationOutputMutation) ResetGenerationOutputLikes() {
-	m.generation_output_likes = nil
-	m.clearedgeneration_output_likes = false
-	m.removedgeneration_output_likes = nil
-}
-
-// Where appends a list predicates to the GenerationOutputMutation builder.
-func (m *GenerationOutputMutation) Where(ps ...predicate.GenerationOutput) {
+// Where appends a list predicates to the IPBlackListMutation builder.
+func (m *IPBlackListMutation) Where(ps ...predicate.IPBlackList) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GenerationOutputMutation builder. Using this method,
+// WhereP appends storage-level predicates to the IPBlackListMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GenerationOutputMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GenerationOutput, len(ps))
+func (m *IPBlackListMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IPBlackList, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10842,283 +20029,87 @@ func (m *GenerationOutputMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GenerationOutputMutation) Op() Op {
+func (m *IPBlackListMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GenerationOutputMutation) SetOp(op Op) {
+func (m *IPBlackListMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (GenerationOutput).
-func (m *GenerationOutputMutation) Type() string {
+// Type returns the node type of this mutation (IPBlackList).
+func (m *IPBlackListMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GenerationOutputMutation) Fields() []string {
-	fields := make([]string, 0, 17)
-	if m.image_path != nil {
-		fields = append(fields, generationoutput.FieldImagePath)
-	}
-	if m.upscaled_image_path != nil {
-		fields = append(fields, generationoutput.FieldUpscaledImagePath)
-	}
-	if m.gallery_status != nil {
-		fields = append(fields, generationoutput.FieldGalleryStatus)
-	}
-	if m.is_favorited != nil {
-		fields = append(fields, generationoutput.FieldIsFavorited)
-	}
-	if m.has_embeddings != nil {
-		fields = append(fields, generationoutput.FieldHasEmbeddings)
-	}
-	if m.has_embeddings_new != nil {
-		fields = append(fields, generationoutput.FieldHasEmbeddingsNew)
-	}
-	if m.is_migrated != nil {
-		fields = append(fields, generationoutput.FieldIsMigrated)
-	}
-	if m.is_public != nil {
-		fields = append(fields, generationoutput.FieldIsPublic)
-	}
-	if m.aesthetic_rating_score != nil {
-		fields = append(fields, generationoutput.FieldAestheticRatingScore)
-	}
-	if m.aesthetic_artifact_score != nil {
-		fields = append(fields, generationoutput.FieldAestheticArtifactScore)
-	}
-	if m.checked_for_nsfw != nil {
-		fields = append(fields, generationoutput.FieldCheckedForNsfw)
-	}
-	if m.nsfw_score != nil {
-		fields = append(fields, generationoutput.FieldNsfwScore)
-	}
-	if m.like_count != nil {
-		fields = append(fields, generationoutput.FieldLikeCount)
-	}
-	if m.generations != nil {
-		fields = append(fields, generationoutput.FieldGenerationID)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, generationoutput.FieldDeletedAt)
+func (m *IPBlackListMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.ip != nil {
+		fields = append(fields, ipblacklist.FieldIP)
 	}
 	if m.created_at != nil {
-		fields = append(fields, generationoutput.FieldCreatedAt)
+		fields = append(fields, ipblacklist.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, generationoutput.FieldUpdatedAt)
+		fields = append(fields, ipblacklist.FieldUpdatedAt)
 	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *GenerationOutputMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case generationoutput.FieldImagePath:
-		return m.ImagePath()
-	case generationoutput.FieldUpscaledImagePath:
-		return m.UpscaledImagePath()
-	case generationoutput.FieldGalleryStatus:
-		return m.GalleryStatus()
-	case generationoutput.FieldIsFavorited:
-		return m.IsFavorited()
-	case generationoutput.FieldHasEmbeddings:
-		return m.HasEmbeddings()
-	case generationoutput.FieldHasEmbeddingsNew:
-		return m.HasEmbeddingsNew()
-	case generationoutput.FieldIsMigrated:
-		return m.IsMigrated()
-	case generationoutput.FieldIsPublic:
-		return m.IsPublic()
-	case generationoutput.FieldAestheticRatingScore:
-		return m.AestheticRatingScore()
-	case generationoutput.FieldAestheticArtifactScore:
-		return m.AestheticArtifactScore()
-	case generationoutput.FieldCheckedForNsfw:
-		return m.CheckedForNsfw()
-	case generationoutput.FieldNsfwScore:
-		return m.NsfwScore()
-	case generationoutput.FieldLikeCount:
-		return m.LikeCount()
-	case generationoutput.FieldGenerationID:
-		return m.GenerationID()
-	case generationoutput.FieldDeletedAt:
-		return m.DeletedAt()
-	case generationoutput.FieldCreatedAt:
-		return m.CreatedAt()
-	case generationoutput.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *GenerationOutputMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case generationoutput.FieldImagePath:
-		return m.OldImagePath(ctx)
-	case generationoutput.FieldUpscaledImagePath:
-		return m.OldUpscaledImagePath(ctx)
-	case generationoutput.FieldGalleryStatus:
-		return m.OldGalleryStatus(ctx)
-	case generationoutput.FieldIsFavorited:
-		return m.OldIsFavorited(ctx)
-	case generationoutput.FieldHasEmbeddings:
-		return m.OldHasEmbeddings(ctx)
-	case generationoutput.FieldHasEmbeddingsNew:
-		return m.OldHasEmbeddingsNew(ctx)
-	case generationoutput.FieldIsMigrated:
-		return m.OldIsMigrated(ctx)
-	case generationoutput.FieldIsPublic:
-		return m.OldIsPublic(ctx)
-	case generationoutput.FieldAestheticRatingScore:
-		return m.OldAestheticRatingScore(ctx)
-	case generationoutput.FieldAestheticArtifactScore:
-		return m.OldAestheticArtifactScore(ctx)
-	case generationoutput.FieldCheckedForNsfw:
-		return m.OldCheckedForNsfw(ctx)
-	case generationoutput.FieldNsfwScore:
-		return m.OldNsfwScore(ctx)
-	case generationoutput.FieldLikeCount:
-		return m.OldLikeCount(ctx)
-	case generationoutput.FieldGenerationID:
-		return m.OldGenerationID(ctx)
-	case generationoutput.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case generationoutput.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case generationoutput.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown GenerationOutput field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GenerationOutputMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case generationoutput.FieldImagePath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetImagePath(v)
-		return nil
-	case generationoutput.FieldUpscaledImagePath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpscaledImagePath(v)
-		return nil
-	case generationoutput.FieldGalleryStatus:
-		v, ok := value.(generationoutput.GalleryStatus)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGalleryStatus(v)
-		return nil
-	case generationoutput.FieldIsFavorited:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsFavorited(v)
-		return nil
-	case generationoutput.FieldHasEmbeddings:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHasEmbeddings(v)
-		return nil
-	case generationoutput.FieldHasEmbeddingsNew:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHasEmbeddingsNew(v)
-		return nil
-	case generationoutput.FieldIsMigrated:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsMigrated(v)
-		return nil
-	case generationoutput.FieldIsPublic:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsPublic(v)
-		return nil
-	case generationoutput.FieldAestheticRatingScore:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAestheticRatingScore(v)
-		return nil
-	case generationoutput.FieldAestheticArtifactScore:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAestheticArtifactScore(v)
-		return nil
-	case generationoutput.FieldCheckedForNsfw:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCheckedForNsfw(v)
-		return nil
-	case generationoutput.FieldNsfwScore:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNsfwScore(v)
-		return nil
-	case generationoutput.FieldLikeCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLikeCount(v)
-		return nil
-	case generationoutput.FieldGenerationID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGenerationID(v)
-		return nil
-	case generationoutput.FieldDeletedAt:
-		v, ok := value.(time.Time)
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IPBlackListMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ipblacklist.FieldIP:
+		return m.IP()
+	case ipblacklist.FieldCreatedAt:
+		return m.CreatedAt()
+	case ipblacklist.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IPBlackListMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ipblacklist.FieldIP:
+		return m.OldIP(ctx)
+	case ipblacklist.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case ipblacklist.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IPBlackList field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IPBlackListMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ipblacklist.FieldIP:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetIP(v)
 		return nil
-	case generationoutput.FieldCreatedAt:
+	case ipblacklist.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case generationoutput.FieldUpdatedAt:
+	case ipblacklist.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -11126,323 +20117,156 @@ func (m *GenerationOutputMutation) SetField(name string, value ent.Value) error
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown GenerationOutput field %s", name)
+	return fmt.Errorf("unknown IPBlackList field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GenerationOutputMutation) AddedFields() []string {
-	var fields []string
-	if m.addaesthetic_rating_score != nil {
-		fields = append(fields, generationoutput.FieldAestheticRatingScore)
-	}
-	if m.addaesthetic_artifact_score != nil {
-		fields = append(fields, generationoutput.FieldAestheticArtifactScore)
-	}
-	if m.addnsfw_score != nil {
-		fields = append(fields, generationoutput.FieldNsfwScore)
-	}
-	if m.addlike_count != nil {
-		fields = append(fields, generationoutput.FieldLikeCount)
-	}
-	return fields
+func (m *IPBlackListMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GenerationOutputMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case generationoutput.FieldAestheticRatingScore:
-		return m.AddedAestheticRatingScore()
-	case generationoutput.FieldAestheticArtifactScore:
-		return m.AddedAestheticArtifactScore()
-	case generationoutput.FieldNsfwScore:
-		return m.AddedNsfwScore()
-	case generationoutput.FieldLikeCount:
-		return m.AddedLikeCount()
-	}
+func (m *IPBlackListMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GenerationOutputMutation) AddField(name string, value ent.Value) error {
+func (m *IPBlackListMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case generationoutput.FieldAestheticRatingScore:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAestheticRatingScore(v)
-		return nil
-	case generationoutput.FieldAestheticArtifactScore:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAestheticArtifactScore(v)
-		return nil
-	case generationoutput.FieldNsfwScore:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddNsfwScore(v)
-		return nil
-	case generationoutput.FieldLikeCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLikeCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown GenerationOutput numeric field %s", name)
+	return fmt.Errorf("unknown IPBlackList numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GenerationOutputMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(generationoutput.FieldUpscaledImagePath) {
-		fields = append(fields, generationoutput.FieldUpscaledImagePath)
-	}
-	if m.FieldCleared(generationoutput.FieldDeletedAt) {
-		fields = append(fields, generationoutput.FieldDeletedAt)
-	}
-	return fields
+func (m *IPBlackListMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GenerationOutputMutation) FieldCleared(name string) bool {
+func (m *IPBlackListMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GenerationOutputMutation) ClearField(name string) error {
-	switch name {
-	case generationoutput.FieldUpscaledImagePath:
-		m.ClearUpscaledImagePath()
-		return nil
-	case generationoutput.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown GenerationOutput nullable field %s", name)
+func (m *IPBlackListMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown IPBlackList nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GenerationOutputMutation) ResetField(name string) error {
+func (m *IPBlackListMutation) ResetField(name string) error {
 	switch name {
-	case generationoutput.FieldImagePath:
-		m.ResetImagePath()
-		return nil
-	case generationoutput.FieldUpscaledImagePath:
-		m.ResetUpscaledImagePath()
-		return nil
-	case generationoutput.FieldGalleryStatus:
-		m.ResetGalleryStatus()
-		return nil
-	case generationoutput.FieldIsFavorited:
-		m.ResetIsFavorited()
-		return nil
-	case generationoutput.FieldHasEmbeddings:
-		m.ResetHasEmbeddings()
-		return nil
-	case generationoutput.FieldHasEmbeddingsNew:
-		m.ResetHasEmbeddingsNew()
-		return nil
-	case generationoutput.FieldIsMigrated:
-		m.ResetIsMigrated()
-		return nil
-	case generationoutput.FieldIsPublic:
-		m.ResetIsPublic()
-		return nil
-	case generationoutput.FieldAestheticRatingScore:
-		m.ResetAestheticRatingScore()
-		return nil
-	case generationoutput.FieldAestheticArtifactScore:
-		m.ResetAestheticArtifactScore()
-		return nil
-	case generationoutput.FieldCheckedForNsfw:
-		m.ResetCheckedForNsfw()
-		return nil
-	case generationoutput.FieldNsfwScore:
-		m.ResetNsfwScore()
-		return nil
-	case generationoutput.FieldLikeCount:
-		m.ResetLikeCount()
-		return nil
-	case generationoutput.FieldGenerationID:
-		m.ResetGenerationID()
-		return nil
-	case generationoutput.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case ipblacklist.FieldIP:
+		m.ResetIP()
 		return nil
-	case generationoutput.FieldCreatedAt:
+	case ipblacklist.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case generationoutput.FieldUpdatedAt:
+	case ipblacklist.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown GenerationOutput field %s", name)
+	return fmt.Errorf("unknown IPBlackList field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GenerationOutputMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.generations != nil {
-		edges = append(edges, generationoutput.EdgeGenerations)
-	}
-	if m.upscale_outputs != nil {
-		edges = append(edges, generationoutput.EdgeUpscaleOutputs)
-	}
-	if m.generation_output_likes != nil {
-		edges = append(edges, generationoutput.EdgeGenerationOutputLikes)
-	}
+func (m *IPBlackListMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GenerationOutputMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case generationoutput.EdgeGenerations:
-		if id := m.generations; id != nil {
-			return []ent.Value{*id}
-		}
-	case generationoutput.EdgeUpscaleOutputs:
-		if id := m.upscale_outputs; id != nil {
-			return []ent.Value{*id}
-		}
-	case generationoutput.EdgeGenerationOutputLikes:
-		ids := make([]ent.Value, 0, len(m.generation_output_likes))
-		for id := range m.generation_output_likes {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *IPBlackListMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GenerationOutputMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedgeneration_output_likes != nil {
-		edges = append(edges, generationoutput.EdgeGenerationOutputLikes)
-	}
+func (m *IPBlackListMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GenerationOutputMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case generationoutput.EdgeGenerationOutputLikes:
-		ids := make([]ent.Value, 0, len(m.removedgeneration_output_likes))
-		for id := range m.removedgeneration_output_likes {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *IPBlackListMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GenerationOutputMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedgenerations {
-		edges = append(edges, generationoutput.EdgeGenerations)
-	}
-	if m.clearedupscale_outputs {
-		edges = append(edges, generationoutput.EdgeUpscaleOutputs)
-	}
-	if m.clearedgeneration_output_likes {
-		edges = append(edges, generationoutput.EdgeGenerationOutputLikes)
-	}
+func (m *IPBlackListMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *GenerationOutputMutation) EdgeCleared(name string) bool {
-	switch name {
-	case generationoutput.EdgeGenerations:
-		return m.clearedgenerations
-	case generationoutput.EdgeUpscaleOutputs:
-		return m.clearedupscale_outputs
-	case generationoutput.EdgeGenerationOutputLikes:
-		return m.clearedgeneration_output_likes
-	}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IPBlackListMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GenerationOutputMutation) ClearEdge(name string) error {
-	switch name {
-	case generationoutput.EdgeGenerations:
-		m.ClearGenerations()
-		return nil
-	case generationoutput.EdgeUpscaleOutputs:
-		m.ClearUpscaleOutputs()
-		return nil
-	}
-	return fmt.Errorf("unknown GenerationOutput unique edge %s", name)
+func (m *IPBlackListMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IPBlackList unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GenerationOutputMutation) ResetEdge(name string) error {
-	switch name {
-	case generationoutput.EdgeGenerations:
-		m.ResetGenerations()
-		return nil
-	case generationoutput.EdgeUpscaleOutputs:
-		m.ResetUpscaleOutputs()
-		return nil
-	case generationoutput.EdgeGenerationOutputLikes:
-		m.ResetGenerationOutputLikes()
-		return nil
-	}
-	return fmt.Errorf("unknown GenerationOutput edge %s", name)
+func (m *IPBlackListMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IPBlackList edge %s", name)
 }
 
-// GenerationOutputLikeMutation represents an operation that mutates the GenerationOutputLike nodes in the graph.
-type GenerationOutputLikeMutation struct {
+// ModerationVerdictMutation represents an operation that mutates the ModerationVerdict nodes in the graph.
+type ModerationVerdictMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *uuid.UUID
-	created_at                *time.Time
-	clearedFields             map[string]struct{}
-	generation_outputs        *uuid.UUID
-	clearedgeneration_outputs bool
-	users                     *uuid.UUID
-	clearedusers              bool
-	done                      bool
-	oldValue                  func(context.Context) (*GenerationOutputLike, error)
-	predicates                []predicate.GenerationOutputLike
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	user_id           *uuid.UUID
+	generation_id     *uuid.UUID
+	source_type       *enttypes.SourceType
+	tier              *string
+	policy            *string
+	prompt            *string
+	translated_prompt *string
+	action            *moderationverdict.Action
+	reason            *string
+	findings          *[]enttypes.ModerationFinding
+	appendfindings    []enttypes.ModerationFinding
+	errors            *[]string
+	appenderrors      []string
+	review_status     *moderationverdict.ReviewStatus
+	reviewed_by       *uuid.UUID
+	review_note       *string
+	reviewed_at       *time.Time
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*ModerationVerdict, error)
+	predicates        []predicate.ModerationVerdict
 }
 
-var _ ent.Mutation = (*GenerationOutputLikeMutation)(nil)
+var _ ent.Mutation = (*ModerationVerdictMutation)(nil)
 
-// generationoutputlikeOption allows management of the mutation configuration using functional options.
-type generationoutputlikeOption func(*GenerationOutputLikeMutation)
+// moderationverdictOption allows management of the mutation configuration using functional options.
+type moderationverdictOption func(*ModerationVerdictMutation)
 
-// newGenerationOutputLikeMutation creates new mutation for the GenerationOutputLike entity.
-func newGenerationOutputLikeMutation(c config, op Op, opts ...generationoutputlikeOption) *GenerationOutputLikeMutation {
-	m := &GenerationOutputLikeMutation{
+// newModerationVerdictMutation creates new mutation for the ModerationVerdict entity.
+func newModerationVerdictMutation(c config, op Op, opts ...moderationverdictOption) *ModerationVerdictMutation {
+	m := &ModerationVerdictMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGenerationOutputLike,
+		typ:           TypeModerationVerdict,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -11451,20 +20275,20 @@ func newGenerationOutputLikeMutation(c config, op Op, opts ...generationoutputli
 	return m
 }
 
-// withGenerationOutputLikeID sets the ID field of the mutation.
-func withGenerationOutputLikeID(id uuid.UUID) generationoutputlikeOption {
-	return func(m *GenerationOutputLikeMutation) {
+// withModerationVerdictID sets the ID field of the mutation.
+func withModerationVerdictID(id uuid.UUID) moderationverdictOption {
+	return func(m *ModerationVerdictMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *GenerationOutputLike
+			value *ModerationVerdict
 		)
-		m.oldValue = func(ctx context.Context) (*GenerationOutputLike, error) {
+		m.oldValue = func(ctx context.Context) (*ModerationVerdict, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().GenerationOutputLike.Get(ctx, id)
+					value, err = m.Client().ModerationVerdict.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -11473,10 +20297,10 @@ func withGenerationOutputLikeID(id uuid.UUID) generationoutputlikeOption {
 	}
 }
 
-// withGenerationOutputLike sets the old GenerationOutputLike of the mutation.
-func withGenerationOutputLike(node *GenerationOutputLike) generationoutputlikeOption {
-	return func(m *GenerationOutputLikeMutation) {
-		m.oldValue = func(context.Context) (*GenerationOutputLike, error) {
+// withModerationVerdict sets the old ModerationVerdict of the mutation.
+func withModerationVerdict(node *ModerationVerdict) moderationverdictOption {
+	return func(m *ModerationVerdictMutation) {
+		m.oldValue = func(context.Context) (*ModerationVerdict, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -11485,7 +20309,7 @@ func withGenerationOutputLike(node *GenerationOutputLike) generationoutputlikeOp
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GenerationOutputLikeMutation) Client() *Client {
+func (m ModerationVerdictMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -11493,7 +20317,7 @@ func (m GenerationOutputLikeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GenerationOutputLikeMutation) Tx() (*Tx, error) {
+func (m ModerationVerdictMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -11503,14 +20327,14 @@ func (m GenerationOutputLikeMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of GenerationOutputLike entities.
-func (m *GenerationOutputLikeMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ModerationVerdict entities.
+func (m *ModerationVerdictMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GenerationOutputLikeMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ModerationVerdictMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -11521,7 +20345,7 @@ func (m *GenerationOutputLikeMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GenerationOutputLikeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ModerationVerdictMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -11530,693 +20354,746 @@ func (m *GenerationOutputLikeMutation) IDs(ctx context.Context) ([]uuid.UUID, er
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GenerationOutputLike.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ModerationVerdict.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetOutputID sets the "output_id" field.
-func (m *GenerationOutputLikeMutation) SetOutputID(u uuid.UUID) {
-	m.generation_outputs = &u
+// SetUserID sets the "user_id" field.
+func (m *ModerationVerdictMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// OutputID returns the value of the "output_id" field in the mutation.
-func (m *GenerationOutputLikeMutation) OutputID() (r uuid.UUID, exists bool) {
-	v := m.generation_outputs
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ModerationVerdictMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOutputID returns the old "output_id" field's value of the GenerationOutputLike entity.
-// If the GenerationOutputLike object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputLikeMutation) OldOutputID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ModerationVerdictMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutputID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutputID requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutputID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.OutputID, nil
+	return oldValue.UserID, nil
 }
 
-// ResetOutputID resets all changes to the "output_id" field.
-func (m *GenerationOutputLikeMutation) ResetOutputID() {
-	m.generation_outputs = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ModerationVerdictMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetLikedByUserID sets the "liked_by_user_id" field.
-func (m *GenerationOutputLikeMutation) SetLikedByUserID(u uuid.UUID) {
-	m.users = &u
+// SetGenerationID sets the "generation_id" field.
+func (m *ModerationVerdictMutation) SetGenerationID(u uuid.UUID) {
+	m.generation_id = &u
 }
 
-// LikedByUserID returns the value of the "liked_by_user_id" field in the mutation.
-func (m *GenerationOutputLikeMutation) LikedByUserID() (r uuid.UUID, exists bool) {
-	v := m.users
+// GenerationID returns the value of the "generation_id" field in the mutation.
+func (m *ModerationVerdictMutation) GenerationID() (r uuid.UUID, exists bool) {
+	v := m.generation_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLikedByUserID returns the old "liked_by_user_id" field's value of the GenerationOutputLike entity.
-// If the GenerationOutputLike object wasn't provided to the builder, the object is fetched from the database.
+// OldGenerationID returns the old "generation_id" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputLikeMutation) OldLikedByUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ModerationVerdictMutation) OldGenerationID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLikedByUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldGenerationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLikedByUserID requires an ID field in the mutation")
+		return v, errors.New("OldGenerationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLikedByUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldGenerationID: %w", err)
 	}
-	return oldValue.LikedByUserID, nil
+	return oldValue.GenerationID, nil
 }
 
-// ResetLikedByUserID resets all changes to the "liked_by_user_id" field.
-func (m *GenerationOutputLikeMutation) ResetLikedByUserID() {
-	m.users = nil
+// ClearGenerationID clears the value of the "generation_id" field.
+func (m *ModerationVerdictMutation) ClearGenerationID() {
+	m.generation_id = nil
+	m.clearedFields[moderationverdict.FieldGenerationID] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *GenerationOutputLikeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// GenerationIDCleared returns if the "generation_id" field was cleared in this mutation.
+func (m *ModerationVerdictMutation) GenerationIDCleared() bool {
+	_, ok := m.clearedFields[moderationverdict.FieldGenerationID]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GenerationOutputLikeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetGenerationID resets all changes to the "generation_id" field.
+func (m *ModerationVerdictMutation) ResetGenerationID() {
+	m.generation_id = nil
+	delete(m.clearedFields, moderationverdict.FieldGenerationID)
+}
+
+// SetSourceType sets the "source_type" field.
+func (m *ModerationVerdictMutation) SetSourceType(et enttypes.SourceType) {
+	m.source_type = &et
+}
+
+// SourceType returns the value of the "source_type" field in the mutation.
+func (m *ModerationVerdictMutation) SourceType() (r enttypes.SourceType, exists bool) {
+	v := m.source_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the GenerationOutputLike entity.
-// If the GenerationOutputLike object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceType returns the old "source_type" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenerationOutputLikeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ModerationVerdictMutation) OldSourceType(ctx context.Context) (v enttypes.SourceType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldSourceType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourceType: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.SourceType, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GenerationOutputLikeMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetSourceType resets all changes to the "source_type" field.
+func (m *ModerationVerdictMutation) ResetSourceType() {
+	m.source_type = nil
 }
 
-// SetGenerationOutputsID sets the "generation_outputs" edge to the GenerationOutput entity by id.
-func (m *GenerationOutputLikeMutation) SetGenerationOutputsID(id uuid.UUID) {
-	m.generation_outputs = &id
+// SetTier sets the "tier" field.
+func (m *ModerationVerdictMutation) SetTier(s string) {
+	m.tier = &s
 }
 
-// ClearGenerationOutputs clears the "generation_outputs" edge to the GenerationOutput entity.
-func (m *GenerationOutputLikeMutation) ClearGenerationOutputs() {
-	m.clearedgeneration_outputs = true
-	m.clearedFields[generationoutputlike.FieldOutputID] = struct{}{}
+// Tier returns the value of the "tier" field in the mutation.
+func (m *ModerationVerdictMutation) Tier() (r string, exists bool) {
+	v := m.tier
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// GenerationOutputsCleared reports if the "generation_outputs" edge to the GenerationOutput entity was cleared.
-func (m *GenerationOutputLikeMutation) GenerationOutputsCleared() bool {
-	return m.clearedgeneration_outputs
+// OldTier returns the old "tier" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationVerdictMutation) OldTier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTier: %w", err)
+	}
+	return oldValue.Tier, nil
 }
 
-// GenerationOutputsID returns the "generation_outputs" edge ID in the mutation.
-func (m *GenerationOutputLikeMutation) GenerationOutputsID() (id uuid.UUID, exists bool) {
-	if m.generation_outputs != nil {
-		return *m.generation_outputs, true
-	}
-	return
+// ResetTier resets all changes to the "tier" field.
+func (m *ModerationVerdictMutation) ResetTier() {
+	m.tier = nil
 }
 
-// GenerationOutputsIDs returns the "generation_outputs" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GenerationOutputsID instead. It exists only for internal usage by the builders.
-func (m *GenerationOutputLikeMutation) GenerationOutputsIDs() (ids []uuid.UUID) {
-	if id := m.generation_outputs; id != nil {
-		ids = append(ids, *id)
+// SetPolicy sets the "policy" field.
+func (m *ModerationVerdictMutation) SetPolicy(s string) {
+	m.policy = &s
+}
+
+// Policy returns the value of the "policy" field in the mutation.
+func (m *ModerationVerdictMutation) Policy() (r string, exists bool) {
+	v := m.policy
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetGenerationOutputs resets all changes to the "generation_outputs" edge.
-func (m *GenerationOutputLikeMutation) ResetGenerationOutputs() {
-	m.generation_outputs = nil
-	m.clearedgeneration_outputs = false
+// OldPolicy returns the old "policy" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationVerdictMutation) OldPolicy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicy: %w", err)
+	}
+	return oldValue.Policy, nil
 }
 
-// SetUsersID sets the "users" edge to the User entity by id.
-func (m *GenerationOutputLikeMutation) SetUsersID(id uuid.UUID) {
-	m.users = &id
+// ResetPolicy resets all changes to the "policy" field.
+func (m *ModerationVerdictMutation) ResetPolicy() {
+	m.policy = nil
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *GenerationOutputLikeMutation) ClearUsers() {
-	m.clearedusers = true
-	m.clearedFields[generationoutputlike.FieldLikedByUserID] = struct{}{}
+// SetPrompt sets the "prompt" field.
+func (m *ModerationVerdictMutation) SetPrompt(s string) {
+	m.prompt = &s
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *GenerationOutputLikeMutation) UsersCleared() bool {
-	return m.clearedusers
+// Prompt returns the value of the "prompt" field in the mutation.
+func (m *ModerationVerdictMutation) Prompt() (r string, exists bool) {
+	v := m.prompt
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UsersID returns the "users" edge ID in the mutation.
-func (m *GenerationOutputLikeMutation) UsersID() (id uuid.UUID, exists bool) {
-	if m.users != nil {
-		return *m.users, true
+// OldPrompt returns the old "prompt" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationVerdictMutation) OldPrompt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrompt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrompt: %w", err)
+	}
+	return oldValue.Prompt, nil
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UsersID instead. It exists only for internal usage by the builders.
-func (m *GenerationOutputLikeMutation) UsersIDs() (ids []uuid.UUID) {
-	if id := m.users; id != nil {
-		ids = append(ids, *id)
+// ResetPrompt resets all changes to the "prompt" field.
+func (m *ModerationVerdictMutation) ResetPrompt() {
+	m.prompt = nil
+}
+
+// SetTranslatedPrompt sets the "translated_prompt" field.
+func (m *ModerationVerdictMutation) SetTranslatedPrompt(s string) {
+	m.translated_prompt = &s
+}
+
+// TranslatedPrompt returns the value of the "translated_prompt" field in the mutation.
+func (m *ModerationVerdictMutation) TranslatedPrompt() (r string, exists bool) {
+	v := m.translated_prompt
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *GenerationOutputLikeMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
+// OldTranslatedPrompt returns the old "translated_prompt" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationVerdictMutation) OldTranslatedPrompt(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTranslatedPrompt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTranslatedPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTranslatedPrompt: %w", err)
+	}
+	return oldValue.TranslatedPrompt, nil
 }
 
-// Where appends a list predicates to the GenerationOutputLikeMutation builder.
-func (m *GenerationOutputLikeMutation) Where(ps ...predicate.GenerationOutputLike) {
-	m.predicates = append(m.predicates, ps...)
+// ClearTranslatedPrompt clears the value of the "translated_prompt" field.
+func (m *ModerationVerdictMutation) ClearTranslatedPrompt() {
+	m.translated_prompt = nil
+	m.clearedFields[moderationverdict.FieldTranslatedPrompt] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the GenerationOutputLikeMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GenerationOutputLikeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GenerationOutputLike, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// TranslatedPromptCleared returns if the "translated_prompt" field was cleared in this mutation.
+func (m *ModerationVerdictMutation) TranslatedPromptCleared() bool {
+	_, ok := m.clearedFields[moderationverdict.FieldTranslatedPrompt]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *GenerationOutputLikeMutation) Op() Op {
-	return m.op
+// ResetTranslatedPrompt resets all changes to the "translated_prompt" field.
+func (m *ModerationVerdictMutation) ResetTranslatedPrompt() {
+	m.translated_prompt = nil
+	delete(m.clearedFields, moderationverdict.FieldTranslatedPrompt)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *GenerationOutputLikeMutation) SetOp(op Op) {
-	m.op = op
+// SetAction sets the "action" field.
+func (m *ModerationVerdictMutation) SetAction(value moderationverdict.Action) {
+	m.action = &value
 }
 
-// Type returns the node type of this mutation (GenerationOutputLike).
-func (m *GenerationOutputLikeMutation) Type() string {
-	return m.typ
+// Action returns the value of the "action" field in the mutation.
+func (m *ModerationVerdictMutation) Action() (r moderationverdict.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *GenerationOutputLikeMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.generation_outputs != nil {
-		fields = append(fields, generationoutputlike.FieldOutputID)
+// OldAction returns the old "action" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationVerdictMutation) OldAction(ctx context.Context) (v moderationverdict.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
-	if m.users != nil {
-		fields = append(fields, generationoutputlike.FieldLikedByUserID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, generationoutputlike.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return fields
+	return oldValue.Action, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *GenerationOutputLikeMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case generationoutputlike.FieldOutputID:
-		return m.OutputID()
-	case generationoutputlike.FieldLikedByUserID:
-		return m.LikedByUserID()
-	case generationoutputlike.FieldCreatedAt:
-		return m.CreatedAt()
-	}
-	return nil, false
+// ResetAction resets all changes to the "action" field.
+func (m *ModerationVerdictMutation) ResetAction() {
+	m.action = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *GenerationOutputLikeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case generationoutputlike.FieldOutputID:
-		return m.OldOutputID(ctx)
-	case generationoutputlike.FieldLikedByUserID:
-		return m.OldLikedByUserID(ctx)
-	case generationoutputlike.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown GenerationOutputLike field %s", name)
+// SetReason sets the "reason" field.
+func (m *ModerationVerdictMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GenerationOutputLikeMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case generationoutputlike.FieldOutputID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOutputID(v)
-		return nil
-	case generationoutputlike.FieldLikedByUserID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLikedByUserID(v)
-		return nil
-	case generationoutputlike.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ModerationVerdictMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown GenerationOutputLike field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *GenerationOutputLikeMutation) AddedFields() []string {
-	return nil
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *GenerationOutputLikeMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GenerationOutputLikeMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldReason returns the old "reason" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationVerdictMutation) OldReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown GenerationOutputLike numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *GenerationOutputLikeMutation) ClearedFields() []string {
-	return nil
+// ClearReason clears the value of the "reason" field.
+func (m *ModerationVerdictMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[moderationverdict.FieldReason] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *GenerationOutputLikeMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *ModerationVerdictMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[moderationverdict.FieldReason]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *GenerationOutputLikeMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown GenerationOutputLike nullable field %s", name)
+// ResetReason resets all changes to the "reason" field.
+func (m *ModerationVerdictMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, moderationverdict.FieldReason)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *GenerationOutputLikeMutation) ResetField(name string) error {
-	switch name {
-	case generationoutputlike.FieldOutputID:
-		m.ResetOutputID()
-		return nil
-	case generationoutputlike.FieldLikedByUserID:
-		m.ResetLikedByUserID()
-		return nil
-	case generationoutputlike.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// SetFindings sets the "findings" field.
+func (m *ModerationVerdictMutation) SetFindings(ef []enttypes.ModerationFinding) {
+	m.findings = &ef
+	m.appendfindings = nil
+}
+
+// Findings returns the value of the "findings" field in the mutation.
+func (m *ModerationVerdictMutation) Findings() (r []enttypes.ModerationFinding, exists bool) {
+	v := m.findings
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown GenerationOutputLike field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GenerationOutputLikeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.generation_outputs != nil {
-		edges = append(edges, generationoutputlike.EdgeGenerationOutputs)
+// OldFindings returns the old "findings" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationVerdictMutation) OldFindings(ctx context.Context) (v []enttypes.ModerationFinding, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFindings is only allowed on UpdateOne operations")
 	}
-	if m.users != nil {
-		edges = append(edges, generationoutputlike.EdgeUsers)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFindings requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFindings: %w", err)
+	}
+	return oldValue.Findings, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *GenerationOutputLikeMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case generationoutputlike.EdgeGenerationOutputs:
-		if id := m.generation_outputs; id != nil {
-			return []ent.Value{*id}
-		}
-	case generationoutputlike.EdgeUsers:
-		if id := m.users; id != nil {
-			return []ent.Value{*id}
-		}
+// AppendFindings adds ef to the "findings" field.
+func (m *ModerationVerdictMutation) AppendFindings(ef []enttypes.ModerationFinding) {
+	m.appendfindings = append(m.appendfindings, ef...)
+}
+
+// AppendedFindings returns the list of values that were appended to the "findings" field in this mutation.
+func (m *ModerationVerdictMutation) AppendedFindings() ([]enttypes.ModerationFinding, bool) {
+	if len(m.appendfindings) == 0 {
+		return nil, false
 	}
-	return nil
+	return m.appendfindings, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GenerationOutputLikeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// ClearFindings clears the value of the "findings" field.
+func (m *ModerationVerdictMutation) ClearFindings() {
+	m.findings = nil
+	m.appendfindings = nil
+	m.clearedFields[moderationverdict.FieldFindings] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *GenerationOutputLikeMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// FindingsCleared returns if the "findings" field was cleared in this mutation.
+func (m *ModerationVerdictMutation) FindingsCleared() bool {
+	_, ok := m.clearedFields[moderationverdict.FieldFindings]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GenerationOutputLikeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedgeneration_outputs {
-		edges = append(edges, generationoutputlike.EdgeGenerationOutputs)
-	}
-	if m.clearedusers {
-		edges = append(edges, generationoutputlike.EdgeUsers)
-	}
-	return edges
+// ResetFindings resets all changes to the "findings" field.
+func (m *ModerationVerdictMutation) ResetFindings() {
+	m.findings = nil
+	m.appendfindings = nil
+	delete(m.clearedFields, moderationverdict.FieldFindings)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *GenerationOutputLikeMutation) EdgeCleared(name string) bool {
-	switch name {
-	case generationoutputlike.EdgeGenerationOutputs:
-		return m.clearedgeneration_outputs
-	case generationoutputlike.EdgeUsers:
-		return m.clearedusers
-	}
-	return false
+// SetErrors sets the "errors" field.
+func (m *ModerationVerdictMutation) SetErrors(s []string) {
+	m.errors = &s
+	m.appenderrors = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *GenerationOutputLikeMutation) ClearEdge(name string) error {
-	switch name {
-	case generationoutputlike.EdgeGenerationOutputs:
-		m.ClearGenerationOutputs()
-		return nil
-	case generationoutputlike.EdgeUsers:
-		m.ClearUsers()
-		return nil
+// Errors returns the value of the "errors" field in the mutation.
+func (m *ModerationVerdictMutation) Errors() (r []string, exists bool) {
+	v := m.errors
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown GenerationOutputLike unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *GenerationOutputLikeMutation) ResetEdge(name string) error {
-	switch name {
-	case generationoutputlike.EdgeGenerationOutputs:
-		m.ResetGenerationOutputs()
-		return nil
-	case generationoutputlike.EdgeUsers:
-		m.ResetUsers()
-		return nil
+// OldErrors returns the old "errors" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationVerdictMutation) OldErrors(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrors: %w", err)
 	}
-	return fmt.Errorf("unknown GenerationOutputLike edge %s", name)
+	return oldValue.Errors, nil
 }
 
-// IPBlackListMutation represents an operation that mutates the IPBlackList nodes in the graph.
-type IPBlackListMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	ip            *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*IPBlackList, error)
-	predicates    []predicate.IPBlackList
+// AppendErrors adds s to the "errors" field.
+func (m *ModerationVerdictMutation) AppendErrors(s []string) {
+	m.appenderrors = append(m.appenderrors, s...)
 }
 
-var _ ent.Mutation = (*IPBlackListMutation)(nil)
+// AppendedErrors returns the list of values that were appended to the "errors" field in this mutation.
+func (m *ModerationVerdictMutation) AppendedErrors() ([]string, bool) {
+	if len(m.appenderrors) == 0 {
+		return nil, false
+	}
+	return m.appenderrors, true
+}
 
-// ipblacklistOption allows management of the mutation configuration using functional options.
-type ipblacklistOption func(*IPBlackListMutation)
+// ClearErrors clears the value of the "errors" field.
+func (m *ModerationVerdictMutation) ClearErrors() {
+	m.errors = nil
+	m.appenderrors = nil
+	m.clearedFields[moderationverdict.FieldErrors] = struct{}{}
+}
 
-// newIPBlackListMutation creates new mutation for the IPBlackList entity.
-func newIPBlackListMutation(c config, op Op, opts ...ipblacklistOption) *IPBlackListMutation {
-	m := &IPBlackListMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeIPBlackList,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// ErrorsCleared returns if the "errors" field was cleared in this mutation.
+func (m *ModerationVerdictMutation) ErrorsCleared() bool {
+	_, ok := m.clearedFields[moderationverdict.FieldErrors]
+	return ok
 }
 
-// withIPBlackListID sets the ID field of the mutation.
-func withIPBlackListID(id uuid.UUID) ipblacklistOption {
-	return func(m *IPBlackListMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *IPBlackList
-		)
-		m.oldValue = func(ctx context.Context) (*IPBlackList, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().IPBlackList.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ResetErrors resets all changes to the "errors" field.
+func (m *ModerationVerdictMutation) ResetErrors() {
+	m.errors = nil
+	m.appenderrors = nil
+	delete(m.clearedFields, moderationverdict.FieldErrors)
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (m *ModerationVerdictMutation) SetReviewStatus(ms moderationverdict.ReviewStatus) {
+	m.review_status = &ms
+}
+
+// ReviewStatus returns the value of the "review_status" field in the mutation.
+func (m *ModerationVerdictMutation) ReviewStatus() (r moderationverdict.ReviewStatus, exists bool) {
+	v := m.review_status
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withIPBlackList sets the old IPBlackList of the mutation.
-func withIPBlackList(node *IPBlackList) ipblacklistOption {
-	return func(m *IPBlackListMutation) {
-		m.oldValue = func(context.Context) (*IPBlackList, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldReviewStatus returns the old "review_status" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationVerdictMutation) OldReviewStatus(ctx context.Context) (v *moderationverdict.ReviewStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewStatus requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewStatus: %w", err)
+	}
+	return oldValue.ReviewStatus, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m IPBlackListMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearReviewStatus clears the value of the "review_status" field.
+func (m *ModerationVerdictMutation) ClearReviewStatus() {
+	m.review_status = nil
+	m.clearedFields[moderationverdict.FieldReviewStatus] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m IPBlackListMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ReviewStatusCleared returns if the "review_status" field was cleared in this mutation.
+func (m *ModerationVerdictMutation) ReviewStatusCleared() bool {
+	_, ok := m.clearedFields[moderationverdict.FieldReviewStatus]
+	return ok
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of IPBlackList entities.
-func (m *IPBlackListMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ResetReviewStatus resets all changes to the "review_status" field.
+func (m *ModerationVerdictMutation) ResetReviewStatus() {
+	m.review_status = nil
+	delete(m.clearedFields, moderationverdict.FieldReviewStatus)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *IPBlackListMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// SetReviewedBy sets the "reviewed_by" field.
+func (m *ModerationVerdictMutation) SetReviewedBy(u uuid.UUID) {
+	m.reviewed_by = &u
+}
+
+// ReviewedBy returns the value of the "reviewed_by" field in the mutation.
+func (m *ModerationVerdictMutation) ReviewedBy() (r uuid.UUID, exists bool) {
+	v := m.reviewed_by
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *IPBlackListMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().IPBlackList.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldReviewedBy returns the old "reviewed_by" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationVerdictMutation) OldReviewedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedBy requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedBy: %w", err)
+	}
+	return oldValue.ReviewedBy, nil
 }
 
-// SetIP sets the "ip" field.
-func (m *IPBlackListMutation) SetIP(s string) {
-	m.ip = &s
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (m *ModerationVerdictMutation) ClearReviewedBy() {
+	m.reviewed_by = nil
+	m.clearedFields[moderationverdict.FieldReviewedBy] = struct{}{}
 }
 
-// IP returns the value of the "ip" field in the mutation.
-func (m *IPBlackListMutation) IP() (r string, exists bool) {
-	v := m.ip
+// ReviewedByCleared returns if the "reviewed_by" field was cleared in this mutation.
+func (m *ModerationVerdictMutation) ReviewedByCleared() bool {
+	_, ok := m.clearedFields[moderationverdict.FieldReviewedBy]
+	return ok
+}
+
+// ResetReviewedBy resets all changes to the "reviewed_by" field.
+func (m *ModerationVerdictMutation) ResetReviewedBy() {
+	m.reviewed_by = nil
+	delete(m.clearedFields, moderationverdict.FieldReviewedBy)
+}
+
+// SetReviewNote sets the "review_note" field.
+func (m *ModerationVerdictMutation) SetReviewNote(s string) {
+	m.review_note = &s
+}
+
+// ReviewNote returns the value of the "review_note" field in the mutation.
+func (m *ModerationVerdictMutation) ReviewNote() (r string, exists bool) {
+	v := m.review_note
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIP returns the old "ip" field's value of the IPBlackList entity.
-// If the IPBlackList object wasn't provided to the builder, the object is fetched from the database.
+// OldReviewNote returns the old "review_note" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IPBlackListMutation) OldIP(ctx context.Context) (v string, err error) {
+func (m *ModerationVerdictMutation) OldReviewNote(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+		return v, errors.New("OldReviewNote is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIP requires an ID field in the mutation")
+		return v, errors.New("OldReviewNote requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+		return v, fmt.Errorf("querying old value for OldReviewNote: %w", err)
 	}
-	return oldValue.IP, nil
+	return oldValue.ReviewNote, nil
 }
 
-// ResetIP resets all changes to the "ip" field.
-func (m *IPBlackListMutation) ResetIP() {
-	m.ip = nil
+// ClearReviewNote clears the value of the "review_note" field.
+func (m *ModerationVerdictMutation) ClearReviewNote() {
+	m.review_note = nil
+	m.clearedFields[moderationverdict.FieldReviewNote] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *IPBlackListMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ReviewNoteCleared returns if the "review_note" field was cleared in this mutation.
+func (m *ModerationVerdictMutation) ReviewNoteCleared() bool {
+	_, ok := m.clearedFields[moderationverdict.FieldReviewNote]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *IPBlackListMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetReviewNote resets all changes to the "review_note" field.
+func (m *ModerationVerdictMutation) ResetReviewNote() {
+	m.review_note = nil
+	delete(m.clearedFields, moderationverdict.FieldReviewNote)
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (m *ModerationVerdictMutation) SetReviewedAt(t time.Time) {
+	m.reviewed_at = &t
+}
+
+// ReviewedAt returns the value of the "reviewed_at" field in the mutation.
+func (m *ModerationVerdictMutation) ReviewedAt() (r time.Time, exists bool) {
+	v := m.reviewed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the IPBlackList entity.
-// If the IPBlackList object wasn't provided to the builder, the object is fetched from the database.
+// OldReviewedAt returns the old "reviewed_at" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IPBlackListMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ModerationVerdictMutation) OldReviewedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ReviewedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *IPBlackListMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (m *ModerationVerdictMutation) ClearReviewedAt() {
+	m.reviewed_at = nil
+	m.clearedFields[moderationverdict.FieldReviewedAt] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *IPBlackListMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ReviewedAtCleared returns if the "reviewed_at" field was cleared in this mutation.
+func (m *ModerationVerdictMutation) ReviewedAtCleared() bool {
+	_, ok := m.clearedFields[moderationverdict.FieldReviewedAt]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *IPBlackListMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetReviewedAt resets all changes to the "reviewed_at" field.
+func (m *ModerationVerdictMutation) ResetReviewedAt() {
+	m.reviewed_at = nil
+	delete(m.clearedFields, moderationverdict.FieldReviewedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ModerationVerdictMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModerationVerdictMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the IPBlackList entity.
-// If the IPBlackList object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ModerationVerdict entity.
+// If the ModerationVerdict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IPBlackListMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ModerationVerdictMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *IPBlackListMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModerationVerdictMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the IPBlackListMutation builder.
-func (m *IPBlackListMutation) Where(ps ...predicate.IPBlackList) {
+// Where appends a list predicates to the ModerationVerdictMutation builder.
+func (m *ModerationVerdictMutation) Where(ps ...predicate.ModerationVerdict) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the IPBlackListMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ModerationVerdictMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *IPBlackListMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.IPBlackList, len(ps))
+func (m *ModerationVerdictMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModerationVerdict, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -12224,33 +21101,72 @@ func (m *IPBlackListMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *IPBlackListMutation) Op() Op {
+func (m *ModerationVerdictMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *IPBlackListMutation) SetOp(op Op) {
+func (m *ModerationVerdictMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (IPBlackList).
-func (m *IPBlackListMutation) Type() string {
+// Type returns the node type of this mutation (ModerationVerdict).
+func (m *ModerationVerdictMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *IPBlackListMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.ip != nil {
-		fields = append(fields, ipblacklist.FieldIP)
+func (m *ModerationVerdictMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.user_id != nil {
+		fields = append(fields, moderationverdict.FieldUserID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, ipblacklist.FieldCreatedAt)
+	if m.generation_id != nil {
+		fields = append(fields, moderationverdict.FieldGenerationID)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, ipblacklist.FieldUpdatedAt)
+	if m.source_type != nil {
+		fields = append(fields, moderationverdict.FieldSourceType)
+	}
+	if m.tier != nil {
+		fields = append(fields, moderationverdict.FieldTier)
+	}
+	if m.policy != nil {
+		fields = append(fields, moderationverdict.FieldPolicy)
+	}
+	if m.prompt != nil {
+		fields = append(fields, moderationverdict.FieldPrompt)
+	}
+	if m.translated_prompt != nil {
+		fields = append(fields, moderationverdict.FieldTranslatedPrompt)
+	}
+	if m.action != nil {
+		fields = append(fields, moderationverdict.FieldAction)
+	}
+	if m.reason != nil {
+		fields = append(fields, moderationverdict.FieldReason)
+	}
+	if m.findings != nil {
+		fields = append(fields, moderationverdict.FieldFindings)
+	}
+	if m.errors != nil {
+		fields = append(fields, moderationverdict.FieldErrors)
+	}
+	if m.review_status != nil {
+		fields = append(fields, moderationverdict.FieldReviewStatus)
+	}
+	if m.reviewed_by != nil {
+		fields = append(fields, moderationverdict.FieldReviewedBy)
+	}
+	if m.review_note != nil {
+		fields = append(fields, moderationverdict.FieldReviewNote)
+	}
+	if m.reviewed_at != nil {
+		fields = append(fields, moderationverdict.FieldReviewedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, moderationverdict.FieldCreatedAt)
 	}
 	return fields
 }
@@ -12258,14 +21174,40 @@ func (m *IPBlackListMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *IPBlackListMutation) Field(name string) (ent.Value, bool) {
+func (m *ModerationVerdictMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case ipblacklist.FieldIP:
-		return m.IP()
-	case ipblacklist.FieldCreatedAt:
+	case moderationverdict.FieldUserID:
+		return m.UserID()
+	case moderationverdict.FieldGenerationID:
+		return m.GenerationID()
+	case moderationverdict.FieldSourceType:
+		return m.SourceType()
+	case moderationverdict.FieldTier:
+		return m.Tier()
+	case moderationverdict.FieldPolicy:
+		return m.Policy()
+	case moderationverdict.FieldPrompt:
+		return m.Prompt()
+	case moderationverdict.FieldTranslatedPrompt:
+		return m.TranslatedPrompt()
+	case moderationverdict.FieldAction:
+		return m.Action()
+	case moderationverdict.FieldReason:
+		return m.Reason()
+	case moderationverdict.FieldFindings:
+		return m.Findings()
+	case moderationverdict.FieldErrors:
+		return m.Errors()
+	case moderationverdict.FieldReviewStatus:
+		return m.ReviewStatus()
+	case moderationverdict.FieldReviewedBy:
+		return m.ReviewedBy()
+	case moderationverdict.FieldReviewNote:
+		return m.ReviewNote()
+	case moderationverdict.FieldReviewedAt:
+		return m.ReviewedAt()
+	case moderationverdict.FieldCreatedAt:
 		return m.CreatedAt()
-	case ipblacklist.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -12273,152 +21215,365 @@ func (m *IPBlackListMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *IPBlackListMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ModerationVerdictMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case ipblacklist.FieldIP:
-		return m.OldIP(ctx)
-	case ipblacklist.FieldCreatedAt:
+	case moderationverdict.FieldUserID:
+		return m.OldUserID(ctx)
+	case moderationverdict.FieldGenerationID:
+		return m.OldGenerationID(ctx)
+	case moderationverdict.FieldSourceType:
+		return m.OldSourceType(ctx)
+	case moderationverdict.FieldTier:
+		return m.OldTier(ctx)
+	case moderationverdict.FieldPolicy:
+		return m.OldPolicy(ctx)
+	case moderationverdict.FieldPrompt:
+		return m.OldPrompt(ctx)
+	case moderationverdict.FieldTranslatedPrompt:
+		return m.OldTranslatedPrompt(ctx)
+	case moderationverdict.FieldAction:
+		return m.OldAction(ctx)
+	case moderationverdict.FieldReason:
+		return m.OldReason(ctx)
+	case moderationverdict.FieldFindings:
+		return m.OldFindings(ctx)
+	case moderationverdict.FieldErrors:
+		return m.OldErrors(ctx)
+	case moderationverdict.FieldReviewStatus:
+		return m.OldReviewStatus(ctx)
+	case moderationverdict.FieldReviewedBy:
+		return m.OldReviewedBy(ctx)
+	case moderationverdict.FieldReviewNote:
+		return m.OldReviewNote(ctx)
+	case moderationverdict.FieldReviewedAt:
+		return m.OldReviewedAt(ctx)
+	case moderationverdict.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case ipblacklist.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown IPBlackList field %s", name)
+	return nil, fmt.Errorf("unknown ModerationVerdict field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IPBlackListMutation) SetField(name string, value ent.Value) error {
+func (m *ModerationVerdictMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case ipblacklist.FieldIP:
+	case moderationverdict.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case moderationverdict.FieldGenerationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenerationID(v)
+		return nil
+	case moderationverdict.FieldSourceType:
+		v, ok := value.(enttypes.SourceType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceType(v)
+		return nil
+	case moderationverdict.FieldTier:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIP(v)
+		m.SetTier(v)
 		return nil
-	case ipblacklist.FieldCreatedAt:
+	case moderationverdict.FieldPolicy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicy(v)
+		return nil
+	case moderationverdict.FieldPrompt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrompt(v)
+		return nil
+	case moderationverdict.FieldTranslatedPrompt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTranslatedPrompt(v)
+		return nil
+	case moderationverdict.FieldAction:
+		v, ok := value.(moderationverdict.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case moderationverdict.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case moderationverdict.FieldFindings:
+		v, ok := value.([]enttypes.ModerationFinding)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFindings(v)
+		return nil
+	case moderationverdict.FieldErrors:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrors(v)
+		return nil
+	case moderationverdict.FieldReviewStatus:
+		v, ok := value.(moderationverdict.ReviewStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewStatus(v)
+		return nil
+	case moderationverdict.FieldReviewedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedBy(v)
+		return nil
+	case moderationverdict.FieldReviewNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewNote(v)
+		return nil
+	case moderationverdict.FieldReviewedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetReviewedAt(v)
 		return nil
-	case ipblacklist.FieldUpdatedAt:
+	case moderationverdict.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown IPBlackList field %s", name)
+	return fmt.Errorf("unknown ModerationVerdict field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *IPBlackListMutation) AddedFields() []string {
+func (m *ModerationVerdictMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *IPBlackListMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ModerationVerdictMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IPBlackListMutation) AddField(name string, value ent.Value) error {
+func (m *ModerationVerdictMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown IPBlackList numeric field %s", name)
+	return fmt.Errorf("unknown ModerationVerdict numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *IPBlackListMutation) ClearedFields() []string {
-	return nil
+func (m *ModerationVerdictMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(moderationverdict.FieldGenerationID) {
+		fields = append(fields, moderationverdict.FieldGenerationID)
+	}
+	if m.FieldCleared(moderationverdict.FieldTranslatedPrompt) {
+		fields = append(fields, moderationverdict.FieldTranslatedPrompt)
+	}
+	if m.FieldCleared(moderationverdict.FieldReason) {
+		fields = append(fields, moderationverdict.FieldReason)
+	}
+	if m.FieldCleared(moderationverdict.FieldFindings) {
+		fields = append(fields, moderationverdict.FieldFindings)
+	}
+	if m.FieldCleared(moderationverdict.FieldErrors) {
+		fields = append(fields, moderationverdict.FieldErrors)
+	}
+	if m.FieldCleared(moderationverdict.FieldReviewStatus) {
+		fields = append(fields, moderationverdict.FieldReviewStatus)
+	}
+	if m.FieldCleared(moderationverdict.FieldReviewedBy) {
+		fields = append(fields, moderationverdict.FieldReviewedBy)
+	}
+	if m.FieldCleared(moderationverdict.FieldReviewNote) {
+		fields = append(fields, moderationverdict.FieldReviewNote)
+	}
+	if m.FieldCleared(moderationverdict.FieldReviewedAt) {
+		fields = append(fields, moderationverdict.FieldReviewedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *IPBlackListMutation) FieldCleared(name string) bool {
+func (m *ModerationVerdictMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *IPBlackListMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown IPBlackList nullable field %s", name)
+func (m *ModerationVerdictMutation) ClearField(name string) error {
+	switch name {
+	case moderationverdict.FieldGenerationID:
+		m.ClearGenerationID()
+		return nil
+	case moderationverdict.FieldTranslatedPrompt:
+		m.ClearTranslatedPrompt()
+		return nil
+	case moderationverdict.FieldReason:
+		m.ClearReason()
+		return nil
+	case moderationverdict.FieldFindings:
+		m.ClearFindings()
+		return nil
+	case moderationverdict.FieldErrors:
+		m.ClearErrors()
+		return nil
+	case moderationverdict.FieldReviewStatus:
+		m.ClearReviewStatus()
+		return nil
+	case moderationverdict.FieldReviewedBy:
+		m.ClearReviewedBy()
+		return nil
+	case moderationverdict.FieldReviewNote:
+		m.ClearReviewNote()
+		return nil
+	case moderationverdict.FieldReviewedAt:
+		m.ClearReviewedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationVerdict nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *IPBlackListMutation) ResetField(name string) error {
+func (m *ModerationVerdictMutation) ResetField(name string) error {
 	switch name {
-	case ipblacklist.FieldIP:
-		m.ResetIP()
+	case moderationverdict.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case ipblacklist.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case moderationverdict.FieldGenerationID:
+		m.ResetGenerationID()
 		return nil
-	case ipblacklist.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case moderationverdict.FieldSourceType:
+		m.ResetSourceType()
+		return nil
+	case moderationverdict.FieldTier:
+		m.ResetTier()
+		return nil
+	case moderationverdict.FieldPolicy:
+		m.ResetPolicy()
+		return nil
+	case moderationverdict.FieldPrompt:
+		m.ResetPrompt()
+		return nil
+	case moderationverdict.FieldTranslatedPrompt:
+		m.ResetTranslatedPrompt()
+		return nil
+	case moderationverdict.FieldAction:
+		m.ResetAction()
+		return nil
+	case moderationverdict.FieldReason:
+		m.ResetReason()
+		return nil
+	case moderationverdict.FieldFindings:
+		m.ResetFindings()
+		return nil
+	case moderationverdict.FieldErrors:
+		m.ResetErrors()
+		return nil
+	case moderationverdict.FieldReviewStatus:
+		m.ResetReviewStatus()
+		return nil
+	case moderationverdict.FieldReviewedBy:
+		m.ResetReviewedBy()
+		return nil
+	case moderationverdict.FieldReviewNote:
+		m.ResetReviewNote()
+		return nil
+	case moderationverdict.FieldReviewedAt:
+		m.ResetReviewedAt()
+		return nil
+	case moderationverdict.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown IPBlackList field %s", name)
+	return fmt.Errorf("unknown ModerationVerdict field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *IPBlackListMutation) AddedEdges() []string {
+func (m *ModerationVerdictMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *IPBlackListMutation) AddedIDs(name string) []ent.Value {
+func (m *ModerationVerdictMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *IPBlackListMutation) RemovedEdges() []string {
+func (m *ModerationVerdictMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *IPBlackListMutation) RemovedIDs(name string) []ent.Value {
+func (m *ModerationVerdictMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *IPBlackListMutation) ClearedEdges() []string {
+func (m *ModerationVerdictMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *IPBlackListMutation) EdgeCleared(name string) bool {
+func (m *ModerationVerdictMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *IPBlackListMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown IPBlackList unique edge %s", name)
+func (m *ModerationVerdictMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ModerationVerdict unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *IPBlackListMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown IPBlackList edge %s", name)
+func (m *ModerationVerdictMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ModerationVerdict edge %s", name)
 }
 
 // MqLogMutation represents an operation that mutates the MqLog nodes in the graph.
@@ -12431,6 +21586,7 @@ type MqLogMutation struct {
 	priority      *int
 	addpriority   *int
 	is_processing *bool
+	cancelled_at  *time.Time
 	created_at    *time.Time
 	updated_at    *time.Time
 	clearedFields map[string]struct{}
@@ -12671,6 +21827,55 @@ func (m *MqLogMutation) ResetIsProcessing() {
 	m.is_processing = nil
 }
 
+// SetCancelledAt sets the "cancelled_at" field.
+func (m *MqLogMutation) SetCancelledAt(t time.Time) {
+	m.cancelled_at = &t
+}
+
+// CancelledAt returns the value of the "cancelled_at" field in the mutation.
+func (m *MqLogMutation) CancelledAt() (r time.Time, exists bool) {
+	v := m.cancelled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelledAt returns the old "cancelled_at" field's value of the MqLog entity.
+// If the MqLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MqLogMutation) OldCancelledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelledAt: %w", err)
+	}
+	return oldValue.CancelledAt, nil
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (m *MqLogMutation) ClearCancelledAt() {
+	m.cancelled_at = nil
+	m.clearedFields[mqlog.FieldCancelledAt] = struct{}{}
+}
+
+// CancelledAtCleared returns if the "cancelled_at" field was cleared in this mutation.
+func (m *MqLogMutation) CancelledAtCleared() bool {
+	_, ok := m.clearedFields[mqlog.FieldCancelledAt]
+	return ok
+}
+
+// ResetCancelledAt resets all changes to the "cancelled_at" field.
+func (m *MqLogMutation) ResetCancelledAt() {
+	m.cancelled_at = nil
+	delete(m.clearedFields, mqlog.FieldCancelledAt)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *MqLogMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -12777,7 +21982,7 @@ func (m *MqLogMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *MqLogMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 6)
 	if m.message_id != nil {
 		fields = append(fields, mqlog.FieldMessageID)
 	}
@@ -12787,6 +21992,9 @@ func (m *MqLogMutation) Fields() []string {
 	if m.is_processing != nil {
 		fields = append(fields, mqlog.FieldIsProcessing)
 	}
+	if m.cancelled_at != nil {
+		fields = append(fields, mqlog.FieldCancelledAt)
+	}
 	if m.created_at != nil {
 		fields = append(fields, mqlog.FieldCreatedAt)
 	}
@@ -12807,6 +22015,8 @@ func (m *MqLogMutation) Field(name string) (ent.Value, bool) {
 		return m.Priority()
 	case mqlog.FieldIsProcessing:
 		return m.IsProcessing()
+	case mqlog.FieldCancelledAt:
+		return m.CancelledAt()
 	case mqlog.FieldCreatedAt:
 		return m.CreatedAt()
 	case mqlog.FieldUpdatedAt:
@@ -12826,6 +22036,8 @@ func (m *MqLogMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldPriority(ctx)
 	case mqlog.FieldIsProcessing:
 		return m.OldIsProcessing(ctx)
+	case mqlog.FieldCancelledAt:
+		return m.OldCancelledAt(ctx)
 	case mqlog.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case mqlog.FieldUpdatedAt:
@@ -12860,6 +22072,13 @@ func (m *MqLogMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetIsProcessing(v)
 		return nil
+	case mqlog.FieldCancelledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelledAt(v)
+		return nil
 	case mqlog.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -12918,7 +22137,11 @@ func (m *MqLogMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *MqLogMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(mqlog.FieldCancelledAt) {
+		fields = append(fields, mqlog.FieldCancelledAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -12931,6 +22154,11 @@ func (m *MqLogMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *MqLogMutation) ClearField(name string) error {
+	switch name {
+	case mqlog.FieldCancelledAt:
+		m.ClearCancelledAt()
+		return nil
+	}
 	return fmt.Errorf("unknown MqLog nullable field %s", name)
 }
 
@@ -12947,6 +22175,9 @@ func (m *MqLogMutation) ResetField(name string) error {
 	case mqlog.FieldIsProcessing:
 		m.ResetIsProcessing()
 		return nil
+	case mqlog.FieldCancelledAt:
+		m.ResetCancelledAt()
+		return nil
 	case mqlog.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -17024,6 +26255,7 @@ type UpscaleMutation struct {
 	system_generated       *bool
 	source_type            *enttypes.SourceType
 	webhook_token          *uuid.UUID
+	requeued_at            *time.Time
 	started_at             *time.Time
 	completed_at           *time.Time
 	created_at             *time.Time
@@ -17765,6 +26997,55 @@ func (m *UpscaleMutation) ResetAPITokenID() {
 	delete(m.clearedFields, upscale.FieldAPITokenID)
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (m *UpscaleMutation) SetRequeuedAt(t time.Time) {
+	m.requeued_at = &t
+}
+
+// RequeuedAt returns the value of the "requeued_at" field in the mutation.
+func (m *UpscaleMutation) RequeuedAt() (r time.Time, exists bool) {
+	v := m.requeued_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequeuedAt returns the old "requeued_at" field's value of the Upscale entity.
+// If the Upscale object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UpscaleMutation) OldRequeuedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequeuedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequeuedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequeuedAt: %w", err)
+	}
+	return oldValue.RequeuedAt, nil
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (m *UpscaleMutation) ClearRequeuedAt() {
+	m.requeued_at = nil
+	m.clearedFields[upscale.FieldRequeuedAt] = struct{}{}
+}
+
+// RequeuedAtCleared returns if the "requeued_at" field was cleared in this mutation.
+func (m *UpscaleMutation) RequeuedAtCleared() bool {
+	_, ok := m.clearedFields[upscale.FieldRequeuedAt]
+	return ok
+}
+
+// ResetRequeuedAt resets all changes to the "requeued_at" field.
+func (m *UpscaleMutation) ResetRequeuedAt() {
+	m.requeued_at = nil
+	delete(m.clearedFields, upscale.FieldRequeuedAt)
+}
+
 // SetStartedAt sets the "started_at" field.
 func (m *UpscaleMutation) SetStartedAt(t time.Time) {
 	m.started_at = &t
@@ -18157,7 +27438,7 @@ func (m *UpscaleMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UpscaleMutation) Fields() []string {
-	fields := make([]string, 0, 18)
+	fields := make([]string, 0, 19)
 	if m.width != nil {
 		fields = append(fields, upscale.FieldWidth)
 	}
@@ -18200,6 +27481,9 @@ func (m *UpscaleMutation) Fields() []string {
 	if m.api_tokens != nil {
 		fields = append(fields, upscale.FieldAPITokenID)
 	}
+	if m.requeued_at != nil {
+		fields = append(fields, upscale.FieldRequeuedAt)
+	}
 	if m.started_at != nil {
 		fields = append(fields, upscale.FieldStartedAt)
 	}
@@ -18248,6 +27532,8 @@ func (m *UpscaleMutation) Field(name string) (ent.Value, bool) {
 		return m.ModelID()
 	case upscale.FieldAPITokenID:
 		return m.APITokenID()
+	case upscale.FieldRequeuedAt:
+		return m.RequeuedAt()
 	case upscale.FieldStartedAt:
 		return m.StartedAt()
 	case upscale.FieldCompletedAt:
@@ -18293,6 +27579,8 @@ func (m *UpscaleMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldModelID(ctx)
 	case upscale.FieldAPITokenID:
 		return m.OldAPITokenID(ctx)
+	case upscale.FieldRequeuedAt:
+		return m.OldRequeuedAt(ctx)
 	case upscale.FieldStartedAt:
 		return m.OldStartedAt(ctx)
 	case upscale.FieldCompletedAt:
@@ -18408,6 +27696,13 @@ func (m *UpscaleMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAPITokenID(v)
 		return nil
+	case upscale.FieldRequeuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequeuedAt(v)
+		return nil
 	case upscale.FieldStartedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -18517,6 +27812,9 @@ func (m *UpscaleMutation) ClearedFields() []string {
 	if m.FieldCleared(upscale.FieldAPITokenID) {
 		fields = append(fields, upscale.FieldAPITokenID)
 	}
+	if m.FieldCleared(upscale.FieldRequeuedAt) {
+		fields = append(fields, upscale.FieldRequeuedAt)
+	}
 	if m.FieldCleared(upscale.FieldStartedAt) {
 		fields = append(fields, upscale.FieldStartedAt)
 	}
@@ -18549,6 +27847,9 @@ func (m *UpscaleMutation) ClearField(name string) error {
 	case upscale.FieldAPITokenID:
 		m.ClearAPITokenID()
 		return nil
+	case upscale.FieldRequeuedAt:
+		m.ClearRequeuedAt()
+		return nil
 	case upscale.FieldStartedAt:
 		m.ClearStartedAt()
 		return nil
@@ -18605,6 +27906,9 @@ func (m *UpscaleMutation) ResetField(name string) error {
 	case upscale.FieldAPITokenID:
 		m.ResetAPITokenID()
 		return nil
+	case upscale.FieldRequeuedAt:
+		m.ResetRequeuedAt()
+		return nil
 	case upscale.FieldStartedAt:
 		m.ResetStartedAt()
 		return nil
@@ -23275,6 +32579,7 @@ type VoiceoverMutation struct {
 	cost                      *int32
 	addcost                   *int32
 	source_type               *enttypes.SourceType
+	requeued_at               *time.Time
 	started_at                *time.Time
 	completed_at              *time.Time
 	created_at                *time.Time
@@ -24141,6 +33446,55 @@ func (m *VoiceoverMutation) ResetAPITokenID() {
 	delete(m.clearedFields, voiceover.FieldAPITokenID)
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (m *VoiceoverMutation) SetRequeuedAt(t time.Time) {
+	m.requeued_at = &t
+}
+
+// RequeuedAt returns the value of the "requeued_at" field in the mutation.
+func (m *VoiceoverMutation) RequeuedAt() (r time.Time, exists bool) {
+	v := m.requeued_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequeuedAt returns the old "requeued_at" field's value of the Voiceover entity.
+// If the Voiceover object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceoverMutation) OldRequeuedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequeuedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequeuedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequeuedAt: %w", err)
+	}
+	return oldValue.RequeuedAt, nil
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (m *VoiceoverMutation) ClearRequeuedAt() {
+	m.requeued_at = nil
+	m.clearedFields[voiceover.FieldRequeuedAt] = struct{}{}
+}
+
+// RequeuedAtCleared returns if the "requeued_at" field was cleared in this mutation.
+func (m *VoiceoverMutation) RequeuedAtCleared() bool {
+	_, ok := m.clearedFields[voiceover.FieldRequeuedAt]
+	return ok
+}
+
+// ResetRequeuedAt resets all changes to the "requeued_at" field.
+func (m *VoiceoverMutation) ResetRequeuedAt() {
+	m.requeued_at = nil
+	delete(m.clearedFields, voiceover.FieldRequeuedAt)
+}
+
 // SetStartedAt sets the "started_at" field.
 func (m *VoiceoverMutation) SetStartedAt(t time.Time) {
 	m.started_at = &t
@@ -24600,7 +33954,7 @@ func (m *VoiceoverMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *VoiceoverMutation) Fields() []string {
-	fields := make([]string, 0, 21)
+	fields := make([]string, 0, 22)
 	if m.country_code != nil {
 		fields = append(fields, voiceover.FieldCountryCode)
 	}
@@ -24652,6 +34006,9 @@ func (m *VoiceoverMutation) Fields() []string {
 	if m.api_tokens != nil {
 		fields = append(fields, voiceover.FieldAPITokenID)
 	}
+	if m.requeued_at != nil {
+		fields = append(fields, voiceover.FieldRequeuedAt)
+	}
 	if m.started_at != nil {
 		fields = append(fields, voiceover.FieldStartedAt)
 	}
@@ -24706,6 +34063,8 @@ func (m *VoiceoverMutation) Field(name string) (ent.Value, bool) {
 		return m.SpeakerID()
 	case voiceover.FieldAPITokenID:
 		return m.APITokenID()
+	case voiceover.FieldRequeuedAt:
+		return m.RequeuedAt()
 	case voiceover.FieldStartedAt:
 		return m.StartedAt()
 	case voiceover.FieldCompletedAt:
@@ -24757,6 +34116,8 @@ func (m *VoiceoverMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldSpeakerID(ctx)
 	case voiceover.FieldAPITokenID:
 		return m.OldAPITokenID(ctx)
+	case voiceover.FieldRequeuedAt:
+		return m.OldRequeuedAt(ctx)
 	case voiceover.FieldStartedAt:
 		return m.OldStartedAt(ctx)
 	case voiceover.FieldCompletedAt:
@@ -24893,6 +34254,13 @@ func (m *VoiceoverMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAPITokenID(v)
 		return nil
+	case voiceover.FieldRequeuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequeuedAt(v)
+		return nil
 	case voiceover.FieldStartedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -25005,6 +34373,9 @@ func (m *VoiceoverMutation) ClearedFields() []string {
 	if m.FieldCleared(voiceover.FieldAPITokenID) {
 		fields = append(fields, voiceover.FieldAPITokenID)
 	}
+	if m.FieldCleared(voiceover.FieldRequeuedAt) {
+		fields = append(fields, voiceover.FieldRequeuedAt)
+	}
 	if m.FieldCleared(voiceover.FieldStartedAt) {
 		fields = append(fields, voiceover.FieldStartedAt)
 	}
@@ -25040,6 +34411,9 @@ func (m *VoiceoverMutation) ClearField(name string) error {
 	case voiceover.FieldAPITokenID:
 		m.ClearAPITokenID()
 		return nil
+	case voiceover.FieldRequeuedAt:
+		m.ClearRequeuedAt()
+		return nil
 	case voiceover.FieldStartedAt:
 		m.ClearStartedAt()
 		return nil
@@ -25105,6 +34479,9 @@ func (m *VoiceoverMutation) ResetField(name string) error {
 	case voiceover.FieldAPITokenID:
 		m.ResetAPITokenID()
 		return nil
+	case voiceover.FieldRequeuedAt:
+		m.ResetRequeuedAt()
+		return nil
 	case voiceover.FieldStartedAt:
 		m.ResetStartedAt()
 		return nil
@@ -9,6 +9,9 @@ import (
 // ApiToken is the predicate function for apitoken builders.
 type ApiToken func(*sql.Selector)
 
+// ApiTokenUsage is the predicate function for apitokenusage builders.
+type ApiTokenUsage func(*sql.Selector)
+
 // AuthClient is the predicate function for authclient builders.
 type AuthClient func(*sql.Selector)
 
@@ -18,9 +21,21 @@ type BannedWords func(*sql.Selector)
 // Credit is the predicate function for credit builders.
 type Credit func(*sql.Selector)
 
+// CreditHold is the predicate function for credithold builders.
+type CreditHold func(*sql.Selector)
+
+// CreditTransaction is the predicate function for credittransaction builders.
+type CreditTransaction func(*sql.Selector)
+
 // CreditType is the predicate function for credittype builders.
 type CreditType func(*sql.Selector)
 
+// CronJobRun is the predicate function for cronjobrun builders.
+type CronJobRun func(*sql.Selector)
+
+// DeadLetter is the predicate function for deadletter builders.
+type DeadLetter func(*sql.Selector)
+
 // DeviceInfo is the predicate function for deviceinfo builders.
 type DeviceInfo func(*sql.Selector)
 
@@ -30,6 +45,9 @@ type DisposableEmail func(*sql.Selector)
 // Generation is the predicate function for generation builders.
 type Generation func(*sql.Selector)
 
+// GenerationBatch is the predicate function for generationbatch builders.
+type GenerationBatch func(*sql.Selector)
+
 // GenerationModel is the predicate function for generationmodel builders.
 type GenerationModel func(*sql.Selector)
 
@@ -39,9 +57,15 @@ type GenerationOutput func(*sql.Selector)
 // GenerationOutputLike is the predicate function for generationoutputlike builders.
 type GenerationOutputLike func(*sql.Selector)
 
+// GenerationPreset is the predicate function for generationpreset builders.
+type GenerationPreset func(*sql.Selector)
+
 // IPBlackList is the predicate function for ipblacklist builders.
 type IPBlackList func(*sql.Selector)
 
+// ModerationVerdict is the predicate function for moderationverdict builders.
+type ModerationVerdict func(*sql.Selector)
+
 // MqLog is the predicate function for mqlog builders.
 type MqLog func(*sql.Selector)
 
@@ -7,17 +7,25 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database/ent/apitoken"
+	"github.com/stablecog/sc-go/database/ent/apitokenusage"
 	"github.com/stablecog/sc-go/database/ent/authclient"
 	"github.com/stablecog/sc-go/database/ent/bannedwords"
 	"github.com/stablecog/sc-go/database/ent/credit"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/credittransaction"
 	"github.com/stablecog/sc-go/database/ent/credittype"
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
+	"github.com/stablecog/sc-go/database/ent/deadletter"
 	"github.com/stablecog/sc-go/database/ent/deviceinfo"
 	"github.com/stablecog/sc-go/database/ent/disposableemail"
 	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
 	"github.com/stablecog/sc-go/database/ent/generationmodel"
 	"github.com/stablecog/sc-go/database/ent/generationoutput"
 	"github.com/stablecog/sc-go/database/ent/generationoutputlike"
+	"github.com/stablecog/sc-go/database/ent/generationpreset"
 	"github.com/stablecog/sc-go/database/ent/ipblacklist"
+	"github.com/stablecog/sc-go/database/ent/moderationverdict"
 	"github.com/stablecog/sc-go/database/ent/mqlog"
 	"github.com/stablecog/sc-go/database/ent/negativeprompt"
 	"github.com/stablecog/sc-go/database/ent/prompt"
@@ -55,12 +63,16 @@ func init() {
 	apitokenDescCreditsSpent := apitokenFields[6].Descriptor()
 	// apitoken.DefaultCreditsSpent holds the default value on creation for the credits_spent field.
 	apitoken.DefaultCreditsSpent = apitokenDescCreditsSpent.Default.(int)
+	// apitokenDescMonthlyCreditsSpent is the schema descriptor for monthly_credits_spent field.
+	apitokenDescMonthlyCreditsSpent := apitokenFields[10].Descriptor()
+	// apitoken.DefaultMonthlyCreditsSpent holds the default value on creation for the monthly_credits_spent field.
+	apitoken.DefaultMonthlyCreditsSpent = apitokenDescMonthlyCreditsSpent.Default.(int)
 	// apitokenDescCreatedAt is the schema descriptor for created_at field.
-	apitokenDescCreatedAt := apitokenFields[10].Descriptor()
+	apitokenDescCreatedAt := apitokenFields[16].Descriptor()
 	// apitoken.DefaultCreatedAt holds the default value on creation for the created_at field.
 	apitoken.DefaultCreatedAt = apitokenDescCreatedAt.Default.(func() time.Time)
 	// apitokenDescUpdatedAt is the schema descriptor for updated_at field.
-	apitokenDescUpdatedAt := apitokenFields[11].Descriptor()
+	apitokenDescUpdatedAt := apitokenFields[17].Descriptor()
 	// apitoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	apitoken.DefaultUpdatedAt = apitokenDescUpdatedAt.Default.(func() time.Time)
 	// apitoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -69,6 +81,20 @@ func init() {
 	apitokenDescID := apitokenFields[0].Descriptor()
 	// apitoken.DefaultID holds the default value on creation for the id field.
 	apitoken.DefaultID = apitokenDescID.Default.(func() uuid.UUID)
+	apitokenusageFields := schema.ApiTokenUsage{}.Fields()
+	_ = apitokenusageFields
+	// apitokenusageDescCreditsSpent is the schema descriptor for credits_spent field.
+	apitokenusageDescCreditsSpent := apitokenusageFields[4].Descriptor()
+	// apitokenusage.DefaultCreditsSpent holds the default value on creation for the credits_spent field.
+	apitokenusage.DefaultCreditsSpent = apitokenusageDescCreditsSpent.Default.(int)
+	// apitokenusageDescCreatedAt is the schema descriptor for created_at field.
+	apitokenusageDescCreatedAt := apitokenusageFields[5].Descriptor()
+	// apitokenusage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apitokenusage.DefaultCreatedAt = apitokenusageDescCreatedAt.Default.(func() time.Time)
+	// apitokenusageDescID is the schema descriptor for id field.
+	apitokenusageDescID := apitokenusageFields[0].Descriptor()
+	// apitokenusage.DefaultID holds the default value on creation for the id field.
+	apitokenusage.DefaultID = apitokenusageDescID.Default.(func() uuid.UUID)
 	authclientFields := schema.AuthClient{}.Fields()
 	_ = authclientFields
 	// authclientDescCreatedAt is the schema descriptor for created_at field.
@@ -133,6 +159,32 @@ func init() {
 	creditDescID := creditFields[0].Descriptor()
 	// credit.DefaultID holds the default value on creation for the id field.
 	credit.DefaultID = creditDescID.Default.(func() uuid.UUID)
+	creditholdFields := schema.CreditHold{}.Fields()
+	_ = creditholdFields
+	// creditholdDescCreatedAt is the schema descriptor for created_at field.
+	creditholdDescCreatedAt := creditholdFields[13].Descriptor()
+	// credithold.DefaultCreatedAt holds the default value on creation for the created_at field.
+	credithold.DefaultCreatedAt = creditholdDescCreatedAt.Default.(func() time.Time)
+	// creditholdDescUpdatedAt is the schema descriptor for updated_at field.
+	creditholdDescUpdatedAt := creditholdFields[14].Descriptor()
+	// credithold.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	credithold.DefaultUpdatedAt = creditholdDescUpdatedAt.Default.(func() time.Time)
+	// credithold.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	credithold.UpdateDefaultUpdatedAt = creditholdDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// creditholdDescID is the schema descriptor for id field.
+	creditholdDescID := creditholdFields[0].Descriptor()
+	// credithold.DefaultID holds the default value on creation for the id field.
+	credithold.DefaultID = creditholdDescID.Default.(func() uuid.UUID)
+	credittransactionFields := schema.CreditTransaction{}.Fields()
+	_ = credittransactionFields
+	// credittransactionDescCreatedAt is the schema descriptor for created_at field.
+	credittransactionDescCreatedAt := credittransactionFields[8].Descriptor()
+	// credittransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	credittransaction.DefaultCreatedAt = credittransactionDescCreatedAt.Default.(func() time.Time)
+	// credittransactionDescID is the schema descriptor for id field.
+	credittransactionDescID := credittransactionFields[0].Descriptor()
+	// credittransaction.DefaultID holds the default value on creation for the id field.
+	credittransaction.DefaultID = credittransactionDescID.Default.(func() uuid.UUID)
 	credittypeFields := schema.CreditType{}.Fields()
 	_ = credittypeFields
 	// credittypeDescAnnual is the schema descriptor for annual field.
@@ -153,6 +205,32 @@ func init() {
 	credittypeDescID := credittypeFields[0].Descriptor()
 	// credittype.DefaultID holds the default value on creation for the id field.
 	credittype.DefaultID = credittypeDescID.Default.(func() uuid.UUID)
+	cronjobrunFields := schema.CronJobRun{}.Fields()
+	_ = cronjobrunFields
+	// cronjobrunDescStartedAt is the schema descriptor for started_at field.
+	cronjobrunDescStartedAt := cronjobrunFields[8].Descriptor()
+	// cronjobrun.DefaultStartedAt holds the default value on creation for the started_at field.
+	cronjobrun.DefaultStartedAt = cronjobrunDescStartedAt.Default.(func() time.Time)
+	// cronjobrunDescID is the schema descriptor for id field.
+	cronjobrunDescID := cronjobrunFields[0].Descriptor()
+	// cronjobrun.DefaultID holds the default value on creation for the id field.
+	cronjobrun.DefaultID = cronjobrunDescID.Default.(func() uuid.UUID)
+	deadletterFields := schema.DeadLetter{}.Fields()
+	_ = deadletterFields
+	// deadletterDescCreatedAt is the schema descriptor for created_at field.
+	deadletterDescCreatedAt := deadletterFields[7].Descriptor()
+	// deadletter.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deadletter.DefaultCreatedAt = deadletterDescCreatedAt.Default.(func() time.Time)
+	// deadletterDescUpdatedAt is the schema descriptor for updated_at field.
+	deadletterDescUpdatedAt := deadletterFields[8].Descriptor()
+	// deadletter.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deadletter.DefaultUpdatedAt = deadletterDescUpdatedAt.Default.(func() time.Time)
+	// deadletter.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deadletter.UpdateDefaultUpdatedAt = deadletterDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// deadletterDescID is the schema descriptor for id field.
+	deadletterDescID := deadletterFields[0].Descriptor()
+	// deadletter.DefaultID holds the default value on creation for the id field.
+	deadletter.DefaultID = deadletterDescID.Default.(func() uuid.UUID)
 	deviceinfoFields := schema.DeviceInfo{}.Fields()
 	_ = deviceinfoFields
 	// deviceinfoDescCreatedAt is the schema descriptor for created_at field.
@@ -200,11 +278,11 @@ func init() {
 	// generation.DefaultWebhookToken holds the default value on creation for the webhook_token field.
 	generation.DefaultWebhookToken = generationDescWebhookToken.Default.(func() uuid.UUID)
 	// generationDescCreatedAt is the schema descriptor for created_at field.
-	generationDescCreatedAt := generationFields[27].Descriptor()
+	generationDescCreatedAt := generationFields[30].Descriptor()
 	// generation.DefaultCreatedAt holds the default value on creation for the created_at field.
 	generation.DefaultCreatedAt = generationDescCreatedAt.Default.(func() time.Time)
 	// generationDescUpdatedAt is the schema descriptor for updated_at field.
-	generationDescUpdatedAt := generationFields[28].Descriptor()
+	generationDescUpdatedAt := generationFields[31].Descriptor()
 	// generation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	generation.DefaultUpdatedAt = generationDescUpdatedAt.Default.(func() time.Time)
 	// generation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -213,6 +291,42 @@ func init() {
 	generationDescID := generationFields[0].Descriptor()
 	// generation.DefaultID holds the default value on creation for the id field.
 	generation.DefaultID = generationDescID.Default.(func() uuid.UUID)
+	generationbatchFields := schema.GenerationBatch{}.Fields()
+	_ = generationbatchFields
+	// generationbatchDescDispatchedItems is the schema descriptor for dispatched_items field.
+	generationbatchDescDispatchedItems := generationbatchFields[6].Descriptor()
+	// generationbatch.DefaultDispatchedItems holds the default value on creation for the dispatched_items field.
+	generationbatch.DefaultDispatchedItems = generationbatchDescDispatchedItems.Default.(int)
+	// generationbatchDescRejectedItems is the schema descriptor for rejected_items field.
+	generationbatchDescRejectedItems := generationbatchFields[7].Descriptor()
+	// generationbatch.DefaultRejectedItems holds the default value on creation for the rejected_items field.
+	generationbatch.DefaultRejectedItems = generationbatchDescRejectedItems.Default.(int)
+	// generationbatchDescCreditsRefunded is the schema descriptor for credits_refunded field.
+	generationbatchDescCreditsRefunded := generationbatchFields[9].Descriptor()
+	// generationbatch.DefaultCreditsRefunded holds the default value on creation for the credits_refunded field.
+	generationbatch.DefaultCreditsRefunded = generationbatchDescCreditsRefunded.Default.(int32)
+	// generationbatchDescFree is the schema descriptor for free field.
+	generationbatchDescFree := generationbatchFields[10].Descriptor()
+	// generationbatch.DefaultFree holds the default value on creation for the free field.
+	generationbatch.DefaultFree = generationbatchDescFree.Default.(bool)
+	// generationbatchDescQueueTier is the schema descriptor for queue_tier field.
+	generationbatchDescQueueTier := generationbatchFields[11].Descriptor()
+	// generationbatch.DefaultQueueTier holds the default value on creation for the queue_tier field.
+	generationbatch.DefaultQueueTier = generationbatchDescQueueTier.Default.(string)
+	// generationbatchDescCreatedAt is the schema descriptor for created_at field.
+	generationbatchDescCreatedAt := generationbatchFields[16].Descriptor()
+	// generationbatch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	generationbatch.DefaultCreatedAt = generationbatchDescCreatedAt.Default.(func() time.Time)
+	// generationbatchDescUpdatedAt is the schema descriptor for updated_at field.
+	generationbatchDescUpdatedAt := generationbatchFields[17].Descriptor()
+	// generationbatch.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	generationbatch.DefaultUpdatedAt = generationbatchDescUpdatedAt.Default.(func() time.Time)
+	// generationbatch.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	generationbatch.UpdateDefaultUpdatedAt = generationbatchDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// generationbatchDescID is the schema descriptor for id field.
+	generationbatchDescID := generationbatchFields[0].Descriptor()
+	// generationbatch.DefaultID holds the default value on creation for the id field.
+	generationbatch.DefaultID = generationbatchDescID.Default.(func() uuid.UUID)
 	generationmodelFields := schema.GenerationModel{}.Fields()
 	_ = generationmodelFields
 	// generationmodelDescIsActive is the schema descriptor for is_active field.
@@ -327,6 +441,22 @@ func init() {
 	generationoutputlikeDescID := generationoutputlikeFields[0].Descriptor()
 	// generationoutputlike.DefaultID holds the default value on creation for the id field.
 	generationoutputlike.DefaultID = generationoutputlikeDescID.Default.(func() uuid.UUID)
+	generationpresetFields := schema.GenerationPreset{}.Fields()
+	_ = generationpresetFields
+	// generationpresetDescCreatedAt is the schema descriptor for created_at field.
+	generationpresetDescCreatedAt := generationpresetFields[13].Descriptor()
+	// generationpreset.DefaultCreatedAt holds the default value on creation for the created_at field.
+	generationpreset.DefaultCreatedAt = generationpresetDescCreatedAt.Default.(func() time.Time)
+	// generationpresetDescUpdatedAt is the schema descriptor for updated_at field.
+	generationpresetDescUpdatedAt := generationpresetFields[14].Descriptor()
+	// generationpreset.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	generationpreset.DefaultUpdatedAt = generationpresetDescUpdatedAt.Default.(func() time.Time)
+	// generationpreset.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	generationpreset.UpdateDefaultUpdatedAt = generationpresetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// generationpresetDescID is the schema descriptor for id field.
+	generationpresetDescID := generationpresetFields[0].Descriptor()
+	// generationpreset.DefaultID holds the default value on creation for the id field.
+	generationpreset.DefaultID = generationpresetDescID.Default.(func() uuid.UUID)
 	ipblacklistFields := schema.IPBlackList{}.Fields()
 	_ = ipblacklistFields
 	// ipblacklistDescCreatedAt is the schema descriptor for created_at field.
@@ -343,6 +473,16 @@ func init() {
 	ipblacklistDescID := ipblacklistFields[0].Descriptor()
 	// ipblacklist.DefaultID holds the default value on creation for the id field.
 	ipblacklist.DefaultID = ipblacklistDescID.Default.(func() uuid.UUID)
+	moderationverdictFields := schema.ModerationVerdict{}.Fields()
+	_ = moderationverdictFields
+	// moderationverdictDescCreatedAt is the schema descriptor for created_at field.
+	moderationverdictDescCreatedAt := moderationverdictFields[16].Descriptor()
+	// moderationverdict.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moderationverdict.DefaultCreatedAt = moderationverdictDescCreatedAt.Default.(func() time.Time)
+	// moderationverdictDescID is the schema descriptor for id field.
+	moderationverdictDescID := moderationverdictFields[0].Descriptor()
+	// moderationverdict.DefaultID holds the default value on creation for the id field.
+	moderationverdict.DefaultID = moderationverdictDescID.Default.(func() uuid.UUID)
 	mqlogFields := schema.MqLog{}.Fields()
 	_ = mqlogFields
 	// mqlogDescIsProcessing is the schema descriptor for is_processing field.
@@ -350,11 +490,11 @@ func init() {
 	// mqlog.DefaultIsProcessing holds the default value on creation for the is_processing field.
 	mqlog.DefaultIsProcessing = mqlogDescIsProcessing.Default.(bool)
 	// mqlogDescCreatedAt is the schema descriptor for created_at field.
-	mqlogDescCreatedAt := mqlogFields[4].Descriptor()
+	mqlogDescCreatedAt := mqlogFields[5].Descriptor()
 	// mqlog.DefaultCreatedAt holds the default value on creation for the created_at field.
 	mqlog.DefaultCreatedAt = mqlogDescCreatedAt.Default.(func() time.Time)
 	// mqlogDescUpdatedAt is the schema descriptor for updated_at field.
-	mqlogDescUpdatedAt := mqlogFields[5].Descriptor()
+	mqlogDescUpdatedAt := mqlogFields[6].Descriptor()
 	// mqlog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	mqlog.DefaultUpdatedAt = mqlogDescUpdatedAt.Default.(func() time.Time)
 	// mqlog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -490,11 +630,11 @@ func init() {
 	// upscale.DefaultWebhookToken holds the default value on creation for the webhook_token field.
 	upscale.DefaultWebhookToken = upscaleDescWebhookToken.Default.(func() uuid.UUID)
 	// upscaleDescCreatedAt is the schema descriptor for created_at field.
-	upscaleDescCreatedAt := upscaleFields[17].Descriptor()
+	upscaleDescCreatedAt := upscaleFields[18].Descriptor()
 	// upscale.DefaultCreatedAt holds the default value on creation for the created_at field.
 	upscale.DefaultCreatedAt = upscaleDescCreatedAt.Default.(func() time.Time)
 	// upscaleDescUpdatedAt is the schema descriptor for updated_at field.
-	upscaleDescUpdatedAt := upscaleFields[18].Descriptor()
+	upscaleDescUpdatedAt := upscaleFields[19].Descriptor()
 	// upscale.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	upscale.DefaultUpdatedAt = upscaleDescUpdatedAt.Default.(func() time.Time)
 	// upscale.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -602,11 +742,11 @@ func init() {
 	// voiceover.DefaultRemoveSilence holds the default value on creation for the remove_silence field.
 	voiceover.DefaultRemoveSilence = voiceoverDescRemoveSilence.Default.(bool)
 	// voiceoverDescCreatedAt is the schema descriptor for created_at field.
-	voiceoverDescCreatedAt := voiceoverFields[20].Descriptor()
+	voiceoverDescCreatedAt := voiceoverFields[21].Descriptor()
 	// voiceover.DefaultCreatedAt holds the default value on creation for the created_at field.
 	voiceover.DefaultCreatedAt = voiceoverDescCreatedAt.Default.(func() time.Time)
 	// voiceoverDescUpdatedAt is the schema descriptor for updated_at field.
-	voiceoverDescUpdatedAt := voiceoverFields[21].Descriptor()
+	voiceoverDescUpdatedAt := voiceoverFields[22].Descriptor()
 	// voiceover.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	voiceover.DefaultUpdatedAt = voiceoverDescUpdatedAt.Default.(func() time.Time)
 	// voiceover.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -26,6 +26,16 @@ func (ApiToken) Fields() []ent.Field {
 		field.Bool("is_active").Default(true),
 		field.Int("uses").Default(0),
 		field.Int("credits_spent").Default(0),
+		// Nil for tokens created before scopes, they can do everything
+		field.Strings("scopes").Optional(),
+		field.Time("expires_at").Optional().Nillable(),
+		// Credits the token may spend per calendar month (UTC)
+		field.Int("monthly_credit_cap").Optional().Nillable(),
+		// Credits spent in the month starting at monthly_credits_period
+		field.Int("monthly_credits_spent").Default(0),
+		field.Time("monthly_credits_period").Optional().Nillable(),
+		// Token this one replaced through rotation
+		field.UUID("rotated_from_id", uuid.UUID{}).Optional().Nillable(),
 		// ! Relationships
 		field.UUID("user_id", uuid.UUID{}),
 		field.UUID("auth_client_id", uuid.UUID{}).Optional().Nillable(),
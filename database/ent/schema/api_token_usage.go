@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ApiTokenUsage holds the schema definition for the ApiTokenUsage entity.
+// One row per request authenticated with an API token
+type ApiTokenUsage struct {
+	ent.Schema
+}
+
+// Fields of the ApiTokenUsage.
+func (ApiTokenUsage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("api_token_id", uuid.UUID{}).Immutable(),
+		field.Text("ip").Immutable(),
+		// Method and path, e.g. POST /v1/image/generation/create
+		field.Text("endpoint").Immutable(),
+		// Added to once the jobs created by the request succeed
+		field.Int("credits_spent").Default(0),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ApiTokenUsage.
+func (ApiTokenUsage) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ApiTokenUsage.
+func (ApiTokenUsage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("api_token_id", "created_at"),
+		// For pruning
+		index.Fields("created_at"),
+	}
+}
+
+// Annotations of the ApiTokenUsage.
+func (ApiTokenUsage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "api_token_usages"},
+	}
+}
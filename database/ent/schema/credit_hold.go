@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CreditHold holds the schema definition for the CreditHold entity.
+// Credits taken from a user's balance for a job that hasn't finished yet
+// held -> captured when the job is charged, held -> released when the credits go back to the user
+type CreditHold struct {
+	ent.Schema
+}
+
+// Fields of the CreditHold.
+func (CreditHold) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}),
+		// Credits still held, a split hold goes to 0 as it's moved to its children
+		field.Int32("amount"),
+		// split when the whole amount has been moved to child holds
+		field.Enum("status").Values("held", "captured", "released", "split"),
+		field.Enum("process_type").Values("generate", "upscale", "voiceover", "generation_batch"),
+		// Generation, upscale, voiceover or batch the credits are held for
+		field.UUID("job_id", uuid.UUID{}).Optional().Nillable(),
+		// Hold this one was split from, i.e. the batch a generation belongs to
+		field.UUID("parent_id", uuid.UUID{}).Optional().Nillable(),
+		// API token whose monthly spend the credits are reserved against, and the month they count towards
+		field.UUID("api_token_id", uuid.UUID{}).Optional().Nillable(),
+		field.Time("api_token_period").Optional().Nillable(),
+		field.Text("release_reason").Optional().Nillable(),
+		// Holds still held after this are settled by reconciliation
+		field.Time("expires_at"),
+		field.Time("captured_at").Optional().Nillable(),
+		field.Time("released_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the CreditHold.
+func (CreditHold) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CreditHold.
+func (CreditHold) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_id"),
+		index.Fields("user_id", "status"),
+		index.Fields("status", "expires_at"),
+	}
+}
+
+// Annotations of the CreditHold.
+func (CreditHold) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "credit_holds"},
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CreditTransaction holds the schema definition for the CreditTransaction entity.
+// Append-only ledger of every change to a user's credits, rows are never updated or deleted
+type CreditTransaction struct {
+	ent.Schema
+}
+
+// Fields of the CreditTransaction.
+func (CreditTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		// Credit row that changed, not an edge since credits can be deleted
+		field.UUID("credit_id", uuid.UUID{}).Immutable(),
+		field.UUID("credit_type_id", uuid.UUID{}).Immutable(),
+		field.Enum("type").Values(
+			"subscription",
+			"purchase",
+			"free",
+			"replenish",
+			"admin",
+			"spend",
+			"hold",
+			"release",
+			"refund",
+			"tip_sent",
+			"tip_received",
+			"expire",
+			"revoke",
+		).Immutable(),
+		// Negative when credits were taken from the balance
+		field.Int32("amount").Immutable(),
+		// Credit hold or job the change was for
+		field.UUID("reference_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.Text("stripe_line_item_id").Optional().Nillable().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the CreditTransaction.
+func (CreditTransaction) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CreditTransaction.
+func (CreditTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("reference_id"),
+	}
+}
+
+// Annotations of the CreditTransaction.
+func (CreditTransaction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "credit_transactions"},
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CronJobRun holds the schema definition for the CronJobRun entity.
+// One run of a cron job, by whichever cron instance held its lease
+type CronJobRun struct {
+	ent.Schema
+}
+
+// Fields of the CronJobRun.
+func (CronJobRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Text("job_name"),
+		field.Enum("trigger").Values("schedule", "manual"),
+		// Skipped when a manual run finds the job already running
+		field.Enum("status").Values("running", "succeeded", "failed", "skipped"),
+		// Hostname of the cron instance that ran it
+		field.Text("instance"),
+		// Admin who triggered a manual run
+		field.UUID("triggered_by", uuid.UUID{}).Optional().Nillable(),
+		// What the job reported doing, e.g. users credited
+		field.JSON("counts", map[string]int{}).Optional(),
+		field.Text("error").Optional().Nillable(),
+		field.Time("started_at").Default(time.Now).Immutable(),
+		field.Time("finished_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the CronJobRun.
+func (CronJobRun) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CronJobRun.
+func (CronJobRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_name", "started_at"),
+		index.Fields("started_at"),
+	}
+}
+
+// Annotations of the CronJobRun.
+func (CronJobRun) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "cron_job_runs"},
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DeadLetter holds the schema definition for the DeadLetter entity.
+// Failed worker requests, kept so they can be inspected and replayed
+type DeadLetter struct {
+	ent.Schema
+}
+
+// Fields of the DeadLetter.
+func (DeadLetter) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		// ID of the generation/upscale/voiceover
+		field.UUID("job_id", uuid.UUID{}),
+		field.Text("process_type"),
+		field.UUID("user_id", uuid.UUID{}).Optional().Nillable(),
+		// JSON of the CogQueueRequest that was sent to the worker
+		field.Text("payload"),
+		field.Text("failure_reason"),
+		field.Time("replayed_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DeadLetter.
+func (DeadLetter) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the DeadLetter.
+func (DeadLetter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_id"),
+		index.Fields("created_at"),
+	}
+}
+
+// Annotations of the DeadLetter.
+func (DeadLetter) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "dead_letter_queue"},
+	}
+}
@@ -48,6 +48,11 @@ func (Generation) Fields() []ent.Field {
 		field.UUID("device_info_id", uuid.UUID{}),
 		field.UUID("api_token_id", uuid.UUID{}).Optional().Nillable(),
 		// ! End relationships
+		field.UUID("batch_id", uuid.UUID{}).Optional().Nillable(),
+		// Output this generation is a variation of
+		field.UUID("source_output_id", uuid.UUID{}).Optional().Nillable(),
+		// Set when a failed job is replayed, it counts as queued from then on
+		field.Time("requeued_at").Optional().Nillable(),
 		field.Time("started_at").Optional().Nillable(),
 		field.Time("completed_at").Optional().Nillable(),
 		field.Time("created_at").Default(time.Now).Immutable(),
@@ -123,5 +128,9 @@ func (Generation) Indexes() []ent.Index {
 		index.Fields("negative_prompt_id"),
 		index.Fields("status", "user_id"),
 		index.Fields("prompt_id"),
+		index.Fields("batch_id"),
+		index.Fields("source_output_id"),
+		// For how fast the queue moves
+		index.Fields("started_at"),
 	}
 }
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/enttypes"
+)
+
+// GenerationBatch holds the schema definition for the GenerationBatch entity.
+// A list of generations submitted together, credits for all of them are reserved up front
+type GenerationBatch struct {
+	ent.Schema
+}
+
+// Fields of the GenerationBatch.
+func (GenerationBatch) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}),
+		field.UUID("api_token_id", uuid.UUID{}).Optional().Nillable(),
+		// dispatching while items are still being sent to the queue, failed if dispatching had to give up
+		field.Enum("status").Values("dispatching", "dispatched", "failed"),
+		// JSON of the CreateGenerationRequest items
+		field.Text("items"),
+		field.Int("num_items"),
+		// Items are dispatched in order, this is the index of the next one
+		field.Int("dispatched_items").Default(0),
+		// Items that could not be queued, their credits were refunded
+		field.Int("rejected_items").Default(0),
+		field.Int32("credits_reserved"),
+		field.Int32("credits_refunded").Default(0),
+		// Tier of the user when the batch was created, items are queued with it
+		field.Bool("free").Default(false),
+		field.Text("queue_tier").Default("free"),
+		// Set when the batch is a set of variations of an output
+		field.UUID("source_output_id", uuid.UUID{}).Optional().Nillable(),
+		field.Enum("variation_type").Values("seed", "strength_sweep").Optional().Nillable(),
+		// Headers of the request that created the batch, items are queued with them
+		field.JSON("origin", &enttypes.RequestOrigin{}).Optional(),
+		field.Time("dispatched_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the GenerationBatch.
+func (GenerationBatch) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the GenerationBatch.
+func (GenerationBatch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("status"),
+	}
+}
+
+// Annotations of the GenerationBatch.
+func (GenerationBatch) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "generation_batches"},
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// GenerationPreset holds the schema definition for the GenerationPreset entity.
+// Saved generation settings of a user, unset settings fall back to the request or defaults
+type GenerationPreset struct {
+	ent.Schema
+}
+
+// Fields of the GenerationPreset.
+func (GenerationPreset) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Text("name"),
+		// Prompt with {{variable}} placeholders, filled in from the generation request
+		field.Text("prompt_template").Optional().Nillable(),
+		field.Text("negative_prompt").Optional().Nillable(),
+		field.UUID("model_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("scheduler_id", uuid.UUID{}).Optional().Nillable(),
+		field.Int32("width").Optional().Nillable(),
+		field.Int32("height").Optional().Nillable(),
+		field.Int32("inference_steps").Optional().Nillable(),
+		field.Float32("guidance_scale").Optional().Nillable(),
+		field.Int32("num_outputs").Optional().Nillable(),
+		field.Float32("prompt_strength").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the GenerationPreset.
+func (GenerationPreset) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the GenerationPreset.
+func (GenerationPreset) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "name").Unique(),
+	}
+}
+
+// Annotations of the GenerationPreset.
+func (GenerationPreset) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "generation_presets"},
+	}
+}
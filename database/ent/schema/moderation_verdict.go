@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/enttypes"
+)
+
+// ModerationVerdict holds the schema definition for the ModerationVerdict entity.
+// What moderation decided about a prompt, kept so it can be reviewed on appeal
+type ModerationVerdict struct {
+	ent.Schema
+}
+
+// Fields of the ModerationVerdict.
+func (ModerationVerdict) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}),
+		// Set once the generation of an allowed or flagged prompt is created
+		field.UUID("generation_id", uuid.UUID{}).Optional().Nillable(),
+		field.Enum("source_type").GoType(enttypes.SourceType("")),
+		field.Text("tier"),
+		// Name of the policy that decided
+		field.Text("policy"),
+		field.Text("prompt"),
+		field.Text("translated_prompt").Optional().Nillable(),
+		field.Enum("action").Values("allow", "flag", "block", "ban"),
+		field.Text("reason").Optional().Nillable(),
+		field.JSON("findings", []enttypes.ModerationFinding{}).Optional(),
+		// Checkers that failed
+		field.JSON("errors", []string{}).Optional(),
+		field.Enum("review_status").Values("upheld", "overturned").Optional().Nillable(),
+		field.UUID("reviewed_by", uuid.UUID{}).Optional().Nillable(),
+		field.Text("review_note").Optional().Nillable(),
+		field.Time("reviewed_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ModerationVerdict.
+func (ModerationVerdict) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ModerationVerdict.
+func (ModerationVerdict) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("action", "created_at"),
+		index.Fields("created_at"),
+	}
+}
+
+// Annotations of the ModerationVerdict.
+func (ModerationVerdict) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "moderation_verdicts"},
+	}
+}
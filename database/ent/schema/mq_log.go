@@ -22,6 +22,7 @@ func (MqLog) Fields() []ent.Field {
 		field.Text("message_id").Unique(),
 		field.Int("priority"),
 		field.Bool("is_processing").Default(false),
+		field.Time("cancelled_at").Optional().Nillable(),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
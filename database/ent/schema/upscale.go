@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database/enttypes"
 )
@@ -37,6 +38,8 @@ func (Upscale) Fields() []ent.Field {
 		field.UUID("model_id", uuid.UUID{}),
 		field.UUID("api_token_id", uuid.UUID{}).Optional().Nillable(),
 		// ! End relationships
+		// Set when a failed job is replayed, it counts as queued from then on
+		field.Time("requeued_at").Optional().Nillable(),
 		field.Time("started_at").Optional().Nillable(),
 		field.Time("completed_at").Optional().Nillable(),
 		field.Time("created_at").Default(time.Now).Immutable(),
@@ -78,6 +81,14 @@ func (Upscale) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the Upscale.
+func (Upscale) Indexes() []ent.Index {
+	return []ent.Index{
+		// For how fast the queue moves
+		index.Fields("started_at"),
+	}
+}
+
 // Annotations of the Upscale.
 func (Upscale) Annotations() []schema.Annotation {
 	return []schema.Annotation{
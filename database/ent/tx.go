@@ -16,28 +16,44 @@ type Tx struct {
 	config
 	// ApiToken is the client for interacting with the ApiToken builders.
 	ApiToken *ApiTokenClient
+	// ApiTokenUsage is the client for interacting with the ApiTokenUsage builders.
+	ApiTokenUsage *ApiTokenUsageClient
 	// AuthClient is the client for interacting with the AuthClient builders.
 	AuthClient *AuthClientClient
 	// BannedWords is the client for interacting with the BannedWords builders.
 	BannedWords *BannedWordsClient
 	// Credit is the client for interacting with the Credit builders.
 	Credit *CreditClient
+	// CreditHold is the client for interacting with the CreditHold builders.
+	CreditHold *CreditHoldClient
+	// CreditTransaction is the client for interacting with the CreditTransaction builders.
+	CreditTransaction *CreditTransactionClient
 	// CreditType is the client for interacting with the CreditType builders.
 	CreditType *CreditTypeClient
+	// CronJobRun is the client for interacting with the CronJobRun builders.
+	CronJobRun *CronJobRunClient
+	// DeadLetter is the client for interacting with the DeadLetter builders.
+	DeadLetter *DeadLetterClient
 	// DeviceInfo is the client for interacting with the DeviceInfo builders.
 	DeviceInfo *DeviceInfoClient
 	// DisposableEmail is the client for interacting with the DisposableEmail builders.
 	DisposableEmail *DisposableEmailClient
 	// Generation is the client for interacting with the Generation builders.
 	Generation *GenerationClient
+	// GenerationBatch is the client for interacting with the GenerationBatch builders.
+	GenerationBatch *GenerationBatchClient
 	// GenerationModel is the client for interacting with the GenerationModel builders.
 	GenerationModel *GenerationModelClient
 	// GenerationOutput is the client for interacting with the GenerationOutput builders.
 	GenerationOutput *GenerationOutputClient
 	// GenerationOutputLike is the client for interacting with the GenerationOutputLike builders.
 	GenerationOutputLike *GenerationOutputLikeClient
+	// GenerationPreset is the client for interacting with the GenerationPreset builders.
+	GenerationPreset *GenerationPresetClient
 	// IPBlackList is the client for interacting with the IPBlackList builders.
 	IPBlackList *IPBlackListClient
+	// ModerationVerdict is the client for interacting with the ModerationVerdict builders.
+	ModerationVerdict *ModerationVerdictClient
 	// MqLog is the client for interacting with the MqLog builders.
 	MqLog *MqLogClient
 	// NegativePrompt is the client for interacting with the NegativePrompt builders.
@@ -202,17 +218,25 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.ApiToken = NewApiTokenClient(tx.config)
+	tx.ApiTokenUsage = NewApiTokenUsageClient(tx.config)
 	tx.AuthClient = NewAuthClientClient(tx.config)
 	tx.BannedWords = NewBannedWordsClient(tx.config)
 	tx.Credit = NewCreditClient(tx.config)
+	tx.CreditHold = NewCreditHoldClient(tx.config)
+	tx.CreditTransaction = NewCreditTransactionClient(tx.config)
 	tx.CreditType = NewCreditTypeClient(tx.config)
+	tx.CronJobRun = NewCronJobRunClient(tx.config)
+	tx.DeadLetter = NewDeadLetterClient(tx.config)
 	tx.DeviceInfo = NewDeviceInfoClient(tx.config)
 	tx.DisposableEmail = NewDisposableEmailClient(tx.config)
 	tx.Generation = NewGenerationClient(tx.config)
+	tx.GenerationBatch = NewGenerationBatchClient(tx.config)
 	tx.GenerationModel = NewGenerationModelClient(tx.config)
 	tx.GenerationOutput = NewGenerationOutputClient(tx.config)
 	tx.GenerationOutputLike = NewGenerationOutputLikeClient(tx.config)
+	tx.GenerationPreset = NewGenerationPresetClient(tx.config)
 	tx.IPBlackList = NewIPBlackListClient(tx.config)
+	tx.ModerationVerdict = NewModerationVerdictClient(tx.config)
 	tx.MqLog = NewMqLogClient(tx.config)
 	tx.NegativePrompt = NewNegativePromptClient(tx.config)
 	tx.Prompt = NewPromptClient(tx.config)
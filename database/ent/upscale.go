@@ -51,6 +51,8 @@ type Upscale struct {
 	ModelID uuid.UUID `json:"model_id,omitempty"`
 	// APITokenID holds the value of the "api_token_id" field.
 	APITokenID *uuid.UUID `json:"api_token_id,omitempty"`
+	// RequeuedAt holds the value of the "requeued_at" field.
+	RequeuedAt *time.Time `json:"requeued_at,omitempty"`
 	// StartedAt holds the value of the "started_at" field.
 	StartedAt *time.Time `json:"started_at,omitempty"`
 	// CompletedAt holds the value of the "completed_at" field.
@@ -148,7 +150,7 @@ func (*Upscale) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case upscale.FieldCountryCode, upscale.FieldStatus, upscale.FieldFailureReason, upscale.FieldStripeProductID, upscale.FieldSourceType:
 			values[i] = new(sql.NullString)
-		case upscale.FieldStartedAt, upscale.FieldCompletedAt, upscale.FieldCreatedAt, upscale.FieldUpdatedAt:
+		case upscale.FieldRequeuedAt, upscale.FieldStartedAt, upscale.FieldCompletedAt, upscale.FieldCreatedAt, upscale.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case upscale.FieldID, upscale.FieldWebhookToken, upscale.FieldUserID, upscale.FieldDeviceInfoID, upscale.FieldModelID:
 			values[i] = new(uuid.UUID)
@@ -261,6 +263,13 @@ func (u *Upscale) assignValues(columns []string, values []any) error {
 				u.APITokenID = new(uuid.UUID)
 				*u.APITokenID = *value.S.(*uuid.UUID)
 			}
+		case upscale.FieldRequeuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field requeued_at", values[i])
+			} else if value.Valid {
+				u.RequeuedAt = new(time.Time)
+				*u.RequeuedAt = value.Time
+			}
 		case upscale.FieldStartedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field started_at", values[i])
@@ -398,6 +407,11 @@ func (u *Upscale) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := u.RequeuedAt; v != nil {
+		builder.WriteString("requeued_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := u.StartedAt; v != nil {
 		builder.WriteString("started_at=")
 		builder.WriteString(v.Format(time.ANSIC))
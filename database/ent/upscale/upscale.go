@@ -45,6 +45,8 @@ const (
 	FieldModelID = "model_id"
 	// FieldAPITokenID holds the string denoting the api_token_id field in the database.
 	FieldAPITokenID = "api_token_id"
+	// FieldRequeuedAt holds the string denoting the requeued_at field in the database.
+	FieldRequeuedAt = "requeued_at"
 	// FieldStartedAt holds the string denoting the started_at field in the database.
 	FieldStartedAt = "started_at"
 	// FieldCompletedAt holds the string denoting the completed_at field in the database.
@@ -119,6 +121,7 @@ var Columns = []string{
 	FieldDeviceInfoID,
 	FieldModelID,
 	FieldAPITokenID,
+	FieldRequeuedAt,
 	FieldStartedAt,
 	FieldCompletedAt,
 	FieldCreatedAt,
@@ -265,6 +268,11 @@ func ByAPITokenID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAPITokenID, opts...).ToFunc()
 }
 
+// ByRequeuedAt orders the results by the requeued_at field.
+func ByRequeuedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequeuedAt, opts...).ToFunc()
+}
+
 // ByStartedAt orders the results by the started_at field.
 func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
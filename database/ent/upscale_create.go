@@ -170,6 +170,20 @@ func (uc *UpscaleCreate) SetNillableAPITokenID(u *uuid.UUID) *UpscaleCreate {
 	return uc
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (uc *UpscaleCreate) SetRequeuedAt(t time.Time) *UpscaleCreate {
+	uc.mutation.SetRequeuedAt(t)
+	return uc
+}
+
+// SetNillableRequeuedAt sets the "requeued_at" field if the given value is not nil.
+func (uc *UpscaleCreate) SetNillableRequeuedAt(t *time.Time) *UpscaleCreate {
+	if t != nil {
+		uc.SetRequeuedAt(*t)
+	}
+	return uc
+}
+
 // SetStartedAt sets the "started_at" field.
 func (uc *UpscaleCreate) SetStartedAt(t time.Time) *UpscaleCreate {
 	uc.mutation.SetStartedAt(t)
@@ -489,6 +503,10 @@ func (uc *UpscaleCreate) createSpec() (*Upscale, *sqlgraph.CreateSpec) {
 		_spec.SetField(upscale.FieldWebhookToken, field.TypeUUID, value)
 		_node.WebhookToken = value
 	}
+	if value, ok := uc.mutation.RequeuedAt(); ok {
+		_spec.SetField(upscale.FieldRequeuedAt, field.TypeTime, value)
+		_node.RequeuedAt = &value
+	}
 	if value, ok := uc.mutation.StartedAt(); ok {
 		_spec.SetField(upscale.FieldStartedAt, field.TypeTime, value)
 		_node.StartedAt = &value
@@ -851,6 +869,24 @@ func (u *UpscaleUpsert) ClearAPITokenID() *UpscaleUpsert {
 	return u
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (u *UpscaleUpsert) SetRequeuedAt(v time.Time) *UpscaleUpsert {
+	u.Set(upscale.FieldRequeuedAt, v)
+	return u
+}
+
+// UpdateRequeuedAt sets the "requeued_at" field to the value that was provided on create.
+func (u *UpscaleUpsert) UpdateRequeuedAt() *UpscaleUpsert {
+	u.SetExcluded(upscale.FieldRequeuedAt)
+	return u
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (u *UpscaleUpsert) ClearRequeuedAt() *UpscaleUpsert {
+	u.SetNull(upscale.FieldRequeuedAt)
+	return u
+}
+
 // SetStartedAt sets the "started_at" field.
 func (u *UpscaleUpsert) SetStartedAt(v time.Time) *UpscaleUpsert {
 	u.Set(upscale.FieldStartedAt, v)
@@ -1195,6 +1231,27 @@ func (u *UpscaleUpsertOne) ClearAPITokenID() *UpscaleUpsertOne {
 	})
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (u *UpscaleUpsertOne) SetRequeuedAt(v time.Time) *UpscaleUpsertOne {
+	return u.Update(func(s *UpscaleUpsert) {
+		s.SetRequeuedAt(v)
+	})
+}
+
+// UpdateRequeuedAt sets the "requeued_at" field to the value that was provided on create.
+func (u *UpscaleUpsertOne) UpdateRequeuedAt() *UpscaleUpsertOne {
+	return u.Update(func(s *UpscaleUpsert) {
+		s.UpdateRequeuedAt()
+	})
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (u *UpscaleUpsertOne) ClearRequeuedAt() *UpscaleUpsertOne {
+	return u.Update(func(s *UpscaleUpsert) {
+		s.ClearRequeuedAt()
+	})
+}
+
 // SetStartedAt sets the "started_at" field.
 func (u *UpscaleUpsertOne) SetStartedAt(v time.Time) *UpscaleUpsertOne {
 	return u.Update(func(s *UpscaleUpsert) {
@@ -1714,6 +1771,27 @@ func (u *UpscaleUpsertBulk) ClearAPITokenID() *UpscaleUpsertBulk {
 	})
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (u *UpscaleUpsertBulk) SetRequeuedAt(v time.Time) *UpscaleUpsertBulk {
+	return u.Update(func(s *UpscaleUpsert) {
+		s.SetRequeuedAt(v)
+	})
+}
+
+// UpdateRequeuedAt sets the "requeued_at" field to the value that was provided on create.
+func (u *UpscaleUpsertBulk) UpdateRequeuedAt() *UpscaleUpsertBulk {
+	return u.Update(func(s *UpscaleUpsert) {
+		s.UpdateRequeuedAt()
+	})
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (u *UpscaleUpsertBulk) ClearRequeuedAt() *UpscaleUpsertBulk {
+	return u.Update(func(s *UpscaleUpsert) {
+		s.ClearRequeuedAt()
+	})
+}
+
 // SetStartedAt sets the "started_at" field.
 func (u *UpscaleUpsertBulk) SetStartedAt(v time.Time) *UpscaleUpsertBulk {
 	return u.Update(func(s *UpscaleUpsert) {
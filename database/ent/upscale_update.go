@@ -277,6 +277,26 @@ func (uu *UpscaleUpdate) ClearAPITokenID() *UpscaleUpdate {
 	return uu
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (uu *UpscaleUpdate) SetRequeuedAt(t time.Time) *UpscaleUpdate {
+	uu.mutation.SetRequeuedAt(t)
+	return uu
+}
+
+// SetNillableRequeuedAt sets the "requeued_at" field if the given value is not nil.
+func (uu *UpscaleUpdate) SetNillableRequeuedAt(t *time.Time) *UpscaleUpdate {
+	if t != nil {
+		uu.SetRequeuedAt(*t)
+	}
+	return uu
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (uu *UpscaleUpdate) ClearRequeuedAt() *UpscaleUpdate {
+	uu.mutation.ClearRequeuedAt()
+	return uu
+}
+
 // SetStartedAt sets the "started_at" field.
 func (uu *UpscaleUpdate) SetStartedAt(t time.Time) *UpscaleUpdate {
 	uu.mutation.SetStartedAt(t)
@@ -554,6 +574,12 @@ func (uu *UpscaleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.WebhookToken(); ok {
 		_spec.SetField(upscale.FieldWebhookToken, field.TypeUUID, value)
 	}
+	if value, ok := uu.mutation.RequeuedAt(); ok {
+		_spec.SetField(upscale.FieldRequeuedAt, field.TypeTime, value)
+	}
+	if uu.mutation.RequeuedAtCleared() {
+		_spec.ClearField(upscale.FieldRequeuedAt, field.TypeTime)
+	}
 	if value, ok := uu.mutation.StartedAt(); ok {
 		_spec.SetField(upscale.FieldStartedAt, field.TypeTime, value)
 	}
@@ -993,6 +1019,26 @@ func (uuo *UpscaleUpdateOne) ClearAPITokenID() *UpscaleUpdateOne {
 	return uuo
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (uuo *UpscaleUpdateOne) SetRequeuedAt(t time.Time) *UpscaleUpdateOne {
+	uuo.mutation.SetRequeuedAt(t)
+	return uuo
+}
+
+// SetNillableRequeuedAt sets the "requeued_at" field if the given value is not nil.
+func (uuo *UpscaleUpdateOne) SetNillableRequeuedAt(t *time.Time) *UpscaleUpdateOne {
+	if t != nil {
+		uuo.SetRequeuedAt(*t)
+	}
+	return uuo
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (uuo *UpscaleUpdateOne) ClearRequeuedAt() *UpscaleUpdateOne {
+	uuo.mutation.ClearRequeuedAt()
+	return uuo
+}
+
 // SetStartedAt sets the "started_at" field.
 func (uuo *UpscaleUpdateOne) SetStartedAt(t time.Time) *UpscaleUpdateOne {
 	uuo.mutation.SetStartedAt(t)
@@ -1300,6 +1346,12 @@ func (uuo *UpscaleUpdateOne) sqlSave(ctx context.Context) (_node *Upscale, err e
 	if value, ok := uuo.mutation.WebhookToken(); ok {
 		_spec.SetField(upscale.FieldWebhookToken, field.TypeUUID, value)
 	}
+	if value, ok := uuo.mutation.RequeuedAt(); ok {
+		_spec.SetField(upscale.FieldRequeuedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.RequeuedAtCleared() {
+		_spec.ClearField(upscale.FieldRequeuedAt, field.TypeTime)
+	}
 	if value, ok := uuo.mutation.StartedAt(); ok {
 		_spec.SetField(upscale.FieldStartedAt, field.TypeTime, value)
 	}
@@ -59,6 +59,8 @@ type Voiceover struct {
 	SpeakerID uuid.UUID `json:"speaker_id,omitempty"`
 	// APITokenID holds the value of the "api_token_id" field.
 	APITokenID *uuid.UUID `json:"api_token_id,omitempty"`
+	// RequeuedAt holds the value of the "requeued_at" field.
+	RequeuedAt *time.Time `json:"requeued_at,omitempty"`
 	// StartedAt holds the value of the "started_at" field.
 	StartedAt *time.Time `json:"started_at,omitempty"`
 	// CompletedAt holds the value of the "completed_at" field.
@@ -184,7 +186,7 @@ func (*Voiceover) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case voiceover.FieldCountryCode, voiceover.FieldStatus, voiceover.FieldFailureReason, voiceover.FieldStripeProductID, voiceover.FieldSourceType:
 			values[i] = new(sql.NullString)
-		case voiceover.FieldStartedAt, voiceover.FieldCompletedAt, voiceover.FieldCreatedAt, voiceover.FieldUpdatedAt:
+		case voiceover.FieldRequeuedAt, voiceover.FieldStartedAt, voiceover.FieldCompletedAt, voiceover.FieldCreatedAt, voiceover.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case voiceover.FieldID, voiceover.FieldUserID, voiceover.FieldDeviceInfoID, voiceover.FieldModelID, voiceover.FieldSpeakerID:
 			values[i] = new(uuid.UUID)
@@ -316,6 +318,13 @@ func (v *Voiceover) assignValues(columns []string, values []any) error {
 				v.APITokenID = new(uuid.UUID)
 				*v.APITokenID = *value.S.(*uuid.UUID)
 			}
+		case voiceover.FieldRequeuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field requeued_at", values[i])
+			} else if value.Valid {
+				v.RequeuedAt = new(time.Time)
+				*v.RequeuedAt = value.Time
+			}
 		case voiceover.FieldStartedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field started_at", values[i])
@@ -474,6 +483,11 @@ func (v *Voiceover) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := v.RequeuedAt; v != nil {
+		builder.WriteString("requeued_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := v.StartedAt; v != nil {
 		builder.WriteString("started_at=")
 		builder.WriteString(v.Format(time.ANSIC))
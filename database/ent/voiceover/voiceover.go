@@ -51,6 +51,8 @@ const (
 	FieldSpeakerID = "speaker_id"
 	// FieldAPITokenID holds the string denoting the api_token_id field in the database.
 	FieldAPITokenID = "api_token_id"
+	// FieldRequeuedAt holds the string denoting the requeued_at field in the database.
+	FieldRequeuedAt = "requeued_at"
 	// FieldStartedAt holds the string denoting the started_at field in the database.
 	FieldStartedAt = "started_at"
 	// FieldCompletedAt holds the string denoting the completed_at field in the database.
@@ -146,6 +148,7 @@ var Columns = []string{
 	FieldModelID,
 	FieldSpeakerID,
 	FieldAPITokenID,
+	FieldRequeuedAt,
 	FieldStartedAt,
 	FieldCompletedAt,
 	FieldCreatedAt,
@@ -309,6 +312,11 @@ func ByAPITokenID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAPITokenID, opts...).ToFunc()
 }
 
+// ByRequeuedAt orders the results by the requeued_at field.
+func ByRequeuedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequeuedAt, opts...).ToFunc()
+}
+
 // ByStartedAt orders the results by the started_at field.
 func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
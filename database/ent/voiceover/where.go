@@ -132,6 +132,11 @@ func APITokenID(v uuid.UUID) predicate.Voiceover {
 	return predicate.Voiceover(sql.FieldEQ(FieldAPITokenID, v))
 }
 
+// RequeuedAt applies equality check predicate on the "requeued_at" field. It's identical to RequeuedAtEQ.
+func RequeuedAt(v time.Time) predicate.Voiceover {
+	return predicate.Voiceover(sql.FieldEQ(FieldRequeuedAt, v))
+}
+
 // StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
 func StartedAt(v time.Time) predicate.Voiceover {
 	return predicate.Voiceover(sql.FieldEQ(FieldStartedAt, v))
@@ -717,6 +722,56 @@ func APITokenIDNotNil() predicate.Voiceover {
 	return predicate.Voiceover(sql.FieldNotNull(FieldAPITokenID))
 }
 
+// RequeuedAtEQ applies the EQ predicate on the "requeued_at" field.
+func RequeuedAtEQ(v time.Time) predicate.Voiceover {
+	return predicate.Voiceover(sql.FieldEQ(FieldRequeuedAt, v))
+}
+
+// RequeuedAtNEQ applies the NEQ predicate on the "requeued_at" field.
+func RequeuedAtNEQ(v time.Time) predicate.Voiceover {
+	return predicate.Voiceover(sql.FieldNEQ(FieldRequeuedAt, v))
+}
+
+// RequeuedAtIn applies the In predicate on the "requeued_at" field.
+func RequeuedAtIn(vs ...time.Time) predicate.Voiceover {
+	return predicate.Voiceover(sql.FieldIn(FieldRequeuedAt, vs...))
+}
+
+// RequeuedAtNotIn applies the NotIn predicate on the "requeued_at" field.
+func RequeuedAtNotIn(vs ...time.Time) predicate.Voiceover {
+	return predicate.Voiceover(sql.FieldNotIn(FieldRequeuedAt, vs...))
+}
+
+// RequeuedAtGT applies the GT predicate on the "requeued_at" field.
+func RequeuedAtGT(v time.Time) predicate.Voiceover {
+	return predicate.Voiceover(sql.FieldGT(FieldRequeuedAt, v))
+}
+
+// RequeuedAtGTE applies the GTE predicate on the "requeued_at" field.
+func RequeuedAtGTE(v time.Time) predicate.Voiceover {
+	return predicate.Voiceover(sql.FieldGTE(FieldRequeuedAt, v))
+}
+
+// RequeuedAtLT applies the LT predicate on the "requeued_at" field.
+func RequeuedAtLT(v time.Time) predicate.Voiceover {
+	return predicate.Voiceover(sql.FieldLT(FieldRequeuedAt, v))
+}
+
+// RequeuedAtLTE applies the LTE predicate on the "requeued_at" field.
+func RequeuedAtLTE(v time.Time) predicate.Voiceover {
+	return predicate.Voiceover(sql.FieldLTE(FieldRequeuedAt, v))
+}
+
+// RequeuedAtIsNil applies the IsNil predicate on the "requeued_at" field.
+func RequeuedAtIsNil() predicate.Voiceover {
+	return predicate.Voiceover(sql.FieldIsNull(FieldRequeuedAt))
+}
+
+// RequeuedAtNotNil applies the NotNil predicate on the "requeued_at" field.
+func RequeuedAtNotNil() predicate.Voiceover {
+	return predicate.Voiceover(sql.FieldNotNull(FieldRequeuedAt))
+}
+
 // StartedAtEQ applies the EQ predicate on the "started_at" field.
 func StartedAtEQ(v time.Time) predicate.Voiceover {
 	return predicate.Voiceover(sql.FieldEQ(FieldStartedAt, v))
@@ -206,6 +206,20 @@ func (vc *VoiceoverCreate) SetNillableAPITokenID(u *uuid.UUID) *VoiceoverCreate
 	return vc
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (vc *VoiceoverCreate) SetRequeuedAt(t time.Time) *VoiceoverCreate {
+	vc.mutation.SetRequeuedAt(t)
+	return vc
+}
+
+// SetNillableRequeuedAt sets the "requeued_at" field if the given value is not nil.
+func (vc *VoiceoverCreate) SetNillableRequeuedAt(t *time.Time) *VoiceoverCreate {
+	if t != nil {
+		vc.SetRequeuedAt(*t)
+	}
+	return vc
+}
+
 // SetStartedAt sets the "started_at" field.
 func (vc *VoiceoverCreate) SetStartedAt(t time.Time) *VoiceoverCreate {
 	vc.mutation.SetStartedAt(t)
@@ -558,6 +572,10 @@ func (vc *VoiceoverCreate) createSpec() (*Voiceover, *sqlgraph.CreateSpec) {
 		_spec.SetField(voiceover.FieldSourceType, field.TypeEnum, value)
 		_node.SourceType = value
 	}
+	if value, ok := vc.mutation.RequeuedAt(); ok {
+		_spec.SetField(voiceover.FieldRequeuedAt, field.TypeTime, value)
+		_node.RequeuedAt = &value
+	}
 	if value, ok := vc.mutation.StartedAt(); ok {
 		_spec.SetField(voiceover.FieldStartedAt, field.TypeTime, value)
 		_node.StartedAt = &value
@@ -996,6 +1014,24 @@ func (u *VoiceoverUpsert) ClearAPITokenID() *VoiceoverUpsert {
 	return u
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (u *VoiceoverUpsert) SetRequeuedAt(v time.Time) *VoiceoverUpsert {
+	u.Set(voiceover.FieldRequeuedAt, v)
+	return u
+}
+
+// UpdateRequeuedAt sets the "requeued_at" field to the value that was provided on create.
+func (u *VoiceoverUpsert) UpdateRequeuedAt() *VoiceoverUpsert {
+	u.SetExcluded(voiceover.FieldRequeuedAt)
+	return u
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (u *VoiceoverUpsert) ClearRequeuedAt() *VoiceoverUpsert {
+	u.SetNull(voiceover.FieldRequeuedAt)
+	return u
+}
+
 // SetStartedAt sets the "started_at" field.
 func (u *VoiceoverUpsert) SetStartedAt(v time.Time) *VoiceoverUpsert {
 	u.Set(voiceover.FieldStartedAt, v)
@@ -1389,6 +1425,27 @@ func (u *VoiceoverUpsertOne) ClearAPITokenID() *VoiceoverUpsertOne {
 	})
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (u *VoiceoverUpsertOne) SetRequeuedAt(v time.Time) *VoiceoverUpsertOne {
+	return u.Update(func(s *VoiceoverUpsert) {
+		s.SetRequeuedAt(v)
+	})
+}
+
+// UpdateRequeuedAt sets the "requeued_at" field to the value that was provided on create.
+func (u *VoiceoverUpsertOne) UpdateRequeuedAt() *VoiceoverUpsertOne {
+	return u.Update(func(s *VoiceoverUpsert) {
+		s.UpdateRequeuedAt()
+	})
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (u *VoiceoverUpsertOne) ClearRequeuedAt() *VoiceoverUpsertOne {
+	return u.Update(func(s *VoiceoverUpsert) {
+		s.ClearRequeuedAt()
+	})
+}
+
 // SetStartedAt sets the "started_at" field.
 func (u *VoiceoverUpsertOne) SetStartedAt(v time.Time) *VoiceoverUpsertOne {
 	return u.Update(func(s *VoiceoverUpsert) {
@@ -1957,6 +2014,27 @@ func (u *VoiceoverUpsertBulk) ClearAPITokenID() *VoiceoverUpsertBulk {
 	})
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (u *VoiceoverUpsertBulk) SetRequeuedAt(v time.Time) *VoiceoverUpsertBulk {
+	return u.Update(func(s *VoiceoverUpsert) {
+		s.SetRequeuedAt(v)
+	})
+}
+
+// UpdateRequeuedAt sets the "requeued_at" field to the value that was provided on create.
+func (u *VoiceoverUpsertBulk) UpdateRequeuedAt() *VoiceoverUpsertBulk {
+	return u.Update(func(s *VoiceoverUpsert) {
+		s.UpdateRequeuedAt()
+	})
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (u *VoiceoverUpsertBulk) ClearRequeuedAt() *VoiceoverUpsertBulk {
+	return u.Update(func(s *VoiceoverUpsert) {
+		s.ClearRequeuedAt()
+	})
+}
+
 // SetStartedAt sets the "started_at" field.
 func (u *VoiceoverUpsertBulk) SetStartedAt(v time.Time) *VoiceoverUpsertBulk {
 	return u.Update(func(s *VoiceoverUpsert) {
@@ -327,6 +327,26 @@ func (vu *VoiceoverUpdate) ClearAPITokenID() *VoiceoverUpdate {
 	return vu
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (vu *VoiceoverUpdate) SetRequeuedAt(t time.Time) *VoiceoverUpdate {
+	vu.mutation.SetRequeuedAt(t)
+	return vu
+}
+
+// SetNillableRequeuedAt sets the "requeued_at" field if the given value is not nil.
+func (vu *VoiceoverUpdate) SetNillableRequeuedAt(t *time.Time) *VoiceoverUpdate {
+	if t != nil {
+		vu.SetRequeuedAt(*t)
+	}
+	return vu
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (vu *VoiceoverUpdate) ClearRequeuedAt() *VoiceoverUpdate {
+	vu.mutation.ClearRequeuedAt()
+	return vu
+}
+
 // SetStartedAt sets the "started_at" field.
 func (vu *VoiceoverUpdate) SetStartedAt(t time.Time) *VoiceoverUpdate {
 	vu.mutation.SetStartedAt(t)
@@ -638,6 +658,12 @@ func (vu *VoiceoverUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := vu.mutation.SourceType(); ok {
 		_spec.SetField(voiceover.FieldSourceType, field.TypeEnum, value)
 	}
+	if value, ok := vu.mutation.RequeuedAt(); ok {
+		_spec.SetField(voiceover.FieldRequeuedAt, field.TypeTime, value)
+	}
+	if vu.mutation.RequeuedAtCleared() {
+		_spec.ClearField(voiceover.FieldRequeuedAt, field.TypeTime)
+	}
 	if value, ok := vu.mutation.StartedAt(); ok {
 		_spec.SetField(voiceover.FieldStartedAt, field.TypeTime, value)
 	}
@@ -1183,6 +1209,26 @@ func (vuo *VoiceoverUpdateOne) ClearAPITokenID() *VoiceoverUpdateOne {
 	return vuo
 }
 
+// SetRequeuedAt sets the "requeued_at" field.
+func (vuo *VoiceoverUpdateOne) SetRequeuedAt(t time.Time) *VoiceoverUpdateOne {
+	vuo.mutation.SetRequeuedAt(t)
+	return vuo
+}
+
+// SetNillableRequeuedAt sets the "requeued_at" field if the given value is not nil.
+func (vuo *VoiceoverUpdateOne) SetNillableRequeuedAt(t *time.Time) *VoiceoverUpdateOne {
+	if t != nil {
+		vuo.SetRequeuedAt(*t)
+	}
+	return vuo
+}
+
+// ClearRequeuedAt clears the value of the "requeued_at" field.
+func (vuo *VoiceoverUpdateOne) ClearRequeuedAt() *VoiceoverUpdateOne {
+	vuo.mutation.ClearRequeuedAt()
+	return vuo
+}
+
 // SetStartedAt sets the "started_at" field.
 func (vuo *VoiceoverUpdateOne) SetStartedAt(t time.Time) *VoiceoverUpdateOne {
 	vuo.mutation.SetStartedAt(t)
@@ -1524,6 +1570,12 @@ func (vuo *VoiceoverUpdateOne) sqlSave(ctx context.Context) (_node *Voiceover, e
 	if value, ok := vuo.mutation.SourceType(); ok {
 		_spec.SetField(voiceover.FieldSourceType, field.TypeEnum, value)
 	}
+	if value, ok := vuo.mutation.RequeuedAt(); ok {
+		_spec.SetField(voiceover.FieldRequeuedAt, field.TypeTime, value)
+	}
+	if vuo.mutation.RequeuedAtCleared() {
+		_spec.ClearField(voiceover.FieldRequeuedAt, field.TypeTime)
+	}
 	if value, ok := vuo.mutation.StartedAt(); ok {
 		_spec.SetField(voiceover.FieldStartedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,12 @@
+package enttypes
+
+// What a moderation checker found in a prompt, kept with the verdict
+type ModerationFinding struct {
+	Checker  string  `json:"checker"`
+	Category string  `json:"category"`
+	Score    float64 `json:"score,omitempty"`
+	// What matched, e.g. a banned prompt ID or banned word
+	Match string `json:"match,omitempty"`
+	// The match warrants banning the user, e.g. special banned prompts
+	BanWorthy bool `json:"ban_worthy,omitempty"`
+}
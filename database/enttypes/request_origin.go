@@ -0,0 +1,11 @@
+package enttypes
+
+// Headers of the request that started a background job, so work done later is attributed the same way
+type RequestOrigin struct {
+	IPAddress   string `json:"ip_address,omitempty"`
+	CountryCode string `json:"country_code,omitempty"`
+	ThumbmarkID string `json:"thumbmark_id,omitempty"`
+	UserAgent   string `json:"user_agent,omitempty"`
+	// API token usage row of the request, if it came with a token
+	ApiTokenUsageID string `json:"api_token_usage_id,omitempty"`
+}
@@ -1651,6 +1651,19 @@ type SearchRequestFilter1 = interface{}
 // SearchRequest_Filter Look only for points which satisfies this conditions
 type SCValue struct {
 	Value interface{} `json:"value"`
+	// Full-text match, needs a text index on the field
+	Text string `json:"-"`
+}
+
+func (v SCValue) MarshalJSON() ([]byte, error) {
+	if v.Text != "" {
+		return json.Marshal(struct {
+			Text string `json:"text"`
+		}{v.Text})
+	}
+	return json.Marshal(struct {
+		Value interface{} `json:"value"`
+	}{v.Value})
 }
 type SCIsEmpty struct {
 	Key string `json:"key,omitempty"`
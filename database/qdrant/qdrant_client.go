@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -28,6 +29,7 @@ type qdrantIndexField struct {
 }
 
 // The fields we create indexes for on app startup
+// Missing ones are added to existing collections too, so a field added here is indexed on the next deploy
 var fieldsToIndex = []qdrantIndexField{
 	{
 		Name: "gallery_status",
@@ -89,6 +91,11 @@ var fieldsToIndex = []qdrantIndexField{
 		Type:   PayloadSchemaTypeFloat,
 		OnDisk: true,
 	},
+	{
+		Name:   "prompt",
+		Type:   PayloadSchemaTypeText,
+		OnDisk: true,
+	},
 }
 
 type QdrantClient struct {
@@ -161,6 +168,76 @@ func (q *QdrantClient) GetCollections(noRetry bool) (*CollectionsResponse, error
 	return resp.JSON200.Result, nil
 }
 
+// Same client, for another collection
+func (q *QdrantClient) WithCollection(name string) *QdrantClient {
+	c := *q
+	c.CollectionName = name
+	return &c
+}
+
+// Get all aliases, alias name to collection name
+func (q *QdrantClient) GetAliases(noRetry bool) (map[string]string, error) {
+	resp, err := q.Client.GetCollectionsAliasesWithResponse(q.Ctx)
+	if err != nil {
+		if !noRetry && (os.IsTimeout(err) || strings.Contains(err.Error(), "connection refused")) {
+			return q.GetAliases(true)
+		}
+		log.Errorf("Error getting aliases %v", err)
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil || resp.JSON200.Result == nil {
+		log.Errorf("Error getting aliases %v", resp.StatusCode())
+		return nil, errors.New("Error getting aliases " + string(resp.Body))
+	}
+	aliases := make(map[string]string, len(resp.JSON200.Result.Aliases))
+	for _, alias := range resp.JSON200.Result.Aliases {
+		aliases[alias.AliasName] = alias.CollectionName
+	}
+	return aliases, nil
+}
+
+// Point the alias at the collection, in one atomic operation so searches never miss
+func (q *QdrantClient) SwitchAlias(alias string, collection string, noRetry bool) error {
+	deleteOp := AliasOperations{}
+	err := deleteOp.FromDeleteAliasOperation(DeleteAliasOperation{
+		DeleteAlias: DeleteAlias{AliasName: alias},
+	})
+	if err != nil {
+		return err
+	}
+	createOp := AliasOperations{}
+	err = createOp.FromCreateAliasOperation(CreateAliasOperation{
+		CreateAlias: CreateAlias{AliasName: alias, CollectionName: collection},
+	})
+	if err != nil {
+		return err
+	}
+	actions := []AliasOperations{createOp}
+	aliases, err := q.GetAliases(noRetry)
+	if err != nil {
+		return err
+	}
+	if _, ok := aliases[alias]; ok {
+		actions = []AliasOperations{deleteOp, createOp}
+	}
+
+	resp, err := q.Client.UpdateAliasesWithResponse(q.Ctx, &UpdateAliasesParams{}, UpdateAliasesJSONRequestBody{
+		Actions: actions,
+	})
+	if err != nil {
+		if !noRetry && (os.IsTimeout(err) || strings.Contains(err.Error(), "connection refused")) {
+			return q.SwitchAlias(alias, collection, true)
+		}
+		log.Errorf("Error switching alias %v", err)
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		log.Errorf("Error switching alias %v", resp.StatusCode())
+		return errors.New("Error switching alias " + string(resp.Body))
+	}
+	return nil
+}
+
 // Create indexes
 const (
 	PayloadTypeKeyword = "keyword"
@@ -202,22 +279,43 @@ func (q *QdrantClient) CreateIndex(fieldName string, schemaType PayloadSchemaTyp
 	return nil
 }
 
+// Size of the CLIP image embeddings in our app collection
+const IMAGE_VECTOR_SIZE = 1024
+
 // Creates our app collection if it doesnt exist
+// The collection name may be an alias of the actual collection, see SwitchAlias
 func (q *QdrantClient) CreateCollectionIfNotExists(noRetry bool) error {
-	// Check if collection exists
-	collections, err := q.GetCollections(false)
-	if err != nil {
+	exists, err := q.CollectionExists(noRetry)
+	if err != nil || exists {
 		return err
 	}
+	return q.CreateCollection(IMAGE_VECTOR_SIZE, noRetry)
+}
+
+// Whether our collection name is a collection or an alias of one
+func (q *QdrantClient) CollectionExists(noRetry bool) (bool, error) {
+	collections, err := q.GetCollections(noRetry)
+	if err != nil {
+		return false, err
+	}
 	for _, collection := range collections.Collections {
 		if collection.Name == q.CollectionName {
-			return nil
+			return true, nil
 		}
 	}
+	aliases, err := q.GetAliases(noRetry)
+	if err != nil {
+		return false, err
+	}
+	_, ok := aliases[q.CollectionName]
+	return ok, nil
+}
 
+// Creates the collection with image vectors of the given size
+func (q *QdrantClient) CreateCollection(vectorSize uint64, noRetry bool) error {
 	// create optimizers config
 	optimizersConfig := &CreateCollection_OptimizersConfig{}
-	err = optimizersConfig.FromOptimizersConfigDiff(OptimizersConfigDiff{
+	err := optimizersConfig.FromOptimizersConfigDiff(OptimizersConfigDiff{
 		MemmapThreshold: utils.ToPtr[uint](20000),
 	})
 	if err != nil {
@@ -247,7 +345,7 @@ func (q *QdrantClient) CreateCollectionIfNotExists(noRetry bool) error {
 	vectorsConfig := VectorsConfig{}
 	vectorsConfigMulti := VectorsConfig1{}
 	vectorsConfigMulti["image"] = VectorParams{
-		Size:     uint64(1024),
+		Size:     vectorSize,
 		Distance: "Dot",
 		OnDisk:   utils.ToPtr(true),
 	}
@@ -280,7 +378,7 @@ func (q *QdrantClient) CreateCollectionIfNotExists(noRetry bool) error {
 
 	if err != nil {
 		if !noRetry && (os.IsTimeout(err) || strings.Contains(err.Error(), "connection refused")) {
-			return q.CreateCollectionIfNotExists(true)
+			return q.CreateCollection(vectorSize, true)
 		}
 		log.Errorf("Error getting collections %v", err)
 		return err
@@ -421,6 +519,25 @@ func (q *QdrantClient) Count(noRetry bool) (uint, error) {
 	return resp.JSON200.Result.Count, nil
 }
 
+// Exact count, slower than Count
+func (q *QdrantClient) CountExact(noRetry bool) (uint, error) {
+	resp, err := q.Client.CountPointsWithResponse(q.Ctx, q.CollectionName, CountPointsJSONRequestBody{
+		Exact: utils.ToPtr(true),
+	})
+	if err != nil {
+		if !noRetry && (os.IsTimeout(err) || strings.Contains(err.Error(), "connection refused")) {
+			return q.CountExact(true)
+		}
+		log.Errorf("Error counting points %v", err)
+		return 0, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		log.Errorf("Error counting points %v", resp.StatusCode())
+		return 0, fmt.Errorf("Error counting points %v", resp.StatusCode())
+	}
+	return resp.JSON200.Result.Count, nil
+}
+
 // Upsert
 func (q *QdrantClient) BatchUpsert(payload []map[string]interface{}, noRetry bool) error {
 	payloadCopy := make([]map[string]interface{}, len(payload))
@@ -726,6 +843,101 @@ func (q *QdrantClient) QueryGenerations(embedding []float32, per_page int, offse
 	return &qAPIResponse, nil
 }
 
+// Rank constant for reciprocal rank fusion, dampens how much the very top ranks dominate
+const HYBRID_RRF_K = 60
+
+// Most results we fuse per search, there are no pages past it
+const HYBRID_MAX_WINDOW = 1000
+
+// Gallery search that combines the semantic search with a full-text match on the prompt
+// Both result lists are merged with weighted reciprocal rank fusion, keywordWeight between 0 and 1
+// Exact phrases, artist names and such rank high even when the embedding doesn't capture them
+// Needs the prompt text index, the server creates it on start with the rest of fieldsToIndex
+func (q *QdrantClient) HybridQueryGenerations(embedding []float32, text string, per_page int, offset *uint, scoreThreshold *float32, oversampling *float32, filters *SearchRequest_Filter, keywordWeight float32, noRetry bool) (*QResponse, error) {
+	var start uint
+	if offset != nil {
+		start = *offset
+	}
+	text = strings.TrimSpace(text)
+	if keywordWeight <= 0 || text == "" {
+		return q.QueryGenerations(embedding, per_page, offset, scoreThreshold, oversampling, filters, false, noRetry)
+	}
+	// The last page ends at the window, switching to the vector order past it would repeat and skip results
+	window := int(start) + per_page
+	if window > HYBRID_MAX_WINDOW {
+		window = HYBRID_MAX_WINDOW
+	}
+	if int(start) >= window {
+		return &QResponse{Result: []QResponseResult{}, Status: "ok"}, nil
+	}
+
+	semantic, err := q.QueryGenerations(embedding, window, nil, scoreThreshold, oversampling, filters, false, noRetry)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same filters, only points where the prompt contains the text
+	// The score threshold is semantic, a prompt match is relevant on its own
+	keywordFilters := &SearchRequest_Filter{}
+	if filters != nil {
+		*keywordFilters = *filters
+	}
+	keywordFilters.Must = append(slices.Clone(keywordFilters.Must), SCMatchCondition{
+		Key:   "prompt",
+		Match: &SCValue{Text: text},
+	})
+	keyword, err := q.QueryGenerations(embedding, window, nil, nil, oversampling, keywordFilters, false, noRetry)
+	if err != nil {
+		return nil, err
+	}
+
+	fused := FuseResults(semantic.Result, keyword.Result, keywordWeight)
+	res := &QResponse{
+		Status: semantic.Status,
+		Time:   semantic.Time + keyword.Time,
+	}
+	if int(start) < len(fused) {
+		res.Result = fused[start:]
+	}
+	limit := window - int(start)
+	if (len(res.Result) > limit || semantic.Next != nil || keyword.Next != nil) && window < HYBRID_MAX_WINDOW {
+		res.Next = utils.ToPtr(start + uint(per_page))
+	}
+	if len(res.Result) > limit {
+		res.Result = res.Result[:limit]
+	}
+	return res, nil
+}
+
+// Weighted reciprocal rank fusion of semantic and keyword results, best first
+// The score of a result becomes its fused score
+func FuseResults(semantic []QResponseResult, keyword []QResponseResult, keywordWeight float32) []QResponseResult {
+	if keywordWeight > 1 {
+		keywordWeight = 1
+	}
+	scores := make(map[string]float64, len(semantic)+len(keyword))
+	results := make([]QResponseResult, 0, len(semantic)+len(keyword))
+	add := func(hits []QResponseResult, weight float64) {
+		for rank, hit := range hits {
+			if _, ok := scores[hit.Id]; !ok {
+				results = append(results, hit)
+			}
+			scores[hit.Id] += weight / float64(HYBRID_RRF_K+rank+1)
+		}
+	}
+	add(semantic, float64(1-keywordWeight))
+	add(keyword, float64(keywordWeight))
+
+	// Stable so ties keep semantic order
+	sort.SliceStable(results, func(i, j int) bool {
+		return scores[results[i].Id] > scores[results[j].Id]
+	})
+	for i := range results {
+		results[i].Score = float32(scores[results[i].Id])
+	}
+	return results
+}
+
 // Get list of fields with index
 func (q *QdrantClient) GetIndexedPayloadFields(noRetry bool) ([]string, error) {
 	resp, err := q.Client.GetCollectionWithResponse(q.Ctx, q.CollectionName)
@@ -762,7 +974,7 @@ func (q *QdrantClient) CreateAllIndexes() error {
 	var mErr *multierror.Error
 	for _, field := range fieldsToIndex {
 		if !slices.Contains(indexFields, field.Name) {
-			mErr = multierror.Append(q.CreateIndex(field.Name, field.Type, field.OnDisk, false))
+			mErr = multierror.Append(mErr, q.CreateIndex(field.Name, field.Type, field.OnDisk, false))
 		}
 	}
 	return mErr.ErrorOrNil()
@@ -0,0 +1,177 @@
+package qdrant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextMatchCondition(t *testing.T) {
+	b, err := json.Marshal([]SCMatchCondition{
+		{Key: "prompt", Match: &SCValue{Text: "greg rutkowski"}},
+		{Key: "is_public", Match: &SCValue{Value: false}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"key":"prompt","match":{"text":"greg rutkowski"}},{"key":"is_public","match":{"value":false}}]`, string(b))
+}
+
+func TestFuseResults(t *testing.T) {
+	semantic := []QResponseResult{{Id: "a", Score: 90}, {Id: "b", Score: 80}, {Id: "c", Score: 70}}
+	keyword := []QResponseResult{{Id: "c", Score: 70}, {Id: "d", Score: 40}}
+
+	// Semantic only keeps the order, keyword-only hits go last
+	fused := FuseResults(semantic, keyword, 0)
+	var ids []string
+	for _, r := range fused {
+		ids = append(ids, r.Id)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d"}, ids)
+
+	// In both lists wins, ties keep semantic order
+	fused = FuseResults(semantic, keyword, 0.5)
+	ids = nil
+	for _, r := range fused {
+		ids = append(ids, r.Id)
+	}
+	assert.Equal(t, []string{"c", "a", "b", "d"}, ids)
+	assert.Greater(t, fused[0].Score, fused[1].Score)
+
+	// Keyword only
+	fused = FuseResults(semantic, keyword, 1)
+	assert.Equal(t, "c", fused[0].Id)
+	assert.Equal(t, "d", fused[1].Id)
+}
+
+func TestSwitchAlias(t *testing.T) {
+	aliases := `[]`
+	var actions string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/collections":
+			w.Write([]byte(`{"result":{"collections":[{"name":"stablecog"}]},"status":"ok","time":0}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/aliases":
+			w.Write([]byte(`{"result":{"aliases":` + aliases + `},"status":"ok","time":0}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/collections/aliases":
+			b, _ := io.ReadAll(r.Body)
+			actions = string(b)
+			w.Write([]byte(`{"result":true,"status":"ok","time":0}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	c, doer, err := NewClientWithResponses(server.URL)
+	assert.Nil(t, err)
+	q := &QdrantClient{Client: c, Doer: doer, Ctx: context.Background(), CollectionName: "stablecog_live"}
+
+	// No alias yet
+	assert.Nil(t, q.SwitchAlias("stablecog_live", "stablecog_v2", true))
+	assert.Equal(t, `{"actions":[{"create_alias":{"alias_name":"stablecog_live","collection_name":"stablecog_v2"}}]}`, actions)
+	exists, err := q.CollectionExists(true)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	// Moved in one request
+	aliases = `[{"alias_name":"stablecog_live","collection_name":"stablecog_v2"}]`
+	assert.Nil(t, q.SwitchAlias("stablecog_live", "stablecog_v3", true))
+	assert.Equal(t, `{"actions":[{"delete_alias":{"alias_name":"stablecog_live"}},{"create_alias":{"alias_name":"stablecog_live","collection_name":"stablecog_v3"}}]}`, actions)
+	exists, err = q.CollectionExists(true)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+}
+
+func TestHybridQueryGenerationsStopsAtWindow(t *testing.T) {
+	searches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Limit int `json:"limit"`
+		}
+		b, _ := io.ReadAll(r.Body)
+		json.Unmarshal(b, &body)
+		searches++
+		// Always more than asked for, keyword hits are told apart by the prompt filter
+		prefix := "s"
+		if strings.Contains(string(b), `"key":"prompt"`) {
+			prefix = "k"
+		}
+		results := make([]string, body.Limit)
+		for i := range results {
+			results[i] = fmt.Sprintf(`{"id":"%s%d","score":%d}`, prefix, i, body.Limit-i)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"result":[` + strings.Join(results, ",") + `],"status":"ok","time":0}`))
+	}))
+	defer server.Close()
+	c, doer, err := NewClientWithResponses(server.URL)
+	assert.Nil(t, err)
+	q := &QdrantClient{Client: c, Doer: doer, Ctx: context.Background(), CollectionName: "stablecog"}
+
+	res, err := q.HybridQueryGenerations([]float32{1}, "castle", 10, nil, nil, nil, nil, 0.5, true)
+	assert.Nil(t, err)
+	assert.Len(t, res.Result, 10)
+	assert.Equal(t, uint(10), *res.Next)
+
+	// The last page ends at the window
+	res, err = q.HybridQueryGenerations([]float32{1}, "castle", 10, utils.ToPtr[uint](HYBRID_MAX_WINDOW-10), nil, nil, nil, 0.5, true)
+	assert.Nil(t, err)
+	assert.Len(t, res.Result, 10)
+	assert.Nil(t, res.Next)
+	res, err = q.HybridQueryGenerations([]float32{1}, "castle", 10, utils.ToPtr[uint](HYBRID_MAX_WINDOW-5), nil, nil, nil, 0.5, true)
+	assert.Nil(t, err)
+	assert.Len(t, res.Result, 5)
+	assert.Nil(t, res.Next)
+
+	// Nothing past it, not even from the vector search
+	searches = 0
+	res, err = q.HybridQueryGenerations([]float32{1}, "castle", 10, utils.ToPtr[uint](HYBRID_MAX_WINDOW), nil, nil, nil, 0.5, true)
+	assert.Nil(t, err)
+	assert.Len(t, res.Result, 0)
+	assert.Nil(t, res.Next)
+	assert.Equal(t, 0, searches)
+}
+
+func TestCreateAllIndexesAddsMissing(t *testing.T) {
+	var created []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/collections/stablecog":
+			// A collection from before the prompt text index
+			schema := make([]string, 0, len(fieldsToIndex))
+			for _, field := range fieldsToIndex {
+				if field.Name != "prompt" {
+					schema = append(schema, fmt.Sprintf(`%q:{"data_type":%q,"points":0}`, field.Name, field.Type))
+				}
+			}
+			w.Write([]byte(`{"result":{"payload_schema":{` + strings.Join(schema, ",") + `}},"status":"ok","time":0}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/collections/stablecog/index":
+			var body struct {
+				FieldName   string `json:"field_name"`
+				FieldSchema struct {
+					Type string `json:"type"`
+				} `json:"field_schema"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			created = append(created, body.FieldName+":"+body.FieldSchema.Type)
+			w.Write([]byte(`{"result":{"operation_id":0,"status":"acknowledged"},"status":"ok","time":0}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	c, doer, err := NewClientWithResponses(server.URL)
+	assert.Nil(t, err)
+	q := &QdrantClient{Client: c, Doer: doer, Ctx: context.Background(), CollectionName: "stablecog"}
+
+	assert.Nil(t, q.CreateAllIndexes())
+	assert.Equal(t, []string{"prompt:text"}, created)
+}
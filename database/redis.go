@@ -24,6 +24,14 @@ type RedisWrapper struct {
 	Ctx    context.Context
 }
 
+// Copy using ctx, sharing the client
+func (r *RedisWrapper) WithCtx(ctx context.Context) *RedisWrapper {
+	return &RedisWrapper{
+		Client: r.Client,
+		Ctx:    ctx,
+	}
+}
+
 // Should return render redis url if render is set
 func getRedisURL() string {
 	return utils.GetEnv().RedisConnectionString
@@ -192,6 +200,48 @@ func (r *RedisWrapper) DeleteCogRequestStreamID(ctx context.Context, requestID s
 	return r.Client.Del(ctx, requestID).Result()
 }
 
+// Get and delete the stream ID of the client for a given request ID in one step
+// Only one caller gets it, whoever does is the one that finishes the request
+func (r *RedisWrapper) TakeCogRequestStreamID(ctx context.Context, requestID string) (string, error) {
+	return r.Client.GetDel(ctx, requestID).Result()
+}
+
+func asyncJobRedisKey(requestID string) string {
+	return fmt.Sprintf("async_job:%s", requestID)
+}
+
+// Keep the callback URL of an async API request, it's empty if it only gets polled
+// Expires with the stream ID, a request can't be cancelled after that
+func (r *RedisWrapper) SetAsyncJobCallbackURL(ctx context.Context, requestID string, callbackURL string) error {
+	return r.Client.Set(ctx, asyncJobRedisKey(requestID), callbackURL, 1*time.Hour).Err()
+}
+
+// Get the callback URL of an async API request, async is false if it wasn't one
+func (r *RedisWrapper) GetAsyncJobCallbackURL(ctx context.Context, requestID string) (callbackURL string, async bool, err error) {
+	callbackURL, err = r.Client.Get(ctx, asyncJobRedisKey(requestID)).Result()
+	if err == redis.Nil {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+	return callbackURL, true, nil
+}
+
+func cancelledJobRedisKey(requestID string) string {
+	return fmt.Sprintf("cancelled_job:%s", requestID)
+}
+
+// Mark a request cancelled, so consumers that pick it up later skip it
+func (r *RedisWrapper) SetJobCancelled(ctx context.Context, requestID string) error {
+	return r.Client.Set(ctx, cancelledJobRedisKey(requestID), "1", shared.CANCELLED_JOB_TTL).Err()
+}
+
+// Whether a request was cancelled
+func (r *RedisWrapper) IsJobCancelled(ctx context.Context, requestID string) (bool, error) {
+	exists, err := r.Client.Exists(ctx, cancelledJobRedisKey(requestID)).Result()
+	return exists > 0, err
+}
+
 // Caching embeddings
 func (r *RedisWrapper) CacheEmbeddings(ctx context.Context, key string, embedding []float32) error {
 	// Convert embedding to string
@@ -308,3 +358,202 @@ func (r *RedisWrapper) SetWorkerHealth(status shared.HEALTH_STATUS) error {
 	}
 	return errRedis
 }
+
+// ! Idempotency keys
+
+// A request made with an idempotency key
+type IdempotentRequest struct {
+	// Hash of the request body, a key can't be reused with a different body
+	BodyHash string `json:"body_hash"`
+	// Response of the request, status code is 0 while it is in progress
+	StatusCode  int    `json:"status_code,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+func idempotencyRedisKey(userID string, key string) string {
+	return fmt.Sprintf("idempotency:%s:%s", userID, key)
+}
+
+// Claim an idempotency key of user for a request, the claim lapses after IDEMPOTENCY_CLAIM_TTL if no response is stored
+// Returns nil if the key was claimed, otherwise the request that claimed it first
+func (r *RedisWrapper) ClaimIdempotencyKey(userID string, key string, bodyHash string) (*IdempotentRequest, error) {
+	claim, err := json.Marshal(IdempotentRequest{BodyHash: bodyHash})
+	if err != nil {
+		return nil, err
+	}
+	claimed, err := r.Client.SetNX(r.Ctx, idempotencyRedisKey(userID, key), claim, shared.IDEMPOTENCY_CLAIM_TTL).Result()
+	if err != nil {
+		return nil, err
+	}
+	if claimed {
+		return nil, nil
+	}
+
+	existing, err := r.Client.Get(r.Ctx, idempotencyRedisKey(userID, key)).Bytes()
+	if err == redis.Nil {
+		// Released or expired in the meantime
+		return r.ClaimIdempotencyKey(userID, key, bodyHash)
+	} else if err != nil {
+		return nil, err
+	}
+	var req IdempotentRequest
+	if err := json.Unmarshal(existing, &req); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// Store the response of the request that claimed an idempotency key, kept for IDEMPOTENCY_KEY_TTL
+func (r *RedisWrapper) SetIdempotentResponse(userID string, key string, req IdempotentRequest) error {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	// Only if still claimed, the key may have expired
+	err = r.Client.SetArgs(r.Ctx, idempotencyRedisKey(userID, key), b, redis.SetArgs{TTL: shared.IDEMPOTENCY_KEY_TTL, Mode: "XX"}).Err()
+	if err == redis.Nil {
+		return nil
+	}
+	return err
+}
+
+// Release an idempotency key so the request can be retried
+func (r *RedisWrapper) DeleteIdempotencyKey(userID string, key string) error {
+	return r.Client.Del(r.Ctx, idempotencyRedisKey(userID, key)).Err()
+}
+
+// An event kept in an SSE stream's backlog
+type SSEEvent struct {
+	ID   int64
+	Data []byte
+}
+
+func sseEventIDRedisKey(streamID string) string {
+	return fmt.Sprintf("sse:event_id:%s", streamID)
+}
+
+func sseBacklogRedisKey(streamID string) string {
+	return fmt.Sprintf("sse:backlog:%s", streamID)
+}
+
+// Next event ID of a stream, IDs only go up while the stream has had an event within the backlog TTL
+func (r *RedisWrapper) NextSSEEventID(streamID string) (int64, error) {
+	pipe := r.Client.TxPipeline()
+	incr := pipe.Incr(r.Ctx, sseEventIDRedisKey(streamID))
+	pipe.Expire(r.Ctx, sseEventIDRedisKey(streamID), shared.SSE_STREAM_BACKLOG_TTL)
+	if _, err := pipe.Exec(r.Ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// Add an event to a stream's backlog, only the most recent SSE_STREAM_BACKLOG_SIZE are kept
+func (r *RedisWrapper) AddSSEBacklogEvent(streamID string, event SSEEvent) error {
+	key := sseBacklogRedisKey(streamID)
+	pipe := r.Client.TxPipeline()
+	pipe.ZAdd(r.Ctx, key, redis.Z{Score: float64(event.ID), Member: event.Data})
+	pipe.ZRemRangeByRank(r.Ctx, key, 0, -shared.SSE_STREAM_BACKLOG_SIZE-1)
+	pipe.Expire(r.Ctx, key, shared.SSE_STREAM_BACKLOG_TTL)
+	_, err := pipe.Exec(r.Ctx)
+	return err
+}
+
+// Events of a stream after afterID, oldest first
+func (r *RedisWrapper) GetSSEBacklogEvents(streamID string, afterID int64) ([]SSEEvent, error) {
+	res, err := r.Client.ZRangeByScoreWithScores(r.Ctx, sseBacklogRedisKey(streamID), &redis.ZRangeBy{
+		Min: fmt.Sprintf("(%d", afterID),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	events := make([]SSEEvent, len(res))
+	for i, z := range res {
+		data, _ := z.Member.(string)
+		events[i] = SSEEvent{ID: int64(z.Score), Data: []byte(data)}
+	}
+	return events, nil
+}
+
+// Progress of loading a new qdrant collection, so the migration can pick up where it stopped
+type QdrantMigrationState struct {
+	Collection string `json:"collection"`
+	Alias      string `json:"alias"`
+	// Collection the alias pointed at before
+	Source string `json:"source"`
+	// Stage is backfill, switched or done
+	Stage     string    `json:"stage"`
+	StartedAt time.Time `json:"started_at"`
+	// Last output loaded, outputs are loaded oldest first
+	CursorCreatedAt *time.Time `json:"cursor_created_at,omitempty"`
+	CursorID        *uuid.UUID `json:"cursor_id,omitempty"`
+	Upserted        int        `json:"upserted"`
+	Skipped         int        `json:"skipped"`
+	// Outputs updated since this are loaded by the next catch-up after the switch, StartedAt if not set
+	CaughtUpSince *time.Time `json:"caught_up_since,omitempty"`
+	// When the catch-up in progress started, it becomes CaughtUpSince when it's done
+	CatchUpStartedAt *time.Time `json:"catch_up_started_at,omitempty"`
+}
+
+func qdrantMigrationRedisKey(collection string) string {
+	return fmt.Sprintf("qdrant_migration:%s", collection)
+}
+
+// Get the migration into a collection, nil if it hasn't started
+func (r *RedisWrapper) GetQdrantMigrationState(collection string) (*QdrantMigrationState, error) {
+	b, err := r.Client.Get(r.Ctx, qdrantMigrationRedisKey(collection)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var state QdrantMigrationState
+	err = json.Unmarshal(b, &state)
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func (r *RedisWrapper) SetQdrantMigrationState(state *QdrantMigrationState) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return r.Client.Set(r.Ctx, qdrantMigrationRedisKey(state.Collection), b, 0).Err()
+}
+
+// A request from an admin to run a cron job now
+type CronJobTrigger struct {
+	Job         shared.CronJobName `json:"job"`
+	TriggeredBy uuid.UUID          `json:"triggered_by"`
+	RequestedAt time.Time          `json:"requested_at"`
+}
+
+const cronJobTriggersRedisKey = "cron_job_triggers"
+
+// Queue a cron job trigger, picked up by one cron instance
+func (r *RedisWrapper) EnqueueCronJobTrigger(trigger CronJobTrigger) error {
+	b, err := json.Marshal(trigger)
+	if err != nil {
+		return err
+	}
+	return r.Client.RPush(r.Ctx, cronJobTriggersRedisKey, b).Err()
+}
+
+// Wait up to timeout for the next cron job trigger, nil if none came
+func (r *RedisWrapper) WaitCronJobTrigger(timeout time.Duration) (*CronJobTrigger, error) {
+	res, err := r.Client.BLPop(r.Ctx, timeout, cronJobTriggersRedisKey).Result()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	// Key and value
+	var trigger CronJobTrigger
+	if err := json.Unmarshal([]byte(res[1]), &trigger); err != nil {
+		return nil, err
+	}
+	return &trigger, nil
+}
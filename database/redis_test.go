@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/server/requests"
@@ -135,3 +136,97 @@ func TestGetPendingGenerationAndUpscaleIDs(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, int64(3), s)
 }
+
+func TestSSEBacklog(t *testing.T) {
+	origMockRedis := utils.GetEnv().MockRedis
+	utils.GetEnv().MockRedis = true
+	defer func() {
+		utils.GetEnv().MockRedis = origMockRedis
+	}()
+	redis, err := NewRedis(context.TODO())
+	assert.Nil(t, err)
+
+	for i := 1; i <= shared.SSE_STREAM_BACKLOG_SIZE+5; i++ {
+		id, err := redis.NextSSEEventID("stream")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(i), id)
+		assert.Nil(t, redis.AddSSEBacklogEvent("stream", SSEEvent{ID: id, Data: []byte(fmt.Sprintf(`{"event_id":%d}`, id))}))
+	}
+
+	// IDs are per stream
+	id, err := redis.NextSSEEventID("other")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), id)
+
+	events, err := redis.GetSSEBacklogEvents("stream", int64(shared.SSE_STREAM_BACKLOG_SIZE+2))
+	assert.Nil(t, err)
+	assert.Len(t, events, 3)
+	assert.Equal(t, int64(shared.SSE_STREAM_BACKLOG_SIZE+3), events[0].ID)
+	assert.Equal(t, fmt.Sprintf(`{"event_id":%d}`, shared.SSE_STREAM_BACKLOG_SIZE+3), string(events[0].Data))
+
+	// Only the most recent are kept
+	events, err = redis.GetSSEBacklogEvents("stream", 0)
+	assert.Nil(t, err)
+	assert.Len(t, events, shared.SSE_STREAM_BACKLOG_SIZE)
+	assert.Equal(t, int64(6), events[0].ID)
+}
+
+func TestQdrantMigrationState(t *testing.T) {
+	origMockRedis := utils.GetEnv().MockRedis
+	utils.GetEnv().MockRedis = true
+	defer func() {
+		utils.GetEnv().MockRedis = origMockRedis
+	}()
+	redis, err := NewRedis(context.TODO())
+	assert.Nil(t, err)
+
+	state, err := redis.GetQdrantMigrationState("stablecog_v2")
+	assert.Nil(t, err)
+	assert.Nil(t, state)
+
+	cursorID := uuid.New()
+	cursorCreatedAt := time.Now().UTC().Truncate(time.Microsecond)
+	assert.Nil(t, redis.SetQdrantMigrationState(&QdrantMigrationState{
+		Collection:      "stablecog_v2",
+		Alias:           "stablecog_live",
+		Source:          "stablecog",
+		Stage:           "backfill",
+		CursorCreatedAt: &cursorCreatedAt,
+		CursorID:        &cursorID,
+		Upserted:        200,
+	}))
+
+	state, err = redis.GetQdrantMigrationState("stablecog_v2")
+	assert.Nil(t, err)
+	assert.Equal(t, "stablecog", state.Source)
+	assert.Equal(t, cursorID, *state.CursorID)
+	assert.True(t, cursorCreatedAt.Equal(*state.CursorCreatedAt))
+	assert.Equal(t, 200, state.Upserted)
+}
+
+func TestAsyncJobCallbackURL(t *testing.T) {
+	origMockRedis := utils.GetEnv().MockRedis
+	utils.GetEnv().MockRedis = true
+	defer func() {
+		utils.GetEnv().MockRedis = origMockRedis
+	}()
+	redis, err := NewRedis(context.TODO())
+	assert.Nil(t, err)
+
+	_, async, err := redis.GetAsyncJobCallbackURL(redis.Ctx, "sync")
+	assert.Nil(t, err)
+	assert.False(t, async)
+
+	// Polled only
+	assert.Nil(t, redis.SetAsyncJobCallbackURL(redis.Ctx, "polled", ""))
+	callbackURL, async, err := redis.GetAsyncJobCallbackURL(redis.Ctx, "polled")
+	assert.Nil(t, err)
+	assert.True(t, async)
+	assert.Equal(t, "", callbackURL)
+
+	assert.Nil(t, redis.SetAsyncJobCallbackURL(redis.Ctx, "callback", "https://example.com/hook"))
+	callbackURL, async, err = redis.GetAsyncJobCallbackURL(redis.Ctx, "callback")
+	assert.Nil(t, err)
+	assert.True(t, async)
+	assert.Equal(t, "https://example.com/hook", callbackURL)
+}
@@ -4,14 +4,22 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database/ent"
 	"github.com/stablecog/sc-go/database/ent/apitoken"
+	"github.com/stablecog/sc-go/database/ent/predicate"
+	"github.com/stablecog/sc-go/server/requests"
 	"github.com/stablecog/sc-go/shared"
 	"github.com/stablecog/sc-go/utils"
 )
 
-func (r *Repository) NewAPIToken(userId uuid.UUID, name string) (dbToken *ent.ApiToken, token string, err error) {
+var ApiTokenAlreadyRotatedErr = fmt.Errorf("token_already_rotated")
+var ApiTokenCreditCapErr = fmt.Errorf("token_credit_cap_reached")
+var ApiTokenLimitErr = fmt.Errorf("too_many_tokens")
+
+// Token has every scope unless req limits them
+func (r *Repository) NewAPIToken(userId uuid.UUID, req requests.NewTokenRequest) (dbToken *ent.ApiToken, token string, err error) {
 	// Create a new random 64 character token
 	token, err = utils.GenerateRandomHex(nil, 32)
 	if err != nil {
@@ -21,15 +29,36 @@ func (r *Repository) NewAPIToken(userId uuid.UUID, name string) (dbToken *ent.Ap
 	// Set prefix
 	token = fmt.Sprintf("%s%s", shared.API_TOKEN_PREFIX, token)
 
+	name := req.Name
 	if name == "" {
 		name = shared.DEFAULT_API_TOKEN_NAME
 	}
 
+	scopes := make([]string, len(req.Scopes))
+	for i, scope := range req.Scopes {
+		scopes[i] = string(scope)
+	}
+	if len(scopes) == 0 {
+		for _, scope := range shared.ApiTokenScopes {
+			scopes = append(scopes, string(scope))
+		}
+	}
+
 	// Get token short string as 3...3
 	tokenShortString := fmt.Sprintf("%s...%s", token[0:3], token[len(token)-4:])
 
 	// Create in DB
-	dbToken, err = r.DB.ApiToken.Create().SetHashedToken(utils.Sha256(token)).SetUserID(userId).SetName(name).SetShortString(tokenShortString).SetIsActive(true).SetUses(0).Save(r.Ctx)
+	dbToken, err = r.DB.ApiToken.Create().
+		SetHashedToken(utils.Sha256(token)).
+		SetUserID(userId).
+		SetName(name).
+		SetShortString(tokenShortString).
+		SetIsActive(true).
+		SetUses(0).
+		SetScopes(scopes).
+		SetNillableExpiresAt(req.ExpiresAt).
+		SetNillableMonthlyCreditCap(req.MonthlyCreditCap).
+		Save(r.Ctx)
 	if err != nil {
 		return nil, "", err
 	}
@@ -69,10 +98,222 @@ func (r *Repository) NewAPITokenForAuthClient(userId uuid.UUID, authClient *ent.
 	return dbToken, token, nil
 }
 
-func (r *Repository) SetTokenUsedAndIncrementCreditsSpent(creditsSpent int, tokenId uuid.UUID) error {
-	return r.DB.ApiToken.Update().Where(apitoken.IDEQ(tokenId)).AddUses(1).AddCreditsSpent(creditsSpent).SetLastUsedAt(time.Now()).Exec(r.Ctx)
+// usageId is the usage log entry of the request the credits were spent by, if known
+// The monthly spend was already counted when the credits were reserved, see ReserveApiTokenCredits
+func (r *Repository) SetTokenUsedAndIncrementCreditsSpent(creditsSpent int, tokenId uuid.UUID, usageId *uuid.UUID) error {
+	if usageId != nil {
+		err := r.DB.ApiTokenUsage.UpdateOneID(*usageId).AddCreditsSpent(creditsSpent).Exec(r.Ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+	}
+	return r.DB.ApiToken.Update().
+		Where(apitoken.IDEQ(tokenId)).
+		AddUses(1).
+		AddCreditsSpent(creditsSpent).
+		SetLastUsedAt(time.Now()).
+		Exec(r.Ctx)
+}
+
+// Tokens with room for amount more credits under their monthly cap, given what they've spent this month
+func apiTokenCapAllows(amount int, spentThisMonth bool) predicate.ApiToken {
+	return predicate.ApiToken(func(s *sql.Selector) {
+		spent := "0"
+		if spentThisMonth {
+			spent = s.C(apitoken.FieldMonthlyCreditsSpent)
+		}
+		s.Where(sql.Or(
+			sql.IsNull(s.C(apitoken.FieldMonthlyCreditCap)),
+			sql.ExprP(fmt.Sprintf("%s + %d <= %s", spent, amount, s.C(apitoken.FieldMonthlyCreditCap))),
+		))
+	})
+}
+
+// Token whose monthly spend tokenID is charged to, the newest token of its rotations
+// Rotated tokens keep working through their grace period, they share the cap of the token that replaced them
+func (r *Repository) apiTokenSpendID(tokenID uuid.UUID, DB *ent.Client) (uuid.UUID, error) {
+	for {
+		next, err := DB.ApiToken.Query().Where(apitoken.RotatedFromIDEQ(tokenID)).OnlyID(r.Ctx)
+		if ent.IsNotFound(err) {
+			return tokenID, nil
+		} else if err != nil {
+			return uuid.Nil, err
+		}
+		tokenID = next
+	}
+}
+
+// Count the credits of hold towards the token's monthly spend, before the job is queued
+// The check and the increment are one conditional update so concurrent requests can't go over the cap together
+// Returns ApiTokenCreditCapErr if the token doesn't have room, releasing the hold gives the credits back
+func (r *Repository) ReserveApiTokenCredits(tokenID uuid.UUID, hold *ent.CreditHold, DB *ent.Client) error {
+	if DB == nil {
+		DB = r.DB
+	}
+	tokenID, err := r.apiTokenSpendID(tokenID, DB)
+	if err != nil {
+		return err
+	}
+	period := ApiTokenMonthlyPeriod(time.Now())
+	amount := int(hold.Amount)
+	updated, err := DB.ApiToken.Update().
+		Where(apitoken.IDEQ(tokenID), apitoken.MonthlyCreditsPeriodGTE(period), apiTokenCapAllows(amount, true)).
+		AddMonthlyCreditsSpent(amount).
+		Save(r.Ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		// First spend of the month starts over
+		updated, err = DB.ApiToken.Update().
+			Where(
+				apitoken.IDEQ(tokenID),
+				apitoken.Or(apitoken.MonthlyCreditsPeriodIsNil(), apitoken.MonthlyCreditsPeriodLT(period)),
+				apiTokenCapAllows(amount, false),
+			).
+			SetMonthlyCreditsSpent(amount).
+			SetMonthlyCreditsPeriod(period).
+			Save(r.Ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if updated == 0 {
+		return ApiTokenCreditCapErr
+	}
+	return DB.CreditHold.UpdateOneID(hold.ID).SetAPITokenID(tokenID).SetAPITokenPeriod(period).Exec(r.Ctx)
+}
+
+// Take the credits of a released hold off its token's monthly spend, if they're still counted in it
+func (r *Repository) releaseApiTokenCredits(hold *ent.CreditHold, DB *ent.Client) error {
+	if hold.APITokenID == nil || hold.APITokenPeriod == nil {
+		return nil
+	}
+	// Counted in the token that replaced it if it was rotated since
+	tokenID, err := r.apiTokenSpendID(*hold.APITokenID, DB)
+	if err != nil {
+		return err
+	}
+	amount := int(hold.Amount)
+	return DB.ApiToken.Update().
+		Where(
+			apitoken.IDEQ(tokenID),
+			apitoken.MonthlyCreditsPeriodEQ(*hold.APITokenPeriod),
+			apitoken.MonthlyCreditsSpentGTE(amount),
+		).
+		AddMonthlyCreditsSpent(-amount).
+		Exec(r.Ctx)
 }
 
 func (r *Repository) DeactivateTokenForUser(id uuid.UUID, userId uuid.UUID) (int, error) {
 	return r.DB.ApiToken.Update().Where(apitoken.IDEQ(id), apitoken.UserIDEQ(userId), apitoken.IsActive(true)).SetIsActive(false).Save(r.Ctx)
 }
+
+// Deactivate rotated tokens once their grace period is over
+func (r *Repository) DeactivateRotatedTokens() (int, error) {
+	return r.DB.ApiToken.Update().Where(
+		apitoken.IsActive(true),
+		apitoken.ExpiresAtLTE(time.Now()),
+		func(s *sql.Selector) {
+			t := sql.Table(apitoken.Table)
+			s.Where(sql.In(s.C(apitoken.FieldID), sql.Select(t.C(apitoken.FieldRotatedFromID)).From(t).Where(sql.NotNull(t.C(apitoken.FieldRotatedFromID)))))
+		},
+	).SetIsActive(false).Save(r.Ctx)
+}
+
+// Issues a replacement for the token with the same name, scopes and monthly cap, linked to it by rotated_from_id
+// The old token keeps working for gracePeriod, or until its own expiry if that's sooner
+func (r *Repository) RotateAPIToken(id uuid.UUID, userId uuid.UUID, gracePeriod time.Duration) (dbToken *ent.ApiToken, oldToken *ent.ApiToken, token string, err error) {
+	// Create a new random 64 character token
+	token, err = utils.GenerateRandomHex(nil, 32)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	// Set prefix
+	token = fmt.Sprintf("%s%s", shared.API_TOKEN_PREFIX, token)
+
+	// Get token short string as 3...3
+	tokenShortString := fmt.Sprintf("%s...%s", token[0:3], token[len(token)-4:])
+
+	now := time.Now()
+	err = r.WithTx(func(tx *ent.Tx) error {
+		db := tx.Client()
+		oldToken, err = db.ApiToken.Query().Where(
+			apitoken.IDEQ(id),
+			apitoken.UserIDEQ(userId),
+			apitoken.IsActive(true),
+			apitoken.Or(apitoken.ExpiresAtIsNil(), apitoken.ExpiresAtGT(now)),
+		).Only(r.Ctx)
+		if err != nil {
+			return err
+		}
+
+		rotated, err := db.ApiToken.Query().Where(apitoken.RotatedFromIDEQ(id)).Exist(r.Ctx)
+		if err != nil {
+			return err
+		}
+		if rotated {
+			return ApiTokenAlreadyRotatedErr
+		}
+
+		expiresAt := now.Add(gracePeriod)
+		if oldToken.ExpiresAt == nil || oldToken.ExpiresAt.After(expiresAt) {
+			oldToken, err = db.ApiToken.UpdateOne(oldToken).SetExpiresAt(expiresAt).Save(r.Ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		// The new token replaces the old one, neither it nor tokens replaced before count as extra tokens
+		if oldToken.AuthClientID == nil {
+			rotations, err := db.ApiToken.Query().Where(
+				apitoken.UserIDEQ(userId),
+				apitoken.RotatedFromIDNotNil(),
+			).Select(apitoken.FieldRotatedFromID).All(r.Ctx)
+			if err != nil {
+				return err
+			}
+			replaced := []uuid.UUID{oldToken.ID}
+			for _, rotation := range rotations {
+				replaced = append(replaced, *rotation.RotatedFromID)
+			}
+			count, err := db.ApiToken.Query().Where(
+				apitoken.UserIDEQ(userId),
+				apitoken.IsActive(true),
+				apitoken.AuthClientIDIsNil(),
+				apitoken.Or(apitoken.ExpiresAtIsNil(), apitoken.ExpiresAtGT(now)),
+				apitoken.IDNotIn(replaced...),
+			).Count(r.Ctx)
+			if err != nil {
+				return err
+			}
+			if count >= shared.MAX_API_TOKENS_PER_USER {
+				return ApiTokenLimitErr
+			}
+		}
+
+		// Carry the monthly spend over so rotating doesn't reset the cap, the old token spends from it too from now on
+		create := db.ApiToken.Create().
+			SetHashedToken(utils.Sha256(token)).
+			SetUserID(userId).
+			SetName(oldToken.Name).
+			SetShortString(tokenShortString).
+			SetIsActive(true).
+			SetUses(0).
+			SetNillableAuthClientID(oldToken.AuthClientID).
+			SetNillableMonthlyCreditCap(oldToken.MonthlyCreditCap).
+			SetMonthlyCreditsSpent(oldToken.MonthlyCreditsSpent).
+			SetNillableMonthlyCreditsPeriod(oldToken.MonthlyCreditsPeriod).
+			SetRotatedFromID(oldToken.ID)
+		if oldToken.Scopes != nil {
+			create.SetScopes(oldToken.Scopes)
+		}
+		dbToken, err = create.Save(r.Ctx)
+		return err
+	})
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return dbToken, oldToken, token, nil
+}
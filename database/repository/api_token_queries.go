@@ -1,10 +1,14 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database/ent"
 	"github.com/stablecog/sc-go/database/ent/apitoken"
 	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+	"golang.org/x/exp/slices"
 )
 
 func (r *Repository) GetToken(id uuid.UUID) (*ent.ApiToken, error) {
@@ -28,10 +32,54 @@ func (r *Repository) GetTokensByUserID(userID uuid.UUID, filters *requests.ApiTo
 	return q.All(r.Ctx)
 }
 
+// Tokens of user counting towards the max, expired ones don't
 func (r *Repository) GetTokenCountByUserID(userID uuid.UUID) (int, error) {
-	return r.DB.ApiToken.Query().Where(apitoken.UserIDEQ(userID), apitoken.IsActive(true), apitoken.AuthClientIDIsNil()).Count(r.Ctx)
+	return r.DB.ApiToken.Query().Where(
+		apitoken.UserIDEQ(userID),
+		apitoken.IsActive(true),
+		apitoken.AuthClientIDIsNil(),
+		apitoken.Or(apitoken.ExpiresAtIsNil(), apitoken.ExpiresAtGT(time.Now())),
+	).Count(r.Ctx)
 }
 
 func (r *Repository) GetTokenByHashedToken(hashedToken string) (*ent.ApiToken, error) {
 	return r.DB.ApiToken.Query().Where(apitoken.HashedTokenEQ(hashedToken), apitoken.IsActiveEQ(true)).Only(r.Ctx)
 }
+
+// Start of the calendar month (UTC) that monthly token spend counts towards
+func ApiTokenMonthlyPeriod(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Credits spent by token in the current month
+func ApiTokenMonthlyCreditsSpent(token *ent.ApiToken) int {
+	if token.MonthlyCreditsPeriod == nil || token.MonthlyCreditsPeriod.Before(ApiTokenMonthlyPeriod(time.Now())) {
+		return 0
+	}
+	return token.MonthlyCreditsSpent
+}
+
+// Whether token can spend cost more credits this month
+func ApiTokenCanSpend(token *ent.ApiToken, cost int) bool {
+	return token.MonthlyCreditCap == nil || ApiTokenMonthlyCreditsSpent(token)+cost <= *token.MonthlyCreditCap
+}
+
+// Tokens without scopes predate them and can do everything
+func ApiTokenHasScope(token *ent.ApiToken, scope shared.ApiTokenScope) bool {
+	return token.Scopes == nil || slices.Contains(token.Scopes, string(scope))
+}
+
+// Tokens with every scope aren't restricted, they're the only ones allowed on routes without a scope
+func ApiTokenHasAllScopes(token *ent.ApiToken) bool {
+	for _, scope := range shared.ApiTokenScopes {
+		if !ApiTokenHasScope(token, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+func ApiTokenExpired(token *ent.ApiToken) bool {
+	return token.ExpiresAt != nil && !time.Now().Before(*token.ExpiresAt)
+}
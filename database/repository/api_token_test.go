@@ -0,0 +1,313 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/apitoken"
+	"github.com/stablecog/sc-go/database/ent/apitokenusage"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPITokenScopes(t *testing.T) {
+	userID := uuid.MustParse(MOCK_NORMAL_UUID)
+	t.Cleanup(func() {
+		MockRepo.DB.ApiToken.Delete().Where(apitoken.UserIDEQ(userID), apitoken.NameHasPrefix("scoped-")).ExecX(MockRepo.Ctx)
+	})
+
+	// Every scope by default
+	token, _, err := MockRepo.NewAPIToken(userID, requests.NewTokenRequest{Name: "scoped-all"})
+	assert.Nil(t, err)
+	assert.Len(t, token.Scopes, len(shared.ApiTokenScopes))
+	assert.Nil(t, token.ExpiresAt)
+	assert.Nil(t, token.MonthlyCreditCap)
+	assert.True(t, ApiTokenCanSpend(token, 1000))
+	assert.True(t, ApiTokenHasAllScopes(token))
+	assert.False(t, ApiTokenExpired(token))
+
+	expiresAt := time.Now().Add(time.Hour)
+	token, _, err = MockRepo.NewAPIToken(userID, requests.NewTokenRequest{
+		Name:             "scoped-read",
+		Scopes:           []shared.ApiTokenScope{shared.ApiTokenScopeOutputsRead},
+		ExpiresAt:        &expiresAt,
+		MonthlyCreditCap: utils.ToPtr(10),
+	})
+	assert.Nil(t, err)
+	assert.True(t, ApiTokenHasScope(token, shared.ApiTokenScopeOutputsRead))
+	assert.False(t, ApiTokenHasScope(token, shared.ApiTokenScopeImageGenerate))
+	assert.False(t, ApiTokenHasAllScopes(token))
+	assert.False(t, ApiTokenExpired(token))
+	assert.Equal(t, 10, *token.MonthlyCreditCap)
+
+	// Tokens created before scopes existed can do everything
+	token.Scopes = nil
+	assert.True(t, ApiTokenHasScope(token, shared.ApiTokenScopeImageGenerate))
+	assert.True(t, ApiTokenHasAllScopes(token))
+
+	past := time.Now().Add(-time.Minute)
+	token.ExpiresAt = &past
+	assert.True(t, ApiTokenExpired(token))
+}
+
+func TestApiTokenMonthlyCreditCap(t *testing.T) {
+	userID := createCreditHoldTestUser(t, 100)
+	token, _, err := MockRepo.NewAPIToken(userID, requests.NewTokenRequest{Name: "capped", MonthlyCreditCap: utils.ToPtr(10)})
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		MockRepo.DB.ApiToken.DeleteOneID(token.ID).ExecX(MockRepo.Ctx)
+	})
+	hold := func(amount int32) *ent.CreditHold {
+		h, err := MockRepo.HoldCredits(userID, amount, credithold.ProcessTypeGenerate, nil)
+		assert.Nil(t, err)
+		return h
+	}
+
+	// Reserved when the credits are held
+	assert.Nil(t, MockRepo.ReserveApiTokenCredits(token.ID, hold(4), nil))
+	five := hold(5)
+	assert.Nil(t, MockRepo.ReserveApiTokenCredits(token.ID, five, nil))
+	assert.ErrorIs(t, MockRepo.ReserveApiTokenCredits(token.ID, hold(2), nil), ApiTokenCreditCapErr)
+	token, err = MockRepo.GetToken(token.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 9, ApiTokenMonthlyCreditsSpent(token))
+	assert.True(t, ApiTokenCanSpend(token, 1))
+	assert.False(t, ApiTokenCanSpend(token, 2))
+
+	// Finishing doesn't count the credits again
+	assert.Nil(t, MockRepo.SetTokenUsedAndIncrementCreditsSpent(4, token.ID, nil))
+	token, err = MockRepo.GetToken(token.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 9, ApiTokenMonthlyCreditsSpent(token))
+	assert.Equal(t, 4, token.CreditsSpent)
+	assert.Equal(t, 1, token.Uses)
+
+	// Failing gives them back
+	five, err = MockRepo.DB.CreditHold.Get(MockRepo.Ctx, five.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, token.ID, *five.APITokenID)
+	released, err := MockRepo.ReleaseHold(five, "TIMEOUT", nil)
+	assert.Nil(t, err)
+	assert.True(t, released)
+	token, err = MockRepo.GetToken(token.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, ApiTokenMonthlyCreditsSpent(token))
+
+	// Spend from a previous month doesn't count
+	lastMonth := ApiTokenMonthlyPeriod(time.Now()).AddDate(0, -1, 0)
+	MockRepo.DB.ApiToken.UpdateOneID(token.ID).SetMonthlyCreditsPeriod(lastMonth).ExecX(MockRepo.Ctx)
+	token, err = MockRepo.GetToken(token.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, ApiTokenMonthlyCreditsSpent(token))
+	assert.True(t, ApiTokenCanSpend(token, 10))
+
+	assert.ErrorIs(t, MockRepo.ReserveApiTokenCredits(token.ID, hold(11), nil), ApiTokenCreditCapErr)
+	assert.Nil(t, MockRepo.ReserveApiTokenCredits(token.ID, hold(3), nil))
+	token, err = MockRepo.GetToken(token.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, ApiTokenMonthlyCreditsSpent(token))
+}
+
+func TestGetTokenCountByUserIDSkipsExpired(t *testing.T) {
+	userID := createCreditHoldTestUser(t, 0)
+	t.Cleanup(func() {
+		MockRepo.DB.ApiToken.Delete().Where(apitoken.UserIDEQ(userID)).ExecX(MockRepo.Ctx)
+	})
+
+	_, _, err := MockRepo.NewAPIToken(userID, requests.NewTokenRequest{Name: "live"})
+	assert.Nil(t, err)
+	expired, _, err := MockRepo.NewAPIToken(userID, requests.NewTokenRequest{Name: "expired"})
+	assert.Nil(t, err)
+	MockRepo.DB.ApiToken.UpdateOneID(expired.ID).SetExpiresAt(time.Now().Add(-time.Minute)).ExecX(MockRepo.Ctx)
+
+	count, err := MockRepo.GetTokenCountByUserID(userID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestRotateAPIToken(t *testing.T) {
+	userID := uuid.MustParse(MOCK_NORMAL_UUID)
+	t.Cleanup(func() {
+		MockRepo.DB.ApiToken.Delete().Where(apitoken.UserIDEQ(userID), apitoken.NameEQ("rotated")).ExecX(MockRepo.Ctx)
+	})
+
+	old, oldStr, err := MockRepo.NewAPIToken(userID, requests.NewTokenRequest{
+		Name:             "rotated",
+		Scopes:           []shared.ApiTokenScope{shared.ApiTokenScopeImageGenerate},
+		MonthlyCreditCap: utils.ToPtr(10),
+	})
+	assert.Nil(t, err)
+	MockRepo.DB.ApiToken.UpdateOneID(old.ID).SetMonthlyCreditsSpent(4).SetMonthlyCreditsPeriod(ApiTokenMonthlyPeriod(time.Now())).ExecX(MockRepo.Ctx)
+
+	// Only the owner can rotate
+	_, _, _, err = MockRepo.RotateAPIToken(old.ID, uuid.MustParse(MOCK_ADMIN_UUID), time.Hour)
+	assert.True(t, ent.IsNotFound(err))
+
+	before := time.Now()
+	token, rotated, tokenStr, err := MockRepo.RotateAPIToken(old.ID, userID, time.Hour)
+	assert.Nil(t, err)
+	assert.NotEqual(t, oldStr, tokenStr)
+	assert.Equal(t, old.ID, *token.RotatedFromID)
+	assert.Equal(t, "rotated", token.Name)
+	assert.Equal(t, []string{string(shared.ApiTokenScopeImageGenerate)}, token.Scopes)
+	assert.Equal(t, 10, *token.MonthlyCreditCap)
+	assert.Equal(t, 4, ApiTokenMonthlyCreditsSpent(token))
+	assert.Nil(t, token.ExpiresAt)
+
+	// Old token works until the grace period is over
+	assert.WithinDuration(t, before.Add(time.Hour), *rotated.ExpiresAt, time.Minute)
+	old, err = MockRepo.GetTokenByHashedToken(utils.Sha256(oldStr))
+	assert.Nil(t, err)
+	assert.False(t, ApiTokenExpired(old))
+
+	_, _, _, err = MockRepo.RotateAPIToken(old.ID, userID, time.Hour)
+	assert.ErrorIs(t, err, ApiTokenAlreadyRotatedErr)
+
+	// No grace period revokes the old token right away
+	_, rotated, _, err = MockRepo.RotateAPIToken(token.ID, userID, 0)
+	assert.Nil(t, err)
+	assert.True(t, ApiTokenExpired(rotated))
+
+	// Only the token whose grace period is over is deactivated
+	deactivated, err := MockRepo.DeactivateRotatedTokens()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, deactivated)
+	assert.False(t, MockRepo.DB.ApiToken.GetX(MockRepo.Ctx, token.ID).IsActive)
+	assert.True(t, MockRepo.DB.ApiToken.GetX(MockRepo.Ctx, old.ID).IsActive)
+}
+
+func TestRotatedAPITokenSharesCreditCap(t *testing.T) {
+	userID := createCreditHoldTestUser(t, 100)
+	old, _, err := MockRepo.NewAPIToken(userID, requests.NewTokenRequest{Name: "rotated-cap", MonthlyCreditCap: utils.ToPtr(10)})
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		MockRepo.DB.ApiToken.Delete().Where(apitoken.UserIDEQ(userID)).ExecX(MockRepo.Ctx)
+	})
+	hold := func(amount int32) *ent.CreditHold {
+		h, err := MockRepo.HoldCredits(userID, amount, credithold.ProcessTypeGenerate, nil)
+		assert.Nil(t, err)
+		return h
+	}
+	four := hold(4)
+	assert.Nil(t, MockRepo.ReserveApiTokenCredits(old.ID, four, nil))
+
+	token, _, _, err := MockRepo.RotateAPIToken(old.ID, userID, time.Hour)
+	assert.Nil(t, err)
+
+	// The old token spends from the new one's cap during the grace period
+	five := hold(5)
+	assert.Nil(t, MockRepo.ReserveApiTokenCredits(old.ID, five, nil))
+	assert.ErrorIs(t, MockRepo.ReserveApiTokenCredits(old.ID, hold(2), nil), ApiTokenCreditCapErr)
+	assert.ErrorIs(t, MockRepo.ReserveApiTokenCredits(token.ID, hold(2), nil), ApiTokenCreditCapErr)
+	token, err = MockRepo.GetToken(token.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 9, ApiTokenMonthlyCreditsSpent(token))
+	old, err = MockRepo.GetToken(old.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, ApiTokenMonthlyCreditsSpent(old))
+
+	// Holds from before and after the rotation give their credits back to the shared cap
+	for _, h := range []*ent.CreditHold{four, five} {
+		h, err = MockRepo.DB.CreditHold.Get(MockRepo.Ctx, h.ID)
+		assert.Nil(t, err)
+		released, err := MockRepo.ReleaseHold(h, "TIMEOUT", nil)
+		assert.Nil(t, err)
+		assert.True(t, released)
+	}
+	token, err = MockRepo.GetToken(token.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, ApiTokenMonthlyCreditsSpent(token))
+}
+
+func TestRotateAPITokenLimit(t *testing.T) {
+	userID := uuid.MustParse(MOCK_NORMAL_UUID)
+	t.Cleanup(func() {
+		MockRepo.DB.ApiToken.Delete().Where(apitoken.UserIDEQ(userID), apitoken.NameEQ("limit")).ExecX(MockRepo.Ctx)
+	})
+
+	count, err := MockRepo.GetTokenCountByUserID(userID)
+	assert.Nil(t, err)
+	var token *ent.ApiToken
+	for i := count; i < shared.MAX_API_TOKENS_PER_USER; i++ {
+		token, _, err = MockRepo.NewAPIToken(userID, requests.NewTokenRequest{Name: "limit"})
+		assert.Nil(t, err)
+	}
+
+	// Rotating at the limit replaces the token, the old one keeps working through its grace period
+	rotated, _, _, err := MockRepo.RotateAPIToken(token.ID, userID, time.Hour)
+	assert.Nil(t, err)
+	assert.NotNil(t, MockRepo.DB.ApiToken.GetX(MockRepo.Ctx, token.ID).ExpiresAt)
+
+	// So does rotating the new one before the old one's grace period ends
+	_, _, _, err = MockRepo.RotateAPIToken(rotated.ID, userID, time.Hour)
+	assert.Nil(t, err)
+
+	// Other tokens can't be created until the rotated ones expire
+	count, err = MockRepo.GetTokenCountByUserID(userID)
+	assert.Nil(t, err)
+	assert.Equal(t, shared.MAX_API_TOKENS_PER_USER+2, count)
+}
+
+func TestApiTokenUsage(t *testing.T) {
+	userID := uuid.MustParse(MOCK_NORMAL_UUID)
+	token, _, err := MockRepo.NewAPIToken(userID, requests.NewTokenRequest{Name: "usage"})
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		MockRepo.DB.ApiTokenUsage.Delete().Where(apitokenusage.APITokenIDEQ(token.ID)).ExecX(MockRepo.Ctx)
+		MockRepo.DB.ApiToken.DeleteOneID(token.ID).ExecX(MockRepo.Ctx)
+	})
+
+	first, err := MockRepo.CreateApiTokenUsage(token.ID, "1.2.3.4", "GET /v1/image/generation/outputs")
+	assert.Nil(t, err)
+	time.Sleep(time.Millisecond)
+	second, err := MockRepo.CreateApiTokenUsage(token.ID, "5.6.7.8", "POST /v1/image/generation/create")
+	assert.Nil(t, err)
+	assert.Nil(t, MockRepo.SetTokenUsedAndIncrementCreditsSpent(2, token.ID, &second.ID))
+
+	usage, next, err := MockRepo.GetApiTokenUsage(token.ID, 1, nil)
+	assert.Nil(t, err)
+	assert.Len(t, usage, 1)
+	assert.Equal(t, second.ID, usage[0].ID)
+	assert.Equal(t, "5.6.7.8", usage[0].IP)
+	assert.Equal(t, 2, usage[0].CreditsSpent)
+	assert.NotNil(t, next)
+
+	usage, next, err = MockRepo.GetApiTokenUsage(token.ID, 1, next)
+	assert.Nil(t, err)
+	assert.Len(t, usage, 1)
+	assert.Equal(t, first.ID, usage[0].ID)
+	assert.Equal(t, 0, usage[0].CreditsSpent)
+	assert.Nil(t, next)
+
+	// Entries written together can share a timestamp
+	at := time.Now().Add(-time.Hour)
+	buffered := []NewApiTokenUsage{
+		{ID: uuid.New(), ApiTokenID: token.ID, IP: "1.2.3.4", Endpoint: "GET /v1/credits", CreatedAt: at},
+		{ID: uuid.New(), ApiTokenID: token.ID, IP: "1.2.3.4", Endpoint: "GET /v1/credits", CreatedAt: at},
+	}
+	assert.Nil(t, MockRepo.CreateApiTokenUsageBulk(buffered))
+	seen := map[uuid.UUID]bool{}
+	for cursor := (*utils.KeysetCursor)(nil); ; {
+		usage, cursor, err = MockRepo.GetApiTokenUsage(token.ID, 1, cursor)
+		assert.Nil(t, err)
+		for _, u := range usage {
+			seen[u.ID] = true
+		}
+		if cursor == nil {
+			break
+		}
+	}
+	assert.Len(t, seen, 4)
+
+	pruned, err := MockRepo.PruneApiTokenUsage(at.Add(time.Minute))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, pruned)
+	usage, _, err = MockRepo.GetApiTokenUsage(token.ID, 10, nil)
+	assert.Nil(t, err)
+	assert.Len(t, usage, 2)
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/apitokenusage"
+	"github.com/stablecog/sc-go/utils"
+)
+
+func (r *Repository) CreateApiTokenUsage(tokenId uuid.UUID, ip string, endpoint string) (*ent.ApiTokenUsage, error) {
+	return r.DB.ApiTokenUsage.Create().
+		SetAPITokenID(tokenId).
+		SetIP(ip).
+		SetEndpoint(endpoint).
+		Save(r.Ctx)
+}
+
+// A usage entry that's written later with others
+type NewApiTokenUsage struct {
+	ID         uuid.UUID
+	ApiTokenID uuid.UUID
+	IP         string
+	Endpoint   string
+	CreatedAt  time.Time
+}
+
+func (r *Repository) CreateApiTokenUsageBulk(usages []NewApiTokenUsage) error {
+	if len(usages) == 0 {
+		return nil
+	}
+	builders := make([]*ent.ApiTokenUsageCreate, len(usages))
+	for i, u := range usages {
+		builders[i] = r.DB.ApiTokenUsage.Create().
+			SetID(u.ID).
+			SetAPITokenID(u.ApiTokenID).
+			SetIP(u.IP).
+			SetEndpoint(u.Endpoint).
+			SetCreatedAt(u.CreatedAt)
+	}
+	return r.DB.ApiTokenUsage.CreateBulk(builders...).Exec(r.Ctx)
+}
+
+// Delete usage entries older than before
+func (r *Repository) PruneApiTokenUsage(before time.Time) (int, error) {
+	return r.DB.ApiTokenUsage.Delete().Where(apitokenusage.CreatedAtLT(before)).Exec(r.Ctx)
+}
+
+// Newest first, next is the cursor for the following page or nil if there isn't one
+func (r *Repository) GetApiTokenUsage(tokenId uuid.UUID, limit int, cursor *utils.KeysetCursor) (usage []*ent.ApiTokenUsage, next *utils.KeysetCursor, err error) {
+	q := r.DB.ApiTokenUsage.Query().Where(apitokenusage.APITokenIDEQ(tokenId))
+	if cursor != nil {
+		q = q.Where(apitokenusage.Or(
+			apitokenusage.CreatedAtLT(cursor.CreatedAt),
+			apitokenusage.And(apitokenusage.CreatedAtEQ(cursor.CreatedAt), apitokenusage.IDLT(cursor.ID)),
+		))
+	}
+	usage, err = q.Order(ent.Desc(apitokenusage.FieldCreatedAt), ent.Desc(apitokenusage.FieldID)).Limit(limit + 1).All(r.Ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(usage) > limit {
+		usage = usage[:limit]
+		next = &utils.KeysetCursor{CreatedAt: usage[limit-1].CreatedAt, ID: usage[limit-1].ID}
+	}
+	return usage, next, nil
+}
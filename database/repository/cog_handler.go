@@ -2,7 +2,6 @@
 package repository
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -18,17 +17,65 @@ import (
 )
 
 // Consider a generation/upscale a failure due to timeout
-func (r *Repository) FailCogMessageDueToTimeoutIfTimedOut(msg requests.CogWebhookMessage) {
+// Returns true if the request timed out and was marked as failed
+func (r *Repository) FailCogMessageDueToTimeoutIfTimedOut(msg requests.CogWebhookMessage) bool {
 	deleted, err := r.Redis.DeleteCogRequestStreamID(r.Redis.Ctx, msg.Input.ID.String())
 	if err != nil {
 		log.Error("Error deleting stream ID from redis", "err", err)
-		return
+		return false
 	}
 	if deleted == 0 {
 		// Means it didnt time out
+		return false
+	}
+
+	msg.Error = shared.TIMEOUT_ERROR
+	r.FinishBackendJob(msg)
+	if !r.failUnfinishedCogMessage(msg) {
+		return false
+	}
+	r.deadLetterCogMessage(msg, msg.Error)
+	return true
+}
+
+// Count a finished job towards the health of the backend that ran it
+// Every result path calls this, so the backend_job key of the job doesn't linger
+func (r *Repository) FinishBackendJob(msg requests.CogWebhookMessage) {
+	if msg.Status != requests.CogSucceeded && msg.Status != requests.CogFailed {
+		return
+	}
+	// Says nothing about the backend
+	if msg.Error == shared.NSFW_ERROR || msg.Error == shared.CANCELLED_ERROR {
+		return
+	}
+	success := msg.Status == requests.CogSucceeded
+	backend, err := shared.NewBackendHealthTracker(r.Redis.Ctx, r.Redis.Client).FinishJob(msg.Input.ID, success)
+	if err != nil {
+		log.Error("Error recording backend result", "id", msg.Input.ID, "err", err)
+	}
+	if backend == "" {
 		return
 	}
+	status, err := shared.NewCircuitBreaker(r.Redis.Ctx, r.Redis.Client, utils.GetEnv().GetCircuitBreakerConfig()).Record(backend, success)
+	if err != nil {
+		log.Error("Error recording circuit result", "id", msg.Input.ID, "backend", backend, "err", err)
+	} else if status.State != shared.CircuitClosed {
+		log.Info("Backend circuit not closed", "backend", backend, "state", status.State, "successes", status.Successes)
+	}
+}
+
+// Count a job whose result never arrived in time as a failure of the backend that ran it
+func (r *Repository) FinishTimedOutBackendJob(id uuid.UUID) {
+	r.FinishBackendJob(requests.CogWebhookMessage{
+		Input:  requests.BaseCogRequest{ID: id},
+		Status: requests.CogFailed,
+		Error:  shared.TIMEOUT_ERROR,
+	})
+}
 
+// Fails a request nobody else is going to finish, the caller must have deleted its stream ID key
+// Refunds the user, frees their queue slot and lets them know over SSE
+func (r *Repository) failUnfinishedCogMessage(msg requests.CogWebhookMessage) bool {
 	// Dec queue count
 	if msg.Input.UserID != nil {
 		var prefix string
@@ -45,21 +92,24 @@ func (r *Repository) FailCogMessageDueToTimeoutIfTimedOut(msg requests.CogWebhoo
 		}
 	}
 
-	// Remove from mq_log
-	_, err = r.DeleteFromQueueLog(utils.Sha256(msg.Input.ID.String()), nil)
+	// Remove from mq_log, cancelled messages are still in the queue so they're kept and marked
+	var err error
+	if msg.Error == shared.CANCELLED_ERROR {
+		_, err = r.CancelInQueueLog(utils.Sha256(msg.Input.ID.String()), nil)
+	} else {
+		_, err = r.DeleteFromQueueLog(utils.Sha256(msg.Input.ID.String()), nil)
+	}
 	if err != nil {
-		log.Errorf("Error deleting from queue log: %v", err)
+		log.Errorf("Error updating queue log: %v", err)
 	}
 
-	// ! Execute timeout failure
+	// ! Execute failure
 	// Get process type
 	if msg.Input.ProcessType != shared.GENERATE && msg.Input.ProcessType != shared.UPSCALE && msg.Input.ProcessType != shared.GENERATE_AND_UPSCALE && msg.Input.ProcessType != shared.VOICEOVER {
 		log.Error("Invalid process type from cog, can't handle message", "process_type", msg.Input.ProcessType)
-		return
+		return false
 	}
 
-	msg.Error = shared.TIMEOUT_ERROR
-
 	// Only set for failures in case of refund
 	var remainingCredits int
 
@@ -68,43 +118,43 @@ func (r *Repository) FailCogMessageDueToTimeoutIfTimedOut(msg requests.CogWebhoo
 		db := tx.Client()
 		if msg.Input.ProcessType == shared.UPSCALE {
 			r.SetUpscaleFailed(msg.Input.ID.String(), msg.Error, db)
-			user, err := r.DB.Upscale.Query().Where(upscale.IDEQ(msg.Input.ID)).QueryUser().Select(user.FieldID).First(r.Ctx)
+			user, err := db.Upscale.Query().Where(upscale.IDEQ(msg.Input.ID)).QueryUser().Select(user.FieldID).First(r.Ctx)
 			if err != nil {
 				log.Error("Error getting user ID from upscale", "err", err)
 				return err
 			}
 			userId = user.ID
 			// Upscale is always 1 credit
-			success, err := r.RefundCreditsToUser(userId, 1, db)
-			if err != nil || !success {
-				log.Error("Error refunding credits for upscale", "user", userId.String(), "id", msg.Input.ID, "err", err)
+			_, err = r.ReleaseCreditHold(msg.Input.ID, userId, 1, msg.Error, db)
+			if err != nil {
+				log.Error("Error releasing credits for upscale", "user", userId.String(), "id", msg.Input.ID, "err", err)
 				return err
 			}
 		} else if msg.Input.ProcessType == shared.VOICEOVER {
 			r.SetVoiceoverFailed(msg.Input.ID.String(), msg.Error, db)
-			user, err := r.DB.Voiceover.Query().Where(voiceover.IDEQ(msg.Input.ID)).QueryUser().Select(user.FieldID).First(r.Ctx)
+			user, err := db.Voiceover.Query().Where(voiceover.IDEQ(msg.Input.ID)).QueryUser().Select(user.FieldID).First(r.Ctx)
 			if err != nil {
 				log.Error("Error getting user ID from upscale", "err", err)
 				return err
 			}
 			userId = user.ID
 			creditAmount := utils.CalculateVoiceoverCredits(msg.Input.Prompt)
-			success, err := r.RefundCreditsToUser(userId, creditAmount, db)
-			if err != nil || !success {
-				log.Error("Error refunding credits for voiceover", "user", userId.String(), "id", msg.Input.ID, "err", err)
+			_, err = r.ReleaseCreditHold(msg.Input.ID, userId, creditAmount, msg.Error, db)
+			if err != nil {
+				log.Error("Error releasing credits for voiceover", "user", userId.String(), "id", msg.Input.ID, "err", err)
 				return err
 			}
 		} else {
 			r.SetGenerationFailed(msg.Input.ID.String(), msg.Error, msg.NSFWCount, db)
-			user, err := r.DB.Generation.Query().Where(generation.IDEQ(msg.Input.ID)).QueryUser().Select(user.FieldID).First(r.Ctx)
+			user, err := db.Generation.Query().Where(generation.IDEQ(msg.Input.ID)).QueryUser().Select(user.FieldID).First(r.Ctx)
 			if err != nil {
 				log.Error("Error getting user ID from upscale", "err", err)
 				return err
 			}
 			userId = user.ID
-			success, err := r.RefundCreditsToUser(userId, *msg.Input.NumOutputs, db)
-			if err != nil || !success {
-				log.Error("Error refunding credits for generation", "user", userId.String(), "id", msg.Input.ID, "err", err)
+			_, err = r.ReleaseCreditHold(msg.Input.ID, userId, *msg.Input.NumOutputs, msg.Error, db)
+			if err != nil {
+				log.Error("Error releasing credits for generation", "user", userId.String(), "id", msg.Input.ID, "err", err)
 				return err
 			}
 		}
@@ -117,8 +167,8 @@ func (r *Repository) FailCogMessageDueToTimeoutIfTimedOut(msg requests.CogWebhoo
 
 		return nil
 	}); err != nil {
-		log.Error("Error in processing timeout failure transaction", "err", err)
-		return
+		log.Error("Error in processing failure transaction", "err", err)
+		return false
 	}
 
 	// Regardless of the status, we always send over sse so user knows what's up
@@ -134,15 +184,11 @@ func (r *Repository) FailCogMessageDueToTimeoutIfTimedOut(msg requests.CogWebhoo
 		RemainingCredits: remainingCredits,
 	}
 
-	// Marshal
-	respBytes, err := json.Marshal(resp)
-	if err != nil {
-		log.Error("Error marshalling sse response", "err", err)
-		return
-	}
-
 	// Broadcast to all clients subcribed to this stream
-	r.Redis.Client.Publish(r.Redis.Ctx, shared.REDIS_SSE_BROADCAST_CHANNEL, respBytes)
+	if err := r.PublishTaskStatusUpdate(resp); err != nil {
+		log.Error("Error publishing sse response", "err", err)
+	}
+	return true
 }
 
 // Process a cog message into database
@@ -159,6 +205,8 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 		return fmt.Errorf("invalid process type from cog %s, can't handle message", msg.Input.ProcessType)
 	}
 
+	r.FinishBackendJob(msg)
+
 	var upscaleOutput *ent.UpscaleOutput
 	var voiceoverOutput *ent.VoiceoverOutput
 	var generationOutputs []*ent.GenerationOutput
@@ -188,7 +236,7 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 			log.Errorf("Error deleting from queue log: %v", err)
 		}
 		// ! Failures for reasons other than NSFW,
-		// ! We need to refund the credits
+		// ! We need to release the held credits
 		if err := r.WithTx(func(tx *ent.Tx) error {
 			db := tx.Client()
 			var userId uuid.UUID
@@ -201,9 +249,9 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 				}
 				userId = user.ID
 				// Upscale is always 1 credit
-				success, err := r.RefundCreditsToUser(userId, 1, db)
-				if err != nil || !success {
-					log.Error("Error refunding credits for upscale", "user", userId.String(), "id", msg.Input.ID, "err", err)
+				_, err = r.ReleaseCreditHold(msg.Input.ID, userId, 1, msg.Error, db)
+				if err != nil {
+					log.Error("Error releasing credits for upscale", "user", userId.String(), "id", msg.Input.ID, "err", err)
 					return err
 				}
 			} else if msg.Input.ProcessType == shared.VOICEOVER {
@@ -215,9 +263,9 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 				}
 				userId = user.ID
 				creditAmount := utils.CalculateVoiceoverCredits(msg.Input.Prompt)
-				success, err := r.RefundCreditsToUser(userId, creditAmount, db)
-				if err != nil || !success {
-					log.Error("Error refunding credits for voiceover", "user", userId.String(), "id", msg.Input.ID, "err", err)
+				_, err = r.ReleaseCreditHold(msg.Input.ID, userId, creditAmount, msg.Error, db)
+				if err != nil {
+					log.Error("Error releasing credits for voiceover", "user", userId.String(), "id", msg.Input.ID, "err", err)
 					return err
 				}
 			} else {
@@ -228,9 +276,9 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 					return err
 				}
 				userId = user.ID
-				success, err := r.RefundCreditsToUser(userId, *msg.Input.NumOutputs, db)
-				if err != nil || !success {
-					log.Error("Error refunding credits for generation", "user", userId.String(), "id", msg.Input.ID, "err", err)
+				_, err = r.ReleaseCreditHold(msg.Input.ID, userId, *msg.Input.NumOutputs, msg.Error, db)
+				if err != nil {
+					log.Error("Error releasing credits for generation", "user", userId.String(), "id", msg.Input.ID, "err", err)
 					return err
 				}
 			}
@@ -245,6 +293,7 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 			log.Error("Error with transaction in cog message process", "err", err)
 			return err
 		}
+		r.deadLetterCogMessage(msg, msg.Error)
 	} else if msg.Status == requests.CogSucceeded {
 		_, err := r.DeleteFromQueueLog(utils.Sha256(msg.Input.ID.String()), nil)
 		if err != nil {
@@ -253,7 +302,7 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 		if len(msg.Output.Images) == 0 && msg.Input.ProcessType != shared.VOICEOVER {
 			if err := r.WithTx(func(tx *ent.Tx) error {
 				db := tx.Client()
-				// NSFW comes back as a success, but with no outputs and nsfw count
+				// NSFW comes back as a success, but with no outputs and nsfw count, those are still charged
 				processRefund := false
 				if msg.NSFWCount > 0 {
 					cogErr = shared.NSFW_ERROR
@@ -273,9 +322,9 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 							log.Error("Error getting user ID from upscale", "err", err)
 							return err
 						}
-						success, err := r.RefundCreditsToUser(user.ID, 1, db)
-						if err != nil || !success {
-							log.Error("Error refunding credits for upscale", "user", user.ID.String(), "id", msg.Input.ID, "err", err)
+						_, err = r.ReleaseCreditHold(msg.Input.ID, user.ID, 1, cogErr, db)
+						if err != nil {
+							log.Error("Error releasing credits for upscale", "user", user.ID.String(), "id", msg.Input.ID, "err", err)
 							return err
 						}
 						remainingCredits, err = r.GetNonExpiredCreditTotalForUser(user.ID, db)
@@ -283,6 +332,9 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 							log.Error("Error getting remaining credits", "err", err)
 							return err
 						}
+					} else if _, err := r.CaptureCreditHold(msg.Input.ID, db); err != nil {
+						log.Error("Error capturing credits", "id", msg.Input.ID, "err", err)
+						return err
 					}
 				} else {
 					err := r.SetGenerationFailed(msg.Input.ID.String(), cogErr, msg.NSFWCount, db)
@@ -296,9 +348,9 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 							log.Error("Error getting user ID from upscale", "err", err)
 							return err
 						}
-						success, err := r.RefundCreditsToUser(user.ID, *msg.Input.NumOutputs, db)
-						if err != nil || !success {
-							log.Error("Error refunding credits for upscale", "user", user.ID.String(), "id", msg.Input.ID, "err", err)
+						_, err = r.ReleaseCreditHold(msg.Input.ID, user.ID, *msg.Input.NumOutputs, cogErr, db)
+						if err != nil {
+							log.Error("Error releasing credits for upscale", "user", user.ID.String(), "id", msg.Input.ID, "err", err)
 							return err
 						}
 						remainingCredits, err = r.GetNonExpiredCreditTotalForUser(user.ID, db)
@@ -306,6 +358,9 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 							log.Error("Error getting remaining credits", "err", err)
 							return err
 						}
+					} else if _, err := r.CaptureCreditHold(msg.Input.ID, db); err != nil {
+						log.Error("Error capturing credits", "id", msg.Input.ID, "err", err)
+						return err
 					}
 				}
 				msg.Status = requests.CogFailed
@@ -328,9 +383,9 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 					return err
 				}
 				creditAmount := utils.CalculateVoiceoverCredits(msg.Input.Prompt)
-				success, err := r.RefundCreditsToUser(user.ID, creditAmount, db)
-				if err != nil || !success {
-					log.Error("Error refunding credits for voiceover", "user", user.ID.String(), "id", msg.Input.ID, "err", err)
+				_, err = r.ReleaseCreditHold(msg.Input.ID, user.ID, creditAmount, "No outputs", db)
+				if err != nil {
+					log.Error("Error releasing credits for voiceover", "user", user.ID.String(), "id", msg.Input.ID, "err", err)
 					return err
 				}
 				remainingCredits, err = r.GetNonExpiredCreditTotalForUser(user.ID, db)
@@ -366,9 +421,9 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 
 	// Regardless of the status, we always send over sse so user knows what's up
 	// Send message to user
-	var respBytes []byte
+	var resp TaskStatusUpdateResponse
 	if msg.Input.ProcessType != shared.VOICEOVER {
-		resp := TaskStatusUpdateResponse{
+		resp = TaskStatusUpdateResponse{
 			Status:           msg.Status,
 			Id:               msg.Input.ID.String(),
 			UIId:             msg.Input.UIId,
@@ -414,15 +469,9 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 			}
 			resp.Outputs = generateOutputs
 		}
-		// Marshal
-		respBytes, err = json.Marshal(resp)
-		if err != nil {
-			log.Error("Error marshalling sse response", "err", err)
-			return err
-		}
 	} else {
 		// Voiceover
-		resp := TaskStatusUpdateResponse{
+		resp = TaskStatusUpdateResponse{
 			Status:           msg.Status,
 			Id:               msg.Input.ID.String(),
 			UIId:             msg.Input.UIId,
@@ -444,12 +493,6 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 				},
 			}
 		}
-		// Marshal
-		respBytes, err = json.Marshal(resp)
-		if err != nil {
-			log.Error("Error marshalling sse response", "err", err)
-			return err
-		}
 	}
 
 	// Dec queue count
@@ -469,6 +512,8 @@ func (r *Repository) ProcessCogMessage(msg requests.CogWebhookMessage) error {
 	}
 
 	// Broadcast to all clients subcribed to this stream
-	r.Redis.Client.Publish(r.Redis.Ctx, shared.REDIS_SSE_BROADCAST_CHANNEL, respBytes)
+	if err := r.PublishTaskStatusUpdate(resp); err != nil {
+		log.Error("Error publishing sse response", "err", err)
+	}
 	return nil
 }
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFinishBackendJob(t *testing.T) {
+	tracker := shared.NewBackendHealthTracker(MockRepo.Redis.Ctx, MockRepo.Redis.Client)
+	modelID := uuid.New()
+
+	// Results that say nothing about the backend leave the job alone
+	jobID := uuid.New()
+	assert.Nil(t, tracker.StartJob(jobID, modelID, shared.BackendRunpodServerless))
+	MockRepo.FinishBackendJob(requests.CogWebhookMessage{
+		Input:  requests.BaseCogRequest{ID: jobID},
+		Status: requests.CogFailed,
+		Error:  shared.NSFW_ERROR,
+	})
+	MockRepo.FinishBackendJob(requests.CogWebhookMessage{
+		Input:  requests.BaseCogRequest{ID: jobID},
+		Status: requests.CogProcessing,
+	})
+	health, err := tracker.Get(modelID, shared.BackendRunpodServerless)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), health.Samples)
+
+	// A final result is recorded and clears the job
+	MockRepo.FinishBackendJob(requests.CogWebhookMessage{
+		Input:  requests.BaseCogRequest{ID: jobID},
+		Status: requests.CogSucceeded,
+	})
+	health, err = tracker.Get(modelID, shared.BackendRunpodServerless)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), health.Samples)
+	backend, err := tracker.FinishJob(jobID, true)
+	assert.Nil(t, err)
+	assert.Equal(t, shared.BackendType(""), backend)
+
+	// Timeouts count as failures
+	jobID = uuid.New()
+	assert.Nil(t, tracker.StartJob(jobID, modelID, shared.BackendRunpodServerless))
+	MockRepo.FinishTimedOutBackendJob(jobID)
+	health, err = tracker.Get(modelID, shared.BackendRunpodServerless)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), health.Samples)
+	assert.Less(t, health.Score, 1.0)
+	backend, err = tracker.FinishJob(jobID, true)
+	assert.Nil(t, err)
+	assert.Equal(t, shared.BackendType(""), backend)
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/credittransaction"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/upscale"
+	"github.com/stablecog/sc-go/database/ent/voiceover"
+	"github.com/stablecog/sc-go/shared"
+)
+
+// Take credits from user and hold them until the job they're for is captured or released
+// Returns nil if the user doesn't have enough credits
+func (r *Repository) HoldCredits(userID uuid.UUID, amount int32, processType credithold.ProcessType, DB *ent.Client) (*ent.CreditHold, error) {
+	if DB == nil {
+		DB = r.DB
+	}
+	id := uuid.New()
+	deducted, err := r.deductCredits(userID, amount, false, credittransaction.TypeHold, &id, DB)
+	if err != nil {
+		return nil, err
+	} else if !deducted {
+		return nil, nil
+	}
+
+	expiresAt := time.Now().Add(shared.CREDIT_HOLD_TTL)
+	if processType == credithold.ProcessTypeGenerationBatch {
+		// Split to the items as they're dispatched, which can take a while
+		expiresAt = NEVER_EXPIRE
+	}
+	return DB.CreditHold.Create().
+		SetID(id).
+		SetUserID(userID).
+		SetAmount(amount).
+		SetStatus(credithold.StatusHeld).
+		SetProcessType(processType).
+		SetExpiresAt(expiresAt).
+		Save(r.Ctx)
+}
+
+// Set the job a hold is for, once it's created
+func (r *Repository) AttachCreditHold(id uuid.UUID, jobID uuid.UUID, DB *ent.Client) error {
+	if DB == nil {
+		DB = r.DB
+	}
+	return DB.CreditHold.UpdateOneID(id).SetJobID(jobID).Exec(r.Ctx)
+}
+
+// Move amount from the hold of parentJobID to a new hold, i.e. from a batch to one of its items
+func (r *Repository) SplitCreditHold(parentJobID uuid.UUID, amount int32, processType credithold.ProcessType, DB *ent.Client) (*ent.CreditHold, error) {
+	if DB == nil {
+		DB = r.DB
+	}
+	parent, err := DB.CreditHold.Query().Where(credithold.JobIDEQ(parentJobID), credithold.StatusEQ(credithold.StatusHeld)).First(r.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := DB.CreditHold.Update().
+		Where(credithold.IDEQ(parent.ID), credithold.StatusEQ(credithold.StatusHeld), credithold.AmountGTE(amount)).
+		AddAmount(-amount)
+	if parent.Amount == amount {
+		update.SetStatus(credithold.StatusSplit)
+	}
+	updated, err := update.Save(r.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, fmt.Errorf("hold %s doesn't have %d credits to split", parent.ID, amount)
+	}
+
+	return DB.CreditHold.Create().
+		SetUserID(parent.UserID).
+		SetAmount(amount).
+		SetStatus(credithold.StatusHeld).
+		SetProcessType(processType).
+		SetParentID(parent.ID).
+		SetNillableAPITokenID(parent.APITokenID).
+		SetNillableAPITokenPeriod(parent.APITokenPeriod).
+		SetExpiresAt(time.Now().Add(shared.CREDIT_HOLD_TTL)).
+		Save(r.Ctx)
+}
+
+// Charge the credits held for a job, returns false if there was nothing held
+func (r *Repository) CaptureCreditHold(jobID uuid.UUID, DB *ent.Client) (captured bool, err error) {
+	if DB == nil {
+		DB = r.DB
+	}
+	updated, err := DB.CreditHold.Update().
+		Where(credithold.JobIDEQ(jobID), credithold.StatusEQ(credithold.StatusHeld)).
+		SetStatus(credithold.StatusCaptured).
+		SetCapturedAt(time.Now()).
+		Save(r.Ctx)
+	if err != nil {
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+// Give the credits held for a job back to the user
+// Jobs from before holds existed have none, they're refunded legacyAmount instead
+// Returns false if the hold was already captured or released, so a job is never refunded twice
+func (r *Repository) ReleaseCreditHold(jobID uuid.UUID, userID uuid.UUID, legacyAmount int32, reason string, DB *ent.Client) (released bool, err error) {
+	if DB == nil {
+		DB = r.DB
+	}
+	hold, err := DB.CreditHold.Query().Where(credithold.JobIDEQ(jobID), credithold.StatusEQ(credithold.StatusHeld)).First(r.Ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return false, err
+	}
+	if hold != nil {
+		return r.ReleaseHold(hold, reason, DB)
+	}
+
+	settled, err := DB.CreditHold.Query().Where(credithold.JobIDEQ(jobID)).Exist(r.Ctx)
+	if err != nil || settled {
+		return false, err
+	}
+	return r.refundCredits(userID, legacyAmount, credittransaction.TypeRefund, &jobID, DB)
+}
+
+// Give the credits of hold back to its user and its API token's monthly spend, returns false if it was already settled
+func (r *Repository) ReleaseHold(hold *ent.CreditHold, reason string, DB *ent.Client) (released bool, err error) {
+	if DB == nil {
+		DB = r.DB
+	}
+	updated, err := DB.CreditHold.Update().
+		Where(credithold.IDEQ(hold.ID), credithold.StatusEQ(credithold.StatusHeld)).
+		SetStatus(credithold.StatusReleased).
+		SetReleaseReason(reason).
+		SetReleasedAt(time.Now()).
+		Save(r.Ctx)
+	if err != nil || updated == 0 {
+		return false, err
+	}
+	refunded, err := r.refundCredits(hold.UserID, hold.Amount, credittransaction.TypeRelease, &hold.ID, DB)
+	if err != nil {
+		return false, err
+	} else if !refunded {
+		return false, fmt.Errorf("refund of hold %s failed", hold.ID)
+	}
+	if err := r.releaseApiTokenCredits(hold, DB); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Credits of user held for jobs that are in flight
+func (r *Repository) GetHeldCreditsForUser(userID uuid.UUID) (int, error) {
+	var total []struct {
+		Sum int
+	}
+	err := r.DB.CreditHold.Query().Where(credithold.UserIDEQ(userID), credithold.StatusEQ(credithold.StatusHeld)).Aggregate(ent.Sum(credithold.FieldAmount)).Scan(r.Ctx, &total)
+	if err != nil {
+		return 0, err
+	} else if len(total) == 0 {
+		return 0, nil
+	}
+	return total[0].Sum, nil
+}
+
+// Job holds that should have been captured or released by now
+func (r *Repository) GetExpiredCreditHolds() ([]*ent.CreditHold, error) {
+	return r.DB.CreditHold.Query().
+		Where(
+			credithold.StatusEQ(credithold.StatusHeld),
+			credithold.ExpiresAtLT(time.Now()),
+			credithold.ProcessTypeNEQ(credithold.ProcessTypeGenerationBatch),
+		).
+		Order(ent.Asc(credithold.FieldExpiresAt)).
+		Limit(100).
+		All(r.Ctx)
+}
+
+// Status and failure reason of the job a hold is for
+func (r *Repository) GetCreditHoldJobStatus(hold *ent.CreditHold) (status string, failureReason *string, err error) {
+	if hold.JobID == nil {
+		return "", nil, &ent.NotFoundError{}
+	}
+	switch hold.ProcessType {
+	case credithold.ProcessTypeUpscale:
+		u, err := r.DB.Upscale.Query().Where(upscale.IDEQ(*hold.JobID)).Select(upscale.FieldStatus, upscale.FieldFailureReason).Only(r.Ctx)
+		if err != nil {
+			return "", nil, err
+		}
+		return string(u.Status), u.FailureReason, nil
+	case credithold.ProcessTypeVoiceover:
+		v, err := r.DB.Voiceover.Query().Where(voiceover.IDEQ(*hold.JobID)).Select(voiceover.FieldStatus, voiceover.FieldFailureReason).Only(r.Ctx)
+		if err != nil {
+			return "", nil, err
+		}
+		return string(v.Status), v.FailureReason, nil
+	default:
+		g, err := r.DB.Generation.Query().Where(generation.IDEQ(*hold.JobID)).Select(generation.FieldStatus, generation.FieldFailureReason).Only(r.Ctx)
+		if err != nil {
+			return "", nil, err
+		}
+		return string(g.Status), g.FailureReason, nil
+	}
+}
+
+func (r *Repository) GetCreditHoldsForJob(jobID uuid.UUID) ([]*ent.CreditHold, error) {
+	return r.DB.CreditHold.Query().Where(credithold.JobIDEQ(jobID)).Order(ent.Asc(credithold.FieldCreatedAt)).All(r.Ctx)
+}
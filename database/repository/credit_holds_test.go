@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/credit"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/credittransaction"
+	"github.com/stretchr/testify/assert"
+)
+
+// User with only credits, removed after the test so holds don't affect other tests
+func createCreditHoldTestUser(t *testing.T, credits int32) uuid.UUID {
+	id := uuid.New()
+	_, err := MockRepo.DB.User.Create().SetID(id).SetEmail(id.String() + "@stablecog.com").SetEmailNormalized(id.String() + "@stablecog.com").SetStripeCustomerID(id.String()).SetUsername(id.String()).Save(MockRepo.Ctx)
+	assert.Nil(t, err)
+	_, err = MockRepo.RefundCreditsToUser(id, credits, nil)
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		MockRepo.DB.CreditHold.Delete().Where(credithold.UserIDEQ(id)).ExecX(MockRepo.Ctx)
+		MockRepo.DB.CreditTransaction.Delete().Where(credittransaction.UserIDEQ(id)).ExecX(MockRepo.Ctx)
+		MockRepo.DB.Credit.Delete().Where(credit.UserIDEQ(id)).ExecX(MockRepo.Ctx)
+		MockRepo.DB.User.DeleteOneID(id).ExecX(MockRepo.Ctx)
+	})
+	return id
+}
+
+func TestCreditHoldLifecycle(t *testing.T) {
+	userID := createCreditHoldTestUser(t, 20)
+	before, err := MockRepo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+
+	// Not enough credits
+	hold, err := MockRepo.HoldCredits(userID, int32(before+1), credithold.ProcessTypeGenerate, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, hold)
+
+	// Hold -> release
+	jobID := uuid.New()
+	hold, err = MockRepo.HoldCredits(userID, 10, credithold.ProcessTypeGenerate, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, credithold.StatusHeld, hold.Status)
+	assert.Nil(t, MockRepo.AttachCreditHold(hold.ID, jobID, nil))
+
+	total, err := MockRepo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, before-10, total)
+	held, err := MockRepo.GetHeldCreditsForUser(userID)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, held)
+
+	released, err := MockRepo.ReleaseCreditHold(jobID, userID, 10, "TIMEOUT", nil)
+	assert.Nil(t, err)
+	assert.True(t, released)
+	total, err = MockRepo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, before, total)
+	held, err = MockRepo.GetHeldCreditsForUser(userID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, held)
+
+	// Settled holds aren't refunded or charged again
+	released, err = MockRepo.ReleaseCreditHold(jobID, userID, 10, "TIMEOUT", nil)
+	assert.Nil(t, err)
+	assert.False(t, released)
+	captured, err := MockRepo.CaptureCreditHold(jobID, nil)
+	assert.Nil(t, err)
+	assert.False(t, captured)
+	holds, err := MockRepo.GetCreditHoldsForJob(jobID)
+	assert.Nil(t, err)
+	assert.Len(t, holds, 1)
+	assert.Equal(t, credithold.StatusReleased, holds[0].Status)
+	assert.Equal(t, "TIMEOUT", *holds[0].ReleaseReason)
+
+	// Hold -> capture
+	jobID = uuid.New()
+	hold, err = MockRepo.HoldCredits(userID, 5, credithold.ProcessTypeUpscale, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, MockRepo.AttachCreditHold(hold.ID, jobID, nil))
+	captured, err = MockRepo.CaptureCreditHold(jobID, nil)
+	assert.Nil(t, err)
+	assert.True(t, captured)
+	released, err = MockRepo.ReleaseCreditHold(jobID, userID, 5, "TIMEOUT", nil)
+	assert.Nil(t, err)
+	assert.False(t, released)
+	total, err = MockRepo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, before-5, total)
+
+	// Jobs without a hold are refunded the legacy amount
+	released, err = MockRepo.ReleaseCreditHold(uuid.New(), userID, 5, "TIMEOUT", nil)
+	assert.Nil(t, err)
+	assert.True(t, released)
+	total, err = MockRepo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, before, total)
+}
+
+func TestSplitCreditHold(t *testing.T) {
+	userID := createCreditHoldTestUser(t, 6)
+
+	batchID := uuid.New()
+	parent, err := MockRepo.HoldCredits(userID, 6, credithold.ProcessTypeGenerationBatch, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, MockRepo.AttachCreditHold(parent.ID, batchID, nil))
+
+	child, err := MockRepo.SplitCreditHold(batchID, 4, credithold.ProcessTypeGenerate, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(4), child.Amount)
+	assert.Equal(t, parent.ID, *child.ParentID)
+	assert.Equal(t, credithold.StatusHeld, MockRepo.DB.CreditHold.GetX(MockRepo.Ctx, parent.ID).Status)
+
+	// Can't take more than what's left
+	_, err = MockRepo.SplitCreditHold(batchID, 3, credithold.ProcessTypeGenerate, nil)
+	assert.NotNil(t, err)
+
+	_, err = MockRepo.SplitCreditHold(batchID, 2, credithold.ProcessTypeGenerate, nil)
+	assert.Nil(t, err)
+	parent = MockRepo.DB.CreditHold.GetX(MockRepo.Ctx, parent.ID)
+	assert.Equal(t, credithold.StatusSplit, parent.Status)
+	assert.Equal(t, int32(0), parent.Amount)
+
+	// Batch holds are never reconciled
+	expired, err := MockRepo.GetExpiredCreditHolds()
+	assert.Nil(t, err)
+	for _, h := range expired {
+		assert.NotEqual(t, parent.ID, h.ID)
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database/ent"
 	"github.com/stablecog/sc-go/database/ent/credit"
+	"github.com/stablecog/sc-go/database/ent/credittransaction"
 	"github.com/stablecog/sc-go/database/ent/credittype"
+	"github.com/stablecog/sc-go/database/ent/predicate"
 	"github.com/stablecog/sc-go/database/ent/user"
 	"github.com/stablecog/sc-go/log"
 	"github.com/stablecog/sc-go/shared"
@@ -17,11 +19,47 @@ import (
 var NEVER_EXPIRE = time.Date(2100, 1, 1, 5, 0, 0, 0, time.UTC)
 
 func (r *Repository) DeleteCreditsWithLineItemID(lineItemID string) error {
-	_, err := r.DB.Credit.Delete().Where(credit.StripeLineItemIDEQ(lineItemID)).Exec(r.Ctx)
-	if err != nil {
+	return r.WithTx(func(tx *ent.Tx) error {
+		db := tx.Client()
+		credits, err := db.Credit.Query().Where(credit.StripeLineItemIDEQ(lineItemID)).All(r.Ctx)
+		if err != nil {
+			return err
+		}
+		for _, c := range credits {
+			if c.RemainingAmount == 0 {
+				continue
+			}
+			err = r.logCreditTransaction(c, credittransaction.TypeRevoke, -c.RemainingAmount, nil, db)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = db.Credit.Delete().Where(credit.StripeLineItemIDEQ(lineItemID)).Exec(r.Ctx)
 		return err
-	}
-	return nil
+	})
+}
+
+// Delete credits of user from lineItemID that haven't started yet, i.e. the remaining months of an annual plan
+func (r *Repository) RevokeFutureCreditsWithLineItemID(userID uuid.UUID, lineItemID string) error {
+	return r.WithTx(func(tx *ent.Tx) error {
+		db := tx.Client()
+		where := []predicate.Credit{credit.UserIDEQ(userID), credit.StartsAtGT(time.Now()), credit.StripeLineItemIDEQ(lineItemID)}
+		credits, err := db.Credit.Query().Where(where...).All(r.Ctx)
+		if err != nil {
+			return err
+		}
+		for _, c := range credits {
+			if c.RemainingAmount == 0 {
+				continue
+			}
+			err = r.logCreditTransaction(c, credittransaction.TypeRevoke, -c.RemainingAmount, nil, db)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = db.Credit.Delete().Where(where...).Exec(r.Ctx)
+		return err
+	})
 }
 
 // Give credits to user
@@ -30,8 +68,14 @@ func (r *Repository) AddCreditsToUser(creditType *ent.CreditType, userID uuid.UU
 		return errors.New("creditType cannot be nil")
 	}
 
-	_, err := r.DB.Credit.Create().SetCreditTypeID(creditType.ID).SetUserID(userID).SetRemainingAmount(creditType.Amount).SetExpiresAt(NEVER_EXPIRE).Save(r.Ctx)
-	return err
+	return r.WithTx(func(tx *ent.Tx) error {
+		db := tx.Client()
+		c, err := db.Credit.Create().SetCreditTypeID(creditType.ID).SetUserID(userID).SetRemainingAmount(creditType.Amount).SetExpiresAt(NEVER_EXPIRE).Save(r.Ctx)
+		if err != nil {
+			return err
+		}
+		return r.logCreditTransaction(c, credittransaction.TypeAdmin, c.RemainingAmount, nil, db)
+	})
 }
 
 // Add credits of creditType to user if they do not have any un-expired credits of this type
@@ -119,8 +163,11 @@ func (r *Repository) createCreditEntry(DB *ent.Client, creditType *ent.CreditTyp
 	if lineItemId != "" {
 		m = m.SetStripeLineItemID(lineItemId)
 	}
-	_, err := m.Save(r.Ctx)
-	return err
+	c, err := m.Save(r.Ctx)
+	if err != nil {
+		return err
+	}
+	return r.logCreditTransaction(c, credittransaction.TypeSubscription, c.RemainingAmount, nil, DB)
 }
 
 func (r *Repository) createTippableCreditEntry(DB *ent.Client, userID uuid.UUID, startsAt, expiresAt time.Time, period int, lineItemId string) error {
@@ -140,8 +187,11 @@ func (r *Repository) createTippableCreditEntry(DB *ent.Client, userID uuid.UUID,
 	if lineItemId != "" {
 		m = m.SetStripeLineItemID(lineItemId)
 	}
-	_, err = m.Save(r.Ctx)
-	return err
+	c, err := m.Save(r.Ctx)
+	if err != nil {
+		return err
+	}
+	return r.logCreditTransaction(c, credittransaction.TypeSubscription, c.RemainingAmount, nil, DB)
 }
 
 func (r *Repository) expireOldCredits(DB *ent.Client, userID uuid.UUID, creditTypeID uuid.UUID, lineItemId string) error {
@@ -157,6 +207,15 @@ func (r *Repository) expireOldCredits(DB *ent.Client, userID uuid.UUID, creditTy
 	if err != nil {
 		return err
 	}
+	for _, c := range creditsToExpire {
+		if c.RemainingAmount == 0 {
+			continue
+		}
+		err = r.logCreditTransaction(c, credittransaction.TypeExpire, -c.RemainingAmount, nil, DB)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Expire credits
 	err = DB.Credit.Update().
@@ -184,6 +243,25 @@ func (r *Repository) expireOldCredits(DB *ent.Client, userID uuid.UUID, creditTy
 			return err
 		}
 
+		tippableToExpire, err := DB.Credit.Query().
+			Where(credit.UserIDEQ(userID),
+				credit.CreditTypeIDEQ(tippableCreditType.ID),
+				credit.StripeLineItemIDIn(lineItemIDs...),
+				credit.ExpiresAtGT(now)).
+			All(r.Ctx)
+		if err != nil {
+			return err
+		}
+		for _, c := range tippableToExpire {
+			if c.RemainingAmount == 0 {
+				continue
+			}
+			err = r.logCreditTransaction(c, credittransaction.TypeExpire, -c.RemainingAmount, nil, DB)
+			if err != nil {
+				return err
+			}
+		}
+
 		err = DB.Credit.Update().
 			Where(credit.UserIDEQ(userID),
 				credit.CreditTypeIDEQ(tippableCreditType.ID),
@@ -226,6 +304,10 @@ func (r *Repository) GiveFreeCredits(userID uuid.UUID, DB *ent.Client) (added bo
 	if err != nil {
 		return false, err
 	}
+	err = r.logCreditTransaction(credits, credittransaction.TypeFree, credits.RemainingAmount, nil, DB)
+	if err != nil {
+		return false, err
+	}
 
 	return true, nil
 }
@@ -263,6 +345,10 @@ func (r *Repository) GiveFreeTippableCredits(userID uuid.UUID, DB *ent.Client) (
 	if err != nil {
 		return false, err
 	}
+	err = r.logCreditTransaction(credits, credittransaction.TypeFree, credits.RemainingAmount, nil, DB)
+	if err != nil {
+		return false, err
+	}
 
 	return true, nil
 }
@@ -285,19 +371,29 @@ func (r *Repository) AddAdhocCreditsIfEligible(creditType *ent.CreditType, userI
 		return false, nil
 	}
 
-	// Add credits
-	_, err = r.DB.Credit.Create().SetCreditTypeID(creditType.ID).SetUserID(userID).SetRemainingAmount(creditType.Amount).SetStripeLineItemID(lineItemID).SetExpiresAt(NEVER_EXPIRE).Save(r.Ctx)
-	if err != nil {
-		return false, err
-	}
-	// Also add tippable type
-	tippableCreditType, err := r.GetOrCreateTippableCreditType(nil)
-	if err != nil {
-		return false, err
-	}
-	tippableAmount := int32(float64(creditType.Amount) * shared.TIPPABLE_CREDIT_MULTIPLIER)
-	_, err = r.DB.Credit.Create().SetCreditTypeID(tippableCreditType.ID).SetUserID(userID).SetRemainingAmount(tippableAmount).SetStripeLineItemID(lineItemID).SetExpiresAt(NEVER_EXPIRE).Save(r.Ctx)
-	if err != nil {
+	if err := r.WithTx(func(tx *ent.Tx) error {
+		db := tx.Client()
+		// Add credits
+		c, err := db.Credit.Create().SetCreditTypeID(creditType.ID).SetUserID(userID).SetRemainingAmount(creditType.Amount).SetStripeLineItemID(lineItemID).SetExpiresAt(NEVER_EXPIRE).Save(r.Ctx)
+		if err != nil {
+			return err
+		}
+		err = r.logCreditTransaction(c, credittransaction.TypePurchase, c.RemainingAmount, nil, db)
+		if err != nil {
+			return err
+		}
+		// Also add tippable type
+		tippableCreditType, err := r.GetOrCreateTippableCreditType(db)
+		if err != nil {
+			return err
+		}
+		tippableAmount := int32(float64(creditType.Amount) * shared.TIPPABLE_CREDIT_MULTIPLIER)
+		c, err = db.Credit.Create().SetCreditTypeID(tippableCreditType.ID).SetUserID(userID).SetRemainingAmount(tippableAmount).SetStripeLineItemID(lineItemID).SetExpiresAt(NEVER_EXPIRE).Save(r.Ctx)
+		if err != nil {
+			return err
+		}
+		return r.logCreditTransaction(c, credittransaction.TypePurchase, c.RemainingAmount, nil, db)
+	}); err != nil {
 		return false, err
 	}
 	return true, nil
@@ -305,6 +401,14 @@ func (r *Repository) AddAdhocCreditsIfEligible(creditType *ent.CreditType, userI
 
 // Deduct credits from user, starting with credits that were refunded, then credits that expire soonest. Return true if deduction was successful
 func (r *Repository) DeductCreditsFromUser(userID uuid.UUID, amount int32, forTip bool, DB *ent.Client) (success bool, err error) {
+	txType := credittransaction.TypeSpend
+	if forTip {
+		txType = credittransaction.TypeTipSent
+	}
+	return r.deductCredits(userID, amount, forTip, txType, nil, DB)
+}
+
+func (r *Repository) deductCredits(userID uuid.UUID, amount int32, forTip bool, txType credittransaction.Type, referenceID *uuid.UUID, DB *ent.Client) (success bool, err error) {
 	if DB == nil {
 		DB = r.DB
 	}
@@ -355,6 +459,10 @@ func (r *Repository) DeductCreditsFromUser(userID uuid.UUID, amount int32, forTi
 				if err != nil {
 					return false, err
 				}
+				err = r.logCreditTransaction(c, txType, -toDeduct, referenceID, DB)
+				if err != nil {
+					return false, err
+				}
 				deducted += toDeduct
 				rowsAffected++
 			}
@@ -365,6 +473,10 @@ func (r *Repository) DeductCreditsFromUser(userID uuid.UUID, amount int32, forTi
 
 // Refund credits for user, starting with credits that expire soonest. Return true if refund was successful
 func (r *Repository) RefundCreditsToUser(userID uuid.UUID, amount int32, db *ent.Client) (success bool, err error) {
+	return r.refundCredits(userID, amount, credittransaction.TypeRefund, nil, db)
+}
+
+func (r *Repository) refundCredits(userID uuid.UUID, amount int32, txType credittransaction.Type, referenceID *uuid.UUID, db *ent.Client) (success bool, err error) {
 	if db == nil {
 		db = r.DB
 	}
@@ -395,7 +507,11 @@ func (r *Repository) RefundCreditsToUser(userID uuid.UUID, amount int32, db *ent
 		return false, err
 	} else if ent.IsNotFound(err) {
 		// Add credits
-		_, err = db.Credit.Create().SetCreditTypeID(refundCreditType.ID).SetUserID(userID).SetRemainingAmount(amount).SetExpiresAt(NEVER_EXPIRE).Save(r.Ctx)
+		credits, err = db.Credit.Create().SetCreditTypeID(refundCreditType.ID).SetUserID(userID).SetRemainingAmount(amount).SetExpiresAt(NEVER_EXPIRE).Save(r.Ctx)
+		if err != nil {
+			return false, err
+		}
+		err = r.logCreditTransaction(credits, txType, amount, referenceID, db)
 		if err != nil {
 			return false, err
 		}
@@ -407,6 +523,10 @@ func (r *Repository) RefundCreditsToUser(userID uuid.UUID, amount int32, db *ent
 	if err != nil {
 		return false, err
 	}
+	err = r.logCreditTransaction(credits, txType, amount, referenceID, db)
+	if err != nil {
+		return false, err
+	}
 	return true, nil
 }
 
@@ -434,27 +554,36 @@ func (r *Repository) ReplenishFreeCreditsToEligibleUsers() (int, error) {
 	var updated int
 	var updatedTippable int
 	if err := r.WithTx(func(tx *ent.Tx) error {
+		db := tx.Client()
+		freePredicates := []predicate.Credit{
+			credit.CreditTypeID(creditType.ID),
+			credit.RemainingAmountLT(creditType.Amount),
+			credit.ReplenishedAtLT(updatedAtSince),
+			credit.HasUsersWith(user.ActiveProductIDIsNil()),
+		}
+		if err := r.logReplenishedCredits(freePredicates, db); err != nil {
+			return err
+		}
 		updated, err = tx.Credit.Update().
-			Where(
-				credit.CreditTypeID(creditType.ID),
-				credit.RemainingAmountLT(creditType.Amount),
-				credit.ReplenishedAtLT(updatedAtSince),
-				credit.HasUsersWith(user.ActiveProductIDIsNil()),
-			).
+			Where(freePredicates...).
 			SetReplenishedAt(time.Now()).
 			AddRemainingAmount(shared.FREE_CREDIT_AMOUNT_DAILY).Save(r.Ctx)
 		if err != nil {
 			return err
 		}
 		// Also update tippable credit type
+		tippablePredicates := []predicate.Credit{
+			credit.CreditTypeID(creditTypeTippable.ID),
+			credit.StripeLineItemIDIsNil(),
+			credit.RemainingAmountLT(creditType.Amount / 2),
+			credit.ReplenishedAtLT(updatedAtSince),
+			credit.HasUsersWith(user.ActiveProductIDIsNil()),
+		}
+		if err := r.logReplenishedCredits(tippablePredicates, db); err != nil {
+			return err
+		}
 		updatedTippable, err = tx.Credit.Update().
-			Where(
-				credit.CreditTypeID(creditTypeTippable.ID),
-				credit.StripeLineItemIDIsNil(),
-				credit.RemainingAmountLT(creditType.Amount/2),
-				credit.ReplenishedAtLT(updatedAtSince),
-				credit.HasUsersWith(user.ActiveProductIDIsNil()),
-			).
+			Where(tippablePredicates...).
 			SetReplenishedAt(time.Now()).
 			AddRemainingAmount(shared.FREE_CREDIT_AMOUNT_DAILY).Save(r.Ctx)
 		// ! TODO re-enable?
@@ -475,3 +604,31 @@ func (r *Repository) ReplenishFreeCreditsToEligibleUsers() (int, error) {
 	}
 	return updated + updatedTippable, nil
 }
+
+const replenishLogChunkSize = 1000
+
+// Log the replenishment of the credits matching predicates, before they're updated
+func (r *Repository) logReplenishedCredits(predicates []predicate.Credit, DB *ent.Client) error {
+	credits, err := DB.Credit.Query().Where(predicates...).All(r.Ctx)
+	if err != nil {
+		return err
+	}
+	// Inserted in chunks, a single insert for every free user would go over postgres' limit on parameters
+	for start := 0; start < len(credits); start += replenishLogChunkSize {
+		end := min(start+replenishLogChunkSize, len(credits))
+		bulk := make([]*ent.CreditTransactionCreate, end-start)
+		for i, c := range credits[start:end] {
+			bulk[i] = DB.CreditTransaction.Create().
+				SetUserID(c.UserID).
+				SetCreditID(c.ID).
+				SetCreditTypeID(c.CreditTypeID).
+				SetType(credittransaction.TypeReplenish).
+				SetAmount(shared.FREE_CREDIT_AMOUNT_DAILY).
+				SetNillableStripeLineItemID(c.StripeLineItemID)
+		}
+		if err := DB.CreditTransaction.CreateBulk(bulk...).Exec(r.Ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
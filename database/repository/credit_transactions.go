@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/credittransaction"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// Append a change of credit c to its user's ledger, amount is negative when credits were taken from it
+func (r *Repository) logCreditTransaction(c *ent.Credit, txType credittransaction.Type, amount int32, referenceID *uuid.UUID, DB *ent.Client) error {
+	_, err := DB.CreditTransaction.Create().
+		SetUserID(c.UserID).
+		SetCreditID(c.ID).
+		SetCreditTypeID(c.CreditTypeID).
+		SetType(txType).
+		SetAmount(amount).
+		SetNillableReferenceID(referenceID).
+		SetNillableStripeLineItemID(c.StripeLineItemID).
+		Save(r.Ctx)
+	return err
+}
+
+// Ledger of a user, newest first
+// cursor is the last transaction of the previous page
+func (r *Repository) GetCreditTransactionsForUser(userID uuid.UUID, limit int, cursor *utils.KeysetCursor) (transactions []*ent.CreditTransaction, next *utils.KeysetCursor, err error) {
+	q := r.DB.CreditTransaction.Query().Where(credittransaction.UserIDEQ(userID))
+	if cursor != nil {
+		q = q.Where(credittransaction.Or(
+			credittransaction.CreatedAtLT(cursor.CreatedAt),
+			credittransaction.And(credittransaction.CreatedAtEQ(cursor.CreatedAt), credittransaction.IDLT(cursor.ID)),
+		))
+	}
+	transactions, err = q.Order(ent.Desc(credittransaction.FieldCreatedAt), ent.Desc(credittransaction.FieldID)).Limit(limit + 1).All(r.Ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(transactions) > limit {
+		transactions = transactions[:limit]
+		next = &utils.KeysetCursor{CreatedAt: transactions[limit-1].CreatedAt, ID: transactions[limit-1].ID}
+	}
+	return transactions, next, nil
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/credit"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/credittransaction"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreditTransactionLedger(t *testing.T) {
+	userID := createCreditHoldTestUser(t, 20)
+
+	hold, err := MockRepo.HoldCredits(userID, 5, credithold.ProcessTypeGenerate, nil)
+	assert.Nil(t, err)
+	jobID := uuid.New()
+	assert.Nil(t, MockRepo.AttachCreditHold(hold.ID, jobID, nil))
+	_, err = MockRepo.ReleaseCreditHold(jobID, userID, 5, "TIMEOUT", nil)
+	assert.Nil(t, err)
+	deducted, err := MockRepo.DeductCreditsFromUser(userID, 3, false, nil)
+	assert.Nil(t, err)
+	assert.True(t, deducted)
+
+	transactions, next, err := MockRepo.GetCreditTransactionsForUser(userID, 10, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, next)
+	assert.Len(t, transactions, 4)
+
+	// Newest first
+	assert.Equal(t, credittransaction.TypeSpend, transactions[0].Type)
+	assert.Equal(t, int32(-3), transactions[0].Amount)
+	assert.Nil(t, transactions[0].ReferenceID)
+	assert.Equal(t, credittransaction.TypeRelease, transactions[1].Type)
+	assert.Equal(t, int32(5), transactions[1].Amount)
+	assert.Equal(t, hold.ID, *transactions[1].ReferenceID)
+	assert.Equal(t, credittransaction.TypeHold, transactions[2].Type)
+	assert.Equal(t, int32(-5), transactions[2].Amount)
+	assert.Equal(t, hold.ID, *transactions[2].ReferenceID)
+	assert.Equal(t, credittransaction.TypeRefund, transactions[3].Type)
+	assert.Equal(t, int32(20), transactions[3].Amount)
+
+	// The ledger adds up to the balance
+	var sum int32
+	for _, tx := range transactions {
+		sum += tx.Amount
+	}
+	total, err := MockRepo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int(sum), total)
+
+	// Pages
+	page, next, err := MockRepo.GetCreditTransactionsForUser(userID, 3, nil)
+	assert.Nil(t, err)
+	assert.Len(t, page, 3)
+	assert.NotNil(t, next)
+	page, next, err = MockRepo.GetCreditTransactionsForUser(userID, 3, next)
+	assert.Nil(t, err)
+	assert.Len(t, page, 1)
+	assert.Nil(t, next)
+	assert.Equal(t, credittransaction.TypeRefund, page[0].Type)
+}
+
+func TestCreditTransactionPagesSameTimestamp(t *testing.T) {
+	userID := createCreditHoldTestUser(t, 20)
+	refund, _, err := MockRepo.GetCreditTransactionsForUser(userID, 1, nil)
+	assert.Nil(t, err)
+
+	// Written in the same instant, i.e. by one transaction
+	createdAt := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+	for i := 0; i < 5; i++ {
+		_, err := MockRepo.DB.CreditTransaction.Create().
+			SetUserID(userID).
+			SetCreditID(refund[0].CreditID).
+			SetCreditTypeID(refund[0].CreditTypeID).
+			SetType(credittransaction.TypeSpend).
+			SetAmount(-1).
+			SetCreatedAt(createdAt).
+			Save(MockRepo.Ctx)
+		assert.Nil(t, err)
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	var cursor *utils.KeysetCursor
+	for {
+		page, next, err := MockRepo.GetCreditTransactionsForUser(userID, 2, cursor)
+		assert.Nil(t, err)
+		for _, tx := range page {
+			assert.False(t, seen[tx.ID])
+			seen[tx.ID] = true
+		}
+		if next == nil {
+			break
+		}
+		cursor = next
+	}
+	assert.Len(t, seen, 6)
+}
+
+func TestRevokeFutureCreditsWithLineItemID(t *testing.T) {
+	userID := createCreditHoldTestUser(t, 0)
+	creditType, err := MockRepo.GetOrCreateFreeCreditType(nil)
+	assert.Nil(t, err)
+	future, err := MockRepo.DB.Credit.Create().
+		SetUserID(userID).
+		SetCreditTypeID(creditType.ID).
+		SetRemainingAmount(100).
+		SetStripeLineItemID("li_annual").
+		SetStartsAt(time.Now().AddDate(0, 1, 0)).
+		SetExpiresAt(time.Now().AddDate(0, 2, 0)).
+		Save(MockRepo.Ctx)
+	assert.Nil(t, err)
+
+	assert.Nil(t, MockRepo.RevokeFutureCreditsWithLineItemID(userID, "li_annual"))
+	_, err = MockRepo.DB.Credit.Get(MockRepo.Ctx, future.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	transactions, _, err := MockRepo.GetCreditTransactionsForUser(userID, 1, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, credittransaction.TypeRevoke, transactions[0].Type)
+	assert.Equal(t, int32(-100), transactions[0].Amount)
+	assert.Equal(t, future.ID, transactions[0].CreditID)
+}
+
+func TestReplenishLogsCreditTransactions(t *testing.T) {
+	ctype, err := MockRepo.GetOrCreateFreeCreditType(nil)
+	assert.Nil(t, err)
+
+	// Two free users that are due a replenishment
+	var userIDs []uuid.UUID
+	for i := 0; i < 2; i++ {
+		userID := createCreditHoldTestUser(t, 1)
+		_, err = MockRepo.GiveFreeCredits(userID, nil)
+		assert.Nil(t, err)
+		MockRepo.DB.Credit.Update().Where(credit.UserID(userID), credit.CreditTypeID(ctype.ID)).
+			SetReplenishedAt(time.Now().Add(-2 * shared.FREE_CREDIT_REPLENISHMENT_INTERVAL)).
+			SetRemainingAmount(0).
+			ExecX(context.Background())
+		userIDs = append(userIDs, userID)
+	}
+
+	_, err = MockRepo.ReplenishFreeCreditsToEligibleUsers()
+	assert.Nil(t, err)
+
+	for _, userID := range userIDs {
+		transactions, err := MockRepo.DB.CreditTransaction.Query().
+			Where(credittransaction.UserIDEQ(userID), credittransaction.TypeEQ(credittransaction.TypeReplenish)).
+			All(context.Background())
+		assert.Nil(t, err)
+		assert.Len(t, transactions, 1)
+		assert.Equal(t, int32(shared.FREE_CREDIT_AMOUNT_DAILY), transactions[0].Amount)
+		assert.Equal(t, ctype.ID, transactions[0].CreditTypeID)
+	}
+}
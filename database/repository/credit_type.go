@@ -149,3 +149,8 @@ func (r *Repository) GetOrCreateTippedCreditType(DB *ent.Client) (*ent.CreditTyp
 func (r *Repository) GetCreditTypeList() ([]*ent.CreditType, error) {
 	return r.DB.CreditType.Query().Where(credittype.TypeEQ(credittype.TypeOneTime)).All(r.Ctx)
 }
+
+// Every credit type, including internal ones like refund and tippable
+func (r *Repository) GetAllCreditTypes() ([]*ent.CreditType, error) {
+	return r.DB.CreditType.Query().All(r.Ctx)
+}
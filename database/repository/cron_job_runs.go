@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// Error of runs whose cron instance died before finishing them
+const CRON_JOB_RUN_ABANDONED = "abandoned"
+
+// Record the start of a cron job run, or a run that was skipped
+func (r *Repository) CreateCronJobRun(job shared.CronJobName, trigger cronjobrun.Trigger, status cronjobrun.Status, instance string, triggeredBy *uuid.UUID) (*ent.CronJobRun, error) {
+	create := r.DB.CronJobRun.Create().
+		SetJobName(string(job)).
+		SetTrigger(trigger).
+		SetStatus(status).
+		SetInstance(instance).
+		SetNillableTriggeredBy(triggeredBy)
+	if status != cronjobrun.StatusRunning {
+		create.SetFinishedAt(time.Now())
+	}
+	return create.Save(r.Ctx)
+}
+
+// Record the end of a cron job run, failed if jobErr isn't nil
+func (r *Repository) FinishCronJobRun(id uuid.UUID, counts map[string]int, jobErr error) (*ent.CronJobRun, error) {
+	update := r.DB.CronJobRun.UpdateOneID(id).
+		SetStatus(cronjobrun.StatusSucceeded).
+		SetFinishedAt(time.Now())
+	if len(counts) > 0 {
+		update.SetCounts(counts)
+	}
+	if jobErr != nil {
+		update.SetStatus(cronjobrun.StatusFailed).SetError(jobErr.Error())
+	}
+	return update.Save(r.Ctx)
+}
+
+// Fail runs of job still marked running, only called while holding the job's lease so none of them can be
+func (r *Repository) AbandonCronJobRuns(job shared.CronJobName) (int, error) {
+	return r.DB.CronJobRun.Update().
+		Where(cronjobrun.JobNameEQ(string(job)), cronjobrun.StatusEQ(cronjobrun.StatusRunning)).
+		SetStatus(cronjobrun.StatusFailed).
+		SetError(CRON_JOB_RUN_ABANDONED).
+		SetFinishedAt(time.Now()).
+		Save(r.Ctx)
+}
+
+// Query cron job runs, newest first
+// cursor is the started_at and id of the last run of the previous page
+// job filters on the job that ran
+func (r *Repository) QueryCronJobRuns(per_page int, cursor *utils.KeysetCursor, job *shared.CronJobName) (*CronJobRunQueryMeta, error) {
+	query := r.DB.CronJobRun.Query().Order(ent.Desc(cronjobrun.FieldStartedAt), ent.Desc(cronjobrun.FieldID))
+	if cursor != nil {
+		query = query.Where(cronjobrun.Or(
+			cronjobrun.StartedAtLT(cursor.CreatedAt),
+			cronjobrun.And(cronjobrun.StartedAtEQ(cursor.CreatedAt), cronjobrun.IDLT(cursor.ID)),
+		))
+	}
+	if job != nil {
+		query = query.Where(cronjobrun.JobNameEQ(string(*job)))
+	}
+
+	res, err := query.Limit(per_page + 1).All(r.Ctx)
+	if err != nil {
+		log.Error("Error querying cron job runs", "err", err)
+		return nil, err
+	}
+
+	// Check if there is a next page
+	var next *utils.KeysetCursor
+	if len(res) > per_page {
+		next = &utils.KeysetCursor{CreatedAt: res[per_page-1].StartedAt, ID: res[per_page-1].ID}
+		res = res[:per_page]
+	}
+
+	meta := &CronJobRunQueryMeta{
+		Next: next,
+		Runs: make([]CronJobRunResult, len(res)),
+	}
+	for i, run := range res {
+		meta.Runs[i] = CronJobRunResultFromEnt(run)
+	}
+	return meta, nil
+}
+
+// Every cron job with its latest run
+func (r *Repository) GetCronJobs() ([]CronJobResult, error) {
+	jobs := make([]CronJobResult, len(shared.CRON_JOBS))
+	for i, job := range shared.CRON_JOBS {
+		jobs[i].Name = job
+		run, err := r.DB.CronJobRun.Query().
+			Where(cronjobrun.JobNameEQ(string(job))).
+			Order(ent.Desc(cronjobrun.FieldStartedAt)).
+			First(r.Ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if run != nil {
+			res := CronJobRunResultFromEnt(run)
+			jobs[i].LastRun = &res
+		}
+	}
+	return jobs, nil
+}
+
+type CronJobRunResult struct {
+	ID          uuid.UUID          `json:"id"`
+	JobName     shared.CronJobName `json:"job_name"`
+	Trigger     cronjobrun.Trigger `json:"trigger"`
+	Status      cronjobrun.Status  `json:"status"`
+	Instance    string             `json:"instance"`
+	TriggeredBy *uuid.UUID         `json:"triggered_by,omitempty"`
+	Counts      map[string]int     `json:"counts,omitempty"`
+	Error       *string            `json:"error,omitempty"`
+	StartedAt   time.Time          `json:"started_at"`
+	FinishedAt  *time.Time         `json:"finished_at,omitempty"`
+}
+
+type CronJobRunQueryMeta struct {
+	Next *utils.KeysetCursor `json:"next,omitempty"`
+	Runs []CronJobRunResult  `json:"runs"`
+}
+
+type CronJobResult struct {
+	Name    shared.CronJobName `json:"name"`
+	LastRun *CronJobRunResult  `json:"last_run,omitempty"`
+}
+
+func CronJobRunResultFromEnt(run *ent.CronJobRun) CronJobRunResult {
+	return CronJobRunResult{
+		ID:          run.ID,
+		JobName:     shared.CronJobName(run.JobName),
+		Trigger:     run.Trigger,
+		Status:      run.Status,
+		Instance:    run.Instance,
+		TriggeredBy: run.TriggeredBy,
+		Counts:      run.Counts,
+		Error:       run.Error,
+		StartedAt:   run.StartedAt,
+		FinishedAt:  run.FinishedAt,
+	}
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/cronjobrun"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronJobRuns(t *testing.T) {
+	adminID := uuid.MustParse(MOCK_ADMIN_UUID)
+
+	abandoned, err := MockRepo.CreateCronJobRun(shared.CronJobFreeCredits, cronjobrun.TriggerSchedule, cronjobrun.StatusRunning, "cron-1", nil)
+	assert.Nil(t, err)
+	assert.Nil(t, abandoned.FinishedAt)
+
+	// Runs left running are failed by the next one
+	n, err := MockRepo.AbandonCronJobRuns(shared.CronJobFreeCredits)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, n)
+
+	run, err := MockRepo.CreateCronJobRun(shared.CronJobFreeCredits, cronjobrun.TriggerManual, cronjobrun.StatusRunning, "cron-2", &adminID)
+	assert.Nil(t, err)
+	run, err = MockRepo.FinishCronJobRun(run.ID, map[string]int{"users": 3}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, cronjobrun.StatusSucceeded, run.Status)
+	assert.NotNil(t, run.FinishedAt)
+
+	failed, err := MockRepo.CreateCronJobRun(shared.CronJobStripeSync, cronjobrun.TriggerSchedule, cronjobrun.StatusRunning, "cron-1", nil)
+	assert.Nil(t, err)
+	failed, err = MockRepo.FinishCronJobRun(failed.ID, nil, errors.New("stripe down"))
+	assert.Nil(t, err)
+	assert.Equal(t, cronjobrun.StatusFailed, failed.Status)
+
+	skipped, err := MockRepo.CreateCronJobRun(shared.CronJobStripeSync, cronjobrun.TriggerManual, cronjobrun.StatusSkipped, "cron-2", &adminID)
+	assert.Nil(t, err)
+	assert.NotNil(t, skipped.FinishedAt)
+
+	// Newest first, filtered by job
+	meta, err := MockRepo.QueryCronJobRuns(50, nil, utils.ToPtr(shared.CronJobFreeCredits))
+	assert.Nil(t, err)
+	assert.Len(t, meta.Runs, 2)
+	assert.Equal(t, run.ID, meta.Runs[0].ID)
+	assert.Equal(t, 3, meta.Runs[0].Counts["users"])
+	assert.Equal(t, adminID, *meta.Runs[0].TriggeredBy)
+	assert.Equal(t, abandoned.ID, meta.Runs[1].ID)
+	assert.Equal(t, cronjobrun.StatusFailed, meta.Runs[1].Status)
+	assert.Equal(t, CRON_JOB_RUN_ABANDONED, *meta.Runs[1].Error)
+
+	meta, err = MockRepo.QueryCronJobRuns(1, nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, meta.Runs, 1)
+	assert.Equal(t, skipped.ID, meta.Runs[0].ID)
+	assert.NotNil(t, meta.Next)
+	meta, err = MockRepo.QueryCronJobRuns(50, meta.Next, utils.ToPtr(shared.CronJobStripeSync))
+	assert.Nil(t, err)
+	assert.Len(t, meta.Runs, 1)
+	assert.Equal(t, "stripe down", *meta.Runs[0].Error)
+
+	jobs, err := MockRepo.GetCronJobs()
+	assert.Nil(t, err)
+	assert.Len(t, jobs, len(shared.CRON_JOBS))
+	for _, job := range jobs {
+		switch job.Name {
+		case shared.CronJobFreeCredits:
+			assert.Equal(t, run.ID, job.LastRun.ID)
+		case shared.CronJobStripeSync:
+			assert.Equal(t, skipped.ID, job.LastRun.ID)
+		default:
+			assert.Nil(t, job.LastRun)
+		}
+	}
+}
+
+func TestQueryCronJobRunsSameStartedAt(t *testing.T) {
+	startedAt := time.Now().Add(-time.Minute)
+	var ids []uuid.UUID
+	for i := 0; i < 3; i++ {
+		run := MockRepo.DB.CronJobRun.Create().
+			SetJobName(string(shared.CronJobApiTokens)).
+			SetTrigger(cronjobrun.TriggerSchedule).
+			SetStatus(cronjobrun.StatusSucceeded).
+			SetInstance("cron-1").
+			SetStartedAt(startedAt).
+			SaveX(MockRepo.Ctx)
+		ids = append(ids, run.ID)
+	}
+	defer MockRepo.DB.CronJobRun.Delete().Where(cronjobrun.IDIn(ids...)).ExecX(MockRepo.Ctx)
+
+	// Runs that started together are all paged through once
+	seen := make(map[uuid.UUID]bool)
+	var cursor *utils.KeysetCursor
+	for page := 0; page < 3; page++ {
+		meta, err := MockRepo.QueryCronJobRuns(1, cursor, utils.ToPtr(shared.CronJobApiTokens))
+		assert.Nil(t, err)
+		assert.Len(t, meta.Runs, 1)
+		seen[meta.Runs[0].ID] = true
+		cursor = meta.Next
+	}
+	assert.Nil(t, cursor)
+	assert.Len(t, seen, 3)
+}
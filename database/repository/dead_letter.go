@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/deadletter"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/upscale"
+	"github.com/stablecog/sc-go/database/ent/voiceover"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+)
+
+var DeadLetterReplayedErr = fmt.Errorf("dead_letter_already_replayed")
+var JobNotFailedErr = fmt.Errorf("job_not_failed")
+
+// Store a failed worker request so it can be inspected and replayed
+func (r *Repository) AddToDeadLetterQueue(req requests.CogQueueRequest, reason string, DB *ent.Client) (*ent.DeadLetter, error) {
+	if DB == nil {
+		DB = r.DB
+	}
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	return DB.DeadLetter.Create().
+		SetJobID(req.Input.ID).
+		SetProcessType(string(req.Input.ProcessType)).
+		SetNillableUserID(req.Input.UserID).
+		SetPayload(string(payload)).
+		SetFailureReason(reason).
+		Save(r.Ctx)
+}
+
+// Dead letter a failed cog message, errors are only logged since the failure itself was already handled
+func (r *Repository) deadLetterCogMessage(msg requests.CogWebhookMessage, reason string) {
+	_, err := r.AddToDeadLetterQueue(requests.CogQueueRequest{
+		WebhookUrl: msg.Webhook,
+		Input:      msg.Input,
+	}, reason, nil)
+	if err != nil {
+		log.Error("Error adding to dead letter queue", "id", msg.Input.ID, "err", err)
+	}
+}
+
+func (r *Repository) GetDeadLetter(id uuid.UUID) (*ent.DeadLetter, error) {
+	return r.DB.DeadLetter.Query().Where(deadletter.IDEQ(id)).Only(r.Ctx)
+}
+
+// Query dead letters, newest first
+// cursor is created_at, will return items with created_at less than cursor
+// replayed filters on whether the dead letter has been replayed
+func (r *Repository) QueryDeadLetters(per_page int, cursor *time.Time, replayed *bool) (*DeadLetterQueryMeta, error) {
+	query := r.DB.DeadLetter.Query().Order(ent.Desc(deadletter.FieldCreatedAt))
+	if cursor != nil {
+		query = query.Where(deadletter.CreatedAtLT(*cursor))
+	}
+	if replayed != nil {
+		if *replayed {
+			query = query.Where(deadletter.ReplayedAtNotNil())
+		} else {
+			query = query.Where(deadletter.ReplayedAtIsNil())
+		}
+	}
+
+	res, err := query.Limit(per_page + 1).All(r.Ctx)
+	if err != nil {
+		log.Error("Error querying dead letters", "err", err)
+		return nil, err
+	}
+
+	// Check if there is a next page
+	var next *time.Time
+	if len(res) > per_page {
+		next = &res[per_page-1].CreatedAt
+		res = res[:per_page]
+	}
+
+	meta := &DeadLetterQueryMeta{
+		Next:        next,
+		DeadLetters: make([]DeadLetterResult, len(res)),
+	}
+	for i, dl := range res {
+		meta.DeadLetters[i] = DeadLetterResultFromEnt(dl, false)
+	}
+	return meta, nil
+}
+
+// Marks a dead letter as replayed, returns DeadLetterReplayedErr if it already was
+// Used as a guard so the same failure can't be replayed (and charged) twice
+func (r *Repository) SetDeadLetterReplayed(id uuid.UUID, DB *ent.Client) error {
+	if DB == nil {
+		DB = r.DB
+	}
+	updated, err := DB.DeadLetter.Update().Where(deadletter.IDEQ(id), deadletter.ReplayedAtIsNil()).SetReplayedAt(time.Now()).Save(r.Ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return DeadLetterReplayedErr
+	}
+	return nil
+}
+
+// Makes a dead letter replayable again, for a replay that couldn't be enqueued
+func (r *Repository) ClearDeadLetterReplayed(id uuid.UUID, DB *ent.Client) error {
+	if DB == nil {
+		DB = r.DB
+	}
+	return DB.DeadLetter.UpdateOneID(id).ClearReplayedAt().Exec(r.Ctx)
+}
+
+// Puts a failed job back to queued, returns the new webhook token
+// Returns JobNotFailedErr if the job isn't in the failed state
+func (r *Repository) RequeueFailedJob(processType shared.ProcessType, id uuid.UUID, DB *ent.Client) (uuid.UUID, error) {
+	if DB == nil {
+		DB = r.DB
+	}
+	webhookToken := uuid.New()
+	// Staleness is measured from here instead of created_at, so the reconciler doesn't time it out straight away
+	now := time.Now()
+	var updated int
+	var err error
+	switch processType {
+	case shared.GENERATE, shared.GENERATE_AND_UPSCALE:
+		updated, err = DB.Generation.Update().
+			Where(generation.IDEQ(id), generation.StatusEQ(generation.StatusFailed)).
+			SetStatus(generation.StatusQueued).
+			SetRequeuedAt(now).
+			ClearFailureReason().
+			ClearStartedAt().
+			ClearCompletedAt().
+			SetNsfwCount(0).
+			SetWebhookToken(webhookToken).
+			Save(r.Ctx)
+	case shared.UPSCALE:
+		updated, err = DB.Upscale.Update().
+			Where(upscale.IDEQ(id), upscale.StatusEQ(upscale.StatusFailed)).
+			SetStatus(upscale.StatusQueued).
+			SetRequeuedAt(now).
+			ClearFailureReason().
+			ClearStartedAt().
+			ClearCompletedAt().
+			SetWebhookToken(webhookToken).
+			Save(r.Ctx)
+	case shared.VOICEOVER:
+		updated, err = DB.Voiceover.Update().
+			Where(voiceover.IDEQ(id), voiceover.StatusEQ(voiceover.StatusFailed)).
+			SetStatus(voiceover.StatusQueued).
+			SetRequeuedAt(now).
+			ClearFailureReason().
+			ClearStartedAt().
+			ClearCompletedAt().
+			Save(r.Ctx)
+	default:
+		return uuid.Nil, fmt.Errorf("invalid process type %s", processType)
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if updated == 0 {
+		return uuid.Nil, JobNotFailedErr
+	}
+	return webhookToken, nil
+}
+
+// API token a job was created with, nil if it wasn't created with one
+func (r *Repository) GetJobApiTokenID(processType shared.ProcessType, id uuid.UUID, DB *ent.Client) (*uuid.UUID, error) {
+	if DB == nil {
+		DB = r.DB
+	}
+	switch processType {
+	case shared.GENERATE, shared.GENERATE_AND_UPSCALE:
+		g, err := DB.Generation.Query().Where(generation.IDEQ(id)).Select(generation.FieldAPITokenID).Only(r.Ctx)
+		if err != nil {
+			return nil, err
+		}
+		return g.APITokenID, nil
+	case shared.UPSCALE:
+		u, err := DB.Upscale.Query().Where(upscale.IDEQ(id)).Select(upscale.FieldAPITokenID).Only(r.Ctx)
+		if err != nil {
+			return nil, err
+		}
+		return u.APITokenID, nil
+	case shared.VOICEOVER:
+		v, err := DB.Voiceover.Query().Where(voiceover.IDEQ(id)).Select(voiceover.FieldAPITokenID).Only(r.Ctx)
+		if err != nil {
+			return nil, err
+		}
+		return v.APITokenID, nil
+	}
+	return nil, fmt.Errorf("invalid process type %s", processType)
+}
+
+type DeadLetterResult struct {
+	ID            uuid.UUID                 `json:"id"`
+	JobID         uuid.UUID                 `json:"job_id"`
+	ProcessType   shared.ProcessType        `json:"process_type"`
+	UserID        *uuid.UUID                `json:"user_id,omitempty"`
+	FailureReason string                    `json:"failure_reason"`
+	Payload       *requests.CogQueueRequest `json:"payload,omitempty"`
+	ReplayedAt    *time.Time                `json:"replayed_at,omitempty"`
+	CreatedAt     time.Time                 `json:"created_at"`
+}
+
+type DeadLetterQueryMeta struct {
+	Next        *time.Time         `json:"next,omitempty"`
+	DeadLetters []DeadLetterResult `json:"dead_letters"`
+}
+
+// Payload is only included when requested, it's large
+func DeadLetterResultFromEnt(dl *ent.DeadLetter, withPayload bool) DeadLetterResult {
+	res := DeadLetterResult{
+		ID:            dl.ID,
+		JobID:         dl.JobID,
+		ProcessType:   shared.ProcessType(dl.ProcessType),
+		UserID:        dl.UserID,
+		FailureReason: dl.FailureReason,
+		ReplayedAt:    dl.ReplayedAt,
+		CreatedAt:     dl.CreatedAt,
+	}
+	if withPayload {
+		var payload requests.CogQueueRequest
+		if err := json.Unmarshal([]byte(dl.Payload), &payload); err != nil {
+			log.Error("Error unmarshalling dead letter payload", "id", dl.ID, "err", err)
+		} else {
+			res.Payload = &payload
+		}
+	}
+	return res
+}
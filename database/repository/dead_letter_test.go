@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/upscale"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadLetterQueue(t *testing.T) {
+	u, err := MockRepo.CreateMockUpscaleForDeletion(MockRepo.Ctx)
+	assert.Nil(t, err)
+	err = MockRepo.SetUpscaleFailed(u.ID.String(), "worker failed", nil)
+	assert.Nil(t, err)
+
+	dl, err := MockRepo.AddToDeadLetterQueue(requests.CogQueueRequest{
+		Input: requests.BaseCogRequest{
+			ID:          u.ID,
+			UserID:      utils.ToPtr(uuid.MustParse(MOCK_ADMIN_UUID)),
+			ProcessType: shared.UPSCALE,
+		},
+	}, "worker failed", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, u.ID, dl.JobID)
+	assert.Equal(t, string(shared.UPSCALE), dl.ProcessType)
+
+	// Query
+	meta, err := MockRepo.QueryDeadLetters(50, nil, utils.ToPtr(false))
+	assert.Nil(t, err)
+	assert.Len(t, meta.DeadLetters, 1)
+	assert.Equal(t, dl.ID, meta.DeadLetters[0].ID)
+	assert.Nil(t, meta.DeadLetters[0].Payload)
+	assert.Equal(t, "worker failed", meta.DeadLetters[0].FailureReason)
+
+	// Payload included when requested
+	res := DeadLetterResultFromEnt(dl, true)
+	assert.NotNil(t, res.Payload)
+	assert.Equal(t, u.ID, res.Payload.Input.ID)
+
+	// Requeue only works while failed
+	token, err := MockRepo.RequeueFailedJob(shared.UPSCALE, u.ID, nil)
+	assert.Nil(t, err)
+	requeued, err := MockRepo.GetUpscale(u.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, upscale.StatusQueued, requeued.Status)
+	assert.Equal(t, token, requeued.WebhookToken)
+	assert.Nil(t, requeued.FailureReason)
+	assert.NotNil(t, requeued.RequeuedAt)
+	_, err = MockRepo.RequeueFailedJob(shared.UPSCALE, u.ID, nil)
+	assert.ErrorIs(t, err, JobNotFailedErr)
+
+	// Can only be replayed once
+	assert.Nil(t, MockRepo.SetDeadLetterReplayed(dl.ID, nil))
+	assert.ErrorIs(t, MockRepo.SetDeadLetterReplayed(dl.ID, nil), DeadLetterReplayedErr)
+
+	meta, err = MockRepo.QueryDeadLetters(50, nil, utils.ToPtr(false))
+	assert.Nil(t, err)
+	assert.Len(t, meta.DeadLetters, 0)
+	meta, err = MockRepo.QueryDeadLetters(50, nil, utils.ToPtr(true))
+	assert.Nil(t, err)
+	assert.Len(t, meta.DeadLetters, 1)
+
+	// Delete
+	MockRepo.DB.DeadLetter.DeleteOne(dl).ExecX(MockRepo.Ctx)
+	MockRepo.DB.Upscale.DeleteOne(u).ExecX(MockRepo.Ctx)
+}
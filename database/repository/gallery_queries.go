@@ -127,7 +127,9 @@ func (r *Repository) RetrieveMostRecentGalleryDataV3(filters *requests.QueryGene
         go.like_count,
 				go.is_favorited,
 				go.gallery_status,
-        COALESCE(lc.like_count_trending, 0) AS like_count_trending 
+        COALESCE(lc.like_count_trending, 0) AS like_count_trending,
+				g.batch_id,
+				g.source_output_id
     FROM 
         generations g
     JOIN 
@@ -285,6 +287,18 @@ func (r *Repository) RetrieveMostRecentGalleryDataV3(filters *requests.QueryGene
 		}
 	}
 
+	// Apply the lineage filters
+	if filters.BatchID != nil {
+		baseQuery += fmt.Sprintf(" AND g.batch_id = $%d", argPos)
+		args = append(args, *filters.BatchID)
+		argPos++
+	}
+	if filters.SourceOutputID != nil {
+		baseQuery += fmt.Sprintf(" AND g.source_output_id = $%d", argPos)
+		args = append(args, *filters.SourceOutputID)
+		argPos++
+	}
+
 	// Apply the aesthetic artifact score filters
 	if filters.AestheticArtifactScoreLTE != nil {
 		baseQuery += fmt.Sprintf(" AND go.aesthetic_artifact_score <= $%d", argPos)
@@ -406,6 +420,8 @@ func (r *Repository) RetrieveMostRecentGalleryDataV3(filters *requests.QueryGene
 			&data.IsFavorited,
 			&data.GalleryStatus,
 			&likeCountTrending,
+			&data.BatchID,
+			&data.SourceOutputID,
 		); err != nil {
 			return nil, nil, nil, fmt.Errorf("failed to scan row: %w", err)
 		}
@@ -1036,6 +1052,8 @@ type GalleryData struct {
 	CompletedAt         *time.Time                     `json:"completed_at,omitempty" sql:"completed_at"`
 	GenerationCreatedAt time.Time                      `json:"generation_created_at" sql:"generation_created_at"`
 	NumOutputs          int                            `json:"num_outputs,omitempty" sql:"num_outputs"`
+	BatchID             *uuid.UUID                     `json:"batch_id,omitempty" sql:"batch_id"`
+	SourceOutputID      *uuid.UUID                     `json:"source_output_id,omitempty" sql:"source_output_id"`
 }
 
 // Consistent struct formats with the UI
@@ -1058,6 +1076,8 @@ type V3GenerationResult struct {
 	InitImageURL       *string     `json:"-"`
 	InitImageURLSigned *string     `json:"init_image_url,omitempty"`
 	PromptStrength     *float32    `json:"prompt_strength,omitempty"`
+	BatchID            *uuid.UUID  `json:"batch_id,omitempty"`
+	SourceOutputID     *uuid.UUID  `json:"source_output_id,omitempty"`
 }
 
 type V3GenerationOutputResult struct {
@@ -1108,6 +1128,8 @@ func (r *Repository) ConvertRawGalleryDataToV3Results(data []GalleryData) []V3Ge
 				InitImageURL:       gd.InitImageURL,
 				InitImageURLSigned: gd.InitImageURLSigned,
 				PromptStrength:     gd.PromptStrength,
+				BatchID:            gd.BatchID,
+				SourceOutputID:     gd.SourceOutputID,
 			},
 		}
 		if gd.NegativePromptID != nil && gd.NegativePromptText != "" {
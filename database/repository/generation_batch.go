@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
+	"github.com/stablecog/sc-go/database/ent/generationoutput"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+)
+
+var GenerationBatchItemDispatchedErr = fmt.Errorf("batch_item_already_dispatched")
+
+// Create a batch, the caller is responsible for holding its credits in the same transaction
+// free and queueTier are what its items are queued with
+func (r *Repository) CreateGenerationBatch(userID uuid.UUID, apiTokenID *uuid.UUID, req requests.CreateGenerationBatchRequest, free bool, queueTier shared.QueueTier, origin *enttypes.RequestOrigin, DB *ent.Client) (*ent.GenerationBatch, error) {
+	if DB == nil {
+		DB = r.DB
+	}
+	items, err := json.Marshal(req.Items)
+	if err != nil {
+		return nil, err
+	}
+	return DB.GenerationBatch.Create().
+		SetUserID(userID).
+		SetNillableAPITokenID(apiTokenID).
+		SetStatus(generationbatch.StatusDispatching).
+		SetItems(string(items)).
+		SetNumItems(len(req.Items)).
+		SetCreditsReserved(req.Cost()).
+		SetFree(free).
+		SetQueueTier(string(queueTier)).
+		SetNillableSourceOutputID(req.SourceOutputID).
+		SetNillableVariationType(req.VariationType).
+		SetOrigin(origin).
+		Save(r.Ctx)
+}
+
+func (r *Repository) GetGenerationBatch(id uuid.UUID) (*ent.GenerationBatch, error) {
+	return r.DB.GenerationBatch.Query().Where(generationbatch.IDEQ(id)).Only(r.Ctx)
+}
+
+func (r *Repository) GetGenerationBatchForUser(id uuid.UUID, userID uuid.UUID) (*ent.GenerationBatch, error) {
+	return r.DB.GenerationBatch.Query().Where(generationbatch.IDEQ(id), generationbatch.UserIDEQ(userID)).Only(r.Ctx)
+}
+
+// Batches that still have items to dispatch
+func (r *Repository) GetDispatchingGenerationBatches() ([]*ent.GenerationBatch, error) {
+	return r.DB.GenerationBatch.Query().Where(generationbatch.StatusEQ(generationbatch.StatusDispatching)).Order(ent.Asc(generationbatch.FieldCreatedAt)).All(r.Ctx)
+}
+
+// Claims item of a batch for dispatch, items are claimed in order
+// Returns GenerationBatchItemDispatchedErr if it was already claimed, so an item can only be queued (and refunded) once
+func (r *Repository) ClaimGenerationBatchItem(id uuid.UUID, item int, DB *ent.Client) error {
+	if DB == nil {
+		DB = r.DB
+	}
+	updated, err := DB.GenerationBatch.Update().
+		Where(generationbatch.IDEQ(id), generationbatch.StatusEQ(generationbatch.StatusDispatching), generationbatch.DispatchedItemsEQ(item)).
+		SetDispatchedItems(item + 1).
+		Save(r.Ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return GenerationBatchItemDispatchedErr
+	}
+	return nil
+}
+
+// Claims item of a batch as rejected and releases its share of the batch's hold
+func (r *Repository) RejectGenerationBatchItem(id uuid.UUID, item int, credits int32, DB *ent.Client) error {
+	if DB == nil {
+		DB = r.DB
+	}
+	updated, err := DB.GenerationBatch.Update().
+		Where(generationbatch.IDEQ(id), generationbatch.StatusEQ(generationbatch.StatusDispatching), generationbatch.DispatchedItemsEQ(item)).
+		SetDispatchedItems(item + 1).
+		AddRejectedItems(1).
+		AddCreditsRefunded(credits).
+		Save(r.Ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return GenerationBatchItemDispatchedErr
+	}
+
+	hold, err := r.SplitCreditHold(id, credits, credithold.ProcessTypeGenerate, DB)
+	if err != nil {
+		return err
+	}
+	_, err = r.ReleaseHold(hold, "batch_item_rejected", DB)
+	return err
+}
+
+// Gives up on a batch, the items it didn't dispatch yet are rejected and what's left of its hold released
+// Returns GenerationBatchItemDispatchedErr if it isn't dispatching anymore
+func (r *Repository) FailGenerationBatch(id uuid.UUID, reason string, DB *ent.Client) error {
+	if DB == nil {
+		DB = r.DB
+	}
+	batch, err := DB.GenerationBatch.Query().Where(generationbatch.IDEQ(id), generationbatch.StatusEQ(generationbatch.StatusDispatching)).Only(r.Ctx)
+	if ent.IsNotFound(err) {
+		return GenerationBatchItemDispatchedErr
+	} else if err != nil {
+		return err
+	}
+	hold, err := DB.CreditHold.Query().Where(credithold.JobIDEQ(id), credithold.StatusEQ(credithold.StatusHeld)).First(r.Ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	var refunded int32
+	if hold != nil {
+		refunded = hold.Amount
+	}
+
+	updated, err := DB.GenerationBatch.Update().
+		Where(generationbatch.IDEQ(id), generationbatch.StatusEQ(generationbatch.StatusDispatching), generationbatch.DispatchedItemsEQ(batch.DispatchedItems)).
+		SetStatus(generationbatch.StatusFailed).
+		SetDispatchedItems(batch.NumItems).
+		AddRejectedItems(batch.NumItems - batch.DispatchedItems).
+		AddCreditsRefunded(refunded).
+		Save(r.Ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return GenerationBatchItemDispatchedErr
+	}
+
+	if hold == nil {
+		return nil
+	}
+	_, err = r.ReleaseHold(hold, reason, DB)
+	return err
+}
+
+func (r *Repository) SetGenerationBatchDispatched(id uuid.UUID) error {
+	return r.DB.GenerationBatch.UpdateOneID(id).SetStatus(generationbatch.StatusDispatched).SetDispatchedAt(time.Now()).Exec(r.Ctx)
+}
+
+// Generations of a batch with their outputs, in the order they were dispatched
+func (r *Repository) GetGenerationBatchItems(batchID uuid.UUID) ([]*ent.Generation, error) {
+	return r.DB.Generation.Query().Where(generation.BatchIDEQ(batchID)).WithGenerationOutputs(func(goq *ent.GenerationOutputQuery) {
+		goq.Where(generationoutput.DeletedAtIsNil()).Order(ent.Asc(generationoutput.FieldCreatedAt))
+	}).Order(ent.Asc(generation.FieldCreatedAt)).All(r.Ctx)
+}
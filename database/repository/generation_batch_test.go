@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerationBatch(t *testing.T) {
+	userID := uuid.MustParse(MOCK_NORMAL_UUID)
+	batch, err := MockRepo.CreateGenerationBatch(userID, nil, requests.CreateGenerationBatchRequest{
+		Items: []requests.CreateGenerationRequest{
+			{Prompt: "cat", NumOutputs: utils.ToPtr[int32](2)},
+			{Prompt: "dog", NumOutputs: utils.ToPtr[int32](3)},
+		},
+	}, false, shared.QueueTierCredits, &enttypes.RequestOrigin{IPAddress: "1.2.3.4"}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, generationbatch.StatusDispatching, batch.Status)
+	assert.Equal(t, 2, batch.NumItems)
+	assert.Equal(t, int32(5), batch.CreditsReserved)
+	assert.Equal(t, "1.2.3.4", batch.Origin.IPAddress)
+	assert.False(t, batch.Free)
+	assert.Equal(t, string(shared.QueueTierCredits), batch.QueueTier)
+
+	var items []requests.CreateGenerationRequest
+	assert.Nil(t, json.Unmarshal([]byte(batch.Items), &items))
+	assert.Len(t, items, 2)
+	assert.Equal(t, "dog", items[1].Prompt)
+
+	dispatching, err := MockRepo.GetDispatchingGenerationBatches()
+	assert.Nil(t, err)
+	assert.Len(t, dispatching, 1)
+
+	// Items are claimed in order, once
+	assert.ErrorIs(t, MockRepo.ClaimGenerationBatchItem(batch.ID, 1, nil), GenerationBatchItemDispatchedErr)
+	assert.Nil(t, MockRepo.ClaimGenerationBatchItem(batch.ID, 0, nil))
+	assert.ErrorIs(t, MockRepo.ClaimGenerationBatchItem(batch.ID, 0, nil), GenerationBatchItemDispatchedErr)
+
+	// Rejected items release their share of the batch's hold
+	hold, err := MockRepo.HoldCredits(createCreditHoldTestUser(t, 5), 5, credithold.ProcessTypeGenerationBatch, nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, hold)
+	assert.Nil(t, MockRepo.AttachCreditHold(hold.ID, batch.ID, nil))
+	_, err = MockRepo.SplitCreditHold(batch.ID, 2, credithold.ProcessTypeGenerate, nil)
+	assert.Nil(t, err)
+
+	assert.Nil(t, MockRepo.RejectGenerationBatchItem(batch.ID, 1, 3, nil))
+	assert.ErrorIs(t, MockRepo.RejectGenerationBatchItem(batch.ID, 1, 3, nil), GenerationBatchItemDispatchedErr)
+
+	assert.Nil(t, MockRepo.SetGenerationBatchDispatched(batch.ID))
+	batch, err = MockRepo.GetGenerationBatchForUser(batch.ID, userID)
+	assert.Nil(t, err)
+	assert.Equal(t, generationbatch.StatusDispatched, batch.Status)
+	assert.Equal(t, 2, batch.DispatchedItems)
+	assert.Equal(t, 1, batch.RejectedItems)
+	assert.Equal(t, int32(3), batch.CreditsRefunded)
+	assert.NotNil(t, batch.DispatchedAt)
+
+	hold = MockRepo.DB.CreditHold.GetX(MockRepo.Ctx, hold.ID)
+	assert.Equal(t, credithold.StatusSplit, hold.Status)
+	assert.Equal(t, int32(0), hold.Amount)
+	rejected := MockRepo.DB.CreditHold.Query().Where(credithold.ParentIDEQ(hold.ID), credithold.StatusEQ(credithold.StatusReleased)).OnlyX(MockRepo.Ctx)
+	assert.Equal(t, int32(3), rejected.Amount)
+	assert.Equal(t, "batch_item_rejected", *rejected.ReleaseReason)
+
+	dispatching, err = MockRepo.GetDispatchingGenerationBatches()
+	assert.Nil(t, err)
+	assert.Len(t, dispatching, 0)
+
+	// Not owned
+	_, err = MockRepo.GetGenerationBatchForUser(batch.ID, uuid.MustParse(MOCK_ADMIN_UUID))
+	assert.NotNil(t, err)
+}
+
+func TestFailGenerationBatch(t *testing.T) {
+	userID := createCreditHoldTestUser(t, 5)
+	batch, err := MockRepo.CreateGenerationBatch(userID, nil, requests.CreateGenerationBatchRequest{
+		Items: []requests.CreateGenerationRequest{
+			{Prompt: "cat", NumOutputs: utils.ToPtr[int32](2)},
+			{Prompt: "dog", NumOutputs: utils.ToPtr[int32](3)},
+		},
+	}, false, shared.QueueTierCredits, nil, nil)
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		MockRepo.DB.GenerationBatch.DeleteOneID(batch.ID).ExecX(MockRepo.Ctx)
+	})
+	hold, err := MockRepo.HoldCredits(userID, 5, credithold.ProcessTypeGenerationBatch, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, MockRepo.AttachCreditHold(hold.ID, batch.ID, nil))
+
+	// First item made it to the queue
+	assert.Nil(t, MockRepo.ClaimGenerationBatchItem(batch.ID, 0, nil))
+	_, err = MockRepo.SplitCreditHold(batch.ID, 2, credithold.ProcessTypeGenerate, nil)
+	assert.Nil(t, err)
+
+	// The rest is rejected and its credits released
+	assert.Nil(t, MockRepo.FailGenerationBatch(batch.ID, "batch_user_not_found", nil))
+	assert.ErrorIs(t, MockRepo.FailGenerationBatch(batch.ID, "batch_user_not_found", nil), GenerationBatchItemDispatchedErr)
+	batch = MockRepo.DB.GenerationBatch.GetX(MockRepo.Ctx, batch.ID)
+	assert.Equal(t, generationbatch.StatusFailed, batch.Status)
+	assert.Equal(t, 2, batch.DispatchedItems)
+	assert.Equal(t, 1, batch.RejectedItems)
+	assert.Equal(t, int32(3), batch.CreditsRefunded)
+
+	hold = MockRepo.DB.CreditHold.GetX(MockRepo.Ctx, hold.ID)
+	assert.Equal(t, credithold.StatusReleased, hold.Status)
+	assert.Equal(t, int32(3), hold.Amount)
+	assert.Equal(t, "batch_user_not_found", *hold.ReleaseReason)
+	held, err := MockRepo.GetHeldCreditsForUser(userID)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, held)
+
+	dispatching, err := MockRepo.GetDispatchingGenerationBatches()
+	assert.Nil(t, err)
+	for _, b := range dispatching {
+		assert.NotEqual(t, batch.ID, b.ID)
+	}
+}
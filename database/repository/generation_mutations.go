@@ -56,6 +56,12 @@ func (r *Repository) CreateGeneration(userID uuid.UUID, deviceType, deviceOs, de
 	if apiTokenId != nil {
 		insert.SetAPITokenID(*apiTokenId)
 	}
+	if req.BatchID != nil {
+		insert.SetBatchID(*req.BatchID)
+	}
+	if req.SourceOutputID != nil {
+		insert.SetSourceOutputID(*req.SourceOutputID)
+	}
 	return insert.Save(r.Ctx)
 }
 
@@ -128,6 +134,13 @@ func (r *Repository) SetGenerationSucceeded(generationID string, promptStr strin
 			return err
 		}
 
+		// Charge the held credits
+		_, err = r.CaptureCreditHold(uid, db)
+		if err != nil {
+			log.Error("Error capturing credits", "id", generationID, "err", err)
+			return err
+		}
+
 		// If this generation was created with "submit_to_gallery", then submit all outputs to gallery
 		var galleryStatus generationoutput.GalleryStatus
 		isPublic := false
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database/ent"
 	"github.com/stablecog/sc-go/database/ent/generation"
@@ -109,3 +111,39 @@ func (r *Repository) SetHasEmbeddingsAndScores(
 	}
 	return output, err
 }
+
+// Outputs that should be in qdrant, oldest first after the cursor, for loading a new collection
+// With updatedSince only outputs changed since then
+func (r *Repository) GetOutputsForQdrantBackfill(cursorCreatedAt *time.Time, cursorID *uuid.UUID, updatedSince *time.Time, limit int) ([]*ent.GenerationOutput, error) {
+	query := r.DB.GenerationOutput.Query().
+		Where(
+			generationoutput.HasEmbeddings(true),
+			generationoutput.ImagePathNEQ("placeholder.webp"),
+		)
+	if cursorCreatedAt != nil && cursorID != nil {
+		query = query.Where(generationoutput.Or(
+			generationoutput.CreatedAtGT(*cursorCreatedAt),
+			generationoutput.And(generationoutput.CreatedAtEQ(*cursorCreatedAt), generationoutput.IDGT(*cursorID)),
+		))
+	}
+	if updatedSince != nil {
+		query = query.Where(generationoutput.UpdatedAtGTE(*updatedSince))
+	}
+	return query.
+		Order(ent.Asc(generationoutput.FieldCreatedAt), ent.Asc(generationoutput.FieldID)).
+		WithGenerations(func(q *ent.GenerationQuery) {
+			q.WithPrompt().WithNegativePrompt()
+		}).
+		Limit(limit).
+		All(r.Ctx)
+}
+
+// How many outputs should be in qdrant
+func (r *Repository) CountOutputsForQdrantBackfill() (int, error) {
+	return r.DB.GenerationOutput.Query().
+		Where(
+			generationoutput.HasEmbeddings(true),
+			generationoutput.ImagePathNEQ("placeholder.webp"),
+		).
+		Count(r.Ctx)
+}
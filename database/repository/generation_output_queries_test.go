@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/generationoutput"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOutputsForQdrantBackfill(t *testing.T) {
+	g, err := MockRepo.DB.Generation.Query().Where(generation.UserIDEQ(uuid.MustParse(MOCK_ADMIN_UUID))).First(MockRepo.Ctx)
+	assert.Nil(t, err)
+
+	// Later than anything else, two with the same created_at
+	base := time.Now().Add(time.Hour).Truncate(time.Second)
+	var outputs []*ent.GenerationOutput
+	for _, createdAt := range []time.Time{base, base, base.Add(time.Second)} {
+		o, err := MockRepo.DB.GenerationOutput.Create().SetGenerationID(g.ID).SetImagePath("backfill.webp").SetHasEmbeddings(true).SetCreatedAt(createdAt).Save(MockRepo.Ctx)
+		assert.Nil(t, err)
+		outputs = append(outputs, o)
+	}
+	noEmbedding, err := MockRepo.DB.GenerationOutput.Create().SetGenerationID(g.ID).SetImagePath("backfill.webp").SetCreatedAt(base).Save(MockRepo.Ctx)
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		MockRepo.DB.GenerationOutput.Delete().Where(generationoutput.ImagePathEQ("backfill.webp")).ExecX(MockRepo.Ctx)
+	})
+	first, second := outputs[0], outputs[1]
+	if second.ID.String() < first.ID.String() {
+		first, second = second, first
+	}
+
+	cursor := base.Add(-time.Second)
+	res, err := MockRepo.GetOutputsForQdrantBackfill(&cursor, &uuid.Nil, nil, 2)
+	assert.Nil(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, first.ID, res[0].ID)
+	assert.Equal(t, second.ID, res[1].ID)
+	assert.NotNil(t, res[0].Edges.Generations)
+	for _, o := range res {
+		assert.NotEqual(t, noEmbedding.ID, o.ID)
+	}
+
+	// Next page starts after the last one, even with the same created_at
+	res, err = MockRepo.GetOutputsForQdrantBackfill(&res[1].CreatedAt, &res[1].ID, nil, 2)
+	assert.Nil(t, err)
+	assert.Len(t, res, 1)
+	assert.Equal(t, outputs[2].ID, res[0].ID)
+
+	// Only changed ones
+	since := time.Now().Add(time.Minute)
+	res, err = MockRepo.GetOutputsForQdrantBackfill(&cursor, &uuid.Nil, &since, 10)
+	assert.Nil(t, err)
+	assert.Len(t, res, 0)
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/generationpreset"
+	"github.com/stablecog/sc-go/server/requests"
+)
+
+func (r *Repository) CreateGenerationPreset(userID uuid.UUID, req requests.GenerationPresetRequest) (*ent.GenerationPreset, error) {
+	return r.DB.GenerationPreset.Create().
+		SetUserID(userID).
+		SetName(req.Name).
+		SetNillablePromptTemplate(req.PromptTemplate).
+		SetNillableNegativePrompt(req.NegativePrompt).
+		SetNillableModelID(req.ModelId).
+		SetNillableSchedulerID(req.SchedulerId).
+		SetNillableWidth(req.Width).
+		SetNillableHeight(req.Height).
+		SetNillableInferenceSteps(req.InferenceSteps).
+		SetNillableGuidanceScale(req.GuidanceScale).
+		SetNillableNumOutputs(req.NumOutputs).
+		SetNillablePromptStrength(req.PromptStrength).
+		Save(r.Ctx)
+}
+
+func (r *Repository) GetGenerationPresetForUser(id uuid.UUID, userID uuid.UUID) (*ent.GenerationPreset, error) {
+	return r.DB.GenerationPreset.Query().Where(generationpreset.IDEQ(id), generationpreset.UserIDEQ(userID)).Only(r.Ctx)
+}
+
+// Presets of a user by name
+func (r *Repository) GetGenerationPresetsForUser(userID uuid.UUID) ([]*ent.GenerationPreset, error) {
+	return r.DB.GenerationPreset.Query().Where(generationpreset.UserIDEQ(userID)).Order(ent.Asc(generationpreset.FieldName)).All(r.Ctx)
+}
+
+func (r *Repository) GetGenerationPresetCountForUser(userID uuid.UUID) (int, error) {
+	return r.DB.GenerationPreset.Query().Where(generationpreset.UserIDEQ(userID)).Count(r.Ctx)
+}
+
+// Replace every setting of a preset, settings missing from req are cleared
+// Returns ent.NotFoundError if the preset doesn't exist or belongs to someone else
+func (r *Repository) UpdateGenerationPresetForUser(id uuid.UUID, userID uuid.UUID, req requests.GenerationPresetRequest) (*ent.GenerationPreset, error) {
+	preset, err := r.GetGenerationPresetForUser(id, userID)
+	if err != nil {
+		return nil, err
+	}
+	update := preset.Update().SetName(req.Name)
+	if req.PromptTemplate != nil {
+		update.SetPromptTemplate(*req.PromptTemplate)
+	} else {
+		update.ClearPromptTemplate()
+	}
+	if req.NegativePrompt != nil {
+		update.SetNegativePrompt(*req.NegativePrompt)
+	} else {
+		update.ClearNegativePrompt()
+	}
+	if req.ModelId != nil {
+		update.SetModelID(*req.ModelId)
+	} else {
+		update.ClearModelID()
+	}
+	if req.SchedulerId != nil {
+		update.SetSchedulerID(*req.SchedulerId)
+	} else {
+		update.ClearSchedulerID()
+	}
+	if req.Width != nil {
+		update.SetWidth(*req.Width)
+	} else {
+		update.ClearWidth()
+	}
+	if req.Height != nil {
+		update.SetHeight(*req.Height)
+	} else {
+		update.ClearHeight()
+	}
+	if req.InferenceSteps != nil {
+		update.SetInferenceSteps(*req.InferenceSteps)
+	} else {
+		update.ClearInferenceSteps()
+	}
+	if req.GuidanceScale != nil {
+		update.SetGuidanceScale(*req.GuidanceScale)
+	} else {
+		update.ClearGuidanceScale()
+	}
+	if req.NumOutputs != nil {
+		update.SetNumOutputs(*req.NumOutputs)
+	} else {
+		update.ClearNumOutputs()
+	}
+	if req.PromptStrength != nil {
+		update.SetPromptStrength(*req.PromptStrength)
+	} else {
+		update.ClearPromptStrength()
+	}
+	return update.Save(r.Ctx)
+}
+
+func (r *Repository) DeleteGenerationPresetForUser(id uuid.UUID, userID uuid.UUID) (int, error) {
+	return r.DB.GenerationPreset.Delete().Where(generationpreset.IDEQ(id), generationpreset.UserIDEQ(userID)).Exec(r.Ctx)
+}
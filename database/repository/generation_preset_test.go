@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/generationpreset"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerationPresetCRUD(t *testing.T) {
+	userID := uuid.MustParse(MOCK_NORMAL_UUID)
+	t.Cleanup(func() {
+		MockRepo.DB.GenerationPreset.Delete().Where(generationpreset.UserIDEQ(userID)).ExecX(MockRepo.Ctx)
+	})
+
+	preset, err := MockRepo.CreateGenerationPreset(userID, requests.GenerationPresetRequest{
+		Name:           "Noir",
+		PromptTemplate: utils.ToPtr("A {{subject}} in noir"),
+		Width:          utils.ToPtr(int32(768)),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Noir", preset.Name)
+	assert.Equal(t, int32(768), *preset.Width)
+	assert.Nil(t, preset.Height)
+
+	// Names are unique per user
+	_, err = MockRepo.CreateGenerationPreset(userID, requests.GenerationPresetRequest{Name: "Noir"})
+	assert.True(t, ent.IsConstraintError(err))
+	_, err = MockRepo.CreateGenerationPreset(userID, requests.GenerationPresetRequest{Name: "Anime"})
+	assert.Nil(t, err)
+
+	presets, err := MockRepo.GetGenerationPresetsForUser(userID)
+	assert.Nil(t, err)
+	assert.Len(t, presets, 2)
+	assert.Equal(t, "Anime", presets[0].Name)
+	count, err := MockRepo.GetGenerationPresetCountForUser(userID)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	// Other users can't see it
+	_, err = MockRepo.GetGenerationPresetForUser(preset.ID, uuid.MustParse(MOCK_ADMIN_UUID))
+	assert.True(t, ent.IsNotFound(err))
+
+	// Update replaces every setting
+	updated, err := MockRepo.UpdateGenerationPresetForUser(preset.ID, userID, requests.GenerationPresetRequest{
+		Name:   "Noir 2",
+		Height: utils.ToPtr(int32(512)),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Noir 2", updated.Name)
+	assert.Nil(t, updated.PromptTemplate)
+	assert.Nil(t, updated.Width)
+	assert.Equal(t, int32(512), *updated.Height)
+	_, err = MockRepo.UpdateGenerationPresetForUser(preset.ID, uuid.MustParse(MOCK_ADMIN_UUID), requests.GenerationPresetRequest{Name: "Mine"})
+	assert.True(t, ent.IsNotFound(err))
+
+	deleted, err := MockRepo.DeleteGenerationPresetForUser(preset.ID, uuid.MustParse(MOCK_ADMIN_UUID))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, deleted)
+	deleted, err = MockRepo.DeleteGenerationPresetForUser(preset.ID, userID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, deleted)
+	_, err = MockRepo.GetGenerationPresetForUser(preset.ID, userID)
+	assert.True(t, ent.IsNotFound(err))
+}
@@ -30,6 +30,13 @@ func (r *Repository) GetGeneration(id uuid.UUID) (*ent.Generation, error) {
 	return r.DB.Generation.Query().Where(generation.IDEQ(id)).First(r.Ctx)
 }
 
+// Get generation by ID for user, with non-deleted outputs
+func (r *Repository) GetGenerationWithOutputsForUser(id uuid.UUID, userID uuid.UUID) (*ent.Generation, error) {
+	return r.DB.Generation.Query().Where(generation.IDEQ(id), generation.UserIDEQ(userID)).WithGenerationOutputs(func(goq *ent.GenerationOutputQuery) {
+		goq.Where(generationoutput.DeletedAtIsNil()).Order(ent.Asc(generationoutput.FieldCreatedAt))
+	}).First(r.Ctx)
+}
+
 // Get generation output by ID
 func (r *Repository) GetGenerationOutput(id uuid.UUID) (*ent.GenerationOutput, error) {
 	return r.DB.GenerationOutput.Query().Where(generationoutput.IDEQ(id)).First(r.Ctx)
@@ -46,6 +53,16 @@ func (r *Repository) GetGenerationOutputForUser(id uuid.UUID, userID uuid.UUID)
 	return r.DB.Generation.Query().Where(generation.UserIDEQ(userID)).QueryGenerationOutputs().Where(generationoutput.IDEQ(id)).First(r.Ctx)
 }
 
+// Get non-deleted generation output of user with its generation and prompts, to derive variations from
+func (r *Repository) GetGenerationOutputForVariations(id uuid.UUID, userID uuid.UUID) (*ent.GenerationOutput, error) {
+	return r.DB.GenerationOutput.Query().
+		Where(generationoutput.IDEQ(id), generationoutput.DeletedAtIsNil(), generationoutput.HasGenerationsWith(generation.UserIDEQ(userID))).
+		WithGenerations(func(gq *ent.GenerationQuery) {
+			gq.WithPrompt().WithNegativePrompt()
+		}).
+		Only(r.Ctx)
+}
+
 // Get width/height for generation output
 func (r *Repository) GetGenerationOutputWidthHeight(outputID uuid.UUID) (width, height int32, err error) {
 	gen, err := r.DB.GenerationOutput.Query().Where(generationoutput.IDEQ(outputID)).QueryGenerations().Select(generation.FieldWidth, generation.FieldHeight).First(r.Ctx)
@@ -296,6 +313,14 @@ func (r *Repository) ApplyUserGenerationsFilters(query *ent.GenerationQuery, fil
 			resQuery = resQuery.Where(generation.PromptIDEQ(*filters.PromptID))
 		}
 
+		if filters.BatchID != nil {
+			resQuery = resQuery.Where(generation.BatchIDEQ(*filters.BatchID))
+		}
+
+		if filters.SourceOutputID != nil {
+			resQuery = resQuery.Where(generation.SourceOutputIDEQ(*filters.SourceOutputID))
+		}
+
 		// Start dt
 		if filters.StartDt != nil {
 			resQuery = resQuery.Where(generation.CreatedAtGTE(*filters.StartDt))
@@ -1302,13 +1327,18 @@ type GenerationQueryWithOutputsResultFormatted struct {
 
 func (r *Repository) GetGenerationsQueuedOrStarted() ([]*ent.Generation, error) {
 	// Get generations that are started/queued and older than 5 minutes
+	// Replayed dead letters are aged from when they were requeued
+	cutoff := time.Now().Add(-5 * time.Minute)
 	return r.DB.Generation.Query().
 		Where(
 			generation.StatusIn(
 				generation.StatusQueued,
 				generation.StatusStarted,
 			),
-			generation.CreatedAtLT(time.Now().Add(-5*time.Minute)),
+			generation.Or(
+				generation.And(generation.RequeuedAtIsNil(), generation.CreatedAtLT(cutoff)),
+				generation.RequeuedAtLT(cutoff),
+			),
 		).
 		Order(ent.Desc(generation.FieldCreatedAt)).
 		Limit(100).
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/generationoutput"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// Succeeded generation of user with one output
+func createVariationsTestGeneration(t *testing.T, userID uuid.UUID, req requests.CreateGenerationRequest) *ent.GenerationOutput {
+	req.Width = utils.ToPtr[int32](512)
+	req.Height = utils.ToPtr[int32](512)
+	req.InferenceSteps = utils.ToPtr[int32](30)
+	req.GuidanceScale = utils.ToPtr[float32](7)
+	req.ModelId = utils.ToPtr(uuid.MustParse(MOCK_GENERATION_MODEL_ID))
+	req.SchedulerId = utils.ToPtr(uuid.MustParse(MOCK_SCHEDULER_ID))
+	req.Seed = utils.ToPtr(1234)
+	req.NumOutputs = utils.ToPtr[int32](1)
+	g, err := MockRepo.CreateGeneration(userID, "browser", "macos", "chrome", "DE", req, nil, nil, enttypes.SourceTypeAPI, nil)
+	assert.Nil(t, err)
+	outputs, err := MockRepo.SetGenerationSucceeded(g.ID.String(), "This is a prompt", "This is a prompt", "", "", false, requests.CogWebhookOutput{
+		Images: []requests.CogWebhookOutputImage{{Image: g.ID.String()}},
+	}, 0)
+	assert.Nil(t, err)
+	return outputs[0]
+}
+
+func TestGenerationVariationsLineage(t *testing.T) {
+	userID := createCreditHoldTestUser(t, 0)
+	t.Cleanup(func() {
+		MockRepo.DB.GenerationOutput.Delete().Where(generationoutput.HasGenerationsWith(generation.UserIDEQ(userID))).ExecX(MockRepo.Ctx)
+		MockRepo.DB.Generation.Delete().Where(generation.UserIDEQ(userID)).ExecX(MockRepo.Ctx)
+	})
+
+	source := createVariationsTestGeneration(t, userID, requests.CreateGenerationRequest{})
+
+	// Only the owner can derive variations
+	_, err := MockRepo.GetGenerationOutputForVariations(source.ID, uuid.MustParse(MOCK_ADMIN_UUID))
+	assert.True(t, ent.IsNotFound(err))
+	output, err := MockRepo.GetGenerationOutputForVariations(source.ID, userID)
+	assert.Nil(t, err)
+	assert.Equal(t, "This is a prompt", output.Edges.Generations.Edges.Prompt.Text)
+	assert.Nil(t, output.Edges.Generations.Edges.NegativePrompt)
+
+	batchID := uuid.New()
+	for i := 0; i < 2; i++ {
+		createVariationsTestGeneration(t, userID, requests.CreateGenerationRequest{BatchID: &batchID, SourceOutputID: &source.ID})
+	}
+
+	filters := &requests.QueryGenerationFilters{ForHistory: true, UserID: &userID}
+	all, _, _, err := MockRepo.RetrieveMostRecentGalleryDataV3(filters, &userID, 50, nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, all, 3)
+
+	filters.SourceOutputID = &source.ID
+	variations, _, _, err := MockRepo.RetrieveMostRecentGalleryDataV3(filters, &userID, 50, nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, variations, 2)
+	results := MockRepo.ConvertRawGalleryDataToV3Results(variations)
+	for _, res := range results {
+		assert.Equal(t, source.ID, *res.Generation.SourceOutputID)
+		assert.Equal(t, batchID, *res.Generation.BatchID)
+	}
+
+	filters.SourceOutputID = nil
+	filters.BatchID = &batchID
+	count, err := MockRepo.GetGenerationCount(filters)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/upscale"
+	"github.com/stablecog/sc-go/database/ent/voiceover"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+)
+
+// The job already finished, or it's a synchronous API request
+var JobNotCancellableErr = fmt.Errorf("job_not_cancellable")
+
+// Cancels a queued or running generation, upscale or voiceover of the user, the user is refunded
+// Workers are told to stop jobs they're already running
+// Only web UI and async API jobs can be cancelled, synchronous API requests are finished by whoever waits on them
+func (r *Repository) CancelJob(processType shared.ProcessType, jobID uuid.UUID, userID uuid.UUID) error {
+	input := requests.BaseCogRequest{
+		ID:          jobID,
+		UserID:      &userID,
+		ProcessType: processType,
+	}
+
+	var queued, started bool
+	switch processType {
+	case shared.GENERATE, shared.GENERATE_AND_UPSCALE:
+		g, err := r.DB.Generation.Query().Where(generation.IDEQ(jobID), generation.UserIDEQ(userID)).Only(r.Ctx)
+		if err != nil {
+			return err
+		}
+		queued = g.Status == generation.StatusQueued
+		started = g.Status == generation.StatusStarted
+		input.NumOutputs = &g.NumOutputs
+	case shared.UPSCALE:
+		u, err := r.DB.Upscale.Query().Where(upscale.IDEQ(jobID), upscale.UserIDEQ(userID)).Only(r.Ctx)
+		if err != nil {
+			return err
+		}
+		queued = u.Status == upscale.StatusQueued
+		started = u.Status == upscale.StatusStarted
+	case shared.VOICEOVER:
+		v, err := r.DB.Voiceover.Query().Where(voiceover.IDEQ(jobID), voiceover.UserIDEQ(userID)).WithPrompt().Only(r.Ctx)
+		if err != nil {
+			return err
+		}
+		queued = v.Status == voiceover.StatusQueued
+		started = v.Status == voiceover.StatusStarted
+		if v.Edges.Prompt != nil {
+			input.Prompt = v.Edges.Prompt.Text
+		}
+	default:
+		return JobNotCancellableErr
+	}
+	if !queued && !started {
+		return JobNotCancellableErr
+	}
+
+	// Whoever takes the stream ID finishes the job, if it's gone the job is already being finished
+	streamID, err := r.Redis.TakeCogRequestStreamID(r.Redis.Ctx, jobID.String())
+	if err == redis.Nil {
+		return JobNotCancellableErr
+	} else if err != nil {
+		return err
+	}
+	input.StreamID = streamID
+
+	// A result that still comes in for it is dropped by the webhook
+	if !r.failUnfinishedCogMessage(requests.CogWebhookMessage{
+		Input:  input,
+		Status: requests.CogFailed,
+		Error:  shared.CANCELLED_ERROR,
+	}) {
+		return fmt.Errorf("failed to cancel job %s", jobID)
+	}
+
+	// Consumers skip queued jobs when they pick them up, running ones are stopped so they don't waste a worker
+	// A queued job may be picked up right now, so workers are told either way
+	if err := r.Redis.SetJobCancelled(r.Redis.Ctx, jobID.String()); err != nil {
+		log.Error("Error marking job cancelled", "err", err, "id", jobID)
+	}
+	controlMsg, err := json.Marshal(requests.WorkerControlMessage{
+		Action:      requests.WorkerControlCancel,
+		ID:          jobID,
+		ProcessType: processType,
+	})
+	if err != nil {
+		log.Error("Error marshalling worker control message", "err", err)
+		return nil
+	}
+	if err := r.Redis.Client.Publish(r.Redis.Ctx, shared.REDIS_SC_WORKER_CONTROL_CHANNEL, controlMsg).Err(); err != nil {
+		log.Error("Error publishing worker control message", "err", err, "id", jobID)
+	}
+	return nil
+}
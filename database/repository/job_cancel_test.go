@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancelJob(t *testing.T) {
+	origThrottler := MockRepo.QueueThrottler
+	MockRepo.QueueThrottler = shared.NewQueueThrottler(MockRepo.Ctx, MockRepo.Redis.Client, time.Minute)
+	defer func() {
+		MockRepo.QueueThrottler = origThrottler
+	}()
+
+	userID := createCreditHoldTestUser(t, 10)
+	t.Cleanup(func() {
+		MockRepo.DB.Generation.Delete().Where(generation.UserIDEQ(userID)).ExecX(MockRepo.Ctx)
+	})
+	g, err := MockRepo.CreateGeneration(userID, "browser", "macos", "chrome", "DE", requests.CreateGenerationRequest{
+		Width:          utils.ToPtr[int32](512),
+		Height:         utils.ToPtr[int32](512),
+		InferenceSteps: utils.ToPtr[int32](30),
+		GuidanceScale:  utils.ToPtr[float32](7),
+		ModelId:        utils.ToPtr(uuid.MustParse(MOCK_GENERATION_MODEL_ID)),
+		SchedulerId:    utils.ToPtr(uuid.MustParse(MOCK_SCHEDULER_ID)),
+		Seed:           utils.ToPtr(1234),
+		NumOutputs:     utils.ToPtr[int32](2),
+	}, nil, nil, enttypes.SourceTypeWebUI, nil)
+	assert.Nil(t, err)
+	hold, err := MockRepo.HoldCredits(userID, 2, credithold.ProcessTypeGenerate, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, MockRepo.AttachCreditHold(hold.ID, g.ID, nil))
+	assert.Nil(t, MockRepo.QueueThrottler.IncrementBy(1, "g:"+userID.String()))
+	queueLog, err := MockRepo.AddToQueueLog(utils.Sha256(g.ID.String()), 1, nil)
+	assert.Nil(t, err)
+	defer MockRepo.DeleteFromQueueLog(queueLog.MessageID, nil)
+
+	// Not theirs
+	err = MockRepo.CancelJob(shared.GENERATE, g.ID, uuid.MustParse(MOCK_NORMAL_UUID))
+	assert.True(t, ent.IsNotFound(err))
+
+	// Synchronous API requests have no stream ID
+	err = MockRepo.CancelJob(shared.GENERATE, g.ID, userID)
+	assert.ErrorIs(t, err, JobNotCancellableErr)
+
+	streamID := utils.Sha256("stream")
+	assert.Nil(t, MockRepo.Redis.SetCogRequestStreamID(MockRepo.Redis.Ctx, g.ID.String(), streamID))
+	assert.Nil(t, MockRepo.CancelJob(shared.GENERATE, g.ID, userID))
+
+	g2, err := MockRepo.GetGeneration(g.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, generation.StatusFailed, g2.Status)
+	assert.Equal(t, shared.CANCELLED_ERROR, *g2.FailureReason)
+	assert.NotEqual(t, g.WebhookToken, g2.WebhookToken)
+	total, err := MockRepo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, total)
+	queued, err := MockRepo.QueueThrottler.NumQueued("g:" + userID.String())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, queued)
+	queueLog, err = MockRepo.DB.MqLog.Get(MockRepo.Ctx, queueLog.ID)
+	assert.Nil(t, err)
+	assert.NotNil(t, queueLog.CancelledAt)
+	events, err := MockRepo.Redis.GetSSEBacklogEvents(streamID, 0)
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+	assert.Contains(t, string(events[0].Data), shared.CANCELLED_ERROR)
+	// Consumers skip it if they pick it up
+	cancelled, err := MockRepo.Redis.IsJobCancelled(MockRepo.Redis.Ctx, g.ID.String())
+	assert.Nil(t, err)
+	assert.True(t, cancelled)
+
+	// Only once
+	assert.Nil(t, MockRepo.Redis.SetCogRequestStreamID(MockRepo.Redis.Ctx, g.ID.String(), streamID))
+	err = MockRepo.CancelJob(shared.GENERATE, g.ID, userID)
+	assert.ErrorIs(t, err, JobNotCancellableErr)
+
+	// Running jobs are stopped by their worker
+	pubsub := MockRepo.Redis.Client.Subscribe(MockRepo.Ctx, shared.REDIS_SC_WORKER_CONTROL_CHANNEL)
+	defer pubsub.Close()
+	_, err = pubsub.Receive(MockRepo.Ctx)
+	assert.Nil(t, err)
+	g3, err := MockRepo.CreateGeneration(userID, "browser", "macos", "chrome", "DE", requests.CreateGenerationRequest{
+		Width:          utils.ToPtr[int32](512),
+		Height:         utils.ToPtr[int32](512),
+		InferenceSteps: utils.ToPtr[int32](30),
+		GuidanceScale:  utils.ToPtr[float32](7),
+		ModelId:        utils.ToPtr(uuid.MustParse(MOCK_GENERATION_MODEL_ID)),
+		SchedulerId:    utils.ToPtr(uuid.MustParse(MOCK_SCHEDULER_ID)),
+		Seed:           utils.ToPtr(1234),
+		NumOutputs:     utils.ToPtr[int32](1),
+	}, nil, nil, enttypes.SourceTypeWebUI, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, MockRepo.SetGenerationStarted(g3.ID.String()))
+	assert.Nil(t, MockRepo.Redis.SetCogRequestStreamID(MockRepo.Redis.Ctx, g3.ID.String(), streamID))
+	assert.Nil(t, MockRepo.CancelJob(shared.GENERATE, g3.ID, userID))
+	select {
+	case msg := <-pubsub.Channel():
+		var controlMsg requests.WorkerControlMessage
+		assert.Nil(t, json.Unmarshal([]byte(msg.Payload), &controlMsg))
+		assert.Equal(t, requests.WorkerControlCancel, controlMsg.Action)
+		assert.Equal(t, g3.ID, controlMsg.ID)
+	case <-time.After(time.Second):
+		t.Fatal("no worker control message")
+	}
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/moderationverdict"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/utils"
+)
+
+var ModerationVerdictReviewedErr = fmt.Errorf("verdict_already_reviewed")
+
+type NewModerationVerdict struct {
+	UserID           uuid.UUID
+	SourceType       enttypes.SourceType
+	Tier             string
+	Policy           string
+	Prompt           string
+	TranslatedPrompt *string
+	Action           moderationverdict.Action
+	Reason           *string
+	Findings         []enttypes.ModerationFinding
+	Errors           []string
+}
+
+// Record what moderation decided about a prompt
+func (r *Repository) CreateModerationVerdict(verdict NewModerationVerdict, DB *ent.Client) (*ent.ModerationVerdict, error) {
+	if DB == nil {
+		DB = r.DB
+	}
+	return DB.ModerationVerdict.Create().
+		SetUserID(verdict.UserID).
+		SetSourceType(verdict.SourceType).
+		SetTier(verdict.Tier).
+		SetPolicy(verdict.Policy).
+		SetPrompt(verdict.Prompt).
+		SetNillableTranslatedPrompt(verdict.TranslatedPrompt).
+		SetAction(verdict.Action).
+		SetNillableReason(verdict.Reason).
+		SetFindings(verdict.Findings).
+		SetErrors(verdict.Errors).
+		Save(r.Ctx)
+}
+
+// Link a verdict to the generation created for its prompt
+func (r *Repository) SetModerationVerdictGeneration(id uuid.UUID, generationID uuid.UUID, DB *ent.Client) error {
+	if DB == nil {
+		DB = r.DB
+	}
+	return DB.ModerationVerdict.UpdateOneID(id).SetGenerationID(generationID).Exec(r.Ctx)
+}
+
+func (r *Repository) GetModerationVerdict(id uuid.UUID) (*ent.ModerationVerdict, error) {
+	return r.DB.ModerationVerdict.Get(r.Ctx, id)
+}
+
+// Delete allowed verdicts created before allowedBefore and all others created before before
+func (r *Repository) PruneModerationVerdicts(allowedBefore time.Time, before time.Time) (int, error) {
+	return r.DB.ModerationVerdict.Delete().Where(moderationverdict.Or(
+		moderationverdict.And(moderationverdict.ActionEQ(moderationverdict.ActionAllow), moderationverdict.CreatedAtLT(allowedBefore)),
+		moderationverdict.CreatedAtLT(before),
+	)).Exec(r.Ctx)
+}
+
+type ModerationVerdictFilters struct {
+	Action *moderationverdict.Action
+	// Only reviewed verdicts if true, only unreviewed if false
+	Reviewed *bool
+	UserID   *uuid.UUID
+}
+
+// Query moderation verdicts, newest first
+// cursor is the created_at and id of the last verdict of the previous page
+func (r *Repository) QueryModerationVerdicts(per_page int, cursor *utils.KeysetCursor, filters ModerationVerdictFilters) (*ModerationVerdictQueryMeta, error) {
+	query := r.DB.ModerationVerdict.Query().Order(ent.Desc(moderationverdict.FieldCreatedAt), ent.Desc(moderationverdict.FieldID))
+	if cursor != nil {
+		query = query.Where(moderationverdict.Or(
+			moderationverdict.CreatedAtLT(cursor.CreatedAt),
+			moderationverdict.And(moderationverdict.CreatedAtEQ(cursor.CreatedAt), moderationverdict.IDLT(cursor.ID)),
+		))
+	}
+	if filters.Action != nil {
+		query = query.Where(moderationverdict.ActionEQ(*filters.Action))
+	}
+	if filters.Reviewed != nil {
+		if *filters.Reviewed {
+			query = query.Where(moderationverdict.ReviewStatusNotNil())
+		} else {
+			query = query.Where(moderationverdict.ReviewStatusIsNil())
+		}
+	}
+	if filters.UserID != nil {
+		query = query.Where(moderationverdict.UserIDEQ(*filters.UserID))
+	}
+
+	res, err := query.Limit(per_page + 1).All(r.Ctx)
+	if err != nil {
+		log.Error("Error querying moderation verdicts", "err", err)
+		return nil, err
+	}
+
+	// Check if there is a next page
+	var next *utils.KeysetCursor
+	if len(res) > per_page {
+		next = &utils.KeysetCursor{CreatedAt: res[per_page-1].CreatedAt, ID: res[per_page-1].ID}
+		res = res[:per_page]
+	}
+
+	meta := &ModerationVerdictQueryMeta{
+		Next:     next,
+		Verdicts: make([]ModerationVerdictResult, len(res)),
+	}
+	for i, verdict := range res {
+		meta.Verdicts[i] = ModerationVerdictResultFromEnt(verdict)
+	}
+	return meta, nil
+}
+
+// Review a verdict on appeal, overturning a ban unbans the user
+// Returns ModerationVerdictReviewedErr if it was already reviewed
+func (r *Repository) ReviewModerationVerdict(id uuid.UUID, reviewer uuid.UUID, status moderationverdict.ReviewStatus, note *string) (*ent.ModerationVerdict, error) {
+	var reviewed *ent.ModerationVerdict
+	if err := r.WithTx(func(tx *ent.Tx) error {
+		DB := tx.Client()
+		verdict, err := DB.ModerationVerdict.Get(r.Ctx, id)
+		if err != nil {
+			return err
+		}
+		if verdict.ReviewStatus != nil {
+			return ModerationVerdictReviewedErr
+		}
+		reviewed, err = DB.ModerationVerdict.UpdateOneID(id).
+			SetReviewStatus(status).
+			SetReviewedBy(reviewer).
+			SetNillableReviewNote(note).
+			SetReviewedAt(time.Now()).
+			Save(r.Ctx)
+		if err != nil {
+			return err
+		}
+		if verdict.Action == moderationverdict.ActionBan && status == moderationverdict.ReviewStatusOverturned {
+			_, err = DB.User.UpdateOneID(verdict.UserID).ClearBannedAt().ClearScheduledForDeletionOn().Save(r.Ctx)
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return reviewed, nil
+}
+
+type ModerationVerdictResult struct {
+	ID               uuid.UUID                       `json:"id"`
+	UserID           uuid.UUID                       `json:"user_id"`
+	GenerationID     *uuid.UUID                      `json:"generation_id,omitempty"`
+	SourceType       enttypes.SourceType             `json:"source_type"`
+	Tier             string                          `json:"tier"`
+	Policy           string                          `json:"policy"`
+	Prompt           string                          `json:"prompt"`
+	TranslatedPrompt *string                         `json:"translated_prompt,omitempty"`
+	Action           moderationverdict.Action        `json:"action"`
+	Reason           *string                         `json:"reason,omitempty"`
+	Findings         []enttypes.ModerationFinding    `json:"findings,omitempty"`
+	Errors           []string                        `json:"errors,omitempty"`
+	ReviewStatus     *moderationverdict.ReviewStatus `json:"review_status,omitempty"`
+	ReviewedBy       *uuid.UUID                      `json:"reviewed_by,omitempty"`
+	ReviewNote       *string                         `json:"review_note,omitempty"`
+	ReviewedAt       *time.Time                      `json:"reviewed_at,omitempty"`
+	CreatedAt        time.Time                       `json:"created_at"`
+}
+
+type ModerationVerdictQueryMeta struct {
+	Next     *utils.KeysetCursor       `json:"next,omitempty"`
+	Verdicts []ModerationVerdictResult `json:"verdicts"`
+}
+
+func ModerationVerdictResultFromEnt(verdict *ent.ModerationVerdict) ModerationVerdictResult {
+	return ModerationVerdictResult{
+		ID:               verdict.ID,
+		UserID:           verdict.UserID,
+		GenerationID:     verdict.GenerationID,
+		SourceType:       verdict.SourceType,
+		Tier:             verdict.Tier,
+		Policy:           verdict.Policy,
+		Prompt:           verdict.Prompt,
+		TranslatedPrompt: verdict.TranslatedPrompt,
+		Action:           verdict.Action,
+		Reason:           verdict.Reason,
+		Findings:         verdict.Findings,
+		Errors:           verdict.Errors,
+		ReviewStatus:     verdict.ReviewStatus,
+		ReviewedBy:       verdict.ReviewedBy,
+		ReviewNote:       verdict.ReviewNote,
+		ReviewedAt:       verdict.ReviewedAt,
+		CreatedAt:        verdict.CreatedAt,
+	}
+}
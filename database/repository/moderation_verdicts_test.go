@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/moderationverdict"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModerationVerdicts(t *testing.T) {
+	userID := uuid.MustParse(MOCK_NORMAL_UUID)
+	adminID := uuid.MustParse(MOCK_ADMIN_UUID)
+
+	allowed, err := MockRepo.CreateModerationVerdict(NewModerationVerdict{
+		UserID:     userID,
+		SourceType: enttypes.SourceTypeWebUI,
+		Tier:       "free",
+		Policy:     "default",
+		Prompt:     "a cat",
+		Action:     moderationverdict.ActionAllow,
+	}, nil)
+	assert.Nil(t, err)
+	generationID := uuid.New()
+	assert.Nil(t, MockRepo.SetModerationVerdictGeneration(allowed.ID, generationID, nil))
+	allowed, err = MockRepo.GetModerationVerdict(allowed.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, generationID, *allowed.GenerationID)
+
+	banned, err := MockRepo.CreateModerationVerdict(NewModerationVerdict{
+		UserID:     userID,
+		SourceType: enttypes.SourceTypeAPI,
+		Tier:       "free",
+		Policy:     "default",
+		Prompt:     "something banned",
+		Action:     moderationverdict.ActionBan,
+		Reason:     utils.ToPtr("banned_prompt"),
+		Findings: []enttypes.ModerationFinding{
+			{Checker: "banned_embedding", Category: "banned_prompt", Score: 0.9, BanWorthy: true},
+		},
+	}, nil)
+	assert.Nil(t, err)
+	_, err = MockRepo.BanUsers([]uuid.UUID{userID}, false)
+	assert.Nil(t, err)
+
+	meta, err := MockRepo.QueryModerationVerdicts(50, nil, ModerationVerdictFilters{Action: utils.ToPtr(moderationverdict.ActionBan), Reviewed: utils.ToPtr(false)})
+	assert.Nil(t, err)
+	assert.Len(t, meta.Verdicts, 1)
+	assert.Equal(t, banned.ID, meta.Verdicts[0].ID)
+	assert.Len(t, meta.Verdicts[0].Findings, 1)
+
+	meta, err = MockRepo.QueryModerationVerdicts(1, nil, ModerationVerdictFilters{UserID: &userID})
+	assert.Nil(t, err)
+	assert.Len(t, meta.Verdicts, 1)
+	assert.NotNil(t, meta.Next)
+	first := meta.Verdicts[0].ID
+	meta, err = MockRepo.QueryModerationVerdicts(1, meta.Next, ModerationVerdictFilters{UserID: &userID})
+	assert.Nil(t, err)
+	assert.Len(t, meta.Verdicts, 1)
+	assert.NotEqual(t, first, meta.Verdicts[0].ID)
+	assert.Nil(t, meta.Next)
+
+	// Overturning a ban unbans the user
+	reviewed, err := MockRepo.ReviewModerationVerdict(banned.ID, adminID, moderationverdict.ReviewStatusOverturned, utils.ToPtr("false positive"))
+	assert.Nil(t, err)
+	assert.Equal(t, moderationverdict.ReviewStatusOverturned, *reviewed.ReviewStatus)
+	assert.Equal(t, adminID, *reviewed.ReviewedBy)
+	user, err := MockRepo.GetUser(userID)
+	assert.Nil(t, err)
+	assert.Nil(t, user.BannedAt)
+
+	_, err = MockRepo.ReviewModerationVerdict(banned.ID, adminID, moderationverdict.ReviewStatusUpheld, nil)
+	assert.ErrorIs(t, err, ModerationVerdictReviewedErr)
+
+	meta, err = MockRepo.QueryModerationVerdicts(50, nil, ModerationVerdictFilters{Reviewed: utils.ToPtr(true)})
+	assert.Nil(t, err)
+	assert.Len(t, meta.Verdicts, 1)
+
+	// Cleanup
+	MockRepo.DB.ModerationVerdict.Delete().ExecX(MockRepo.Ctx)
+}
+
+func TestPruneModerationVerdicts(t *testing.T) {
+	create := func(action moderationverdict.Action, age time.Duration) uuid.UUID {
+		return MockRepo.DB.ModerationVerdict.Create().
+			SetUserID(uuid.MustParse(MOCK_NORMAL_UUID)).
+			SetSourceType(enttypes.SourceTypeWebUI).
+			SetTier("free").
+			SetPolicy("default").
+			SetPrompt("a prompt").
+			SetAction(action).
+			SetCreatedAt(time.Now().Add(-age)).
+			SaveX(MockRepo.Ctx).ID
+	}
+	day := 24 * time.Hour
+	create(moderationverdict.ActionAllow, 40*day)
+	recentAllowed := create(moderationverdict.ActionAllow, day)
+	block := create(moderationverdict.ActionBlock, 40*day)
+	create(moderationverdict.ActionBan, 200*day)
+	defer MockRepo.DB.ModerationVerdict.Delete().ExecX(MockRepo.Ctx)
+
+	// Allowed verdicts go first, others are kept longer
+	pruned, err := MockRepo.PruneModerationVerdicts(time.Now().Add(-30*day), time.Now().Add(-180*day))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, pruned)
+	ids, err := MockRepo.DB.ModerationVerdict.Query().IDs(MockRepo.Ctx)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{recentAllowed, block}, ids)
+}
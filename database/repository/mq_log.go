@@ -1,9 +1,12 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/stablecog/sc-go/database/ent"
 	"github.com/stablecog/sc-go/database/ent/mqlog"
 	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
 )
 
 func (r *Repository) GetQueuePosition(messageId string) (position int, total int, err error) {
@@ -14,6 +17,7 @@ func (r *Repository) GetQueuePosition(messageId string) (position int, total int
 						 ROW_NUMBER() OVER (ORDER BY priority DESC, created_at ASC) as row_num,
 						 COUNT(*) OVER () as total
 			FROM mq_log
+			WHERE cancelled_at IS NULL
 	) AS subquery
 	WHERE message_id = $1
 	`
@@ -32,7 +36,7 @@ func (r *Repository) GetQueuePosition(messageId string) (position int, total int
 	} else {
 		// No rows for the given message ID means not in the queue
 		// For the total, we need another query
-		count, err := r.DB.MqLog.Query().Count(r.Ctx)
+		count, err := r.DB.MqLog.Query().Where(mqlog.CancelledAtIsNil()).Count(r.Ctx)
 		if err != nil {
 			return 0, 0, err
 		}
@@ -47,6 +51,29 @@ func (r *Repository) GetQueuePosition(messageId string) (position int, total int
 	return position, total, nil
 }
 
+// Get a queue log item that wasn't cancelled
+func (r *Repository) GetQueueLogItem(messageId string) (*ent.MqLog, error) {
+	return r.DB.MqLog.Query().Where(mqlog.MessageIDEQ(messageId), mqlog.CancelledAtIsNil()).Only(r.Ctx)
+}
+
+// Number of waiting items that will be picked before item, ones of higher priority and earlier ones of the same priority
+func (r *Repository) CountQueuedAhead(item *ent.MqLog) (int, error) {
+	return r.DB.MqLog.Query().Where(
+		mqlog.IDNEQ(item.ID),
+		mqlog.IsProcessingEQ(false),
+		mqlog.CancelledAtIsNil(),
+		mqlog.Or(
+			mqlog.PriorityGT(item.Priority),
+			mqlog.And(mqlog.PriorityEQ(item.Priority), mqlog.CreatedAtLT(item.CreatedAt)),
+		),
+	).Count(r.Ctx)
+}
+
+// Number of items waiting to be picked, ones being processed aren't in line anymore
+func (r *Repository) CountQueuedWaiting() (int, error) {
+	return r.DB.MqLog.Query().Where(mqlog.IsProcessingEQ(false), mqlog.CancelledAtIsNil()).Count(r.Ctx)
+}
+
 // Add to queue log
 func (r *Repository) AddToQueueLog(messageId string, priority int, DB *ent.Client) (*ent.MqLog, error) {
 	if DB == nil {
@@ -63,6 +90,20 @@ func (r *Repository) DeleteFromQueueLog(messageId string, DB *ent.Client) (int,
 	return DB.MqLog.Delete().Where(mqlog.MessageIDEQ(messageId)).Exec(r.Ctx)
 }
 
+// Cancelled items stay in the log until their message is taken off the queue or they're pruned
+// They don't count towards anyone's position
+func (r *Repository) CancelInQueueLog(messageId string, DB *ent.Client) (int, error) {
+	if DB == nil {
+		DB = r.DB
+	}
+	return DB.MqLog.Update().Where(mqlog.MessageIDEQ(messageId), mqlog.CancelledAtIsNil()).SetCancelledAt(time.Now()).Save(r.Ctx)
+}
+
+// Delete items cancelled before cancelledBefore, their messages have been skipped by then
+func (r *Repository) PruneCancelledQueueLog(cancelledBefore time.Time) (int, error) {
+	return r.DB.MqLog.Delete().Where(mqlog.CancelledAtLT(cancelledBefore)).Exec(r.Ctx)
+}
+
 // Set is_processing
 func (r *Repository) SetIsProcessingInQueueLog(messageId string, isProcessing bool, DB *ent.Client) (int, error) {
 	if DB == nil {
@@ -78,6 +119,7 @@ func (r *Repository) GetQueuedItems(DB *ent.Client) ([]*responses.QueuedItem, er
 	}
 	mqlog, err := DB.MqLog.
 		Query().
+		Where(mqlog.CancelledAtIsNil()).
 		Select(mqlog.FieldMessageID, mqlog.FieldPriority, mqlog.FieldCreatedAt).
 		Order(ent.Desc(mqlog.FieldPriority), ent.Asc(mqlog.FieldCreatedAt)).
 		All(r.Ctx)
@@ -95,3 +137,27 @@ func (r *Repository) GetQueuedItems(DB *ent.Client) ([]*responses.QueuedItem, er
 	}
 	return queueLog, nil
 }
+
+// Number of queued items per queue tier, tiers are derived from the priority they were dispatched at
+func (r *Repository) GetQueueDepthByTier() (map[shared.QueueTier]int, error) {
+	var rows []struct {
+		Priority int `json:"priority"`
+		Count    int `json:"count"`
+	}
+	err := r.DB.MqLog.Query().Where(mqlog.IsProcessingEQ(false), mqlog.CancelledAtIsNil()).
+		GroupBy(mqlog.FieldPriority).
+		Aggregate(ent.Count()).
+		Scan(r.Ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	depth := make(map[shared.QueueTier]int, len(shared.QUEUE_TIERS))
+	for _, tier := range shared.QUEUE_TIERS {
+		depth[tier] = 0
+	}
+	for _, row := range rows {
+		depth[shared.QueueTierForPriority(uint8(row.Priority))] += row.Count
+	}
+	return depth, nil
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetQueueDepthByTier(t *testing.T) {
+	free, err := MockRepo.AddToQueueLog("depth-free", int(shared.QUEUE_TIER_CONFIGS[shared.QueueTierFree].Priority), nil)
+	assert.Nil(t, err)
+	paid1, err := MockRepo.AddToQueueLog("depth-paid-1", int(shared.QUEUE_TIER_CONFIGS[shared.QueueTierPaid].Priority), nil)
+	assert.Nil(t, err)
+	paid2, err := MockRepo.AddToQueueLog("depth-paid-2", int(shared.QUEUE_TIER_CONFIGS[shared.QueueTierPaid].Priority), nil)
+	assert.Nil(t, err)
+	processing, err := MockRepo.AddToQueueLog("depth-processing", int(shared.QUEUE_TIER_CONFIGS[shared.QueueTierAdmin].Priority), nil)
+	assert.Nil(t, err)
+	_, err = MockRepo.SetIsProcessingInQueueLog(processing.MessageID, true, nil)
+	assert.Nil(t, err)
+
+	depth, err := MockRepo.GetQueueDepthByTier()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, depth[shared.QueueTierFree])
+	assert.Equal(t, 0, depth[shared.QueueTierCredits])
+	assert.Equal(t, 0, depth[shared.QueueTierAdmin])
+	assert.Equal(t, 2, depth[shared.QueueTierPaid])
+
+	// Cleanup
+	for _, l := range []string{free.MessageID, paid1.MessageID, paid2.MessageID, processing.MessageID} {
+		_, err = MockRepo.DeleteFromQueueLog(l, nil)
+		assert.Nil(t, err)
+	}
+}
+
+func TestCancelInQueueLog(t *testing.T) {
+	kept, err := MockRepo.AddToQueueLog("cancel-kept", int(shared.QUEUE_PRIORITY_1), nil)
+	assert.Nil(t, err)
+	cancelled, err := MockRepo.AddToQueueLog("cancel-cancelled", int(shared.QUEUE_PRIORITY_1), nil)
+	assert.Nil(t, err)
+	defer func() {
+		for _, l := range []string{kept.MessageID, cancelled.MessageID} {
+			_, err = MockRepo.DeleteFromQueueLog(l, nil)
+			assert.Nil(t, err)
+		}
+	}()
+
+	updated, err := MockRepo.CancelInQueueLog(cancelled.MessageID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, updated)
+	// Only once
+	updated, err = MockRepo.CancelInQueueLog(cancelled.MessageID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, updated)
+
+	items, err := MockRepo.GetQueuedItems(nil)
+	assert.Nil(t, err)
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.Id)
+	}
+	assert.Contains(t, ids, kept.MessageID)
+	assert.NotContains(t, ids, cancelled.MessageID)
+
+	depth, err := MockRepo.GetQueueDepthByTier()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, depth[shared.QueueTierFree])
+
+	// Pruned once they've been cancelled for a while
+	pruned, err := MockRepo.PruneCancelledQueueLog(time.Now().Add(-time.Minute))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, pruned)
+	pruned, err = MockRepo.PruneCancelledQueueLog(time.Now().Add(time.Minute))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, pruned)
+	_, err = MockRepo.GetQueueLogItem(kept.MessageID)
+	assert.Nil(t, err)
+}
+
+func TestCountQueuedAhead(t *testing.T) {
+	higher, err := MockRepo.AddToQueueLog("ahead-higher", int(shared.QUEUE_PRIORITY_5), nil)
+	assert.Nil(t, err)
+	earlier, err := MockRepo.AddToQueueLog("ahead-earlier", int(shared.QUEUE_PRIORITY_2), nil)
+	assert.Nil(t, err)
+	processing, err := MockRepo.AddToQueueLog("ahead-processing", int(shared.QUEUE_PRIORITY_5), nil)
+	assert.Nil(t, err)
+	_, err = MockRepo.SetIsProcessingInQueueLog(processing.MessageID, true, nil)
+	assert.Nil(t, err)
+	time.Sleep(time.Millisecond)
+	item, err := MockRepo.AddToQueueLog("ahead-item", int(shared.QUEUE_PRIORITY_2), nil)
+	assert.Nil(t, err)
+	lower, err := MockRepo.AddToQueueLog("ahead-lower", int(shared.QUEUE_PRIORITY_1), nil)
+	assert.Nil(t, err)
+	later, err := MockRepo.AddToQueueLog("ahead-later", int(shared.QUEUE_PRIORITY_2), nil)
+	assert.Nil(t, err)
+	defer func() {
+		for _, l := range []string{higher.MessageID, earlier.MessageID, processing.MessageID, item.MessageID, lower.MessageID, later.MessageID} {
+			_, err = MockRepo.DeleteFromQueueLog(l, nil)
+			assert.Nil(t, err)
+		}
+	}()
+
+	item, err = MockRepo.GetQueueLogItem(item.MessageID)
+	assert.Nil(t, err)
+	ahead, err := MockRepo.CountQueuedAhead(item)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, ahead)
+	waiting, err := MockRepo.CountQueuedWaiting()
+	assert.Nil(t, err)
+	assert.Equal(t, 5, waiting)
+
+	// Cancelled ones aren't in the queue
+	_, err = MockRepo.CancelInQueueLog(higher.MessageID, nil)
+	assert.Nil(t, err)
+	ahead, err = MockRepo.CountQueuedAhead(item)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, ahead)
+	waiting, err = MockRepo.CountQueuedWaiting()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, waiting)
+	_, err = MockRepo.GetQueueLogItem(higher.MessageID)
+	assert.True(t, ent.IsNotFound(err))
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/upscale"
+	"github.com/stablecog/sc-go/database/ent/voiceover"
+	"github.com/stablecog/sc-go/shared"
+)
+
+// A generation, upscale or voiceover, with what we need to know about it to place it in the queue
+type QueuedJob struct {
+	ID          uuid.UUID
+	ProcessType shared.ProcessType
+	ModelID     uuid.UUID
+	Status      string
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+}
+
+// Get a generation, upscale or voiceover of the user, whichever has the ID
+func (r *Repository) GetQueuedJobForUser(id uuid.UUID, userID uuid.UUID) (*QueuedJob, error) {
+	g, err := r.DB.Generation.Query().Where(generation.IDEQ(id), generation.UserIDEQ(userID)).Only(r.Ctx)
+	if err == nil {
+		return &QueuedJob{ID: g.ID, ProcessType: shared.GENERATE, ModelID: g.ModelID, Status: string(g.Status), CreatedAt: g.CreatedAt, StartedAt: g.StartedAt, CompletedAt: g.CompletedAt}, nil
+	} else if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	u, err := r.DB.Upscale.Query().Where(upscale.IDEQ(id), upscale.UserIDEQ(userID)).Only(r.Ctx)
+	if err == nil {
+		return &QueuedJob{ID: u.ID, ProcessType: shared.UPSCALE, ModelID: u.ModelID, Status: string(u.Status), CreatedAt: u.CreatedAt, StartedAt: u.StartedAt, CompletedAt: u.CompletedAt}, nil
+	} else if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	v, err := r.DB.Voiceover.Query().Where(voiceover.IDEQ(id), voiceover.UserIDEQ(userID)).Only(r.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &QueuedJob{ID: v.ID, ProcessType: shared.VOICEOVER, ModelID: v.ModelID, Status: string(v.Status), CreatedAt: v.CreatedAt, StartedAt: v.StartedAt, CompletedAt: v.CompletedAt}, nil
+}
+
+// How long recent jobs of a model waited in the queue and took to run
+type JobTimings struct {
+	Samples   int
+	AvgQueueS float64
+	AvgRunS   float64
+}
+
+// Timings of the most recent jobs of the model that succeeded since, at most limit of them
+func (r *Repository) GetRecentJobTimings(processType shared.ProcessType, modelID uuid.UUID, since time.Time, limit int) (*JobTimings, error) {
+	var rows []struct {
+		CreatedAt   time.Time  `json:"created_at"`
+		StartedAt   *time.Time `json:"started_at"`
+		CompletedAt *time.Time `json:"completed_at"`
+	}
+	var err error
+	switch processType {
+	case shared.UPSCALE:
+		err = r.DB.Upscale.Query().
+			Where(upscale.ModelIDEQ(modelID), upscale.StatusEQ(upscale.StatusSucceeded), upscale.CreatedAtGT(since), upscale.StartedAtNotNil(), upscale.CompletedAtNotNil()).
+			Order(ent.Desc(upscale.FieldCreatedAt)).
+			Limit(limit).
+			Select(upscale.FieldCreatedAt, upscale.FieldStartedAt, upscale.FieldCompletedAt).
+			Scan(r.Ctx, &rows)
+	case shared.VOICEOVER:
+		err = r.DB.Voiceover.Query().
+			Where(voiceover.ModelIDEQ(modelID), voiceover.StatusEQ(voiceover.StatusSucceeded), voiceover.CreatedAtGT(since), voiceover.StartedAtNotNil(), voiceover.CompletedAtNotNil()).
+			Order(ent.Desc(voiceover.FieldCreatedAt)).
+			Limit(limit).
+			Select(voiceover.FieldCreatedAt, voiceover.FieldStartedAt, voiceover.FieldCompletedAt).
+			Scan(r.Ctx, &rows)
+	default:
+		err = r.DB.Generation.Query().
+			Where(generation.ModelIDEQ(modelID), generation.StatusEQ(generation.StatusSucceeded), generation.CreatedAtGT(since), generation.StartedAtNotNil(), generation.CompletedAtNotNil()).
+			Order(ent.Desc(generation.FieldCreatedAt)).
+			Limit(limit).
+			Select(generation.FieldCreatedAt, generation.FieldStartedAt, generation.FieldCompletedAt).
+			Scan(r.Ctx, &rows)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	timings := &JobTimings{Samples: len(rows)}
+	if len(rows) == 0 {
+		return timings, nil
+	}
+	for _, row := range rows {
+		timings.AvgQueueS += row.StartedAt.Sub(row.CreatedAt).Seconds()
+		timings.AvgRunS += row.CompletedAt.Sub(*row.StartedAt).Seconds()
+	}
+	timings.AvgQueueS /= float64(len(rows))
+	timings.AvgRunS /= float64(len(rows))
+	return timings, nil
+}
+
+// Jobs of any kind started per second since, how fast the queue moves
+func (r *Repository) GetJobStartRate(since time.Time) (float64, error) {
+	generations, err := r.DB.Generation.Query().Where(generation.StartedAtGTE(since)).Count(r.Ctx)
+	if err != nil {
+		return 0, err
+	}
+	upscales, err := r.DB.Upscale.Query().Where(upscale.StartedAtGTE(since)).Count(r.Ctx)
+	if err != nil {
+		return 0, err
+	}
+	voiceovers, err := r.DB.Voiceover.Query().Where(voiceover.StartedAtGTE(since)).Count(r.Ctx)
+	if err != nil {
+		return 0, err
+	}
+	elapsed := time.Since(since).Seconds()
+	if elapsed <= 0 {
+		return 0, nil
+	}
+	return float64(generations+upscales+voiceovers) / elapsed, nil
+}
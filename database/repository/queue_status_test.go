@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetQueuedJobForUser(t *testing.T) {
+	g, err := MockRepo.DB.Generation.Query().Where(generation.UserIDEQ(uuid.MustParse(MOCK_ADMIN_UUID))).First(MockRepo.Ctx)
+	assert.Nil(t, err)
+
+	job, err := MockRepo.GetQueuedJobForUser(g.ID, uuid.MustParse(MOCK_ADMIN_UUID))
+	assert.Nil(t, err)
+	assert.Equal(t, shared.GENERATE, job.ProcessType)
+	assert.Equal(t, g.ModelID, job.ModelID)
+	assert.Equal(t, string(g.Status), job.Status)
+
+	_, err = MockRepo.GetQueuedJobForUser(g.ID, uuid.MustParse(MOCK_NORMAL_UUID))
+	assert.True(t, ent.IsNotFound(err))
+	_, err = MockRepo.GetQueuedJobForUser(uuid.New(), uuid.MustParse(MOCK_ADMIN_UUID))
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestGetRecentJobTimings(t *testing.T) {
+	// Own model so other jobs don't count
+	model, err := MockRepo.DB.GenerationModel.Create().SetDefaultSchedulerID(uuid.MustParse(MOCK_SCHEDULER_ID)).SetNameInWorker("etamodel").SetShortName("eta").AddSchedulerIDs(uuid.MustParse(MOCK_SCHEDULER_ID)).SetIsActive(true).Save(MockRepo.Ctx)
+	assert.Nil(t, err)
+	userID := createCreditHoldTestUser(t, 0)
+	t.Cleanup(func() {
+		MockRepo.DB.Generation.Delete().Where(generation.UserIDEQ(userID)).ExecX(MockRepo.Ctx)
+		MockRepo.DB.GenerationModel.DeleteOneID(model.ID).ExecX(MockRepo.Ctx)
+	})
+
+	timings, err := MockRepo.GetRecentJobTimings(shared.GENERATE, model.ID, time.Now().Add(-time.Hour), 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, timings.Samples)
+
+	for _, d := range []struct{ queueS, runS int }{{10, 5}, {20, 7}} {
+		g, err := MockRepo.CreateGeneration(userID, "browser", "macos", "chrome", "DE", requests.CreateGenerationRequest{
+			Width:          utils.ToPtr[int32](512),
+			Height:         utils.ToPtr[int32](512),
+			InferenceSteps: utils.ToPtr[int32](30),
+			GuidanceScale:  utils.ToPtr[float32](7),
+			ModelId:        utils.ToPtr(model.ID),
+			SchedulerId:    utils.ToPtr(uuid.MustParse(MOCK_SCHEDULER_ID)),
+			Seed:           utils.ToPtr(1234),
+			NumOutputs:     utils.ToPtr[int32](1),
+		}, nil, nil, enttypes.SourceTypeWebUI, nil)
+		assert.Nil(t, err)
+		startedAt := g.CreatedAt.Add(time.Duration(d.queueS) * time.Second)
+		_, err = MockRepo.DB.Generation.UpdateOneID(g.ID).
+			SetStatus(generation.StatusSucceeded).
+			SetStartedAt(startedAt).
+			SetCompletedAt(startedAt.Add(time.Duration(d.runS) * time.Second)).
+			Save(MockRepo.Ctx)
+		assert.Nil(t, err)
+	}
+
+	timings, err = MockRepo.GetRecentJobTimings(shared.GENERATE, model.ID, time.Now().Add(-time.Hour), 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, timings.Samples)
+	assert.InDelta(t, 15, timings.AvgQueueS, 0.01)
+	assert.InDelta(t, 6, timings.AvgRunS, 0.01)
+
+	rate, err := MockRepo.GetJobStartRate(time.Now().Add(-time.Minute))
+	assert.Nil(t, err)
+	assert.Greater(t, rate, float64(0))
+}
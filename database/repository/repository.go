@@ -23,6 +23,16 @@ type Repository struct {
 	QueueThrottler *shared.UserQueueThrottlerMap
 }
 
+// Copy whose queries use ctx, e.g. to stop them when ctx is cancelled
+func (r *Repository) WithCtx(ctx context.Context) *Repository {
+	repo := *r
+	repo.Ctx = ctx
+	if r.Redis != nil {
+		repo.Redis = r.Redis.WithCtx(ctx)
+	}
+	return &repo
+}
+
 // WithTx runs a function in a transaction
 // Usage example:
 //
@@ -1,12 +1,18 @@
 package repository
 
 import (
+	"encoding/json"
+
+	"github.com/stablecog/sc-go/database"
+	"github.com/stablecog/sc-go/log"
 	"github.com/stablecog/sc-go/server/requests"
 	"github.com/stablecog/sc-go/shared"
 )
 
 // Represents an update to a generation/upscale in our database
 type TaskStatusUpdateResponse struct {
+	// Increases with every update of the stream, sent as the SSE event ID
+	EventID          int64                     `json:"event_id,omitempty"`
 	MessageType      string                    `json:"message_type"`
 	Status           requests.CogTaskStatus    `json:"status"`
 	ProcessType      shared.ProcessType        `json:"process_type"`
@@ -20,3 +26,31 @@ type TaskStatusUpdateResponse struct {
 	LivePageMessage  *shared.LivePageMessage   `json:"live_page_message,omitempty"`
 	RemainingCredits int                       `json:"total_remaining_credits,omitempty"`
 }
+
+// Publish an update to the servers holding SSE connections of its stream
+// It's given the stream's next event ID and kept in its backlog so clients that reconnect can catch up
+func (r *Repository) PublishTaskStatusUpdate(resp TaskStatusUpdateResponse) error {
+	resp.MessageType = "creation_process"
+	if resp.StreamId != "" {
+		eventID, err := r.Redis.NextSSEEventID(resp.StreamId)
+		if err != nil {
+			// Still deliver it to connected clients
+			log.Error("Error getting sse event id", "err", err, "stream_id", resp.StreamId)
+		} else {
+			resp.EventID = eventID
+		}
+	}
+
+	respBytes, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.EventID > 0 {
+		if err := r.Redis.AddSSEBacklogEvent(resp.StreamId, database.SSEEvent{ID: resp.EventID, Data: respBytes}); err != nil {
+			log.Error("Error adding sse backlog event", "err", err, "stream_id", resp.StreamId)
+		}
+	}
+
+	return r.Redis.Client.Publish(r.Redis.Ctx, shared.REDIS_SSE_BROADCAST_CHANNEL, respBytes).Err()
+}
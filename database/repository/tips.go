@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database/ent"
 	"github.com/stablecog/sc-go/database/ent/credit"
+	"github.com/stablecog/sc-go/database/ent/credittransaction"
 	"github.com/stablecog/sc-go/database/ent/tiplog"
 	"github.com/stablecog/sc-go/log"
 	"github.com/stablecog/sc-go/server/responses"
@@ -39,7 +40,7 @@ func (r *Repository) TipCreditsToUser(fromUser uuid.UUID, toUser *uuid.UUID, toU
 			}
 			if err != nil && ent.IsNotFound(err) {
 				// Create credit
-				_, err := db.Credit.Create().SetCreditTypeID(tippedCreditType.ID).SetUserID(*toUser).SetRemainingAmount(amount).SetExpiresAt(NEVER_EXPIRE).Save(r.Ctx)
+				tippedCredits, err = db.Credit.Create().SetCreditTypeID(tippedCreditType.ID).SetUserID(*toUser).SetRemainingAmount(amount).SetExpiresAt(NEVER_EXPIRE).Save(r.Ctx)
 				if err != nil {
 					return err
 				}
@@ -49,6 +50,10 @@ func (r *Repository) TipCreditsToUser(fromUser uuid.UUID, toUser *uuid.UUID, toU
 					return err
 				}
 			}
+			err = r.logCreditTransaction(tippedCredits, credittransaction.TypeTipReceived, amount, nil, db)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Log this tip
@@ -104,7 +109,7 @@ func (r *Repository) CollectUnclaimedTips(toUserId uuid.UUID, toUserDiscordId st
 	}
 	if err != nil && ent.IsNotFound(err) {
 		// Create credit
-		_, err := DB.Credit.Create().SetCreditTypeID(tippedCreditType.ID).SetUserID(toUserId).SetRemainingAmount(total).SetExpiresAt(NEVER_EXPIRE).Save(r.Ctx)
+		tippedCredits, err = DB.Credit.Create().SetCreditTypeID(tippedCreditType.ID).SetUserID(toUserId).SetRemainingAmount(total).SetExpiresAt(NEVER_EXPIRE).Save(r.Ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -114,5 +119,11 @@ func (r *Repository) CollectUnclaimedTips(toUserId uuid.UUID, toUserDiscordId st
 			return 0, err
 		}
 	}
+	if total != 0 {
+		err = r.logCreditTransaction(tippedCredits, credittransaction.TypeTipReceived, total, nil, DB)
+		if err != nil {
+			return 0, err
+		}
+	}
 	return total, nil
 }
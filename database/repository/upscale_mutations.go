@@ -109,6 +109,13 @@ func (r *Repository) SetUpscaleSucceeded(upscaleID, generationOutputID, inputIma
 			return err
 		}
 
+		// Charge the held credits
+		_, err = r.CaptureCreditHold(uid, tx.Client())
+		if err != nil {
+			log.Error("Error capturing credits", "id", upscaleID, "err", err)
+			return err
+		}
+
 		// Set upscale output
 		parsedS3, err := utils.GetPathFromS3URL(output.Images[0].Image)
 		if err != nil {
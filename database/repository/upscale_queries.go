@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database/ent"
 	"github.com/stablecog/sc-go/database/ent/upscale"
+	"github.com/stablecog/sc-go/database/ent/upscaleoutput"
 )
 
 // Get upscale by ID
@@ -13,15 +14,27 @@ func (r *Repository) GetUpscale(id uuid.UUID) (*ent.Upscale, error) {
 	return r.DB.Upscale.Query().Where(upscale.IDEQ(id)).First(r.Ctx)
 }
 
+// Get upscale by ID for user, with non-deleted outputs
+func (r *Repository) GetUpscaleWithOutputsForUser(id uuid.UUID, userID uuid.UUID) (*ent.Upscale, error) {
+	return r.DB.Upscale.Query().Where(upscale.IDEQ(id), upscale.UserIDEQ(userID)).WithUpscaleOutputs(func(uoq *ent.UpscaleOutputQuery) {
+		uoq.Where(upscaleoutput.DeletedAtIsNil())
+	}).First(r.Ctx)
+}
+
 func (r *Repository) GetUpscalesQueuedOrStarted() ([]*ent.Upscale, error) {
 	// Get upscales that are started/queued and older than 5 minutes
+	// Replayed dead letters are aged from when they were requeued
+	cutoff := time.Now().Add(-5 * time.Minute)
 	return r.DB.Upscale.Query().
 		Where(
 			upscale.StatusIn(
 				upscale.StatusQueued,
 				upscale.StatusStarted,
 			),
-			upscale.CreatedAtLT(time.Now().Add(-5*time.Minute)),
+			upscale.Or(
+				upscale.And(upscale.RequeuedAtIsNil(), upscale.CreatedAtLT(cutoff)),
+				upscale.RequeuedAtLT(cutoff),
+			),
 		).
 		Order(ent.Desc(upscale.FieldCreatedAt)).
 		Limit(100).
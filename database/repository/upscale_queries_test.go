@@ -3,6 +3,8 @@ package repository
 import (
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,3 +24,22 @@ func TestGetUpscale(t *testing.T) {
 	// Delete
 	MockRepo.DB.Upscale.DeleteOne(u).ExecX(MockRepo.Ctx)
 }
+
+func TestGetUpscaleWithOutputsForUser(t *testing.T) {
+	u, err := MockRepo.CreateMockUpscaleForDeletion(MockRepo.Ctx)
+	assert.Nil(t, err)
+	assert.NotNil(t, u)
+
+	// Owner
+	u2, err := MockRepo.GetUpscaleWithOutputsForUser(u.ID, uuid.MustParse(MOCK_ADMIN_UUID))
+	assert.Nil(t, err)
+	assert.Equal(t, u.ID, u2.ID)
+	assert.Len(t, u2.Edges.UpscaleOutputs, 0)
+
+	// Not owner
+	_, err = MockRepo.GetUpscaleWithOutputsForUser(u.ID, uuid.MustParse(MOCK_NORMAL_UUID))
+	assert.True(t, ent.IsNotFound(err))
+
+	// Delete
+	MockRepo.DB.Upscale.DeleteOne(u).ExecX(MockRepo.Ctx)
+}
@@ -118,6 +118,13 @@ func (r *Repository) SetVoiceoverSucceeded(voiceoverId, promptStr string, submit
 			return err
 		}
 
+		// Charge the held credits
+		_, err = r.CaptureCreditHold(uid, db)
+		if err != nil {
+			log.Error("Error capturing credits", "id", voiceoverId, "err", err)
+			return err
+		}
+
 		// If this voiceover was created with "submit_to_gallery", then submit all outputs to gallery
 		var galleryStatus voiceoveroutput.GalleryStatus
 		if submitToGallery {
@@ -37,15 +37,27 @@ func (r *Repository) GetVoiceover(id uuid.UUID) (*ent.Voiceover, error) {
 	return r.DB.Voiceover.Query().Where(voiceover.ID(id)).Only(r.Ctx)
 }
 
+// Get voiceover by ID for user, with non-deleted outputs
+func (r *Repository) GetVoiceoverWithOutputsForUser(id uuid.UUID, userID uuid.UUID) (*ent.Voiceover, error) {
+	return r.DB.Voiceover.Query().Where(voiceover.IDEQ(id), voiceover.UserIDEQ(userID)).WithVoiceoverOutputs(func(voq *ent.VoiceoverOutputQuery) {
+		voq.Where(voiceoveroutput.DeletedAtIsNil())
+	}).First(r.Ctx)
+}
+
 func (r *Repository) GetVoiceoversQueuedOrStarted() ([]*ent.Voiceover, error) {
 	// Get voiceovers that are started/queued and older than 5 minutes
+	// Replayed dead letters are aged from when they were requeued
+	cutoff := time.Now().Add(-5 * time.Minute)
 	return r.DB.Voiceover.Query().
 		Where(
 			voiceover.StatusIn(
 				voiceover.StatusQueued,
 				voiceover.StatusStarted,
 			),
-			voiceover.CreatedAtLT(time.Now().Add(-5*time.Minute)),
+			voiceover.Or(
+				voiceover.And(voiceover.RequeuedAtIsNil(), voiceover.CreatedAtLT(cutoff)),
+				voiceover.RequeuedAtLT(cutoff),
+			),
 		).
 		Order(ent.Desc(voiceover.FieldCreatedAt)).
 		Limit(100).
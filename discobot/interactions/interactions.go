@@ -70,6 +70,8 @@ func NewDiscordInteractionWrapper(
 			Repo:           repo,
 			Redis:          redis,
 			QueueThrottler: qThrottler,
+			Scheduler:      shared.NewFairScheduler(redis.Ctx, redis.Client),
+			QueueDepth:     scworker.NewQueueDepthCache(repo),
 			Track:          track,
 			SMap:           sMap,
 			SafetyChecker:  safetyChecker,
@@ -150,12 +150,12 @@ func main() {
 	analyticsService := analytics.NewAnalyticsService()
 	defer analyticsService.Close()
 
-	// Setup rabbitmq client
-	rabbitmqClient, err := queue.NewRabbitMQClient(ctx, utils.GetEnv().RabbitMQAMQPUrl)
+	// Setup message queue client
+	mqClient, err := queue.NewMQClient(ctx, redis.Client)
 	if err != nil {
-		log.Fatalf("Error connecting to rabbitmq: %v", err)
+		log.Fatalf("Error connecting to message queue: %v", err)
 	}
-	defer rabbitmqClient.Close()
+	defer mqClient.Close()
 
 	// Setup asynq client
 	options := redis.Client.Options()
@@ -168,7 +168,7 @@ func main() {
 	defer asynqClient.Close()
 
 	// Setup interactions
-	cmdWrapper := interactions.NewDiscordInteractionWrapper(repo, redis, database.NewSupabaseAuth(), sMap, qThrottler, safetyChecker, analyticsService, loginInteractionMap, rabbitmqClient, asynqClient)
+	cmdWrapper := interactions.NewDiscordInteractionWrapper(repo, redis, database.NewSupabaseAuth(), sMap, qThrottler, safetyChecker, analyticsService, loginInteractionMap, mqClient, asynqClient)
 
 	s.AddHandler(func(s *discordgo.Session, r *discordgo.Ready) {
 		log.Infof("Logged in as: %v#%v", s.State.User.Username, s.State.User.Discriminator)
@@ -3,16 +3,23 @@ package main
 // asynq consumer
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 
 	"github.com/hibiken/asynq"
 	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/stablecog/sc-go/database"
 	"github.com/stablecog/sc-go/log"
 	"github.com/stablecog/sc-go/quecon/processor"
+	"github.com/stablecog/sc-go/server/requests"
 	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/shared/queue"
+	"github.com/stablecog/sc-go/utils"
 )
 
 var Version = "dev"
@@ -31,6 +38,8 @@ func main() {
 	defer log.CloseLoki()
 
 	showHelp := flag.Bool("help", false, "Show help")
+	// Queue depth and job metrics are served on /metrics, all interfaces on port 9090 unless set
+	metricsAddr := flag.String("metrics-addr", ":9090", "Address to serve prometheus metrics on, /metrics on every interface at port 9090 by default")
 	flag.Parse()
 
 	if *showHelp {
@@ -75,20 +84,74 @@ func main() {
 		Password: options.Password,
 	}
 
+	// Webhooks are persisted as tasks
+	asynqClient := asynq.NewClient(redisOptions)
+	defer asynqClient.Close()
+
 	// Setup handler wrapper
-	processor := processor.NewQueueProcessor()
+	queueProcessor := processor.NewQueueProcessor(asynqClient)
+	queueProcessor.Cancelled = redis
 
 	srv := asynq.NewServer(
 		redisOptions,
 		asynq.Config{
-			Concurrency: 5,
-			Queues:      shared.ASYNQ_QUEUE_DEFINITIONS,
+			Concurrency:    5,
+			Queues:         processor.QueueDefinitions(),
+			RetryDelayFunc: processor.RetryDelay,
+			ErrorHandler: asynq.ErrorHandlerFunc(func(ctx context.Context, task *asynq.Task, err error) {
+				id, _ := asynq.GetTaskID(ctx)
+				retried, _ := asynq.GetRetryCount(ctx)
+				log.Warn("Task failed", "type", task.Type(), "id", id, "retried", retried, "err", err)
+			}),
 		},
 	)
 
+	// Serve metrics
+	inspector := asynq.NewInspector(redisOptions)
+	defer inspector.Close()
+	prometheus.MustRegister(processor.NewQueueCollector(inspector))
+	go func() {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		if err := http.ListenAndServe(*metricsAddr, mux); err != nil {
+			log.Error("Error serving metrics", "err", err)
+		}
+	}()
+
+	// Stop jobs their users cancelled
+	go func() {
+		pubsub := redis.Client.Subscribe(ctx, shared.REDIS_SC_WORKER_CONTROL_CHANNEL)
+		defer pubsub.Close()
+		for msg := range pubsub.Channel() {
+			var controlMsg requests.WorkerControlMessage
+			if err := json.Unmarshal([]byte(msg.Payload), &controlMsg); err != nil {
+				log.Error("Error unmarshalling worker control message", "err", err)
+				continue
+			}
+			if controlMsg.Action == requests.WorkerControlCancel && queueProcessor.CancelJob(controlMsg.ID.String()) {
+				log.Info("Cancelled job", "id", controlMsg.ID)
+			}
+		}
+	}()
+
+	// Without RabbitMQ, jobs published to the redis streams are taken from there
+	if utils.GetEnv().MQBackend == queue.BackendRedis {
+		mqConsumer := queue.NewRedisMQClient(ctx, redis.Client, utils.GetEnv().RabbitMQQueueName)
+		go func() {
+			if err := mqConsumer.Consume(ctx, queueProcessor.HandleMQDelivery); err != nil {
+				log.Fatal("Error consuming redis queue", "err", err)
+			}
+		}()
+	}
+
 	// Define handler
 	mux := asynq.NewServeMux()
-	mux.HandleFunc(shared.ASYNQ_TASK_GENERATE, processor.HandleImageJob)
+	mux.Use(processor.MetricsMiddleware)
+	mux.HandleFunc(shared.ASYNQ_TASK_GENERATE, queueProcessor.HandleGenerateTask)
+	mux.HandleFunc(shared.ASYNQ_TASK_UPSCALE, queueProcessor.HandleUpscaleTask)
+	mux.HandleFunc(shared.ASYNQ_TASK_VOICEOVER, queueProcessor.HandleVoiceoverTask)
+	mux.HandleFunc(shared.ASYNQ_TASK_WEBHOOK, queueProcessor.HandleWebhookTask)
+	mux.HandleFunc(shared.ASYNQ_TASK_CALLBACK, queueProcessor.HandleCallbackTask)
 
 	if err := srv.Run(mux); err != nil {
 		log.Fatal("Error running asynq server", "err", err)
@@ -0,0 +1,113 @@
+package processor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stablecog/sc-go/log"
+)
+
+// Outcomes of handled tasks by type, counted by MetricsMiddleware
+var taskResults = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "quecon_task_results_total",
+		Help: "Number of handled tasks by type and result: succeeded, retried or archived",
+	},
+	[]string{"type", "result"},
+)
+
+func init() {
+	prometheus.MustRegister(taskResults)
+}
+
+// Counts the outcome of every task
+func MetricsMiddleware(next asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+		err := next.ProcessTask(ctx, t)
+		result := "succeeded"
+		if err != nil {
+			result = "retried"
+			if errors.Is(err, asynq.SkipRetry) || lastAttempt(ctx) {
+				result = "archived"
+			}
+		}
+		taskResults.WithLabelValues(t.Type(), result).Inc()
+		return err
+	})
+}
+
+// Exposes the size, latency and throughput of each asynq queue, read from redis on every scrape
+type QueueCollector struct {
+	inspector *asynq.Inspector
+	tasks     *prometheus.Desc
+	latency   *prometheus.Desc
+	processed *prometheus.Desc
+	failed    *prometheus.Desc
+}
+
+func NewQueueCollector(inspector *asynq.Inspector) *QueueCollector {
+	return &QueueCollector{
+		inspector: inspector,
+		tasks: prometheus.NewDesc(
+			"quecon_queue_tasks",
+			"Number of tasks in the queue by state",
+			[]string{"queue", "state"},
+			nil,
+		),
+		latency: prometheus.NewDesc(
+			"quecon_queue_latency_seconds",
+			"Age of the oldest pending task in the queue",
+			[]string{"queue"},
+			nil,
+		),
+		processed: prometheus.NewDesc(
+			"quecon_queue_processed_total",
+			"Number of tasks processed from the queue, failed or not",
+			[]string{"queue"},
+			nil,
+		),
+		failed: prometheus.NewDesc(
+			"quecon_queue_failed_total",
+			"Number of tasks from the queue whose processing failed",
+			[]string{"queue"},
+			nil,
+		),
+	}
+}
+
+func (c *QueueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.tasks
+	ch <- c.latency
+	ch <- c.processed
+	ch <- c.failed
+}
+
+func (c *QueueCollector) Collect(ch chan<- prometheus.Metric) {
+	// Queues that never had a task don't exist yet
+	queues, err := c.inspector.Queues()
+	if err != nil {
+		log.Error("Error listing queues", "err", err)
+		return
+	}
+	for _, queue := range queues {
+		info, err := c.inspector.GetQueueInfo(queue)
+		if err != nil {
+			log.Error("Error getting queue info", "queue", queue, "err", err)
+			continue
+		}
+		for state, n := range map[string]int{
+			"pending":   info.Pending,
+			"active":    info.Active,
+			"scheduled": info.Scheduled,
+			"retry":     info.Retry,
+			"archived":  info.Archived,
+		} {
+			ch <- prometheus.MustNewConstMetric(c.tasks, prometheus.GaugeValue, float64(n), queue, state)
+		}
+		ch <- prometheus.MustNewConstMetric(c.latency, prometheus.GaugeValue, info.Latency.Seconds(), queue)
+		ch <- prometheus.MustNewConstMetric(c.processed, prometheus.CounterValue, float64(info.ProcessedTotal), queue)
+		ch <- prometheus.MustNewConstMetric(c.failed, prometheus.CounterValue, float64(info.FailedTotal), queue)
+	}
+}
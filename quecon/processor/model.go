@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -19,172 +20,253 @@ import (
 // How frequently to poll runpod for current job status
 const POLL_INTERVAL = 300 * time.Millisecond
 
-func (p *QueueProcessor) HandleImageJob(ctx context.Context, t *asynq.Task) error {
-	start := time.Now()
+// How long a job may take on runpod
+const JOB_TIMEOUT = 60 * time.Second
 
-	sentProcessing := false
+// Runpod couldn't take the job right now, worth retrying
+type transientError struct {
+	err error
+}
+
+func (e *transientError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transientError) Unwrap() error {
+	return e.err
+}
+
+// Converts what a runpod job returned to the webhook output, with the number of outputs
+type outputConverter func(output responses.RunpodOutputOutput) (requests.CogWebhookOutput, int)
+
+func imageOutput(output responses.RunpodOutputOutput) (requests.CogWebhookOutput, int) {
+	// Convert shape of images array for compatibility
+	images := make([]requests.CogWebhookOutputImage, len(output.Images))
+	for i, url := range output.Images {
+		images[i] = requests.CogWebhookOutputImage{Image: url}
+	}
+	return requests.CogWebhookOutput{Images: images}, len(images)
+}
+
+func audioOutput(output responses.RunpodOutputOutput) (requests.CogWebhookOutput, int) {
+	return requests.CogWebhookOutput{AudioFiles: output.AudioFiles}, len(output.AudioFiles)
+}
+
+func (p *QueueProcessor) HandleGenerateTask(ctx context.Context, t *asynq.Task) error {
+	return p.runRunpodJob(ctx, t, imageOutput)
+}
+
+func (p *QueueProcessor) HandleUpscaleTask(ctx context.Context, t *asynq.Task) error {
+	return p.runRunpodJob(ctx, t, imageOutput)
+}
+
+func (p *QueueProcessor) HandleVoiceoverTask(ctx context.Context, t *asynq.Task) error {
+	return p.runRunpodJob(ctx, t, audioOutput)
+}
+
+// Fail a job on the server, the task is archived
+func (p *QueueProcessor) failJob(input requests.BaseCogRequest, reason string, err error) error {
+	p.QueueSCWebhook(requests.CogWebhookMessage{
+		Status: requests.CogFailed,
+		Input:  input,
+		Error:  reason,
+	})
+	return fmt.Errorf("%s: %v: %w", reason, err, asynq.SkipRetry)
+}
+
+// Run a job on its runpod endpoint and send its result to the server
+// Transient errors are retried with backoff, a job that keeps failing or fails for good is failed on the server and archived
+func (p *QueueProcessor) runRunpodJob(ctx context.Context, t *asynq.Task, convert outputConverter) error {
+	start := time.Now()
 
 	var payload requests.RunpodInput
 	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		// Poisoned, nothing to tell the server about
 		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
 	}
 
-	// Informative logging
-	log.Infof("Processing image job %s, model %s", payload.Input.ID.String(), payload.Input.Model)
+	log.Info("Processing job", "type", t.Type(), "id", payload.Input.ID, "model", payload.Input.Model)
 
-	if payload.Input.RunpodEndpoint == nil {
-		log.Errorf("Received job with no runpod endpoint %s", payload.Input.ID.String())
-		go func() {
-			p.IssueSCWebhook(requests.CogWebhookMessage{
-				Status: requests.CogFailed,
-				Input:  payload.Input,
-				Error:  "runpod_endpoint_not_set",
-			}, 0)
-		}()
-		return fmt.Errorf("runpod_endpoint_not_set: %w", asynq.SkipRetry)
-	}
-
-	// Issue task to runpod
-	jsonData, err := json.Marshal(payload)
-	if err != nil {
-		log.Errorf("Error marshaling payload: %v", err)
-		return fmt.Errorf("json.Marshal failed: %v: %w", err, asynq.SkipRetry)
+	// So the job can be stopped if its user cancels it
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	p.running.Put(payload.Input.ID.String(), cancel)
+	defer p.running.Delete(payload.Input.ID.String())
+	// Checked once it can be stopped, so a cancel can't slip in between
+	if p.jobCancelled(ctx, payload.Input.ID.String()) {
+		log.Info("Skipping cancelled job", "id", payload.Input.ID)
+		return fmt.Errorf("job cancelled: %w", asynq.SkipRetry)
 	}
 
-	// Create a new request
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/run", *payload.Input.RunpodEndpoint), bytes.NewBuffer(jsonData))
-	if err != nil {
-		return fmt.Errorf("http.NewRequest failed: %v: %w", err, asynq.SkipRetry)
+	if payload.Input.RunpodEndpoint == nil {
+		log.Error("Received job with no runpod endpoint", "id", payload.Input.ID)
+		return p.failJob(payload.Input, "runpod_endpoint_not_set", errors.New("no runpod endpoint"))
 	}
+	endpoint := *payload.Input.RunpodEndpoint
 
-	// Set the content type and signature header
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", utils.GetEnv().RunpodApiToken))
-
-	// Perform the request
-	resp, err := p.Client.Do(req)
+	runpodID, err := p.submitRunpodJob(endpoint, payload)
 	if err != nil {
-		return fmt.Errorf("http.Client.Do failed: %v", err)
-	}
-	defer resp.Body.Close()
-
-	// Unmarshal response
-	var runpodInitialResponse responses.RunpodOutput
-	if err := json.NewDecoder(resp.Body).Decode(&runpodInitialResponse); err != nil {
-		log.Errorf("Error decoding runpod response: %v", err)
-		// Send error to webhook
-		go func() {
-			p.IssueSCWebhook(requests.CogWebhookMessage{
-				Status: requests.CogFailed,
-				Input:  payload.Input,
-				Error:  "error_decoding_runpod_response",
-			}, 0)
-		}()
-		return fmt.Errorf("error_decoding_runpod_response: %w", asynq.SkipRetry)
+		var transient *transientError
+		if errors.As(err, &transient) && !lastAttempt(ctx) {
+			log.Warn("Error submitting job to runpod, retrying", "id", payload.Input.ID, "err", err)
+			return err
+		}
+		log.Error("Error submitting job to runpod", "id", payload.Input.ID, "err", err)
+		return p.failJob(payload.Input, "runpod_unavailable", err)
 	}
 
 	// Poll runpod for status
-	// Poll runpod for status
-	statusURL := fmt.Sprintf("%s/status/%s", *payload.Input.RunpodEndpoint, runpodInitialResponse.ID)
+	statusURL := fmt.Sprintf("%s/status/%s", endpoint, runpodID)
 	ticker := time.NewTicker(POLL_INTERVAL)
 	defer ticker.Stop()
 
-	timeout := time.After(60 * time.Second)
+	sentProcessing := false
+	timeout := time.After(JOB_TIMEOUT)
 	for {
 		select {
 		case <-ctx.Done():
+			// The server already failed and refunded it, no webhook
+			if context.Cause(ctx) == errJobCancelled {
+				log.Info("Cancelling job", "id", payload.Input.ID)
+				if err := p.cancelRunpodJob(endpoint, runpodID); err != nil {
+					log.Error("Error cancelling runpod job", "runpod_id", runpodID, "err", err)
+				}
+				return fmt.Errorf("job cancelled: %w", asynq.SkipRetry)
+			}
+			// Shutting down, the retry submits it again so this run would only use up GPU time
+			log.Info("Shutting down, cancelling runpod job to retry later", "id", payload.Input.ID)
+			if err := p.cancelRunpodJob(endpoint, runpodID); err != nil {
+				log.Error("Error cancelling runpod job", "runpod_id", runpodID, "err", err)
+			}
 			return fmt.Errorf("context canceled: %w", ctx.Err())
 		case <-timeout:
-			go func() {
-				p.IssueSCWebhook(requests.CogWebhookMessage{
-					Status: requests.CogFailed,
-					Input:  payload.Input,
-					Error:  shared.TIMEOUT_ERROR,
-				}, 0)
-			}()
-			return fmt.Errorf("polling timed out after 60 seconds: %w", asynq.SkipRetry)
-		case <-ticker.C:
-			// Poll the status endpoint
-			// Create a new request for polling the status
-			req, err := http.NewRequest("GET", statusURL, nil)
-			if err != nil {
-				log.Errorf("http.NewRequest failed: %v", err)
-				continue // Retry polling on error
+			if err := p.cancelRunpodJob(endpoint, runpodID); err != nil {
+				log.Error("Error cancelling timed out runpod job", "runpod_id", runpodID, "err", err)
 			}
-			req.Header.Set("Content-Type", "application/json")
-			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", utils.GetEnv().RunpodApiToken))
-
-			statusResp, err := p.Client.Do(req)
+			return p.failJob(payload.Input, shared.TIMEOUT_ERROR, fmt.Errorf("polling timed out after %s", JOB_TIMEOUT))
+		case <-ticker.C:
+			runpodResponse, err := p.getRunpodStatus(statusURL)
 			if err != nil {
-				log.Errorf("Error polling runpod status: %v", err)
-				continue // Retry polling on error
-			}
-			defer statusResp.Body.Close()
-
-			var runpodResponse responses.RunpodOutput
-			if err := json.NewDecoder(statusResp.Body).Decode(&runpodResponse); err != nil {
-				log.Errorf("Error decoding runpod status response: %v", err)
+				log.Warn("Error polling runpod status", "id", payload.Input.ID, "err", err)
 				continue // Retry polling on error
 			}
 
-			if runpodResponse.Status == responses.RunpodStatusInProgress {
+			switch runpodResponse.Status {
+			case responses.RunpodStatusInProgress:
 				if !sentProcessing {
 					sentProcessing = true
-					// Send processing to webhook
+					// Best effort, a lost processing webhook doesn't change the outcome
 					go func() {
-						p.IssueSCWebhook(requests.CogWebhookMessage{
+						if _, err := p.IssueSCWebhook(requests.CogWebhookMessage{
 							Status: requests.CogProcessing,
 							Input:  payload.Input,
-						}, 0)
+						}); err != nil {
+							log.Warn("Error sending processing webhook", "id", payload.Input.ID, "err", err)
+						}
 					}()
 				}
-				continue
-			}
-
-			// Check the status and act accordingly
-			if runpodResponse.Status == responses.RunpodStatusFailed || (runpodResponse.Status == responses.RunpodStatusCompleted && len(runpodResponse.Output.Output.Images) == 0) {
+			case responses.RunpodStatusFailed:
 				errorMsg := runpodResponse.Error
 				if errorMsg == "" {
 					errorMsg = "runpod_failed"
-				} else if len(runpodResponse.Output.Output.Images) == 0 {
-					errorMsg = "no_outputs"
 				}
-				log.Errorf("Runpod failed for task %s: %s", payload.Input.ID, errorMsg)
-				// Send error to webhook
-				go func() {
-					p.IssueSCWebhook(requests.CogWebhookMessage{
-						Status: requests.CogFailed,
-						Input:  payload.Input,
-						Error:  errorMsg,
-					}, 0)
-				}()
-				return fmt.Errorf("runpod_failed: %w", asynq.SkipRetry)
-			}
-
-			// Success status
-			if runpodResponse.Status == responses.RunpodStatusCompleted {
-				// Send success to webhook
-				go func() {
-					// Convert shape of images array for compatibility
-					images := make([]requests.CogWebhookOutputImage, len(runpodResponse.Output.Output.Images))
-					for i, url := range runpodResponse.Output.Output.Images {
-						images[i] = requests.CogWebhookOutputImage{Image: url}
-					}
-
-					p.IssueSCWebhook(requests.CogWebhookMessage{
-						Status: requests.CogSucceeded,
-						Input:  payload.Input,
-						Output: requests.CogWebhookOutput{
-							Images: images,
-						},
-					}, 0)
-				}()
-
-				end := time.Now()
-				//Log duration in seconds
-				log.Infof("Generated %d outputs of %s in %f seconds", len(runpodResponse.Output.Output.Images), payload.Input.Model, end.Sub(start).Seconds())
+				log.Error("Runpod failed job", "id", payload.Input.ID, "err", errorMsg)
+				return p.failJob(payload.Input, errorMsg, errors.New("runpod_failed"))
+			case responses.RunpodStatusCompleted:
+				output, count := convert(runpodResponse.Output.Output)
+				if count == 0 {
+					log.Error("Runpod job completed without outputs", "id", payload.Input.ID)
+					return p.failJob(payload.Input, "no_outputs", errors.New("runpod_failed"))
+				}
+				p.QueueSCWebhook(requests.CogWebhookMessage{
+					Status: requests.CogSucceeded,
+					Input:  payload.Input,
+					Output: output,
+				})
+				log.Info("Completed job", "type", t.Type(), "id", payload.Input.ID, "model", payload.Input.Model, "outputs", count, "seconds", time.Since(start).Seconds())
 				return nil
 			}
 		}
 	}
 }
+
+func newRunpodRequest(method string, url string, body []byte) (*http.Request, error) {
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", utils.GetEnv().RunpodApiToken))
+	return req, nil
+}
+
+// Start a job on runpod, returns its runpod ID
+// Network errors, rate limits and server errors are transient
+func (p *QueueProcessor) submitRunpodJob(endpoint string, payload requests.RunpodInput) (string, error) {
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	req, err := newRunpodRequest("POST", fmt.Sprintf("%s/run", endpoint), jsonData)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return "", &transientError{err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+		return "", &transientError{fmt.Errorf("unexpected status code %d", resp.StatusCode)}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var runpodResponse responses.RunpodOutput
+	if err := json.NewDecoder(resp.Body).Decode(&runpodResponse); err != nil {
+		return "", fmt.Errorf("error_decoding_runpod_response: %w", err)
+	}
+	if runpodResponse.ID == "" {
+		return "", errors.New("runpod returned no job id")
+	}
+	return runpodResponse.ID, nil
+}
+
+func (p *QueueProcessor) getRunpodStatus(statusURL string) (*responses.RunpodOutput, error) {
+	req, err := newRunpodRequest("GET", statusURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var runpodResponse responses.RunpodOutput
+	if err := json.NewDecoder(resp.Body).Decode(&runpodResponse); err != nil {
+		return nil, err
+	}
+	return &runpodResponse, nil
+}
+
+// Stop a job on runpod so it doesn't keep a worker busy
+func (p *QueueProcessor) cancelRunpodJob(endpoint string, runpodID string) error {
+	req, err := newRunpodRequest("POST", fmt.Sprintf("%s/cancel/%s", endpoint, runpodID), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
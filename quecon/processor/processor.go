@@ -2,64 +2,255 @@ package processor
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand"
 	"net/http"
 	"time"
 
+	"github.com/hibiken/asynq"
+	"github.com/stablecog/sc-go/log"
 	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/shared/queue"
 	"github.com/stablecog/sc-go/utils"
 )
 
-const MaxWebhookRetries = 5
+// Cause of the context of a job cancelled by its user
+var errJobCancelled = errors.New("job_cancelled")
+
+// What the processor needs from the asynq client, *asynq.Client in production
+type TaskEnqueuer interface {
+	Enqueue(task *asynq.Task, opts ...asynq.Option) (*asynq.TaskInfo, error)
+}
+
+// Where the processor finds out about jobs cancelled before it picked them up, *database.RedisWrapper in production
+type CancelledJobs interface {
+	IsJobCancelled(ctx context.Context, requestID string) (bool, error)
+}
 
 type QueueProcessor struct {
 	Client *http.Client
+	// For callback URLs users give us, only reaches public addresses
+	CallbackClient *http.Client
+	// Webhooks are persisted as tasks through this
+	Asynq TaskEnqueuer
+	// Jobs cancelled while queued are skipped when set
+	Cancelled CancelledJobs
+	// Jobs being processed, by ID
+	running *shared.SyncMap[context.CancelCauseFunc]
 }
 
-func NewQueueProcessor() *QueueProcessor {
+func NewQueueProcessor(asynqClient TaskEnqueuer) *QueueProcessor {
 	return &QueueProcessor{
 		Client: &http.Client{
 			Timeout: time.Second * 60,
 		},
+		CallbackClient: utils.NewPublicHTTPClient(10 * time.Second),
+		Asynq:          asynqClient,
+		running:        shared.NewSyncMap[context.CancelCauseFunc](),
+	}
+}
+
+// Stops a job cancelled by its user, returns false if it isn't being processed here
+func (p *QueueProcessor) CancelJob(id string) bool {
+	if !p.running.Exists(id) {
+		return false
+	}
+	p.running.Get(id)(errJobCancelled)
+	return true
+}
+
+// Whether a job was cancelled before it was picked up, the server already failed and refunded it
+// Errors count as not cancelled, running a job nobody wants is better than dropping one
+func (p *QueueProcessor) jobCancelled(ctx context.Context, id string) bool {
+	if p.Cancelled == nil {
+		return false
+	}
+	cancelled, err := p.Cancelled.IsJobCancelled(ctx, id)
+	if err != nil {
+		log.Error("Error checking if job was cancelled", "id", id, "err", err)
+		return false
+	}
+	return cancelled
+}
+
+// Queues consumed by quecon, the job queues by priority and the webhook queue
+func QueueDefinitions() map[string]int {
+	queues := make(map[string]int, len(shared.ASYNQ_QUEUE_DEFINITIONS)+1)
+	for name, priority := range shared.ASYNQ_QUEUE_DEFINITIONS {
+		queues[name] = priority
 	}
+	queues[shared.ASYNQ_WEBHOOK_QUEUE] = shared.ASYNQ_WEBHOOK_QUEUE_PRIORITY
+	return queues
+}
+
+// Exponential backoff with full jitter, an asynq.RetryDelayFunc
+// Jitter keeps a burst of tasks failing together from retrying together
+func RetryDelay(n int, err error, t *asynq.Task) time.Duration {
+	delay := shared.ASYNQ_RETRY_MAX_DELAY
+	if n < 16 {
+		delay = min(shared.ASYNQ_RETRY_BASE_DELAY<<n, shared.ASYNQ_RETRY_MAX_DELAY)
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// Whether this is the last attempt of the task being processed in ctx
+func lastAttempt(ctx context.Context) bool {
+	retried, _ := asynq.GetRetryCount(ctx)
+	maxRetry, ok := asynq.GetMaxRetry(ctx)
+	return ok && retried >= maxRetry
 }
 
-func (p *QueueProcessor) IssueSCWebhook(data requests.CogWebhookMessage, retries int) int {
-	// Convert data to JSON
+// Persist a terminal webhook as a task, so it's retried until the server takes it even if either side restarts
+// Falls back to sending it directly if it can't be queued
+func (p *QueueProcessor) QueueSCWebhook(data requests.CogWebhookMessage) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		log.Error("Error marshalling webhook", "id", data.Input.ID, "err", err)
+		return
+	}
+	_, err = p.Asynq.Enqueue(
+		asynq.NewTask(shared.ASYNQ_TASK_WEBHOOK, payload),
+		asynq.Queue(shared.ASYNQ_WEBHOOK_QUEUE),
+		asynq.MaxRetry(shared.ASYNQ_WEBHOOK_MAX_RETRY),
+		// One webhook per job and status
+		asynq.TaskID(fmt.Sprintf("webhook:%s:%s", data.Input.ID, data.Status)),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return
+	}
+	if err != nil {
+		log.Error("Error queueing webhook, sending it directly", "id", data.Input.ID, "status", data.Status, "err", err)
+		go func() {
+			if _, err := p.IssueSCWebhook(data); err != nil {
+				log.Error("Error sending webhook", "id", data.Input.ID, "status", data.Status, "err", err)
+			}
+		}()
+	}
+}
+
+// Deliver a webhook queued with QueueSCWebhook
+func (p *QueueProcessor) HandleWebhookTask(ctx context.Context, t *asynq.Task) error {
+	var data requests.CogWebhookMessage
+	if err := json.Unmarshal(t.Payload(), &data); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+
+	statusCode, err := p.IssueSCWebhook(data)
+	if err != nil {
+		log.Warn("Error sending webhook", "id", data.Input.ID, "status", data.Status, "err", err)
+		return err
+	}
+	switch {
+	case statusCode == http.StatusOK:
+		return nil
+	case statusCode == http.StatusBadRequest || statusCode == http.StatusUnauthorized:
+		// The server won't take it however many times it's sent, kept archived for inspection
+		log.Error("Webhook rejected", "id", data.Input.ID, "status", data.Status, "status_code", statusCode)
+		return fmt.Errorf("webhook rejected with status code %d: %w", statusCode, asynq.SkipRetry)
+	default:
+		log.Warn("Webhook failed", "id", data.Input.ID, "status", data.Status, "status_code", statusCode)
+		return fmt.Errorf("webhook failed with status code %d", statusCode)
+	}
+}
+
+// Send a webhook to the server once, returns the status code of the response
+func (p *QueueProcessor) IssueSCWebhook(data requests.CogWebhookMessage) (int, error) {
 	jsonData, err := json.Marshal(data)
 	if err != nil {
-		fmt.Println("Error marshaling data:", err)
-		return 0
+		return 0, err
 	}
 
-	// Create a new request
 	req, err := http.NewRequest("POST", data.Input.WebhookPrivateUrl, bytes.NewBuffer(jsonData))
 	if err != nil {
-		fmt.Println("Error creating request:", err)
-		return 0
+		return 0, err
 	}
 
 	// Set the content type and signature header
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("signature", utils.GetEnv().ScWorkerWebhookSecret)
 
-	// Perform the request
 	resp, err := p.Client.Do(req)
 	if err != nil {
-		fmt.Println("Error sending request:", err)
-		return 0
+		return 0, err
 	}
 	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+// Deliver an async job callback queued by the server, signed at the time it's sent
+func (p *QueueProcessor) HandleCallbackTask(ctx context.Context, t *asynq.Task) error {
+	var callback requests.AsyncJobCallback
+	if err := json.Unmarshal(t.Payload(), &callback); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
 
-	// Check response status code
-	if resp.StatusCode != 200 && resp.StatusCode != 400 && resp.StatusCode != 401 && retries < MaxWebhookRetries {
-		fmt.Printf("Webhook failed with status code %d\n", resp.StatusCode)
-		sleepTime := 0.15 * float64(retries)
-		fmt.Printf("Sleeping %f seconds before retrying webhook\n", sleepTime)
-		time.Sleep(time.Duration(sleepTime) * time.Second)
-		return p.IssueSCWebhook(data, retries+1)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callback.URL, bytes.NewReader(callback.Body))
+	if err != nil {
+		return fmt.Errorf("invalid callback request: %v: %w", err, asynq.SkipRetry)
 	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(requests.CALLBACK_SIGNATURE_HEADER, requests.SignCallback(time.Now().Unix(), callback.Body))
 
-	return resp.StatusCode
+	resp, err := p.CallbackClient.Do(req)
+	if err != nil {
+		log.Warn("Error sending callback", "id", callback.ID, "err", err)
+		return err
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests:
+		// Their server won't take it however many times it's sent
+		log.Warn("Callback rejected", "id", callback.ID, "status_code", resp.StatusCode)
+		return fmt.Errorf("callback rejected with status code %d: %w", resp.StatusCode, asynq.SkipRetry)
+	default:
+		return fmt.Errorf("callback failed with status code %d", resp.StatusCode)
+	}
+}
+
+// Run a job published to the redis MQ backend, a queue.MQConsumer handler
+// There's no sc-worker without RabbitMQ, so jobs go to runpod like those routed there
+func (p *QueueProcessor) HandleMQDelivery(d queue.Delivery) error {
+	var cogReq requests.CogQueueRequest
+	if err := json.Unmarshal(d.Body, &cogReq); err != nil {
+		// Poisoned, redelivering won't help
+		log.Error("Error unmarshalling queued job", "id", d.ID, "err", err)
+		return nil
+	}
+	if p.jobCancelled(context.Background(), cogReq.Input.ID.String()) {
+		log.Info("Skipping cancelled job", "id", cogReq.Input.ID)
+		return nil
+	}
+	if cogReq.Input.RunpodEndpoint == nil {
+		log.Error("Queued job has no runpod endpoint", "id", cogReq.Input.ID, "model", cogReq.Input.Model)
+		p.QueueSCWebhook(requests.CogWebhookMessage{
+			Status: requests.CogFailed,
+			Input:  cogReq.Input,
+			Error:  "no_runpod_endpoint",
+		})
+		return nil
+	}
+
+	payload, err := json.Marshal(requests.RunpodInput{
+		Input: cogReq.Input,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = p.Asynq.Enqueue(
+		asynq.NewTask(shared.AsynqTaskForProcessType(cogReq.Input.ProcessType), payload),
+		asynq.MaxRetry(shared.ASYNQ_JOB_MAX_RETRY),
+		asynq.TaskID(cogReq.Input.ID.String()),
+		asynq.Queue(shared.QueueByPriority(d.Priority)),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		// Redelivered after it was handed over
+		return nil
+	}
+	return err
 }
@@ -0,0 +1,274 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/shared/queue"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeEnqueuer struct {
+	tasks []*asynq.Task
+}
+
+func (e *fakeEnqueuer) Enqueue(task *asynq.Task, opts ...asynq.Option) (*asynq.TaskInfo, error) {
+	e.tasks = append(e.tasks, task)
+	return &asynq.TaskInfo{}, nil
+}
+
+func (e *fakeEnqueuer) webhooks(t *testing.T) []requests.CogWebhookMessage {
+	var msgs []requests.CogWebhookMessage
+	for _, task := range e.tasks {
+		assert.Equal(t, shared.ASYNQ_TASK_WEBHOOK, task.Type())
+		var msg requests.CogWebhookMessage
+		assert.Nil(t, json.Unmarshal(task.Payload(), &msg))
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+type fakeCancelledJobs map[string]bool
+
+func (c fakeCancelledJobs) IsJobCancelled(ctx context.Context, requestID string) (bool, error) {
+	return c[requestID], nil
+}
+
+func runpodTask(t *testing.T, taskType string, endpoint string) *asynq.Task {
+	payload, err := json.Marshal(requests.RunpodInput{
+		Input: requests.BaseCogRequest{
+			ID:             uuid.New(),
+			RunpodEndpoint: &endpoint,
+		},
+	})
+	assert.Nil(t, err)
+	return asynq.NewTask(taskType, payload)
+}
+
+func TestRetryDelay(t *testing.T) {
+	for n := 0; n < 100; n++ {
+		delay := RetryDelay(n, nil, nil)
+		max := shared.ASYNQ_RETRY_MAX_DELAY
+		if n < 8 {
+			max = shared.ASYNQ_RETRY_BASE_DELAY << n
+		}
+		assert.GreaterOrEqual(t, delay, max/2)
+		assert.LessOrEqual(t, delay, max)
+	}
+}
+
+func TestHandleWebhookTask(t *testing.T) {
+	statusCode := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	p := NewQueueProcessor(&fakeEnqueuer{})
+	payload, err := json.Marshal(requests.CogWebhookMessage{
+		Status: requests.CogSucceeded,
+		Input:  requests.BaseCogRequest{ID: uuid.New(), WebhookPrivateUrl: server.URL},
+	})
+	assert.Nil(t, err)
+	task := asynq.NewTask(shared.ASYNQ_TASK_WEBHOOK, payload)
+
+	assert.Nil(t, p.HandleWebhookTask(context.Background(), task))
+
+	// Server errors are retried
+	statusCode = http.StatusServiceUnavailable
+	err = p.HandleWebhookTask(context.Background(), task)
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, asynq.SkipRetry))
+
+	// Rejected ones are archived
+	statusCode = http.StatusBadRequest
+	assert.ErrorIs(t, p.HandleWebhookTask(context.Background(), task), asynq.SkipRetry)
+	assert.ErrorIs(t, p.HandleWebhookTask(context.Background(), asynq.NewTask(shared.ASYNQ_TASK_WEBHOOK, []byte("{"))), asynq.SkipRetry)
+}
+
+func TestHandleCallbackTask(t *testing.T) {
+	statusCode := http.StatusOK
+	var signature string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(requests.CALLBACK_SIGNATURE_HEADER)
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	p := NewQueueProcessor(&fakeEnqueuer{})
+	// The test server is on loopback, which the callback client refuses
+	p.CallbackClient = server.Client()
+	payload, err := json.Marshal(requests.AsyncJobCallback{
+		ID:   uuid.NewString(),
+		URL:  server.URL,
+		Body: json.RawMessage(`{"status":"succeeded"}`),
+	})
+	assert.Nil(t, err)
+	task := asynq.NewTask(shared.ASYNQ_TASK_CALLBACK, payload)
+
+	assert.Nil(t, p.HandleCallbackTask(context.Background(), task))
+	assert.Contains(t, signature, "v1=")
+
+	statusCode = http.StatusBadGateway
+	err = p.HandleCallbackTask(context.Background(), task)
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, asynq.SkipRetry))
+
+	statusCode = http.StatusTooManyRequests
+	assert.False(t, errors.Is(p.HandleCallbackTask(context.Background(), task), asynq.SkipRetry))
+
+	statusCode = http.StatusNotFound
+	assert.ErrorIs(t, p.HandleCallbackTask(context.Background(), task), asynq.SkipRetry)
+
+	// Never internal addresses
+	p = NewQueueProcessor(&fakeEnqueuer{})
+	statusCode = http.StatusOK
+	err = p.HandleCallbackTask(context.Background(), task)
+	assert.ErrorContains(t, err, "non-public address")
+}
+
+func TestHandleMQDelivery(t *testing.T) {
+	enqueuer := &fakeEnqueuer{}
+	p := NewQueueProcessor(enqueuer)
+
+	endpoint := "endpoint"
+	body, err := json.Marshal(requests.CogQueueRequest{
+		Input: requests.BaseCogRequest{
+			ID:             uuid.New(),
+			ProcessType:    shared.UPSCALE,
+			RunpodEndpoint: &endpoint,
+		},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, p.HandleMQDelivery(queue.Delivery{ID: "a", Body: body, Priority: 5, Attempt: 1}))
+	assert.Len(t, enqueuer.tasks, 1)
+	assert.Equal(t, shared.ASYNQ_TASK_UPSCALE, enqueuer.tasks[0].Type())
+
+	// Fails jobs runpod can't run
+	body, err = json.Marshal(requests.CogQueueRequest{
+		Input: requests.BaseCogRequest{ID: uuid.New(), ProcessType: shared.GENERATE},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, p.HandleMQDelivery(queue.Delivery{ID: "b", Body: body, Priority: 5, Attempt: 1}))
+	assert.Len(t, enqueuer.tasks, 2)
+	assert.Equal(t, shared.ASYNQ_TASK_WEBHOOK, enqueuer.tasks[1].Type())
+	var msg requests.CogWebhookMessage
+	assert.Nil(t, json.Unmarshal(enqueuer.tasks[1].Payload(), &msg))
+	assert.Equal(t, requests.CogFailed, msg.Status)
+
+	// Skips jobs cancelled while they were queued, the server already failed them
+	id := uuid.New()
+	p.Cancelled = fakeCancelledJobs{id.String(): true}
+	body, err = json.Marshal(requests.CogQueueRequest{
+		Input: requests.BaseCogRequest{ID: id, ProcessType: shared.GENERATE, RunpodEndpoint: &endpoint},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, p.HandleMQDelivery(queue.Delivery{ID: "c", Body: body, Priority: 5, Attempt: 1}))
+	assert.Len(t, enqueuer.tasks, 2)
+}
+
+func TestRunRunpodJob(t *testing.T) {
+	var polls atomic.Int32
+	runStatus := http.StatusOK
+	runpod := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/run":
+			w.WriteHeader(runStatus)
+			json.NewEncoder(w).Encode(responses.RunpodOutput{ID: "runpod-id", Status: responses.RunpodStatusInQueue})
+		case r.URL.Path == "/status/runpod-id" && polls.Add(1) == 1:
+			json.NewEncoder(w).Encode(responses.RunpodOutput{ID: "runpod-id", Status: responses.RunpodStatusInQueue})
+		case r.URL.Path == "/status/runpod-id":
+			output := responses.RunpodOutput{ID: "runpod-id", Status: responses.RunpodStatusCompleted}
+			output.Output.Output.AudioFiles = []requests.CogWebhookOutputAudio{{AudioFile: "audio.mp3", AudioDuration: 2}}
+			json.NewEncoder(w).Encode(output)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer runpod.Close()
+
+	enqueuer := &fakeEnqueuer{}
+	p := NewQueueProcessor(enqueuer)
+	p.Client.Timeout = time.Second
+
+	// Voiceovers send their audio files
+	assert.Nil(t, p.HandleVoiceoverTask(context.Background(), runpodTask(t, shared.ASYNQ_TASK_VOICEOVER, runpod.URL)))
+	webhooks := enqueuer.webhooks(t)
+	assert.Len(t, webhooks, 1)
+	assert.Equal(t, requests.CogSucceeded, webhooks[0].Status)
+	assert.Equal(t, "audio.mp3", webhooks[0].Output.AudioFiles[0].AudioFile)
+
+	// Images are expected from generations
+	assert.ErrorIs(t, p.HandleGenerateTask(context.Background(), runpodTask(t, shared.ASYNQ_TASK_GENERATE, runpod.URL)), asynq.SkipRetry)
+	webhooks = enqueuer.webhooks(t)
+	assert.Len(t, webhooks, 2)
+	assert.Equal(t, requests.CogFailed, webhooks[1].Status)
+	assert.Equal(t, "no_outputs", webhooks[1].Error)
+
+	// Runpod being unavailable is retried without telling the server
+	runStatus = http.StatusBadGateway
+	err := p.HandleGenerateTask(context.Background(), runpodTask(t, shared.ASYNQ_TASK_GENERATE, runpod.URL))
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, asynq.SkipRetry))
+	assert.Len(t, enqueuer.tasks, 2)
+
+	// Runpod rejecting the job fails it
+	runStatus = http.StatusUnauthorized
+	assert.ErrorIs(t, p.HandleUpscaleTask(context.Background(), runpodTask(t, shared.ASYNQ_TASK_UPSCALE, runpod.URL)), asynq.SkipRetry)
+	webhooks = enqueuer.webhooks(t)
+	assert.Len(t, webhooks, 3)
+	assert.Equal(t, "runpod_unavailable", webhooks[2].Error)
+
+	// Cancelled jobs never reach runpod
+	runStatus = http.StatusOK
+	task := runpodTask(t, shared.ASYNQ_TASK_GENERATE, runpod.URL)
+	var payload requests.RunpodInput
+	assert.Nil(t, json.Unmarshal(task.Payload(), &payload))
+	p.Cancelled = fakeCancelledJobs{payload.Input.ID.String(): true}
+	pollsBefore := polls.Load()
+	assert.ErrorIs(t, p.HandleGenerateTask(context.Background(), task), asynq.SkipRetry)
+	assert.Len(t, enqueuer.tasks, 3)
+	assert.Equal(t, pollsBefore, polls.Load())
+}
+
+func TestRunRunpodJobShutdown(t *testing.T) {
+	var cancelled atomic.Int32
+	runpod := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/run":
+			json.NewEncoder(w).Encode(responses.RunpodOutput{ID: "runpod-id", Status: responses.RunpodStatusInQueue})
+		case "/status/runpod-id":
+			json.NewEncoder(w).Encode(responses.RunpodOutput{ID: "runpod-id", Status: responses.RunpodStatusInProgress})
+		case "/cancel/runpod-id":
+			cancelled.Add(1)
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer runpod.Close()
+
+	enqueuer := &fakeEnqueuer{}
+	p := NewQueueProcessor(enqueuer)
+	p.Client.Timeout = time.Second
+
+	// Shutting down stops the runpod job and leaves the task to be retried, the server isn't told
+	ctx, cancel := context.WithTimeout(context.Background(), POLL_INTERVAL/2)
+	defer cancel()
+	err := p.HandleGenerateTask(ctx, runpodTask(t, shared.ASYNQ_TASK_GENERATE, runpod.URL))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, errors.Is(err, asynq.SkipRetry))
+	assert.Equal(t, int32(1), cancelled.Load())
+	assert.Len(t, enqueuer.tasks, 0)
+}
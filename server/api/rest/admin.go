@@ -20,6 +20,7 @@ import (
 	"github.com/stablecog/sc-go/log"
 	"github.com/stablecog/sc-go/server/requests"
 	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/server/scworker"
 	"github.com/stablecog/sc-go/shared"
 	"github.com/stablecog/sc-go/utils"
 	"golang.org/x/exp/slices"
@@ -676,6 +677,13 @@ func (c *RestAPI) HandleSystemChangeBackend(w http.ResponseWriter, r *http.Reque
 			responses.ErrInternalServerError(w, r, "An unknown error has occurred")
 			return
 		}
+		// Don't wait for probes
+		_, err = c.SCWorker.Router().Breaker.Reset(shared.PRIMARY_BACKEND)
+		if err != nil {
+			log.Error("Error closing sc-worker circuit", "err", err)
+			responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+			return
+		}
 		render.Status(r, http.StatusOK)
 		render.JSON(w, r, responses.ChangeSystemBackendResponse{
 			Backend: shared.BackendScWorker,
@@ -706,19 +714,62 @@ func (c *RestAPI) HandleSystemStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	resp := responses.SystemStatusResponse{
+		Backend:  shared.BackendScWorker,
+		Backends: shared.BACKENDS,
+		Models:   []responses.ModelRoutes{},
+	}
+
+	router := c.SCWorker.Router()
+	for _, backend := range shared.BACKENDS {
+		circuit, err := router.Breaker.Status(backend)
+		if err != nil {
+			log.Error("Error getting circuit status", "backend", backend, "err", err)
+			responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+			return
+		}
+		resp.Circuits = append(resp.Circuits, *circuit)
+		// Models on runpod are failed over while sc-worker is out
+		if backend == shared.PRIMARY_BACKEND && circuit.State != shared.CircuitClosed {
+			isRunpodServerless = true
+		}
+	}
 	if isRunpodServerless {
-		render.Status(r, http.StatusOK)
-		render.JSON(w, r, responses.SystemStatusResponse{
-			Backend:  shared.BackendRunpodServerless,
-			Backends: shared.BACKENDS,
-		})
-		return
+		resp.Backend = shared.BackendRunpodServerless
+	}
+
+	// Live routing of each model
+	var models []scworker.RouteModel
+	var names []string
+	for _, model := range shared.GetCache().GenerationModels() {
+		models = append(models, scworker.GenerationRouteModel(model))
+		names = append(names, model.NameInWorker)
+	}
+	for _, model := range shared.GetCache().UpscaleModels() {
+		models = append(models, scworker.UpscaleRouteModel(model))
+		names = append(names, model.NameInWorker)
+	}
+	for i, model := range models {
+		routes, err := router.Routes(model)
+		if err != nil {
+			log.Error("Error getting backend routes", "model_id", model.ID, "err", err)
+			responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+			return
+		}
+		modelRoutes := responses.ModelRoutes{
+			ModelID:   model.ID,
+			ModelName: names[i],
+		}
+		for _, route := range routes {
+			modelRoutes.Routes = append(modelRoutes.Routes, responses.BackendRoute{
+				BackendHealth: route.BackendHealth,
+				Circuit:       route.Circuit,
+				Weight:        route.Weight,
+			})
+		}
+		resp.Models = append(resp.Models, modelRoutes)
 	}
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, responses.SystemStatusResponse{
-		Backend:  shared.BackendScWorker,
-		Backends: shared.BACKENDS,
-	})
-	return
+	render.JSON(w, r, resp)
 }
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database"
 	"github.com/stablecog/sc-go/database/repository"
 	"github.com/stablecog/sc-go/server/analytics"
@@ -92,7 +93,7 @@ func testMainWrapper(m *testing.M) int {
 	// Add user to hub
 	hub.Register <- &sse.Client{
 		Uid:  MockSSEId,
-		Send: make(chan []byte, 256),
+		Send: make(chan sse.Event, 256),
 	}
 
 	// Create mock client
@@ -115,11 +116,19 @@ func testMainWrapper(m *testing.M) int {
 			Repo:           repo,
 			Redis:          redis,
 			QueueThrottler: qThrottler,
+			Scheduler:      shared.NewFairScheduler(ctx, redis.Client),
+			QueueDepth:     scworker.NewQueueDepthCache(repo),
+			StartRate:      scworker.NewJobStartRateCache(repo),
 			Track:          analytics.NewAnalyticsService(),
 			SafetyChecker:  translator.NewTranslatorSafetyChecker(ctx, "", true, redis),
 			MQClient:       mockClient,
 		},
 	}
 
+	hub.CancelJob = func(processType shared.ProcessType, id uuid.UUID, userID uuid.UUID) error {
+		_, err := MockController.SCWorker.CancelJob(processType, id, userID)
+		return err
+	}
+
 	return m.Run()
 }
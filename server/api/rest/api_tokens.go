@@ -2,17 +2,23 @@ package rest
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"unicode/utf8"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
+	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/repository"
 	"github.com/stablecog/sc-go/log"
 	"github.com/stablecog/sc-go/server/requests"
 	"github.com/stablecog/sc-go/server/responses"
 	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
 )
 
 // GET - Get active API tokens for user
@@ -40,15 +46,20 @@ func (c *RestAPI) HandleGetAPITokens(w http.ResponseWriter, r *http.Request) {
 	tokenRes := make([]responses.ApiToken, len(tokens))
 	for i, token := range tokens {
 		tokenRes[i] = responses.ApiToken{
-			ID:           token.ID,
-			Name:         token.Name,
-			ShortString:  token.ShortString,
-			Uses:         token.Uses,
-			CreditsSpent: token.CreditsSpent,
-			IsActive:     token.IsActive,
-			LastUsedAt:   token.LastUsedAt,
-			CreatedAt:    token.CreatedAt,
-			AuthClientID: token.AuthClientID,
+			ID:                  token.ID,
+			Name:                token.Name,
+			ShortString:         token.ShortString,
+			Uses:                token.Uses,
+			CreditsSpent:        token.CreditsSpent,
+			IsActive:            token.IsActive,
+			LastUsedAt:          token.LastUsedAt,
+			CreatedAt:           token.CreatedAt,
+			AuthClientID:        token.AuthClientID,
+			Scopes:              token.Scopes,
+			ExpiresAt:           token.ExpiresAt,
+			MonthlyCreditCap:    token.MonthlyCreditCap,
+			MonthlyCreditsSpent: repository.ApiTokenMonthlyCreditsSpent(token),
+			RotatedFromID:       token.RotatedFromID,
 		}
 	}
 
@@ -79,6 +90,11 @@ func (c *RestAPI) HandleNewAPIToken(w http.ResponseWriter, r *http.Request) {
 		newReq.Name = newReq.Name[:shared.MAX_TOKEN_NAME_SIZE]
 	}
 
+	if err := newReq.Validate(); err != nil {
+		responses.ErrBadRequest(w, r, err.Error(), "")
+		return
+	}
+
 	// See if user already has more than max tokens
 	count, err := c.Repo.GetTokenCountByUserID(user.ID)
 	if err != nil {
@@ -93,7 +109,7 @@ func (c *RestAPI) HandleNewAPIToken(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Create new token
-	token, tokenStr, err := c.Repo.NewAPIToken(user.ID, newReq.Name)
+	token, tokenStr, err := c.Repo.NewAPIToken(user.ID, newReq)
 	if err != nil {
 		log.Error("Error creating new token", "err", err)
 		responses.ErrInternalServerError(w, r, "An unknown error has occured")
@@ -143,3 +159,128 @@ func (c *RestAPI) HandleDeactivateAPIToken(w http.ResponseWriter, r *http.Reques
 		"status": "ok",
 	})
 }
+
+// POST - Replace an API token, the old one keeps working for the requested grace period
+func (c *RestAPI) HandleRotateAPIToken(w http.ResponseWriter, r *http.Request) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+
+	tokenID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_token_id", "")
+		return
+	}
+
+	// Parse request body
+	reqBody, _ := io.ReadAll(r.Body)
+	var rotateReq requests.RotateApiTokenRequest
+	err = json.Unmarshal(reqBody, &rotateReq)
+	if err != nil {
+		responses.ErrUnableToParseJson(w, r)
+		return
+	}
+
+	if err := rotateReq.Validate(); err != nil {
+		responses.ErrBadRequest(w, r, err.Error(), "")
+		return
+	}
+
+	token, oldToken, tokenStr, err := c.Repo.RotateAPIToken(tokenID, user.ID, rotateReq.GracePeriod())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			responses.ErrNotFound(w, r, "token_not_found")
+			return
+		}
+		if errors.Is(err, repository.ApiTokenAlreadyRotatedErr) {
+			responses.ErrBadRequest(w, r, err.Error(), "")
+			return
+		}
+		if errors.Is(err, repository.ApiTokenLimitErr) {
+			responses.ErrBadRequest(w, r, err.Error(), fmt.Sprintf("You already have the maximum number of API tokens (%d)", shared.MAX_API_TOKENS_PER_USER))
+			return
+		}
+		log.Error("Error rotating token", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occured")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, responses.RotateApiTokenResponse{
+		ID:                   token.ID,
+		Token:                tokenStr,
+		RotatedFromID:        oldToken.ID,
+		RotatedFromExpiresAt: *oldToken.ExpiresAt,
+	})
+}
+
+// GET - Requests made with one of the user's API tokens, newest first
+func (c *RestAPI) HandleGetAPITokenUsage(w http.ResponseWriter, r *http.Request) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+
+	tokenID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_token_id", "")
+		return
+	}
+
+	perPage := DEFAULT_PER_PAGE
+	if perPageStr := r.URL.Query().Get("per_page"); perPageStr != "" {
+		perPage, err = strconv.Atoi(perPageStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "per_page must be an integer", "")
+			return
+		} else if perPage < 1 || perPage > MAX_PER_PAGE {
+			responses.ErrBadRequest(w, r, fmt.Sprintf("per_page must be between 1 and %d", MAX_PER_PAGE), "")
+			return
+		}
+	}
+
+	var cursor *utils.KeysetCursor
+	if cursorStr := r.URL.Query().Get("cursor"); cursorStr != "" {
+		cursor, err = utils.ParseKeysetCursor(cursorStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "cursor must be a valid cursor or iso time string", "")
+			return
+		}
+	}
+
+	token, err := c.Repo.GetToken(tokenID)
+	if err != nil && !ent.IsNotFound(err) {
+		log.Error("Error getting token", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occured")
+		return
+	}
+	if token == nil || token.UserID != user.ID {
+		responses.ErrNotFound(w, r, "token_not_found")
+		return
+	}
+
+	usage, next, err := c.Repo.GetApiTokenUsage(token.ID, perPage, cursor)
+	if err != nil {
+		log.Error("Error getting token usage", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occured")
+		return
+	}
+
+	res := responses.GetApiTokenUsageResponse{
+		Usage: make([]responses.ApiTokenUsage, len(usage)),
+		Next:  next,
+	}
+	for i, u := range usage {
+		res.Usage[i] = responses.ApiTokenUsage{
+			ID:           u.ID,
+			IP:           u.IP,
+			Endpoint:     u.Endpoint,
+			CreditsSpent: u.CreditsSpent,
+			CreatedAt:    u.CreatedAt,
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, res)
+}
@@ -0,0 +1,152 @@
+package rest
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// Transactions fetched at a time for CSV exports
+const CREDIT_HISTORY_EXPORT_PAGE_SIZE = 1000
+
+// HTTP Get - credit ledger for user, newest first
+// Takes query paramers for pagination
+// per_page: number of transactions to return
+// cursor: cursor for pagination, the next of the previous page
+// format: csv to export every transaction before cursor
+func (c *RestAPI) HandleQueryCreditHistory(w http.ResponseWriter, r *http.Request) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+
+	perPage := DEFAULT_PER_PAGE
+	var err error
+	if perPageStr := r.URL.Query().Get("per_page"); perPageStr != "" {
+		perPage, err = strconv.Atoi(perPageStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "per_page must be an integer", "")
+			return
+		} else if perPage < 1 || perPage > MAX_PER_PAGE {
+			responses.ErrBadRequest(w, r, fmt.Sprintf("per_page must be between 1 and %d", MAX_PER_PAGE), "")
+			return
+		}
+	}
+
+	var cursor *utils.KeysetCursor
+	if cursorStr := r.URL.Query().Get("cursor"); cursorStr != "" {
+		cursor, err = utils.ParseKeysetCursor(cursorStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "cursor must be a valid cursor or iso time string", "")
+			return
+		}
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		responses.ErrBadRequest(w, r, "format must be json or csv", "")
+		return
+	}
+
+	creditTypes, err := c.Repo.GetAllCreditTypes()
+	if err != nil {
+		log.Error("Error getting credit types", "err", err)
+		responses.ErrInternalServerError(w, r, "Error getting credit history")
+		return
+	}
+	creditTypeNames := make(map[uuid.UUID]string, len(creditTypes))
+	for _, ct := range creditTypes {
+		creditTypeNames[ct.ID] = ct.Name
+	}
+
+	if format == "csv" {
+		c.exportCreditHistory(w, r, user.ID, cursor, creditTypeNames)
+		return
+	}
+
+	transactions, next, err := c.Repo.GetCreditTransactionsForUser(user.ID, perPage, cursor)
+	if err != nil {
+		log.Error("Error getting credit transactions", "err", err)
+		responses.ErrInternalServerError(w, r, "Error getting credit history")
+		return
+	}
+
+	res := responses.CreditHistoryResponse{
+		Transactions: make([]responses.CreditTransaction, len(transactions)),
+		Next:         next,
+	}
+	for i, t := range transactions {
+		res.Transactions[i] = responses.CreditTransaction{
+			ID:             t.ID,
+			Type:           string(t.Type),
+			Amount:         t.Amount,
+			CreditTypeID:   t.CreditTypeID,
+			CreditTypeName: creditTypeNames[t.CreditTypeID],
+			ReferenceID:    t.ReferenceID,
+			CreatedAt:      t.CreatedAt,
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, res)
+}
+
+// Write every transaction of user before cursor as CSV, a page at a time
+func (c *RestAPI) exportCreditHistory(w http.ResponseWriter, r *http.Request, userID uuid.UUID, cursor *utils.KeysetCursor, creditTypeNames map[uuid.UUID]string) {
+	transactions, next, err := c.Repo.GetCreditTransactionsForUser(userID, CREDIT_HISTORY_EXPORT_PAGE_SIZE, cursor)
+	if err != nil {
+		log.Error("Error getting credit transactions", "err", err)
+		responses.ErrInternalServerError(w, r, "Error getting credit history")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", "attachment; filename=\"credit-history.csv\"")
+	w.WriteHeader(http.StatusOK)
+
+	csvWriter := csv.NewWriter(w)
+	csvWriter.Write([]string{"id", "created_at", "type", "amount", "credit_type_id", "credit_type_name", "reference_id", "stripe_line_item_id"})
+	for {
+		for _, t := range transactions {
+			var referenceID, lineItemID string
+			if t.ReferenceID != nil {
+				referenceID = t.ReferenceID.String()
+			}
+			if t.StripeLineItemID != nil {
+				lineItemID = *t.StripeLineItemID
+			}
+			csvWriter.Write([]string{
+				t.ID.String(),
+				t.CreatedAt.UTC().Format(time.RFC3339Nano),
+				string(t.Type),
+				strconv.Itoa(int(t.Amount)),
+				t.CreditTypeID.String(),
+				creditTypeNames[t.CreditTypeID],
+				referenceID,
+				lineItemID,
+			})
+		}
+		if next == nil {
+			break
+		}
+		transactions, next, err = c.Repo.GetCreditTransactionsForUser(userID, CREDIT_HISTORY_EXPORT_PAGE_SIZE, next)
+		if err != nil {
+			// Headers are already sent, the export is cut short
+			log.Error("Error getting credit transactions for export", "err", err, "user_id", userID)
+			break
+		}
+	}
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		log.Error("Error writing credit history csv", "err", err, "user_id", userID)
+	}
+}
@@ -0,0 +1,111 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/stablecog/sc-go/database"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// For v1/admin/cron/jobs, every job with its latest run
+func (c *RestAPI) HandleGetCronJobs(w http.ResponseWriter, r *http.Request) {
+	if user, email := c.GetUserIDAndEmailIfAuthenticated(w, r); user == nil || email == "" {
+		return
+	}
+
+	jobs, err := c.Repo.GetCronJobs()
+	if err != nil {
+		log.Error("Error getting cron jobs", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, jobs)
+}
+
+// For v1/admin/cron/runs
+func (c *RestAPI) HandleQueryCronJobRuns(w http.ResponseWriter, r *http.Request) {
+	if user, email := c.GetUserIDAndEmailIfAuthenticated(w, r); user == nil || email == "" {
+		return
+	}
+
+	perPage := DEFAULT_PER_PAGE
+	var err error
+	if perPageStr := r.URL.Query().Get("per_page"); perPageStr != "" {
+		perPage, err = strconv.Atoi(perPageStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "per_page must be an integer", "")
+			return
+		} else if perPage < 1 || perPage > MAX_PER_PAGE {
+			responses.ErrBadRequest(w, r, fmt.Sprintf("per_page must be between 1 and %d", MAX_PER_PAGE), "")
+			return
+		}
+	}
+
+	var cursor *utils.KeysetCursor
+	if cursorStr := r.URL.Query().Get("cursor"); cursorStr != "" {
+		cursor, err = utils.ParseKeysetCursor(cursorStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "cursor must be a valid cursor or iso time string", "")
+			return
+		}
+	}
+
+	var job *shared.CronJobName
+	if jobStr := r.URL.Query().Get("job"); jobStr != "" {
+		if !shared.IsValidCronJob(jobStr) {
+			responses.ErrBadRequest(w, r, "invalid_job", "")
+			return
+		}
+		job = utils.ToPtr(shared.CronJobName(jobStr))
+	}
+
+	runs, err := c.Repo.QueryCronJobRuns(perPage, cursor, job)
+	if err != nil {
+		log.Error("Error querying cron job runs", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, runs)
+}
+
+// For v1/admin/cron/jobs/{name}/run
+// The job runs on a cron instance, its run shows up in the history
+func (c *RestAPI) HandleTriggerCronJob(w http.ResponseWriter, r *http.Request) {
+	user, email := c.GetUserIDAndEmailIfAuthenticated(w, r)
+	if user == nil || email == "" {
+		return
+	}
+
+	name := chi.URLParam(r, "name")
+	if !shared.IsValidCronJob(name) {
+		responses.ErrNotFound(w, r, "cron_job_not_found")
+		return
+	}
+
+	trigger := database.CronJobTrigger{
+		Job:         shared.CronJobName(name),
+		TriggeredBy: *user,
+		RequestedAt: time.Now(),
+	}
+	if err := c.Redis.EnqueueCronJobTrigger(trigger); err != nil {
+		log.Error("Error triggering cron job", "job", name, "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return
+	}
+	log.Info("Triggered cron job", "job", name, "user_id", user, "email", email)
+
+	render.Status(r, http.StatusAccepted)
+	render.JSON(w, r, trigger)
+}
@@ -0,0 +1,57 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func triggerCronJobRequest(name string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", nil)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("name", name)
+	ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+	ctx = context.WithValue(ctx, "user_id", repository.MOCK_ADMIN_UUID)
+	ctx = context.WithValue(ctx, "user_email", "mockadmin@stablecog.com")
+
+	MockController.HandleTriggerCronJob(w, req.WithContext(ctx))
+	return w
+}
+
+func TestHandleTriggerCronJob(t *testing.T) {
+	w := triggerCronJobRequest(string(shared.CronJobFreeCredits))
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 202, resp.StatusCode)
+
+	// Picked up by cron
+	trigger, err := MockController.Redis.WaitCronJobTrigger(time.Second)
+	assert.Nil(t, err)
+	assert.NotNil(t, trigger)
+	assert.Equal(t, shared.CronJobFreeCredits, trigger.Job)
+	assert.Equal(t, uuid.MustParse(repository.MOCK_ADMIN_UUID), trigger.TriggeredBy)
+
+	w = triggerCronJobRequest("NOT_A_JOB")
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 404, resp.StatusCode)
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "cron_job_not_found", respJson["error"])
+
+	trigger, err = MockController.Redis.WaitCronJobTrigger(time.Millisecond)
+	assert.Nil(t, err)
+	assert.Nil(t, trigger)
+}
@@ -0,0 +1,149 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// For v1/admin/queue/dead-letter
+func (c *RestAPI) HandleQueryDeadLetters(w http.ResponseWriter, r *http.Request) {
+	if user, email := c.GetUserIDAndEmailIfAuthenticated(w, r); user == nil || email == "" {
+		return
+	}
+
+	perPage := DEFAULT_PER_PAGE
+	var err error
+	if perPageStr := r.URL.Query().Get("per_page"); perPageStr != "" {
+		perPage, err = strconv.Atoi(perPageStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "per_page must be an integer", "")
+			return
+		} else if perPage < 1 || perPage > MAX_PER_PAGE {
+			responses.ErrBadRequest(w, r, fmt.Sprintf("per_page must be between 1 and %d", MAX_PER_PAGE), "")
+			return
+		}
+	}
+
+	var cursor *time.Time
+	if cursorStr := r.URL.Query().Get("cursor"); cursorStr != "" {
+		cursorTime, err := utils.ParseIsoTime(cursorStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "cursor must be a valid iso time string", "")
+			return
+		}
+		cursor = &cursorTime
+	}
+
+	var replayed *bool
+	if replayedStr := r.URL.Query().Get("replayed"); replayedStr != "" {
+		replayedBool, err := strconv.ParseBool(replayedStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "replayed must be a boolean", "")
+			return
+		}
+		replayed = &replayedBool
+	}
+
+	deadLetters, err := c.Repo.QueryDeadLetters(perPage, cursor, replayed)
+	if err != nil {
+		log.Error("Error querying dead letters", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, deadLetters)
+}
+
+// For v1/admin/queue/dead-letter/{id}, includes the full payload
+func (c *RestAPI) HandleGetDeadLetter(w http.ResponseWriter, r *http.Request) {
+	if user, email := c.GetUserIDAndEmailIfAuthenticated(w, r); user == nil || email == "" {
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_id", "")
+		return
+	}
+
+	dl, err := c.Repo.GetDeadLetter(id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			responses.ErrNotFound(w, r, "dead_letter_not_found")
+			return
+		}
+		log.Error("Error getting dead letter", "id", id, "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, repository.DeadLetterResultFromEnt(dl, true))
+}
+
+// For v1/admin/queue/dead-letter/{id}/replay
+func (c *RestAPI) HandleReplayDeadLetter(w http.ResponseWriter, r *http.Request) {
+	if user, email := c.GetUserIDAndEmailIfAuthenticated(w, r); user == nil || email == "" {
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_id", "")
+		return
+	}
+
+	// Body is optional
+	var replayReq requests.ReplayDeadLetterRequest
+	reqBody, _ := io.ReadAll(r.Body)
+	if len(reqBody) > 0 {
+		if err := json.Unmarshal(reqBody, &replayReq); err != nil {
+			responses.ErrUnableToParseJson(w, r)
+			return
+		}
+	}
+	priority := shared.QUEUE_PRIORITY_5
+	if replayReq.Priority != nil {
+		if *replayReq.Priority < shared.QUEUE_PRIORITY_1 || *replayReq.Priority > shared.QUEUE_PRIORITY_10 {
+			responses.ErrBadRequest(w, r, "invalid_priority", fmt.Sprintf("priority must be between %d and %d", shared.QUEUE_PRIORITY_1, shared.QUEUE_PRIORITY_10))
+			return
+		}
+		priority = *replayReq.Priority
+	}
+
+	dl, err := c.SCWorker.ReplayDeadLetter(id, priority)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			responses.ErrNotFound(w, r, "dead_letter_not_found")
+		case errors.Is(err, repository.DeadLetterReplayedErr), errors.Is(err, repository.JobNotFailedErr), errors.Is(err, responses.InsufficientCreditsErr):
+			responses.ErrBadRequest(w, r, err.Error(), "")
+		case errors.Is(err, repository.ApiTokenCreditCapErr):
+			responses.ErrForbiddenWithReason(w, r, err.Error())
+		default:
+			log.Error("Error replaying dead letter", "id", id, "err", err)
+			responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		}
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, repository.DeadLetterResultFromEnt(dl, false))
+}
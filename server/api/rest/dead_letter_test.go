@@ -0,0 +1,197 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/upscale"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/shared/queue"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func replayDeadLetterRequest(id string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", nil)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", id)
+	ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+	ctx = context.WithValue(ctx, "user_id", repository.MOCK_ADMIN_UUID)
+	ctx = context.WithValue(ctx, "user_email", "mockadmin@stablecog.com")
+
+	MockController.HandleReplayDeadLetter(w, req.WithContext(ctx))
+	return w
+}
+
+func TestHandleReplayDeadLetter(t *testing.T) {
+	u, err := MockController.Repo.CreateMockUpscaleForDeletion(MockController.Repo.Ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, MockController.Repo.SetUpscaleFailed(u.ID.String(), "worker failed", nil))
+	dl, err := MockController.Repo.AddToDeadLetterQueue(requests.CogQueueRequest{
+		Input: requests.BaseCogRequest{
+			ID:          u.ID,
+			UserID:      utils.ToPtr(uuid.MustParse(repository.MOCK_ADMIN_UUID)),
+			ProcessType: shared.UPSCALE,
+			ModelId:     uuid.MustParse(repository.MOCK_UPSCALE_MODEL_ID),
+			APIRequest:  true,
+		},
+	}, "worker failed", nil)
+	assert.Nil(t, err)
+
+	creditsBefore, err := MockController.Repo.GetNonExpiredCreditTotalForUser(uuid.MustParse(repository.MOCK_ADMIN_UUID), nil)
+	assert.Nil(t, err)
+
+	// Replay
+	w := replayDeadLetterRequest(dl.ID.String())
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	var replayResp repository.DeadLetterResult
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &replayResp)
+	assert.Equal(t, dl.ID, replayResp.ID)
+	assert.NotNil(t, replayResp.ReplayedAt)
+
+	requeued, err := MockController.Repo.GetUpscale(u.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, upscale.StatusQueued, requeued.Status)
+
+	creditsAfter, err := MockController.Repo.GetNonExpiredCreditTotalForUser(uuid.MustParse(repository.MOCK_ADMIN_UUID), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, creditsBefore-1, creditsAfter)
+
+	// Second replay is rejected and doesn't charge again
+	w = replayDeadLetterRequest(dl.ID.String())
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+	var respJson map[string]interface{}
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "dead_letter_already_replayed", respJson["error"])
+
+	creditsAfterSecond, err := MockController.Repo.GetNonExpiredCreditTotalForUser(uuid.MustParse(repository.MOCK_ADMIN_UUID), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, creditsAfter, creditsAfterSecond)
+
+	// Cleanup
+	MockController.Repo.RefundCreditsToUser(uuid.MustParse(repository.MOCK_ADMIN_UUID), 1, nil)
+	MockController.Repo.DB.DeadLetter.DeleteOne(dl).ExecX(MockController.Repo.Ctx)
+	MockController.Repo.DB.Upscale.DeleteOne(u).ExecX(MockController.Repo.Ctx)
+}
+
+func TestHandleReplayDeadLetterTokenCreditCap(t *testing.T) {
+	userID := uuid.MustParse(repository.MOCK_ADMIN_UUID)
+	token, _, err := MockController.Repo.NewAPIToken(userID, requests.NewTokenRequest{Name: "replay-cap", MonthlyCreditCap: utils.ToPtr(1)})
+	assert.Nil(t, err)
+	MockController.Repo.DB.ApiToken.UpdateOneID(token.ID).SetMonthlyCreditsSpent(1).SetMonthlyCreditsPeriod(repository.ApiTokenMonthlyPeriod(time.Now())).ExecX(MockController.Repo.Ctx)
+
+	u, err := MockController.Repo.CreateMockUpscaleForDeletion(MockController.Repo.Ctx)
+	assert.Nil(t, err)
+	MockController.Repo.DB.Upscale.UpdateOneID(u.ID).SetAPITokenID(token.ID).ExecX(MockController.Repo.Ctx)
+	assert.Nil(t, MockController.Repo.SetUpscaleFailed(u.ID.String(), "worker failed", nil))
+	dl, err := MockController.Repo.AddToDeadLetterQueue(requests.CogQueueRequest{
+		Input: requests.BaseCogRequest{
+			ID:          u.ID,
+			UserID:      &userID,
+			ProcessType: shared.UPSCALE,
+			ModelId:     uuid.MustParse(repository.MOCK_UPSCALE_MODEL_ID),
+		},
+	}, "worker failed", nil)
+	assert.Nil(t, err)
+	creditsBefore, err := MockController.Repo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+
+	// The token's cap applies to replays too, nothing is changed
+	w := replayDeadLetterRequest(dl.ID.String())
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 403, resp.StatusCode)
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, repository.ApiTokenCreditCapErr.Error(), respJson["error"])
+
+	dl, err = MockController.Repo.GetDeadLetter(dl.ID)
+	assert.Nil(t, err)
+	assert.Nil(t, dl.ReplayedAt)
+	failed, err := MockController.Repo.GetUpscale(u.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, upscale.StatusFailed, failed.Status)
+	creditsAfter, err := MockController.Repo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, creditsBefore, creditsAfter)
+
+	MockController.Repo.DB.DeadLetter.DeleteOne(dl).ExecX(MockController.Repo.Ctx)
+	MockController.Repo.DB.Upscale.DeleteOne(u).ExecX(MockController.Repo.Ctx)
+	MockController.Repo.DB.ApiToken.DeleteOneID(token.ID).ExecX(MockController.Repo.Ctx)
+}
+
+func TestHandleReplayDeadLetterEnqueueFailed(t *testing.T) {
+	worker := *MockController.SCWorker
+	worker.MQClient = &queue.MockRabbitMQClient{
+		PublishFunc: func(id string, msg any, priority uint8) error {
+			return errors.New("publish failed")
+		},
+	}
+	origWorker := MockController.SCWorker
+	MockController.SCWorker = &worker
+	defer func() { MockController.SCWorker = origWorker }()
+
+	userID := uuid.MustParse(repository.MOCK_ADMIN_UUID)
+	u, err := MockController.Repo.CreateMockUpscaleForDeletion(MockController.Repo.Ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, MockController.Repo.SetUpscaleFailed(u.ID.String(), "worker failed", nil))
+	dl, err := MockController.Repo.AddToDeadLetterQueue(requests.CogQueueRequest{
+		Input: requests.BaseCogRequest{
+			ID:          u.ID,
+			UserID:      &userID,
+			ProcessType: shared.UPSCALE,
+			ModelId:     uuid.MustParse(repository.MOCK_UPSCALE_MODEL_ID),
+		},
+	}, "worker failed", nil)
+	assert.Nil(t, err)
+	creditsBefore, err := MockController.Repo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+
+	// The replay is undone, so it can be replayed again
+	w := replayDeadLetterRequest(dl.ID.String())
+	assert.Equal(t, 500, w.Result().StatusCode)
+
+	dl, err = MockController.Repo.GetDeadLetter(dl.ID)
+	assert.Nil(t, err)
+	assert.Nil(t, dl.ReplayedAt)
+	failed, err := MockController.Repo.GetUpscale(u.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, upscale.StatusFailed, failed.Status)
+	assert.Equal(t, "worker failed", *failed.FailureReason)
+	hold, err := MockController.Repo.DB.CreditHold.Query().Where(credithold.JobIDEQ(u.ID)).Only(MockController.Repo.Ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, credithold.StatusReleased, hold.Status)
+	creditsAfter, err := MockController.Repo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, creditsBefore, creditsAfter)
+
+	MockController.Repo.DB.CreditHold.DeleteOne(hold).ExecX(MockController.Repo.Ctx)
+	MockController.Repo.DB.DeadLetter.DeleteOne(dl).ExecX(MockController.Repo.Ctx)
+	MockController.Repo.DB.Upscale.DeleteOne(u).ExecX(MockController.Repo.Ctx)
+}
+
+func TestHandleReplayDeadLetterNotFound(t *testing.T) {
+	w := replayDeadLetterRequest(uuid.NewString())
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 404, resp.StatusCode)
+}
@@ -177,7 +177,17 @@ func (c *RestAPI) HandleSemanticSearchGallery(w http.ResponseWriter, r *http.Req
 			}
 		}
 
-		res, err := c.Qdrant.QueryGenerations(embeddings, perPage, offset, scoreThreshold, filters.Oversampling, qdrantFilters, false, false)
+		var res *qdrant.QResponse
+		if uid != uuid.Nil {
+			// Similar to another output, there is no text to match
+			res, err = c.Qdrant.QueryGenerations(embeddings, perPage, offset, scoreThreshold, filters.Oversampling, qdrantFilters, false, false)
+		} else {
+			keywordWeight := utils.GetEnv().GallerySearchKeywordWeight
+			if filters.KeywordWeight != nil {
+				keywordWeight = *filters.KeywordWeight
+			}
+			res, err = c.Qdrant.HybridQueryGenerations(embeddings, search, perPage, offset, scoreThreshold, filters.Oversampling, qdrantFilters, keywordWeight, false)
+		}
 		if err != nil {
 			log.Error("Error querying qdrant", "err", err)
 			responses.ErrInternalServerError(w, r, "An unknown error occurred")
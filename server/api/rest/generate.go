@@ -121,3 +121,83 @@ func (c *RestAPI) HandleCreateGenerationToken(w http.ResponseWriter, r *http.Req
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, generation)
 }
+
+// POST generation batch endpoint
+// Reserves credits for every item, items are queued in the background
+func (c *RestAPI) HandleCreateGenerationBatch(w http.ResponseWriter, r *http.Request) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+	var apiToken *ent.ApiToken
+	if apiToken = c.GetApiToken(w, r); apiToken == nil {
+		return
+	}
+
+	// Parse request body
+	reqBody, _ := io.ReadAll(r.Body)
+	var batchReq requests.CreateGenerationBatchRequest
+	err := json.Unmarshal(reqBody, &batchReq)
+	if err != nil {
+		responses.ErrUnableToParseJson(w, r)
+		return
+	}
+
+	batch, workerErr := c.SCWorker.CreateGenerationBatch(r, user, &apiToken.ID, c.Clip, batchReq)
+	if workerErr != nil {
+		render.Status(r, workerErr.StatusCode)
+		render.JSON(w, r, responses.ApiFailedResponse{
+			Error: workerErr.Err.Error(),
+		})
+		return
+	}
+
+	// Update last seen at
+	err = c.Repo.UpdateLastSeenAt(user.ID)
+	if err != nil {
+		log.Warn("Error updating last seen at", "err", err, "user", user.ID.String())
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, batch)
+}
+
+// POST - Create variations of an output of the user as a batch
+// Progress is queried the same way as batches
+func (c *RestAPI) HandleCreateGenerationVariations(w http.ResponseWriter, r *http.Request) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+	var apiToken *ent.ApiToken
+	if apiToken = c.GetApiToken(w, r); apiToken == nil {
+		return
+	}
+
+	// Parse request body
+	reqBody, _ := io.ReadAll(r.Body)
+	var variationsReq requests.CreateGenerationVariationsRequest
+	err := json.Unmarshal(reqBody, &variationsReq)
+	if err != nil {
+		responses.ErrUnableToParseJson(w, r)
+		return
+	}
+
+	batch, workerErr := c.SCWorker.CreateGenerationVariations(r, user, &apiToken.ID, c.Clip, variationsReq)
+	if workerErr != nil {
+		render.Status(r, workerErr.StatusCode)
+		render.JSON(w, r, responses.ApiFailedResponse{
+			Error: workerErr.Err.Error(),
+		})
+		return
+	}
+
+	// Update last seen at
+	err = c.Repo.UpdateLastSeenAt(user.ID)
+	if err != nil {
+		log.Warn("Error updating last seen at", "err", err, "user", user.ID.String())
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, batch)
+}
@@ -6,14 +6,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/credittype"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
 	"github.com/stablecog/sc-go/database/repository"
 	"github.com/stablecog/sc-go/server/requests"
 	"github.com/stablecog/sc-go/server/responses"
 	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/shared/queue"
 	"github.com/stablecog/sc-go/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -355,3 +368,333 @@ func TestGenerateValidRequest(t *testing.T) {
 	_, err := uuid.Parse(generateResp.ID)
 	assert.Nil(t, err)
 }
+
+func createGenerationBatchRequest(t *testing.T, batchReq requests.CreateGenerationBatchRequest) *httptest.ResponseRecorder {
+	return createGenerationBatchRequestForUser(t, uuid.MustParse(repository.MOCK_ADMIN_UUID), "mockadmin@stablecog.com", batchReq)
+}
+
+func createGenerationBatchRequestForUser(t *testing.T, userID uuid.UUID, email string, batchReq requests.CreateGenerationBatchRequest) *httptest.ResponseRecorder {
+	token, _, err := MockController.Repo.NewAPIToken(userID, requests.NewTokenRequest{Name: "batch"})
+	assert.Nil(t, err)
+
+	body, _ := json.Marshal(batchReq)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	ctx := context.WithValue(req.Context(), "user_id", userID.String())
+	ctx = context.WithValue(ctx, "user_email", email)
+	ctx = context.WithValue(ctx, "api_token_id", token.ID.String())
+
+	MockController.HandleCreateGenerationBatch(w, req.WithContext(ctx))
+	return w
+}
+
+func TestCreateGenerationBatchNoItems(t *testing.T) {
+	w := createGenerationBatchRequest(t, requests.CreateGenerationBatchRequest{})
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+	var errResp map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &errResp)
+	assert.Equal(t, "no_items", errResp["error"])
+}
+
+func TestCreateGenerationBatch(t *testing.T) {
+	// Items need somewhere to upload outputs, URLs are only signed so this never gets called
+	worker := *MockController.SCWorker
+	worker.S3 = s3.New(session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("key", "secret", ""),
+		Endpoint:    aws.String("http://localhost:9000"),
+		Region:      aws.String("us-east-1"),
+		// The SDK would otherwise set a transport on http.DefaultClient, which breaks httpmock
+		HTTPClient: &http.Client{},
+	})))
+	origWorker := MockController.SCWorker
+	MockController.SCWorker = &worker
+	defer func() { MockController.SCWorker = origWorker }()
+
+	userID := uuid.MustParse(repository.MOCK_ADMIN_UUID)
+	creditsBefore, err := MockController.Repo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+
+	item := requests.CreateGenerationRequest{
+		Height:         utils.ToPtr[int32](512),
+		Width:          utils.ToPtr[int32](512),
+		SchedulerId:    utils.ToPtr(uuid.MustParse(repository.MOCK_SCHEDULER_ID)),
+		ModelId:        utils.ToPtr(uuid.MustParse(repository.MOCK_GENERATION_MODEL_ID)),
+		NumOutputs:     utils.ToPtr[int32](2),
+		GuidanceScale:  utils.ToPtr[float32](7),
+		InferenceSteps: utils.ToPtr[int32](30),
+		Prompt:         "A portrait of a cat by Van Gogh",
+	}
+	item2 := item
+	item2.Prompt = "A portrait of a dog by Monet"
+	item2.NumOutputs = utils.ToPtr[int32](1)
+	// Can't be queued, the init image doesn't exist
+	item3 := item2
+	item3.InitImageUrl = "http://127.0.0.1:1/init.png"
+
+	w := createGenerationBatchRequest(t, requests.CreateGenerationBatchRequest{
+		Items: []requests.CreateGenerationRequest{item, item2, item3},
+	})
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	var batchResp responses.ApiGenerationBatchResponse
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &batchResp)
+	assert.Equal(t, 3, batchResp.NumItems)
+	assert.Equal(t, int32(4), batchResp.CreditsReserved)
+
+	assert.Eventually(t, func() bool {
+		batch, err := MockController.Repo.GetGenerationBatch(batchResp.ID)
+		return err == nil && batch.Status == generationbatch.StatusDispatched
+	}, 5*time.Second, 50*time.Millisecond)
+
+	batch, err := MockController.SCWorker.GetGenerationBatch(batchResp.ID, userID)
+	assert.Nil(t, err)
+	assert.Equal(t, "processing", batch.Status)
+	assert.Equal(t, 0, batch.Progress.Pending)
+	assert.Equal(t, 2, batch.Progress.Queued)
+	assert.Equal(t, 1, batch.Progress.Rejected)
+	assert.Equal(t, int32(1), batch.CreditsRefunded)
+	assert.Len(t, batch.Items, 2)
+
+	// Credits were reserved once for the whole batch, minus the rejected item
+	creditsAfter, err := MockController.Repo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, creditsBefore-3, creditsAfter)
+
+	// Failed items are refunded individually, as the webhook does
+	assert.Nil(t, MockController.Repo.SetGenerationFailed(batch.Items[0].ID.String(), "worker failed", 0, nil))
+	_, err = MockController.Repo.RefundCreditsToUser(userID, 2, nil)
+	assert.Nil(t, err)
+
+	batch, err = MockController.SCWorker.GetGenerationBatch(batchResp.ID, userID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, batch.Progress.Queued)
+	assert.Equal(t, 1, batch.Progress.Failed)
+	assert.Equal(t, int32(3), batch.CreditsRefunded)
+
+	creditsAfter, err = MockController.Repo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, creditsBefore-1, creditsAfter)
+}
+
+func TestCreateGenerationBatchCreditsOnly(t *testing.T) {
+	worker := *MockController.SCWorker
+	worker.S3 = s3.New(session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("key", "secret", ""),
+		Endpoint:    aws.String("http://localhost:9000"),
+		Region:      aws.String("us-east-1"),
+		HTTPClient:  &http.Client{},
+	})))
+	origWorker := MockController.SCWorker
+	MockController.SCWorker = &worker
+	defer func() { MockController.SCWorker = origWorker }()
+
+	// Free plan, with just enough purchased credits for the batch
+	ctx := context.Background()
+	user, err := MockController.Repo.DB.User.Create().SetEmail("mockcreditsuser@stablecog.com").SetStripeCustomerID("5").SetUsername("5").Save(ctx)
+	assert.Nil(t, err)
+	creditType, err := MockController.Repo.DB.CreditType.Query().Where(credittype.NameEQ("mock")).Only(ctx)
+	assert.Nil(t, err)
+	_, err = MockController.Repo.DB.Credit.Create().SetCreditTypeID(creditType.ID).SetUserID(user.ID).SetRemainingAmount(2).SetExpiresAt(time.Now().AddDate(0, 0, 30)).Save(ctx)
+	assert.Nil(t, err)
+
+	item := requests.CreateGenerationRequest{
+		Height:         utils.ToPtr[int32](512),
+		Width:          utils.ToPtr[int32](512),
+		SchedulerId:    utils.ToPtr(uuid.MustParse(repository.MOCK_SCHEDULER_ID)),
+		ModelId:        utils.ToPtr(uuid.MustParse(repository.MOCK_GENERATION_MODEL_ID)),
+		NumOutputs:     utils.ToPtr[int32](1),
+		GuidanceScale:  utils.ToPtr[float32](7),
+		InferenceSteps: utils.ToPtr[int32](30),
+		Prompt:         "A portrait of a cat by Van Gogh",
+	}
+	w := createGenerationBatchRequestForUser(t, user.ID, user.Email, requests.CreateGenerationBatchRequest{
+		Items: []requests.CreateGenerationRequest{item, item},
+	})
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	var batchResp responses.ApiGenerationBatchResponse
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &batchResp)
+
+	// The batch holds all of their credits, items are still queued as a credits user
+	batch, err := MockController.Repo.GetGenerationBatch(batchResp.ID)
+	assert.Nil(t, err)
+	assert.False(t, batch.Free)
+	assert.Equal(t, string(shared.QueueTierCredits), batch.QueueTier)
+
+	assert.Eventually(t, func() bool {
+		batch, err := MockController.Repo.GetGenerationBatch(batchResp.ID)
+		return err == nil && batch.Status == generationbatch.StatusDispatched
+	}, 5*time.Second, 50*time.Millisecond)
+
+	generations, err := MockController.Repo.DB.Generation.Query().Where(generation.BatchIDEQ(batchResp.ID)).All(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, generations, 2)
+	for _, g := range generations {
+		// Not submitted to the gallery
+		assert.False(t, g.WasAutoSubmitted)
+	}
+}
+
+func TestGenerationBatchFailsWhenItCantDispatch(t *testing.T) {
+	userID := uuid.MustParse(repository.MOCK_ADMIN_UUID)
+	creditsBefore, err := MockController.Repo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+
+	batch, err := MockController.Repo.CreateGenerationBatch(userID, nil, requests.CreateGenerationBatchRequest{
+		Items: []requests.CreateGenerationRequest{{Prompt: "cat", NumOutputs: utils.ToPtr[int32](2)}},
+	}, false, shared.QueueTierCredits, nil, nil)
+	assert.Nil(t, err)
+	hold, err := MockController.Repo.HoldCredits(userID, 2, credithold.ProcessTypeGenerationBatch, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, MockController.Repo.AttachCreditHold(hold.ID, batch.ID, nil))
+	// Items it can't read
+	MockController.Repo.DB.GenerationBatch.UpdateOneID(batch.ID).SetItems("nope").ExecX(MockController.Repo.Ctx)
+
+	MockController.SCWorker.ResumeGenerationBatches(nil)
+	assert.Eventually(t, func() bool {
+		batch, err := MockController.Repo.GetGenerationBatch(batch.ID)
+		return err == nil && batch.Status == generationbatch.StatusFailed
+	}, 5*time.Second, 50*time.Millisecond)
+
+	res, err := MockController.SCWorker.GetGenerationBatch(batch.ID, userID)
+	assert.Nil(t, err)
+	assert.Equal(t, "failed", res.Status)
+	assert.Equal(t, 1, res.Progress.Rejected)
+	assert.Equal(t, int32(2), res.CreditsRefunded)
+
+	// Its hold isn't left held
+	hold = MockController.Repo.DB.CreditHold.GetX(MockController.Repo.Ctx, hold.ID)
+	assert.Equal(t, credithold.StatusReleased, hold.Status)
+	creditsAfter, err := MockController.Repo.GetNonExpiredCreditTotalForUser(userID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, creditsBefore, creditsAfter)
+}
+
+func readWSMessage(t *testing.T, conn *websocket.Conn) string {
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, data, err := conn.ReadMessage()
+	assert.Nil(t, err)
+	return string(data)
+}
+
+func TestGenerateTokenStatusUpdatesReachWebsocket(t *testing.T) {
+	// Outputs are only signed for upload, the cog request is taken off the queue
+	published := make(chan requests.CogQueueRequest, 1)
+	worker := *MockController.SCWorker
+	worker.S3 = s3.New(session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("key", "secret", ""),
+		Endpoint:    aws.String("http://localhost:9000"),
+		Region:      aws.String("us-east-1"),
+		HTTPClient:  &http.Client{},
+	})))
+	worker.MQClient = &queue.MockRabbitMQClient{
+		PublishFunc: func(id string, msg any, priority uint8) error {
+			if cogReq, ok := msg.(requests.CogQueueRequest); ok {
+				select {
+				case published <- cogReq:
+				default:
+				}
+			}
+			return nil
+		},
+	}
+	origWorker := MockController.SCWorker
+	MockController.SCWorker = &worker
+	defer func() { MockController.SCWorker = origWorker }()
+
+	// Status updates go from redis to the hub, as the server does
+	pubsub := MockController.Redis.Client.Subscribe(context.Background(), shared.REDIS_SSE_BROADCAST_CHANNEL)
+	defer pubsub.Close()
+	_, err := pubsub.Receive(context.Background())
+	assert.Nil(t, err)
+	go func() {
+		for msg := range pubsub.Channel() {
+			var update repository.TaskStatusUpdateResponse
+			if json.Unmarshal([]byte(msg.Payload), &update) == nil && !update.ForLivePage {
+				MockController.Hub.BroadcastStatusUpdate(update)
+			}
+		}
+	}()
+
+	userID := uuid.MustParse(repository.MOCK_NORMAL_UUID)
+	token, _, err := MockController.Repo.NewAPIToken(userID, requests.NewTokenRequest{Name: "ws"})
+	assert.Nil(t, err)
+
+	// The token's websocket connection subscribes to the stream it passes with its jobs
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), "user_id", userID.String())
+		ctx = context.WithValue(ctx, "api_token_id", token.ID.String())
+		ctx = context.WithValue(ctx, "api_token_scopes", []string{string(shared.ApiTokenScopeImageGenerate)})
+		MockController.Hub.ServeWS(w, r.WithContext(ctx))
+	}))
+	defer server.Close()
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	assert.Contains(t, readWSMessage(t, conn), `"version"`)
+	streamID := utils.Sha256(uuid.NewString())
+	assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"type":"subscribe","stream_ids":["%s"]}`, streamID))))
+	assert.Contains(t, readWSMessage(t, conn), `"message_type":"ack"`)
+
+	// Invalid stream IDs are refused, even though API tokens don't need one
+	reqBody := requests.CreateGenerationRequest{
+		StreamID:       "nope",
+		Height:         utils.ToPtr[int32](512),
+		Width:          utils.ToPtr[int32](512),
+		SchedulerId:    utils.ToPtr(uuid.MustParse(repository.MOCK_SCHEDULER_ID)),
+		ModelId:        utils.ToPtr(uuid.MustParse(repository.MOCK_GENERATION_MODEL_ID)),
+		NumOutputs:     utils.ToPtr[int32](1),
+		GuidanceScale:  utils.ToPtr[float32](7),
+		InferenceSteps: utils.ToPtr[int32](30),
+		Prompt:         "A portrait of a cat by Van Gogh",
+		AsyncJobOptions: requests.AsyncJobOptions{
+			Async: true,
+		},
+	}
+	createGeneration := func() *http.Response {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		ctx := context.WithValue(req.Context(), "user_id", userID.String())
+		ctx = context.WithValue(ctx, "user_email", "mockuser@stablecog.com")
+		ctx = context.WithValue(ctx, "api_token_id", token.ID.String())
+		MockController.HandleCreateGenerationToken(w, req.WithContext(ctx))
+		return w.Result()
+	}
+	resp := createGeneration()
+	resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	reqBody.StreamID = strings.ToUpper(streamID)
+	resp = createGeneration()
+	resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var cogReq requests.CogQueueRequest
+	select {
+	case cogReq = <-published:
+	case <-time.After(5 * time.Second):
+		t.Fatal("generation was not queued")
+	}
+	assert.Equal(t, streamID, cogReq.Input.StreamID)
+
+	// The worker picking it up reaches the token's connection
+	assert.Nil(t, MockController.Repo.ProcessCogMessage(requests.CogWebhookMessage{
+		Input:  cogReq.Input,
+		Status: requests.CogProcessing,
+	}))
+	update := readWSMessage(t, conn)
+	assert.Contains(t, update, fmt.Sprintf(`"id":"%s"`, cogReq.Input.ID))
+	assert.Contains(t, update, fmt.Sprintf(`"stream_id":"%s"`, streamID))
+	assert.Contains(t, update, `"status":"processing"`)
+}
@@ -0,0 +1,195 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
+)
+
+// GET - Saved generation presets of the user
+func (c *RestAPI) HandleGetGenerationPresets(w http.ResponseWriter, r *http.Request) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+
+	presets, err := c.Repo.GetGenerationPresetsForUser(user.ID)
+	if err != nil {
+		log.Error("Error getting generation presets", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occured")
+		return
+	}
+
+	res := responses.GetGenerationPresetsResponse{
+		Presets: make([]responses.GenerationPreset, len(presets)),
+	}
+	for i, preset := range presets {
+		res.Presets[i] = responses.NewGenerationPreset(preset)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, res)
+}
+
+// GET - A single generation preset of the user
+func (c *RestAPI) HandleGetGenerationPreset(w http.ResponseWriter, r *http.Request) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_id", "")
+		return
+	}
+
+	preset, err := c.Repo.GetGenerationPresetForUser(id, user.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			responses.ErrNotFound(w, r, "preset_not_found")
+			return
+		}
+		log.Error("Error getting generation preset", "err", err, "id", id)
+		responses.ErrInternalServerError(w, r, "An unknown error has occured")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, responses.NewGenerationPreset(preset))
+}
+
+// POST - Save a new generation preset for the user
+func (c *RestAPI) HandleCreateGenerationPreset(w http.ResponseWriter, r *http.Request) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+
+	// Parse request body
+	reqBody, _ := io.ReadAll(r.Body)
+	var presetReq requests.GenerationPresetRequest
+	err := json.Unmarshal(reqBody, &presetReq)
+	if err != nil {
+		responses.ErrUnableToParseJson(w, r)
+		return
+	}
+
+	if err := presetReq.Validate(); err != nil {
+		responses.ErrBadRequest(w, r, err.Error(), "")
+		return
+	}
+
+	count, err := c.Repo.GetGenerationPresetCountForUser(user.ID)
+	if err != nil {
+		log.Error("Error getting generation preset count", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occured")
+		return
+	}
+
+	if count >= shared.MAX_GENERATION_PRESETS_PER_USER {
+		responses.ErrBadRequest(w, r, "too_many_presets", fmt.Sprintf("You already have the maximum number of presets (%d)", shared.MAX_GENERATION_PRESETS_PER_USER))
+		return
+	}
+
+	preset, err := c.Repo.CreateGenerationPreset(user.ID, presetReq)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			responses.ErrBadRequest(w, r, "preset_name_taken", "")
+			return
+		}
+		log.Error("Error creating generation preset", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occured")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, responses.NewGenerationPreset(preset))
+}
+
+// PUT - Replace the settings of a generation preset of the user
+func (c *RestAPI) HandleUpdateGenerationPreset(w http.ResponseWriter, r *http.Request) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_id", "")
+		return
+	}
+
+	// Parse request body
+	reqBody, _ := io.ReadAll(r.Body)
+	var presetReq requests.GenerationPresetRequest
+	err = json.Unmarshal(reqBody, &presetReq)
+	if err != nil {
+		responses.ErrUnableToParseJson(w, r)
+		return
+	}
+
+	if err := presetReq.Validate(); err != nil {
+		responses.ErrBadRequest(w, r, err.Error(), "")
+		return
+	}
+
+	preset, err := c.Repo.UpdateGenerationPresetForUser(id, user.ID, presetReq)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			responses.ErrNotFound(w, r, "preset_not_found")
+			return
+		}
+		if ent.IsConstraintError(err) {
+			responses.ErrBadRequest(w, r, "preset_name_taken", "")
+			return
+		}
+		log.Error("Error updating generation preset", "err", err, "id", id)
+		responses.ErrInternalServerError(w, r, "An unknown error has occured")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, responses.NewGenerationPreset(preset))
+}
+
+// DELETE - Delete a generation preset of the user
+func (c *RestAPI) HandleDeleteGenerationPreset(w http.ResponseWriter, r *http.Request) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_id", "")
+		return
+	}
+
+	deleted, err := c.Repo.DeleteGenerationPresetForUser(id, user.ID)
+	if err != nil {
+		log.Error("Error deleting generation preset", "err", err, "id", id)
+		responses.ErrInternalServerError(w, r, "An unknown error has occured")
+		return
+	}
+
+	if deleted == 0 {
+		responses.ErrNotFound(w, r, "preset_not_found")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, responses.DeletedResponse{
+		Deleted: deleted,
+	})
+}
@@ -0,0 +1,115 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/generationpreset"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func presetRequest(method string, id string, body interface{}, handler http.HandlerFunc) *http.Response {
+	var reqBody io.Reader
+	if body != nil {
+		b, _ := json.Marshal(body)
+		reqBody = bytes.NewReader(b)
+	}
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, "/", reqBody)
+	req.Header.Set("Content-Type", "application/json")
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", id)
+	ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+	ctx = context.WithValue(ctx, "user_id", repository.MOCK_ALT_UUID)
+	ctx = context.WithValue(ctx, "user_email", "mockaltuser@stablecog.com")
+
+	handler(w, req.WithContext(ctx))
+	return w.Result()
+}
+
+func TestHandleGenerationPresets(t *testing.T) {
+	t.Cleanup(func() {
+		MockController.Repo.DB.GenerationPreset.Delete().Where(generationpreset.UserIDEQ(uuid.MustParse(repository.MOCK_ALT_UUID))).ExecX(MockController.Repo.Ctx)
+	})
+
+	// Create
+	resp := presetRequest("POST", "", requests.GenerationPresetRequest{
+		Name:           "Noir",
+		PromptTemplate: utils.ToPtr("A {{subject}} at {{place}}"),
+		GuidanceScale:  utils.ToPtr(float32(9)),
+	}, MockController.HandleCreateGenerationPreset)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	var preset responses.GenerationPreset
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &preset)
+	assert.Equal(t, "Noir", preset.Name)
+	assert.Equal(t, []string{"subject", "place"}, preset.Variables)
+	assert.Equal(t, float32(9), *preset.GuidanceScale)
+
+	// Duplicate name
+	resp = presetRequest("POST", "", requests.GenerationPresetRequest{Name: "Noir"}, MockController.HandleCreateGenerationPreset)
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+	var errResp map[string]interface{}
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &errResp)
+	assert.Equal(t, "preset_name_taken", errResp["error"])
+
+	// List
+	resp = presetRequest("GET", "", nil, MockController.HandleGetGenerationPresets)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	var presets responses.GetGenerationPresetsResponse
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &presets)
+	assert.Len(t, presets.Presets, 1)
+	assert.Equal(t, preset.ID, presets.Presets[0].ID)
+
+	// Update
+	resp = presetRequest("PUT", preset.ID.String(), requests.GenerationPresetRequest{Name: "Noir v2"}, MockController.HandleUpdateGenerationPreset)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	resp = presetRequest("GET", preset.ID.String(), nil, MockController.HandleGetGenerationPreset)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	preset = responses.GenerationPreset{}
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &preset)
+	assert.Equal(t, "Noir v2", preset.Name)
+	assert.Nil(t, preset.PromptTemplate)
+	assert.Nil(t, preset.GuidanceScale)
+
+	// Delete
+	resp = presetRequest("DELETE", preset.ID.String(), nil, MockController.HandleDeleteGenerationPreset)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	resp = presetRequest("GET", preset.ID.String(), nil, MockController.HandleGetGenerationPreset)
+	defer resp.Body.Close()
+	assert.Equal(t, 404, resp.StatusCode)
+	resp = presetRequest("DELETE", preset.ID.String(), nil, MockController.HandleDeleteGenerationPreset)
+	defer resp.Body.Close()
+	assert.Equal(t, 404, resp.StatusCode)
+}
+
+func TestHandleCreateGenerationPresetInvalid(t *testing.T) {
+	resp := presetRequest("POST", "", requests.GenerationPresetRequest{Name: ""}, MockController.HandleCreateGenerationPreset)
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	resp = presetRequest("PUT", "not-uuid", requests.GenerationPresetRequest{Name: "Noir"}, MockController.HandleUpdateGenerationPreset)
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+}
@@ -0,0 +1,162 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
+)
+
+// For v1/image/generation/{id}
+func (c *RestAPI) HandleGetGenerationJob(w http.ResponseWriter, r *http.Request) {
+	c.handleGetJob(w, r, shared.GENERATE)
+}
+
+// For v1/image/upscale/{id}
+func (c *RestAPI) HandleGetUpscaleJob(w http.ResponseWriter, r *http.Request) {
+	c.handleGetJob(w, r, shared.UPSCALE)
+}
+
+// For v1/audio/voiceover/{id}
+func (c *RestAPI) HandleGetVoiceoverJob(w http.ResponseWriter, r *http.Request) {
+	c.handleGetJob(w, r, shared.VOICEOVER)
+}
+
+// For v1/image/generation/batch/{id}
+// Progress of a batch owned by the authenticated user
+func (c *RestAPI) HandleGetGenerationBatch(w http.ResponseWriter, r *http.Request) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_id", "")
+		return
+	}
+
+	batch, err := c.SCWorker.GetGenerationBatch(id, user.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			responses.ErrNotFound(w, r, "batch_not_found")
+			return
+		}
+		log.Error("Error getting generation batch", "err", err, "id", id)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, batch)
+}
+
+// Status and outputs of a job owned by the authenticated user
+func (c *RestAPI) handleGetJob(w http.ResponseWriter, r *http.Request, processType shared.ProcessType) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_id", "")
+		return
+	}
+
+	job, err := c.SCWorker.GetJob(processType, id, user.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			responses.ErrNotFound(w, r, "job_not_found")
+			return
+		}
+		log.Error("Error getting job", "err", err, "id", id, "process_type", processType)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, job)
+}
+
+// For v1/image/generation/{id}/cancel
+func (c *RestAPI) HandleCancelGenerationJob(w http.ResponseWriter, r *http.Request) {
+	c.handleCancelJob(w, r, shared.GENERATE)
+}
+
+// For v1/image/upscale/{id}/cancel
+func (c *RestAPI) HandleCancelUpscaleJob(w http.ResponseWriter, r *http.Request) {
+	c.handleCancelJob(w, r, shared.UPSCALE)
+}
+
+// For v1/audio/voiceover/{id}/cancel
+func (c *RestAPI) HandleCancelVoiceoverJob(w http.ResponseWriter, r *http.Request) {
+	c.handleCancelJob(w, r, shared.VOICEOVER)
+}
+
+// Cancels a queued or running job owned by the authenticated user, its credits are refunded
+func (c *RestAPI) handleCancelJob(w http.ResponseWriter, r *http.Request, processType shared.ProcessType) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_id", "")
+		return
+	}
+
+	job, err := c.SCWorker.CancelJob(processType, id, user.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			responses.ErrNotFound(w, r, "job_not_found")
+			return
+		} else if errors.Is(err, repository.JobNotCancellableErr) {
+			responses.ErrConflict(w, r, err.Error())
+			return
+		}
+		log.Error("Error cancelling job", "err", err, "id", id, "process_type", processType)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, job)
+}
+
+// For v1/queue/{job_id}
+// Position and ETA of a job owned by the authenticated user
+func (c *RestAPI) HandleGetQueueStatus(w http.ResponseWriter, r *http.Request) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "job_id"))
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_id", "")
+		return
+	}
+
+	status, err := c.SCWorker.GetQueueStatus(id, user.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			responses.ErrNotFound(w, r, "job_not_found")
+			return
+		}
+		log.Error("Error getting queue status", "err", err, "id", id)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, status)
+}
@@ -0,0 +1,291 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stablecog/sc-go/database/ent/apitokenusage"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/server/middleware"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func getJobRequest(userID string, id string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/", nil)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", id)
+	ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+	if userID != "" {
+		ctx = context.WithValue(ctx, "user_id", userID)
+		ctx = context.WithValue(ctx, "user_email", "mockadmin@stablecog.com")
+	}
+
+	MockController.HandleGetGenerationJob(w, req.WithContext(ctx))
+	return w
+}
+
+func TestGetGenerationJobUnauthorized(t *testing.T) {
+	w := getJobRequest("", uuid.NewString())
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 401, resp.StatusCode)
+}
+
+func TestGetGenerationJobInvalidID(t *testing.T) {
+	w := getJobRequest(repository.MOCK_ADMIN_UUID, "not-uuid")
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "invalid_id", respJson["error"])
+}
+
+func TestGetGenerationJob(t *testing.T) {
+	g, err := MockController.Repo.DB.Generation.Query().Where(generation.UserIDEQ(uuid.MustParse(repository.MOCK_ADMIN_UUID))).WithGenerationOutputs().First(MockController.Repo.Ctx)
+	assert.Nil(t, err)
+
+	w := getJobRequest(repository.MOCK_ADMIN_UUID, g.ID.String())
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	var job responses.ApiJobResponse
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &job)
+	assert.Equal(t, g.ID, job.ID)
+	assert.Equal(t, shared.GENERATE, job.ProcessType)
+	assert.Equal(t, string(g.Status), job.Status)
+	assert.Len(t, job.Outputs, len(g.Edges.GenerationOutputs))
+}
+
+func TestGetGenerationJobNotOwned(t *testing.T) {
+	g, err := MockController.Repo.DB.Generation.Query().Where(generation.UserIDEQ(uuid.MustParse(repository.MOCK_ADMIN_UUID))).First(MockController.Repo.Ctx)
+	assert.Nil(t, err)
+
+	w := getJobRequest(repository.MOCK_NORMAL_UUID, g.ID.String())
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 404, resp.StatusCode)
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "job_not_found", respJson["error"])
+}
+
+func TestGetGenerationBatchNotFound(t *testing.T) {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/", nil)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", uuid.NewString())
+	ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+	ctx = context.WithValue(ctx, "user_id", repository.MOCK_ADMIN_UUID)
+	ctx = context.WithValue(ctx, "user_email", "mockadmin@stablecog.com")
+
+	MockController.HandleGetGenerationBatch(w, req.WithContext(ctx))
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 404, resp.StatusCode)
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "batch_not_found", respJson["error"])
+}
+
+func cancelJobRequest(userID string, id string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", nil)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", id)
+	ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+	ctx = context.WithValue(ctx, "user_id", userID)
+	ctx = context.WithValue(ctx, "user_email", "mockadmin@stablecog.com")
+
+	MockController.HandleCancelGenerationJob(w, req.WithContext(ctx))
+	return w
+}
+
+func TestCancelGenerationJob(t *testing.T) {
+	w := cancelJobRequest(repository.MOCK_ADMIN_UUID, "not-uuid")
+	assert.Equal(t, 400, w.Result().StatusCode)
+
+	g, err := MockController.Repo.DB.Generation.Query().Where(generation.UserIDEQ(uuid.MustParse(repository.MOCK_ADMIN_UUID)), generation.StatusEQ(generation.StatusSucceeded)).First(MockController.Repo.Ctx)
+	assert.Nil(t, err)
+
+	w = cancelJobRequest(repository.MOCK_NORMAL_UUID, g.ID.String())
+	assert.Equal(t, 404, w.Result().StatusCode)
+
+	// Already finished
+	w = cancelJobRequest(repository.MOCK_ADMIN_UUID, g.ID.String())
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 409, resp.StatusCode)
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "job_not_cancellable", respJson["error"])
+}
+
+func TestCancelJobWithCappedApiToken(t *testing.T) {
+	dbToken, token, err := MockController.Repo.NewAPIToken(uuid.MustParse(repository.MOCK_NORMAL_UUID), requests.NewTokenRequest{Name: "capped", MonthlyCreditCap: utils.ToPtr(1)})
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		MockController.Repo.DB.ApiTokenUsage.Delete().Where(apitokenusage.APITokenIDEQ(dbToken.ID)).ExecX(MockController.Repo.Ctx)
+		MockController.Repo.DB.ApiToken.DeleteOneID(dbToken.ID).ExecX(MockController.Repo.Ctx)
+	})
+	MockController.Repo.DB.ApiToken.UpdateOneID(dbToken.ID).
+		SetMonthlyCreditsSpent(1).
+		SetMonthlyCreditsPeriod(repository.ApiTokenMonthlyPeriod(time.Now())).
+		ExecX(MockController.Repo.Ctx)
+
+	mw := &middleware.Middleware{Repo: MockController.Repo, Redis: MockController.Redis}
+	request := func(handler http.Handler) *http.Response {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", uuid.NewString())
+		handler.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx)))
+		return w.Result()
+	}
+
+	// Creating is refused
+	resp := request(mw.SpendingAuthMiddleware(shared.ApiTokenScopeImageGenerate, middleware.AuthLevelAPIToken)(http.HandlerFunc(MockController.HandleCreateGenerationToken)))
+	defer resp.Body.Close()
+	assert.Equal(t, 403, resp.StatusCode)
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "token_credit_cap_reached", respJson["error"])
+
+	// Cancelling gives credits back, so it reaches the handler
+	resp = request(mw.ScopedAuthMiddleware(shared.ApiTokenScopeImageGenerate, middleware.AuthLevelAPIToken)(http.HandlerFunc(MockController.HandleCancelGenerationJob)))
+	defer resp.Body.Close()
+	assert.Equal(t, 404, resp.StatusCode)
+}
+
+func TestCancelAsyncGenerationJobQueuesCallback(t *testing.T) {
+	// Callbacks are queued for quecon
+	options := MockController.Redis.Client.Options()
+	worker := *MockController.SCWorker
+	worker.AsynqClient = asynq.NewClient(asynq.RedisClientOpt{Addr: options.Addr})
+	defer worker.AsynqClient.Close()
+	origWorker := MockController.SCWorker
+	MockController.SCWorker = &worker
+	defer func() { MockController.SCWorker = origWorker }()
+
+	userID := uuid.MustParse(repository.MOCK_ADMIN_UUID)
+	g, err := MockController.Repo.CreateGeneration(userID, "browser", "macos", "chrome", "DE", requests.CreateGenerationRequest{
+		Width:          utils.ToPtr[int32](512),
+		Height:         utils.ToPtr[int32](512),
+		InferenceSteps: utils.ToPtr[int32](30),
+		GuidanceScale:  utils.ToPtr[float32](7),
+		ModelId:        utils.ToPtr(uuid.MustParse(repository.MOCK_GENERATION_MODEL_ID)),
+		SchedulerId:    utils.ToPtr(uuid.MustParse(repository.MOCK_SCHEDULER_ID)),
+		Seed:           utils.ToPtr(1234),
+		NumOutputs:     utils.ToPtr[int32](1),
+	}, nil, nil, enttypes.SourceTypeAPI, nil)
+	assert.Nil(t, err)
+	defer MockController.Repo.DB.Generation.DeleteOneID(g.ID).ExecX(MockController.Repo.Ctx)
+	hold, err := MockController.Repo.HoldCredits(userID, 1, credithold.ProcessTypeGenerate, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, MockController.Repo.AttachCreditHold(hold.ID, g.ID, nil))
+	assert.Nil(t, MockController.Redis.SetCogRequestStreamID(MockController.Redis.Ctx, g.ID.String(), utils.Sha256("stream")))
+	assert.Nil(t, MockController.Redis.SetAsyncJobCallbackURL(MockController.Redis.Ctx, g.ID.String(), "https://example.com/hook"))
+
+	w := cancelJobRequest(repository.MOCK_ADMIN_UUID, g.ID.String())
+	assert.Equal(t, 200, w.Result().StatusCode)
+
+	inspector := asynq.NewInspector(asynq.RedisClientOpt{Addr: options.Addr})
+	defer inspector.Close()
+	info, err := inspector.GetTaskInfo(shared.ASYNQ_WEBHOOK_QUEUE, fmt.Sprintf("callback:%s", g.ID))
+	assert.Nil(t, err)
+	var callback requests.AsyncJobCallback
+	assert.Nil(t, json.Unmarshal(info.Payload, &callback))
+	assert.Equal(t, "https://example.com/hook", callback.URL)
+	var job responses.ApiJobResponse
+	assert.Nil(t, json.Unmarshal(callback.Body, &job))
+	assert.Equal(t, g.ID, job.ID)
+	assert.Equal(t, string(generation.StatusFailed), job.Status)
+	assert.Equal(t, shared.CANCELLED_ERROR, job.Error)
+}
+
+func queueStatusRequest(userID string, id string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/", nil)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("job_id", id)
+	ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+	ctx = context.WithValue(ctx, "user_id", userID)
+	ctx = context.WithValue(ctx, "user_email", "mockadmin@stablecog.com")
+
+	MockController.HandleGetQueueStatus(w, req.WithContext(ctx))
+	return w
+}
+
+func TestGetQueueStatus(t *testing.T) {
+	w := queueStatusRequest(repository.MOCK_ADMIN_UUID, "not-uuid")
+	assert.Equal(t, 400, w.Result().StatusCode)
+
+	g, err := MockController.Repo.DB.Generation.Query().Where(generation.UserIDEQ(uuid.MustParse(repository.MOCK_ADMIN_UUID))).First(MockController.Repo.Ctx)
+	assert.Nil(t, err)
+
+	w = queueStatusRequest(repository.MOCK_NORMAL_UUID, g.ID.String())
+	assert.Equal(t, 404, w.Result().StatusCode)
+
+	// Queued behind another job
+	_, err = MockController.Repo.DB.Generation.UpdateOneID(g.ID).SetStatus(generation.StatusQueued).ClearStartedAt().ClearCompletedAt().Save(MockController.Repo.Ctx)
+	assert.Nil(t, err)
+	defer MockController.Repo.DB.Generation.UpdateOneID(g.ID).SetStatus(g.Status).SetNillableStartedAt(g.StartedAt).SetNillableCompletedAt(g.CompletedAt).ExecX(MockController.Repo.Ctx)
+	ahead, err := MockController.Repo.AddToQueueLog("queue-status-ahead", int(shared.QUEUE_PRIORITY_10), nil)
+	assert.Nil(t, err)
+	defer MockController.Repo.DeleteFromQueueLog(ahead.MessageID, nil)
+	// Not in line anymore
+	processing, err := MockController.Repo.AddToQueueLog("queue-status-processing", int(shared.QUEUE_PRIORITY_10), nil)
+	assert.Nil(t, err)
+	defer MockController.Repo.DeleteFromQueueLog(processing.MessageID, nil)
+	_, err = MockController.Repo.SetIsProcessingInQueueLog(processing.MessageID, true, nil)
+	assert.Nil(t, err)
+	item, err := MockController.Repo.AddToQueueLog(utils.Sha256(g.ID.String()), int(shared.QUEUE_PRIORITY_1), nil)
+	assert.Nil(t, err)
+	defer MockController.Repo.DeleteFromQueueLog(item.MessageID, nil)
+
+	w = queueStatusRequest(repository.MOCK_ADMIN_UUID, g.ID.String())
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	var status responses.QueueStatusResponse
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &status)
+	assert.Equal(t, g.ID, status.JobID)
+	assert.Equal(t, shared.GENERATE, status.ProcessType)
+	assert.Equal(t, "queued", status.Status)
+	// Lowest priority, last in line behind whatever else is queued
+	assert.GreaterOrEqual(t, status.Total, 2)
+	assert.Equal(t, status.Total, status.Position)
+	assert.Equal(t, status.Total-1, status.Ahead)
+	waiting, err := MockController.Repo.CountQueuedWaiting()
+	assert.Nil(t, err)
+	assert.Equal(t, waiting, status.Total)
+}
@@ -0,0 +1,130 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/moderationverdict"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// For v1/admin/moderation/verdicts
+func (c *RestAPI) HandleQueryModerationVerdicts(w http.ResponseWriter, r *http.Request) {
+	if user, email := c.GetUserIDAndEmailIfAuthenticated(w, r); user == nil || email == "" {
+		return
+	}
+
+	perPage := DEFAULT_PER_PAGE
+	var err error
+	if perPageStr := r.URL.Query().Get("per_page"); perPageStr != "" {
+		perPage, err = strconv.Atoi(perPageStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "per_page must be an integer", "")
+			return
+		} else if perPage < 1 || perPage > MAX_PER_PAGE {
+			responses.ErrBadRequest(w, r, fmt.Sprintf("per_page must be between 1 and %d", MAX_PER_PAGE), "")
+			return
+		}
+	}
+
+	var cursor *utils.KeysetCursor
+	if cursorStr := r.URL.Query().Get("cursor"); cursorStr != "" {
+		cursor, err = utils.ParseKeysetCursor(cursorStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "cursor must be a valid cursor or iso time string", "")
+			return
+		}
+	}
+
+	var filters repository.ModerationVerdictFilters
+	if actionStr := r.URL.Query().Get("action"); actionStr != "" {
+		action := moderationverdict.Action(actionStr)
+		if moderationverdict.ActionValidator(action) != nil {
+			responses.ErrBadRequest(w, r, "invalid_action", "")
+			return
+		}
+		filters.Action = &action
+	}
+	if reviewedStr := r.URL.Query().Get("reviewed"); reviewedStr != "" {
+		reviewed, err := strconv.ParseBool(reviewedStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "reviewed must be a boolean", "")
+			return
+		}
+		filters.Reviewed = &reviewed
+	}
+	if userIDStr := r.URL.Query().Get("user_id"); userIDStr != "" {
+		userID, err := uuid.Parse(userIDStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "invalid_user_id", "")
+			return
+		}
+		filters.UserID = &userID
+	}
+
+	verdicts, err := c.Repo.QueryModerationVerdicts(perPage, cursor, filters)
+	if err != nil {
+		log.Error("Error querying moderation verdicts", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, verdicts)
+}
+
+// For v1/admin/moderation/verdicts/{id}/review
+// Overturning a ban unbans the user
+func (c *RestAPI) HandleReviewModerationVerdict(w http.ResponseWriter, r *http.Request) {
+	user, email := c.GetUserIDAndEmailIfAuthenticated(w, r)
+	if user == nil || email == "" {
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_id", "")
+		return
+	}
+
+	var reviewReq requests.ReviewModerationVerdictRequest
+	reqBody, _ := io.ReadAll(r.Body)
+	if err := json.Unmarshal(reqBody, &reviewReq); err != nil {
+		responses.ErrUnableToParseJson(w, r)
+		return
+	}
+	if moderationverdict.ReviewStatusValidator(reviewReq.Status) != nil {
+		responses.ErrBadRequest(w, r, "invalid_status", "")
+		return
+	}
+
+	verdict, err := c.Repo.ReviewModerationVerdict(id, *user, reviewReq.Status, reviewReq.Note)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			responses.ErrNotFound(w, r, "verdict_not_found")
+		case errors.Is(err, repository.ModerationVerdictReviewedErr):
+			responses.ErrBadRequest(w, r, err.Error(), "")
+		default:
+			log.Error("Error reviewing moderation verdict", "id", id, "err", err)
+			responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		}
+		return
+	}
+	log.Info("Reviewed moderation verdict", "id", id, "status", reviewReq.Status, "user_id", user, "email", email)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, repository.ModerationVerdictResultFromEnt(verdict))
+}
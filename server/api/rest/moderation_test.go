@@ -0,0 +1,95 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/moderationverdict"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func reviewModerationVerdictRequest(id string, reviewReq requests.ReviewModerationVerdictRequest) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(reviewReq)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", id)
+	ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+	ctx = context.WithValue(ctx, "user_id", repository.MOCK_ADMIN_UUID)
+	ctx = context.WithValue(ctx, "user_email", "mockadmin@stablecog.com")
+
+	MockController.HandleReviewModerationVerdict(w, req.WithContext(ctx))
+	return w
+}
+
+func TestHandleReviewModerationVerdict(t *testing.T) {
+	verdict, err := MockController.Repo.CreateModerationVerdict(repository.NewModerationVerdict{
+		UserID:     uuid.MustParse(repository.MOCK_NORMAL_UUID),
+		SourceType: enttypes.SourceTypeAPI,
+		Tier:       "free",
+		Policy:     "default",
+		Prompt:     "something blocked",
+		Action:     moderationverdict.ActionBlock,
+		Reason:     utils.ToPtr("sexual"),
+	}, nil)
+	assert.Nil(t, err)
+
+	// Shows up as unreviewed
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/?action=block&reviewed=false&user_id="+repository.MOCK_NORMAL_UUID, nil)
+	ctx := context.WithValue(req.Context(), "user_id", repository.MOCK_ADMIN_UUID)
+	ctx = context.WithValue(ctx, "user_email", "mockadmin@stablecog.com")
+	MockController.HandleQueryModerationVerdicts(w, req.WithContext(ctx))
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	var queryResp repository.ModerationVerdictQueryMeta
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &queryResp)
+	assert.Len(t, queryResp.Verdicts, 1)
+	assert.Equal(t, verdict.ID, queryResp.Verdicts[0].ID)
+
+	w = reviewModerationVerdictRequest(verdict.ID.String(), requests.ReviewModerationVerdictRequest{Status: "dismissed"})
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	w = reviewModerationVerdictRequest(verdict.ID.String(), requests.ReviewModerationVerdictRequest{
+		Status: moderationverdict.ReviewStatusUpheld,
+		Note:   utils.ToPtr("clearly nsfw"),
+	})
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	var reviewResp repository.ModerationVerdictResult
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &reviewResp)
+	assert.Equal(t, moderationverdict.ReviewStatusUpheld, *reviewResp.ReviewStatus)
+	assert.Equal(t, uuid.MustParse(repository.MOCK_ADMIN_UUID), *reviewResp.ReviewedBy)
+
+	// Only reviewed once
+	w = reviewModerationVerdictRequest(verdict.ID.String(), requests.ReviewModerationVerdictRequest{Status: moderationverdict.ReviewStatusOverturned})
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+	var respJson map[string]interface{}
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "verdict_already_reviewed", respJson["error"])
+
+	w = reviewModerationVerdictRequest(uuid.NewString(), requests.ReviewModerationVerdictRequest{Status: moderationverdict.ReviewStatusUpheld})
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 404, resp.StatusCode)
+}
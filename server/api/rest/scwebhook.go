@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-chi/render"
 	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/voiceover"
 	"github.com/stablecog/sc-go/database/enttypes"
 	"github.com/stablecog/sc-go/database/repository"
 	"github.com/stablecog/sc-go/log"
@@ -37,6 +38,7 @@ func (c *RestAPI) HandleSCWorkerWebhook(w http.ResponseWriter, r *http.Request)
 		return
 	} else if cogMessage.Input.APIRequest {
 		// API request handled in a separate flow
+		c.Repo.FinishBackendJob(cogMessage)
 		err = c.Redis.Client.Publish(c.Redis.Ctx, shared.REDIS_APITOKEN_COG_CHANNEL, reqBody).Err()
 		if err != nil {
 			log.Error("Failed to publish API worker msg", "err", err)
@@ -82,10 +84,29 @@ func (c *RestAPI) HandleSCWorkerWebhook(w http.ResponseWriter, r *http.Request)
 			responses.ErrUnauthorized(w, r)
 			return
 		}
+	} else if cogMessage.Input.ProcessType == shared.VOICEOVER {
+		// Voiceovers have no webhook token, results of ones that already timed out or were cancelled are dropped
+		uid := cogMessage.Input.ID
+		vo, err := c.Repo.GetVoiceover(uid)
+		if err != nil && ent.IsNotFound(err) {
+			log.Error("Voiceover not found", "id", uid)
+			responses.ErrNotFound(w, r, "Voiceover not found")
+			return
+		} else if err != nil {
+			log.Error("Error getting Voiceover for webhook", "err", err)
+			responses.ErrInternalServerError(w, r, "server error")
+			return
+		} else if vo.Status == voiceover.StatusFailed {
+			log.Info("Ignoring result of failed voiceover", "id", uid)
+			render.Status(r, http.StatusOK)
+			render.PlainText(w, r, "OK")
+			return
+		}
 	}
 
 	if cogMessage.Input.Internal {
 		// Internal request handled in a separate flow
+		c.Repo.FinishBackendJob(cogMessage)
 		err = c.Redis.Client.Publish(c.Redis.Ctx, shared.REDIS_INTERNAL_COG_CHANNEL, reqBody).Err()
 		if err != nil {
 			log.Error("Failed to publish internal worker msg", "err", err)
@@ -228,6 +249,11 @@ func (c *RestAPI) HandleSCWorkerWebhook(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// Async API requests are finished here, since nobody is waiting on them
+	if cogMessage.Input.Async {
+		go c.SCWorker.CompleteAsyncJob(cogMessage)
+	}
+
 	render.Status(r, http.StatusOK)
 	render.PlainText(w, r, "OK")
 }
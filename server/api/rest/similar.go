@@ -0,0 +1,332 @@
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/generationoutput"
+	"github.com/stablecog/sc-go/database/qdrant"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// HTTP GET/POST - public gallery items that look like an output or an uploaded image
+// GET takes output_id, POST takes a multipart file
+func (c *RestAPI) HandleSimilarGallery(w http.ResponseWriter, r *http.Request) {
+	// Get user for like data, if authenticated
+	callingUser, err := c.GetUserIfAuthenticatedOnly(w, r)
+	if err != nil {
+		log.Error("Error getting user", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return
+	}
+
+	c.handleSimilar(w, r, callingUser, false)
+}
+
+// HTTP GET/POST - outputs of the user that look like an output or an uploaded image
+func (c *RestAPI) HandleSimilarHistory(w http.ResponseWriter, r *http.Request) {
+	var user *ent.User
+	if user = c.GetUserIfAuthenticated(w, r); user == nil {
+		return
+	}
+
+	c.handleSimilar(w, r, user, true)
+}
+
+func (c *RestAPI) handleSimilar(w http.ResponseWriter, r *http.Request, callingUser *ent.User, forHistory bool) {
+	var callingUserId *uuid.UUID
+	if callingUser != nil {
+		callingUserId = utils.ToPtr(callingUser.ID)
+	}
+
+	// Parse filters
+	filters := &requests.QueryGenerationFilters{}
+	err := filters.ParseURLQueryParameters(r.URL.Query())
+	if err != nil {
+		responses.ErrBadRequest(w, r, err.Error(), "")
+		return
+	}
+
+	perPage := GALLERY_PER_PAGE
+	if forHistory {
+		perPage = DEFAULT_PER_PAGE
+	}
+	if perPageStr := r.URL.Query().Get("per_page"); perPageStr != "" {
+		perPage, err = strconv.Atoi(perPageStr)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "per_page must be an integer", "")
+			return
+		} else if perPage < 1 || perPage > MAX_PER_PAGE {
+			responses.ErrBadRequest(w, r, fmt.Sprintf("per_page must be between 1 and %d", MAX_PER_PAGE), "")
+			return
+		}
+	}
+
+	var offset *uint
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+		cursoru64, err := strconv.ParseUint(cursor, 10, 64)
+		if err != nil {
+			responses.ErrBadRequest(w, r, "cursor must be a valid uint", "")
+			return
+		}
+		offset = utils.ToPtr(uint(cursoru64))
+	}
+
+	// Only outputs of these users
+	var usernameFilter []qdrant.SCMatchCondition
+	if len(filters.Username) > 0 {
+		userIDs, err := c.Repo.GetUserIDsByUsernames(filters.Username)
+		if err != nil {
+			log.Error("Error getting user ids by usernames", "err", err)
+			responses.ErrInternalServerError(w, r, "An unknown error occurred")
+			return
+		}
+		if len(userIDs) == 0 {
+			render.Status(r, http.StatusOK)
+			render.JSON(w, r, GalleryResponseV3[*uint]{
+				Outputs: c.Repo.ConvertRawGalleryDataToV3Results([]repository.GalleryData{}),
+			})
+			return
+		}
+		for _, userID := range userIDs {
+			usernameFilter = append(usernameFilter, qdrant.SCMatchCondition{
+				Key:   "user_id",
+				Match: &qdrant.SCValue{Value: userID.String()},
+			})
+		}
+	}
+
+	var embedding []float32
+	var sourceOutputId *uuid.UUID
+	if r.Method == http.MethodPost {
+		embedding = c.getUploadedImageEmbedding(w, r, callingUser)
+	} else {
+		sourceOutputId, embedding = c.getOutputEmbedding(w, r, callingUserId)
+	}
+	if embedding == nil {
+		return
+	}
+
+	// Same filters as the text search
+	qdrantFilters, scoreThreshold := filters.ToQdrantFilters(!forHistory)
+	if forHistory {
+		qdrantFilters.Must = append(qdrantFilters.Must, qdrant.SCMatchCondition{
+			Key:   "user_id",
+			Match: &qdrant.SCValue{Value: callingUser.ID.String()},
+		}, qdrant.SCMatchCondition{
+			IsEmpty: &qdrant.SCIsEmpty{Key: "deleted_at"},
+		})
+	} else {
+		qdrantFilters.Must = append(qdrantFilters.Must, qdrant.SCMatchCondition{
+			Key:   "gallery_status",
+			Match: &qdrant.SCValue{Value: generationoutput.GalleryStatusApproved},
+		}, qdrant.SCMatchCondition{
+			Key:   "is_public",
+			Match: &qdrant.SCValue{Value: true},
+		})
+	}
+	if len(usernameFilter) > 0 {
+		qdrantFilters.Must = append(qdrantFilters.Must, qdrant.SCMatchCondition{
+			Should: usernameFilter,
+		})
+	}
+	// Not the output we are looking for more of
+	if sourceOutputId != nil {
+		qdrantFilters.MustNot = append(qdrantFilters.MustNot, qdrant.SCMatchCondition{
+			HasId: []uuid.UUID{*sourceOutputId},
+		})
+	}
+
+	res, err := c.Qdrant.QueryGenerations(embedding, perPage, offset, scoreThreshold, filters.Oversampling, qdrantFilters, false, false)
+	if err != nil {
+		log.Error("Error querying qdrant", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error occurred")
+		return
+	}
+
+	var outputIds []uuid.UUID
+	for _, hit := range res.Result {
+		outputId, err := uuid.Parse(hit.Id)
+		if err != nil {
+			log.Error("Error parsing uuid", "err", err)
+			continue
+		}
+		outputIds = append(outputIds, outputId)
+	}
+
+	source := repository.GalleryDataFromGallery
+	if forHistory {
+		source = repository.GalleryDataFromHistory
+	}
+	galleryDataUnsorted, err := c.Repo.RetrieveGalleryDataWithOutputIDs(outputIds, callingUserId, source)
+	if err != nil {
+		log.Error("Error querying gallery data", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error occurred")
+		return
+	}
+	gDataMap := make(map[uuid.UUID]repository.GalleryData)
+	for _, gData := range galleryDataUnsorted {
+		gDataMap[gData.ID] = gData
+	}
+
+	galleryData := []repository.GalleryData{}
+	for _, outputId := range outputIds {
+		item, ok := gDataMap[outputId]
+		if !ok {
+			log.Error("Error retrieving gallery data", "output_id", outputId)
+			continue
+		}
+		if !forHistory {
+			// We don't want to leak primary keys
+			item.UserID = nil
+		}
+		galleryData = append(galleryData, item)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, GalleryResponseV3[*uint]{
+		Next:    res.Next,
+		Outputs: c.Repo.ConvertRawGalleryDataToV3Results(galleryData),
+	})
+}
+
+// Stored vector of the output in output_id, if the user may see it
+// Writes the error response and returns nil embedding on failure
+func (c *RestAPI) getOutputEmbedding(w http.ResponseWriter, r *http.Request, callingUserId *uuid.UUID) (*uuid.UUID, []float32) {
+	outputId, err := uuid.Parse(r.URL.Query().Get("output_id"))
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_output_id", "")
+		return nil, nil
+	}
+
+	point, err := c.Qdrant.GetPoint(outputId, false)
+	if err != nil {
+		if strings.Contains(err.Error(), "Error querying collection 404") {
+			responses.ErrNotFound(w, r, "generation_not_found")
+			return nil, nil
+		}
+		log.Error("Error getting point from qdrant", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error occurred")
+		return nil, nil
+	}
+
+	// Their own, or public in the gallery
+	ownerId, _ := point.Result.Payload["user_id"].(string)
+	isPublic, _ := point.Result.Payload["is_public"].(bool)
+	galleryStatus, _ := point.Result.Payload["gallery_status"].(string)
+	_, deleted := point.Result.Payload["deleted_at"]
+	isOwner := callingUserId != nil && ownerId == callingUserId.String()
+	if deleted || (!isOwner && (!isPublic || galleryStatus != string(generationoutput.GalleryStatusApproved))) {
+		responses.ErrNotFound(w, r, "generation_not_found")
+		return nil, nil
+	}
+	if len(point.Result.Vector.Image) == 0 {
+		responses.ErrNotFound(w, r, "generation_not_found")
+		return nil, nil
+	}
+
+	return &outputId, point.Result.Vector.Image
+}
+
+// Embedding of an image uploaded as the multipart file, needs a user
+// The CLIP API only downloads images, so it's put under SIMILAR_UPLOAD_PREFIX in the img2img bucket and deleted once embedded
+// Writes the error response and returns nil on failure
+func (c *RestAPI) getUploadedImageEmbedding(w http.ResponseWriter, r *http.Request, user *ent.User) []float32 {
+	if user == nil {
+		responses.ErrUnauthorized(w, r)
+		return nil
+	}
+	if user.BannedAt != nil {
+		responses.ErrForbidden(w, r)
+		return nil
+	}
+
+	// Enforce max upload size
+	r.Body = http.MaxBytesReader(w, r.Body, shared.MAX_UPLOAD_SIZE_MB*1024*1024)
+	if err := r.ParseMultipartForm(shared.MAX_UPLOAD_SIZE_MB * 1024 * 1024); err != nil {
+		responses.ErrBadRequest(w, r, "parse_error", err.Error())
+		return nil
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		responses.ErrBadRequest(w, r, "invalid_file", "Invalid file")
+		return nil
+	}
+	defer file.Close()
+
+	buf, err := io.ReadAll(file)
+	if err != nil {
+		log.Error("Error reading body", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return nil
+	}
+	// Detect content-type
+	contentType := http.DetectContentType(buf)
+	var extension string
+	switch contentType {
+	case "image/jpeg":
+		extension = "jpg"
+	case "image/png":
+		extension = "png"
+	case "image/webp":
+		extension = "webp"
+	default:
+		responses.ErrBadRequest(w, r, "invalid_content_type", "Content type must be image/jpeg, image/png, or image/webp")
+		return nil
+	}
+
+	imageKey := fmt.Sprintf("%s%s/%s.%s", shared.SIMILAR_UPLOAD_PREFIX, utils.Sha256(user.ID.String()), uuid.New().String(), extension)
+	_, err = c.S3.PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String(utils.GetEnv().S3Img2ImgBucketName),
+		Key:         aws.String(imageKey),
+		Body:        bytes.NewReader(buf),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		log.Error("Error uploading object", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return nil
+	}
+	// Query images aren't kept, the prefix's lifecycle rule expires any this misses
+	defer func() {
+		if _, err := c.S3.DeleteObject(&s3.DeleteObjectInput{
+			Bucket: aws.String(utils.GetEnv().S3Img2ImgBucketName),
+			Key:    aws.String(imageKey),
+		}); err != nil {
+			log.Error("Error deleting similarity search image", "key", imageKey, "err", err)
+		}
+	}()
+	req, _ := c.S3.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(utils.GetEnv().S3Img2ImgBucketName),
+		Key:    aws.String(imageKey),
+	})
+	imageUrl, err := req.Presign(5 * time.Minute)
+	if err != nil {
+		log.Error("Error signing image URL", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error has occurred")
+		return nil
+	}
+
+	embedding, err := c.Clip.GetEmbeddingFromImage(imageUrl)
+	if err != nil {
+		log.Error("Error getting embeddings from clip service", "err", err)
+		responses.ErrInternalServerError(w, r, "An unknown error occurred")
+		return nil
+	}
+	return embedding
+}
@@ -0,0 +1,106 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/generationoutput"
+	"github.com/stablecog/sc-go/database/qdrant"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func similarRequest(method string, target string, userID string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, target, nil)
+	ctx := context.WithValue(req.Context(), "user_id", userID)
+	ctx = context.WithValue(ctx, "user_email", "mockadmin@stablecog.com")
+	MockController.HandleSimilarHistory(w, req.WithContext(ctx))
+	return w
+}
+
+func TestSimilarHistory(t *testing.T) {
+	outputs, err := MockController.Repo.DB.GenerationOutput.Query().
+		Where(generationoutput.HasGenerationsWith(generation.UserIDEQ(uuid.MustParse(repository.MOCK_ADMIN_UUID)))).
+		Limit(3).
+		All(MockController.Repo.Ctx)
+	assert.Nil(t, err)
+	assert.Len(t, outputs, 3)
+	source, hit1, hit2 := outputs[0], outputs[1], outputs[2]
+	private := uuid.New()
+
+	// Fake qdrant with the source point and a search returning the hits
+	var searchBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/collections/test/points/search":
+			b, _ := io.ReadAll(r.Body)
+			searchBody = string(b)
+			fmt.Fprintf(w, `{"result":[{"id":"%s","score":99},{"id":"%s","score":98}],"status":"ok","time":0.1}`, hit2.ID, hit1.ID)
+		case r.URL.Path == "/collections/test/points/"+source.ID.String():
+			fmt.Fprintf(w, `{"result":{"id":"%s","payload":{"user_id":"%s","is_public":false},"vector":{"image":[0.1,0.2]}}}`, source.ID, repository.MOCK_ADMIN_UUID)
+		case r.URL.Path == "/collections/test/points/"+private.String():
+			fmt.Fprintf(w, `{"result":{"id":"%s","payload":{"user_id":"%s","is_public":false},"vector":{"image":[0.1,0.2]}}}`, private, uuid.New())
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":{"error":"Not found"}}`))
+		}
+	}))
+	defer server.Close()
+	client, doer, err := qdrant.NewClientWithResponses(server.URL)
+	assert.Nil(t, err)
+	origQdrant := MockController.Qdrant
+	MockController.Qdrant = &qdrant.QdrantClient{Client: client, Doer: doer, Ctx: context.Background(), CollectionName: "test"}
+	defer func() {
+		MockController.Qdrant = origQdrant
+	}()
+
+	w := similarRequest("GET", "/?output_id=nope", repository.MOCK_ADMIN_UUID)
+	assert.Equal(t, 400, w.Code)
+
+	// Someone elses private output
+	w = similarRequest("GET", "/?output_id="+private.String(), repository.MOCK_ADMIN_UUID)
+	assert.Equal(t, 404, w.Code)
+
+	// Not in qdrant
+	w = similarRequest("GET", "/?output_id="+uuid.NewString(), repository.MOCK_ADMIN_UUID)
+	assert.Equal(t, 404, w.Code)
+
+	// Own output, in qdrant order without the source
+	w = similarRequest("GET", "/?output_id="+source.ID.String(), repository.MOCK_ADMIN_UUID)
+	assert.Equal(t, 200, w.Code)
+	var resp GalleryResponseV3[*uint]
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp.Outputs, 2)
+	assert.Equal(t, hit2.ID, resp.Outputs[0].ID)
+	assert.Equal(t, hit1.ID, resp.Outputs[1].ID)
+	assert.Contains(t, searchBody, fmt.Sprintf(`"must_not":[{"has_id":["%s"]}]`, source.ID))
+	assert.Contains(t, searchBody, fmt.Sprintf(`{"key":"user_id","match":{"value":"%s"}}`, repository.MOCK_ADMIN_UUID))
+
+	// Only outputs of the given users
+	w = similarRequest("GET", "/?username=2&output_id="+source.ID.String(), repository.MOCK_ADMIN_UUID)
+	assert.Equal(t, 200, w.Code)
+	assert.Contains(t, searchBody, fmt.Sprintf(`{"should":[{"key":"user_id","match":{"value":"%s"}}]}`, repository.MOCK_NORMAL_UUID))
+
+	// Nobody by that name, nothing to search
+	searchBody = ""
+	w = similarRequest("GET", "/?username=nobody&output_id="+source.ID.String(), repository.MOCK_ADMIN_UUID)
+	assert.Equal(t, 200, w.Code)
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp.Outputs, 0)
+	assert.Equal(t, "", searchBody)
+
+	// Uploads have to be multipart
+	w = similarRequest("POST", "/", repository.MOCK_ADMIN_UUID)
+	assert.Equal(t, 400, w.Code)
+	assert.True(t, strings.Contains(w.Body.String(), "parse_error"))
+}
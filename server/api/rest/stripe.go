@@ -11,7 +11,6 @@ import (
 	"github.com/go-chi/render"
 	"github.com/redis/go-redis/v9"
 	"github.com/stablecog/sc-go/database/ent"
-	"github.com/stablecog/sc-go/database/ent/credit"
 	"github.com/stablecog/sc-go/log"
 	"github.com/stablecog/sc-go/server/discord"
 	"github.com/stablecog/sc-go/server/requests"
@@ -1045,13 +1044,7 @@ func (c *RestAPI) HandleStripeWebhook(w http.ResponseWriter, r *http.Request) {
 				for _, line := range lastInvoice.Lines.Data {
 					if line.Subscription == sub.ID {
 						invoiceLineItemId := line.ID
-						if err := c.Repo.WithTx(func(tx *ent.Tx) error {
-							_, err := tx.Client().Credit.Delete().
-								Where(credit.UserIDEQ(user.ID), credit.StartsAtGT(time.Now()), credit.StripeLineItemIDEQ(invoiceLineItemId)).
-								Exec(c.Repo.Ctx)
-
-							return err
-						}); err != nil {
+						if err := c.Repo.RevokeFutureCreditsWithLineItemID(user.ID, invoiceLineItemId); err != nil {
 							log.Error("Unable to revoke annual credits", "err", err)
 						}
 					}
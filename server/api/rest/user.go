@@ -836,6 +836,13 @@ func (c *RestAPI) HandleQueryCredits(w http.ResponseWriter, r *http.Request) {
 		totalRemaining += credit.RemainingAmount
 	}
 
+	heldCredits, err := c.Repo.GetHeldCreditsForUser(userId)
+	if err != nil {
+		log.Error("Error getting held credits for user", "err", err)
+		responses.ErrInternalServerError(w, r, "Error getting credits")
+		return
+	}
+
 	creditsFormatted := make([]responses.Credit, len(credits))
 	for i, credit := range credits {
 		creditsFormatted[i] = responses.Credit{
@@ -853,6 +860,7 @@ func (c *RestAPI) HandleQueryCredits(w http.ResponseWriter, r *http.Request) {
 
 	creditsResponse := responses.QueryCreditsResponse{
 		TotalRemainingCredits: totalRemaining,
+		HeldCredits:           heldCredits,
 		Credits:               creditsFormatted,
 	}
 
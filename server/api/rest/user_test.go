@@ -3,6 +3,7 @@ package rest
 import (
 	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -407,6 +408,54 @@ func TestHandleQueryCredits(t *testing.T) {
 	assert.Equal(t, "mock", creditResp.Credits[1].Type.Name)
 }
 
+func TestHandleQueryCreditHistory(t *testing.T) {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/credits/history?per_page=1", nil)
+
+	ctx := context.WithValue(req.Context(), "user_id", repository.MOCK_ALT_UUID)
+	ctx = context.WithValue(ctx, "user_email", repository.MOCK_ADMIN_UUID)
+
+	MockController.HandleQueryCreditHistory(w, req.WithContext(ctx))
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	var historyResp responses.CreditHistoryResponse
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &historyResp)
+
+	// Mock subscription credits and their tippable credits, newest first
+	assert.Len(t, historyResp.Transactions, 1)
+	assert.Equal(t, "subscription", historyResp.Transactions[0].Type)
+	assert.Equal(t, "Tippable", historyResp.Transactions[0].CreditTypeName)
+	assert.NotNil(t, historyResp.Next)
+
+	// CSV export of everything
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("GET", "/credits/history?format=csv", nil)
+	MockController.HandleQueryCreditHistory(w, req.WithContext(ctx))
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+	rows, err := csv.NewReader(resp.Body).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, "id", rows[0][0])
+	assert.GreaterOrEqual(t, len(rows), 3)
+}
+
+func TestHandleQueryCreditHistoryBadFormat(t *testing.T) {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/credits/history?format=xml", nil)
+
+	ctx := context.WithValue(req.Context(), "user_id", repository.MOCK_ALT_UUID)
+	ctx = context.WithValue(ctx, "user_email", repository.MOCK_ADMIN_UUID)
+
+	MockController.HandleQueryCreditHistory(w, req.WithContext(ctx))
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+}
+
 func TestHandleDeleteGenerationForUser(t *testing.T) {
 	ctx := context.Background()
 	// Create mock generation
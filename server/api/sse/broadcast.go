@@ -23,6 +23,7 @@ func (h *Hub) BroadcastStatusUpdate(msg repository.TaskStatusUpdateResponse) {
 	// Broadcast to all clients subcribed to this stream
 	h.Broadcast <- BroadcastPayload{
 		ID:      msg.StreamId,
+		EventID: msg.EventID,
 		Message: respBytes,
 	}
 }
@@ -1,10 +1,58 @@
 package sse
 
+import "sync"
+
+// A message for a client, ID is 0 for messages that aren't kept for replay
+type Event struct {
+	ID       int64
+	StreamID string
+	Data     []byte
+}
+
 // Every server connection is a client instance
 type Client struct {
 	// Buffered channel of outbound messages.
-	Send chan []byte
+	Send chan Event
 
 	// identifier for connected client
 	Uid string
+
+	// Additional streams, websocket clients can subscribe to many on one connection
+	mu      sync.RWMutex
+	streams map[string]bool
+}
+
+// Whether the client gets messages of the stream
+func (c *Client) Subscribed(streamID string) bool {
+	if c.Uid != "" && c.Uid == streamID {
+		return true
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.streams[streamID]
+}
+
+func (c *Client) Subscribe(streamIDs ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.streams == nil {
+		c.streams = make(map[string]bool)
+	}
+	for _, streamID := range streamIDs {
+		c.streams[streamID] = true
+	}
+}
+
+func (c *Client) Unsubscribe(streamIDs ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, streamID := range streamIDs {
+		delete(c.streams, streamID)
+	}
+}
+
+func (c *Client) NumSubscribed() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.streams)
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/stablecog/sc-go/log"
@@ -39,8 +40,23 @@ func (h *Hub) ServeSSE(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Connection", "keep-alive")
 	w.Header().Set("X-Accel-Buffering", "no")
 
+	// Browsers send the header when they reconnect, other clients can use the query parameter
+	lastEventIDStr := r.Header.Get("Last-Event-ID")
+	if lastEventIDStr == "" {
+		lastEventIDStr = query.Get("last_event_id")
+	}
+	var lastEventID int64
+	if lastEventIDStr != "" {
+		var err error
+		lastEventID, err = strconv.ParseInt(lastEventIDStr, 10, 64)
+		if err != nil || lastEventID < 0 {
+			responses.ErrBadRequest(w, r, "Invalid Last-Event-ID", "")
+			return
+		}
+	}
+
 	// Register client in the hub
-	client := &Client{Send: make(chan []byte, 256), Uid: streamID}
+	client := &Client{Send: make(chan Event, 256), Uid: streamID}
 	h.Register <- client
 
 	// Remove this client from the map of connected clients
@@ -57,22 +73,51 @@ func (h *Hub) ServeSSE(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Error marshalling app version message", http.StatusInternalServerError)
 		return
 	}
-	client.Send <- versionBytes
+	client.Send <- Event{Data: versionBytes}
+
+	// Replay what the client missed, registered first so nothing published meanwhile is lost
+	var replayedID int64
+	if lastEventID > 0 && streamID != LIVE_STREAM_ID {
+		events, err := h.Redis.GetSSEBacklogEvents(streamID, lastEventID)
+		if err != nil {
+			log.Error("Error getting sse backlog", "err", err, "stream_id", streamID)
+		}
+		for _, event := range events {
+			writeEvent(w, Event{ID: event.ID, Data: event.Data})
+			replayedID = event.ID
+		}
+		flusher.Flush()
+	}
 
 	// Listen to connection close and un-register client
 	for {
 		select {
 		case <-r.Context().Done():
 			return
-		case message := <-client.Send:
-			// Write to the ResponseWriter
-			// SSE compatible
-			fmt.Fprintf(w, "data: %s\n\n", message)
+		case message, ok := <-client.Send:
+			// Closed by the hub, the client reconnects and replays what it missed
+			if !ok {
+				return
+			}
+			// Already replayed
+			if message.ID > 0 && message.ID <= replayedID {
+				continue
+			}
+			writeEvent(w, message)
 			flusher.Flush()
 		}
 	}
 }
 
+// Write to the ResponseWriter
+// SSE compatible
+func writeEvent(w http.ResponseWriter, event Event) {
+	if event.ID > 0 {
+		fmt.Fprintf(w, "id: %d\n", event.ID)
+	}
+	fmt.Fprintf(w, "data: %s\n\n", event.Data)
+}
+
 type AppVersionMessage struct {
 	Version string `json:"version"`
 }
@@ -0,0 +1,56 @@
+package sse
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stablecog/sc-go/database"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServeSSEReplaysMissedEvents(t *testing.T) {
+	origMockRedis := utils.GetEnv().MockRedis
+	utils.GetEnv().MockRedis = true
+	defer func() {
+		utils.GetEnv().MockRedis = origMockRedis
+	}()
+	redis, err := database.NewRedis(context.TODO())
+	assert.Nil(t, err)
+
+	streamID := utils.Sha256("stream")
+	for i := int64(1); i <= 3; i++ {
+		assert.Nil(t, redis.AddSSEBacklogEvent(streamID, database.SSEEvent{ID: i, Data: []byte(fmt.Sprintf(`{"event_id":%d}`, i))}))
+	}
+
+	hub := NewHub(redis, nil)
+	go hub.Run()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req := httptest.NewRequest("GET", "/?id="+streamID, nil).WithContext(ctx)
+	req.Header.Set("Last-Event-ID", "1")
+	w := httptest.NewRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		hub.ServeSSE(w, req)
+		close(done)
+	}()
+	// Already replayed, not sent twice
+	time.Sleep(20 * time.Millisecond)
+	hub.Broadcast <- BroadcastPayload{ID: streamID, EventID: 3, Message: []byte(`{"event_id":3}`)}
+	hub.Broadcast <- BroadcastPayload{ID: streamID, EventID: 4, Message: []byte(`{"event_id":4}`)}
+	<-done
+
+	body := w.Body.String()
+	assert.NotContains(t, body, `{"event_id":1}`)
+	assert.Contains(t, body, "id: 2\ndata: {\"event_id\":2}\n\n")
+	assert.Equal(t, 1, strings.Count(body, "id: 3\n"))
+	assert.Contains(t, body, "id: 4\ndata: {\"event_id\":4}\n\n")
+	assert.True(t, strings.Index(body, "id: 2\n") < strings.Index(body, "id: 4\n"))
+}
@@ -3,6 +3,7 @@ package sse
 import (
 	"encoding/json"
 
+	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database"
 	"github.com/stablecog/sc-go/database/repository"
 	"github.com/stablecog/sc-go/shared"
@@ -12,6 +13,7 @@ const ALL_CLIENTS_ID = "all"
 
 type BroadcastPayload struct {
 	ID      string `json:"id"`
+	EventID int64  `json:"event_id,omitempty"`
 	Message []byte `json:"message"`
 }
 
@@ -34,6 +36,9 @@ type Hub struct {
 	// Database access
 	Repo  *repository.Repository
 	Redis *database.RedisWrapper
+
+	// Cancels a job of a user, set to the same path the REST API cancels through so jobs are also taken off the queues
+	CancelJob func(processType shared.ProcessType, id uuid.UUID, userID uuid.UUID) error
 }
 
 func NewHub(redis *database.RedisWrapper, repo *repository.Repository) *Hub {
@@ -48,6 +53,7 @@ func NewHub(redis *database.RedisWrapper, repo *repository.Repository) *Hub {
 	}
 }
 
+// Clients that can't keep up are dropped, they replay what they missed when they reconnect
 func (h *Hub) Run() {
 	for {
 		select {
@@ -66,25 +72,26 @@ func (h *Hub) Run() {
 			keepaliveBytes, _ := json.Marshal(keepaliveMsg)
 			for client := range h.clients {
 				select {
-				case client.Send <- keepaliveBytes:
+				case client.Send <- Event{Data: keepaliveBytes}:
 				default:
 					close(client.Send)
 					delete(h.clients, client)
 				}
 			}
 		case payload := <-h.Broadcast:
+			event := Event{ID: payload.EventID, StreamID: payload.ID, Data: payload.Message}
 			for client := range h.clients {
 				if payload.ID == ALL_CLIENTS_ID {
 					select {
-					case client.Send <- payload.Message:
+					case client.Send <- event:
 					default:
 						close(client.Send)
 						delete(h.clients, client)
 					}
 					continue
-				} else if client.Uid == payload.ID {
+				} else if client.Subscribed(payload.ID) {
 					select {
-					case client.Send <- payload.Message:
+					case client.Send <- event:
 					default:
 						close(client.Send)
 						delete(h.clients, client)
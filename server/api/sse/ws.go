@@ -0,0 +1,288 @@
+package sse
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"golang.org/x/exp/slices"
+)
+
+// Messages websocket clients send
+const (
+	WSMessageSubscribe   = "subscribe"
+	WSMessageUnsubscribe = "unsubscribe"
+	WSMessageCancel      = "cancel"
+)
+
+type WSClientMessage struct {
+	Type string `json:"type"`
+	// Echoed back in the reply so clients can match it
+	RequestID string `json:"request_id,omitempty"`
+	// Subscribe/unsubscribe
+	StreamIDs []string `json:"stream_ids,omitempty"`
+	// Last event received per stream ID, event IDs are numbered per stream
+	LastEventIDs map[string]int64 `json:"last_event_ids,omitempty"`
+	// Cancel
+	ProcessType shared.ProcessType `json:"process_type,omitempty"`
+	ID          *uuid.UUID         `json:"id,omitempty"`
+}
+
+// Reply to a client message, status updates are sent the same as over SSE
+type WSReply struct {
+	MessageType string   `json:"message_type"`
+	Type        string   `json:"type"`
+	RequestID   string   `json:"request_id,omitempty"`
+	StreamIDs   []string `json:"stream_ids,omitempty"`
+	Error       string   `json:"error,omitempty"`
+}
+
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     wsCheckOrigin,
+}
+
+// Browsers may only connect from our frontends, other clients don't send an origin
+func wsCheckOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	return origin == "" || slices.Contains(utils.GetEnv().GetCorsOrigins(), origin)
+}
+
+// Who a websocket connection belongs to, set when it's upgraded
+type wsAuth struct {
+	UserID uuid.UUID
+	// Scopes of the API token the connection was opened with, nil for web users
+	ApiTokenScopes []string
+}
+
+// API tokens need the same scope to cancel a job as the REST cancel route
+func (a wsAuth) CanCancel(processType shared.ProcessType) bool {
+	return a.ApiTokenScopes == nil || slices.Contains(a.ApiTokenScopes, string(shared.ApiTokenScopeForProcessType(processType)))
+}
+
+// Handles websocket connections, the alternative to SSE for clients that want many streams on one connection
+// The user is authenticated by our middleware, they can cancel their jobs over it too
+func (h *Hub) ServeWS(w http.ResponseWriter, r *http.Request) {
+	userIDStr, _ := r.Context().Value("user_id").(string)
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		responses.ErrUnauthorized(w, r)
+		return
+	}
+	auth := wsAuth{UserID: userID}
+	if tokenID, _ := r.Context().Value("api_token_id").(string); tokenID != "" {
+		// Never nil for tokens, so a token without scopes in the context can't cancel anything
+		auth.ApiTokenScopes, _ = r.Context().Value("api_token_scopes").([]string)
+		if auth.ApiTokenScopes == nil {
+			auth.ApiTokenScopes = []string{}
+		}
+	}
+
+	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrader already responded
+		log.Error("Error upgrading websocket connection", "err", err)
+		return
+	}
+	defer conn.Close()
+
+	client := &Client{Send: make(chan Event, 256)}
+	h.Register <- client
+	defer func() {
+		h.Unregister <- client
+	}()
+
+	// Reads happen in their own goroutine, everything is written from this one
+	incoming := make(chan []byte)
+	done := make(chan struct{})
+	defer close(done)
+	go readWS(conn, incoming, done)
+
+	ping := time.NewTicker(shared.WS_PONG_TIMEOUT * 9 / 10)
+	defer ping.Stop()
+
+	version, err := json.Marshal(AppVersionMessage{Version: shared.APP_VERSION})
+	if err != nil {
+		log.Error("Error marshalling app version message", "err", err)
+		return
+	}
+	if writeWS(conn, websocket.TextMessage, version) != nil {
+		return
+	}
+
+	// Last event replayed per stream, so live events that were queued meanwhile aren't sent twice
+	replayed := make(map[string]int64)
+	for {
+		select {
+		case data, ok := <-incoming:
+			if !ok {
+				return
+			}
+			if h.handleWSMessage(conn, client, auth, data, replayed) != nil {
+				return
+			}
+		case message, ok := <-client.Send:
+			// Closed by the hub, the client reconnects and replays what it missed
+			if !ok {
+				return
+			}
+			if message.ID > 0 && message.ID <= replayed[message.StreamID] {
+				continue
+			}
+			if writeWS(conn, websocket.TextMessage, message.Data) != nil {
+				return
+			}
+		case <-ping.C:
+			if writeWS(conn, websocket.PingMessage, nil) != nil {
+				return
+			}
+		}
+	}
+}
+
+// Reads client messages until the connection is closed or stops answering pings
+func readWS(conn *websocket.Conn, incoming chan<- []byte, done <-chan struct{}) {
+	defer close(incoming)
+	conn.SetReadLimit(4096)
+	conn.SetReadDeadline(time.Now().Add(shared.WS_PONG_TIMEOUT))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(shared.WS_PONG_TIMEOUT))
+	})
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure, websocket.CloseNoStatusReceived) {
+				log.Error("Error reading websocket message", "err", err)
+			}
+			return
+		}
+		select {
+		case incoming <- data:
+		case <-done:
+			return
+		}
+	}
+}
+
+func writeWS(conn *websocket.Conn, messageType int, data []byte) error {
+	conn.SetWriteDeadline(time.Now().Add(shared.WS_WRITE_TIMEOUT))
+	return conn.WriteMessage(messageType, data)
+}
+
+// Handles a client message and replies to it, only returns an error if the connection is broken
+func (h *Hub) handleWSMessage(conn *websocket.Conn, client *Client, auth wsAuth, data []byte, replayed map[string]int64) error {
+	var msg WSClientMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return writeWSReply(conn, WSReply{Error: "invalid_json"})
+	}
+	reply := WSReply{Type: msg.Type, RequestID: msg.RequestID}
+
+	switch msg.Type {
+	case WSMessageSubscribe, WSMessageUnsubscribe:
+		if len(msg.StreamIDs) == 0 {
+			reply.Error = "stream_ids_required"
+			return writeWSReply(conn, reply)
+		}
+		streamIDs := make([]string, len(msg.StreamIDs))
+		for i, streamID := range msg.StreamIDs {
+			streamIDs[i] = strings.ToLower(streamID)
+			if !utils.IsSha256Hash(streamIDs[i]) && streamIDs[i] != LIVE_STREAM_ID {
+				reply.Error = "invalid_stream_id"
+				return writeWSReply(conn, reply)
+			}
+		}
+		reply.StreamIDs = streamIDs
+		if msg.Type == WSMessageUnsubscribe {
+			client.Unsubscribe(streamIDs...)
+			for _, streamID := range streamIDs {
+				delete(replayed, streamID)
+			}
+			return writeWSReply(conn, reply)
+		}
+		if client.NumSubscribed()+len(streamIDs) > shared.WS_MAX_SUBSCRIBED_STREAMS {
+			reply.Error = "too_many_streams"
+			return writeWSReply(conn, reply)
+		}
+		lastEventIDs := make(map[string]int64, len(msg.LastEventIDs))
+		for streamID, lastEventID := range msg.LastEventIDs {
+			streamID = strings.ToLower(streamID)
+			if lastEventID < 0 || !slices.Contains(streamIDs, streamID) {
+				reply.Error = "invalid_last_event_ids"
+				return writeWSReply(conn, reply)
+			}
+			lastEventIDs[streamID] = lastEventID
+		}
+		// Subscribed first so nothing published while we replay is lost
+		client.Subscribe(streamIDs...)
+		if err := writeWSReply(conn, reply); err != nil {
+			return err
+		}
+		for _, streamID := range streamIDs {
+			if streamID == LIVE_STREAM_ID || lastEventIDs[streamID] == 0 {
+				continue
+			}
+			events, err := h.Redis.GetSSEBacklogEvents(streamID, lastEventIDs[streamID])
+			if err != nil {
+				log.Error("Error getting sse backlog", "err", err, "stream_id", streamID)
+				continue
+			}
+			for _, event := range events {
+				if err := writeWS(conn, websocket.TextMessage, event.Data); err != nil {
+					return err
+				}
+				replayed[streamID] = event.ID
+			}
+		}
+		return nil
+	case WSMessageCancel:
+		if msg.ID == nil {
+			reply.Error = "id_required"
+			return writeWSReply(conn, reply)
+		}
+		if !slices.Contains([]shared.ProcessType{shared.GENERATE, shared.GENERATE_AND_UPSCALE, shared.UPSCALE, shared.VOICEOVER}, msg.ProcessType) {
+			reply.Error = "invalid_process_type"
+			return writeWSReply(conn, reply)
+		}
+		if !auth.CanCancel(msg.ProcessType) {
+			reply.Error = "insufficient_scope"
+			return writeWSReply(conn, reply)
+		}
+		err := h.CancelJob(msg.ProcessType, *msg.ID, auth.UserID)
+		if errors.Is(err, repository.JobNotCancellableErr) {
+			reply.Error = err.Error()
+		} else if ent.IsNotFound(err) {
+			reply.Error = "job_not_found"
+		} else if err != nil {
+			log.Error("Error cancelling job", "err", err, "id", *msg.ID)
+			reply.Error = "internal_error"
+		}
+		return writeWSReply(conn, reply)
+	default:
+		reply.Error = "invalid_type"
+		return writeWSReply(conn, reply)
+	}
+}
+
+func writeWSReply(conn *websocket.Conn, reply WSReply) error {
+	reply.MessageType = "ack"
+	if reply.Error != "" {
+		reply.MessageType = "error"
+	}
+	data, err := json.Marshal(reply)
+	if err != nil {
+		log.Error("Error marshalling websocket reply", "err", err)
+		return nil
+	}
+	return writeWS(conn, websocket.TextMessage, data)
+}
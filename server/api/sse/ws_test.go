@@ -0,0 +1,151 @@
+package sse
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/stablecog/sc-go/database"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func readWSMessage(t *testing.T, conn *websocket.Conn) string {
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, data, err := conn.ReadMessage()
+	assert.Nil(t, err)
+	return string(data)
+}
+
+func TestServeWS(t *testing.T) {
+	origMockRedis := utils.GetEnv().MockRedis
+	utils.GetEnv().MockRedis = true
+	defer func() {
+		utils.GetEnv().MockRedis = origMockRedis
+	}()
+	redis, err := database.NewRedis(context.TODO())
+	assert.Nil(t, err)
+
+	streamA := utils.Sha256("ws-a")
+	streamB := utils.Sha256("ws-b")
+	for i := int64(1); i <= 2; i++ {
+		assert.Nil(t, redis.AddSSEBacklogEvent(streamA, database.SSEEvent{ID: i, Data: []byte(fmt.Sprintf(`{"a":%d}`, i))}))
+	}
+	for i := int64(1); i <= 3; i++ {
+		assert.Nil(t, redis.AddSSEBacklogEvent(streamB, database.SSEEvent{ID: i, Data: []byte(fmt.Sprintf(`{"b":%d}`, i))}))
+	}
+
+	hub := NewHub(redis, nil)
+	var cancelled []uuid.UUID
+	hub.CancelJob = func(processType shared.ProcessType, id uuid.UUID, userID uuid.UUID) error {
+		if len(cancelled) > 0 {
+			return repository.JobNotCancellableErr
+		}
+		cancelled = append(cancelled, id)
+		return nil
+	}
+	go hub.Run()
+
+	// Auth middleware sets the user
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hub.ServeWS(w, r.WithContext(context.WithValue(r.Context(), "user_id", "00000000-0000-0000-0000-000000000001")))
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	assert.Contains(t, readWSMessage(t, conn), `"version"`)
+
+	// Bad requests get an error, the connection stays open
+	assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"subscribe","request_id":"1","stream_ids":["nope"]}`)))
+	assert.Equal(t, `{"message_type":"error","type":"subscribe","request_id":"1","error":"invalid_stream_id"}`, readWSMessage(t, conn))
+	assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"cancel","request_id":"2"}`)))
+	assert.Contains(t, readWSMessage(t, conn), `"error":"id_required"`)
+
+	// Cancels go through the hub's canceller
+	jobID := uuid.New()
+	assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"type":"cancel","process_type":"generate","id":"%s"}`, jobID))))
+	assert.Contains(t, readWSMessage(t, conn), `"message_type":"ack"`)
+	assert.Equal(t, []uuid.UUID{jobID}, cancelled)
+	assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"type":"cancel","process_type":"generate","id":"%s"}`, jobID))))
+	assert.Contains(t, readWSMessage(t, conn), fmt.Sprintf(`"error":"%s"`, repository.JobNotCancellableErr))
+
+	// Replay cursors must be for streams being subscribed to
+	assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"type":"subscribe","stream_ids":["%s"],"last_event_ids":{"%s":1}}`, streamA, streamB))))
+	assert.Contains(t, readWSMessage(t, conn), `"error":"invalid_last_event_ids"`)
+
+	// Many streams on one connection, missed events replayed from each stream's own last event
+	assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"type":"subscribe","stream_ids":["%s","%s"],"last_event_ids":{"%s":1,"%s":2}}`, streamA, streamB, streamA, streamB))))
+	assert.Contains(t, readWSMessage(t, conn), `"message_type":"ack"`)
+	assert.Equal(t, `{"a":2}`, readWSMessage(t, conn))
+	assert.Equal(t, `{"b":3}`, readWSMessage(t, conn))
+
+	// Already replayed, not sent twice
+	hub.Broadcast <- BroadcastPayload{ID: streamA, EventID: 2, Message: []byte(`{"a":2}`)}
+	hub.Broadcast <- BroadcastPayload{ID: utils.Sha256("other"), EventID: 1, Message: []byte(`{"other":1}`)}
+	hub.Broadcast <- BroadcastPayload{ID: streamB, EventID: 3, Message: []byte(`{"b":3}`)}
+	hub.Broadcast <- BroadcastPayload{ID: streamB, EventID: 4, Message: []byte(`{"b":4}`)}
+	hub.Broadcast <- BroadcastPayload{ID: streamA, EventID: 3, Message: []byte(`{"a":3}`)}
+	assert.Equal(t, `{"b":4}`, readWSMessage(t, conn))
+	assert.Equal(t, `{"a":3}`, readWSMessage(t, conn))
+
+	assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"type":"unsubscribe","stream_ids":["%s"]}`, streamA))))
+	assert.Contains(t, readWSMessage(t, conn), `"message_type":"ack"`)
+	hub.Broadcast <- BroadcastPayload{ID: streamA, EventID: 4, Message: []byte(`{"a":4}`)}
+	hub.Broadcast <- BroadcastPayload{ID: ALL_CLIENTS_ID, Message: []byte(`{"queue":1}`)}
+	assert.Equal(t, `{"queue":1}`, readWSMessage(t, conn))
+}
+
+func TestServeWSCancelNeedsScope(t *testing.T) {
+	origMockRedis := utils.GetEnv().MockRedis
+	utils.GetEnv().MockRedis = true
+	defer func() {
+		utils.GetEnv().MockRedis = origMockRedis
+	}()
+	redis, err := database.NewRedis(context.TODO())
+	assert.Nil(t, err)
+
+	hub := NewHub(redis, nil)
+	var cancelled []uuid.UUID
+	hub.CancelJob = func(processType shared.ProcessType, id uuid.UUID, userID uuid.UUID) error {
+		cancelled = append(cancelled, id)
+		return nil
+	}
+	go hub.Run()
+
+	// Auth middleware sets the user and the scopes of their token
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), "user_id", "00000000-0000-0000-0000-000000000001")
+		ctx = context.WithValue(ctx, "api_token_id", uuid.NewString())
+		ctx = context.WithValue(ctx, "api_token_scopes", []string{string(shared.ApiTokenScopeOutputsRead), string(shared.ApiTokenScopeImageUpscale)})
+		hub.ServeWS(w, r.WithContext(ctx))
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	assert.Contains(t, readWSMessage(t, conn), `"version"`)
+
+	// Can't cancel generations without image:generate
+	assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"type":"cancel","request_id":"1","process_type":"generate","id":"%s"}`, uuid.New()))))
+	assert.Equal(t, `{"message_type":"error","type":"cancel","request_id":"1","error":"insufficient_scope"}`, readWSMessage(t, conn))
+	assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"type":"cancel","process_type":"voiceover","id":"%s"}`, uuid.New()))))
+	assert.Contains(t, readWSMessage(t, conn), `"error":"insufficient_scope"`)
+	assert.Empty(t, cancelled)
+
+	// Upscales it can
+	jobID := uuid.New()
+	assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"type":"cancel","process_type":"upscale","id":"%s"}`, jobID))))
+	assert.Contains(t, readWSMessage(t, conn), `"message_type":"ack"`)
+	assert.Equal(t, []uuid.UUID{jobID}, cancelled)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hibiken/asynq"
 	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	chiprometheus "github.com/stablecog/chi-prometheus"
 	"github.com/stablecog/sc-go/database"
@@ -139,7 +140,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Create indexes in Qdrant
+	// Create indexes in Qdrant, existing collections get the ones they're missing
 	err = qdrantClient.CreateAllIndexes()
 	if err != nil {
 		log.Warn("Error creating qdrant indexes", "err", err)
@@ -996,6 +997,8 @@ func main() {
 	// Prometheus middleware
 	promMiddleware := chiprometheus.NewMiddleware("sc-server")
 	app.Use(promMiddleware)
+	queueDepth := scworker.NewQueueDepthCache(repo)
+	prometheus.MustRegister(scworker.NewQueueDepthCollector(queueDepth))
 
 	// Cors middleware
 	app.Use(cors.Handler(cors.Options{
@@ -1003,7 +1006,7 @@ func main() {
 		// AllowOriginFunc:  func(r *http.Request, origin string) bool { return true },
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"*"},
-		ExposedHeaders:   []string{"Link"},
+		ExposedHeaders:   []string{"Link", shared.IDEMPOTENT_REPLAYED_HEADER},
 		AllowCredentials: true,
 		MaxAge:           300, // Maximum value not ignored by any of major browsers
 	}))
@@ -1072,12 +1075,12 @@ func main() {
 	newSession := session.New(s3Config)
 	s3Client := s3.New(newSession)
 
-	// Setup rabbitmq client
-	rabbitmqClient, err := queue.NewRabbitMQClient(ctx, utils.GetEnv().RabbitMQAMQPUrl)
+	// Setup message queue client
+	mqClient, err := queue.NewMQClient(ctx, redis.Client)
 	if err != nil {
-		log.Fatalf("Error connecting to rabbitmq: %v", err)
+		log.Fatalf("Error connecting to message queue: %v", err)
 	}
-	defer rabbitmqClient.Close()
+	defer mqClient.Close()
 
 	// Setup asynq client
 	options := redis.Client.Options()
@@ -1109,16 +1112,25 @@ func main() {
 			Repo:           repo,
 			Redis:          redis,
 			QueueThrottler: qThrottler,
+			Scheduler:      shared.NewFairScheduler(ctx, redis.Client),
+			QueueDepth:     queueDepth,
+			StartRate:      scworker.NewJobStartRateCache(repo),
 			Track:          analyticsService,
 			SMap:           apiTokenSmap,
 			SafetyChecker:  safetyChecker,
 			S3Img:          s3ClientImg,
 			S3:             s3Client,
-			MQClient:       rabbitmqClient,
+			MQClient:       mqClient,
 			AsynqClient:    asynqClient,
 		},
 	}
 
+	// Websocket cancels go through the same path as the REST API's
+	sseHub.CancelJob = func(processType shared.ProcessType, id uuid.UUID, userID uuid.UUID) error {
+		_, err := hc.SCWorker.CancelJob(processType, id, userID)
+		return err
+	}
+
 	// Create upload controller
 	uploadHc := uapi.Controller{
 		Repo:  repo,
@@ -1131,7 +1143,9 @@ func main() {
 		SupabaseAuth: database.NewSupabaseAuth(),
 		Repo:         repo,
 		Redis:        redis,
+		UsageBuffer:  middleware.NewApiTokenUsageBuffer(repo),
 	}
+	go mw.UsageBuffer.Run(ctx)
 
 	if *transferUserData {
 		sourceID := uuid.MustParse(*sourceUser)
@@ -1226,6 +1240,23 @@ func main() {
 			})
 		})
 
+		// Websocket alternative to SSE
+		r.Route("/ws", func(r chi.Router) {
+			r.Use(mw.RateLimit(5, "srv", 1*time.Second))
+			r.Use(middleware.AccessTokenFromQuery)
+			r.Use(mw.AnyScopeAuthMiddleware(shared.ApiTokenJobScopes, middleware.AuthLevelUserOrAPIToken))
+			r.Get("/", sseHub.ServeWS)
+		})
+
+		// Queue position and ETA of a job, for web users and API tokens alike
+		r.Route("/queue/{job_id}", func(r chi.Router) {
+			r.Use(middleware.Logger)
+			r.Use(mw.IPRateLimit(middleware.RateLimitPolicyRead))
+			r.Use(mw.AnyScopeAuthMiddleware(shared.ApiTokenJobScopes, middleware.AuthLevelUserOrAPIToken))
+			r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyRead))
+			r.Get("/", hc.HandleGetQueueStatus)
+		})
+
 		// Stripe
 		r.Route("/stripe", func(r chi.Router) {
 			r.Use(middleware.Logger)
@@ -1255,6 +1286,9 @@ func main() {
 			// 20 requests per second
 			r.Use(mw.RateLimit(20, "srv", 1*time.Second))
 			r.Get("/", hc.HandleSemanticSearchGallery)
+			// More like an output, or an uploaded image
+			r.Get("/similar", hc.HandleSimilarGallery)
+			r.Post("/similar", hc.HandleSimilarGallery)
 		})
 
 		// User profiles
@@ -1269,10 +1303,10 @@ func main() {
 
 		// Routes that require authentication
 		r.Route("/user", func(r chi.Router) {
+			r.Use(mw.IPRateLimit(middleware.RateLimitPolicyUser))
 			r.Use(mw.AuthMiddleware(middleware.AuthLevelAny))
 			r.Use(middleware.Logger)
-			// 10 requests per second
-			r.Use(mw.RateLimit(10, "srv", 1*time.Second))
+			r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyUser))
 
 			// Get user summary
 			r.Get("/", hc.HandleGetUserV2)
@@ -1283,11 +1317,13 @@ func main() {
 			// Create Generation
 			r.Route("/image/generation/create", func(r chi.Router) {
 				r.Use(mw.AbuseProtectorMiddleware())
+				r.Use(mw.IdempotencyMiddleware())
 				r.Post("/", hc.HandleCreateGeneration)
 			})
 			// ! Deprecated
 			r.Route("/generation", func(r chi.Router) {
 				r.Use(mw.AbuseProtectorMiddleware())
+				r.Use(mw.IdempotencyMiddleware())
 				r.Post("/", hc.HandleCreateGeneration)
 			})
 			// Mark generation for deletion
@@ -1299,6 +1335,9 @@ func main() {
 			r.Get("/image/generation/outputs", hc.HandleQueryGenerations)
 			// ! Deprecated
 			r.Get("/outputs", hc.HandleQueryGenerations)
+			// Outputs like an output, or an uploaded image
+			r.Get("/image/generation/outputs/similar", hc.HandleSimilarHistory)
+			r.Post("/image/generation/outputs/similar", hc.HandleSimilarHistory)
 
 			// Favorite
 			r.Post("/image/generation/outputs/favorite", hc.HandleFavoriteGenerationOutputsForUser)
@@ -1309,19 +1348,35 @@ func main() {
 			r.Post("/like", hc.HandleLikeGenerationOutputsForUser)
 
 			// Create upscale
-			r.Post("/image/upscale/create", hc.HandleUpscale)
+			r.Route("/image/upscale/create", func(r chi.Router) {
+				r.Use(mw.IdempotencyMiddleware())
+				r.Post("/", hc.HandleUpscale)
+			})
 			// ! Deprecated
-			r.Post("/upscale", hc.HandleUpscale)
+			r.Route("/upscale", func(r chi.Router) {
+				r.Use(mw.IdempotencyMiddleware())
+				r.Post("/", hc.HandleUpscale)
+			})
 
 			// Create voiceover
-			r.Post("/audio/voiceover/create", hc.HandleVoiceover)
+			r.Route("/audio/voiceover/create", func(r chi.Router) {
+				r.Use(mw.IdempotencyMiddleware())
+				r.Post("/", hc.HandleVoiceover)
+			})
 
 			// Query voiceover outputs
 			r.Get("/audio/voiceover/outputs", hc.HandleQueryVoiceovers)
 			r.Delete("/audio/voiceover", hc.HandleDeleteVoiceoverOutputForUser)
 
+			// Cancel jobs
+			r.Post("/image/generation/{id}/cancel", hc.HandleCancelGenerationJob)
+			r.Post("/image/upscale/{id}/cancel", hc.HandleCancelUpscaleJob)
+			r.Post("/audio/voiceover/{id}/cancel", hc.HandleCancelVoiceoverJob)
+
 			// Query credits
 			r.Get("/credits", hc.HandleQueryCredits)
+			// Credit ledger, as JSON pages or a CSV export
+			r.Get("/credits/history", hc.HandleQueryCreditHistory)
 
 			// ! Deprecated Submit to gallery
 			r.Put("/gallery", hc.HandleSubmitGenerationToGallery)
@@ -1341,6 +1396,15 @@ func main() {
 			r.Post("/tokens", hc.HandleNewAPIToken)
 			r.Get("/tokens", hc.HandleGetAPITokens)
 			r.Delete("/tokens", hc.HandleDeactivateAPIToken)
+			r.Post("/tokens/{id}/rotate", hc.HandleRotateAPIToken)
+			r.Get("/tokens/{id}/usage", hc.HandleGetAPITokenUsage)
+
+			// Generation presets
+			r.Get("/presets", hc.HandleGetGenerationPresets)
+			r.Post("/presets", hc.HandleCreateGenerationPreset)
+			r.Get("/presets/{id}", hc.HandleGetGenerationPreset)
+			r.Put("/presets/{id}", hc.HandleUpdateGenerationPreset)
+			r.Delete("/presets/{id}", hc.HandleDeleteGenerationPreset)
 
 			// Operations
 			r.Get("/operations", hc.HandleQueryOperations)
@@ -1398,6 +1462,26 @@ func main() {
 				r.Get("/status", hc.HandleSystemStatus)
 				r.Post("/change-backend", hc.HandleSystemChangeBackend)
 			})
+			r.Route("/queue", func(r chi.Router) {
+				r.Use(mw.AuthMiddleware(middleware.AuthLevelSuperAdmin))
+				r.Use(middleware.Logger)
+				r.Get("/dead-letter", hc.HandleQueryDeadLetters)
+				r.Get("/dead-letter/{id}", hc.HandleGetDeadLetter)
+				r.Post("/dead-letter/{id}/replay", hc.HandleReplayDeadLetter)
+			})
+			r.Route("/cron", func(r chi.Router) {
+				r.Use(mw.AuthMiddleware(middleware.AuthLevelSuperAdmin))
+				r.Use(middleware.Logger)
+				r.Get("/jobs", hc.HandleGetCronJobs)
+				r.Post("/jobs/{name}/run", hc.HandleTriggerCronJob)
+				r.Get("/runs", hc.HandleQueryCronJobRuns)
+			})
+			r.Route("/moderation", func(r chi.Router) {
+				r.Use(mw.AuthMiddleware(middleware.AuthLevelSuperAdmin))
+				r.Use(middleware.Logger)
+				r.Get("/verdicts", hc.HandleQueryModerationVerdicts)
+				r.Post("/verdicts/{id}/review", hc.HandleReviewModerationVerdict)
+			})
 		})
 
 		// For API tokens
@@ -1405,37 +1489,93 @@ func main() {
 			// txt2img/img2img
 			r.Route("/generation/create", func(r chi.Router) {
 				r.Route("/", func(r chi.Router) {
-					r.Use(mw.AuthMiddleware(middleware.AuthLevelAPIToken))
+					r.Use(mw.IPRateLimit(middleware.RateLimitPolicyCreate))
+					r.Use(mw.SpendingAuthMiddleware(shared.ApiTokenScopeImageGenerate, middleware.AuthLevelAPIToken))
+					r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyCreate))
 					r.Use(middleware.Logger)
 					r.Use(mw.AbuseProtectorMiddleware())
-					r.Use(mw.RateLimit(5, "api", 1*time.Second))
+					r.Use(mw.IdempotencyMiddleware())
 					r.Post("/", hc.HandleCreateGenerationToken)
 				})
 			})
+			// Batch of generations with credits reserved up front
+			r.Route("/generation/batch", func(r chi.Router) {
+				r.Route("/", func(r chi.Router) {
+					r.Use(mw.IPRateLimit(middleware.RateLimitPolicyBatch))
+					r.Use(mw.SpendingAuthMiddleware(shared.ApiTokenScopeImageGenerate, middleware.AuthLevelAPIToken))
+					r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyBatch))
+					r.Use(middleware.Logger)
+					r.Use(mw.AbuseProtectorMiddleware())
+					r.Use(mw.IdempotencyMiddleware())
+					r.Post("/", hc.HandleCreateGenerationBatch)
+				})
+				// Progress of a batch
+				r.Route("/{id}", func(r chi.Router) {
+					r.Use(middleware.Logger)
+					r.Use(mw.IPRateLimit(middleware.RateLimitPolicyRead))
+					r.Use(mw.ScopedAuthMiddleware(shared.ApiTokenScopeImageGenerate, middleware.AuthLevelAPIToken))
+					r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyRead))
+					r.Get("/", hc.HandleGetGenerationBatch)
+				})
+			})
+			// Variations of an output, created as a batch
+			r.Route("/generation/variations", func(r chi.Router) {
+				r.Use(mw.IPRateLimit(middleware.RateLimitPolicyBatch))
+				r.Use(mw.SpendingAuthMiddleware(shared.ApiTokenScopeImageGenerate, middleware.AuthLevelAPIToken))
+				r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyBatch))
+				r.Use(middleware.Logger)
+				r.Use(mw.AbuseProtectorMiddleware())
+				r.Use(mw.IdempotencyMiddleware())
+				r.Post("/", hc.HandleCreateGenerationVariations)
+			})
+			// Status of a generation, for async requests
+			r.Route("/generation/{id}", func(r chi.Router) {
+				r.Use(middleware.Logger)
+				r.Use(mw.IPRateLimit(middleware.RateLimitPolicyRead))
+				r.Use(mw.ScopedAuthMiddleware(shared.ApiTokenScopeImageGenerate, middleware.AuthLevelAPIToken))
+				r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyRead))
+				r.Get("/", hc.HandleGetGenerationJob)
+				r.Post("/cancel", hc.HandleCancelGenerationJob)
+			})
 			// ! Deprecated
 			r.Route("/generate", func(r chi.Router) {
 				r.Route("/", func(r chi.Router) {
-					r.Use(mw.AuthMiddleware(middleware.AuthLevelAPIToken))
+					r.Use(mw.IPRateLimit(middleware.RateLimitPolicyCreate))
+					r.Use(mw.SpendingAuthMiddleware(shared.ApiTokenScopeImageGenerate, middleware.AuthLevelAPIToken))
+					r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyCreate))
 					r.Use(middleware.Logger)
-					r.Use(mw.RateLimit(5, "api", 1*time.Second))
+					r.Use(mw.IdempotencyMiddleware())
 					r.Post("/", hc.HandleCreateGenerationToken)
 				})
 			})
 
 			r.Route("/upscale/create", func(r chi.Router) {
 				r.Route("/", func(r chi.Router) {
-					r.Use(mw.AuthMiddleware(middleware.AuthLevelAPIToken))
+					r.Use(mw.IPRateLimit(middleware.RateLimitPolicyCreate))
+					r.Use(mw.SpendingAuthMiddleware(shared.ApiTokenScopeImageUpscale, middleware.AuthLevelAPIToken))
+					r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyCreate))
 					r.Use(middleware.Logger)
-					r.Use(mw.RateLimit(5, "api", 1*time.Second))
+					r.Use(mw.IdempotencyMiddleware())
 					r.Post("/", hc.HandleCreateUpscaleToken)
 				})
 			})
+			// Status of an upscale, for async requests
+			r.Route("/upscale/{id}", func(r chi.Router) {
+				r.Use(middleware.Logger)
+				r.Use(mw.IPRateLimit(middleware.RateLimitPolicyRead))
+				r.Use(mw.ScopedAuthMiddleware(shared.ApiTokenScopeImageUpscale, middleware.AuthLevelAPIToken))
+				r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyRead))
+				r.Get("/", hc.HandleGetUpscaleJob)
+				r.Post("/cancel", hc.HandleCancelUpscaleJob)
+			})
 			// ! Deprecated
 			r.Route("/upscale", func(r chi.Router) {
 				r.Route("/", func(r chi.Router) {
-					r.Use(mw.AuthMiddleware(middleware.AuthLevelAPIToken))
+					r.Use(mw.IPRateLimit(middleware.RateLimitPolicyCreate))
+					r.Use(mw.SpendingAuthMiddleware(shared.ApiTokenScopeImageUpscale, middleware.AuthLevelAPIToken))
+					r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyCreate))
 					r.Use(middleware.Logger)
-					r.Use(mw.RateLimit(5, "api", 1*time.Second))
+					r.Use(mw.IdempotencyMiddleware())
 					r.Post("/", hc.HandleCreateUpscaleToken)
 				})
 			})
@@ -1482,23 +1622,28 @@ func main() {
 			// upload
 			r.Route("/upload", func(r chi.Router) {
 				r.Use(middleware.Logger)
-				r.Use(mw.RateLimit(2, "uapi", 1*time.Second))
-				r.Use(mw.AuthMiddleware(middleware.AuthLevelAPIToken))
+				r.Use(mw.IPRateLimit(middleware.RateLimitPolicyUpload))
+				r.Use(mw.ScopedAuthMiddleware(shared.ApiTokenScopeImageGenerate, middleware.AuthLevelAPIToken))
+				r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyUpload))
 				r.Post("/", uploadHc.HandleUpload)
 			})
 
 			// Querying user outputs
 			r.Route("/generation/outputs", func(r chi.Router) {
 				r.Use(middleware.Logger)
-				r.Use(mw.RateLimit(10, "api", 1*time.Second))
-				r.Use(mw.AuthMiddleware(middleware.AuthLevelAPIToken))
+				r.Use(mw.IPRateLimit(middleware.RateLimitPolicyRead))
+				r.Use(mw.ScopedAuthMiddleware(shared.ApiTokenScopeOutputsRead, middleware.AuthLevelAPIToken))
+				r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyRead))
 				r.Get("/", hc.HandleQueryGenerations)
+				r.Get("/similar", hc.HandleSimilarHistory)
+				r.Post("/similar", hc.HandleSimilarHistory)
 			})
 			// ! Deprecated
 			r.Route("/outputs", func(r chi.Router) {
 				r.Use(middleware.Logger)
-				r.Use(mw.RateLimit(10, "api", 1*time.Second))
-				r.Use(mw.AuthMiddleware(middleware.AuthLevelAPIToken))
+				r.Use(mw.IPRateLimit(middleware.RateLimitPolicyRead))
+				r.Use(mw.ScopedAuthMiddleware(shared.ApiTokenScopeOutputsRead, middleware.AuthLevelAPIToken))
+				r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyRead))
 				r.Get("/", hc.HandleQueryGenerations)
 			})
 		})
@@ -1506,18 +1651,30 @@ func main() {
 		r.Route("/audio", func(r chi.Router) {
 			r.Route("/voiceover/create", func(r chi.Router) {
 				r.Route("/", func(r chi.Router) {
-					r.Use(mw.AuthMiddleware(middleware.AuthLevelAPIToken))
+					r.Use(mw.IPRateLimit(middleware.RateLimitPolicyCreate))
+					r.Use(mw.SpendingAuthMiddleware(shared.ApiTokenScopeAudioVoiceover, middleware.AuthLevelAPIToken))
+					r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyCreate))
 					r.Use(middleware.Logger)
-					r.Use(mw.RateLimit(5, "api", 1*time.Second))
+					r.Use(mw.IdempotencyMiddleware())
 					r.Post("/", hc.HandleCreateVoiceoverToken)
 				})
 			})
+			// Status of a voiceover, for async requests
+			r.Route("/voiceover/{id}", func(r chi.Router) {
+				r.Use(middleware.Logger)
+				r.Use(mw.IPRateLimit(middleware.RateLimitPolicyRead))
+				r.Use(mw.ScopedAuthMiddleware(shared.ApiTokenScopeAudioVoiceover, middleware.AuthLevelAPIToken))
+				r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyRead))
+				r.Get("/", hc.HandleGetVoiceoverJob)
+				r.Post("/cancel", hc.HandleCancelVoiceoverJob)
+			})
 
 			// Querying user outputs
 			r.Route("/voiceover/outputs", func(r chi.Router) {
 				r.Use(middleware.Logger)
-				r.Use(mw.RateLimit(10, "api", 1*time.Second))
-				r.Use(mw.AuthMiddleware(middleware.AuthLevelAPIToken))
+				r.Use(mw.IPRateLimit(middleware.RateLimitPolicyRead))
+				r.Use(mw.ScopedAuthMiddleware(shared.ApiTokenScopeOutputsRead, middleware.AuthLevelAPIToken))
+				r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyRead))
 				r.Get("/", hc.HandleQueryVoiceovers)
 			})
 
@@ -1536,8 +1693,9 @@ func main() {
 
 		r.Route("/credits", func(r chi.Router) {
 			r.Use(middleware.Logger)
-			r.Use(mw.RateLimit(10, "api", 1*time.Second))
-			r.Use(mw.AuthMiddleware(middleware.AuthLevelAPIToken))
+			r.Use(mw.IPRateLimit(middleware.RateLimitPolicyRead))
+			r.Use(mw.ScopedAuthMiddleware(shared.ApiTokenScopeCreditsRead, middleware.AuthLevelAPIToken))
+			r.Use(mw.PolicyRateLimit(middleware.RateLimitPolicyRead))
 			r.Get("/", hc.HandleQueryCredits)
 		})
 	})
@@ -1606,6 +1764,9 @@ func main() {
 		}
 	}()
 
+	// Pick up generation batches that were still being queued when we last shut down
+	go hc.SCWorker.ResumeGenerationBatches(hc.Clip)
+
 	// Start server
 	port := utils.GetEnv().Port
 	log.Info("Starting server", "port", port)
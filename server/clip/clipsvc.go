@@ -48,6 +48,17 @@ func NewClipService(redis *database.RedisWrapper, safetyChecker *translator.Tran
 	return svc
 }
 
+// Same service against another CLIP API, e.g. one serving a new model
+func (c *ClipService) WithURL(apiUrl string) *ClipService {
+	svc := *c
+	svc.apiUrl = apiUrl
+	svc.client = &http.Client{
+		Timeout:   c.client.Timeout,
+		Transport: &svc,
+	}
+	return &svc
+}
+
 // GetEmbeddingFromText, retry up to retries times
 func (c *ClipService) GetEmbeddingFromText(text string, translate bool) (embedding []float32, err error) {
 	// Translate text
@@ -119,6 +130,15 @@ func (c *ClipService) GetEmbeddingFromText(text string, translate bool) (embeddi
 	return embed, nil
 }
 
+// GetEmbeddingFromImage, image is a URL the CLIP API can download
+func (c *ClipService) GetEmbeddingFromImage(imageUrl string) (embedding []float32, err error) {
+	res, err := c.GetEmbeddings([]EmbeddingReqObject{{Image: imageUrl}})
+	if err != nil {
+		return nil, err
+	}
+	return res[0].Embedding, nil
+}
+
 func (c *ClipService) GetEmbeddings(toEmbedObjects []EmbeddingReqObject) (embeddings []EmbeddingResObject, err error) {
 	s := time.Now()
 	var req []requests.ClipApiEmbeddingRequest = []requests.ClipApiEmbeddingRequest{}
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/shared"
+)
+
+// Buffers api token usage entries so they're written in bulk instead of once per request
+type ApiTokenUsageBuffer struct {
+	Repo    *repository.Repository
+	mu      sync.Mutex
+	pending []repository.NewApiTokenUsage
+	full    chan struct{}
+}
+
+func NewApiTokenUsageBuffer(repo *repository.Repository) *ApiTokenUsageBuffer {
+	return &ApiTokenUsageBuffer{
+		Repo: repo,
+		full: make(chan struct{}, 1),
+	}
+}
+
+// Queue an entry, it's written on the next flush
+func (b *ApiTokenUsageBuffer) Add(tokenID uuid.UUID, ip string, endpoint string) uuid.UUID {
+	id := uuid.New()
+	b.mu.Lock()
+	b.pending = append(b.pending, repository.NewApiTokenUsage{
+		ID:         id,
+		ApiTokenID: tokenID,
+		IP:         ip,
+		Endpoint:   endpoint,
+		CreatedAt:  time.Now(),
+	})
+	full := len(b.pending) >= shared.API_TOKEN_USAGE_BATCH_SIZE
+	b.mu.Unlock()
+	if full {
+		select {
+		case b.full <- struct{}{}:
+		default:
+		}
+	}
+	return id
+}
+
+// Write everything that's buffered
+func (b *ApiTokenUsageBuffer) Flush() error {
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = nil
+	b.mu.Unlock()
+	return b.Repo.CreateApiTokenUsageBulk(pending)
+}
+
+// Flush every interval or when the buffer fills up, until ctx is done
+func (b *ApiTokenUsageBuffer) Run(ctx context.Context) {
+	ticker := time.NewTicker(shared.API_TOKEN_USAGE_FLUSH_INTERVAL)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := b.Flush(); err != nil {
+				log.Error("Error writing api token usage", "err", err)
+			}
+			return
+		case <-ticker.C:
+		case <-b.full:
+		}
+		if err := b.Flush(); err != nil {
+			log.Error("Error writing api token usage", "err", err)
+		}
+	}
+}
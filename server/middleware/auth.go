@@ -3,12 +3,14 @@ package middleware
 import (
 	"context"
 	"crypto/subtle"
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/repository"
 	"github.com/stablecog/sc-go/log"
 	"github.com/stablecog/sc-go/server/responses"
 	"github.com/stablecog/sc-go/shared"
@@ -24,10 +26,49 @@ const (
 	AuthLevelSuperAdmin
 	AuthLevelAPIToken
 	AuthLevelOptional
+	// Users with a bearer token or API tokens
+	AuthLevelUserOrAPIToken
 )
 
+// Browsers can't set headers on websocket connections, they send the token as the access_token query parameter
+// It's moved to the Authorization header and out of the URL, so it doesn't end up in logs
+func AccessTokenFromQuery(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if token := query.Get("access_token"); token != "" {
+			if r.Header.Get("Authorization") == "" {
+				r.Header.Set("Authorization", "Bearer "+token)
+			}
+			query.Del("access_token")
+			r.URL.RawQuery = query.Encode()
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 // Enforces authorization at specific level
+// Routes without a scope, including every admin route, only accept API tokens that have all scopes
 func (m *Middleware) AuthMiddleware(levels ...AuthLevel) func(next http.Handler) http.Handler {
+	return m.authMiddleware(nil, false, levels...)
+}
+
+// Enforces authorization at specific level, API tokens must also have scope and not be expired
+func (m *Middleware) ScopedAuthMiddleware(scope shared.ApiTokenScope, levels ...AuthLevel) func(next http.Handler) http.Handler {
+	return m.authMiddleware([]shared.ApiTokenScope{scope}, false, levels...)
+}
+
+// Like ScopedAuthMiddleware, for routes that create jobs, API tokens must also have some of their monthly credit cap left
+// Everything else, e.g. cancelling a job which gives credits back, is allowed for tokens at their cap
+func (m *Middleware) SpendingAuthMiddleware(scope shared.ApiTokenScope, levels ...AuthLevel) func(next http.Handler) http.Handler {
+	return m.authMiddleware([]shared.ApiTokenScope{scope}, true, levels...)
+}
+
+// Like ScopedAuthMiddleware, for routes API tokens can use with any one of scopes
+func (m *Middleware) AnyScopeAuthMiddleware(scopes []shared.ApiTokenScope, levels ...AuthLevel) func(next http.Handler) http.Handler {
+	return m.authMiddleware(scopes, false, levels...)
+}
+
+func (m *Middleware) authMiddleware(scopes []shared.ApiTokenScope, spends bool, levels ...AuthLevel) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Copy the levels array
@@ -60,6 +101,11 @@ func (m *Middleware) AuthMiddleware(levels ...AuthLevel) func(next http.Handler)
 					levelsCopy = append(levelsCopy, AuthLevelAPIToken)
 				}
 			}
+			if slices.Contains(levelsCopy, AuthLevelUserOrAPIToken) {
+				if strings.HasPrefix(authHeader[1], "sc-") && len(authHeader[1]) == 67 {
+					levelsCopy = append(levelsCopy, AuthLevelAPIToken)
+				}
+			}
 			if slices.Contains(levelsCopy, AuthLevelGalleryAdmin) || slices.Contains(levelsCopy, AuthLevelSuperAdmin) {
 				if strings.HasPrefix(authHeader[1], "sc-") && len(authHeader[1]) == 67 {
 					levelsCopy = append(levelsCopy, AuthLevelAPIToken)
@@ -79,6 +125,20 @@ func (m *Middleware) AuthMiddleware(levels ...AuthLevel) func(next http.Handler)
 					return
 				}
 
+				if repository.ApiTokenExpired(token) {
+					responses.ErrUnauthorized(w, r)
+					return
+				}
+				if !apiTokenHasAnyScope(token, scopes) {
+					responses.ErrForbiddenWithReason(w, r, "insufficient_scope")
+					return
+				}
+				// The exact cost of a job is checked when it's created
+				if spends && !repository.ApiTokenCanSpend(token, 1) {
+					responses.ErrForbiddenWithReason(w, r, "token_credit_cap_reached")
+					return
+				}
+
 				user, err := m.Repo.GetUser(token.UserID)
 				if err != nil {
 					log.Error("Error getting user", "err", err)
@@ -117,6 +177,25 @@ func (m *Middleware) AuthMiddleware(levels ...AuthLevel) func(next http.Handler)
 				email = user.Email
 				lastSignIn = user.LastSignInAt
 				ctx = context.WithValue(ctx, "api_token_id", token.ID.String())
+				// For handlers that check scopes per message, like the websocket
+				tokenScopes := token.Scopes
+				if tokenScopes == nil {
+					for _, s := range shared.ApiTokenScopes {
+						tokenScopes = append(tokenScopes, string(s))
+					}
+				}
+				ctx = context.WithValue(ctx, "api_token_scopes", tokenScopes)
+
+				// Audit trail, a failure to record it shouldn't fail the request
+				// Reads don't spend credits, so they don't need the entry to exist yet
+				endpoint := fmt.Sprintf("%s %s", r.Method, r.URL.Path)
+				if m.UsageBuffer != nil && r.Method == http.MethodGet {
+					m.UsageBuffer.Add(token.ID, ip, endpoint)
+				} else if usage, err := m.Repo.CreateApiTokenUsage(token.ID, ip, endpoint); err != nil {
+					log.Error("Error creating api token usage", "err", err)
+				} else {
+					ctx = context.WithValue(ctx, "api_token_usage_id", usage.ID.String())
+				}
 			} else {
 				// Check supabase to see if it's all good
 				userId, email, lastSignIn, err = m.SupabaseAuth.GetSupabaseUserIdFromAccessToken(authHeader[1])
@@ -205,6 +284,19 @@ func (m *Middleware) AuthMiddleware(levels ...AuthLevel) func(next http.Handler)
 	}
 }
 
+// Tokens restricted to some scopes can't use routes that don't declare any
+func apiTokenHasAnyScope(token *ent.ApiToken, scopes []shared.ApiTokenScope) bool {
+	if len(scopes) == 0 {
+		return repository.ApiTokenHasAllScopes(token)
+	}
+	for _, scope := range scopes {
+		if repository.ApiTokenHasScope(token, scope) {
+			return true
+		}
+	}
+	return false
+}
+
 // BasicAuth is a wrapper for Handler that requires username and password
 func BasicAuth(next http.Handler, username, password, realm string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
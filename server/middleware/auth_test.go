@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApiTokenHasAnyScope(t *testing.T) {
+	readOnly := &ent.ApiToken{Scopes: []string{string(shared.ApiTokenScopeOutputsRead)}}
+	generate := &ent.ApiToken{Scopes: []string{string(shared.ApiTokenScopeImageGenerate)}}
+	all := &ent.ApiToken{}
+	for _, scope := range shared.ApiTokenScopes {
+		all.Scopes = append(all.Scopes, string(scope))
+	}
+	legacy := &ent.ApiToken{}
+
+	// Routes without scopes, e.g. admin routes, only take unrestricted tokens
+	assert.False(t, apiTokenHasAnyScope(readOnly, nil))
+	assert.False(t, apiTokenHasAnyScope(generate, nil))
+	assert.True(t, apiTokenHasAnyScope(all, nil))
+	assert.True(t, apiTokenHasAnyScope(legacy, nil))
+
+	assert.True(t, apiTokenHasAnyScope(readOnly, []shared.ApiTokenScope{shared.ApiTokenScopeOutputsRead}))
+	assert.False(t, apiTokenHasAnyScope(readOnly, shared.ApiTokenJobScopes))
+	assert.True(t, apiTokenHasAnyScope(generate, shared.ApiTokenJobScopes))
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/stablecog/sc-go/database"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// Makes create requests safe to retry, must come after auth
+// The first response for an Idempotency-Key is stored per user and replayed for later requests with the same key,
+// reusing a key with a different request is a conflict. Unsuccessful requests release the key since they didn't create anything
+func (m *Middleware) IdempotencyMiddleware() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(shared.IDEMPOTENCY_KEY_HEADER)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > shared.MAX_IDEMPOTENCY_KEY_LENGTH {
+				responses.ErrBadRequest(w, r, "invalid_idempotency_key", "")
+				return
+			}
+			userID, _ := r.Context().Value("user_id").(string)
+			if userID == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				responses.ErrUnableToParseJson(w, r)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			requestHash := utils.Sha256(r.Method + " " + r.URL.Path + "\n" + string(body))
+
+			existing, err := m.Redis.ClaimIdempotencyKey(userID, key, requestHash)
+			if err != nil {
+				// Don't turn requests away because of redis
+				log.Error("Error claiming idempotency key", "err", err, "user_id", userID)
+				next.ServeHTTP(w, r)
+				return
+			}
+			if existing != nil {
+				if existing.BodyHash != requestHash {
+					responses.ErrConflict(w, r, "idempotency_key_reused")
+					return
+				}
+				if existing.StatusCode == 0 {
+					responses.ErrConflict(w, r, "idempotency_key_in_progress")
+					return
+				}
+				if existing.ContentType != "" {
+					w.Header().Set("Content-Type", existing.ContentType)
+				}
+				w.Header().Set(shared.IDEMPOTENT_REPLAYED_HEADER, "true")
+				w.WriteHeader(existing.StatusCode)
+				w.Write(existing.Body)
+				return
+			}
+
+			ww := NewWrapResponseWriter(w, r.ProtoMajor)
+			var resp bytes.Buffer
+			ww.Tee(&resp)
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if status < 200 || status >= 300 {
+				if err := m.Redis.DeleteIdempotencyKey(userID, key); err != nil {
+					log.Error("Error releasing idempotency key", "err", err, "user_id", userID)
+				}
+				return
+			}
+			err = m.Redis.SetIdempotentResponse(userID, key, database.IdempotentRequest{
+				BodyHash:    requestHash,
+				StatusCode:  status,
+				ContentType: ww.Header().Get("Content-Type"),
+				Body:        resp.Bytes(),
+			})
+			if err != nil {
+				log.Error("Error storing idempotent response", "err", err, "user_id", userID)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/stablecog/sc-go/database"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func idempotencyRouter(t *testing.T, calls *int, status *int) (http.Handler, *database.RedisWrapper) {
+	mr := miniredis.RunT(t)
+	rw := &database.RedisWrapper{
+		Client: redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+		Ctx:    context.Background(),
+	}
+	m := &Middleware{Redis: rw}
+
+	r := chi.NewRouter()
+	r.Use(m.IdempotencyMiddleware())
+	r.Post("/", func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(*status)
+		w.Write([]byte(`{"id":"job"}`))
+	})
+	return r, rw
+}
+
+func idempotentRequest(h http.Handler, userID string, key string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(shared.IDEMPOTENCY_KEY_HEADER, key)
+	}
+	req = req.WithContext(context.WithValue(req.Context(), "user_id", userID))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotencyReplaysResponse(t *testing.T) {
+	calls, status := 0, http.StatusOK
+	h, _ := idempotencyRouter(t, &calls, &status)
+
+	w := idempotentRequest(h, "user", "key", `{"prompt":"cat"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "", w.Header().Get(shared.IDEMPOTENT_REPLAYED_HEADER))
+
+	w = idempotentRequest(h, "user", "key", `{"prompt":"cat"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"id":"job"}`, w.Body.String())
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(t, "true", w.Header().Get(shared.IDEMPOTENT_REPLAYED_HEADER))
+	assert.Equal(t, 1, calls)
+
+	// Keys are per user
+	idempotentRequest(h, "other", "key", `{"prompt":"cat"}`)
+	assert.Equal(t, 2, calls)
+
+	// No key, no idempotency
+	idempotentRequest(h, "user", "", `{"prompt":"cat"}`)
+	idempotentRequest(h, "user", "", `{"prompt":"cat"}`)
+	assert.Equal(t, 4, calls)
+}
+
+func TestIdempotencyConflicts(t *testing.T) {
+	calls, status := 0, http.StatusOK
+	h, rw := idempotencyRouter(t, &calls, &status)
+
+	idempotentRequest(h, "user", "key", `{"prompt":"cat"}`)
+	w := idempotentRequest(h, "user", "key", `{"prompt":"dog"}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "idempotency_key_reused")
+	assert.Equal(t, 1, calls)
+
+	// Original request still running
+	existing, err := rw.ClaimIdempotencyKey("user", "running", utils.Sha256("POST /\n"+`{"prompt":"cat"}`))
+	assert.Nil(t, err)
+	assert.Nil(t, existing)
+	w = idempotentRequest(h, "user", "running", `{"prompt":"cat"}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "idempotency_key_in_progress")
+
+	w = idempotentRequest(h, "user", strings.Repeat("k", shared.MAX_IDEMPOTENCY_KEY_LENGTH+1), `{"prompt":"cat"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, 1, calls)
+}
+
+func TestIdempotencyReleasesKeyOnFailure(t *testing.T) {
+	calls, status := 0, http.StatusBadRequest
+	h, _ := idempotencyRouter(t, &calls, &status)
+
+	w := idempotentRequest(h, "user", "key", `{"prompt":"cat"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	status = http.StatusOK
+	w = idempotentRequest(h, "user", "key", `{"prompt":"cat"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "", w.Header().Get(shared.IDEMPOTENT_REPLAYED_HEADER))
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotencyClaimTTL(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rw := &database.RedisWrapper{
+		Client: redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+		Ctx:    context.Background(),
+	}
+
+	// In progress claims lapse about when the request would have timed out
+	existing, err := rw.ClaimIdempotencyKey("user", "key", "hash")
+	assert.Nil(t, err)
+	assert.Nil(t, existing)
+	assert.Equal(t, shared.IDEMPOTENCY_CLAIM_TTL, mr.TTL("idempotency:user:key"))
+
+	mr.FastForward(shared.IDEMPOTENCY_CLAIM_TTL)
+	existing, err = rw.ClaimIdempotencyKey("user", "key", "hash")
+	assert.Nil(t, err)
+	assert.Nil(t, existing)
+
+	// Responses are kept for replay
+	assert.Nil(t, rw.SetIdempotentResponse("user", "key", database.IdempotentRequest{BodyHash: "hash", StatusCode: http.StatusOK}))
+	assert.Equal(t, shared.IDEMPOTENCY_KEY_TTL, mr.TTL("idempotency:user:key"))
+}
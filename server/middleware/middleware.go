@@ -11,4 +11,6 @@ type Middleware struct {
 	Repo         *repository.Repository
 	Redis        *database.RedisWrapper
 	Track        *analytics.AnalyticsService
+	// Usage for reads is written through this when set
+	UsageBuffer *ApiTokenUsageBuffer
 }
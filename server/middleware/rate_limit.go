@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"fmt"
+	"math"
 	"net/http"
 	"strconv"
 	"time"
@@ -10,6 +11,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"github.com/stablecog/sc-go/database"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/responses"
 	"github.com/stablecog/sc-go/shared"
 	"github.com/stablecog/sc-go/utils"
 )
@@ -47,6 +50,142 @@ func (m *Middleware) RateLimit(requestLimit int, customKey string, windowLength
 	)
 }
 
+// Budget of requests per window for each subscription plan
+type RateLimitPolicy struct {
+	// Separates the counters of different policies
+	Name     string
+	Window   time.Duration
+	Free     int
+	Starter  int
+	Pro      int
+	Ultimate int
+	// Budget per IP before authenticating, users behind a shared IP share it so it's well above any plan's
+	IP int
+}
+
+var (
+	RateLimitPolicyUser   = RateLimitPolicy{Name: "user", Window: time.Second, Free: 10, Starter: 15, Pro: 20, Ultimate: 30, IP: 60}
+	RateLimitPolicyCreate = RateLimitPolicy{Name: "create", Window: time.Second, Free: 5, Starter: 10, Pro: 15, Ultimate: 20, IP: 40}
+	RateLimitPolicyBatch  = RateLimitPolicy{Name: "batch", Window: time.Second, Free: 1, Starter: 2, Pro: 3, Ultimate: 5, IP: 10}
+	RateLimitPolicyRead   = RateLimitPolicy{Name: "read", Window: time.Second, Free: 10, Starter: 20, Pro: 30, Ultimate: 40, IP: 80}
+	RateLimitPolicyUpload = RateLimitPolicy{Name: "upload", Window: time.Second, Free: 2, Starter: 4, Pro: 6, Ultimate: 8, IP: 16}
+)
+
+// Subscriptions we don't know the plan of get the starter budget
+func (p RateLimitPolicy) LimitForProduct(productID string) int {
+	switch productID {
+	case "":
+		return p.Free
+	case utils.GetEnv().StripeUltimateProductID:
+		return p.Ultimate
+	case utils.GetEnv().StripeProProductID:
+		return p.Pro
+	default:
+		return p.Starter
+	}
+}
+
+// KEYS[1] current window counter, KEYS[2] previous window counter
+// ARGV limit, weight of the previous window, counter TTL in ms
+// Counts the request only if it's within the limit, returns whether it was and the rate before it
+var slidingWindowScript = redis.NewScript(`
+local curr = tonumber(redis.call("GET", KEYS[1]) or "0")
+local prev = tonumber(redis.call("GET", KEYS[2]) or "0")
+local rate = math.floor(prev * tonumber(ARGV[2]) + curr + 0.5)
+if rate + 1 > tonumber(ARGV[1]) then
+	return {0, rate}
+end
+redis.call("INCR", KEYS[1])
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+return {1, rate}
+`)
+
+// Sliding window, same as httprate
+// Returns whether the request is allowed, the rate before it and seconds until the window resets
+func (m *Middleware) slidingWindowAllow(key string, limit int, window time.Duration) (allowed bool, rate int, reset int, err error) {
+	now := time.Now().UTC()
+	currentWindow := now.Truncate(window)
+	previousWindow := currentWindow.Add(-window)
+	elapsed := now.Sub(currentWindow)
+	reset = int(math.Ceil((window - elapsed).Seconds()))
+	weight := float64(window-elapsed) / float64(window)
+
+	res, err := slidingWindowScript.Run(m.Redis.Ctx, m.Redis.Client,
+		[]string{limitCounterKey(key, currentWindow), limitCounterKey(key, previousWindow)},
+		limit, strconv.FormatFloat(weight, 'f', -1, 64), (window * 3).Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return false, 0, reset, err
+	}
+	return res[0] == 1, int(res[1]), reset, nil
+}
+
+// Rate limit by IP at the policy's IP budget, runs before auth so unauthenticated floods don't reach it
+// Fails open if redis is unavailable
+func (m *Middleware) IPRateLimit(policy RateLimitPolicy) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := fmt.Sprintf("rl:%s:preauth:%s", policy.Name, utils.GetIPAddress(r))
+			allowed, _, reset, err := m.slidingWindowAllow(key, policy.IP, policy.Window)
+			if err != nil {
+				log.Error("Error checking ip rate limit, allowing request", "err", err)
+			} else if !allowed {
+				w.Header().Set("Retry-After", strconv.Itoa(reset))
+				responses.ErrTooManyRequests(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Rate limit keyed by API token, or user if the request wasn't made with one, so users behind a shared IP don't share a budget
+// Must run after AuthMiddleware, unauthenticated requests are limited by IP at the free budget
+// Sets the RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers, plus Retry-After when the limit is hit
+// Fails open if redis is unavailable
+func (m *Middleware) PolicyRateLimit(policy RateLimitPolicy) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var key string
+			userId, _ := r.Context().Value("user_id").(string)
+			if tokenId, ok := r.Context().Value("api_token_id").(string); ok && tokenId != "" {
+				key = fmt.Sprintf("rl:%s:token:%s", policy.Name, tokenId)
+			} else if userId != "" {
+				key = fmt.Sprintf("rl:%s:user:%s", policy.Name, userId)
+			} else {
+				key = fmt.Sprintf("rl:%s:ip:%s", policy.Name, utils.GetIPAddress(r))
+			}
+			if parsed, err := uuid.Parse(userId); err == nil && shared.GetCache().IsAdmin(parsed) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			productId, _ := r.Context().Value("user_active_product_id").(string)
+			limit := policy.LimitForProduct(productId)
+
+			allowed, rate, reset, err := m.slidingWindowAllow(key, limit, policy.Window)
+			if err != nil {
+				log.Error("Error checking rate limit, allowing request", "err", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("RateLimit-Limit", strconv.Itoa(limit))
+			w.Header().Set("RateLimit-Reset", strconv.Itoa(reset))
+
+			if !allowed {
+				w.Header().Set("RateLimit-Remaining", "0")
+				w.Header().Set("Retry-After", strconv.Itoa(reset))
+				responses.ErrTooManyRequests(w, r)
+				return
+			}
+			w.Header().Set("RateLimit-Remaining", strconv.Itoa(limit-rate-1))
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 type redisCounter struct {
 	redis        *database.RedisWrapper
 	windowLength time.Duration
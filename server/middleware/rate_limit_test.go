@@ -0,0 +1,152 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/stablecog/sc-go/database"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var testRateLimitPolicy = RateLimitPolicy{Name: "test", Window: time.Minute, Free: 2, Starter: 3, Pro: 4, Ultimate: 5, IP: 6}
+
+func rateLimitRouter(t *testing.T) http.Handler {
+	h, _ := rateLimitRouterWithRedis(t)
+	return h
+}
+
+func rateLimitRouterWithRedis(t *testing.T) (http.Handler, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	rw := &database.RedisWrapper{
+		Client: redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+		Ctx:    context.Background(),
+	}
+	m := &Middleware{Redis: rw}
+
+	r := chi.NewRouter()
+	r.Use(m.IPRateLimit(testRateLimitPolicy))
+	r.Use(m.PolicyRateLimit(testRateLimitPolicy))
+	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return r, mr
+}
+
+func rateLimitedRequest(h http.Handler, ctxValues map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("GET", "/", nil)
+	ctx := req.Context()
+	for k, v := range ctxValues {
+		ctx = context.WithValue(ctx, k, v)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req.WithContext(ctx))
+	return w
+}
+
+func TestPolicyRateLimitHeaders(t *testing.T) {
+	h := rateLimitRouter(t)
+	user := map[string]string{"user_id": "c0b3a3c1-0b0b-4f8c-9c2b-4b4b4b4b4b4b"}
+
+	w := rateLimitedRequest(h, user)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "2", w.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "1", w.Header().Get("RateLimit-Remaining"))
+	reset, err := strconv.Atoi(w.Header().Get("RateLimit-Reset"))
+	assert.Nil(t, err)
+	assert.True(t, reset > 0 && reset <= 60)
+	assert.Equal(t, "", w.Header().Get("Retry-After"))
+
+	w = rateLimitedRequest(h, user)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+
+	w = rateLimitedRequest(h, user)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+	assert.NotEqual(t, "", w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), "rate_limit_exceeded")
+}
+
+func TestPolicyRateLimitKeys(t *testing.T) {
+	h := rateLimitRouter(t)
+	user := map[string]string{"user_id": "c0b3a3c1-0b0b-4f8c-9c2b-4b4b4b4b4b4b"}
+	token := map[string]string{"user_id": user["user_id"], "api_token_id": "token-1"}
+	otherToken := map[string]string{"user_id": user["user_id"], "api_token_id": "token-2"}
+
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, http.StatusOK, rateLimitedRequest(h, token).Code)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, rateLimitedRequest(h, token).Code)
+
+	// Every token and the user's own session have their own budget
+	assert.Equal(t, http.StatusOK, rateLimitedRequest(h, otherToken).Code)
+	assert.Equal(t, http.StatusOK, rateLimitedRequest(h, user).Code)
+}
+
+func TestPolicyRateLimitPlans(t *testing.T) {
+	h := rateLimitRouter(t)
+	pro := map[string]string{"user_id": "c0b3a3c1-0b0b-4f8c-9c2b-4b4b4b4b4b4b", "user_active_product_id": utils.GetEnv().StripeProProductID}
+
+	for i := 0; i < 4; i++ {
+		w := rateLimitedRequest(h, pro)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "4", w.Header().Get("RateLimit-Limit"))
+	}
+	assert.Equal(t, http.StatusTooManyRequests, rateLimitedRequest(h, pro).Code)
+
+	assert.Equal(t, 2, testRateLimitPolicy.LimitForProduct(""))
+	assert.Equal(t, 3, testRateLimitPolicy.LimitForProduct(utils.GetEnv().StripeStarterProductID))
+	assert.Equal(t, 3, testRateLimitPolicy.LimitForProduct("prod_unknown"))
+	assert.Equal(t, 5, testRateLimitPolicy.LimitForProduct(utils.GetEnv().StripeUltimateProductID))
+}
+
+func TestIPRateLimitBeforeAuth(t *testing.T) {
+	h := rateLimitRouter(t)
+
+	// Every request from the IP counts, whoever it authenticates as
+	for i := 0; i < testRateLimitPolicy.IP; i++ {
+		token := map[string]string{"user_id": "c0b3a3c1-0b0b-4f8c-9c2b-4b4b4b4b4b4b", "api_token_id": fmt.Sprintf("token-%d", i)}
+		assert.Equal(t, http.StatusOK, rateLimitedRequest(h, token).Code)
+	}
+	w := rateLimitedRequest(h, map[string]string{"api_token_id": "token-new"})
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NotEqual(t, "", w.Header().Get("Retry-After"))
+}
+
+func TestPolicyRateLimitConcurrent(t *testing.T) {
+	h := rateLimitRouter(t)
+	user := map[string]string{"user_id": "c0b3a3c1-0b0b-4f8c-9c2b-4b4b4b4b4b4b"}
+
+	var wg sync.WaitGroup
+	var allowed atomic.Int32
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if rateLimitedRequest(h, user).Code == http.StatusOK {
+				allowed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(testRateLimitPolicy.Free), allowed.Load())
+}
+
+func TestPolicyRateLimitFailsOpen(t *testing.T) {
+	h, mr := rateLimitRouterWithRedis(t)
+	mr.Close()
+
+	w := rateLimitedRequest(h, map[string]string{"user_id": "c0b3a3c1-0b0b-4f8c-9c2b-4b4b4b4b4b4b"})
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,247 @@
+package moderation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/shared"
+)
+
+// Names of the checkers, what policy rules match on
+const (
+	CheckerKeyword         = "keyword"
+	CheckerBannedEmbedding = "banned_embedding"
+	CheckerModerationAPI   = "moderation_api"
+	CheckerClassifier      = "classifier"
+)
+
+// Prompts a checker looks at, the translation too if it differs
+func prompts(input Input) []string {
+	if input.TranslatedPrompt == "" || input.TranslatedPrompt == input.Prompt {
+		return []string{input.Prompt}
+	}
+	return []string{input.Prompt, input.TranslatedPrompt}
+}
+
+// Matches banned words from the cache
+type KeywordChecker struct {
+	// Defaults to the cached banned words
+	BannedWords func() []*ent.BannedWords
+}
+
+func (c *KeywordChecker) Name() string {
+	return CheckerKeyword
+}
+
+func (c *KeywordChecker) Check(ctx context.Context, input Input) ([]enttypes.ModerationFinding, error) {
+	getBannedWords := c.BannedWords
+	if getBannedWords == nil {
+		getBannedWords = shared.GetCache().BannedWords
+	}
+	bannedWords := getBannedWords()
+
+	var findings []enttypes.ModerationFinding
+	for _, bw := range bannedWords {
+		if len(bw.Words) == 0 {
+			continue
+		}
+		for _, prompt := range prompts(input) {
+			if !matchesBannedWords(strings.ToLower(prompt), bw) {
+				continue
+			}
+			category := bw.Reason
+			if category == "" {
+				category = "banned_word"
+			}
+			findings = append(findings, enttypes.ModerationFinding{
+				Checker:  CheckerKeyword,
+				Category: category,
+				Score:    1,
+				Match:    strings.Join(bw.Words, " "),
+			})
+			break
+		}
+	}
+	return findings, nil
+}
+
+// Every word has to be in the prompt, as a whole word if split match
+func matchesBannedWords(prompt string, bw *ent.BannedWords) bool {
+	var tokens map[string]bool
+	if bw.SplitMatch {
+		tokens = make(map[string]bool)
+		for _, token := range strings.FieldsFunc(prompt, func(r rune) bool {
+			return !(r == '\'' || r == '-' || (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r > 127)
+		}) {
+			tokens[token] = true
+		}
+	}
+	for _, word := range bw.Words {
+		word = strings.ToLower(word)
+		if bw.SplitMatch && !tokens[word] {
+			return false
+		}
+		if !bw.SplitMatch && !strings.Contains(prompt, word) {
+			return false
+		}
+	}
+	return true
+}
+
+type Embedder interface {
+	GetEmbeddingFromText(text string, translate bool) ([]float32, error)
+}
+
+type BannedPromptMatcher interface {
+	IsBannedPromptEmbedding(embedding []float32, DB *ent.Client) ([]repository.MatchBannedPrompts, error)
+}
+
+// Compares the prompt's embedding to banned prompts
+type EmbeddingChecker struct {
+	Embedder Embedder
+	Matcher  BannedPromptMatcher
+}
+
+func (c *EmbeddingChecker) Name() string {
+	return CheckerBannedEmbedding
+}
+
+func (c *EmbeddingChecker) Check(ctx context.Context, input Input) ([]enttypes.ModerationFinding, error) {
+	prompt := input.TranslatedPrompt
+	if prompt == "" {
+		prompt = input.Prompt
+	}
+	embedding, err := c.Embedder.GetEmbeddingFromText(prompt, false)
+	if err != nil {
+		return nil, fmt.Errorf("getting embedding: %w", err)
+	}
+	// Without an embedding the prompt can't be checked, but a failed match query isn't held against it
+	matches, err := c.Matcher.IsBannedPromptEmbedding(embedding, nil)
+	if err != nil {
+		log.Error("Error matching banned prompts", "err", err)
+		return nil, nil
+	}
+
+	var findings []enttypes.ModerationFinding
+	for _, match := range matches {
+		if match.ID == uuid.Nil {
+			continue
+		}
+		findings = append(findings, enttypes.ModerationFinding{
+			Checker:   CheckerBannedEmbedding,
+			Category:  "banned_prompt",
+			Score:     float64(match.Similarity),
+			Match:     match.ID.String(),
+			BanWorthy: match.ShouldBanUser,
+		})
+	}
+	return findings, nil
+}
+
+type NSFWDetector interface {
+	IsPromptNSFW(input string) (isNsfw bool, nsfwReason string, score float32, err error)
+}
+
+// Asks the moderation API about the prompt
+type ModerationAPIChecker struct {
+	API NSFWDetector
+}
+
+func (c *ModerationAPIChecker) Name() string {
+	return CheckerModerationAPI
+}
+
+func (c *ModerationAPIChecker) Check(ctx context.Context, input Input) ([]enttypes.ModerationFinding, error) {
+	prompt := input.TranslatedPrompt
+	if prompt == "" {
+		prompt = input.Prompt
+	}
+	isNsfw, reason, score, err := c.API.IsPromptNSFW(prompt)
+	if err != nil {
+		return nil, err
+	}
+	if !isNsfw {
+		return nil, nil
+	}
+	return []enttypes.ModerationFinding{{
+		Checker:  CheckerModerationAPI,
+		Category: reason,
+		Score:    float64(score),
+	}}, nil
+}
+
+// Scores the prompt with a classifier we host, it takes {"text": ...} and returns {"scores": {category: score}}
+type ClassifierChecker struct {
+	URL    string
+	Client *http.Client
+}
+
+type classifierRequest struct {
+	Text string `json:"text"`
+}
+
+type classifierResponse struct {
+	Scores map[string]float64 `json:"scores"`
+}
+
+func (c *ClassifierChecker) Name() string {
+	return CheckerClassifier
+}
+
+func (c *ClassifierChecker) Check(ctx context.Context, input Input) ([]enttypes.ModerationFinding, error) {
+	prompt := input.TranslatedPrompt
+	if prompt == "" {
+		prompt = input.Prompt
+	}
+	body, err := json.Marshal(classifierRequest{Text: prompt})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("classifier returned status %d", resp.StatusCode)
+	}
+	var classified classifierResponse
+	if err := json.NewDecoder(resp.Body).Decode(&classified); err != nil {
+		return nil, err
+	}
+
+	var findings []enttypes.ModerationFinding
+	for category, score := range classified.Scores {
+		if score < shared.MODERATION_CLASSIFIER_MIN_SCORE {
+			continue
+		}
+		findings = append(findings, enttypes.ModerationFinding{
+			Checker:  CheckerClassifier,
+			Category: category,
+			Score:    score,
+		})
+	}
+	sort.Slice(findings, func(i, j int) bool {
+		return findings[i].Category < findings[j].Category
+	})
+	return findings, nil
+}
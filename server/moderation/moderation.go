@@ -0,0 +1,122 @@
+// Decides whether a prompt is allowed, by running it through checkers and the policy of its source and tier
+package moderation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/shared"
+)
+
+// What to do with a prompt, in order of severity
+type Action string
+
+const (
+	ActionAllow Action = "allow"
+	// Allowed, but worth a look
+	ActionFlag  Action = "flag"
+	ActionBlock Action = "block"
+	// Blocked and the user banned
+	ActionBan Action = "ban"
+)
+
+func (a Action) severity() int {
+	switch a {
+	case ActionFlag:
+		return 1
+	case ActionBlock:
+		return 2
+	case ActionBan:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// A prompt to moderate and who sent it
+type Input struct {
+	UserID        uuid.UUID
+	UserCreatedAt time.Time
+	Source        enttypes.SourceType
+	Tier          shared.QueueTier
+	Prompt        string
+	// English prompt, what the checkers look at
+	TranslatedPrompt string
+}
+
+func (i Input) isNewAccount() bool {
+	return time.Since(i.UserCreatedAt) < shared.MODERATION_NEW_ACCOUNT_AGE
+}
+
+// Looks for one kind of problem in prompts
+type Checker interface {
+	Name() string
+	// Findings in the prompt, none if it's fine
+	Check(ctx context.Context, input Input) ([]enttypes.ModerationFinding, error)
+}
+
+type Verdict struct {
+	Action Action
+	// Why the prompt was blocked, shown to the user
+	Reason string
+	// Name of the policy that decided
+	Policy   string
+	Findings []enttypes.ModerationFinding
+	// Finding that decided the action, nil if none did
+	Decisive *enttypes.ModerationFinding
+	// Checkers that failed
+	Errors []string
+	// Names of the checkers that failed
+	failed []string
+}
+
+// Blocked because checkers failed rather than anything they found
+func (v *Verdict) Unavailable() bool {
+	return v.Decisive == nil && v.Action.severity() >= ActionBlock.severity()
+}
+
+type Pipeline struct {
+	Checkers []Checker
+	Policies []Policy
+}
+
+func NewPipeline(checkers []Checker, policies []Policy) *Pipeline {
+	return &Pipeline{
+		Checkers: checkers,
+		Policies: policies,
+	}
+}
+
+// Run every checker on the prompt in parallel and apply the policy for its source and tier
+func (p *Pipeline) Moderate(ctx context.Context, input Input) *Verdict {
+	findings := make([][]enttypes.ModerationFinding, len(p.Checkers))
+	errs := make([]error, len(p.Checkers))
+	var wg sync.WaitGroup
+	for i, checker := range p.Checkers {
+		wg.Add(1)
+		go func(i int, checker Checker) {
+			defer wg.Done()
+			findings[i], errs[i] = checker.Check(ctx, input)
+		}(i, checker)
+	}
+	wg.Wait()
+
+	verdict := &Verdict{}
+	for i, checker := range p.Checkers {
+		if errs[i] != nil {
+			log.Error("Error checking prompt", "checker", checker.Name(), "err", errs[i])
+			verdict.Errors = append(verdict.Errors, checker.Name()+": "+errs[i].Error())
+			verdict.failed = append(verdict.failed, checker.Name())
+			continue
+		}
+		verdict.Findings = append(verdict.Findings, findings[i]...)
+	}
+
+	policy := SelectPolicy(p.Policies, input.Source, input.Tier)
+	policy.Apply(input, verdict)
+	return verdict
+}
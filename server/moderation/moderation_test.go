@@ -0,0 +1,251 @@
+package moderation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeChecker struct {
+	name     string
+	findings []enttypes.ModerationFinding
+	err      error
+}
+
+func (c *fakeChecker) Name() string {
+	return c.name
+}
+
+func (c *fakeChecker) Check(ctx context.Context, input Input) ([]enttypes.ModerationFinding, error) {
+	return c.findings, c.err
+}
+
+func testInput(accountAge time.Duration) Input {
+	return Input{
+		UserID:        uuid.New(),
+		UserCreatedAt: time.Now().Add(-accountAge),
+		Source:        enttypes.SourceTypeWebUI,
+		Tier:          shared.QueueTierFree,
+		Prompt:        "a prompt",
+	}
+}
+
+func TestModerateAllowsCleanPrompts(t *testing.T) {
+	pipeline := NewPipeline([]Checker{
+		&fakeChecker{name: CheckerKeyword},
+		&fakeChecker{name: CheckerModerationAPI},
+	}, DefaultPolicies)
+
+	verdict := pipeline.Moderate(context.Background(), testInput(time.Hour))
+	assert.Equal(t, ActionAllow, verdict.Action)
+	assert.Equal(t, "default", verdict.Policy)
+	assert.Nil(t, verdict.Decisive)
+	assert.False(t, verdict.Unavailable())
+}
+
+func TestModerateBansNewAccountsOnly(t *testing.T) {
+	pipeline := NewPipeline([]Checker{
+		&fakeChecker{name: CheckerBannedEmbedding, findings: []enttypes.ModerationFinding{
+			{Checker: CheckerBannedEmbedding, Category: "banned_prompt", Score: 0.9, BanWorthy: true},
+		}},
+	}, DefaultPolicies)
+
+	verdict := pipeline.Moderate(context.Background(), testInput(time.Hour))
+	assert.Equal(t, ActionBan, verdict.Action)
+	assert.Equal(t, "banned_prompt", verdict.Reason)
+
+	verdict = pipeline.Moderate(context.Background(), testInput(48*time.Hour))
+	assert.Equal(t, ActionBlock, verdict.Action)
+	assert.Equal(t, "sexual_minors", verdict.Reason)
+	assert.NotNil(t, verdict.Decisive)
+}
+
+func TestModerateMostSevereWins(t *testing.T) {
+	pipeline := NewPipeline([]Checker{
+		&fakeChecker{name: CheckerClassifier, findings: []enttypes.ModerationFinding{
+			{Checker: CheckerClassifier, Category: "violence", Score: 0.9},
+		}},
+		&fakeChecker{name: CheckerModerationAPI, findings: []enttypes.ModerationFinding{
+			{Checker: CheckerModerationAPI, Category: "sexual", Score: 0.7},
+		}},
+	}, DefaultPolicies)
+
+	verdict := pipeline.Moderate(context.Background(), testInput(time.Hour))
+	assert.Equal(t, ActionBlock, verdict.Action)
+	assert.Equal(t, "sexual", verdict.Reason)
+	assert.Len(t, verdict.Findings, 2)
+	assert.Equal(t, CheckerModerationAPI, verdict.Decisive.Checker)
+}
+
+func TestModerateCheckerErrors(t *testing.T) {
+	pipeline := NewPipeline([]Checker{
+		&fakeChecker{name: CheckerModerationAPI, err: errors.New("timeout")},
+		&fakeChecker{name: CheckerClassifier, findings: []enttypes.ModerationFinding{
+			{Checker: CheckerClassifier, Category: "violence", Score: 0.9},
+		}},
+	}, DefaultPolicies)
+
+	verdict := pipeline.Moderate(context.Background(), testInput(time.Hour))
+	assert.Equal(t, ActionBlock, verdict.Action)
+	assert.Equal(t, []string{"moderation_api: timeout"}, verdict.Errors)
+	assert.True(t, verdict.Unavailable())
+
+	// Fail open for some
+	pipeline.Policies = []Policy{{Name: "lenient", Rules: DefaultPolicies[0].Rules, OnError: ActionAllow}}
+	verdict = pipeline.Moderate(context.Background(), testInput(time.Hour))
+	assert.Equal(t, ActionFlag, verdict.Action)
+	assert.False(t, verdict.Unavailable())
+}
+
+func TestModerateCheckerOnError(t *testing.T) {
+	// The classifier fails open by default
+	pipeline := NewPipeline([]Checker{
+		&fakeChecker{name: CheckerKeyword},
+		&fakeChecker{name: CheckerClassifier, err: errors.New("timeout")},
+	}, DefaultPolicies)
+	verdict := pipeline.Moderate(context.Background(), testInput(time.Hour))
+	assert.Equal(t, ActionAllow, verdict.Action)
+	assert.Len(t, verdict.Errors, 1)
+	assert.False(t, verdict.Unavailable())
+
+	// Policies can say otherwise per checker
+	var policies []Policy
+	err := json.Unmarshal([]byte(`[
+		{"name": "strict", "rules": [], "on_error": "allow", "checker_on_error": {"classifier": "block"}}
+	]`), &policies)
+	assert.Nil(t, err)
+	pipeline.Policies = policies
+	verdict = pipeline.Moderate(context.Background(), testInput(time.Hour))
+	assert.Equal(t, ActionBlock, verdict.Action)
+	assert.True(t, verdict.Unavailable())
+}
+
+func TestSelectPolicy(t *testing.T) {
+	var policies []Policy
+	err := json.Unmarshal([]byte(`[
+		{"name": "api_paid", "sources": ["api"], "tiers": ["paid"], "rules": [{"checker": "keyword", "action": "flag"}], "on_error": "allow"},
+		{"name": "default", "rules": [{"checker": "keyword", "action": "block"}], "on_error": "block"}
+	]`), &policies)
+	assert.Nil(t, err)
+
+	policy := SelectPolicy(policies, enttypes.SourceTypeAPI, shared.QueueTierPaid)
+	assert.Equal(t, "api_paid", policy.Name)
+	assert.Equal(t, ActionFlag, policy.Rules[0].Action)
+	assert.Equal(t, "default", SelectPolicy(policies, enttypes.SourceTypeWebUI, shared.QueueTierPaid).Name)
+	assert.Equal(t, "default", SelectPolicy(policies, enttypes.SourceTypeAPI, shared.QueueTierFree).Name)
+
+	// Fails closed without a catch-all
+	policy = SelectPolicy(policies[:1], enttypes.SourceTypeWebUI, shared.QueueTierFree)
+	assert.Equal(t, "none", policy.Name)
+	verdict := &Verdict{Findings: []enttypes.ModerationFinding{{Checker: CheckerKeyword, Category: "x"}}}
+	policy.Apply(testInput(time.Hour), verdict)
+	assert.Equal(t, ActionBlock, verdict.Action)
+}
+
+func TestKeywordChecker(t *testing.T) {
+	checker := &KeywordChecker{BannedWords: func() []*ent.BannedWords {
+		return []*ent.BannedWords{
+			{Words: []string{"bad", "word"}, Reason: "bad_words", SplitMatch: true},
+			{Words: []string{"forbidden"}},
+		}
+	}}
+
+	findings, err := checker.Check(context.Background(), Input{Prompt: "A bad, bad word"})
+	assert.Nil(t, err)
+	assert.Len(t, findings, 1)
+	assert.Equal(t, "bad_words", findings[0].Category)
+
+	// Split match needs whole words
+	findings, _ = checker.Check(context.Background(), Input{Prompt: "badly worded"})
+	assert.Len(t, findings, 0)
+
+	findings, _ = checker.Check(context.Background(), Input{Prompt: "un mot", TranslatedPrompt: "unforbiddenness"})
+	assert.Len(t, findings, 1)
+	assert.Equal(t, "banned_word", findings[0].Category)
+}
+
+type fakeEmbedder struct {
+	err error
+}
+
+func (e fakeEmbedder) GetEmbeddingFromText(text string, translate bool) ([]float32, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	return []float32{1, 2, 3}, nil
+}
+
+type fakeMatcher struct {
+	matches []repository.MatchBannedPrompts
+	err     error
+}
+
+func (m fakeMatcher) IsBannedPromptEmbedding(embedding []float32, DB *ent.Client) ([]repository.MatchBannedPrompts, error) {
+	return m.matches, m.err
+}
+
+func TestEmbeddingChecker(t *testing.T) {
+	id := uuid.New()
+	checker := &EmbeddingChecker{Embedder: fakeEmbedder{}, Matcher: fakeMatcher{matches: []repository.MatchBannedPrompts{
+		{ID: id, Similarity: 0.8, ShouldBanUser: true},
+		{ID: uuid.Nil},
+	}}}
+	findings, err := checker.Check(context.Background(), Input{Prompt: "a prompt"})
+	assert.Nil(t, err)
+	assert.Len(t, findings, 1)
+	assert.Equal(t, id.String(), findings[0].Match)
+	assert.InDelta(t, 0.8, findings[0].Score, 0.0001)
+	assert.True(t, findings[0].BanWorthy)
+}
+
+func TestEmbeddingCheckerClipDown(t *testing.T) {
+	// Prompts aren't let through unchecked when CLIP is down
+	pipeline := NewPipeline([]Checker{
+		&fakeChecker{name: CheckerKeyword},
+		&EmbeddingChecker{Embedder: fakeEmbedder{err: errors.New("clip down")}, Matcher: fakeMatcher{}},
+	}, DefaultPolicies)
+	verdict := pipeline.Moderate(context.Background(), testInput(time.Hour))
+	assert.Equal(t, ActionBlock, verdict.Action)
+	assert.Len(t, verdict.Errors, 1)
+	assert.True(t, verdict.Unavailable())
+
+	// A failed match query fails open
+	pipeline.Checkers[1] = &EmbeddingChecker{Embedder: fakeEmbedder{}, Matcher: fakeMatcher{err: errors.New("db down")}}
+	verdict = pipeline.Moderate(context.Background(), testInput(time.Hour))
+	assert.Equal(t, ActionAllow, verdict.Action)
+	assert.Len(t, verdict.Errors, 0)
+}
+
+func TestClassifierChecker(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req classifierRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Text != "translated" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"scores": {"violence": 0.9, "hate": 0.1, "sexual": 0.6}}`))
+	}))
+	defer srv.Close()
+
+	checker := &ClassifierChecker{URL: srv.URL}
+	findings, err := checker.Check(context.Background(), Input{Prompt: "original", TranslatedPrompt: "translated"})
+	assert.Nil(t, err)
+	assert.Len(t, findings, 2)
+	assert.Equal(t, "sexual", findings[0].Category)
+	assert.Equal(t, "violence", findings[1].Category)
+
+	_, err = checker.Check(context.Background(), Input{Prompt: "original"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,144 @@
+package moderation
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// Matches findings and says what to do about them, empty fields match anything
+type Rule struct {
+	Checker  string  `json:"checker,omitempty"`
+	Category string  `json:"category,omitempty"`
+	MinScore float64 `json:"min_score,omitempty"`
+	// Only findings that warrant a ban
+	BanWorthy bool `json:"ban_worthy,omitempty"`
+	// Only users whose account is younger than MODERATION_NEW_ACCOUNT_AGE
+	NewAccountsOnly bool   `json:"new_accounts_only,omitempty"`
+	Action          Action `json:"action"`
+	// Given to the user when blocked, the finding's category if empty
+	Reason string `json:"reason,omitempty"`
+}
+
+func (r Rule) matches(input Input, finding enttypes.ModerationFinding) bool {
+	return (r.Checker == "" || r.Checker == finding.Checker) &&
+		(r.Category == "" || r.Category == finding.Category) &&
+		finding.Score >= r.MinScore &&
+		(!r.BanWorthy || finding.BanWorthy) &&
+		(!r.NewAccountsOnly || input.isNewAccount())
+}
+
+// Rules for prompts from some sources and tiers, empty sources or tiers match all of them
+type Policy struct {
+	Name    string                `json:"name"`
+	Sources []enttypes.SourceType `json:"sources,omitempty"`
+	Tiers   []shared.QueueTier    `json:"tiers,omitempty"`
+	// The first rule matching a finding decides what to do about it, findings no rule matches are allowed
+	Rules []Rule `json:"rules"`
+	// When a checker fails
+	OnError Action `json:"on_error"`
+	// When a particular checker fails, OnError for checkers not in it
+	CheckerOnError map[string]Action `json:"checker_on_error,omitempty"`
+}
+
+func (p Policy) onError(checker string) Action {
+	if action, ok := p.CheckerOnError[checker]; ok {
+		return action
+	}
+	return p.OnError
+}
+
+func (p Policy) appliesTo(source enttypes.SourceType, tier shared.QueueTier) bool {
+	sourceOk := len(p.Sources) == 0
+	for _, s := range p.Sources {
+		sourceOk = sourceOk || s == source
+	}
+	tierOk := len(p.Tiers) == 0
+	for _, t := range p.Tiers {
+		tierOk = tierOk || t == tier
+	}
+	return sourceOk && tierOk
+}
+
+// Decide the action of verdict, the most severe of its findings and errors
+func (p Policy) Apply(input Input, verdict *Verdict) {
+	verdict.Policy = p.Name
+	verdict.Action = ActionAllow
+	for _, checker := range verdict.failed {
+		if action := p.onError(checker); action.severity() > verdict.Action.severity() {
+			verdict.Action = action
+		}
+	}
+	for i, finding := range verdict.Findings {
+		for _, rule := range p.Rules {
+			if !rule.matches(input, finding) {
+				continue
+			}
+			if rule.Action.severity() > verdict.Action.severity() || (verdict.Decisive == nil && rule.Action == verdict.Action && rule.Action != ActionAllow) {
+				verdict.Action = rule.Action
+				verdict.Decisive = &verdict.Findings[i]
+				verdict.Reason = rule.Reason
+				if verdict.Reason == "" {
+					verdict.Reason = finding.Category
+				}
+			}
+			break
+		}
+	}
+}
+
+// First policy that applies to source and tier, policies should end with a catch-all
+func SelectPolicy(policies []Policy, source enttypes.SourceType, tier shared.QueueTier) Policy {
+	for _, p := range policies {
+		if p.appliesTo(source, tier) {
+			return p
+		}
+	}
+	// Nothing applies, fail closed
+	return Policy{Name: "none", Rules: []Rule{{Action: ActionBlock}}, OnError: ActionBlock}
+}
+
+// Used unless MODERATION_POLICIES sets others
+var DefaultPolicies = []Policy{
+	{
+		Name: "default",
+		Rules: []Rule{
+			// Special banned prompts get new accounts banned
+			{Checker: CheckerBannedEmbedding, BanWorthy: true, NewAccountsOnly: true, Action: ActionBan},
+			{Checker: CheckerBannedEmbedding, Action: ActionBlock, Reason: "sexual_minors"},
+			{Checker: CheckerModerationAPI, Action: ActionBlock},
+			{Checker: CheckerKeyword, Action: ActionBlock},
+			{Checker: CheckerClassifier, MinScore: 0.8, Action: ActionFlag},
+		},
+		OnError: ActionBlock,
+		// An extra signal, prompts aren't held up when the classifier is down
+		CheckerOnError: map[string]Action{
+			CheckerClassifier: ActionAllow,
+		},
+	},
+}
+
+var loadPoliciesOnce sync.Once
+var loadedPolicies []Policy
+
+// Policies from MODERATION_POLICIES, a JSON list of policies, or the defaults
+func LoadPolicies() []Policy {
+	loadPoliciesOnce.Do(func() {
+		loadedPolicies = DefaultPolicies
+		raw := utils.GetEnv().ModerationPolicies
+		if raw == "" {
+			return
+		}
+		var policies []Policy
+		if err := json.Unmarshal([]byte(raw), &policies); err != nil || len(policies) == 0 {
+			log.Error("Invalid MODERATION_POLICIES, using the defaults", "err", err)
+			return
+		}
+		loadedPolicies = policies
+	})
+	return loadedPolicies
+}
@@ -1,11 +1,14 @@
 package requests
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/shared"
 )
 
 type DeactiveApiTokenRequest struct {
@@ -14,6 +17,41 @@ type DeactiveApiTokenRequest struct {
 
 type NewTokenRequest struct {
 	Name string `json:"name"`
+	// Every scope if empty
+	Scopes           []shared.ApiTokenScope `json:"scopes,omitempty"`
+	ExpiresAt        *time.Time             `json:"expires_at,omitempty"`
+	MonthlyCreditCap *int                   `json:"monthly_credit_cap,omitempty"`
+}
+
+func (t *NewTokenRequest) Validate() error {
+	for _, scope := range t.Scopes {
+		if !shared.IsValidApiTokenScope(scope) {
+			return fmt.Errorf("invalid scope: '%s'", scope)
+		}
+	}
+	if t.ExpiresAt != nil && !t.ExpiresAt.After(time.Now()) {
+		return errors.New("expires_at must be in the future")
+	}
+	if t.MonthlyCreditCap != nil && *t.MonthlyCreditCap < 1 {
+		return errors.New("monthly_credit_cap must be positive")
+	}
+	return nil
+}
+
+type RotateApiTokenRequest struct {
+	// How long the old token keeps working, 0 revokes it right away
+	GracePeriodSeconds int `json:"grace_period_seconds"`
+}
+
+func (t *RotateApiTokenRequest) Validate() error {
+	if t.GracePeriodSeconds < 0 || t.GracePeriodSeconds > int(shared.MAX_API_TOKEN_ROTATION_GRACE_PERIOD/time.Second) {
+		return fmt.Errorf("grace_period_seconds must be between 0 and %d", int(shared.MAX_API_TOKEN_ROTATION_GRACE_PERIOD.Seconds()))
+	}
+	return nil
+}
+
+func (t *RotateApiTokenRequest) GracePeriod() time.Duration {
+	return time.Duration(t.GracePeriodSeconds) * time.Second
 }
 
 // Filters for querying
@@ -0,0 +1,47 @@
+package requests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTokenRequestValidate(t *testing.T) {
+	req := NewTokenRequest{Name: "test"}
+	assert.Nil(t, req.Validate())
+
+	req.Scopes = []shared.ApiTokenScope{shared.ApiTokenScopeImageGenerate, "image:delete"}
+	assert.EqualError(t, req.Validate(), "invalid scope: 'image:delete'")
+	req.Scopes = []shared.ApiTokenScope{shared.ApiTokenScopeImageGenerate}
+	assert.Nil(t, req.Validate())
+
+	past := time.Now().Add(-time.Hour)
+	req.ExpiresAt = &past
+	assert.EqualError(t, req.Validate(), "expires_at must be in the future")
+	future := time.Now().Add(time.Hour)
+	req.ExpiresAt = &future
+	assert.Nil(t, req.Validate())
+
+	req.MonthlyCreditCap = utils.ToPtr(0)
+	assert.EqualError(t, req.Validate(), "monthly_credit_cap must be positive")
+	req.MonthlyCreditCap = utils.ToPtr(100)
+	assert.Nil(t, req.Validate())
+}
+
+func TestRotateApiTokenRequestValidate(t *testing.T) {
+	req := RotateApiTokenRequest{}
+	assert.Nil(t, req.Validate())
+	req.GracePeriodSeconds = 3600
+	assert.Nil(t, req.Validate())
+	assert.Equal(t, time.Hour, req.GracePeriod())
+	req.GracePeriodSeconds = -1
+	assert.NotNil(t, req.Validate())
+	req.GracePeriodSeconds = int(shared.MAX_API_TOKEN_ROTATION_GRACE_PERIOD.Seconds()) + 1
+	assert.NotNil(t, req.Validate())
+	// Would overflow as a duration
+	req.GracePeriodSeconds = 10000000000
+	assert.NotNil(t, req.Validate())
+}
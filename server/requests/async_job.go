@@ -0,0 +1,51 @@
+package requests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/stablecog/sc-go/utils"
+)
+
+// Options for API requests that should return a job ID immediately instead of waiting for the result
+// The result can be polled, or delivered to callback_url when the job finishes
+type AsyncJobOptions struct {
+	Async       bool   `json:"async,omitempty"`
+	CallbackURL string `json:"callback_url,omitempty"`
+}
+
+// A callback URL implies async
+func (t *AsyncJobOptions) IsAsync() bool {
+	return t.Async || t.CallbackURL != ""
+}
+
+func (t *AsyncJobOptions) ValidateAsync(api bool) error {
+	if !api {
+		// UI requests are always async, delivered over SSE
+		t.Async = false
+		t.CallbackURL = ""
+		return nil
+	}
+	if t.CallbackURL != "" && !utils.IsPublicHTTPURL(t.CallbackURL) {
+		return errors.New("invalid_callback_url")
+	}
+	return nil
+}
+
+// Header containing the signature of callbacks we send for async jobs
+// Format is t=<unix timestamp>,v1=<hex hmac-sha256 of "<timestamp>.<body>">
+const CALLBACK_SIGNATURE_HEADER = "X-Stablecog-Signature"
+
+// Signature for a callback body sent at timestamp, value of CALLBACK_SIGNATURE_HEADER
+func SignCallback(timestamp int64, body []byte) string {
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, utils.HmacSha256(utils.GetEnv().ApiCallbackSecret, fmt.Sprintf("%d.%s", timestamp, body)))
+}
+
+// Payload of an async job callback task, delivered by quecon
+type AsyncJobCallback struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+	// JSON of the job
+	Body json.RawMessage `json:"body"`
+}
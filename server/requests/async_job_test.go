@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsyncJobOptionsIsAsync(t *testing.T) {
+	assert.False(t, (&AsyncJobOptions{}).IsAsync())
+	assert.True(t, (&AsyncJobOptions{Async: true}).IsAsync())
+	assert.True(t, (&AsyncJobOptions{CallbackURL: "https://example.com/hook"}).IsAsync())
+}
+
+func TestAsyncJobOptionsValidateAsync(t *testing.T) {
+	opts := AsyncJobOptions{CallbackURL: "not a url"}
+	assert.EqualError(t, opts.ValidateAsync(true), "invalid_callback_url")
+
+	opts = AsyncJobOptions{CallbackURL: "ftp://example.com/hook"}
+	assert.EqualError(t, opts.ValidateAsync(true), "invalid_callback_url")
+
+	opts = AsyncJobOptions{CallbackURL: "https://example.com/hook"}
+	assert.Nil(t, opts.ValidateAsync(true))
+
+	// Internal addresses
+	opts = AsyncJobOptions{CallbackURL: "http://169.254.169.254/latest/meta-data"}
+	assert.EqualError(t, opts.ValidateAsync(true), "invalid_callback_url")
+	opts = AsyncJobOptions{CallbackURL: "http://localhost:3000/hook"}
+	assert.EqualError(t, opts.ValidateAsync(true), "invalid_callback_url")
+
+	// Ignored for the UI
+	opts = AsyncJobOptions{Async: true, CallbackURL: "not a url"}
+	assert.Nil(t, opts.ValidateAsync(false))
+	assert.False(t, opts.IsAsync())
+}
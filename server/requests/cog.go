@@ -36,6 +36,9 @@ type BaseCogRequest struct {
 	Internal           bool                    `json:"internal,omitempty"`    // Used to indicate if the request is internal or not
 	APIRequest         bool                    `json:"api_request,omitempty"` // Used to indicate if the request is from token or not
 	WasAutoSubmitted   bool                    `json:"was_auto_submitted,omitempty"`
+	Async              bool                    `json:"async,omitempty"`              // API request that returned immediately, result is polled or sent to CallbackURL
+	CallbackURL        string                  `json:"callback_url,omitempty"`       // Where to POST the result of an async API request
+	ApiTokenUsageID    *uuid.UUID              `json:"api_token_usage_id,omitempty"` // Usage log entry the credits are attributed to
 	// Generate specific
 	UploadPathPrefix       string             `json:"upload_path_prefix,omitempty"`
 	OriginalPrompt         string             `json:"original_prompt,omitempty"`
@@ -127,3 +130,16 @@ type CogWebhookMessage struct {
 	Output    CogWebhookOutput `json:"output"`
 	NSFWCount int32            `json:"nsfw_count"`
 }
+
+type WorkerControlAction string
+
+const (
+	WorkerControlCancel WorkerControlAction = "cancel"
+)
+
+// Published to workers on shared.REDIS_SC_WORKER_CONTROL_CHANNEL
+type WorkerControlMessage struct {
+	Action      WorkerControlAction `json:"action"`
+	ID          uuid.UUID           `json:"id"`
+	ProcessType shared.ProcessType  `json:"process_type"`
+}
@@ -37,6 +37,18 @@ type CreateGenerationRequest struct {
 	ProcessType          shared.ProcessType    `json:"process_type"`
 	OutputImageExtension shared.ImageExtension `json:"output_image_extension"`
 	WasAutoSubmitted     bool
+	// Saved preset to take settings from, variables fill in its prompt template
+	PresetID  *uuid.UUID        `json:"preset_id,omitempty"`
+	Variables map[string]string `json:"variables,omitempty"`
+	// Set for items of a batch, credits were already reserved by the batch
+	BatchID   *uuid.UUID `json:"-"`
+	BatchItem int        `json:"-"`
+	// Worked out when the batch was created, before its credits were held
+	BatchFree      bool             `json:"-"`
+	BatchQueueTier shared.QueueTier `json:"-"`
+	// Set for variations, the output they were derived from
+	SourceOutputID *uuid.UUID `json:"-"`
+	AsyncJobOptions
 }
 
 func (t *CreateGenerationRequest) Cost() int32 {
@@ -76,9 +88,16 @@ func (t *CreateGenerationRequest) ApplyDefaults() {
 }
 
 func (t *CreateGenerationRequest) Validate(api bool) error {
-	if !api && !utils.IsSha256Hash(t.StreamID) {
+	// Optional for API tokens, they can pass one to get status updates over the websocket
+	if (!api || t.StreamID != "") && !utils.IsSha256Hash(t.StreamID) {
 		return errors.New("invalid_stream_id")
 	}
+	// Subscriptions are lowercased
+	t.StreamID = strings.ToLower(t.StreamID)
+
+	if err := t.ValidateAsync(api); err != nil {
+		return err
+	}
 
 	t.ApplyDefaults()
 
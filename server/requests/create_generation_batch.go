@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
+	"github.com/stablecog/sc-go/shared"
+)
+
+// HTTP Request for creating a batch of generations
+// Credits for every item are reserved when the batch is created
+type CreateGenerationBatchRequest struct {
+	Items []CreateGenerationRequest `json:"items"`
+	// Lineage of batches created for variations
+	SourceOutputID *uuid.UUID                     `json:"-"`
+	VariationType  *generationbatch.VariationType `json:"-"`
+}
+
+// Credits reserved for the batch, only valid after Validate
+func (t *CreateGenerationBatchRequest) Cost() int32 {
+	var cost int32
+	for i := range t.Items {
+		cost += t.Items[i].Cost()
+	}
+	return cost
+}
+
+func (t *CreateGenerationBatchRequest) Validate() error {
+	if len(t.Items) == 0 {
+		return errors.New("no_items")
+	}
+	if len(t.Items) > shared.MAX_GENERATION_BATCH_ITEMS {
+		return fmt.Errorf("Batch can't have more than %d items", shared.MAX_GENERATION_BATCH_ITEMS)
+	}
+	for i := range t.Items {
+		if err := t.Items[i].Validate(true); err != nil {
+			return fmt.Errorf("items[%d]: %w", i, err)
+		}
+		// Nobody waits on batch items, results are polled or sent to the item's callback
+		t.Items[i].Async = true
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package requests
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// HTTP Request for creating variations of an output, they are created as a batch of single output generations
+// seed: same seed and settings, with the guidance scale whole guidance_steps around the source's, skipping the source's own
+// strength_sweep: the source image is used as init image with target_prompt, at prompt strengths evenly spaced
+// between 0 and 1, the prompts aren't interpolated so every variation is generated from target_prompt alone
+type CreateGenerationVariationsRequest struct {
+	SourceOutputID uuid.UUID                     `json:"source_output_id"`
+	Type           generationbatch.VariationType `json:"type"`
+	NumVariations  int                           `json:"num_variations,omitempty"`
+	GuidanceStep   *float32                      `json:"guidance_step,omitempty"`
+	TargetPrompt   string                        `json:"target_prompt,omitempty"`
+	AsyncJobOptions
+}
+
+func (t *CreateGenerationVariationsRequest) Validate() error {
+	if t.SourceOutputID == uuid.Nil {
+		return errors.New("invalid_source_output_id")
+	}
+	if err := generationbatch.VariationTypeValidator(t.Type); err != nil {
+		return fmt.Errorf("invalid type: '%s' expected '%s' or '%s'", t.Type, generationbatch.VariationTypeSeed, generationbatch.VariationTypeStrengthSweep)
+	}
+	if t.NumVariations == 0 {
+		t.NumVariations = shared.DEFAULT_GENERATION_VARIATIONS
+	}
+	if t.NumVariations < 0 || t.NumVariations > shared.MAX_GENERATION_VARIATIONS {
+		return fmt.Errorf("num_variations must be between 1 and %d", shared.MAX_GENERATION_VARIATIONS)
+	}
+	if t.GuidanceStep == nil {
+		t.GuidanceStep = utils.ToPtr(shared.DEFAULT_VARIATION_GUIDANCE_STEP)
+	}
+	if *t.GuidanceStep <= 0 {
+		return errors.New("guidance_step must be positive")
+	}
+	t.TargetPrompt = strings.TrimSpace(t.TargetPrompt)
+	if t.Type == generationbatch.VariationTypeStrengthSweep && t.TargetPrompt == "" {
+		return errors.New("target_prompt_required")
+	}
+	return t.ValidateAsync(true)
+}
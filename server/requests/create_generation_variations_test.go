@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateGenerationVariationsValidate(t *testing.T) {
+	req := CreateGenerationVariationsRequest{Type: generationbatch.VariationTypeSeed}
+	assert.EqualError(t, req.Validate(), "invalid_source_output_id")
+
+	req = CreateGenerationVariationsRequest{SourceOutputID: uuid.New(), Type: "zoom"}
+	assert.NotNil(t, req.Validate())
+
+	req = CreateGenerationVariationsRequest{SourceOutputID: uuid.New(), Type: generationbatch.VariationTypeSeed, NumVariations: shared.MAX_GENERATION_VARIATIONS + 1}
+	assert.NotNil(t, req.Validate())
+
+	req = CreateGenerationVariationsRequest{SourceOutputID: uuid.New(), Type: generationbatch.VariationTypeStrengthSweep, TargetPrompt: " "}
+	assert.EqualError(t, req.Validate(), "target_prompt_required")
+
+	req = CreateGenerationVariationsRequest{SourceOutputID: uuid.New(), Type: generationbatch.VariationTypeSeed}
+	assert.Nil(t, req.Validate())
+	assert.Equal(t, shared.DEFAULT_GENERATION_VARIATIONS, req.NumVariations)
+	assert.Equal(t, shared.DEFAULT_VARIATION_GUIDANCE_STEP, *req.GuidanceStep)
+}
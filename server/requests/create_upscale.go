@@ -26,6 +26,7 @@ type CreateUpscaleRequest struct {
 	StreamID string              `json:"stream_id"`
 	UIId     string              `json:"ui_id"` // Corresponds to UI identifier
 	OutputID *uuid.UUID
+	AsyncJobOptions
 }
 
 func (t *CreateUpscaleRequest) Cost() int32 {
@@ -47,9 +48,16 @@ func (t *CreateUpscaleRequest) ApplyDefaults() {
 }
 
 func (t *CreateUpscaleRequest) Validate(api bool) error {
-	if !api && !utils.IsSha256Hash(t.StreamID) {
+	// Optional for API tokens, they can pass one to get status updates over the websocket
+	if (!api || t.StreamID != "") && !utils.IsSha256Hash(t.StreamID) {
 		return errors.New("invalid_stream_id")
 	}
+	// Subscriptions are lowercased
+	t.StreamID = strings.ToLower(t.StreamID)
+
+	if err := t.ValidateAsync(api); err != nil {
+		return err
+	}
 
 	// Apply default settings
 	t.ApplyDefaults()
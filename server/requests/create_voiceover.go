@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -24,6 +25,7 @@ type CreateVoiceoverRequest struct {
 	SubmitToGallery  bool       `json:"submit_to_gallery"`
 	UIId             string     `json:"ui_id"` // Corresponds to UI identifier
 	WasAutoSubmitted bool
+	AsyncJobOptions
 }
 
 func (t *CreateVoiceoverRequest) Cost() int32 {
@@ -58,9 +60,16 @@ func (t *CreateVoiceoverRequest) ApplyDefaults() {
 }
 
 func (t *CreateVoiceoverRequest) Validate(api bool) error {
-	if !api && !utils.IsSha256Hash(t.StreamID) {
+	// Optional for API tokens, they can pass one to get status updates over the websocket
+	if (!api || t.StreamID != "") && !utils.IsSha256Hash(t.StreamID) {
 		return fmt.Errorf("invalid_stream_id")
 	}
+	// Subscriptions are lowercased
+	t.StreamID = strings.ToLower(t.StreamID)
+
+	if err := t.ValidateAsync(api); err != nil {
+		return err
+	}
 
 	// Apply default settings
 	t.ApplyDefaults()
@@ -0,0 +1,6 @@
+package requests
+
+// Body of v1/admin/queue/dead-letter/{id}/replay, all optional
+type ReplayDeadLetterRequest struct {
+	Priority *uint8 `json:"priority,omitempty"`
+}
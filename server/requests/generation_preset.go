@@ -0,0 +1,77 @@
+package requests
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/shared"
+)
+
+// {{variable}} placeholders in prompt templates
+var promptTemplateVariableRegex = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_]+)\s*\}\}`)
+
+// HTTP Request for creating or replacing a generation preset
+// Settings that are not set are left to the generation request or its defaults
+type GenerationPresetRequest struct {
+	Name           string     `json:"name"`
+	PromptTemplate *string    `json:"prompt_template,omitempty"`
+	NegativePrompt *string    `json:"negative_prompt,omitempty"`
+	ModelId        *uuid.UUID `json:"model_id,omitempty"`
+	SchedulerId    *uuid.UUID `json:"scheduler_id,omitempty"`
+	Width          *int32     `json:"width,omitempty"`
+	Height         *int32     `json:"height,omitempty"`
+	InferenceSteps *int32     `json:"inference_steps,omitempty"`
+	GuidanceScale  *float32   `json:"guidance_scale,omitempty"`
+	NumOutputs     *int32     `json:"num_outputs,omitempty"`
+	PromptStrength *float32   `json:"prompt_strength,omitempty"`
+}
+
+func (t *GenerationPresetRequest) Validate() error {
+	t.Name = strings.TrimSpace(t.Name)
+	if t.Name == "" {
+		return errors.New("name_required")
+	}
+	if utf8.RuneCountInString(t.Name) > shared.MAX_GENERATION_PRESET_NAME_SIZE {
+		return fmt.Errorf("Name can't be longer than %d characters", shared.MAX_GENERATION_PRESET_NAME_SIZE)
+	}
+	if t.PromptTemplate != nil && strings.Count(*t.PromptTemplate, "{{") != len(promptTemplateVariableRegex.FindAllString(*t.PromptTemplate, -1)) {
+		return errors.New("invalid_prompt_template")
+	}
+	if t.ModelId != nil && !shared.GetCache().IsValidGenerationModelID(*t.ModelId) {
+		return errors.New("invalid_model_id")
+	}
+	if t.SchedulerId != nil && !shared.GetCache().IsValidShedulerID(*t.SchedulerId) {
+		return errors.New("invalid_scheduler_id")
+	}
+	// Everything else is validated with the generation request the preset is used in
+	return nil
+}
+
+// Variables used in a prompt template, in order of first use
+func PromptTemplateVariables(template string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, match := range promptTemplateVariableRegex.FindAllStringSubmatch(template, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// Replace {{variable}} placeholders in template with their values, every variable must have a value
+func RenderPromptTemplate(template string, variables map[string]string) (string, error) {
+	for _, name := range PromptTemplateVariables(template) {
+		if _, ok := variables[name]; !ok {
+			return "", fmt.Errorf("missing_template_variable: %s", name)
+		}
+	}
+	return promptTemplateVariableRegex.ReplaceAllStringFunc(template, func(placeholder string) string {
+		return variables[promptTemplateVariableRegex.FindStringSubmatch(placeholder)[1]]
+	}), nil
+}
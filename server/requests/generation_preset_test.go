@@ -0,0 +1,36 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderPromptTemplate(t *testing.T) {
+	template := "A {{ animal }} in the style of {{style}}, {{style}} lighting"
+	assert.Equal(t, []string{"animal", "style"}, PromptTemplateVariables(template))
+
+	prompt, err := RenderPromptTemplate(template, map[string]string{"animal": "cat", "style": "noir", "unused": "x"})
+	assert.Nil(t, err)
+	assert.Equal(t, "A cat in the style of noir, noir lighting", prompt)
+
+	_, err = RenderPromptTemplate(template, map[string]string{"animal": "cat"})
+	assert.EqualError(t, err, "missing_template_variable: style")
+
+	prompt, err = RenderPromptTemplate("No variables", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "No variables", prompt)
+}
+
+func TestGenerationPresetRequestValidate(t *testing.T) {
+	req := GenerationPresetRequest{Name: "  "}
+	assert.EqualError(t, req.Validate(), "name_required")
+
+	req = GenerationPresetRequest{Name: "Noir", PromptTemplate: utils.ToPtr("A {{subject in noir")}
+	assert.EqualError(t, req.Validate(), "invalid_prompt_template")
+
+	req = GenerationPresetRequest{Name: " Noir ", PromptTemplate: utils.ToPtr("A {{subject}} in noir")}
+	assert.Nil(t, req.Validate())
+	assert.Equal(t, "Noir", req.Name)
+}
@@ -0,0 +1,9 @@
+package requests
+
+import "github.com/stablecog/sc-go/database/ent/moderationverdict"
+
+// Body of v1/admin/moderation/verdicts/{id}/review
+type ReviewModerationVerdictRequest struct {
+	Status moderationverdict.ReviewStatus `json:"status"`
+	Note   *string                        `json:"note,omitempty"`
+}
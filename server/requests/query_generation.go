@@ -74,6 +74,8 @@ type QueryGenerationFilters struct {
 	IsFavorited               *bool                            `json:"is_favorited,omitempty"`
 	WasAutoSubmitted          *bool                            `json:"was_auto_submitted,omitempty"`
 	PromptID                  *uuid.UUID                       `json:"prompt,omitempty"`
+	BatchID                   *uuid.UUID                       `json:"batch_id,omitempty"`
+	SourceOutputID            *uuid.UUID                       `json:"source_output_id,omitempty"`
 	IsPublic                  *bool                            `json:"is_public,omitempty"`
 	IsLiked                   *bool                            `json:"is_liked,omitempty"`
 	AestheticArtifactScoreLTE *float32                         `json:"aesthetic_artifact_score_lte,omitempty"`
@@ -83,6 +85,7 @@ type QueryGenerationFilters struct {
 	Username                  []string                         `json:"username,omitempty"`
 	AspectRatio               []aspectratio.AspectRatio        `json:"aspect_ratio,omitempty"`
 	Oversampling              *float32                         `json:"oversampling,omitempty"`
+	KeywordWeight             *float32                         `json:"keyword_weight,omitempty"`
 	AdminMode                 *bool                            `json:"admin_mode,omitempty"`
 }
 
@@ -338,6 +341,14 @@ func (filters *QueryGenerationFilters) ParseURLQueryParameters(urlValues url.Val
 			filters.Oversampling = utils.ToPtr(float32(parsed))
 		}
 
+		if key == "keyword_weight" {
+			parsed, err := strconv.ParseFloat(value[0], 32)
+			if err != nil || parsed < 0 || parsed > 1 {
+				return fmt.Errorf("invalid keyword_weight: %s", value[0])
+			}
+			filters.KeywordWeight = utils.ToPtr(float32(parsed))
+		}
+
 		// Gallery status
 		if key == "gallery_status" {
 			var statuses []string
@@ -453,6 +464,21 @@ func (filters *QueryGenerationFilters) ParseURLQueryParameters(urlValues url.Val
 			}
 			filters.PromptID = &parsed
 		}
+		// Lineage, generations of a batch or variations of an output
+		if key == "batch_id" {
+			parsed, err := uuid.Parse(value[0])
+			if err != nil {
+				return fmt.Errorf("invalid batch_id: %s", value[0])
+			}
+			filters.BatchID = &parsed
+		}
+		if key == "source_output_id" {
+			parsed, err := uuid.Parse(value[0])
+			if err != nil {
+				return fmt.Errorf("invalid source_output_id: %s", value[0])
+			}
+			filters.SourceOutputID = &parsed
+		}
 
 		// Aesthetic scores
 		if key == "aesthetic_artifact_score_lte" {
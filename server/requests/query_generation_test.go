@@ -129,3 +129,18 @@ func TestToQdrantFiltersIgnoreGalleryStatus(t *testing.T) {
 	// assert equal to prefined string
 	assert.Equal(t, "{\"must\":[{\"key\":\"height\",\"range\":{\"gte\":6}},{\"key\":\"height\",\"range\":{\"lte\":7}},{\"key\":\"width\",\"range\":{\"gte\":1}},{\"key\":\"width\",\"range\":{\"lte\":5}},{\"key\":\"created_at\",\"range\":{\"gte\":1609459200}},{\"key\":\"is_favorited\",\"match\":{\"value\":true}},{\"key\":\"was_auto_submitted\",\"match\":{\"value\":true}}],\"should\":[{\"key\":\"model\",\"match\":{\"value\":\"49d75ae2-5407-40d9-8c02-0c44ba08f358\"}},{\"key\":\"scheduler\",\"match\":{\"value\":\"e07ad712-41ad-4ff7-8727-faf0d91e4c4e\"}},{\"key\":\"scheduler\",\"match\":{\"value\":\"c09aaf4d-2d78-4281-89aa-88d5d0a5d70b\"}},{\"key\":\"height\",\"match\":{\"value\":512}},{\"key\":\"width\",\"match\":{\"value\":512}},{\"key\":\"width\",\"match\":{\"value\":768}}]}", string(b))
 }
+
+func TestParseKeywordWeight(t *testing.T) {
+	values, err := url.ParseQuery("keyword_weight=0.5")
+	assert.Nil(t, err)
+	filters := &QueryGenerationFilters{}
+	assert.Nil(t, filters.ParseURLQueryParameters(values))
+	assert.Equal(t, float32(0.5), *filters.KeywordWeight)
+
+	values, err = url.ParseQuery("keyword_weight=2")
+	assert.Nil(t, err)
+	filters = &QueryGenerationFilters{}
+	err = filters.ParseURLQueryParameters(values)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid keyword_weight: 2", err.Error())
+}
@@ -1,6 +1,11 @@
 package responses
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/shared"
+)
 
 type ApiOutput struct {
 	ID               uuid.UUID `json:"id"`
@@ -23,3 +28,40 @@ type ApiFailedResponse struct {
 	Error    string      `json:"error"`
 	Settings interface{} `json:"settings,omitempty"`
 }
+
+// Status of an async API job, returned when polling and sent to the callback URL
+type ApiJobResponse struct {
+	ID          uuid.UUID          `json:"id"`
+	ProcessType shared.ProcessType `json:"process_type"`
+	Status      string             `json:"status"`
+	Error       string             `json:"error,omitempty"`
+	Outputs     []ApiOutput        `json:"outputs"`
+	CreatedAt   time.Time          `json:"created_at"`
+	StartedAt   *time.Time         `json:"started_at,omitempty"`
+	CompletedAt *time.Time         `json:"completed_at,omitempty"`
+}
+
+// Progress of a generation batch, items are in the order they were queued
+type ApiGenerationBatchResponse struct {
+	ID              uuid.UUID                `json:"id"`
+	Status          string                   `json:"status"`
+	NumItems        int                      `json:"num_items"`
+	Progress        ApiGenerationBatchCounts `json:"progress"`
+	CreditsReserved int32                    `json:"credits_reserved"`
+	CreditsRefunded int32                    `json:"credits_refunded"`
+	Items           []ApiJobResponse         `json:"items"`
+	CreatedAt       time.Time                `json:"created_at"`
+	DispatchedAt    *time.Time               `json:"dispatched_at,omitempty"`
+	// Set for variations
+	SourceOutputID *uuid.UUID `json:"source_output_id,omitempty"`
+	VariationType  *string    `json:"variation_type,omitempty"`
+}
+
+type ApiGenerationBatchCounts struct {
+	Pending   int `json:"pending"` // Not queued yet
+	Queued    int `json:"queued"`
+	Started   int `json:"started"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Rejected  int `json:"rejected"` // Could not be queued
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/utils"
 )
 
 type NewApiTokensResponse struct {
@@ -11,6 +12,14 @@ type NewApiTokensResponse struct {
 	Token string    `json:"token"`
 }
 
+type RotateApiTokenResponse struct {
+	ID    uuid.UUID `json:"id"`
+	Token string    `json:"token"`
+	// The old token stops working at this time
+	RotatedFromID        uuid.UUID `json:"rotated_from_id"`
+	RotatedFromExpiresAt time.Time `json:"rotated_from_expires_at"`
+}
+
 // For retrieving a list of API tokens
 type ApiToken struct {
 	ID           uuid.UUID  `json:"id"`
@@ -22,8 +31,27 @@ type ApiToken struct {
 	LastUsedAt   *time.Time `json:"last_used_at,omitempty"`
 	CreatedAt    time.Time  `json:"created_at"`
 	AuthClientID *uuid.UUID `json:"auth_client_id,omitempty"`
+	// Nil for tokens that can do everything
+	Scopes              []string   `json:"scopes,omitempty"`
+	ExpiresAt           *time.Time `json:"expires_at,omitempty"`
+	MonthlyCreditCap    *int       `json:"monthly_credit_cap,omitempty"`
+	MonthlyCreditsSpent int        `json:"monthly_credits_spent"`
+	RotatedFromID       *uuid.UUID `json:"rotated_from_id,omitempty"`
 }
 
 type GetApiTokensResponse struct {
 	Tokens []ApiToken `json:"tokens"`
 }
+
+type ApiTokenUsage struct {
+	ID           uuid.UUID `json:"id"`
+	IP           string    `json:"ip"`
+	Endpoint     string    `json:"endpoint"`
+	CreditsSpent int       `json:"credits_spent"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type GetApiTokenUsageResponse struct {
+	Usage []ApiTokenUsage     `json:"usage"`
+	Next  *utils.KeysetCursor `json:"next,omitempty"`
+}
@@ -0,0 +1,55 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/server/requests"
+)
+
+type GenerationPreset struct {
+	ID             uuid.UUID  `json:"id"`
+	Name           string     `json:"name"`
+	PromptTemplate *string    `json:"prompt_template,omitempty"`
+	Variables      []string   `json:"variables"`
+	NegativePrompt *string    `json:"negative_prompt,omitempty"`
+	ModelId        *uuid.UUID `json:"model_id,omitempty"`
+	SchedulerId    *uuid.UUID `json:"scheduler_id,omitempty"`
+	Width          *int32     `json:"width,omitempty"`
+	Height         *int32     `json:"height,omitempty"`
+	InferenceSteps *int32     `json:"inference_steps,omitempty"`
+	GuidanceScale  *float32   `json:"guidance_scale,omitempty"`
+	NumOutputs     *int32     `json:"num_outputs,omitempty"`
+	PromptStrength *float32   `json:"prompt_strength,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+func NewGenerationPreset(p *ent.GenerationPreset) GenerationPreset {
+	variables := []string{}
+	if p.PromptTemplate != nil {
+		variables = append(variables, requests.PromptTemplateVariables(*p.PromptTemplate)...)
+	}
+	return GenerationPreset{
+		ID:             p.ID,
+		Name:           p.Name,
+		PromptTemplate: p.PromptTemplate,
+		Variables:      variables,
+		NegativePrompt: p.NegativePrompt,
+		ModelId:        p.ModelID,
+		SchedulerId:    p.SchedulerID,
+		Width:          p.Width,
+		Height:         p.Height,
+		InferenceSteps: p.InferenceSteps,
+		GuidanceScale:  p.GuidanceScale,
+		NumOutputs:     p.NumOutputs,
+		PromptStrength: p.PromptStrength,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+	}
+}
+
+type GetGenerationPresetsResponse struct {
+	Presets []GenerationPreset `json:"presets"`
+}
@@ -39,6 +39,13 @@ func ErrForbidden(w http.ResponseWriter, r *http.Request) {
 	render.JSON(w, r, &ForbiddenError)
 }
 
+func ErrForbiddenWithReason(w http.ResponseWriter, r *http.Request, errorText string) {
+	render.Status(r, http.StatusForbidden)
+	render.JSON(w, r, &ErrorResponse{
+		Error: errorText,
+	})
+}
+
 var InsufficientCredits = ErrorResponse{
 	Error: "insufficient_credits",
 }
@@ -65,6 +72,13 @@ func ErrBadRequest(w http.ResponseWriter, r *http.Request, errorText string, des
 	})
 }
 
+func ErrConflict(w http.ResponseWriter, r *http.Request, errorText string) {
+	render.Status(r, http.StatusConflict)
+	render.JSON(w, r, &ErrorResponse{
+		Error: errorText,
+	})
+}
+
 func ErrInternalServerError(w http.ResponseWriter, r *http.Request, errorText string) {
 	render.Status(r, http.StatusInternalServerError)
 	render.JSON(w, r, &ErrorResponse{
@@ -78,3 +92,12 @@ func ErrMethodNotAllowed(w http.ResponseWriter, r *http.Request, errorText strin
 		Error: errorText,
 	})
 }
+
+var TooManyRequestsError = ErrorResponse{
+	Error: "rate_limit_exceeded",
+}
+
+func ErrTooManyRequests(w http.ResponseWriter, r *http.Request) {
+	render.Status(r, http.StatusTooManyRequests)
+	render.JSON(w, r, &TooManyRequestsError)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/utils"
 )
 
 // Response for retrieving user credits
@@ -22,6 +23,24 @@ type Credit struct {
 }
 
 type QueryCreditsResponse struct {
-	TotalRemainingCredits int32    `json:"total_remaining_credits"`
-	Credits               []Credit `json:"credits"`
+	TotalRemainingCredits int32 `json:"total_remaining_credits"`
+	// Taken from the balance for jobs that haven't finished yet
+	HeldCredits int      `json:"held_credits"`
+	Credits     []Credit `json:"credits"`
+}
+
+// A change to one of the user's credits
+type CreditTransaction struct {
+	ID             uuid.UUID  `json:"id"`
+	Type           string     `json:"type"`
+	Amount         int32      `json:"amount"`
+	CreditTypeID   uuid.UUID  `json:"credit_type_id"`
+	CreditTypeName string     `json:"credit_type_name,omitempty"`
+	ReferenceID    *uuid.UUID `json:"reference_id,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+type CreditHistoryResponse struct {
+	Transactions []CreditTransaction `json:"transactions"`
+	Next         *utils.KeysetCursor `json:"next,omitempty"`
 }
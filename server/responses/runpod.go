@@ -12,8 +12,10 @@ const (
 )
 
 // Runpod returns {"output": {"input": ..., "output": {"images": []}}} where "images" is a list of image URLs
+// Voiceover jobs return "audio_files" in the same shape as sc-worker
 type RunpodOutputOutput struct {
-	Images []string `json:"images"`
+	Images     []string                         `json:"images"`
+	AudioFiles []requests.CogWebhookOutputAudio `json:"audio_files,omitempty"`
 }
 
 type RunpodBaseOutput struct {
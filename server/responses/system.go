@@ -1,6 +1,9 @@
 package responses
 
-import "github.com/stablecog/sc-go/shared"
+import (
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/shared"
+)
 
 type ChangeSystemBackendResponse struct {
 	Backend shared.BackendType `json:"backend"`
@@ -8,6 +11,21 @@ type ChangeSystemBackendResponse struct {
 }
 
 type SystemStatusResponse struct {
-	Backend  shared.BackendType   `json:"backend"`
-	Backends []shared.BackendType `json:"backends"`
+	Backend  shared.BackendType     `json:"backend"`
+	Backends []shared.BackendType   `json:"backends"`
+	Circuits []shared.CircuitStatus `json:"circuits"`
+	Models   []ModelRoutes          `json:"models"`
+}
+
+// Backends serving a model, with their health and share of its jobs
+type ModelRoutes struct {
+	ModelID   uuid.UUID      `json:"model_id"`
+	ModelName string         `json:"model_name"`
+	Routes    []BackendRoute `json:"routes"`
+}
+
+type BackendRoute struct {
+	shared.BackendHealth
+	Circuit shared.CircuitState `json:"circuit"`
+	Weight  float64             `json:"weight"`
 }
@@ -2,6 +2,9 @@ package responses
 
 import (
 	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/shared"
 )
 
 type QueuedItem struct {
@@ -20,3 +23,18 @@ type TaskQueuedResponse struct {
 	QueuedId         string        `json:"queued_id,omitempty"`
 	QueueItems       []*QueuedItem `json:"queue_items,omitempty"`
 }
+
+// Where a job is in the queue and when it's expected to start and finish
+type QueueStatusResponse struct {
+	JobID       uuid.UUID          `json:"job_id"`
+	ProcessType shared.ProcessType `json:"process_type"`
+	Status      string             `json:"status"`
+	// Ahead + 1, 0 if it's not waiting in the queue
+	Position int `json:"position"`
+	// Jobs waiting, ones being processed aren't counted
+	Total int `json:"total"`
+	// Waiting jobs of the same or higher priority that are picked first
+	Ahead             int        `json:"ahead"`
+	EstimatedStartAt  *time.Time `json:"estimated_start_at,omitempty"`
+	EstimatedFinishAt *time.Time `json:"estimated_finish_at,omitempty"`
+}
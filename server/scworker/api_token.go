@@ -0,0 +1,33 @@
+package scworker
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+)
+
+// Reserve the credits held for a job against the API token's monthly cap, in the transaction holding them
+// Fails with repository.ApiTokenCreditCapErr if it would take the token over the cap
+func (w *SCWorker) reserveApiTokenCredits(apiTokenId *uuid.UUID, hold *ent.CreditHold, DB *ent.Client) error {
+	if apiTokenId == nil {
+		return nil
+	}
+	return w.Repo.ReserveApiTokenCredits(*apiTokenId, hold, DB)
+}
+
+// Usage log entry the auth middleware created for the request, if any
+func apiTokenUsageID(r *http.Request) *uuid.UUID {
+	if r == nil {
+		return nil
+	}
+	usageIDStr, ok := r.Context().Value("api_token_usage_id").(string)
+	if !ok {
+		return nil
+	}
+	usageID, err := uuid.Parse(usageIDStr)
+	if err != nil {
+		return nil
+	}
+	return &usageID
+}
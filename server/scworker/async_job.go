@@ -0,0 +1,230 @@
+package scworker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// Get the status and outputs of a job owned by user
+func (w *SCWorker) GetJob(processType shared.ProcessType, id uuid.UUID, userID uuid.UUID) (*responses.ApiJobResponse, error) {
+	job, _, err := w.getJob(processType, id, userID)
+	return job, err
+}
+
+// Cancels a queued or running job of the user, returns it as it is afterwards
+func (w *SCWorker) CancelJob(processType shared.ProcessType, id uuid.UUID, userID uuid.UUID) (*responses.ApiJobResponse, error) {
+	if err := w.Repo.CancelJob(processType, id, userID); err != nil {
+		return nil, err
+	}
+	// Take it off the runpod queues if it's still waiting there, nothing will pick its message up then
+	if removed, err := deleteAsynqTask(w.Redis.Client, id.String(), inactiveAsynqTaskStates); err != nil {
+		log.Error("Error removing cancelled job from queue", "err", err, "id", id)
+	} else if removed {
+		if _, err := w.Repo.DeleteFromQueueLog(utils.Sha256(id.String()), nil); err != nil {
+			log.Error("Error deleting cancelled job from queue log", "err", err, "id", id)
+		}
+	}
+	// Async API requests get their callback, like when they time out
+	if callbackURL, async, err := w.Redis.GetAsyncJobCallbackURL(w.Redis.Ctx, id.String()); err != nil {
+		log.Error("Error getting async job callback", "err", err, "id", id)
+	} else if async {
+		w.CompleteAsyncJob(requests.CogWebhookMessage{
+			Input: requests.BaseCogRequest{
+				ID:          id,
+				UserID:      &userID,
+				ProcessType: processType,
+				Async:       true,
+				CallbackURL: callbackURL,
+			},
+			Error:  shared.CANCELLED_ERROR,
+			Status: requests.CogFailed,
+		})
+	}
+	return w.GetJob(processType, id, userID)
+}
+
+// Keeps the callback of an async job, for finishing it if it's cancelled
+func (w *SCWorker) trackAsyncJob(input requests.BaseCogRequest) {
+	if !input.Async {
+		return
+	}
+	if err := w.Redis.SetAsyncJobCallbackURL(w.Redis.Ctx, input.ID.String(), input.CallbackURL); err != nil {
+		log.Error("Failed to set async job callback", "err", err, "id", input.ID)
+	}
+}
+
+func (w *SCWorker) getJob(processType shared.ProcessType, id uuid.UUID, userID uuid.UUID) (job *responses.ApiJobResponse, apiTokenID *uuid.UUID, err error) {
+	switch processType {
+	case shared.GENERATE, shared.GENERATE_AND_UPSCALE:
+		g, err := w.Repo.GetGenerationWithOutputsForUser(id, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return generationJobResponse(g), g.APITokenID, nil
+	case shared.UPSCALE:
+		u, err := w.Repo.GetUpscaleWithOutputsForUser(id, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		job = &responses.ApiJobResponse{
+			ID:          u.ID,
+			ProcessType: shared.UPSCALE,
+			Status:      string(u.Status),
+			CreatedAt:   u.CreatedAt,
+			StartedAt:   u.StartedAt,
+			CompletedAt: u.CompletedAt,
+			Outputs:     make([]responses.ApiOutput, len(u.Edges.UpscaleOutputs)),
+		}
+		if u.FailureReason != nil {
+			job.Error = *u.FailureReason
+		}
+		for i, output := range u.Edges.UpscaleOutputs {
+			job.Outputs[i] = responses.ApiOutput{
+				ID:               output.ID,
+				URL:              utils.GetEnv().GetURLFromImagePath(output.ImagePath),
+				UpscaledImageURL: utils.ToPtr(utils.GetEnv().GetURLFromImagePath(output.ImagePath)),
+			}
+		}
+		return job, u.APITokenID, nil
+	case shared.VOICEOVER:
+		v, err := w.Repo.GetVoiceoverWithOutputsForUser(id, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		job = &responses.ApiJobResponse{
+			ID:          v.ID,
+			ProcessType: shared.VOICEOVER,
+			Status:      string(v.Status),
+			CreatedAt:   v.CreatedAt,
+			StartedAt:   v.StartedAt,
+			CompletedAt: v.CompletedAt,
+			Outputs:     make([]responses.ApiOutput, len(v.Edges.VoiceoverOutputs)),
+		}
+		if v.FailureReason != nil {
+			job.Error = *v.FailureReason
+		}
+		for i, output := range v.Edges.VoiceoverOutputs {
+			job.Outputs[i] = responses.ApiOutput{
+				ID:            output.ID,
+				URL:           utils.GetEnv().GetURLFromAudioFilePath(output.AudioPath),
+				AudioFileURL:  utils.ToPtr(utils.GetEnv().GetURLFromAudioFilePath(output.AudioPath)),
+				AudioDuration: utils.ToPtr(output.AudioDuration),
+			}
+			if output.VideoPath != nil {
+				job.Outputs[i].VideoFileURL = utils.ToPtr(utils.GetEnv().GetURLFromAudioFilePath(*output.VideoPath))
+			}
+		}
+		return job, v.APITokenID, nil
+	}
+	return nil, nil, fmt.Errorf("invalid process type %s", processType)
+}
+
+// Job response for a generation queried with its outputs
+func generationJobResponse(g *ent.Generation) *responses.ApiJobResponse {
+	job := &responses.ApiJobResponse{
+		ID:          g.ID,
+		ProcessType: shared.GENERATE,
+		Status:      string(g.Status),
+		CreatedAt:   g.CreatedAt,
+		StartedAt:   g.StartedAt,
+		CompletedAt: g.CompletedAt,
+		Outputs:     make([]responses.ApiOutput, len(g.Edges.GenerationOutputs)),
+	}
+	if g.FailureReason != nil {
+		job.Error = *g.FailureReason
+	}
+	for i, output := range g.Edges.GenerationOutputs {
+		job.Outputs[i] = responses.ApiOutput{
+			ID:       output.ID,
+			URL:      utils.GetEnv().GetURLFromImagePath(output.ImagePath),
+			ImageURL: utils.ToPtr(utils.GetEnv().GetURLFromImagePath(output.ImagePath)),
+		}
+		if output.UpscaledImagePath != nil {
+			job.Outputs[i].UpscaledImageURL = utils.ToPtr(utils.GetEnv().GetURLFromImagePath(*output.UpscaledImagePath))
+		}
+	}
+	return job
+}
+
+// Called once the database reflects the final state of an async job
+// Marks the token as used on success and delivers the callback if one was requested
+func (w *SCWorker) CompleteAsyncJob(msg requests.CogWebhookMessage) {
+	if !msg.Input.Async || msg.Input.UserID == nil {
+		return
+	}
+	if msg.Status != requests.CogSucceeded && msg.Status != requests.CogFailed {
+		return
+	}
+
+	job, apiTokenID, err := w.getJob(msg.Input.ProcessType, msg.Input.ID, *msg.Input.UserID)
+	if err != nil {
+		log.Error("Error getting async job", "id", msg.Input.ID, "err", err)
+		return
+	}
+
+	// Set token used, the synchronous flow does this when it receives the result
+	if job.Status == string(requests.CogSucceeded) && apiTokenID != nil {
+		var cost int
+		switch msg.Input.ProcessType {
+		case shared.UPSCALE:
+			cost = 1
+		case shared.VOICEOVER:
+			cost = int(utils.CalculateVoiceoverCredits(msg.Input.Prompt))
+		default:
+			if msg.Input.NumOutputs != nil {
+				cost = int(*msg.Input.NumOutputs)
+			}
+		}
+		err = w.Repo.SetTokenUsedAndIncrementCreditsSpent(cost, *apiTokenID, msg.Input.ApiTokenUsageID)
+		if err != nil {
+			log.Error("Failed to set token used", "err", err)
+		}
+	}
+
+	if msg.Input.CallbackURL == "" {
+		return
+	}
+	if err := w.queueCallback(msg.Input.ID, msg.Input.CallbackURL, job); err != nil {
+		log.Error("Failed to queue async job callback", "id", msg.Input.ID, "url", msg.Input.CallbackURL, "err", err)
+	}
+}
+
+// Persist the callback as a task, quecon delivers it with retries that survive restarts
+func (w *SCWorker) queueCallback(id uuid.UUID, callbackURL string, job *responses.ApiJobResponse) error {
+	if w.AsynqClient == nil {
+		return errors.New("no asynq client to queue the callback with")
+	}
+	body, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(requests.AsyncJobCallback{
+		ID:   id.String(),
+		URL:  callbackURL,
+		Body: body,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.AsynqClient.Enqueue(
+		asynq.NewTask(shared.ASYNQ_TASK_CALLBACK, payload),
+		asynq.Queue(shared.ASYNQ_WEBHOOK_QUEUE),
+		asynq.MaxRetry(shared.ASYNQ_CALLBACK_MAX_RETRY),
+		// One callback per job, it's only sent once the job is final
+		asynq.TaskID(fmt.Sprintf("callback:%s", id)),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+	return err
+}
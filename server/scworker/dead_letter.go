@@ -0,0 +1,229 @@
+package scworker
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// Store a failed request in the dead letter queue, errors are only logged
+func (w *SCWorker) deadLetter(cogReqBody requests.CogQueueRequest, reason string) {
+	_, err := w.Repo.AddToDeadLetterQueue(cogReqBody, reason, nil)
+	if err != nil {
+		log.Error("Error adding to dead letter queue", "id", cogReqBody.Input.ID, "err", err)
+	}
+}
+
+// Re-enqueue the request of a dead letter
+// The failure released the user's credits, so they are held again. The dead letter is marked replayed in the same transaction
+// and the job must still be failed, so a dead letter can only ever be charged for once
+// The request is enqueued after that's committed, if that fails the replay is undone
+// Replayed requests always complete through the webhook, sync API requests become async
+func (w *SCWorker) ReplayDeadLetter(id uuid.UUID, priority uint8) (*ent.DeadLetter, error) {
+	dl, err := w.Repo.GetDeadLetter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var cogReqBody requests.CogQueueRequest
+	if err := json.Unmarshal([]byte(dl.Payload), &cogReqBody); err != nil {
+		return nil, err
+	}
+	if cogReqBody.Input.UserID == nil {
+		return nil, fmt.Errorf("dead letter %s has no user", id)
+	}
+	userID := *cogReqBody.Input.UserID
+	processType := cogReqBody.Input.ProcessType
+
+	var credits int32
+	var throttlePrefix string
+	var holdType credithold.ProcessType
+	switch processType {
+	case shared.UPSCALE:
+		credits = 1
+		throttlePrefix = "u"
+		holdType = credithold.ProcessTypeUpscale
+	case shared.VOICEOVER:
+		credits = utils.CalculateVoiceoverCredits(cogReqBody.Input.Prompt)
+		throttlePrefix = "v"
+		holdType = credithold.ProcessTypeVoiceover
+	default:
+		if cogReqBody.Input.NumOutputs == nil {
+			return nil, fmt.Errorf("dead letter %s has no num_outputs", id)
+		}
+		credits = *cogReqBody.Input.NumOutputs
+		throttlePrefix = "g"
+		holdType = credithold.ProcessTypeGenerate
+	}
+
+	cogReqBody.WebhookEventsFilter = []requests.CogEventFilter{requests.CogEventFilterStart, requests.CogEventFilterStart}
+	cogReqBody.WebhookUrl = fmt.Sprintf("%s/v1/worker/webhook", utils.GetEnv().PublicApiUrl)
+	cogReqBody.Input.WebhookPrivateUrl = fmt.Sprintf("%s/v1/worker/webhook", utils.GetEnv().PrivateApiUrl)
+	// Nobody is waiting on the original API request anymore
+	if cogReqBody.Input.APIRequest {
+		cogReqBody.Input.APIRequest = false
+		cogReqBody.Input.Async = true
+	}
+	// Original upload URLs may have expired
+	if len(cogReqBody.Input.SignedUrls) > 0 && processType != shared.VOICEOVER {
+		cogReqBody.Input.SignedUrls, err = w.signOutputUrls(len(cogReqBody.Input.SignedUrls), cogReqBody.Input.OutputImageExtension)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	queueId := utils.Sha256(cogReqBody.Input.ID.String())
+	if err := w.Repo.WithTx(func(tx *ent.Tx) error {
+		DB := tx.Client()
+
+		if err := w.Repo.SetDeadLetterReplayed(dl.ID, DB); err != nil {
+			return err
+		}
+
+		webhookToken, err := w.Repo.RequeueFailedJob(processType, cogReqBody.Input.ID, DB)
+		if err != nil {
+			return err
+		}
+		cogReqBody.Input.WebhookToken = webhookToken
+
+		hold, err := w.Repo.HoldCredits(userID, credits, holdType, DB)
+		if err != nil {
+			return err
+		} else if hold == nil {
+			return responses.InsufficientCreditsErr
+		}
+		// Counts towards the monthly cap of the token the job was created with, like when it was created
+		apiTokenId, err := w.Repo.GetJobApiTokenID(processType, cogReqBody.Input.ID, DB)
+		if err != nil {
+			return err
+		}
+		if err := w.reserveApiTokenCredits(apiTokenId, hold, DB); err != nil {
+			return err
+		}
+		if err := w.Repo.AttachCreditHold(hold.ID, cogReqBody.Input.ID, DB); err != nil {
+			return err
+		}
+
+		_, err = w.Repo.AddToQueueLog(queueId, int(priority), DB)
+		return err
+	}); err != nil {
+		log.Error("Error replaying dead letter", "id", dl.ID, "err", err)
+		return nil, err
+	}
+
+	// Only once it's committed, a worker could otherwise pick the job up before it's queued again
+	if err := w.enqueue(queueId, cogReqBody, priority); err != nil {
+		log.Error("Error enqueueing replayed dead letter", "id", dl.ID, "err", err)
+		if err := w.undoReplay(dl, cogReqBody.Input, credits); err != nil {
+			log.Error("Error undoing replay of dead letter", "id", dl.ID, "err", err)
+		}
+		return nil, err
+	}
+
+	w.QueueThrottler.IncrementBy(1, fmt.Sprintf("%s:%s", throttlePrefix, userID.String()))
+
+	// Same timeout as the original request
+	err = w.Redis.SetCogRequestStreamID(w.Redis.Ctx, cogReqBody.Input.ID.String(), cogReqBody.Input.StreamID)
+	if err != nil {
+		log.Error("Failed to set timeout key", "err", err)
+	} else {
+		w.trackAsyncJob(cogReqBody.Input)
+		timeout := shared.REQUEST_COG_TIMEOUT
+		if processType == shared.VOICEOVER {
+			timeout = shared.REQUEST_COG_TIMEOUT_VOICEOVER
+		}
+		go func() {
+			time.Sleep(timeout)
+			timeoutMsg := requests.CogWebhookMessage{
+				Input:  cogReqBody.Input,
+				Error:  shared.TIMEOUT_ERROR,
+				Status: requests.CogFailed,
+			}
+			if w.Repo.FailCogMessageDueToTimeoutIfTimedOut(timeoutMsg) {
+				w.CompleteAsyncJob(timeoutMsg)
+			}
+		}()
+	}
+
+	return w.Repo.GetDeadLetter(dl.ID)
+}
+
+// Puts back a replay whose request couldn't be enqueued, the job is failed again, its credits released and the dead
+// letter can be replayed again
+func (w *SCWorker) undoReplay(dl *ent.DeadLetter, input requests.BaseCogRequest, credits int32) error {
+	return w.Repo.WithTx(func(tx *ent.Tx) error {
+		DB := tx.Client()
+
+		var err error
+		switch input.ProcessType {
+		case shared.UPSCALE:
+			err = w.Repo.SetUpscaleFailed(input.ID.String(), dl.FailureReason, DB)
+		case shared.VOICEOVER:
+			err = w.Repo.SetVoiceoverFailed(input.ID.String(), dl.FailureReason, DB)
+		default:
+			err = w.Repo.SetGenerationFailed(input.ID.String(), dl.FailureReason, 0, DB)
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Repo.ReleaseCreditHold(input.ID, *input.UserID, credits, dl.FailureReason, DB); err != nil {
+			return err
+		}
+		if _, err := w.Repo.DeleteFromQueueLog(utils.Sha256(input.ID.String()), DB); err != nil {
+			return err
+		}
+		return w.Repo.ClearDeadLetterReplayed(dl.ID, DB)
+	})
+}
+
+// Send a request to the backend the router picks for its model
+func (w *SCWorker) enqueue(queueId string, cogReqBody requests.CogQueueRequest, priority uint8) error {
+	router := w.Router()
+	var routeModel RouteModel
+	switch cogReqBody.Input.ProcessType {
+	case shared.GENERATE, shared.GENERATE_AND_UPSCALE:
+		model := shared.GetCache().GetGenerationModelFromID(cogReqBody.Input.ModelId)
+		if model == nil {
+			return w.MQClient.Publish(queueId, cogReqBody, priority)
+		}
+		routeModel = GenerationRouteModel(model)
+	case shared.UPSCALE:
+		model := shared.GetCache().GetUpscaleModelFromID(cogReqBody.Input.ModelId)
+		if model == nil {
+			return w.MQClient.Publish(queueId, cogReqBody, priority)
+		}
+		routeModel = UpscaleRouteModel(model)
+	default:
+		return w.MQClient.Publish(queueId, cogReqBody, priority)
+	}
+
+	return router.Enqueue(router.Route(routeModel), routeModel, queueId, cogReqBody, priority)
+}
+
+// Pre-signed URLs the worker uploads outputs to
+func (w *SCWorker) signOutputUrls(n int, extension string) ([]string, error) {
+	urls := make([]string, n)
+	for i := range urls {
+		req, _ := w.S3.PutObjectRequest(&s3.PutObjectInput{
+			Bucket: aws.String(utils.GetEnv().S3BucketName),
+			Key:    aws.String(fmt.Sprintf("%s.%s", uuid.NewString(), extension)),
+		})
+		urlStr, err := req.Presign(24 * time.Hour)
+		if err != nil {
+			return nil, err
+		}
+		urls[i] = urlStr
+	}
+	return urls, nil
+}
@@ -13,14 +13,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/google/uuid"
-	"github.com/hibiken/asynq"
 	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/credithold"
 	"github.com/stablecog/sc-go/database/ent/upscale"
 	"github.com/stablecog/sc-go/database/enttypes"
 	"github.com/stablecog/sc-go/database/repository"
 	"github.com/stablecog/sc-go/log"
 	"github.com/stablecog/sc-go/server/clip"
-	"github.com/stablecog/sc-go/server/discord"
 	"github.com/stablecog/sc-go/server/requests"
 	"github.com/stablecog/sc-go/server/responses"
 	"github.com/stablecog/sc-go/server/stripe"
@@ -29,14 +28,82 @@ import (
 	"golang.org/x/exp/slices"
 )
 
-func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
-	r *http.Request,
-	user *ent.User,
-	apiTokenId *uuid.UUID,
-	clipSvc *clip.ClipService,
-	generateReq requests.CreateGenerationRequest) (*responses.ApiSucceededResponse, *responses.ImageGenerationSettingsResponse, *WorkerError) {
-	// Queue priority for MQ
-	var queuePriority uint8 = shared.QUEUE_PRIORITY_2
+// Fill in settings of the preset referenced by the request, if any
+func (w *SCWorker) applyGenerationPreset(userID uuid.UUID, generateReq *requests.CreateGenerationRequest) *WorkerError {
+	if generateReq.PresetID == nil {
+		return nil
+	}
+	preset, err := w.Repo.GetGenerationPresetForUser(*generateReq.PresetID, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &WorkerError{http.StatusNotFound, fmt.Errorf("preset_not_found"), ""}
+		}
+		log.Error("Error getting generation preset", "err", err, "id", *generateReq.PresetID)
+		return WorkerInternalServerError()
+	}
+	if err := applyPreset(generateReq, preset); err != nil {
+		return &WorkerError{http.StatusBadRequest, err, ""}
+	}
+	return nil
+}
+
+// Fill in settings missing from the request from preset, settings in the request take precedence
+// The prompt is rendered from the preset's template unless the request has its own
+func applyPreset(t *requests.CreateGenerationRequest, preset *ent.GenerationPreset) error {
+	if t.Prompt == "" && preset.PromptTemplate != nil {
+		prompt, err := requests.RenderPromptTemplate(*preset.PromptTemplate, t.Variables)
+		if err != nil {
+			return err
+		}
+		t.Prompt = prompt
+	}
+	if t.NegativePrompt == "" && preset.NegativePrompt != nil {
+		t.NegativePrompt = *preset.NegativePrompt
+	}
+	if t.ModelId == nil {
+		t.ModelId = preset.ModelID
+	}
+	if t.SchedulerId == nil {
+		t.SchedulerId = preset.SchedulerID
+	}
+	if t.Width == nil {
+		t.Width = preset.Width
+	}
+	if t.Height == nil {
+		t.Height = preset.Height
+	}
+	if t.InferenceSteps == nil {
+		t.InferenceSteps = preset.InferenceSteps
+	}
+	if t.GuidanceScale == nil {
+		t.GuidanceScale = preset.GuidanceScale
+	}
+	if t.NumOutputs == nil {
+		t.NumOutputs = preset.NumOutputs
+	}
+	if t.PromptStrength == nil {
+		t.PromptStrength = preset.PromptStrength
+	}
+	// Resolved, so it isn't applied again i.e. when batch items are dispatched
+	t.PresetID = nil
+	t.Variables = nil
+	return nil
+}
+
+// What a user's generations are queued with
+type generationAccess struct {
+	// Free users' outputs are submitted to the gallery
+	free bool
+	// Tier for fair share scheduling, decides the band of MQ priorities
+	queueTier    shared.QueueTier
+	qMax         int
+	isSuperAdmin bool
+}
+
+// Work out the tier of user from their plan, roles and credits
+// Must be called before credits are held for the request, held credits don't count as paid credits
+func (w *SCWorker) getGenerationAccess(user *ent.User) (*generationAccess, *WorkerError) {
+	queueTier := shared.QueueTierFree
 
 	free := user.ActiveProductID == nil
 	if free {
@@ -44,11 +111,11 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 		count, err := w.Repo.GetNonFreeCreditSum(user.ID)
 		if err != nil {
 			log.Error("Error getting paid credit sum for users", "err", err)
-			return nil, nil, WorkerInternalServerError()
+			return nil, WorkerInternalServerError()
 		}
 		free = count <= 0
 		if !free {
-			queuePriority = shared.QUEUE_PRIORITY_3
+			queueTier = shared.QueueTierCredits
 		}
 	}
 
@@ -56,7 +123,7 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 	roles, err := w.Repo.GetRoles(user.ID)
 	if err != nil {
 		log.Error("Error getting roles for user", "err", err)
-		return nil, nil, WorkerInternalServerError()
+		return nil, WorkerInternalServerError()
 	}
 	isSuperAdmin := slices.Contains(roles, "SUPER_ADMIN")
 	if isSuperAdmin {
@@ -69,15 +136,15 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 		// Starter
 		case stripe.GetProductIDs()[1]:
 			qMax = shared.MAX_QUEUED_ITEMS_STARTER
-			queuePriority = shared.QUEUE_PRIORITY_5
+			queueTier = shared.QueueTierPaid
 			// Pro
 		case stripe.GetProductIDs()[2]:
 			qMax = shared.MAX_QUEUED_ITEMS_PRO
-			queuePriority = shared.QUEUE_PRIORITY_5
+			queueTier = shared.QueueTierPaid
 		// Ultimate
 		case stripe.GetProductIDs()[3]:
 			qMax = shared.MAX_QUEUED_ITEMS_ULTIMATE
-			queuePriority = shared.QUEUE_PRIORITY_5
+			queueTier = shared.QueueTierPaid
 		default:
 			log.Warn("Unknown product ID", "product_id", *user.ActiveProductID)
 		}
@@ -110,26 +177,56 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 	}
 
 	if isSuperAdmin {
-		queuePriority = shared.QUEUE_PRIORITY_4
+		queueTier = shared.QueueTierAdmin
 	}
 
 	// With gift credits, give them a priority in between super admins and paid credits
-	if !free && queuePriority < shared.QUEUE_PRIORITY_5 {
+	if !free && queueTier != shared.QueueTierPaid {
 		// Re-evaluate if they have paid credits
 		paidCount, err := w.Repo.GetPaidCreditSum(user.ID)
 		if err != nil {
 			log.Error("Error getting paid credit sum for users", "err", err)
-			return nil, nil, WorkerInternalServerError()
+			return nil, WorkerInternalServerError()
 		}
 		if paidCount > 0 {
-			queuePriority = shared.QUEUE_PRIORITY_5
+			queueTier = shared.QueueTierPaid
 		}
 	}
 
+	return &generationAccess{
+		free:         free,
+		queueTier:    queueTier,
+		qMax:         qMax,
+		isSuperAdmin: isSuperAdmin,
+	}, nil
+}
+
+func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
+	r *http.Request,
+	user *ent.User,
+	apiTokenId *uuid.UUID,
+	clipSvc *clip.ClipService,
+	generateReq requests.CreateGenerationRequest) (*responses.ApiSucceededResponse, *responses.ImageGenerationSettingsResponse, *WorkerError) {
+	access, wErr := w.getGenerationAccess(user)
+	if wErr != nil {
+		return nil, nil, wErr
+	}
+	// Batch items keep what the batch was created with, its hold would make credits only users look free now
+	if generateReq.BatchID != nil {
+		access.free = generateReq.BatchFree
+		access.queueTier = generateReq.BatchQueueTier
+	}
+	free, queueTier, qMax, isSuperAdmin := access.free, access.queueTier, access.qMax, access.isSuperAdmin
+	var err error
+
 	if user.BannedAt != nil {
 		return nil, nil, &WorkerError{http.StatusForbidden, fmt.Errorf("user_banned"), ""}
 	}
 
+	if wErr := w.applyGenerationPreset(user.ID, &generateReq); wErr != nil {
+		return nil, nil, wErr
+	}
+
 	// Validation
 	if !isSuperAdmin {
 		err = generateReq.Validate(source != enttypes.SourceTypeWebUI)
@@ -147,6 +244,9 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 	}
 	//////////////////////////////////
 
+	// API requests can opt in to returning immediately, the result is then polled or sent to a callback
+	async := source != enttypes.SourceTypeWebUI && generateReq.IsAsync()
+
 	// Set settings resp
 	initSettings := responses.ImageGenerationSettingsResponse{
 		ModelId:        *generateReq.ModelId,
@@ -276,35 +376,13 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 		log.Warn("Error getting queue count", "err", err, "user_id", user.ID.String())
 	}
 	if err == nil && nq > qMax {
-		// Get queue overflow size
-		overflowSize, err := w.QueueThrottler.NumQueued(fmt.Sprintf("of:%s", user.ID.String()))
-		if err != nil {
-			log.Warn("Error getting queue overflow count", "err", err, "user_id", user.ID.String())
-		}
-		// If overflow size is greater than max, return error
-		if overflowSize > shared.QUEUE_OVERFLOW_MAX {
-			return nil, &initSettings, &WorkerError{http.StatusBadRequest, fmt.Errorf("queue_limit_reached"), ""}
-		}
-		// Overflow size can be 0 so we need to add 1
-		overflowSize++
-		w.QueueThrottler.IncrementBy(1, fmt.Sprintf("of:%s", user.ID.String()))
-		for {
-			time.Sleep(time.Duration(shared.QUEUE_OVERFLOW_PENALTY_MS*overflowSize) * time.Millisecond)
-			nq, err = w.QueueThrottler.NumQueued(fmt.Sprintf("g:%s", user.ID.String()))
-			if err != nil {
-				log.Warn("Error getting queue count", "err", err, "user_id", user.ID.String())
-			}
-			if err == nil && nq <= qMax {
-				w.QueueThrottler.DecrementBy(1, fmt.Sprintf("of:%s", user.ID.String()))
-				break
-			}
-			// Update overflow size
-			overflowSize, err = w.QueueThrottler.NumQueued(fmt.Sprintf("of:%s", user.ID.String()))
-			if err != nil {
-				log.Warn("Error getting queue overflow count", "err", err, "user_id", user.ID.String())
-			}
-			overflowSize++
-		}
+		return nil, &initSettings, &WorkerError{http.StatusBadRequest, fmt.Errorf("queue_limit_reached"), ""}
+	}
+
+	// Fair share admission decides the priority the request is dispatched at
+	queuePriority, wErr := w.admit(user, queueTier)
+	if wErr != nil {
+		return nil, &initSettings, wErr
 	}
 
 	// Enforce submit to gallery
@@ -341,7 +419,9 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 
 	modelName := model.NameInWorker
 
-	useRunpod := ShouldUseRunpodGenerate(model, w.Redis)
+	router := w.Router()
+	routeModel := GenerationRouteModel(model)
+	backend := router.Route(routeModel)
 
 	// Format prompts
 	generateReq.Prompt = utils.FormatPrompt(generateReq.Prompt)
@@ -364,137 +444,59 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 	// Cleanup
 	defer close(activeChl)
 
+	// Translate prompts
+	translatedPrompt, translatedNegativePrompt, err := w.SafetyChecker.TranslatePrompt(generateReq.Prompt, generateReq.NegativePrompt)
+	if err != nil {
+		log.Error("Error translating prompt", "err", err)
+		return nil, &initSettings, WorkerInternalServerError()
+	}
+
+	// Moderate before holding credits
+	verdict, wErr := w.moderatePrompt(w.Moderation(clipSvc), user, source, queueTier, generateReq.Prompt, translatedPrompt, requestOrigin{
+		IPAddress:   ipAddress,
+		CountryCode: countryCode,
+		ThumbmarkID: thumbmarkID,
+	})
+	if wErr != nil {
+		return nil, &initSettings, wErr
+	}
+
 	// Wrap everything in a DB transaction
 	// We do this since we want our credit deduction to be atomic with the whole process
 	if err := w.Repo.WithTx(func(tx *ent.Tx) error {
 		// Bind a client to the transaction
 		DB := tx.Client()
 
-		// Deduct credits from user
-		deducted, err := w.Repo.DeductCreditsFromUser(user.ID, *generateReq.NumOutputs, false, DB)
-		if err != nil {
-			log.Error("Error deducting credits", "err", err)
-			return err
-		} else if !deducted {
-			return responses.InsufficientCreditsErr
-		}
-
-		// Translate prompts
-		translatedPrompt, translatedNegativePrompt, err := w.SafetyChecker.TranslatePrompt(generateReq.Prompt, generateReq.NegativePrompt)
-		if err != nil {
-			log.Error("Error translating prompt", "err", err)
-			return err
-		}
-
-		nsfwModerationAPIResultChan := make(chan bool)
-		bannedPromptResultChan := make(chan bool)
-		errChan := make(chan error)
-
-		// Goroutine to check NSFW
-		go func() {
-			isNSFW, reason, score, err := w.SafetyChecker.IsPromptNSFW(translatedPrompt)
+		var hold *ent.CreditHold
+		if generateReq.BatchID != nil {
+			// Credits were held for the whole batch, claim the item and take its share
+			err := w.Repo.ClaimGenerationBatchItem(*generateReq.BatchID, generateReq.BatchItem, DB)
 			if err != nil {
-				log.Error("Error checking prompt NSFW", "err", err)
-				errChan <- err
-				return
+				log.Error("Error claiming batch item", "err", err, "batch_id", *generateReq.BatchID, "item", generateReq.BatchItem)
+				return err
 			}
-			if isNSFW {
-				w.Track.GenerationFailedNSFWPrompt(
-					user,
-					requests.BaseCogRequest{
-						Prompt: generateReq.Prompt,
-					},
-					"Moderation API",
-					source,
-					translatedPrompt,
-					"",
-					0.0,
-					reason,
-					score,
-					ipAddress,
-				)
-				errChan <- fmt.Errorf("nsfw: %s", reason)
-				return
+			hold, err = w.Repo.SplitCreditHold(*generateReq.BatchID, *generateReq.NumOutputs, credithold.ProcessTypeGenerate, DB)
+			if err != nil {
+				log.Error("Error splitting batch credit hold", "err", err, "batch_id", *generateReq.BatchID, "item", generateReq.BatchItem)
+				return err
 			}
-			nsfwModerationAPIResultChan <- true
-		}()
-
-		// Goroutine to check banned embedding
-		if clipSvc != nil {
-			go func() {
-				embedding, err := clipSvc.GetEmbeddingFromText(translatedPrompt, false)
-				if err != nil {
-					log.Error("Error fetching embedding", "err", err)
-					errChan <- err
-					return
-				}
-				bannedMatches, err := w.Repo.IsBannedPromptEmbedding(embedding, DB)
-				if err != nil {
-					log.Error("Error checking banned embedding", "err", err)
-					// errChan <- err
-					bannedPromptResultChan <- true
-					return
-				}
-				if len(bannedMatches) > 0 {
-					// Special case for prompts that should also cause a user ban
-					isSpecialBanned := false
-					hasShouldBanUser := false
-					for _, match := range bannedMatches {
-						if match.ShouldBanUser {
-							hasShouldBanUser = true
-							break
-						}
-					}
-					isNewAccount := time.Since(user.CreatedAt) < 24*time.Hour
-					if hasShouldBanUser && isNewAccount {
-						err := discord.FireBannedUserWebhook(ipAddress, user.Email, user.Email, user.ID.String(), countryCode, thumbmarkID, []string{"New account used special banned prompt."})
-						if err == nil {
-							_, err = w.Repo.BanUsers([]uuid.UUID{user.ID}, false)
-							if err != nil {
-								log.Error("Error banning user", "err", err)
-							}
-							time.Sleep(150 * time.Second)
-							isSpecialBanned = true
-						}
-					}
-					w.Track.GenerationFailedNSFWPrompt(
-						user,
-						requests.BaseCogRequest{
-							Prompt: generateReq.Prompt,
-						},
-						"Banned Prompt Embedding",
-						source,
-						translatedPrompt,
-						bannedMatches[0].ID.String(),
-						float64(bannedMatches[0].Similarity),
-						"",
-						0,
-						ipAddress,
-					)
-					if isSpecialBanned {
-						errChan <- fmt.Errorf("error: unknown")
-					} else {
-						errChan <- fmt.Errorf("nsfw: %s", "sexual_minors")
-					}
-					return
-				}
-				bannedPromptResultChan <- true
-			}()
-		}
-
-		// Wait for either of the two to complete successfully or fail
-		nsfwModerationAPIDone, bannedPromptDone := false, clipSvc == nil // If clipSvc is nil, mark bannedPromptDone as true
-		for !(nsfwModerationAPIDone && bannedPromptDone) {
-			select {
-			case <-nsfwModerationAPIResultChan:
-				nsfwModerationAPIDone = true
-			case <-bannedPromptResultChan:
-				bannedPromptDone = true
-			case err := <-errChan:
+		} else {
+			// Hold credits until the generation is done
+			var err error
+			hold, err = w.Repo.HoldCredits(user.ID, *generateReq.NumOutputs, credithold.ProcessTypeGenerate, DB)
+			if err != nil {
+				log.Error("Error holding credits", "err", err)
+				return err
+			} else if hold == nil {
+				return responses.InsufficientCreditsErr
+			}
+			// Batch items were reserved with their batch
+			if err := w.reserveApiTokenCredits(apiTokenId, hold, DB); err != nil {
 				return err
 			}
 		}
 
+		var err error
 		remainingCredits, err = w.Repo.GetNonExpiredCreditTotalForUser(user.ID, DB)
 		if err != nil {
 			log.Error("Error getting remaining credits", "err", err)
@@ -517,6 +519,16 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 			log.Error("Error creating generation", "err", err)
 			return err
 		}
+		if err := w.Repo.AttachCreditHold(hold.ID, g.ID, DB); err != nil {
+			log.Error("Error attaching credit hold", "err", err)
+			return err
+		}
+		if verdict != nil {
+			if err := w.Repo.SetModerationVerdictGeneration(verdict.ID, g.ID, DB); err != nil {
+				log.Error("Error linking moderation verdict", "err", err)
+				return err
+			}
+		}
 
 		// Request Id matches generation ID
 		requestId = g.ID
@@ -545,7 +557,10 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 				SkipSafetyChecker:      true,
 				SkipTranslation:        true,
 				WasAutoSubmitted:       generateReq.WasAutoSubmitted,
-				APIRequest:             source != enttypes.SourceTypeWebUI,
+				APIRequest:             source != enttypes.SourceTypeWebUI && !async,
+				Async:                  async,
+				CallbackURL:            generateReq.CallbackURL,
+				ApiTokenUsageID:        apiTokenUsageID(r),
 				ID:                     requestId,
 				IP:                     ipAddress,
 				ThumbmarkID:            thumbmarkID,
@@ -598,9 +613,10 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 			}
 		}
 
+		// API tokens' jobs have a stream too if they asked for one
+		cogReqBody.Input.StreamID = generateReq.StreamID
 		if source == enttypes.SourceTypeWebUI {
 			cogReqBody.Input.UIId = generateReq.UIId
-			cogReqBody.Input.StreamID = generateReq.StreamID
 		}
 
 		if cogReqBody.Input.InitImageUrl != "" {
@@ -617,40 +633,10 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 			return err
 		}
 
-		if !useRunpod {
-			err = w.MQClient.Publish(queueId, cogReqBody, queuePriority)
-			if err != nil {
-				log.Error("Failed to write request %s to exchange: %v", queueId, err)
-				return err
-			}
-		} else {
-			// use QueCon internal queue
-			queueName := shared.QueueByPriority(queuePriority)
-			// Enqueue task with priority
-			opts := []asynq.Option{
-				asynq.MaxRetry(3),
-				asynq.TaskID(requestId.String()), // Unique Task ID
-				asynq.Queue(queueName),           // Queue name
-			}
-
-			// Create payload
-			rpInput := requests.RunpodInput{
-				Input: cogReqBody.Input,
-			}
-			payload, err := json.Marshal(rpInput)
-			if err != nil {
-				log.Error("Error marshalling runpod payload", "err", err)
-				return err
-			}
-
-			_, err = w.AsynqClient.Enqueue(asynq.NewTask(
-				shared.ASYNQ_TASK_GENERATE,
-				payload,
-			), opts...)
-			if err != nil {
-				log.Error("Failed to enqueue task", "err", err)
-				return err
-			}
+		err = router.Enqueue(backend, routeModel, queueId, cogReqBody, queuePriority)
+		if err != nil {
+			log.Error("Failed to enqueue request", "queue_id", queueId, "backend", backend.Type(), "err", err)
+			return err
 		}
 
 		w.QueueThrottler.IncrementBy(1, fmt.Sprintf("g:%s", user.ID.String()))
@@ -660,20 +646,24 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 		if errors.Is(err, responses.InsufficientCreditsErr) {
 			return nil, &initSettings, &WorkerError{http.StatusBadRequest, responses.InsufficientCreditsErr, ""}
 		}
-		if strings.Contains(err.Error(), "nsfw:") {
-			// Get reason
-			reason := ""
-			splitStr := strings.Split(err.Error(), "nsfw:")
-			if len(splitStr) > 1 {
-				reason = splitStr[1]
-			}
-			return nil, &initSettings, &WorkerError{http.StatusBadRequest, fmt.Errorf("nsfw_prompt"), reason}
+		if errors.Is(err, repository.ApiTokenCreditCapErr) {
+			return nil, &initSettings, &WorkerError{http.StatusForbidden, repository.ApiTokenCreditCapErr, ""}
+		}
+		if errors.Is(err, repository.GenerationBatchItemDispatchedErr) {
+			return nil, &initSettings, &WorkerError{http.StatusConflict, repository.GenerationBatchItemDispatchedErr, ""}
 		}
 		return nil, &initSettings, WorkerInternalServerError()
 	}
 
+	// Accepted, count it towards the user's fair share
+	cost := 1
+	if generateReq.NumOutputs != nil {
+		cost = int(*generateReq.NumOutputs)
+	}
+	w.chargeFairShare(user, queueTier, cost)
+
 	// Add channel to sync array (basically a thread-safe map)
-	if source != enttypes.SourceTypeWebUI {
+	if source != enttypes.SourceTypeWebUI && !async {
 		w.SMap.Put(requestId.String(), activeChl)
 		defer w.SMap.Delete(requestId.String())
 		defer w.QueueThrottler.DecrementBy(1, fmt.Sprintf("g:%s", user.ID.String()))
@@ -698,24 +688,28 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 
 	// Analytics
 	go w.Track.GenerationStarted(user, cogReqBody.Input, source, ipAddress)
-	// Set timeout delay for UI
-	if source == enttypes.SourceTypeWebUI {
+	// Set timeout delay for UI and async API requests
+	if source == enttypes.SourceTypeWebUI || async {
 		// Set timeout key
 		err = w.Redis.SetCogRequestStreamID(w.Redis.Ctx, requestId.String(), generateReq.StreamID)
 		if err != nil {
 			// Don't time it out if this fails
 			log.Error("Failed to set timeout key", "err", err)
 		} else {
+			w.trackAsyncJob(cogReqBody.Input)
 			// Start the timeout timer
 			go func() {
 				// sleep
 				time.Sleep(shared.REQUEST_COG_TIMEOUT)
 				// this will trigger timeout if it hasnt been finished
-				w.Repo.FailCogMessageDueToTimeoutIfTimedOut(requests.CogWebhookMessage{
+				timeoutMsg := requests.CogWebhookMessage{
 					Input:  cogReqBody.Input,
 					Error:  shared.TIMEOUT_ERROR,
 					Status: requests.CogFailed,
-				})
+				}
+				if w.Repo.FailCogMessageDueToTimeoutIfTimedOut(timeoutMsg) {
+					w.CompleteAsyncJob(timeoutMsg)
+				}
 			}()
 		}
 
@@ -829,7 +823,7 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 
 				// Set token used
 				if generation.APITokenID != nil {
-					err = w.Repo.SetTokenUsedAndIncrementCreditsSpent(int(*generateReq.NumOutputs), *generation.APITokenID)
+					err = w.Repo.SetTokenUsedAndIncrementCreditsSpent(int(*generateReq.NumOutputs), *generation.APITokenID, apiTokenUsageID(r))
 					if err != nil {
 						log.Error("Failed to set token used", "err", err)
 					}
@@ -870,10 +864,10 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 					// Analytics
 					duration := time.Now().Sub(cogMsg.Input.LivePageData.CreatedAt).Seconds()
 					go w.Track.GenerationFailed(user, cogMsg.Input, duration, cogMsg.Error, source, ipAddress)
-					// Refund credits
-					_, err = w.Repo.RefundCreditsToUser(user.ID, *generateReq.NumOutputs, DB)
+					// Release held credits
+					_, err = w.Repo.ReleaseCreditHold(requestId, user.ID, *generateReq.NumOutputs, cogMsg.Error, DB)
 					if err != nil {
-						log.Error("Failed to refund credits", "err", err)
+						log.Error("Failed to release held credits", "err", err)
 						return err
 					}
 					return nil
@@ -881,10 +875,12 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 					log.Error("Failed to set generation failed", "id", requestId, "err", err)
 					return nil, &initSettings, WorkerInternalServerError()
 				}
+				w.deadLetter(cogReqBody, cogMsg.Error)
 
 				return nil, &initSettings, &WorkerError{http.StatusInternalServerError, fmt.Errorf(cogMsg.Error), ""}
 			}
 		case <-time.After(shared.REQUEST_COG_TIMEOUT):
+			w.Repo.FinishTimedOutBackendJob(requestId)
 			_, err := w.Repo.DeleteFromQueueLog(queueId, nil)
 			if err != nil {
 				log.Error("Error deleting from queue log", "err", err)
@@ -895,10 +891,10 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 				if err != nil {
 					log.Error("Failed to set generation failed", "id", upscale.ID, "err", err)
 				}
-				// Refund credits
-				_, err = w.Repo.RefundCreditsToUser(user.ID, *generateReq.NumOutputs, DB)
+				// Release held credits
+				_, err = w.Repo.ReleaseCreditHold(requestId, user.ID, *generateReq.NumOutputs, shared.TIMEOUT_ERROR, DB)
 				if err != nil {
-					log.Error("Failed to refund credits", "err", err)
+					log.Error("Failed to release held credits", "err", err)
 					return err
 				}
 				return nil
@@ -906,6 +902,7 @@ func (w *SCWorker) CreateGeneration(source enttypes.SourceType,
 				log.Error("Failed to set generation failed", "id", requestId, "err", err)
 				return nil, &initSettings, WorkerInternalServerError()
 			}
+			w.deadLetter(cogReqBody, shared.TIMEOUT_ERROR)
 
 			return nil, &initSettings, &WorkerError{http.StatusInternalServerError, fmt.Errorf(shared.TIMEOUT_ERROR), ""}
 		}
@@ -0,0 +1,50 @@
+package scworker
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyPreset(t *testing.T) {
+	presetID := uuid.New()
+	modelID := uuid.New()
+	preset := &ent.GenerationPreset{
+		ID:             presetID,
+		PromptTemplate: utils.ToPtr("A {{subject}} in noir"),
+		NegativePrompt: utils.ToPtr("color"),
+		ModelID:        &modelID,
+		Width:          utils.ToPtr(int32(768)),
+		Height:         utils.ToPtr(int32(512)),
+		GuidanceScale:  utils.ToPtr(float32(9)),
+	}
+
+	// Request settings take precedence
+	req := requests.CreateGenerationRequest{
+		PresetID:  &presetID,
+		Variables: map[string]string{"subject": "detective"},
+		Width:     utils.ToPtr(int32(1024)),
+	}
+	assert.Nil(t, applyPreset(&req, preset))
+	assert.Equal(t, "A detective in noir", req.Prompt)
+	assert.Equal(t, "color", req.NegativePrompt)
+	assert.Equal(t, modelID, *req.ModelId)
+	assert.Equal(t, int32(1024), *req.Width)
+	assert.Equal(t, int32(512), *req.Height)
+	assert.Equal(t, float32(9), *req.GuidanceScale)
+	assert.Nil(t, req.SchedulerId)
+	assert.Nil(t, req.PresetID)
+	assert.Nil(t, req.Variables)
+
+	// Own prompt skips the template
+	req = requests.CreateGenerationRequest{PresetID: &presetID, Prompt: "A lighthouse"}
+	assert.Nil(t, applyPreset(&req, preset))
+	assert.Equal(t, "A lighthouse", req.Prompt)
+
+	req = requests.CreateGenerationRequest{PresetID: &presetID}
+	assert.EqualError(t, applyPreset(&req, preset), "missing_template_variable: subject")
+}
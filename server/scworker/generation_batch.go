@@ -0,0 +1,359 @@
+package scworker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/credithold"
+	"github.com/stablecog/sc-go/database/ent/generation"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/clip"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// Hold credits for every item of a batch and start queueing them
+// Items are queued in the background through the same path as single API generations, so they are subject to the
+// same queue limits and fair share scheduling. Each item takes its share of the batch's hold, items that can't be
+// queued or fail release it individually
+func (w *SCWorker) CreateGenerationBatch(r *http.Request,
+	user *ent.User,
+	apiTokenId *uuid.UUID,
+	clipSvc *clip.ClipService,
+	batchReq requests.CreateGenerationBatchRequest) (*responses.ApiGenerationBatchResponse, *WorkerError) {
+	if user.BannedAt != nil {
+		return nil, &WorkerError{http.StatusForbidden, fmt.Errorf("user_banned"), ""}
+	}
+
+	// Presets are resolved up front so the credits held match the settings the items are queued with
+	for i := range batchReq.Items {
+		if wErr := w.applyGenerationPreset(user.ID, &batchReq.Items[i]); wErr != nil {
+			if wErr.StatusCode != http.StatusInternalServerError {
+				wErr.Err = fmt.Errorf("items[%d]: %w", i, wErr.Err)
+			}
+			return nil, wErr
+		}
+	}
+
+	if err := batchReq.Validate(); err != nil {
+		return nil, &WorkerError{http.StatusBadRequest, err, ""}
+	}
+
+	// Decided before the hold, items can't tell credits they paid for from the batch's hold
+	access, wErr := w.getGenerationAccess(user)
+	if wErr != nil {
+		return nil, wErr
+	}
+
+	var batch *ent.GenerationBatch
+	if err := w.Repo.WithTx(func(tx *ent.Tx) error {
+		DB := tx.Client()
+
+		hold, err := w.Repo.HoldCredits(user.ID, batchReq.Cost(), credithold.ProcessTypeGenerationBatch, DB)
+		if err != nil {
+			log.Error("Error holding credits", "err", err)
+			return err
+		} else if hold == nil {
+			return responses.InsufficientCreditsErr
+		}
+		// Items take their share of the reservation along with their share of the hold
+		if err := w.reserveApiTokenCredits(apiTokenId, hold, DB); err != nil {
+			return err
+		}
+
+		batch, err = w.Repo.CreateGenerationBatch(user.ID, apiTokenId, batchReq, access.free, access.queueTier, generationBatchOrigin(r), DB)
+		if err != nil {
+			log.Error("Error creating generation batch", "err", err)
+			return err
+		}
+		return w.Repo.AttachCreditHold(hold.ID, batch.ID, DB)
+	}); err != nil {
+		if errors.Is(err, responses.InsufficientCreditsErr) {
+			return nil, &WorkerError{http.StatusBadRequest, responses.InsufficientCreditsErr, ""}
+		}
+		if errors.Is(err, repository.ApiTokenCreditCapErr) {
+			return nil, &WorkerError{http.StatusForbidden, repository.ApiTokenCreditCapErr, ""}
+		}
+		return nil, WorkerInternalServerError()
+	}
+
+	go w.dispatchGenerationBatch(batch, clipSvc)
+
+	res, err := w.GetGenerationBatch(batch.ID, user.ID)
+	if err != nil {
+		log.Error("Error getting generation batch", "err", err, "id", batch.ID)
+		return nil, WorkerInternalServerError()
+	}
+	return res, nil
+}
+
+// Create variations of an output of user as a batch, its items are derived from the output's generation
+func (w *SCWorker) CreateGenerationVariations(r *http.Request,
+	user *ent.User,
+	apiTokenId *uuid.UUID,
+	clipSvc *clip.ClipService,
+	variationsReq requests.CreateGenerationVariationsRequest) (*responses.ApiGenerationBatchResponse, *WorkerError) {
+	if err := variationsReq.Validate(); err != nil {
+		return nil, &WorkerError{http.StatusBadRequest, err, ""}
+	}
+
+	output, err := w.Repo.GetGenerationOutputForVariations(variationsReq.SourceOutputID, user.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &WorkerError{http.StatusNotFound, fmt.Errorf("source_output_not_found"), ""}
+		}
+		log.Error("Error getting source output for variations", "err", err, "id", variationsReq.SourceOutputID)
+		return nil, WorkerInternalServerError()
+	}
+	source := output.Edges.Generations
+	var prompt, negativePrompt string
+	if source.Edges.Prompt != nil {
+		prompt = source.Edges.Prompt.Text
+	}
+	if source.Edges.NegativePrompt != nil {
+		negativePrompt = source.Edges.NegativePrompt.Text
+	}
+
+	return w.CreateGenerationBatch(r, user, apiTokenId, clipSvc, requests.CreateGenerationBatchRequest{
+		Items:          generationVariationItems(variationsReq, source, prompt, negativePrompt, utils.GetEnv().GetURLFromImagePath(output.ImagePath)),
+		SourceOutputID: &output.ID,
+		VariationType:  &variationsReq.Type,
+	})
+}
+
+// Generation requests of the variations in t of source, whose output image is at sourceImageURL
+func generationVariationItems(t requests.CreateGenerationVariationsRequest, source *ent.Generation, prompt string, negativePrompt string, sourceImageURL string) []requests.CreateGenerationRequest {
+	items := make([]requests.CreateGenerationRequest, t.NumVariations)
+	for i := range items {
+		item := requests.CreateGenerationRequest{
+			Prompt:          prompt,
+			NegativePrompt:  negativePrompt,
+			Width:           utils.ToPtr(source.Width),
+			Height:          utils.ToPtr(source.Height),
+			InferenceSteps:  utils.ToPtr(source.InferenceSteps),
+			GuidanceScale:   utils.ToPtr(source.GuidanceScale),
+			ModelId:         utils.ToPtr(source.ModelID),
+			SchedulerId:     utils.ToPtr(source.SchedulerID),
+			Seed:            utils.ToPtr(source.Seed),
+			NumOutputs:      utils.ToPtr(int32(1)),
+			AsyncJobOptions: t.AsyncJobOptions,
+		}
+		switch t.Type {
+		case generationbatch.VariationTypeSeed:
+			// Whole steps around the source's guidance scale, skipping the source's own i.e. -1, 1, 2 steps for 3
+			steps := i - t.NumVariations/2
+			if steps >= 0 {
+				steps++
+			}
+			offset := *t.GuidanceStep * float32(steps)
+			*item.GuidanceScale = min(max(source.GuidanceScale+offset, shared.MIN_GUIDANCE_SCALE), shared.MAX_GUIDANCE_SCALE)
+			if source.InitImageURL != nil {
+				item.InitImageUrl = *source.InitImageURL
+				item.PromptStrength = source.PromptStrength
+			}
+			if source.MaskImageURL != nil {
+				item.MaskImageUrl = *source.MaskImageURL
+			}
+		case generationbatch.VariationTypeStrengthSweep:
+			item.Prompt = t.TargetPrompt
+			item.InitImageUrl = sourceImageURL
+			item.PromptStrength = utils.ToPtr(float32(i+1) / float32(t.NumVariations+1))
+		}
+		items[i] = item
+	}
+	return items
+}
+
+// Continue dispatching batches that were interrupted, i.e. by a restart
+func (w *SCWorker) ResumeGenerationBatches(clipSvc *clip.ClipService) {
+	batches, err := w.Repo.GetDispatchingGenerationBatches()
+	if err != nil {
+		log.Error("Error getting dispatching generation batches", "err", err)
+		return
+	}
+	for _, batch := range batches {
+		log.Info("Resuming generation batch", "id", batch.ID, "dispatched", batch.DispatchedItems, "items", batch.NumItems)
+		go w.dispatchGenerationBatch(batch, clipSvc)
+	}
+}
+
+// Headers of the request creating a batch that its items are queued with
+func generationBatchOrigin(r *http.Request) *enttypes.RequestOrigin {
+	if r == nil {
+		return nil
+	}
+	origin := &enttypes.RequestOrigin{
+		IPAddress:   utils.GetIPAddress(r),
+		CountryCode: utils.GetCountryCode(r),
+		ThumbmarkID: utils.GetThumbmarkID(r),
+		UserAgent:   r.Header.Get("User-Agent"),
+	}
+	if usageID := apiTokenUsageID(r); usageID != nil {
+		origin.ApiTokenUsageID = usageID.String()
+	}
+	return origin
+}
+
+// Request standing in for the one that created the batch, items are queued in the background after it's gone
+func generationBatchRequest(origin *enttypes.RequestOrigin) *http.Request {
+	if origin == nil {
+		return nil
+	}
+	ctx := context.Background()
+	if origin.ApiTokenUsageID != "" {
+		ctx = context.WithValue(ctx, "api_token_usage_id", origin.ApiTokenUsageID)
+	}
+	r, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/", nil)
+	r.Header.Set("CF-Connecting-IP", origin.IPAddress)
+	r.Header.Set("CF-IPCountry", origin.CountryCode)
+	r.Header.Set("X-Thumbmark-ID", origin.ThumbmarkID)
+	r.Header.Set("User-Agent", origin.UserAgent)
+	return r
+}
+
+// Queue the remaining items of a batch in order
+// Items are claimed in the database as they are queued, if another dispatcher gets to an item first this one stops
+// Items still over the queue limit after GENERATION_BATCH_MAX_RETRIES are rejected
+func (w *SCWorker) dispatchGenerationBatch(batch *ent.GenerationBatch, clipSvc *clip.ClipService) {
+	var items []requests.CreateGenerationRequest
+	if err := json.Unmarshal([]byte(batch.Items), &items); err != nil {
+		log.Error("Error unmarshalling generation batch items", "id", batch.ID, "err", err)
+		w.failGenerationBatch(batch.ID, "batch_items_invalid")
+		return
+	}
+	r := generationBatchRequest(batch.Origin)
+
+	retries := 0
+	for i := batch.DispatchedItems; i < len(items); {
+		// Fetch every time, their plan or ban status may change while the batch is dispatching
+		user, err := w.Repo.GetUser(batch.UserID)
+		if err != nil || user == nil {
+			log.Error("Error getting user for generation batch", "id", batch.ID, "err", err)
+			w.failGenerationBatch(batch.ID, "batch_user_not_found")
+			return
+		}
+
+		item := items[i]
+		item.BatchID = &batch.ID
+		item.BatchItem = i
+		item.SourceOutputID = batch.SourceOutputID
+		item.BatchFree = batch.Free
+		item.BatchQueueTier = shared.QueueTier(batch.QueueTier)
+		_, _, wErr := w.CreateGeneration(enttypes.SourceTypeAPI, r, user, batch.APITokenID, clipSvc, item)
+		if wErr == nil {
+			i++
+			retries = 0
+			continue
+		}
+
+		switch {
+		case errors.Is(wErr.Err, repository.GenerationBatchItemDispatchedErr):
+			return
+		case wErr.Err.Error() == "queue_limit_reached" && retries < shared.GENERATION_BATCH_MAX_RETRIES:
+			retries++
+			time.Sleep(shared.GENERATION_BATCH_RETRY_INTERVAL)
+			continue
+		}
+
+		log.Info("Generation batch item rejected", "id", batch.ID, "item", i, "err", wErr.Err)
+		if err := w.rejectGenerationBatchItem(batch.ID, i, item.Cost()); err != nil {
+			if !errors.Is(err, repository.GenerationBatchItemDispatchedErr) {
+				log.Error("Error rejecting generation batch item", "id", batch.ID, "item", i, "err", err)
+				w.failGenerationBatch(batch.ID, "batch_item_rejected")
+			}
+			return
+		}
+		i++
+		retries = 0
+	}
+
+	if err := w.Repo.SetGenerationBatchDispatched(batch.ID); err != nil {
+		log.Error("Error setting generation batch dispatched", "id", batch.ID, "err", err)
+	}
+}
+
+// Skip an item that could not be queued and release its share of the batch's hold
+func (w *SCWorker) rejectGenerationBatchItem(batchID uuid.UUID, item int, credits int32) error {
+	return w.Repo.WithTx(func(tx *ent.Tx) error {
+		return w.Repo.RejectGenerationBatchItem(batchID, item, credits, tx.Client())
+	})
+}
+
+// Stop dispatching a batch that can't continue, so its hold isn't left held forever
+func (w *SCWorker) failGenerationBatch(batchID uuid.UUID, reason string) {
+	if err := w.Repo.WithTx(func(tx *ent.Tx) error {
+		return w.Repo.FailGenerationBatch(batchID, reason, tx.Client())
+	}); err != nil && !errors.Is(err, repository.GenerationBatchItemDispatchedErr) {
+		log.Error("Error failing generation batch", "id", batchID, "err", err)
+	}
+}
+
+// Progress of a batch owned by user
+func (w *SCWorker) GetGenerationBatch(id uuid.UUID, userID uuid.UUID) (*responses.ApiGenerationBatchResponse, error) {
+	batch, err := w.Repo.GetGenerationBatchForUser(id, userID)
+	if err != nil {
+		return nil, err
+	}
+	generations, err := w.Repo.GetGenerationBatchItems(batch.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &responses.ApiGenerationBatchResponse{
+		ID:              batch.ID,
+		NumItems:        batch.NumItems,
+		CreditsReserved: batch.CreditsReserved,
+		CreditsRefunded: batch.CreditsRefunded,
+		Items:           make([]responses.ApiJobResponse, len(generations)),
+		CreatedAt:       batch.CreatedAt,
+		DispatchedAt:    batch.DispatchedAt,
+		SourceOutputID:  batch.SourceOutputID,
+		Progress: responses.ApiGenerationBatchCounts{
+			Pending:  batch.NumItems - batch.DispatchedItems,
+			Rejected: batch.RejectedItems,
+		},
+	}
+	if batch.VariationType != nil {
+		res.VariationType = utils.ToPtr(string(*batch.VariationType))
+	}
+	for i, g := range generations {
+		res.Items[i] = *generationJobResponse(g)
+		switch g.Status {
+		case generation.StatusQueued:
+			res.Progress.Queued++
+		case generation.StatusStarted:
+			res.Progress.Started++
+		case generation.StatusSucceeded:
+			res.Progress.Succeeded++
+		case generation.StatusFailed:
+			res.Progress.Failed++
+			// Failures are refunded, except when the outputs were NSFW
+			if g.FailureReason == nil || *g.FailureReason != shared.NSFW_ERROR {
+				res.CreditsRefunded += g.NumOutputs
+			}
+		}
+	}
+
+	switch {
+	case batch.Status == generationbatch.StatusDispatching:
+		res.Status = string(generationbatch.StatusDispatching)
+	case batch.Status == generationbatch.StatusFailed && res.Progress.Queued+res.Progress.Started == 0:
+		res.Status = string(generationbatch.StatusFailed)
+	case res.Progress.Queued+res.Progress.Started > 0:
+		res.Status = "processing"
+	default:
+		res.Status = "completed"
+	}
+	return res, nil
+}
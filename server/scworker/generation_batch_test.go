@@ -0,0 +1,91 @@
+package scworker
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/generationbatch"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerationBatchOriginRoundTrip(t *testing.T) {
+	assert.Nil(t, generationBatchOrigin(nil))
+	assert.Nil(t, generationBatchRequest(nil))
+
+	usageID := uuid.New()
+	req := httptest.NewRequest("POST", "/", nil)
+	req.Header.Set("CF-Connecting-IP", "1.2.3.4")
+	req.Header.Set("CF-IPCountry", "DE")
+	req.Header.Set("X-Thumbmark-ID", "thumb")
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Safari/605.1.15")
+	req = req.WithContext(context.WithValue(req.Context(), "api_token_usage_id", usageID.String()))
+
+	origin := generationBatchOrigin(req)
+	assert.Equal(t, "1.2.3.4", origin.IPAddress)
+	assert.Equal(t, usageID.String(), origin.ApiTokenUsageID)
+
+	// Stands in for the original request wherever generations read it
+	r := generationBatchRequest(origin)
+	assert.Equal(t, "1.2.3.4", utils.GetIPAddress(r))
+	assert.Equal(t, "DE", utils.GetCountryCode(r))
+	assert.Equal(t, "thumb", utils.GetThumbmarkID(r))
+	assert.Equal(t, utils.GetClientDeviceInfo(req), utils.GetClientDeviceInfo(r))
+	assert.Equal(t, usageID, *apiTokenUsageID(r))
+}
+
+func TestGenerationVariationItems(t *testing.T) {
+	source := &ent.Generation{
+		Width:          768,
+		Height:         512,
+		InferenceSteps: 30,
+		GuidanceScale:  2,
+		ModelID:        uuid.New(),
+		SchedulerID:    uuid.New(),
+		Seed:           42,
+	}
+
+	// Seed variations spread the guidance scale around the source's
+	req := requests.CreateGenerationVariationsRequest{SourceOutputID: uuid.New(), Type: generationbatch.VariationTypeSeed, GuidanceStep: utils.ToPtr[float32](1)}
+	assert.Nil(t, req.Validate())
+	items := generationVariationItems(req, source, "a cat", "blurry", "http://test.com/source.jpeg")
+	assert.Len(t, items, 4)
+	var guidance []float32
+	for _, item := range items {
+		guidance = append(guidance, *item.GuidanceScale)
+		assert.Equal(t, "a cat", item.Prompt)
+		assert.Equal(t, "blurry", item.NegativePrompt)
+		assert.Equal(t, 42, *item.Seed)
+		assert.Equal(t, int32(1), *item.NumOutputs)
+		assert.Equal(t, source.ModelID, *item.ModelId)
+		assert.Equal(t, "", item.InitImageUrl)
+	}
+	// Clamped to the minimum
+	assert.Equal(t, []float32{1, 1, 3, 4}, guidance)
+
+	// None of them are the source's guidance scale, which would regenerate the source image
+	req = requests.CreateGenerationVariationsRequest{SourceOutputID: uuid.New(), Type: generationbatch.VariationTypeSeed, NumVariations: 3, GuidanceStep: utils.ToPtr[float32](0.5)}
+	assert.Nil(t, req.Validate())
+	items = generationVariationItems(req, source, "a cat", "blurry", "http://test.com/source.jpeg")
+	guidance = nil
+	for _, item := range items {
+		guidance = append(guidance, *item.GuidanceScale)
+	}
+	assert.Equal(t, []float32{1.5, 2.5, 3}, guidance)
+
+	// Strength sweeps redo the source image with the target prompt at increasing prompt strength
+	req = requests.CreateGenerationVariationsRequest{SourceOutputID: uuid.New(), Type: generationbatch.VariationTypeStrengthSweep, NumVariations: 3, TargetPrompt: "a dog"}
+	assert.Nil(t, req.Validate())
+	items = generationVariationItems(req, source, "a cat", "blurry", "http://test.com/source.jpeg")
+	assert.Len(t, items, 3)
+	for i, item := range items {
+		assert.Equal(t, "a dog", item.Prompt)
+		assert.Equal(t, "http://test.com/source.jpeg", item.InitImageUrl)
+		assert.Equal(t, float32(i+1)/4, *item.PromptStrength)
+		assert.Equal(t, float32(2), *item.GuidanceScale)
+	}
+}
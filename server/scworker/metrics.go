@@ -0,0 +1,85 @@
+package scworker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/shared"
+)
+
+// Number of queued requests per fair share tier, read from the queue log at most once per QUEUE_DEPTH_CACHE_TTL
+type QueueDepthCache struct {
+	repo      *repository.Repository
+	mu        sync.Mutex
+	depth     map[shared.QueueTier]int
+	fetchedAt time.Time
+}
+
+func NewQueueDepthCache(repo *repository.Repository) *QueueDepthCache {
+	return &QueueDepthCache{repo: repo}
+}
+
+func (c *QueueDepthCache) Get() (map[shared.QueueTier]int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.depth != nil && time.Since(c.fetchedAt) < shared.QUEUE_DEPTH_CACHE_TTL {
+		return c.depth, nil
+	}
+	depth, err := c.repo.GetQueueDepthByTier()
+	if err != nil {
+		return nil, err
+	}
+	c.depth = depth
+	c.fetchedAt = time.Now()
+	return depth, nil
+}
+
+// Whether any requests are waiting for workers
+func (c *QueueDepthCache) Contended() (bool, error) {
+	depth, err := c.Get()
+	if err != nil {
+		return false, err
+	}
+	for _, n := range depth {
+		if n > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Exposes the number of queued requests per fair share tier
+type QueueDepthCollector struct {
+	cache *QueueDepthCache
+	depth *prometheus.Desc
+}
+
+func NewQueueDepthCollector(cache *QueueDepthCache) *QueueDepthCollector {
+	return &QueueDepthCollector{
+		cache: cache,
+		depth: prometheus.NewDesc(
+			"sc_queue_depth",
+			"Number of requests waiting in the queue by tier",
+			[]string{"tier"},
+			nil,
+		),
+	}
+}
+
+func (c *QueueDepthCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.depth
+}
+
+func (c *QueueDepthCollector) Collect(ch chan<- prometheus.Metric) {
+	depth, err := c.cache.Get()
+	if err != nil {
+		log.Error("Error getting queue depth", "err", err)
+		return
+	}
+	for tier, n := range depth {
+		ch <- prometheus.MustNewConstMetric(c.depth, prometheus.GaugeValue, float64(n), string(tier))
+	}
+}
@@ -0,0 +1,142 @@
+package scworker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/moderationverdict"
+	"github.com/stablecog/sc-go/database/enttypes"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/clip"
+	"github.com/stablecog/sc-go/server/discord"
+	"github.com/stablecog/sc-go/server/moderation"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// Pipeline prompts go through, banned prompt embeddings are only checked with clipSvc
+func (w *SCWorker) Moderation(clipSvc *clip.ClipService) *moderation.Pipeline {
+	checkers := []moderation.Checker{
+		&moderation.KeywordChecker{},
+		&moderation.ModerationAPIChecker{API: w.SafetyChecker},
+	}
+	if clipSvc != nil {
+		checkers = append(checkers, &moderation.EmbeddingChecker{Embedder: clipSvc, Matcher: w.Repo})
+	}
+	if url := utils.GetEnv().ModerationClassifierUrl; url != "" {
+		checkers = append(checkers, &moderation.ClassifierChecker{URL: url})
+	}
+	return moderation.NewPipeline(checkers, moderation.LoadPolicies())
+}
+
+// What analytics calls each checker
+var moderationFailureSources = map[string]string{
+	moderation.CheckerKeyword:         "Banned Words",
+	moderation.CheckerBannedEmbedding: "Banned Prompt Embedding",
+	moderation.CheckerModerationAPI:   "Moderation API",
+	moderation.CheckerClassifier:      "Classifier",
+}
+
+// Request headers, for the banned user webhook
+type requestOrigin struct {
+	IPAddress   string
+	CountryCode string
+	ThumbmarkID string
+}
+
+// Moderate a prompt and record the verdict, banning the user if it says to
+// Returns the recorded verdict, nil if it couldn't be recorded, and an error if the prompt can't be generated
+func (w *SCWorker) moderatePrompt(pipeline *moderation.Pipeline, user *ent.User, source enttypes.SourceType, tier shared.QueueTier, prompt string, translatedPrompt string, origin requestOrigin) (*ent.ModerationVerdict, *WorkerError) {
+	verdict := pipeline.Moderate(context.Background(), moderation.Input{
+		UserID:           user.ID,
+		UserCreatedAt:    user.CreatedAt,
+		Source:           source,
+		Tier:             tier,
+		Prompt:           prompt,
+		TranslatedPrompt: translatedPrompt,
+	})
+
+	newVerdict := repository.NewModerationVerdict{
+		UserID:     user.ID,
+		SourceType: source,
+		Tier:       string(tier),
+		Policy:     verdict.Policy,
+		Prompt:     prompt,
+		Action:     moderationverdict.Action(verdict.Action),
+		Findings:   verdict.Findings,
+		Errors:     verdict.Errors,
+	}
+	if translatedPrompt != prompt {
+		newVerdict.TranslatedPrompt = utils.ToPtr(translatedPrompt)
+	}
+	if verdict.Reason != "" {
+		newVerdict.Reason = utils.ToPtr(verdict.Reason)
+	}
+	// The verdict stands whether or not it's recorded
+	recorded, err := w.Repo.CreateModerationVerdict(newVerdict, nil)
+	if err != nil {
+		log.Error("Error recording moderation verdict", "err", err, "user_id", user.ID)
+		recorded = nil
+	}
+
+	switch verdict.Action {
+	case moderation.ActionAllow, moderation.ActionFlag:
+		if verdict.Action == moderation.ActionFlag {
+			log.Info("Flagged prompt", "user_id", user.ID, "reason", verdict.Reason, "policy", verdict.Policy)
+		}
+		return recorded, nil
+	}
+
+	if verdict.Unavailable() {
+		log.Error("Couldn't moderate prompt", "user_id", user.ID, "errors", verdict.Errors)
+		return recorded, WorkerInternalServerError()
+	}
+
+	finding := verdict.Decisive
+	failureSource, ok := moderationFailureSources[finding.Checker]
+	if !ok {
+		failureSource = finding.Checker
+	}
+	var bannedPromptID, nsfwReason string
+	var similarity float64
+	var score float32
+	if finding.Checker == moderation.CheckerBannedEmbedding {
+		bannedPromptID = finding.Match
+		similarity = finding.Score
+	} else {
+		nsfwReason = finding.Category
+		score = float32(finding.Score)
+	}
+	w.Track.GenerationFailedNSFWPrompt(
+		user,
+		requests.BaseCogRequest{
+			Prompt: prompt,
+		},
+		failureSource,
+		source,
+		translatedPrompt,
+		bannedPromptID,
+		similarity,
+		nsfwReason,
+		score,
+		origin.IPAddress,
+	)
+
+	if verdict.Action == moderation.ActionBan {
+		if _, err := w.Repo.BanUsers([]uuid.UUID{user.ID}, false); err != nil {
+			log.Error("Error banning user", "err", err, "user_id", user.ID)
+		}
+		if err := discord.FireBannedUserWebhook(origin.IPAddress, user.Email, user.Email, user.ID.String(), origin.CountryCode, origin.ThumbmarkID, []string{fmt.Sprintf("Moderation policy %s banned the user: %s", verdict.Policy, verdict.Reason)}); err != nil {
+			log.Error("Error firing banned user webhook", "err", err)
+		}
+		// Don't tell them why
+		return recorded, WorkerInternalServerError()
+	}
+
+	return recorded, &WorkerError{http.StatusBadRequest, fmt.Errorf("nsfw_prompt"), verdict.Reason}
+}
@@ -0,0 +1,118 @@
+package scworker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/repository"
+	"github.com/stablecog/sc-go/server/responses"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+)
+
+// Where a job of the user is in the queue, with estimates of when it starts and finishes
+// Jobs ahead are assumed to drain as fast as the queue has been moving, run time comes from recent jobs of the same model
+func (w *SCWorker) GetQueueStatus(jobID uuid.UUID, userID uuid.UUID) (*responses.QueueStatusResponse, error) {
+	job, err := w.Repo.GetQueuedJobForUser(jobID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &responses.QueueStatusResponse{
+		JobID:       job.ID,
+		ProcessType: job.ProcessType,
+		Status:      job.Status,
+	}
+
+	now := time.Now()
+	timings, err := w.Repo.GetRecentJobTimings(job.ProcessType, job.ModelID, now.Add(-shared.QUEUE_ETA_WINDOW), shared.QUEUE_ETA_SAMPLES)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case job.CompletedAt != nil:
+		res.EstimatedStartAt = job.StartedAt
+		res.EstimatedFinishAt = job.CompletedAt
+		return res, nil
+	case job.StartedAt != nil:
+		res.EstimatedStartAt = job.StartedAt
+	default:
+		// Only waiting jobs are in line, the position is one past the jobs ahead
+		res.Total, err = w.Repo.CountQueuedWaiting()
+		if err != nil {
+			return nil, err
+		}
+		item, err := w.Repo.GetQueueLogItem(utils.Sha256(job.ID.String()))
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		} else if item != nil && !item.IsProcessing {
+			res.Ahead, err = w.Repo.CountQueuedAhead(item)
+			if err != nil {
+				return nil, err
+			}
+			res.Position = res.Ahead + 1
+		}
+
+		rate, err := w.jobStartRate()
+		if err != nil {
+			return nil, err
+		}
+		if rate > 0 {
+			res.EstimatedStartAt = utils.ToPtr(now.Add(time.Duration(float64(res.Ahead) / rate * float64(time.Second))))
+		} else if timings.Samples > 0 {
+			// Nothing started lately, fall back to how long jobs of this model usually wait
+			start := job.CreatedAt.Add(time.Duration(timings.AvgQueueS * float64(time.Second)))
+			if start.Before(now) {
+				start = now
+			}
+			res.EstimatedStartAt = &start
+		}
+	}
+
+	if res.EstimatedStartAt != nil && timings.Samples > 0 {
+		finish := res.EstimatedStartAt.Add(time.Duration(timings.AvgRunS * float64(time.Second)))
+		// Taking longer than usual
+		if finish.Before(now) {
+			finish = now
+		}
+		res.EstimatedFinishAt = &finish
+	}
+	return res, nil
+}
+
+// Jobs started per second over QUEUE_ETA_RATE_WINDOW, measured at most once per QUEUE_ETA_RATE_CACHE_TTL
+type JobStartRateCache struct {
+	repo      *repository.Repository
+	mu        sync.Mutex
+	rate      float64
+	fetchedAt time.Time
+}
+
+func NewJobStartRateCache(repo *repository.Repository) *JobStartRateCache {
+	return &JobStartRateCache{repo: repo}
+}
+
+func (c *JobStartRateCache) Get() (float64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.fetchedAt.IsZero() && time.Since(c.fetchedAt) < shared.QUEUE_ETA_RATE_CACHE_TTL {
+		return c.rate, nil
+	}
+	rate, err := c.repo.GetJobStartRate(time.Now().Add(-shared.QUEUE_ETA_RATE_WINDOW))
+	if err != nil {
+		return 0, err
+	}
+	c.rate = rate
+	c.fetchedAt = time.Now()
+	return rate, nil
+}
+
+func (w *SCWorker) jobStartRate() (float64, error) {
+	if w.StartRate != nil {
+		return w.StartRate.Get()
+	}
+	return w.Repo.GetJobStartRate(time.Now().Add(-shared.QUEUE_ETA_RATE_WINDOW))
+}
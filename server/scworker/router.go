@@ -0,0 +1,357 @@
+package scworker
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+	"github.com/stablecog/sc-go/database"
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/shared/queue"
+	"github.com/stablecog/sc-go/utils"
+	"golang.org/x/exp/slices"
+)
+
+// A place jobs run, the sc-worker queue, runpod through quecon or another provider
+type InferenceBackend interface {
+	Type() shared.BackendType
+	// Whether the backend can run jobs for the model
+	Serves(model RouteModel) bool
+	Enqueue(queueId string, cogReqBody requests.CogQueueRequest, priority uint8) error
+}
+
+// What the router needs to know about a generation or upscale model
+type RouteModel struct {
+	ID             uuid.UUID
+	RunpodEndpoint *string
+	// Set by an admin to send all jobs to runpod
+	RunpodActive bool
+}
+
+func GenerationRouteModel(model *ent.GenerationModel) RouteModel {
+	return RouteModel{
+		ID:             model.ID,
+		RunpodEndpoint: model.RunpodEndpoint,
+		RunpodActive:   model.RunpodActive,
+	}
+}
+
+func UpscaleRouteModel(model *ent.UpscaleModel) RouteModel {
+	return RouteModel{
+		ID:             model.ID,
+		RunpodEndpoint: model.RunpodEndpoint,
+		RunpodActive:   model.RunpodActive,
+	}
+}
+
+// sc-worker, through the message queue
+type scWorkerBackend struct {
+	mq queue.MQClient
+}
+
+func (b *scWorkerBackend) Type() shared.BackendType {
+	return shared.BackendScWorker
+}
+
+func (b *scWorkerBackend) Serves(model RouteModel) bool {
+	return true
+}
+
+func (b *scWorkerBackend) Enqueue(queueId string, cogReqBody requests.CogQueueRequest, priority uint8) error {
+	return b.mq.Publish(queueId, cogReqBody, priority)
+}
+
+// Runpod serverless, through the quecon asynq queues
+type runpodBackend struct {
+	asynq *asynq.Client
+	// For finding the task of an earlier attempt of a job
+	redis *redis.Client
+}
+
+func (b *runpodBackend) Type() shared.BackendType {
+	return shared.BackendRunpodServerless
+}
+
+func (b *runpodBackend) Serves(model RouteModel) bool {
+	return model.RunpodEndpoint != nil
+}
+
+func (b *runpodBackend) Enqueue(queueId string, cogReqBody requests.CogQueueRequest, priority uint8) error {
+	payload, err := json.Marshal(requests.RunpodInput{
+		Input: cogReqBody.Input,
+	})
+	if err != nil {
+		return err
+	}
+	task := asynq.NewTask(shared.AsynqTaskForProcessType(cogReqBody.Input.ProcessType), payload)
+	opts := []asynq.Option{asynq.MaxRetry(shared.ASYNQ_JOB_MAX_RETRY), asynq.TaskID(cogReqBody.Input.ID.String()), asynq.Queue(shared.QueueByPriority(priority))}
+	_, err = b.asynq.Enqueue(task, opts...)
+	if !errors.Is(err, asynq.ErrTaskIDConflict) {
+		return err
+	}
+
+	// A replayed dead letter, its failed attempt is kept archived. The replay takes its place
+	replaced, err := deleteAsynqTask(b.redis, cogReqBody.Input.ID.String(), finishedAsynqTaskStates)
+	if err != nil {
+		return err
+	}
+	if !replaced {
+		// Still queued or running
+		log.Info("Job already has a runpod task", "id", cogReqBody.Input.ID)
+		return nil
+	}
+	_, err = b.asynq.Enqueue(task, opts...)
+	return err
+}
+
+func newAsynqInspector(client *redis.Client) *asynq.Inspector {
+	options := client.Options()
+	return asynq.NewInspector(asynq.RedisClientOpt{
+		Addr:     options.Addr,
+		DB:       options.DB,
+		Password: options.Password,
+	})
+}
+
+// Task states of a job that's done with, archived after failing or retained after completing
+var finishedAsynqTaskStates = []asynq.TaskState{asynq.TaskStateArchived, asynq.TaskStateCompleted}
+
+// Task states of a job that isn't being processed, whether it's waiting, retained or archived
+var inactiveAsynqTaskStates = []asynq.TaskState{
+	asynq.TaskStatePending,
+	asynq.TaskStateScheduled,
+	asynq.TaskStateRetry,
+	asynq.TaskStateAggregating,
+	asynq.TaskStateArchived,
+	asynq.TaskStateCompleted,
+}
+
+// Remove the task of a job from the quecon queues if it's in one of states
+// Returns false if there's none, or it's in another state
+func deleteAsynqTask(client *redis.Client, id string, states []asynq.TaskState) (bool, error) {
+	inspector := newAsynqInspector(client)
+	defer inspector.Close()
+
+	queues, err := inspector.Queues()
+	if err != nil {
+		return false, err
+	}
+	for _, queue := range queues {
+		info, err := inspector.GetTaskInfo(queue, id)
+		if errors.Is(err, asynq.ErrTaskNotFound) || errors.Is(err, asynq.ErrQueueNotFound) {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		if !slices.Contains(states, info.State) {
+			return false, nil
+		}
+		return true, inspector.DeleteTask(queue, id)
+	}
+	return false, nil
+}
+
+// Picks a backend for each job from the health of the backends serving its model
+// Healthy backends share jobs by BACKEND_ROUTE_WEIGHTS, scaled by score and latency
+// A model fails over to standby backends when its weighted ones turn unhealthy, and back once probes succeed again
+// Backends whose circuit is open get no jobs at all, half-open ones only get probes
+type Router struct {
+	Health   *shared.BackendHealthTracker
+	Breaker  *shared.CircuitBreaker
+	Backends []InferenceBackend
+	// Uniform in [0, 1), replaced in tests
+	rand func() float64
+}
+
+func NewRouter(redis *database.RedisWrapper, mqClient queue.MQClient, asynqClient *asynq.Client) *Router {
+	return &Router{
+		Health:  shared.NewBackendHealthTracker(redis.Ctx, redis.Client),
+		Breaker: shared.NewCircuitBreaker(redis.Ctx, redis.Client, utils.GetEnv().GetCircuitBreakerConfig()),
+		Backends: []InferenceBackend{
+			&scWorkerBackend{mq: mqClient},
+			&runpodBackend{asynq: asynqClient, redis: redis.Client},
+		},
+		rand: rand.Float64,
+	}
+}
+
+func (w *SCWorker) Router() *Router {
+	return NewRouter(w.Redis, w.MQClient, w.AsynqClient)
+}
+
+// A backend serving a model, with its health and share of the model's jobs
+type BackendRoute struct {
+	shared.BackendHealth
+	Circuit shared.CircuitState `json:"circuit"`
+	Weight  float64             `json:"weight"`
+	// Score scaled by how fast the backend is compared to the fastest one
+	fitness float64
+	backend InferenceBackend
+}
+
+// Backends that serve model, in the order of r.Backends
+func (r *Router) Routes(model RouteModel) ([]BackendRoute, error) {
+	var routes []BackendRoute
+	fastest := 0.0
+	for _, backend := range r.Backends {
+		if !backend.Serves(model) {
+			continue
+		}
+		health, err := r.Health.Get(model.ID, backend.Type())
+		if err != nil {
+			return nil, err
+		}
+		circuit, err := r.Breaker.Status(backend.Type())
+		if err != nil {
+			return nil, err
+		}
+		if health.LatencyMs > 0 && (fastest == 0 || health.LatencyMs < fastest) {
+			fastest = health.LatencyMs
+		}
+		routes = append(routes, BackendRoute{BackendHealth: *health, Circuit: circuit.State, backend: backend})
+	}
+
+	for i := range routes {
+		routes[i].fitness = routes[i].Score
+		if fastest > 0 && routes[i].LatencyMs > 0 {
+			routes[i].fitness *= fastest / routes[i].LatencyMs
+		}
+		if routes[i].Healthy() && routes[i].Circuit == shared.CircuitClosed {
+			routes[i].Weight = shared.BACKEND_ROUTE_WEIGHTS[routes[i].Backend] * routes[i].fitness
+		}
+	}
+	return routes, nil
+}
+
+// Backend to send the next job for model to
+func (r *Router) Route(model RouteModel) InferenceBackend {
+	routes, err := r.Routes(model)
+	if err != nil {
+		// Can't tell, go with the primary backend
+		log.Error("Error getting backend health", "model_id", model.ID, "err", err)
+		return r.Backends[0]
+	}
+	if len(routes) == 1 {
+		return routes[0].backend
+	}
+
+	if model.RunpodActive {
+		for _, route := range routes {
+			if route.Backend == shared.BackendRunpodServerless {
+				log.Info("🏃‍♂️‍➡️📦 Using Runpod, pinned by admin", "model_id", model.ID)
+				return route.backend
+			}
+		}
+	}
+
+	// Half-open backends get probes until their circuit closes or opens again
+	for _, route := range routes {
+		if route.Circuit != shared.CircuitHalfOpen {
+			continue
+		}
+		allowed, err := r.Breaker.AllowProbe(route.Backend)
+		if err != nil {
+			log.Error("Error claiming circuit probe", "backend", route.Backend, "err", err)
+			continue
+		}
+		if allowed {
+			log.Info("Probing half-open backend", "model_id", model.ID, "backend", route.Backend)
+			return route.backend
+		}
+	}
+
+	// Some jobs go to unhealthy backends so they can recover
+	var unhealthy []BackendRoute
+	for _, route := range routes {
+		if !route.Healthy() && route.Circuit == shared.CircuitClosed {
+			unhealthy = append(unhealthy, route)
+		}
+	}
+	if len(unhealthy) > 0 && len(unhealthy) < len(routes) && r.rand() < shared.BACKEND_PROBE_RATE {
+		probe := unhealthy[int(r.rand()*float64(len(unhealthy)))]
+		log.Info("Probing unhealthy backend", "model_id", model.ID, "backend", probe.Backend, "score", probe.Score)
+		return probe.backend
+	}
+
+	total := 0.0
+	for _, route := range routes {
+		total += route.Weight
+	}
+	if total > 0 {
+		pick := r.rand() * total
+		for _, route := range routes {
+			if route.Weight <= 0 {
+				continue
+			}
+			pick -= route.Weight
+			if pick < 0 {
+				return route.backend
+			}
+		}
+		// Rounding
+		for i := len(routes) - 1; i >= 0; i-- {
+			if routes[i].Weight > 0 {
+				return routes[i].backend
+			}
+		}
+	}
+
+	// Failover to the fittest healthy standby, or the least unhealthy backend if none are healthy
+	// Backends with a closed circuit first, an open one is only used when all of them are open
+	best := -1
+	for i, route := range routes {
+		if best == -1 || routeRank(route, routes[best]) > 0 {
+			best = i
+		}
+	}
+	log.Info("Failing over", "model_id", model.ID, "backend", routes[best].Backend, "score", routes[best].Score)
+	return routes[best].backend
+}
+
+// Positive if a is a better failover target than b
+func routeRank(a BackendRoute, b BackendRoute) int {
+	aClosed, bClosed := a.Circuit == shared.CircuitClosed, b.Circuit == shared.CircuitClosed
+	if aClosed != bClosed {
+		if aClosed {
+			return 1
+		}
+		return -1
+	}
+	if a.Healthy() != b.Healthy() {
+		if a.Healthy() {
+			return 1
+		}
+		return -1
+	}
+	if a.fitness > b.fitness {
+		return 1
+	} else if a.fitness < b.fitness {
+		return -1
+	}
+	return 0
+}
+
+// Send a job to backend, its result counts towards the backend's health for model
+func (r *Router) Enqueue(backend InferenceBackend, model RouteModel, queueId string, cogReqBody requests.CogQueueRequest, priority uint8) error {
+	err := backend.Enqueue(queueId, cogReqBody, priority)
+	if err != nil {
+		if _, rErr := r.Health.Record(model.ID, backend.Type(), false, 0); rErr != nil {
+			log.Error("Error recording backend failure", "backend", backend.Type(), "err", rErr)
+		}
+		if _, rErr := r.Breaker.Record(backend.Type(), false); rErr != nil {
+			log.Error("Error recording circuit failure", "backend", backend.Type(), "err", rErr)
+		}
+		return err
+	}
+	if err := r.Health.StartJob(cogReqBody.Input.ID, model.ID, backend.Type()); err != nil {
+		log.Error("Error tracking job backend", "backend", backend.Type(), "err", err)
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+package scworker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+	"github.com/stablecog/sc-go/server/requests"
+	"github.com/stablecog/sc-go/shared"
+	"github.com/stablecog/sc-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeBackend struct {
+	backendType shared.BackendType
+	serves      func(model RouteModel) bool
+	err         error
+	enqueued    int
+}
+
+func (b *fakeBackend) Type() shared.BackendType {
+	return b.backendType
+}
+
+func (b *fakeBackend) Serves(model RouteModel) bool {
+	return b.serves == nil || b.serves(model)
+}
+
+func (b *fakeBackend) Enqueue(queueId string, cogReqBody requests.CogQueueRequest, priority uint8) error {
+	if b.err != nil {
+		return b.err
+	}
+	b.enqueued++
+	return nil
+}
+
+func testRouter(t *testing.T) (*Router, *fakeBackend, *fakeBackend) {
+	mr := miniredis.RunT(t)
+	scWorker := &fakeBackend{backendType: shared.BackendScWorker}
+	runpod := &fakeBackend{
+		backendType: shared.BackendRunpodServerless,
+		serves:      func(model RouteModel) bool { return model.RunpodEndpoint != nil },
+	}
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	return &Router{
+		Health: shared.NewBackendHealthTracker(context.Background(), client),
+		Breaker: shared.NewCircuitBreaker(context.Background(), client, shared.CircuitBreakerConfig{
+			FailureThreshold: 3,
+			OpenDuration:     time.Hour,
+			ProbeSuccesses:   1,
+		}),
+		Backends: []InferenceBackend{scWorker, runpod},
+		rand:     func() float64 { return 0.5 },
+	}, scWorker, runpod
+}
+
+func TestRouteFailsOverPerModel(t *testing.T) {
+	router, scWorker, runpod := testRouter(t)
+	failing := RouteModel{ID: uuid.New(), RunpodEndpoint: utils.ToPtr("failing")}
+	other := RouteModel{ID: uuid.New(), RunpodEndpoint: utils.ToPtr("other")}
+
+	// Healthy sc-worker gets everything, runpod is standby
+	assert.Equal(t, scWorker, router.Route(failing))
+
+	for i := 0; i < 7; i++ {
+		_, err := router.Health.Record(failing.ID, shared.BackendScWorker, false, 0)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, runpod, router.Route(failing))
+	// Only for that model
+	assert.Equal(t, scWorker, router.Route(other))
+
+	// Unless the model isn't on runpod
+	assert.Equal(t, scWorker, router.Route(RouteModel{ID: failing.ID}))
+
+	// Some jobs probe sc-worker
+	router.rand = func() float64 { return 0 }
+	assert.Equal(t, scWorker, router.Route(failing))
+	router.rand = func() float64 { return 0.5 }
+
+	// It comes back after probes succeed
+	for i := 0; i < 2; i++ {
+		_, err := router.Health.Record(failing.ID, shared.BackendScWorker, true, time.Second)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, scWorker, router.Route(failing))
+
+	// Least unhealthy when both are down
+	for i := 0; i < 10; i++ {
+		_, err := router.Health.Record(failing.ID, shared.BackendRunpodServerless, false, 0)
+		assert.Nil(t, err)
+		_, err = router.Health.Record(failing.ID, shared.BackendScWorker, false, 0)
+		assert.Nil(t, err)
+	}
+	_, err := router.Health.Record(failing.ID, shared.BackendRunpodServerless, true, time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, runpod, router.Route(failing))
+
+	// Admin pin wins
+	failing.RunpodActive = true
+	assert.Equal(t, runpod, router.Route(failing))
+}
+
+func TestRouteWeights(t *testing.T) {
+	router, scWorker, runpod := testRouter(t)
+	model := RouteModel{ID: uuid.New(), RunpodEndpoint: utils.ToPtr("endpoint")}
+
+	orgWeights := shared.BACKEND_ROUTE_WEIGHTS
+	defer func() { shared.BACKEND_ROUTE_WEIGHTS = orgWeights }()
+	shared.BACKEND_ROUTE_WEIGHTS = map[shared.BackendType]float64{
+		shared.BackendScWorker:         1,
+		shared.BackendRunpodServerless: 1,
+	}
+
+	// Runpod takes twice as long, so it gets a third of the jobs
+	_, err := router.Health.Record(model.ID, shared.BackendScWorker, true, 2*time.Second)
+	assert.Nil(t, err)
+	_, err = router.Health.Record(model.ID, shared.BackendRunpodServerless, true, 4*time.Second)
+	assert.Nil(t, err)
+	routes, err := router.Routes(model)
+	assert.Nil(t, err)
+	assert.Len(t, routes, 2)
+	assert.InDelta(t, 1.0, routes[0].Weight, 0.001)
+	assert.InDelta(t, 0.5, routes[1].Weight, 0.001)
+
+	router.rand = func() float64 { return 0.6 }
+	assert.Equal(t, scWorker, router.Route(model))
+	router.rand = func() float64 { return 0.7 }
+	assert.Equal(t, runpod, router.Route(model))
+}
+
+func TestRouteCircuitBreaker(t *testing.T) {
+	router, scWorker, runpod := testRouter(t)
+	model := RouteModel{ID: uuid.New(), RunpodEndpoint: utils.ToPtr("endpoint")}
+
+	// Open circuit moves every model on runpod off sc-worker, however healthy it looks for the model
+	_, err := router.Breaker.Trip(shared.BackendScWorker)
+	assert.Nil(t, err)
+	assert.Equal(t, runpod, router.Route(model))
+	assert.Equal(t, runpod, router.Route(RouteModel{ID: uuid.New(), RunpodEndpoint: utils.ToPtr("other")}))
+	routes, err := router.Routes(model)
+	assert.Nil(t, err)
+	assert.Equal(t, shared.CircuitOpen, routes[0].Circuit)
+	assert.Equal(t, 0.0, routes[0].Weight)
+	// Models only sc-worker serves stay on it
+	assert.Equal(t, scWorker, router.Route(RouteModel{ID: uuid.New()}))
+
+	// An open circuit is only used when every circuit is open
+	_, err = router.Breaker.Trip(shared.BackendRunpodServerless)
+	assert.Nil(t, err)
+	_, err = router.Health.Record(model.ID, shared.BackendRunpodServerless, false, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, scWorker, router.Route(model))
+	_, err = router.Breaker.Reset(shared.BackendRunpodServerless)
+	assert.Nil(t, err)
+
+	// Half-open sends one probe to sc-worker, the rest stay on runpod
+	router.Breaker.Config.OpenDuration = 0
+	assert.Equal(t, scWorker, router.Route(model))
+	router.Breaker.Config.OpenDuration = time.Hour
+	assert.Equal(t, runpod, router.Route(model))
+
+	// Traffic comes back once the probe succeeds
+	status, err := router.Breaker.Record(shared.BackendScWorker, true)
+	assert.Nil(t, err)
+	assert.Equal(t, shared.CircuitClosed, status.State)
+	assert.Equal(t, scWorker, router.Route(model))
+}
+
+func TestRouterEnqueueTracksJob(t *testing.T) {
+	router, scWorker, _ := testRouter(t)
+	model := RouteModel{ID: uuid.New()}
+	body := requests.CogQueueRequest{Input: requests.BaseCogRequest{ID: uuid.New()}}
+
+	assert.Nil(t, router.Enqueue(scWorker, model, "queue-id", body, shared.QUEUE_PRIORITY_1))
+	assert.Equal(t, 1, scWorker.enqueued)
+	backend, err := router.Health.FinishJob(body.Input.ID, true)
+	assert.Nil(t, err)
+	assert.Equal(t, shared.BackendScWorker, backend)
+	health, err := router.Health.Get(model.ID, shared.BackendScWorker)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), health.Samples)
+	assert.Equal(t, 1.0, health.Score)
+
+	// Failing to enqueue counts against the backend
+	scWorker.err = fmt.Errorf("connection refused")
+	assert.NotNil(t, router.Enqueue(scWorker, model, "queue-id", body, shared.QUEUE_PRIORITY_1))
+	health, err = router.Health.Get(model.ID, shared.BackendScWorker)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), health.Samples)
+	assert.InDelta(t, 0.9, health.Score, 0.001)
+}
+
+func TestDeleteAsynqTask(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	asynqClient := asynq.NewClient(asynq.RedisClientOpt{Addr: mr.Addr()})
+	defer asynqClient.Close()
+	inspector := asynq.NewInspector(asynq.RedisClientOpt{Addr: mr.Addr()})
+	defer inspector.Close()
+
+	deleted, err := deleteAsynqTask(client, "missing", inactiveAsynqTaskStates)
+	assert.Nil(t, err)
+	assert.False(t, deleted)
+
+	// A waiting job is only deleted when it's cancelled, not when it's replaced
+	id := uuid.NewString()
+	queue := shared.QueueByPriority(shared.QUEUE_PRIORITY_5)
+	_, err = asynqClient.Enqueue(asynq.NewTask(shared.ASYNQ_TASK_GENERATE, nil), asynq.TaskID(id), asynq.Queue(queue))
+	assert.Nil(t, err)
+	deleted, err = deleteAsynqTask(client, id, finishedAsynqTaskStates)
+	assert.Nil(t, err)
+	assert.False(t, deleted)
+
+	// An archived job, e.g. one that became a dead letter
+	assert.Nil(t, inspector.ArchiveTask(queue, id))
+	_, err = asynqClient.Enqueue(asynq.NewTask(shared.ASYNQ_TASK_GENERATE, nil), asynq.TaskID(id), asynq.Queue(queue))
+	assert.ErrorIs(t, err, asynq.ErrTaskIDConflict)
+
+	deleted, err = deleteAsynqTask(client, id, inactiveAsynqTaskStates)
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+	_, err = asynqClient.Enqueue(asynq.NewTask(shared.ASYNQ_TASK_GENERATE, nil), asynq.TaskID(id), asynq.Queue(queue))
+	assert.Nil(t, err)
+}
+
+func TestRunpodBackendReplacesFinishedTask(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	asynqClient := asynq.NewClient(asynq.RedisClientOpt{Addr: mr.Addr()})
+	defer asynqClient.Close()
+	inspector := asynq.NewInspector(asynq.RedisClientOpt{Addr: mr.Addr()})
+	defer inspector.Close()
+	backend := &runpodBackend{asynq: asynqClient, redis: client}
+
+	body := requests.CogQueueRequest{Input: requests.BaseCogRequest{ID: uuid.New(), ProcessType: shared.GENERATE}}
+	queue := shared.QueueByPriority(shared.QUEUE_PRIORITY_5)
+	assert.Nil(t, backend.Enqueue("queue-id", body, shared.QUEUE_PRIORITY_5))
+
+	// Already queued, enqueueing again is a no-op
+	assert.Nil(t, backend.Enqueue("queue-id", body, shared.QUEUE_PRIORITY_5))
+	pending, err := inspector.ListPendingTasks(queue)
+	assert.Nil(t, err)
+	assert.Len(t, pending, 1)
+
+	// A replay takes the place of the archived attempt
+	assert.Nil(t, inspector.ArchiveTask(queue, body.Input.ID.String()))
+	assert.Nil(t, backend.Enqueue("queue-id", body, shared.QUEUE_PRIORITY_5))
+	info, err := inspector.GetTaskInfo(queue, body.Input.ID.String())
+	assert.Nil(t, err)
+	assert.Equal(t, asynq.TaskStatePending, info.State)
+}
@@ -0,0 +1,40 @@
+package scworker
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/log"
+	"github.com/stablecog/sc-go/shared"
+)
+
+// Admission decision from the fair share scheduler, returns the MQ priority to dispatch at, lower for users ahead of their share
+// Users are only turned away while other requests are waiting, nothing is charged until the request is accepted
+func (w *SCWorker) admit(user *ent.User, queueTier shared.QueueTier) (uint8, *WorkerError) {
+	contended := false
+	if w.QueueDepth != nil {
+		var err error
+		contended, err = w.QueueDepth.Contended()
+		if err != nil {
+			log.Warn("Error getting queue depth", "err", err)
+		}
+	}
+	decision, err := w.Scheduler.Admit(user.ID.String(), queueTier, contended)
+	if err != nil {
+		// Don't turn requests away because of redis
+		log.Warn("Error getting admission decision", "err", err, "user_id", user.ID.String())
+		return shared.QUEUE_TIER_CONFIGS[queueTier].Priority, nil
+	}
+	if !decision.Admitted {
+		return 0, &WorkerError{http.StatusBadRequest, fmt.Errorf("queue_limit_reached"), fmt.Sprintf("Try again in %d seconds", int(decision.RetryAfter.Seconds())+1)}
+	}
+	return decision.Priority, nil
+}
+
+// Count an accepted request towards the user's fair share
+func (w *SCWorker) chargeFairShare(user *ent.User, queueTier shared.QueueTier, cost int) {
+	if err := w.Scheduler.Charge(user.ID.String(), queueTier, cost); err != nil {
+		log.Warn("Error charging fair share", "err", err, "user_id", user.ID.String())
+	}
+}
@@ -13,8 +13,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/google/uuid"
-	"github.com/hibiken/asynq"
 	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/credithold"
 	"github.com/stablecog/sc-go/database/ent/upscale"
 	"github.com/stablecog/sc-go/database/enttypes"
 	"github.com/stablecog/sc-go/database/repository"
@@ -32,8 +32,8 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 	user *ent.User,
 	apiTokenId *uuid.UUID,
 	upscaleReq requests.CreateUpscaleRequest) (*responses.ApiSucceededResponse, *responses.ImageUpscaleSettingsResponse, *WorkerError) {
-	// Queue priority for MQ
-	var queuePriority uint8 = shared.QUEUE_PRIORITY_2
+	// Tier for fair share scheduling, decides the band of MQ priorities
+	queueTier := shared.QueueTierFree
 
 	free := user.ActiveProductID == nil
 	if free {
@@ -45,7 +45,7 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 		}
 		free = count <= 0
 		if !free {
-			queuePriority = shared.QUEUE_PRIORITY_3
+			queueTier = shared.QueueTierCredits
 		}
 	}
 
@@ -66,15 +66,15 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 		// Starter
 		case stripe.GetProductIDs()[1]:
 			qMax = shared.MAX_QUEUED_ITEMS_STARTER
-			queuePriority = shared.QUEUE_PRIORITY_5
+			queueTier = shared.QueueTierPaid
 			// Pro
 		case stripe.GetProductIDs()[2]:
 			qMax = shared.MAX_QUEUED_ITEMS_PRO
-			queuePriority = shared.QUEUE_PRIORITY_5
+			queueTier = shared.QueueTierPaid
 		// Ultimate
 		case stripe.GetProductIDs()[3]:
 			qMax = shared.MAX_QUEUED_ITEMS_ULTIMATE
-			queuePriority = shared.QUEUE_PRIORITY_5
+			queueTier = shared.QueueTierPaid
 		default:
 			log.Warn("Unknown product ID", "product_id", *user.ActiveProductID)
 		}
@@ -107,11 +107,11 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 	}
 
 	if isSuperAdmin {
-		queuePriority = shared.QUEUE_PRIORITY_4
+		queueTier = shared.QueueTierAdmin
 	}
 
 	// With gift credits, give them a priority in between super admins and paid credits
-	if !free && queuePriority < shared.QUEUE_PRIORITY_5 {
+	if !free && queueTier != shared.QueueTierPaid {
 		// Re-evaluate if they have paid credits
 		paidCount, err := w.Repo.GetPaidCreditSum(user.ID)
 		if err != nil {
@@ -119,7 +119,7 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 			return nil, nil, WorkerInternalServerError()
 		}
 		if paidCount > 0 {
-			queuePriority = shared.QUEUE_PRIORITY_5
+			queueTier = shared.QueueTierPaid
 		}
 	}
 
@@ -133,6 +133,9 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 		return nil, nil, &WorkerError{http.StatusBadRequest, err, ""}
 	}
 
+	// API requests can opt in to returning immediately, the result is then polled or sent to a callback
+	async := source != enttypes.SourceTypeWebUI && upscaleReq.IsAsync()
+
 	// Set settings resp
 	initSettings := responses.ImageUpscaleSettingsResponse{
 		ModelId: *upscaleReq.ModelId,
@@ -156,38 +159,16 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 			log.Warn("Error getting queue count", "err", err, "user_id", user.ID.String())
 		}
 		if err == nil && nq > qMax {
-			// Get queue overflow size
-			overflowSize, err := w.QueueThrottler.NumQueued(fmt.Sprintf("of:%s", user.ID.String()))
-			if err != nil {
-				log.Warn("Error getting queue overflow count", "err", err, "user_id", user.ID.String())
-			}
-			// If overflow size is greater than max, return error
-			if overflowSize > shared.QUEUE_OVERFLOW_MAX {
-				return nil, nil, &WorkerError{http.StatusBadRequest, fmt.Errorf("queue_limit_reached"), ""}
-			}
-			// Overflow size can be 0 so we need to add 1
-			overflowSize++
-			w.QueueThrottler.IncrementBy(1, fmt.Sprintf("of:%s", user.ID.String()))
-			for {
-				time.Sleep(time.Duration(shared.QUEUE_OVERFLOW_PENALTY_MS*overflowSize) * time.Millisecond)
-				nq, err = w.QueueThrottler.NumQueued(fmt.Sprintf("u:%s", user.ID.String()))
-				if err != nil {
-					log.Warn("Error getting queue count", "err", err, "user_id", user.ID.String())
-				}
-				if err == nil && nq <= qMax {
-					w.QueueThrottler.DecrementBy(1, fmt.Sprintf("of:%s", user.ID.String()))
-					break
-				}
-				// Update overflow size
-				overflowSize, err = w.QueueThrottler.NumQueued(fmt.Sprintf("of:%s", user.ID.String()))
-				if err != nil {
-					log.Warn("Error getting queue overflow count", "err", err, "user_id", user.ID.String())
-				}
-				overflowSize++
-			}
+			return nil, nil, &WorkerError{http.StatusBadRequest, fmt.Errorf("queue_limit_reached"), ""}
 		}
 	}
 
+	// Fair share admission decides the priority the request is dispatched at
+	queuePriority, wErr := w.admit(user, queueTier)
+	if wErr != nil {
+		return nil, nil, wErr
+	}
+
 	// Parse request headers
 	var countryCode string
 	var thumbmarkID string
@@ -215,7 +196,9 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 		return nil, nil, WorkerInternalServerError()
 	}
 	modelName = model.NameInWorker
-	useRunpod := ShouldUseRunpodUpscale(model, w.Redis)
+	router := w.Router()
+	routeModel := UpscaleRouteModel(model)
+	backend := router.Route(routeModel)
 
 	// Initiate upscale
 	// We need to get width/height, from our database if output otherwise from the external image
@@ -357,14 +340,17 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 	if err := w.Repo.WithTx(func(tx *ent.Tx) error {
 		// Bind a client to the transaction
 		DB := tx.Client()
-		// Deduct credits from user
-		deducted, err := w.Repo.DeductCreditsFromUser(user.ID, 1, false, DB)
+		// Hold credits until the upscale is done
+		hold, err := w.Repo.HoldCredits(user.ID, 1, credithold.ProcessTypeUpscale, DB)
 		if err != nil {
-			log.Error("Error deducting credits", "err", err)
+			log.Error("Error holding credits", "err", err)
 			return err
-		} else if !deducted {
+		} else if hold == nil {
 			return responses.InsufficientCreditsErr
 		}
+		if err := w.reserveApiTokenCredits(apiTokenId, hold, DB); err != nil {
+			return err
+		}
 
 		remainingCredits, err = w.Repo.GetNonExpiredCreditTotalForUser(user.ID, DB)
 		if err != nil {
@@ -391,6 +377,10 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 			log.Error("Error creating upscale", "err", err)
 			return err
 		}
+		if err := w.Repo.AttachCreditHold(hold.ID, upscale.ID, DB); err != nil {
+			log.Error("Error attaching credit hold", "err", err)
+			return err
+		}
 
 		// Request Id matches upscale ID
 		requestId = upscale.ID
@@ -417,7 +407,10 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 			WebhookUrl:          fmt.Sprintf("%s/v1/worker/webhook", utils.GetEnv().PublicApiUrl),
 			Input: requests.BaseCogRequest{
 				WebhookPrivateUrl:    fmt.Sprintf("%s/v1/worker/webhook", utils.GetEnv().PrivateApiUrl),
-				APIRequest:           source != enttypes.SourceTypeWebUI,
+				APIRequest:           source != enttypes.SourceTypeWebUI && !async,
+				Async:                async,
+				CallbackURL:          upscaleReq.CallbackURL,
+				ApiTokenUsageID:      apiTokenUsageID(r),
 				ID:                   requestId,
 				IP:                   ipAddress,
 				ThumbmarkID:          thumbmarkID,
@@ -441,7 +434,7 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 				RunpodEndpoint:       model.RunpodEndpoint,
 			},
 		}
-		if useRunpod {
+		if backend.Type() == shared.BackendRunpodServerless {
 			cogReqBody.Input.Images = []string{imageUrl}
 		}
 
@@ -461,9 +454,10 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 
 		cogReqBody.Input.SignedUrls[0] = urlStr
 
+		// API tokens' jobs have a stream too if they asked for one
+		cogReqBody.Input.StreamID = upscaleReq.StreamID
 		if source == enttypes.SourceTypeWebUI {
 			cogReqBody.Input.UIId = upscaleReq.UIId
-			cogReqBody.Input.StreamID = upscaleReq.StreamID
 		}
 
 		_, err = w.Repo.AddToQueueLog(queueId, int(queuePriority), DB)
@@ -472,41 +466,10 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 			return err
 		}
 
-		if !useRunpod {
-			err = w.MQClient.Publish(queueId, cogReqBody, queuePriority)
-			if err != nil {
-				log.Error("Failed to write request %s to queue: %v", queueId, err)
-				return err
-			}
-		} else {
-			// use QueCon internal queue
-			queueName := shared.QueueByPriority(queuePriority)
-			// Enqueue task with priority
-			opts := []asynq.Option{
-				asynq.MaxRetry(3),
-				asynq.TaskID(requestId.String()), // Unique Task ID
-				asynq.Queue(queueName),           // Queue name
-			}
-
-			// Create payload
-			rpInput := requests.RunpodInput{
-				Input: cogReqBody.Input,
-			}
-
-			payload, err := json.Marshal(rpInput)
-			if err != nil {
-				log.Error("Error marshalling runpod payload", "err", err)
-				return err
-			}
-
-			_, err = w.AsynqClient.Enqueue(asynq.NewTask(
-				shared.ASYNQ_TASK_GENERATE,
-				payload,
-			), opts...)
-			if err != nil {
-				log.Error("Failed to enqueue task", "err", err)
-				return err
-			}
+		err = router.Enqueue(backend, routeModel, queueId, cogReqBody, queuePriority)
+		if err != nil {
+			log.Error("Failed to enqueue request", "queue_id", queueId, "backend", backend.Type(), "err", err)
+			return err
 		}
 
 		w.QueueThrottler.IncrementBy(1, fmt.Sprintf("u:%s", user.ID.String()))
@@ -516,10 +479,17 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 		if errors.Is(err, responses.InsufficientCreditsErr) {
 			return nil, &initSettings, &WorkerError{http.StatusBadRequest, responses.InsufficientCreditsErr, ""}
 		}
+		if errors.Is(err, repository.ApiTokenCreditCapErr) {
+			return nil, &initSettings, &WorkerError{http.StatusForbidden, repository.ApiTokenCreditCapErr, ""}
+		}
 		return nil, &initSettings, WorkerInternalServerError()
 	}
+
+	// Accepted, count it towards the user's fair share
+	w.chargeFairShare(user, queueTier, 1)
+
 	// Add channel to sync array (basically a thread-safe map)
-	if source != enttypes.SourceTypeWebUI {
+	if source != enttypes.SourceTypeWebUI && !async {
 		w.SMap.Put(requestId.String(), activeChl)
 		defer w.SMap.Delete(requestId.String())
 		defer w.QueueThrottler.DecrementBy(1, fmt.Sprintf("u:%s", user.ID.String()))
@@ -545,24 +515,28 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 	// Analytics
 	go w.Track.UpscaleStarted(user, cogReqBody.Input, source, ipAddress)
 
-	// Set timeout delay for UI
-	if source == enttypes.SourceTypeWebUI {
+	// Set timeout delay for UI and async API requests
+	if source == enttypes.SourceTypeWebUI || async {
 		// Set timeout key
 		err = w.Redis.SetCogRequestStreamID(w.Redis.Ctx, requestId.String(), upscaleReq.StreamID)
 		if err != nil {
 			// Don't time it out if this fails
 			log.Error("Failed to set timeout key", "err", err)
 		} else {
+			w.trackAsyncJob(cogReqBody.Input)
 			// Start the timeout timer
 			go func() {
 				// sleep
 				time.Sleep(shared.REQUEST_COG_TIMEOUT)
 				// this will trigger timeout if it hasnt been finished
-				w.Repo.FailCogMessageDueToTimeoutIfTimedOut(requests.CogWebhookMessage{
+				timeoutMsg := requests.CogWebhookMessage{
 					Input:  cogReqBody.Input,
 					Error:  shared.TIMEOUT_ERROR,
 					Status: requests.CogFailed,
-				})
+				}
+				if w.Repo.FailCogMessageDueToTimeoutIfTimedOut(timeoutMsg) {
+					w.CompleteAsyncJob(timeoutMsg)
+				}
 			}()
 		}
 
@@ -671,7 +645,7 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 
 				// Set token used
 				if upscale.APITokenID != nil {
-					err = w.Repo.SetTokenUsedAndIncrementCreditsSpent(1, *upscale.APITokenID)
+					err = w.Repo.SetTokenUsedAndIncrementCreditsSpent(1, *upscale.APITokenID, apiTokenUsageID(r))
 					if err != nil {
 						log.Error("Failed to set token used", "err", err)
 					}
@@ -712,10 +686,10 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 					//  Analytics
 					duration := time.Now().Sub(cogMsg.Input.LivePageData.CreatedAt).Seconds()
 					go w.Track.UpscaleFailed(user, cogMsg.Input, duration, cogMsg.Error, source, ipAddress)
-					// Refund credits
-					_, err = w.Repo.RefundCreditsToUser(user.ID, int32(1), DB)
+					// Release held credits
+					_, err = w.Repo.ReleaseCreditHold(requestId, user.ID, int32(1), cogMsg.Error, DB)
 					if err != nil {
-						log.Error("Failed to refund credits", "err", err)
+						log.Error("Failed to release held credits", "err", err)
 						return err
 					}
 					return nil
@@ -723,10 +697,12 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 					log.Error("Failed to set upscale failed", "id", requestId, "err", err)
 					return nil, &initSettings, WorkerInternalServerError()
 				}
+				w.deadLetter(cogReqBody, cogMsg.Error)
 
 				return nil, &initSettings, WorkerInternalServerError()
 			}
 		case <-time.After(shared.REQUEST_COG_TIMEOUT):
+			w.Repo.FinishTimedOutBackendJob(requestId)
 			_, err := w.Repo.DeleteFromQueueLog(queueId, nil)
 			if err != nil {
 				log.Error("Error deleting from queue log", "err", err)
@@ -737,10 +713,10 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 				if err != nil {
 					log.Error("Failed to set upscale failed", "id", upscale.ID, "err", err)
 				}
-				// Refund credits
-				_, err = w.Repo.RefundCreditsToUser(user.ID, int32(1), DB)
+				// Release held credits
+				_, err = w.Repo.ReleaseCreditHold(requestId, user.ID, int32(1), shared.TIMEOUT_ERROR, DB)
 				if err != nil {
-					log.Error("Failed to refund credits", "err", err)
+					log.Error("Failed to release held credits", "err", err)
 					return err
 				}
 				return nil
@@ -748,6 +724,7 @@ func (w *SCWorker) CreateUpscale(source enttypes.SourceType,
 				log.Error("Failed to set upscale failed", "id", requestId, "err", err)
 				return nil, &initSettings, WorkerInternalServerError()
 			}
+			w.deadLetter(cogReqBody, shared.TIMEOUT_ERROR)
 
 			return nil, &initSettings, &WorkerError{http.StatusInternalServerError, fmt.Errorf(shared.TIMEOUT_ERROR), ""}
 		}
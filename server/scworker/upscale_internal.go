@@ -47,7 +47,9 @@ func CreateUpscaleInternal(AsynqClient *asynq.Client, S3 *s3.S3, Track *analytic
 		Input:   output.ID.String(),
 		ModelId: utils.ToPtr(upscaleModel.ID),
 	}
-	useRunpod := ShouldUseRunpodUpscale(upscaleModel, Redis)
+	router := NewRouter(Redis, MQClient, AsynqClient)
+	routeModel := UpscaleRouteModel(upscaleModel)
+	backend := router.Route(routeModel)
 
 	var upscale *ent.Upscale
 	var requestId uuid.UUID
@@ -133,7 +135,7 @@ func CreateUpscaleInternal(AsynqClient *asynq.Client, S3 *s3.S3, Track *analytic
 				RunpodEndpoint:       upscaleModel.RunpodEndpoint,
 			},
 		}
-		if useRunpod {
+		if backend.Type() == shared.BackendRunpodServerless {
 			cogReqBody.Input.Images = []string{utils.GetEnv().GetURLFromImagePath(output.ImagePath)}
 		}
 
@@ -159,40 +161,10 @@ func CreateUpscaleInternal(AsynqClient *asynq.Client, S3 *s3.S3, Track *analytic
 			return err
 		}
 
-		if !useRunpod {
-			err = MQClient.Publish(queueId, cogReqBody, shared.QUEUE_PRIORITY_1)
-			if err != nil {
-				log.Error("Failed to write request to queue", "id", queueId, "err", err)
-				return err
-			}
-		} else {
-			// use QueCon internal queue
-			queueName := shared.QueueByPriority(shared.QUEUE_PRIORITY_1)
-			// Enqueue task with priority
-			opts := []asynq.Option{
-				asynq.MaxRetry(3),
-				asynq.TaskID(requestId.String()), // Unique Task ID
-				asynq.Queue(queueName),           // Queue name
-			}
-
-			// Create payload
-			rpInput := requests.RunpodInput{
-				Input: cogReqBody.Input,
-			}
-			payload, err := json.Marshal(rpInput)
-			if err != nil {
-				log.Error("Error marshalling runpod payload", "err", err)
-				return err
-			}
-
-			_, err = AsynqClient.Enqueue(asynq.NewTask(
-				shared.ASYNQ_TASK_GENERATE,
-				payload,
-			), opts...)
-			if err != nil {
-				log.Error("Failed to enqueue task", "err", err)
-				return err
-			}
+		err = router.Enqueue(backend, routeModel, queueId, cogReqBody, shared.QUEUE_PRIORITY_1)
+		if err != nil {
+			log.Error("Failed to enqueue request", "id", queueId, "backend", backend.Type(), "err", err)
+			return err
 		}
 
 		// Analytics
@@ -323,6 +295,7 @@ func CreateUpscaleInternal(AsynqClient *asynq.Client, S3 *s3.S3, Track *analytic
 			}
 		// Make ~30 minute timeouts, the TTL of MQ messages
 		case <-time.After(30 * time.Minute):
+			Repo.FinishTimedOutBackendJob(upscale.ID)
 			err := Repo.SetUpscaleFailed(upscale.ID.String(), shared.TIMEOUT_ERROR, nil)
 			if err != nil {
 				log.Error("Failed to set upscale failed", "id", upscale.ID, "err", err)
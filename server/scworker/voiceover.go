@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stablecog/sc-go/database/ent"
+	"github.com/stablecog/sc-go/database/ent/credithold"
 	"github.com/stablecog/sc-go/database/ent/upscale"
 	"github.com/stablecog/sc-go/database/enttypes"
 	"github.com/stablecog/sc-go/database/repository"
@@ -75,6 +76,9 @@ func (w *SCWorker) CreateVoiceover(source enttypes.SourceType,
 		voiceoverReq.ApplyDefaults()
 	}
 
+	// API requests can opt in to returning immediately, the result is then polled or sent to a callback
+	async := source != enttypes.SourceTypeWebUI && voiceoverReq.IsAsync()
+
 	// Set settings resp
 	initSettings := responses.VoiceoverSettingsResponse{
 		ModelId:       *voiceoverReq.ModelId,
@@ -102,38 +106,21 @@ func (w *SCWorker) CreateVoiceover(source enttypes.SourceType,
 			log.Warn("Error getting queue count for user", "err", err, "user_id", user.ID)
 		}
 		if err == nil && nq > qMax {
-			// Get queue overflow size
-			overflowSize, err := w.QueueThrottler.NumQueued(fmt.Sprintf("of:%s", user.ID.String()))
-			if err != nil {
-				log.Warn("Error getting queue overflow count", "err", err, "user_id", user.ID.String())
-			}
-			// If overflow size is greater than max, return error
-			if overflowSize > shared.QUEUE_OVERFLOW_MAX {
-				return nil, nil, &WorkerError{http.StatusBadRequest, fmt.Errorf("queue_limit_reached"), ""}
-			}
-			// Overflow size can be 0 so we need to add 1
-			overflowSize++
-			w.QueueThrottler.IncrementBy(1, fmt.Sprintf("of:%s", user.ID.String()))
-			for {
-				time.Sleep(time.Duration(shared.QUEUE_OVERFLOW_PENALTY_MS*overflowSize) * time.Millisecond)
-				nq, err = w.QueueThrottler.NumQueued(fmt.Sprintf("v:%s", user.ID.String()))
-				if err != nil {
-					log.Warn("Error getting queue count", "err", err, "user_id", user.ID.String())
-				}
-				if err == nil && nq <= qMax {
-					w.QueueThrottler.DecrementBy(1, fmt.Sprintf("of:%s", user.ID.String()))
-					break
-				}
-				// Update overflow size
-				overflowSize, err = w.QueueThrottler.NumQueued(fmt.Sprintf("of:%s", user.ID.String()))
-				if err != nil {
-					log.Warn("Error getting queue overflow count", "err", err, "user_id", user.ID.String())
-				}
-				overflowSize++
-			}
+			return nil, nil, &WorkerError{http.StatusBadRequest, fmt.Errorf("queue_limit_reached"), ""}
 		}
 	}
 
+	// Fair share admission, voiceovers aren't dispatched through the MQ so the priority isn't used
+	queueTier := shared.QueueTierPaid
+	if isSuperAdmin {
+		queueTier = shared.QueueTierAdmin
+	} else if free {
+		queueTier = shared.QueueTierFree
+	}
+	if _, wErr := w.admit(user, queueTier); wErr != nil {
+		return nil, &initSettings, wErr
+	}
+
 	// Enforce submit to gallery
 	if free {
 		voiceoverReq.WasAutoSubmitted = true
@@ -192,15 +179,18 @@ func (w *SCWorker) CreateVoiceover(source enttypes.SourceType,
 		// Bind transaction to client
 		DB := tx.Client()
 
-		// Charge credits
+		// Hold credits until the voiceover is done
 		creditAmount := utils.CalculateVoiceoverCredits(voiceoverReq.Prompt)
-		deducted, err := w.Repo.DeductCreditsFromUser(user.ID, creditAmount, false, DB)
+		hold, err := w.Repo.HoldCredits(user.ID, creditAmount, credithold.ProcessTypeVoiceover, DB)
 		if err != nil {
-			log.Error("Error deducting credits", "err", err)
+			log.Error("Error holding credits", "err", err)
 			return err
-		} else if !deducted {
+		} else if hold == nil {
 			return responses.InsufficientCreditsErr
 		}
+		if err := w.reserveApiTokenCredits(apiTokenId, hold, DB); err != nil {
+			return err
+		}
 
 		remainingCredits, err = w.Repo.GetNonExpiredCreditTotalForUser(user.ID, DB)
 		if err != nil {
@@ -224,6 +214,10 @@ func (w *SCWorker) CreateVoiceover(source enttypes.SourceType,
 			log.Error("Error creating voiceover", "err", err)
 			return err
 		}
+		if err := w.Repo.AttachCreditHold(hold.ID, voiceover.ID, DB); err != nil {
+			log.Error("Error attaching credit hold", "err", err)
+			return err
+		}
 
 		// Request ID matches upscale ID
 		requestId = voiceover.ID
@@ -249,7 +243,10 @@ func (w *SCWorker) CreateVoiceover(source enttypes.SourceType,
 			WebhookEventsFilter: []requests.CogEventFilter{requests.CogEventFilterStart, requests.CogEventFilterStart},
 			WebhookUrl:          fmt.Sprintf("%s/v1/worker/webhook", utils.GetEnv().PublicApiUrl),
 			Input: requests.BaseCogRequest{
-				APIRequest:       source != enttypes.SourceTypeWebUI,
+				APIRequest:       source != enttypes.SourceTypeWebUI && !async,
+				Async:            async,
+				CallbackURL:      voiceoverReq.CallbackURL,
+				ApiTokenUsageID:  apiTokenUsageID(r),
 				ID:               requestId,
 				IP:               ipAddress,
 				WasAutoSubmitted: voiceoverReq.WasAutoSubmitted,
@@ -269,9 +266,10 @@ func (w *SCWorker) CreateVoiceover(source enttypes.SourceType,
 			},
 		}
 
+		// API tokens' jobs have a stream too if they asked for one
+		cogReqBody.Input.StreamID = voiceoverReq.StreamID
 		if source == enttypes.SourceTypeWebUI {
 			cogReqBody.Input.UIId = voiceoverReq.UIId
-			cogReqBody.Input.StreamID = voiceoverReq.StreamID
 		}
 
 		err = w.Redis.EnqueueCogRequest(w.Redis.Ctx, shared.COG_REDIS_VOICEOVER_QUEUE, cogReqBody)
@@ -288,11 +286,17 @@ func (w *SCWorker) CreateVoiceover(source enttypes.SourceType,
 		if errors.Is(err, responses.InsufficientCreditsErr) {
 			return nil, nil, &WorkerError{http.StatusBadRequest, responses.InsufficientCreditsErr, ""}
 		}
+		if errors.Is(err, repository.ApiTokenCreditCapErr) {
+			return nil, nil, &WorkerError{http.StatusForbidden, repository.ApiTokenCreditCapErr, ""}
+		}
 		return nil, nil, WorkerInternalServerError()
 	}
 
+	// Accepted, count it towards the user's fair share
+	w.chargeFairShare(user, queueTier, int(utils.CalculateVoiceoverCredits(voiceoverReq.Prompt)))
+
 	// Add channel to sync array (basically a thread-safe map)
-	if source != enttypes.SourceTypeWebUI {
+	if source != enttypes.SourceTypeWebUI && !async {
 		w.SMap.Put(requestId.String(), activeChl)
 		defer w.SMap.Delete(requestId.String())
 		defer w.QueueThrottler.DecrementBy(1, fmt.Sprintf("v:%s", user.ID.String()))
@@ -318,24 +322,28 @@ func (w *SCWorker) CreateVoiceover(source enttypes.SourceType,
 	// Analytics
 	go w.Track.VoiceoverStarted(user, cogReqBody.Input, source, ipAddress)
 
-	// Set timeout delay for UI
-	if source == enttypes.SourceTypeWebUI {
+	// Set timeout delay for UI and async API requests
+	if source == enttypes.SourceTypeWebUI || async {
 		// Set timeout key
 		err = w.Redis.SetCogRequestStreamID(w.Redis.Ctx, requestId.String(), voiceoverReq.StreamID)
 		if err != nil {
 			// Don't time it out if this fails
 			log.Error("Failed to set timeout key", "err", err)
 		} else {
+			w.trackAsyncJob(cogReqBody.Input)
 			// Start the timeout timer
 			go func() {
 				// sleep
 				time.Sleep(shared.REQUEST_COG_TIMEOUT_VOICEOVER)
 				// this will trigger timeout if it hasnt been finished
-				w.Repo.FailCogMessageDueToTimeoutIfTimedOut(requests.CogWebhookMessage{
+				timeoutMsg := requests.CogWebhookMessage{
 					Input:  cogReqBody.Input,
 					Error:  shared.TIMEOUT_ERROR,
 					Status: requests.CogFailed,
-				})
+				}
+				if w.Repo.FailCogMessageDueToTimeoutIfTimedOut(timeoutMsg) {
+					w.CompleteAsyncJob(timeoutMsg)
+				}
 			}()
 		}
 
@@ -435,7 +443,7 @@ func (w *SCWorker) CreateVoiceover(source enttypes.SourceType,
 
 				// Set token used
 				if voiceover.APITokenID != nil {
-					err = w.Repo.SetTokenUsedAndIncrementCreditsSpent(int(utils.CalculateVoiceoverCredits(voiceoverReq.Prompt)), *voiceover.APITokenID)
+					err = w.Repo.SetTokenUsedAndIncrementCreditsSpent(int(utils.CalculateVoiceoverCredits(voiceoverReq.Prompt)), *voiceover.APITokenID, apiTokenUsageID(r))
 					if err != nil {
 						log.Error("Failed to set token used", "err", err)
 					}
@@ -476,10 +484,10 @@ func (w *SCWorker) CreateVoiceover(source enttypes.SourceType,
 					// Analytics
 					duration := time.Now().Sub(cogMsg.Input.LivePageData.CreatedAt).Seconds()
 					go w.Track.VoiceoverFailed(user, cogMsg.Input, duration, cogMsg.Error, source, ipAddress)
-					// Refund credits
-					_, err = w.Repo.RefundCreditsToUser(user.ID, utils.CalculateVoiceoverCredits(voiceoverReq.Prompt), DB)
+					// Release held credits
+					_, err = w.Repo.ReleaseCreditHold(requestId, user.ID, utils.CalculateVoiceoverCredits(voiceoverReq.Prompt), cogMsg.Error, DB)
 					if err != nil {
-						log.Error("Failed to refund credits", "err", err)
+						log.Error("Failed to release held credits", "err", err)
 						return err
 					}
 					return nil
@@ -487,20 +495,22 @@ func (w *SCWorker) CreateVoiceover(source enttypes.SourceType,
 					log.Error("Failed to set voiceover failed", "id", requestId, "err", err)
 					return nil, nil, WorkerInternalServerError()
 				}
+				w.deadLetter(cogReqBody, cogMsg.Error)
 
 				return nil, &initSettings, &WorkerError{http.StatusInternalServerError, fmt.Errorf(cogMsg.Error), ""}
 			}
 		case <-time.After(shared.REQUEST_COG_TIMEOUT_VOICEOVER):
+			w.Repo.FinishTimedOutBackendJob(requestId)
 			if err := w.Repo.WithTx(func(tx *ent.Tx) error {
 				DB := tx.Client()
 				err := w.Repo.SetVoiceoverFailed(requestId.String(), shared.TIMEOUT_ERROR, DB)
 				if err != nil {
 					log.Error("Failed to set voiceover failed", "id", upscale.ID, "err", err)
 				}
-				// Refund credits
-				_, err = w.Repo.RefundCreditsToUser(user.ID, utils.CalculateVoiceoverCredits(voiceoverReq.Prompt), DB)
+				// Release held credits
+				_, err = w.Repo.ReleaseCreditHold(requestId, user.ID, utils.CalculateVoiceoverCredits(voiceoverReq.Prompt), shared.TIMEOUT_ERROR, DB)
 				if err != nil {
-					log.Error("Failed to refund credits", "err", err)
+					log.Error("Failed to release held credits", "err", err)
 					return err
 				}
 				return nil
@@ -508,6 +518,7 @@ func (w *SCWorker) CreateVoiceover(source enttypes.SourceType,
 				log.Error("Failed to set voiceover failed", "id", requestId, "err", err)
 				return nil, nil, WorkerInternalServerError()
 			}
+			w.deadLetter(cogReqBody, shared.TIMEOUT_ERROR)
 
 			return nil, nil, &WorkerError{http.StatusInternalServerError, fmt.Errorf(shared.TIMEOUT_ERROR), ""}
 		}
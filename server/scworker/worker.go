@@ -4,9 +4,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hibiken/asynq"
 	"github.com/stablecog/sc-go/database"
-	"github.com/stablecog/sc-go/database/ent"
 	"github.com/stablecog/sc-go/database/repository"
-	"github.com/stablecog/sc-go/log"
 	"github.com/stablecog/sc-go/server/analytics"
 	"github.com/stablecog/sc-go/server/requests"
 	"github.com/stablecog/sc-go/server/translator"
@@ -19,6 +17,9 @@ type SCWorker struct {
 	Redis          *database.RedisWrapper
 	SMap           *shared.SyncMap[chan requests.CogWebhookMessage]
 	QueueThrottler *shared.UserQueueThrottlerMap
+	Scheduler      *shared.FairScheduler
+	QueueDepth     *QueueDepthCache
+	StartRate      *JobStartRateCache
 	Track          *analytics.AnalyticsService
 	SafetyChecker  *translator.TranslatorSafetyChecker
 	S3Img          *s3.S3
@@ -26,29 +27,3 @@ type SCWorker struct {
 	MQClient       queue.MQClient
 	AsynqClient    *asynq.Client
 }
-
-func ShouldUseRunpodGenerate(model *ent.GenerationModel, redis *database.RedisWrapper) bool {
-	if model.RunpodEndpoint == nil {
-		return false
-	}
-
-	if model.RunpodActive {
-		log.Info("🏃‍♂️‍➡️📦 Using Runpod for generate")
-		return true
-	}
-
-	return false
-}
-
-func ShouldUseRunpodUpscale(model *ent.UpscaleModel, redis *database.RedisWrapper) bool {
-	if model.RunpodEndpoint == nil {
-		return false
-	}
-
-	if model.RunpodActive {
-		log.Info("🏃‍♂️‍➡️📦 Using Runpod for upscale")
-		return true
-	}
-
-	return false
-}
@@ -0,0 +1,54 @@
+package shared
+
+// What an API token is allowed to do
+type ApiTokenScope string
+
+const (
+	// Create generations, batches and variations, upload init images and check on them
+	ApiTokenScopeImageGenerate ApiTokenScope = "image:generate"
+	// Create upscales and check on them
+	ApiTokenScopeImageUpscale ApiTokenScope = "image:upscale"
+	// Create voiceovers and check on them
+	ApiTokenScopeAudioVoiceover ApiTokenScope = "audio:voiceover"
+	// Query generation and voiceover outputs
+	ApiTokenScopeOutputsRead ApiTokenScope = "outputs:read"
+	// Query credits
+	ApiTokenScopeCreditsRead ApiTokenScope = "credits:read"
+)
+
+// Every scope, given to tokens created without explicit scopes
+var ApiTokenScopes = []ApiTokenScope{
+	ApiTokenScopeImageGenerate,
+	ApiTokenScopeImageUpscale,
+	ApiTokenScopeAudioVoiceover,
+	ApiTokenScopeOutputsRead,
+	ApiTokenScopeCreditsRead,
+}
+
+// Scopes that create jobs, any of them lets a token follow its jobs' status and queue position
+var ApiTokenJobScopes = []ApiTokenScope{
+	ApiTokenScopeImageGenerate,
+	ApiTokenScopeImageUpscale,
+	ApiTokenScopeAudioVoiceover,
+}
+
+func IsValidApiTokenScope(scope ApiTokenScope) bool {
+	for _, s := range ApiTokenScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Scope a token needs to create, check on or cancel jobs of processType
+func ApiTokenScopeForProcessType(processType ProcessType) ApiTokenScope {
+	switch processType {
+	case UPSCALE:
+		return ApiTokenScopeImageUpscale
+	case VOICEOVER:
+		return ApiTokenScopeAudioVoiceover
+	default:
+		return ApiTokenScopeImageGenerate
+	}
+}
@@ -0,0 +1,159 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const BACKEND_HEALTH_REDIS_KEY = "backend_health"
+const BACKEND_JOB_REDIS_KEY = "backend_job"
+
+// Jobs that never report back are forgotten after this, longer than any job timeout
+const backendJobTTL = 24 * time.Hour
+
+// Health of a backend for one model, moving averages over its recent jobs
+type BackendHealth struct {
+	Backend BackendType `json:"backend"`
+	// Success rate, 1 for a backend without results yet
+	Score float64 `json:"score"`
+	// Time from dispatch to a successful result, 0 without results yet
+	LatencyMs float64 `json:"latency_ms"`
+	Samples   int64   `json:"samples"`
+}
+
+func (h BackendHealth) Healthy() bool {
+	return h.Score >= BACKEND_UNHEALTHY_SCORE
+}
+
+// A job dispatched to a backend, kept until its result comes in
+type backendJob struct {
+	Backend      BackendType `json:"backend"`
+	ModelID      uuid.UUID   `json:"model_id"`
+	DispatchedAt int64       `json:"dispatched_at"`
+}
+
+// Scores inference backends per model from the results of the jobs they run
+type BackendHealthTracker struct {
+	redis *redis.Client
+	ctx   context.Context
+}
+
+func NewBackendHealthTracker(ctx context.Context, redis *redis.Client) *BackendHealthTracker {
+	return &BackendHealthTracker{
+		redis: redis,
+		ctx:   ctx,
+	}
+}
+
+func backendHealthRedisKey(modelID uuid.UUID, backend BackendType) string {
+	return fmt.Sprintf("%s:%s:%s", BACKEND_HEALTH_REDIS_KEY, modelID.String(), backend)
+}
+
+func backendJobRedisKey(jobID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", BACKEND_JOB_REDIS_KEY, jobID.String())
+}
+
+// KEYS[1] health hash, ARGV success (1 or 0), latency ms, alpha and ttl ms
+// Latency is only averaged over successes, failures are often timeouts
+var recordBackendResultScript = redis.NewScript(`
+local ok = tonumber(ARGV[1])
+local alpha = tonumber(ARGV[3])
+local score = tonumber(redis.call("HGET", KEYS[1], "score") or "1")
+score = score + alpha * (ok - score)
+redis.call("HSET", KEYS[1], "score", tostring(score))
+if ok == 1 then
+	local latency = tonumber(ARGV[2])
+	local avg = redis.call("HGET", KEYS[1], "latency_ms")
+	if avg then
+		latency = tonumber(avg) + alpha * (latency - tonumber(avg))
+	end
+	redis.call("HSET", KEYS[1], "latency_ms", tostring(latency))
+end
+redis.call("HINCRBY", KEYS[1], "samples", 1)
+redis.call("PEXPIRE", KEYS[1], ARGV[4])
+return tostring(score)
+`)
+
+// Record the result of a job on backend for model, returns the new score
+func (t *BackendHealthTracker) Record(modelID uuid.UUID, backend BackendType, success bool, latency time.Duration) (float64, error) {
+	ok := 0
+	if success {
+		ok = 1
+	}
+	res, err := recordBackendResultScript.Run(t.ctx, t.redis,
+		[]string{backendHealthRedisKey(modelID, backend)},
+		ok, latency.Milliseconds(), BACKEND_HEALTH_ALPHA, BACKEND_HEALTH_TTL.Milliseconds(),
+	).Text()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(res, 64)
+}
+
+// Current health of backend for model
+func (t *BackendHealthTracker) Get(modelID uuid.UUID, backend BackendType) (*BackendHealth, error) {
+	health := &BackendHealth{
+		Backend: backend,
+		Score:   1,
+	}
+	res, err := t.redis.HGetAll(t.ctx, backendHealthRedisKey(modelID, backend)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if score, ok := res["score"]; ok {
+		health.Score, err = strconv.ParseFloat(score, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if latency, ok := res["latency_ms"]; ok {
+		health.LatencyMs, err = strconv.ParseFloat(latency, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if samples, ok := res["samples"]; ok {
+		health.Samples, err = strconv.ParseInt(samples, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return health, nil
+}
+
+// Remember which backend a job went to, so its result can be recorded with FinishJob
+func (t *BackendHealthTracker) StartJob(jobID uuid.UUID, modelID uuid.UUID, backend BackendType) error {
+	b, err := json.Marshal(backendJob{
+		Backend:      backend,
+		ModelID:      modelID,
+		DispatchedAt: time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+	return t.redis.Set(t.ctx, backendJobRedisKey(jobID), b, backendJobTTL).Err()
+}
+
+// Record the result of a job started with StartJob, only the first result of a job counts
+// Returns the backend the job ran on, empty if it wasn't started or already finished
+func (t *BackendHealthTracker) FinishJob(jobID uuid.UUID, success bool) (BackendType, error) {
+	b, err := t.redis.GetDel(t.ctx, backendJobRedisKey(jobID)).Bytes()
+	if err == redis.Nil {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	var job backendJob
+	if err := json.Unmarshal(b, &job); err != nil {
+		return "", err
+	}
+	latency := time.Since(time.UnixMilli(job.DispatchedAt))
+	_, err = t.Record(job.ModelID, job.Backend, success, latency)
+	return job.Backend, err
+}
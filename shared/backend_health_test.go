@@ -0,0 +1,82 @@
+package shared
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackendHealthRecord(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+	tracker := NewBackendHealthTracker(ctx, redis)
+	modelID := uuid.New()
+
+	// Healthy until results say otherwise
+	health, err := tracker.Get(modelID, BackendScWorker)
+	assert.Nil(t, err)
+	assert.Equal(t, 1.0, health.Score)
+	assert.Equal(t, int64(0), health.Samples)
+	assert.True(t, health.Healthy())
+
+	score, err := tracker.Record(modelID, BackendScWorker, true, 2*time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, 1.0, score)
+	score, err = tracker.Record(modelID, BackendScWorker, true, 4*time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, 1.0, score)
+	health, err = tracker.Get(modelID, BackendScWorker)
+	assert.Nil(t, err)
+	assert.InDelta(t, 2200, health.LatencyMs, 0.001)
+	assert.Equal(t, int64(2), health.Samples)
+
+	// Failures lower the score and leave latency alone
+	for i := 0; i < 7; i++ {
+		score, err = tracker.Record(modelID, BackendScWorker, false, time.Minute)
+		assert.Nil(t, err)
+	}
+	assert.InDelta(t, 0.478, score, 0.001)
+	health, err = tracker.Get(modelID, BackendScWorker)
+	assert.Nil(t, err)
+	assert.False(t, health.Healthy())
+	assert.InDelta(t, 2200, health.LatencyMs, 0.001)
+
+	// Other backends and models aren't affected
+	health, err = tracker.Get(modelID, BackendRunpodServerless)
+	assert.Nil(t, err)
+	assert.True(t, health.Healthy())
+	health, err = tracker.Get(uuid.New(), BackendScWorker)
+	assert.Nil(t, err)
+	assert.True(t, health.Healthy())
+}
+
+func TestBackendHealthJob(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+	tracker := NewBackendHealthTracker(ctx, redis)
+	modelID := uuid.New()
+	jobID := uuid.New()
+
+	assert.Nil(t, tracker.StartJob(jobID, modelID, BackendRunpodServerless))
+	backend, err := tracker.FinishJob(jobID, false)
+	assert.Nil(t, err)
+	assert.Equal(t, BackendRunpodServerless, backend)
+	// Second result of the same job is ignored
+	backend, err = tracker.FinishJob(jobID, false)
+	assert.Nil(t, err)
+	assert.Equal(t, BackendType(""), backend)
+	// So is a job that wasn't started
+	backend, err = tracker.FinishJob(uuid.New(), false)
+	assert.Nil(t, err)
+	assert.Equal(t, BackendType(""), backend)
+
+	health, err := tracker.Get(modelID, BackendRunpodServerless)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), health.Samples)
+	assert.InDelta(t, 0.9, health.Score, 0.001)
+}
@@ -0,0 +1,247 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const CIRCUIT_BREAKER_REDIS_KEY = "circuit_breaker"
+
+// The backend the circuit breaker fails back to once it recovers
+const PRIMARY_BACKEND = BackendScWorker
+
+type CircuitState string
+
+const (
+	// Backend gets jobs
+	CircuitClosed CircuitState = "closed"
+	// Backend is out until OpenDuration passes
+	CircuitOpen CircuitState = "open"
+	// Backend gets probes, closes when enough of them succeed and opens again when one fails
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+type CircuitBreakerConfig struct {
+	// Consecutive failed jobs that open the circuit
+	FailureThreshold int
+	// How long the circuit stays open before probing the backend
+	OpenDuration time.Duration
+	// Probes that must succeed to close the circuit
+	ProbeSuccesses int
+}
+
+type CircuitStatus struct {
+	Backend BackendType  `json:"backend"`
+	State   CircuitState `json:"state"`
+	// Consecutive failed jobs while closed
+	Failures int `json:"failures"`
+	// Probes sent and succeeded while half-open
+	Probes    int        `json:"probes"`
+	Successes int        `json:"successes"`
+	OpenedAt  *time.Time `json:"opened_at,omitempty"`
+	ChangedAt *time.Time `json:"changed_at,omitempty"`
+}
+
+// Takes backends out when their jobs keep failing and brings them back once probes succeed
+// State is per backend and shared by every server through redis
+type CircuitBreaker struct {
+	redis  *redis.Client
+	ctx    context.Context
+	Config CircuitBreakerConfig
+}
+
+func NewCircuitBreaker(ctx context.Context, redis *redis.Client, config CircuitBreakerConfig) *CircuitBreaker {
+	return &CircuitBreaker{
+		redis:  redis,
+		ctx:    ctx,
+		Config: config,
+	}
+}
+
+func circuitBreakerRedisKey(backend BackendType) string {
+	return fmt.Sprintf("%s:%s", CIRCUIT_BREAKER_REDIS_KEY, backend)
+}
+
+// KEYS[1] circuit hash, ARGV op, now ms, failure threshold, open duration ms and probe successes
+// Ops are status, probe, success, failure, trip and reset, times are kept as the strings they came in as
+// Status only reads, an open circuit that has been open long enough is reported half-open until another op stores it
+// Probes that never report back are given up on after the open duration so the circuit can't get stuck half-open
+var circuitBreakerScript = redis.NewScript(`
+local op = ARGV[1]
+local now = ARGV[2]
+local threshold = tonumber(ARGV[3])
+local openDuration = tonumber(ARGV[4])
+local needed = tonumber(ARGV[5])
+local state = redis.call("HGET", KEYS[1], "state") or "closed"
+local failures = tonumber(redis.call("HGET", KEYS[1], "failures") or "0")
+local probes = tonumber(redis.call("HGET", KEYS[1], "probes") or "0")
+local successes = tonumber(redis.call("HGET", KEYS[1], "successes") or "0")
+local openedAt = redis.call("HGET", KEYS[1], "opened_at") or "0"
+local changedAt = redis.call("HGET", KEYS[1], "changed_at") or "0"
+local probedAt = redis.call("HGET", KEYS[1], "probed_at") or "0"
+
+local function open()
+	state = "open"
+	failures = 0
+	probes = 0
+	successes = 0
+	openedAt = now
+end
+local function close()
+	state = "closed"
+	failures = 0
+	probes = 0
+	successes = 0
+	openedAt = "0"
+end
+
+if state == "open" and tonumber(now) - tonumber(openedAt) >= openDuration then
+	state = "half-open"
+	probes = 0
+	successes = 0
+	changedAt = tostring(tonumber(openedAt) + openDuration)
+end
+local prev = state
+
+local allowed = 0
+if op == "trip" then
+	open()
+elseif op == "reset" then
+	close()
+elseif op == "probe" and state == "half-open" then
+	if probes >= needed and tonumber(now) - tonumber(probedAt) >= openDuration then
+		probes = successes
+	end
+	if probes < needed then
+		probes = probes + 1
+		probedAt = now
+		allowed = 1
+	end
+elseif op == "success" then
+	if state == "closed" then
+		failures = 0
+	elseif state == "half-open" then
+		successes = successes + 1
+		if successes >= needed then
+			close()
+		end
+	end
+elseif op == "failure" then
+	if state == "closed" then
+		failures = failures + 1
+		if failures >= threshold then
+			open()
+		end
+	elseif state == "half-open" then
+		open()
+	end
+end
+
+if state ~= prev then
+	changedAt = now
+end
+if op ~= "status" then
+	redis.call("HSET", KEYS[1], "state", state, "failures", tostring(failures), "probes", tostring(probes), "successes", tostring(successes), "opened_at", openedAt, "changed_at", changedAt, "probed_at", probedAt)
+end
+return {state, tostring(failures), tostring(probes), tostring(successes), openedAt, changedAt, tostring(allowed)}
+`)
+
+func (b *CircuitBreaker) run(backend BackendType, op string) (*CircuitStatus, bool, error) {
+	res, err := circuitBreakerScript.Run(b.ctx, b.redis,
+		[]string{circuitBreakerRedisKey(backend)},
+		op, time.Now().UnixMilli(), b.Config.FailureThreshold, b.Config.OpenDuration.Milliseconds(), b.Config.ProbeSuccesses,
+	).StringSlice()
+	if err != nil {
+		return nil, false, err
+	}
+	if len(res) != 7 {
+		return nil, false, fmt.Errorf("unexpected circuit breaker reply %v", res)
+	}
+
+	status := &CircuitStatus{
+		Backend: backend,
+		State:   CircuitState(res[0]),
+	}
+	ints := make([]int64, 6)
+	for i := 1; i < 7; i++ {
+		ints[i-1], err = strconv.ParseInt(res[i], 10, 64)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	status.Failures = int(ints[0])
+	status.Probes = int(ints[1])
+	status.Successes = int(ints[2])
+	if ints[3] > 0 {
+		openedAt := time.UnixMilli(ints[3])
+		status.OpenedAt = &openedAt
+	}
+	if ints[4] > 0 {
+		changedAt := time.UnixMilli(ints[4])
+		status.ChangedAt = &changedAt
+	}
+	return status, ints[5] == 1, nil
+}
+
+// Current state of the backend's circuit, an open circuit is half-open once OpenDuration has passed
+// Read-only, safe to call on every routing decision
+func (b *CircuitBreaker) Status(backend BackendType) (*CircuitStatus, error) {
+	status, _, err := b.run(backend, "status")
+	return status, err
+}
+
+// Claim a probe of a half-open backend, false if it isn't half-open or enough probes are out
+func (b *CircuitBreaker) AllowProbe(backend BackendType) (bool, error) {
+	_, allowed, err := b.run(backend, "probe")
+	return allowed, err
+}
+
+// Record the result of a job on the backend
+func (b *CircuitBreaker) Record(backend BackendType, success bool) (*CircuitStatus, error) {
+	op := "failure"
+	if success {
+		op = "success"
+	}
+	status, _, err := b.run(backend, op)
+	return status, err
+}
+
+// Open the backend's circuit now
+func (b *CircuitBreaker) Trip(backend BackendType) (*CircuitStatus, error) {
+	status, _, err := b.run(backend, "trip")
+	return status, err
+}
+
+// Close the backend's circuit now
+func (b *CircuitBreaker) Reset(backend BackendType) (*CircuitStatus, error) {
+	status, _, err := b.run(backend, "reset")
+	return status, err
+}
+
+// KEYS[1] circuit hash, ARGV[1] state
+var circuitNotifiedScript = redis.NewScript(`
+local prev = redis.call("HGET", KEYS[1], "notified_state") or ""
+if prev == ARGV[1] then
+	return {prev, "0"}
+end
+redis.call("HSET", KEYS[1], "notified_state", ARGV[1])
+return {prev, "1"}
+`)
+
+// Store state as the one last notified for the backend's circuit, i.e. on discord
+// Compare and set, of everyone seeing the same change only one gets changed true
+// prev is the state notified before, empty if there wasn't one
+func (b *CircuitBreaker) SetNotifiedState(backend BackendType, state CircuitState) (prev CircuitState, changed bool, err error) {
+	res, err := circuitNotifiedScript.Run(b.ctx, b.redis, []string{circuitBreakerRedisKey(backend)}, string(state)).StringSlice()
+	if err != nil {
+		return "", false, err
+	}
+	if len(res) != 2 {
+		return "", false, fmt.Errorf("unexpected circuit notified reply %v", res)
+	}
+	return CircuitState(res[0]), res[1] == "1", nil
+}
@@ -0,0 +1,196 @@
+package shared
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreakerOpensAndRecovers(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+	b := NewCircuitBreaker(ctx, redis, CircuitBreakerConfig{
+		FailureThreshold: 3,
+		OpenDuration:     time.Hour,
+		ProbeSuccesses:   2,
+	})
+
+	status, err := b.Status(BackendScWorker)
+	assert.Nil(t, err)
+	assert.Equal(t, CircuitClosed, status.State)
+	assert.Nil(t, status.ChangedAt)
+
+	// Successes reset the failure count
+	for i := 0; i < 2; i++ {
+		_, err = b.Record(BackendScWorker, false)
+		assert.Nil(t, err)
+	}
+	status, err = b.Record(BackendScWorker, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, status.Failures)
+
+	for i := 0; i < 3; i++ {
+		status, err = b.Record(BackendScWorker, false)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, CircuitOpen, status.State)
+	assert.NotNil(t, status.OpenedAt)
+	assert.NotNil(t, status.ChangedAt)
+
+	// Other backends aren't affected
+	status, err = b.Status(BackendRunpodServerless)
+	assert.Nil(t, err)
+	assert.Equal(t, CircuitClosed, status.State)
+
+	// No probes while open
+	allowed, err := b.AllowProbe(BackendScWorker)
+	assert.Nil(t, err)
+	assert.False(t, allowed)
+
+	// Half-open once the open duration passes, the first probe stores it
+	b.Config.OpenDuration = 0
+	status, err = b.Status(BackendScWorker)
+	assert.Nil(t, err)
+	assert.Equal(t, CircuitHalfOpen, status.State)
+	allowed, err = b.AllowProbe(BackendScWorker)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+	b.Config.OpenDuration = time.Hour
+
+	// As many probes as need to succeed
+	allowed, err = b.AllowProbe(BackendScWorker)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+	allowed, err = b.AllowProbe(BackendScWorker)
+	assert.Nil(t, err)
+	assert.False(t, allowed)
+
+	status, err = b.Record(BackendScWorker, true)
+	assert.Nil(t, err)
+	assert.Equal(t, CircuitHalfOpen, status.State)
+	assert.Equal(t, 1, status.Successes)
+	status, err = b.Record(BackendScWorker, true)
+	assert.Nil(t, err)
+	assert.Equal(t, CircuitClosed, status.State)
+	assert.Nil(t, status.OpenedAt)
+}
+
+func TestCircuitBreakerFailedProbeReopens(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+	b := NewCircuitBreaker(ctx, redis, CircuitBreakerConfig{
+		FailureThreshold: 3,
+		OpenDuration:     0,
+		ProbeSuccesses:   2,
+	})
+
+	status, err := b.Trip(BackendScWorker)
+	assert.Nil(t, err)
+	assert.Equal(t, CircuitOpen, status.State)
+
+	allowed, err := b.AllowProbe(BackendScWorker)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+	b.Config.OpenDuration = time.Hour
+	status, err = b.Record(BackendScWorker, false)
+	assert.Nil(t, err)
+	assert.Equal(t, CircuitOpen, status.State)
+	assert.Equal(t, 0, status.Probes)
+
+	status, err = b.Reset(BackendScWorker)
+	assert.Nil(t, err)
+	assert.Equal(t, CircuitClosed, status.State)
+}
+
+func TestCircuitBreakerLostProbes(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+	b := NewCircuitBreaker(ctx, redis, CircuitBreakerConfig{
+		FailureThreshold: 3,
+		OpenDuration:     0,
+		ProbeSuccesses:   1,
+	})
+
+	_, err = b.Trip(BackendScWorker)
+	assert.Nil(t, err)
+	allowed, err := b.AllowProbe(BackendScWorker)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+
+	// Probe never reported back, another one goes out after the open duration
+	allowed, err = b.AllowProbe(BackendScWorker)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+	b.Config.OpenDuration = time.Hour
+	allowed, err = b.AllowProbe(BackendScWorker)
+	assert.Nil(t, err)
+	assert.False(t, allowed)
+}
+
+func TestCircuitBreakerStatusReadOnly(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+	b := NewCircuitBreaker(ctx, redis, CircuitBreakerConfig{
+		FailureThreshold: 3,
+		OpenDuration:     0,
+		ProbeSuccesses:   1,
+	})
+
+	// Nothing stored for a backend that was only looked at
+	status, err := b.Status(BackendScWorker)
+	assert.Nil(t, err)
+	assert.Equal(t, CircuitClosed, status.State)
+	n, err := redis.Exists(ctx, circuitBreakerRedisKey(BackendScWorker)).Result()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), n)
+
+	// Reported half-open, stored state stays open until the next op
+	opened, err := b.Trip(BackendScWorker)
+	assert.Nil(t, err)
+	status, err = b.Status(BackendScWorker)
+	assert.Nil(t, err)
+	assert.Equal(t, CircuitHalfOpen, status.State)
+	assert.Equal(t, opened.OpenedAt.UnixMilli(), status.ChangedAt.UnixMilli())
+	state, err := redis.HGet(ctx, circuitBreakerRedisKey(BackendScWorker), "state").Result()
+	assert.Nil(t, err)
+	assert.Equal(t, string(CircuitOpen), state)
+
+	status, err = b.Record(BackendScWorker, true)
+	assert.Nil(t, err)
+	assert.Equal(t, CircuitClosed, status.State)
+}
+
+func TestCircuitBreakerNotifiedState(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+	b := NewCircuitBreaker(ctx, redis, CircuitBreakerConfig{
+		FailureThreshold: 1,
+		OpenDuration:     time.Hour,
+		ProbeSuccesses:   1,
+	})
+
+	prev, changed, err := b.SetNotifiedState(BackendScWorker, CircuitOpen)
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, CircuitState(""), prev)
+
+	// Kept across the breaker's own updates
+	_, err = b.Reset(BackendScWorker)
+	assert.Nil(t, err)
+	prev, changed, err = b.SetNotifiedState(BackendScWorker, CircuitOpen)
+	assert.Nil(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, CircuitOpen, prev)
+
+	prev, changed, err = b.SetNotifiedState(BackendScWorker, CircuitClosed)
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, CircuitOpen, prev)
+}
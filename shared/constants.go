@@ -15,6 +15,12 @@ const NSFW_ERROR = "NSFW"
 // Timeout
 const TIMEOUT_ERROR = "TIMEOUT"
 
+// Cancelled by the user before it started
+const CANCELLED_ERROR = "CANCELLED"
+
+// How long consumers are told to skip a cancelled request, as long as its stream ID is kept
+const CANCELLED_JOB_TTL = 1 * time.Hour
+
 // After this period, a request will timeout and a user will be refunded
 // But the generation/upscale may still go through, if it takes longer than this
 const REQUEST_COG_TIMEOUT = 240 * time.Second
@@ -30,6 +36,8 @@ const MIN_INFERENCE_STEPS = 10
 const MAX_GENERATE_INTERFERENCE_STEPS_FREE = 30
 const MAX_PRO_PIXEL_STEPS = 1024 * 1024 * 30
 const MAX_GENERATE_NUM_OUTPUTS = 4
+const MAX_GENERATION_BATCH_ITEMS = 500
+const MAX_GENERATION_VARIATIONS = 16
 const MIN_GENERATE_NUM_OUTPUTS = 1
 const MAX_GUIDANCE_SCALE = 30.0
 const MIN_GUIDANCE_SCALE = 1.0
@@ -49,6 +57,21 @@ const COG_REDIS_VOICEOVER_QUEUE = "input_queue_for_voiceover"
 // This redis channel our servers publish to when we want to broadcast SSE events to clients
 const REDIS_SSE_BROADCAST_CHANNEL = "sse:broadcast_channel"
 
+// Events kept per SSE stream for clients that reconnect with Last-Event-ID
+const SSE_STREAM_BACKLOG_SIZE = 100
+
+// How long a stream's backlog and event IDs are kept after its last event
+const SSE_STREAM_BACKLOG_TTL = 1 * time.Hour
+
+// Streams a websocket connection can be subscribed to at once
+const WS_MAX_SUBSCRIBED_STREAMS = 20
+
+// Websocket connections are closed when a write takes longer than this
+const WS_WRITE_TIMEOUT = 10 * time.Second
+
+// Or when the client doesn't answer a ping for this long
+const WS_PONG_TIMEOUT = 60 * time.Second
+
 // This redis channel is when webhook sends an internal request we care about
 const REDIS_INTERNAL_COG_CHANNEL = "cog:internal_message"
 
@@ -58,8 +81,25 @@ const REDIS_APITOKEN_COG_CHANNEL = "cog:apitoken_message"
 // This redis channel is for discord bot, when a user has connected their account
 const REDIS_DISCORD_COG_CHANNEL = "cog:discord_message"
 
+// This redis channel is for control messages to workers, like cancelling a job they're running
+const REDIS_SC_WORKER_CONTROL_CHANNEL = "sc_worker:control"
+
 const REDIS_SC_WORKER_HEALTH_KEY = "sc_worker:health"
 
+// Header clients set to make create requests safe to retry
+const IDEMPOTENCY_KEY_HEADER = "Idempotency-Key"
+
+// Set on responses replayed for a repeated idempotency key
+const IDEMPOTENT_REPLAYED_HEADER = "Idempotent-Replayed"
+
+const MAX_IDEMPOTENCY_KEY_LENGTH = 255
+
+// How long a response is kept for replay
+const IDEMPOTENCY_KEY_TTL = 24 * time.Hour
+
+// How long a key stays claimed without a response, a little over the longest a request can take
+const IDEMPOTENCY_CLAIM_TTL = REQUEST_COG_TIMEOUT + 30*time.Second
+
 // Allowed image extensions used by various APIs
 type ImageExtension string
 
@@ -140,13 +180,110 @@ const API_TOKEN_PREFIX = "sc-"
 // Max chars in an API token name
 const MAX_TOKEN_NAME_SIZE = 50
 
-// ! API Queue Overflow
-// Max items in overflow queue
-const QUEUE_OVERFLOW_MAX = 50
+// Longest a rotated token can stay valid alongside its replacement
+const MAX_API_TOKEN_ROTATION_GRACE_PERIOD = 7 * 24 * time.Hour
+
+// Usage entries for reads are buffered and written together every interval, or once there are this many
+const API_TOKEN_USAGE_FLUSH_INTERVAL = 5 * time.Second
+const API_TOKEN_USAGE_BATCH_SIZE = 500
+
+// Usage entries older than this are pruned
+const API_TOKEN_USAGE_RETENTION = 90 * 24 * time.Hour
+
+// ! Generation presets
+// Maximum number of presets a user can save
+const MAX_GENERATION_PRESETS_PER_USER = 50
+
+// Max chars in a preset name
+const MAX_GENERATION_PRESET_NAME_SIZE = 50
+
+// ! Fair share scheduling
+// Credits per second each unit of queue tier weight is entitled to
+const FAIR_SHARE_RATE = 0.05
+
+// Requests from users further ahead of their fair share than this are rejected while the queue is contended
+const FAIR_SHARE_MAX_LAG = 15 * time.Minute
+
+// How long the queue depth used for admission and metrics is cached for
+const QUEUE_DEPTH_CACHE_TTL = 5 * time.Second
+
+// ! Backend routing
+// Weight of the newest job result in a backend's moving averages
+const BACKEND_HEALTH_ALPHA = 0.1
+
+// Backends scoring below this for a model stop getting its jobs, other than probes
+const BACKEND_UNHEALTHY_SCORE = 0.5
+
+// Share of jobs sent to an unhealthy backend to find out if it recovered
+const BACKEND_PROBE_RATE = 0.02
+
+// Health of a backend that stops getting jobs is forgotten after this
+const BACKEND_HEALTH_TTL = 1 * time.Hour
+
+// How long batch dispatch waits before retrying an item that hit the queue limit
+const GENERATION_BATCH_RETRY_INTERVAL = 10 * time.Second
+
+// Times an item is retried for the queue limit before it's rejected
+const GENERATION_BATCH_MAX_RETRIES = 30
+
+// Credits held for a job that hasn't been captured or released by then are reconciled
+const CREDIT_HOLD_TTL = 5 * time.Minute
+
+// ! Cron jobs
+// Jobs cron runs on a schedule, admins can also trigger them by name
+type CronJobName string
 
-// Penalty for queue overflow
-// Computed as time.Sleep(QUEUE_OVERFLOW_PENALTY * QUEUE_OVERFLOW_SIZE)
-const QUEUE_OVERFLOW_PENALTY_MS = 150
+const (
+	CronJobStats          CronJobName = "STATS"
+	CronJobEmbeddings     CronJobName = "EMBEDDINGS"
+	CronJobHealth         CronJobName = "HEALTH"
+	CronJobFreeCredits    CronJobName = "FREE_CREDITS"
+	CronJobStripeSync     CronJobName = "STRIPE_SYNC"
+	CronJobQueueCleanup   CronJobName = "RDQUEUE_CLEANUP"
+	CronJobCreditHolds    CronJobName = "CREDIT_HOLDS"
+	CronJobDeleteUserData CronJobName = "AUTO_DELETE_DATA"
+	CronJobApiTokens      CronJobName = "API_TOKENS"
+	CronJobModeration     CronJobName = "MODERATION_VERDICTS"
+)
+
+var CRON_JOBS = []CronJobName{
+	CronJobStats,
+	CronJobEmbeddings,
+	CronJobHealth,
+	CronJobFreeCredits,
+	CronJobStripeSync,
+	CronJobQueueCleanup,
+	CronJobCreditHolds,
+	CronJobDeleteUserData,
+	CronJobApiTokens,
+	CronJobModeration,
+}
+
+func IsValidCronJob(name string) bool {
+	for _, job := range CRON_JOBS {
+		if string(job) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// A cron instance holds a job's lease while running it, renewed every third of this
+// An instance that dies lets the job run elsewhere after at most this long
+const CRON_JOB_LEASE_TTL = 1 * time.Minute
+
+// ! Moderation
+// Accounts younger than this can be banned by moderation policies
+const MODERATION_NEW_ACCOUNT_AGE = 24 * time.Hour
+
+// Allowed prompts are rarely looked at again, their verdicts are pruned after this
+const MODERATION_ALLOWED_VERDICT_RETENTION = 30 * 24 * time.Hour
+
+// Other verdicts are kept this long for appeals
+const MODERATION_VERDICT_RETENTION = 180 * 24 * time.Hour
+
+// Classifier scores below this aren't findings
+const MODERATION_CLASSIFIER_MIN_SCORE = 0.5
 
 // ! Image Generation Defaults
 const DEFAULT_GENERATE_OUTPUT_EXTENSION = JPEG
@@ -155,6 +292,8 @@ const DEFAULT_GENERATE_NUM_OUTPUTS int32 = 2
 const DEFAULT_GENERATE_GUIDANCE_SCALE float32 = 7.0
 const DEFAULT_GENERATE_INFERENCE_STEPS int32 = 30
 const DEFAULT_GENERATE_PROMPT_STRENGTH float32 = 0.5
+const DEFAULT_GENERATION_VARIATIONS = 4
+const DEFAULT_VARIATION_GUIDANCE_STEP float32 = 1.0
 
 // ! Voiceover
 // Calculated as math.Ceil(VOICEOVER_CREDIT_COST_PER_CHARACTER * len(text))
@@ -176,6 +315,10 @@ const TIPPABLE_CREDIT_MULTIPLIER = 0.2
 // Max upload size allowed for img2img/upscale
 const MAX_UPLOAD_SIZE_MB = 10
 
+// Images uploaded for a similarity search are put under this prefix of the img2img bucket until they're embedded
+// The bucket expires objects under it after a day, in case one isn't deleted
+const SIMILAR_UPLOAD_PREFIX = "similar/"
+
 // Queue priorities
 const (
 	QUEUE_PRIORITY_1 uint8 = iota + 1
@@ -190,6 +333,18 @@ const (
 	QUEUE_PRIORITY_10
 )
 
+// Queue ETAs are estimated from jobs of the same model that succeeded within this window
+const QUEUE_ETA_WINDOW = 1 * time.Hour
+
+// Using at most this many of them
+const QUEUE_ETA_SAMPLES = 100
+
+// How fast the queue moves is measured over this window
+const QUEUE_ETA_RATE_WINDOW = 10 * time.Minute
+
+// And measured again at most this often
+const QUEUE_ETA_RATE_CACHE_TTL = 30 * time.Second
+
 // Asynq queue priorities, map a queue name to a priority
 var ASYNQ_QUEUE_DEFINITIONS = map[string]int{
 	"priority_1":  int(QUEUE_PRIORITY_1),
@@ -233,9 +388,44 @@ func QueueByPriority(prio uint8) string {
 
 // Asynq task types
 const (
-	ASYNQ_TASK_GENERATE = "runpod:generate"
+	ASYNQ_TASK_GENERATE  = "runpod:generate"
+	ASYNQ_TASK_UPSCALE   = "runpod:upscale"
+	ASYNQ_TASK_VOICEOVER = "runpod:voiceover"
+	// Results of runpod jobs on their way to the server's webhook
+	ASYNQ_TASK_WEBHOOK = "quecon:webhook"
+	// Results of async API jobs on their way to the user's callback URL
+	ASYNQ_TASK_CALLBACK = "quecon:callback"
 )
 
+// Task type for a job of the process type
+func AsynqTaskForProcessType(processType ProcessType) string {
+	switch processType {
+	case UPSCALE:
+		return ASYNQ_TASK_UPSCALE
+	case VOICEOVER:
+		return ASYNQ_TASK_VOICEOVER
+	default:
+		return ASYNQ_TASK_GENERATE
+	}
+}
+
+// Webhook tasks have their own queue, ahead of every job queue so results aren't stuck behind new jobs
+const ASYNQ_WEBHOOK_QUEUE = "webhooks"
+const ASYNQ_WEBHOOK_QUEUE_PRIORITY = 20
+
+// Runpod jobs are retried this many times on transient errors before they're failed and archived
+const ASYNQ_JOB_MAX_RETRY = 3
+
+// Webhooks are retried for longer, the server may be restarting
+const ASYNQ_WEBHOOK_MAX_RETRY = 10
+
+// Async job callbacks, users' servers get a few hours of backoff to come back
+const ASYNQ_CALLBACK_MAX_RETRY = 12
+
+// Exponential backoff between retries, with jitter
+const ASYNQ_RETRY_BASE_DELAY = 2 * time.Second
+const ASYNQ_RETRY_MAX_DELAY = 5 * time.Minute
+
 type HEALTH_STATUS int
 
 const (
@@ -253,6 +443,13 @@ const (
 
 var BACKENDS = []BackendType{BackendScWorker, BackendRunpodServerless}
 
+// Share of a model's jobs each healthy backend gets, relative to the others
+// Backends with weight 0 are on standby, they get jobs when no weighted backend is healthy
+var BACKEND_ROUTE_WEIGHTS = map[BackendType]float64{
+	BackendScWorker:         1,
+	BackendRunpodServerless: 0,
+}
+
 // String returns the string representation of the BackendType
 func (b BackendType) String() string {
 	return string(b)
@@ -0,0 +1,154 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const FAIR_SCHEDULER_REDIS_KEY = "fair_scheduler"
+
+// Tiers of users competing for the queue
+type QueueTier string
+
+const (
+	QueueTierFree    QueueTier = "free"
+	QueueTierCredits QueueTier = "credits" // Free plan with purchased credits
+	QueueTierAdmin   QueueTier = "admin"
+	QueueTierPaid    QueueTier = "paid"
+)
+
+var QUEUE_TIERS = []QueueTier{QueueTierFree, QueueTierCredits, QueueTierAdmin, QueueTierPaid}
+
+// Weight is the tier's share of throughput relative to other tiers
+// The tier's requests are dispatched at MQ priorities between MinPriority and Priority, a user's place in the band
+// depends on how far ahead of their fair share they are, so light users are dispatched before heavy ones
+type QueueTierConfig struct {
+	Weight      float64
+	MinPriority uint8
+	Priority    uint8
+}
+
+// Bands don't overlap, priority 1 is left for internal jobs
+var QUEUE_TIER_CONFIGS = map[QueueTier]QueueTierConfig{
+	QueueTierFree:    {Weight: 1, MinPriority: QUEUE_PRIORITY_2, Priority: QUEUE_PRIORITY_3},
+	QueueTierCredits: {Weight: 2, MinPriority: QUEUE_PRIORITY_4, Priority: QUEUE_PRIORITY_5},
+	QueueTierAdmin:   {Weight: 2, MinPriority: QUEUE_PRIORITY_6, Priority: QUEUE_PRIORITY_7},
+	QueueTierPaid:    {Weight: 4, MinPriority: QUEUE_PRIORITY_8, Priority: QUEUE_PRIORITY_10},
+}
+
+// Priority within the band for a user lag ahead of their fair share
+// Users at or behind their share get the top of the band, the rest are spread over the levels below it up to maxLag
+func (c QueueTierConfig) PriorityForLag(lag time.Duration, maxLag time.Duration) uint8 {
+	levels := int64(c.Priority - c.MinPriority)
+	if lag <= 0 || levels == 0 {
+		return c.Priority
+	}
+	drop := int64(1)
+	if maxLag > 0 {
+		drop += int64(lag) * levels / int64(maxLag)
+	}
+	if drop > levels {
+		drop = levels
+	}
+	return c.Priority - uint8(drop)
+}
+
+// Tier dispatched at priority, anything outside the tiers' bands counts as free
+func QueueTierForPriority(priority uint8) QueueTier {
+	for tier, config := range QUEUE_TIER_CONFIGS {
+		if priority >= config.MinPriority && priority <= config.Priority {
+			return tier
+		}
+	}
+	return QueueTierFree
+}
+
+// Weighted fair queuing with a virtual clock per user and tier
+// Each accepted request advances the user's clock by cost / (weight * rate), the distance between their clock and
+// real time is how far ahead of their fair share they are
+// That lag decides the dispatch priority within the tier's band, and past MaxLag whether the request is admitted at all
+// Users are only turned away while the queue is contended, an idle queue admits everyone
+type FairScheduler struct {
+	redis *redis.Client
+	ctx   context.Context
+	// Credits per second each unit of weight is entitled to
+	Rate float64
+	// Requests from users lagging further than this are rejected while the queue is contended
+	MaxLag time.Duration
+}
+
+type AdmissionDecision struct {
+	Admitted bool
+	Priority uint8
+	// How far ahead of their fair share the user is
+	Lag time.Duration
+	// When rejected, how long until the request would be admitted
+	RetryAfter time.Duration
+}
+
+func NewFairScheduler(ctx context.Context, redis *redis.Client) *FairScheduler {
+	return &FairScheduler{
+		redis:  redis,
+		ctx:    ctx,
+		Rate:   FAIR_SHARE_RATE,
+		MaxLag: FAIR_SHARE_MAX_LAG,
+	}
+}
+
+func (s *FairScheduler) key(userID string, tier QueueTier) string {
+	return fmt.Sprintf("%s:%s:%s", FAIR_SCHEDULER_REDIS_KEY, tier, userID)
+}
+
+// Decide if a request from user is admitted and at what priority in its tier's band, doesn't charge the user
+// contended is whether other requests are waiting for workers
+func (s *FairScheduler) Admit(userID string, tier QueueTier, contended bool) (*AdmissionDecision, error) {
+	config, ok := QUEUE_TIER_CONFIGS[tier]
+	if !ok {
+		return nil, fmt.Errorf("unknown queue tier %s", tier)
+	}
+
+	decision := &AdmissionDecision{
+		Admitted: true,
+	}
+	finish, err := s.redis.Get(s.ctx, s.key(userID, tier)).Int64()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	if lag := finish - time.Now().UnixMilli(); lag > 0 {
+		decision.Lag = time.Duration(lag) * time.Millisecond
+	}
+	decision.Priority = config.PriorityForLag(decision.Lag, s.MaxLag)
+
+	if contended && decision.Lag > s.MaxLag {
+		decision.Admitted = false
+		decision.RetryAfter = decision.Lag - s.MaxLag
+	}
+	return decision, nil
+}
+
+// KEYS[1] user's virtual finish time, ARGV now and request duration, both ms
+var chargeScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local finish = tonumber(redis.call("GET", KEYS[1]) or "0")
+local newFinish = math.max(now, finish) + tonumber(ARGV[2])
+redis.call("SET", KEYS[1], newFinish, "PX", newFinish - now + 1000)
+return newFinish
+`)
+
+// Advance user's virtual clock for an accepted request costing cost credits
+func (s *FairScheduler) Charge(userID string, tier QueueTier, cost int) error {
+	config, ok := QUEUE_TIER_CONFIGS[tier]
+	if !ok {
+		return fmt.Errorf("unknown queue tier %s", tier)
+	}
+	if cost < 1 {
+		cost = 1
+	}
+
+	duration := int64(math.Ceil(float64(cost) / (config.Weight * s.Rate) * 1000))
+	return chargeScript.Run(s.ctx, s.redis, []string{s.key(userID, tier)}, time.Now().UnixMilli(), duration).Err()
+}
@@ -0,0 +1,128 @@
+package shared
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFairSchedulerKeepsTierPriority(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+	s := NewFairScheduler(ctx, redis)
+
+	for tier, config := range QUEUE_TIER_CONFIGS {
+		d, err := s.Admit("user", tier, true)
+		assert.Nil(t, err)
+		assert.True(t, d.Admitted)
+		assert.Equal(t, config.Priority, d.Priority)
+		assert.Equal(t, tier, QueueTierForPriority(d.Priority))
+		assert.Equal(t, tier, QueueTierForPriority(config.MinPriority))
+	}
+	assert.Equal(t, QueueTierFree, QueueTierForPriority(QUEUE_PRIORITY_1))
+	assert.Equal(t, QUEUE_PRIORITY_3, QUEUE_TIER_CONFIGS[QueueTierFree].Priority)
+	assert.Equal(t, QUEUE_PRIORITY_10, QUEUE_TIER_CONFIGS[QueueTierPaid].Priority)
+}
+
+func TestFairSchedulerOrdersUsersWithinTier(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+	s := NewFairScheduler(ctx, redis)
+	s.MaxLag = 10 * time.Minute
+	paid := QUEUE_TIER_CONFIGS[QueueTierPaid]
+
+	// 2 credits at weight 4 is 10s, a minute ahead drops the heavy user one level
+	for i := 0; i < 6; i++ {
+		assert.Nil(t, s.Charge("heavy", QueueTierPaid, 2))
+	}
+	heavy, err := s.Admit("heavy", QueueTierPaid, true)
+	assert.Nil(t, err)
+	assert.Equal(t, paid.Priority-1, heavy.Priority)
+	light, err := s.Admit("light", QueueTierPaid, true)
+	assert.Nil(t, err)
+	assert.Equal(t, paid.Priority, light.Priority)
+
+	// Further ahead is the bottom of the band, still above lower tiers
+	for i := 0; i < 60; i++ {
+		assert.Nil(t, s.Charge("heavy", QueueTierPaid, 2))
+	}
+	heavy, err = s.Admit("heavy", QueueTierPaid, false)
+	assert.Nil(t, err)
+	assert.Equal(t, paid.MinPriority, heavy.Priority)
+	assert.True(t, heavy.Priority > QUEUE_TIER_CONFIGS[QueueTierAdmin].Priority)
+}
+
+func TestFairSchedulerChargeAdvancesLag(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+	s := NewFairScheduler(ctx, redis)
+
+	// Admitting doesn't charge
+	for i := 0; i < 10; i++ {
+		d, err := s.Admit("heavy", QueueTierPaid, true)
+		assert.Nil(t, err)
+		assert.Equal(t, time.Duration(0), d.Lag)
+	}
+
+	// 2 credits at weight 4 is 10s of virtual time
+	for i := 0; i < 7; i++ {
+		assert.Nil(t, s.Charge("heavy", QueueTierPaid, 2))
+	}
+	d, err := s.Admit("heavy", QueueTierPaid, true)
+	assert.Nil(t, err)
+	assert.True(t, d.Lag > time.Minute)
+
+	// A light user in the same tier isn't affected
+	d, err = s.Admit("light", QueueTierPaid, true)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), d.Lag)
+
+	// Neither is the same user in another tier
+	d, err = s.Admit("heavy", QueueTierFree, true)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), d.Lag)
+}
+
+func TestFairSchedulerOnlyRejectsUnderContention(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+	s := NewFairScheduler(ctx, redis)
+	s.MaxLag = time.Minute
+
+	// 1 credit at weight 1 is 20s, so 5 puts the user 100s ahead
+	for i := 0; i < 5; i++ {
+		assert.Nil(t, s.Charge("user", QueueTierFree, 1))
+	}
+
+	// Nobody else is waiting
+	d, err := s.Admit("user", QueueTierFree, false)
+	assert.Nil(t, err)
+	assert.True(t, d.Admitted)
+
+	d, err = s.Admit("user", QueueTierFree, true)
+	assert.Nil(t, err)
+	assert.False(t, d.Admitted)
+	assert.True(t, d.RetryAfter > 0)
+
+	// Rejections don't push the user further back
+	d2, err := s.Admit("user", QueueTierFree, true)
+	assert.Nil(t, err)
+	assert.False(t, d2.Admitted)
+	assert.True(t, d2.Lag <= d.Lag)
+}
+
+func TestFairSchedulerUnknownTier(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+	s := NewFairScheduler(ctx, redis)
+	_, err = s.Admit("user", QueueTier("nope"), false)
+	assert.NotNil(t, err)
+	assert.NotNil(t, s.Charge("user", QueueTier("nope"), 1))
+}
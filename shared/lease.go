@@ -0,0 +1,97 @@
+package shared
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const LEASE_REDIS_KEY = "lease"
+
+// Exclusive hold on a named resource across processes, expires unless renewed
+// Only the holder can renew or release it, a lease that expired may already be someone else's
+type Lease struct {
+	redis *redis.Client
+	ctx   context.Context
+	Name  string
+	TTL   time.Duration
+	token string
+}
+
+func leaseRedisKey(name string) string {
+	return LEASE_REDIS_KEY + ":" + name
+}
+
+// Acquire the lease on name, returns nil if someone else holds it
+func AcquireLease(ctx context.Context, redis *redis.Client, name string, ttl time.Duration) (*Lease, error) {
+	lease := &Lease{
+		redis: redis,
+		ctx:   ctx,
+		Name:  name,
+		TTL:   ttl,
+		token: uuid.NewString(),
+	}
+	ok, err := redis.SetNX(ctx, leaseRedisKey(name), lease.token, ttl).Result()
+	if err != nil || !ok {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// KEYS[1] lease, ARGV token and ttl ms
+var renewLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// KEYS[1] lease, ARGV token
+var releaseLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Extend the lease by its TTL, returns false if it was lost
+func (l *Lease) Renew() (bool, error) {
+	res, err := renewLeaseScript.Run(l.ctx, l.redis, []string{leaseRedisKey(l.Name)}, l.token, l.TTL.Milliseconds()).Int()
+	return res == 1, err
+}
+
+// Release the lease if it's still held
+func (l *Lease) Release() error {
+	return releaseLeaseScript.Run(l.ctx, l.redis, []string{leaseRedisKey(l.Name)}, l.token).Err()
+}
+
+// Renew the lease every third of its TTL until ctx is done
+// Returns a channel that's closed if the lease is lost, or couldn't be renewed for a whole TTL so it may have expired
+func (l *Lease) KeepAlive(ctx context.Context) <-chan struct{} {
+	lost := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(l.TTL / 3)
+		defer ticker.Stop()
+		renewedAt := time.Now()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				held, err := l.Renew()
+				if err == nil && held {
+					renewedAt = time.Now()
+					continue
+				}
+				// Redis errors are retried while the last renewal still holds
+				if err == nil || time.Since(renewedAt) >= l.TTL {
+					close(lost)
+					return
+				}
+			}
+		}
+	}()
+	return lost
+}
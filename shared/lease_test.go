@@ -0,0 +1,72 @@
+package shared
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLease(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+
+	lease, err := AcquireLease(ctx, redis, "job", time.Minute)
+	assert.Nil(t, err)
+	assert.NotNil(t, lease)
+
+	// Held until released
+	other, err := AcquireLease(ctx, redis, "job", time.Minute)
+	assert.Nil(t, err)
+	assert.Nil(t, other)
+	held, err := lease.Renew()
+	assert.Nil(t, err)
+	assert.True(t, held)
+
+	// Other names aren't affected
+	other, err = AcquireLease(ctx, redis, "other-job", time.Minute)
+	assert.Nil(t, err)
+	assert.NotNil(t, other)
+
+	assert.Nil(t, lease.Release())
+	other, err = AcquireLease(ctx, redis, "job", time.Minute)
+	assert.Nil(t, err)
+	assert.NotNil(t, other)
+
+	// A released lease can't touch the new holder's
+	held, err = lease.Renew()
+	assert.Nil(t, err)
+	assert.False(t, held)
+	assert.Nil(t, lease.Release())
+	held, err = other.Renew()
+	assert.Nil(t, err)
+	assert.True(t, held)
+}
+
+func TestLeaseKeepAliveRedisDown(t *testing.T) {
+	ctx := context.Background()
+	redis, err := MockRedis(ctx)
+	assert.Nil(t, err)
+
+	lease, err := AcquireLease(ctx, redis, "job", 300*time.Millisecond)
+	assert.Nil(t, err)
+	assert.NotNil(t, lease)
+	keepAliveCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	lost := lease.KeepAlive(keepAliveCtx)
+
+	// Renewals fail, the lease is still held until the last renewal runs out
+	assert.Nil(t, redis.Close())
+	select {
+	case <-lost:
+		t.Fatal("lost before the TTL passed")
+	case <-time.After(150 * time.Millisecond):
+	}
+	select {
+	case <-lost:
+	case <-time.After(time.Second):
+		t.Fatal("not lost after the TTL passed")
+	}
+}
@@ -3,18 +3,76 @@ package queue
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/redis/go-redis/v9"
 	"github.com/stablecog/sc-go/log"
 	"github.com/stablecog/sc-go/utils"
 )
 
+// Highest priority a message can have, matches shared.QUEUE_PRIORITY_10
+const MAX_PRIORITY = 10
+
+// Messages that aren't consumed within this time are dropped
+const MESSAGE_TTL = 30 * time.Minute
+
+// Messages are dropped after failing this many deliveries
+const MAX_DELIVERIES = 5
+
+// Supported values for MQ_BACKEND
+const (
+	BackendRabbitMQ = "rabbitmq"
+	BackendRedis    = "redis"
+)
+
 type MQClient interface {
 	Close()
 	Publish(id string, msg any, priority uint8) error
 }
 
+// A message handed to a consumer
+type Delivery struct {
+	ID       string
+	Body     []byte
+	Priority uint8
+	// 1 on the first delivery, incremented on every redelivery
+	Attempt int
+}
+
+// Implemented by backends we consume ourselves, sc-worker consumes RabbitMQ directly
+// quecon consumes the redis backend, running its jobs on runpod
+// Higher priorities are delivered first, messages older than MESSAGE_TTL are dropped
+// If handler returns an error the message is redelivered, up to MAX_DELIVERIES times
+type MQConsumer interface {
+	Consume(ctx context.Context, handler func(d Delivery) error) error
+}
+
+// Create the client for the backend set in MQ_BACKEND
+// The server, cron and discobot publish and quecon consumes, so the queue lives outside of them
+// Without a RabbitMQ broker, use redis, it only needs the redis we already run
+func NewMQClient(ctx context.Context, redisClient *redis.Client) (MQClient, error) {
+	switch utils.GetEnv().MQBackend {
+	case BackendRedis:
+		return NewRedisMQClient(ctx, redisClient, utils.GetEnv().RabbitMQQueueName), nil
+	case BackendRabbitMQ, "":
+		client, err := NewRabbitMQClient(ctx, utils.GetEnv().RabbitMQAMQPUrl)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	}
+	return nil, fmt.Errorf("unknown MQ backend %s", utils.GetEnv().MQBackend)
+}
+
+func clampPriority(priority uint8) uint8 {
+	if priority > MAX_PRIORITY {
+		return MAX_PRIORITY
+	}
+	return priority
+}
+
 type RabbitMQClient struct {
 	connUrl string
 	conn    *amqp.Connection
@@ -65,8 +123,8 @@ func (c *RabbitMQClient) createQueue() error {
 		false,   // exclusie,
 		false,   // noWait
 		amqp.Table{
-			"x-max-priority": 10,
-			"x-message-ttl":  1800000,
+			"x-max-priority": MAX_PRIORITY,
+			"x-message-ttl":  MESSAGE_TTL.Milliseconds(),
 		},
 	)
 	return err
@@ -0,0 +1,265 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stablecog/sc-go/log"
+)
+
+// Queue backed by redis streams, one stream per priority
+// Consumers share a consumer group, so each message is handled by one consumer
+type RedisMQClient struct {
+	Ctx      context.Context
+	Client   *redis.Client
+	Queue    string
+	Group    string
+	Consumer string
+	TTL      time.Duration
+	// Messages a consumer hasn't acked within this time are redelivered, e.g. if it crashed
+	VisibilityTimeout time.Duration
+	// How long a consumer blocks waiting for new messages
+	Block time.Duration
+}
+
+func NewRedisMQClient(ctx context.Context, client *redis.Client, queue string) *RedisMQClient {
+	hostname, _ := os.Hostname()
+	return &RedisMQClient{
+		Ctx:               ctx,
+		Client:            client,
+		Queue:             queue,
+		Group:             "sc",
+		Consumer:          fmt.Sprintf("%s-%s", hostname, uuid.NewString()),
+		TTL:               MESSAGE_TTL,
+		VisibilityTimeout: 5 * time.Minute,
+		Block:             time.Second,
+	}
+}
+
+// The redis client is owned by the caller
+func (c *RedisMQClient) Close() {}
+
+func (c *RedisMQClient) streamKey(priority uint8) string {
+	return fmt.Sprintf("mq:%s:%d", c.Queue, priority)
+}
+
+// Highest priority first
+func (c *RedisMQClient) streamKeys() []string {
+	keys := make([]string, 0, MAX_PRIORITY+1)
+	for p := MAX_PRIORITY; p >= 0; p-- {
+		keys = append(keys, c.streamKey(uint8(p)))
+	}
+	return keys
+}
+
+func (c *RedisMQClient) Publish(id string, msg any, priority uint8) error {
+	marshalled, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return c.add(c.Client, c.streamKey(clampPriority(priority)), id, string(marshalled), time.Now().UnixMilli(), 1).Err()
+}
+
+func (c *RedisMQClient) add(cmd redis.Cmdable, stream string, id string, body string, publishedAt int64, attempt int) *redis.StringCmd {
+	return cmd.XAdd(c.Ctx, &redis.XAddArgs{
+		Stream: stream,
+		Values: map[string]interface{}{
+			"id":           id,
+			"body":         body,
+			"published_at": publishedAt,
+			"attempt":      attempt,
+		},
+	})
+}
+
+func (c *RedisMQClient) createGroups() error {
+	for _, key := range c.streamKeys() {
+		err := c.Client.XGroupCreateMkStream(c.Ctx, key, c.Group, "0").Err()
+		if err != nil && !strings.Contains(err.Error(), "BUSYGROUP") {
+			return err
+		}
+	}
+	return nil
+}
+
+// A message read from one of the priority streams
+type streamMessage struct {
+	stream    string
+	msg       redis.XMessage
+	reclaimed bool
+}
+
+func (c *RedisMQClient) Consume(ctx context.Context, handler func(d Delivery) error) error {
+	if err := c.createGroups(); err != nil {
+		return err
+	}
+	var lastReclaim time.Time
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var msgs []streamMessage
+		var err error
+		if time.Since(lastReclaim) >= c.reclaimInterval() {
+			lastReclaim = time.Now()
+			msgs, err = c.reclaim(ctx)
+		}
+		if err == nil && len(msgs) == 0 {
+			msgs, err = c.read(ctx)
+		}
+		if err != nil {
+			log.Error("Error reading from redis stream", "queue", c.Queue, "err", err)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		for _, m := range msgs {
+			c.handle(m.stream, &m.msg, m.reclaimed, handler)
+		}
+	}
+}
+
+// Abandoned messages are looked for this often, a fraction of the visibility timeout
+func (c *RedisMQClient) reclaimInterval() time.Duration {
+	return c.VisibilityTimeout / 4
+}
+
+// Claim messages other consumers haven't acked within the visibility timeout, highest priority first
+func (c *RedisMQClient) reclaim(ctx context.Context) ([]streamMessage, error) {
+	var claimed []streamMessage
+	for _, key := range c.streamKeys() {
+		msgs, _, err := c.Client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   key,
+			Group:    c.Group,
+			Consumer: c.Consumer,
+			MinIdle:  c.VisibilityTimeout,
+			Start:    "0-0",
+			Count:    10,
+		}).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range msgs {
+			claimed = append(claimed, streamMessage{stream: key, msg: msg, reclaimed: true})
+		}
+	}
+	return claimed, nil
+}
+
+// New messages, at most one per priority, highest priority first
+// Blocks on all priorities at once until something is published or Block passes
+func (c *RedisMQClient) read(ctx context.Context) ([]streamMessage, error) {
+	keys := c.streamKeys()
+	args := make([]string, 0, len(keys)*2)
+	args = append(args, keys...)
+	for range keys {
+		args = append(args, ">")
+	}
+	streams, err := c.Client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    c.Group,
+		Consumer: c.Consumer,
+		Streams:  args,
+		Count:    1,
+		Block:    c.Block,
+	}).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	priorityOf := make(map[string]int, len(keys))
+	for i, key := range keys {
+		priorityOf[key] = i
+	}
+	var msgs []streamMessage
+	for _, s := range streams {
+		for _, msg := range s.Messages {
+			msgs = append(msgs, streamMessage{stream: s.Stream, msg: msg})
+		}
+	}
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return priorityOf[msgs[i].stream] < priorityOf[msgs[j].stream]
+	})
+	return msgs, nil
+}
+
+func (c *RedisMQClient) handle(stream string, msg *redis.XMessage, reclaimed bool, handler func(d Delivery) error) {
+	id, _ := msg.Values["id"].(string)
+	body, _ := msg.Values["body"].(string)
+	publishedAt, _ := strconv.ParseInt(fmt.Sprint(msg.Values["published_at"]), 10, 64)
+	attempt, _ := strconv.Atoi(fmt.Sprint(msg.Values["attempt"]))
+	if attempt < 1 {
+		attempt = 1
+	}
+	priority, _ := strconv.Atoi(stream[strings.LastIndex(stream, ":")+1:])
+
+	// Every claim of an abandoned message counts as a delivery
+	if reclaimed {
+		pending, err := c.Client.XPendingExt(c.Ctx, &redis.XPendingExtArgs{
+			Stream: stream,
+			Group:  c.Group,
+			Start:  msg.ID,
+			End:    msg.ID,
+			Count:  1,
+		}).Result()
+		if err == nil && len(pending) > 0 {
+			attempt += int(pending[0].RetryCount) - 1
+		}
+	}
+
+	if time.Since(time.UnixMilli(publishedAt)) > c.TTL {
+		log.Warn("Dropping expired message", "id", id, "priority", priority)
+		c.ack(stream, msg.ID)
+		return
+	}
+
+	err := handler(Delivery{
+		ID:       id,
+		Body:     []byte(body),
+		Priority: uint8(priority),
+		Attempt:  attempt,
+	})
+	if err == nil {
+		c.ack(stream, msg.ID)
+		return
+	}
+
+	if attempt >= MAX_DELIVERIES {
+		log.Error("Dropping message after max deliveries", "id", id, "attempts", attempt, "err", err)
+		c.ack(stream, msg.ID)
+		return
+	}
+
+	// Redeliver now rather than waiting for the visibility timeout
+	_, txErr := c.Client.TxPipelined(c.Ctx, func(pipe redis.Pipeliner) error {
+		c.add(pipe, stream, id, body, publishedAt, attempt+1)
+		pipe.XAck(c.Ctx, stream, c.Group, msg.ID)
+		pipe.XDel(c.Ctx, stream, msg.ID)
+		return nil
+	})
+	if txErr != nil {
+		log.Error("Error requeueing message", "id", id, "err", txErr)
+	}
+}
+
+func (c *RedisMQClient) ack(stream string, msgID string) {
+	_, err := c.Client.TxPipelined(c.Ctx, func(pipe redis.Pipeliner) error {
+		pipe.XAck(c.Ctx, stream, c.Group, msgID)
+		pipe.XDel(c.Ctx, stream, msgID)
+		return nil
+	})
+	if err != nil {
+		log.Error("Error acking message", "id", msgID, "err", err)
+	}
+}
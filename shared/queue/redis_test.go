@@ -0,0 +1,125 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockRedisMQClient(t *testing.T) *RedisMQClient {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	c := NewRedisMQClient(context.Background(), client, "test")
+	c.Block = 10 * time.Millisecond
+	return c
+}
+
+// Consume until n deliveries have been handled or the timeout hits
+func consumeN(t *testing.T, consumer MQConsumer, n int, handler func(d Delivery) error) []Delivery {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var deliveries []Delivery
+	consumer.Consume(ctx, func(d Delivery) error {
+		deliveries = append(deliveries, d)
+		err := handler(d)
+		if len(deliveries) >= n {
+			cancel()
+		}
+		return err
+	})
+	return deliveries
+}
+
+func TestRedisPriorityOrder(t *testing.T) {
+	c := mockRedisMQClient(t)
+	assert.Nil(t, c.Publish("low", "a", 1))
+	assert.Nil(t, c.Publish("high", "b", 5))
+	assert.Nil(t, c.Publish("low2", "c", 1))
+
+	deliveries := consumeN(t, c, 3, func(d Delivery) error { return nil })
+	assert.Len(t, deliveries, 3)
+	assert.Equal(t, "high", deliveries[0].ID)
+	assert.Equal(t, uint8(5), deliveries[0].Priority)
+	assert.Equal(t, "low", deliveries[1].ID)
+	assert.Equal(t, "low2", deliveries[2].ID)
+	assert.Equal(t, `"a"`, string(deliveries[1].Body))
+	assert.Equal(t, 1, deliveries[1].Attempt)
+
+	// Acked messages are removed
+	n, err := c.Client.XLen(c.Ctx, c.streamKey(1)).Result()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), n)
+}
+
+func TestRedisRedelivery(t *testing.T) {
+	c := mockRedisMQClient(t)
+	assert.Nil(t, c.Publish("flaky", "a", 1))
+
+	deliveries := consumeN(t, c, 2, func(d Delivery) error {
+		if d.Attempt == 1 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	assert.Len(t, deliveries, 2)
+	assert.Equal(t, "flaky", deliveries[1].ID)
+	assert.Equal(t, 2, deliveries[1].Attempt)
+}
+
+func TestRedisMaxDeliveries(t *testing.T) {
+	c := mockRedisMQClient(t)
+	assert.Nil(t, c.Publish("broken", "a", 1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	attempts := 0
+	c.Consume(ctx, func(d Delivery) error {
+		attempts++
+		return errors.New("failed")
+	})
+	assert.Equal(t, MAX_DELIVERIES, attempts)
+}
+
+func TestRedisReclaimsAbandonedMessages(t *testing.T) {
+	c := mockRedisMQClient(t)
+	assert.Nil(t, c.createGroups())
+	assert.Nil(t, c.Publish("abandoned", "a", 1))
+
+	// Another consumer reads it and never acks
+	_, err := c.Client.XReadGroup(c.Ctx, &redis.XReadGroupArgs{
+		Group:    c.Group,
+		Consumer: "crashed",
+		Streams:  []string{c.streamKey(1), ">"},
+		Count:    1,
+		Block:    -1,
+	}).Result()
+	assert.Nil(t, err)
+
+	c.VisibilityTimeout = time.Millisecond
+	time.Sleep(5 * time.Millisecond)
+	deliveries := consumeN(t, c, 1, func(d Delivery) error { return nil })
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, "abandoned", deliveries[0].ID)
+	assert.Equal(t, 2, deliveries[0].Attempt)
+}
+
+func TestRedisTTL(t *testing.T) {
+	c := mockRedisMQClient(t)
+	c.TTL = 10 * time.Millisecond
+	assert.Nil(t, c.Publish("expired", "a", 1))
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	var ids []string
+	c.Consume(ctx, func(d Delivery) error {
+		ids = append(ids, d.ID)
+		return nil
+	})
+	assert.Empty(t, ids)
+}
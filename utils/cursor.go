@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Position in a list ordered by created_at then id, both descending
+// Rows sharing a timestamp are told apart by id so none are skipped between pages
+type KeysetCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// e.g. 2023-01-27T14:40:53.858Z_9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d
+func (c KeysetCursor) String() string {
+	return fmt.Sprintf("%s_%s", TimeToIsoString(c.CreatedAt.UTC()), c.ID)
+}
+
+func (c KeysetCursor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+func (c *KeysetCursor) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseKeysetCursor(s)
+	if err != nil {
+		return err
+	}
+	*c = *parsed
+	return nil
+}
+
+// Parse a cursor from String, a bare iso time string is a cursor before every row at that time
+func ParseKeysetCursor(cursor string) (*KeysetCursor, error) {
+	timeStr, idStr, hasID := strings.Cut(cursor, "_")
+	createdAt, err := ParseIsoTime(timeStr)
+	if err != nil {
+		return nil, err
+	}
+	c := &KeysetCursor{CreatedAt: createdAt}
+	if hasID {
+		if c.ID, err = uuid.Parse(idStr); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeysetCursor(t *testing.T) {
+	c := KeysetCursor{
+		CreatedAt: time.Date(2023, 1, 27, 14, 40, 53, 858123000, time.UTC),
+		ID:        uuid.MustParse("9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d"),
+	}
+	assert.Equal(t, "2023-01-27T14:40:53.858123Z_9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d", c.String())
+
+	parsed, err := ParseKeysetCursor(c.String())
+	assert.Nil(t, err)
+	assert.True(t, c.CreatedAt.Equal(parsed.CreatedAt))
+	assert.Equal(t, c.ID, parsed.ID)
+
+	b, err := json.Marshal(&c)
+	assert.Nil(t, err)
+	assert.Equal(t, `"`+c.String()+`"`, string(b))
+	var unmarshalled KeysetCursor
+	assert.Nil(t, json.Unmarshal(b, &unmarshalled))
+	assert.Equal(t, c.ID, unmarshalled.ID)
+
+	// Plain iso times still work
+	parsed, err = ParseKeysetCursor("2023-01-27T14:40:53.858Z")
+	assert.Nil(t, err)
+	assert.Equal(t, uuid.Nil, parsed.ID)
+
+	_, err = ParseKeysetCursor("2023-01-27T14:40:53.858Z_nope")
+	assert.NotNil(t, err)
+	_, err = ParseKeysetCursor("nope")
+	assert.NotNil(t, err)
+}
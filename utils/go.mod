@@ -7,6 +7,7 @@ replace google.golang.org/genproto => google.golang.org/genproto v0.0.0-20240725
 require (
 	github.com/TwiN/go-away v1.6.13
 	github.com/caarlos0/env/v9 v9.0.0
+	github.com/google/uuid v1.6.0
 	github.com/mileusna/useragent v1.3.4
 	github.com/stablecog/sc-go/log v0.0.0-20240730141151-89c08cf309f7
 	github.com/stablecog/sc-go/shared v0.0.0-20240730141151-89c08cf309f7
@@ -35,7 +36,6 @@ require (
 	github.com/gogo/protobuf v1.3.2 // indirect
 	github.com/golang/snappy v0.0.4 // indirect
 	github.com/google/go-cmp v0.6.0 // indirect
-	github.com/google/uuid v1.6.0 // indirect
 	github.com/grafana/loki-client-go v0.0.0-20230116142646-e7494d0ef70c // indirect
 	github.com/grafana/regexp v0.0.0-20240518133315-a468a5bfb3bc // indirect
 	github.com/hashicorp/errwrap v1.1.0 // indirect
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
 )
@@ -10,3 +11,10 @@ func Sha256(s string) string {
 	h.Write([]byte(s))
 	return hex.EncodeToString(h.Sum(nil))
 }
+
+// Hex encoded HMAC-SHA256 of s keyed with secret
+func HmacSha256(secret string, s string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(s))
+	return hex.EncodeToString(h.Sum(nil))
+}
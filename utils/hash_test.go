@@ -10,3 +10,8 @@ func TestSha256(t *testing.T) {
 	toHash := "test"
 	assert.Equal(t, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", Sha256(toHash))
 }
+
+func TestHmacSha256(t *testing.T) {
+	assert.Equal(t, "0329a06b62cd16b33eb6792be8c60b158d89a2ee3a876fce9a881ebb488c0914", HmacSha256("secret", "test"))
+	assert.NotEqual(t, HmacSha256("secret", "test"), HmacSha256("other", "test"))
+}
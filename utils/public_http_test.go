@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	for _, ip := range []string{"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.64.0.1", "0.0.0.0", "::1", "fe80::1", "fd00::1", "::ffff:127.0.0.1"} {
+		assert.False(t, IsPublicIP(netip.MustParseAddr(ip)), ip)
+	}
+	for _, ip := range []string{"1.1.1.1", "8.8.8.8", "2606:4700:4700::1111"} {
+		assert.True(t, IsPublicIP(netip.MustParseAddr(ip)), ip)
+	}
+}
+
+func TestIsPublicHTTPURL(t *testing.T) {
+	assert.True(t, IsPublicHTTPURL("https://example.com/callback"))
+	assert.True(t, IsPublicHTTPURL("http://1.1.1.1/callback"))
+	assert.False(t, IsPublicHTTPURL("http://localhost:8080/callback"))
+	assert.False(t, IsPublicHTTPURL("http://127.0.0.1/callback"))
+	assert.False(t, IsPublicHTTPURL("http://169.254.169.254/latest/meta-data"))
+	assert.False(t, IsPublicHTTPURL("http://[::1]/callback"))
+	assert.False(t, IsPublicHTTPURL("ftp://example.com"))
+}
+
+func TestPublicHTTPClientRefusesInternalAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	// The test server listens on loopback
+	client := NewPublicHTTPClient(time.Second)
+	_, err := client.Get(srv.URL)
+	assert.ErrorContains(t, err, "non-public address")
+}